@@ -21,16 +21,19 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 	"time"
 
 	"context"
 
 	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/servenv"
 )
 
@@ -46,6 +49,15 @@ var (
 	// mysqlctl init flags
 	waitTime      = flag.Duration("wait_time", 5*time.Minute, "how long to wait for mysqld startup or shutdown")
 	initDBSQLFile = flag.String("init_db_sql_file", "", "path to .sql file to run after mysql_install_db")
+
+	// binlog server flags
+	binlogServer              = flag.Bool("binlog_server", false, "if set, continuously archive the binlogs of the replication source configured with the db-config-erepl-* flags into the backup storage")
+	binlogServerKeyspace      = flag.String("binlog_server_keyspace", "", "keyspace of the shard whose binlogs are archived in binlog server mode")
+	binlogServerShard         = flag.String("binlog_server_shard", "", "shard whose binlogs are archived in binlog server mode")
+	binlogServerID            = flag.Uint("binlog_server_id", 0, "server id used to connect to the replication source in binlog server mode, must be unique among its replicas")
+	binlogServerRetryInterval = flag.Duration("binlog_server_retry_interval", 10*time.Second, "how long to wait before reconnecting to the replication source after an error in binlog server mode")
+	binlogServerPort          = flag.Int("binlog_server_port", 0, "if set, serve the archived binlogs to replicas over the MySQL replication protocol on this port in binlog server mode, authenticating them with the -mysql_auth_server_static_* flags. Replicas must use file and position replication, and lag behind the source by up to one binlog file")
+	binlogServerPollInterval  = flag.Duration("binlog_server_poll_interval", 5*time.Second, "how often the backup storage is checked for newly archived binlogs to serve to replicas in binlog server mode")
 )
 
 func init() {
@@ -58,9 +70,15 @@ func main() {
 	defer logutil.Flush()
 
 	// mysqlctld only starts and stops mysql, only needs dba.
-	dbconfigs.RegisterFlags(dbconfigs.Dba)
+	// The binlog server mode also streams from an external source.
+	dbconfigs.RegisterFlags(dbconfigs.Dba, dbconfigs.ExternalRepl)
 	servenv.ParseFlags("mysqlctld")
 
+	if *binlogServer && (*binlogServerKeyspace == "" || *binlogServerShard == "" || *binlogServerID == 0) {
+		log.Errorf("-binlog_server requires -binlog_server_keyspace, -binlog_server_shard and -binlog_server_id")
+		exit.Return(1)
+	}
+
 	// We'll register this OnTerm handler before mysqld starts, so we get notified
 	// if mysqld dies on its own without us (or our RPC client) telling it to.
 	mysqldTerminated := make(chan struct{})
@@ -129,6 +147,35 @@ func main() {
 		}
 	})
 
+	if *binlogServer {
+		bs, err := backupstorage.GetBackupStorage()
+		if err != nil {
+			log.Errorf("binlog server mode needs a backup storage: %v", err)
+			exit.Return(1)
+		}
+		defer bs.Close()
+		binlogServerCtx, binlogServerCancel := context.WithCancel(context.Background())
+		servenv.OnTermSync(binlogServerCancel)
+		bls := mysqlctl.NewBinlogServer(dbconfigs.GlobalDBConfigs.ExternalRepl(), bs,
+			mysqlctl.GetBinlogArchiveDir(*binlogServerKeyspace, *binlogServerShard),
+			uint32(*binlogServerID), *binlogServerRetryInterval)
+		go bls.Run(binlogServerCtx)
+
+		if *binlogServerPort != 0 {
+			mysql.InitAuthServerStatic()
+			handler := mysqlctl.NewBinlogServerHandler(bs,
+				mysqlctl.GetBinlogArchiveDir(*binlogServerKeyspace, *binlogServerShard),
+				uint32(*binlogServerID), *binlogServerPollInterval)
+			l, err := mysql.NewListener("tcp", fmt.Sprintf(":%d", *binlogServerPort), mysql.GetAuthServer("static"), handler, 0, 0, false)
+			if err != nil {
+				log.Errorf("binlog server mode failed to listen on port %v: %v", *binlogServerPort, err)
+				exit.Return(1)
+			}
+			servenv.OnTermSync(l.Close)
+			go l.Accept()
+		}
+	}
+
 	// Start RPC server and wait for SIGTERM.
 	mysqlctldTerminated := make(chan struct{})
 	go func() {
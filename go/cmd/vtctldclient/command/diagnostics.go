@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/statssnapshot"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// CollectDiagnostics makes a CollectDiagnostics gRPC call to a vtctld.
+	CollectDiagnostics = &cobra.Command{
+		Use:   "CollectDiagnostics [--cpu-profile DURATION] [--query-log DURATION] [--output FILE] [--upload] {--vtgate HOST:PORT | <tablet_alias>}",
+		Short: "Collects the profiles, goroutines, stats, recent query logs and configuration of a vttablet or vtgate into an archive.",
+		Long: `Collects the profiles, goroutines, stats, recent query logs and configuration of a vttablet or vtgate into an archive.
+
+The vtctld fetches the debug endpoints of the tablet, or of the vtgate at the given web address, and
+returns a gzipped tar archive, which is written to --output. With --upload, the vtctld also stores the
+archive in its backup storage, in the "diagnostics" directory.
+
+The vtgate address must be one of the web addresses the vtgates register in the topo of their cell,
+see the vtgate -web_hostname flag. The collection fails if the files are larger than the
+-diagnostics_max_size of the vtctld.
+
+The CPU profile and the query log are only collected if their duration is set, the command lasts at
+least as long as the longest of them.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		RunE:                  commandCollectDiagnostics,
+	}
+	// DiffStatsSnapshots diffs two stats snapshots, without a vtctld.
+	DiffStatsSnapshots = &cobra.Command{
+		Use:   "DiffStatsSnapshots [--limit N] <before_file> <after_file>",
+		Short: "Reports the differences between two stats snapshots, with the biggest changes first.",
+		Long: `Reports the differences between two stats snapshots, with the biggest changes first.
+
+The snapshots are the files written by GetTabletStatsSnapshot, typically before and after an incident.
+The numeric stats are sorted by their change relative to their value in the before snapshot, and the
+--limit biggest changes are reported, with the stats which doubled, dropped to zero, appeared or
+disappeared highlighted with a "!". The changes of the other stats are all reported.
+
+This command runs locally, it does not need --server.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandDiffStatsSnapshots,
+		Annotations:           map[string]string{offlineAnnotation: "true"},
+	}
+	// GetTabletStatsSnapshot makes a GetTabletStatsSnapshot gRPC call to a vtctld.
+	GetTabletStatsSnapshot = &cobra.Command{
+		Use:   "GetTabletStatsSnapshot [--output FILE] <tablet_alias>",
+		Short: "Captures a snapshot of the stats a tablet exports on /debug/vars.",
+		Long: `Captures a snapshot of the stats a tablet exports on /debug/vars.
+
+The stats are flattened into their leaves, and written as JSON to --output, or to stdout. Two snapshots
+can be compared with DiffStatsSnapshots.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetTabletStatsSnapshot,
+	}
+)
+
+var collectDiagnosticsOptions = struct {
+	VtgateAddress string
+	CPUProfile    time.Duration
+	QueryLog      time.Duration
+	Output        string
+	Upload        bool
+}{}
+
+func commandCollectDiagnostics(cmd *cobra.Command, args []string) error {
+	req := &vtctldatapb.CollectDiagnosticsRequest{
+		VtgateAddress:     collectDiagnosticsOptions.VtgateAddress,
+		CpuProfileSeconds: int64(collectDiagnosticsOptions.CPUProfile.Seconds()),
+		QueryLogSeconds:   int64(collectDiagnosticsOptions.QueryLog.Seconds()),
+		Upload:            collectDiagnosticsOptions.Upload,
+	}
+
+	switch {
+	case cmd.Flags().NArg() == 1 && req.VtgateAddress == "":
+		alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+		if err != nil {
+			return err
+		}
+		req.TabletAlias = alias
+	case cmd.Flags().NArg() == 0 && req.VtgateAddress != "":
+	default:
+		return errors.New("must pass exactly one of --vtgate and a tablet alias")
+	}
+
+	if collectDiagnosticsOptions.Output == "" && !req.Upload {
+		return errors.New("must pass --output, --upload or both")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.CollectDiagnostics(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	if collectDiagnosticsOptions.Output != "" {
+		if err := os.WriteFile(collectDiagnosticsOptions.Output, resp.Archive, 0644); err != nil {
+			return err
+		}
+		fmt.Printf("Diagnostics written to %s\n", collectDiagnosticsOptions.Output)
+	}
+	if resp.UploadLocation != "" {
+		fmt.Printf("Diagnostics uploaded to %s\n", resp.UploadLocation)
+	}
+
+	return nil
+}
+
+var diffStatsSnapshotsOptions = struct {
+	Limit int
+}{}
+
+func commandDiffStatsSnapshots(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	var snapshots [2]*vtctldatapb.StatsSnapshot
+	for i, path := range cmd.Flags().Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		snapshots[i] = &vtctldatapb.StatsSnapshot{}
+		if err := json2.Unmarshal(data, snapshots[i]); err != nil {
+			return fmt.Errorf("cannot parse the stats snapshot %s: %w", path, err)
+		}
+	}
+
+	diff := statssnapshot.DiffSnapshots(snapshots[0], snapshots[1])
+	fmt.Print(diff.Format(diffStatsSnapshotsOptions.Limit))
+	return nil
+}
+
+var getTabletStatsSnapshotOptions = struct {
+	Output string
+}{}
+
+func commandGetTabletStatsSnapshot(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetTabletStatsSnapshot(commandCtx, &vtctldatapb.GetTabletStatsSnapshotRequest{
+		TabletAlias: alias,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Snapshot)
+	if err != nil {
+		return err
+	}
+	if getTabletStatsSnapshotOptions.Output == "" {
+		fmt.Printf("%s\n", data)
+		return nil
+	}
+	if err := os.WriteFile(getTabletStatsSnapshotOptions.Output, data, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("Stats snapshot written to %s\n", getTabletStatsSnapshotOptions.Output)
+	return nil
+}
+
+func init() {
+	CollectDiagnostics.Flags().StringVar(&collectDiagnosticsOptions.VtgateAddress, "vtgate", "", "The host:port of the web port of the vtgate to collect the diagnostics from.")
+	CollectDiagnostics.Flags().DurationVar(&collectDiagnosticsOptions.CPUProfile, "cpu-profile", 0, "Duration of the CPU profile. No CPU profile is collected if zero.")
+	CollectDiagnostics.Flags().DurationVar(&collectDiagnosticsOptions.QueryLog, "query-log", 0, "Duration during which the query log is collected. The query log is not collected if zero.")
+	CollectDiagnostics.Flags().StringVarP(&collectDiagnosticsOptions.Output, "output", "o", "", "File to write the archive to.")
+	CollectDiagnostics.Flags().BoolVar(&collectDiagnosticsOptions.Upload, "upload", false, "Store the archive in the backup storage of the vtctld.")
+	Root.AddCommand(CollectDiagnostics)
+
+	DiffStatsSnapshots.Flags().IntVar(&diffStatsSnapshotsOptions.Limit, "limit", 20, "Number of numeric changes to report. All of them are reported if zero.")
+	Root.AddCommand(DiffStatsSnapshots)
+
+	GetTabletStatsSnapshot.Flags().StringVarP(&getTabletStatsSnapshotOptions.Output, "output", "o", "", "File to write the snapshot to, instead of stdout.")
+	Root.AddCommand(GetTabletStatsSnapshot)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ApplyMaskingPolicy makes an ApplyMaskingPolicy gRPC call to a vtctld.
+	ApplyMaskingPolicy = &cobra.Command{
+		Use:   "ApplyMaskingPolicy {--policy POLICY | --policy-file POLICY_FILE} [--dry-run]",
+		Short: "Applies the masking policy of the cluster.",
+		Long: `Applies the masking policy of the cluster.
+
+The values of the masked columns are masked when VReplication copies them into the non-production keyspaces
+of the policy, from any other keyspace. The policy applies to the streams starting after it is applied. An empty
+policy deletes it. The salt is base64 encoded in the JSON.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandApplyMaskingPolicy,
+	}
+	// GetMaskingPolicy makes a GetMaskingPolicy gRPC call to a vtctld.
+	GetMaskingPolicy = &cobra.Command{
+		Use:                   "GetMaskingPolicy",
+		Short:                 "Displays the masking policy of the cluster.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandGetMaskingPolicy,
+	}
+)
+
+var applyMaskingPolicyOptions = struct {
+	Policy         string
+	PolicyFilePath string
+	DryRun         bool
+}{}
+
+func commandApplyMaskingPolicy(cmd *cobra.Command, args []string) error {
+	if applyMaskingPolicyOptions.Policy != "" && applyMaskingPolicyOptions.PolicyFilePath != "" {
+		return fmt.Errorf("cannot pass both --policy (=%s) and --policy-file (=%s)", applyMaskingPolicyOptions.Policy, applyMaskingPolicyOptions.PolicyFilePath)
+	}
+
+	if applyMaskingPolicyOptions.Policy == "" && applyMaskingPolicyOptions.PolicyFilePath == "" {
+		return errors.New("must pass exactly one of --policy and --policy-file")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	var policyBytes []byte
+	if applyMaskingPolicyOptions.PolicyFilePath != "" {
+		data, err := os.ReadFile(applyMaskingPolicyOptions.PolicyFilePath)
+		if err != nil {
+			return err
+		}
+
+		policyBytes = data
+	} else {
+		policyBytes = []byte(applyMaskingPolicyOptions.Policy)
+	}
+
+	policy := &binlogdatapb.MaskingPolicy{}
+	if err := json2.Unmarshal(policyBytes, &policy); err != nil {
+		return err
+	}
+
+	// Round-trip so when we display the result it's readable.
+	data, err := cli.MarshalJSON(policy)
+	if err != nil {
+		return err
+	}
+
+	if applyMaskingPolicyOptions.DryRun {
+		fmt.Printf("[DRY RUN] Would have saved new MaskingPolicy object:\n%s\n", data)
+		return nil
+	}
+
+	_, err = client.ApplyMaskingPolicy(commandCtx, &vtctldatapb.ApplyMaskingPolicyRequest{
+		MaskingPolicy: policy,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New MaskingPolicy object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", data)
+
+	return nil
+}
+
+func commandGetMaskingPolicy(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetMaskingPolicy(commandCtx, &vtctldatapb.GetMaskingPolicyRequest{})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.MaskingPolicy)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	ApplyMaskingPolicy.Flags().StringVarP(&applyMaskingPolicyOptions.Policy, "policy", "p", "", "Masking policy, specified as a string")
+	ApplyMaskingPolicy.Flags().StringVarP(&applyMaskingPolicyOptions.PolicyFilePath, "policy-file", "f", "", "Path to a file containing the masking policy specified as JSON")
+	ApplyMaskingPolicy.Flags().BoolVarP(&applyMaskingPolicyOptions.DryRun, "dry-run", "d", false, "Load the specified masking policy as a validation step, but do not actually apply it to the topo.")
+	Root.AddCommand(ApplyMaskingPolicy)
+
+	Root.AddCommand(GetMaskingPolicy)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// GetDeadLetters makes a GetDeadLetters gRPC call to a vtctld.
+	GetDeadLetters = &cobra.Command{
+		Use:   "GetDeadLetters [--limit N] <keyspace> <table>",
+		Short: "Displays the dead-lettered messages of a message table, by shard.",
+		Long: `Displays the dead-lettered messages of a message table, by shard.
+
+Messages are dead-lettered once they were sent vt_max_attempts times without being acked, if the
+table is configured with vt_dead_letter=park. Only the shards with dead-lettered messages are displayed.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandGetDeadLetters,
+	}
+	// PurgeDeadLetters makes a PurgeDeadLetters gRPC call to a vtctld.
+	PurgeDeadLetters = &cobra.Command{
+		Use:                   "PurgeDeadLetters {--all | --id ID ...} <keyspace> <table>",
+		Short:                 "Deletes the given, or all, dead-lettered messages of a message table.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandPurgeDeadLetters,
+	}
+	// RequeueDeadLetters makes a RequeueDeadLetters gRPC call to a vtctld.
+	RequeueDeadLetters = &cobra.Command{
+		Use:   "RequeueDeadLetters {--all | --id ID ...} <keyspace> <table>",
+		Short: "Sends the given, or all, dead-lettered messages of a message table again.",
+		Long: `Sends the given, or all, dead-lettered messages of a message table again.
+
+The attempts of the messages are reset, so they are dead-lettered again only after vt_max_attempts new attempts.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandRequeueDeadLetters,
+	}
+)
+
+var getDeadLettersOptions = struct {
+	Limit int64
+}{}
+
+func commandGetDeadLetters(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetDeadLetters(commandCtx, &vtctldatapb.GetDeadLettersRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Table:    cmd.Flags().Arg(1),
+		Limit:    getDeadLettersOptions.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var updateDeadLettersOptions = struct {
+	IDs []string
+	All bool
+}{}
+
+func checkUpdateDeadLettersOptions() error {
+	if (len(updateDeadLettersOptions.IDs) == 0) == !updateDeadLettersOptions.All {
+		return errors.New("must pass exactly one of --all and --id")
+	}
+	return nil
+}
+
+func commandPurgeDeadLetters(cmd *cobra.Command, args []string) error {
+	if err := checkUpdateDeadLettersOptions(); err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.PurgeDeadLetters(commandCtx, &vtctldatapb.PurgeDeadLettersRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Table:    cmd.Flags().Arg(1),
+		Ids:      updateDeadLettersOptions.IDs,
+		All:      updateDeadLettersOptions.All,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Purged %d dead-lettered messages\n", resp.Count)
+
+	return nil
+}
+
+func commandRequeueDeadLetters(cmd *cobra.Command, args []string) error {
+	if err := checkUpdateDeadLettersOptions(); err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.RequeueDeadLetters(commandCtx, &vtctldatapb.RequeueDeadLettersRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Table:    cmd.Flags().Arg(1),
+		Ids:      updateDeadLettersOptions.IDs,
+		All:      updateDeadLettersOptions.All,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Requeued %d dead-lettered messages\n", resp.Count)
+
+	return nil
+}
+
+func init() {
+	GetDeadLetters.Flags().Int64Var(&getDeadLettersOptions.Limit, "limit", 100, "Maximum number of dead-lettered messages to display per shard.")
+	Root.AddCommand(GetDeadLetters)
+
+	PurgeDeadLetters.Flags().StringSliceVar(&updateDeadLettersOptions.IDs, "id", nil, "ID of a dead-lettered message to purge. Can be repeated.")
+	PurgeDeadLetters.Flags().BoolVar(&updateDeadLettersOptions.All, "all", false, "Purge all the dead-lettered messages.")
+	Root.AddCommand(PurgeDeadLetters)
+
+	RequeueDeadLetters.Flags().StringSliceVar(&updateDeadLettersOptions.IDs, "id", nil, "ID of a dead-lettered message to requeue. Can be repeated.")
+	RequeueDeadLetters.Flags().BoolVar(&updateDeadLettersOptions.All, "all", false, "Requeue all the dead-lettered messages.")
+	Root.AddCommand(RequeueDeadLetters)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ApplyQueryRule makes an ApplyQueryRule gRPC call to a vtctld.
+	ApplyQueryRule = &cobra.Command{
+		Use:   "ApplyQueryRule {--rule RULE | --rule-file RULE_FILE} [--shard SHARD | --tablet-alias TABLET_ALIAS] [--expected-version VERSION] <keyspace>",
+		Short: "Creates or replaces a custom query rule for a keyspace, a shard or a tablet.",
+		Long: `Creates or replaces a custom query rule for a keyspace, a shard or a tablet.
+
+The rule is specified as JSON, in the same format as the rules of the -filecustomrules file of vttablet,
+for instance {"Name": "no_scans", "TableNames": ["t1"], "Query": "select .*", "Action": "FAIL"}.
+Supported actions are FAIL, FAIL_RETRY (to make vtgate retry on another tablet), and TIMEOUT (with a "Timeout" duration).
+Query rules run on the tablets, and cannot redirect queries to another table or keyspace: use the routing rules of the
+vschema (ApplyRoutingRules) for that.
+If a rule with the same name already exists in the same scope, it is replaced in place.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandApplyQueryRule,
+	}
+	// DeleteQueryRule makes a DeleteQueryRule gRPC call to a vtctld.
+	DeleteQueryRule = &cobra.Command{
+		Use:                   "DeleteQueryRule [--shard SHARD | --tablet-alias TABLET_ALIAS] [--expected-version VERSION] <keyspace> <rule_name>",
+		Short:                 "Deletes a custom query rule of a keyspace, a shard or a tablet.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandDeleteQueryRule,
+	}
+	// GetQueryRules makes a GetQueryRules gRPC call to a vtctld.
+	GetQueryRules = &cobra.Command{
+		Use:                   "GetQueryRules <keyspace>",
+		Short:                 "Displays the custom query rules of a keyspace, its shards and its tablets.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetQueryRules,
+	}
+)
+
+// queryRuleScopeOptions are the flags shared by ApplyQueryRule and
+// DeleteQueryRule.
+type queryRuleScopeOptions struct {
+	Shard           string
+	TabletAlias     string
+	ExpectedVersion int64
+}
+
+func (opts *queryRuleScopeOptions) tabletAlias() (*topodatapb.TabletAlias, error) {
+	if opts.TabletAlias == "" {
+		return nil, nil
+	}
+	if opts.Shard != "" {
+		return nil, fmt.Errorf("cannot pass both --shard (=%s) and --tablet-alias (=%s)", opts.Shard, opts.TabletAlias)
+	}
+	return topoproto.ParseTabletAlias(opts.TabletAlias)
+}
+
+func (opts *queryRuleScopeOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&opts.Shard, "shard", "", "Limit the rule to the tablets of this shard.")
+	cmd.Flags().StringVar(&opts.TabletAlias, "tablet-alias", "", "Limit the rule to this tablet.")
+	cmd.Flags().Int64Var(&opts.ExpectedVersion, "expected-version", 0, "Fail if the query rules of the keyspace are not at this version, to protect against concurrent changes.")
+}
+
+var applyQueryRuleOptions = struct {
+	queryRuleScopeOptions
+	Rule         string
+	RuleFilePath string
+}{}
+
+func commandApplyQueryRule(cmd *cobra.Command, args []string) error {
+	if applyQueryRuleOptions.Rule != "" && applyQueryRuleOptions.RuleFilePath != "" {
+		return fmt.Errorf("cannot pass both --rule (=%s) and --rule-file (=%s)", applyQueryRuleOptions.Rule, applyQueryRuleOptions.RuleFilePath)
+	}
+
+	if applyQueryRuleOptions.Rule == "" && applyQueryRuleOptions.RuleFilePath == "" {
+		return errors.New("must pass exactly one of --rule and --rule-file")
+	}
+
+	alias, err := applyQueryRuleOptions.tabletAlias()
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	rule := applyQueryRuleOptions.Rule
+	if applyQueryRuleOptions.RuleFilePath != "" {
+		data, err := os.ReadFile(applyQueryRuleOptions.RuleFilePath)
+		if err != nil {
+			return err
+		}
+
+		rule = string(data)
+	}
+
+	resp, err := client.ApplyQueryRule(commandCtx, &vtctldatapb.ApplyQueryRuleRequest{
+		Keyspace:        cmd.Flags().Arg(0),
+		Shard:           applyQueryRuleOptions.Shard,
+		TabletAlias:     alias,
+		Rule:            rule,
+		ExpectedVersion: applyQueryRuleOptions.ExpectedVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.QueryRules)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New QueryRules object:\n%s\n", data)
+
+	return nil
+}
+
+var deleteQueryRuleOptions = queryRuleScopeOptions{}
+
+func commandDeleteQueryRule(cmd *cobra.Command, args []string) error {
+	alias, err := deleteQueryRuleOptions.tabletAlias()
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.DeleteQueryRule(commandCtx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace:        cmd.Flags().Arg(0),
+		Shard:           deleteQueryRuleOptions.Shard,
+		TabletAlias:     alias,
+		Name:            cmd.Flags().Arg(1),
+		ExpectedVersion: deleteQueryRuleOptions.ExpectedVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.QueryRules)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New QueryRules object:\n%s\n", data)
+
+	return nil
+}
+
+func commandGetQueryRules(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetQueryRules(commandCtx, &vtctldatapb.GetQueryRulesRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.QueryRules)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	applyQueryRuleOptions.addFlags(ApplyQueryRule)
+	ApplyQueryRule.Flags().StringVarP(&applyQueryRuleOptions.Rule, "rule", "r", "", "Query rule, specified as a JSON string.")
+	ApplyQueryRule.Flags().StringVarP(&applyQueryRuleOptions.RuleFilePath, "rule-file", "f", "", "Path to a file containing the query rule specified as JSON.")
+	Root.AddCommand(ApplyQueryRule)
+
+	deleteQueryRuleOptions.addFlags(DeleteQueryRule)
+	Root.AddCommand(DeleteQueryRule)
+
+	Root.AddCommand(GetQueryRules)
+}
@@ -41,6 +41,13 @@ var (
 		Long: "Reparents the shard to the new primary. Assumes the old primary is dead and not responding",
 		RunE: commandEmergencyReparentShard,
 	}
+	// EvacuateCell makes an EvacuateCell gRPC call to a vtctld.
+	EvacuateCell = &cobra.Command{
+		Use:  "EvacuateCell [--target-cells <cell1,cell2,...>] [--concurrency <n>] <keyspace> <cell>",
+		Long: "Moves the primaries of the keyspace out of the cell with PlannedReparentShard, skipping the shards whose primary is already out of the cell.",
+		Args: cobra.ExactArgs(2),
+		RunE: commandEvacuateCell,
+	}
 	// InitShardPrimary makes an InitShardPrimary gRPC call to a vtctld.
 	InitShardPrimary = &cobra.Command{
 		Use:  "InitShardPrimary <keyspace/shard> <primary alias>",
@@ -127,6 +134,43 @@ func commandEmergencyReparentShard(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var evacuateCellOptions = struct {
+	TargetCells         []string
+	Concurrency         int32
+	WaitReplicasTimeout time.Duration
+}{}
+
+func commandEvacuateCell(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.EvacuateCell(commandCtx, &vtctldatapb.EvacuateCellRequest{
+		Keyspace:            cmd.Flags().Arg(0),
+		Cell:                cmd.Flags().Arg(1),
+		TargetCells:         evacuateCellOptions.TargetCells,
+		Concurrency:         evacuateCellOptions.Concurrency,
+		WaitReplicasTimeout: protoutil.DurationToProto(evacuateCellOptions.WaitReplicasTimeout),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range resp.Events {
+		fmt.Println(logutil.EventString(event))
+	}
+
+	data, err := cli.MarshalJSON(&vtctldatapb.EvacuateCellResponse{
+		Keyspace: resp.Keyspace,
+		Shards:   resp.Shards,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var initShardPrimaryOptions = struct {
 	WaitReplicasTimeout time.Duration
 	Force               bool
@@ -267,6 +311,11 @@ func init() {
 	EmergencyReparentShard.Flags().StringSliceVarP(&emergencyReparentShardOptions.IgnoreReplicaAliasStrList, "ignore-replicas", "i", nil, "Comma-separated, repeated list of replica tablet aliases to ignore during the emergency reparent.")
 	Root.AddCommand(EmergencyReparentShard)
 
+	EvacuateCell.Flags().StringSliceVar(&evacuateCellOptions.TargetCells, "target-cells", nil, "Cells to promote the new primaries in. If not specified, the new primaries may be promoted in any other cell.")
+	EvacuateCell.Flags().Int32Var(&evacuateCellOptions.Concurrency, "concurrency", 1, "Number of shards to reparent at the same time.")
+	EvacuateCell.Flags().DurationVar(&evacuateCellOptions.WaitReplicasTimeout, "wait-replicas-timeout", *topo.RemoteOperationTimeout, "Time to wait for replicas to catch up on replication both before and after each reparent.")
+	Root.AddCommand(EvacuateCell)
+
 	InitShardPrimary.Flags().DurationVar(&initShardPrimaryOptions.WaitReplicasTimeout, "wait-replicas-timeout", 30*time.Second, "time to wait for replicas to catch up in reparenting")
 	InitShardPrimary.Flags().BoolVar(&initShardPrimaryOptions.Force, "force", false, "will force the reparent even if the provided tablet is not writable or the shard primary")
 	Root.AddCommand(InitShardPrimary)
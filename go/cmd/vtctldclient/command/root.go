@@ -46,6 +46,16 @@ var (
 		// command context for every command.
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) (err error) {
 			traceCloser = trace.StartTracing("vtctldclient")
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			commandCtx, commandCancel = context.WithTimeout(ctx, actionTimeout)
+
+			if _, ok := cmd.Annotations[offlineAnnotation]; ok {
+				return nil
+			}
 			if VtctldClientProtocol != "local" {
 				if err := ensureServerArg(); err != nil {
 					return err
@@ -53,19 +63,16 @@ var (
 			}
 
 			client, err = vtctldclient.New(VtctldClientProtocol, server)
-
-			ctx := cmd.Context()
-			if ctx == nil {
-				ctx = context.Background()
-			}
-			commandCtx, commandCancel = context.WithTimeout(ctx, actionTimeout)
 			return err
 		},
 		// Similarly, PersistentPostRun cleans up the resources spawned by
 		// PersistentPreRun.
 		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
 			commandCancel()
-			err := client.Close()
+			var err error
+			if client != nil {
+				err = client.Close()
+			}
 			trace.LogErrorsWhenClosing(traceCloser)
 			return err
 		},
@@ -81,6 +88,10 @@ var (
 	}
 )
 
+// offlineAnnotation is the annotation of the commands which run without a
+// vtctld, and so without --server.
+const offlineAnnotation = "offline"
+
 var errNoServer = errors.New("please specify -server <vtctld_host:vtctld_port> to specify the vtctld server to connect to")
 
 // ensureServerArg validates that --server was passed to the CLI.
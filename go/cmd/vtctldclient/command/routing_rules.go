@@ -40,6 +40,27 @@ var (
 		Args:                  cobra.NoArgs,
 		RunE:                  commandApplyRoutingRules,
 	}
+	// ApplyVersionedTable makes an ApplyVersionedTable gRPC call to a vtctld.
+	ApplyVersionedTable = &cobra.Command{
+		Use:   "ApplyVersionedTable --current-table KEYSPACE.TABLE --next-table KEYSPACE.TABLE [--next-percent PERCENT] [--cells=c1,c2,...] [--skip-rebuild] <from_table>",
+		Short: "Serves a new version of a table under the name of the table to a percentage of the sessions.",
+		Long: `Serves a new version of a table, like orders_v2, under the name of the table
+to a percentage of the sessions, so that a backward incompatible rewrite of the
+table can be ramped up gradually without changing the applications. Run it
+again with a higher --next-percent to ramp the new version up, or with
+--next-percent 0 to roll it back.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandApplyVersionedTable,
+	}
+	// DeleteVersionedTable makes a DeleteVersionedTable gRPC call to a vtctld.
+	DeleteVersionedTable = &cobra.Command{
+		Use:                   "DeleteVersionedTable [--cells=c1,c2,...] [--skip-rebuild] <from_table>",
+		Short:                 "Deletes a versioned table from the routing rules.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandDeleteVersionedTable,
+	}
 	// GetRoutingRules makes a GetRoutingRules gRPC call to a vtctld.
 	GetRoutingRules = &cobra.Command{
 		Use:                   "GetRoutingRules",
@@ -127,6 +148,69 @@ func commandApplyRoutingRules(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var applyVersionedTableOptions = struct {
+	CurrentTable string
+	NextTable    string
+	NextPercent  uint32
+	Cells        []string
+	SkipRebuild  bool
+}{}
+
+func commandApplyVersionedTable(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ApplyVersionedTable(commandCtx, &vtctldatapb.ApplyVersionedTableRequest{
+		VersionedTable: &vschemapb.VersionedTable{
+			FromTable:    cmd.Flags().Arg(0),
+			CurrentTable: applyVersionedTableOptions.CurrentTable,
+			NextTable:    applyVersionedTableOptions.NextTable,
+			NextPercent:  applyVersionedTableOptions.NextPercent,
+		},
+		SkipRebuild:  applyVersionedTableOptions.SkipRebuild,
+		RebuildCells: applyVersionedTableOptions.Cells,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printRoutingRules(resp.RoutingRules, applyVersionedTableOptions.SkipRebuild)
+}
+
+var deleteVersionedTableOptions = struct {
+	Cells       []string
+	SkipRebuild bool
+}{}
+
+func commandDeleteVersionedTable(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.DeleteVersionedTable(commandCtx, &vtctldatapb.DeleteVersionedTableRequest{
+		FromTable:    cmd.Flags().Arg(0),
+		SkipRebuild:  deleteVersionedTableOptions.SkipRebuild,
+		RebuildCells: deleteVersionedTableOptions.Cells,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printRoutingRules(resp.RoutingRules, deleteVersionedTableOptions.SkipRebuild)
+}
+
+func printRoutingRules(rr *vschemapb.RoutingRules, skipRebuild bool) error {
+	data, err := cli.MarshalJSON(rr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New RoutingRules object:\n%s\n", data)
+
+	if skipRebuild {
+		fmt.Println("Skipping rebuild of VSchema graph, will need to run RebuildVSchemaGraph for changes to take effect.")
+	}
+
+	return nil
+}
+
 func commandGetRoutingRules(cmd *cobra.Command, args []string) error {
 	cli.FinishedParsing(cmd)
 
@@ -154,4 +238,17 @@ func init() {
 	Root.AddCommand(ApplyRoutingRules)
 
 	Root.AddCommand(GetRoutingRules)
+
+	ApplyVersionedTable.Flags().StringVar(&applyVersionedTableOptions.CurrentTable, "current-table", "", "The table serving the other sessions, qualified by its keyspace.")
+	ApplyVersionedTable.Flags().StringVar(&applyVersionedTableOptions.NextTable, "next-table", "", "The new version of the table, qualified by its keyspace.")
+	ApplyVersionedTable.Flags().Uint32Var(&applyVersionedTableOptions.NextPercent, "next-percent", 0, "The percentage of the sessions served by the new version of the table, from 0 to 100.")
+	ApplyVersionedTable.Flags().StringSliceVarP(&applyVersionedTableOptions.Cells, "cells", "c", nil, "Limit the VSchema graph rebuilding to the specified cells. Ignored if --skip-rebuild is specified.")
+	ApplyVersionedTable.Flags().BoolVar(&applyVersionedTableOptions.SkipRebuild, "skip-rebuild", false, "Skip rebuilding the SrvVSchema objects.")
+	ApplyVersionedTable.MarkFlagRequired("current-table")
+	ApplyVersionedTable.MarkFlagRequired("next-table")
+	Root.AddCommand(ApplyVersionedTable)
+
+	DeleteVersionedTable.Flags().StringSliceVarP(&deleteVersionedTableOptions.Cells, "cells", "c", nil, "Limit the VSchema graph rebuilding to the specified cells. Ignored if --skip-rebuild is specified.")
+	DeleteVersionedTable.Flags().BoolVar(&deleteVersionedTableOptions.SkipRebuild, "skip-rebuild", false, "Skip rebuilding the SrvVSchema objects.")
+	Root.AddCommand(DeleteVersionedTable)
 }
@@ -27,10 +27,19 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
 var (
+	// GetOnlineDDLSchedulerConfig makes a GetOnlineDDLSchedulerConfig gRPC call to a vtctld.
+	GetOnlineDDLSchedulerConfig = &cobra.Command{
+		Use:                   "GetOnlineDDLSchedulerConfig <keyspace>",
+		Short:                 "Displays the configuration of the online DDL scheduler of a keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetOnlineDDLSchedulerConfig,
+	}
 	// GetSchema makes a GetSchema gRPC call to a vtctld.
 	GetSchema = &cobra.Command{
 		Use:                   "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
@@ -39,6 +48,14 @@ var (
 		Args:                  cobra.ExactArgs(1),
 		RunE:                  commandGetSchema,
 	}
+	// GetSchemaMigrationProgress makes a GetSchemaMigrationProgress gRPC call to a vtctld.
+	GetSchemaMigrationProgress = &cobra.Command{
+		Use:                   "GetSchemaMigrationProgress [--uuid <uuid>] <keyspace>",
+		Short:                 "Displays the progress of the running online schema migrations of a keyspace, or of the specified migration, on each shard.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetSchemaMigrationProgress,
+	}
 	// ReloadSchema makes a ReloadSchema gRPC call to a vtctld.
 	ReloadSchema = &cobra.Command{
 		Use:                   "ReloadSchema <tablet_alias>",
@@ -63,8 +80,42 @@ var (
 		Args:                  cobra.ExactArgs(1),
 		RunE:                  commandReloadSchemaShard,
 	}
+	// SetOnlineDDLSchedulerConfig makes a SetOnlineDDLSchedulerConfig gRPC call to a vtctld.
+	SetOnlineDDLSchedulerConfig = &cobra.Command{
+		Use:   "SetOnlineDDLSchedulerConfig [--max-concurrent-migrations <count>] <keyspace>",
+		Short: "Replaces the configuration of the online DDL scheduler of a keyspace.",
+		Long: `Replaces the configuration of the online DDL scheduler of a keyspace.
+
+The primary tablets of the keyspace read the configuration at most once a minute, so changes take up to a minute to apply.
+Only online (vreplication) migrations of distinct tables run concurrently; gh-ost and pt-osc migrations always run alone.
+The order in which queued migrations are scheduled is controlled per migration, with the -priority, -depends-on
+and -postpone-until flags of the DDL strategy.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandSetOnlineDDLSchedulerConfig,
+	}
 )
 
+func commandGetOnlineDDLSchedulerConfig(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetOnlineDDLSchedulerConfig(commandCtx, &vtctldatapb.GetOnlineDDLSchedulerConfigRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Config)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var getSchemaOptions = struct {
 	Tables         []string
 	ExcludeTables  []string
@@ -119,6 +170,33 @@ func commandGetSchema(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var getSchemaMigrationProgressOptions = struct {
+	UUID string
+}{}
+
+func commandGetSchemaMigrationProgress(cmd *cobra.Command, args []string) error {
+	keyspace := cmd.Flags().Arg(0)
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetSchemaMigrationProgress(commandCtx, &vtctldatapb.GetSchemaMigrationProgressRequest{
+		Keyspace: keyspace,
+		Uuid:     getSchemaMigrationProgressOptions.UUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func commandReloadSchema(cmd *cobra.Command, args []string) error {
 	tabletAlias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
 	if err != nil {
@@ -193,7 +271,36 @@ func commandReloadSchemaShard(cmd *cobra.Command, args []string) error {
 	return err
 }
 
+var setOnlineDDLSchedulerConfigOptions = struct {
+	MaxConcurrentMigrations int32
+}{}
+
+func commandSetOnlineDDLSchedulerConfig(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.SetOnlineDDLSchedulerConfig(commandCtx, &vtctldatapb.SetOnlineDDLSchedulerConfigRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Config: &topodatapb.OnlineDDLSchedulerConfig{
+			MaxConcurrentMigrations: setOnlineDDLSchedulerConfigOptions.MaxConcurrentMigrations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Config)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New OnlineDDLSchedulerConfig object:\n%s\n", data)
+
+	return nil
+}
+
 func init() {
+	Root.AddCommand(GetOnlineDDLSchedulerConfig)
+
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.Tables, "tables", nil, "List of tables to display the schema for. Each is either an exact match, or a regular expression of the form `/regexp/`.")
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.ExcludeTables, "exclude-tables", nil, "List of tables to exclude from the result. Each is either an exact match, or a regular expression of the form `/regexp/`.")
 	GetSchema.Flags().BoolVar(&getSchemaOptions.IncludeViews, "include-views", false, "Includes views in the output in addition to base tables.")
@@ -202,6 +309,9 @@ func init() {
 
 	Root.AddCommand(GetSchema)
 
+	GetSchemaMigrationProgress.Flags().StringVar(&getSchemaMigrationProgressOptions.UUID, "uuid", "", "The migration to display the progress of. If empty, the running migrations are displayed.")
+	Root.AddCommand(GetSchemaMigrationProgress)
+
 	Root.AddCommand(ReloadSchema)
 
 	ReloadSchemaKeyspace.Flags().Uint32Var(&reloadSchemaKeyspaceOptions.Concurrency, "concurrency", 10, "Number of tablets to reload in parallel. Set to zero for unbounded concurrency.")
@@ -211,4 +321,7 @@ func init() {
 	ReloadSchemaShard.Flags().Uint32Var(&reloadSchemaShardOptions.Concurrency, "concurrency", 10, "Number of tablets to reload in parallel. Set to zero for unbounded concurrency.")
 	ReloadSchemaShard.Flags().BoolVar(&reloadSchemaShardOptions.IncludePrimary, "include-primary", false, "Also reload the primary tablet.")
 	Root.AddCommand(ReloadSchemaShard)
+
+	SetOnlineDDLSchedulerConfig.Flags().Int32Var(&setOnlineDDLSchedulerConfigOptions.MaxConcurrentMigrations, "max-concurrent-migrations", 0, "Number of online migrations of distinct tables which may run at the same time on each shard. Zero means a single migration at a time.")
+	Root.AddCommand(SetOnlineDDLSchedulerConfig)
 }
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ApplySchemaOwnership makes an ApplySchemaOwnership gRPC call to a vtctld.
+	ApplySchemaOwnership = &cobra.Command{
+		Use:   "ApplySchemaOwnership {--ownership OWNERSHIP | --ownership-file OWNERSHIP_FILE} [--dry-run] <keyspace>",
+		Short: "Applies the owning teams and the approval policies of the tables of a keyspace.",
+		Long: `Applies the owning teams and the approval policies of the tables of a keyspace.
+
+The schema changes applied with ApplySchema to a table with an approval policy must carry an approval token,
+which the approval checker of the vtctld validates. An empty ownership deletes it.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandApplySchemaOwnership,
+	}
+	// GetSchemaOwnership makes a GetSchemaOwnership gRPC call to a vtctld.
+	GetSchemaOwnership = &cobra.Command{
+		Use:                   "GetSchemaOwnership <keyspace>",
+		Short:                 "Displays the owning teams and the approval policies of the tables of a keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetSchemaOwnership,
+	}
+)
+
+var applySchemaOwnershipOptions = struct {
+	Ownership         string
+	OwnershipFilePath string
+	DryRun            bool
+}{}
+
+func commandApplySchemaOwnership(cmd *cobra.Command, args []string) error {
+	if applySchemaOwnershipOptions.Ownership != "" && applySchemaOwnershipOptions.OwnershipFilePath != "" {
+		return fmt.Errorf("cannot pass both --ownership (=%s) and --ownership-file (=%s)", applySchemaOwnershipOptions.Ownership, applySchemaOwnershipOptions.OwnershipFilePath)
+	}
+
+	if applySchemaOwnershipOptions.Ownership == "" && applySchemaOwnershipOptions.OwnershipFilePath == "" {
+		return errors.New("must pass exactly one of --ownership and --ownership-file")
+	}
+
+	keyspace := cmd.Flags().Arg(0)
+
+	cli.FinishedParsing(cmd)
+
+	var ownershipBytes []byte
+	if applySchemaOwnershipOptions.OwnershipFilePath != "" {
+		data, err := os.ReadFile(applySchemaOwnershipOptions.OwnershipFilePath)
+		if err != nil {
+			return err
+		}
+
+		ownershipBytes = data
+	} else {
+		ownershipBytes = []byte(applySchemaOwnershipOptions.Ownership)
+	}
+
+	ownership := &vtctldatapb.SchemaOwnership{}
+	if err := json2.Unmarshal(ownershipBytes, &ownership); err != nil {
+		return err
+	}
+
+	// Round-trip so when we display the result it's readable.
+	data, err := cli.MarshalJSON(ownership)
+	if err != nil {
+		return err
+	}
+
+	if applySchemaOwnershipOptions.DryRun {
+		fmt.Printf("[DRY RUN] Would have saved new SchemaOwnership object for keyspace %s:\n%s\n", keyspace, data)
+		return nil
+	}
+
+	_, err = client.ApplySchemaOwnership(commandCtx, &vtctldatapb.ApplySchemaOwnershipRequest{
+		Keyspace:        keyspace,
+		SchemaOwnership: ownership,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New SchemaOwnership object for keyspace %s:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", keyspace, data)
+
+	return nil
+}
+
+func commandGetSchemaOwnership(cmd *cobra.Command, args []string) error {
+	keyspace := cmd.Flags().Arg(0)
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetSchemaOwnership(commandCtx, &vtctldatapb.GetSchemaOwnershipRequest{
+		Keyspace: keyspace,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.SchemaOwnership)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	ApplySchemaOwnership.Flags().StringVarP(&applySchemaOwnershipOptions.Ownership, "ownership", "o", "", "Schema ownership, specified as a string")
+	ApplySchemaOwnership.Flags().StringVarP(&applySchemaOwnershipOptions.OwnershipFilePath, "ownership-file", "f", "", "Path to a file containing the schema ownership specified as JSON")
+	ApplySchemaOwnership.Flags().BoolVarP(&applySchemaOwnershipOptions.DryRun, "dry-run", "d", false, "Load the specified schema ownership as a validation step, but do not actually apply it to the topo.")
+	Root.AddCommand(ApplySchemaOwnership)
+
+	Root.AddCommand(GetSchemaOwnership)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// GetSequences makes a GetSequences gRPC call to a vtctld.
+	GetSequences = &cobra.Command{
+		Use:   "GetSequences <keyspace> [<table> ...]",
+		Short: "Displays the state of the given, or all, sequences of an unsharded keyspace.",
+		Long: `Displays the state of the given, or all, sequences of an unsharded keyspace.
+
+The state is made of the next_id and cache of the sequence table, and of the range of values cached by
+the primary, along with the vt_max_value, vt_exhaustion_alert and vt_strict_monotonic options of
+the comment of the table.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(1),
+		RunE:                  commandGetSequences,
+	}
+	// ResetSequence makes a ResetSequence gRPC call to a vtctld.
+	ResetSequence = &cobra.Command{
+		Use:   "ResetSequence [--next-id N] [--cache N] <keyspace> <table>",
+		Short: "Sets the next ID and the cache size of a sequence, and drops the values cached by the primary.",
+		Long: `Sets the next ID and the cache size of a sequence, and drops the values cached by the primary.
+
+The next ID cannot go below its current value, as the sequence could then hand out duplicate values.
+Omitted values are kept.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandResetSequence,
+	}
+)
+
+func commandGetSequences(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetSequences(commandCtx, &vtctldatapb.GetSequencesRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Tables:   cmd.Flags().Args()[1:],
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var resetSequenceOptions = struct {
+	NextID int64
+	Cache  int64
+}{}
+
+func commandResetSequence(cmd *cobra.Command, args []string) error {
+	if resetSequenceOptions.NextID == 0 && resetSequenceOptions.Cache == 0 {
+		return errors.New("must pass at least one of --next-id and --cache")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ResetSequence(commandCtx, &vtctldatapb.ResetSequenceRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Table:    cmd.Flags().Arg(1),
+		NextId:   resetSequenceOptions.NextID,
+		Cache:    resetSequenceOptions.Cache,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Sequence)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	Root.AddCommand(GetSequences)
+
+	ResetSequence.Flags().Int64Var(&resetSequenceOptions.NextID, "next-id", 0, "Next value of the sequence. Cannot go below its current value.")
+	ResetSequence.Flags().Int64Var(&resetSequenceOptions.Cache, "cache", 0, "Number of values cached by the primary per reservation in the sequence table.")
+	Root.AddCommand(ResetSequence)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// GetStorageUsage makes a GetStorageUsage gRPC call to a vtctld.
+	GetStorageUsage = &cobra.Command{
+		Use:   "GetStorageUsage <keyspace>",
+		Short: "Displays the size of the tables of the primaries of a keyspace, with their growth and the forecast time their disks are full.",
+		Long: `Displays the size of the tables of the primaries of a keyspace, with their growth and the forecast time their disks are full.
+
+The growth of each shard is fitted over the samples of the size of its tables, which its primary takes
+every --storage_usage_sample_interval. The forecast of the keyspace is the earliest forecast of its shards.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetStorageUsage,
+	}
+)
+
+func commandGetStorageUsage(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetStorageUsage(commandCtx, &vtctldatapb.GetStorageUsageRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	Root.AddCommand(GetStorageUsage)
+}
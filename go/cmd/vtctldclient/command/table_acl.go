@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ApplyTableACL makes an ApplyTableACL gRPC call to a vtctld.
+	ApplyTableACL = &cobra.Command{
+		Use:   "ApplyTableACL {--acl ACL | --acl-file ACL_FILE} [--dry-run] <keyspace>",
+		Short: "Validates and applies a table ACL to a keyspace.",
+		Long: `Validates and applies a table ACL to a keyspace.
+
+The table ACL is specified as JSON, in the same format as the -table-acl-config file of vttablet.
+It replaces the previous table ACL of the keyspace, and the tablets of the keyspace apply it without
+being restarted. While it exists, it takes precedence over the -table-acl-config file of the tablets.
+With --dry-run, the table ACL is only validated.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandApplyTableACL,
+	}
+	// DeleteTableACL makes a DeleteTableACL gRPC call to a vtctld.
+	DeleteTableACL = &cobra.Command{
+		Use:                   "DeleteTableACL <keyspace>",
+		Short:                 "Deletes the table ACL of a keyspace. Its tablets go back to the table ACL of their -table-acl-config file.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandDeleteTableACL,
+	}
+	// GetTableACL makes a GetTableACL gRPC call to a vtctld.
+	GetTableACL = &cobra.Command{
+		Use:                   "GetTableACL <keyspace>",
+		Short:                 "Displays the table ACL of a keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetTableACL,
+	}
+)
+
+var applyTableACLOptions = struct {
+	ACL         string
+	ACLFilePath string
+	DryRun      bool
+}{}
+
+func commandApplyTableACL(cmd *cobra.Command, args []string) error {
+	if applyTableACLOptions.ACL != "" && applyTableACLOptions.ACLFilePath != "" {
+		return fmt.Errorf("cannot pass both --acl (=%s) and --acl-file (=%s)", applyTableACLOptions.ACL, applyTableACLOptions.ACLFilePath)
+	}
+
+	if applyTableACLOptions.ACL == "" && applyTableACLOptions.ACLFilePath == "" {
+		return errors.New("must pass exactly one of --acl and --acl-file")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	data := []byte(applyTableACLOptions.ACL)
+	if applyTableACLOptions.ACLFilePath != "" {
+		var err error
+		data, err = os.ReadFile(applyTableACLOptions.ACLFilePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	config := &tableaclpb.Config{}
+	if err := json2.Unmarshal(data, config); err != nil {
+		return err
+	}
+
+	resp, err := client.ApplyTableACL(commandCtx, &vtctldatapb.ApplyTableACLRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		TableAcl: config,
+		DryRun:   applyTableACLOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err = cli.MarshalJSON(resp.TableAcl)
+	if err != nil {
+		return err
+	}
+
+	if applyTableACLOptions.DryRun {
+		fmt.Printf("Dry run: table ACL is valid, it was not applied:\n%s\n", data)
+		return nil
+	}
+
+	fmt.Printf("New table ACL:\n%s\n", data)
+
+	return nil
+}
+
+func commandDeleteTableACL(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	_, err := client.DeleteTableACL(commandCtx, &vtctldatapb.DeleteTableACLRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted the table ACL of keyspace %s\n", cmd.Flags().Arg(0))
+
+	return nil
+}
+
+func commandGetTableACL(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetTableACL(commandCtx, &vtctldatapb.GetTableACLRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.TableAcl)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	ApplyTableACL.Flags().StringVarP(&applyTableACLOptions.ACL, "acl", "a", "", "Table ACL, specified as a JSON string.")
+	ApplyTableACL.Flags().StringVarP(&applyTableACLOptions.ACLFilePath, "acl-file", "f", "", "Path to a file containing the table ACL specified as JSON.")
+	ApplyTableACL.Flags().BoolVar(&applyTableACLOptions.DryRun, "dry-run", false, "Only validate the table ACL, without applying it.")
+	Root.AddCommand(ApplyTableACL)
+
+	Root.AddCommand(DeleteTableACL)
+
+	Root.AddCommand(GetTableACL)
+}
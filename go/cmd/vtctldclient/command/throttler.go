@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// UpdateThrottlerConfig makes an UpdateThrottlerConfig gRPC call to a vtctld.
+	UpdateThrottlerConfig = &cobra.Command{
+		Use:   "UpdateThrottlerConfig [--enable | --disable] [--threshold THRESHOLD] [--custom-query QUERY] [--check-as-check-self | --check-as-check-shard] [--throttle-app APP [--throttle-app-ratio RATIO] [--throttle-app-duration DURATION] | --unthrottle-app APP] <keyspace>",
+		Short: "Updates the tablet throttler configuration of a keyspace.",
+		Long: `Updates the tablet throttler configuration of a keyspace.
+
+The configuration is applied without a restart by the tablets running with -throttler_config_via_topo. Until it is
+first updated, these tablets use their own flags. The options which are not passed keep their current value.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandUpdateThrottlerConfig,
+	}
+)
+
+var updateThrottlerConfigOptions = struct {
+	Enable              bool
+	Disable             bool
+	Threshold           float64
+	CustomQuery         string
+	CheckAsCheckSelf    bool
+	CheckAsCheckShard   bool
+	ThrottleApp         string
+	ThrottleAppRatio    float64
+	ThrottleAppDuration time.Duration
+	UnthrottleApp       string
+}{}
+
+func commandUpdateThrottlerConfig(cmd *cobra.Command, args []string) error {
+	if updateThrottlerConfigOptions.Enable && updateThrottlerConfigOptions.Disable {
+		return errors.New("cannot pass both --enable and --disable")
+	}
+	if updateThrottlerConfigOptions.CheckAsCheckSelf && updateThrottlerConfigOptions.CheckAsCheckShard {
+		return errors.New("cannot pass both --check-as-check-self and --check-as-check-shard")
+	}
+	if updateThrottlerConfigOptions.ThrottleApp != "" && updateThrottlerConfigOptions.UnthrottleApp != "" {
+		return errors.New("cannot pass both --throttle-app and --unthrottle-app")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	req := &vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:          cmd.Flags().Arg(0),
+		Enable:            updateThrottlerConfigOptions.Enable,
+		Disable:           updateThrottlerConfigOptions.Disable,
+		Threshold:         updateThrottlerConfigOptions.Threshold,
+		CustomQuery:       updateThrottlerConfigOptions.CustomQuery,
+		CustomQuerySet:    cmd.Flags().Changed("custom-query"),
+		CheckAsCheckSelf:  updateThrottlerConfigOptions.CheckAsCheckSelf,
+		CheckAsCheckShard: updateThrottlerConfigOptions.CheckAsCheckShard,
+	}
+	switch {
+	case updateThrottlerConfigOptions.ThrottleApp != "":
+		req.ThrottledApp = &topodatapb.ThrottledAppRule{
+			Name:      updateThrottlerConfigOptions.ThrottleApp,
+			Ratio:     updateThrottlerConfigOptions.ThrottleAppRatio,
+			ExpiresAt: protoutil.TimeToProto(time.Now().Add(updateThrottlerConfigOptions.ThrottleAppDuration)),
+		}
+	case updateThrottlerConfigOptions.UnthrottleApp != "":
+		req.ThrottledApp = &topodatapb.ThrottledAppRule{
+			Name: updateThrottlerConfigOptions.UnthrottleApp,
+		}
+	}
+
+	resp, err := client.UpdateThrottlerConfig(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.ThrottlerConfig)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	UpdateThrottlerConfig.Flags().BoolVar(&updateThrottlerConfigOptions.Enable, "enable", false, "Enable the throttler.")
+	UpdateThrottlerConfig.Flags().BoolVar(&updateThrottlerConfigOptions.Disable, "disable", false, "Disable the throttler. A disabled throttler accepts all the checks.")
+	UpdateThrottlerConfig.Flags().Float64Var(&updateThrottlerConfigOptions.Threshold, "threshold", 0, "Threshold of the throttler metric, in seconds for the replication lag. Ignored if zero.")
+	UpdateThrottlerConfig.Flags().StringVar(&updateThrottlerConfigOptions.CustomQuery, "custom-query", "", "Query replacing the replication lag query, either a SELECT returning a single value or a SHOW GLOBAL ... LIKE ... query. An empty query restores the replication lag query.")
+	UpdateThrottlerConfig.Flags().BoolVar(&updateThrottlerConfigOptions.CheckAsCheckSelf, "check-as-check-self", false, "Make the checks of the primary writes check the tablet's own metric.")
+	UpdateThrottlerConfig.Flags().BoolVar(&updateThrottlerConfigOptions.CheckAsCheckShard, "check-as-check-shard", false, "Make the checks of the primary writes check the shard's metric, which is the default.")
+	UpdateThrottlerConfig.Flags().StringVar(&updateThrottlerConfigOptions.ThrottleApp, "throttle-app", "", "Name of an app to throttle.")
+	UpdateThrottlerConfig.Flags().Float64Var(&updateThrottlerConfigOptions.ThrottleAppRatio, "throttle-app-ratio", 1, "Ratio of the checks of the --throttle-app app which are rejected, between 0 and 1.")
+	UpdateThrottlerConfig.Flags().DurationVar(&updateThrottlerConfigOptions.ThrottleAppDuration, "throttle-app-duration", time.Hour, "How long the --throttle-app app is throttled for.")
+	UpdateThrottlerConfig.Flags().StringVar(&updateThrottlerConfigOptions.UnthrottleApp, "unthrottle-app", "", "Name of an app to unthrottle.")
+	Root.AddCommand(UpdateThrottlerConfig)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// GetUnresolvedTransactions makes a GetUnresolvedTransactions gRPC call to a vtctld.
+	GetUnresolvedTransactions = &cobra.Command{
+		Use:   "GetUnresolvedTransactions [--abandon-age DURATION] <keyspace>",
+		Short: "Displays the distributed transactions which are not resolved on the primaries of a keyspace.",
+		Long: `Displays the distributed transactions which are not resolved on the primaries of a keyspace.
+
+Each transaction lists the state recorded by its metadata manager, its participants, and the shards
+on which it is still prepared or failed to commit. The oldest transactions are displayed first.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetUnresolvedTransactions,
+	}
+	// ResolveTransaction makes a ResolveTransaction gRPC call to a vtctld.
+	ResolveTransaction = &cobra.Command{
+		Use:   "ResolveTransaction {--commit | --rollback} <dtid>",
+		Short: "Commits or rolls back a distributed transaction on all its participants, and removes its metadata.",
+		Long: `Commits or rolls back a distributed transaction on all its participants, and removes its metadata.
+
+Only a transaction in state COMMIT can be committed, since its metadata manager already committed its own part,
+and a transaction in state COMMIT cannot be rolled back.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandResolveTransaction,
+	}
+)
+
+var getUnresolvedTransactionsOptions = struct {
+	AbandonAge time.Duration
+}{}
+
+func commandGetUnresolvedTransactions(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetUnresolvedTransactions(commandCtx, &vtctldatapb.GetUnresolvedTransactionsRequest{
+		Keyspace:          cmd.Flags().Arg(0),
+		AbandonAgeSeconds: int64(getUnresolvedTransactionsOptions.AbandonAge.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Transactions)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var resolveTransactionOptions = struct {
+	Commit   bool
+	Rollback bool
+}{}
+
+func commandResolveTransaction(cmd *cobra.Command, args []string) error {
+	if resolveTransactionOptions.Commit == resolveTransactionOptions.Rollback {
+		return errors.New("must pass exactly one of --commit and --rollback")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	dtid := cmd.Flags().Arg(0)
+	_, err := client.ResolveTransaction(commandCtx, &vtctldatapb.ResolveTransactionRequest{
+		Dtid:   dtid,
+		Commit: resolveTransactionOptions.Commit,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resolveTransactionOptions.Commit {
+		fmt.Printf("Transaction %s committed\n", dtid)
+	} else {
+		fmt.Printf("Transaction %s rolled back\n", dtid)
+	}
+
+	return nil
+}
+
+func init() {
+	GetUnresolvedTransactions.Flags().DurationVar(&getUnresolvedTransactionsOptions.AbandonAge, "abandon-age", 0, "Only display the transactions older than this age.")
+	Root.AddCommand(GetUnresolvedTransactions)
+
+	ResolveTransaction.Flags().BoolVar(&resolveTransactionOptions.Commit, "commit", false, "Commit the transaction.")
+	ResolveTransaction.Flags().BoolVar(&resolveTransactionOptions.Rollback, "rollback", false, "Roll back the transaction.")
+	Root.AddCommand(ResolveTransaction)
+}
@@ -27,6 +27,13 @@ import (
 )
 
 var (
+	// GetWorkflowProgress makes a GetWorkflowProgress gRPC call to a vtctld.
+	GetWorkflowProgress = &cobra.Command{
+		Use:   "GetWorkflowProgress <keyspace> <workflow>",
+		Short: "Outputs the progress of a workflow, with the rows copied, the replication lag and the estimated time left, per stream and per table.",
+		Args:  cobra.ExactArgs(2),
+		RunE:  commandGetWorkflowProgress,
+	}
 	// GetWorkflows makes a GetWorkflows gRPC call to a vtctld.
 	GetWorkflows = &cobra.Command{
 		Use:  "GetWorkflows <keyspace>",
@@ -35,6 +42,27 @@ var (
 	}
 )
 
+func commandGetWorkflowProgress(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetWorkflowProgress(commandCtx, &vtctldatapb.GetWorkflowProgressRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Progress)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var getWorkflowsOptions = struct {
 	ShowAll bool
 }{}
@@ -66,4 +94,6 @@ func commandGetWorkflows(cmd *cobra.Command, args []string) error {
 func init() {
 	GetWorkflows.Flags().BoolVarP(&getWorkflowsOptions.ShowAll, "show-all", "a", false, "Show all workflows instead of just active workflows")
 	Root.AddCommand(GetWorkflows)
+
+	Root.AddCommand(GetWorkflowProgress)
 }
@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/servenv"
@@ -41,6 +42,9 @@ import (
 var (
 	cell              = flag.String("cell", "test_nj", "cell to use")
 	tabletTypesToWait = flag.String("tablet_types_to_wait", "", "wait till connected for specified tablet types during Gateway initialization")
+	topoReadCache     = flag.Bool("topo_read_cache", false, "if set, the reads of the cell topo services are cached in memory and kept up to date with watches, so that the topo QPS does not grow with the vtgate query load")
+	topoStaleWindow   = flag.Duration("topo_read_cache_stale_window", 5*time.Minute, "how long the topo read cache serves its values after the watches of a cell topo service stopped, while the topo service is unavailable")
+	webHostname       = flag.String("web_hostname", "", "hostname of the web port registered in the topo of the cell, from which the vtctld collects the diagnostics of this vtgate. Defaults to the fully qualified hostname.")
 )
 
 var resilientServer *srvtopo.ResilientServer
@@ -107,6 +111,30 @@ func CheckCellFlags(ctx context.Context, serv srvtopo.Server, cell string, cells
 	return nil
 }
 
+// registerVtgate registers the address of the web port in the topo of the
+// cell, and returns it. It returns an empty address if the vtgate has no web
+// port or could not be registered.
+func registerVtgate(ts *topo.Server) string {
+	if *servenv.Port == 0 {
+		return ""
+	}
+	hostname := *webHostname
+	if hostname == "" {
+		var err error
+		hostname, err = netutil.FullyQualifiedHostname()
+		if err != nil {
+			log.Warningf("Unable to get the hostname of the vtgate, it is not registered in the topo: %v", err)
+			return ""
+		}
+	}
+	webAddr := netutil.JoinHostPort(hostname, int32(*servenv.Port))
+	if err := ts.RegisterVtgate(context.Background(), *cell, webAddr); err != nil {
+		log.Warningf("Unable to register the vtgate in the topo: %v", err)
+		return ""
+	}
+	return webAddr
+}
+
 func main() {
 	defer exit.Recover()
 
@@ -115,6 +143,9 @@ func main() {
 
 	ts := topo.Open()
 	defer ts.Close()
+	if *topoReadCache {
+		ts.EnableReadCache(*topoStaleWindow)
+	}
 
 	resilientServer = srvtopo.NewResilientServer(ts, "ResilientSrvTopoServer")
 
@@ -155,12 +186,19 @@ func main() {
 		vtg = vtgate.Init(context.Background(), resilientServer, *cell, tabletTypes)
 	}
 
+	webAddr := registerVtgate(ts)
+
 	servenv.OnRun(func() {
 		// Flags are parsed now. Parse the template using the actual flag value and overwrite the current template.
 		discovery.ParseTabletURLTemplateFromFlag()
 		addStatusParts(vtg)
 	})
 	servenv.OnClose(func() {
+		if webAddr != "" {
+			if err := ts.UnregisterVtgate(context.Background(), *cell, webAddr); err != nil {
+				log.Warningf("Unable to unregister the vtgate from the topo: %v", err)
+			}
+		}
 		_ = vtg.Gateway().Close(context.Background())
 		if legacyHealthCheck != nil {
 			_ = legacyHealthCheck.Close()
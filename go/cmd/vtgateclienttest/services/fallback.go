@@ -48,6 +48,10 @@ func (c fallbackClient) ExecuteBatch(ctx context.Context, session *vtgatepb.Sess
 	return c.fallback.ExecuteBatch(ctx, session, sqlList, bindVariablesList)
 }
 
+func (c fallbackClient) ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	return c.fallback.ExecuteScript(ctx, session, statements, variables)
+}
+
 func (c fallbackClient) StreamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	return c.fallback.StreamExecute(ctx, session, sql, bindVariables, callback)
 }
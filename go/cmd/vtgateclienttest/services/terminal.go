@@ -58,6 +58,10 @@ func (c *terminalClient) ExecuteBatch(ctx context.Context, session *vtgatepb.Ses
 	return session, nil, errTerminal
 }
 
+func (c *terminalClient) ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	return session, nil, nil, errTerminal
+}
+
 func (c *terminalClient) StreamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	return errTerminal
 }
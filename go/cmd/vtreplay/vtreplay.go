@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+	"vitess.io/vitess/go/vt/vtreplay"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+var (
+	usage = `
+vtreplay replays a recorded query trace against a baseline and a candidate
+vtgate, usually running different Vitess versions, and prints a json report
+of the differences between their plans, results and latencies.
+
+The trace contains one query per line, either as a plain SQL statement or as
+a vtgate query log entry in the text or json format. Only the selects are
+replayed, unless -allow_writes is set.
+
+The exit code is 1 if the results or the errors of the two vtgates differ.
+
+Example:
+
+  $ vtreplay -baseline vtgate-old:15991 -candidate vtgate-new:15991 -target commerce@replica -trace queries.log
+
+`
+	traceFile         = flag.String("trace", "", "file containing the queries to replay")
+	baselineServer    = flag.String("baseline", "", "vtgate server running the current version")
+	candidateServer   = flag.String("candidate", "", "vtgate server running the version to verify")
+	targetString      = flag.String("target", "", "keyspace:shard@tablet_type")
+	timeout           = flag.Duration("timeout", 30*time.Second, "timeout for each query")
+	allowWrites       = flag.Bool("allow_writes", false, "also replay the statements which are not selects, which modifies the data of both clusters")
+	comparePlans      = flag.Bool("compare_plans", true, "compare the vtgate plans of the selects")
+	latencyRegression = flag.Float64("latency_regression", 2, "report the queries whose candidate latency is above this ratio of the baseline latency, 0 disables the latency comparison")
+	minLatency        = flag.Duration("min_latency", 10*time.Millisecond, "latency below which queries are not reported as latency regressions")
+	maxDiffs          = flag.Int("max_diffs", 100, "maximum number of differences listed in the report, 0 lists all of them")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprint(os.Stderr, usage)
+	}
+}
+
+// timeoutConn applies the query timeout to every query.
+type timeoutConn struct {
+	session *vtgateconn.VTGateSession
+}
+
+func (tc *timeoutConn) Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	return tc.session.Execute(ctx, query, bindVars)
+}
+
+func dial(ctx context.Context, server string) (*vtgateconn.VTGateConn, vtreplay.Conn, error) {
+	conn, err := vtgateconn.Dial(ctx, server)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot dial %s: %v", server, err)
+	}
+	return conn, &timeoutConn{session: conn.Session(*targetString, nil)}, nil
+}
+
+func main() {
+	defer exit.RecoverAll()
+	defer logutil.Flush()
+
+	flag.Parse()
+	report, err := run()
+	if err != nil {
+		log.Error(err)
+		exit.Return(2)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Errorf("cannot marshal report: %v", err)
+		exit.Return(2)
+	}
+	fmt.Println(string(data))
+	if !report.Ready() {
+		exit.Return(1)
+	}
+}
+
+func run() (*vtreplay.Report, error) {
+	if *traceFile == "" || *baselineServer == "" || *candidateServer == "" {
+		flag.Usage()
+		return nil, errors.New("-trace, -baseline and -candidate are required")
+	}
+
+	f, err := os.Open(*traceFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	queries, skipped, err := vtreplay.ReadTrace(f)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %v", *traceFile, err)
+	}
+	log.Infof("Replaying %d queries, %d entries of the trace cannot be replayed", len(queries), skipped)
+
+	ctx := context.Background()
+	baselineConn, baseline, err := dial(ctx, *baselineServer)
+	if err != nil {
+		return nil, err
+	}
+	defer baselineConn.Close()
+	candidateConn, candidate, err := dial(ctx, *candidateServer)
+	if err != nil {
+		return nil, err
+	}
+	defer candidateConn.Close()
+
+	report, err := vtreplay.Replay(ctx, queries, baseline, candidate, vtreplay.Options{
+		AllowWrites:       *allowWrites,
+		ComparePlans:      *comparePlans,
+		LatencyRegression: *latencyRegression,
+		MinLatency:        *minLatency,
+		MaxDiffs:          *maxDiffs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Skipped += skipped
+	return report, nil
+}
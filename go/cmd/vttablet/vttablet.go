@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
@@ -64,6 +65,11 @@ func main() {
 	servenv.ParseFlags("vttablet")
 	servenv.Init()
 
+	// The vstreamer builds the vschema of the keyspace.
+	if err := vindexes.LoadFlagPlugins(); err != nil {
+		log.Exitf("failed to load the vindex plugins: %v", err)
+	}
+
 	if *tabletPath == "" {
 		log.Exit("-tablet-path required")
 	}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cron parses cron expressions, and uses them to declare the time
+// windows in which an operation is allowed.
+//
+// An expression has the five fields of crontab: minute (0-59), hour (0-23),
+// day of month (1-31), month (1-12) and day of week (0-7, where both 0 and 7
+// are Sunday). A field is a comma separated list of values, ranges like 1-5,
+// or *, each optionally followed by a step like */15 or 0-30/10. Like in
+// crontab, when both the day of month and the day of week are restricted, a
+// day matches if either of them matches. The times are in UTC.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearch bounds the search of the next matching minute, for the
+// expressions which never match, like "* * 30 2 *".
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	expr                              string
+	minutes, hours, days, months, dow uint64
+	// anyDay and anyDow are true when the day of month or the day of week is *.
+	anyDay, anyDow bool
+}
+
+// Parse parses a cron expression.
+func Parse(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expecting %d fields, found %d", expr, len(fields), len(parts))
+	}
+	sets := make([]uint64, len(fields))
+	for i, part := range parts {
+		set, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expr, err)
+		}
+		sets[i] = set
+	}
+	s := &Schedule{
+		expr:    strings.Join(parts, " "),
+		minutes: sets[0],
+		hours:   sets[1],
+		days:    sets[2],
+		months:  sets[3],
+		dow:     sets[4],
+		anyDay:  parts[2] == "*",
+		anyDow:  parts[4] == "*",
+	}
+	// Sunday is both 0 and 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+func parseField(part string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(part, ",") {
+		rng, step := item, 1
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %s %q", f.name, item)
+			}
+			rng, step = item[:i], n
+		}
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			var err error
+			bounds := strings.SplitN(rng, "-", 2)
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("invalid %s %q", f.name, item)
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("invalid %s %q", f.name, item)
+				}
+			} else if step > 1 {
+				// Like in crontab, a start with a step runs to the end of the field.
+				hi = f.max
+			}
+		}
+		if lo < f.min || hi > f.max || lo > hi {
+			return 0, fmt.Errorf("%s %q out of range %d-%d", f.name, item, f.min, f.max)
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+// String returns the expression of the schedule.
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<uint(v)) != 0
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayMatches := has(s.days, t.Day())
+	dowMatches := has(s.dow, int(t.Weekday()))
+	switch {
+	case s.anyDay && s.anyDow:
+		return true
+	case s.anyDay:
+		return dowMatches
+	case s.anyDow:
+		return dayMatches
+	}
+	return dayMatches || dowMatches
+}
+
+// Matches returns true if the minute of the given time matches the schedule.
+func (s *Schedule) Matches(t time.Time) bool {
+	t = t.UTC()
+	return has(s.months, int(t.Month())) && s.matchesDay(t) && has(s.hours, t.Hour()) && has(s.minutes, t.Minute())
+}
+
+// Next returns the start of the first minute matching the schedule at or
+// after the given time, or the zero time if the schedule never matches.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.UTC()
+	start := t
+	// A time within a minute is in that minute.
+	t = t.Truncate(time.Minute)
+	for t.Sub(start) < maxSearch {
+		switch {
+		case !has(s.months, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !has(s.hours, t.Hour()):
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case !has(s.minutes, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Windows are the time windows in which an operation is allowed, as the
+// minutes matching any of their schedules.
+type Windows []*Schedule
+
+// ParseWindows parses the semicolon separated cron expressions of windows,
+// like "* 2-4 * * 1-5; * * * * 0,6". An empty string has no windows.
+func ParseWindows(s string) (Windows, error) {
+	var windows Windows
+	for _, expr := range strings.Split(s, ";") {
+		if expr = strings.TrimSpace(expr); expr == "" {
+			continue
+		}
+		schedule, err := Parse(expr)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, schedule)
+	}
+	return windows, nil
+}
+
+// String returns the expressions of the windows.
+func (w Windows) String() string {
+	exprs := make([]string, len(w))
+	for i, schedule := range w {
+		exprs[i] = schedule.String()
+	}
+	return strings.Join(exprs, "; ")
+}
+
+// Contains returns true if the given time is in one of the windows.
+func (w Windows) Contains(t time.Time) bool {
+	for _, schedule := range w {
+		if schedule.Matches(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns the time the first window opens at or after the given
+// time, or the zero time if the windows never open.
+func (w Windows) NextOpen(t time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range w {
+		if n := schedule.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func utc(s string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestParseErrors(t *testing.T) {
+	tcases := []struct {
+		expr string
+		err  string
+	}{
+		{"* * * *", `invalid cron expression "* * * *": expecting 5 fields, found 4`},
+		{"60 * * * *", `invalid cron expression "60 * * * *": minute "60" out of range 0-59`},
+		{"* 5-2 * * *", `invalid cron expression "* 5-2 * * *": hour "5-2" out of range 0-23`},
+		{"* * 0 * *", `invalid cron expression "* * 0 * *": day of month "0" out of range 1-31`},
+		{"* * * x *", `invalid cron expression "* * * x *": invalid month "x"`},
+		{"*/0 * * * *", `invalid cron expression "*/0 * * * *": invalid step in minute "*/0"`},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			_, err := Parse(tcase.expr)
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tcases := []struct {
+		expr    string
+		time    string
+		matches bool
+	}{
+		{"* * * * *", "2021-10-14 13:37", true},
+		{"30 2 * * *", "2021-10-14 02:30", true},
+		{"30 2 * * *", "2021-10-14 02:31", false},
+		{"*/15 * * * *", "2021-10-14 02:45", true},
+		{"*/15 * * * *", "2021-10-14 02:46", false},
+		{"10/20 * * * *", "2021-10-14 02:50", true},
+		{"0-30/10 * * * *", "2021-10-14 02:40", false},
+		// 2021-10-14 is a Thursday, 2021-10-17 a Sunday.
+		{"* 2-4 * * 1-5", "2021-10-14 04:59", true},
+		{"* 2-4 * * 1-5", "2021-10-14 05:00", false},
+		{"* * * * 7", "2021-10-17 00:00", true},
+		{"* * * * 0,6", "2021-10-14 00:00", false},
+		{"* * 1,14 10 *", "2021-10-14 00:00", true},
+		{"* * 1,14 11 *", "2021-10-14 00:00", false},
+		// Either the day of month or the day of week matches.
+		{"* * 1 * 4", "2021-10-14 00:00", true},
+		{"* * 1 * 5", "2021-10-14 00:00", false},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr+" "+tcase.time, func(t *testing.T) {
+			s, err := Parse(tcase.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.matches, s.Matches(utc(tcase.time)))
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	tcases := []struct {
+		expr string
+		time string
+		next string
+	}{
+		{"* * * * *", "2021-10-14 13:37", "2021-10-14 13:37"},
+		{"0 2 * * *", "2021-10-14 13:37", "2021-10-15 02:00"},
+		{"*/15 * * * *", "2021-10-14 13:37", "2021-10-14 13:45"},
+		{"* 2-4 * * 1-5", "2021-10-15 05:00", "2021-10-18 02:00"},
+		{"0 0 1 1 *", "2021-10-14 13:37", "2022-01-01 00:00"},
+		{"0 0 29 2 *", "2021-10-14 13:37", "2024-02-29 00:00"},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr+" "+tcase.time, func(t *testing.T) {
+			s, err := Parse(tcase.expr)
+			require.NoError(t, err)
+			assert.Equal(t, utc(tcase.next), s.Next(utc(tcase.time)))
+		})
+	}
+
+	s, err := Parse("* * 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, s.Next(utc("2021-10-14 13:37")).IsZero())
+	// A time within a matching minute is in that minute.
+	s, err = Parse("37 13 * * *")
+	require.NoError(t, err)
+	assert.Equal(t, utc("2021-10-14 13:37"), s.Next(utc("2021-10-14 13:37").Add(30*time.Second)))
+}
+
+func TestWindows(t *testing.T) {
+	windows, err := ParseWindows("* 2-4 * * 1-5;  * * * * 0,6 ")
+	require.NoError(t, err)
+	assert.Equal(t, "* 2-4 * * 1-5; * * * * 0,6", windows.String())
+	assert.True(t, windows.Contains(utc("2021-10-14 03:00")))
+	assert.False(t, windows.Contains(utc("2021-10-14 13:37")))
+	assert.True(t, windows.Contains(utc("2021-10-16 13:37")))
+	assert.Equal(t, utc("2021-10-15 02:00"), windows.NextOpen(utc("2021-10-14 13:37")))
+	assert.Equal(t, utc("2021-10-16 00:00"), windows.NextOpen(utc("2021-10-15 05:00")))
+
+	windows, err = ParseWindows("")
+	require.NoError(t, err)
+	assert.Empty(t, windows)
+	assert.True(t, windows.NextOpen(utc("2021-10-14 13:37")).IsZero())
+
+	_, err = ParseWindows("* * * * *; 0 0")
+	assert.EqualError(t, err, `invalid cron expression "0 0": expecting 5 fields, found 2`)
+}
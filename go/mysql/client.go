@@ -338,6 +338,13 @@ func (c *Conn) clientHandshake(params *ConnParams) error {
 		if err != nil {
 			return NewSQLError(CRSSLConnectionError, SSUnknownSQLState, "error loading client cert and ca: %v", err)
 		}
+		if params.TLSCipherSuites != "" {
+			ciphers, err := vttls.CipherSuitesToNumbers(params.TLSCipherSuites)
+			if err != nil {
+				return NewSQLError(CRSSLConnectionError, SSUnknownSQLState, "error parsing TLS cipher suites: %v", err)
+			}
+			clientConfig.CipherSuites = ciphers
+		}
 
 		// Send the SSLRequest packet.
 		if err := c.writeSSLRequest(capabilities, characterSet, params); err != nil {
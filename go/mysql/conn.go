@@ -209,6 +209,11 @@ type PrepareData struct {
 	BindVars    map[string]*querypb.BindVariable
 	StatementID uint32
 	ParamsCount uint16
+
+	// ClientData is for the Handler to keep the state of the statement
+	// between its executions, e.g. its plan. It is dropped with the
+	// statement.
+	ClientData interface{}
 }
 
 // execResult is an enum signifying the result of executing a query
@@ -888,12 +893,15 @@ func (c *Conn) handleNextCommand(handler Handler) bool {
 	case ComSetOption:
 		return c.handleComSetOption(data)
 	case ComPrepare:
+		preparedStmtCounts.Add("Prepare", 1)
 		return c.handleComPrepare(handler, data)
 	case ComStmtExecute:
+		preparedStmtCounts.Add("Execute", 1)
 		return c.handleComStmtExecute(handler, data)
 	case ComStmtSendLongData:
 		return c.handleComStmtSendLongData(data)
 	case ComStmtClose:
+		preparedStmtCounts.Add("Close", 1)
 		stmtID, ok := c.parseComStmtClose(data)
 		c.recycleReadPacket()
 		if ok {
@@ -904,6 +912,10 @@ func (c *Conn) handleNextCommand(handler Handler) bool {
 	case ComResetConnection:
 		c.handleComResetConnection(handler)
 		return true
+	case ComRegisterReplica:
+		return c.handleComRegisterReplica(handler, data)
+	case ComBinlogDump:
+		return c.handleComBinlogDump(handler, data)
 	case ComFieldList:
 		c.recycleReadPacket()
 		if !c.writeErrorAndLog(ERUnknownComError, SSNetError, "command handling not implemented yet: %v", data[0]) {
@@ -1104,9 +1116,12 @@ func (c *Conn) handleComPrepare(handler Handler, data []byte) (kontinue bool) {
 			log.Errorf("Conn %v: Error splitting query: %v", c, err)
 			return c.writeErrorPacketFromErrorAndLog(err)
 		}
-		if len(queries) != 1 {
-			log.Errorf("Conn %v: can not prepare multiple statements", c, err)
-			return c.writeErrorPacketFromErrorAndLog(err)
+		if len(queries) == 0 {
+			return c.writeErrorPacketFromErrorAndLog(errEmptyStatement)
+		}
+		if len(queries) > 1 {
+			log.Errorf("Conn %v: can not prepare multiple statements", c)
+			return c.writeErrorPacketFromErrorAndLog(errPrepareMultipleStatements)
 		}
 	} else {
 		queries = []string{query}
@@ -1211,6 +1226,8 @@ func (c *Conn) handleComPing() bool {
 
 var errEmptyStatement = NewSQLError(EREmptyQuery, SSClientError, "Query was empty")
 
+var errPrepareMultipleStatements = NewSQLError(ERUnsupportedPS, SSUnknownSQLState, "can not prepare multiple statements")
+
 func (c *Conn) handleComQuery(handler Handler, data []byte) (kontinue bool) {
 	c.startWriterBuffering()
 	defer func() {
@@ -563,6 +563,53 @@ func TestMultiStatementOnSplitError(t *testing.T) {
 	require.EqualValues(t, data[0], ErrPacket) // we should see the error here
 }
 
+func TestMultiStatementWithTrailingComment(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	sConn.Capabilities |= CapabilityClientMultiStatements
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	err := cConn.WriteComQuery("select 1; -- the end")
+	require.NoError(t, err)
+
+	handler := &testRun{t: t, err: fmt.Errorf("execution failed")}
+	res := sConn.handleNextCommand(handler)
+	require.True(t, res, "we should not break the connection in case of no errors")
+
+	// The trailing comment is not a statement, so there is a single result.
+	data, more, _, err := cConn.ReadQueryResult(100, true)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.True(t, data.Equal(selectRowsResult))
+}
+
+func TestMultiStatementPrepare(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	sConn.Capabilities |= CapabilityClientMultiStatements
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	err := cConn.writePacket(preparePacket(t, "select 1;select 2"))
+	require.NoError(t, err)
+
+	handler := &testRun{t: t, err: fmt.Errorf("not used")}
+	res := sConn.handleNextCommand(handler)
+	require.True(t, res, "we should not break the connection because a statement can't be prepared")
+
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	require.NotEmpty(t, data)
+	require.EqualValues(t, data[0], ErrPacket)
+	err = ParseErrorPacket(data)
+	require.EqualError(t, err, "can not prepare multiple statements (errno 1295) (sqlstate HY000)")
+}
+
 func TestInitDbAgainstWrongDbDoesNotDropConnection(t *testing.T) {
 	listener, sConn, cConn := createSocketPair(t)
 	sConn.Capabilities |= CapabilityClientMultiStatements
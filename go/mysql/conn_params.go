@@ -45,6 +45,7 @@ type ConnParams struct {
 	SslCrl           string        `json:"ssl_crl"`
 	SslKey           string        `json:"ssl_key"`
 	TLSMinVersion    string        `json:"tls_min_version"`
+	TLSCipherSuites  string        `json:"tls_cipher_suites"`
 	ServerName       string        `json:"server_name"`
 	ConnectTimeoutMs uint64        `json:"connect_timeout_ms"`
 
@@ -213,6 +213,9 @@ const (
 	// ComBinlogDump is COM_BINLOG_DUMP.
 	ComBinlogDump = 0x12
 
+	// ComRegisterReplica is COM_REGISTER_SLAVE.
+	ComRegisterReplica = 0x15
+
 	// ComPrepare is COM_PREPARE.
 	ComPrepare = 0x16
 
@@ -404,6 +407,7 @@ const (
 	ERRowIsReferenced               = 1217
 	ERCantUpdateWithReadLock        = 1223
 	ERNoDefault                     = 1230
+	ERMasterFatalReadingBinlog      = 1236
 	EROperandColumns                = 1241
 	ERSubqueryNo1Row                = 1242
 	ERWarnDataOutOfRange            = 1264
@@ -16,6 +16,10 @@ limitations under the License.
 
 package mysql
 
+import (
+	"vitess.io/vitess/go/vt/log"
+)
+
 // This file contains the methods related to replication.
 
 // WriteComBinlogDump writes a ComBinlogDump command.
@@ -77,3 +81,99 @@ func (c *Conn) SemiSyncExtensionLoaded() bool {
 	}
 	return len(qr.Rows) >= 1
 }
+
+// BinlogDumpHandler is implemented by the Handlers that can serve binlogs
+// to replicas. Listeners whose Handler does not implement it reject the
+// replication commands.
+type BinlogDumpHandler interface {
+	// ComRegisterReplica is called when a replica registers itself,
+	// before requesting a binlog dump.
+	ComRegisterReplica(c *Conn, serverID uint32) error
+
+	// ComBinlogDump is called when a replica requests the binlog events
+	// starting at binlogPos in logFile. It streams them with
+	// WriteBinlogEvent until the replica goes away or an error occurs.
+	// The connection is closed when it returns.
+	ComBinlogDump(c *Conn, serverID uint32, logFile string, binlogPos uint32) error
+}
+
+// WriteBinlogEvent writes a raw binlog event to a replica, as part of the
+// reply to a ComBinlogDump command.
+func (c *Conn) WriteBinlogEvent(ev []byte) error {
+	data, pos := c.startEphemeralPacketWithHeader(1 + len(ev))
+	pos = writeByte(data, pos, OKPacket)
+	copy(data[pos:], ev)
+	if err := c.writeEphemeralPacket(); err != nil {
+		return NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+	return nil
+}
+
+func (c *Conn) handleComRegisterReplica(handler Handler, data []byte) bool {
+	serverID, ok := c.parseComRegisterReplica(data)
+	c.recycleReadPacket()
+	bh, isBinlogDumpHandler := handler.(BinlogDumpHandler)
+	if !isBinlogDumpHandler {
+		return c.writeErrorAndLog(ERUnknownComError, SSNetError, "command handling not implemented yet: %v", ComRegisterReplica)
+	}
+	if !ok {
+		return c.writeErrorAndLog(ERUnknownComError, SSNetError, "error parsing ComRegisterReplica packet")
+	}
+	if err := bh.ComRegisterReplica(c, serverID); err != nil {
+		return c.writeErrorPacketFromErrorAndLog(err)
+	}
+	if err := c.writeOKPacket(&PacketOK{statusFlags: c.StatusFlags}); err != nil {
+		log.Errorf("Error writing ComRegisterReplica result to %s: %v", c, err)
+		return false
+	}
+	return true
+}
+
+func (c *Conn) handleComBinlogDump(handler Handler, data []byte) bool {
+	serverID, logFile, binlogPos, ok := c.parseComBinlogDump(data)
+	c.recycleReadPacket()
+	bh, isBinlogDumpHandler := handler.(BinlogDumpHandler)
+	if !isBinlogDumpHandler {
+		return c.writeErrorAndLog(ERUnknownComError, SSNetError, "command handling not implemented yet: %v", ComBinlogDump)
+	}
+	if !ok {
+		return c.writeErrorAndLog(ERUnknownComError, SSNetError, "error parsing ComBinlogDump packet")
+	}
+	if err := bh.ComBinlogDump(c, serverID, logFile, binlogPos); err != nil {
+		c.writeErrorPacketFromErrorAndLog(err)
+	}
+	// Like mysqld, a dump is the last command of the connection.
+	return false
+}
+
+// parseComRegisterReplica parses a ComRegisterReplica packet, and returns
+// the server id of the replica. The other fields are informational.
+// See https://dev.mysql.com/doc/internals/en/com-register-slave.html.
+func (c *Conn) parseComRegisterReplica(data []byte) (uint32, bool) {
+	serverID, _, ok := readUint32(data, 1)
+	return serverID, ok
+}
+
+// parseComBinlogDump parses a ComBinlogDump packet, as written by
+// WriteComBinlogDump.
+func (c *Conn) parseComBinlogDump(data []byte) (serverID uint32, logFile string, binlogPos uint32, ok bool) {
+	pos := 1
+	binlogPos, pos, ok = readUint32(data, pos)
+	if !ok {
+		return 0, "", 0, false
+	}
+	// flags
+	_, pos, ok = readUint16(data, pos)
+	if !ok {
+		return 0, "", 0, false
+	}
+	serverID, pos, ok = readUint32(data, pos)
+	if !ok {
+		return 0, "", 0, false
+	}
+	logFile, _, ok = readEOFString(data, pos)
+	if !ok {
+		return 0, "", 0, false
+	}
+	return serverID, logFile, binlogPos, true
+}
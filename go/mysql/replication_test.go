@@ -17,8 +17,12 @@ limitations under the License.
 package mysql
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestComBinlogDump(t *testing.T) {
@@ -128,3 +132,91 @@ func TestComBinlogDumpGTID(t *testing.T) {
 		t.Errorf("ComBinlogDumpGTID returned unexpected data:\n%v\nwas expecting:\n%v", data, expectedData)
 	}
 }
+
+// testBinlogDumpHandler serves a fixed list of events.
+type testBinlogDumpHandler struct {
+	testRun
+	events [][]byte
+	err    error
+
+	serverID  uint32
+	logFile   string
+	binlogPos uint32
+}
+
+func (h *testBinlogDumpHandler) ComRegisterReplica(c *Conn, serverID uint32) error {
+	h.serverID = serverID
+	return nil
+}
+
+func (h *testBinlogDumpHandler) ComBinlogDump(c *Conn, serverID uint32, logFile string, binlogPos uint32) error {
+	h.logFile = logFile
+	h.binlogPos = binlogPos
+	for _, ev := range h.events {
+		if err := c.WriteBinlogEvent(ev); err != nil {
+			return err
+		}
+	}
+	return h.err
+}
+
+func TestHandleComBinlogDump(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	cConn.flavor = mysqlFlavor57{}
+
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+	events := [][]byte{
+		NewRotateEvent(f, s, 4, "vt-bin.000001").(mysql56BinlogEvent).Bytes(),
+		NewFormatDescriptionEvent(f, s).(mysql56BinlogEvent).Bytes(),
+	}
+	handler := &testBinlogDumpHandler{
+		events: events,
+		err:    NewSQLError(ERMasterFatalReadingBinlog, SSUnknownSQLState, "no more binlogs"),
+	}
+
+	// ComRegisterReplica is acknowledged.
+	data, pos := cConn.startEphemeralPacketWithHeader(1 + 4)
+	pos = writeByte(data, pos, ComRegisterReplica)
+	writeUint32(data, pos, 1234)
+	require.NoError(t, cConn.writeEphemeralPacket())
+	assert.True(t, sConn.handleNextCommand(handler))
+	assert.EqualValues(t, 1234, handler.serverID)
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	assert.EqualValues(t, OKPacket, data[0])
+
+	// ComBinlogDump streams the events, then the error, and closes the
+	// connection.
+	require.NoError(t, cConn.WriteComBinlogDump(1234, "vt-bin.000001", 4, 0))
+	assert.False(t, sConn.handleNextCommand(handler))
+	assert.Equal(t, "vt-bin.000001", handler.logFile)
+	assert.EqualValues(t, 4, handler.binlogPos)
+	for _, want := range events {
+		ev, err := cConn.ReadBinlogEvent()
+		require.NoError(t, err)
+		assert.Equal(t, want, ev.(mysql56BinlogEvent).Bytes())
+	}
+	_, err = cConn.ReadBinlogEvent()
+	assert.EqualError(t, err, "no more binlogs (errno 1236) (sqlstate HY000)")
+}
+
+func TestHandleComBinlogDumpNotSupported(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	require.NoError(t, cConn.WriteComBinlogDump(1234, "vt-bin.000001", 4, 0))
+	assert.True(t, sConn.handleNextCommand(testRun{t: t}))
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	assert.EqualError(t, ParseErrorPacket(data), fmt.Sprintf("command handling not implemented yet: %v (errno 1047) (sqlstate 08S01)", ComBinlogDump))
+}
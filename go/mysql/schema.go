@@ -111,6 +111,12 @@ order by table_name, ordinal_position`
 	FetchTables = `select ` + fetchColumns + ` 
 from _vt.schemacopy 
 where table_schema = database() 
+order by table_name, ordinal_position`
+
+	// FetchTablesWhere queries fetches all information about the tables of a table_name predicate, formatted in with %s
+	FetchTablesWhere = `select ` + fetchColumns + ` 
+from _vt.schemacopy 
+where table_schema = database() and %s 
 order by table_name, ordinal_position`
 )
 
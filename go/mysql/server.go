@@ -74,6 +74,9 @@ var (
 		}
 		return connCount.Get() - totalUsers
 	})
+
+	preparedStmtCounts = stats.NewCountersWithSingleLabel("MysqlServerPreparedStatements", "Prepared statement commands processed by MySQL server", "command")
+	_                  = stats.NewRates("MysqlServerPreparedStatementRates", preparedStmtCounts, 15, time.Minute)
 )
 
 // A Handler is an interface used by Listener to send queries.
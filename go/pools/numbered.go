@@ -173,6 +173,12 @@ func (nu *Numbered) GetByFilter(purpose string, match func(val interface{}) bool
 // GetOutdated returns a list of resources that are older than age, and locks them.
 // It does not return any resources that are already locked.
 func (nu *Numbered) GetOutdated(age time.Duration, purpose string) (vals []interface{}) {
+	return nu.GetOutdatedFunc(func(interface{}) time.Duration { return age }, purpose)
+}
+
+// GetOutdatedFunc is like GetOutdated, but the age of each resource is
+// returned by the age function.
+func (nu *Numbered) GetOutdatedFunc(age func(val interface{}) time.Duration, purpose string) (vals []interface{}) {
 	nu.mu.Lock()
 	defer nu.mu.Unlock()
 	now := time.Now()
@@ -180,7 +186,7 @@ func (nu *Numbered) GetOutdated(age time.Duration, purpose string) (vals []inter
 		if nw.inUse || !nw.enforceTimeout {
 			continue
 		}
-		if nw.timeUsed.Add(age).Sub(now) <= 0 {
+		if nw.timeUsed.Add(age(nw.val)).Sub(now) <= 0 {
 			nw.inUse = true
 			nw.purpose = purpose
 			vals = append(vals, nw.val)
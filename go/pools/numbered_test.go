@@ -119,6 +119,22 @@ func TestNumberedGetByFilter(t *testing.T) {
 	assert.Equal(t, want, vals)
 }
 
+func TestNumberedGetOutdatedFunc(t *testing.T) {
+	p := NewNumbered()
+	p.Register(1, 1, true)
+	p.Register(2, 2, true)
+	time.Sleep(100 * time.Millisecond)
+
+	vals := p.GetOutdatedFunc(func(v interface{}) time.Duration {
+		if v.(int) == 1 {
+			return 50 * time.Millisecond
+		}
+		return time.Hour
+	}, "by outdated")
+	want := []interface{}{1}
+	assert.Equal(t, want, vals)
+}
+
 /*
 go test --test.run=XXX --test.bench=. --test.benchtime=10s
 
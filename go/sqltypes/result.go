@@ -291,15 +291,17 @@ func (result *Result) StripMetadata(incl querypb.ExecuteOptions_IncludedFields)
 // AppendResult will combine the Results Objects of one result
 // to another result.Note currently it doesn't handle cases like
 // if two results have different fields.We will enhance this function.
+// The InsertID of the combined result is the smallest non-zero one, so
+// that it does not depend on the order in which the results are appended.
 func (result *Result) AppendResult(src *Result) {
-	if src.RowsAffected == 0 && len(src.Rows) == 0 && len(src.Fields) == 0 {
+	if src.RowsAffected == 0 && len(src.Rows) == 0 && len(src.Fields) == 0 && src.InsertID == 0 {
 		return
 	}
 	if result.Fields == nil {
 		result.Fields = src.Fields
 	}
 	result.RowsAffected += src.RowsAffected
-	if src.InsertID != 0 {
+	if src.InsertID != 0 && (result.InsertID == 0 || src.InsertID < result.InsertID) {
 		result.InsertID = src.InsertID
 	}
 	result.Rows = append(result.Rows, src.Rows...)
@@ -343,4 +343,13 @@ func TestAppendResult(t *testing.T) {
 	if !reflect.DeepEqual(result, want) {
 		t.Errorf("Got:\n%#v, want:\n%#v", result, want)
 	}
+
+	// The smallest insert id is kept, whatever the order of the results.
+	result = &Result{}
+	result.AppendResult(&Result{InsertID: 5})
+	result.AppendResult(&Result{InsertID: 3, RowsAffected: 1})
+	result.AppendResult(&Result{RowsAffected: 1})
+	if result.InsertID != 3 || result.RowsAffected != 2 {
+		t.Errorf("Got InsertID %d, RowsAffected %d, want 3, 2", result.InsertID, result.RowsAffected)
+	}
 }
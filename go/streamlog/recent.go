@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"flag"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	// QueryLogRecentSize is the number of recent query logs kept to be searched
+	QueryLogRecentSize = flag.Int("querylog-recent-size", 1000, "Number of the most recent query logs kept in memory to be searched by request ID. 0 disables it.")
+)
+
+// RecentLogs keeps the most recent messages sent to a StreamLogger.
+type RecentLogs struct {
+	mu       sync.Mutex
+	messages []interface{}
+	next     int
+}
+
+// KeepRecent starts keeping the size most recent messages sent to the logger.
+func (logger *StreamLogger) KeepRecent(size int) *RecentLogs {
+	recent := &RecentLogs{
+		messages: make([]interface{}, 0, size),
+	}
+	if size <= 0 {
+		return recent
+	}
+
+	ch := logger.Subscribe("RecentLogs")
+	go func() {
+		for message := range ch {
+			recent.add(message)
+		}
+	}()
+	return recent
+}
+
+func (recent *RecentLogs) add(message interface{}) {
+	recent.mu.Lock()
+	defer recent.mu.Unlock()
+
+	if len(recent.messages) < cap(recent.messages) {
+		recent.messages = append(recent.messages, message)
+		return
+	}
+	recent.messages[recent.next] = message
+	recent.next = (recent.next + 1) % len(recent.messages)
+}
+
+// Find returns the kept messages for which match returns true, from the
+// oldest to the most recent.
+func (recent *RecentLogs) Find(match func(message interface{}) bool) []interface{} {
+	recent.mu.Lock()
+	defer recent.mu.Unlock()
+
+	var found []interface{}
+	for i := range recent.messages {
+		message := recent.messages[(recent.next+i)%len(recent.messages)]
+		if match(message) {
+			found = append(found, message)
+		}
+	}
+	return found
+}
+
+// ServeLogs registers the URL on which the kept messages matching the
+// parameters of the request are served.
+func (recent *RecentLogs) ServeLogs(url string, logf LogFormatter, match func(params url.Values, message interface{}) bool) {
+	http.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		messages := recent.Find(func(message interface{}) bool {
+			return match(r.Form, message)
+		})
+		for _, message := range messages {
+			if err := logf(w, r.Form, message); err != nil {
+				return
+			}
+		}
+	})
+	log.Infof("Serving recent logs at %v.", url)
+}
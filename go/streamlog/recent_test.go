@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecentLogs(t *testing.T) {
+	logger := New("logger", 10)
+	recent := logger.KeepRecent(3)
+
+	for i := 0; i < 5; i++ {
+		logger.Send(i)
+		// Let the subscriber keep the message before sending the next one,
+		// as the logger drops the messages of slow subscribers.
+		require.Eventually(t, func() bool {
+			return len(recent.Find(func(message interface{}) bool { return message == i })) == 1
+		}, 5*time.Second, time.Millisecond)
+	}
+
+	all := recent.Find(func(message interface{}) bool { return true })
+	assert.Equal(t, []interface{}{2, 3, 4}, all)
+
+	odd := recent.Find(func(message interface{}) bool { return message.(int)%2 == 1 })
+	assert.Equal(t, []interface{}{3}, odd)
+
+	recent.ServeLogs("/test/recentlogs", func(w io.Writer, params url.Values, message interface{}) error {
+		_, err := fmt.Fprintf(w, "%v\n", message)
+		return err
+	}, func(params url.Values, message interface{}) bool {
+		return fmt.Sprint(message) >= params.Get("from")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test/recentlogs?from=3", nil)
+	resp := httptest.NewRecorder()
+	http.DefaultServeMux.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "3\n4\n", resp.Body.String())
+}
+
+func TestRecentLogsDisabled(t *testing.T) {
+	logger := New("logger", 10)
+	recent := logger.KeepRecent(0)
+
+	logger.Send("message")
+	assert.Empty(t, recent.Find(func(message interface{}) bool { return true }))
+	assert.Empty(t, logger.subscribed)
+}
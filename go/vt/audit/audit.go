@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package audit records the security relevant events of the Vitess components,
+like the access checks of vtctld, the table ACL denials of vttablet, the
+schema changes and the reparents, and delivers them to pluggable sinks, so
+that they can be collected by a single audit pipeline.
+
+The sinks are configured with the -audit_sinks flag, as a list of URLs whose
+scheme is the name of a registered sink:
+
+	file:///var/log/vitess/audit.log     appends the events to a file, one JSON event per line
+	syslog:                              sends the events to the local syslog daemon
+	syslog://host:514?network=udp        sends the events to a remote syslog daemon
+	grpc://host:port                     publishes the events to an Audit gRPC service
+	kafka://host:8082/topic              produces the events to a Kafka topic through a Kafka REST proxy
+
+Each sink has its own buffer of events, so that a sink which is unavailable
+neither blocks the components nor the other sinks. The events a sink fails
+to deliver are delivered again until they succeed. The events which do not
+fit in the buffer, or which are not delivered yet when the process exits,
+are saved in the -audit_spool_dir directory and delivered when the sink
+catches up, or after a restart. The events are delivered at least once.
+
+Other sinks can be added with RegisterSink.
+*/
+package audit
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+var (
+	sinkURLs    = flag.String("audit_sinks", "", "comma separated list of the URLs of the sinks the audit events are delivered to, like file:///var/log/vitess/audit.log, syslog:, grpc://host:port or kafka://host:8082/topic")
+	bufferSize  = flag.Int("audit_buffer_size", 10000, "number of audit events buffered in memory for each sink")
+	spoolDir    = flag.String("audit_spool_dir", "", "directory where the audit events which do not fit in the buffer of a sink, or which are not delivered when the process exits, are saved to be delivered later; if empty these events are dropped")
+	sinkTimeout = flag.Duration("audit_sink_timeout", 10*time.Second, "timeout of the delivery of a batch of audit events to a sink")
+	retryDelay  = flag.Duration("audit_retry_delay", time.Second, "delay before a sink delivers again the audit events it failed to deliver, doubled after each failure up to a minute")
+
+	deliveredCount = stats.NewCountersWithSingleLabel("AuditEventsDelivered", "Number of audit events delivered by sink", "Sink")
+	spooledCount   = stats.NewCountersWithSingleLabel("AuditEventsSpooled", "Number of audit events saved in the spool directory by sink", "Sink")
+	droppedCount   = stats.NewCountersWithSingleLabel("AuditEventsDropped", "Number of audit events dropped by sink", "Sink")
+	errorCount     = stats.NewCountersWithSingleLabel("AuditSinkErrors", "Number of failed deliveries of batches of audit events by sink", "Sink")
+
+	sinkFactories = make(map[string]SinkFactory)
+
+	mu             sync.Mutex
+	defaultAuditor *Auditor
+)
+
+// Sink delivers audit events to an external system.
+type Sink interface {
+	// Write delivers a batch of events. It is called by a single goroutine,
+	// and called again with the same events when it returns an error.
+	Write(ctx context.Context, events []*auditpb.Event) error
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// SinkFactory creates a sink from its URL.
+type SinkFactory func(u *url.URL) (Sink, error)
+
+// RegisterSink registers the factory of the sinks of a URL scheme. It should
+// be called in an init() function.
+func RegisterSink(scheme string, factory SinkFactory) {
+	if _, ok := sinkFactories[scheme]; ok {
+		log.Fatalf("audit sink %s is already registered", scheme)
+	}
+	sinkFactories[scheme] = factory
+}
+
+// Config is the configuration of the buffering of the sinks of an Auditor.
+type Config struct {
+	BufferSize  int
+	SpoolDir    string
+	SinkTimeout time.Duration
+	RetryDelay  time.Duration
+}
+
+// Auditor records the audit events of a component to its sinks.
+type Auditor struct {
+	component string
+	hostname  string
+	sinks     []*bufferedSink
+}
+
+// New returns an Auditor of a component, which delivers its events to the
+// sinks of the URLs.
+func New(component string, urls []string, config Config) (*Auditor, error) {
+	if config.BufferSize <= 0 {
+		config.BufferSize = 1
+	}
+	if config.SinkTimeout <= 0 {
+		config.SinkTimeout = 10 * time.Second
+	}
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = time.Second
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	a := &Auditor{
+		component: component,
+		hostname:  hostname,
+	}
+	for _, rawURL := range urls {
+		s, err := newSink(rawURL, config)
+		if err != nil {
+			a.Close()
+			return nil, err
+		}
+		a.sinks = append(a.sinks, s)
+	}
+	return a, nil
+}
+
+func newSink(rawURL string, config Config) (*bufferedSink, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit sink %q: %v", rawURL, err)
+	}
+	factory, ok := sinkFactories[u.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("unknown audit sink %q", u.Scheme)
+	}
+	sink, err := factory(u)
+	if err != nil {
+		return nil, fmt.Errorf("can't create audit sink %s: %v", u.Redacted(), err)
+	}
+	bs, err := newBufferedSink(u.Redacted(), sink, config)
+	if err != nil {
+		sink.Close()
+		return nil, err
+	}
+	return bs, nil
+}
+
+// Record records an event. The time, component and hostname of the event
+// are set if they are empty. Record does not block: the event is delivered
+// asynchronously, and must not be modified after Record is called.
+func (a *Auditor) Record(ev *auditpb.Event) {
+	if ev.Time == nil {
+		ev.Time = protoutil.TimeToProto(time.Now())
+	}
+	if ev.Component == "" {
+		ev.Component = a.component
+	}
+	if ev.Hostname == "" {
+		ev.Hostname = a.hostname
+	}
+	for _, s := range a.sinks {
+		s.enqueue(ev)
+	}
+}
+
+// Close delivers or spools the buffered events, and closes the sinks.
+func (a *Auditor) Close() {
+	var wg sync.WaitGroup
+	for _, s := range a.sinks {
+		wg.Add(1)
+		go func(s *bufferedSink) {
+			defer wg.Done()
+			s.close()
+		}(s)
+	}
+	wg.Wait()
+}
+
+// Record records an event with the auditor created from the flags. It does
+// nothing if no audit sink is configured.
+func Record(ev *auditpb.Event) {
+	mu.Lock()
+	a := defaultAuditor
+	mu.Unlock()
+	if a != nil {
+		a.Record(ev)
+	}
+}
+
+// PrincipalFromContext returns the principal the gRPC server authenticated
+// the caller of a context as, or else the principal of its effective caller
+// id, or else the user name of its immediate caller id.
+func PrincipalFromContext(ctx context.Context) string {
+	if principal := servenv.AuthenticatedPrincipalFromContext(ctx); principal != "" {
+		return principal
+	}
+	if principal := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); principal != "" {
+		return principal
+	}
+	return callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+}
+
+// Init creates the auditor of the process from the flags.
+func Init() {
+	if *sinkURLs == "" {
+		return
+	}
+	a, err := New(filepath.Base(os.Args[0]), strings.Split(*sinkURLs, ","), Config{
+		BufferSize:  *bufferSize,
+		SpoolDir:    *spoolDir,
+		SinkTimeout: *sinkTimeout,
+		RetryDelay:  *retryDelay,
+	})
+	if err != nil {
+		log.Exitf("audit: %v", err)
+	}
+	mu.Lock()
+	defaultAuditor = a
+	mu.Unlock()
+}
+
+// Close closes the auditor of the process.
+func Close() {
+	mu.Lock()
+	a := defaultAuditor
+	defaultAuditor = nil
+	mu.Unlock()
+	if a != nil {
+		a.Close()
+	}
+}
+
+func init() {
+	servenv.OnInit(Init)
+	servenv.OnClose(Close)
+}
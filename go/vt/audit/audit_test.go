@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/callerid"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+// fakeSink records the events it delivers, and fails the first failures
+// writes.
+type fakeSink struct {
+	mu       sync.Mutex
+	events   []*auditpb.Event
+	failures int
+	writes   int
+}
+
+func (fs *fakeSink) Write(ctx context.Context, events []*auditpb.Event) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.writes++
+	if fs.failures != 0 {
+		fs.failures--
+		return fmt.Errorf("sink unavailable")
+	}
+	fs.events = append(fs.events, events...)
+	return nil
+}
+
+func (fs *fakeSink) Close() error {
+	return nil
+}
+
+func (fs *fakeSink) delivered() []string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var actions []string
+	for _, ev := range fs.events {
+		actions = append(actions, ev.Action)
+	}
+	return actions
+}
+
+var fakeSinks = struct {
+	mu    sync.Mutex
+	sinks map[string]*fakeSink
+}{sinks: make(map[string]*fakeSink)}
+
+// newFakeSink returns the fake sink of the host of the URL, so that the
+// tests can reopen a sink.
+func newFakeSink(u *url.URL) (Sink, error) {
+	fakeSinks.mu.Lock()
+	defer fakeSinks.mu.Unlock()
+	return fakeSinks.sinks[u.Host], nil
+}
+
+func setFakeSink(host string, sink *fakeSink) {
+	fakeSinks.mu.Lock()
+	defer fakeSinks.mu.Unlock()
+	fakeSinks.sinks[host] = sink
+}
+
+func init() {
+	RegisterSink("fake", newFakeSink)
+}
+
+func recordActions(a *Auditor, actions ...string) {
+	for _, action := range actions {
+		a.Record(&auditpb.Event{Action: action})
+	}
+}
+
+func TestAuditorDelivers(t *testing.T) {
+	sink := &fakeSink{}
+	setFakeSink("delivers", sink)
+	a, err := New("vtctld", []string{"fake://delivers"}, Config{BufferSize: 10})
+	require.NoError(t, err)
+
+	recordActions(a, "a", "b", "c")
+	a.Close()
+	assert.Equal(t, []string{"a", "b", "c"}, sink.delivered())
+	ev := sink.events[0]
+	assert.Equal(t, "vtctld", ev.Component)
+	assert.NotEmpty(t, ev.Hostname)
+	assert.NotNil(t, ev.Time)
+}
+
+func TestAuditorRetries(t *testing.T) {
+	sink := &fakeSink{failures: 2}
+	setFakeSink("retries", sink)
+	a, err := New("vtctld", []string{"fake://retries"}, Config{BufferSize: 10, RetryDelay: time.Millisecond})
+	require.NoError(t, err)
+	defer a.Close()
+
+	recordActions(a, "a")
+	assert.Eventually(t, func() bool {
+		return len(sink.delivered()) == 1
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, 3, sink.writes)
+}
+
+func TestAuditorSpools(t *testing.T) {
+	dir := t.TempDir()
+	sink := &fakeSink{failures: -1}
+	setFakeSink("spools", sink)
+	a, err := New("vttablet", []string{"fake://spools"}, Config{BufferSize: 2, SpoolDir: dir, RetryDelay: time.Hour})
+	require.NoError(t, err)
+
+	// The events which do not fit in the buffer, and the buffered events
+	// when the auditor is closed, are spooled.
+	recordActions(a, "a", "b", "c", "d", "e")
+	a.Close()
+	assert.Empty(t, sink.delivered())
+
+	// The spooled events are delivered by the next auditor.
+	sink = &fakeSink{}
+	setFakeSink("spools", sink)
+	a, err = New("vttablet", []string{"fake://spools"}, Config{BufferSize: 2, SpoolDir: dir})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(sink.delivered()) == 5
+	}, 5*time.Second, time.Millisecond)
+	assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e"}, sink.delivered())
+	a.Close()
+
+	// The delivered events are removed from the spool.
+	sink = &fakeSink{}
+	setFakeSink("spools", sink)
+	a, err = New("vttablet", []string{"fake://spools"}, Config{SpoolDir: dir})
+	require.NoError(t, err)
+	a.Close()
+	assert.Empty(t, sink.delivered())
+}
+
+func TestAuditorDrops(t *testing.T) {
+	sink := &fakeSink{failures: -1}
+	setFakeSink("drops", sink)
+	a, err := New("vttablet", []string{"fake://drops"}, Config{BufferSize: 1, RetryDelay: time.Hour})
+	require.NoError(t, err)
+
+	dropped := droppedCount.Counts()["fake://drops"]
+	recordActions(a, "a", "b", "c")
+	a.Close()
+	assert.GreaterOrEqual(t, droppedCount.Counts()["fake://drops"]-dropped, int64(2))
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := New("vtctld", []string{"unknown://host"}, Config{})
+	assert.EqualError(t, err, `unknown audit sink "unknown"`)
+	_, err = New("vtctld", []string{"kafka://host"}, Config{})
+	assert.EqualError(t, err, "can't create audit sink kafka://host: expected kafka://host:port/topic, got kafka://host")
+}
+
+func TestPrincipalFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, PrincipalFromContext(ctx))
+	ctx = callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("user"))
+	assert.Equal(t, "user", PrincipalFromContext(ctx))
+	ctx = callerid.NewContext(ctx, callerid.NewEffectiveCallerID("principal", "", ""), callerid.NewImmediateCallerID("user"))
+	assert.Equal(t, "principal", PrincipalFromContext(ctx))
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"path/filepath"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+const (
+	// maxBatchSize is the maximum number of events delivered to a sink in
+	// one Write.
+	maxBatchSize = 100
+	// maxRetryDelay is the maximum delay between two deliveries of a batch.
+	maxRetryDelay = time.Minute
+)
+
+// bufferedSink delivers the events of a sink from a goroutine, retrying the
+// batches the sink fails to deliver, and spooling the events which do not
+// fit in its buffer.
+type bufferedSink struct {
+	name   string
+	sink   Sink
+	config Config
+	events chan *auditpb.Event
+	// spool is nil if there is no spool directory.
+	spool *spool
+
+	closing chan struct{}
+	done    chan struct{}
+}
+
+func newBufferedSink(name string, sink Sink, config Config) (*bufferedSink, error) {
+	bs := &bufferedSink{
+		name:    name,
+		sink:    sink,
+		config:  config,
+		events:  make(chan *auditpb.Event, config.BufferSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if config.SpoolDir != "" {
+		path := filepath.Join(config.SpoolDir, fmt.Sprintf("audit-%08x.spool", crc32.ChecksumIEEE([]byte(name))))
+		spool, err := openSpool(path)
+		if err != nil {
+			return nil, fmt.Errorf("can't open the spool of audit sink %s: %v", name, err)
+		}
+		bs.spool = spool
+	}
+	go bs.run()
+	return bs, nil
+}
+
+// enqueue buffers an event, or spools it if the buffer is full.
+func (bs *bufferedSink) enqueue(ev *auditpb.Event) {
+	select {
+	case bs.events <- ev:
+	default:
+		bs.spill([]*auditpb.Event{ev})
+	}
+}
+
+// spill saves events to the spool, or drops them if there is no spool.
+func (bs *bufferedSink) spill(events []*auditpb.Event) {
+	if len(events) == 0 {
+		return
+	}
+	if bs.spool != nil {
+		err := bs.spool.write(events)
+		if err == nil {
+			spooledCount.Add(bs.name, int64(len(events)))
+			return
+		}
+		log.Errorf("can't spool %d events of audit sink %s: %v", len(events), bs.name, err)
+	}
+	droppedCount.Add(bs.name, int64(len(events)))
+}
+
+func (bs *bufferedSink) run() {
+	defer close(bs.done)
+	for {
+		// The buffered events are delivered first, so that the buffer has
+		// room for the new events, then the spooled events.
+		select {
+		case ev := <-bs.events:
+			batch := bs.batch(ev)
+			if !bs.deliver(batch) {
+				bs.spill(batch)
+				return
+			}
+			continue
+		case <-bs.closing:
+			return
+		default:
+		}
+
+		if bs.spool != nil && !bs.spool.empty() {
+			batch, offset, err := bs.spool.read(maxBatchSize)
+			if err != nil {
+				log.Errorf("%v", err)
+			}
+			if !bs.deliver(batch) {
+				return
+			}
+			if err := bs.spool.commit(offset); err != nil {
+				log.Errorf("can't commit the spool of audit sink %s: %v", bs.name, err)
+			}
+			continue
+		}
+
+		select {
+		case ev := <-bs.events:
+			batch := bs.batch(ev)
+			if !bs.deliver(batch) {
+				bs.spill(batch)
+				return
+			}
+		case <-bs.closing:
+			return
+		}
+	}
+}
+
+// batch returns a batch of the first event and of the buffered events.
+func (bs *bufferedSink) batch(first *auditpb.Event) []*auditpb.Event {
+	batch := []*auditpb.Event{first}
+	for len(batch) < maxBatchSize {
+		select {
+		case ev := <-bs.events:
+			batch = append(batch, ev)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// deliver writes a batch to the sink until it succeeds. It returns false if
+// the sink is closed before the batch is delivered.
+func (bs *bufferedSink) deliver(batch []*auditpb.Event) bool {
+	if len(batch) == 0 {
+		return true
+	}
+	delay := bs.config.RetryDelay
+	for {
+		err := bs.write(batch)
+		if err == nil {
+			return true
+		}
+		log.Warningf("can't deliver %d events to audit sink %s, retrying in %v: %v", len(batch), bs.name, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-bs.closing:
+			return false
+		}
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+func (bs *bufferedSink) write(batch []*auditpb.Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), bs.config.SinkTimeout)
+	defer cancel()
+	if err := bs.sink.Write(ctx, batch); err != nil {
+		errorCount.Add(bs.name, 1)
+		return err
+	}
+	deliveredCount.Add(bs.name, int64(len(batch)))
+	return nil
+}
+
+// close stops the delivery goroutine, then makes one attempt to deliver the
+// buffered events, and spools the events it fails to deliver.
+func (bs *bufferedSink) close() {
+	close(bs.closing)
+	<-bs.done
+
+	var pending []*auditpb.Event
+	for {
+		select {
+		case ev := <-bs.events:
+			pending = append(pending, ev)
+			continue
+		default:
+		}
+		break
+	}
+	for len(pending) > 0 {
+		n := len(pending)
+		if n > maxBatchSize {
+			n = maxBatchSize
+		}
+		if err := bs.write(pending[:n]); err != nil {
+			log.Warningf("can't deliver %d events to audit sink %s: %v", len(pending), bs.name, err)
+			bs.spill(pending)
+			break
+		}
+		pending = pending[n:]
+	}
+
+	if bs.spool != nil {
+		if err := bs.spool.close(); err != nil {
+			log.Errorf("can't close the spool of audit sink %s: %v", bs.name, err)
+		}
+	}
+	if err := bs.sink.Close(); err != nil {
+		log.Errorf("can't close audit sink %s: %v", bs.name, err)
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+
+	"vitess.io/vitess/go/json2"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+// fileSink appends the events to a file, one JSON event per line, and syncs
+// the file after each batch.
+type fileSink struct {
+	file *os.File
+}
+
+func newFileSink(u *url.URL) (Sink, error) {
+	path := u.Path
+	if path == "" {
+		path = u.Opaque
+	}
+	if path == "" {
+		return nil, fmt.Errorf("no path in %s", u)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{file: file}, nil
+}
+
+// Write is part of the Sink interface.
+func (fs *fileSink) Write(ctx context.Context, events []*auditpb.Event) error {
+	var buf bytes.Buffer
+	for _, ev := range events {
+		data, err := json2.MarshalPB(ev)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if _, err := fs.file.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return fs.file.Sync()
+}
+
+// Close is part of the Sink interface.
+func (fs *fileSink) Close() error {
+	return fs.file.Close()
+}
+
+func init() {
+	RegisterSink("file", newFileSink)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+// grpcSink publishes the events to an Audit gRPC service.
+//
+// The URL grpc://host:port connects without TLS, unless the cert, key, ca,
+// crl or server_name parameters are set, like
+// grpc://host:port?ca=/path/to/ca.pem&server_name=audit.
+type grpcSink struct {
+	cc     *grpc.ClientConn
+	client auditpb.AuditClient
+}
+
+func newGRPCSink(u *url.URL) (Sink, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("no address in %s", u.Redacted())
+	}
+	query := u.Query()
+	opt, err := grpcclient.SecureDialOption(query.Get("cert"), query.Get("key"), query.Get("ca"), query.Get("crl"), query.Get("server_name"))
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpcclient.Dial(u.Host, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcSink{
+		cc:     cc,
+		client: auditpb.NewAuditClient(cc),
+	}, nil
+}
+
+// Write is part of the Sink interface.
+func (gs *grpcSink) Write(ctx context.Context, events []*auditpb.Event) error {
+	_, err := gs.client.Publish(ctx, &auditpb.PublishRequest{Events: events})
+	return err
+}
+
+// Close is part of the Sink interface.
+func (gs *grpcSink) Close() error {
+	return gs.cc.Close()
+}
+
+func init() {
+	RegisterSink("grpc", newGRPCSink)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/kafkarest"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+var testEvents = []*auditpb.Event{{
+	Category:  auditpb.Category_TABLE_ACL,
+	Principal: "user",
+	Action:    "Select",
+	Resource:  "t1",
+	Outcome:   auditpb.Outcome_DENIED,
+	Details:   map[string]string{"keyspace": "ks"},
+}, {
+	Category: auditpb.Category_REPARENT,
+	Hostname: "host",
+	Action:   "PlannedReparentShard",
+}}
+
+func newTestSink(t *testing.T, rawURL string) Sink {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	sink, err := sinkFactories[u.Scheme](u)
+	require.NoError(t, err)
+	return sink
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink := newTestSink(t, "file://"+path)
+	require.NoError(t, sink.Write(context.Background(), testEvents))
+	require.NoError(t, sink.Write(context.Background(), testEvents[:1]))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		ev := &auditpb.Event{}
+		require.NoError(t, protojson.Unmarshal([]byte(line), ev))
+		utils.MustMatch(t, testEvents[i%2], ev)
+	}
+}
+
+func TestKafkaSink(t *testing.T) {
+	var body struct {
+		Records []kafkarest.Record `json:"records"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/audit", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"offsets":[{"partition":0,"offset":1},{"partition":0,"offset":2}]}`))
+	}))
+	defer server.Close()
+
+	sink := newTestSink(t, "kafka://"+strings.TrimPrefix(server.URL, "http://")+"/audit")
+	defer sink.Close()
+	require.NoError(t, sink.Write(context.Background(), testEvents))
+	require.Len(t, body.Records, 2)
+	assert.Equal(t, "ks", body.Records[0].Key)
+	assert.Equal(t, "host", body.Records[1].Key)
+	ev := &auditpb.Event{}
+	require.NoError(t, protojson.Unmarshal(body.Records[0].Value, ev))
+	utils.MustMatch(t, testEvents[0], ev)
+}
+
+type fakeAuditServer struct {
+	auditpb.UnimplementedAuditServer
+	events []*auditpb.Event
+}
+
+func (s *fakeAuditServer) Publish(ctx context.Context, req *auditpb.PublishRequest) (*auditpb.PublishResponse, error) {
+	s.events = append(s.events, req.Events...)
+	return &auditpb.PublishResponse{}, nil
+}
+
+func TestGRPCSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collector := &fakeAuditServer{}
+	auditpb.RegisterAuditServer(server, collector)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	sink := newTestSink(t, "grpc://"+listener.Addr().String())
+	defer sink.Close()
+	require.NoError(t, sink.Write(context.Background(), testEvents))
+	utils.MustMatch(t, testEvents, collector.events)
+}
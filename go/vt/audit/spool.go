@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+// spool is a file of the events a sink has not delivered yet. The events
+// are saved as length prefixed protos. The events before the offset of the
+// spool are delivered, and are removed from the file when all the events
+// are delivered, or when the spool is closed.
+type spool struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	size   int64
+	offset int64
+}
+
+func openSpool(path string) (*spool, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &spool{
+		path: path,
+		file: file,
+		size: size,
+	}, nil
+}
+
+// write appends events to the spool.
+func (s *spool) write(events []*auditpb.Event) error {
+	var buf []byte
+	var length [binary.MaxVarintLen64]byte
+	for _, ev := range events {
+		data, err := proto.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		buf = append(buf, length[:binary.PutUvarint(length[:], uint64(len(data)))]...)
+		buf = append(buf, data...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, err := s.file.WriteAt(buf, s.size)
+	if err != nil {
+		// Do not leave a partial event at the end of the spool.
+		s.file.Truncate(s.size)
+		return err
+	}
+	s.size += int64(n)
+	return nil
+}
+
+// read returns up to n events after the offset, and the offset after them
+// to commit once they are delivered.
+func (s *spool) read(n int) ([]*auditpb.Event, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := bufio.NewReader(io.NewSectionReader(s.file, s.offset, s.size-s.offset))
+	offset := s.offset
+	var events []*auditpb.Event
+	for len(events) < n && offset < s.size {
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return events, offset, s.corrupted(offset, err)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return events, offset, s.corrupted(offset, err)
+		}
+		ev := &auditpb.Event{}
+		if err := proto.Unmarshal(data, ev); err != nil {
+			return events, offset, s.corrupted(offset, err)
+		}
+		events = append(events, ev)
+		offset += int64(uvarintLen(length)) + int64(length)
+	}
+	return events, offset, nil
+}
+
+// corrupted drops the events of the spool after a corrupted event, which
+// can be left by a crash.
+func (s *spool) corrupted(offset int64, err error) error {
+	s.size = offset
+	if terr := s.file.Truncate(offset); terr != nil {
+		return fmt.Errorf("corrupted audit spool %s at offset %d: %v, and can't truncate it: %v", s.path, offset, err, terr)
+	}
+	return fmt.Errorf("corrupted audit spool %s at offset %d, dropped the events after it: %v", s.path, offset, err)
+}
+
+// commit marks the events before an offset returned by read as delivered.
+func (s *spool) commit(offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.offset = offset
+	if s.offset < s.size {
+		return nil
+	}
+	s.offset = 0
+	s.size = 0
+	return s.file.Truncate(0)
+}
+
+// empty returns true if all the events of the spool are delivered.
+func (s *spool) empty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.offset >= s.size
+}
+
+// close removes the delivered events from the spool, so that they are not
+// delivered again after a restart, and closes it.
+func (s *spool) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.file.Close()
+	if s.offset == 0 {
+		return nil
+	}
+	tmp, err := os.OpenFile(s.path+".tmp", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(tmp, io.NewSectionReader(s.file, s.offset, s.size-s.offset)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(s.path+".tmp", s.path)
+}
+
+func uvarintLen(x uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], x)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"log/syslog"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"vitess.io/vitess/go/json2"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+// syslogSink sends the events to a syslog daemon with the auth facility, as
+// JSON messages. The denied and failed operations are logged as warnings.
+//
+// The URL syslog: sends the events to the local daemon, and the URL
+// syslog://host:port?network=udp&tag=vitess sends them to a remote daemon.
+// The network defaults to udp, and the tag to the name of the binary.
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+func newSyslogSink(u *url.URL) (Sink, error) {
+	query := u.Query()
+	tag := query.Get("tag")
+	if tag == "" {
+		tag = filepath.Base(os.Args[0])
+	}
+	network := ""
+	if u.Host != "" {
+		network = query.Get("network")
+		if network == "" {
+			network = "udp"
+		}
+	}
+	writer, err := syslog.Dial(network, u.Host, syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogSink{writer: writer}, nil
+}
+
+// Write is part of the Sink interface.
+func (ss *syslogSink) Write(ctx context.Context, events []*auditpb.Event) error {
+	for _, ev := range events {
+		data, err := json2.MarshalPB(ev)
+		if err != nil {
+			return err
+		}
+		if ev.Outcome == auditpb.Outcome_SUCCESS {
+			err = ss.writer.Info(string(data))
+		} else {
+			err = ss.writer.Warning(string(data))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close is part of the Sink interface.
+func (ss *syslogSink) Close() error {
+	return ss.writer.Close()
+}
+
+func init() {
+	RegisterSink("syslog", newSyslogSink)
+}
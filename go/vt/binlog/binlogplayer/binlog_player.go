@@ -97,6 +97,7 @@ type Stats struct {
 	CopyRowCount   *stats.Counter
 	CopyLoopCount  *stats.Counter
 	ErrorCounts    *stats.CountersWithMultiLabels
+	RetryCounts    *stats.CountersWithSingleLabel
 	NoopQueryCount *stats.CountersWithSingleLabel
 
 	VReplicationLags     *stats.Timings
@@ -156,6 +157,7 @@ func NewStats() *Stats {
 	bps.CopyRowCount = stats.NewCounter("", "")
 	bps.CopyLoopCount = stats.NewCounter("", "")
 	bps.ErrorCounts = stats.NewCountersWithMultiLabels("", "", []string{"type"})
+	bps.RetryCounts = stats.NewCountersWithSingleLabel("", "", "Class")
 	bps.NoopQueryCount = stats.NewCountersWithSingleLabel("", "", "Statement", "")
 	bps.VReplicationLags = stats.NewTimings("", "", "")
 	bps.VReplicationLagRates = stats.NewRates("", bps.VReplicationLags, 15*60/5, 5*time.Second)
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"flag"
+	"os"
 
 	"vitess.io/vitess/go/vt/vttls"
 
@@ -83,7 +84,9 @@ type DBConfigs struct {
 	SslCaPath                  string        `json:"sslCaPath,omitempty"`
 	SslCert                    string        `json:"sslCert,omitempty"`
 	SslKey                     string        `json:"sslKey,omitempty"`
+	SslCrl                     string        `json:"sslCrl,omitempty"`
 	TLSMinVersion              string        `json:"tlsMinVersion,omitempty"`
+	TLSCipherSuites            string        `json:"tlsCipherSuites,omitempty"`
 	ServerName                 string        `json:"serverName,omitempty"`
 	ConnectTimeoutMilliseconds int           `json:"connectTimeoutMilliseconds,omitempty"`
 	DBName                     string        `json:"dbName,omitempty"`
@@ -137,7 +140,9 @@ func registerBaseFlags() {
 	flag.StringVar(&GlobalDBConfigs.SslCaPath, "db_ssl_ca_path", "", "connection ssl ca path")
 	flag.StringVar(&GlobalDBConfigs.SslCert, "db_ssl_cert", "", "connection ssl certificate")
 	flag.StringVar(&GlobalDBConfigs.SslKey, "db_ssl_key", "", "connection ssl key")
+	flag.StringVar(&GlobalDBConfigs.SslCrl, "db_ssl_crl", "", "connection ssl certificate revocation list")
 	flag.StringVar(&GlobalDBConfigs.TLSMinVersion, "db_tls_min_version", "", "Configures the minimal TLS version negotiated when SSL is enabled. Defaults to TLSv1.2. Options: TLSv1.0, TLSv1.1, TLSv1.2, TLSv1.3.")
+	flag.StringVar(&GlobalDBConfigs.TLSCipherSuites, "db_tls_cipher_suites", "", "Comma separated list of the TLS cipher suites allowed when SSL is enabled, e.g. TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384. Defaults to the built-in list of secure cipher suites.")
 	flag.StringVar(&GlobalDBConfigs.ServerName, "db_server_name", "", "server name of the DB we are connecting to.")
 	flag.IntVar(&GlobalDBConfigs.ConnectTimeoutMilliseconds, "db_connect_timeout_ms", 0, "connection timeout to mysqld in milliseconds (0 for no timeout)")
 }
@@ -326,6 +331,31 @@ func (dbcfgs *DBConfigs) Redacted() *DBConfigs {
 	return dbcfgs
 }
 
+// ValidateTLS checks the TLS settings. The cipher suites must be known
+// and secure, and the ssl key must be readable. A warning is logged if the
+// ssl key is accessible by other users than its owner, since the key of a
+// client certificate which grants access to an external database should not
+// be readable by anyone else on the host.
+func (dbcfgs *DBConfigs) ValidateTLS() error {
+	if _, err := vttls.TLSVersionToNumber(dbcfgs.TLSMinVersion); err != nil {
+		return err
+	}
+	if _, err := vttls.CipherSuitesToNumbers(dbcfgs.TLSCipherSuites); err != nil {
+		return err
+	}
+	if dbcfgs.SslKey == "" {
+		return nil
+	}
+	fi, err := os.Stat(dbcfgs.SslKey)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot read ssl key")
+	}
+	if fi.Mode().Perm()&0077 != 0 {
+		log.Warningf("ssl key %s should not be accessible by group or others, its permissions are %v", dbcfgs.SslKey, fi.Mode().Perm())
+	}
+	return nil
+}
+
 // Clone returns a clone of the DBConfig.
 func (dbcfgs *DBConfigs) Clone() *DBConfigs {
 	result := *dbcfgs
@@ -388,7 +418,9 @@ func (dbcfgs *DBConfigs) InitWithSocket(defaultSocketFile string) {
 			cp.SslCaPath = dbcfgs.SslCaPath
 			cp.SslCert = dbcfgs.SslCert
 			cp.SslKey = dbcfgs.SslKey
+			cp.SslCrl = dbcfgs.SslCrl
 			cp.TLSMinVersion = dbcfgs.TLSMinVersion
+			cp.TLSCipherSuites = dbcfgs.TLSCipherSuites
 			cp.ServerName = dbcfgs.ServerName
 		}
 	}
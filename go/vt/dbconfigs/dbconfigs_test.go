@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/vttls"
 	"vitess.io/vitess/go/yaml2"
 )
 
@@ -226,6 +227,78 @@ func TestUseTCP(t *testing.T) {
 	assert.Equal(t, want, dbConfigs.dbaParams)
 }
 
+func TestInitTLS(t *testing.T) {
+	dbConfigs := DBConfigs{
+		Host:            "a",
+		Port:            1,
+		SslMode:         vttls.VerifyIdentity,
+		SslCa:           "ca",
+		SslCert:         "cert",
+		SslKey:          "key",
+		SslCrl:          "crl",
+		TLSMinVersion:   "TLSv1.3",
+		TLSCipherSuites: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		ServerName:      "server",
+		App: UserConfig{
+			User:   "app",
+			UseSSL: true,
+		},
+		Dba: UserConfig{
+			User: "dba",
+		},
+	}
+	dbConfigs.InitWithSocket("default")
+
+	want := mysql.ConnParams{
+		Host:            "a",
+		Port:            1,
+		Uname:           "app",
+		SslMode:         vttls.VerifyIdentity,
+		SslCa:           "ca",
+		SslCert:         "cert",
+		SslKey:          "key",
+		SslCrl:          "crl",
+		TLSMinVersion:   "TLSv1.3",
+		TLSCipherSuites: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		ServerName:      "server",
+	}
+	assert.Equal(t, want, dbConfigs.appParams)
+
+	want = mysql.ConnParams{
+		Host:  "a",
+		Port:  1,
+		Uname: "dba",
+	}
+	assert.Equal(t, want, dbConfigs.dbaParams)
+}
+
+func TestValidateTLS(t *testing.T) {
+	key, err := os.CreateTemp("", "key")
+	require.NoError(t, err)
+	defer os.Remove(key.Name())
+	require.NoError(t, key.Close())
+
+	dbConfigs := DBConfigs{
+		TLSCipherSuites: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+		SslKey:          key.Name(),
+	}
+	require.NoError(t, os.Chmod(key.Name(), 0600))
+	assert.NoError(t, dbConfigs.ValidateTLS())
+
+	// Keys accessible by group or others are only warned about.
+	require.NoError(t, os.Chmod(key.Name(), 0644))
+	assert.NoError(t, dbConfigs.ValidateTLS())
+
+	dbConfigs.SslKey = "/nonexistent"
+	assert.EqualError(t, dbConfigs.ValidateTLS(), "cannot read ssl key: stat /nonexistent: no such file or directory")
+
+	dbConfigs.TLSCipherSuites = "TLS_RSA_WITH_RC4_128_SHA"
+	assert.EqualError(t, dbConfigs.ValidateTLS(), "Invalid or insecure TLS cipher suite specified: TLS_RSA_WITH_RC4_128_SHA")
+
+	dbConfigs.TLSMinVersion = "SSLv3"
+	assert.EqualError(t, dbConfigs.ValidateTLS(), "Invalid TLS version specified: SSLv3. Allowed options are TLSv1.0, TLSv1.1, TLSv1.2 & TLSv1.3")
+}
+
 func TestAccessors(t *testing.T) {
 	dbc := &DBConfigs{
 		appParams:      mysql.ConnParams{},
@@ -92,6 +92,19 @@ func (fhc *FakeHealthCheck) GetHealthyTabletStats(target *querypb.Target) []*Tab
 	return result
 }
 
+// GetTabletStats returns all the tablets of the target, whether they are healthy or not.
+func (fhc *FakeHealthCheck) GetTabletStats(target *querypb.Target) []*TabletHealth {
+	result := make([]*TabletHealth, 0)
+	fhc.mu.Lock()
+	defer fhc.mu.Unlock()
+	for _, item := range fhc.items {
+		if proto.Equal(item.ts.Target, target) {
+			result = append(result, item.ts)
+		}
+	}
+	return result
+}
+
 // Subscribe returns the channel in the struct. Subscribe should only be called in one place for this fake health check
 func (fhc *FakeHealthCheck) Subscribe() chan *TabletHealth {
 	return fhc.ch
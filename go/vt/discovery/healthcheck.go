@@ -193,6 +193,12 @@ type HealthCheck interface {
 	// synchronization
 	GetHealthyTabletStats(target *query.Target) []*TabletHealth
 
+	// GetTabletStats returns all the tablets of the target, including the
+	// ones filtered out by GetHealthyTabletStats because of their
+	// replication lag or health.
+	// The returned array is owned by the caller.
+	GetTabletStats(target *query.Target) []*TabletHealth
+
 	// Subscribe adds a listener. Used by vtgate buffer to learn about primary changes.
 	Subscribe() chan *TabletHealth
 
@@ -606,14 +612,18 @@ func (hc *HealthCheckImpl) GetHealthyTabletStats(target *query.Target) []*Tablet
 	return append(result, hc.healthy[hc.keyFromTarget(target)]...)
 }
 
-// getTabletStats returns all tablets for the given target.
+// GetTabletStats returns all tablets for the given target.
 // The returned array is owned by the caller.
 // For TabletType_PRIMARY, this will only return at most one entry,
 // the most recent tablet of type primary.
-func (hc *HealthCheckImpl) getTabletStats(target *query.Target) []*TabletHealth {
+func (hc *HealthCheckImpl) GetTabletStats(target *query.Target) []*TabletHealth {
 	var result []*TabletHealth
 	hc.mu.Lock()
 	defer hc.mu.Unlock()
+	// The target belongs to the caller, so it is not modified.
+	if target.Shard == "" {
+		target = &query.Target{Keyspace: target.Keyspace, Shard: "0", TabletType: target.TabletType}
+	}
 	ths := hc.healthData[hc.keyFromTarget(target)]
 	for _, th := range ths {
 		result = append(result, th)
@@ -679,7 +689,7 @@ func (hc *HealthCheckImpl) waitForTablets(ctx context.Context, targets []*query.
 			if requireServing {
 				tabletHealths = hc.GetHealthyTabletStats(target)
 			} else {
-				tabletHealths = hc.getTabletStats(target)
+				tabletHealths = hc.GetTabletStats(target)
 			}
 			if len(tabletHealths) == 0 {
 				allPresent = false
@@ -707,6 +707,18 @@ func TestRemoveTablet(t *testing.T) {
 	assert.Empty(t, a, "wrong result, expected empty list")
 }
 
+func TestGetTabletStatsKeepsTarget(t *testing.T) {
+	ts := memorytopo.NewServer("cell")
+	hc := createTestHc(ts)
+	defer hc.Close()
+
+	// The target of an unsharded keyspace may have no shard, which must
+	// stay as is for the caller.
+	target := &querypb.Target{Keyspace: "k", TabletType: topodatapb.TabletType_REPLICA}
+	assert.Empty(t, hc.GetTabletStats(target))
+	assert.Equal(t, "", target.Shard)
+}
+
 // TestGetHealthyTablets tests the functionality of GetHealthyTabletStats.
 func TestGetHealthyTablets(t *testing.T) {
 	ts := memorytopo.NewServer("cell")
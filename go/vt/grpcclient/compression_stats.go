@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/encoding"
+
+	"vitess.io/vitess/go/stats"
+)
+
+const (
+	compressOperation   = "compress"
+	decompressOperation = "decompress"
+	rawSize             = "raw"
+	compressedSize      = "compressed"
+)
+
+var compressionBytes = stats.NewCountersWithMultiLabels(
+	"GRPCCompressionBytes",
+	"Number of bytes of the gRPC messages compressed and decompressed by the process, before (raw) and after (compressed) compression",
+	[]string{"Compressor", "Operation", "Size"})
+
+func init() {
+	stats.NewGaugesFuncWithMultiLabels(
+		"GRPCCompressionRatio",
+		"Ratio of the raw size to the compressed size of the gRPC messages compressed and decompressed by the process, in percent",
+		[]string{"Compressor", "Operation"},
+		compressionRatios)
+}
+
+func compressionRatios() map[string]int64 {
+	counts := compressionBytes.Counts()
+	ratios := make(map[string]int64)
+	for key, compressed := range counts {
+		if compressed == 0 || !strings.HasSuffix(key, "."+compressedSize) {
+			continue
+		}
+		prefix := strings.TrimSuffix(key, "."+compressedSize)
+		ratios[prefix] = counts[prefix+"."+rawSize] * 100 / compressed
+	}
+	return ratios
+}
+
+// withCompressionStats returns the compressor counting the bytes it
+// compresses and decompresses in the GRPCCompressionBytes stats.
+func withCompressionStats(compressor encoding.Compressor) encoding.Compressor {
+	return &statsCompressor{Compressor: compressor}
+}
+
+type statsCompressor struct {
+	encoding.Compressor
+}
+
+func (sc *statsCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	compressed := &countingWriter{Writer: w}
+	wc, err := sc.Compressor.Compress(compressed)
+	if err != nil {
+		return nil, err
+	}
+	return &statsWriter{WriteCloser: wc, name: sc.Name(), compressed: compressed}, nil
+}
+
+func (sc *statsCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	name := sc.Name()
+	raw, err := sc.Compressor.Decompress(&countingReader{Reader: r, labels: []string{name, decompressOperation, compressedSize}})
+	if err != nil {
+		return nil, err
+	}
+	return &countingReader{Reader: raw, labels: []string{name, decompressOperation, rawSize}}, nil
+}
+
+// statsWriter counts the bytes of a message when it is closed.
+type statsWriter struct {
+	io.WriteCloser
+	name       string
+	raw        int64
+	compressed *countingWriter
+}
+
+func (sw *statsWriter) Write(p []byte) (int, error) {
+	n, err := sw.WriteCloser.Write(p)
+	sw.raw += int64(n)
+	return n, err
+}
+
+func (sw *statsWriter) Close() error {
+	err := sw.WriteCloser.Close()
+	compressionBytes.Add([]string{sw.name, compressOperation, rawSize}, sw.raw)
+	compressionBytes.Add([]string{sw.name, compressOperation, compressedSize}, sw.compressed.n)
+	return err
+}
+
+type countingWriter struct {
+	io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.Writer.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+type countingReader struct {
+	io.Reader
+	labels []string
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.Reader.Read(p)
+	if n > 0 {
+		compressionBytes.Add(cr.labels, int64(n))
+	}
+	return n, err
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestCompressors(t *testing.T) {
+	message := []byte(strings.Repeat("select * from t where id = 1;", 100))
+	for _, name := range []string{"snappy", "zstd"} {
+		t.Run(name, func(t *testing.T) {
+			compressionBytes.ResetAll()
+			compressor := encoding.GetCompressor(name)
+			require.NotNil(t, compressor)
+
+			compressed := &bytes.Buffer{}
+			w, err := compressor.Compress(compressed)
+			require.NoError(t, err)
+			_, err = w.Write(message)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			assert.Less(t, compressed.Len(), len(message))
+
+			r, err := compressor.Decompress(bytes.NewReader(compressed.Bytes()))
+			require.NoError(t, err)
+			raw, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, message, raw)
+
+			counts := compressionBytes.Counts()
+			assert.EqualValues(t, len(message), counts[name+".compress.raw"])
+			assert.EqualValues(t, compressed.Len(), counts[name+".compress.compressed"])
+			assert.EqualValues(t, len(message), counts[name+".decompress.raw"])
+			assert.EqualValues(t, compressed.Len(), counts[name+".decompress.compressed"])
+			ratios := compressionRatios()
+			assert.EqualValues(t, len(message)*100/compressed.Len(), ratios[name+".compress"])
+			assert.EqualValues(t, len(message)*100/compressed.Len(), ratios[name+".decompress"])
+		})
+	}
+}
+
+func TestZstdCompressorEmptyMessage(t *testing.T) {
+	compressor := encoding.GetCompressor("zstd")
+	compressed := &bytes.Buffer{}
+	w, err := compressor.Compress(compressed)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r, err := compressor.Decompress(bytes.NewReader(compressed.Bytes()))
+	require.NoError(t, err)
+	raw, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Empty(t, raw)
+}
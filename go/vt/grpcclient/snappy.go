@@ -18,6 +18,7 @@ package grpcclient
 
 import (
 	"flag"
+	"fmt"
 	"io"
 
 	"github.com/golang/snappy"
@@ -26,7 +27,7 @@ import (
 )
 
 var (
-	compression = flag.String("grpc_compression", "", "Which protocol to use for compressing gRPC. Default: nothing. Supported: snappy")
+	compression = flag.String("grpc_compression", "", "Which protocol to use for compressing gRPC. Default: nothing. Supported: snappy, zstd")
 )
 
 // SnappyCompressor is a gRPC compressor using the Snappy algorithm.
@@ -48,15 +49,18 @@ func (s SnappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
 }
 
 func appendCompression(opts []grpc.DialOption) ([]grpc.DialOption, error) {
-	if *compression == "snappy" {
-		compression := grpc.WithDefaultCallOptions(grpc.UseCompressor("snappy"))
-		opts = append(opts, compression)
+	if *compression != "" {
+		if encoding.GetCompressor(*compression) == nil {
+			return nil, fmt.Errorf("unsupported grpc_compression %q", *compression)
+		}
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(*compression)))
 	}
 
 	return opts, nil
 }
 
 func init() {
-	encoding.RegisterCompressor(SnappyCompressor{})
+	encoding.RegisterCompressor(withCompressionStats(SnappyCompressor{}))
+	encoding.RegisterCompressor(withCompressionStats(&ZstdCompressor{}))
 	RegisterGRPCDialOptions(appendCompression)
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"vitess.io/vitess/go/vt/grpccommon"
+)
+
+// ZstdCompressor is a gRPC compressor using the Zstandard algorithm. Each
+// message is compressed as a whole, so that its encoder and decoder are
+// shared by all the messages. They use the default concurrency, so that up
+// to GOMAXPROCS messages are compressed or decompressed in parallel.
+type ZstdCompressor struct {
+	once    sync.Once
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+	err     error
+}
+
+// Name is "zstd"
+func (z *ZstdCompressor) Name() string {
+	return "zstd"
+}
+
+func (z *ZstdCompressor) init() error {
+	z.once.Do(func() {
+		z.encoder, z.err = zstd.NewWriter(nil)
+		if z.err != nil {
+			return
+		}
+		z.decoder, z.err = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(uint64(*grpccommon.MaxMessageSize)))
+	})
+	return z.err
+}
+
+// Compress buffers the message, and writes it compressed on Close.
+func (z *ZstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	if err := z.init(); err != nil {
+		return nil, err
+	}
+	return &zstdWriter{encoder: z.encoder, w: w}, nil
+}
+
+// Decompress decompresses the whole message.
+func (z *ZstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	if err := z.init(); err != nil {
+		return nil, err
+	}
+	compressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := z.decoder.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(raw), nil
+}
+
+type zstdWriter struct {
+	encoder *zstd.Encoder
+	w       io.Writer
+	buf     bytes.Buffer
+}
+
+func (zw *zstdWriter) Write(p []byte) (int, error) {
+	return zw.buf.Write(p)
+}
+
+func (zw *zstdWriter) Close() error {
+	_, err := zw.w.Write(zw.encoder.EncodeAll(zw.buf.Bytes(), nil))
+	return err
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkarest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProducer(t *testing.T) {
+	var body struct {
+		Records []Record `json:"records"`
+	}
+	response := `{"offsets":[{"partition":0,"offset":1},{"partition":0,"offset":2}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/events", r.URL.Path)
+		assert.Equal(t, ContentType, r.Header.Get("Content-Type"))
+		user, password, _ := r.BasicAuth()
+		assert.Equal(t, "user:password", user+":"+password)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	u, err := url.Parse("kafka://user:password@" + strings.TrimPrefix(server.URL, "http://") + "/events")
+	require.NoError(t, err)
+	p, err := NewProducer(u)
+	require.NoError(t, err)
+	defer p.Close()
+
+	records := []Record{{Key: "k1", Value: json.RawMessage(`{"a":1}`)}, {Value: json.RawMessage(`"b"`)}}
+	require.NoError(t, p.Produce(context.Background(), records))
+	assert.Equal(t, records, body.Records)
+
+	response = `{"offsets":[{"partition":0,"offset":3},{"error_code":50002,"error":"broker unavailable"}]}`
+	err = p.Produce(context.Background(), records)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to produce a record: broker unavailable")
+}
+
+func TestProduceWithSchemas(t *testing.T) {
+	var body map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, AvroContentType, r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"key_schema_id":1,"value_schema_id":2,"offsets":[{"partition":0,"offset":1}]}`))
+	}))
+	defer server.Close()
+
+	u, err := url.Parse("kafka://" + strings.TrimPrefix(server.URL, "http://") + "/events")
+	require.NoError(t, err)
+	p, err := NewProducer(u)
+	require.NoError(t, err)
+	defer p.Close()
+
+	schemas := &Schemas{KeySchema: `"string"`, ValueSchema: `{"type":"record","name":"event","fields":[{"name":"a","type":"long"}]}`}
+	records := []Record{{Key: "k1", Value: json.RawMessage(`{"a":1}`)}}
+	require.NoError(t, p.ProduceWithSchemas(context.Background(), AvroContentType, schemas, records))
+	assert.JSONEq(t, `"\"string\""`, string(body["key_schema"]))
+	assert.JSONEq(t, `"{\"type\":\"record\",\"name\":\"event\",\"fields\":[{\"name\":\"a\",\"type\":\"long\"}]}"`, string(body["value_schema"]))
+	assert.JSONEq(t, `[{"key":"k1","value":{"a":1}}]`, string(body["records"]))
+}
+
+func TestNewProducer(t *testing.T) {
+	for rawURL, want := range map[string]string{
+		"kafka://proxy:8082/events":          "http://proxy:8082/topics/events",
+		"kafka://proxy:8082/events?tls=true": "https://proxy:8082/topics/events",
+	} {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		p, err := NewProducer(u)
+		require.NoError(t, err)
+		assert.Equal(t, want, p.endpoint)
+	}
+
+	u, err := url.Parse("kafka://proxy:8082")
+	require.NoError(t, err)
+	_, err = NewProducer(u)
+	assert.EqualError(t, err, "expected kafka://host:port/topic, got kafka://proxy:8082")
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package masking masks the values of the columns of the masking policy,
+// so the copies of the production data in the non-production keyspaces do
+// not expose it.
+//
+// The masking is deterministic: a value is always masked the same way under
+// the same salt, so the masked columns can still be joined and grouped on.
+package masking
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ValidatePolicy returns an error if the policy is invalid.
+func ValidatePolicy(policy *binlogdatapb.MaskingPolicy) error {
+	keyspaces := make(map[string]bool, len(policy.Keyspaces))
+	for _, keyspace := range policy.Keyspaces {
+		if keyspace == "" {
+			return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "keyspaces must not be empty")
+		}
+		if keyspaces[keyspace] {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "duplicate keyspace %s", keyspace)
+		}
+		keyspaces[keyspace] = true
+	}
+
+	columns := make(map[string]bool, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		if rule.Table == "" || rule.Column == "" {
+			return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "rule must have a Table and a Column")
+		}
+		if _, ok := binlogdatapb.MaskingTransform_name[int32(rule.Transform)]; !ok {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown transform %v for %s.%s", rule.Transform, rule.Table, rule.Column)
+		}
+		column := rule.Table + "." + strings.ToLower(rule.Column)
+		if columns[column] {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "duplicate rule for %s.%s", rule.Table, rule.Column)
+		}
+		columns[column] = true
+	}
+	return nil
+}
+
+// IsMasked returns true if the keyspace is one of the non-production
+// keyspaces of the policy.
+func IsMasked(policy *binlogdatapb.MaskingPolicy, keyspace string) bool {
+	for _, ks := range policy.Keyspaces {
+		if ks == keyspace {
+			return true
+		}
+	}
+	return false
+}
+
+// TableMasks returns the masks of the columns of a table, by lowercased
+// column name.
+func TableMasks(policy *binlogdatapb.MaskingPolicy, table string) map[string]*Mask {
+	masks := make(map[string]*Mask)
+	for _, rule := range policy.Rules {
+		if rule.Table == table {
+			masks[strings.ToLower(rule.Column)] = NewMask(rule.Transform, policy.Salt)
+		}
+	}
+	return masks
+}
+
+// Mask masks the values of a column.
+type Mask struct {
+	transform binlogdatapb.MaskingTransform
+	salt      []byte
+}
+
+// NewMask returns the Mask applying the transform under the salt.
+func NewMask(transform binlogdatapb.MaskingTransform, salt []byte) *Mask {
+	return &Mask{transform: transform, salt: salt}
+}
+
+// Transform returns the transform of the mask.
+func (m *Mask) Transform() binlogdatapb.MaskingTransform {
+	return m.transform
+}
+
+// Apply returns the masked value. NULL stays NULL. HASH and FAKE only
+// support the numeric, text and binary values.
+func (m *Mask) Apply(v sqltypes.Value) (sqltypes.Value, error) {
+	if v.IsNull() {
+		return v, nil
+	}
+	if m.transform == binlogdatapb.MaskingTransform_NULLIFY {
+		return sqltypes.NULL, nil
+	}
+
+	typ := v.Type()
+	raw := v.Raw()
+	switch {
+	case sqltypes.IsIntegral(typ) && typ != sqltypes.Year:
+		return m.maskIntegral(v)
+	case sqltypes.IsFloat(typ), typ == sqltypes.Decimal:
+		return sqltypes.MakeTrusted(typ, m.maskDigits(raw)), nil
+	case sqltypes.IsBinary(typ):
+		return sqltypes.MakeTrusted(typ, m.stream(raw, len(raw))), nil
+	case sqltypes.IsText(typ):
+		if m.transform == binlogdatapb.MaskingTransform_FAKE {
+			return sqltypes.MakeTrusted(typ, m.fake(raw)), nil
+		}
+		// The hash keeps the length in characters, so it fits the column.
+		n := utf8.RuneCount(raw)
+		hash := make([]byte, hex.EncodedLen(n/2+1))
+		hex.Encode(hash, m.stream(raw, n/2+1))
+		return sqltypes.MakeTrusted(typ, hash[:n]), nil
+	default:
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot %s a value of type %v", strings.ToLower(m.transform.String()), typ)
+	}
+}
+
+// stream returns n pseudo-random bytes derived from the value.
+func (m *Mask) stream(raw []byte, n int) []byte {
+	out := make([]byte, 0, n+sha256.Size)
+	var counter [4]byte
+	for i := uint32(0); len(out) < n; i++ {
+		mac := hmac.New(sha256.New, m.salt)
+		binary.BigEndian.PutUint32(counter[:], i)
+		mac.Write(counter[:])
+		mac.Write(raw)
+		out = mac.Sum(out)
+	}
+	return out[:n]
+}
+
+// maskIntegral replaces the integer with one of the same sign and a smaller
+// or equal magnitude, so it fits the column.
+func (m *Mask) maskIntegral(v sqltypes.Value) (sqltypes.Value, error) {
+	h := binary.BigEndian.Uint64(m.stream(v.Raw(), 8))
+	bound := func(magnitude uint64) uint64 {
+		if magnitude == ^uint64(0) {
+			return h
+		}
+		return h % (magnitude + 1)
+	}
+
+	if sqltypes.IsUnsigned(v.Type()) {
+		u, err := v.ToUint64()
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return sqltypes.MakeTrusted(v.Type(), strconv.AppendUint(nil, bound(u), 10)), nil
+	}
+
+	i, err := v.ToInt64()
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if i >= 0 {
+		return sqltypes.MakeTrusted(v.Type(), strconv.AppendInt(nil, int64(bound(uint64(i))), 10)), nil
+	}
+	masked := bound(uint64(-(i + 1)) + 1)
+	if masked == 0 {
+		return sqltypes.MakeTrusted(v.Type(), []byte("0")), nil
+	}
+	return sqltypes.MakeTrusted(v.Type(), append([]byte{'-'}, strconv.AppendUint(nil, masked, 10)...)), nil
+}
+
+// maskDigits replaces the digits of the mantissa of a number, so it keeps
+// its precision and scale.
+func (m *Mask) maskDigits(raw []byte) []byte {
+	random := m.stream(raw, len(raw))
+	out := make([]byte, len(raw))
+	exponent := false
+	for i, c := range raw {
+		if c == 'e' || c == 'E' {
+			exponent = true
+		}
+		if c >= '0' && c <= '9' && !exponent {
+			c = '0' + random[i]%10
+		}
+		out[i] = c
+	}
+	return out
+}
+
+// fake replaces the letters and digits of the text with pseudo-random ASCII
+// ones, keeping its other characters. The bytes which are not valid UTF-8
+// are replaced as letters.
+func (m *Mask) fake(raw []byte) []byte {
+	random := m.stream(raw, len(raw))
+	out := make([]byte, 0, len(raw))
+	for i := 0; len(raw) > 0; i++ {
+		r, size := utf8.DecodeRune(raw)
+		switch {
+		case unicode.IsUpper(r):
+			out = append(out, 'A'+random[i]%26)
+		case unicode.IsLetter(r), r == utf8.RuneError && size == 1:
+			out = append(out, 'a'+random[i]%26)
+		case unicode.IsDigit(r):
+			out = append(out, '0'+random[i]%10)
+		default:
+			out = append(out, raw[:size]...)
+		}
+		raw = raw[size:]
+	}
+	return out
+}
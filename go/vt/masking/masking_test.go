@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package masking
+
+import (
+	"strconv"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	tcases := []struct {
+		name   string
+		policy *binlogdatapb.MaskingPolicy
+		err    string
+	}{{
+		name: "valid",
+		policy: &binlogdatapb.MaskingPolicy{
+			Keyspaces: []string{"staging", "dev"},
+			Rules: []*binlogdatapb.MaskingRule{
+				{Table: "customer", Column: "email", Transform: binlogdatapb.MaskingTransform_FAKE},
+				{Table: "customer", Column: "phone", Transform: binlogdatapb.MaskingTransform_NULLIFY},
+				{Table: "orders", Column: "email"},
+			},
+		},
+	}, {
+		name:   "empty",
+		policy: &binlogdatapb.MaskingPolicy{},
+	}, {
+		name:   "empty keyspace",
+		policy: &binlogdatapb.MaskingPolicy{Keyspaces: []string{""}},
+		err:    "keyspaces must not be empty",
+	}, {
+		name:   "duplicate keyspace",
+		policy: &binlogdatapb.MaskingPolicy{Keyspaces: []string{"dev", "dev"}},
+		err:    "duplicate keyspace dev",
+	}, {
+		name: "missing column",
+		policy: &binlogdatapb.MaskingPolicy{
+			Rules: []*binlogdatapb.MaskingRule{{Table: "customer"}},
+		},
+		err: "rule must have a Table and a Column",
+	}, {
+		name: "unknown transform",
+		policy: &binlogdatapb.MaskingPolicy{
+			Rules: []*binlogdatapb.MaskingRule{{Table: "customer", Column: "email", Transform: 10}},
+		},
+		err: "unknown transform 10 for customer.email",
+	}, {
+		name: "duplicate rule",
+		policy: &binlogdatapb.MaskingPolicy{
+			Rules: []*binlogdatapb.MaskingRule{
+				{Table: "customer", Column: "email"},
+				{Table: "customer", Column: "EMAIL", Transform: binlogdatapb.MaskingTransform_NULLIFY},
+			},
+		},
+		err: "duplicate rule for customer.EMAIL",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			err := ValidatePolicy(tcase.policy)
+			if tcase.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
+func TestTableMasks(t *testing.T) {
+	policy := &binlogdatapb.MaskingPolicy{
+		Keyspaces: []string{"staging"},
+		Rules: []*binlogdatapb.MaskingRule{
+			{Table: "customer", Column: "Email", Transform: binlogdatapb.MaskingTransform_FAKE},
+			{Table: "orders", Column: "email"},
+		},
+		Salt: []byte("salt"),
+	}
+	assert.True(t, IsMasked(policy, "staging"))
+	assert.False(t, IsMasked(policy, "commerce"))
+
+	masks := TableMasks(policy, "customer")
+	require.Len(t, masks, 1)
+	assert.Equal(t, NewMask(binlogdatapb.MaskingTransform_FAKE, []byte("salt")), masks["email"])
+	assert.Empty(t, TableMasks(policy, "product"))
+}
+
+func TestApply(t *testing.T) {
+	hash := NewMask(binlogdatapb.MaskingTransform_HASH, []byte("salt"))
+	fake := NewMask(binlogdatapb.MaskingTransform_FAKE, []byte("salt"))
+	nullify := NewMask(binlogdatapb.MaskingTransform_NULLIFY, []byte("salt"))
+
+	t.Run("null", func(t *testing.T) {
+		for _, mask := range []*Mask{hash, fake, nullify} {
+			got, err := mask.Apply(sqltypes.NULL)
+			require.NoError(t, err)
+			assert.True(t, got.IsNull())
+		}
+		got, err := nullify.Apply(sqltypes.NewVarChar("a"))
+		require.NoError(t, err)
+		assert.True(t, got.IsNull())
+	})
+
+	t.Run("text", func(t *testing.T) {
+		v := sqltypes.NewVarChar("jane.doe@example.com")
+		got, err := hash.Apply(v)
+		require.NoError(t, err)
+		assert.Equal(t, sqltypes.VarChar, got.Type())
+		assert.Len(t, got.ToString(), len(v.ToString()))
+		assert.NotEqual(t, v.ToString(), got.ToString())
+		assert.Regexp(t, "^[0-9a-f]+$", got.ToString())
+
+		again, err := hash.Apply(v)
+		require.NoError(t, err)
+		assert.Equal(t, got, again, "masking is deterministic")
+		salted, err := NewMask(binlogdatapb.MaskingTransform_HASH, []byte("pepper")).Apply(v)
+		require.NoError(t, err)
+		assert.NotEqual(t, got, salted)
+
+		got, err = fake.Apply(v)
+		require.NoError(t, err)
+		assert.Regexp(t, `^[a-z]{4}\.[a-z]{3}@[a-z]{7}\.[a-z]{3}$`, got.ToString())
+
+		got, err = fake.Apply(sqltypes.NewVarChar("Élise 42-B"))
+		require.NoError(t, err)
+		assert.Regexp(t, `^[A-Z][a-z]{4} [0-9]{2}-[A-Z]$`, got.ToString())
+
+		got, err = hash.Apply(sqltypes.NewVarChar("Élise"))
+		require.NoError(t, err)
+		assert.Equal(t, 5, utf8.RuneCountInString(got.ToString()))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		v := sqltypes.MakeTrusted(sqltypes.Binary, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+		for _, mask := range []*Mask{hash, fake} {
+			got, err := mask.Apply(v)
+			require.NoError(t, err)
+			assert.Equal(t, sqltypes.Binary, got.Type())
+			assert.Len(t, got.Raw(), 16)
+			assert.NotEqual(t, v.Raw(), got.Raw())
+		}
+		long := sqltypes.MakeTrusted(sqltypes.Blob, make([]byte, 100))
+		got, err := hash.Apply(long)
+		require.NoError(t, err)
+		assert.Len(t, got.Raw(), 100)
+	})
+
+	t.Run("integral", func(t *testing.T) {
+		for _, v := range []sqltypes.Value{
+			sqltypes.NewInt64(12345),
+			sqltypes.NewInt64(-12345),
+			sqltypes.NewInt64(-9223372036854775808),
+			sqltypes.NewInt8(127),
+			sqltypes.NewUint64(18446744073709551615),
+			sqltypes.NewInt64(0),
+		} {
+			got, err := hash.Apply(v)
+			require.NoError(t, err)
+			assert.Equal(t, v.Type(), got.Type())
+			if sqltypes.IsUnsigned(v.Type()) {
+				_, err := got.ToUint64()
+				require.NoError(t, err)
+				continue
+			}
+			want, _ := v.ToInt64()
+			n, err := got.ToInt64()
+			require.NoError(t, err, got.ToString())
+			if want >= 0 {
+				assert.True(t, n >= 0 && n <= want, "%d masked to %d", want, n)
+			} else {
+				assert.True(t, n <= 0 && n >= want, "%d masked to %d", want, n)
+			}
+		}
+	})
+
+	t.Run("decimal", func(t *testing.T) {
+		got, err := fake.Apply(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-123.45")))
+		require.NoError(t, err)
+		assert.Regexp(t, `^-[0-9]{3}\.[0-9]{2}$`, got.ToString())
+
+		got, err = hash.Apply(sqltypes.NewFloat64(1.5e+300))
+		require.NoError(t, err)
+		f, err := strconv.ParseFloat(got.ToString(), 64)
+		require.NoError(t, err)
+		assert.Regexp(t, `e\+300$`, strconv.FormatFloat(f, 'g', -1, 64))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := hash.Apply(sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-01 00:00:00")))
+		assert.EqualError(t, err, "cannot hash a value of type DATETIME")
+		_, err = fake.Apply(sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte("{}")))
+		assert.EqualError(t, err, "cannot fake a value of type JSON")
+		_, err = hash.Apply(sqltypes.NewInt64(2021))
+		assert.NoError(t, err)
+		_, err = hash.Apply(sqltypes.MakeTrusted(sqltypes.Year, []byte("2021")))
+		assert.Error(t, err)
+		got, err := nullify.Apply(sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-01 00:00:00")))
+		require.NoError(t, err)
+		assert.True(t, got.IsNull())
+	})
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file contains the binlog server mode: a mysqlctld (or vttablet)
+// connects to the primary as a replica, and archives every binlog file
+// it receives into the backup storage. Archived binlogs can then be read
+// back as a stream of events, for point in time recovery or to serve
+// replicas without putting the load on the primary.
+
+const (
+	// binlogArchiveFileName is the name of the single file stored in
+	// each archived binlog handle.
+	binlogArchiveFileName = "binlog"
+
+	// binlogEventHeaderLen is the size of a v4 binlog event header.
+	binlogEventHeaderLen = 19
+
+	// binlogEventArtificialFlag is LOG_EVENT_ARTIFICIAL_F, set on events
+	// generated by the dump thread that are not part of any binlog file.
+	binlogEventArtificialFlag = 0x20
+
+	// binlogEventTypeRotate is ROTATE_EVENT.
+	binlogEventTypeRotate = 4
+
+	// binlogEventTypeHeartbeat is HEARTBEAT_LOG_EVENT.
+	binlogEventTypeHeartbeat = 27
+)
+
+var (
+	// binlogFileMagic is the header of every binlog file.
+	binlogFileMagic = []byte{0xfe, 'b', 'i', 'n'}
+
+	binlogServerFiles  = stats.NewCounter("BinlogServerArchivedFiles", "Number of binlog files archived by the binlog server")
+	binlogServerEvents = stats.NewCounter("BinlogServerArchivedEvents", "Number of binlog events archived by the binlog server")
+	binlogServerBytes  = stats.NewCounter("BinlogServerArchivedBytes", "Number of binlog bytes archived by the binlog server")
+	binlogServerErrors = stats.NewCounter("BinlogServerErrors", "Number of errors encountered while archiving binlogs")
+)
+
+// GetBinlogArchiveDir returns the backup storage directory holding the
+// archived binlogs of a shard. It is kept apart from GetBackupDir so
+// archived binlogs never show up as backups.
+func GetBinlogArchiveDir(keyspace, shard string) string {
+	return fmt.Sprintf("binlogs/%v/%v", keyspace, shard)
+}
+
+// BinlogServer archives binlogs streamed from a replication source into
+// the backup storage.
+type BinlogServer struct {
+	cp            dbconfigs.Connector
+	bs            backupstorage.BackupStorage
+	dir           string
+	serverID      uint32
+	retryInterval time.Duration
+}
+
+// NewBinlogServer returns a BinlogServer that streams binlogs from cp and
+// stores them under dir. serverID must be unique among all the replicas
+// of the source.
+func NewBinlogServer(cp dbconfigs.Connector, bs backupstorage.BackupStorage, dir string, serverID uint32, retryInterval time.Duration) *BinlogServer {
+	return &BinlogServer{
+		cp:            cp,
+		bs:            bs,
+		dir:           dir,
+		serverID:      serverID,
+		retryInterval: retryInterval,
+	}
+}
+
+// Run archives binlogs until the context is canceled. Errors are logged
+// and the stream is re-established after the retry interval, resuming
+// from the last completely archived binlog file.
+func (bls *BinlogServer) Run(ctx context.Context) {
+	for {
+		err := bls.archive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		binlogServerErrors.Add(1)
+		log.Errorf("binlog server: archiving from source failed, retrying in %v: %v", bls.retryInterval, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(bls.retryInterval):
+		}
+	}
+}
+
+// archive runs a single binlog dump from the source.
+func (bls *BinlogServer) archive(ctx context.Context) error {
+	archived, err := ListArchivedBinlogs(ctx, bls.bs, bls.dir)
+	if err != nil {
+		return err
+	}
+
+	conn, err := bls.cp.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// Unblock ReadBinlogEvent when the context is canceled.
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if _, err := conn.ExecuteFetch("SET @master_binlog_checksum=@@global.binlog_checksum", 0, false); err != nil {
+		return vterrors.Wrap(err, "failed to set @master_binlog_checksum")
+	}
+	qr, err := conn.ExecuteFetch("SELECT @master_binlog_checksum", 1, false)
+	if err != nil {
+		return vterrors.Wrap(err, "failed to read @master_binlog_checksum")
+	}
+	if len(qr.Rows) != 1 {
+		return fmt.Errorf("binlog server: unexpected result for @master_binlog_checksum: %v", qr.Rows)
+	}
+	checksum := qr.Rows[0][0].ToString() != "NONE"
+
+	startFile, err := bls.startFile(conn, archived)
+	if err != nil {
+		return err
+	}
+	// We always start at the beginning of a file, so its content is
+	// archived as a whole. Skipping the already archived file is handled
+	// by the writer.
+	log.Infof("binlog server: starting binlog dump from %v, serverID=%v", startFile, bls.serverID)
+	if err := conn.WriteComBinlogDump(bls.serverID, startFile, 4, 0); err != nil {
+		return vterrors.Wrap(err, "failed to send the ComBinlogDump command")
+	}
+
+	w := newBinlogArchiveWriter(bls.bs, bls.dir, checksum, archived)
+	defer w.abort(ctx)
+	for {
+		ev, err := conn.ReadBinlogEvent()
+		if err != nil {
+			return err
+		}
+		raw, ok := ev.(interface{ Bytes() []byte })
+		if !ok || !ev.IsValid() {
+			return fmt.Errorf("binlog server: received an invalid binlog event")
+		}
+		if err := w.writeEvent(ctx, raw.Bytes()); err != nil {
+			return err
+		}
+	}
+}
+
+// startFile returns the binlog file to start dumping from: the last
+// archived one if any, or the oldest binlog of the source.
+func (bls *BinlogServer) startFile(conn *mysql.Conn, archived []string) (string, error) {
+	if len(archived) > 0 {
+		return archived[len(archived)-1], nil
+	}
+	qr, err := conn.ExecuteFetch("SHOW BINARY LOGS", 10000, false)
+	if err != nil {
+		return "", vterrors.Wrap(err, "failed to SHOW BINARY LOGS")
+	}
+	if len(qr.Rows) == 0 {
+		return "", fmt.Errorf("binlog server: source has no binary logs")
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
+
+// binlogArchiveWriter splits a raw binlog dump stream into files, and
+// stores each one as a separate handle in the backup storage. A file is
+// only finalized when the source rotates to the next one, so an archived
+// binlog is always complete.
+type binlogArchiveWriter struct {
+	bs       backupstorage.BackupStorage
+	dir      string
+	checksum bool
+	archived map[string]bool
+
+	// current is the binlog file being received, skip is true if it was
+	// already archived.
+	current string
+	skip    bool
+	handle  backupstorage.BackupHandle
+	wc      io.WriteCloser
+}
+
+func newBinlogArchiveWriter(bs backupstorage.BackupStorage, dir string, checksum bool, archived []string) *binlogArchiveWriter {
+	w := &binlogArchiveWriter{
+		bs:       bs,
+		dir:      dir,
+		checksum: checksum,
+		archived: make(map[string]bool, len(archived)),
+	}
+	for _, name := range archived {
+		w.archived[name] = true
+	}
+	return w
+}
+
+// writeEvent processes one raw event, as received from the dump stream.
+func (w *binlogArchiveWriter) writeEvent(ctx context.Context, buf []byte) error {
+	if len(buf) < binlogEventHeaderLen {
+		return fmt.Errorf("binlog server: event too short: %v bytes", len(buf))
+	}
+	typ := buf[4]
+	flags := binary.LittleEndian.Uint16(buf[17:19])
+
+	if typ == binlogEventTypeHeartbeat {
+		return nil
+	}
+	if typ == binlogEventTypeRotate {
+		name, err := parseRotateEventFileName(buf, w.checksum)
+		if err != nil {
+			return err
+		}
+		// A real rotate event is the last event of the current file.
+		if flags&binlogEventArtificialFlag == 0 {
+			if err := w.writeRaw(buf); err != nil {
+				return err
+			}
+		}
+		if name == w.current {
+			return nil
+		}
+		if err := w.finish(ctx); err != nil {
+			return err
+		}
+		return w.start(ctx, name)
+	}
+	if flags&binlogEventArtificialFlag != 0 {
+		return nil
+	}
+	return w.writeRaw(buf)
+}
+
+// start begins archiving a new binlog file.
+func (w *binlogArchiveWriter) start(ctx context.Context, name string) error {
+	w.current = name
+	w.skip = w.archived[name]
+	if w.skip {
+		log.Infof("binlog server: %v is already archived, skipping it", name)
+		return nil
+	}
+	handle, err := w.bs.StartBackup(ctx, w.dir, name)
+	if err != nil {
+		return vterrors.Wrapf(err, "StartBackup failed for binlog %v", name)
+	}
+	wc, err := handle.AddFile(ctx, binlogArchiveFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		handle.AbortBackup(ctx)
+		return vterrors.Wrapf(err, "AddFile failed for binlog %v", name)
+	}
+	if _, err := wc.Write(binlogFileMagic); err != nil {
+		wc.Close()
+		handle.AbortBackup(ctx)
+		return err
+	}
+	w.handle = handle
+	w.wc = wc
+	return nil
+}
+
+// writeRaw appends an event to the current file.
+func (w *binlogArchiveWriter) writeRaw(buf []byte) error {
+	if w.skip || w.wc == nil {
+		return nil
+	}
+	if _, err := w.wc.Write(buf); err != nil {
+		return vterrors.Wrapf(err, "failed to write binlog %v", w.current)
+	}
+	binlogServerEvents.Add(1)
+	binlogServerBytes.Add(int64(len(buf)))
+	return nil
+}
+
+// finish completes the current file, if any.
+func (w *binlogArchiveWriter) finish(ctx context.Context) error {
+	if w.handle == nil {
+		return nil
+	}
+	handle, wc := w.handle, w.wc
+	w.handle, w.wc = nil, nil
+	if err := wc.Close(); err != nil {
+		handle.AbortBackup(ctx)
+		return vterrors.Wrapf(err, "failed to close binlog %v", w.current)
+	}
+	if err := handle.EndBackup(ctx); err != nil {
+		return vterrors.Wrapf(err, "EndBackup failed for binlog %v", w.current)
+	}
+	w.archived[w.current] = true
+	binlogServerFiles.Add(1)
+	log.Infof("binlog server: archived binlog %v", w.current)
+	return nil
+}
+
+// abort discards the partially received file, if any. It will be
+// archived again from its beginning on the next run.
+func (w *binlogArchiveWriter) abort(ctx context.Context) {
+	if w.handle == nil {
+		return
+	}
+	w.wc.Close()
+	if err := w.handle.AbortBackup(ctx); err != nil {
+		log.Warningf("binlog server: failed to abort partial binlog %v: %v", w.current, err)
+	}
+	w.handle, w.wc = nil, nil
+}
+
+// parseRotateEventFileName returns the name of the next binlog file from a
+// raw ROTATE_EVENT.
+func parseRotateEventFileName(buf []byte, hasChecksum bool) (string, error) {
+	end := len(buf)
+	if hasChecksum {
+		end -= 4
+	}
+	// The body has an 8 bytes position before the file name.
+	start := binlogEventHeaderLen + 8
+	if end <= start {
+		return "", fmt.Errorf("binlog server: rotate event too short: %v bytes", len(buf))
+	}
+	return string(buf[start:end]), nil
+}
+
+// ListArchivedBinlogs returns the names of the binlogs archived in dir,
+// oldest first.
+func ListArchivedBinlogs(ctx context.Context, bs backupstorage.BackupStorage, dir string) ([]string, error) {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	names := make([]string, 0, len(bhs))
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	return names, nil
+}
+
+// ReadArchivedBinlogs streams the events of the binlogs archived in dir,
+// starting with the file named from (or the oldest one if empty), and
+// calls send for each of them in order.
+func ReadArchivedBinlogs(ctx context.Context, bs backupstorage.BackupStorage, dir, from string, send func(name string, ev mysql.BinlogEvent) error) error {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, bh := range bhs {
+		if bh.Name() < from {
+			continue
+		}
+		if err := readArchivedBinlog(ctx, bh, send); err != nil {
+			return vterrors.Wrapf(err, "failed to read archived binlog %v", bh.Name())
+		}
+	}
+	return nil
+}
+
+func readArchivedBinlog(ctx context.Context, bh backupstorage.BackupHandle, send func(name string, ev mysql.BinlogEvent) error) error {
+	rc, err := bh.ReadFile(ctx, binlogArchiveFileName)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	r := bufio.NewReader(rc)
+
+	magic := make([]byte, len(binlogFileMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return err
+	}
+	if string(magic) != string(binlogFileMagic) {
+		return fmt.Errorf("not a binlog file")
+	}
+	header := make([]byte, binlogEventHeaderLen)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		length := binary.LittleEndian.Uint32(header[9:13])
+		if length < binlogEventHeaderLen {
+			return fmt.Errorf("invalid event length %v", length)
+		}
+		buf := make([]byte, length)
+		copy(buf, header)
+		if _, err := io.ReadFull(r, buf[binlogEventHeaderLen:]); err != nil {
+			return err
+		}
+		if err := send(bh.Name(), mysql.NewMysql56BinlogEvent(buf)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the serving side of the binlog server mode: the
+// archived binlogs are served to replicas over the MySQL replication
+// protocol, so they can replicate from the binlog server instead of the
+// primary.
+//
+// Replicas must use file and position replication
+// (CHANGE MASTER TO ... MASTER_AUTO_POSITION=0), COM_BINLOG_DUMP_GTID is
+// not supported. Since only complete binlog files are archived, the
+// replicas lag behind the source by up to one binlog file.
+
+const (
+	// binlogEventTypeFormatDescription is FORMAT_DESCRIPTION_EVENT.
+	binlogEventTypeFormatDescription = 15
+
+	// binlogEventTypeGTID is GTID_LOG_EVENT.
+	binlogEventTypeGTID = 33
+
+	// binlogEventTypeAnonymousGTID is ANONYMOUS_GTID_LOG_EVENT.
+	binlogEventTypeAnonymousGTID = 34
+
+	// binlogFormatDescriptionCreatedOffset is the offset of the created
+	// timestamp in a FORMAT_DESCRIPTION_EVENT: after the header, the
+	// binlog version and the server version.
+	binlogFormatDescriptionCreatedOffset = binlogEventHeaderLen + 2 + 50
+)
+
+var binlogServerReplicas = stats.NewGauge("BinlogServerReplicas", "Number of replicas streaming from the binlog server")
+
+// binlogServerConn is the state of a replica connection, stored in its
+// ClientData.
+type binlogServerConn struct {
+	heartbeatPeriod time.Duration
+}
+
+// BinlogServerHandler is a mysql.Handler that serves the binlogs archived
+// by a BinlogServer to replicas.
+type BinlogServerHandler struct {
+	bs           backupstorage.BackupStorage
+	dir          string
+	serverID     uint32
+	pollInterval time.Duration
+}
+
+// NewBinlogServerHandler returns a BinlogServerHandler serving the binlogs
+// archived under dir. serverID is the server id it reports to replicas,
+// and must differ from theirs. pollInterval is how often the backup
+// storage is checked for newly archived binlogs.
+func NewBinlogServerHandler(bs backupstorage.BackupStorage, dir string, serverID uint32, pollInterval time.Duration) *BinlogServerHandler {
+	return &BinlogServerHandler{
+		bs:           bs,
+		dir:          dir,
+		serverID:     serverID,
+		pollInterval: pollInterval,
+	}
+}
+
+// NewConnection is part of the mysql.Handler interface.
+func (h *BinlogServerHandler) NewConnection(c *mysql.Conn) {
+	c.ClientData = &binlogServerConn{}
+}
+
+// ConnectionClosed is part of the mysql.Handler interface.
+func (h *BinlogServerHandler) ConnectionClosed(c *mysql.Conn) {
+}
+
+// ComQuery is part of the mysql.Handler interface. It only answers the
+// queries a replica runs against its source before requesting a dump.
+func (h *BinlogServerHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	q := strings.ToLower(strings.TrimSpace(query))
+	switch {
+	case strings.HasPrefix(q, "set "):
+		// Other session settings don't change the dump stream.
+		h.setUserVariable(c, q)
+		return callback(&sqltypes.Result{})
+	case q == "select unix_timestamp()":
+		return callback(singleValueResult("UNIX_TIMESTAMP()", sqltypes.NewInt64(time.Now().Unix())))
+	case q == "select @@global.server_id":
+		return callback(singleValueResult("@@GLOBAL.SERVER_ID", sqltypes.NewUint64(uint64(h.serverID))))
+	case q == "show variables like 'server_id'":
+		return callback(&sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Name: "Variable_name", Type: sqltypes.VarChar},
+				{Name: "Value", Type: sqltypes.VarChar},
+			},
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarChar("server_id"),
+				sqltypes.NewVarChar(strconv.FormatUint(uint64(h.serverID), 10)),
+			}},
+		})
+	case q == "select @@global.server_uuid":
+		return callback(singleValueResult("@@GLOBAL.SERVER_UUID", sqltypes.NewVarChar(h.serverUUID())))
+	case q == "select @master_binlog_checksum", q == "select @source_binlog_checksum":
+		checksum, _, err := h.archivedBinlogInfo(context.Background())
+		if err != nil {
+			return err
+		}
+		return callback(singleValueResult(q[len("select "):], sqltypes.NewVarChar(checksum)))
+	case q == "select @@global.gtid_mode":
+		_, gtidMode, err := h.archivedBinlogInfo(context.Background())
+		if err != nil {
+			return err
+		}
+		return callback(singleValueResult("@@GLOBAL.GTID_MODE", sqltypes.NewVarChar(gtidMode)))
+	case strings.HasPrefix(q, "select @@"):
+		return mysql.NewSQLError(mysql.ERUnknownSystemVariable, mysql.SSUnknownSQLState, "binlog server: unknown system variable in %v", query)
+	}
+	return mysql.NewSQLError(mysql.ERNotSupportedYet, mysql.SSUnknownSQLState, "binlog server: unsupported query %v", query)
+}
+
+// setUserVariable records the user variables a replica sets that change
+// the dump stream.
+func (h *BinlogServerHandler) setUserVariable(c *mysql.Conn, q string) {
+	parts := strings.SplitN(strings.TrimPrefix(q, "set @"), "=", 2)
+	if !strings.HasPrefix(q, "set @") || len(parts) != 2 {
+		return
+	}
+	name := strings.TrimSpace(parts[0])
+	value := strings.Trim(strings.TrimSpace(parts[1]), "'\"")
+	switch name {
+	case "master_heartbeat_period", "source_heartbeat_period":
+		// The period is in nanoseconds.
+		period, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			log.Warningf("binlog server: invalid heartbeat period from %v: %v", c, value)
+			return
+		}
+		c.ClientData.(*binlogServerConn).heartbeatPeriod = time.Duration(period)
+	}
+}
+
+// ComPrepare is part of the mysql.Handler interface.
+func (h *BinlogServerHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	return nil, mysql.NewSQLError(mysql.ERNotSupportedYet, mysql.SSUnknownSQLState, "binlog server: prepared statements are not supported")
+}
+
+// ComStmtExecute is part of the mysql.Handler interface.
+func (h *BinlogServerHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	return mysql.NewSQLError(mysql.ERNotSupportedYet, mysql.SSUnknownSQLState, "binlog server: prepared statements are not supported")
+}
+
+// WarningCount is part of the mysql.Handler interface.
+func (h *BinlogServerHandler) WarningCount(c *mysql.Conn) uint16 {
+	return 0
+}
+
+// ComResetConnection is part of the mysql.Handler interface.
+func (h *BinlogServerHandler) ComResetConnection(c *mysql.Conn) {
+}
+
+// ComRegisterReplica is part of the mysql.BinlogDumpHandler interface.
+func (h *BinlogServerHandler) ComRegisterReplica(c *mysql.Conn, serverID uint32) error {
+	if serverID == h.serverID {
+		return mysql.NewSQLError(mysql.ERMasterFatalReadingBinlog, mysql.SSUnknownSQLState, "binlog server: replica has the same server id %v as the binlog server", serverID)
+	}
+	return nil
+}
+
+// ComBinlogDump is part of the mysql.BinlogDumpHandler interface. It
+// streams the archived binlogs from logFile, then waits for the next
+// ones to be archived, sending heartbeats in the meantime.
+func (h *BinlogServerHandler) ComBinlogDump(c *mysql.Conn, serverID uint32, logFile string, binlogPos uint32) error {
+	binlogServerReplicas.Add(1)
+	defer binlogServerReplicas.Add(-1)
+	log.Infof("binlog server: replica %v (server id %v) requested a dump from %v:%v", c, serverID, logFile, binlogPos)
+
+	ctx := context.Background()
+	checksumAlg, _, err := h.archivedBinlogInfo(ctx)
+	if err != nil {
+		return err
+	}
+	checksum := checksumAlg == "CRC32"
+	names, err := ListArchivedBinlogs(ctx, h.bs, h.dir)
+	if err != nil {
+		return err
+	}
+	if logFile == "" {
+		logFile = names[0]
+		binlogPos = 4
+	}
+
+	// current and pos are the coordinates reported in heartbeats while
+	// waiting for a binlog to be archived.
+	current, pos := logFile, binlogPos
+	for {
+		bh, err := h.waitForArchivedBinlog(ctx, c, current, pos, checksum, logFile)
+		if err != nil {
+			return err
+		}
+		if current == logFile && bh.Name() != logFile {
+			return mysql.NewSQLError(mysql.ERMasterFatalReadingBinlog, mysql.SSUnknownSQLState, "binlog server: binlog %v is not archived", logFile)
+		}
+		end, err := h.sendArchivedBinlog(ctx, c, bh, binlogPos, checksum)
+		if err != nil {
+			return err
+		}
+		current, pos = bh.Name(), end
+		// The following files are sent from their beginning.
+		logFile = bh.Name() + "\x00"
+		binlogPos = 4
+	}
+}
+
+// waitForArchivedBinlog returns the oldest archived binlog whose name is
+// from or after, waiting for it to be archived if needed.
+func (h *BinlogServerHandler) waitForArchivedBinlog(ctx context.Context, c *mysql.Conn, current string, pos uint32, checksum bool, from string) (backupstorage.BackupHandle, error) {
+	interval := h.pollInterval
+	if period := c.ClientData.(*binlogServerConn).heartbeatPeriod; period > 0 && period < interval {
+		interval = period
+	}
+	for {
+		bhs, err := h.bs.ListBackups(ctx, h.dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, bh := range bhs {
+			if bh.Name() >= from {
+				return bh, nil
+			}
+		}
+		// Heartbeats keep the replica from timing out, and detect when
+		// it went away.
+		time.Sleep(interval)
+		if err := c.WriteBinlogEvent(h.makeHeartbeatEvent(current, pos, checksum)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sendArchivedBinlog sends the events of an archived binlog from pos,
+// preceded by a rotate event and its format description, like mysqld.
+// It returns the position of the end of the file.
+func (h *BinlogServerHandler) sendArchivedBinlog(ctx context.Context, c *mysql.Conn, bh backupstorage.BackupHandle, pos uint32, checksum bool) (uint32, error) {
+	if err := c.WriteBinlogEvent(h.makeRotateEvent(bh.Name(), uint64(pos), checksum)); err != nil {
+		return 0, err
+	}
+	offset := uint32(len(binlogFileMagic))
+	err := readArchivedBinlog(ctx, bh, func(name string, ev mysql.BinlogEvent) error {
+		buf := ev.(interface{ Bytes() []byte }).Bytes()
+		evOffset := offset
+		offset += uint32(len(buf))
+		switch {
+		case buf[4] == binlogEventTypeFormatDescription:
+			if pos > evOffset {
+				// The replica must not rewind its position to the end of
+				// the format description, nor drop its temporary tables.
+				f, err := ev.Format()
+				if err != nil {
+					return err
+				}
+				buf = append([]byte(nil), buf...)
+				binary.LittleEndian.PutUint32(buf[13:17], 0)
+				binary.LittleEndian.PutUint32(buf[binlogFormatDescriptionCreatedOffset:], 0)
+				setBinlogEventChecksum(buf, f.ChecksumAlgorithm == mysql.BinlogChecksumAlgCRC32)
+			}
+		case evOffset < pos:
+			if offset > pos {
+				return mysql.NewSQLError(mysql.ERMasterFatalReadingBinlog, mysql.SSUnknownSQLState, "binlog server: position %v is not an event boundary in %v", pos, name)
+			}
+			return nil
+		}
+		return c.WriteBinlogEvent(buf)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if offset < pos {
+		return 0, mysql.NewSQLError(mysql.ERMasterFatalReadingBinlog, mysql.SSUnknownSQLState, "binlog server: position %v is past the end of %v", pos, bh.Name())
+	}
+	return offset, nil
+}
+
+// archivedBinlogInfo returns the checksum algorithm of the archived
+// binlogs, as @master_binlog_checksum, and the GTID mode of their source,
+// as @@global.gtid_mode. Both are read from the newest archived binlog.
+func (h *BinlogServerHandler) archivedBinlogInfo(ctx context.Context) (checksum, gtidMode string, err error) {
+	bhs, err := h.bs.ListBackups(ctx, h.dir)
+	if err != nil {
+		return "", "", err
+	}
+	if len(bhs) == 0 {
+		return "", "", mysql.NewSQLError(mysql.ERMasterFatalReadingBinlog, mysql.SSUnknownSQLState, "binlog server: no binlog archived yet")
+	}
+	errDone := fmt.Errorf("done")
+	gtidMode = "OFF"
+	err = readArchivedBinlog(ctx, bhs[len(bhs)-1], func(name string, ev mysql.BinlogEvent) error {
+		buf := ev.(interface{ Bytes() []byte }).Bytes()
+		switch buf[4] {
+		case binlogEventTypeFormatDescription:
+			f, err := ev.Format()
+			if err != nil {
+				return err
+			}
+			checksum = "NONE"
+			if f.ChecksumAlgorithm == mysql.BinlogChecksumAlgCRC32 {
+				checksum = "CRC32"
+			}
+		case binlogEventTypeGTID:
+			gtidMode = "ON"
+			return errDone
+		case binlogEventTypeAnonymousGTID:
+			return errDone
+		}
+		return nil
+	})
+	if err != nil && err != errDone {
+		return "", "", err
+	}
+	if checksum == "" {
+		return "", "", fmt.Errorf("binlog server: %v has no format description event", bhs[len(bhs)-1].Name())
+	}
+	return checksum, gtidMode, nil
+}
+
+// serverUUID returns the server uuid reported to replicas, derived from
+// the server id.
+func (h *BinlogServerHandler) serverUUID() string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012x", h.serverID)
+}
+
+// makeRotateEvent returns the artificial ROTATE_EVENT sent before the
+// events of each binlog file.
+func (h *BinlogServerHandler) makeRotateEvent(name string, pos uint64, checksum bool) []byte {
+	body := make([]byte, 8+len(name))
+	binary.LittleEndian.PutUint64(body, pos)
+	copy(body[8:], name)
+	return h.makeArtificialEvent(binlogEventTypeRotate, 0, body, checksum)
+}
+
+// makeHeartbeatEvent returns a HEARTBEAT_LOG_EVENT for the given
+// coordinates.
+func (h *BinlogServerHandler) makeHeartbeatEvent(name string, pos uint32, checksum bool) []byte {
+	return h.makeArtificialEvent(binlogEventTypeHeartbeat, pos, []byte(name), checksum)
+}
+
+func (h *BinlogServerHandler) makeArtificialEvent(typ byte, logPos uint32, body []byte, checksum bool) []byte {
+	length := binlogEventHeaderLen + len(body)
+	if checksum {
+		length += 4
+	}
+	buf := make([]byte, length)
+	buf[4] = typ
+	binary.LittleEndian.PutUint32(buf[5:9], h.serverID)
+	binary.LittleEndian.PutUint32(buf[9:13], uint32(length))
+	binary.LittleEndian.PutUint32(buf[13:17], logPos)
+	binary.LittleEndian.PutUint16(buf[17:19], binlogEventArtificialFlag)
+	copy(buf[binlogEventHeaderLen:], body)
+	setBinlogEventChecksum(buf, checksum)
+	return buf
+}
+
+// setBinlogEventChecksum computes the CRC32 of an event in its last 4
+// bytes, if checksums are enabled.
+func setBinlogEventChecksum(buf []byte, checksum bool) {
+	if !checksum {
+		return
+	}
+	end := len(buf) - 4
+	binary.LittleEndian.PutUint32(buf[end:], crc32.ChecksumIEEE(buf[:end]))
+}
+
+func singleValueResult(name string, value sqltypes.Value) *sqltypes.Result {
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{{Name: name, Type: value.Type()}},
+		Rows:   [][]sqltypes.Value{{value}},
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func rawBinlogEvent(ev mysql.BinlogEvent) []byte {
+	return ev.(interface{ Bytes() []byte }).Bytes()
+}
+
+func TestBinlogServerHandler(t *testing.T) {
+	root := t.TempDir()
+	savedRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = root
+	defer func() { *filebackupstorage.FileBackupStorageRoot = savedRoot }()
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	dir := GetBinlogArchiveDir("ks", "0")
+	require.NoError(t, os.MkdirAll(root+"/"+dir, os.ModePerm))
+
+	fde := rawBinlogEvent(mysql.NewFormatDescriptionEvent(mysql.NewMySQL56BinlogFormat(), mysql.NewFakeBinlogStream()))
+	query1 := makeBinlogEvent(2, 0, []byte("query1"))
+	query2 := makeBinlogEvent(2, 0, []byte("query2"))
+	rotate2 := makeRotateEvent(0, "vt-bin.000002")
+	rotate3 := makeRotateEvent(0, "vt-bin.000003")
+	w := newBinlogArchiveWriter(bs, dir, true, nil)
+	for _, ev := range [][]byte{
+		makeRotateEvent(binlogEventArtificialFlag, "vt-bin.000001"),
+		fde,
+		query1,
+		rotate2,
+		fde,
+		query2,
+		rotate3,
+	} {
+		require.NoError(t, w.writeEvent(ctx, ev))
+	}
+	w.abort(ctx)
+
+	h := NewBinlogServerHandler(bs, dir, 1, 10*time.Millisecond)
+	l, err := mysql.NewListener("tcp", "127.0.0.1:", mysql.NewAuthServerNone(), h, 0, 0, false)
+	require.NoError(t, err)
+	defer l.Close()
+	go l.Accept()
+
+	conn, err := mysql.Connect(ctx, &mysql.ConnParams{
+		Host:  "127.0.0.1",
+		Port:  l.Addr().(*net.TCPAddr).Port,
+		Uname: "user",
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The replica handshake.
+	_, err = conn.ExecuteFetch("SET @master_heartbeat_period= 1000000", 0, false)
+	require.NoError(t, err)
+	qr, err := conn.ExecuteFetch("SELECT @master_binlog_checksum", 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, "CRC32", qr.Rows[0][0].ToString())
+	qr, err = conn.ExecuteFetch("SELECT @@GLOBAL.SERVER_ID", 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, "1", qr.Rows[0][0].ToString())
+	qr, err = conn.ExecuteFetch("SELECT @@GLOBAL.GTID_MODE", 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, "OFF", qr.Rows[0][0].ToString())
+	_, err = conn.ExecuteFetch("SELECT @@GLOBAL.UNKNOWN", 1, false)
+	assert.Error(t, err)
+
+	// Dump from query1: the format description is sent first, without
+	// its position.
+	pos := uint32(len(binlogFileMagic) + len(fde))
+	require.NoError(t, conn.WriteComBinlogDump(100, "vt-bin.000001", pos, 0))
+	readEvent := func() []byte {
+		ev, err := conn.ReadBinlogEvent()
+		require.NoError(t, err)
+		return rawBinlogEvent(ev)
+	}
+	ev := readEvent()
+	name, err := parseRotateEventFileName(ev, true)
+	require.NoError(t, err)
+	assert.Equal(t, "vt-bin.000001", name)
+	assert.EqualValues(t, pos, binary.LittleEndian.Uint64(ev[binlogEventHeaderLen:]))
+	ev = readEvent()
+	assert.EqualValues(t, binlogEventTypeFormatDescription, ev[4])
+	assert.EqualValues(t, 0, binary.LittleEndian.Uint32(ev[13:17]))
+	assert.Equal(t, query1, readEvent())
+	assert.Equal(t, rotate2, readEvent())
+
+	// The next file is sent from its beginning.
+	name, err = parseRotateEventFileName(readEvent(), true)
+	require.NoError(t, err)
+	assert.Equal(t, "vt-bin.000002", name)
+	assert.Equal(t, fde, readEvent())
+	assert.Equal(t, query2, readEvent())
+	assert.Equal(t, rotate3, readEvent())
+
+	// vt-bin.000003 is not archived yet.
+	ev = readEvent()
+	assert.EqualValues(t, binlogEventTypeHeartbeat, ev[4])
+	assert.Equal(t, "vt-bin.000002", string(ev[binlogEventHeaderLen:len(ev)-4]))
+
+	// Binlogs that are not archived can't be dumped.
+	conn2, err := mysql.Connect(ctx, &mysql.ConnParams{
+		Host:  "127.0.0.1",
+		Port:  l.Addr().(*net.TCPAddr).Port,
+		Uname: "user",
+	})
+	require.NoError(t, err)
+	defer conn2.Close()
+	require.NoError(t, conn2.WriteComBinlogDump(101, "vt-bin.000000", 4, 0))
+	_, err = conn2.ReadBinlogEvent()
+	assert.EqualError(t, err, "binlog server: binlog vt-bin.000000 is not archived (errno 1236) (sqlstate HY000)")
+
+	// The dumps end when the replicas go away.
+	conn.Close()
+	conn2.Close()
+	for binlogServerReplicas.Get() != 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMakeArtificialEvents(t *testing.T) {
+	h := NewBinlogServerHandler(nil, "", 1, time.Second)
+
+	rotate := h.makeRotateEvent("vt-bin.000002", 4, true)
+	name, err := parseRotateEventFileName(rotate, true)
+	require.NoError(t, err)
+	assert.Equal(t, "vt-bin.000002", name)
+	ev := mysql.NewMysql56BinlogEvent(rotate)
+	require.True(t, ev.IsValid())
+	assert.True(t, ev.IsRotate())
+	assert.EqualValues(t, binlogEventArtificialFlag, binary.LittleEndian.Uint16(rotate[17:19]))
+	assert.EqualValues(t, 0, binary.LittleEndian.Uint32(rotate[13:17]))
+
+	heartbeat := h.makeHeartbeatEvent("vt-bin.000002", 120, false)
+	assert.EqualValues(t, binlogEventTypeHeartbeat, heartbeat[4])
+	assert.EqualValues(t, 120, binary.LittleEndian.Uint32(heartbeat[13:17]))
+	assert.Equal(t, "vt-bin.000002", string(heartbeat[binlogEventHeaderLen:]))
+}
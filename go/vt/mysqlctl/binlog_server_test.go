@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// makeBinlogEvent builds a raw v4 event of the given type, with a 4 bytes
+// checksum.
+func makeBinlogEvent(typ byte, flags uint16, body []byte) []byte {
+	buf := make([]byte, binlogEventHeaderLen+len(body)+4)
+	buf[4] = typ
+	binary.LittleEndian.PutUint32(buf[9:13], uint32(len(buf)))
+	binary.LittleEndian.PutUint16(buf[17:19], flags)
+	copy(buf[binlogEventHeaderLen:], body)
+	return buf
+}
+
+func makeRotateEvent(flags uint16, name string) []byte {
+	body := make([]byte, 8+len(name))
+	binary.LittleEndian.PutUint64(body, 4)
+	copy(body[8:], name)
+	return makeBinlogEvent(binlogEventTypeRotate, flags, body)
+}
+
+func TestParseRotateEventFileName(t *testing.T) {
+	name, err := parseRotateEventFileName(makeRotateEvent(0, "vt-bin.000002"), true)
+	require.NoError(t, err)
+	assert.Equal(t, "vt-bin.000002", name)
+
+	_, err = parseRotateEventFileName(make([]byte, binlogEventHeaderLen+4), true)
+	assert.Error(t, err)
+}
+
+func TestBinlogArchiveWriter(t *testing.T) {
+	root := t.TempDir()
+	savedRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = root
+	defer func() { *filebackupstorage.FileBackupStorageRoot = savedRoot }()
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	dir := GetBinlogArchiveDir("ks", "0")
+	require.NoError(t, os.MkdirAll(root+"/"+dir, os.ModePerm))
+
+	query1 := makeBinlogEvent(2, 0, []byte("query1"))
+	query2 := makeBinlogEvent(2, 0, []byte("query2"))
+	query3 := makeBinlogEvent(2, 0, []byte("query3"))
+	events := [][]byte{
+		makeRotateEvent(binlogEventArtificialFlag, "vt-bin.000001"),
+		query1,
+		makeBinlogEvent(binlogEventTypeHeartbeat, 0, nil),
+		makeRotateEvent(0, "vt-bin.000002"),
+		query2,
+		makeRotateEvent(0, "vt-bin.000003"),
+		// vt-bin.000003 is incomplete and must not be archived.
+		query3,
+	}
+	w := newBinlogArchiveWriter(bs, dir, true, nil)
+	for _, ev := range events {
+		require.NoError(t, w.writeEvent(ctx, ev))
+	}
+	w.abort(ctx)
+
+	names, err := ListArchivedBinlogs(ctx, bs, dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vt-bin.000001", "vt-bin.000002"}, names)
+
+	// Restarting from the last archived file skips its content.
+	w = newBinlogArchiveWriter(bs, dir, true, names)
+	for _, ev := range [][]byte{
+		makeRotateEvent(binlogEventArtificialFlag, "vt-bin.000002"),
+		query2,
+		makeRotateEvent(0, "vt-bin.000003"),
+		query3,
+		makeRotateEvent(0, "vt-bin.000004"),
+	} {
+		require.NoError(t, w.writeEvent(ctx, ev))
+	}
+	w.abort(ctx)
+
+	var got []string
+	err = ReadArchivedBinlogs(ctx, bs, dir, "vt-bin.000002", func(name string, ev mysql.BinlogEvent) error {
+		require.True(t, ev.IsValid())
+		got = append(got, name)
+		return nil
+	})
+	require.NoError(t, err)
+	// query2 + rotate, query3 + rotate.
+	assert.Equal(t, []string{"vt-bin.000002", "vt-bin.000002", "vt-bin.000003", "vt-bin.000003"}, got)
+}
@@ -32,6 +32,7 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"net/url"
 	"os"
 	"sort"
 	"strings"
@@ -49,6 +50,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 
+	"vitess.io/vitess/go/flagutil"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 )
@@ -80,6 +82,18 @@ var (
 	// sse is the server-side encryption algorithm used when storing this object in S3
 	sse = flag.String("s3_backup_server_side_encryption", "", "server-side encryption algorithm (e.g., AES256, aws:kms, sse_c:/path/to/key/file)")
 
+	// sseKMSKeyID is the KMS key used when sse is aws:kms. If empty, S3 uses the default KMS key of the account.
+	sseKMSKeyID = flag.String("s3_backup_server_side_encryption_kms_key_id", "", "KMS key id to use with -s3_backup_server_side_encryption=aws:kms (the account default key is used if empty)")
+
+	// storageClass is the storage class of the backup objects. If empty, the bucket default is used.
+	storageClass = flag.String("s3_backup_storage_class", "", "storage class of the backup objects (e.g., STANDARD, STANDARD_IA, ONEZONE_IA, INTELLIGENT_TIERING, GLACIER_IR); uses the bucket default if empty")
+
+	// objectTags are added to every object of a backup.
+	objectTags flagutil.StringMapValue
+
+	// requesterPays must be set to access buckets configured with requester pays.
+	requesterPays = flag.Bool("s3_backup_requester_pays", false, "acknowledge that the requester pays for requests to the bucket, required for requester pays buckets")
+
 	// path component delimiter
 	delimiter = "/"
 )
@@ -90,6 +104,18 @@ var logNameMap logNameToLogLevel
 
 const sseCustomerPrefix = "sse_c:"
 
+// validStorageClasses are the storage classes accepted by -s3_backup_storage_class.
+// Archive classes that need a restore before being read (GLACIER, DEEP_ARCHIVE)
+// are not allowed, since a backup must be readable to be restored.
+var validStorageClasses = map[string]bool{
+	s3.StorageClassStandard:           true,
+	s3.StorageClassReducedRedundancy:  true,
+	s3.StorageClassStandardIa:         true,
+	s3.StorageClassOnezoneIa:          true,
+	s3.StorageClassIntelligentTiering: true,
+	"GLACIER_IR":                      true,
+}
+
 // S3BackupHandle implements the backupstorage.BackupHandle interface.
 type S3BackupHandle struct {
 	client    s3iface.S3API
@@ -158,9 +184,13 @@ func (bh *S3BackupHandle) AddFile(ctx context.Context, filename string, filesize
 			Key:                  object,
 			Body:                 reader,
 			ServerSideEncryption: bh.bs.s3SSE.awsAlg,
+			SSEKMSKeyId:          bh.bs.s3SSE.awsKMSKeyID,
 			SSECustomerAlgorithm: bh.bs.s3SSE.customerAlg,
 			SSECustomerKey:       bh.bs.s3SSE.customerKey,
 			SSECustomerKeyMD5:    bh.bs.s3SSE.customerMd5,
+			StorageClass:         getStorageClass(),
+			Tagging:              getObjectTagging(),
+			RequestPayer:         getRequestPayer(),
 		})
 		if err != nil {
 			reader.CloseWithError(err)
@@ -200,6 +230,7 @@ func (bh *S3BackupHandle) ReadFile(ctx context.Context, filename string) (io.Rea
 		SSECustomerAlgorithm: bh.bs.s3SSE.customerAlg,
 		SSECustomerKey:       bh.bs.s3SSE.customerKey,
 		SSECustomerKeyMD5:    bh.bs.s3SSE.customerMd5,
+		RequestPayer:         getRequestPayer(),
 	})
 	if err != nil {
 		return nil, err
@@ -211,6 +242,7 @@ var _ backupstorage.BackupHandle = (*S3BackupHandle)(nil)
 
 type S3ServerSideEncryption struct {
 	awsAlg      *string
+	awsKMSKeyID *string
 	customerAlg *string
 	customerKey *string
 	customerMd5 *string
@@ -239,11 +271,19 @@ func (s3ServerSideEncryption *S3ServerSideEncryption) init() error {
 	} else if *sse != "" {
 		s3ServerSideEncryption.awsAlg = sse
 	}
+
+	if *sseKMSKeyID != "" {
+		if *sse != s3.ServerSideEncryptionAwsKms {
+			return fmt.Errorf("-s3_backup_server_side_encryption_kms_key_id requires -s3_backup_server_side_encryption=%v", s3.ServerSideEncryptionAwsKms)
+		}
+		s3ServerSideEncryption.awsKMSKeyID = sseKMSKeyID
+	}
 	return nil
 }
 
 func (s3ServerSideEncryption *S3ServerSideEncryption) reset() {
 	s3ServerSideEncryption.awsAlg = nil
+	s3ServerSideEncryption.awsKMSKeyID = nil
 	s3ServerSideEncryption.customerAlg = nil
 	s3ServerSideEncryption.customerKey = nil
 	s3ServerSideEncryption.customerMd5 = nil
@@ -273,9 +313,10 @@ func (bs *S3BackupStorage) ListBackups(ctx context.Context, dir string) ([]backu
 	log.Infof("objName: %v", searchPrefix)
 
 	query := &s3.ListObjectsV2Input{
-		Bucket:    bucket,
-		Delimiter: &delimiter,
-		Prefix:    searchPrefix,
+		Bucket:       bucket,
+		Delimiter:    &delimiter,
+		Prefix:       searchPrefix,
+		RequestPayer: getRequestPayer(),
 	}
 
 	var subdirs []string
@@ -339,8 +380,9 @@ func (bs *S3BackupStorage) RemoveBackup(ctx context.Context, dir, name string) e
 	}
 
 	query := &s3.ListObjectsV2Input{
-		Bucket: bucket,
-		Prefix: objName(dir, name),
+		Bucket:       bucket,
+		Prefix:       objName(dir, name),
+		RequestPayer: getRequestPayer(),
 	}
 
 	for {
@@ -363,6 +405,7 @@ func (bs *S3BackupStorage) RemoveBackup(ctx context.Context, dir, name string) e
 				Objects: objIds,
 				Quiet:   &quiet,
 			},
+			RequestPayer: getRequestPayer(),
 		})
 
 		if err != nil {
@@ -441,6 +484,10 @@ func (bs *S3BackupStorage) client() (*s3.S3, error) {
 			return nil, fmt.Errorf("-s3_backup_storage_bucket required")
 		}
 
+		if err := checkStorageClass(); err != nil {
+			return nil, err
+		}
+
 		if _, err := bs._client.HeadBucket(&s3.HeadBucketInput{Bucket: bucket}); err != nil {
 			return nil, err
 		}
@@ -452,6 +499,46 @@ func (bs *S3BackupStorage) client() (*s3.S3, error) {
 	return bs._client, nil
 }
 
+// checkStorageClass makes sure the configured storage class, if any, can be
+// used to store backups.
+func checkStorageClass() error {
+	if *storageClass != "" && !validStorageClasses[*storageClass] {
+		return fmt.Errorf("invalid -s3_backup_storage_class %v", *storageClass)
+	}
+	return nil
+}
+
+// getStorageClass returns the storage class of the backup objects, or nil to
+// use the bucket default.
+func getStorageClass() *string {
+	if *storageClass == "" {
+		return nil
+	}
+	return storageClass
+}
+
+// getObjectTagging returns the tags to add to the backup objects, URL encoded
+// as expected by S3, or nil if there are none.
+func getObjectTagging() *string {
+	if len(objectTags) == 0 {
+		return nil
+	}
+	tags := url.Values{}
+	for k, v := range objectTags {
+		tags.Set(k, v)
+	}
+	return aws.String(tags.Encode())
+}
+
+// getRequestPayer returns the request payer to use for all requests, or nil
+// if the bucket owner pays.
+func getRequestPayer() *string {
+	if !*requesterPays {
+		return nil
+	}
+	return aws.String(s3.RequestPayerRequester)
+}
+
 func objName(parts ...string) *string {
 	res := ""
 	if *root != "" {
@@ -462,6 +549,8 @@ func objName(parts ...string) *string {
 }
 
 func init() {
+	flag.Var(&objectTags, "s3_backup_object_tags", "comma separated list of key:value tags to add to the backup objects")
+
 	backupstorage.BackupStorageMap["s3"] = &S3BackupStorage{}
 
 	logNameMap = logNameToLogLevel{
@@ -164,3 +164,86 @@ func TestSSECustomerFileBase64Key(t *testing.T) {
 	assert.Nil(t, sseData.customerKey, "customerKey expected to be nil")
 	assert.Nil(t, sseData.customerMd5, "customerMd5 expected to be nil")
 }
+
+func TestSSEKMSKeyID(t *testing.T) {
+	defer func() {
+		sse = aws.String("")
+		sseKMSKeyID = aws.String("")
+	}()
+
+	sse = aws.String("AES256")
+	sseKMSKeyID = aws.String("my-key")
+	sseData := S3ServerSideEncryption{}
+	err := sseData.init()
+	require.Errorf(t, err, "init() expected to fail without aws:kms")
+
+	sse = aws.String("aws:kms")
+	err = sseData.init()
+	require.NoErrorf(t, err, "init() expected to succeed")
+	assert.Equal(t, aws.String("aws:kms"), sseData.awsAlg, "awsAlg expected to be aws:kms")
+	assert.Equal(t, aws.String("my-key"), sseData.awsKMSKeyID, "awsKMSKeyID expected to be my-key")
+
+	sseData.reset()
+	assert.Nil(t, sseData.awsKMSKeyID, "awsKMSKeyID expected to be nil")
+}
+
+func TestObjectOptions(t *testing.T) {
+	defer func() {
+		storageClass = aws.String("")
+		objectTags = nil
+		*requesterPays = false
+	}()
+
+	assert.Nil(t, getStorageClass())
+	assert.Nil(t, getObjectTagging())
+	assert.Nil(t, getRequestPayer())
+	require.NoError(t, checkStorageClass())
+
+	storageClass = aws.String("GLACIER_IR")
+	require.NoError(t, checkStorageClass())
+	assert.Equal(t, aws.String("GLACIER_IR"), getStorageClass())
+
+	storageClass = aws.String("DEEP_ARCHIVE")
+	require.Error(t, checkStorageClass())
+
+	require.NoError(t, objectTags.Set("team:db,cost center:a&b"))
+	assert.Equal(t, aws.String("cost+center=a%26b&team=db"), getObjectTagging())
+
+	*requesterPays = true
+	assert.Equal(t, aws.String("requester"), getRequestPayer())
+}
+
+type s3CaptureClient struct {
+	s3iface.S3API
+	input *s3.PutObjectInput
+}
+
+func (s3capclient *s3CaptureClient) PutObjectRequest(in *s3.PutObjectInput) (*request.Request, *s3.PutObjectOutput) {
+	s3capclient.input = in
+	return &request.Request{HTTPRequest: &http.Request{}, Error: errors.New("some error")}, &s3.PutObjectOutput{}
+}
+
+func TestAddFileObjectOptions(t *testing.T) {
+	defer func() {
+		storageClass = aws.String("")
+		objectTags = nil
+		*requesterPays = false
+	}()
+	storageClass = aws.String("STANDARD_IA")
+	objectTags = map[string]string{"env": "prod"}
+	*requesterPays = true
+
+	client := &s3CaptureClient{}
+	bh := &S3BackupHandle{client: client, bs: &S3BackupStorage{}, readOnly: false}
+	wc, err := bh.AddFile(aws.BackgroundContext(), "somefile", 100000)
+	require.NoError(t, err)
+	_, err = wc.Write([]byte("here are some bytes"))
+	require.NoError(t, err)
+	require.NoError(t, wc.Close())
+	bh.waitGroup.Wait()
+
+	require.NotNil(t, client.input)
+	assert.Equal(t, aws.String("STANDARD_IA"), client.input.StorageClass)
+	assert.Equal(t, aws.String("env=prod"), client.input.Tagging)
+	assert.Equal(t, aws.String("requester"), client.input.RequestPayer)
+}
@@ -31,6 +31,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 
@@ -214,12 +215,18 @@ func (mysqld *Mysqld) collectBasicTableData(ctx context.Context, dbName string,
 }
 
 func (mysqld *Mysqld) collectSchema(ctx context.Context, dbName, tableName, tableType string) ([]*querypb.Field, []string, string, error) {
-	fields, columns, err := mysqld.GetColumns(ctx, dbName, tableName)
+	schema, err := mysqld.normalizedSchema(ctx, dbName, tableName, tableType)
 	if err != nil {
 		return nil, nil, "", err
 	}
 
-	schema, err := mysqld.normalizedSchema(ctx, dbName, tableName, tableType)
+	var fields []*querypb.Field
+	var columns []string
+	if tmutils.HasInvisibleColumns(schema) {
+		fields, columns, err = mysqld.getAllColumns(ctx, dbName, tableName)
+	} else {
+		fields, columns, err = mysqld.GetColumns(ctx, dbName, tableName)
+	}
 	if err != nil {
 		return nil, nil, "", err
 	}
@@ -274,7 +281,34 @@ func (mysqld *Mysqld) GetColumns(ctx context.Context, dbName, table string) ([]*
 	}
 	defer conn.Recycle()
 
-	qr, err := conn.ExecuteFetch(fmt.Sprintf("SELECT * FROM %s.%s WHERE 1=0", sqlescape.EscapeID(dbName), sqlescape.EscapeID(table)), 0, true)
+	return fetchColumns(conn, dbName, table, "*")
+}
+
+// getAllColumns returns the columns of table, including the invisible
+// columns which are not returned by select *.
+func (mysqld *Mysqld) getAllColumns(ctx context.Context, dbName, table string) ([]*querypb.Field, []string, error) {
+	conn, err := getPoolReconnect(ctx, mysqld.dbaPool)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Recycle()
+
+	qr, err := conn.ExecuteFetch(fmt.Sprintf("SELECT COLUMN_NAME FROM information_schema.columns WHERE TABLE_SCHEMA = %s AND TABLE_NAME = %s ORDER BY ORDINAL_POSITION", encodeTableName(dbName), encodeTableName(table)), 10000, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil, fmt.Errorf("no columns found for table %v", table)
+	}
+	names := make([]string, len(qr.Rows))
+	for i, row := range qr.Rows {
+		names[i] = sqlescape.EscapeID(row[0].ToString())
+	}
+	return fetchColumns(conn, dbName, table, strings.Join(names, ", "))
+}
+
+func fetchColumns(conn *dbconnpool.PooledDBConnection, dbName, table, selectExprs string) ([]*querypb.Field, []string, error) {
+	qr, err := conn.ExecuteFetch(fmt.Sprintf("SELECT %s FROM %s.%s WHERE 1=0", selectExprs, sqlescape.EscapeID(dbName), sqlescape.EscapeID(table)), 0, true)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -284,7 +318,6 @@ func (mysqld *Mysqld) GetColumns(ctx context.Context, dbName, table string) ([]*
 		columns[i] = field.Name
 	}
 	return qr.Fields, columns, nil
-
 }
 
 // GetPrimaryKeyColumns returns the primary key columns of table.
@@ -41,6 +41,29 @@ const (
 	TableView = "VIEW"
 )
 
+var (
+	// invisibleColumnRegexp matches the INVISIBLE attribute of a column,
+	// which SHOW CREATE TABLE prints in a versioned comment.
+	invisibleColumnRegexp = regexp.MustCompile(`(?i)\sINVISIBLE\b`)
+
+	// checkConstraintNameRegexp matches the name of a CHECK constraint.
+	checkConstraintNameRegexp = regexp.MustCompile("(?i)CONSTRAINT `(?:[^`]|``)*` CHECK")
+)
+
+// HasInvisibleColumns returns true if the CREATE TABLE statement may have
+// invisible columns, which are not returned by select *.
+func HasInvisibleColumns(createTable string) bool {
+	return invisibleColumnRegexp.MatchString(createTable)
+}
+
+// normalizeCheckConstraints removes the names of the CHECK constraints. The
+// CHECK constraint names are unique in a database, so MySQL generates new
+// names when a table is copied, e.g. by an online DDL, even though the
+// constraints are the same.
+func normalizeCheckConstraints(createTable string) string {
+	return checkConstraintNameRegexp.ReplaceAllLiteralString(createTable, "CONSTRAINT CHECK")
+}
+
 // TableDefinitionGetColumn returns the index of a column inside a
 // TableDefinition.
 func TableDefinitionGetColumn(td *tabletmanagerdatapb.TableDefinition, name string) (index int, ok bool) {
@@ -288,7 +311,7 @@ func DiffSchema(leftName string, left *tabletmanagerdatapb.SchemaDefinition, rig
 		}
 
 		// same name, let's see content
-		if left.TableDefinitions[leftIndex].Schema != right.TableDefinitions[rightIndex].Schema {
+		if normalizeCheckConstraints(left.TableDefinitions[leftIndex].Schema) != normalizeCheckConstraints(right.TableDefinitions[rightIndex].Schema) {
 			if schema.IsInternalOperationTableName(left.TableDefinitions[leftIndex].Name) {
 				log.Infof("found internal table %v, skipping in schema diff", left.TableDefinitions[leftIndex].Name)
 			} else {
@@ -254,6 +254,46 @@ func TestSchemaDiff(t *testing.T) {
 
 	sd2.TableDefinitions = append(sd2.TableDefinitions, &tabletmanagerdatapb.TableDefinition{Name: "table2", Schema: "schema3", Type: TableBaseTable})
 	testDiff(t, sd1, sd2, "sd1", "sd2", []string{"schemas differ on table table2:\nsd1: schema2\n differs from:\nsd2: schema3"})
+
+	// the generated names of the CHECK constraints are ignored
+	sd6 := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "table1",
+			Schema: "CREATE TABLE `table1` (\n  `id` int,\n  CONSTRAINT `table1_chk_1` CHECK ((`id` > 0))\n)",
+			Type:   TableBaseTable,
+		}},
+	}
+	sd7 := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "table1",
+			Schema: "CREATE TABLE `table1` (\n  `id` int,\n  CONSTRAINT `_vt_table1_chk_1` CHECK ((`id` > 0))\n)",
+			Type:   TableBaseTable,
+		}},
+	}
+	testDiff(t, sd6, sd7, "sd6", "sd7", []string{})
+	sd7.TableDefinitions[0].Schema = "CREATE TABLE `table1` (\n  `id` int,\n  CONSTRAINT `table1_chk_1` CHECK ((`id` > 1))\n)"
+	testDiff(t, sd6, sd7, "sd6", "sd7", []string{fmt.Sprintf("schemas differ on table table1:\nsd6: %s\n differs from:\nsd7: %s", sd6.TableDefinitions[0].Schema, sd7.TableDefinitions[0].Schema)})
+}
+
+func TestHasInvisibleColumns(t *testing.T) {
+	testcases := []struct {
+		schema string
+		want   bool
+	}{{
+		schema: "CREATE TABLE `t1` (\n  `id` int,\n  `c1` int DEFAULT NULL /*!80023 INVISIBLE */\n)",
+		want:   true,
+	}, {
+		schema: "CREATE TABLE `t1` (\n  `id` int,\n  `invisible` int DEFAULT NULL\n)",
+		want:   false,
+	}, {
+		schema: "CREATE TABLE `t1` (\n  `id` int\n)",
+		want:   false,
+	}}
+	for _, tc := range testcases {
+		if got := HasInvisibleColumns(tc.schema); got != tc.want {
+			t.Errorf("HasInvisibleColumns(%s): %v, want %v", tc.schema, got, tc.want)
+		}
+	}
 }
 
 func TestTableFilter(t *testing.T) {
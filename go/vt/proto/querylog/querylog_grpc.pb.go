@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package querylog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// QueryLogClient is the client API for QueryLog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryLogClient interface {
+	// Publish streams query log events.
+	Publish(ctx context.Context, opts ...grpc.CallOption) (QueryLog_PublishClient, error)
+}
+
+type queryLogClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryLogClient(cc grpc.ClientConnInterface) QueryLogClient {
+	return &queryLogClient{cc}
+}
+
+func (c *queryLogClient) Publish(ctx context.Context, opts ...grpc.CallOption) (QueryLog_PublishClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QueryLog_ServiceDesc.Streams[0], "/querylog.QueryLog/Publish", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryLogPublishClient{stream}
+	return x, nil
+}
+
+type QueryLog_PublishClient interface {
+	Send(*Event) error
+	CloseAndRecv() (*PublishResponse, error)
+	grpc.ClientStream
+}
+
+type queryLogPublishClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryLogPublishClient) Send(m *Event) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *queryLogPublishClient) CloseAndRecv() (*PublishResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PublishResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryLogServer is the server API for QueryLog service.
+// All implementations must embed UnimplementedQueryLogServer
+// for forward compatibility
+type QueryLogServer interface {
+	// Publish streams query log events.
+	Publish(QueryLog_PublishServer) error
+	mustEmbedUnimplementedQueryLogServer()
+}
+
+// UnimplementedQueryLogServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryLogServer struct {
+}
+
+func (UnimplementedQueryLogServer) Publish(QueryLog_PublishServer) error {
+	return status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedQueryLogServer) mustEmbedUnimplementedQueryLogServer() {}
+
+// UnsafeQueryLogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryLogServer will
+// result in compilation errors.
+type UnsafeQueryLogServer interface {
+	mustEmbedUnimplementedQueryLogServer()
+}
+
+func RegisterQueryLogServer(s grpc.ServiceRegistrar, srv QueryLogServer) {
+	s.RegisterService(&QueryLog_ServiceDesc, srv)
+}
+
+func _QueryLog_Publish_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QueryLogServer).Publish(&queryLogPublishServer{stream})
+}
+
+type QueryLog_PublishServer interface {
+	SendAndClose(*PublishResponse) error
+	Recv() (*Event, error)
+	grpc.ServerStream
+}
+
+type queryLogPublishServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryLogPublishServer) SendAndClose(m *PublishResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *queryLogPublishServer) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryLog_ServiceDesc is the grpc.ServiceDesc for QueryLog service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QueryLog_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "querylog.QueryLog",
+	HandlerType: (*QueryLogServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Publish",
+			Handler:       _QueryLog_Publish_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "querylog.proto",
+}
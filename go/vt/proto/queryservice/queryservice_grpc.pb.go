@@ -31,6 +31,11 @@ type QueryClient interface {
 	// contain the Fields, subsequent QueryResult messages will contain
 	// the rows.
 	StreamExecute(ctx context.Context, in *query.StreamExecuteRequest, opts ...grpc.CallOption) (Query_StreamExecuteClient, error)
+	// StreamExecuteBatch executes a list of queries one after the other,
+	// and streams the results of each of them like StreamExecute does.
+	// Use this method instead of ExecuteBatch if the queries return a
+	// large number of rows.
+	StreamExecuteBatch(ctx context.Context, in *query.StreamExecuteBatchRequest, opts ...grpc.CallOption) (Query_StreamExecuteBatchClient, error)
 	// Begin a transaction.
 	Begin(ctx context.Context, in *query.BeginRequest, opts ...grpc.CallOption) (*query.BeginResponse, error)
 	// Commit a transaction.
@@ -142,6 +147,38 @@ func (x *queryStreamExecuteClient) Recv() (*query.StreamExecuteResponse, error)
 	return m, nil
 }
 
+func (c *queryClient) StreamExecuteBatch(ctx context.Context, in *query.StreamExecuteBatchRequest, opts ...grpc.CallOption) (Query_StreamExecuteBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], "/queryservice.Query/StreamExecuteBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamExecuteBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamExecuteBatchClient interface {
+	Recv() (*query.StreamExecuteBatchResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamExecuteBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamExecuteBatchClient) Recv() (*query.StreamExecuteBatchResponse, error) {
+	m := new(query.StreamExecuteBatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) Begin(ctx context.Context, in *query.BeginRequest, opts ...grpc.CallOption) (*query.BeginResponse, error) {
 	out := new(query.BeginResponse)
 	err := c.cc.Invoke(ctx, "/queryservice.Query/Begin", in, out, opts...)
@@ -260,7 +297,7 @@ func (c *queryClient) BeginExecuteBatch(ctx context.Context, in *query.BeginExec
 }
 
 func (c *queryClient) BeginStreamExecute(ctx context.Context, in *query.BeginStreamExecuteRequest, opts ...grpc.CallOption) (Query_BeginStreamExecuteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], "/queryservice.Query/BeginStreamExecute", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[2], "/queryservice.Query/BeginStreamExecute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +329,7 @@ func (x *queryBeginStreamExecuteClient) Recv() (*query.BeginStreamExecuteRespons
 }
 
 func (c *queryClient) MessageStream(ctx context.Context, in *query.MessageStreamRequest, opts ...grpc.CallOption) (Query_MessageStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[2], "/queryservice.Query/MessageStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[3], "/queryservice.Query/MessageStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +388,7 @@ func (c *queryClient) ReserveBeginExecute(ctx context.Context, in *query.Reserve
 }
 
 func (c *queryClient) ReserveStreamExecute(ctx context.Context, in *query.ReserveStreamExecuteRequest, opts ...grpc.CallOption) (Query_ReserveStreamExecuteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[3], "/queryservice.Query/ReserveStreamExecute", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[4], "/queryservice.Query/ReserveStreamExecute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -383,7 +420,7 @@ func (x *queryReserveStreamExecuteClient) Recv() (*query.ReserveStreamExecuteRes
 }
 
 func (c *queryClient) ReserveBeginStreamExecute(ctx context.Context, in *query.ReserveBeginStreamExecuteRequest, opts ...grpc.CallOption) (Query_ReserveBeginStreamExecuteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[4], "/queryservice.Query/ReserveBeginStreamExecute", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[5], "/queryservice.Query/ReserveBeginStreamExecute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -424,7 +461,7 @@ func (c *queryClient) Release(ctx context.Context, in *query.ReleaseRequest, opt
 }
 
 func (c *queryClient) StreamHealth(ctx context.Context, in *query.StreamHealthRequest, opts ...grpc.CallOption) (Query_StreamHealthClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[5], "/queryservice.Query/StreamHealth", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[6], "/queryservice.Query/StreamHealth", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -456,7 +493,7 @@ func (x *queryStreamHealthClient) Recv() (*query.StreamHealthResponse, error) {
 }
 
 func (c *queryClient) VStream(ctx context.Context, in *binlogdata.VStreamRequest, opts ...grpc.CallOption) (Query_VStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[6], "/queryservice.Query/VStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[7], "/queryservice.Query/VStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -488,7 +525,7 @@ func (x *queryVStreamClient) Recv() (*binlogdata.VStreamResponse, error) {
 }
 
 func (c *queryClient) VStreamRows(ctx context.Context, in *binlogdata.VStreamRowsRequest, opts ...grpc.CallOption) (Query_VStreamRowsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[7], "/queryservice.Query/VStreamRows", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[8], "/queryservice.Query/VStreamRows", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -520,7 +557,7 @@ func (x *queryVStreamRowsClient) Recv() (*binlogdata.VStreamRowsResponse, error)
 }
 
 func (c *queryClient) VStreamResults(ctx context.Context, in *binlogdata.VStreamResultsRequest, opts ...grpc.CallOption) (Query_VStreamResultsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[8], "/queryservice.Query/VStreamResults", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[9], "/queryservice.Query/VStreamResults", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -566,6 +603,11 @@ type QueryServer interface {
 	// contain the Fields, subsequent QueryResult messages will contain
 	// the rows.
 	StreamExecute(*query.StreamExecuteRequest, Query_StreamExecuteServer) error
+	// StreamExecuteBatch executes a list of queries one after the other,
+	// and streams the results of each of them like StreamExecute does.
+	// Use this method instead of ExecuteBatch if the queries return a
+	// large number of rows.
+	StreamExecuteBatch(*query.StreamExecuteBatchRequest, Query_StreamExecuteBatchServer) error
 	// Begin a transaction.
 	Begin(context.Context, *query.BeginRequest) (*query.BeginResponse, error)
 	// Commit a transaction.
@@ -633,6 +675,9 @@ func (UnimplementedQueryServer) ExecuteBatch(context.Context, *query.ExecuteBatc
 func (UnimplementedQueryServer) StreamExecute(*query.StreamExecuteRequest, Query_StreamExecuteServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamExecute not implemented")
 }
+func (UnimplementedQueryServer) StreamExecuteBatch(*query.StreamExecuteBatchRequest, Query_StreamExecuteBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExecuteBatch not implemented")
+}
 func (UnimplementedQueryServer) Begin(context.Context, *query.BeginRequest) (*query.BeginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Begin not implemented")
 }
@@ -778,6 +823,27 @@ func (x *queryStreamExecuteServer) Send(m *query.StreamExecuteResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_StreamExecuteBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(query.StreamExecuteBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamExecuteBatch(m, &queryStreamExecuteBatchServer{stream})
+}
+
+type Query_StreamExecuteBatchServer interface {
+	Send(*query.StreamExecuteBatchResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamExecuteBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamExecuteBatchServer) Send(m *query.StreamExecuteBatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_Begin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(query.BeginRequest)
 	if err := dec(in); err != nil {
@@ -1342,6 +1408,11 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Query_StreamExecute_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamExecuteBatch",
+			Handler:       _Query_StreamExecuteBatch_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "BeginStreamExecute",
 			Handler:       _Query_BeginStreamExecute_Handler,
@@ -109,6 +109,29 @@ type TabletManagerClient interface {
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
 	// Generic VExec request. Can be used for various purposes
 	VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error)
+	// GetUnresolvedTransactions returns the distributed transactions and the
+	// prepared transactions of the tablet which are not resolved yet
+	GetUnresolvedTransactions(ctx context.Context, in *tabletmanagerdata.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetUnresolvedTransactionsResponse, error)
+	// ResolveTransaction commits or rolls back a prepared transaction of the tablet
+	ResolveTransaction(ctx context.Context, in *tabletmanagerdata.ResolveTransactionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResolveTransactionResponse, error)
+	// SetRollbackTransaction decides to roll back a distributed transaction in
+	// state PREPARE, of which the tablet is the metadata manager
+	SetRollbackTransaction(ctx context.Context, in *tabletmanagerdata.SetRollbackTransactionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetRollbackTransactionResponse, error)
+	// GetStorageUsage returns the size of the tables of the tablet, and its
+	// recent samples
+	GetStorageUsage(ctx context.Context, in *tabletmanagerdata.GetStorageUsageRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetStorageUsageResponse, error)
+	// GetDeadLetters returns the messages parked after their last attempt in a
+	// message table
+	GetDeadLetters(ctx context.Context, in *tabletmanagerdata.GetDeadLettersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetDeadLettersResponse, error)
+	// RequeueDeadLetters schedules dead-lettered messages to be sent again
+	RequeueDeadLetters(ctx context.Context, in *tabletmanagerdata.RequeueDeadLettersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RequeueDeadLettersResponse, error)
+	// PurgeDeadLetters deletes dead-lettered messages
+	PurgeDeadLetters(ctx context.Context, in *tabletmanagerdata.PurgeDeadLettersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PurgeDeadLettersResponse, error)
+	// GetSequences returns the state of the sequences of the tablet
+	GetSequences(ctx context.Context, in *tabletmanagerdata.GetSequencesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSequencesResponse, error)
+	// ResetSequence sets the next ID and the cache size of a sequence, and
+	// drops its cached values
+	ResetSequence(ctx context.Context, in *tabletmanagerdata.ResetSequenceRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetSequenceResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -624,6 +647,87 @@ func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.V
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetUnresolvedTransactions(ctx context.Context, in *tabletmanagerdata.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetUnresolvedTransactionsResponse, error) {
+	out := new(tabletmanagerdata.GetUnresolvedTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetUnresolvedTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ResolveTransaction(ctx context.Context, in *tabletmanagerdata.ResolveTransactionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResolveTransactionResponse, error) {
+	out := new(tabletmanagerdata.ResolveTransactionResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResolveTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SetRollbackTransaction(ctx context.Context, in *tabletmanagerdata.SetRollbackTransactionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetRollbackTransactionResponse, error) {
+	out := new(tabletmanagerdata.SetRollbackTransactionResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetRollbackTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetStorageUsage(ctx context.Context, in *tabletmanagerdata.GetStorageUsageRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetStorageUsageResponse, error) {
+	out := new(tabletmanagerdata.GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetDeadLetters(ctx context.Context, in *tabletmanagerdata.GetDeadLettersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetDeadLettersResponse, error) {
+	out := new(tabletmanagerdata.GetDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) RequeueDeadLetters(ctx context.Context, in *tabletmanagerdata.RequeueDeadLettersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RequeueDeadLettersResponse, error) {
+	out := new(tabletmanagerdata.RequeueDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RequeueDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) PurgeDeadLetters(ctx context.Context, in *tabletmanagerdata.PurgeDeadLettersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PurgeDeadLettersResponse, error) {
+	out := new(tabletmanagerdata.PurgeDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PurgeDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetSequences(ctx context.Context, in *tabletmanagerdata.GetSequencesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSequencesResponse, error) {
+	out := new(tabletmanagerdata.GetSequencesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSequences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ResetSequence(ctx context.Context, in *tabletmanagerdata.ResetSequenceRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetSequenceResponse, error) {
+	out := new(tabletmanagerdata.ResetSequenceResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResetSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 // All implementations must embed UnimplementedTabletManagerServer
 // for forward compatibility
@@ -718,6 +822,29 @@ type TabletManagerServer interface {
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
 	// Generic VExec request. Can be used for various purposes
 	VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error)
+	// GetUnresolvedTransactions returns the distributed transactions and the
+	// prepared transactions of the tablet which are not resolved yet
+	GetUnresolvedTransactions(context.Context, *tabletmanagerdata.GetUnresolvedTransactionsRequest) (*tabletmanagerdata.GetUnresolvedTransactionsResponse, error)
+	// ResolveTransaction commits or rolls back a prepared transaction of the tablet
+	ResolveTransaction(context.Context, *tabletmanagerdata.ResolveTransactionRequest) (*tabletmanagerdata.ResolveTransactionResponse, error)
+	// SetRollbackTransaction decides to roll back a distributed transaction in
+	// state PREPARE, of which the tablet is the metadata manager
+	SetRollbackTransaction(context.Context, *tabletmanagerdata.SetRollbackTransactionRequest) (*tabletmanagerdata.SetRollbackTransactionResponse, error)
+	// GetStorageUsage returns the size of the tables of the tablet, and its
+	// recent samples
+	GetStorageUsage(context.Context, *tabletmanagerdata.GetStorageUsageRequest) (*tabletmanagerdata.GetStorageUsageResponse, error)
+	// GetDeadLetters returns the messages parked after their last attempt in a
+	// message table
+	GetDeadLetters(context.Context, *tabletmanagerdata.GetDeadLettersRequest) (*tabletmanagerdata.GetDeadLettersResponse, error)
+	// RequeueDeadLetters schedules dead-lettered messages to be sent again
+	RequeueDeadLetters(context.Context, *tabletmanagerdata.RequeueDeadLettersRequest) (*tabletmanagerdata.RequeueDeadLettersResponse, error)
+	// PurgeDeadLetters deletes dead-lettered messages
+	PurgeDeadLetters(context.Context, *tabletmanagerdata.PurgeDeadLettersRequest) (*tabletmanagerdata.PurgeDeadLettersResponse, error)
+	// GetSequences returns the state of the sequences of the tablet
+	GetSequences(context.Context, *tabletmanagerdata.GetSequencesRequest) (*tabletmanagerdata.GetSequencesResponse, error)
+	// ResetSequence sets the next ID and the cache size of a sequence, and
+	// drops its cached values
+	ResetSequence(context.Context, *tabletmanagerdata.ResetSequenceRequest) (*tabletmanagerdata.ResetSequenceResponse, error)
 	mustEmbedUnimplementedTabletManagerServer()
 }
 
@@ -878,6 +1005,33 @@ func (UnimplementedTabletManagerServer) RestoreFromBackup(*tabletmanagerdata.Res
 func (UnimplementedTabletManagerServer) VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VExec not implemented")
 }
+func (UnimplementedTabletManagerServer) GetUnresolvedTransactions(context.Context, *tabletmanagerdata.GetUnresolvedTransactionsRequest) (*tabletmanagerdata.GetUnresolvedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnresolvedTransactions not implemented")
+}
+func (UnimplementedTabletManagerServer) ResolveTransaction(context.Context, *tabletmanagerdata.ResolveTransactionRequest) (*tabletmanagerdata.ResolveTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTransaction not implemented")
+}
+func (UnimplementedTabletManagerServer) SetRollbackTransaction(context.Context, *tabletmanagerdata.SetRollbackTransactionRequest) (*tabletmanagerdata.SetRollbackTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRollbackTransaction not implemented")
+}
+func (UnimplementedTabletManagerServer) GetStorageUsage(context.Context, *tabletmanagerdata.GetStorageUsageRequest) (*tabletmanagerdata.GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedTabletManagerServer) GetDeadLetters(context.Context, *tabletmanagerdata.GetDeadLettersRequest) (*tabletmanagerdata.GetDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetters not implemented")
+}
+func (UnimplementedTabletManagerServer) RequeueDeadLetters(context.Context, *tabletmanagerdata.RequeueDeadLettersRequest) (*tabletmanagerdata.RequeueDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueDeadLetters not implemented")
+}
+func (UnimplementedTabletManagerServer) PurgeDeadLetters(context.Context, *tabletmanagerdata.PurgeDeadLettersRequest) (*tabletmanagerdata.PurgeDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
+func (UnimplementedTabletManagerServer) GetSequences(context.Context, *tabletmanagerdata.GetSequencesRequest) (*tabletmanagerdata.GetSequencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSequences not implemented")
+}
+func (UnimplementedTabletManagerServer) ResetSequence(context.Context, *tabletmanagerdata.ResetSequenceRequest) (*tabletmanagerdata.ResetSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetSequence not implemented")
+}
 func (UnimplementedTabletManagerServer) mustEmbedUnimplementedTabletManagerServer() {}
 
 // UnsafeTabletManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -1815,6 +1969,168 @@ func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetUnresolvedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetUnresolvedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetUnresolvedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetUnresolvedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetUnresolvedTransactions(ctx, req.(*tabletmanagerdata.GetUnresolvedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ResolveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ResolveTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ResolveTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ResolveTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ResolveTransaction(ctx, req.(*tabletmanagerdata.ResolveTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetRollbackTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetRollbackTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetRollbackTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetRollbackTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetRollbackTransaction(ctx, req.(*tabletmanagerdata.SetRollbackTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetStorageUsage(ctx, req.(*tabletmanagerdata.GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetDeadLetters(ctx, req.(*tabletmanagerdata.GetDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RequeueDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RequeueDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RequeueDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RequeueDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RequeueDeadLetters(ctx, req.(*tabletmanagerdata.RequeueDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PurgeDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).PurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/PurgeDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).PurgeDeadLetters(ctx, req.(*tabletmanagerdata.PurgeDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetSequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetSequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetSequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetSequences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetSequences(ctx, req.(*tabletmanagerdata.GetSequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ResetSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ResetSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ResetSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ResetSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ResetSequence(ctx, req.(*tabletmanagerdata.ResetSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TabletManager_ServiceDesc is the grpc.ServiceDesc for TabletManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2018,6 +2334,42 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VExec",
 			Handler:    _TabletManager_VExec_Handler,
 		},
+		{
+			MethodName: "GetUnresolvedTransactions",
+			Handler:    _TabletManager_GetUnresolvedTransactions_Handler,
+		},
+		{
+			MethodName: "ResolveTransaction",
+			Handler:    _TabletManager_ResolveTransaction_Handler,
+		},
+		{
+			MethodName: "SetRollbackTransaction",
+			Handler:    _TabletManager_SetRollbackTransaction_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _TabletManager_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "GetDeadLetters",
+			Handler:    _TabletManager_GetDeadLetters_Handler,
+		},
+		{
+			MethodName: "RequeueDeadLetters",
+			Handler:    _TabletManager_RequeueDeadLetters_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _TabletManager_PurgeDeadLetters_Handler,
+		},
+		{
+			MethodName: "GetSequences",
+			Handler:    _TabletManager_GetSequences_Handler,
+		},
+		{
+			MethodName: "ResetSequence",
+			Handler:    _TabletManager_ResetSequence_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -56,6 +56,9 @@ type VTAdminClient interface {
 	GetSrvVSchema(ctx context.Context, in *GetSrvVSchemaRequest, opts ...grpc.CallOption) (*SrvVSchema, error)
 	// GetSrvVSchemas returns all SrvVSchemas across all (or specified) clusters and cells.
 	GetSrvVSchemas(ctx context.Context, in *GetSrvVSchemasRequest, opts ...grpc.CallOption) (*GetSrvVSchemasResponse, error)
+	// GetStorageUsage returns the storage usage of the shards of a keyspace,
+	// with their growth and the forecast time their disks are full.
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*vtctldata.GetStorageUsageResponse, error)
 	// GetTablet looks up a tablet by hostname across all clusters and returns
 	// the result.
 	GetTablet(ctx context.Context, in *GetTabletRequest, opts ...grpc.CallOption) (*Tablet, error)
@@ -71,6 +74,9 @@ type VTAdminClient interface {
 	// GetWorkflow returns a single Workflow for a given cluster, keyspace, and
 	// workflow name.
 	GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	// GetWorkflowProgress returns the progress of a workflow in a given
+	// cluster and keyspace.
+	GetWorkflowProgress(ctx context.Context, in *GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowProgressResponse, error)
 	// GetWorkflows returns the Workflows for all specified clusters.
 	GetWorkflows(ctx context.Context, in *GetWorkflowsRequest, opts ...grpc.CallOption) (*GetWorkflowsResponse, error)
 	// VTExplain provides information on how Vitess plans to execute a particular query.
@@ -220,6 +226,15 @@ func (c *vTAdminClient) GetSrvVSchemas(ctx context.Context, in *GetSrvVSchemasRe
 	return out, nil
 }
 
+func (c *vTAdminClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*vtctldata.GetStorageUsageResponse, error) {
+	out := new(vtctldata.GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) GetTablet(ctx context.Context, in *GetTabletRequest, opts ...grpc.CallOption) (*Tablet, error) {
 	out := new(Tablet)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetTablet", in, out, opts...)
@@ -274,6 +289,15 @@ func (c *vTAdminClient) GetWorkflow(ctx context.Context, in *GetWorkflowRequest,
 	return out, nil
 }
 
+func (c *vTAdminClient) GetWorkflowProgress(ctx context.Context, in *GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowProgressResponse, error) {
+	out := new(vtctldata.GetWorkflowProgressResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetWorkflowProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) GetWorkflows(ctx context.Context, in *GetWorkflowsRequest, opts ...grpc.CallOption) (*GetWorkflowsResponse, error) {
 	out := new(GetWorkflowsResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetWorkflows", in, out, opts...)
@@ -333,6 +357,9 @@ type VTAdminServer interface {
 	GetSrvVSchema(context.Context, *GetSrvVSchemaRequest) (*SrvVSchema, error)
 	// GetSrvVSchemas returns all SrvVSchemas across all (or specified) clusters and cells.
 	GetSrvVSchemas(context.Context, *GetSrvVSchemasRequest) (*GetSrvVSchemasResponse, error)
+	// GetStorageUsage returns the storage usage of the shards of a keyspace,
+	// with their growth and the forecast time their disks are full.
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*vtctldata.GetStorageUsageResponse, error)
 	// GetTablet looks up a tablet by hostname across all clusters and returns
 	// the result.
 	GetTablet(context.Context, *GetTabletRequest) (*Tablet, error)
@@ -348,6 +375,9 @@ type VTAdminServer interface {
 	// GetWorkflow returns a single Workflow for a given cluster, keyspace, and
 	// workflow name.
 	GetWorkflow(context.Context, *GetWorkflowRequest) (*Workflow, error)
+	// GetWorkflowProgress returns the progress of a workflow in a given
+	// cluster and keyspace.
+	GetWorkflowProgress(context.Context, *GetWorkflowProgressRequest) (*vtctldata.GetWorkflowProgressResponse, error)
 	// GetWorkflows returns the Workflows for all specified clusters.
 	GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error)
 	// VTExplain provides information on how Vitess plans to execute a particular query.
@@ -404,6 +434,9 @@ func (UnimplementedVTAdminServer) GetSrvVSchema(context.Context, *GetSrvVSchemaR
 func (UnimplementedVTAdminServer) GetSrvVSchemas(context.Context, *GetSrvVSchemasRequest) (*GetSrvVSchemasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSrvVSchemas not implemented")
 }
+func (UnimplementedVTAdminServer) GetStorageUsage(context.Context, *GetStorageUsageRequest) (*vtctldata.GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
 func (UnimplementedVTAdminServer) GetTablet(context.Context, *GetTabletRequest) (*Tablet, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTablet not implemented")
 }
@@ -422,6 +455,9 @@ func (UnimplementedVTAdminServer) GetVtctlds(context.Context, *GetVtctldsRequest
 func (UnimplementedVTAdminServer) GetWorkflow(context.Context, *GetWorkflowRequest) (*Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
 }
+func (UnimplementedVTAdminServer) GetWorkflowProgress(context.Context, *GetWorkflowProgressRequest) (*vtctldata.GetWorkflowProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowProgress not implemented")
+}
 func (UnimplementedVTAdminServer) GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
 }
@@ -711,6 +747,24 @@ func _VTAdmin_GetSrvVSchemas_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_GetTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTabletRequest)
 	if err := dec(in); err != nil {
@@ -819,6 +873,24 @@ func _VTAdmin_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_GetWorkflowProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).GetWorkflowProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/GetWorkflowProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).GetWorkflowProgress(ctx, req.(*GetWorkflowProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkflowsRequest)
 	if err := dec(in); err != nil {
@@ -922,6 +994,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSrvVSchemas",
 			Handler:    _VTAdmin_GetSrvVSchemas_Handler,
 		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _VTAdmin_GetStorageUsage_Handler,
+		},
 		{
 			MethodName: "GetTablet",
 			Handler:    _VTAdmin_GetTablet_Handler,
@@ -946,6 +1022,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkflow",
 			Handler:    _VTAdmin_GetWorkflow_Handler,
 		},
+		{
+			MethodName: "GetWorkflowProgress",
+			Handler:    _VTAdmin_GetWorkflowProgress_Handler,
+		},
 		{
 			MethodName: "GetWorkflows",
 			Handler:    _VTAdmin_GetWorkflows_Handler,
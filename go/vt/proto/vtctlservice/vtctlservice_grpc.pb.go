@@ -142,16 +142,35 @@ type VtctldClient interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(ctx context.Context, in *vtctldata.AddCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.AddCellsAliasResponse, error)
+	// ApplyMaskingPolicy validates the masking policy of the cluster, and saves
+	// it in the topo. VReplication applies it to the streams starting after.
+	ApplyMaskingPolicy(ctx context.Context, in *vtctldata.ApplyMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldata.ApplyMaskingPolicyResponse, error)
+	// ApplyQueryRule creates or replaces a custom query rule for a keyspace,
+	// shard or tablet.
+	ApplyQueryRule(ctx context.Context, in *vtctldata.ApplyQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.ApplyQueryRuleResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error)
+	// ApplySchemaOwnership saves the owning teams and the approval policies of
+	// the tables of a keyspace in the topo.
+	ApplySchemaOwnership(ctx context.Context, in *vtctldata.ApplySchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldata.ApplySchemaOwnershipResponse, error)
+	// ApplyTableACL validates the table ACL of a keyspace, and saves it in the
+	// topo. The tablets of the keyspace apply it when it changes.
+	ApplyTableACL(ctx context.Context, in *vtctldata.ApplyTableACLRequest, opts ...grpc.CallOption) (*vtctldata.ApplyTableACLResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
 	ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error)
+	// ApplyVersionedTable adds or replaces a versioned table of the routing
+	// rules, which serves a new version of a table to a percentage of the
+	// sessions.
+	ApplyVersionedTable(ctx context.Context, in *vtctldata.ApplyVersionedTableRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVersionedTableResponse, error)
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error)
+	// CollectDiagnostics collects the profiles, goroutines, stats, recent query
+	// logs and configuration of a vttablet or vtgate into an archive.
+	CollectDiagnostics(ctx context.Context, in *vtctldata.CollectDiagnosticsRequest, opts ...grpc.CallOption) (*vtctldata.CollectDiagnosticsResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
@@ -168,6 +187,9 @@ type VtctldClient interface {
 	// Otherwise, the keyspace must be empty (have no shards), or DeleteKeyspace
 	// returns an error.
 	DeleteKeyspace(ctx context.Context, in *vtctldata.DeleteKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.DeleteKeyspaceResponse, error)
+	// DeleteQueryRule deletes a custom query rule of a keyspace, shard or
+	// tablet.
+	DeleteQueryRule(ctx context.Context, in *vtctldata.DeleteQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.DeleteQueryRuleResponse, error)
 	// DeleteShards deletes the specified shards from the topology. In recursive
 	// mode, it also deletes all tablets belonging to the shard. Otherwise, the
 	// shard must be empty (have no tablets) or DeleteShards returns an error for
@@ -175,11 +197,20 @@ type VtctldClient interface {
 	DeleteShards(ctx context.Context, in *vtctldata.DeleteShardsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteShardsResponse, error)
 	// DeleteSrvVSchema deletes the SrvVSchema object in the specified cell.
 	DeleteSrvVSchema(ctx context.Context, in *vtctldata.DeleteSrvVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.DeleteSrvVSchemaResponse, error)
+	// DeleteTableACL deletes the table ACL of a keyspace. The tablets of the
+	// keyspace go back to the table ACL of their config file.
+	DeleteTableACL(ctx context.Context, in *vtctldata.DeleteTableACLRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTableACLResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(ctx context.Context, in *vtctldata.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTabletsResponse, error)
+	// DeleteVersionedTable deletes a versioned table of the routing rules.
+	DeleteVersionedTable(ctx context.Context, in *vtctldata.DeleteVersionedTableRequest, opts ...grpc.CallOption) (*vtctldata.DeleteVersionedTableResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error)
+	// EvacuateCell moves the primaries of the keyspace out of the cell with
+	// PlannedReparentShard, skipping the shards whose primary is already out of
+	// the cell.
+	EvacuateCell(ctx context.Context, in *vtctldata.EvacuateCellRequest, opts ...grpc.CallOption) (*vtctldata.EvacuateCellResponse, error)
 	// ExecuteHook runs the hook on the tablet.
 	ExecuteHook(ctx context.Context, in *vtctldata.ExecuteHookRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteHookResponse, error)
 	// FindAllShardsInKeyspace returns a map of shard names to shard references
@@ -195,15 +226,34 @@ type VtctldClient interface {
 	// GetCellsAliases returns a mapping of cell alias to cells identified by that
 	// alias.
 	GetCellsAliases(ctx context.Context, in *vtctldata.GetCellsAliasesRequest, opts ...grpc.CallOption) (*vtctldata.GetCellsAliasesResponse, error)
+	// GetDeadLetters returns the dead-lettered messages of a message table, on
+	// the primaries of a keyspace.
+	GetDeadLetters(ctx context.Context, in *vtctldata.GetDeadLettersRequest, opts ...grpc.CallOption) (*vtctldata.GetDeadLettersResponse, error)
 	// GetKeyspace reads the given keyspace from the topo and returns it.
 	GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspacesResponse, error)
+	// GetMaskingPolicy returns the masking policy of the cluster.
+	GetMaskingPolicy(ctx context.Context, in *vtctldata.GetMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldata.GetMaskingPolicyResponse, error)
+	// GetOnlineDDLSchedulerConfig returns the configuration of the online DDL
+	// scheduler of a keyspace.
+	GetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldata.GetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldata.GetOnlineDDLSchedulerConfigResponse, error)
+	// GetQueryRules returns the custom query rules of a keyspace.
+	GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error)
 	// GetRoutingRules returns the VSchema routing rules.
 	GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error)
 	// GetSchema returns the schema for a tablet, or just the schema for the
 	// specified tables in that tablet.
 	GetSchema(ctx context.Context, in *vtctldata.GetSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaResponse, error)
+	// GetSchemaMigrationProgress returns the progress of the online schema
+	// migrations of a keyspace on each of its shards.
+	GetSchemaMigrationProgress(ctx context.Context, in *vtctldata.GetSchemaMigrationProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaMigrationProgressResponse, error)
+	// GetSchemaOwnership returns the owning teams and the approval policies of
+	// the tables of a keyspace.
+	GetSchemaOwnership(ctx context.Context, in *vtctldata.GetSchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaOwnershipResponse, error)
+	// GetSequences returns the state of the sequences of an unsharded keyspace,
+	// as stored in their tables and cached by its primary.
+	GetSequences(ctx context.Context, in *vtctldata.GetSequencesRequest, opts ...grpc.CallOption) (*vtctldata.GetSequencesResponse, error)
 	// GetShard returns information about a shard in the topology.
 	GetShard(ctx context.Context, in *vtctldata.GetShardRequest, opts ...grpc.CallOption) (*vtctldata.GetShardResponse, error)
 	// GetSrvKeyspaceNames returns a mapping of cell name to the keyspaces served
@@ -217,12 +267,27 @@ type VtctldClient interface {
 	// GetSrvVSchemas returns a mapping from cell name to SrvVSchema for all cells,
 	// optionally filtered by cell name.
 	GetSrvVSchemas(ctx context.Context, in *vtctldata.GetSrvVSchemasRequest, opts ...grpc.CallOption) (*vtctldata.GetSrvVSchemasResponse, error)
+	// GetStorageUsage returns the size of the tables of the primaries of a
+	// keyspace, with their growth and the forecast time their disks are full.
+	GetStorageUsage(ctx context.Context, in *vtctldata.GetStorageUsageRequest, opts ...grpc.CallOption) (*vtctldata.GetStorageUsageResponse, error)
+	// GetTableACL returns the table ACL of a keyspace.
+	GetTableACL(ctx context.Context, in *vtctldata.GetTableACLRequest, opts ...grpc.CallOption) (*vtctldata.GetTableACLResponse, error)
 	// GetTablet returns information about a tablet.
 	GetTablet(ctx context.Context, in *vtctldata.GetTabletRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletResponse, error)
+	// GetTabletStatsSnapshot captures a snapshot of the stats a tablet exports
+	// on /debug/vars.
+	GetTabletStatsSnapshot(ctx context.Context, in *vtctldata.GetTabletStatsSnapshotRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletStatsSnapshotResponse, error)
 	// GetTablets returns tablets, optionally filtered by keyspace and shard.
 	GetTablets(ctx context.Context, in *vtctldata.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletsResponse, error)
+	// GetUnresolvedTransactions returns the distributed transactions which are
+	// not resolved on the primaries of a keyspace.
+	GetUnresolvedTransactions(ctx context.Context, in *vtctldata.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*vtctldata.GetUnresolvedTransactionsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error)
+	// GetWorkflowProgress returns the progress of a workflow, with the rows
+	// and bytes copied, the replication lag and the estimated time left, per
+	// stream and per table.
+	GetWorkflowProgress(ctx context.Context, in *vtctldata.GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowProgressResponse, error)
 	// GetWorkflows returns a list of workflows for the given keyspace.
 	GetWorkflows(ctx context.Context, in *vtctldata.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowsResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
@@ -243,6 +308,9 @@ type VtctldClient interface {
 	// current shard primary is in for promotion unless NewPrimary is explicitly
 	// provided in the request.
 	PlannedReparentShard(ctx context.Context, in *vtctldata.PlannedReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.PlannedReparentShardResponse, error)
+	// PurgeDeadLetters deletes dead-lettered messages of a message table, on the
+	// primaries of a keyspace.
+	PurgeDeadLetters(ctx context.Context, in *vtctldata.PurgeDeadLettersRequest, opts ...grpc.CallOption) (*vtctldata.PurgeDeadLettersResponse, error)
 	// RebuildKeyspaceGraph rebuilds the serving data for a keyspace.
 	//
 	// This may trigger an update to all connected clients.
@@ -273,6 +341,15 @@ type VtctldClient interface {
 	// RemoveShardCell removes the specified cell from the specified shard's Cells
 	// list.
 	RemoveShardCell(ctx context.Context, in *vtctldata.RemoveShardCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveShardCellResponse, error)
+	// RequeueDeadLetters schedules dead-lettered messages of a message table to
+	// be sent again, on the primaries of a keyspace.
+	RequeueDeadLetters(ctx context.Context, in *vtctldata.RequeueDeadLettersRequest, opts ...grpc.CallOption) (*vtctldata.RequeueDeadLettersResponse, error)
+	// ResetSequence sets the next ID and the cache size of a sequence, and drops
+	// the values cached by the primary of its keyspace.
+	ResetSequence(ctx context.Context, in *vtctldata.ResetSequenceRequest, opts ...grpc.CallOption) (*vtctldata.ResetSequenceResponse, error)
+	// ResolveTransaction commits or rolls back a distributed transaction on all
+	// its participants, and removes its metadata.
+	ResolveTransaction(ctx context.Context, in *vtctldata.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtctldata.ResolveTransactionResponse, error)
 	// ReparentTablet reparents a tablet to the current primary in the shard. This
 	// only works if the current replica position matches the last known reparent
 	// action.
@@ -286,6 +363,9 @@ type VtctldClient interface {
 	SetKeyspaceServedFrom(ctx context.Context, in *vtctldata.SetKeyspaceServedFromRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceServedFromResponse, error)
 	// SetKeyspaceShardingInfo updates the sharding information for a keyspace.
 	SetKeyspaceShardingInfo(ctx context.Context, in *vtctldata.SetKeyspaceShardingInfoRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceShardingInfoResponse, error)
+	// SetOnlineDDLSchedulerConfig replaces the configuration of the online DDL
+	// scheduler of a keyspace.
+	SetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldata.SetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldata.SetOnlineDDLSchedulerConfigResponse, error)
 	// SetShardIsPrimaryServing adds or removes a shard from serving.
 	//
 	// This is meant as an emergency function. It does not rebuild any serving
@@ -330,6 +410,11 @@ type VtctldClient interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(ctx context.Context, in *vtctldata.UpdateCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellsAliasResponse, error)
+	// UpdateThrottlerConfig updates the tablet throttler configuration of a
+	// keyspace, in the keyspace record and in the SrvKeyspace of every cell.
+	// The tablets running with -throttler_config_via_topo apply it without a
+	// restart.
+	UpdateThrottlerConfig(ctx context.Context, in *vtctldata.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigResponse, error)
 	// Validate validates that all nodes from the global replication graph are
 	// reachable, and that all tablets in discoverable cells are consistent.
 	Validate(ctx context.Context, in *vtctldata.ValidateRequest, opts ...grpc.CallOption) (*vtctldata.ValidateResponse, error)
@@ -367,6 +452,24 @@ func (c *vtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata.AddCells
 	return out, nil
 }
 
+func (c *vtctldClient) ApplyMaskingPolicy(ctx context.Context, in *vtctldata.ApplyMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldata.ApplyMaskingPolicyResponse, error) {
+	out := new(vtctldata.ApplyMaskingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyMaskingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ApplyQueryRule(ctx context.Context, in *vtctldata.ApplyQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.ApplyQueryRuleResponse, error) {
+	out := new(vtctldata.ApplyQueryRuleResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyQueryRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	out := new(vtctldata.ApplyRoutingRulesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyRoutingRules", in, out, opts...)
@@ -376,6 +479,24 @@ func (c *vtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldata.Appl
 	return out, nil
 }
 
+func (c *vtctldClient) ApplySchemaOwnership(ctx context.Context, in *vtctldata.ApplySchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldata.ApplySchemaOwnershipResponse, error) {
+	out := new(vtctldata.ApplySchemaOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplySchemaOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ApplyTableACL(ctx context.Context, in *vtctldata.ApplyTableACLRequest, opts ...grpc.CallOption) (*vtctldata.ApplyTableACLResponse, error) {
+	out := new(vtctldata.ApplyTableACLResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyTableACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error) {
 	out := new(vtctldata.ApplyVSchemaResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyVSchema", in, out, opts...)
@@ -385,6 +506,15 @@ func (c *vtctldClient) ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSch
 	return out, nil
 }
 
+func (c *vtctldClient) ApplyVersionedTable(ctx context.Context, in *vtctldata.ApplyVersionedTableRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVersionedTableResponse, error) {
+	out := new(vtctldata.ApplyVersionedTableResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyVersionedTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error) {
 	out := new(vtctldata.ChangeTabletTypeResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ChangeTabletType", in, out, opts...)
@@ -394,6 +524,15 @@ func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.Chang
 	return out, nil
 }
 
+func (c *vtctldClient) CollectDiagnostics(ctx context.Context, in *vtctldata.CollectDiagnosticsRequest, opts ...grpc.CallOption) (*vtctldata.CollectDiagnosticsResponse, error) {
+	out := new(vtctldata.CollectDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CollectDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) CreateKeyspace(ctx context.Context, in *vtctldata.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.CreateKeyspaceResponse, error) {
 	out := new(vtctldata.CreateKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateKeyspace", in, out, opts...)
@@ -439,6 +578,15 @@ func (c *vtctldClient) DeleteKeyspace(ctx context.Context, in *vtctldata.DeleteK
 	return out, nil
 }
 
+func (c *vtctldClient) DeleteQueryRule(ctx context.Context, in *vtctldata.DeleteQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.DeleteQueryRuleResponse, error) {
+	out := new(vtctldata.DeleteQueryRuleResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteQueryRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) DeleteShards(ctx context.Context, in *vtctldata.DeleteShardsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteShardsResponse, error) {
 	out := new(vtctldata.DeleteShardsResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteShards", in, out, opts...)
@@ -457,6 +605,15 @@ func (c *vtctldClient) DeleteSrvVSchema(ctx context.Context, in *vtctldata.Delet
 	return out, nil
 }
 
+func (c *vtctldClient) DeleteTableACL(ctx context.Context, in *vtctldata.DeleteTableACLRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTableACLResponse, error) {
+	out := new(vtctldata.DeleteTableACLResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteTableACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) DeleteTablets(ctx context.Context, in *vtctldata.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTabletsResponse, error) {
 	out := new(vtctldata.DeleteTabletsResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteTablets", in, out, opts...)
@@ -466,6 +623,15 @@ func (c *vtctldClient) DeleteTablets(ctx context.Context, in *vtctldata.DeleteTa
 	return out, nil
 }
 
+func (c *vtctldClient) DeleteVersionedTable(ctx context.Context, in *vtctldata.DeleteVersionedTableRequest, opts ...grpc.CallOption) (*vtctldata.DeleteVersionedTableResponse, error) {
+	out := new(vtctldata.DeleteVersionedTableResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteVersionedTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error) {
 	out := new(vtctldata.EmergencyReparentShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/EmergencyReparentShard", in, out, opts...)
@@ -475,6 +641,15 @@ func (c *vtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldata
 	return out, nil
 }
 
+func (c *vtctldClient) EvacuateCell(ctx context.Context, in *vtctldata.EvacuateCellRequest, opts ...grpc.CallOption) (*vtctldata.EvacuateCellResponse, error) {
+	out := new(vtctldata.EvacuateCellResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/EvacuateCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ExecuteHook(ctx context.Context, in *vtctldata.ExecuteHookRequest, opts ...grpc.CallOption) (*vtctldata.ExecuteHookResponse, error) {
 	out := new(vtctldata.ExecuteHookResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ExecuteHook", in, out, opts...)
@@ -529,6 +704,15 @@ func (c *vtctldClient) GetCellsAliases(ctx context.Context, in *vtctldata.GetCel
 	return out, nil
 }
 
+func (c *vtctldClient) GetDeadLetters(ctx context.Context, in *vtctldata.GetDeadLettersRequest, opts ...grpc.CallOption) (*vtctldata.GetDeadLettersResponse, error) {
+	out := new(vtctldata.GetDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error) {
 	out := new(vtctldata.GetKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetKeyspace", in, out, opts...)
@@ -547,6 +731,33 @@ func (c *vtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspa
 	return out, nil
 }
 
+func (c *vtctldClient) GetMaskingPolicy(ctx context.Context, in *vtctldata.GetMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldata.GetMaskingPolicyResponse, error) {
+	out := new(vtctldata.GetMaskingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetMaskingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldata.GetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldata.GetOnlineDDLSchedulerConfigResponse, error) {
+	out := new(vtctldata.GetOnlineDDLSchedulerConfigResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetOnlineDDLSchedulerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error) {
+	out := new(vtctldata.GetQueryRulesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetQueryRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error) {
 	out := new(vtctldata.GetRoutingRulesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetRoutingRules", in, out, opts...)
@@ -565,6 +776,33 @@ func (c *vtctldClient) GetSchema(ctx context.Context, in *vtctldata.GetSchemaReq
 	return out, nil
 }
 
+func (c *vtctldClient) GetSchemaMigrationProgress(ctx context.Context, in *vtctldata.GetSchemaMigrationProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaMigrationProgressResponse, error) {
+	out := new(vtctldata.GetSchemaMigrationProgressResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSchemaMigrationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetSchemaOwnership(ctx context.Context, in *vtctldata.GetSchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaOwnershipResponse, error) {
+	out := new(vtctldata.GetSchemaOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSchemaOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetSequences(ctx context.Context, in *vtctldata.GetSequencesRequest, opts ...grpc.CallOption) (*vtctldata.GetSequencesResponse, error) {
+	out := new(vtctldata.GetSequencesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSequences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetShard(ctx context.Context, in *vtctldata.GetShardRequest, opts ...grpc.CallOption) (*vtctldata.GetShardResponse, error) {
 	out := new(vtctldata.GetShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetShard", in, out, opts...)
@@ -610,6 +848,24 @@ func (c *vtctldClient) GetSrvVSchemas(ctx context.Context, in *vtctldata.GetSrvV
 	return out, nil
 }
 
+func (c *vtctldClient) GetStorageUsage(ctx context.Context, in *vtctldata.GetStorageUsageRequest, opts ...grpc.CallOption) (*vtctldata.GetStorageUsageResponse, error) {
+	out := new(vtctldata.GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetTableACL(ctx context.Context, in *vtctldata.GetTableACLRequest, opts ...grpc.CallOption) (*vtctldata.GetTableACLResponse, error) {
+	out := new(vtctldata.GetTableACLResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetTableACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetTablet(ctx context.Context, in *vtctldata.GetTabletRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletResponse, error) {
 	out := new(vtctldata.GetTabletResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetTablet", in, out, opts...)
@@ -619,6 +875,15 @@ func (c *vtctldClient) GetTablet(ctx context.Context, in *vtctldata.GetTabletReq
 	return out, nil
 }
 
+func (c *vtctldClient) GetTabletStatsSnapshot(ctx context.Context, in *vtctldata.GetTabletStatsSnapshotRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletStatsSnapshotResponse, error) {
+	out := new(vtctldata.GetTabletStatsSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetTabletStatsSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetTablets(ctx context.Context, in *vtctldata.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletsResponse, error) {
 	out := new(vtctldata.GetTabletsResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetTablets", in, out, opts...)
@@ -628,6 +893,15 @@ func (c *vtctldClient) GetTablets(ctx context.Context, in *vtctldata.GetTabletsR
 	return out, nil
 }
 
+func (c *vtctldClient) GetUnresolvedTransactions(ctx context.Context, in *vtctldata.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*vtctldata.GetUnresolvedTransactionsResponse, error) {
+	out := new(vtctldata.GetUnresolvedTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetUnresolvedTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error) {
 	out := new(vtctldata.GetVSchemaResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetVSchema", in, out, opts...)
@@ -637,6 +911,15 @@ func (c *vtctldClient) GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaR
 	return out, nil
 }
 
+func (c *vtctldClient) GetWorkflowProgress(ctx context.Context, in *vtctldata.GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowProgressResponse, error) {
+	out := new(vtctldata.GetWorkflowProgressResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflowProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetWorkflows(ctx context.Context, in *vtctldata.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowsResponse, error) {
 	out := new(vtctldata.GetWorkflowsResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflows", in, out, opts...)
@@ -673,6 +956,15 @@ func (c *vtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldata.P
 	return out, nil
 }
 
+func (c *vtctldClient) PurgeDeadLetters(ctx context.Context, in *vtctldata.PurgeDeadLettersRequest, opts ...grpc.CallOption) (*vtctldata.PurgeDeadLettersResponse, error) {
+	out := new(vtctldata.PurgeDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PurgeDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) RebuildKeyspaceGraph(ctx context.Context, in *vtctldata.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildKeyspaceGraphResponse, error) {
 	out := new(vtctldata.RebuildKeyspaceGraphResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RebuildKeyspaceGraph", in, out, opts...)
@@ -754,6 +1046,33 @@ func (c *vtctldClient) RemoveShardCell(ctx context.Context, in *vtctldata.Remove
 	return out, nil
 }
 
+func (c *vtctldClient) RequeueDeadLetters(ctx context.Context, in *vtctldata.RequeueDeadLettersRequest, opts ...grpc.CallOption) (*vtctldata.RequeueDeadLettersResponse, error) {
+	out := new(vtctldata.RequeueDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RequeueDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ResetSequence(ctx context.Context, in *vtctldata.ResetSequenceRequest, opts ...grpc.CallOption) (*vtctldata.ResetSequenceResponse, error) {
+	out := new(vtctldata.ResetSequenceResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ResetSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ResolveTransaction(ctx context.Context, in *vtctldata.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtctldata.ResolveTransactionResponse, error) {
+	out := new(vtctldata.ResolveTransactionResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ResolveTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ReparentTablet(ctx context.Context, in *vtctldata.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldata.ReparentTabletResponse, error) {
 	out := new(vtctldata.ReparentTabletResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReparentTablet", in, out, opts...)
@@ -790,6 +1109,15 @@ func (c *vtctldClient) SetKeyspaceShardingInfo(ctx context.Context, in *vtctldat
 	return out, nil
 }
 
+func (c *vtctldClient) SetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldata.SetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldata.SetOnlineDDLSchedulerConfigResponse, error) {
+	out := new(vtctldata.SetOnlineDDLSchedulerConfigResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetOnlineDDLSchedulerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) SetShardIsPrimaryServing(ctx context.Context, in *vtctldata.SetShardIsPrimaryServingRequest, opts ...grpc.CallOption) (*vtctldata.SetShardIsPrimaryServingResponse, error) {
 	out := new(vtctldata.SetShardIsPrimaryServingResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetShardIsPrimaryServing", in, out, opts...)
@@ -880,6 +1208,15 @@ func (c *vtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctldata.Updat
 	return out, nil
 }
 
+func (c *vtctldClient) UpdateThrottlerConfig(ctx context.Context, in *vtctldata.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigResponse, error) {
+	out := new(vtctldata.UpdateThrottlerConfigResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateThrottlerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) Validate(ctx context.Context, in *vtctldata.ValidateRequest, opts ...grpc.CallOption) (*vtctldata.ValidateResponse, error) {
 	out := new(vtctldata.ValidateResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/Validate", in, out, opts...)
@@ -921,16 +1258,35 @@ type VtctldServer interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error)
+	// ApplyMaskingPolicy validates the masking policy of the cluster, and saves
+	// it in the topo. VReplication applies it to the streams starting after.
+	ApplyMaskingPolicy(context.Context, *vtctldata.ApplyMaskingPolicyRequest) (*vtctldata.ApplyMaskingPolicyResponse, error)
+	// ApplyQueryRule creates or replaces a custom query rule for a keyspace,
+	// shard or tablet.
+	ApplyQueryRule(context.Context, *vtctldata.ApplyQueryRuleRequest) (*vtctldata.ApplyQueryRuleResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error)
+	// ApplySchemaOwnership saves the owning teams and the approval policies of
+	// the tables of a keyspace in the topo.
+	ApplySchemaOwnership(context.Context, *vtctldata.ApplySchemaOwnershipRequest) (*vtctldata.ApplySchemaOwnershipResponse, error)
+	// ApplyTableACL validates the table ACL of a keyspace, and saves it in the
+	// topo. The tablets of the keyspace apply it when it changes.
+	ApplyTableACL(context.Context, *vtctldata.ApplyTableACLRequest) (*vtctldata.ApplyTableACLResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
 	ApplyVSchema(context.Context, *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error)
+	// ApplyVersionedTable adds or replaces a versioned table of the routing
+	// rules, which serves a new version of a table to a percentage of the
+	// sessions.
+	ApplyVersionedTable(context.Context, *vtctldata.ApplyVersionedTableRequest) (*vtctldata.ApplyVersionedTableResponse, error)
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(context.Context, *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error)
+	// CollectDiagnostics collects the profiles, goroutines, stats, recent query
+	// logs and configuration of a vttablet or vtgate into an archive.
+	CollectDiagnostics(context.Context, *vtctldata.CollectDiagnosticsRequest) (*vtctldata.CollectDiagnosticsResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
@@ -947,6 +1303,9 @@ type VtctldServer interface {
 	// Otherwise, the keyspace must be empty (have no shards), or DeleteKeyspace
 	// returns an error.
 	DeleteKeyspace(context.Context, *vtctldata.DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error)
+	// DeleteQueryRule deletes a custom query rule of a keyspace, shard or
+	// tablet.
+	DeleteQueryRule(context.Context, *vtctldata.DeleteQueryRuleRequest) (*vtctldata.DeleteQueryRuleResponse, error)
 	// DeleteShards deletes the specified shards from the topology. In recursive
 	// mode, it also deletes all tablets belonging to the shard. Otherwise, the
 	// shard must be empty (have no tablets) or DeleteShards returns an error for
@@ -954,11 +1313,20 @@ type VtctldServer interface {
 	DeleteShards(context.Context, *vtctldata.DeleteShardsRequest) (*vtctldata.DeleteShardsResponse, error)
 	// DeleteSrvVSchema deletes the SrvVSchema object in the specified cell.
 	DeleteSrvVSchema(context.Context, *vtctldata.DeleteSrvVSchemaRequest) (*vtctldata.DeleteSrvVSchemaResponse, error)
+	// DeleteTableACL deletes the table ACL of a keyspace. The tablets of the
+	// keyspace go back to the table ACL of their config file.
+	DeleteTableACL(context.Context, *vtctldata.DeleteTableACLRequest) (*vtctldata.DeleteTableACLResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error)
+	// DeleteVersionedTable deletes a versioned table of the routing rules.
+	DeleteVersionedTable(context.Context, *vtctldata.DeleteVersionedTableRequest) (*vtctldata.DeleteVersionedTableResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error)
+	// EvacuateCell moves the primaries of the keyspace out of the cell with
+	// PlannedReparentShard, skipping the shards whose primary is already out of
+	// the cell.
+	EvacuateCell(context.Context, *vtctldata.EvacuateCellRequest) (*vtctldata.EvacuateCellResponse, error)
 	// ExecuteHook runs the hook on the tablet.
 	ExecuteHook(context.Context, *vtctldata.ExecuteHookRequest) (*vtctldata.ExecuteHookResponse, error)
 	// FindAllShardsInKeyspace returns a map of shard names to shard references
@@ -974,15 +1342,34 @@ type VtctldServer interface {
 	// GetCellsAliases returns a mapping of cell alias to cells identified by that
 	// alias.
 	GetCellsAliases(context.Context, *vtctldata.GetCellsAliasesRequest) (*vtctldata.GetCellsAliasesResponse, error)
+	// GetDeadLetters returns the dead-lettered messages of a message table, on
+	// the primaries of a keyspace.
+	GetDeadLetters(context.Context, *vtctldata.GetDeadLettersRequest) (*vtctldata.GetDeadLettersResponse, error)
 	// GetKeyspace reads the given keyspace from the topo and returns it.
 	GetKeyspace(context.Context, *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error)
+	// GetMaskingPolicy returns the masking policy of the cluster.
+	GetMaskingPolicy(context.Context, *vtctldata.GetMaskingPolicyRequest) (*vtctldata.GetMaskingPolicyResponse, error)
+	// GetOnlineDDLSchedulerConfig returns the configuration of the online DDL
+	// scheduler of a keyspace.
+	GetOnlineDDLSchedulerConfig(context.Context, *vtctldata.GetOnlineDDLSchedulerConfigRequest) (*vtctldata.GetOnlineDDLSchedulerConfigResponse, error)
+	// GetQueryRules returns the custom query rules of a keyspace.
+	GetQueryRules(context.Context, *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error)
 	// GetRoutingRules returns the VSchema routing rules.
 	GetRoutingRules(context.Context, *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error)
 	// GetSchema returns the schema for a tablet, or just the schema for the
 	// specified tables in that tablet.
 	GetSchema(context.Context, *vtctldata.GetSchemaRequest) (*vtctldata.GetSchemaResponse, error)
+	// GetSchemaMigrationProgress returns the progress of the online schema
+	// migrations of a keyspace on each of its shards.
+	GetSchemaMigrationProgress(context.Context, *vtctldata.GetSchemaMigrationProgressRequest) (*vtctldata.GetSchemaMigrationProgressResponse, error)
+	// GetSchemaOwnership returns the owning teams and the approval policies of
+	// the tables of a keyspace.
+	GetSchemaOwnership(context.Context, *vtctldata.GetSchemaOwnershipRequest) (*vtctldata.GetSchemaOwnershipResponse, error)
+	// GetSequences returns the state of the sequences of an unsharded keyspace,
+	// as stored in their tables and cached by its primary.
+	GetSequences(context.Context, *vtctldata.GetSequencesRequest) (*vtctldata.GetSequencesResponse, error)
 	// GetShard returns information about a shard in the topology.
 	GetShard(context.Context, *vtctldata.GetShardRequest) (*vtctldata.GetShardResponse, error)
 	// GetSrvKeyspaceNames returns a mapping of cell name to the keyspaces served
@@ -996,12 +1383,27 @@ type VtctldServer interface {
 	// GetSrvVSchemas returns a mapping from cell name to SrvVSchema for all cells,
 	// optionally filtered by cell name.
 	GetSrvVSchemas(context.Context, *vtctldata.GetSrvVSchemasRequest) (*vtctldata.GetSrvVSchemasResponse, error)
+	// GetStorageUsage returns the size of the tables of the primaries of a
+	// keyspace, with their growth and the forecast time their disks are full.
+	GetStorageUsage(context.Context, *vtctldata.GetStorageUsageRequest) (*vtctldata.GetStorageUsageResponse, error)
+	// GetTableACL returns the table ACL of a keyspace.
+	GetTableACL(context.Context, *vtctldata.GetTableACLRequest) (*vtctldata.GetTableACLResponse, error)
 	// GetTablet returns information about a tablet.
 	GetTablet(context.Context, *vtctldata.GetTabletRequest) (*vtctldata.GetTabletResponse, error)
+	// GetTabletStatsSnapshot captures a snapshot of the stats a tablet exports
+	// on /debug/vars.
+	GetTabletStatsSnapshot(context.Context, *vtctldata.GetTabletStatsSnapshotRequest) (*vtctldata.GetTabletStatsSnapshotResponse, error)
 	// GetTablets returns tablets, optionally filtered by keyspace and shard.
 	GetTablets(context.Context, *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error)
+	// GetUnresolvedTransactions returns the distributed transactions which are
+	// not resolved on the primaries of a keyspace.
+	GetUnresolvedTransactions(context.Context, *vtctldata.GetUnresolvedTransactionsRequest) (*vtctldata.GetUnresolvedTransactionsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(context.Context, *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error)
+	// GetWorkflowProgress returns the progress of a workflow, with the rows
+	// and bytes copied, the replication lag and the estimated time left, per
+	// stream and per table.
+	GetWorkflowProgress(context.Context, *vtctldata.GetWorkflowProgressRequest) (*vtctldata.GetWorkflowProgressResponse, error)
 	// GetWorkflows returns a list of workflows for the given keyspace.
 	GetWorkflows(context.Context, *vtctldata.GetWorkflowsRequest) (*vtctldata.GetWorkflowsResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
@@ -1022,6 +1424,9 @@ type VtctldServer interface {
 	// current shard primary is in for promotion unless NewPrimary is explicitly
 	// provided in the request.
 	PlannedReparentShard(context.Context, *vtctldata.PlannedReparentShardRequest) (*vtctldata.PlannedReparentShardResponse, error)
+	// PurgeDeadLetters deletes dead-lettered messages of a message table, on the
+	// primaries of a keyspace.
+	PurgeDeadLetters(context.Context, *vtctldata.PurgeDeadLettersRequest) (*vtctldata.PurgeDeadLettersResponse, error)
 	// RebuildKeyspaceGraph rebuilds the serving data for a keyspace.
 	//
 	// This may trigger an update to all connected clients.
@@ -1052,6 +1457,15 @@ type VtctldServer interface {
 	// RemoveShardCell removes the specified cell from the specified shard's Cells
 	// list.
 	RemoveShardCell(context.Context, *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error)
+	// RequeueDeadLetters schedules dead-lettered messages of a message table to
+	// be sent again, on the primaries of a keyspace.
+	RequeueDeadLetters(context.Context, *vtctldata.RequeueDeadLettersRequest) (*vtctldata.RequeueDeadLettersResponse, error)
+	// ResetSequence sets the next ID and the cache size of a sequence, and drops
+	// the values cached by the primary of its keyspace.
+	ResetSequence(context.Context, *vtctldata.ResetSequenceRequest) (*vtctldata.ResetSequenceResponse, error)
+	// ResolveTransaction commits or rolls back a distributed transaction on all
+	// its participants, and removes its metadata.
+	ResolveTransaction(context.Context, *vtctldata.ResolveTransactionRequest) (*vtctldata.ResolveTransactionResponse, error)
 	// ReparentTablet reparents a tablet to the current primary in the shard. This
 	// only works if the current replica position matches the last known reparent
 	// action.
@@ -1065,6 +1479,9 @@ type VtctldServer interface {
 	SetKeyspaceServedFrom(context.Context, *vtctldata.SetKeyspaceServedFromRequest) (*vtctldata.SetKeyspaceServedFromResponse, error)
 	// SetKeyspaceShardingInfo updates the sharding information for a keyspace.
 	SetKeyspaceShardingInfo(context.Context, *vtctldata.SetKeyspaceShardingInfoRequest) (*vtctldata.SetKeyspaceShardingInfoResponse, error)
+	// SetOnlineDDLSchedulerConfig replaces the configuration of the online DDL
+	// scheduler of a keyspace.
+	SetOnlineDDLSchedulerConfig(context.Context, *vtctldata.SetOnlineDDLSchedulerConfigRequest) (*vtctldata.SetOnlineDDLSchedulerConfigResponse, error)
 	// SetShardIsPrimaryServing adds or removes a shard from serving.
 	//
 	// This is meant as an emergency function. It does not rebuild any serving
@@ -1109,6 +1526,11 @@ type VtctldServer interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error)
+	// UpdateThrottlerConfig updates the tablet throttler configuration of a
+	// keyspace, in the keyspace record and in the SrvKeyspace of every cell.
+	// The tablets running with -throttler_config_via_topo apply it without a
+	// restart.
+	UpdateThrottlerConfig(context.Context, *vtctldata.UpdateThrottlerConfigRequest) (*vtctldata.UpdateThrottlerConfigResponse, error)
 	// Validate validates that all nodes from the global replication graph are
 	// reachable, and that all tablets in discoverable cells are consistent.
 	Validate(context.Context, *vtctldata.ValidateRequest) (*vtctldata.ValidateResponse, error)
@@ -1131,15 +1553,33 @@ func (UnimplementedVtctldServer) AddCellInfo(context.Context, *vtctldata.AddCell
 func (UnimplementedVtctldServer) AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) ApplyMaskingPolicy(context.Context, *vtctldata.ApplyMaskingPolicyRequest) (*vtctldata.ApplyMaskingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyMaskingPolicy not implemented")
+}
+func (UnimplementedVtctldServer) ApplyQueryRule(context.Context, *vtctldata.ApplyQueryRuleRequest) (*vtctldata.ApplyQueryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyQueryRule not implemented")
+}
 func (UnimplementedVtctldServer) ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyRoutingRules not implemented")
 }
+func (UnimplementedVtctldServer) ApplySchemaOwnership(context.Context, *vtctldata.ApplySchemaOwnershipRequest) (*vtctldata.ApplySchemaOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySchemaOwnership not implemented")
+}
+func (UnimplementedVtctldServer) ApplyTableACL(context.Context, *vtctldata.ApplyTableACLRequest) (*vtctldata.ApplyTableACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyTableACL not implemented")
+}
 func (UnimplementedVtctldServer) ApplyVSchema(context.Context, *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyVSchema not implemented")
 }
+func (UnimplementedVtctldServer) ApplyVersionedTable(context.Context, *vtctldata.ApplyVersionedTableRequest) (*vtctldata.ApplyVersionedTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyVersionedTable not implemented")
+}
 func (UnimplementedVtctldServer) ChangeTabletType(context.Context, *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeTabletType not implemented")
 }
+func (UnimplementedVtctldServer) CollectDiagnostics(context.Context, *vtctldata.CollectDiagnosticsRequest) (*vtctldata.CollectDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectDiagnostics not implemented")
+}
 func (UnimplementedVtctldServer) CreateKeyspace(context.Context, *vtctldata.CreateKeyspaceRequest) (*vtctldata.CreateKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
 }
@@ -1155,18 +1595,30 @@ func (UnimplementedVtctldServer) DeleteCellsAlias(context.Context, *vtctldata.De
 func (UnimplementedVtctldServer) DeleteKeyspace(context.Context, *vtctldata.DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeyspace not implemented")
 }
+func (UnimplementedVtctldServer) DeleteQueryRule(context.Context, *vtctldata.DeleteQueryRuleRequest) (*vtctldata.DeleteQueryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueryRule not implemented")
+}
 func (UnimplementedVtctldServer) DeleteShards(context.Context, *vtctldata.DeleteShardsRequest) (*vtctldata.DeleteShardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteShards not implemented")
 }
 func (UnimplementedVtctldServer) DeleteSrvVSchema(context.Context, *vtctldata.DeleteSrvVSchemaRequest) (*vtctldata.DeleteSrvVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSrvVSchema not implemented")
 }
+func (UnimplementedVtctldServer) DeleteTableACL(context.Context, *vtctldata.DeleteTableACLRequest) (*vtctldata.DeleteTableACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTableACL not implemented")
+}
 func (UnimplementedVtctldServer) DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTablets not implemented")
 }
+func (UnimplementedVtctldServer) DeleteVersionedTable(context.Context, *vtctldata.DeleteVersionedTableRequest) (*vtctldata.DeleteVersionedTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVersionedTable not implemented")
+}
 func (UnimplementedVtctldServer) EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmergencyReparentShard not implemented")
 }
+func (UnimplementedVtctldServer) EvacuateCell(context.Context, *vtctldata.EvacuateCellRequest) (*vtctldata.EvacuateCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateCell not implemented")
+}
 func (UnimplementedVtctldServer) ExecuteHook(context.Context, *vtctldata.ExecuteHookRequest) (*vtctldata.ExecuteHookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteHook not implemented")
 }
@@ -1185,18 +1637,39 @@ func (UnimplementedVtctldServer) GetCellInfoNames(context.Context, *vtctldata.Ge
 func (UnimplementedVtctldServer) GetCellsAliases(context.Context, *vtctldata.GetCellsAliasesRequest) (*vtctldata.GetCellsAliasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCellsAliases not implemented")
 }
+func (UnimplementedVtctldServer) GetDeadLetters(context.Context, *vtctldata.GetDeadLettersRequest) (*vtctldata.GetDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetters not implemented")
+}
 func (UnimplementedVtctldServer) GetKeyspace(context.Context, *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspace not implemented")
 }
 func (UnimplementedVtctldServer) GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaces not implemented")
 }
+func (UnimplementedVtctldServer) GetMaskingPolicy(context.Context, *vtctldata.GetMaskingPolicyRequest) (*vtctldata.GetMaskingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaskingPolicy not implemented")
+}
+func (UnimplementedVtctldServer) GetOnlineDDLSchedulerConfig(context.Context, *vtctldata.GetOnlineDDLSchedulerConfigRequest) (*vtctldata.GetOnlineDDLSchedulerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnlineDDLSchedulerConfig not implemented")
+}
+func (UnimplementedVtctldServer) GetQueryRules(context.Context, *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryRules not implemented")
+}
 func (UnimplementedVtctldServer) GetRoutingRules(context.Context, *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoutingRules not implemented")
 }
 func (UnimplementedVtctldServer) GetSchema(context.Context, *vtctldata.GetSchemaRequest) (*vtctldata.GetSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
+func (UnimplementedVtctldServer) GetSchemaMigrationProgress(context.Context, *vtctldata.GetSchemaMigrationProgressRequest) (*vtctldata.GetSchemaMigrationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaMigrationProgress not implemented")
+}
+func (UnimplementedVtctldServer) GetSchemaOwnership(context.Context, *vtctldata.GetSchemaOwnershipRequest) (*vtctldata.GetSchemaOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaOwnership not implemented")
+}
+func (UnimplementedVtctldServer) GetSequences(context.Context, *vtctldata.GetSequencesRequest) (*vtctldata.GetSequencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSequences not implemented")
+}
 func (UnimplementedVtctldServer) GetShard(context.Context, *vtctldata.GetShardRequest) (*vtctldata.GetShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
@@ -1212,15 +1685,30 @@ func (UnimplementedVtctldServer) GetSrvVSchema(context.Context, *vtctldata.GetSr
 func (UnimplementedVtctldServer) GetSrvVSchemas(context.Context, *vtctldata.GetSrvVSchemasRequest) (*vtctldata.GetSrvVSchemasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSrvVSchemas not implemented")
 }
+func (UnimplementedVtctldServer) GetStorageUsage(context.Context, *vtctldata.GetStorageUsageRequest) (*vtctldata.GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedVtctldServer) GetTableACL(context.Context, *vtctldata.GetTableACLRequest) (*vtctldata.GetTableACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTableACL not implemented")
+}
 func (UnimplementedVtctldServer) GetTablet(context.Context, *vtctldata.GetTabletRequest) (*vtctldata.GetTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTablet not implemented")
 }
+func (UnimplementedVtctldServer) GetTabletStatsSnapshot(context.Context, *vtctldata.GetTabletStatsSnapshotRequest) (*vtctldata.GetTabletStatsSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTabletStatsSnapshot not implemented")
+}
 func (UnimplementedVtctldServer) GetTablets(context.Context, *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTablets not implemented")
 }
+func (UnimplementedVtctldServer) GetUnresolvedTransactions(context.Context, *vtctldata.GetUnresolvedTransactionsRequest) (*vtctldata.GetUnresolvedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnresolvedTransactions not implemented")
+}
 func (UnimplementedVtctldServer) GetVSchema(context.Context, *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVSchema not implemented")
 }
+func (UnimplementedVtctldServer) GetWorkflowProgress(context.Context, *vtctldata.GetWorkflowProgressRequest) (*vtctldata.GetWorkflowProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowProgress not implemented")
+}
 func (UnimplementedVtctldServer) GetWorkflows(context.Context, *vtctldata.GetWorkflowsRequest) (*vtctldata.GetWorkflowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
 }
@@ -1233,6 +1721,9 @@ func (UnimplementedVtctldServer) PingTablet(context.Context, *vtctldata.PingTabl
 func (UnimplementedVtctldServer) PlannedReparentShard(context.Context, *vtctldata.PlannedReparentShardRequest) (*vtctldata.PlannedReparentShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlannedReparentShard not implemented")
 }
+func (UnimplementedVtctldServer) PurgeDeadLetters(context.Context, *vtctldata.PurgeDeadLettersRequest) (*vtctldata.PurgeDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
 func (UnimplementedVtctldServer) RebuildKeyspaceGraph(context.Context, *vtctldata.RebuildKeyspaceGraphRequest) (*vtctldata.RebuildKeyspaceGraphResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildKeyspaceGraph not implemented")
 }
@@ -1260,6 +1751,15 @@ func (UnimplementedVtctldServer) RemoveKeyspaceCell(context.Context, *vtctldata.
 func (UnimplementedVtctldServer) RemoveShardCell(context.Context, *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveShardCell not implemented")
 }
+func (UnimplementedVtctldServer) RequeueDeadLetters(context.Context, *vtctldata.RequeueDeadLettersRequest) (*vtctldata.RequeueDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueDeadLetters not implemented")
+}
+func (UnimplementedVtctldServer) ResetSequence(context.Context, *vtctldata.ResetSequenceRequest) (*vtctldata.ResetSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetSequence not implemented")
+}
+func (UnimplementedVtctldServer) ResolveTransaction(context.Context, *vtctldata.ResolveTransactionRequest) (*vtctldata.ResolveTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTransaction not implemented")
+}
 func (UnimplementedVtctldServer) ReparentTablet(context.Context, *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReparentTablet not implemented")
 }
@@ -1272,6 +1772,9 @@ func (UnimplementedVtctldServer) SetKeyspaceServedFrom(context.Context, *vtctlda
 func (UnimplementedVtctldServer) SetKeyspaceShardingInfo(context.Context, *vtctldata.SetKeyspaceShardingInfoRequest) (*vtctldata.SetKeyspaceShardingInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspaceShardingInfo not implemented")
 }
+func (UnimplementedVtctldServer) SetOnlineDDLSchedulerConfig(context.Context, *vtctldata.SetOnlineDDLSchedulerConfigRequest) (*vtctldata.SetOnlineDDLSchedulerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnlineDDLSchedulerConfig not implemented")
+}
 func (UnimplementedVtctldServer) SetShardIsPrimaryServing(context.Context, *vtctldata.SetShardIsPrimaryServingRequest) (*vtctldata.SetShardIsPrimaryServingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetShardIsPrimaryServing not implemented")
 }
@@ -1302,6 +1805,9 @@ func (UnimplementedVtctldServer) UpdateCellInfo(context.Context, *vtctldata.Upda
 func (UnimplementedVtctldServer) UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) UpdateThrottlerConfig(context.Context, *vtctldata.UpdateThrottlerConfigRequest) (*vtctldata.UpdateThrottlerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateThrottlerConfig not implemented")
+}
 func (UnimplementedVtctldServer) Validate(context.Context, *vtctldata.ValidateRequest) (*vtctldata.ValidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
 }
@@ -1360,164 +1866,290 @@ func _Vtctld_AddCellsAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_ApplyRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.ApplyRoutingRulesRequest)
+func _Vtctld_ApplyMaskingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyMaskingPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).ApplyRoutingRules(ctx, in)
+		return srv.(VtctldServer).ApplyMaskingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/ApplyRoutingRules",
+		FullMethod: "/vtctlservice.Vtctld/ApplyMaskingPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).ApplyRoutingRules(ctx, req.(*vtctldata.ApplyRoutingRulesRequest))
+		return srv.(VtctldServer).ApplyMaskingPolicy(ctx, req.(*vtctldata.ApplyMaskingPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_ApplyVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.ApplyVSchemaRequest)
+func _Vtctld_ApplyQueryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyQueryRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).ApplyVSchema(ctx, in)
+		return srv.(VtctldServer).ApplyQueryRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/ApplyVSchema",
+		FullMethod: "/vtctlservice.Vtctld/ApplyQueryRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).ApplyVSchema(ctx, req.(*vtctldata.ApplyVSchemaRequest))
+		return srv.(VtctldServer).ApplyQueryRule(ctx, req.(*vtctldata.ApplyQueryRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.ChangeTabletTypeRequest)
+func _Vtctld_ApplyRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyRoutingRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).ChangeTabletType(ctx, in)
+		return srv.(VtctldServer).ApplyRoutingRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/ChangeTabletType",
+		FullMethod: "/vtctlservice.Vtctld/ApplyRoutingRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).ChangeTabletType(ctx, req.(*vtctldata.ChangeTabletTypeRequest))
+		return srv.(VtctldServer).ApplyRoutingRules(ctx, req.(*vtctldata.ApplyRoutingRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.CreateKeyspaceRequest)
+func _Vtctld_ApplySchemaOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplySchemaOwnershipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).CreateKeyspace(ctx, in)
+		return srv.(VtctldServer).ApplySchemaOwnership(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/CreateKeyspace",
+		FullMethod: "/vtctlservice.Vtctld/ApplySchemaOwnership",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).CreateKeyspace(ctx, req.(*vtctldata.CreateKeyspaceRequest))
+		return srv.(VtctldServer).ApplySchemaOwnership(ctx, req.(*vtctldata.ApplySchemaOwnershipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_CreateShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.CreateShardRequest)
+func _Vtctld_ApplyTableACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyTableACLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).CreateShard(ctx, in)
+		return srv.(VtctldServer).ApplyTableACL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/CreateShard",
+		FullMethod: "/vtctlservice.Vtctld/ApplyTableACL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).CreateShard(ctx, req.(*vtctldata.CreateShardRequest))
+		return srv.(VtctldServer).ApplyTableACL(ctx, req.(*vtctldata.ApplyTableACLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_DeleteCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.DeleteCellInfoRequest)
+func _Vtctld_ApplyVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyVSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).DeleteCellInfo(ctx, in)
+		return srv.(VtctldServer).ApplyVSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/DeleteCellInfo",
+		FullMethod: "/vtctlservice.Vtctld/ApplyVSchema",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).DeleteCellInfo(ctx, req.(*vtctldata.DeleteCellInfoRequest))
+		return srv.(VtctldServer).ApplyVSchema(ctx, req.(*vtctldata.ApplyVSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_DeleteCellsAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.DeleteCellsAliasRequest)
+func _Vtctld_ApplyVersionedTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyVersionedTableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).DeleteCellsAlias(ctx, in)
+		return srv.(VtctldServer).ApplyVersionedTable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/DeleteCellsAlias",
+		FullMethod: "/vtctlservice.Vtctld/ApplyVersionedTable",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).DeleteCellsAlias(ctx, req.(*vtctldata.DeleteCellsAliasRequest))
+		return srv.(VtctldServer).ApplyVersionedTable(ctx, req.(*vtctldata.ApplyVersionedTableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_DeleteKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.DeleteKeyspaceRequest)
+func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ChangeTabletTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).DeleteKeyspace(ctx, in)
+		return srv.(VtctldServer).ChangeTabletType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/DeleteKeyspace",
+		FullMethod: "/vtctlservice.Vtctld/ChangeTabletType",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).DeleteKeyspace(ctx, req.(*vtctldata.DeleteKeyspaceRequest))
+		return srv.(VtctldServer).ChangeTabletType(ctx, req.(*vtctldata.ChangeTabletTypeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_DeleteShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.DeleteShardsRequest)
+func _Vtctld_CollectDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CollectDiagnosticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).DeleteShards(ctx, in)
+		return srv.(VtctldServer).CollectDiagnostics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/DeleteShards",
+		FullMethod: "/vtctlservice.Vtctld/CollectDiagnostics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).DeleteShards(ctx, req.(*vtctldata.DeleteShardsRequest))
+		return srv.(VtctldServer).CollectDiagnostics(ctx, req.(*vtctldata.CollectDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CreateKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CreateKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CreateKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CreateKeyspace(ctx, req.(*vtctldata.CreateKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CreateShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CreateShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CreateShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CreateShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CreateShard(ctx, req.(*vtctldata.CreateShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteCellInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteCellInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteCellInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteCellInfo(ctx, req.(*vtctldata.DeleteCellInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteCellsAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteCellsAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteCellsAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteCellsAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteCellsAlias(ctx, req.(*vtctldata.DeleteCellsAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteKeyspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteKeyspace(ctx, req.(*vtctldata.DeleteKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteQueryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteQueryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteQueryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteQueryRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteQueryRule(ctx, req.(*vtctldata.DeleteQueryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteShards(ctx, req.(*vtctldata.DeleteShardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1540,6 +2172,24 @@ func _Vtctld_DeleteSrvVSchema_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_DeleteTableACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteTableACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteTableACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteTableACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteTableACL(ctx, req.(*vtctldata.DeleteTableACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_DeleteTablets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.DeleteTabletsRequest)
 	if err := dec(in); err != nil {
@@ -1558,6 +2208,24 @@ func _Vtctld_DeleteTablets_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_DeleteVersionedTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteVersionedTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteVersionedTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteVersionedTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteVersionedTable(ctx, req.(*vtctldata.DeleteVersionedTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_EmergencyReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.EmergencyReparentShardRequest)
 	if err := dec(in); err != nil {
@@ -1576,6 +2244,24 @@ func _Vtctld_EmergencyReparentShard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_EvacuateCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.EvacuateCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).EvacuateCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/EvacuateCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).EvacuateCell(ctx, req.(*vtctldata.EvacuateCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_ExecuteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ExecuteHookRequest)
 	if err := dec(in); err != nil {
@@ -1684,6 +2370,24 @@ func _Vtctld_GetCellsAliases_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetDeadLetters(ctx, req.(*vtctldata.GetDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -1720,6 +2424,60 @@ func _Vtctld_GetKeyspaces_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetMaskingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetMaskingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetMaskingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetMaskingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetMaskingPolicy(ctx, req.(*vtctldata.GetMaskingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetOnlineDDLSchedulerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetOnlineDDLSchedulerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetOnlineDDLSchedulerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetOnlineDDLSchedulerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetOnlineDDLSchedulerConfig(ctx, req.(*vtctldata.GetOnlineDDLSchedulerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetQueryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetQueryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetQueryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetQueryRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetQueryRules(ctx, req.(*vtctldata.GetQueryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetRoutingRulesRequest)
 	if err := dec(in); err != nil {
@@ -1756,6 +2514,60 @@ func _Vtctld_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetSchemaMigrationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSchemaMigrationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSchemaMigrationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSchemaMigrationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSchemaMigrationProgress(ctx, req.(*vtctldata.GetSchemaMigrationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetSchemaOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSchemaOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSchemaOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSchemaOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSchemaOwnership(ctx, req.(*vtctldata.GetSchemaOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetSequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSequences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSequences(ctx, req.(*vtctldata.GetSequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetShardRequest)
 	if err := dec(in); err != nil {
@@ -1846,6 +2658,42 @@ func _Vtctld_GetSrvVSchemas_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetStorageUsage(ctx, req.(*vtctldata.GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetTableACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetTableACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetTableACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetTableACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetTableACL(ctx, req.(*vtctldata.GetTableACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetTabletRequest)
 	if err := dec(in); err != nil {
@@ -1864,6 +2712,24 @@ func _Vtctld_GetTablet_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetTabletStatsSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetTabletStatsSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetTabletStatsSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetTabletStatsSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetTabletStatsSnapshot(ctx, req.(*vtctldata.GetTabletStatsSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetTablets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetTabletsRequest)
 	if err := dec(in); err != nil {
@@ -1882,6 +2748,24 @@ func _Vtctld_GetTablets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetUnresolvedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetUnresolvedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetUnresolvedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetUnresolvedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetUnresolvedTransactions(ctx, req.(*vtctldata.GetUnresolvedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetVSchemaRequest)
 	if err := dec(in); err != nil {
@@ -1900,6 +2784,24 @@ func _Vtctld_GetVSchema_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetWorkflowProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetWorkflowProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetWorkflowProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetWorkflowProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetWorkflowProgress(ctx, req.(*vtctldata.GetWorkflowProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetWorkflowsRequest)
 	if err := dec(in); err != nil {
@@ -1972,6 +2874,24 @@ func _Vtctld_PlannedReparentShard_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.PurgeDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).PurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/PurgeDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).PurgeDeadLetters(ctx, req.(*vtctldata.PurgeDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_RebuildKeyspaceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.RebuildKeyspaceGraphRequest)
 	if err := dec(in); err != nil {
@@ -2134,6 +3054,60 @@ func _Vtctld_RemoveShardCell_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_RequeueDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RequeueDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RequeueDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RequeueDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RequeueDeadLetters(ctx, req.(*vtctldata.RequeueDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ResetSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ResetSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ResetSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ResetSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ResetSequence(ctx, req.(*vtctldata.ResetSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ResolveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ResolveTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ResolveTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ResolveTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ResolveTransaction(ctx, req.(*vtctldata.ResolveTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_ReparentTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ReparentTabletRequest)
 	if err := dec(in); err != nil {
@@ -2206,6 +3180,24 @@ func _Vtctld_SetKeyspaceShardingInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_SetOnlineDDLSchedulerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetOnlineDDLSchedulerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetOnlineDDLSchedulerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetOnlineDDLSchedulerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetOnlineDDLSchedulerConfig(ctx, req.(*vtctldata.SetOnlineDDLSchedulerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_SetShardIsPrimaryServing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.SetShardIsPrimaryServingRequest)
 	if err := dec(in); err != nil {
@@ -2386,6 +3378,24 @@ func _Vtctld_UpdateCellsAlias_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_UpdateThrottlerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateThrottlerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateThrottlerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateThrottlerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateThrottlerConfig(ctx, req.(*vtctldata.UpdateThrottlerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ValidateRequest)
 	if err := dec(in); err != nil {
@@ -2455,18 +3465,42 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCellsAlias",
 			Handler:    _Vtctld_AddCellsAlias_Handler,
 		},
+		{
+			MethodName: "ApplyMaskingPolicy",
+			Handler:    _Vtctld_ApplyMaskingPolicy_Handler,
+		},
+		{
+			MethodName: "ApplyQueryRule",
+			Handler:    _Vtctld_ApplyQueryRule_Handler,
+		},
 		{
 			MethodName: "ApplyRoutingRules",
 			Handler:    _Vtctld_ApplyRoutingRules_Handler,
 		},
+		{
+			MethodName: "ApplySchemaOwnership",
+			Handler:    _Vtctld_ApplySchemaOwnership_Handler,
+		},
+		{
+			MethodName: "ApplyTableACL",
+			Handler:    _Vtctld_ApplyTableACL_Handler,
+		},
 		{
 			MethodName: "ApplyVSchema",
 			Handler:    _Vtctld_ApplyVSchema_Handler,
 		},
+		{
+			MethodName: "ApplyVersionedTable",
+			Handler:    _Vtctld_ApplyVersionedTable_Handler,
+		},
 		{
 			MethodName: "ChangeTabletType",
 			Handler:    _Vtctld_ChangeTabletType_Handler,
 		},
+		{
+			MethodName: "CollectDiagnostics",
+			Handler:    _Vtctld_CollectDiagnostics_Handler,
+		},
 		{
 			MethodName: "CreateKeyspace",
 			Handler:    _Vtctld_CreateKeyspace_Handler,
@@ -2487,6 +3521,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteKeyspace",
 			Handler:    _Vtctld_DeleteKeyspace_Handler,
 		},
+		{
+			MethodName: "DeleteQueryRule",
+			Handler:    _Vtctld_DeleteQueryRule_Handler,
+		},
 		{
 			MethodName: "DeleteShards",
 			Handler:    _Vtctld_DeleteShards_Handler,
@@ -2495,14 +3533,26 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSrvVSchema",
 			Handler:    _Vtctld_DeleteSrvVSchema_Handler,
 		},
+		{
+			MethodName: "DeleteTableACL",
+			Handler:    _Vtctld_DeleteTableACL_Handler,
+		},
 		{
 			MethodName: "DeleteTablets",
 			Handler:    _Vtctld_DeleteTablets_Handler,
 		},
+		{
+			MethodName: "DeleteVersionedTable",
+			Handler:    _Vtctld_DeleteVersionedTable_Handler,
+		},
 		{
 			MethodName: "EmergencyReparentShard",
 			Handler:    _Vtctld_EmergencyReparentShard_Handler,
 		},
+		{
+			MethodName: "EvacuateCell",
+			Handler:    _Vtctld_EvacuateCell_Handler,
+		},
 		{
 			MethodName: "ExecuteHook",
 			Handler:    _Vtctld_ExecuteHook_Handler,
@@ -2527,6 +3577,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCellsAliases",
 			Handler:    _Vtctld_GetCellsAliases_Handler,
 		},
+		{
+			MethodName: "GetDeadLetters",
+			Handler:    _Vtctld_GetDeadLetters_Handler,
+		},
 		{
 			MethodName: "GetKeyspace",
 			Handler:    _Vtctld_GetKeyspace_Handler,
@@ -2535,6 +3589,18 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKeyspaces",
 			Handler:    _Vtctld_GetKeyspaces_Handler,
 		},
+		{
+			MethodName: "GetMaskingPolicy",
+			Handler:    _Vtctld_GetMaskingPolicy_Handler,
+		},
+		{
+			MethodName: "GetOnlineDDLSchedulerConfig",
+			Handler:    _Vtctld_GetOnlineDDLSchedulerConfig_Handler,
+		},
+		{
+			MethodName: "GetQueryRules",
+			Handler:    _Vtctld_GetQueryRules_Handler,
+		},
 		{
 			MethodName: "GetRoutingRules",
 			Handler:    _Vtctld_GetRoutingRules_Handler,
@@ -2543,6 +3609,18 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchema",
 			Handler:    _Vtctld_GetSchema_Handler,
 		},
+		{
+			MethodName: "GetSchemaMigrationProgress",
+			Handler:    _Vtctld_GetSchemaMigrationProgress_Handler,
+		},
+		{
+			MethodName: "GetSchemaOwnership",
+			Handler:    _Vtctld_GetSchemaOwnership_Handler,
+		},
+		{
+			MethodName: "GetSequences",
+			Handler:    _Vtctld_GetSequences_Handler,
+		},
 		{
 			MethodName: "GetShard",
 			Handler:    _Vtctld_GetShard_Handler,
@@ -2563,18 +3641,38 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSrvVSchemas",
 			Handler:    _Vtctld_GetSrvVSchemas_Handler,
 		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _Vtctld_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "GetTableACL",
+			Handler:    _Vtctld_GetTableACL_Handler,
+		},
 		{
 			MethodName: "GetTablet",
 			Handler:    _Vtctld_GetTablet_Handler,
 		},
+		{
+			MethodName: "GetTabletStatsSnapshot",
+			Handler:    _Vtctld_GetTabletStatsSnapshot_Handler,
+		},
 		{
 			MethodName: "GetTablets",
 			Handler:    _Vtctld_GetTablets_Handler,
 		},
+		{
+			MethodName: "GetUnresolvedTransactions",
+			Handler:    _Vtctld_GetUnresolvedTransactions_Handler,
+		},
 		{
 			MethodName: "GetVSchema",
 			Handler:    _Vtctld_GetVSchema_Handler,
 		},
+		{
+			MethodName: "GetWorkflowProgress",
+			Handler:    _Vtctld_GetWorkflowProgress_Handler,
+		},
 		{
 			MethodName: "GetWorkflows",
 			Handler:    _Vtctld_GetWorkflows_Handler,
@@ -2591,6 +3689,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlannedReparentShard",
 			Handler:    _Vtctld_PlannedReparentShard_Handler,
 		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _Vtctld_PurgeDeadLetters_Handler,
+		},
 		{
 			MethodName: "RebuildKeyspaceGraph",
 			Handler:    _Vtctld_RebuildKeyspaceGraph_Handler,
@@ -2627,6 +3729,18 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveShardCell",
 			Handler:    _Vtctld_RemoveShardCell_Handler,
 		},
+		{
+			MethodName: "RequeueDeadLetters",
+			Handler:    _Vtctld_RequeueDeadLetters_Handler,
+		},
+		{
+			MethodName: "ResetSequence",
+			Handler:    _Vtctld_ResetSequence_Handler,
+		},
+		{
+			MethodName: "ResolveTransaction",
+			Handler:    _Vtctld_ResolveTransaction_Handler,
+		},
 		{
 			MethodName: "ReparentTablet",
 			Handler:    _Vtctld_ReparentTablet_Handler,
@@ -2643,6 +3757,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetKeyspaceShardingInfo",
 			Handler:    _Vtctld_SetKeyspaceShardingInfo_Handler,
 		},
+		{
+			MethodName: "SetOnlineDDLSchedulerConfig",
+			Handler:    _Vtctld_SetOnlineDDLSchedulerConfig_Handler,
+		},
 		{
 			MethodName: "SetShardIsPrimaryServing",
 			Handler:    _Vtctld_SetShardIsPrimaryServing_Handler,
@@ -2683,6 +3801,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCellsAlias",
 			Handler:    _Vtctld_UpdateCellsAlias_Handler,
 		},
+		{
+			MethodName: "UpdateThrottlerConfig",
+			Handler:    _Vtctld_UpdateThrottlerConfig_Handler,
+		},
 		{
 			MethodName: "Validate",
 			Handler:    _Vtctld_Validate_Handler,
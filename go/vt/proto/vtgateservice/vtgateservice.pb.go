@@ -44,7 +44,7 @@ var file_vtgateservice_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x1a, 0x0c, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0x8f, 0x04, 0x0a, 0x06, 0x56, 0x69, 0x74, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a,
+	0x74, 0x6f, 0x32, 0xdf, 0x04, 0x0a, 0x06, 0x56, 0x69, 0x74, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a,
 	0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74,
 	0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x17, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
@@ -53,6 +53,11 @@ var file_vtgateservice_proto_rawDesc = []byte{
 	0x67, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
 	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74,
 	0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1c, 0x2e, 0x76, 0x74, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x74, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x74, 0x67, 0x61,
 	0x74, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
@@ -87,36 +92,40 @@ var file_vtgateservice_proto_rawDesc = []byte{
 var file_vtgateservice_proto_goTypes = []interface{}{
 	(*vtgate.ExecuteRequest)(nil),             // 0: vtgate.ExecuteRequest
 	(*vtgate.ExecuteBatchRequest)(nil),        // 1: vtgate.ExecuteBatchRequest
-	(*vtgate.StreamExecuteRequest)(nil),       // 2: vtgate.StreamExecuteRequest
-	(*vtgate.ResolveTransactionRequest)(nil),  // 3: vtgate.ResolveTransactionRequest
-	(*vtgate.VStreamRequest)(nil),             // 4: vtgate.VStreamRequest
-	(*vtgate.PrepareRequest)(nil),             // 5: vtgate.PrepareRequest
-	(*vtgate.CloseSessionRequest)(nil),        // 6: vtgate.CloseSessionRequest
-	(*vtgate.ExecuteResponse)(nil),            // 7: vtgate.ExecuteResponse
-	(*vtgate.ExecuteBatchResponse)(nil),       // 8: vtgate.ExecuteBatchResponse
-	(*vtgate.StreamExecuteResponse)(nil),      // 9: vtgate.StreamExecuteResponse
-	(*vtgate.ResolveTransactionResponse)(nil), // 10: vtgate.ResolveTransactionResponse
-	(*vtgate.VStreamResponse)(nil),            // 11: vtgate.VStreamResponse
-	(*vtgate.PrepareResponse)(nil),            // 12: vtgate.PrepareResponse
-	(*vtgate.CloseSessionResponse)(nil),       // 13: vtgate.CloseSessionResponse
+	(*vtgate.ExecuteScriptRequest)(nil),       // 2: vtgate.ExecuteScriptRequest
+	(*vtgate.StreamExecuteRequest)(nil),       // 3: vtgate.StreamExecuteRequest
+	(*vtgate.ResolveTransactionRequest)(nil),  // 4: vtgate.ResolveTransactionRequest
+	(*vtgate.VStreamRequest)(nil),             // 5: vtgate.VStreamRequest
+	(*vtgate.PrepareRequest)(nil),             // 6: vtgate.PrepareRequest
+	(*vtgate.CloseSessionRequest)(nil),        // 7: vtgate.CloseSessionRequest
+	(*vtgate.ExecuteResponse)(nil),            // 8: vtgate.ExecuteResponse
+	(*vtgate.ExecuteBatchResponse)(nil),       // 9: vtgate.ExecuteBatchResponse
+	(*vtgate.ExecuteScriptResponse)(nil),      // 10: vtgate.ExecuteScriptResponse
+	(*vtgate.StreamExecuteResponse)(nil),      // 11: vtgate.StreamExecuteResponse
+	(*vtgate.ResolveTransactionResponse)(nil), // 12: vtgate.ResolveTransactionResponse
+	(*vtgate.VStreamResponse)(nil),            // 13: vtgate.VStreamResponse
+	(*vtgate.PrepareResponse)(nil),            // 14: vtgate.PrepareResponse
+	(*vtgate.CloseSessionResponse)(nil),       // 15: vtgate.CloseSessionResponse
 }
 var file_vtgateservice_proto_depIdxs = []int32{
 	0,  // 0: vtgateservice.Vitess.Execute:input_type -> vtgate.ExecuteRequest
 	1,  // 1: vtgateservice.Vitess.ExecuteBatch:input_type -> vtgate.ExecuteBatchRequest
-	2,  // 2: vtgateservice.Vitess.StreamExecute:input_type -> vtgate.StreamExecuteRequest
-	3,  // 3: vtgateservice.Vitess.ResolveTransaction:input_type -> vtgate.ResolveTransactionRequest
-	4,  // 4: vtgateservice.Vitess.VStream:input_type -> vtgate.VStreamRequest
-	5,  // 5: vtgateservice.Vitess.Prepare:input_type -> vtgate.PrepareRequest
-	6,  // 6: vtgateservice.Vitess.CloseSession:input_type -> vtgate.CloseSessionRequest
-	7,  // 7: vtgateservice.Vitess.Execute:output_type -> vtgate.ExecuteResponse
-	8,  // 8: vtgateservice.Vitess.ExecuteBatch:output_type -> vtgate.ExecuteBatchResponse
-	9,  // 9: vtgateservice.Vitess.StreamExecute:output_type -> vtgate.StreamExecuteResponse
-	10, // 10: vtgateservice.Vitess.ResolveTransaction:output_type -> vtgate.ResolveTransactionResponse
-	11, // 11: vtgateservice.Vitess.VStream:output_type -> vtgate.VStreamResponse
-	12, // 12: vtgateservice.Vitess.Prepare:output_type -> vtgate.PrepareResponse
-	13, // 13: vtgateservice.Vitess.CloseSession:output_type -> vtgate.CloseSessionResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	2,  // 2: vtgateservice.Vitess.ExecuteScript:input_type -> vtgate.ExecuteScriptRequest
+	3,  // 3: vtgateservice.Vitess.StreamExecute:input_type -> vtgate.StreamExecuteRequest
+	4,  // 4: vtgateservice.Vitess.ResolveTransaction:input_type -> vtgate.ResolveTransactionRequest
+	5,  // 5: vtgateservice.Vitess.VStream:input_type -> vtgate.VStreamRequest
+	6,  // 6: vtgateservice.Vitess.Prepare:input_type -> vtgate.PrepareRequest
+	7,  // 7: vtgateservice.Vitess.CloseSession:input_type -> vtgate.CloseSessionRequest
+	8,  // 8: vtgateservice.Vitess.Execute:output_type -> vtgate.ExecuteResponse
+	9,  // 9: vtgateservice.Vitess.ExecuteBatch:output_type -> vtgate.ExecuteBatchResponse
+	10, // 10: vtgateservice.Vitess.ExecuteScript:output_type -> vtgate.ExecuteScriptResponse
+	11, // 11: vtgateservice.Vitess.StreamExecute:output_type -> vtgate.StreamExecuteResponse
+	12, // 12: vtgateservice.Vitess.ResolveTransaction:output_type -> vtgate.ResolveTransactionResponse
+	13, // 13: vtgateservice.Vitess.VStream:output_type -> vtgate.VStreamResponse
+	14, // 14: vtgateservice.Vitess.Prepare:output_type -> vtgate.PrepareResponse
+	15, // 15: vtgateservice.Vitess.CloseSession:output_type -> vtgate.CloseSessionResponse
+	8,  // [8:16] is the sub-list for method output_type
+	0,  // [0:8] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
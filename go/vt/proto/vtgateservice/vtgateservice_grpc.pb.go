@@ -29,6 +29,10 @@ type VitessClient interface {
 	// information in conjunction with the vindexes to route the query.
 	// API group: v3
 	ExecuteBatch(ctx context.Context, in *vtgate.ExecuteBatchRequest, opts ...grpc.CallOption) (*vtgate.ExecuteBatchResponse, error)
+	// ExecuteScript executes a script of statements in a single transaction,
+	// which is rolled back if any of them fails or aborts the script.
+	// API group: v3
+	ExecuteScript(ctx context.Context, in *vtgate.ExecuteScriptRequest, opts ...grpc.CallOption) (*vtgate.ExecuteScriptResponse, error)
 	// StreamExecute executes a streaming query based on shards.
 	// It depends on the query and bind variables to provide enough
 	// information in conjunction with the vindexes to route the query.
@@ -74,6 +78,15 @@ func (c *vitessClient) ExecuteBatch(ctx context.Context, in *vtgate.ExecuteBatch
 	return out, nil
 }
 
+func (c *vitessClient) ExecuteScript(ctx context.Context, in *vtgate.ExecuteScriptRequest, opts ...grpc.CallOption) (*vtgate.ExecuteScriptResponse, error) {
+	out := new(vtgate.ExecuteScriptResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/ExecuteScript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vitessClient) StreamExecute(ctx context.Context, in *vtgate.StreamExecuteRequest, opts ...grpc.CallOption) (Vitess_StreamExecuteClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Vitess_ServiceDesc.Streams[0], "/vtgateservice.Vitess/StreamExecute", opts...)
 	if err != nil {
@@ -179,6 +192,10 @@ type VitessServer interface {
 	// information in conjunction with the vindexes to route the query.
 	// API group: v3
 	ExecuteBatch(context.Context, *vtgate.ExecuteBatchRequest) (*vtgate.ExecuteBatchResponse, error)
+	// ExecuteScript executes a script of statements in a single transaction,
+	// which is rolled back if any of them fails or aborts the script.
+	// API group: v3
+	ExecuteScript(context.Context, *vtgate.ExecuteScriptRequest) (*vtgate.ExecuteScriptResponse, error)
 	// StreamExecute executes a streaming query based on shards.
 	// It depends on the query and bind variables to provide enough
 	// information in conjunction with the vindexes to route the query.
@@ -209,6 +226,9 @@ func (UnimplementedVitessServer) Execute(context.Context, *vtgate.ExecuteRequest
 func (UnimplementedVitessServer) ExecuteBatch(context.Context, *vtgate.ExecuteBatchRequest) (*vtgate.ExecuteBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBatch not implemented")
 }
+func (UnimplementedVitessServer) ExecuteScript(context.Context, *vtgate.ExecuteScriptRequest) (*vtgate.ExecuteScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScript not implemented")
+}
 func (UnimplementedVitessServer) StreamExecute(*vtgate.StreamExecuteRequest, Vitess_StreamExecuteServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamExecute not implemented")
 }
@@ -273,6 +293,24 @@ func _Vitess_ExecuteBatch_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vitess_ExecuteScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.ExecuteScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).ExecuteScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/ExecuteScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).ExecuteScript(ctx, req.(*vtgate.ExecuteScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vitess_StreamExecute_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(vtgate.StreamExecuteRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -384,6 +422,10 @@ var Vitess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteBatch",
 			Handler:    _Vitess_ExecuteBatch_Handler,
 		},
+		{
+			MethodName: "ExecuteScript",
+			Handler:    _Vitess_ExecuteScript_Handler,
+		},
 		{
 			MethodName: "ResolveTransaction",
 			Handler:    _Vitess_ResolveTransaction_Handler,
@@ -19,8 +19,13 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/shlex"
+
+	"vitess.io/vitess/go/cron"
 )
 
 var (
@@ -35,6 +40,16 @@ const (
 	allowZeroInDateFlag    = "allow-zero-in-date"
 	postponeCompletionFlag = "postpone-completion"
 	vreplicationTestSuite  = "vreplication-test-suite"
+	throttlerAppFlag       = "throttler-app"
+	throttleRatioFlag      = "throttle-ratio"
+	cutOverWindowsFlag     = "cut-over-windows"
+	desiredSchemaFlag      = "desired-schema"
+	allowDestructiveFlag   = "allow-destructive"
+	revertibleFlag         = "revertible"
+	retainArtifactsFlag    = "retain-artifacts"
+	priorityFlag           = "priority"
+	dependsOnFlag          = "depends-on"
+	postponeUntilFlag      = "postpone-until"
 )
 
 // DDLStrategy suggests how an ALTER TABLE should run (e.g. "direct", "online", "gh-ost" or "pt-osc")
@@ -61,6 +76,30 @@ func (s DDLStrategy) IsDirect() bool {
 	return true
 }
 
+// MigrationPriority is the priority class of a migration, given by -priority. Queued migrations of a higher
+// priority are scheduled before those of a lower priority, regardless of when they were submitted.
+type MigrationPriority string
+
+const (
+	// MigrationPriorityHigh is scheduled before any other priority
+	MigrationPriorityHigh MigrationPriority = "high"
+	// MigrationPriorityNormal is the priority of migrations which do not specify -priority
+	MigrationPriorityNormal MigrationPriority = "normal"
+	// MigrationPriorityLow is scheduled after any other priority
+	MigrationPriorityLow MigrationPriority = "low"
+)
+
+// Rank returns the rank of the priority; a migration of a higher rank is scheduled first
+func (p MigrationPriority) Rank() int {
+	switch p {
+	case MigrationPriorityHigh:
+		return 2
+	case MigrationPriorityLow:
+		return 0
+	}
+	return 1
+}
+
 // DDLStrategySetting is a formal breakdown of the @@ddl_strategy variable, into strategy and options
 type DDLStrategySetting struct {
 	Strategy DDLStrategy `json:"strategy,omitempty"`
@@ -92,6 +131,31 @@ func ParseDDLStrategy(strategyVariable string) (*DDLStrategySetting, error) {
 	default:
 		return nil, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
 	}
+	if _, err := setting.ThrottleRatio(); err != nil {
+		return nil, err
+	}
+	windows, err := setting.CutOverWindows()
+	if err != nil {
+		return nil, err
+	}
+	if len(windows) > 0 && setting.Strategy == DDLStrategyPTOSC {
+		return nil, fmt.Errorf("-%s is not supported by the %s strategy", cutOverWindowsFlag, setting.Strategy)
+	}
+	if setting.IsRevertible() && setting.Strategy != DDLStrategyGhost && setting.Strategy != DDLStrategyPTOSC {
+		return nil, fmt.Errorf("-%s is only supported by the %s and %s strategies", revertibleFlag, DDLStrategyGhost, DDLStrategyPTOSC)
+	}
+	if _, err := setting.RetainArtifacts(); err != nil {
+		return nil, err
+	}
+	if _, err := setting.Priority(); err != nil {
+		return nil, err
+	}
+	if _, err := setting.DependsOn(); err != nil {
+		return nil, err
+	}
+	if _, err := setting.PostponeUntil(); err != nil {
+		return nil, err
+	}
 	return setting, nil
 }
 
@@ -106,6 +170,28 @@ func isFlag(s string, name string) bool {
 	return false
 }
 
+// isFlagValue returns the value of the given string when it is a CLI flag of the given name with a value,
+// e.g. -name=value
+func isFlagValue(s string, name string) (string, bool) {
+	for _, prefix := range []string{fmt.Sprintf("-%s=", name), fmt.Sprintf("--%s=", name)} {
+		if strings.HasPrefix(s, prefix) {
+			return s[len(prefix):], true
+		}
+	}
+	return "", false
+}
+
+// flagValue returns the value of the named flag in Options
+func (setting *DDLStrategySetting) flagValue(name string) (string, bool) {
+	opts, _ := shlex.Split(setting.Options)
+	for _, opt := range opts {
+		if value, ok := isFlagValue(opt, name); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
 // hasFlag returns true when Options include named flag
 func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	opts, _ := shlex.Split(setting.Options)
@@ -122,6 +208,24 @@ func (setting *DDLStrategySetting) IsDeclarative() bool {
 	return setting.hasFlag(declarativeFlag)
 }
 
+// IsDesiredSchema checks if strategy options include -desired-schema, which marks a declarative migration as part
+// of a complete desired schema. Its destructive changes are rejected unless -allow-destructive is set.
+func (setting *DDLStrategySetting) IsDesiredSchema() bool {
+	return setting.hasFlag(desiredSchemaFlag)
+}
+
+// IsAllowDestructive checks if strategy options include -allow-destructive
+func (setting *DDLStrategySetting) IsAllowDestructive() bool {
+	return setting.hasFlag(allowDestructiveFlag)
+}
+
+// IsRevertible checks if strategy options include -revertible, which makes a gh-ost or pt-osc ALTER revertible.
+// Such an ALTER may only add and drop columns and indexes. Dropped columns are retained as invisible shadow
+// columns for as long as the migration is revertible.
+func (setting *DDLStrategySetting) IsRevertible() bool {
+	return setting.hasFlag(revertibleFlag)
+}
+
 // IsSingleton checks if strategy options include -singleton
 func (setting *DDLStrategySetting) IsSingleton() bool {
 	return setting.hasFlag(singletonFlag)
@@ -147,9 +251,104 @@ func (setting *DDLStrategySetting) IsVreplicationTestSuite() bool {
 	return setting.hasFlag(vreplicationTestSuite)
 }
 
+// ThrottlerApp returns the value of -throttler-app, the throttler app of the vreplication stream of an online migration.
+// It is empty when the stream uses the default, online-ddl:<uuid>.
+func (setting *DDLStrategySetting) ThrottlerApp() string {
+	value, _ := setting.flagValue(throttlerAppFlag)
+	return value
+}
+
+// ThrottleRatio returns the value of -throttle-ratio, the ratio at which the vreplication stream of an online
+// migration throttles itself.
+func (setting *DDLStrategySetting) ThrottleRatio() (float64, error) {
+	value, ok := setting.flagValue(throttleRatioFlag)
+	if !ok {
+		return 0, nil
+	}
+	ratio, err := strconv.ParseFloat(value, 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return 0, fmt.Errorf("invalid -%s value: '%s', expecting a ratio between 0 and 1", throttleRatioFlag, value)
+	}
+	return ratio, nil
+}
+
+// CutOverWindows returns the windows of -cut-over-windows, the semicolon separated cron expressions of the times
+// at which the migration is allowed to cut over. The migration can cut over at any time when there are none.
+func (setting *DDLStrategySetting) CutOverWindows() (cron.Windows, error) {
+	value, _ := setting.flagValue(cutOverWindowsFlag)
+	windows, err := cron.ParseWindows(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -%s value: %v", cutOverWindowsFlag, err)
+	}
+	return windows, nil
+}
+
+// RetainArtifacts returns the value of -retain-artifacts, how long the artifacts of the migration are retained after
+// it completes, and so for how long it can be reverted. It is zero when the migration uses the default of vttablet.
+func (setting *DDLStrategySetting) RetainArtifacts() (time.Duration, error) {
+	value, ok := setting.flagValue(retainArtifactsFlag)
+	if !ok {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid -%s value: '%s', expecting a positive duration", retainArtifactsFlag, value)
+	}
+	return d, nil
+}
+
+// Priority returns the value of -priority, the priority class of the migration. It is normal by default.
+func (setting *DDLStrategySetting) Priority() (MigrationPriority, error) {
+	value, ok := setting.flagValue(priorityFlag)
+	if !ok {
+		return MigrationPriorityNormal, nil
+	}
+	switch priority := MigrationPriority(value); priority {
+	case MigrationPriorityHigh, MigrationPriorityNormal, MigrationPriorityLow:
+		return priority, nil
+	}
+	return "", fmt.Errorf("invalid -%s value: '%s', expecting one of %s, %s, %s", priorityFlag, value, MigrationPriorityHigh, MigrationPriorityNormal, MigrationPriorityLow)
+}
+
+// DependsOn returns the UUIDs of -depends-on, the comma separated migrations which must complete before this
+// migration is scheduled. The migration fails if any of them fails or is cancelled.
+func (setting *DDLStrategySetting) DependsOn() ([]string, error) {
+	value, ok := setting.flagValue(dependsOnFlag)
+	if !ok {
+		return nil, nil
+	}
+	var uuids []string
+	for _, uuid := range strings.Split(value, ",") {
+		uuid = strings.TrimSpace(uuid)
+		if !IsOnlineDDLUUID(uuid) {
+			return nil, fmt.Errorf("invalid -%s value: '%s', expecting comma separated migration UUIDs", dependsOnFlag, value)
+		}
+		uuids = append(uuids, uuid)
+	}
+	return uuids, nil
+}
+
+// PostponeUntil returns the value of -postpone-until, the RFC 3339 time before which the migration is not
+// scheduled. It is zero when the migration can be scheduled at any time.
+func (setting *DDLStrategySetting) PostponeUntil() (time.Time, error) {
+	value, ok := setting.flagValue(postponeUntilFlag)
+	if !ok {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid -%s value: '%s', expecting an RFC 3339 time", postponeUntilFlag, value)
+	}
+	return t, nil
+}
+
 // RuntimeOptions returns the options used as runtime flags for given strategy, removing any internal hint options
 func (setting *DDLStrategySetting) RuntimeOptions() []string {
 	opts, _ := shlex.Split(setting.Options)
+	hasValue := func(opt string, name string) bool {
+		_, ok := isFlagValue(opt, name)
+		return ok
+	}
 	validOpts := []string{}
 	for _, opt := range opts {
 		switch {
@@ -160,6 +359,16 @@ func (setting *DDLStrategySetting) RuntimeOptions() []string {
 		case isFlag(opt, allowZeroInDateFlag):
 		case isFlag(opt, postponeCompletionFlag):
 		case isFlag(opt, vreplicationTestSuite):
+		case isFlag(opt, desiredSchemaFlag):
+		case isFlag(opt, allowDestructiveFlag):
+		case isFlag(opt, revertibleFlag):
+		case hasValue(opt, throttlerAppFlag):
+		case hasValue(opt, throttleRatioFlag):
+		case hasValue(opt, cutOverWindowsFlag):
+		case hasValue(opt, retainArtifactsFlag):
+		case hasValue(opt, priorityFlag):
+		case hasValue(opt, dependsOnFlag):
+		case hasValue(opt, postponeUntilFlag):
 		default:
 			validOpts = append(validOpts, opt)
 		}
@@ -19,6 +19,7 @@ package schema
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -40,8 +41,18 @@ func TestParseDDLStrategy(t *testing.T) {
 		strategy             DDLStrategy
 		options              string
 		isDeclarative        bool
+		isDesiredSchema      bool
+		isAllowDestructive   bool
 		isSingleton          bool
 		isPostponeCompletion bool
+		isRevertible         bool
+		retainArtifacts      time.Duration
+		priority             MigrationPriority
+		dependsOn            []string
+		postponeUntil        time.Time
+		throttlerApp         string
+		throttleRatio        float64
+		cutOverWindows       string
 		runtimeOptions       string
 		err                  error
 	}{
@@ -85,6 +96,15 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:   "--max-load=Threads_running=100",
 			isDeclarative:    true,
 		},
+		{
+			strategyVariable:   "online --declarative --desired-schema --allow-destructive",
+			strategy:           DDLStrategyOnline,
+			options:            "--declarative --desired-schema --allow-destructive",
+			runtimeOptions:     "",
+			isDeclarative:      true,
+			isDesiredSchema:    true,
+			isAllowDestructive: true,
+		},
 		{
 			strategyVariable: "pt-osc -singleton",
 			strategy:         DDLStrategyPTOSC,
@@ -99,6 +119,38 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:       "",
 			isPostponeCompletion: true,
 		},
+		{
+			strategyVariable: "online -throttler-app=backfill --throttle-ratio=0.75",
+			strategy:         DDLStrategyOnline,
+			options:          "-throttler-app=backfill --throttle-ratio=0.75",
+			runtimeOptions:   "",
+			throttlerApp:     "backfill",
+			throttleRatio:    0.75,
+		},
+		{
+			strategyVariable: `gh-ost --cut-over-windows="* 2-4 * * 1-5; * * * * 0,6" --max-load=Threads_running=100`,
+			strategy:         DDLStrategyGhost,
+			options:          `--cut-over-windows="* 2-4 * * 1-5; * * * * 0,6" --max-load=Threads_running=100`,
+			runtimeOptions:   "--max-load=Threads_running=100",
+			cutOverWindows:   "* 2-4 * * 1-5; * * * * 0,6",
+		},
+		{
+			strategyVariable: "pt-osc --revertible --retain-artifacts=72h",
+			strategy:         DDLStrategyPTOSC,
+			options:          "--revertible --retain-artifacts=72h",
+			runtimeOptions:   "",
+			isRevertible:     true,
+			retainArtifacts:  72 * time.Hour,
+		},
+		{
+			strategyVariable: "online -priority=high -depends-on=a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a,b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a --postpone-until=2021-10-01T02:00:00Z",
+			strategy:         DDLStrategyOnline,
+			options:          "-priority=high -depends-on=a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a,b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a --postpone-until=2021-10-01T02:00:00Z",
+			runtimeOptions:   "",
+			priority:         MigrationPriorityHigh,
+			dependsOn:        []string{"a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a", "b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"},
+			postponeUntil:    time.Date(2021, 10, 1, 2, 0, 0, 0, time.UTC),
+		},
 	}
 	for _, ts := range tt {
 		setting, err := ParseDDLStrategy(ts.strategyVariable)
@@ -106,8 +158,33 @@ func TestParseDDLStrategy(t *testing.T) {
 		assert.Equal(t, ts.strategy, setting.Strategy)
 		assert.Equal(t, ts.options, setting.Options)
 		assert.Equal(t, ts.isDeclarative, setting.IsDeclarative())
+		assert.Equal(t, ts.isDesiredSchema, setting.IsDesiredSchema())
+		assert.Equal(t, ts.isAllowDestructive, setting.IsAllowDestructive())
 		assert.Equal(t, ts.isSingleton, setting.IsSingleton())
 		assert.Equal(t, ts.isPostponeCompletion, setting.IsPostponeCompletion())
+		assert.Equal(t, ts.isRevertible, setting.IsRevertible())
+		retainArtifacts, err := setting.RetainArtifacts()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.retainArtifacts, retainArtifacts)
+		priority, err := setting.Priority()
+		assert.NoError(t, err)
+		if ts.priority == "" {
+			ts.priority = MigrationPriorityNormal
+		}
+		assert.Equal(t, ts.priority, priority)
+		dependsOn, err := setting.DependsOn()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.dependsOn, dependsOn)
+		postponeUntil, err := setting.PostponeUntil()
+		assert.NoError(t, err)
+		assert.True(t, ts.postponeUntil.Equal(postponeUntil))
+		assert.Equal(t, ts.throttlerApp, setting.ThrottlerApp())
+		throttleRatio, err := setting.ThrottleRatio()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.throttleRatio, throttleRatio)
+		cutOverWindows, err := setting.CutOverWindows()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.cutOverWindows, cutOverWindows.String())
 
 		runtimeOptions := strings.Join(setting.RuntimeOptions(), " ")
 		assert.Equal(t, ts.runtimeOptions, runtimeOptions)
@@ -116,4 +193,41 @@ func TestParseDDLStrategy(t *testing.T) {
 		_, err := ParseDDLStrategy("other")
 		assert.Error(t, err)
 	}
+	{
+		_, err := ParseDDLStrategy("online -throttle-ratio=2")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("online -cut-over-windows='* 25 * * *'")
+		assert.EqualError(t, err, `invalid -cut-over-windows value: invalid cron expression "* 25 * * *": hour "25" out of range 0-23`)
+	}
+	{
+		_, err := ParseDDLStrategy("pt-osc -cut-over-windows='* 2-4 * * *'")
+		assert.EqualError(t, err, "-cut-over-windows is not supported by the pt-osc strategy")
+	}
+	{
+		_, err := ParseDDLStrategy("online -revertible")
+		assert.EqualError(t, err, "-revertible is only supported by the gh-ost and pt-osc strategies")
+	}
+	{
+		_, err := ParseDDLStrategy("online -retain-artifacts=forever")
+		assert.EqualError(t, err, "invalid -retain-artifacts value: 'forever', expecting a positive duration")
+	}
+	{
+		_, err := ParseDDLStrategy("online -priority=urgent")
+		assert.EqualError(t, err, "invalid -priority value: 'urgent', expecting one of high, normal, low")
+	}
+	{
+		_, err := ParseDDLStrategy("online -depends-on=a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a,t1")
+		assert.EqualError(t, err, "invalid -depends-on value: 'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a,t1', expecting comma separated migration UUIDs")
+	}
+	{
+		_, err := ParseDDLStrategy("online -postpone-until=tomorrow")
+		assert.EqualError(t, err, "invalid -postpone-until value: 'tomorrow', expecting an RFC 3339 time")
+	}
+}
+
+func TestMigrationPriorityRank(t *testing.T) {
+	assert.Greater(t, MigrationPriorityHigh.Rank(), MigrationPriorityNormal.Rank())
+	assert.Greater(t, MigrationPriorityNormal.Rank(), MigrationPriorityLow.Rank())
 }
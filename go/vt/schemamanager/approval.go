@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	approvalCheckerName = flag.String("schema_change_approval_checker", "token", "Name of the approval checker which validates the approval token of the schema changes to the tables with an approval policy. The default checker requires a non-empty token.")
+)
+
+// ApprovalChecker validates the approval token of a schema change to a table
+// with an approval policy.
+type ApprovalChecker func(ctx context.Context, keyspace string, table string, ownership *vtctldatapb.TableOwnership, token string) error
+
+var (
+	approvalCheckers = make(map[string]ApprovalChecker)
+)
+
+func init() {
+	RegisterApprovalChecker("token", checkApprovalToken)
+}
+
+// checkApprovalToken accepts any non-empty approval token.
+func checkApprovalToken(ctx context.Context, keyspace string, table string, ownership *vtctldatapb.TableOwnership, token string) error {
+	if token == "" {
+		return fmt.Errorf("schema change to table %s.%s requires an approval token, by approval policy %s of owner %s", keyspace, table, ownership.ApprovalPolicy, ownership.Owner)
+	}
+	return nil
+}
+
+// RegisterApprovalChecker registers an approval checker.
+func RegisterApprovalChecker(name string, checker ApprovalChecker) {
+	if _, ok := approvalCheckers[name]; ok {
+		panic(fmt.Sprintf("register a registered key: %s", name))
+	}
+	approvalCheckers[name] = checker
+}
+
+// GetApprovalChecker gets an ApprovalChecker.
+func GetApprovalChecker(name string) (ApprovalChecker, error) {
+	checker, ok := approvalCheckers[name]
+	if !ok {
+		return nil, fmt.Errorf("there is no approval checker with name: %s", name)
+	}
+	return checker, nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	estimatedRowsPerSecond = flag.Int("schema_change_estimated_rows_per_second", 10000, "Number of rows per second a schema change copies, used to estimate the duration of the schema changes which wait for approval.")
+)
+
+// Stage validates the schema change read by the controller, and saves it in
+// the topo as a pending schema migration, which waits for approval instead of
+// being applied. The caller sets the options of the migration, which Approve
+// applies it with; Stage sets its UUID, SQL, submitter and impact. The
+// submitter is the principal the gRPC server authenticated the caller as.
+// When the executor applies a desired schema, the SQL of the migration is the
+// diff to the desired schema as computed now, which is what gets applied once
+// approved.
+func Stage(ctx context.Context, controller Controller, executor *TabletExecutor, migration *vtctldatapb.PendingSchemaMigration) error {
+	if err := controller.Open(ctx); err != nil {
+		log.Errorf("failed to open data sourcer: %v", err)
+		return err
+	}
+	defer controller.Close()
+	sqls, err := controller.Read(ctx)
+	if err != nil {
+		log.Errorf("failed to read data from data sourcer: %v", err)
+		controller.OnReadFail(ctx, err)
+		return err
+	}
+	controller.OnReadSuccess(ctx)
+	if len(sqls) == 0 {
+		return fmt.Errorf("no schema change to submit for approval")
+	}
+	keyspace := controller.Keyspace()
+	if err := executor.Open(ctx, keyspace); err != nil {
+		log.Errorf("failed to open executor: %v", err)
+		return err
+	}
+	defer executor.Close()
+	if executor.desiredSchema {
+		if sqls, err = executor.desiredSchemaChanges(ctx, sqls); err != nil {
+			return err
+		}
+		executor.desiredSchemaComputed = true
+	}
+	if err := executor.Validate(ctx, sqls); err != nil {
+		log.Errorf("validation fail: %v", err)
+		controller.OnValidationFail(ctx, err)
+		return err
+	}
+	impact, err := executor.Impact(ctx, sqls)
+	if err != nil {
+		return err
+	}
+
+	uuid, err := schema.CreateUUID()
+	if err != nil {
+		return err
+	}
+	migration.Uuid = uuid
+	migration.Keyspace = keyspace
+	migration.Sql = sqls
+	migration.SubmittedBy = servenv.AuthenticatedPrincipalFromContext(ctx)
+	migration.SubmittedAt = protoutil.TimeToProto(time.Now())
+	migration.Impact = impact
+	return executor.wr.TopoServer().CreatePendingSchemaMigration(ctx, migration)
+}
+
+// Approve applies a pending schema migration with the executor, which the
+// caller configures with the options of the migration. The approver, who is
+// the principal the gRPC server authenticated the caller as, must be known and
+// differ from the submitter. Caller ids are not trusted, since the callers set
+// them.
+// The pending migration is deleted before it is applied, so that it is applied
+// at most once, even if it fails.
+func Approve(ctx context.Context, ts *topo.Server, migration *vtctldatapb.PendingSchemaMigration, version topo.Version, executor Executor) error {
+	approver := servenv.AuthenticatedPrincipalFromContext(ctx)
+	if approver == "" {
+		return fmt.Errorf("schema migration %s must be approved by an authenticated caller", migration.Uuid)
+	}
+	if approver == migration.SubmittedBy {
+		return fmt.Errorf("schema migration %s was submitted by %s, and must be approved by another caller", migration.Uuid, approver)
+	}
+	if err := ts.DeletePendingSchemaMigration(ctx, migration.Keyspace, migration.Uuid, version); err != nil {
+		if topo.IsErrType(err, topo.NoNode) || topo.IsErrType(err, topo.BadVersion) {
+			return fmt.Errorf("schema migration %s is no longer pending approval", migration.Uuid)
+		}
+		return err
+	}
+	log.Infof("schema migration %s submitted by %s is approved by %s", migration.Uuid, migration.SubmittedBy, approver)
+
+	return Run(ctx, NewPlainController(strings.Join(migration.Sql, ";\n"), migration.Keyspace), executor)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestTabletExecutorImpact(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "table schema", Type: tmutils.TableBaseTable, RowCount: 50000, DataLength: 4096},
+		},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor("TestTabletExecutorImpact", wr, testWaitReplicasTimeout)
+	ctx := context.Background()
+	require.NoError(t, executor.SetDDLStrategy("online"))
+	require.NoError(t, executor.Open(ctx, "test_keyspace"))
+	defer executor.Close()
+
+	impact, err := executor.Impact(ctx, []string{"ALTER TABLE t1 ADD COLUMN c int", "CREATE TABLE t2 (id int)"})
+	require.NoError(t, err)
+	require.Len(t, impact, 6)
+	for i, shard := range []string{"0", "1", "2"} {
+		assert.Equal(t, shard, impact[2*i].Shard)
+		assert.Equal(t, "t1", impact[2*i].Table)
+		assert.Equal(t, "alter", impact[2*i].DdlAction)
+		assert.EqualValues(t, 50000, impact[2*i].RowCount)
+		assert.EqualValues(t, 4096, impact[2*i].DataLength)
+		assert.Equal(t, protoutil.DurationToProto(5*time.Second), impact[2*i].EstimatedDuration)
+
+		assert.Equal(t, "t2", impact[2*i+1].Table)
+		assert.Equal(t, "create", impact[2*i+1].DdlAction)
+		assert.Nil(t, impact[2*i+1].EstimatedDuration)
+	}
+}
+
+func TestStageAndApprove(t *testing.T) {
+	sql := "ALTER TABLE t1 ADD COLUMN c int"
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "table schema", Type: tmutils.TableBaseTable},
+		},
+	})
+	ts := newFakeTopo(t)
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, fakeTmc)
+	aliceCtx := servenv.NewAuthenticatedPrincipalContext(context.Background(), "alice")
+	bobCtx := servenv.NewAuthenticatedPrincipalContext(context.Background(), "bob")
+
+	newExecutor := func() *TabletExecutor {
+		executor := NewTabletExecutor("TestStageAndApprove", wr, testWaitReplicasTimeout)
+		require.NoError(t, executor.SetDDLStrategy("online"))
+		return executor
+	}
+
+	migration := &vtctldatapb.PendingSchemaMigration{DdlStrategy: "online", RequestContext: "TestStageAndApprove"}
+	err := Stage(aliceCtx, NewPlainController(sql, "test_keyspace"), newExecutor(), migration)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", migration.SubmittedBy)
+	assert.Equal(t, []string{sql}, migration.Sql)
+	assert.Len(t, migration.Impact, 3)
+
+	pending, version, err := ts.GetPendingSchemaMigration(context.Background(), "test_keyspace", migration.Uuid)
+	require.NoError(t, err)
+	assert.Equal(t, migration.Uuid, pending.Uuid)
+
+	err = Approve(context.Background(), ts, pending, version, newExecutor())
+	assert.EqualError(t, err, "schema migration "+migration.Uuid+" must be approved by an authenticated caller")
+	// caller ids are set by the callers, and are not trusted
+	spoofedCtx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("bob", "", ""), callerid.NewImmediateCallerID("bob"))
+	err = Approve(spoofedCtx, ts, pending, version, newExecutor())
+	assert.EqualError(t, err, "schema migration "+migration.Uuid+" must be approved by an authenticated caller")
+	err = Approve(aliceCtx, ts, pending, version, newExecutor())
+	assert.EqualError(t, err, "schema migration "+migration.Uuid+" was submitted by alice, and must be approved by another caller")
+
+	require.NoError(t, Approve(bobCtx, ts, pending, version, newExecutor()))
+	_, _, err = ts.GetPendingSchemaMigration(context.Background(), "test_keyspace", migration.Uuid)
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "expected NoNode, got %v", err)
+
+	err = Approve(bobCtx, ts, pending, version, newExecutor())
+	assert.EqualError(t, err, "schema migration "+migration.Uuid+" is no longer pending approval")
+
+	// invalid schema changes are rejected when submitted
+	err = Stage(aliceCtx, NewPlainController("ALTER TABLE", "test_keyspace"), newExecutor(), &vtctldatapb.PendingSchemaMigration{})
+	assert.Error(t, err)
+	migrations, err := ts.GetPendingSchemaMigrations(context.Background(), "test_keyspace")
+	require.NoError(t, err)
+	assert.Empty(t, migrations)
+}
+
+func TestStageAndApproveDesiredSchema(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "table schema", Type: tmutils.TableBaseTable},
+		},
+	})
+	ts := newFakeTopo(t)
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, fakeTmc)
+	newExecutor := func(pending bool) *TabletExecutor {
+		executor := NewTabletExecutor("TestStageAndApproveDesiredSchema", wr, testWaitReplicasTimeout)
+		require.NoError(t, executor.SetDDLStrategy("online -allow-destructive"))
+		if pending {
+			require.NoError(t, executor.SetDesiredSchemaChanges())
+		} else {
+			require.NoError(t, executor.SetDesiredSchema())
+		}
+		return executor
+	}
+
+	// the diff to the desired schema is saved, and applied as is
+	migration := &vtctldatapb.PendingSchemaMigration{DdlStrategy: "online -allow-destructive", DesiredSchema: true}
+	err := Stage(servenv.NewAuthenticatedPrincipalContext(context.Background(), "alice"), NewPlainController("CREATE TABLE t2 (id int)", "test_keyspace"), newExecutor(false), migration)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CREATE TABLE t2 (id int)", "DROP TABLE t1"}, migration.Sql)
+
+	pending, version, err := ts.GetPendingSchemaMigration(context.Background(), "test_keyspace", migration.Uuid)
+	require.NoError(t, err)
+	err = Approve(servenv.NewAuthenticatedPrincipalContext(context.Background(), "bob"), ts, pending, version, newExecutor(true))
+	require.NoError(t, err)
+}
@@ -19,11 +19,15 @@ package schemamanager
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"context"
 
+	"vitess.io/vitess/go/vt/audit"
 	"vitess.io/vitess/go/vt/log"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
@@ -126,6 +130,7 @@ func Run(ctx context.Context, controller Controller, executor Executor) error {
 	}
 
 	result := executor.Execute(ctx, sqls)
+	recordSchemaChange(ctx, keyspace, result)
 
 	if err := controller.OnExecutorComplete(ctx, result); err != nil {
 		return err
@@ -137,6 +142,33 @@ func Run(ctx context.Context, controller Controller, executor Executor) error {
 	return nil
 }
 
+// recordSchemaChange records the audit event of a schema change.
+func recordSchemaChange(ctx context.Context, keyspace string, result *ExecuteResult) {
+	ev := &auditpb.Event{
+		Category:  auditpb.Category_SCHEMA_CHANGE,
+		Principal: audit.PrincipalFromContext(ctx),
+		Action:    "ApplySchema",
+		Resource:  keyspace,
+		Details: map[string]string{
+			"keyspace": keyspace,
+			"sql":      strings.Join(result.Sqls, ";\n"),
+		},
+	}
+	if result.ExecutorErr != "" || len(result.FailedShards) > 0 {
+		ev.Outcome = auditpb.Outcome_FAILURE
+		ev.Error = result.ExecutorErr
+		var shards []string
+		for _, shard := range result.FailedShards {
+			shards = append(shards, shard.Shard)
+			if ev.Error == "" {
+				ev.Error = shard.Err
+			}
+		}
+		ev.Details["failed_shards"] = strings.Join(shards, ",")
+	}
+	audit.Record(ev)
+}
+
 // RegisterControllerFactory register a control factory.
 func RegisterControllerFactory(name string, factory ControllerFactory) {
 	if _, ok := controllerFactories[name]; ok {
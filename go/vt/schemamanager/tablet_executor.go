@@ -18,21 +18,41 @@ package schemamanager
 
 import (
 	"fmt"
+	"io"
+	"strings"
 	"sync"
 	"time"
 
 	"context"
 
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl/schematools"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 	"vitess.io/vitess/go/vt/wrangler"
 )
 
+const (
+	sqlSelectMigrationStatus = "select migration_status, message from _vt.schema_migrations where migration_uuid = %a"
+	sqlSelectMigrationTable  = "select mysql_table from _vt.schema_migrations where migration_uuid = %a"
+)
+
+var (
+	// canaryCheckInterval is how often the migrations and the health of a canary shard are checked.
+	canaryCheckInterval = 10 * time.Second
+)
+
 // TabletExecutor applies schema changes to all tablets.
 type TabletExecutor struct {
 	requestContext       string
@@ -44,6 +64,18 @@ type TabletExecutor struct {
 	waitReplicasTimeout  time.Duration
 	ddlStrategySetting   *schema.DDLStrategySetting
 	skipPreflight        bool
+	approvalToken        string
+	desiredSchema        bool
+	// desiredSchemaComputed is set when the schema changes are the diff to the
+	// desired schema, already computed by desiredSchemaChanges
+	desiredSchemaComputed bool
+
+	canaryShard        string
+	canarySoakPeriod   time.Duration
+	canaryMaxErrorRate float64
+	canaryHealth       func(ctx context.Context, tablet *topodatapb.Tablet) (*querypb.RealtimeStats, error)
+	// submittedMigrations are the UUIDs of the online DDLs submitted to the tablets of the running phase
+	submittedMigrations []string
 }
 
 // NewTabletExecutor creates a new TabletExecutor instance
@@ -54,6 +86,7 @@ func NewTabletExecutor(requestContext string, wr *wrangler.Wrangler, waitReplica
 		allowBigSchemaChange: false,
 		waitReplicasTimeout:  waitReplicasTimeout,
 		requestContext:       requestContext,
+		canaryHealth:         streamRealtimeStats,
 	}
 }
 
@@ -84,6 +117,52 @@ func (exec *TabletExecutor) SkipPreflight() {
 	exec.skipPreflight = true
 }
 
+// SetApprovalToken sets the approval token of the schema changes, which is
+// required to change the tables with an approval policy
+func (exec *TabletExecutor) SetApprovalToken(token string) {
+	exec.approvalToken = token
+}
+
+// SetDesiredSchema makes the executor take the schema changes as the complete desired
+// schema of the keyspace: a CREATE TABLE statement for every table. They run as
+// declarative migrations, which create, alter or leave alone each table as needed,
+// and the tables which are not declared are dropped. The destructive changes, like
+// dropping a table or a column, are rejected unless the strategy has -allow-destructive.
+// The DDL strategy must be set first, and must not be direct.
+func (exec *TabletExecutor) SetDesiredSchema() error {
+	if exec.ddlStrategySetting == nil || exec.ddlStrategySetting.Strategy.IsDirect() {
+		return fmt.Errorf("a desired schema is applied with online DDL, and requires a ddl_strategy other than direct")
+	}
+	options := strings.TrimSpace(exec.ddlStrategySetting.Options + " --declarative --desired-schema")
+	exec.ddlStrategySetting = schema.NewDDLStrategySetting(exec.ddlStrategySetting.Strategy, options)
+	exec.desiredSchema = true
+	return nil
+}
+
+// SetDesiredSchemaChanges is like SetDesiredSchema, but the schema changes are
+// the diff to the desired schema as computed when the schema change was
+// submitted for approval, and are applied as is.
+func (exec *TabletExecutor) SetDesiredSchemaChanges() error {
+	if err := exec.SetDesiredSchema(); err != nil {
+		return err
+	}
+	exec.desiredSchemaComputed = true
+	return nil
+}
+
+// SetCanary makes the executor apply the schema changes to the canary shard
+// first. Once they complete successfully, including the online DDL migrations,
+// the primary of the canary shard must stay healthy for the soak period, with
+// errors making up at most maxErrorRate of its queries, before the schema
+// changes are applied to the other shards. The rollout stops at the first
+// failure of the canary. Since the canary waits for its migrations to
+// complete, it can't be used with the -postpone-completion DDL strategy flag.
+func (exec *TabletExecutor) SetCanary(shard string, soakPeriod time.Duration, maxErrorRate float64) {
+	exec.canaryShard = shard
+	exec.canarySoakPeriod = soakPeriod
+	exec.canaryMaxErrorRate = maxErrorRate
+}
+
 // Open opens a connection to the primary for every shard.
 func (exec *TabletExecutor) Open(ctx context.Context, keyspace string) error {
 	if !exec.isClosed {
@@ -122,14 +201,22 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 	if exec.isClosed {
 		return fmt.Errorf("executor is closed")
 	}
+	sqls, err := exec.desiredSchemaChanges(ctx, sqls)
+	if err != nil {
+		return err
+	}
 
 	// We ignore DATABASE-level DDLs here because detectBigSchemaChanges doesn't
 	// look at them anyway.
-	parsedDDLs, _, _, err := exec.parseDDLs(sqls)
+	parsedDDLs, _, revertStatements, err := exec.parseDDLs(sqls)
 	if err != nil {
 		return err
 	}
 
+	if err := exec.checkApprovals(ctx, parsedDDLs, revertStatements); err != nil {
+		return err
+	}
+
 	bigSchemaChange, err := exec.detectBigSchemaChanges(ctx, parsedDDLs)
 	if bigSchemaChange && exec.allowBigSchemaChange {
 		exec.wr.Logger().Warningf("Processing big schema change. This may cause visible MySQL downtime.")
@@ -138,6 +225,53 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 	return err
 }
 
+// desiredSchemaChanges returns the schema changes which converge the keyspace to
+// the desired schema: the declared CREATE TABLE statements, followed by a DROP
+// TABLE statement for every table which is not declared. It returns the schema
+// changes as is when the executor does not apply a desired schema, or when they
+// are the diff to it already.
+func (exec *TabletExecutor) desiredSchemaChanges(ctx context.Context, sqls []string) ([]string, error) {
+	if !exec.desiredSchema || exec.desiredSchemaComputed {
+		return sqls, nil
+	}
+	declared := make(map[string]bool, len(sqls))
+	for _, sql := range sqls {
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sql: %s, got error: %v", sql, err)
+		}
+		createTable, ok := stmt.(*sqlparser.CreateTable)
+		if !ok || createTable.IfNotExists {
+			return nil, fmt.Errorf("a desired schema must only contain CREATE TABLE statements without IF NOT EXISTS: %s", sql)
+		}
+		declared[createTable.Table.Name.String()] = true
+	}
+
+	// exec.tablets is guaranteed to have at least one element, and all the
+	// shards of a keyspace have the same schema.
+	dbSchema, err := exec.wr.TabletManagerClient().GetSchema(ctx, exec.tablets[0], []string{}, []string{}, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get database schema, error: %v", err)
+	}
+	var drops []string
+	for _, td := range dbSchema.TableDefinitions {
+		// A desired schema only declares tables, so the views are left as is
+		// instead of being dropped with DROP TABLE, which fails on views.
+		if td.Type == tmutils.TableView || declared[td.Name] || schema.IsInternalOperationTableName(td.Name) {
+			continue
+		}
+		drops = append(drops, td.Name)
+	}
+	if len(drops) != 0 && !exec.ddlStrategySetting.IsAllowDestructive() {
+		return nil, fmt.Errorf("desired schema drops tables %s, add -allow-destructive to the ddl_strategy to drop them", strings.Join(drops, ", "))
+	}
+	changes := append([]string(nil), sqls...)
+	for _, table := range drops {
+		changes = append(changes, fmt.Sprintf("DROP TABLE %s", sqlparser.String(sqlparser.NewTableIdent(table))))
+	}
+	return changes, nil
+}
+
 func (exec *TabletExecutor) parseDDLs(sqls []string) ([]sqlparser.DDLStatement, []sqlparser.DBDDLStatement, [](*sqlparser.RevertMigration), error) {
 	parsedDDLs := make([]sqlparser.DDLStatement, 0)
 	parsedDBDDLs := make([]sqlparser.DBDDLStatement, 0)
@@ -163,6 +297,125 @@ func (exec *TabletExecutor) parseDDLs(sqls []string) ([]sqlparser.DDLStatement,
 	return parsedDDLs, parsedDBDDLs, revertStatements, nil
 }
 
+// checkApprovals validates the approval token against the approval policies of
+// the tables the DDLs change, and of the tables of the migrations the reverts
+// revert. vtgate rejects the schema changes to these tables, so that they are
+// all validated here.
+func (exec *TabletExecutor) checkApprovals(ctx context.Context, parsedDDLs []sqlparser.DDLStatement, revertStatements []*sqlparser.RevertMigration) error {
+	ownership, err := exec.wr.TopoServer().GetSchemaOwnership(ctx, exec.keyspace)
+	if err != nil {
+		return fmt.Errorf("unable to get schema ownership for keyspace: %s, error: %v", exec.keyspace, err)
+	}
+	hasApprovalPolicy := false
+	for _, tableOwnership := range ownership.Tables {
+		if tableOwnership.ApprovalPolicy != "" {
+			hasApprovalPolicy = true
+			break
+		}
+	}
+	if !hasApprovalPolicy {
+		return nil
+	}
+	checker, err := GetApprovalChecker(*approvalCheckerName)
+	if err != nil {
+		return err
+	}
+	var tables []string
+	for _, ddl := range parsedDDLs {
+		for _, table := range ddl.AffectedTables() {
+			tables = append(tables, table.Name.String())
+		}
+	}
+	for _, revert := range revertStatements {
+		revertedTables, err := exec.revertedTables(ctx, revert.UUID)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, revertedTables...)
+	}
+	for _, table := range tables {
+		tableOwnership, ok := ownership.Tables[table]
+		if !ok || tableOwnership.ApprovalPolicy == "" {
+			continue
+		}
+		if err := checker(ctx, exec.keyspace, table, tableOwnership, exec.approvalToken); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revertedTables returns the table of a migration on every tablet of the
+// keyspace which has it.
+func (exec *TabletExecutor) revertedTables(ctx context.Context, uuid string) ([]string, error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectMigrationTable, sqltypes.StringBindVariable(uuid))
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for _, tablet := range exec.tablets {
+		qr, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 1, false, false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the table of migration %s on tablet %v, error: %v", uuid, topoproto.TabletAliasString(tablet.Alias), err)
+		}
+		for _, row := range sqltypes.Proto3ToResult(qr).Rows {
+			tables = append(tables, row[0].ToString())
+		}
+	}
+	return tables, nil
+}
+
+// Impact returns the impact of the schema changes on every table they change,
+// on every shard. The duration of the changes which copy the rows of a table
+// is estimated from -schema_change_estimated_rows_per_second.
+func (exec *TabletExecutor) Impact(ctx context.Context, sqls []string) ([]*vtctldatapb.SchemaMigrationImpact, error) {
+	if exec.isClosed {
+		return nil, fmt.Errorf("executor is closed")
+	}
+	sqls, err := exec.desiredSchemaChanges(ctx, sqls)
+	if err != nil {
+		return nil, err
+	}
+	parsedDDLs, _, _, err := exec.parseDDLs(sqls)
+	if err != nil {
+		return nil, err
+	}
+
+	var impact []*vtctldatapb.SchemaMigrationImpact
+	for _, tablet := range exec.tablets {
+		dbSchema, err := exec.wr.TabletManagerClient().GetSchema(ctx, tablet, []string{}, []string{}, false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get database schema of shard %s, error: %v", tablet.Shard, err)
+		}
+		tableDefinitions := make(map[string]*tabletmanagerdatapb.TableDefinition, len(dbSchema.TableDefinitions))
+		for _, td := range dbSchema.TableDefinitions {
+			tableDefinitions[td.Name] = td
+		}
+		for _, ddl := range parsedDDLs {
+			for _, table := range ddl.AffectedTables() {
+				tableImpact := &vtctldatapb.SchemaMigrationImpact{
+					Shard:     tablet.Shard,
+					Table:     table.Name.String(),
+					DdlAction: ddl.GetAction().ToString(),
+				}
+				td, ok := tableDefinitions[tableImpact.Table]
+				if ok {
+					tableImpact.RowCount = td.RowCount
+					tableImpact.DataLength = td.DataLength
+				}
+				// A declarative CREATE of an existing table is an ALTER.
+				copiesRows := ddl.GetAction() == sqlparser.AlterDDLAction ||
+					(ok && ddl.GetAction() == sqlparser.CreateDDLAction && exec.ddlStrategySetting != nil && exec.ddlStrategySetting.IsDeclarative())
+				if copiesRows && *estimatedRowsPerSecond > 0 {
+					tableImpact.EstimatedDuration = protoutil.DurationToProto(time.Duration(tableImpact.RowCount) * time.Second / time.Duration(*estimatedRowsPerSecond))
+				}
+				impact = append(impact, tableImpact)
+			}
+		}
+	}
+	return impact, nil
+}
+
 // IsOnlineSchemaDDL returns true if we expect to run a online schema change DDL
 func (exec *TabletExecutor) isOnlineSchemaDDL(stmt sqlparser.Statement) (isOnline bool) {
 	switch stmt := stmt.(type) {
@@ -226,7 +479,9 @@ func (exec *TabletExecutor) detectBigSchemaChanges(ctx context.Context, parsedDD
 }
 
 func (exec *TabletExecutor) preflightSchemaChanges(ctx context.Context, sqls []string) error {
-	if exec.skipPreflight {
+	// The CREATE TABLE statements of a desired schema are declarative, and
+	// cannot be applied on top of the current schema.
+	if exec.skipPreflight || exec.desiredSchema {
 		return nil
 	}
 	_, err := exec.wr.TabletManagerClient().PreflightSchema(ctx, exec.tablets[0], sqls)
@@ -249,9 +504,11 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResu
 				return err
 			}
 			for _, onlineDDL := range onlineDDLs {
-				if exec.ddlStrategySetting.IsSkipTopo() {
+				// The migrations of a canary rollout are submitted to the tablets of each phase.
+				if exec.ddlStrategySetting.IsSkipTopo() || exec.canaryShard != "" {
 					exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
 					if len(execResult.SuccessShards) > 0 {
+						exec.submittedMigrations = append(exec.submittedMigrations, onlineDDL.UUID)
 						exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
 					}
 				} else {
@@ -267,8 +524,9 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResu
 			execResult.ExecutorErr = err.Error()
 			return err
 		}
-		if exec.ddlStrategySetting.IsSkipTopo() {
+		if exec.ddlStrategySetting.IsSkipTopo() || exec.canaryShard != "" {
 			exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
+			exec.submittedMigrations = append(exec.submittedMigrations, onlineDDL.UUID)
 			exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
 		} else {
 			exec.executeOnlineDDL(ctx, execResult, onlineDDL)
@@ -288,6 +546,12 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 		execResult.ExecutorErr = "executor is closed"
 		return &execResult
 	}
+	sqls, err := exec.desiredSchemaChanges(ctx, sqls)
+	if err != nil {
+		execResult.ExecutorErr = err.Error()
+		return &execResult
+	}
+	execResult.Sqls = sqls
 	startTime := time.Now()
 	defer func() { execResult.TotalTimeSpent = time.Since(startTime) }()
 
@@ -314,17 +578,167 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 		return &execResult
 	}
 
+	if exec.canaryShard != "" {
+		exec.executeWithCanary(ctx, sqls, &execResult)
+		return &execResult
+	}
+	exec.executeSQLs(ctx, sqls, &execResult)
+	return &execResult
+}
+
+// executeSQLs executes the SQL statements one by one, and stops at the first failure.
+func (exec *TabletExecutor) executeSQLs(ctx context.Context, sqls []string, execResult *ExecuteResult) {
 	for index, sql := range sqls {
 		execResult.CurSQLIndex = index
-		if err := exec.executeSQL(ctx, sql, &execResult); err != nil {
+		if err := exec.executeSQL(ctx, sql, execResult); err != nil {
 			execResult.ExecutorErr = err.Error()
-			return &execResult
+			return
 		}
 		if len(execResult.FailedShards) > 0 {
-			break
+			return
 		}
 	}
-	return &execResult
+}
+
+// executeWithCanary executes the SQL statements on the canary shard, waits for
+// its migrations to complete, soaks it, and then executes the SQL statements on
+// the other shards.
+func (exec *TabletExecutor) executeWithCanary(ctx context.Context, sqls []string, execResult *ExecuteResult) {
+	var canary *topodatapb.Tablet
+	var others []*topodatapb.Tablet
+	for _, tablet := range exec.tablets {
+		if tablet.Shard == exec.canaryShard {
+			canary = tablet
+		} else {
+			others = append(others, tablet)
+		}
+	}
+	if canary == nil {
+		execResult.ExecutorErr = fmt.Sprintf("canary shard %s is not a shard of keyspace %s", exec.canaryShard, exec.keyspace)
+		return
+	}
+	if exec.ddlStrategySetting != nil && exec.ddlStrategySetting.IsPostponeCompletion() {
+		// The migrations of the canary would never complete.
+		execResult.ExecutorErr = fmt.Sprintf("canary shard %s cannot be used with -postpone-completion", exec.canaryShard)
+		return
+	}
+	allTablets := exec.tablets
+	defer func() { exec.tablets = allTablets }()
+
+	exec.wr.Logger().Printf("Applying the schema change to canary shard %s\n", exec.canaryShard)
+	exec.tablets = []*topodatapb.Tablet{canary}
+	exec.submittedMigrations = nil
+	exec.executeSQLs(ctx, sqls, execResult)
+	if execResult.ExecutorErr != "" || len(execResult.FailedShards) > 0 {
+		return
+	}
+	if err := exec.waitForMigrations(ctx, canary, exec.submittedMigrations); err != nil {
+		execResult.ExecutorErr = fmt.Sprintf("canary shard %s failed, the schema change is not applied to the other shards: %v", exec.canaryShard, err)
+		return
+	}
+	if err := exec.soakCanary(ctx, canary); err != nil {
+		execResult.ExecutorErr = fmt.Sprintf("canary shard %s failed, the schema change is not applied to the other shards: %v", exec.canaryShard, err)
+		return
+	}
+	canaryResults := execResult.SuccessShards
+	if len(others) == 0 {
+		return
+	}
+
+	exec.wr.Logger().Printf("Canary shard %s succeeded, applying the schema change to the other shards\n", exec.canaryShard)
+	exec.tablets = others
+	exec.executeSQLs(ctx, sqls, execResult)
+	execResult.SuccessShards = append(canaryResults, execResult.SuccessShards...)
+}
+
+// waitForMigrations waits for the given online DDL migrations to complete on a
+// tablet, and returns an error if any of them fails or is cancelled.
+func (exec *TabletExecutor) waitForMigrations(ctx context.Context, tablet *topodatapb.Tablet, uuids []string) error {
+	ticker := time.NewTicker(canaryCheckInterval)
+	defer ticker.Stop()
+	for _, uuid := range uuids {
+		query, err := sqlparser.ParseAndBind(sqlSelectMigrationStatus, sqltypes.StringBindVariable(uuid))
+		if err != nil {
+			return err
+		}
+		for {
+			qr, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 1, false, false)
+			if err != nil {
+				return err
+			}
+			if len(qr.Rows) == 0 {
+				return fmt.Errorf("migration %s not found", uuid)
+			}
+			row := sqltypes.Proto3ToResult(qr).Named().Row()
+			status := schema.OnlineDDLStatus(row["migration_status"].ToString())
+			if status == schema.OnlineDDLStatusComplete {
+				break
+			}
+			if status == schema.OnlineDDLStatusFailed || status == schema.OnlineDDLStatusCancelled {
+				return fmt.Errorf("migration %s is %s: %s", uuid, status, row["message"].ToString())
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		}
+	}
+	return nil
+}
+
+// soakCanary checks the health of the primary of the canary shard until the end
+// of the soak period.
+func (exec *TabletExecutor) soakCanary(ctx context.Context, tablet *topodatapb.Tablet) error {
+	if exec.canarySoakPeriod <= 0 {
+		return nil
+	}
+	exec.wr.Logger().Printf("Soaking canary shard %s for %v\n", exec.canaryShard, exec.canarySoakPeriod)
+	soakCtx, cancel := context.WithTimeout(ctx, exec.canarySoakPeriod)
+	defer cancel()
+	ticker := time.NewTicker(canaryCheckInterval)
+	defer ticker.Stop()
+	for {
+		stats, err := exec.canaryHealth(ctx, tablet)
+		if err != nil {
+			return fmt.Errorf("unable to get the health of the primary: %v", err)
+		}
+		if stats.HealthError != "" {
+			return fmt.Errorf("the primary is unhealthy: %s", stats.HealthError)
+		}
+		if stats.Qps > 0 && stats.ErrorsPerSecond/stats.Qps > exec.canaryMaxErrorRate {
+			return fmt.Errorf("the primary has an error rate of %.4f, over the maximum of %.4f", stats.ErrorsPerSecond/stats.Qps, exec.canaryMaxErrorRate)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-soakCtx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// streamRealtimeStats returns the realtime stats of a tablet, as reported by its health stream.
+func streamRealtimeStats(ctx context.Context, tablet *topodatapb.Tablet) (*querypb.RealtimeStats, error) {
+	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(true))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	var stats *querypb.RealtimeStats
+	err = conn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+		stats = shr.RealtimeStats
+		return io.EOF
+	})
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		return nil, fmt.Errorf("health stream of tablet %s ended early", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return stats, nil
 }
 
 // executeOnlineDDL submits an online DDL request; this runs on topo, not on tablets, and is a quick operation.
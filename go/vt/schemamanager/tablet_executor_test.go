@@ -17,22 +17,28 @@ limitations under the License.
 package schemamanager
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"context"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -169,6 +175,81 @@ func TestTabletExecutorValidate(t *testing.T) {
 	}
 }
 
+func TestTabletExecutorValidateApproval(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "test_table",
+				Schema: "table schema",
+				Type:   tmutils.TableBaseTable,
+			},
+		},
+	})
+
+	ctx := context.Background()
+	ts := newFakeTopo(t)
+	err := ts.SaveSchemaOwnership(ctx, "test_keyspace", &vtctldatapb.SchemaOwnership{
+		Tables: map[string]*vtctldatapb.TableOwnership{
+			"test_table":    {Owner: "payments", ApprovalPolicy: "payments-dba"},
+			"test_table_02": {Owner: "payments"},
+		},
+	})
+	require.NoError(t, err)
+
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, fakeTmc)
+	executor := NewTabletExecutor("TestTabletExecutorValidateApproval", wr, testWaitReplicasTimeout)
+	require.NoError(t, executor.Open(ctx, "test_keyspace"))
+	defer executor.Close()
+
+	// tables without an approval policy need no approval token
+	err = executor.Validate(ctx, []string{"CREATE TABLE test_table_02 (pk int)"})
+	assert.NoError(t, err)
+
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table ADD COLUMN new_id bigint(20)"})
+	assert.EqualError(t, err, "schema change to table test_keyspace.test_table requires an approval token, by approval policy payments-dba of owner payments")
+
+	err = executor.Validate(ctx, []string{"RENAME TABLE test_table_03 TO test_table"})
+	assert.Error(t, err)
+
+	executor.SetApprovalToken("CHG-1234")
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table ADD COLUMN new_id bigint(20)"})
+	assert.NoError(t, err)
+
+	RegisterApprovalChecker("test_approval_checker", func(ctx context.Context, keyspace string, table string, ownership *vtctldatapb.TableOwnership, token string) error {
+		if token != "approved-by-"+ownership.ApprovalPolicy {
+			return fmt.Errorf("token %s is not approved by %s", token, ownership.ApprovalPolicy)
+		}
+		return nil
+	})
+	defer func(name string) {
+		*approvalCheckerName = name
+	}(*approvalCheckerName)
+	*approvalCheckerName = "test_approval_checker"
+
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table ADD COLUMN new_id bigint(20)"})
+	assert.EqualError(t, err, "token CHG-1234 is not approved by payments-dba")
+
+	executor.SetApprovalToken("approved-by-payments-dba")
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table ADD COLUMN new_id bigint(20)"})
+	assert.NoError(t, err)
+
+	// reverts are checked against the table of the reverted migration
+	executor.SetApprovalToken("")
+	fakeTmc.executeFetchAsDbaResults = map[string]*querypb.QueryResult{
+		"select mysql_table from _vt.schema_migrations where migration_uuid = 'bf9ac1cd_5a22_11eb_8f5e_f875a4d24e90'": sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("mysql_table", "varchar"), "test_table")),
+	}
+	err = executor.Validate(ctx, []string{"REVERT VITESS_MIGRATION 'bf9ac1cd_5a22_11eb_8f5e_f875a4d24e90'"})
+	assert.EqualError(t, err, "token  is not approved by payments-dba")
+	err = executor.Validate(ctx, []string{"REVERT VITESS_MIGRATION 'aaaaaaaa_5a22_11eb_8f5e_f875a4d24e90'"})
+	assert.NoError(t, err)
+
+	*approvalCheckerName = "unknown"
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table ADD COLUMN new_id bigint(20)"})
+	assert.EqualError(t, err, "there is no approval checker with name: unknown")
+}
+
 func TestTabletExecutorDML(t *testing.T) {
 	fakeTmc := newFakeTabletManagerClient()
 
@@ -209,6 +290,54 @@ func TestTabletExecutorDML(t *testing.T) {
 	}
 }
 
+func TestTabletExecutorDesiredSchema(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "table schema", Type: tmutils.TableBaseTable},
+			{Name: "t2", Schema: "table schema", Type: tmutils.TableBaseTable},
+			{Name: "v1", Schema: "view schema", Type: tmutils.TableView},
+			{Name: "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", Schema: "table schema", Type: tmutils.TableBaseTable},
+		},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	ctx := context.Background()
+
+	executor := NewTabletExecutor("TestTabletExecutorDesiredSchema", wr, testWaitReplicasTimeout)
+	require.NoError(t, executor.SetDDLStrategy("direct"))
+	assert.EqualError(t, executor.SetDesiredSchema(), "a desired schema is applied with online DDL, and requires a ddl_strategy other than direct")
+
+	require.NoError(t, executor.SetDDLStrategy("online"))
+	require.NoError(t, executor.SetDesiredSchema())
+	assert.Equal(t, "--declarative --desired-schema", executor.ddlStrategySetting.Options)
+	require.NoError(t, executor.Open(ctx, "test_keyspace"))
+	defer executor.Close()
+
+	_, err := executor.desiredSchemaChanges(ctx, []string{"CREATE TABLE t1 (id int)", "ALTER TABLE t2 ADD COLUMN i int"})
+	assert.EqualError(t, err, "a desired schema must only contain CREATE TABLE statements without IF NOT EXISTS: ALTER TABLE t2 ADD COLUMN i int")
+
+	_, err = executor.desiredSchemaChanges(ctx, []string{"CREATE TABLE IF NOT EXISTS t1 (id int)"})
+	assert.EqualError(t, err, "a desired schema must only contain CREATE TABLE statements without IF NOT EXISTS: CREATE TABLE IF NOT EXISTS t1 (id int)")
+
+	// The views, and the internal tables of the online DDL and the table
+	// lifecycle, are not part of the schema.
+	sqls := []string{"CREATE TABLE t1 (id int)", "CREATE TABLE t2 (id int)", "CREATE TABLE t3 (id int)"}
+	changes, err := executor.desiredSchemaChanges(ctx, sqls)
+	require.NoError(t, err)
+	assert.Equal(t, sqls, changes)
+
+	err = executor.Validate(ctx, []string{"CREATE TABLE t1 (id int)"})
+	assert.EqualError(t, err, "desired schema drops tables t2, add -allow-destructive to the ddl_strategy to drop them")
+
+	require.NoError(t, executor.SetDDLStrategy("online --allow-destructive"))
+	require.NoError(t, executor.SetDesiredSchema())
+	changes, err = executor.desiredSchemaChanges(ctx, []string{"CREATE TABLE t1 (id int)"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CREATE TABLE t1 (id int)", "DROP TABLE t2"}, changes)
+	assert.NoError(t, executor.Validate(ctx, []string{"CREATE TABLE t1 (id int)"}))
+}
+
 func TestTabletExecutorExecute(t *testing.T) {
 	executor := newFakeExecutor(t)
 	ctx := context.Background()
@@ -221,6 +350,94 @@ func TestTabletExecutorExecute(t *testing.T) {
 	}
 }
 
+func TestTabletExecutorCanary(t *testing.T) {
+	defer func(interval time.Duration) { canaryCheckInterval = interval }(canaryCheckInterval)
+	canaryCheckInterval = 10 * time.Millisecond
+
+	sql := "create table test_table (pk int)"
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaChange(sql, &tabletmanagerdatapb.SchemaChangeResult{
+		BeforeSchema: &tabletmanagerdatapb.SchemaDefinition{},
+		AfterSchema: &tabletmanagerdatapb.SchemaDefinition{
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+				{Name: "test_table", Schema: sql, Type: tmutils.TableBaseTable},
+			},
+		},
+	})
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		canaryShard   string
+		ddlStrategy   string
+		stats         *querypb.RealtimeStats
+		successShards []string
+		err           string
+	}{
+		{
+			name:          "healthy canary",
+			canaryShard:   "1",
+			stats:         &querypb.RealtimeStats{Qps: 100, ErrorsPerSecond: 0.5},
+			successShards: []string{"0", "1", "2"},
+		},
+		{
+			name:          "unhealthy canary",
+			canaryShard:   "1",
+			stats:         &querypb.RealtimeStats{HealthError: "replication is broken"},
+			successShards: []string{"1"},
+			err:           "canary shard 1 failed, the schema change is not applied to the other shards: the primary is unhealthy: replication is broken",
+		},
+		{
+			name:          "canary with errors",
+			canaryShard:   "1",
+			stats:         &querypb.RealtimeStats{Qps: 100, ErrorsPerSecond: 5},
+			successShards: []string{"1"},
+			err:           "canary shard 1 failed, the schema change is not applied to the other shards: the primary has an error rate of 0.0500, over the maximum of 0.0100",
+		},
+		{
+			name:        "unknown canary",
+			canaryShard: "3",
+			err:         "canary shard 3 is not a shard of keyspace test_keyspace",
+		},
+		{
+			name:        "postponed completion",
+			canaryShard: "1",
+			ddlStrategy: "online -postpone-completion",
+			err:         "canary shard 1 cannot be used with -postpone-completion",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor := NewTabletExecutor("TestTabletExecutorCanary", wr, testWaitReplicasTimeout)
+			executor.SetCanary(tt.canaryShard, 50*time.Millisecond, 0.01)
+			if tt.ddlStrategy != "" {
+				require.NoError(t, executor.SetDDLStrategy(tt.ddlStrategy))
+			}
+			var checkedShards []string
+			executor.canaryHealth = func(ctx context.Context, tablet *topodatapb.Tablet) (*querypb.RealtimeStats, error) {
+				checkedShards = append(checkedShards, tablet.Shard)
+				return tt.stats, nil
+			}
+			require.NoError(t, executor.Open(ctx, "test_keyspace"))
+			defer executor.Close()
+
+			result := executor.Execute(ctx, []string{sql})
+			assert.Equal(t, tt.err, result.ExecutorErr)
+			var successShards []string
+			for _, shardResult := range result.SuccessShards {
+				successShards = append(successShards, shardResult.Shard)
+			}
+			sort.Strings(successShards)
+			assert.Equal(t, tt.successShards, successShards)
+			for _, shard := range checkedShards {
+				assert.Equal(t, tt.canaryShard, shard)
+			}
+		})
+	}
+}
+
 func TestIsOnlineSchemaDDL(t *testing.T) {
 	tt := []struct {
 		query       string
@@ -33,6 +33,24 @@ type Authenticator interface {
 	Authenticate(ctx context.Context, fullMethod string) (context.Context, error)
 }
 
+// authenticatedPrincipalKey is the context key of the principal an
+// Authenticator authenticated the caller as.
+type authenticatedPrincipalKey struct{}
+
+// NewAuthenticatedPrincipalContext returns a context which carries the
+// principal an Authenticator authenticated the caller as.
+func NewAuthenticatedPrincipalContext(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, authenticatedPrincipalKey{}, principal)
+}
+
+// AuthenticatedPrincipalFromContext returns the principal the Authenticator
+// of the gRPC server authenticated the caller as, or "" if the caller was not
+// authenticated. Unlike the caller ids, it cannot be set by the caller.
+func AuthenticatedPrincipalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(authenticatedPrincipalKey{}).(string)
+	return principal
+}
+
 // authPlugins is a registry of AuthPlugin initializers.
 var authPlugins = make(map[string]func() (Authenticator, error))
 
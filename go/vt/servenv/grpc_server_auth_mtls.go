@@ -45,7 +45,7 @@ type MtlsAuthPlugin struct {
 }
 
 // Authenticate implements Authenticator interface. This method will be used inside a middleware in grpc_server to authenticate
-// incoming requests.
+// incoming requests. The subject of the client certificate is the authenticated principal of the returned context.
 func (ma *MtlsAuthPlugin) Authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
@@ -58,7 +58,7 @@ func (ma *MtlsAuthPlugin) Authenticate(ctx context.Context, fullMethod string) (
 	for _, substring := range ma.clientCertSubstrings {
 		for _, cert := range tlsInfo.State.PeerCertificates {
 			if strings.Contains(cert.Subject.String(), substring) {
-				return ctx, nil
+				return NewAuthenticatedPrincipalContext(ctx, cert.Subject.String()), nil
 			}
 		}
 	}
@@ -53,7 +53,7 @@ type StaticAuthPlugin struct {
 }
 
 // Authenticate implements AuthPlugin interface. This method will be used inside a middleware in grpc_server to authenticate
-// incoming requests.
+// incoming requests. The user name is the authenticated principal of the returned context.
 func (sa *StaticAuthPlugin) Authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		if len(md["username"]) == 0 || len(md["password"]) == 0 {
@@ -63,7 +63,7 @@ func (sa *StaticAuthPlugin) Authenticate(ctx context.Context, fullMethod string)
 		password := md["password"][0]
 		for _, authEntry := range sa.entries {
 			if username == authEntry.Username && password == authEntry.Password {
-				return ctx, nil
+				return NewAuthenticatedPrincipalContext(ctx, username), nil
 			}
 		}
 		return nil, status.Errorf(codes.PermissionDenied, "auth failure: caller %q provided invalid credentials", username)
@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"net/http"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/statssnapshot"
+)
+
+// This file registers a handler which returns a snapshot of the stats of
+// /debug/vars, flattened into their leaves, as the JSON of a
+// vtctldata.StatsSnapshot. The snapshots are captured by the
+// GetTabletStatsSnapshot vtctld RPC, and diffed by the DiffStatsSnapshots
+// vtctldclient command.
+
+func init() {
+	http.HandleFunc("/debug/vars_snapshot", func(w http.ResponseWriter, r *http.Request) {
+		data, err := json2.MarshalPB(statssnapshot.Capture())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Write(data)
+	})
+}
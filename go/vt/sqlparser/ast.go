@@ -126,7 +126,7 @@ type (
 
 	// With contains the lists of common table expression and specifies if it is recursive or not
 	With struct {
-		ctes      []*CommonTableExpr
+		CTEs      []*CommonTableExpr
 		Recursive bool
 	}
 
@@ -1570,6 +1570,9 @@ type ColumnTypeOptions struct {
 	Comment       *Literal
 	Storage       ColumnStorage
 	Collate       string
+	// Invisible is set for the invisible columns of MySQL 8, which are not
+	// returned by select *.
+	Invisible bool
 	// Reference stores a foreign key constraint for the given column
 	Reference *ReferenceDefinition
 
@@ -1948,6 +1951,14 @@ type (
 		Exprs     SelectExprs
 	}
 
+	// WindowExpr represents a function evaluated over a window of rows,
+	// e.g. rank() over (partition by a order by b).
+	WindowExpr struct {
+		Func        *FuncExpr
+		PartitionBy Exprs
+		OrderBy     OrderBy
+	}
+
 	// GroupConcatExpr represents a call to GROUP_CONCAT
 	GroupConcatExpr struct {
 		Distinct  bool
@@ -2059,6 +2070,7 @@ func (*UnaryExpr) iExpr()         {}
 func (*IntervalExpr) iExpr()      {}
 func (*CollateExpr) iExpr()       {}
 func (*FuncExpr) iExpr()          {}
+func (*WindowExpr) iExpr()        {}
 func (*TimestampFuncExpr) iExpr() {}
 func (*ExtractFuncExpr) iExpr()   {}
 func (*CurTimeFuncExpr) iExpr()   {}
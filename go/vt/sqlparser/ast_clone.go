@@ -327,6 +327,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfWhen(in)
 	case *Where:
 		return CloneRefOfWhere(in)
+	case *WindowExpr:
+		return CloneRefOfWindowExpr(in)
 	case *With:
 		return CloneRefOfWith(in)
 	case *XorExpr:
@@ -1907,13 +1909,25 @@ func CloneRefOfWhere(n *Where) *Where {
 	return &out
 }
 
+// CloneRefOfWindowExpr creates a deep clone of the input.
+func CloneRefOfWindowExpr(n *WindowExpr) *WindowExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Func = CloneRefOfFuncExpr(n.Func)
+	out.PartitionBy = CloneExprs(n.PartitionBy)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	return &out
+}
+
 // CloneRefOfWith creates a deep clone of the input.
 func CloneRefOfWith(n *With) *With {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.ctes = CloneSliceOfRefOfCommonTableExpr(n.ctes)
+	out.CTEs = CloneSliceOfRefOfCommonTableExpr(n.CTEs)
 	return &out
 }
 
@@ -2160,6 +2174,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneValTuple(in)
 	case *ValuesFuncExpr:
 		return CloneRefOfValuesFuncExpr(in)
+	case *WindowExpr:
+		return CloneRefOfWindowExpr(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -938,6 +938,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfWhere(a, b)
+	case *WindowExpr:
+		b, ok := inB.(*WindowExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowExpr(a, b)
 	case *With:
 		b, ok := inB.(*With)
 		if !ok {
@@ -2809,6 +2815,19 @@ func EqualsRefOfWhere(a, b *Where) bool {
 		EqualsExpr(a.Expr, b.Expr)
 }
 
+// EqualsRefOfWindowExpr does deep equals between the two objects.
+func EqualsRefOfWindowExpr(a, b *WindowExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsRefOfFuncExpr(a.Func, b.Func) &&
+		EqualsExprs(a.PartitionBy, b.PartitionBy) &&
+		EqualsOrderBy(a.OrderBy, b.OrderBy)
+}
+
 // EqualsRefOfWith does deep equals between the two objects.
 func EqualsRefOfWith(a, b *With) bool {
 	if a == b {
@@ -2818,7 +2837,7 @@ func EqualsRefOfWith(a, b *With) bool {
 		return false
 	}
 	return a.Recursive == b.Recursive &&
-		EqualsSliceOfRefOfCommonTableExpr(a.ctes, b.ctes)
+		EqualsSliceOfRefOfCommonTableExpr(a.CTEs, b.CTEs)
 }
 
 // EqualsRefOfXorExpr does deep equals between the two objects.
@@ -3373,6 +3392,12 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfValuesFuncExpr(a, b)
+	case *WindowExpr:
+		b, ok := inB.(*WindowExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowExpr(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -3932,6 +3957,7 @@ func EqualsRefOfColumnTypeOptions(a, b *ColumnTypeOptions) bool {
 	}
 	return a.Autoincrement == b.Autoincrement &&
 		a.Collate == b.Collate &&
+		a.Invisible == b.Invisible &&
 		EqualsRefOfBool(a.Null, b.Null) &&
 		EqualsExpr(a.Default, b.Default) &&
 		EqualsExpr(a.OnUpdate, b.OnUpdate) &&
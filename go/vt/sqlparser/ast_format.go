@@ -126,16 +126,16 @@ func (node *With) Format(buf *TrackedBuffer) {
 	if node.Recursive {
 		buf.astPrintf(node, "recursive ")
 	}
-	ctesLength := len(node.ctes)
+	ctesLength := len(node.CTEs)
 	for i := 0; i < ctesLength-1; i++ {
-		buf.astPrintf(node, "%v, ", node.ctes[i])
+		buf.astPrintf(node, "%v, ", node.CTEs[i])
 	}
-	buf.astPrintf(node, "%v", node.ctes[ctesLength-1])
+	buf.astPrintf(node, "%v ", node.CTEs[ctesLength-1])
 }
 
 // Format formats the node.
 func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%v%v as %v ", node.TableID, node.Columns, node.Subquery)
+	buf.astPrintf(node, "%v%v as %v", node.TableID, node.Columns, node.Subquery)
 }
 
 // Format formats the node.
@@ -625,6 +625,9 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Options.Autoincrement {
 		buf.astPrintf(ct, " %s", keywordStrings[AUTO_INCREMENT])
 	}
+	if ct.Options.Invisible {
+		buf.astPrintf(ct, " %s", keywordStrings[INVISIBLE])
+	}
 	if ct.Options.Comment != nil {
 		buf.astPrintf(ct, " %s %v", keywordStrings[COMMENT_KEYWORD], ct.Options.Comment)
 	}
@@ -1244,6 +1247,26 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
 }
 
+// Format formats the node.
+func (node *WindowExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v over (", node.Func)
+	if len(node.PartitionBy) > 0 {
+		buf.astPrintf(node, "partition by %v", node.PartitionBy)
+	}
+	for i, order := range node.OrderBy {
+		switch {
+		case i > 0:
+			buf.WriteString(", ")
+		case len(node.PartitionBy) > 0:
+			buf.WriteString(" order by ")
+		default:
+			buf.WriteString("order by ")
+		}
+		buf.astPrintf(node, "%v", order)
+	}
+	buf.WriteByte(')')
+}
+
 // Format formats the node
 func (node *GroupConcatExpr) Format(buf *TrackedBuffer) {
 	if node.Distinct {
@@ -194,12 +194,13 @@ func (node *With) formatFast(buf *TrackedBuffer) {
 	if node.Recursive {
 		buf.WriteString("recursive ")
 	}
-	ctesLength := len(node.ctes)
+	ctesLength := len(node.CTEs)
 	for i := 0; i < ctesLength-1; i++ {
-		node.ctes[i].formatFast(buf)
+		node.CTEs[i].formatFast(buf)
 		buf.WriteString(", ")
 	}
-	node.ctes[ctesLength-1].formatFast(buf)
+	node.CTEs[ctesLength-1].formatFast(buf)
+	buf.WriteByte(' ')
 }
 
 // formatFast formats the node.
@@ -208,7 +209,6 @@ func (node *CommonTableExpr) formatFast(buf *TrackedBuffer) {
 	node.Columns.formatFast(buf)
 	buf.WriteString(" as ")
 	node.Subquery.formatFast(buf)
-	buf.WriteByte(' ')
 }
 
 // formatFast formats the node.
@@ -869,6 +869,10 @@ func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[AUTO_INCREMENT])
 	}
+	if ct.Options.Invisible {
+		buf.WriteByte(' ')
+		buf.WriteString(keywordStrings[INVISIBLE])
+	}
 	if ct.Options.Comment != nil {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[COMMENT_KEYWORD])
@@ -1655,6 +1659,28 @@ func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte(')')
 }
 
+// formatFast formats the node.
+func (node *WindowExpr) formatFast(buf *TrackedBuffer) {
+	node.Func.formatFast(buf)
+	buf.WriteString(" over (")
+	if len(node.PartitionBy) > 0 {
+		buf.WriteString("partition by ")
+		node.PartitionBy.formatFast(buf)
+	}
+	for i, order := range node.OrderBy {
+		switch {
+		case i > 0:
+			buf.WriteString(", ")
+		case len(node.PartitionBy) > 0:
+			buf.WriteString(" order by ")
+		default:
+			buf.WriteString("order by ")
+		}
+		order.formatFast(buf)
+	}
+	buf.WriteByte(')')
+}
+
 // formatFast formats the node
 func (node *GroupConcatExpr) formatFast(buf *TrackedBuffer) {
 	if node.Distinct {
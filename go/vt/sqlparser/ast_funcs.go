@@ -997,11 +997,31 @@ func (lock Lock) ToString() string {
 		return ForUpdateStr
 	case ShareModeLock:
 		return ShareModeStr
+	case ForUpdateLockNoWait:
+		return ForUpdateNoWaitStr
+	case ForUpdateLockSkipLocked:
+		return ForUpdateSkipLockedStr
+	case ForShareLock:
+		return ForShareStr
+	case ForShareLockNoWait:
+		return ForShareNoWaitStr
+	case ForShareLockSkipLocked:
+		return ForShareSkipLockedStr
 	default:
 		return "Unknown lock"
 	}
 }
 
+// NoWait returns true if the lock does not wait for the rows locked by other
+// transactions, and either fails (NOWAIT) or skips them (SKIP LOCKED).
+func (lock Lock) NoWait() bool {
+	switch lock {
+	case ForUpdateLockNoWait, ForUpdateLockSkipLocked, ForShareLockNoWait, ForShareLockSkipLocked:
+		return true
+	}
+	return false
+}
+
 // ToString returns the string associated with WhereType
 func (whereType WhereType) ToString() string {
 	switch whereType {
@@ -1223,6 +1243,8 @@ func (ty ExplainType) ToString() string {
 		return TraditionalStr
 	case AnalyzeType:
 		return AnalyzeStr
+	case CostType:
+		return CostStr
 	default:
 		return "Unknown ExplainType"
 	}
@@ -1481,10 +1503,14 @@ func ToString(exprs []TableExpr) string {
 	return buf.String()
 }
 
-// ContainsAggregation returns true if the expression contains aggregation
+// ContainsAggregation returns true if the expression contains aggregation.
+// The aggregate functions evaluated over a window are not aggregations.
 func ContainsAggregation(e SQLNode) bool {
 	hasAggregates := false
 	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		if _, isWindow := node.(*WindowExpr); isWindow {
+			return false, nil
+		}
 		if IsAggregation(node) {
 			hasAggregates = true
 			return false, nil
@@ -1494,6 +1520,23 @@ func ContainsAggregation(e SQLNode) bool {
 	return hasAggregates
 }
 
+// ContainsWindowFunction returns true if the expression contains a function
+// evaluated over a window.
+func ContainsWindowFunction(e SQLNode) bool {
+	hasWindow := false
+	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		switch node.(type) {
+		case *WindowExpr:
+			hasWindow = true
+			return false, nil
+		case *Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, e)
+	return hasWindow
+}
+
 // IsAggregation returns true if the node is an aggregation expression
 func IsAggregation(node SQLNode) bool {
 	switch node := node.(type) {
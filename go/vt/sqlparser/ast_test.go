@@ -775,6 +775,15 @@ func TestSplitStatementToPieces(t *testing.T) {
 	}, {
 		input:  "select * from table1;--comment;\nselect * from table2;",
 		output: "select * from table1;--comment;\nselect * from table2",
+	}, {
+		input:  "select * from table1; -- comment",
+		output: "select * from table1",
+	}, {
+		input:  "select * from table1; /* comment */ ; /* comment */ select * from table2; # comment",
+		output: "select * from table1; /* comment */ select * from table2",
+	}, {
+		input:  "select * from table1; /*! select * from table2 */",
+		output: "select * from table1; /*! select * from table2 */",
 	}, {
 		input: "CREATE TABLE `total_data` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'id', " +
 			"`region` varchar(32) NOT NULL COMMENT 'region name, like zh; th; kepler'," +
@@ -18,6 +18,7 @@ package sqlparser
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
@@ -41,6 +42,16 @@ const (
 	DirectiveAllowScatter = "ALLOW_SCATTER"
 	// DirectiveAllowHashJoin lets the planner use hash join if possible
 	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
+	// DirectiveAsOf reads the data as of the given point in time, from a delayed replica. Only supported for SELECTS.
+	DirectiveAsOf = "AS_OF"
+	// DirectiveShardLocal makes the planner fail the query if it needs a join across shards.
+	DirectiveShardLocal = "SHARD_LOCAL"
+	// DirectiveJoinStrategy chooses how the planner joins the tables of different shards, either "hash", "nested_loop", "merge" or "block_nested_loop".
+	DirectiveJoinStrategy = "JOIN_STRATEGY"
+	// DirectiveForceScatter makes the planner send the query to all the shards instead of routing it by a vindex. Only supported for SELECTS.
+	DirectiveForceScatter = "FORCE_SCATTER"
+	// DirectiveVindex makes the planner route the query only by the vindex of the given name. Only supported for SELECTS.
+	DirectiveVindex = "VINDEX"
 )
 
 func isNonSpace(r rune) bool {
@@ -137,6 +148,26 @@ func SplitMarginComments(sql string) (query string, comments MarginComments) {
 	}), comments
 }
 
+// requestIDComment matches the margin comment which carries the request ID of a query.
+var requestIDComment = regexp.MustCompile(`/\*\s*request_id[:=]\s*([A-Za-z0-9._:-]{1,128})\s*\*/`)
+
+// RequestID returns the request ID carried by a margin comment of the form
+// /* request_id:<id> */, or the empty string if there is none.
+func (mc MarginComments) RequestID() string {
+	if m := requestIDComment.FindStringSubmatch(mc.Leading); m != nil {
+		return m[1]
+	}
+	if m := requestIDComment.FindStringSubmatch(mc.Trailing); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// RequestIDComment returns the leading margin comment which carries a request ID.
+func RequestIDComment(requestID string) string {
+	return "/* request_id:" + requestID + " */ "
+}
+
 // StripLeadingComments trims the SQL string and removes any leading comments
 func StripLeadingComments(sql string) string {
 	sql = strings.TrimFunc(sql, unicode.IsSpace)
@@ -225,7 +256,7 @@ func ExtractCommentDirectives(comments Comments) CommentDirectives {
 
 		// Split on whitespace and ignore the first and last directive
 		// since they contain the comment start/end
-		directives := strings.Fields(commentStr)
+		directives := splitCommentDirectives(commentStr)
 		for i := 1; i < len(directives)-1; i++ {
 			directive := directives[i]
 			sep := strings.IndexByte(directive, '=')
@@ -257,6 +288,38 @@ func ExtractCommentDirectives(comments Comments) CommentDirectives {
 	return vals
 }
 
+// splitCommentDirectives splits the comment on whitespace, except for the
+// whitespace inside of quoted values, like in AS_OF='2021-05-01 10:00'.
+func splitCommentDirectives(comment string) []string {
+	var directives []string
+	var quote rune
+	start := -1
+	for i, c := range comment {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case unicode.IsSpace(c):
+			if start >= 0 {
+				directives = append(directives, comment[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		directives = append(directives, comment[start:])
+	}
+	return directives
+}
+
 // IsSet checks the directive map for the named directive and returns
 // true if the directive is set and has a true/false or 0/1 value
 func (d CommentDirectives) IsSet(key string) bool {
@@ -290,6 +353,8 @@ func (d CommentDirectives) GetString(key string, defaultVal string) string {
 	stringVal := fmt.Sprintf("%v", val)
 	if unquoted, err := strconv.Unquote(stringVal); err == nil {
 		stringVal = unquoted
+	} else if len(stringVal) >= 2 && stringVal[0] == '\'' && stringVal[len(stringVal)-1] == '\'' {
+		stringVal = stringVal[1 : len(stringVal)-1]
 	}
 	return stringVal
 }
@@ -406,3 +471,21 @@ func AllowScatterDirective(stmt Statement) bool {
 	}
 	return directives.IsSet(DirectiveAllowScatter)
 }
+
+// ShardLocalDirective returns true if the query is expected to be shard-local
+func ShardLocalDirective(stmt Statement) bool {
+	var directives CommentDirectives
+	switch stmt := stmt.(type) {
+	case *Select:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Insert:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Update:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Delete:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	default:
+		return false
+	}
+	return directives.IsSet(DirectiveShardLocal)
+}
@@ -151,6 +151,39 @@ func TestSplitComments(t *testing.T) {
 	}
 }
 
+func TestMarginCommentsRequestID(t *testing.T) {
+	testCases := []struct {
+		input, requestID string
+	}{{
+		input:     "select 1",
+		requestID: "",
+	}, {
+		input:     "/* request_id:abc-123 */ select 1",
+		requestID: "abc-123",
+	}, {
+		input:     "/* leading */ /* request_id=app:42 */ select 1",
+		requestID: "app:42",
+	}, {
+		input:     "select 1 /* request_id: 7f3e.1 */",
+		requestID: "7f3e.1",
+	}, {
+		input:     "select 1 /* request_id:a b */",
+		requestID: "",
+	}, {
+		input:     "select /* request_id:inner */ 1",
+		requestID: "",
+	}}
+	for _, tcase := range testCases {
+		t.Run(tcase.input, func(t *testing.T) {
+			_, comments := SplitMarginComments(tcase.input)
+			assert.Equal(t, tcase.requestID, comments.RequestID())
+		})
+	}
+
+	_, comments := SplitMarginComments(RequestIDComment("abc-123") + "select 1")
+	assert.Equal(t, "abc-123", comments.RequestID())
+}
+
 func TestStripLeadingComments(t *testing.T) {
 	var testCases = []struct {
 		input, outSQL string
@@ -315,6 +348,12 @@ func TestExtractCommentDirectives(t *testing.T) {
 			"ANOTHER_WITH_VALEQ": "val=",
 			"AND_ONE_WITH_EQ":    "=",
 		},
+	}, {
+		input: "/*vt+ AS_OF='2021-05-01 10:00:00' ONE_OPT */",
+		vals: CommentDirectives{
+			"AS_OF":   "'2021-05-01 10:00:00'",
+			"ONE_OPT": true,
+		},
 	}}
 
 	for _, testCase := range testCases {
@@ -364,6 +403,7 @@ func TestExtractCommentDirectives(t *testing.T) {
 		"four":    2,
 		"five":    0,
 		"six":     "true",
+		"seven":   "'2021-05-01 10:00'",
 	}
 
 	if !d.IsSet("ONE_OPT") {
@@ -389,6 +429,10 @@ func TestExtractCommentDirectives(t *testing.T) {
 	if d.IsSet("six") {
 		t.Errorf("d.IsSet(six) should be false")
 	}
+
+	if got := d.GetString("seven", ""); got != "2021-05-01 10:00" {
+		t.Errorf("d.GetString(seven) = %q, want 2021-05-01 10:00", got)
+	}
 }
 
 func TestSkipQueryPlanCacheDirective(t *testing.T) {
@@ -25,9 +25,14 @@ const (
 	SQLCalcFoundRowsStr = "sql_calc_found_rows "
 
 	// Select.Lock
-	NoLockStr    = ""
-	ForUpdateStr = " for update"
-	ShareModeStr = " lock in share mode"
+	NoLockStr              = ""
+	ForUpdateStr           = " for update"
+	ForUpdateNoWaitStr     = " for update nowait"
+	ForUpdateSkipLockedStr = " for update skip locked"
+	ForShareStr            = " for share"
+	ForShareNoWaitStr      = " for share nowait"
+	ForShareSkipLockedStr  = " for share skip locked"
+	ShareModeStr           = " lock in share mode"
 
 	// Select.Cache
 	SQLCacheStr   = "sql_cache "
@@ -200,6 +205,7 @@ const (
 	VitessStr      = "vitess"
 	TraditionalStr = "traditional"
 	AnalyzeStr     = "analyze"
+	CostStr        = "cost"
 
 	// Lock Types
 	ReadStr             = "read"
@@ -326,6 +332,11 @@ const (
 	NoLock Lock = iota
 	ForUpdateLock
 	ShareModeLock
+	ForUpdateLockNoWait
+	ForUpdateLockSkipLocked
+	ForShareLock
+	ForShareLockNoWait
+	ForShareLockSkipLocked
 )
 
 // Constants for Enum Type - WhereType
@@ -458,6 +469,7 @@ const (
 	VitessType
 	TraditionalType
 	AnalyzeType
+	CostType
 )
 
 // Constant for Enum Type - SelectIntoType
@@ -137,6 +137,7 @@ var keywords = []keyword{
 	{"continue", UNUSED},
 	{"convert", CONVERT},
 	{"copy", COPY},
+	{"cost", COST},
 	{"cume_dist", UNUSED},
 	{"substr", SUBSTRING},
 	{"subpartition", SUBPARTITION},
@@ -271,6 +272,7 @@ var keywords = []keyword{
 	{"integer", INTEGER},
 	{"interval", INTERVAL},
 	{"into", INTO},
+	{"invisible", INVISIBLE},
 	{"io_after_gtids", UNUSED},
 	{"is", IS},
 	{"isolation", ISOLATION},
@@ -307,6 +309,7 @@ var keywords = []keyword{
 	{"localtime", LOCALTIME},
 	{"localtimestamp", LOCALTIMESTAMP},
 	{"lock", LOCK},
+	{"locked", LOCKED},
 	{"logs", LOGS},
 	{"long", UNUSED},
 	{"longblob", LONGBLOB},
@@ -346,6 +349,7 @@ var keywords = []keyword{
 	{"no", NO},
 	{"none", NONE},
 	{"not", NOT},
+	{"nowait", NOWAIT},
 	{"no_write_to_binlog", NO_WRITE_TO_BINLOG},
 	{"nth_value", UNUSED},
 	{"ntile", UNUSED},
@@ -366,7 +370,7 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
@@ -438,6 +442,7 @@ var keywords = []keyword{
 	{"show", SHOW},
 	{"signal", UNUSED},
 	{"signed", SIGNED},
+	{"skip", SKIP},
 	{"slow", SLOW},
 	{"smallint", SMALLINT},
 	{"spatial", SPATIAL},
@@ -518,6 +523,7 @@ var keywords = []keyword{
 	{"vindex", VINDEX},
 	{"vindexes", VINDEXES},
 	{"view", VIEW},
+	{"visible", VISIBLE},
 	{"vitess", VITESS},
 	{"vitess_keyspaces", VITESS_KEYSPACES},
 	{"vitess_metadata", VITESS_METADATA},
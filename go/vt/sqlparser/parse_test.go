@@ -164,6 +164,8 @@ var (
 	}, {
 		input:  "WITH topsales2003 AS (SELECT salesRepEmployeeNumber employeeNumber, SUM(quantityOrdered * priceEach) sales FROM orders INNER JOIN orderdetails USING (orderNumber) INNER JOIN customers USING (customerNumber) WHERE YEAR(shippedDate) = 2003 AND status = 'Shipped' GROUP BY salesRepEmployeeNumber ORDER BY sales DESC LIMIT 5)SELECT employeeNumber, firstName, lastName, sales FROM employees JOIN topsales2003 USING (employeeNumber)",
 		output: "with topsales2003 as (select salesRepEmployeeNumber as employeeNumber, SUM(quantityOrdered * priceEach) as sales from orders join orderdetails using (orderNumber) join customers using (customerNumber) where YEAR(shippedDate) = 2003 and `status` = 'Shipped' group by salesRepEmployeeNumber order by sales desc limit 5) select employeeNumber, firstName, lastName, sales from employees join topsales2003 using (employeeNumber)",
+	}, {
+		input: "with a as (select 1 from dual), b(x) as (select * from a) select x from b",
 	}, {
 		input: "select 1 from t",
 	}, {
@@ -333,6 +335,19 @@ var (
 		input: "select /* for update */ 1 from t for update",
 	}, {
 		input: "select /* lock in share mode */ 1 from t lock in share mode",
+	}, {
+		input: "select /* for update nowait */ 1 from t for update nowait",
+	}, {
+		input: "select /* for update skip locked */ 1 from t for update skip locked",
+	}, {
+		input: "select /* for share */ 1 from t for share",
+	}, {
+		input: "select /* for share nowait */ 1 from t for share nowait",
+	}, {
+		input: "select /* for share skip locked */ 1 from t for share skip locked",
+	}, {
+		input:  "select /* union for update skip locked */ 1 from t union select 1 from t limit 1 for update skip locked",
+		output: "select /* union for update skip locked */ 1 from t union select 1 from t limit 1 for update skip locked",
 	}, {
 		input: "select /* select list */ 1, 2 from t",
 	}, {
@@ -1109,6 +1124,16 @@ var (
 		input: "alter table a change column s foo int default 1 after x",
 	}, {
 		input: "alter table a modify column foo int default 1 first",
+	}, {
+		input: "alter table a add column foo int default null invisible",
+	}, {
+		input:  "alter table a modify column foo int not null visible",
+		output: "alter table a modify column foo int not null",
+	}, {
+		input: "alter table a add column foo int default null invisible comment 'hidden'",
+	}, {
+		input:  "alter table a add foo varchar(255) generated always as (concat(bar, ' ', baz)) stored invisible",
+		output: "alter table a add column foo varchar(255) as (concat(bar, ' ', baz)) stored invisible",
 	}, {
 		input:  "alter table a add foo varchar(255) generated always as (concat(bar, ' ', baz)) stored",
 		output: "alter table a add column foo varchar(255) as (concat(bar, ' ', baz)) stored",
@@ -1889,6 +1914,11 @@ var (
 		input: "explain format = json select * from t",
 	}, {
 		input: "explain format = vitess select * from t",
+	}, {
+		input: "explain format = cost select * from t where id = 1",
+	}, {
+		input:  "select cost from t",
+		output: "select `cost` from t",
 	}, {
 		input:  "describe format = vitess select * from t",
 		output: "explain format = vitess select * from t",
@@ -2019,6 +2049,15 @@ var (
 	}, {
 		input:  "SELECT id FROM blog_posts USE INDEX (PRIMARY) WHERE id = 10",
 		output: "select id from blog_posts use index (`PRIMARY`) where id = 10",
+	}, {
+		input: "select id, row_number() over (partition by col order by id desc) from t",
+	}, {
+		input:  "select id, rank() over (order by col) from t",
+		output: "select id, rank() over (order by col asc) from t",
+	}, {
+		input: "select id, dense_rank() over (partition by a, b) from t",
+	}, {
+		input: "select id, sum(col) over (partition by a order by b asc) as total, count(*) over () from t",
 	}, {
 		input:  "select name, group_concat(score) from t group by name",
 		output: "select `name`, group_concat(score) from t group by `name`",
@@ -285,6 +285,9 @@ loop:
 				}
 			}
 			break loop
+		case COMMENT:
+			// a statement made only of comments is empty, and the comments
+			// preceding a statement stay with it
 		default:
 			emptyStatement = false
 		}
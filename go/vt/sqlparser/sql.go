@@ -495,49 +495,50 @@ const FORMAT = 57792
 const TREE = 57793
 const VITESS = 57794
 const TRADITIONAL = 57795
-const LOCAL = 57796
-const LOW_PRIORITY = 57797
-const NO_WRITE_TO_BINLOG = 57798
-const LOGS = 57799
-const ERROR = 57800
-const GENERAL = 57801
-const HOSTS = 57802
-const OPTIMIZER_COSTS = 57803
-const USER_RESOURCES = 57804
-const SLOW = 57805
-const CHANNEL = 57806
-const RELAY = 57807
-const EXPORT = 57808
-const AVG_ROW_LENGTH = 57809
-const CONNECTION = 57810
-const CHECKSUM = 57811
-const DELAY_KEY_WRITE = 57812
-const ENCRYPTION = 57813
-const ENGINE = 57814
-const INSERT_METHOD = 57815
-const MAX_ROWS = 57816
-const MIN_ROWS = 57817
-const PACK_KEYS = 57818
-const PASSWORD = 57819
-const FIXED = 57820
-const DYNAMIC = 57821
-const COMPRESSED = 57822
-const REDUNDANT = 57823
-const COMPACT = 57824
-const ROW_FORMAT = 57825
-const STATS_AUTO_RECALC = 57826
-const STATS_PERSISTENT = 57827
-const STATS_SAMPLE_PAGES = 57828
-const STORAGE = 57829
-const MEMORY = 57830
-const DISK = 57831
-const PARTITIONS = 57832
-const LINEAR = 57833
-const RANGE = 57834
-const LIST = 57835
-const SUBPARTITION = 57836
-const SUBPARTITIONS = 57837
-const HASH = 57838
+const COST = 57796
+const LOCAL = 57797
+const LOW_PRIORITY = 57798
+const NO_WRITE_TO_BINLOG = 57799
+const LOGS = 57800
+const ERROR = 57801
+const GENERAL = 57802
+const HOSTS = 57803
+const OPTIMIZER_COSTS = 57804
+const USER_RESOURCES = 57805
+const SLOW = 57806
+const CHANNEL = 57807
+const RELAY = 57808
+const EXPORT = 57809
+const AVG_ROW_LENGTH = 57810
+const CONNECTION = 57811
+const CHECKSUM = 57812
+const DELAY_KEY_WRITE = 57813
+const ENCRYPTION = 57814
+const ENGINE = 57815
+const INSERT_METHOD = 57816
+const MAX_ROWS = 57817
+const MIN_ROWS = 57818
+const PACK_KEYS = 57819
+const PASSWORD = 57820
+const FIXED = 57821
+const DYNAMIC = 57822
+const COMPRESSED = 57823
+const REDUNDANT = 57824
+const COMPACT = 57825
+const ROW_FORMAT = 57826
+const STATS_AUTO_RECALC = 57827
+const STATS_PERSISTENT = 57828
+const STATS_SAMPLE_PAGES = 57829
+const STORAGE = 57830
+const MEMORY = 57831
+const DISK = 57832
+const PARTITIONS = 57833
+const LINEAR = 57834
+const RANGE = 57835
+const LIST = 57836
+const SUBPARTITION = 57837
+const SUBPARTITIONS = 57838
+const HASH = 57839
 
 var yyToknames = [...]string{
 	"$end",
@@ -1010,6 +1011,7 @@ var yyToknames = [...]string{
 	"TREE",
 	"VITESS",
 	"TRADITIONAL",
+	"COST",
 	"LOCAL",
 	"LOW_PRIORITY",
 	"NO_WRITE_TO_BINLOG",
@@ -1073,3248 +1075,3286 @@ var yyExca = [...]int{
 	-2, 0,
 	-1, 44,
 	1, 137,
-	514, 137,
+	515, 137,
 	-2, 143,
 	-1, 45,
 	115, 143,
 	155, 143,
 	271, 143,
-	-2, 404,
+	-2, 408,
 	-1, 52,
-	33, 577,
-	177, 577,
-	188, 577,
-	221, 591,
-	222, 591,
-	-2, 579,
+	33, 581,
+	177, 581,
+	188, 581,
+	221, 595,
+	222, 595,
+	-2, 583,
 	-1, 57,
-	179, 601,
-	-2, 599,
+	179, 605,
+	-2, 603,
 	-1, 108,
-	176, 1046,
+	176, 1060,
 	-2, 116,
 	-1, 110,
 	1, 138,
-	514, 138,
+	515, 138,
 	-2, 143,
 	-1, 120,
-	116, 307,
-	182, 307,
-	-2, 398,
+	116, 311,
+	182, 311,
+	-2, 402,
 	-1, 139,
 	115, 143,
 	155, 143,
 	271, 143,
-	-2, 413,
-	-1, 598,
-	162, 1067,
-	-2, 1063,
-	-1, 599,
-	162, 1068,
-	-2, 1064,
-	-1, 632,
-	57, 669,
-	-2, 677,
-	-1, 669,
-	131, 1425,
+	-2, 417,
+	-1, 600,
+	162, 1081,
+	-2, 1077,
+	-1, 601,
+	162, 1082,
+	-2, 1078,
+	-1, 634,
+	57, 674,
+	-2, 682,
+	-1, 672,
+	131, 1440,
 	-2, 109,
-	-1, 670,
-	131, 1302,
+	-1, 673,
+	131, 1317,
 	-2, 110,
-	-1, 676,
-	131, 1356,
-	-2, 1040,
-	-1, 818,
-	131, 1235,
-	-2, 1037,
-	-1, 854,
+	-1, 679,
+	131, 1371,
+	-2, 1054,
+	-1, 821,
+	131, 1249,
+	-2, 1051,
+	-1, 857,
 	187, 38,
 	192, 38,
-	-2, 318,
-	-1, 931,
-	1, 452,
-	514, 452,
+	-2, 322,
+	-1, 934,
+	1, 456,
+	515, 456,
 	-2, 143,
-	-1, 1127,
-	57, 670,
-	-2, 682,
-	-1, 1128,
-	57, 671,
-	-2, 683,
-	-1, 1180,
+	-1, 1131,
+	57, 675,
+	-2, 687,
+	-1, 1132,
+	57, 676,
+	-2, 688,
+	-1, 1188,
 	115, 143,
 	155, 143,
 	271, 143,
-	-2, 348,
-	-1, 1183,
+	-2, 352,
+	-1, 1191,
 	23, 162,
 	-2, 164,
-	-1, 1256,
-	116, 307,
-	182, 307,
-	-2, 398,
-	-1, 1265,
+	-1, 1264,
+	116, 311,
+	182, 311,
+	-2, 402,
+	-1, 1273,
 	187, 39,
 	192, 39,
-	-2, 319,
-	-1, 1515,
-	162, 1072,
-	-2, 1066,
-	-1, 1590,
+	-2, 323,
+	-1, 1525,
+	162, 1086,
+	-2, 1080,
+	-1, 1602,
 	115, 143,
 	155, 143,
 	271, 143,
-	-2, 349,
-	-1, 1826,
+	-2, 353,
+	-1, 1840,
 	75, 91,
 	84, 91,
-	-2, 735,
-	-1, 1993,
-	47, 1008,
-	-2, 1002,
-	-1, 2181,
+	-2, 740,
+	-1, 2011,
+	47, 1022,
+	-2, 1016,
+	-1, 2201,
 	5, 50,
 	16, 50,
 	18, 50,
 	85, 50,
-	-2, 710,
+	-2, 715,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 31089
+const yyLast = 31465
 
 var yyAct = [...]int{
-	598, 2446, 1546, 2395, 2332, 2362, 2334, 2417, 2226, 2187,
-	2381, 2090, 2102, 2368, 1846, 1853, 3, 592, 34, 2007,
-	2298, 90, 2091, 1855, 2008, 1142, 2152, 2004, 1771, 1109,
-	625, 2250, 1799, 2005, 2255, 2146, 1529, 1550, 994, 550,
-	593, 2172, 601, 1994, 1563, 1622, 547, 2002, 176, 1791,
-	1872, 176, 942, 514, 176, 1895, 647, 546, 2049, 530,
-	2242, 176, 554, 1822, 1933, 590, 148, 548, 591, 176,
-	1642, 1627, 1873, 576, 1874, 1568, 33, 1811, 1576, 648,
-	674, 176, 1460, 35, 1783, 1129, 1419, 1567, 1587, 629,
-	1949, 633, 627, 821, 542, 1655, 1509, 1687, 1467, 134,
-	1641, 1629, 1866, 530, 849, 971, 530, 176, 530, 650,
-	1828, 1570, 1151, 1112, 89, 1172, 559, 1279, 1479, 85,
-	1437, 1531, 884, 1353, 1012, 1270, 1367, 828, 1370, 1555,
-	850, 855, 1639, 851, 1169, 1155, 1171, 639, 927, 992,
-	829, 671, 825, 1255, 987, 1375, 151, 1232, 862, 111,
-	634, 1263, 1618, 112, 92, 1551, 117, 635, 118, 661,
-	637, 70, 79, 91, 537, 1082, 1522, 8, 1078, 636,
-	71, 7, 2430, 6, 2280, 852, 178, 179, 180, 83,
-	1237, 2447, 2189, 2190, 2191, 657, 2363, 2189, 2335, 119,
-	1685, 113, 1913, 1912, 1793, 655, 837, 660, 1941, 1942,
-	84, 96, 832, 1013, 641, 517, 889, 1426, 487, 1512,
-	822, 178, 179, 180, 1425, 1526, 1527, 1424, 1423, 1422,
-	1421, 1339, 1408, 540, 2409, 541, 1769, 538, 1990, 2203,
-	2294, 2293, 888, 628, 626, 582, 2071, 668, 887, 98,
-	99, 1413, 102, 2440, 2221, 108, 504, 2222, 173, 2391,
-	543, 482, 642, 2435, 649, 503, 1725, 113, 675, 886,
-	843, 2350, 2425, 2227, 842, 1013, 501, 72, 1023, 844,
-	866, 624, 900, 901, 632, 904, 905, 906, 907, 651,
-	2382, 910, 911, 912, 913, 914, 915, 916, 917, 918,
-	919, 920, 921, 922, 923, 924, 897, 865, 72, 1673,
-	72, 2390, 663, 664, 498, 2349, 1948, 902, 2134, 1246,
-	1173, 1044, 1174, 512, 1770, 1920, 890, 891, 892, 1919,
-	1723, 113, 1802, 1634, 2041, 2042, 1582, 1583, 509, 2040,
-	1023, 172, 2303, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
-	1053, 1052, 1054, 1055, 81, 1837, 1632, 1803, 1836, 2268,
-	1940, 1838, 1722, 1581, 72, 114, 1528, 74, 1508, 1019,
-	990, 518, 1011, 961, 841, 622, 936, 937, 156, 978,
-	926, 980, 966, 967, 621, 81, 949, 81, 2282, 1863,
-	949, 950, 2149, 962, 955, 950, 2104, 1602, 1601, 488,
-	528, 490, 505, 948, 520, 947, 519, 494, 517, 492,
-	496, 506, 497, 517, 491, 2126, 502, 977, 979, 493,
-	507, 508, 510, 524, 523, 511, 930, 500, 521, 1412,
-	839, 1019, 517, 2124, 153, 532, 154, 1414, 1415, 1416,
-	526, 81, 1359, 1116, 1631, 1896, 171, 1656, 903, 1500,
-	1489, 1490, 1491, 1492, 1502, 1493, 1494, 1495, 1507, 1503,
-	1496, 1497, 1504, 1505, 1506, 1498, 1499, 1501, 989, 968,
-	963, 956, 845, 2105, 178, 179, 180, 1916, 1688, 969,
-	2098, 2410, 594, 2434, 577, 579, 595, 596, 2099, 575,
-	578, 597, 176, 1354, 176, 964, 965, 176, 836, 984,
-	1701, 838, 1702, 517, 1703, 970, 975, 932, 1928, 1704,
-	976, 2106, 909, 157, 908, 982, 1329, 1693, 580, 581,
-	981, 2216, 162, 841, 925, 530, 530, 530, 1690, 2290,
-	873, 1018, 1015, 1016, 1017, 1022, 1024, 1021, 840, 1020,
-	2070, 871, 974, 530, 530, 1692, 1014, 1699, 1696, 1698,
-	1697, 945, 1658, 951, 952, 953, 954, 841, 1330, 833,
-	1331, 1005, 34, 1564, 518, 1842, 835, 834, 846, 518,
-	1694, 522, 882, 881, 880, 879, 991, 878, 2429, 1249,
-	2279, 2304, 864, 1918, 877, 1691, 929, 876, 518, 515,
-	875, 1360, 883, 1018, 1015, 1016, 1017, 1022, 1024, 1021,
-	870, 1020, 1723, 1859, 516, 2283, 826, 1056, 1014, 983,
-	1056, 858, 826, 839, 959, 2431, 824, 2150, 2421, 2423,
-	1950, 826, 2348, 1932, 985, 1269, 1724, 857, 1368, 149,
-	874, 1640, 176, 1059, 1060, 1061, 1062, 1107, 1633, 1772,
-	1774, 872, 863, 1067, 864, 1070, 662, 867, 857, 176,
-	899, 80, 1944, 1929, 1119, 1736, 1679, 868, 1123, 518,
-	627, 1122, 1364, 1952, 629, 996, 997, 999, 530, 1120,
-	893, 2078, 176, 928, 1915, 869, 864, 530, 1977, 1976,
-	1102, 1975, 80, 530, 80, 1244, 1905, 840, 1063, 938,
-	1243, 1268, 1117, 1242, 946, 935, 1108, 1341, 1340, 1342,
-	1343, 1344, 1365, 110, 863, 1240, 486, 481, 1935, 1675,
-	1057, 1058, 1008, 1934, 71, 671, 1006, 1108, 1007, 1121,
-	2327, 840, 864, 89, 1927, 75, 1954, 1926, 1958, 1935,
-	1953, 2186, 1951, 2168, 1934, 1833, 863, 1956, 80, 1798,
-	1029, 867, 857, 1113, 864, 1761, 1955, 1773, 1521, 1159,
-	1056, 868, 1089, 940, 1080, 1084, 1081, 1588, 958, 1957,
-	1959, 2374, 1358, 92, 1055, 2372, 2039, 644, 1076, 960,
-	1095, 1096, 1097, 1098, 2376, 2377, 972, 1376, 2419, 1857,
-	1858, 2420, 863, 2418, 988, 105, 2373, 2361, 857, 860,
-	861, 2344, 826, 2162, 864, 1110, 854, 858, 885, 1118,
-	1689, 1361, 626, 1442, 863, 543, 898, 1175, 628, 1141,
-	1965, 1138, 178, 179, 180, 853, 1462, 1443, 1444, 1441,
-	150, 155, 152, 158, 159, 160, 161, 163, 164, 165,
-	166, 176, 675, 1165, 1166, 1233, 167, 168, 169, 170,
-	944, 106, 1009, 1124, 1241, 1856, 1674, 1026, 1152, 1027,
-	1028, 1480, 1885, 1355, 863, 1356, 1967, 1859, 1357, 1028,
-	857, 860, 861, 530, 826, 1265, 1027, 1028, 854, 858,
-	178, 179, 180, 1274, 1795, 2264, 2060, 1276, 931, 2059,
-	530, 530, 1463, 530, 1672, 530, 530, 1044, 530, 530,
-	530, 530, 530, 530, 1048, 1049, 1050, 1051, 1053, 1052,
-	1054, 1055, 1480, 530, 1750, 973, 1377, 176, 1312, 1045,
-	1046, 1047, 1048, 1049, 1050, 1051, 1053, 1052, 1054, 1055,
-	1662, 1278, 2137, 176, 1046, 1047, 1048, 1049, 1050, 1051,
-	1053, 1052, 1054, 1055, 530, 1277, 176, 1267, 1275, 1667,
-	1796, 1050, 1051, 1053, 1052, 1054, 1055, 1366, 2353, 1556,
-	1557, 176, 1432, 1434, 1435, 1667, 1261, 1026, 1254, 1027,
-	1028, 1670, 873, 1307, 1308, 1671, 943, 176, 1026, 871,
-	1027, 1028, 2131, 1433, 176, 1170, 1160, 1247, 1248, 2354,
-	1239, 1669, 2320, 176, 176, 176, 176, 176, 176, 176,
-	176, 176, 530, 530, 530, 2403, 1309, 1272, 1281, 2432,
-	1282, 1251, 1284, 1286, 2045, 2449, 1290, 1292, 1294, 1296,
-	1298, 2404, 1264, 2321, 1252, 2394, 1250, 2364, 1380, 1271,
-	1271, 2202, 176, 2136, 1273, 1384, 1136, 1386, 1387, 1388,
-	1389, 1372, 2444, 1484, 1393, 1315, 1316, 1136, 1728, 1729,
-	1730, 1321, 1322, 1026, 1325, 1027, 1028, 1739, 1407, 1245,
-	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1030, 1026, 1310,
-	1027, 1028, 2324, 1378, 1379, 2201, 1026, 1461, 1027, 1028,
-	1741, 1026, 2433, 1027, 1028, 1369, 1746, 1383, 666, 1740,
-	1470, 530, 612, 613, 1390, 1391, 1392, 1136, 113, 1348,
-	1438, 843, 2129, 1136, 2323, 842, 530, 530, 1445, 1436,
-	1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456,
-	1457, 1458, 1459, 1382, 1026, 81, 1027, 1028, 2076, 1346,
-	1446, 1026, 2392, 1027, 1028, 176, 1870, 1026, 1440, 1027,
-	1028, 1403, 1404, 1405, 2365, 1149, 172, 1869, 1513, 1406,
-	1374, 1336, 1637, 1026, 1349, 1027, 1028, 1334, 1745, 1333,
-	1481, 176, 1347, 1332, 530, 1534, 1026, 2286, 1027, 1028,
-	114, 178, 179, 180, 176, 2057, 1439, 530, 1026, 1871,
-	1027, 1028, 176, 156, 176, 1323, 176, 176, 530, 1317,
-	1515, 530, 1345, 1026, 1314, 1027, 1028, 1536, 1313, 1537,
-	1288, 1026, 530, 1027, 1028, 2322, 2263, 89, 1517, 1518,
-	1148, 2261, 1145, 1026, 1335, 1027, 1028, 2239, 1513, 2199,
-	178, 179, 180, 89, 1840, 1843, 671, 2056, 1879, 671,
-	1867, 1427, 1428, 1429, 1430, 1542, 1683, 1682, 1514, 153,
-	1549, 154, 178, 179, 180, 1026, 1650, 1027, 1028, 1566,
-	1535, 171, 1608, 1609, 1610, 1611, 1465, 530, 1464, 1409,
-	1515, 1146, 1373, 1643, 1644, 1645, 1337, 1591, 1647, 1649,
-	1324, 1468, 1469, 1574, 178, 179, 180, 1320, 641, 1474,
-	1319, 530, 178, 179, 180, 1318, 1648, 530, 1274, 1147,
-	986, 1274, 86, 1274, 1466, 1592, 95, 1624, 1544, 1666,
-	2101, 1472, 1473, 87, 2161, 1657, 1595, 94, 1561, 93,
-	1789, 2448, 1559, 2219, 2428, 1789, 2414, 88, 157, 1136,
-	1516, 543, 1578, 1519, 1520, 1579, 1630, 162, 2288, 530,
-	2287, 1461, 1594, 1789, 2398, 1593, 1461, 1461, 2225, 1044,
-	1800, 1943, 1897, 675, 1789, 2388, 675, 1789, 2357, 1789,
-	2338, 2310, 1136, 1882, 1552, 1553, 1541, 1136, 2219, 1136,
-	1849, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1053, 1052,
-	1054, 1055, 176, 1829, 1654, 1136, 1136, 1789, 2217, 176,
-	1596, 1586, 1800, 1638, 176, 176, 1668, 1636, 176, 1603,
-	176, 1604, 1605, 1606, 1607, 1625, 176, 1635, 1646, 1667,
-	1136, 1620, 1621, 176, 94, 1850, 2163, 1614, 1615, 1616,
-	1617, 1808, 599, 1678, 1660, 1663, 1659, 1829, 1680, 1681,
-	2166, 1136, 1676, 1025, 95, 1677, 1785, 1625, 866, 1852,
-	2343, 176, 530, 1847, 149, 94, 1830, 93, 1271, 1661,
-	1626, 88, 1664, 1667, 1665, 1832, 88, 1857, 1858, 2068,
-	2067, 1714, 1715, 2161, 1848, 865, 1717, 86, 1686, 1789,
-	177, 2064, 2065, 177, 88, 1718, 177, 1808, 87, 2064,
-	2063, 531, 1737, 177, 1808, 1136, 1737, 1136, 1723, 1914,
-	1830, 177, 1236, 1899, 1893, 1894, 1854, 1789, 1788, 1723,
-	1025, 1136, 2034, 177, 1236, 1235, 1181, 1180, 1737, 2066,
-	2003, 1723, 1438, 1807, 1136, 1580, 1737, 1755, 2204, 1732,
-	2161, 1734, 1707, 1856, 1754, 531, 1667, 1651, 531, 177,
-	531, 631, 1136, 1554, 1140, 1859, 1524, 1417, 81, 2333,
-	1363, 1733, 1167, 1303, 603, 610, 611, 612, 613, 604,
-	606, 848, 847, 2443, 605, 176, 2396, 608, 614, 615,
-	2438, 2360, 81, 176, 2337, 2331, 1876, 1808, 2205, 2206,
-	2207, 530, 1721, 2300, 1143, 2275, 2196, 1238, 1623, 2100,
-	2062, 1900, 1794, 1619, 1613, 1612, 1351, 1266, 1439, 1262,
-	1767, 1234, 1731, 1304, 1305, 1306, 107, 930, 2103, 2208,
-	2053, 2054, 1875, 176, 176, 2173, 2174, 2301, 81, 1300,
-	1804, 34, 616, 618, 617, 619, 1634, 1790, 2400, 1839,
-	1824, 2369, 1813, 1816, 1817, 1818, 1814, 1749, 1815, 1819,
-	2176, 2179, 1515, 2083, 1851, 150, 155, 152, 158, 159,
-	160, 161, 163, 164, 165, 166, 2209, 2210, 2082, 1876,
-	2081, 167, 168, 169, 170, 2003, 1301, 1302, 1786, 1886,
-	1708, 1410, 2025, 2178, 2023, 530, 1113, 2026, 1768, 2024,
-	176, 2022, 1864, 1865, 2021, 2412, 2389, 176, 1548, 1823,
-	1514, 1144, 1540, 530, 1776, 1892, 2167, 1844, 2087, 530,
-	1827, 1782, 1787, 1274, 1274, 1983, 1751, 1797, 530, 1045,
-	1046, 1047, 1048, 1049, 1050, 1051, 1053, 1052, 1054, 1055,
-	1911, 2027, 1982, 1817, 1818, 1747, 1831, 2319, 2254, 1995,
-	1997, 176, 176, 176, 176, 176, 1834, 1845, 1998, 1630,
-	1813, 1816, 1817, 1818, 1814, 2154, 1815, 1819, 176, 176,
-	2173, 2174, 2256, 2153, 645, 2157, 1868, 1134, 1130, 1992,
-	1758, 1759, 646, 1362, 176, 620, 1861, 1599, 1880, 1476,
-	1877, 895, 1131, 1909, 894, 2113, 1883, 1875, 86, 1938,
-	1152, 86, 1461, 1477, 1887, 1888, 1889, 998, 88, 87,
-	2159, 1254, 87, 95, 1878, 1907, 1906, 1538, 1539, 1133,
-	114, 1132, 530, 88, 94, 2079, 93, 1910, 1711, 627,
-	1556, 1557, 1908, 2340, 2296, 88, 530, 1860, 1964, 1821,
-	1545, 653, 654, 1981, 1700, 95, 176, 1727, 93, 2397,
-	530, 1980, 2262, 1945, 1930, 2260, 94, 94, 93, 530,
-	1974, 2259, 2252, 2158, 1946, 2156, 530, 530, 2084, 176,
-	176, 176, 176, 176, 1901, 1902, 1985, 2043, 1652, 1936,
-	652, 176, 1937, 633, 1961, 2251, 176, 176, 95, 176,
-	2147, 1960, 176, 176, 176, 2015, 1947, 1974, 1800, 94,
-	2402, 2401, 2402, 1123, 2009, 1785, 1756, 1161, 1153, 1986,
-	100, 101, 2325, 2055, 643, 2058, 2000, 1973, 97, 82,
-	176, 1, 607, 2033, 2371, 2006, 499, 1984, 1525, 1111,
-	2006, 513, 1987, 2367, 177, 1338, 177, 1328, 2228, 177,
-	2297, 2077, 634, 1628, 856, 2017, 2018, 176, 2020, 635,
-	139, 1589, 2035, 1978, 530, 2036, 2016, 2028, 1590, 2019,
-	2384, 530, 2032, 2089, 89, 104, 176, 531, 531, 531,
-	2044, 2037, 1134, 1130, 1372, 2052, 176, 819, 2051, 103,
-	859, 957, 1653, 2048, 2014, 531, 531, 1131, 2220, 1862,
-	176, 1962, 1963, 176, 1600, 1187, 1966, 1185, 1186, 1184,
-	1968, 1969, 1970, 2114, 1189, 1188, 1183, 2073, 2072, 1411,
-	527, 1820, 1127, 1128, 1133, 174, 1132, 1176, 1154, 2086,
-	896, 489, 2069, 1684, 2095, 495, 2093, 1068, 1979, 583,
-	1630, 2088, 1835, 2052, 672, 665, 2051, 2011, 2151, 1991,
-	176, 1993, 2109, 1792, 1996, 1989, 2001, 2085, 2318, 2111,
-	2112, 2253, 2339, 1597, 1150, 2108, 1748, 1075, 2074, 2075,
-	2122, 2115, 1478, 1571, 1533, 1431, 552, 551, 549, 1778,
-	1801, 1031, 2116, 602, 177, 1162, 1812, 2145, 1810, 1809,
-	1709, 1575, 2175, 2171, 1569, 1784, 560, 553, 529, 545,
-	600, 177, 2047, 2050, 1598, 176, 1917, 2155, 2097, 2148,
-	1010, 1126, 539, 831, 1475, 2302, 1726, 2133, 2170, 1125,
-	531, 1487, 2160, 1488, 177, 2180, 2281, 1841, 60, 531,
-	38, 2177, 534, 2408, 1001, 531, 659, 32, 2184, 2185,
-	31, 30, 673, 29, 28, 823, 23, 830, 2182, 176,
-	22, 21, 176, 176, 176, 530, 1044, 2215, 1735, 20,
-	19, 25, 18, 17, 16, 2195, 2193, 2194, 2183, 109,
-	47, 2198, 44, 2200, 530, 530, 530, 530, 1045, 1046,
-	1047, 1048, 1049, 1050, 1051, 1053, 1052, 1054, 1055, 42,
-	116, 2235, 2224, 115, 45, 41, 933, 39, 27, 26,
-	15, 2119, 2120, 14, 2121, 13, 12, 2123, 11, 2125,
-	10, 530, 530, 530, 176, 9, 5, 4, 1004, 24,
-	2, 2188, 0, 2135, 0, 0, 0, 0, 2234, 0,
-	0, 0, 0, 0, 2233, 0, 0, 530, 0, 530,
-	0, 0, 0, 0, 0, 0, 0, 0, 2258, 0,
-	2248, 2249, 2269, 34, 0, 2257, 0, 2271, 2267, 0,
-	2238, 1135, 627, 0, 0, 2265, 543, 530, 0, 2285,
-	0, 2273, 2246, 2247, 2009, 0, 0, 0, 2009, 0,
-	0, 0, 0, 177, 0, 2277, 2278, 0, 0, 0,
-	0, 0, 0, 0, 2006, 0, 0, 0, 530, 0,
-	0, 0, 2192, 0, 0, 0, 0, 2289, 0, 0,
-	0, 2291, 2292, 0, 0, 531, 0, 0, 2197, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 531, 531, 0, 531, 2299, 531, 531, 0,
-	531, 531, 531, 531, 531, 531, 530, 2314, 2329, 0,
-	2223, 2315, 2317, 0, 0, 531, 0, 0, 2326, 177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 627,
-	0, 530, 176, 2345, 0, 177, 0, 0, 2330, 2009,
-	0, 530, 0, 2342, 34, 2236, 531, 2237, 177, 0,
-	0, 0, 2240, 2241, 0, 0, 0, 0, 530, 0,
-	2328, 0, 0, 177, 0, 0, 0, 0, 530, 0,
-	2366, 0, 0, 0, 530, 530, 0, 0, 2266, 177,
-	0, 0, 0, 2355, 2370, 2375, 177, 2378, 34, 2274,
-	0, 530, 2276, 2393, 2383, 177, 177, 177, 177, 177,
-	177, 177, 177, 177, 531, 531, 531, 0, 0, 0,
-	0, 2399, 2299, 2385, 0, 0, 0, 2358, 2006, 2405,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2413,
-	0, 2411, 0, 0, 177, 0, 0, 2416, 2415, 0,
-	2422, 0, 0, 0, 0, 0, 0, 0, 0, 2426,
-	2424, 2427, 0, 0, 0, 0, 0, 0, 0, 0,
-	2436, 0, 0, 2437, 0, 2439, 0, 2316, 543, 0,
-	0, 2441, 0, 0, 530, 0, 2445, 0, 0, 0,
-	2450, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 531, 0, 0, 0, 0, 2336, 1044,
-	0, 0, 1040, 0, 1041, 0, 0, 0, 531, 531,
-	0, 0, 0, 0, 673, 673, 673, 0, 1042, 1043,
-	1039, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1053, 1052,
-	1054, 1055, 1000, 1002, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 2359, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2379, 0, 0,
-	0, 0, 0, 177, 0, 0, 531, 0, 114, 0,
-	136, 0, 0, 0, 0, 0, 177, 0, 0, 531,
-	0, 156, 0, 0, 177, 0, 177, 0, 177, 177,
-	531, 0, 0, 531, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 531, 0, 0, 1105, 0, 0,
-	0, 0, 146, 0, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 153, 0, 154,
-	0, 0, 0, 0, 0, 123, 124, 145, 144, 171,
-	0, 0, 0, 0, 2442, 0, 0, 1157, 0, 531,
-	0, 0, 0, 0, 0, 0, 673, 0, 0, 0,
-	0, 0, 1177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 531, 0, 0, 0, 0, 0, 531,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 140, 121, 147, 128, 120,
-	0, 141, 142, 0, 0, 0, 157, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 129, 0, 0, 0,
-	0, 531, 0, 0, 0, 0, 72, 36, 37, 74,
-	132, 130, 125, 126, 127, 131, 0, 0, 0, 0,
-	122, 0, 0, 0, 0, 0, 78, 0, 0, 133,
-	40, 66, 67, 0, 64, 68, 0, 0, 0, 0,
-	0, 0, 0, 65, 177, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 0, 0, 177, 177, 0, 0,
-	177, 0, 177, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 53, 0, 0, 177, 0, 0, 0, 0,
-	0, 0, 0, 81, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 149, 177, 531, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 823, 0, 0, 584, 1137, 1139, 0, 0,
-	0, 0, 0, 0, 0, 1105, 0, 0, 0, 1280,
-	1280, 0, 1280, 0, 1280, 1280, 0, 1289, 1280, 1280,
-	1280, 1280, 1280, 0, 0, 0, 0, 0, 0, 0,
-	1105, 1105, 823, 0, 0, 43, 46, 49, 48, 51,
-	0, 63, 0, 175, 69, 0, 485, 143, 0, 525,
-	0, 0, 0, 0, 0, 0, 485, 0, 0, 137,
-	0, 0, 138, 1350, 485, 0, 52, 77, 76, 0,
-	0, 61, 62, 50, 0, 0, 640, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 658, 0, 658, 177, 0, 0, 0, 0,
-	0, 0, 485, 531, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 55, 0, 56, 57, 58, 59,
-	0, 673, 673, 673, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 177, 0, 0, 0,
+	600, 2468, 2417, 2352, 2382, 2354, 2439, 2246, 1556, 2207,
+	2120, 2108, 2403, 2388, 2318, 997, 3, 650, 2025, 595,
+	2270, 627, 1860, 1867, 1785, 2109, 2026, 2022, 2172, 1113,
+	594, 34, 1871, 2023, 2275, 2166, 2262, 1146, 551, 1575,
+	556, 603, 2192, 2020, 1539, 1836, 1805, 547, 176, 2012,
+	1634, 176, 945, 515, 176, 1813, 1888, 549, 1911, 531,
+	592, 176, 1949, 593, 1889, 2067, 1639, 1890, 1588, 176,
+	1654, 578, 677, 1825, 651, 824, 35, 1580, 148, 33,
+	548, 176, 1579, 1133, 629, 1797, 1469, 1477, 887, 1967,
+	1599, 1519, 1699, 1653, 543, 1562, 1428, 134, 1271, 1641,
+	1882, 1180, 631, 531, 635, 1667, 531, 176, 531, 89,
+	1842, 1159, 1541, 852, 1582, 1116, 561, 85, 674, 90,
+	1446, 1378, 653, 1489, 1375, 1015, 1278, 831, 828, 1651,
+	858, 853, 1361, 1630, 974, 1567, 854, 832, 1179, 641,
+	855, 1163, 1177, 1383, 865, 1240, 1263, 117, 118, 664,
+	637, 639, 92, 636, 151, 930, 70, 538, 111, 990,
+	1563, 112, 91, 1086, 1082, 638, 71, 8, 7, 995,
+	1287, 6, 2452, 2300, 83, 2469, 2383, 2209, 1532, 79,
+	2209, 2210, 2211, 2355, 658, 1697, 663, 1929, 1928, 1957,
+	1807, 1958, 119, 840, 835, 1435, 178, 179, 180, 113,
+	1536, 1537, 643, 1434, 1347, 1433, 1432, 1431, 1430, 1522,
+	1416, 1783, 84, 488, 2431, 541, 1245, 542, 892, 825,
+	539, 1421, 2008, 1559, 1558, 889, 2089, 2223, 630, 96,
+	1060, 2314, 2313, 628, 584, 891, 890, 2241, 903, 904,
+	2242, 907, 908, 909, 910, 671, 72, 913, 914, 915,
+	916, 917, 918, 919, 920, 921, 922, 923, 924, 925,
+	926, 927, 868, 678, 644, 113, 869, 98, 99, 846,
+	102, 652, 845, 108, 847, 1646, 173, 1150, 1148, 483,
+	2462, 893, 894, 895, 72, 2413, 1737, 1016, 2457, 172,
+	2370, 2447, 900, 72, 72, 2288, 74, 2247, 1644, 626,
+	2404, 1685, 634, 2412, 1151, 1149, 2369, 1966, 1816, 1016,
+	2154, 1254, 905, 114, 1936, 1181, 1851, 1182, 1935, 1850,
+	1784, 2394, 1852, 81, 2058, 2392, 156, 1956, 1863, 113,
+	666, 667, 1593, 1817, 2398, 2399, 2059, 2060, 1734, 1873,
+	1874, 981, 1538, 983, 964, 839, 2393, 993, 841, 624,
+	1735, 623, 1026, 1594, 1595, 844, 2302, 939, 940, 965,
+	958, 81, 1879, 178, 179, 180, 518, 929, 1857, 2169,
+	81, 81, 952, 1864, 1026, 2122, 952, 953, 2146, 980,
+	982, 953, 153, 518, 154, 951, 1643, 950, 1614, 1613,
+	969, 970, 518, 2144, 171, 529, 1420, 1866, 518, 533,
+	527, 1861, 1367, 1912, 844, 1872, 836, 1422, 1423, 1424,
+	1425, 842, 1120, 838, 837, 1873, 1874, 1875, 178, 179,
+	180, 1668, 1862, 906, 1711, 1708, 1710, 1709, 1932, 2456,
+	1713, 2116, 1714, 505, 1715, 933, 966, 959, 848, 2117,
+	1700, 1705, 504, 1022, 1362, 992, 1014, 518, 1337, 987,
+	1944, 973, 2123, 502, 1868, 967, 968, 935, 1704, 660,
+	842, 157, 2432, 1716, 912, 1022, 911, 2310, 978, 2124,
+	162, 596, 979, 579, 581, 597, 598, 971, 577, 580,
+	599, 1872, 984, 176, 2236, 176, 1702, 972, 176, 867,
+	1338, 499, 1339, 1875, 1706, 1670, 1576, 1257, 1703, 1856,
+	513, 1750, 885, 884, 977, 883, 985, 582, 583, 1875,
+	882, 881, 880, 879, 878, 510, 531, 531, 531, 843,
+	2088, 873, 519, 876, 544, 849, 874, 2453, 886, 1059,
+	1059, 829, 844, 928, 531, 531, 861, 2443, 2445, 519,
+	829, 860, 948, 1376, 954, 955, 956, 957, 519, 866,
+	1652, 1368, 1008, 654, 519, 860, 863, 864, 829, 829,
+	665, 1945, 827, 857, 861, 1691, 34, 994, 843, 2451,
+	2299, 1372, 1934, 2303, 1960, 1748, 489, 149, 491, 506,
+	1645, 521, 856, 520, 495, 986, 493, 497, 507, 498,
+	1277, 492, 1865, 503, 2170, 932, 494, 508, 509, 511,
+	525, 524, 512, 519, 501, 522, 1021, 1018, 1019, 1020,
+	1025, 1027, 1024, 2368, 1023, 1063, 1064, 1065, 1066, 1002,
+	80, 1017, 896, 877, 176, 1071, 875, 1074, 1021, 1018,
+	1019, 1020, 1025, 1027, 1024, 1735, 1023, 1948, 2096, 962,
+	1931, 176, 1111, 1017, 988, 1121, 1736, 1995, 2396, 867,
+	1106, 1921, 1124, 1994, 75, 1993, 1276, 1252, 80, 1123,
+	1251, 531, 1250, 1127, 1373, 176, 1248, 80, 80, 631,
+	531, 1349, 1348, 1350, 1351, 1352, 531, 941, 938, 487,
+	1067, 949, 931, 1786, 1788, 674, 999, 1000, 482, 2395,
+	902, 1112, 110, 1061, 1062, 1943, 843, 2441, 1942, 2347,
+	2442, 71, 2440, 1011, 1009, 1047, 1125, 1010, 1687, 866,
+	89, 2206, 1112, 1059, 2188, 1968, 1126, 1847, 1812, 1775,
+	90, 1600, 1531, 1167, 1870, 1093, 2323, 1048, 1049, 1050,
+	1051, 1052, 1053, 1054, 1056, 1055, 1057, 1058, 1117, 867,
+	1366, 1951, 1084, 1951, 1085, 1088, 1950, 867, 1950, 523,
+	943, 1058, 2057, 92, 647, 105, 991, 2381, 1970, 2364,
+	1099, 1100, 1101, 1102, 947, 1869, 2182, 516, 888, 150,
+	155, 152, 158, 159, 160, 161, 163, 164, 165, 166,
+	1114, 975, 517, 961, 867, 167, 168, 169, 170, 1701,
+	1369, 1787, 1145, 628, 963, 630, 1183, 1490, 1983, 866,
+	1029, 1384, 1030, 1031, 870, 860, 1012, 866, 1122, 1985,
+	1901, 106, 870, 860, 871, 1142, 1173, 1174, 1490, 1031,
+	1764, 1972, 871, 1976, 176, 1971, 2284, 1969, 1241, 2078,
+	678, 1363, 1974, 1364, 2149, 1140, 1365, 1249, 867, 2077,
+	872, 1973, 1674, 1286, 866, 1686, 901, 1053, 1054, 1056,
+	1055, 1057, 1058, 1285, 1975, 1977, 531, 1275, 1273, 1441,
+	1443, 1444, 1029, 1128, 1030, 1031, 1282, 934, 1684, 1029,
+	1284, 1030, 1031, 531, 531, 1682, 531, 876, 531, 531,
+	1442, 531, 531, 531, 531, 531, 531, 178, 179, 180,
+	946, 1471, 874, 178, 179, 180, 531, 1809, 866, 2425,
+	176, 1320, 1679, 1760, 860, 863, 864, 1679, 829, 1283,
+	976, 2466, 857, 861, 1030, 1031, 176, 1049, 1050, 1051,
+	1052, 1053, 1054, 1056, 1055, 1057, 1058, 531, 1683, 176,
+	1385, 1269, 1140, 1681, 1315, 1316, 1255, 1256, 1451, 2373,
+	1374, 2151, 1568, 1569, 176, 1029, 2063, 1030, 1031, 1262,
+	1168, 2454, 1452, 1453, 1450, 1178, 1356, 1472, 2340, 2471,
+	176, 614, 615, 1810, 1281, 2324, 1029, 176, 1030, 1031,
+	2374, 1317, 1740, 1741, 1742, 1759, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 531, 531, 531, 1354, 2341,
+	1247, 1280, 2416, 2384, 1323, 1324, 2414, 1259, 1260, 1258,
+	1329, 1330, 1753, 1272, 2222, 1032, 1140, 2221, 1279, 1279,
+	1029, 1388, 1030, 1031, 1157, 176, 1380, 1755, 1392, 1355,
+	1394, 1395, 1396, 1397, 2455, 1344, 1754, 1401, 1333, 2094,
+	1029, 2385, 1030, 1031, 1080, 1886, 1029, 81, 1030, 1031,
+	1029, 1415, 1030, 1031, 1289, 1885, 1290, 2306, 1292, 1294,
+	1449, 1353, 1298, 1300, 1302, 1304, 1306, 1318, 669, 1649,
+	1470, 1029, 1447, 1030, 1031, 1029, 1377, 1030, 1031, 1357,
+	1253, 544, 1342, 1140, 1480, 531, 1887, 1341, 1340, 1156,
+	1494, 1029, 1445, 1030, 1031, 1386, 1387, 2426, 1343, 113,
+	531, 531, 601, 846, 1331, 1325, 845, 1322, 1029, 1391,
+	1030, 1031, 1321, 1455, 1390, 1296, 1398, 1399, 1400, 1523,
+	1029, 1153, 1030, 1031, 1029, 1160, 1030, 1031, 2344, 176,
+	2343, 2342, 1491, 2283, 2281, 1454, 2259, 1456, 1457, 1458,
+	1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467, 1468,
+	177, 2219, 1546, 177, 1547, 176, 177, 1414, 531, 1544,
+	2074, 532, 1895, 177, 1411, 1412, 1413, 1883, 1695, 1448,
+	1154, 177, 176, 1694, 1561, 531, 1525, 1545, 1475, 1474,
+	176, 2119, 176, 177, 176, 176, 531, 1417, 1381, 531,
+	178, 179, 180, 1523, 2075, 1345, 89, 1527, 1528, 1332,
+	531, 674, 1328, 1327, 674, 532, 90, 1326, 532, 177,
+	532, 1047, 89, 1155, 1043, 989, 1044, 1803, 2470, 2157,
+	2239, 2450, 90, 1803, 2436, 1524, 88, 1552, 1140, 1578,
+	1045, 1046, 1042, 1048, 1049, 1050, 1051, 1052, 1053, 1054,
+	1056, 1055, 1057, 1058, 2308, 2156, 178, 179, 180, 2307,
+	1525, 1803, 2420, 2245, 1029, 531, 1030, 1031, 95, 1803,
+	2410, 1655, 1656, 1657, 1803, 2377, 1659, 1661, 2021, 94,
+	643, 93, 178, 179, 180, 1604, 1854, 1603, 2181, 531,
+	1029, 1586, 1030, 1031, 1140, 531, 1282, 86, 1476, 1282,
+	1913, 1282, 1803, 2358, 1554, 1482, 1483, 1678, 87, 1573,
+	1636, 178, 179, 180, 1898, 1662, 1669, 1814, 1620, 1621,
+	1622, 1623, 1608, 1607, 1526, 2181, 1571, 1529, 1530, 178,
+	179, 180, 2183, 1660, 1642, 1591, 1028, 531, 1590, 1470,
+	2363, 1606, 1803, 86, 1470, 1470, 1605, 1047, 1140, 1959,
+	88, 2330, 1140, 1822, 87, 2084, 678, 2239, 1140, 678,
+	1551, 94, 1140, 1814, 1666, 1803, 2237, 1679, 1140, 1048,
+	1049, 1050, 1051, 1052, 1053, 1054, 1056, 1055, 1057, 1058,
+	176, 2186, 1140, 2086, 2085, 2082, 2083, 176, 1822, 1637,
+	1632, 1633, 176, 176, 1673, 1592, 176, 1676, 176, 1677,
+	1650, 1648, 1647, 1658, 176, 2082, 2081, 2465, 1140, 1822,
+	1140, 176, 1688, 1051, 1052, 1053, 1054, 1056, 1055, 1057,
+	1058, 1637, 1672, 1671, 1675, 1690, 1843, 1382, 868, 1751,
+	1692, 1693, 869, 1615, 2181, 1616, 1617, 1618, 1619, 176,
+	531, 2052, 1279, 1689, 1751, 1140, 1735, 1930, 1244, 1915,
+	1735, 1626, 1627, 1628, 1629, 1909, 1910, 1803, 1802, 1726,
+	1727, 2353, 1028, 1140, 1729, 1035, 1036, 1037, 1038, 1039,
+	1040, 1041, 1033, 1730, 1244, 1243, 1698, 1189, 1188, 1821,
+	1751, 1769, 1768, 1679, 1799, 81, 1892, 1663, 1680, 1844,
+	1447, 605, 612, 613, 614, 615, 606, 608, 1846, 88,
+	1566, 607, 1144, 1534, 610, 616, 617, 1426, 1436, 1437,
+	1438, 1439, 1047, 1371, 1747, 633, 1175, 851, 1719, 1048,
+	1049, 1050, 1051, 1052, 1053, 1054, 1056, 1055, 1057, 1058,
+	1745, 1843, 850, 1822, 1048, 1049, 1050, 1051, 1052, 1053,
+	1054, 1056, 1055, 1057, 1058, 1679, 2418, 2071, 2072, 1478,
+	1479, 1047, 1744, 2460, 1746, 176, 1751, 1484, 2224, 618,
+	620, 619, 621, 176, 1311, 2380, 81, 1781, 2357, 2351,
+	2320, 531, 1733, 1048, 1049, 1050, 1051, 1052, 1053, 1054,
+	1056, 1055, 1057, 1058, 1808, 95, 1147, 1448, 2295, 2216,
+	1246, 1743, 81, 1635, 1844, 177, 94, 177, 93, 544,
+	177, 2118, 933, 1735, 2080, 176, 176, 88, 2225, 2226,
+	2227, 1916, 1818, 1631, 1312, 1313, 1314, 1625, 2121, 1624,
+	1359, 1853, 1274, 1270, 1525, 1242, 34, 107, 532, 532,
+	532, 585, 1763, 2228, 2321, 1838, 1564, 1565, 1827, 1830,
+	1831, 1832, 1828, 1646, 1829, 1833, 532, 532, 2193, 2194,
+	1891, 2193, 2194, 2422, 1152, 2389, 1800, 1308, 2196, 2101,
+	2100, 2099, 2021, 1598, 1902, 1140, 1720, 531, 1418, 1117,
+	1782, 2043, 176, 1524, 2199, 1790, 2044, 1804, 2041, 176,
+	2229, 2230, 2198, 2042, 1837, 531, 2040, 2039, 1550, 1796,
+	530, 531, 1801, 1908, 2434, 1282, 1282, 1892, 1811, 2411,
+	531, 1858, 1841, 1560, 1309, 1310, 2045, 2187, 1831, 1832,
+	645, 2105, 1927, 2001, 1848, 1761, 2000, 2339, 1845, 2013,
+	2015, 2174, 1638, 176, 176, 176, 176, 176, 2016, 2173,
+	2274, 1642, 1880, 1881, 676, 2276, 177, 826, 1859, 833,
+	176, 176, 2177, 2010, 1370, 622, 1884, 648, 1877, 1611,
+	1772, 1773, 1894, 177, 1896, 649, 176, 898, 1486, 646,
+	1893, 897, 2131, 1899, 1891, 1138, 1134, 86, 1925, 1903,
+	1904, 1905, 1487, 532, 88, 1001, 1470, 177, 87, 1954,
+	1135, 1262, 532, 1827, 1830, 1831, 1832, 1828, 532, 1829,
+	1833, 1963, 1923, 1917, 1918, 1922, 531, 114, 2179, 1568,
+	1569, 1926, 88, 1982, 2097, 1548, 1549, 1137, 1139, 1136,
+	531, 1992, 1138, 1134, 1924, 1961, 1723, 86, 2360, 2316,
+	176, 1876, 1835, 1555, 531, 1712, 1962, 1135, 87, 656,
+	657, 1999, 1739, 531, 93, 2419, 1946, 2282, 2280, 1998,
+	531, 531, 2279, 176, 176, 176, 176, 176, 1992, 2272,
+	2178, 2018, 1131, 1132, 1137, 176, 1136, 2027, 2176, 1965,
+	176, 176, 1979, 176, 1978, 2004, 176, 176, 176, 2033,
+	635, 2102, 2061, 2003, 95, 1964, 1664, 2024, 655, 94,
+	1952, 95, 2024, 1953, 95, 94, 1991, 93, 2271, 2076,
+	1127, 2167, 94, 1814, 93, 94, 176, 2424, 2423, 97,
+	1799, 1770, 2002, 88, 2005, 2051, 1169, 1161, 2053, 100,
+	101, 2054, 2424, 2345, 2073, 82, 637, 2095, 1, 636,
+	609, 2035, 2036, 176, 2038, 2034, 2391, 2046, 2037, 500,
+	531, 1535, 1115, 89, 514, 2055, 177, 531, 2387, 2050,
+	1380, 1346, 176, 90, 2070, 2107, 1336, 2069, 2248, 2317,
+	1640, 859, 176, 139, 1601, 2066, 2062, 1602, 2406, 104,
+	822, 103, 862, 960, 1665, 2240, 176, 1878, 532, 176,
+	1765, 1612, 1195, 2104, 1193, 2091, 1194, 2090, 1192, 2132,
+	1996, 1197, 1196, 1191, 1419, 532, 532, 528, 532, 1834,
+	532, 532, 174, 532, 532, 532, 532, 532, 532, 1184,
+	2092, 2093, 2106, 2113, 2070, 2103, 1642, 2069, 532, 2111,
+	1162, 2032, 177, 899, 2136, 490, 2087, 1696, 176, 496,
+	1072, 1997, 1849, 675, 2127, 2129, 2130, 2133, 177, 2126,
+	668, 2029, 2171, 2009, 2011, 1806, 2014, 2007, 2134, 532,
+	2338, 177, 2273, 2142, 2359, 1609, 1160, 1158, 1749, 1762,
+	1079, 1488, 1583, 1543, 1440, 554, 177, 553, 552, 550,
+	1792, 1815, 1034, 604, 1170, 1826, 1824, 1823, 1721, 1587,
+	2195, 2191, 177, 176, 2168, 1581, 1798, 562, 2165, 177,
+	555, 2175, 546, 602, 2065, 2068, 2180, 1610, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 532, 532, 532,
+	2197, 1933, 2115, 1013, 1130, 2200, 540, 834, 1485, 2190,
+	2322, 2202, 1738, 2153, 1129, 1497, 1498, 176, 2203, 2301,
+	176, 176, 176, 531, 1855, 60, 38, 177, 535, 2204,
+	2205, 2235, 2215, 2213, 2214, 2430, 1004, 662, 32, 31,
+	30, 29, 531, 531, 531, 531, 28, 676, 676, 676,
+	23, 22, 21, 20, 19, 25, 18, 17, 16, 109,
+	47, 2255, 44, 42, 116, 1003, 1005, 115, 45, 41,
+	936, 39, 2244, 27, 26, 15, 14, 13, 12, 11,
+	10, 531, 531, 531, 176, 9, 5, 532, 4, 1007,
+	24, 2258, 2, 2208, 0, 0, 0, 2218, 0, 2220,
+	0, 0, 532, 532, 0, 2139, 2140, 531, 2141, 531,
+	0, 2143, 0, 2145, 0, 0, 0, 0, 2266, 2267,
+	2278, 2269, 2289, 2027, 0, 2277, 2291, 2027, 2268, 0,
+	2287, 177, 2293, 0, 0, 2285, 34, 531, 2253, 1980,
+	1981, 1109, 2024, 0, 1984, 2305, 0, 0, 1986, 1987,
+	1988, 0, 0, 0, 0, 0, 2254, 177, 0, 0,
+	532, 0, 0, 0, 0, 0, 0, 0, 531, 0,
+	0, 0, 0, 0, 177, 0, 0, 532, 2297, 2298,
+	0, 0, 177, 2319, 177, 2312, 177, 177, 532, 2309,
+	2311, 532, 1165, 0, 2019, 0, 0, 0, 0, 0,
+	0, 676, 532, 0, 0, 0, 0, 1185, 0, 0,
+	2335, 0, 0, 2334, 0, 0, 531, 0, 0, 0,
+	0, 0, 2337, 0, 2349, 0, 0, 2346, 2027, 0,
+	0, 2348, 0, 0, 0, 0, 0, 0, 0, 2350,
+	0, 531, 176, 0, 0, 0, 0, 0, 0, 2365,
+	0, 531, 0, 2362, 0, 0, 0, 532, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 34, 531, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 531, 0,
+	0, 532, 0, 0, 531, 531, 2386, 532, 2378, 0,
+	0, 0, 0, 2375, 0, 0, 0, 2400, 2405, 2319,
+	2407, 531, 2390, 2397, 0, 0, 2024, 0, 0, 2415,
+	0, 34, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2421, 0, 0, 0, 0, 0, 0, 0, 532,
+	0, 0, 0, 2427, 0, 0, 0, 0, 0, 0,
+	2435, 2433, 0, 0, 544, 1141, 1143, 0, 0, 2438,
+	2437, 2444, 0, 0, 0, 0, 0, 0, 0, 0,
+	2448, 2446, 2449, 0, 0, 2155, 0, 0, 0, 0,
+	0, 2458, 177, 0, 2459, 0, 2461, 0, 0, 177,
+	1518, 0, 2463, 0, 177, 177, 531, 826, 177, 0,
+	177, 0, 2472, 0, 2467, 0, 177, 0, 0, 0,
+	1109, 0, 0, 177, 1288, 1288, 0, 1288, 544, 1288,
+	1288, 0, 1297, 1288, 1288, 1288, 1288, 1288, 0, 0,
+	0, 0, 0, 0, 0, 1109, 1109, 826, 0, 0,
+	0, 177, 532, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 586, 0, 2212, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1358, 0,
+	2217, 1510, 1499, 1500, 1501, 1502, 1512, 1503, 1504, 1505,
+	1517, 1513, 1506, 1507, 1514, 1515, 1516, 1508, 1509, 1511,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 0, 2243, 486, 0, 0, 526, 0, 0, 0,
+	0, 0, 0, 486, 0, 0, 0, 0, 0, 0,
+	0, 486, 0, 0, 0, 0, 676, 676, 676, 0,
+	0, 0, 0, 642, 0, 0, 0, 0, 0, 2256,
+	0, 2257, 0, 0, 0, 0, 2260, 2261, 0, 661,
+	0, 661, 172, 0, 0, 0, 0, 177, 0, 486,
+	0, 0, 0, 0, 0, 177, 0, 0, 0, 0,
+	0, 0, 2286, 532, 0, 0, 114, 0, 0, 0,
+	0, 0, 0, 2294, 0, 0, 2296, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 177, 0,
+	0, 0, 0, 0, 0, 0, 1481, 0, 0, 0,
+	0, 0, 0, 1109, 0, 0, 0, 0, 0, 0,
+	0, 1495, 1496, 0, 0, 676, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 153, 0, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 0,
+	0, 2336, 544, 0, 0, 0, 0, 0, 0, 532,
+	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
+	0, 177, 0, 0, 0, 0, 0, 532, 0, 1557,
+	0, 0, 2356, 532, 0, 0, 0, 0, 0, 0,
+	0, 0, 532, 0, 0, 0, 1165, 0, 0, 676,
+	0, 0, 0, 0, 0, 0, 0, 676, 0, 0,
+	676, 0, 0, 0, 157, 177, 177, 177, 177, 177,
+	0, 826, 0, 162, 0, 0, 0, 0, 0, 0,
+	0, 2379, 177, 177, 0, 0, 0, 0, 0, 0,
+	0, 2401, 0, 0, 0, 0, 0, 0, 177, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 833, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 532, 0,
+	0, 0, 1492, 0, 0, 0, 1493, 0, 0, 0,
+	826, 0, 532, 0, 0, 172, 833, 0, 0, 0,
+	0, 0, 177, 0, 0, 0, 532, 0, 0, 0,
+	0, 1141, 1533, 0, 0, 532, 0, 0, 0, 114,
+	149, 136, 532, 532, 0, 177, 177, 177, 177, 177,
+	2464, 0, 156, 0, 0, 0, 0, 177, 826, 0,
+	0, 1553, 177, 177, 0, 177, 0, 0, 177, 177,
+	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 146, 0, 0, 0, 0, 135, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 153, 0,
+	154, 0, 0, 0, 0, 0, 123, 124, 145, 144,
+	171, 0, 0, 0, 0, 177, 0, 0, 0, 0,
+	0, 0, 532, 0, 0, 486, 0, 486, 0, 532,
+	486, 0, 0, 0, 177, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
+	0, 1732, 0, 0, 0, 0, 0, 0, 177, 0,
+	0, 177, 0, 0, 0, 0, 140, 121, 147, 128,
+	120, 0, 141, 142, 0, 0, 0, 157, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 129, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 132, 130, 125, 126, 127, 131, 0, 0, 0,
+	177, 122, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 0, 150, 155, 152, 158, 159, 160, 161, 163,
+	164, 165, 166, 0, 0, 0, 0, 0, 167, 168,
+	169, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1110, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 676, 0, 177, 486, 0, 81, 0,
+	0, 0, 0, 0, 605, 612, 613, 614, 615, 606,
+	608, 0, 1793, 642, 607, 0, 0, 610, 616, 617,
+	0, 0, 0, 149, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 486, 0, 177,
+	0, 0, 177, 177, 177, 532, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2071, 2072, 0, 0, 532, 532, 532, 532, 0, 0,
+	0, 0, 618, 620, 619, 621, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
+	0, 0, 0, 532, 532, 532, 177, 0, 1897, 0,
+	137, 0, 0, 138, 0, 1752, 0, 0, 0, 1756,
+	0, 1757, 1758, 0, 0, 0, 1557, 0, 0, 532,
+	1766, 532, 1914, 1767, 0, 0, 0, 0, 485, 0,
+	0, 1919, 0, 0, 0, 0, 0, 0, 534, 0,
+	0, 0, 0, 0, 0, 0, 625, 0, 1771, 532,
+	0, 0, 0, 0, 0, 1776, 1777, 1778, 1779, 1780,
+	0, 1553, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1791, 0, 0, 0, 0, 0, 0, 0,
+	532, 0, 0, 0, 830, 0, 486, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 155, 152, 158, 159,
+	160, 161, 163, 164, 165, 166, 0, 0, 0, 0,
+	0, 167, 168, 169, 170, 0, 0, 676, 532, 0,
+	0, 1110, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1288, 0, 596, 0, 0, 0, 597, 598, 0,
+	0, 0, 599, 532, 177, 2006, 1110, 1110, 0, 0,
+	0, 0, 486, 532, 676, 0, 0, 0, 1109, 0,
+	0, 2031, 1288, 1109, 0, 0, 0, 0, 1334, 0,
+	532, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	532, 486, 0, 0, 0, 0, 532, 532, 0, 0,
+	0, 0, 0, 0, 0, 0, 1379, 0, 0, 0,
+	0, 0, 0, 532, 0, 0, 0, 0, 0, 0,
+	0, 0, 486, 0, 0, 0, 0, 0, 0, 486,
+	0, 0, 0, 0, 0, 0, 0, 0, 1402, 1403,
+	486, 486, 486, 486, 486, 486, 486, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 826, 0, 0, 1109, 0, 0, 0, 1557, 0,
+	0, 0, 0, 0, 0, 0, 0, 486, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	611, 73, 0, 0, 0, 0, 0, 0, 532, 0,
+	0, 1989, 1990, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 661, 0, 0, 0, 0, 0, 0, 661, 661,
+	0, 0, 0, 0, 1110, 0, 0, 0, 2030, 0,
+	0, 0, 0, 0, 0, 661, 1379, 661, 661, 661,
+	661, 661, 632, 0, 73, 2048, 2049, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1334, 632, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 661, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 642, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 486, 0, 0, 0, 0, 0,
+	1379, 0, 486, 0, 486, 0, 486, 1589, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1557, 0, 0, 0, 0, 0,
+	937, 0, 942, 0, 0, 944, 0, 0, 0, 0,
+	0, 0, 0, 2249, 2250, 2251, 2252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2135, 0, 0, 0, 2138, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2147, 2148, 2150,
+	2152, 0, 2264, 2264, 2264, 0, 0, 2158, 0, 0,
+	2159, 0, 0, 172, 0, 2163, 0, 0, 0, 0,
+	0, 0, 0, 1109, 1907, 0, 0, 0, 2290, 0,
+	2292, 0, 0, 0, 0, 0, 0, 114, 0, 136,
+	0, 0, 0, 0, 0, 0, 0, 2184, 2185, 0,
+	156, 2189, 0, 0, 0, 0, 0, 0, 1557, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 146, 0, 0, 0, 0, 135, 0, 0, 676,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 154, 0,
+	0, 0, 486, 0, 1265, 1266, 145, 144, 171, 486,
+	0, 0, 0, 0, 486, 486, 0, 2238, 486, 0,
+	1724, 0, 1172, 0, 0, 0, 486, 1557, 0, 0,
+	0, 0, 0, 486, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1557, 0, 0, 0, 0, 0, 0, 0,
+	0, 486, 2371, 0, 140, 1267, 147, 0, 1264, 2263,
+	141, 142, 0, 0, 0, 157, 0, 1109, 0, 2376,
+	0, 0, 0, 0, 162, 0, 0, 0, 0, 1557,
+	0, 0, 0, 0, 0, 676, 676, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1557, 0, 0, 0, 0, 0, 0, 0,
+	0, 2304, 0, 0, 0, 0, 0, 0, 661, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 661, 661, 2325, 2326, 2327, 0, 2328,
+	2329, 2331, 0, 0, 1379, 2332, 2333, 486, 0, 0,
+	0, 1190, 0, 0, 0, 1334, 996, 996, 996, 0,
+	0, 149, 0, 0, 0, 0, 0, 1557, 0, 0,
+	0, 0, 0, 0, 0, 0, 73, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 486, 486, 0,
+	0, 2367, 0, 0, 632, 1068, 1069, 1070, 0, 1073,
+	0, 1075, 1076, 1077, 1078, 0, 1081, 1083, 1083, 0,
+	1083, 1087, 1087, 1089, 1090, 1091, 1092, 1319, 1094, 1095,
+	1096, 1097, 1098, 0, 0, 0, 143, 1087, 1087, 1087,
+	1087, 0, 0, 0, 0, 0, 0, 0, 137, 0,
+	0, 138, 0, 0, 0, 0, 1360, 0, 0, 0,
+	0, 0, 0, 0, 486, 0, 1119, 0, 0, 632,
+	0, 1906, 0, 632, 0, 0, 0, 0, 0, 632,
+	2428, 2429, 0, 0, 0, 0, 0, 1389, 0, 0,
+	0, 0, 0, 0, 1393, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1404, 1405, 1406, 1407, 1408,
+	1409, 1410, 0, 0, 0, 486, 486, 486, 486, 486,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 486, 486, 0, 0, 0, 0, 0, 0,
+	0, 1212, 1429, 0, 0, 0, 0, 0, 486, 0,
+	0, 0, 0, 150, 155, 152, 158, 159, 160, 161,
+	163, 164, 165, 166, 661, 0, 0, 0, 0, 167,
+	168, 169, 170, 0, 0, 0, 0, 0, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 661, 0, 1261,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 114, 0, 136, 0, 0, 0, 0, 0,
+	0, 0, 486, 0, 0, 156, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1110,
+	0, 0, 0, 0, 1110, 486, 486, 486, 486, 486,
+	0, 0, 0, 0, 0, 0, 146, 2047, 0, 0,
+	0, 135, 486, 1334, 0, 486, 0, 0, 486, 2056,
+	1379, 0, 0, 0, 0, 0, 0, 1200, 0, 0,
+	0, 153, 0, 154, 0, 0, 0, 0, 0, 1265,
+	1266, 145, 144, 171, 0, 0, 0, 0, 486, 1570,
+	0, 0, 0, 0, 0, 0, 0, 1574, 0, 1577,
+	0, 0, 1429, 0, 0, 0, 0, 0, 0, 0,
+	1213, 0, 0, 0, 0, 486, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1110, 0, 0, 0, 0,
+	0, 0, 0, 0, 486, 0, 0, 0, 0, 140,
+	1267, 147, 0, 1264, 486, 141, 142, 0, 0, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 486, 162,
+	0, 486, 1226, 1229, 1230, 1231, 1232, 1233, 1234, 0,
+	1235, 1236, 1237, 1238, 1239, 1214, 1215, 1216, 1217, 1198,
+	1199, 1227, 0, 1201, 0, 1202, 1203, 1204, 1205, 1206,
+	1207, 1208, 1209, 1210, 1211, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 0, 0, 0, 0, 0, 0, 0,
+	486, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 996, 996, 996, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 150, 155, 152, 158, 159, 160, 161,
-	163, 164, 165, 166, 0, 0, 0, 0, 0, 167,
-	168, 169, 170, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 531, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 177,
-	1471, 0, 0, 0, 0, 531, 0, 1105, 0, 0,
-	0, 531, 0, 0, 0, 1485, 1486, 0, 0, 673,
-	531, 0, 0, 0, 0, 0, 0, 75, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 0, 0, 177, 177, 177, 177, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1547, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1157, 0, 0, 673,
-	0, 0, 0, 0, 0, 0, 0, 673, 0, 0,
-	673, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 823, 0, 0, 531, 0, 0, 0, 0, 0,
-	0, 0, 0, 1204, 0, 0, 0, 0, 531, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 531, 0, 0, 0, 0, 0, 0, 0,
-	0, 531, 0, 0, 0, 0, 0, 0, 531, 531,
-	0, 177, 177, 177, 177, 177, 830, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 0, 177, 177,
-	0, 177, 0, 0, 177, 177, 177, 0, 0, 0,
-	823, 0, 0, 0, 0, 0, 830, 0, 0, 0,
-	0, 0, 0, 0, 0, 609, 73, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1482, 0, 0, 0, 1483, 0, 0, 823, 177,
-	0, 0, 0, 0, 0, 0, 531, 0, 0, 1192,
-	0, 0, 0, 531, 0, 0, 0, 1114, 177, 0,
-	1137, 1523, 0, 0, 0, 0, 0, 485, 177, 485,
-	0, 0, 485, 0, 0, 0, 0, 630, 0, 73,
-	0, 0, 177, 0, 0, 177, 0, 0, 0, 0,
-	1543, 0, 1205, 0, 0, 0, 0, 630, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 484, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 533, 0,
-	0, 0, 0, 0, 0, 0, 623, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 1720, 0, 0, 1218, 1221, 1222, 1223, 1224, 1225,
-	1226, 0, 1227, 1228, 1229, 1230, 1231, 1206, 1207, 1208,
-	1209, 1190, 1191, 1219, 827, 1193, 0, 1194, 1195, 1196,
-	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1210, 1211, 1212,
-	1213, 1214, 1215, 1216, 1217, 0, 0, 177, 0, 0,
-	0, 0, 0, 1106, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 485, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 640, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 177, 177, 177, 531, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 485, 0, 0,
-	0, 673, 0, 0, 0, 0, 531, 531, 531, 531,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1779, 1220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 81, 0, 531, 531, 531, 177, 603, 610, 611,
-	612, 613, 604, 606, 0, 0, 0, 605, 0, 0,
-	608, 614, 615, 0, 0, 0, 0, 0, 0, 531,
-	0, 531, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
-	0, 0, 0, 2053, 2054, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1881, 616, 618, 617, 619, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	531, 0, 1547, 0, 0, 0, 0, 0, 1898, 0,
-	0, 0, 0, 0, 0, 0, 0, 1903, 0, 0,
-	0, 0, 0, 0, 0, 0, 485, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1738, 0,
-	0, 0, 1742, 0, 1743, 1744, 0, 0, 531, 0,
-	0, 0, 0, 1752, 0, 0, 1753, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1106, 0, 531, 177, 0, 0, 0, 0, 0,
-	0, 1757, 0, 531, 0, 0, 0, 0, 1762, 1763,
-	1764, 1765, 1766, 0, 1543, 0, 1106, 1106, 0, 0,
-	531, 0, 485, 0, 0, 1777, 0, 0, 0, 0,
-	531, 673, 0, 0, 0, 0, 531, 531, 1326, 0,
-	0, 0, 0, 0, 0, 1280, 0, 0, 0, 0,
-	0, 485, 0, 531, 0, 0, 0, 0, 0, 1988,
-	993, 993, 993, 0, 0, 0, 1371, 0, 673, 0,
-	0, 0, 1105, 0, 0, 2013, 1280, 1105, 0, 934,
-	73, 939, 485, 0, 941, 0, 594, 0, 0, 485,
-	595, 596, 0, 0, 0, 597, 0, 0, 1394, 1395,
-	485, 485, 485, 485, 485, 485, 485, 630, 1064, 1065,
-	1066, 0, 1069, 0, 1071, 1072, 1073, 1074, 0, 1077,
-	1079, 1079, 0, 1079, 1083, 1083, 1085, 1086, 1087, 1088,
-	0, 1090, 1091, 1092, 1093, 1094, 531, 485, 0, 0,
-	1083, 1083, 1083, 1083, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 823, 0, 0, 1105, 0, 0, 1115,
-	1547, 0, 630, 0, 0, 0, 630, 0, 0, 0,
-	0, 0, 630, 0, 0, 0, 0, 0, 0, 0,
-	658, 0, 0, 0, 0, 0, 0, 658, 658, 0,
-	0, 0, 0, 1106, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 658, 1371, 658, 658, 658, 658,
-	658, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1326, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1971, 1972, 658, 0, 0, 0, 0, 0, 0, 1164,
-	0, 0, 0, 0, 0, 0, 640, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 485,
-	0, 0, 0, 0, 0, 1371, 0, 485, 0, 485,
-	0, 485, 1577, 0, 0, 0, 0, 2012, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2030, 2031, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1547, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2229, 2230, 2231, 2232, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2244, 2244, 2244, 0, 0, 0, 0, 0, 1182, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1105, 0, 0, 0, 0, 2270, 0, 2272, 0,
-	0, 2118, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2127, 2128, 2130, 2132, 0, 0, 0, 0,
-	0, 0, 2138, 0, 0, 2139, 1547, 0, 0, 0,
-	2143, 0, 0, 0, 0, 0, 0, 485, 0, 0,
-	0, 0, 0, 0, 485, 0, 0, 0, 0, 485,
-	485, 0, 0, 485, 1311, 1712, 0, 673, 0, 0,
-	0, 485, 2164, 2165, 0, 0, 2169, 0, 485, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1352, 2181, 0, 0, 993, 993, 993,
-	0, 0, 0, 0, 0, 0, 485, 0, 0, 0,
-	0, 0, 0, 0, 0, 1547, 0, 0, 0, 0,
-	0, 0, 0, 0, 1381, 0, 0, 0, 0, 0,
-	0, 1385, 0, 0, 0, 0, 0, 0, 0, 0,
-	1547, 0, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 0,
-	2351, 0, 2218, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1105, 0, 2356, 0, 0,
-	0, 658, 0, 0, 0, 0, 0, 1547, 0, 1420,
-	0, 0, 0, 673, 673, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1547, 0, 2243, 0, 0, 0, 658, 658, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1371, 0, 0,
-	485, 0, 0, 0, 0, 0, 0, 0, 1326, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2284, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 0, 485, 485,
-	0, 0, 0, 0, 0, 1891, 0, 0, 0, 0,
-	0, 1572, 0, 1547, 0, 2295, 0, 0, 114, 0,
-	136, 0, 0, 0, 0, 0, 0, 0, 2305, 2306,
-	2307, 156, 2308, 2309, 2311, 0, 0, 0, 2312, 2313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1558, 0, 0, 0, 0, 0, 0, 0, 1562,
-	0, 1565, 146, 0, 1420, 485, 0, 135, 0, 0,
-	0, 0, 1890, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 153, 0, 154,
-	0, 0, 0, 0, 2347, 1257, 1258, 145, 144, 171,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 485, 485, 485, 485,
-	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 485, 485, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 485,
-	0, 0, 0, 0, 0, 140, 1259, 147, 0, 1256,
-	0, 141, 142, 658, 0, 0, 157, 0, 0, 0,
-	0, 2406, 2407, 0, 0, 162, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 658, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1106, 0,
-	0, 0, 0, 1106, 485, 485, 485, 485, 485, 0,
-	0, 0, 0, 0, 0, 0, 2029, 0, 0, 1420,
-	0, 485, 1326, 0, 485, 0, 1695, 485, 2038, 1371,
-	0, 1705, 1706, 0, 0, 1710, 0, 0, 0, 0,
-	0, 0, 0, 1713, 0, 0, 0, 0, 0, 0,
-	1716, 0, 149, 0, 0, 485, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1719, 0,
-	0, 0, 485, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1106, 0, 0, 0, 0, 0, 0, 0,
-	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 485, 0, 143, 485, 1760,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 137,
-	0, 1253, 138, 0, 0, 0, 1775, 0, 0, 0,
-	0, 0, 0, 0, 114, 0, 136, 0, 0, 0,
-	0, 630, 0, 0, 0, 0, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 485, 0, 0, 0, 1805,
-	1806, 0, 0, 0, 0, 0, 0, 0, 1825, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 153, 0, 154, 0, 0, 0, 0,
-	485, 1257, 1258, 145, 144, 171, 0, 0, 0, 0,
-	0, 1826, 0, 150, 155, 152, 158, 159, 160, 161,
-	163, 164, 165, 166, 0, 0, 0, 0, 0, 167,
-	168, 169, 170, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 485, 0, 0, 485, 485, 485,
-	0, 0, 0, 0, 1904, 0, 0, 0, 0, 0,
-	0, 140, 1259, 147, 0, 1256, 0, 141, 142, 0,
-	0, 0, 157, 0, 0, 0, 0, 1884, 0, 0,
-	0, 162, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1326,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1106, 1921, 1922,
-	1923, 1924, 1925, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1420, 1931, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1939, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1572, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 2010,
-	0, 73, 0, 0, 1572, 1572, 1572, 1572, 1572, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1825, 0, 0, 1572, 0, 0, 1572, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2046, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 0, 0, 0, 485, 0, 0,
-	0, 0, 0, 0, 0, 137, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1106, 0, 2094, 0, 0, 0, 2061, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2080, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2117, 0, 0, 0, 0, 0,
-	0, 0, 0, 2092, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2096, 0, 0, 0, 0, 0, 0,
-	0, 2140, 2141, 2142, 0, 0, 0, 2107, 0, 0,
-	2110, 0, 0, 0, 0, 0, 0, 0, 0, 150,
-	155, 152, 158, 159, 160, 161, 163, 164, 165, 166,
-	0, 0, 0, 0, 0, 167, 168, 169, 170, 0,
+	0, 0, 0, 0, 72, 36, 37, 74, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2144, 0, 0,
-	1572, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 78, 486, 149, 0, 40, 66,
+	67, 0, 64, 68, 0, 0, 0, 0, 0, 0,
+	0, 65, 0, 0, 0, 0, 0, 1429, 0, 1228,
+	0, 0, 0, 0, 1707, 0, 0, 0, 0, 1717,
+	1718, 1473, 0, 1722, 0, 0, 0, 0, 0, 486,
+	53, 1725, 486, 486, 486, 0, 0, 0, 1728, 0,
+	0, 81, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 143, 0, 0, 0, 0, 1731, 0, 0, 0,
+	0, 0, 0, 137, 0, 0, 138, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1334, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1110, 0, 0, 0, 0, 0,
+	0, 0, 0, 43, 46, 49, 48, 51, 0, 63,
+	0, 0, 69, 0, 1584, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 52, 77, 76, 0, 0, 61,
+	62, 50, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 155,
+	152, 158, 159, 160, 161, 163, 164, 165, 166, 0,
+	0, 0, 0, 0, 167, 168, 169, 170, 0, 0,
+	0, 54, 55, 0, 56, 57, 58, 59, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1840, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 486, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2211, 0, 0, 2212,
-	2213, 2214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1110, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2010,
-	0, 73, 0, 2010, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1900,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 75, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 801, 787, 409, 0,
-	735, 804, 705, 723, 814, 726, 729, 769, 684, 748,
-	332, 720, 0, 709, 680, 715, 681, 707, 737, 236,
-	704, 789, 752, 803, 288, 233, 686, 710, 346, 725,
-	187, 771, 385, 221, 298, 295, 416, 247, 239, 235,
-	220, 272, 304, 344, 403, 338, 810, 292, 758, 0,
-	394, 317, 0, 0, 2010, 739, 793, 746, 783, 734,
-	770, 694, 757, 805, 721, 766, 806, 278, 219, 186,
-	329, 395, 251, 0, 0, 0, 2341, 178, 179, 180,
-	0, 2386, 73, 2387, 0, 0, 0, 0, 0, 210,
-	0, 217, 717, 763, 800, 718, 765, 231, 276, 238,
-	230, 413, 811, 792, 0, 0, 202, 802, 741, 0,
-	768, 0, 817, 679, 760, 0, 682, 685, 813, 796,
-	713, 241, 0, 0, 0, 0, 73, 0, 0, 738,
-	747, 780, 732, 0, 0, 0, 0, 0, 0, 2346,
-	711, 0, 756, 0, 0, 0, 690, 683, 0, 0,
-	0, 0, 736, 0, 0, 0, 693, 0, 712, 781,
-	0, 677, 259, 687, 318, 0, 785, 795, 733, 445,
-	799, 731, 730, 775, 691, 791, 724, 287, 689, 284,
-	182, 198, 0, 722, 328, 368, 374, 790, 708, 716,
-	222, 714, 372, 342, 430, 206, 249, 365, 347, 370,
-	755, 773, 371, 293, 418, 360, 428, 446, 447, 229,
-	322, 436, 407, 442, 458, 199, 226, 336, 400, 433,
-	391, 315, 414, 415, 283, 390, 257, 185, 291, 452,
-	197, 380, 214, 204, 190, 402, 426, 211, 383, 0,
-	0, 460, 192, 424, 399, 311, 280, 281, 191, 0,
-	364, 234, 255, 224, 331, 421, 422, 223, 461, 201,
-	441, 194, 995, 440, 324, 417, 425, 312, 303, 193,
-	423, 310, 302, 286, 245, 266, 358, 296, 359, 267,
-	320, 319, 321, 0, 188, 0, 396, 434, 462, 207,
-	208, 209, 703, 244, 248, 254, 256, 262, 263, 270,
-	289, 335, 357, 355, 361, 786, 412, 429, 437, 444,
-	450, 451, 453, 454, 455, 456, 457, 323, 269, 392,
-	285, 294, 778, 816, 341, 373, 212, 432, 393, 698,
-	702, 696, 697, 750, 751, 699, 807, 808, 809, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 0, 782, 692,
-	0, 700, 701, 0, 788, 797, 798, 754, 181, 195,
-	290, 812, 362, 252, 459, 439, 435, 678, 695, 228,
-	706, 0, 0, 719, 727, 728, 740, 742, 743, 744,
-	745, 314, 761, 762, 764, 772, 774, 777, 779, 784,
-	794, 815, 183, 184, 196, 205, 215, 227, 242, 250,
-	260, 265, 268, 273, 274, 277, 282, 300, 305, 306,
-	307, 308, 325, 326, 327, 330, 333, 334, 337, 339,
-	340, 343, 350, 351, 352, 353, 354, 356, 363, 367,
-	375, 376, 377, 378, 379, 381, 382, 386, 387, 388,
-	389, 397, 401, 419, 420, 431, 443, 448, 261, 427,
-	449, 0, 299, 753, 759, 301, 246, 264, 275, 767,
-	438, 398, 200, 369, 253, 189, 218, 203, 225, 240,
-	243, 279, 309, 316, 345, 349, 258, 237, 216, 366,
-	213, 384, 404, 405, 406, 408, 313, 232, 348, 749,
-	776, 297, 410, 411, 271, 801, 787, 409, 0, 735,
-	804, 705, 723, 814, 726, 729, 769, 684, 748, 332,
-	720, 0, 709, 680, 715, 681, 707, 737, 236, 704,
-	789, 752, 803, 288, 233, 686, 710, 346, 725, 187,
-	771, 385, 221, 298, 295, 416, 247, 239, 235, 220,
-	272, 304, 344, 403, 338, 810, 292, 758, 0, 394,
-	317, 0, 0, 0, 739, 793, 746, 783, 734, 770,
-	694, 757, 805, 721, 766, 806, 278, 219, 186, 329,
-	395, 251, 0, 0, 0, 0, 178, 179, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	217, 717, 763, 800, 718, 765, 231, 276, 238, 230,
-	413, 811, 792, 0, 0, 202, 802, 741, 0, 768,
-	0, 817, 679, 760, 0, 682, 685, 813, 796, 713,
-	241, 0, 0, 0, 0, 0, 0, 0, 738, 747,
-	780, 732, 0, 0, 0, 0, 0, 2039, 0, 711,
-	0, 756, 0, 0, 0, 690, 683, 0, 0, 0,
-	0, 736, 0, 0, 0, 693, 0, 712, 781, 0,
-	677, 259, 687, 318, 0, 785, 795, 733, 445, 799,
-	731, 730, 775, 691, 791, 724, 287, 689, 284, 182,
-	198, 0, 722, 328, 368, 374, 790, 708, 716, 222,
-	714, 372, 342, 430, 206, 249, 365, 347, 370, 755,
-	773, 371, 293, 418, 360, 428, 446, 447, 229, 322,
-	436, 407, 442, 458, 199, 226, 336, 400, 433, 391,
-	315, 414, 415, 283, 390, 257, 185, 291, 452, 197,
-	380, 214, 204, 190, 402, 426, 211, 383, 0, 0,
-	460, 192, 424, 399, 311, 280, 281, 191, 0, 364,
-	234, 255, 224, 331, 421, 422, 223, 461, 201, 441,
-	194, 995, 440, 324, 417, 425, 312, 303, 193, 423,
-	310, 302, 286, 245, 266, 358, 296, 359, 267, 320,
-	319, 321, 0, 188, 0, 396, 434, 462, 207, 208,
-	209, 703, 244, 248, 254, 256, 262, 263, 270, 289,
-	335, 357, 355, 361, 786, 412, 429, 437, 444, 450,
-	451, 453, 454, 455, 456, 457, 323, 269, 392, 285,
-	294, 778, 816, 341, 373, 212, 432, 393, 698, 702,
-	696, 697, 750, 751, 699, 807, 808, 809, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 0, 782, 692, 0,
-	700, 701, 0, 788, 797, 798, 754, 181, 195, 290,
-	812, 362, 252, 459, 439, 435, 678, 695, 228, 706,
-	0, 0, 719, 727, 728, 740, 742, 743, 744, 745,
-	314, 761, 762, 764, 772, 774, 777, 779, 784, 794,
-	815, 183, 184, 196, 205, 215, 227, 242, 250, 260,
-	265, 268, 273, 274, 277, 282, 300, 305, 306, 307,
-	308, 325, 326, 327, 330, 333, 334, 337, 339, 340,
-	343, 350, 351, 352, 353, 354, 356, 363, 367, 375,
-	376, 377, 378, 379, 381, 382, 386, 387, 388, 389,
-	397, 401, 419, 420, 431, 443, 448, 261, 427, 449,
-	0, 299, 753, 759, 301, 246, 264, 275, 767, 438,
-	398, 200, 369, 253, 189, 218, 203, 225, 240, 243,
-	279, 309, 316, 345, 349, 258, 237, 216, 366, 213,
-	384, 404, 405, 406, 408, 313, 232, 348, 749, 776,
-	297, 410, 411, 271, 801, 787, 409, 0, 735, 804,
-	705, 723, 814, 726, 729, 769, 684, 748, 332, 720,
-	0, 709, 680, 715, 681, 707, 737, 236, 704, 789,
-	752, 803, 288, 233, 686, 710, 346, 725, 187, 771,
-	385, 221, 298, 295, 416, 247, 239, 235, 220, 272,
-	304, 344, 403, 338, 810, 292, 758, 0, 394, 317,
-	0, 0, 0, 739, 793, 746, 783, 734, 770, 694,
-	757, 805, 721, 766, 806, 278, 219, 186, 329, 395,
-	251, 0, 0, 0, 0, 178, 179, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 210, 0, 217,
-	717, 763, 800, 718, 765, 231, 276, 238, 230, 413,
-	811, 792, 0, 0, 202, 802, 741, 0, 768, 0,
-	817, 679, 760, 0, 682, 685, 813, 796, 713, 241,
-	0, 0, 0, 0, 0, 0, 0, 738, 747, 780,
-	732, 0, 0, 0, 0, 0, 1999, 0, 711, 0,
-	756, 0, 0, 0, 690, 683, 0, 0, 0, 0,
-	736, 0, 0, 0, 693, 0, 712, 781, 0, 677,
-	259, 687, 318, 0, 785, 795, 733, 445, 799, 731,
-	730, 775, 691, 791, 724, 287, 689, 284, 182, 198,
-	0, 722, 328, 368, 374, 790, 708, 716, 222, 714,
-	372, 342, 430, 206, 249, 365, 347, 370, 755, 773,
-	371, 293, 418, 360, 428, 446, 447, 229, 322, 436,
-	407, 442, 458, 199, 226, 336, 400, 433, 391, 315,
-	414, 415, 283, 390, 257, 185, 291, 452, 197, 380,
-	214, 204, 190, 402, 426, 211, 383, 0, 0, 460,
-	192, 424, 399, 311, 280, 281, 191, 0, 364, 234,
-	255, 224, 331, 421, 422, 223, 461, 201, 441, 194,
-	995, 440, 324, 417, 425, 312, 303, 193, 423, 310,
-	302, 286, 245, 266, 358, 296, 359, 267, 320, 319,
-	321, 0, 188, 0, 396, 434, 462, 207, 208, 209,
-	703, 244, 248, 254, 256, 262, 263, 270, 289, 335,
-	357, 355, 361, 786, 412, 429, 437, 444, 450, 451,
-	453, 454, 455, 456, 457, 323, 269, 392, 285, 294,
-	778, 816, 341, 373, 212, 432, 393, 698, 702, 696,
-	697, 750, 751, 699, 807, 808, 809, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 0, 782, 692, 0, 700,
-	701, 0, 788, 797, 798, 754, 181, 195, 290, 812,
-	362, 252, 459, 439, 435, 678, 695, 228, 706, 0,
-	0, 719, 727, 728, 740, 742, 743, 744, 745, 314,
-	761, 762, 764, 772, 774, 777, 779, 784, 794, 815,
-	183, 184, 196, 205, 215, 227, 242, 250, 260, 265,
-	268, 273, 274, 277, 282, 300, 305, 306, 307, 308,
-	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
-	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
-	377, 378, 379, 381, 382, 386, 387, 388, 389, 397,
-	401, 419, 420, 431, 443, 448, 261, 427, 449, 0,
-	299, 753, 759, 301, 246, 264, 275, 767, 438, 398,
-	200, 369, 253, 189, 218, 203, 225, 240, 243, 279,
-	309, 316, 345, 349, 258, 237, 216, 366, 213, 384,
-	404, 405, 406, 408, 313, 232, 348, 749, 776, 297,
-	410, 411, 271, 801, 787, 409, 0, 735, 804, 705,
-	723, 814, 726, 729, 769, 684, 748, 332, 720, 0,
-	709, 680, 715, 681, 707, 737, 236, 704, 789, 752,
-	803, 288, 233, 686, 710, 346, 725, 187, 771, 385,
-	221, 298, 295, 416, 247, 239, 235, 220, 272, 304,
-	344, 403, 338, 810, 292, 758, 0, 394, 317, 0,
-	0, 0, 739, 793, 746, 783, 734, 770, 694, 757,
-	805, 721, 766, 806, 278, 219, 186, 329, 395, 251,
-	0, 0, 0, 0, 178, 179, 180, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 217, 717,
-	763, 800, 718, 765, 231, 276, 238, 230, 413, 811,
-	792, 0, 0, 202, 802, 741, 0, 768, 0, 817,
-	679, 760, 0, 682, 685, 813, 796, 713, 241, 0,
-	0, 0, 0, 0, 0, 0, 738, 747, 780, 732,
-	0, 0, 0, 0, 0, 1560, 0, 711, 0, 756,
-	0, 0, 0, 690, 683, 0, 0, 0, 0, 736,
-	0, 0, 0, 693, 0, 712, 781, 0, 677, 259,
-	687, 318, 0, 785, 795, 733, 445, 799, 731, 730,
-	775, 691, 791, 724, 287, 689, 284, 182, 198, 0,
-	722, 328, 368, 374, 790, 708, 716, 222, 714, 372,
-	342, 430, 206, 249, 365, 347, 370, 755, 773, 371,
-	293, 418, 360, 428, 446, 447, 229, 322, 436, 407,
-	442, 458, 199, 226, 336, 400, 433, 391, 315, 414,
-	415, 283, 390, 257, 185, 291, 452, 197, 380, 214,
-	204, 190, 402, 426, 211, 383, 0, 0, 460, 192,
-	424, 399, 311, 280, 281, 191, 0, 364, 234, 255,
-	224, 331, 421, 422, 223, 461, 201, 441, 194, 995,
-	440, 324, 417, 425, 312, 303, 193, 423, 310, 302,
-	286, 245, 266, 358, 296, 359, 267, 320, 319, 321,
-	0, 188, 0, 396, 434, 462, 207, 208, 209, 703,
-	244, 248, 254, 256, 262, 263, 270, 289, 335, 357,
-	355, 361, 786, 412, 429, 437, 444, 450, 451, 453,
-	454, 455, 456, 457, 323, 269, 392, 285, 294, 778,
-	816, 341, 373, 212, 432, 393, 698, 702, 696, 697,
-	750, 751, 699, 807, 808, 809, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 0, 782, 692, 0, 700, 701,
-	0, 788, 797, 798, 754, 181, 195, 290, 812, 362,
-	252, 459, 439, 435, 678, 695, 228, 706, 0, 0,
-	719, 727, 728, 740, 742, 743, 744, 745, 314, 761,
-	762, 764, 772, 774, 777, 779, 784, 794, 815, 183,
-	184, 196, 205, 215, 227, 242, 250, 260, 265, 268,
-	273, 274, 277, 282, 300, 305, 306, 307, 308, 325,
-	326, 327, 330, 333, 334, 337, 339, 340, 343, 350,
-	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
-	378, 379, 381, 382, 386, 387, 388, 389, 397, 401,
-	419, 420, 431, 443, 448, 261, 427, 449, 0, 299,
-	753, 759, 301, 246, 264, 275, 767, 438, 398, 200,
-	369, 253, 189, 218, 203, 225, 240, 243, 279, 309,
-	316, 345, 349, 258, 237, 216, 366, 213, 384, 404,
-	405, 406, 408, 313, 232, 348, 749, 776, 297, 410,
-	411, 271, 801, 787, 409, 0, 735, 804, 705, 723,
-	814, 726, 729, 769, 684, 748, 332, 720, 0, 709,
-	680, 715, 681, 707, 737, 236, 704, 789, 752, 803,
-	288, 233, 686, 710, 346, 725, 187, 771, 385, 221,
-	298, 295, 416, 247, 239, 235, 220, 272, 304, 344,
-	403, 338, 810, 292, 758, 0, 394, 317, 0, 0,
-	0, 739, 793, 746, 783, 734, 770, 694, 757, 805,
-	721, 766, 806, 278, 219, 186, 329, 395, 251, 0,
-	81, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 210, 0, 217, 717, 763,
-	800, 718, 765, 231, 276, 238, 230, 413, 811, 792,
-	0, 0, 202, 802, 741, 0, 768, 0, 817, 679,
-	760, 0, 682, 685, 813, 796, 713, 241, 0, 0,
-	0, 0, 0, 0, 0, 738, 747, 780, 732, 0,
-	0, 0, 0, 0, 0, 0, 711, 0, 756, 0,
-	0, 0, 690, 683, 0, 0, 0, 0, 736, 0,
-	0, 0, 693, 0, 712, 781, 0, 677, 259, 687,
-	318, 0, 785, 795, 733, 445, 799, 731, 730, 775,
-	691, 791, 724, 287, 689, 284, 182, 198, 0, 722,
-	328, 368, 374, 790, 708, 716, 222, 714, 372, 342,
-	430, 206, 249, 365, 347, 370, 755, 773, 371, 293,
-	418, 360, 428, 446, 447, 229, 322, 436, 407, 442,
-	458, 199, 226, 336, 400, 433, 391, 315, 414, 415,
-	283, 390, 257, 185, 291, 452, 197, 380, 214, 204,
-	190, 402, 426, 211, 383, 0, 0, 460, 192, 424,
-	399, 311, 280, 281, 191, 0, 364, 234, 255, 224,
-	331, 421, 422, 223, 461, 201, 441, 194, 995, 440,
-	324, 417, 425, 312, 303, 193, 423, 310, 302, 286,
-	245, 266, 358, 296, 359, 267, 320, 319, 321, 0,
-	188, 0, 396, 434, 462, 207, 208, 209, 703, 244,
-	248, 254, 256, 262, 263, 270, 289, 335, 357, 355,
-	361, 786, 412, 429, 437, 444, 450, 451, 453, 454,
-	455, 456, 457, 323, 269, 392, 285, 294, 778, 816,
-	341, 373, 212, 432, 393, 698, 702, 696, 697, 750,
-	751, 699, 807, 808, 809, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 0, 782, 692, 0, 700, 701, 0,
-	788, 797, 798, 754, 181, 195, 290, 812, 362, 252,
-	459, 439, 435, 678, 695, 228, 706, 0, 0, 719,
-	727, 728, 740, 742, 743, 744, 745, 314, 761, 762,
-	764, 772, 774, 777, 779, 784, 794, 815, 183, 184,
-	196, 205, 215, 227, 242, 250, 260, 265, 268, 273,
-	274, 277, 282, 300, 305, 306, 307, 308, 325, 326,
-	327, 330, 333, 334, 337, 339, 340, 343, 350, 351,
-	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
-	379, 381, 382, 386, 387, 388, 389, 397, 401, 419,
-	420, 431, 443, 448, 261, 427, 449, 0, 299, 753,
-	759, 301, 246, 264, 275, 767, 438, 398, 200, 369,
-	253, 189, 218, 203, 225, 240, 243, 279, 309, 316,
-	345, 349, 258, 237, 216, 366, 213, 384, 404, 405,
-	406, 408, 313, 232, 348, 749, 776, 297, 410, 411,
-	271, 801, 787, 409, 0, 735, 804, 705, 723, 814,
-	726, 729, 769, 684, 748, 332, 720, 0, 709, 680,
-	715, 681, 707, 737, 236, 704, 789, 752, 803, 288,
-	233, 686, 710, 346, 725, 187, 771, 385, 221, 298,
-	295, 416, 247, 239, 235, 220, 272, 304, 344, 403,
-	338, 810, 292, 758, 0, 394, 317, 0, 0, 0,
-	739, 793, 746, 783, 734, 770, 694, 757, 805, 721,
-	766, 806, 278, 219, 186, 329, 395, 251, 0, 0,
+	1937, 1938, 1939, 1940, 1941, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1429, 1947, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1955, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1774, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1789, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 632, 0, 0, 0,
+	0, 0, 0, 2079, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1819, 1820, 0, 0,
+	0, 0, 0, 0, 0, 1839, 0, 0, 0, 0,
+	2098, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2110,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2114,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2125, 0, 0, 2128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1920, 0, 0, 0, 2164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2231, 0, 0, 2232, 2233, 2234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1584, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2028, 0,
+	73, 0, 0, 1584, 1584, 1584, 1584, 1584, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1839, 0, 0, 1584, 0, 0, 1584, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2064, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2112, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2160, 2161, 2162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1584, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2028, 0, 73, 0, 2028, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 2028,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 2361, 178, 179, 180, 0, 2408, 73, 2409, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 202, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 73, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 0, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 998, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
 	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 217, 717, 763, 800,
-	718, 765, 231, 276, 238, 230, 413, 811, 792, 0,
-	0, 202, 802, 741, 0, 768, 0, 817, 679, 760,
-	0, 682, 685, 813, 796, 713, 241, 0, 0, 0,
-	0, 0, 0, 0, 738, 747, 780, 732, 0, 0,
-	0, 0, 0, 0, 0, 711, 0, 756, 0, 0,
-	0, 690, 683, 0, 0, 0, 0, 736, 0, 0,
-	0, 693, 0, 712, 781, 0, 677, 259, 687, 318,
-	0, 785, 795, 733, 445, 799, 731, 730, 775, 691,
-	791, 724, 287, 689, 284, 182, 198, 0, 722, 328,
-	368, 374, 790, 708, 716, 222, 714, 372, 342, 430,
-	206, 249, 365, 347, 370, 755, 773, 371, 293, 418,
-	360, 428, 446, 447, 229, 322, 436, 407, 442, 458,
-	199, 226, 336, 400, 433, 391, 315, 414, 415, 283,
-	390, 257, 185, 291, 452, 197, 380, 214, 204, 190,
-	402, 426, 211, 383, 0, 0, 460, 192, 424, 399,
-	311, 280, 281, 191, 0, 364, 234, 255, 224, 331,
-	421, 422, 223, 461, 201, 441, 194, 995, 440, 324,
-	417, 425, 312, 303, 193, 423, 310, 302, 286, 245,
-	266, 358, 296, 359, 267, 320, 319, 321, 0, 188,
-	0, 396, 434, 462, 207, 208, 209, 703, 244, 248,
-	254, 256, 262, 263, 270, 289, 335, 357, 355, 361,
-	786, 412, 429, 437, 444, 450, 451, 453, 454, 455,
-	456, 457, 323, 269, 392, 285, 294, 778, 816, 341,
-	373, 212, 432, 393, 698, 702, 696, 697, 750, 751,
-	699, 807, 808, 809, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 0, 782, 692, 0, 700, 701, 0, 788,
-	797, 798, 754, 181, 195, 290, 812, 362, 252, 459,
-	439, 435, 678, 695, 228, 706, 0, 0, 719, 727,
-	728, 740, 742, 743, 744, 745, 314, 761, 762, 764,
-	772, 774, 777, 779, 784, 794, 815, 183, 184, 196,
-	205, 215, 227, 242, 250, 260, 265, 268, 273, 274,
-	277, 282, 300, 305, 306, 307, 308, 325, 326, 327,
-	330, 333, 334, 337, 339, 340, 343, 350, 351, 352,
-	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
-	381, 382, 386, 387, 388, 389, 397, 401, 419, 420,
-	431, 443, 448, 261, 427, 449, 0, 299, 753, 759,
-	301, 246, 264, 275, 767, 438, 398, 200, 369, 253,
-	189, 218, 203, 225, 240, 243, 279, 309, 316, 345,
-	349, 258, 237, 216, 366, 213, 384, 404, 405, 406,
-	408, 313, 232, 348, 749, 776, 297, 410, 411, 271,
-	801, 787, 409, 0, 735, 804, 705, 723, 814, 726,
-	729, 769, 684, 748, 332, 720, 0, 709, 680, 715,
-	681, 707, 737, 236, 704, 789, 752, 803, 288, 233,
-	686, 710, 346, 725, 187, 771, 385, 221, 298, 295,
-	416, 247, 239, 235, 220, 272, 304, 344, 403, 338,
-	810, 292, 758, 0, 394, 317, 0, 0, 0, 739,
-	793, 746, 783, 734, 770, 694, 757, 805, 721, 766,
-	806, 278, 219, 186, 329, 395, 251, 0, 0, 0,
-	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 210, 0, 217, 717, 763, 800, 718,
-	765, 231, 276, 238, 230, 413, 811, 792, 0, 0,
-	818, 802, 741, 0, 768, 0, 817, 679, 760, 0,
-	682, 685, 813, 796, 713, 241, 0, 0, 0, 0,
-	0, 0, 0, 738, 747, 780, 732, 0, 0, 0,
-	0, 0, 0, 0, 711, 0, 756, 0, 0, 0,
-	690, 683, 0, 0, 0, 0, 736, 0, 0, 0,
-	693, 0, 712, 781, 0, 677, 259, 687, 318, 0,
-	785, 795, 733, 445, 799, 731, 730, 775, 691, 791,
-	724, 287, 689, 284, 182, 198, 0, 722, 328, 368,
-	374, 790, 708, 716, 222, 714, 372, 342, 430, 206,
-	249, 365, 347, 370, 755, 773, 371, 293, 418, 360,
-	428, 446, 447, 229, 322, 436, 407, 442, 458, 199,
-	226, 336, 400, 433, 391, 315, 414, 415, 283, 390,
-	257, 185, 291, 452, 197, 380, 214, 204, 190, 402,
-	426, 211, 383, 0, 0, 460, 192, 424, 399, 311,
-	280, 281, 191, 0, 364, 234, 255, 224, 331, 421,
-	422, 223, 461, 201, 441, 194, 688, 440, 324, 417,
-	425, 312, 303, 193, 423, 310, 302, 286, 245, 266,
-	358, 296, 359, 267, 320, 319, 321, 0, 188, 0,
-	396, 434, 462, 207, 208, 209, 703, 244, 248, 254,
-	256, 262, 263, 270, 289, 335, 357, 355, 361, 786,
-	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
-	457, 676, 670, 669, 285, 294, 778, 816, 341, 373,
-	212, 432, 393, 698, 702, 696, 697, 750, 751, 699,
-	807, 808, 809, 463, 464, 465, 466, 467, 468, 469,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 202, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 2057, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 998, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
 	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 0, 782, 692, 0, 700, 701, 0, 788, 797,
-	798, 754, 181, 195, 290, 812, 362, 252, 459, 439,
-	435, 678, 695, 228, 706, 0, 0, 719, 727, 728,
-	740, 742, 743, 744, 745, 314, 761, 762, 764, 772,
-	774, 777, 779, 784, 794, 815, 183, 184, 196, 205,
-	215, 227, 242, 250, 260, 265, 268, 273, 274, 277,
-	282, 300, 305, 306, 307, 308, 325, 326, 327, 330,
-	333, 334, 337, 339, 340, 343, 350, 351, 352, 353,
-	354, 356, 363, 367, 375, 376, 377, 378, 379, 381,
-	382, 386, 387, 388, 389, 397, 401, 419, 420, 431,
-	443, 448, 261, 427, 449, 0, 299, 753, 759, 301,
-	246, 264, 275, 767, 438, 398, 200, 369, 253, 189,
-	218, 203, 225, 240, 243, 279, 309, 316, 345, 349,
-	258, 237, 216, 366, 213, 384, 404, 405, 406, 408,
-	313, 232, 348, 749, 776, 297, 410, 411, 271, 801,
-	787, 409, 0, 735, 804, 705, 723, 814, 726, 729,
-	769, 684, 748, 332, 720, 0, 709, 680, 715, 681,
-	707, 737, 236, 704, 789, 752, 803, 288, 233, 686,
-	710, 346, 725, 187, 771, 385, 221, 298, 295, 416,
-	247, 239, 235, 220, 272, 304, 344, 403, 338, 810,
-	292, 758, 0, 394, 317, 0, 0, 0, 739, 793,
-	746, 783, 734, 770, 694, 757, 805, 721, 766, 806,
-	278, 219, 186, 329, 395, 251, 0, 0, 0, 0,
-	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 210, 0, 217, 717, 763, 800, 718, 765,
-	231, 276, 238, 230, 413, 811, 792, 0, 0, 818,
-	802, 741, 0, 768, 0, 817, 679, 760, 0, 682,
-	685, 813, 796, 713, 241, 0, 0, 0, 0, 0,
-	0, 0, 738, 747, 780, 732, 0, 0, 0, 0,
-	0, 0, 0, 711, 0, 756, 0, 0, 0, 690,
-	683, 0, 0, 0, 0, 736, 0, 0, 0, 693,
-	0, 712, 781, 0, 677, 259, 687, 318, 0, 785,
-	795, 733, 445, 799, 731, 730, 775, 691, 791, 724,
-	287, 689, 284, 182, 198, 0, 722, 328, 368, 374,
-	790, 708, 716, 222, 714, 372, 342, 430, 206, 249,
-	365, 347, 370, 755, 773, 371, 293, 418, 360, 428,
-	446, 447, 229, 322, 436, 407, 442, 458, 199, 226,
-	336, 400, 433, 391, 315, 414, 415, 283, 390, 257,
-	185, 291, 452, 197, 380, 214, 204, 190, 402, 1168,
-	211, 383, 0, 0, 460, 192, 424, 399, 311, 280,
-	281, 191, 0, 364, 234, 255, 224, 331, 421, 422,
-	223, 461, 201, 441, 194, 688, 440, 324, 417, 425,
-	312, 303, 193, 423, 310, 302, 286, 245, 266, 358,
-	296, 359, 267, 320, 319, 321, 0, 188, 0, 396,
-	434, 462, 207, 208, 209, 703, 244, 248, 254, 256,
-	262, 263, 270, 289, 335, 357, 355, 361, 786, 412,
-	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
-	676, 670, 669, 285, 294, 778, 816, 341, 373, 212,
-	432, 393, 698, 702, 696, 697, 750, 751, 699, 807,
-	808, 809, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 782, 692, 0, 700, 701, 0, 788, 797, 798,
-	754, 181, 195, 290, 812, 362, 252, 459, 439, 435,
-	678, 695, 228, 706, 0, 0, 719, 727, 728, 740,
-	742, 743, 744, 745, 314, 761, 762, 764, 772, 774,
-	777, 779, 784, 794, 815, 183, 184, 196, 205, 215,
-	227, 242, 250, 260, 265, 268, 273, 274, 277, 282,
-	300, 305, 306, 307, 308, 325, 326, 327, 330, 333,
-	334, 337, 339, 340, 343, 350, 351, 352, 353, 354,
-	356, 363, 367, 375, 376, 377, 378, 379, 381, 382,
-	386, 387, 388, 389, 397, 401, 419, 420, 431, 443,
-	448, 261, 427, 449, 0, 299, 753, 759, 301, 246,
-	264, 275, 767, 438, 398, 200, 369, 253, 189, 218,
-	203, 225, 240, 243, 279, 309, 316, 345, 349, 258,
-	237, 216, 366, 213, 384, 404, 405, 406, 408, 313,
-	232, 348, 749, 776, 297, 410, 411, 271, 801, 787,
-	409, 0, 735, 804, 705, 723, 814, 726, 729, 769,
-	684, 748, 332, 720, 0, 709, 680, 715, 681, 707,
-	737, 236, 704, 789, 752, 803, 288, 233, 686, 710,
-	346, 725, 187, 771, 385, 221, 298, 295, 416, 247,
-	239, 235, 220, 272, 304, 344, 403, 338, 810, 292,
-	758, 0, 394, 317, 0, 0, 0, 739, 793, 746,
-	783, 734, 770, 694, 757, 805, 721, 766, 806, 278,
-	219, 186, 329, 395, 251, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 210, 0, 217, 717, 763, 800, 718, 765, 231,
-	276, 238, 230, 413, 811, 792, 0, 0, 818, 802,
-	741, 0, 768, 0, 817, 679, 760, 0, 682, 685,
-	813, 796, 713, 241, 0, 0, 0, 0, 0, 0,
-	0, 738, 747, 780, 732, 0, 0, 0, 0, 0,
-	0, 0, 711, 0, 756, 0, 0, 0, 690, 683,
-	0, 0, 0, 0, 736, 0, 0, 0, 693, 0,
-	712, 781, 0, 677, 259, 687, 318, 0, 785, 795,
-	733, 445, 799, 731, 730, 775, 691, 791, 724, 287,
-	689, 284, 182, 198, 0, 722, 328, 368, 374, 790,
-	708, 716, 222, 714, 372, 342, 430, 206, 249, 365,
-	347, 370, 755, 773, 371, 293, 418, 360, 428, 446,
-	447, 229, 322, 436, 407, 442, 458, 199, 226, 336,
-	400, 433, 391, 315, 414, 415, 283, 390, 257, 185,
-	291, 452, 197, 380, 214, 204, 190, 402, 667, 211,
-	383, 0, 0, 460, 192, 424, 399, 311, 280, 281,
-	191, 0, 364, 234, 255, 224, 331, 421, 422, 223,
-	461, 201, 441, 194, 688, 440, 324, 417, 425, 312,
-	303, 193, 423, 310, 302, 286, 245, 266, 358, 296,
-	359, 267, 320, 319, 321, 0, 188, 0, 396, 434,
-	462, 207, 208, 209, 703, 244, 248, 254, 256, 262,
-	263, 270, 289, 335, 357, 355, 361, 786, 412, 429,
-	437, 444, 450, 451, 453, 454, 455, 456, 457, 676,
-	670, 669, 285, 294, 778, 816, 341, 373, 212, 432,
-	393, 698, 702, 696, 697, 750, 751, 699, 807, 808,
-	809, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 0,
-	782, 692, 0, 700, 701, 0, 788, 797, 798, 754,
-	181, 195, 290, 812, 362, 252, 459, 439, 435, 678,
-	695, 228, 706, 0, 0, 719, 727, 728, 740, 742,
-	743, 744, 745, 314, 761, 762, 764, 772, 774, 777,
-	779, 784, 794, 815, 183, 184, 196, 205, 215, 227,
-	242, 250, 260, 265, 268, 273, 274, 277, 282, 300,
-	305, 306, 307, 308, 325, 326, 327, 330, 333, 334,
-	337, 339, 340, 343, 350, 351, 352, 353, 354, 356,
-	363, 367, 375, 376, 377, 378, 379, 381, 382, 386,
-	387, 388, 389, 397, 401, 419, 420, 431, 443, 448,
-	261, 427, 449, 0, 299, 753, 759, 301, 246, 264,
-	275, 767, 438, 398, 200, 369, 253, 189, 218, 203,
-	225, 240, 243, 279, 309, 316, 345, 349, 258, 237,
-	216, 366, 213, 384, 404, 405, 406, 408, 313, 232,
-	348, 749, 776, 297, 410, 411, 271, 409, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
-	0, 0, 1510, 0, 561, 0, 0, 0, 236, 566,
-	0, 0, 0, 288, 233, 0, 1511, 346, 0, 187,
-	0, 385, 221, 298, 295, 416, 247, 239, 235, 220,
-	272, 304, 344, 403, 338, 573, 292, 0, 0, 394,
-	317, 0, 0, 0, 0, 0, 568, 569, 0, 0,
-	0, 0, 0, 0, 0, 0, 278, 219, 186, 329,
-	395, 251, 0, 81, 0, 0, 178, 179, 180, 603,
-	610, 611, 612, 613, 604, 606, 0, 0, 210, 605,
-	217, 582, 608, 614, 615, 0, 231, 276, 238, 230,
-	413, 0, 0, 0, 0, 202, 0, 0, 0, 0,
-	0, 0, 0, 544, 558, 0, 572, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 555, 556, 656, 0, 0,
-	0, 588, 0, 557, 0, 0, 565, 616, 618, 617,
-	619, 567, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 318, 0, 587, 0, 0, 445, 0,
-	0, 585, 0, 0, 0, 0, 287, 0, 284, 182,
-	198, 0, 0, 328, 368, 374, 0, 0, 0, 222,
-	0, 372, 342, 430, 206, 249, 365, 347, 370, 0,
-	0, 371, 293, 418, 360, 428, 446, 447, 229, 322,
-	436, 407, 442, 458, 199, 226, 336, 400, 433, 391,
-	315, 414, 415, 283, 390, 257, 185, 291, 452, 197,
-	380, 214, 204, 190, 402, 426, 211, 383, 0, 0,
-	460, 192, 424, 399, 311, 280, 281, 191, 0, 364,
-	234, 255, 224, 331, 421, 422, 223, 461, 201, 441,
-	194, 0, 440, 324, 417, 425, 312, 303, 193, 423,
-	310, 302, 286, 245, 266, 358, 296, 359, 267, 320,
-	319, 321, 0, 188, 0, 396, 434, 462, 207, 208,
-	209, 0, 244, 248, 254, 256, 262, 263, 270, 289,
-	335, 357, 355, 361, 0, 412, 429, 437, 444, 450,
-	451, 453, 454, 455, 456, 457, 323, 269, 392, 285,
-	294, 0, 0, 341, 373, 212, 432, 393, 594, 586,
-	577, 579, 595, 596, 574, 575, 578, 597, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 0, 589, 564, 563,
-	0, 570, 571, 0, 580, 581, 562, 181, 195, 290,
-	0, 362, 252, 459, 439, 435, 0, 0, 228, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 196, 205, 215, 227, 242, 250, 260,
-	265, 268, 273, 274, 277, 282, 300, 305, 306, 307,
-	308, 325, 326, 327, 330, 333, 334, 337, 339, 340,
-	343, 350, 351, 352, 353, 354, 356, 363, 367, 375,
-	376, 377, 378, 379, 381, 382, 386, 387, 388, 389,
-	397, 401, 419, 420, 431, 443, 448, 261, 427, 449,
-	0, 299, 0, 0, 301, 246, 264, 275, 0, 438,
-	398, 200, 369, 253, 189, 218, 203, 225, 240, 243,
-	279, 309, 316, 345, 349, 258, 237, 216, 366, 213,
-	384, 404, 405, 406, 408, 313, 232, 348, 409, 0,
-	297, 410, 411, 271, 0, 0, 0, 0, 0, 0,
-	332, 0, 0, 0, 0, 561, 0, 0, 0, 236,
-	566, 0, 0, 0, 288, 233, 0, 0, 346, 0,
-	187, 0, 385, 221, 298, 295, 416, 247, 239, 235,
-	220, 272, 304, 344, 403, 338, 573, 292, 0, 0,
-	394, 317, 0, 0, 0, 0, 0, 568, 569, 0,
-	0, 0, 0, 0, 0, 1584, 0, 278, 219, 186,
-	329, 395, 251, 0, 81, 0, 0, 178, 179, 180,
-	603, 610, 611, 612, 613, 604, 606, 0, 0, 210,
-	605, 217, 582, 608, 614, 615, 1585, 231, 276, 238,
-	230, 413, 0, 0, 0, 0, 202, 0, 0, 0,
-	0, 0, 0, 0, 544, 558, 0, 572, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 555, 556, 0, 0,
-	0, 0, 588, 0, 557, 0, 0, 565, 616, 618,
-	617, 619, 567, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 318, 0, 587, 0, 0, 445,
-	0, 0, 585, 0, 0, 0, 0, 287, 0, 284,
-	182, 198, 0, 0, 328, 368, 374, 0, 0, 0,
-	222, 0, 372, 342, 430, 206, 249, 365, 347, 370,
-	0, 0, 371, 293, 418, 360, 428, 446, 447, 229,
-	322, 436, 407, 442, 458, 199, 226, 336, 400, 433,
-	391, 315, 414, 415, 283, 390, 257, 185, 291, 452,
-	197, 380, 214, 204, 190, 402, 426, 211, 383, 0,
-	0, 460, 192, 424, 399, 311, 280, 281, 191, 0,
-	364, 234, 255, 224, 331, 421, 422, 223, 461, 201,
-	441, 194, 0, 440, 324, 417, 425, 312, 303, 193,
-	423, 310, 302, 286, 245, 266, 358, 296, 359, 267,
-	320, 319, 321, 0, 188, 0, 396, 434, 462, 207,
-	208, 209, 0, 244, 248, 254, 256, 262, 263, 270,
-	289, 335, 357, 355, 361, 0, 412, 429, 437, 444,
-	450, 451, 453, 454, 455, 456, 457, 323, 269, 392,
-	285, 294, 0, 0, 341, 373, 212, 432, 393, 594,
-	586, 577, 579, 595, 596, 574, 575, 578, 597, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 0, 589, 564,
-	563, 0, 570, 571, 0, 580, 581, 562, 181, 195,
-	290, 0, 362, 252, 459, 439, 435, 0, 0, 228,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 196, 205, 215, 227, 242, 250,
-	260, 265, 268, 273, 274, 277, 282, 300, 305, 306,
-	307, 308, 325, 326, 327, 330, 333, 334, 337, 339,
-	340, 343, 350, 351, 352, 353, 354, 356, 363, 367,
-	375, 376, 377, 378, 379, 381, 382, 386, 387, 388,
-	389, 397, 401, 419, 420, 431, 443, 448, 261, 427,
-	449, 0, 299, 0, 0, 301, 246, 264, 275, 0,
-	438, 398, 200, 369, 253, 189, 218, 203, 225, 240,
-	243, 279, 309, 316, 345, 349, 258, 237, 216, 366,
-	213, 384, 404, 405, 406, 408, 313, 232, 348, 72,
-	409, 297, 410, 411, 271, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 0, 0, 0, 561, 0, 0,
-	0, 236, 566, 0, 0, 0, 288, 233, 0, 0,
-	346, 0, 187, 0, 385, 221, 298, 295, 416, 247,
-	239, 235, 220, 272, 304, 344, 403, 338, 573, 292,
-	0, 0, 394, 317, 0, 0, 0, 0, 0, 568,
-	569, 0, 0, 0, 0, 0, 0, 0, 0, 278,
-	219, 186, 329, 395, 251, 0, 81, 0, 0, 178,
-	179, 180, 603, 610, 611, 612, 613, 604, 606, 0,
-	0, 210, 605, 217, 582, 608, 614, 615, 0, 231,
-	276, 238, 230, 413, 0, 0, 0, 0, 202, 0,
-	0, 0, 0, 0, 0, 0, 544, 558, 0, 572,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 555, 556,
-	0, 0, 0, 0, 588, 0, 557, 0, 0, 565,
-	616, 618, 617, 619, 567, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 318, 0, 587, 0,
-	0, 445, 0, 0, 585, 0, 0, 0, 0, 287,
-	0, 284, 182, 198, 0, 0, 328, 368, 374, 0,
-	0, 0, 222, 0, 372, 342, 430, 206, 249, 365,
-	347, 370, 0, 0, 371, 293, 418, 360, 428, 446,
-	447, 229, 322, 436, 407, 442, 458, 199, 226, 336,
-	400, 433, 391, 315, 414, 415, 283, 390, 257, 185,
-	291, 452, 197, 380, 214, 204, 190, 402, 426, 211,
-	383, 0, 0, 460, 192, 424, 399, 311, 280, 281,
-	191, 0, 364, 234, 255, 224, 331, 421, 422, 223,
-	461, 201, 441, 194, 0, 440, 324, 417, 425, 312,
-	303, 193, 423, 310, 302, 286, 245, 266, 358, 296,
-	359, 267, 320, 319, 321, 0, 188, 0, 396, 434,
-	462, 207, 208, 209, 0, 244, 248, 254, 256, 262,
-	263, 270, 289, 335, 357, 355, 361, 0, 412, 429,
-	437, 444, 450, 451, 453, 454, 455, 456, 457, 323,
-	269, 392, 285, 294, 0, 0, 341, 373, 212, 432,
-	393, 594, 586, 577, 579, 595, 596, 574, 575, 578,
-	597, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 0,
-	589, 564, 563, 0, 570, 571, 0, 580, 581, 562,
-	181, 195, 290, 80, 362, 252, 459, 439, 435, 0,
-	0, 228, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 196, 205, 215, 227,
-	242, 250, 260, 265, 268, 273, 274, 277, 282, 300,
-	305, 306, 307, 308, 325, 326, 327, 330, 333, 334,
-	337, 339, 340, 343, 350, 351, 352, 353, 354, 356,
-	363, 367, 375, 376, 377, 378, 379, 381, 382, 386,
-	387, 388, 389, 397, 401, 419, 420, 431, 443, 448,
-	261, 427, 449, 0, 299, 0, 0, 301, 246, 264,
-	275, 0, 438, 398, 200, 369, 253, 189, 218, 203,
-	225, 240, 243, 279, 309, 316, 345, 349, 258, 237,
-	216, 366, 213, 384, 404, 405, 406, 408, 313, 232,
-	348, 409, 0, 297, 410, 411, 271, 0, 0, 0,
-	0, 0, 0, 332, 0, 0, 0, 0, 561, 0,
-	0, 0, 236, 566, 0, 0, 0, 288, 233, 0,
-	0, 346, 0, 187, 0, 385, 221, 298, 295, 416,
-	247, 239, 235, 220, 272, 304, 344, 403, 338, 573,
-	292, 0, 0, 394, 317, 0, 0, 0, 0, 0,
-	568, 569, 0, 0, 0, 0, 0, 0, 0, 0,
-	278, 219, 186, 329, 395, 251, 0, 81, 0, 0,
-	178, 179, 180, 603, 610, 611, 612, 613, 604, 606,
-	0, 0, 210, 605, 217, 582, 608, 614, 615, 0,
-	231, 276, 238, 230, 413, 0, 0, 0, 0, 202,
-	0, 0, 0, 0, 0, 0, 0, 544, 558, 0,
-	572, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 555,
-	556, 0, 0, 0, 0, 588, 0, 557, 0, 0,
-	565, 616, 618, 617, 619, 567, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 318, 0, 587,
-	0, 0, 445, 0, 0, 585, 0, 0, 0, 0,
-	287, 0, 284, 182, 198, 0, 0, 328, 368, 374,
-	0, 0, 0, 222, 0, 372, 342, 430, 206, 249,
-	365, 347, 370, 2380, 0, 371, 293, 418, 360, 428,
-	446, 447, 229, 322, 436, 407, 442, 458, 199, 226,
-	336, 400, 433, 391, 315, 414, 415, 283, 390, 257,
-	185, 291, 452, 197, 380, 214, 204, 190, 402, 426,
-	211, 383, 0, 0, 460, 192, 424, 399, 311, 280,
-	281, 191, 0, 364, 234, 255, 224, 331, 421, 422,
-	223, 461, 201, 441, 194, 0, 440, 324, 417, 425,
-	312, 303, 193, 423, 310, 302, 286, 245, 266, 358,
-	296, 359, 267, 320, 319, 321, 0, 188, 0, 396,
-	434, 462, 207, 208, 209, 0, 244, 248, 254, 256,
-	262, 263, 270, 289, 335, 357, 355, 361, 0, 412,
-	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
-	323, 269, 392, 285, 294, 0, 0, 341, 373, 212,
-	432, 393, 594, 586, 577, 579, 595, 596, 574, 575,
-	578, 597, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 589, 564, 563, 0, 570, 571, 0, 580, 581,
-	562, 181, 195, 290, 0, 362, 252, 459, 439, 435,
-	0, 0, 228, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 314, 0, 0, 0, 0, 0,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 202, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 2017, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 998, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 202, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 1572, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 998, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 81,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 202, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 0, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 998, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 202, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 0, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 998, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 821, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 0, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 691, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 679, 673, 672, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 821, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 0, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 1176, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 691, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 679, 673, 672, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 804, 790, 410, 0, 738, 807, 708, 726, 817,
+	729, 732, 772, 687, 751, 333, 723, 0, 712, 683,
+	718, 684, 710, 740, 237, 707, 792, 755, 806, 289,
+	234, 689, 713, 347, 728, 187, 774, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 813, 293, 761, 0, 395, 318, 0, 0, 0,
+	742, 796, 749, 786, 737, 773, 697, 760, 808, 724,
+	769, 809, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 720, 766, 803,
+	721, 768, 232, 277, 239, 231, 414, 814, 795, 0,
+	0, 821, 805, 744, 0, 771, 0, 820, 682, 763,
+	0, 685, 688, 816, 799, 716, 242, 0, 0, 0,
+	0, 0, 0, 0, 741, 750, 783, 735, 0, 0,
+	0, 0, 0, 0, 0, 714, 0, 759, 0, 0,
+	0, 693, 686, 0, 0, 0, 0, 739, 0, 0,
+	0, 696, 0, 715, 784, 0, 680, 260, 690, 319,
+	0, 788, 798, 736, 446, 802, 734, 733, 778, 694,
+	794, 727, 288, 692, 285, 182, 198, 0, 725, 329,
+	369, 375, 793, 711, 719, 223, 717, 373, 343, 431,
+	206, 250, 366, 348, 371, 758, 776, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 670, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 691, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 706, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	789, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 679, 673, 672, 286, 295, 781, 819, 342,
+	374, 212, 433, 394, 701, 705, 699, 700, 753, 754,
+	702, 810, 811, 812, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 785, 695, 0, 703, 704, 0, 791,
+	800, 801, 757, 181, 195, 291, 815, 363, 253, 460,
+	440, 436, 681, 698, 229, 709, 0, 0, 722, 730,
+	731, 743, 745, 746, 747, 748, 315, 764, 765, 767,
+	775, 777, 780, 782, 787, 797, 818, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 756,
+	762, 302, 247, 265, 276, 770, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 752, 779, 298, 411, 412,
+	272, 410, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 1520, 0, 563, 0,
+	0, 0, 237, 568, 0, 0, 0, 289, 234, 0,
+	1521, 347, 0, 187, 0, 386, 222, 299, 296, 417,
+	248, 240, 236, 221, 273, 305, 345, 404, 339, 575,
+	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
+	570, 571, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 219, 186, 330, 396, 252, 0, 81, 0, 0,
+	178, 179, 180, 605, 612, 613, 614, 615, 606, 608,
+	0, 0, 210, 607, 217, 584, 610, 616, 617, 0,
+	232, 277, 239, 231, 414, 0, 0, 0, 0, 202,
+	0, 0, 0, 0, 0, 0, 0, 545, 560, 0,
+	574, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 557,
+	558, 659, 0, 0, 0, 590, 0, 559, 0, 0,
+	567, 618, 620, 619, 621, 569, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 0, 319, 0, 589,
+	0, 0, 446, 0, 0, 587, 0, 0, 0, 0,
+	288, 0, 285, 182, 198, 0, 0, 329, 369, 375,
+	0, 0, 0, 223, 0, 373, 343, 431, 206, 250,
+	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
+	447, 448, 230, 323, 437, 408, 443, 459, 199, 227,
+	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
+	185, 292, 453, 197, 381, 214, 204, 190, 403, 427,
+	211, 384, 0, 0, 461, 192, 425, 400, 312, 281,
+	282, 191, 0, 365, 235, 256, 225, 332, 422, 423,
+	224, 462, 201, 442, 194, 0, 441, 325, 418, 426,
+	313, 304, 193, 424, 311, 303, 287, 246, 267, 359,
+	297, 360, 268, 321, 320, 322, 0, 188, 0, 397,
+	435, 463, 207, 208, 209, 0, 245, 249, 255, 257,
+	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
+	430, 438, 445, 451, 452, 454, 455, 456, 457, 458,
+	324, 270, 393, 286, 295, 0, 0, 342, 374, 212,
+	433, 394, 596, 588, 579, 581, 597, 598, 576, 577,
+	580, 599, 464, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	0, 591, 566, 565, 0, 572, 573, 0, 582, 583,
+	564, 181, 195, 291, 0, 363, 253, 460, 440, 436,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 183, 184, 196, 205, 215,
-	227, 242, 250, 260, 265, 268, 273, 274, 277, 282,
-	300, 305, 306, 307, 308, 325, 326, 327, 330, 333,
-	334, 337, 339, 340, 343, 350, 351, 352, 353, 354,
-	356, 363, 367, 375, 376, 377, 378, 379, 381, 382,
-	386, 387, 388, 389, 397, 401, 419, 420, 431, 443,
-	448, 261, 427, 449, 0, 299, 0, 0, 301, 246,
-	264, 275, 0, 438, 398, 200, 369, 253, 189, 218,
-	203, 225, 240, 243, 279, 309, 316, 345, 349, 258,
-	237, 216, 366, 213, 384, 404, 405, 406, 408, 313,
-	232, 348, 409, 0, 297, 410, 411, 271, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 0, 0, 561,
-	0, 0, 0, 236, 566, 0, 0, 0, 288, 233,
-	0, 0, 346, 0, 187, 0, 385, 221, 298, 295,
-	416, 247, 239, 235, 220, 272, 304, 344, 403, 338,
-	573, 292, 0, 0, 394, 317, 0, 0, 0, 0,
-	0, 568, 569, 0, 0, 0, 0, 0, 0, 0,
-	0, 278, 219, 186, 329, 395, 251, 0, 81, 0,
-	1136, 178, 179, 180, 603, 610, 611, 612, 613, 604,
-	606, 0, 0, 210, 605, 217, 582, 608, 614, 615,
-	0, 231, 276, 238, 230, 413, 0, 0, 0, 0,
-	202, 0, 0, 0, 0, 0, 0, 0, 544, 558,
-	0, 572, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	555, 556, 0, 0, 0, 0, 588, 0, 557, 0,
-	0, 565, 616, 618, 617, 619, 567, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 318, 0,
-	587, 0, 0, 445, 0, 0, 585, 0, 0, 0,
-	0, 287, 0, 284, 182, 198, 0, 0, 328, 368,
-	374, 0, 0, 0, 222, 0, 372, 342, 430, 206,
-	249, 365, 347, 370, 0, 0, 371, 293, 418, 360,
-	428, 446, 447, 229, 322, 436, 407, 442, 458, 199,
-	226, 336, 400, 433, 391, 315, 414, 415, 283, 390,
-	257, 185, 291, 452, 197, 380, 214, 204, 190, 402,
-	426, 211, 383, 0, 0, 460, 192, 424, 399, 311,
-	280, 281, 191, 0, 364, 234, 255, 224, 331, 421,
-	422, 223, 461, 201, 441, 194, 0, 440, 324, 417,
-	425, 312, 303, 193, 423, 310, 302, 286, 245, 266,
-	358, 296, 359, 267, 320, 319, 321, 0, 188, 0,
-	396, 434, 462, 207, 208, 209, 0, 244, 248, 254,
-	256, 262, 263, 270, 289, 335, 357, 355, 361, 0,
-	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
-	457, 323, 269, 392, 285, 294, 0, 0, 341, 373,
-	212, 432, 393, 594, 586, 577, 579, 595, 596, 574,
-	575, 578, 597, 463, 464, 465, 466, 467, 468, 469,
+	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
+	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
+	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
+	387, 388, 389, 390, 398, 402, 420, 421, 432, 444,
+	449, 262, 428, 450, 0, 220, 300, 0, 0, 302,
+	247, 265, 276, 0, 439, 399, 200, 370, 254, 189,
+	218, 203, 226, 241, 244, 280, 310, 317, 346, 350,
+	259, 238, 216, 367, 213, 385, 405, 406, 407, 409,
+	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
+	563, 0, 0, 0, 237, 568, 0, 0, 0, 289,
+	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 575, 293, 0, 0, 395, 318, 0, 0, 0,
+	0, 0, 570, 571, 0, 0, 0, 0, 0, 0,
+	1596, 0, 279, 219, 186, 330, 396, 252, 0, 81,
+	0, 0, 178, 179, 180, 605, 612, 613, 614, 615,
+	606, 608, 0, 0, 210, 607, 217, 584, 610, 616,
+	617, 1597, 232, 277, 239, 231, 414, 0, 0, 0,
+	0, 202, 0, 0, 0, 0, 0, 0, 0, 545,
+	560, 0, 574, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 557, 558, 0, 0, 0, 0, 590, 0, 559,
+	0, 0, 567, 618, 620, 619, 621, 569, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
+	0, 589, 0, 0, 446, 0, 0, 587, 0, 0,
+	0, 0, 288, 0, 285, 182, 198, 0, 0, 329,
+	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
+	206, 250, 366, 348, 371, 0, 0, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 0, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 0, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	0, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 0, 0, 342,
+	374, 212, 433, 394, 596, 588, 579, 581, 597, 598,
+	576, 577, 580, 599, 464, 465, 466, 467, 468, 469,
 	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 0, 589, 564, 563, 0, 570, 571, 0, 580,
-	581, 562, 181, 195, 290, 0, 362, 252, 459, 439,
-	435, 0, 0, 228, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 196, 205,
-	215, 227, 242, 250, 260, 265, 268, 273, 274, 277,
-	282, 300, 305, 306, 307, 308, 325, 326, 327, 330,
-	333, 334, 337, 339, 340, 343, 350, 351, 352, 353,
-	354, 356, 363, 367, 375, 376, 377, 378, 379, 381,
-	382, 386, 387, 388, 389, 397, 401, 419, 420, 431,
-	443, 448, 261, 427, 449, 0, 299, 0, 0, 301,
-	246, 264, 275, 0, 438, 398, 200, 369, 253, 189,
-	218, 203, 225, 240, 243, 279, 309, 316, 345, 349,
-	258, 237, 216, 366, 213, 384, 404, 405, 406, 408,
-	313, 232, 348, 409, 0, 297, 410, 411, 271, 0,
-	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
-	561, 0, 0, 0, 236, 566, 0, 0, 0, 288,
-	233, 0, 0, 346, 0, 187, 0, 385, 221, 298,
-	295, 416, 247, 239, 235, 220, 272, 304, 344, 403,
-	338, 573, 292, 0, 0, 394, 317, 0, 0, 0,
-	0, 0, 568, 569, 0, 0, 0, 0, 0, 0,
-	0, 0, 278, 219, 186, 329, 395, 251, 0, 81,
-	0, 0, 178, 179, 180, 603, 610, 611, 612, 613,
-	604, 606, 0, 0, 210, 605, 217, 582, 608, 614,
-	615, 0, 231, 276, 238, 230, 413, 0, 0, 0,
-	0, 202, 0, 0, 0, 0, 0, 0, 0, 544,
-	558, 0, 572, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 555, 556, 656, 0, 0, 0, 588, 0, 557,
-	0, 0, 565, 616, 618, 617, 619, 567, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 318,
-	0, 587, 0, 0, 445, 0, 0, 585, 0, 0,
-	0, 0, 287, 0, 284, 182, 198, 0, 0, 328,
-	368, 374, 0, 0, 0, 222, 0, 372, 342, 430,
-	206, 249, 365, 347, 370, 0, 0, 371, 293, 418,
-	360, 428, 446, 447, 229, 322, 436, 407, 442, 458,
-	199, 226, 336, 400, 433, 391, 315, 414, 415, 283,
-	390, 257, 185, 291, 452, 197, 380, 214, 204, 190,
-	402, 426, 211, 383, 0, 0, 460, 192, 424, 399,
-	311, 280, 281, 191, 0, 364, 234, 255, 224, 331,
-	421, 422, 223, 461, 201, 441, 194, 0, 440, 324,
-	417, 425, 312, 303, 193, 423, 310, 302, 286, 245,
-	266, 358, 296, 359, 267, 320, 319, 321, 0, 188,
-	0, 396, 434, 462, 207, 208, 209, 0, 244, 248,
-	254, 256, 262, 263, 270, 289, 335, 357, 355, 361,
-	0, 412, 429, 437, 444, 450, 451, 453, 454, 455,
-	456, 457, 323, 269, 392, 285, 294, 0, 0, 341,
-	373, 212, 432, 393, 594, 586, 577, 579, 595, 596,
-	574, 575, 578, 597, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 0, 589, 564, 563, 0, 570, 571, 0,
-	580, 581, 562, 181, 195, 290, 0, 362, 252, 459,
-	439, 435, 0, 0, 228, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 314, 0, 0, 0,
+	480, 481, 0, 591, 566, 565, 0, 572, 573, 0,
+	582, 583, 564, 181, 195, 291, 0, 363, 253, 460,
+	440, 436, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 183, 184, 196,
-	205, 215, 227, 242, 250, 260, 265, 268, 273, 274,
-	277, 282, 300, 305, 306, 307, 308, 325, 326, 327,
-	330, 333, 334, 337, 339, 340, 343, 350, 351, 352,
-	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
-	381, 382, 386, 387, 388, 389, 397, 401, 419, 420,
-	431, 443, 448, 261, 427, 449, 0, 299, 0, 0,
-	301, 246, 264, 275, 0, 438, 398, 200, 369, 253,
-	189, 218, 203, 225, 240, 243, 279, 309, 316, 345,
-	349, 258, 237, 216, 366, 213, 384, 404, 405, 406,
-	408, 313, 232, 348, 409, 0, 297, 410, 411, 271,
-	0, 0, 0, 0, 0, 0, 332, 0, 0, 0,
-	0, 561, 0, 0, 0, 236, 566, 0, 0, 0,
-	288, 233, 0, 0, 346, 0, 187, 0, 385, 221,
-	298, 295, 416, 247, 239, 235, 220, 272, 304, 344,
-	403, 338, 573, 292, 0, 0, 394, 317, 0, 0,
-	0, 0, 0, 568, 569, 0, 0, 0, 0, 0,
-	0, 0, 0, 278, 219, 186, 329, 395, 251, 0,
-	81, 0, 0, 178, 179, 180, 603, 610, 611, 612,
-	613, 604, 606, 0, 0, 210, 605, 217, 582, 608,
-	614, 615, 0, 231, 276, 238, 230, 413, 0, 0,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 0,
+	0, 302, 247, 265, 276, 0, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 72, 410, 298, 411, 412,
+	272, 0, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 0, 0, 563, 0, 0, 0, 237, 568, 0,
+	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
+	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
+	305, 345, 404, 339, 575, 293, 0, 0, 395, 318,
+	0, 0, 0, 0, 0, 570, 571, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 219, 186, 330, 396,
+	252, 0, 81, 0, 0, 178, 179, 180, 605, 612,
+	613, 614, 615, 606, 608, 0, 0, 210, 607, 217,
+	584, 610, 616, 617, 0, 232, 277, 239, 231, 414,
+	0, 0, 0, 0, 202, 0, 0, 0, 0, 0,
+	0, 0, 545, 560, 0, 574, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 557, 558, 0, 0, 0, 0,
+	590, 0, 559, 0, 0, 567, 618, 620, 619, 621,
+	569, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 0, 319, 0, 589, 0, 0, 446, 0, 0,
+	587, 0, 0, 0, 0, 288, 0, 285, 182, 198,
+	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
+	373, 343, 431, 206, 250, 366, 348, 371, 0, 0,
+	372, 294, 419, 361, 429, 447, 448, 230, 323, 437,
+	408, 443, 459, 199, 227, 337, 401, 434, 392, 316,
+	415, 416, 284, 391, 258, 185, 292, 453, 197, 381,
+	214, 204, 190, 403, 427, 211, 384, 0, 0, 461,
+	192, 425, 400, 312, 281, 282, 191, 0, 365, 235,
+	256, 225, 332, 422, 423, 224, 462, 201, 442, 194,
+	0, 441, 325, 418, 426, 313, 304, 193, 424, 311,
+	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
+	322, 0, 188, 0, 397, 435, 463, 207, 208, 209,
+	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
+	358, 356, 362, 0, 413, 430, 438, 445, 451, 452,
+	454, 455, 456, 457, 458, 324, 270, 393, 286, 295,
+	0, 0, 342, 374, 212, 433, 394, 596, 588, 579,
+	581, 597, 598, 576, 577, 580, 599, 464, 465, 466,
+	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 0, 591, 566, 565, 0,
+	572, 573, 0, 582, 583, 564, 181, 195, 291, 80,
+	363, 253, 460, 440, 436, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 196, 205, 215, 228, 243, 251, 261, 266,
+	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
+	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
+	402, 420, 421, 432, 444, 449, 262, 428, 450, 0,
+	220, 300, 0, 0, 302, 247, 265, 276, 0, 439,
+	399, 200, 370, 254, 189, 218, 203, 226, 241, 244,
+	280, 310, 317, 346, 350, 259, 238, 216, 367, 213,
+	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
+	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 0, 0, 563, 0, 0, 0, 237,
+	568, 0, 0, 0, 289, 234, 0, 0, 347, 0,
+	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
+	221, 273, 305, 345, 404, 339, 575, 293, 0, 0,
+	395, 318, 0, 0, 0, 0, 0, 570, 571, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 219, 186,
+	330, 396, 252, 0, 81, 0, 0, 178, 179, 180,
+	605, 612, 613, 614, 615, 606, 608, 0, 0, 210,
+	607, 217, 584, 610, 616, 617, 0, 232, 277, 239,
+	231, 414, 0, 0, 0, 0, 202, 0, 0, 0,
+	0, 0, 0, 0, 545, 560, 0, 574, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 557, 558, 0, 0,
+	0, 0, 590, 0, 559, 0, 0, 567, 618, 620,
+	619, 621, 569, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 0, 319, 0, 589, 0, 0, 446,
+	0, 0, 587, 0, 0, 0, 0, 288, 0, 285,
+	182, 198, 0, 0, 329, 369, 375, 0, 0, 0,
+	223, 0, 373, 343, 431, 206, 250, 366, 348, 371,
+	2402, 0, 372, 294, 419, 361, 429, 447, 448, 230,
+	323, 437, 408, 443, 459, 199, 227, 337, 401, 434,
+	392, 316, 415, 416, 284, 391, 258, 185, 292, 453,
+	197, 381, 214, 204, 190, 403, 427, 211, 384, 0,
+	0, 461, 192, 425, 400, 312, 281, 282, 191, 0,
+	365, 235, 256, 225, 332, 422, 423, 224, 462, 201,
+	442, 194, 0, 441, 325, 418, 426, 313, 304, 193,
+	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
+	321, 320, 322, 0, 188, 0, 397, 435, 463, 207,
+	208, 209, 0, 245, 249, 255, 257, 263, 264, 271,
+	290, 336, 358, 356, 362, 0, 413, 430, 438, 445,
+	451, 452, 454, 455, 456, 457, 458, 324, 270, 393,
+	286, 295, 0, 0, 342, 374, 212, 433, 394, 596,
+	588, 579, 581, 597, 598, 576, 577, 580, 599, 464,
+	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 477, 478, 479, 480, 481, 0, 591, 566,
+	565, 0, 572, 573, 0, 582, 583, 564, 181, 195,
+	291, 0, 363, 253, 460, 440, 436, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 184, 196, 205, 215, 228, 243, 251,
+	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
+	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
+	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
+	390, 398, 402, 420, 421, 432, 444, 449, 262, 428,
+	450, 0, 220, 300, 0, 0, 302, 247, 265, 276,
+	0, 439, 399, 200, 370, 254, 189, 218, 203, 226,
+	241, 244, 280, 310, 317, 346, 350, 259, 238, 216,
+	367, 213, 385, 405, 406, 407, 409, 314, 233, 349,
+	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 0, 0, 563, 0, 0,
+	0, 237, 568, 0, 0, 0, 289, 234, 0, 0,
+	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
+	240, 236, 221, 273, 305, 345, 404, 339, 575, 293,
+	0, 0, 395, 318, 0, 0, 0, 0, 0, 570,
+	571, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	219, 186, 330, 396, 252, 0, 81, 0, 1140, 178,
+	179, 180, 605, 612, 613, 614, 615, 606, 608, 0,
+	0, 210, 607, 217, 584, 610, 616, 617, 0, 232,
+	277, 239, 231, 414, 0, 0, 0, 0, 202, 0,
+	0, 0, 0, 0, 0, 0, 545, 560, 0, 574,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 590, 0, 559, 0, 0, 567,
+	618, 620, 619, 621, 569, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 260, 0, 319, 0, 589, 0,
+	0, 446, 0, 0, 587, 0, 0, 0, 0, 288,
+	0, 285, 182, 198, 0, 0, 329, 369, 375, 0,
+	0, 0, 223, 0, 373, 343, 431, 206, 250, 366,
+	348, 371, 0, 0, 372, 294, 419, 361, 429, 447,
+	448, 230, 323, 437, 408, 443, 459, 199, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 453, 197, 381, 214, 204, 190, 403, 427, 211,
+	384, 0, 0, 461, 192, 425, 400, 312, 281, 282,
+	191, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	462, 201, 442, 194, 0, 441, 325, 418, 426, 313,
+	304, 193, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 0, 188, 0, 397, 435,
+	463, 207, 208, 209, 0, 245, 249, 255, 257, 263,
+	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
+	438, 445, 451, 452, 454, 455, 456, 457, 458, 324,
+	270, 393, 286, 295, 0, 0, 342, 374, 212, 433,
+	394, 596, 588, 579, 581, 597, 598, 576, 577, 580,
+	599, 464, 465, 466, 467, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 477, 478, 479, 480, 481, 0,
+	591, 566, 565, 0, 572, 573, 0, 582, 583, 564,
+	181, 195, 291, 0, 363, 253, 460, 440, 436, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 183, 184, 196, 205, 215, 228,
+	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
+	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
+	338, 340, 341, 344, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 382, 383, 387,
+	388, 389, 390, 398, 402, 420, 421, 432, 444, 449,
+	262, 428, 450, 0, 220, 300, 0, 0, 302, 247,
+	265, 276, 0, 439, 399, 200, 370, 254, 189, 218,
+	203, 226, 241, 244, 280, 310, 317, 346, 350, 259,
+	238, 216, 367, 213, 385, 405, 406, 407, 409, 314,
+	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 0, 0, 563,
+	0, 0, 0, 237, 568, 0, 0, 0, 289, 234,
+	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	575, 293, 0, 0, 395, 318, 0, 0, 0, 0,
+	0, 570, 571, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 219, 186, 330, 396, 252, 0, 81, 0,
+	0, 178, 179, 180, 605, 612, 613, 614, 615, 606,
+	608, 0, 0, 210, 607, 217, 584, 610, 616, 617,
+	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
+	202, 0, 0, 0, 0, 0, 0, 0, 545, 560,
+	0, 574, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	557, 558, 659, 0, 0, 0, 590, 0, 559, 0,
+	0, 567, 618, 620, 619, 621, 569, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
+	589, 0, 0, 446, 0, 0, 587, 0, 0, 0,
+	0, 288, 0, 285, 182, 198, 0, 0, 329, 369,
+	375, 0, 0, 0, 223, 0, 373, 343, 431, 206,
+	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
+	429, 447, 448, 230, 323, 437, 408, 443, 459, 199,
+	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
+	258, 185, 292, 453, 197, 381, 214, 204, 190, 403,
+	427, 211, 384, 0, 0, 461, 192, 425, 400, 312,
+	281, 282, 191, 0, 365, 235, 256, 225, 332, 422,
+	423, 224, 462, 201, 442, 194, 0, 441, 325, 418,
+	426, 313, 304, 193, 424, 311, 303, 287, 246, 267,
+	359, 297, 360, 268, 321, 320, 322, 0, 188, 0,
+	397, 435, 463, 207, 208, 209, 0, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
+	413, 430, 438, 445, 451, 452, 454, 455, 456, 457,
+	458, 324, 270, 393, 286, 295, 0, 0, 342, 374,
+	212, 433, 394, 596, 588, 579, 581, 597, 598, 576,
+	577, 580, 599, 464, 465, 466, 467, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 0, 591, 566, 565, 0, 572, 573, 0, 582,
+	583, 564, 181, 195, 291, 0, 363, 253, 460, 440,
+	436, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 183, 184, 196, 205,
+	215, 228, 243, 251, 261, 266, 269, 274, 275, 278,
+	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
+	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
+	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
+	444, 449, 262, 428, 450, 0, 220, 300, 0, 0,
+	302, 247, 265, 276, 0, 439, 399, 200, 370, 254,
+	189, 218, 203, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 216, 367, 213, 385, 405, 406, 407,
+	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
+	0, 563, 0, 0, 0, 237, 568, 0, 0, 0,
+	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
+	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
+	404, 339, 575, 293, 0, 0, 395, 318, 0, 0,
+	0, 0, 0, 570, 571, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 219, 186, 330, 396, 252, 0,
+	81, 0, 0, 178, 179, 180, 605, 612, 613, 614,
+	615, 606, 608, 0, 0, 210, 607, 217, 584, 610,
+	616, 617, 0, 232, 277, 239, 231, 414, 0, 0,
 	0, 0, 202, 0, 0, 0, 0, 0, 0, 0,
-	544, 558, 0, 572, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 555, 556, 0, 0, 0, 0, 588, 0,
-	557, 0, 0, 565, 616, 618, 617, 619, 567, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
-	318, 0, 587, 0, 0, 445, 0, 0, 585, 0,
-	0, 0, 0, 287, 0, 284, 182, 198, 0, 0,
-	328, 368, 374, 0, 0, 0, 222, 0, 372, 342,
-	430, 206, 249, 365, 347, 370, 0, 0, 371, 293,
-	418, 360, 428, 446, 447, 229, 322, 436, 407, 442,
-	458, 199, 226, 336, 400, 433, 391, 315, 414, 415,
-	283, 390, 257, 185, 291, 452, 197, 380, 214, 204,
-	190, 402, 426, 211, 383, 0, 0, 460, 192, 424,
-	399, 311, 280, 281, 191, 0, 364, 234, 255, 224,
-	331, 421, 422, 223, 461, 201, 441, 194, 0, 440,
-	324, 417, 425, 312, 303, 193, 423, 310, 302, 286,
-	245, 266, 358, 296, 359, 267, 320, 319, 321, 0,
-	188, 0, 396, 434, 462, 207, 208, 209, 0, 244,
-	248, 254, 256, 262, 263, 270, 289, 335, 357, 355,
-	361, 0, 412, 429, 437, 444, 450, 451, 453, 454,
-	455, 456, 457, 323, 269, 392, 285, 294, 0, 0,
-	341, 373, 212, 432, 393, 594, 586, 577, 579, 595,
-	596, 574, 575, 578, 597, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 0, 589, 564, 563, 0, 570, 571,
-	0, 580, 581, 562, 181, 195, 290, 0, 362, 252,
-	459, 439, 435, 0, 0, 228, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 314, 0, 0,
+	545, 560, 0, 574, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 557, 558, 0, 0, 0, 0, 590, 0,
+	559, 0, 0, 567, 618, 620, 619, 621, 569, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
+	319, 0, 589, 0, 0, 446, 0, 0, 587, 0,
+	0, 0, 0, 288, 0, 285, 182, 198, 0, 0,
+	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
+	431, 206, 250, 366, 348, 371, 0, 0, 372, 294,
+	419, 361, 429, 447, 448, 230, 323, 437, 408, 443,
+	459, 199, 227, 337, 401, 434, 392, 316, 415, 416,
+	284, 391, 258, 185, 292, 453, 197, 381, 214, 204,
+	190, 403, 427, 211, 384, 0, 0, 461, 192, 425,
+	400, 312, 281, 282, 191, 0, 365, 235, 256, 225,
+	332, 422, 423, 224, 462, 201, 442, 194, 0, 441,
+	325, 418, 426, 313, 304, 193, 424, 311, 303, 287,
+	246, 267, 359, 297, 360, 268, 321, 320, 322, 0,
+	188, 0, 397, 435, 463, 207, 208, 209, 0, 245,
+	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
+	362, 0, 413, 430, 438, 445, 451, 452, 454, 455,
+	456, 457, 458, 324, 270, 393, 286, 295, 0, 0,
+	342, 374, 212, 433, 394, 596, 588, 579, 581, 597,
+	598, 576, 577, 580, 599, 464, 465, 466, 467, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 0, 591, 566, 565, 0, 572, 573,
+	0, 582, 583, 564, 181, 195, 291, 0, 363, 253,
+	460, 440, 436, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	196, 205, 215, 227, 242, 250, 260, 265, 268, 273,
-	274, 277, 282, 300, 305, 306, 307, 308, 325, 326,
-	327, 330, 333, 334, 337, 339, 340, 343, 350, 351,
-	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
-	379, 381, 382, 386, 387, 388, 389, 397, 401, 419,
-	420, 431, 443, 448, 261, 427, 449, 0, 299, 0,
-	0, 301, 246, 264, 275, 0, 438, 398, 200, 369,
-	253, 189, 218, 203, 225, 240, 243, 279, 309, 316,
-	345, 349, 258, 237, 216, 366, 213, 384, 404, 405,
-	406, 408, 313, 232, 348, 409, 0, 297, 410, 411,
-	271, 0, 0, 0, 0, 0, 0, 332, 0, 0,
-	0, 0, 561, 0, 0, 0, 236, 566, 0, 0,
-	0, 288, 233, 0, 0, 346, 0, 187, 0, 385,
-	221, 298, 295, 416, 247, 239, 235, 220, 272, 304,
-	344, 403, 338, 573, 292, 0, 0, 394, 317, 0,
-	0, 0, 0, 0, 568, 569, 0, 0, 0, 0,
-	0, 0, 0, 0, 278, 219, 186, 329, 395, 251,
-	0, 81, 0, 0, 178, 179, 180, 603, 610, 611,
-	612, 613, 604, 606, 0, 0, 210, 605, 217, 582,
-	608, 614, 615, 0, 231, 276, 238, 230, 413, 0,
-	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
-	0, 0, 558, 0, 572, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 555, 556, 0, 0, 0, 0, 588,
-	0, 557, 0, 0, 565, 616, 618, 617, 619, 567,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 318, 0, 587, 0, 0, 445, 0, 0, 585,
-	0, 0, 0, 0, 287, 0, 284, 182, 198, 0,
-	0, 328, 368, 374, 0, 0, 0, 222, 0, 372,
-	342, 430, 206, 249, 365, 347, 370, 0, 0, 371,
-	293, 418, 360, 428, 446, 447, 229, 322, 436, 407,
-	442, 458, 199, 226, 336, 400, 433, 391, 315, 414,
-	415, 283, 390, 257, 185, 291, 452, 197, 380, 214,
-	204, 190, 402, 426, 211, 383, 0, 0, 460, 192,
-	424, 399, 311, 280, 281, 191, 0, 364, 234, 255,
-	224, 331, 421, 422, 223, 461, 201, 441, 194, 0,
-	440, 324, 417, 425, 312, 303, 193, 423, 310, 302,
-	286, 245, 266, 358, 296, 359, 267, 320, 319, 321,
-	0, 188, 0, 396, 434, 462, 207, 208, 209, 0,
-	244, 248, 254, 256, 262, 263, 270, 289, 335, 357,
-	355, 361, 0, 412, 429, 437, 444, 450, 451, 453,
-	454, 455, 456, 457, 323, 269, 392, 285, 294, 0,
-	0, 341, 373, 212, 432, 393, 594, 586, 577, 579,
-	595, 596, 574, 575, 578, 597, 463, 464, 465, 466,
+	196, 205, 215, 228, 243, 251, 261, 266, 269, 274,
+	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
+	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
+	421, 432, 444, 449, 262, 428, 450, 0, 220, 300,
+	0, 0, 302, 247, 265, 276, 0, 439, 399, 200,
+	370, 254, 189, 218, 203, 226, 241, 244, 280, 310,
+	317, 346, 350, 259, 238, 216, 367, 213, 385, 405,
+	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
+	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 0, 0, 563, 0, 0, 0, 237, 568, 0,
+	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
+	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
+	305, 345, 404, 339, 575, 293, 0, 0, 395, 318,
+	0, 0, 0, 0, 0, 570, 571, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 219, 186, 330, 396,
+	252, 0, 81, 0, 0, 178, 179, 180, 605, 612,
+	613, 614, 615, 606, 608, 0, 0, 210, 607, 217,
+	584, 610, 616, 617, 0, 232, 277, 239, 231, 414,
+	0, 0, 0, 0, 202, 0, 0, 0, 0, 0,
+	0, 0, 0, 560, 0, 574, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 557, 558, 0, 0, 0, 0,
+	590, 0, 559, 0, 0, 567, 618, 620, 619, 621,
+	569, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 0, 319, 0, 589, 0, 0, 446, 0, 0,
+	587, 0, 0, 0, 0, 288, 0, 285, 182, 198,
+	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
+	373, 343, 431, 206, 250, 366, 348, 371, 0, 0,
+	372, 294, 419, 361, 429, 447, 448, 230, 323, 437,
+	408, 443, 459, 199, 227, 337, 401, 434, 392, 316,
+	415, 416, 284, 391, 258, 185, 292, 453, 197, 381,
+	214, 204, 190, 403, 427, 211, 384, 0, 0, 461,
+	192, 425, 400, 312, 281, 282, 191, 0, 365, 235,
+	256, 225, 332, 422, 423, 224, 462, 201, 442, 194,
+	0, 441, 325, 418, 426, 313, 304, 193, 424, 311,
+	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
+	322, 0, 188, 0, 397, 435, 463, 207, 208, 209,
+	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
+	358, 356, 362, 0, 413, 430, 438, 445, 451, 452,
+	454, 455, 456, 457, 458, 324, 270, 393, 286, 295,
+	0, 0, 342, 374, 212, 433, 394, 596, 588, 579,
+	581, 597, 598, 576, 577, 580, 599, 464, 465, 466,
 	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 0, 589, 564, 563, 0, 570,
-	571, 0, 580, 581, 562, 181, 195, 290, 0, 362,
-	252, 459, 439, 435, 0, 0, 228, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 314, 0,
+	477, 478, 479, 480, 481, 0, 591, 566, 565, 0,
+	572, 573, 0, 582, 583, 564, 181, 195, 291, 0,
+	363, 253, 460, 440, 436, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 196, 205, 215, 228, 243, 251, 261, 266,
+	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
+	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
+	402, 420, 421, 432, 444, 449, 262, 428, 450, 0,
+	220, 300, 0, 0, 302, 247, 265, 276, 0, 439,
+	399, 200, 370, 254, 189, 218, 203, 226, 241, 244,
+	280, 310, 317, 346, 350, 259, 238, 216, 367, 213,
+	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
+	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
+	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
+	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
+	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 219, 186,
+	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 210,
+	0, 217, 0, 0, 0, 0, 0, 232, 277, 239,
+	231, 414, 0, 0, 0, 0, 202, 0, 867, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 0, 319, 0, 0, 0, 866, 446,
+	0, 0, 0, 0, 0, 863, 864, 288, 829, 285,
+	182, 198, 857, 861, 329, 369, 375, 0, 0, 0,
+	223, 0, 373, 343, 431, 206, 250, 366, 348, 371,
+	0, 0, 372, 294, 419, 361, 429, 447, 448, 230,
+	323, 437, 408, 443, 459, 199, 227, 337, 401, 434,
+	392, 316, 415, 416, 284, 391, 258, 185, 292, 453,
+	197, 381, 214, 204, 190, 403, 427, 211, 384, 0,
+	0, 461, 192, 425, 400, 312, 281, 282, 191, 0,
+	365, 235, 256, 225, 332, 422, 423, 224, 462, 201,
+	442, 194, 0, 441, 325, 418, 426, 313, 304, 193,
+	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
+	321, 320, 322, 0, 188, 0, 397, 435, 463, 207,
+	208, 209, 0, 245, 249, 255, 257, 263, 264, 271,
+	290, 336, 358, 356, 362, 0, 413, 430, 438, 445,
+	451, 452, 454, 455, 456, 457, 458, 324, 270, 393,
+	286, 295, 0, 0, 342, 374, 212, 433, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 477, 478, 479, 480, 481, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 181, 195,
+	291, 0, 363, 253, 460, 440, 436, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 184, 196, 205, 215, 228, 243, 251,
+	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
+	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
+	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
+	390, 398, 402, 420, 421, 432, 444, 449, 262, 428,
+	450, 0, 220, 300, 0, 0, 302, 247, 265, 276,
+	0, 439, 399, 200, 370, 254, 189, 218, 203, 226,
+	241, 244, 280, 310, 317, 346, 350, 259, 238, 216,
+	367, 213, 385, 405, 406, 407, 409, 314, 233, 349,
+	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 0, 1164, 0, 0, 0,
+	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
+	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
+	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
+	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	219, 186, 330, 396, 252, 0, 0, 0, 0, 178,
+	179, 180, 0, 1166, 0, 0, 0, 0, 0, 0,
+	0, 210, 0, 217, 0, 0, 0, 0, 0, 232,
+	277, 239, 231, 414, 0, 0, 0, 0, 202, 0,
+	0, 0, 1029, 0, 1030, 1031, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 260, 0, 319, 0, 0, 0,
+	0, 446, 0, 0, 0, 0, 0, 0, 0, 288,
+	0, 285, 182, 198, 0, 0, 329, 369, 375, 0,
+	0, 0, 223, 0, 373, 343, 431, 206, 250, 366,
+	348, 371, 0, 0, 372, 294, 419, 361, 429, 447,
+	448, 230, 323, 437, 408, 443, 459, 199, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 453, 197, 381, 214, 204, 190, 403, 427, 211,
+	384, 0, 0, 461, 192, 425, 400, 312, 281, 282,
+	191, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	462, 201, 442, 194, 0, 441, 325, 418, 426, 313,
+	304, 193, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 0, 188, 0, 397, 435,
+	463, 207, 208, 209, 0, 245, 249, 255, 257, 263,
+	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
+	438, 445, 451, 452, 454, 455, 456, 457, 458, 324,
+	270, 393, 286, 295, 0, 0, 342, 374, 212, 433,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 464, 465, 466, 467, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 477, 478, 479, 480, 481, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 195, 291, 0, 363, 253, 460, 440, 436, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 183, 184, 196, 205, 215, 228,
+	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
+	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
+	338, 340, 341, 344, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 382, 383, 387,
+	388, 389, 390, 398, 402, 420, 421, 432, 444, 449,
+	262, 428, 450, 0, 220, 300, 0, 0, 302, 247,
+	265, 276, 0, 439, 399, 200, 370, 254, 189, 218,
+	203, 226, 241, 244, 280, 310, 317, 346, 350, 259,
+	238, 216, 367, 213, 385, 405, 406, 407, 409, 314,
+	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
+	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 219, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 1105, 1108, 0, 0, 0, 1104,
+	1107, 0, 0, 210, 1103, 217, 0, 0, 0, 0,
+	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
+	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
+	0, 0, 0, 446, 0, 0, 0, 0, 0, 0,
+	0, 288, 0, 285, 182, 198, 0, 0, 329, 369,
+	375, 0, 0, 0, 223, 0, 373, 343, 431, 206,
+	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
+	429, 447, 448, 230, 323, 437, 408, 443, 459, 199,
+	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
+	258, 185, 292, 453, 197, 381, 214, 204, 190, 403,
+	427, 211, 384, 0, 0, 461, 192, 425, 400, 312,
+	281, 282, 191, 0, 365, 235, 256, 225, 332, 422,
+	423, 224, 462, 201, 442, 194, 0, 441, 325, 418,
+	426, 313, 304, 193, 424, 311, 303, 287, 246, 267,
+	359, 297, 360, 268, 321, 320, 322, 0, 188, 0,
+	397, 435, 463, 207, 208, 209, 0, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
+	413, 430, 438, 445, 451, 452, 454, 455, 456, 457,
+	458, 324, 270, 393, 286, 295, 0, 0, 342, 374,
+	212, 433, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 464, 465, 466, 467, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 181, 195, 291, 0, 363, 253, 460, 440,
+	436, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 183, 184, 196, 205,
+	215, 228, 243, 251, 261, 266, 269, 274, 275, 278,
+	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
+	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
+	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
+	444, 449, 262, 428, 450, 0, 220, 300, 0, 0,
+	302, 247, 265, 276, 0, 439, 399, 200, 370, 254,
+	189, 218, 203, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 216, 367, 213, 385, 405, 406, 407,
+	409, 314, 233, 349, 72, 410, 298, 411, 412, 272,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
+	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
+	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 219, 186, 330, 396, 252,
+	0, 81, 0, 1140, 178, 179, 180, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 0, 217, 0,
+	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
+	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 319, 0, 0, 0, 0, 446, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 198, 0,
+	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
+	343, 431, 206, 250, 366, 348, 371, 0, 0, 372,
+	294, 419, 361, 429, 447, 448, 230, 323, 437, 408,
+	443, 459, 199, 227, 337, 401, 434, 392, 316, 415,
+	416, 284, 391, 258, 185, 292, 453, 197, 381, 214,
+	204, 190, 403, 427, 211, 384, 0, 0, 461, 192,
+	425, 400, 312, 281, 282, 191, 0, 365, 235, 256,
+	225, 332, 422, 423, 224, 462, 201, 442, 194, 0,
+	441, 325, 418, 426, 313, 304, 193, 424, 311, 303,
+	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
+	0, 188, 0, 397, 435, 463, 207, 208, 209, 0,
+	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
+	356, 362, 0, 413, 430, 438, 445, 451, 452, 454,
+	455, 456, 457, 458, 324, 270, 393, 286, 295, 0,
+	0, 342, 374, 212, 433, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 465, 466, 467,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 195, 291, 80, 363,
+	253, 460, 440, 436, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 196, 205, 215, 227, 242, 250, 260, 265, 268,
-	273, 274, 277, 282, 300, 305, 306, 307, 308, 325,
-	326, 327, 330, 333, 334, 337, 339, 340, 343, 350,
-	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
-	378, 379, 381, 382, 386, 387, 388, 389, 397, 401,
-	419, 420, 431, 443, 448, 261, 427, 449, 0, 299,
-	0, 0, 301, 246, 264, 275, 0, 438, 398, 200,
-	369, 253, 189, 218, 203, 225, 240, 243, 279, 309,
-	316, 345, 349, 258, 237, 216, 366, 213, 384, 404,
-	405, 406, 408, 313, 232, 348, 409, 0, 297, 410,
-	411, 271, 0, 0, 0, 0, 0, 0, 332, 0,
-	0, 0, 0, 0, 0, 0, 0, 236, 0, 0,
-	0, 0, 288, 233, 0, 0, 346, 0, 187, 0,
-	385, 221, 298, 295, 416, 247, 239, 235, 220, 272,
-	304, 344, 403, 338, 0, 292, 0, 0, 394, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 278, 219, 186, 329, 395,
-	251, 0, 0, 0, 0, 178, 179, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 210, 0, 217,
-	0, 0, 0, 0, 0, 231, 276, 238, 230, 413,
-	0, 0, 0, 0, 202, 0, 864, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 318, 0, 0, 0, 863, 445, 0, 0,
-	0, 0, 0, 860, 861, 287, 826, 284, 182, 198,
-	854, 858, 328, 368, 374, 0, 0, 0, 222, 0,
-	372, 342, 430, 206, 249, 365, 347, 370, 0, 0,
-	371, 293, 418, 360, 428, 446, 447, 229, 322, 436,
-	407, 442, 458, 199, 226, 336, 400, 433, 391, 315,
-	414, 415, 283, 390, 257, 185, 291, 452, 197, 380,
-	214, 204, 190, 402, 426, 211, 383, 0, 0, 460,
-	192, 424, 399, 311, 280, 281, 191, 0, 364, 234,
-	255, 224, 331, 421, 422, 223, 461, 201, 441, 194,
-	0, 440, 324, 417, 425, 312, 303, 193, 423, 310,
-	302, 286, 245, 266, 358, 296, 359, 267, 320, 319,
-	321, 0, 188, 0, 396, 434, 462, 207, 208, 209,
-	0, 244, 248, 254, 256, 262, 263, 270, 289, 335,
-	357, 355, 361, 0, 412, 429, 437, 444, 450, 451,
-	453, 454, 455, 456, 457, 323, 269, 392, 285, 294,
-	0, 0, 341, 373, 212, 432, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 181, 195, 290, 0,
-	362, 252, 459, 439, 435, 0, 0, 228, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 196, 205, 215, 227, 242, 250, 260, 265,
-	268, 273, 274, 277, 282, 300, 305, 306, 307, 308,
-	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
-	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
-	377, 378, 379, 381, 382, 386, 387, 388, 389, 397,
-	401, 419, 420, 431, 443, 448, 261, 427, 449, 0,
-	299, 0, 0, 301, 246, 264, 275, 0, 438, 398,
-	200, 369, 253, 189, 218, 203, 225, 240, 243, 279,
-	309, 316, 345, 349, 258, 237, 216, 366, 213, 384,
-	404, 405, 406, 408, 313, 232, 348, 409, 0, 297,
-	410, 411, 271, 0, 0, 0, 0, 0, 0, 332,
-	0, 0, 0, 1156, 0, 0, 0, 0, 236, 0,
-	0, 0, 0, 288, 233, 0, 0, 346, 0, 187,
-	0, 385, 221, 298, 295, 416, 247, 239, 235, 220,
-	272, 304, 344, 403, 338, 0, 292, 0, 0, 394,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 278, 219, 186, 329,
-	395, 251, 0, 0, 0, 0, 178, 179, 180, 0,
-	1158, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	217, 0, 0, 0, 0, 0, 231, 276, 238, 230,
-	413, 0, 0, 0, 0, 202, 0, 0, 0, 1026,
-	0, 1027, 1028, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 318, 0, 0, 0, 0, 445, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 284, 182,
-	198, 0, 0, 328, 368, 374, 0, 0, 0, 222,
-	0, 372, 342, 430, 206, 249, 365, 347, 370, 0,
-	0, 371, 293, 418, 360, 428, 446, 447, 229, 322,
-	436, 407, 442, 458, 199, 226, 336, 400, 433, 391,
-	315, 414, 415, 283, 390, 257, 185, 291, 452, 197,
-	380, 214, 204, 190, 402, 426, 211, 383, 0, 0,
-	460, 192, 424, 399, 311, 280, 281, 191, 0, 364,
-	234, 255, 224, 331, 421, 422, 223, 461, 201, 441,
-	194, 0, 440, 324, 417, 425, 312, 303, 193, 423,
-	310, 302, 286, 245, 266, 358, 296, 359, 267, 320,
-	319, 321, 0, 188, 0, 396, 434, 462, 207, 208,
-	209, 0, 244, 248, 254, 256, 262, 263, 270, 289,
-	335, 357, 355, 361, 0, 412, 429, 437, 444, 450,
-	451, 453, 454, 455, 456, 457, 323, 269, 392, 285,
-	294, 0, 0, 341, 373, 212, 432, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 463, 464,
+	184, 196, 205, 215, 228, 243, 251, 261, 266, 269,
+	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
+	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
+	420, 421, 432, 444, 449, 262, 428, 450, 0, 220,
+	300, 0, 0, 302, 247, 265, 276, 0, 439, 399,
+	200, 370, 254, 189, 218, 203, 226, 241, 244, 280,
+	310, 317, 346, 350, 259, 238, 216, 367, 213, 385,
+	405, 406, 407, 409, 314, 233, 349, 72, 410, 298,
+	411, 412, 272, 0, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
+	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
+	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
+	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 219, 186,
+	330, 396, 252, 0, 81, 0, 0, 178, 179, 180,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 210,
+	0, 217, 0, 0, 0, 0, 0, 232, 277, 239,
+	231, 414, 0, 0, 0, 0, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 0, 319, 0, 0, 0, 0, 446,
+	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
+	182, 198, 0, 0, 329, 369, 375, 0, 0, 0,
+	223, 0, 373, 343, 431, 206, 250, 366, 348, 371,
+	0, 0, 372, 294, 419, 361, 429, 447, 448, 230,
+	323, 437, 408, 443, 459, 199, 227, 337, 401, 434,
+	392, 316, 415, 416, 284, 391, 258, 185, 292, 453,
+	197, 381, 214, 204, 190, 403, 427, 211, 384, 0,
+	0, 461, 192, 425, 400, 312, 281, 282, 191, 0,
+	365, 235, 256, 225, 332, 422, 423, 224, 462, 201,
+	442, 194, 0, 441, 325, 418, 426, 313, 304, 193,
+	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
+	321, 320, 322, 0, 188, 0, 397, 435, 463, 207,
+	208, 209, 0, 245, 249, 255, 257, 263, 264, 271,
+	290, 336, 358, 356, 362, 0, 413, 430, 438, 445,
+	451, 452, 454, 455, 456, 457, 458, 324, 270, 393,
+	286, 295, 0, 0, 342, 374, 212, 433, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 181, 195, 290,
-	0, 362, 252, 459, 439, 435, 0, 0, 228, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 196, 205, 215, 227, 242, 250, 260,
-	265, 268, 273, 274, 277, 282, 300, 305, 306, 307,
-	308, 325, 326, 327, 330, 333, 334, 337, 339, 340,
-	343, 350, 351, 352, 353, 354, 356, 363, 367, 375,
-	376, 377, 378, 379, 381, 382, 386, 387, 388, 389,
-	397, 401, 419, 420, 431, 443, 448, 261, 427, 449,
-	0, 299, 0, 0, 301, 246, 264, 275, 0, 438,
-	398, 200, 369, 253, 189, 218, 203, 225, 240, 243,
-	279, 309, 316, 345, 349, 258, 237, 216, 366, 213,
-	384, 404, 405, 406, 408, 313, 232, 348, 409, 0,
-	297, 410, 411, 271, 0, 0, 0, 0, 0, 0,
-	332, 0, 0, 0, 0, 0, 0, 0, 0, 236,
-	0, 0, 0, 0, 288, 233, 0, 0, 346, 0,
-	187, 0, 385, 221, 298, 295, 416, 247, 239, 235,
-	220, 272, 304, 344, 403, 338, 0, 292, 0, 0,
-	394, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 278, 219, 186,
-	329, 395, 251, 0, 0, 0, 0, 178, 179, 180,
-	1101, 1104, 0, 0, 0, 1100, 1103, 0, 0, 210,
-	1099, 217, 0, 0, 0, 0, 0, 231, 276, 238,
-	230, 413, 0, 0, 0, 0, 202, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 318, 0, 0, 0, 0, 445,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 284,
-	182, 198, 0, 0, 328, 368, 374, 0, 0, 0,
-	222, 0, 372, 342, 430, 206, 249, 365, 347, 370,
-	0, 0, 371, 293, 418, 360, 428, 446, 447, 229,
-	322, 436, 407, 442, 458, 199, 226, 336, 400, 433,
-	391, 315, 414, 415, 283, 390, 257, 185, 291, 452,
-	197, 380, 214, 204, 190, 402, 426, 211, 383, 0,
-	0, 460, 192, 424, 399, 311, 280, 281, 191, 0,
-	364, 234, 255, 224, 331, 421, 422, 223, 461, 201,
-	441, 194, 0, 440, 324, 417, 425, 312, 303, 193,
-	423, 310, 302, 286, 245, 266, 358, 296, 359, 267,
-	320, 319, 321, 0, 188, 0, 396, 434, 462, 207,
-	208, 209, 0, 244, 248, 254, 256, 262, 263, 270,
-	289, 335, 357, 355, 361, 0, 412, 429, 437, 444,
-	450, 451, 453, 454, 455, 456, 457, 323, 269, 392,
-	285, 294, 0, 0, 341, 373, 212, 432, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 0, 0, 0,
+	475, 476, 477, 478, 479, 480, 481, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 181, 195,
-	290, 0, 362, 252, 459, 439, 435, 0, 0, 228,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 196, 205, 215, 227, 242, 250,
-	260, 265, 268, 273, 274, 277, 282, 300, 305, 306,
-	307, 308, 325, 326, 327, 330, 333, 334, 337, 339,
-	340, 343, 350, 351, 352, 353, 354, 356, 363, 367,
-	375, 376, 377, 378, 379, 381, 382, 386, 387, 388,
-	389, 397, 401, 419, 420, 431, 443, 448, 261, 427,
-	449, 0, 299, 0, 0, 301, 246, 264, 275, 0,
-	438, 398, 200, 369, 253, 189, 218, 203, 225, 240,
-	243, 279, 309, 316, 345, 349, 258, 237, 216, 366,
-	213, 384, 404, 405, 406, 408, 313, 232, 348, 72,
-	409, 297, 410, 411, 271, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 0, 0, 0, 0, 0, 0,
-	0, 236, 0, 0, 0, 0, 288, 233, 0, 0,
-	346, 0, 187, 0, 385, 221, 298, 295, 416, 247,
-	239, 235, 220, 272, 304, 344, 403, 338, 0, 292,
-	0, 0, 394, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
-	219, 186, 329, 395, 251, 0, 81, 0, 1136, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 210, 0, 217, 0, 0, 0, 0, 0, 231,
-	276, 238, 230, 413, 0, 0, 0, 0, 202, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 318, 0, 0, 0,
-	0, 445, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 284, 182, 198, 0, 0, 328, 368, 374, 0,
-	0, 0, 222, 0, 372, 342, 430, 206, 249, 365,
-	347, 370, 0, 0, 371, 293, 418, 360, 428, 446,
-	447, 229, 322, 436, 407, 442, 458, 199, 226, 336,
-	400, 433, 391, 315, 414, 415, 283, 390, 257, 185,
-	291, 452, 197, 380, 214, 204, 190, 402, 426, 211,
-	383, 0, 0, 460, 192, 424, 399, 311, 280, 281,
-	191, 0, 364, 234, 255, 224, 331, 421, 422, 223,
-	461, 201, 441, 194, 0, 440, 324, 417, 425, 312,
-	303, 193, 423, 310, 302, 286, 245, 266, 358, 296,
-	359, 267, 320, 319, 321, 0, 188, 0, 396, 434,
-	462, 207, 208, 209, 0, 244, 248, 254, 256, 262,
-	263, 270, 289, 335, 357, 355, 361, 0, 412, 429,
-	437, 444, 450, 451, 453, 454, 455, 456, 457, 323,
-	269, 392, 285, 294, 0, 0, 341, 373, 212, 432,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	181, 195, 290, 80, 362, 252, 459, 439, 435, 0,
-	0, 228, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 196, 205, 215, 227,
-	242, 250, 260, 265, 268, 273, 274, 277, 282, 300,
-	305, 306, 307, 308, 325, 326, 327, 330, 333, 334,
-	337, 339, 340, 343, 350, 351, 352, 353, 354, 356,
-	363, 367, 375, 376, 377, 378, 379, 381, 382, 386,
-	387, 388, 389, 397, 401, 419, 420, 431, 443, 448,
-	261, 427, 449, 0, 299, 0, 0, 301, 246, 264,
-	275, 0, 438, 398, 200, 369, 253, 189, 218, 203,
-	225, 240, 243, 279, 309, 316, 345, 349, 258, 237,
-	216, 366, 213, 384, 404, 405, 406, 408, 313, 232,
-	348, 72, 409, 297, 410, 411, 271, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 0, 0, 0,
-	0, 0, 0, 236, 0, 0, 0, 0, 288, 233,
-	0, 0, 346, 0, 187, 0, 385, 221, 298, 295,
-	416, 247, 239, 235, 220, 272, 304, 344, 403, 338,
-	0, 292, 0, 0, 394, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 278, 219, 186, 329, 395, 251, 0, 81, 0,
+	291, 80, 363, 253, 460, 440, 436, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 184, 196, 205, 215, 228, 243, 251,
+	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
+	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
+	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
+	390, 398, 402, 420, 421, 432, 444, 449, 262, 428,
+	450, 0, 220, 300, 0, 0, 302, 247, 265, 276,
+	0, 439, 399, 200, 370, 254, 189, 218, 203, 226,
+	241, 244, 280, 310, 317, 346, 350, 259, 238, 216,
+	367, 213, 385, 405, 406, 407, 409, 314, 233, 349,
+	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 0, 1542, 0, 0, 0,
+	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
+	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
+	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
+	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	219, 186, 330, 396, 252, 0, 0, 0, 0, 178,
+	179, 180, 0, 1335, 0, 0, 0, 0, 0, 0,
+	0, 210, 0, 217, 0, 0, 0, 0, 0, 232,
+	277, 239, 231, 414, 0, 0, 0, 0, 202, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 260, 0, 319, 0, 0, 0,
+	0, 446, 0, 0, 0, 0, 0, 0, 0, 288,
+	0, 285, 182, 198, 0, 0, 329, 369, 375, 0,
+	0, 0, 223, 0, 373, 343, 431, 206, 250, 366,
+	348, 371, 0, 1540, 372, 294, 419, 361, 429, 447,
+	448, 230, 323, 437, 408, 443, 459, 199, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 453, 197, 381, 214, 204, 190, 403, 427, 211,
+	384, 0, 0, 461, 192, 425, 400, 312, 281, 282,
+	191, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	462, 201, 442, 194, 0, 441, 325, 418, 426, 313,
+	304, 193, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 0, 188, 0, 397, 435,
+	463, 207, 208, 209, 0, 245, 249, 255, 257, 263,
+	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
+	438, 445, 451, 452, 454, 455, 456, 457, 458, 324,
+	270, 393, 286, 295, 0, 0, 342, 374, 212, 433,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 464, 465, 466, 467, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 477, 478, 479, 480, 481, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 195, 291, 0, 363, 253, 460, 440, 436, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 183, 184, 196, 205, 215, 228,
+	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
+	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
+	338, 340, 341, 344, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 382, 383, 387,
+	388, 389, 390, 398, 402, 420, 421, 432, 444, 449,
+	262, 428, 450, 0, 220, 300, 0, 0, 302, 247,
+	265, 276, 0, 439, 399, 200, 370, 254, 189, 218,
+	203, 226, 241, 244, 280, 310, 317, 346, 350, 259,
+	238, 216, 367, 213, 385, 405, 406, 407, 409, 314,
+	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
+	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 219, 186, 330, 396, 252, 0, 0, 0,
 	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 210, 0, 217, 0, 0, 0, 0,
-	0, 231, 276, 238, 230, 413, 0, 0, 0, 0,
+	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
 	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 318, 0,
-	0, 0, 0, 445, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 284, 182, 198, 0, 0, 328, 368,
-	374, 0, 0, 0, 222, 0, 372, 342, 430, 206,
-	249, 365, 347, 370, 0, 0, 371, 293, 418, 360,
-	428, 446, 447, 229, 322, 436, 407, 442, 458, 199,
-	226, 336, 400, 433, 391, 315, 414, 415, 283, 390,
-	257, 185, 291, 452, 197, 380, 214, 204, 190, 402,
-	426, 211, 383, 0, 0, 460, 192, 424, 399, 311,
-	280, 281, 191, 0, 364, 234, 255, 224, 331, 421,
-	422, 223, 461, 201, 441, 194, 0, 440, 324, 417,
-	425, 312, 303, 193, 423, 310, 302, 286, 245, 266,
-	358, 296, 359, 267, 320, 319, 321, 0, 188, 0,
-	396, 434, 462, 207, 208, 209, 0, 244, 248, 254,
-	256, 262, 263, 270, 289, 335, 357, 355, 361, 0,
-	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
-	457, 323, 269, 392, 285, 294, 0, 0, 341, 373,
-	212, 432, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 181, 195, 290, 80, 362, 252, 459, 439,
-	435, 0, 0, 228, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 823, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
+	0, 0, 0, 446, 0, 0, 0, 0, 0, 0,
+	0, 288, 829, 285, 182, 198, 827, 0, 329, 369,
+	375, 0, 0, 0, 223, 0, 373, 343, 431, 206,
+	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
+	429, 447, 448, 230, 323, 437, 408, 443, 459, 199,
+	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
+	258, 185, 292, 453, 197, 381, 214, 204, 190, 403,
+	427, 211, 384, 0, 0, 461, 192, 425, 400, 312,
+	281, 282, 191, 0, 365, 235, 256, 225, 332, 422,
+	423, 224, 462, 201, 442, 194, 0, 441, 325, 418,
+	426, 313, 304, 193, 424, 311, 303, 287, 246, 267,
+	359, 297, 360, 268, 321, 320, 322, 0, 188, 0,
+	397, 435, 463, 207, 208, 209, 0, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
+	413, 430, 438, 445, 451, 452, 454, 455, 456, 457,
+	458, 324, 270, 393, 286, 295, 0, 0, 342, 374,
+	212, 433, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 464, 465, 466, 467, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 181, 195, 291, 0, 363, 253, 460, 440,
+	436, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 183, 184, 196, 205,
-	215, 227, 242, 250, 260, 265, 268, 273, 274, 277,
-	282, 300, 305, 306, 307, 308, 325, 326, 327, 330,
-	333, 334, 337, 339, 340, 343, 350, 351, 352, 353,
-	354, 356, 363, 367, 375, 376, 377, 378, 379, 381,
-	382, 386, 387, 388, 389, 397, 401, 419, 420, 431,
-	443, 448, 261, 427, 449, 0, 299, 0, 0, 301,
-	246, 264, 275, 0, 438, 398, 200, 369, 253, 189,
-	218, 203, 225, 240, 243, 279, 309, 316, 345, 349,
-	258, 237, 216, 366, 213, 384, 404, 405, 406, 408,
-	313, 232, 348, 409, 0, 297, 410, 411, 271, 0,
-	0, 0, 0, 0, 0, 332, 0, 0, 0, 1532,
-	0, 0, 0, 0, 236, 0, 0, 0, 0, 288,
-	233, 0, 0, 346, 0, 187, 0, 385, 221, 298,
-	295, 416, 247, 239, 235, 220, 272, 304, 344, 403,
-	338, 0, 292, 0, 0, 394, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 278, 219, 186, 329, 395, 251, 0, 0,
-	0, 0, 178, 179, 180, 0, 1327, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 217, 0, 0, 0,
-	0, 0, 231, 276, 238, 230, 413, 0, 0, 0,
-	0, 202, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 318,
-	0, 0, 0, 0, 445, 0, 0, 0, 0, 0,
-	0, 0, 287, 0, 284, 182, 198, 0, 0, 328,
-	368, 374, 0, 0, 0, 222, 0, 372, 342, 430,
-	206, 249, 365, 347, 370, 0, 1530, 371, 293, 418,
-	360, 428, 446, 447, 229, 322, 436, 407, 442, 458,
-	199, 226, 336, 400, 433, 391, 315, 414, 415, 283,
-	390, 257, 185, 291, 452, 197, 380, 214, 204, 190,
-	402, 426, 211, 383, 0, 0, 460, 192, 424, 399,
-	311, 280, 281, 191, 0, 364, 234, 255, 224, 331,
-	421, 422, 223, 461, 201, 441, 194, 0, 440, 324,
-	417, 425, 312, 303, 193, 423, 310, 302, 286, 245,
-	266, 358, 296, 359, 267, 320, 319, 321, 0, 188,
-	0, 396, 434, 462, 207, 208, 209, 0, 244, 248,
-	254, 256, 262, 263, 270, 289, 335, 357, 355, 361,
-	0, 412, 429, 437, 444, 450, 451, 453, 454, 455,
-	456, 457, 323, 269, 392, 285, 294, 0, 0, 341,
-	373, 212, 432, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 181, 195, 290, 0, 362, 252, 459,
-	439, 435, 0, 0, 228, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 184, 196,
-	205, 215, 227, 242, 250, 260, 265, 268, 273, 274,
-	277, 282, 300, 305, 306, 307, 308, 325, 326, 327,
-	330, 333, 334, 337, 339, 340, 343, 350, 351, 352,
-	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
-	381, 382, 386, 387, 388, 389, 397, 401, 419, 420,
-	431, 443, 448, 261, 427, 449, 0, 299, 0, 0,
-	301, 246, 264, 275, 0, 438, 398, 200, 369, 253,
-	189, 218, 203, 225, 240, 243, 279, 309, 316, 345,
-	349, 258, 237, 216, 366, 213, 384, 404, 405, 406,
-	408, 313, 232, 348, 409, 0, 297, 410, 411, 271,
-	0, 0, 0, 0, 0, 0, 332, 0, 0, 0,
-	0, 0, 0, 0, 0, 236, 0, 0, 0, 0,
-	288, 233, 0, 0, 346, 0, 187, 0, 385, 221,
-	298, 295, 416, 247, 239, 235, 220, 272, 304, 344,
-	403, 338, 0, 292, 0, 0, 394, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 278, 219, 186, 329, 395, 251, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
+	215, 228, 243, 251, 261, 266, 269, 274, 275, 278,
+	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
+	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
+	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
+	444, 449, 262, 428, 450, 0, 220, 300, 0, 0,
+	302, 247, 265, 276, 0, 439, 399, 200, 370, 254,
+	189, 218, 203, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 216, 367, 213, 385, 405, 406, 407,
+	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
+	1542, 0, 0, 0, 0, 237, 0, 0, 0, 0,
+	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
+	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
+	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 219, 186, 330, 396, 252, 0,
+	0, 0, 0, 178, 179, 180, 0, 1335, 0, 0,
 	0, 0, 0, 0, 0, 210, 0, 217, 0, 0,
-	0, 0, 0, 231, 276, 238, 230, 413, 0, 0,
+	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
 	0, 0, 202, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 0, 0, 0, 820, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
-	318, 0, 0, 0, 0, 445, 0, 0, 0, 0,
-	0, 0, 0, 287, 826, 284, 182, 198, 824, 0,
-	328, 368, 374, 0, 0, 0, 222, 0, 372, 342,
-	430, 206, 249, 365, 347, 370, 0, 0, 371, 293,
-	418, 360, 428, 446, 447, 229, 322, 436, 407, 442,
-	458, 199, 226, 336, 400, 433, 391, 315, 414, 415,
-	283, 390, 257, 185, 291, 452, 197, 380, 214, 204,
-	190, 402, 426, 211, 383, 0, 0, 460, 192, 424,
-	399, 311, 280, 281, 191, 0, 364, 234, 255, 224,
-	331, 421, 422, 223, 461, 201, 441, 194, 0, 440,
-	324, 417, 425, 312, 303, 193, 423, 310, 302, 286,
-	245, 266, 358, 296, 359, 267, 320, 319, 321, 0,
-	188, 0, 396, 434, 462, 207, 208, 209, 0, 244,
-	248, 254, 256, 262, 263, 270, 289, 335, 357, 355,
-	361, 0, 412, 429, 437, 444, 450, 451, 453, 454,
-	455, 456, 457, 323, 269, 392, 285, 294, 0, 0,
-	341, 373, 212, 432, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 181, 195, 290, 0, 362, 252,
-	459, 439, 435, 0, 0, 228, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 314, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
+	319, 0, 0, 0, 0, 446, 0, 0, 0, 0,
+	0, 0, 0, 288, 0, 285, 182, 198, 0, 0,
+	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
+	431, 206, 250, 366, 348, 371, 0, 0, 372, 294,
+	419, 361, 429, 447, 448, 230, 323, 437, 408, 443,
+	459, 199, 227, 337, 401, 434, 392, 316, 415, 416,
+	284, 391, 258, 185, 292, 453, 197, 381, 214, 204,
+	190, 403, 427, 211, 384, 0, 0, 461, 192, 425,
+	400, 312, 281, 282, 191, 0, 365, 235, 256, 225,
+	332, 422, 423, 224, 462, 201, 442, 194, 0, 441,
+	325, 418, 426, 313, 304, 193, 424, 311, 303, 287,
+	246, 267, 359, 297, 360, 268, 321, 320, 322, 0,
+	188, 0, 397, 435, 463, 207, 208, 209, 0, 245,
+	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
+	362, 0, 413, 430, 438, 445, 451, 452, 454, 455,
+	456, 457, 458, 324, 270, 393, 286, 295, 0, 0,
+	342, 374, 212, 433, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 464, 465, 466, 467, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 181, 195, 291, 0, 363, 253,
+	460, 440, 436, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	196, 205, 215, 227, 242, 250, 260, 265, 268, 273,
-	274, 277, 282, 300, 305, 306, 307, 308, 325, 326,
-	327, 330, 333, 334, 337, 339, 340, 343, 350, 351,
-	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
-	379, 381, 382, 386, 387, 388, 389, 397, 401, 419,
-	420, 431, 443, 448, 261, 427, 449, 0, 299, 0,
-	0, 301, 246, 264, 275, 0, 438, 398, 200, 369,
-	253, 189, 218, 203, 225, 240, 243, 279, 309, 316,
-	345, 349, 258, 237, 216, 366, 213, 384, 404, 405,
-	406, 408, 313, 232, 348, 409, 0, 297, 410, 411,
-	271, 0, 0, 0, 0, 0, 0, 332, 0, 0,
-	0, 1532, 0, 0, 0, 0, 236, 0, 0, 0,
-	0, 288, 233, 0, 0, 346, 0, 187, 0, 385,
-	221, 298, 295, 416, 247, 239, 235, 220, 272, 304,
-	344, 403, 338, 0, 292, 0, 0, 394, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 278, 219, 186, 329, 395, 251,
-	0, 0, 0, 0, 178, 179, 180, 0, 1327, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 217, 0,
-	0, 0, 0, 0, 231, 276, 238, 230, 413, 0,
-	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 318, 0, 0, 0, 0, 445, 0, 0, 0,
-	0, 0, 0, 0, 287, 0, 284, 182, 198, 0,
-	0, 328, 368, 374, 0, 0, 0, 222, 0, 372,
-	342, 430, 206, 249, 365, 347, 370, 0, 0, 371,
-	293, 418, 360, 428, 446, 447, 229, 322, 436, 407,
-	442, 458, 199, 226, 336, 400, 433, 391, 315, 414,
-	415, 283, 390, 257, 185, 291, 452, 197, 380, 214,
-	204, 190, 402, 426, 211, 383, 0, 0, 460, 192,
-	424, 399, 311, 280, 281, 191, 0, 364, 234, 255,
-	224, 331, 421, 422, 223, 461, 201, 441, 194, 0,
-	440, 324, 417, 425, 312, 303, 193, 423, 310, 302,
-	286, 245, 266, 358, 296, 359, 267, 320, 319, 321,
-	0, 188, 0, 396, 434, 462, 207, 208, 209, 0,
-	244, 248, 254, 256, 262, 263, 270, 289, 335, 357,
-	355, 361, 0, 412, 429, 437, 444, 450, 451, 453,
-	454, 455, 456, 457, 323, 269, 392, 285, 294, 0,
-	0, 341, 373, 212, 432, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 181, 195, 290, 0, 362,
-	252, 459, 439, 435, 0, 0, 228, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 196, 205, 215, 227, 242, 250, 260, 265, 268,
-	273, 274, 277, 282, 300, 305, 306, 307, 308, 325,
-	326, 327, 330, 333, 334, 337, 339, 340, 343, 350,
-	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
-	378, 379, 381, 382, 386, 387, 388, 389, 397, 401,
-	419, 420, 431, 443, 448, 261, 427, 449, 0, 299,
-	0, 0, 301, 246, 264, 275, 0, 438, 398, 200,
-	369, 253, 189, 218, 203, 225, 240, 243, 279, 309,
-	316, 345, 349, 258, 237, 216, 366, 213, 384, 404,
-	405, 406, 408, 313, 232, 348, 409, 0, 297, 410,
-	411, 271, 0, 0, 0, 0, 0, 0, 332, 0,
-	0, 0, 0, 0, 0, 0, 0, 236, 0, 0,
-	0, 0, 288, 233, 0, 0, 346, 0, 187, 0,
-	385, 221, 298, 295, 416, 247, 239, 235, 220, 272,
-	304, 344, 403, 338, 0, 292, 0, 0, 394, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 278, 219, 186, 329, 395,
-	251, 0, 0, 0, 1136, 178, 179, 180, 0, 0,
+	196, 205, 215, 228, 243, 251, 261, 266, 269, 274,
+	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
+	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
+	421, 432, 444, 449, 262, 428, 450, 0, 220, 300,
+	0, 0, 302, 247, 265, 276, 0, 439, 399, 200,
+	370, 254, 189, 218, 203, 226, 241, 244, 280, 310,
+	317, 346, 350, 259, 238, 216, 367, 213, 385, 405,
+	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
+	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
+	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
+	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
+	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 219, 186, 330, 396,
+	252, 0, 0, 0, 1140, 178, 179, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 210, 0, 217,
-	0, 0, 0, 0, 0, 231, 276, 238, 230, 413,
+	0, 0, 0, 0, 0, 232, 277, 239, 231, 414,
 	0, 0, 0, 0, 202, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 318, 0, 0, 0, 0, 445, 0, 0,
-	0, 2245, 0, 0, 0, 287, 0, 284, 182, 198,
-	0, 0, 328, 368, 374, 0, 0, 0, 222, 0,
-	372, 342, 430, 206, 249, 365, 347, 370, 0, 0,
-	371, 293, 418, 360, 428, 446, 447, 229, 322, 436,
-	407, 442, 458, 199, 226, 336, 400, 433, 391, 315,
-	414, 415, 283, 390, 257, 185, 291, 452, 197, 380,
-	214, 204, 190, 402, 426, 211, 383, 0, 0, 460,
-	192, 424, 399, 311, 280, 281, 191, 0, 364, 234,
-	255, 224, 331, 421, 422, 223, 461, 201, 441, 194,
-	0, 440, 324, 417, 425, 312, 303, 193, 423, 310,
-	302, 286, 245, 266, 358, 296, 359, 267, 320, 319,
-	321, 0, 188, 0, 396, 434, 462, 207, 208, 209,
-	0, 244, 248, 254, 256, 262, 263, 270, 289, 335,
-	357, 355, 361, 0, 412, 429, 437, 444, 450, 451,
-	453, 454, 455, 456, 457, 323, 269, 392, 285, 294,
-	0, 0, 341, 373, 212, 432, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 181, 195, 290, 0,
-	362, 252, 459, 439, 435, 0, 0, 228, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 196, 205, 215, 227, 242, 250, 260, 265,
-	268, 273, 274, 277, 282, 300, 305, 306, 307, 308,
-	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
-	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
-	377, 378, 379, 381, 382, 386, 387, 388, 389, 397,
-	401, 419, 420, 431, 443, 448, 261, 427, 449, 0,
-	299, 0, 0, 301, 246, 264, 275, 0, 438, 398,
-	200, 369, 253, 189, 218, 203, 225, 240, 243, 279,
-	309, 316, 345, 349, 258, 237, 216, 366, 213, 384,
-	404, 405, 406, 408, 313, 232, 348, 409, 0, 297,
-	410, 411, 271, 0, 0, 0, 0, 0, 0, 332,
-	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
-	0, 0, 0, 288, 233, 0, 0, 346, 0, 187,
-	0, 385, 221, 298, 295, 416, 247, 239, 235, 220,
-	272, 304, 344, 403, 338, 0, 292, 0, 0, 394,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 278, 219, 186, 329,
-	395, 251, 0, 0, 0, 0, 178, 179, 180, 0,
-	0, 0, 1780, 0, 0, 1781, 0, 0, 210, 0,
-	217, 0, 0, 0, 0, 0, 231, 276, 238, 230,
-	413, 0, 0, 0, 0, 202, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 318, 0, 0, 0, 0, 445, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 284, 182,
-	198, 0, 0, 328, 368, 374, 0, 0, 0, 222,
-	0, 372, 342, 430, 206, 249, 365, 347, 370, 0,
-	0, 371, 293, 418, 360, 428, 446, 447, 229, 322,
-	436, 407, 442, 458, 199, 226, 336, 400, 433, 391,
-	315, 414, 415, 283, 390, 257, 185, 291, 452, 197,
-	380, 214, 204, 190, 402, 426, 211, 383, 0, 0,
-	460, 192, 424, 399, 311, 280, 281, 191, 0, 364,
-	234, 255, 224, 331, 421, 422, 223, 461, 201, 441,
-	194, 0, 440, 324, 417, 425, 312, 303, 193, 423,
-	310, 302, 286, 245, 266, 358, 296, 359, 267, 320,
-	319, 321, 0, 188, 0, 396, 434, 462, 207, 208,
-	209, 0, 244, 248, 254, 256, 262, 263, 270, 289,
-	335, 357, 355, 361, 0, 412, 429, 437, 444, 450,
-	451, 453, 454, 455, 456, 457, 323, 269, 392, 285,
-	294, 0, 0, 341, 373, 212, 432, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 463, 464,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 0, 319, 0, 0, 0, 0, 446, 0, 0,
+	0, 2265, 0, 0, 0, 288, 0, 285, 182, 198,
+	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
+	373, 343, 431, 206, 250, 366, 348, 371, 0, 0,
+	372, 294, 419, 361, 429, 447, 448, 230, 323, 437,
+	408, 443, 459, 199, 227, 337, 401, 434, 392, 316,
+	415, 416, 284, 391, 258, 185, 292, 453, 197, 381,
+	214, 204, 190, 403, 427, 211, 384, 0, 0, 461,
+	192, 425, 400, 312, 281, 282, 191, 0, 365, 235,
+	256, 225, 332, 422, 423, 224, 462, 201, 442, 194,
+	0, 441, 325, 418, 426, 313, 304, 193, 424, 311,
+	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
+	322, 0, 188, 0, 397, 435, 463, 207, 208, 209,
+	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
+	358, 356, 362, 0, 413, 430, 438, 445, 451, 452,
+	454, 455, 456, 457, 458, 324, 270, 393, 286, 295,
+	0, 0, 342, 374, 212, 433, 394, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 464, 465, 466,
+	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 195, 291, 0,
+	363, 253, 460, 440, 436, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 196, 205, 215, 228, 243, 251, 261, 266,
+	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
+	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
+	402, 420, 421, 432, 444, 449, 262, 428, 450, 0,
+	220, 300, 0, 0, 302, 247, 265, 276, 0, 439,
+	399, 200, 370, 254, 189, 218, 203, 226, 241, 244,
+	280, 310, 317, 346, 350, 259, 238, 216, 367, 213,
+	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
+	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
+	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
+	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
+	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 219, 186,
+	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
+	0, 0, 0, 1794, 0, 0, 1795, 0, 0, 210,
+	0, 217, 0, 0, 0, 0, 0, 232, 277, 239,
+	231, 414, 0, 0, 0, 0, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 0, 319, 0, 0, 0, 0, 446,
+	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
+	182, 198, 0, 0, 329, 369, 375, 0, 0, 0,
+	223, 0, 373, 343, 431, 206, 250, 366, 348, 371,
+	0, 0, 372, 294, 419, 361, 429, 447, 448, 230,
+	323, 437, 408, 443, 459, 199, 227, 337, 401, 434,
+	392, 316, 415, 416, 284, 391, 258, 185, 292, 453,
+	197, 381, 214, 204, 190, 403, 427, 211, 384, 0,
+	0, 461, 192, 425, 400, 312, 281, 282, 191, 0,
+	365, 235, 256, 225, 332, 422, 423, 224, 462, 201,
+	442, 194, 0, 441, 325, 418, 426, 313, 304, 193,
+	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
+	321, 320, 322, 0, 188, 0, 397, 435, 463, 207,
+	208, 209, 0, 245, 249, 255, 257, 263, 264, 271,
+	290, 336, 358, 356, 362, 0, 413, 430, 438, 445,
+	451, 452, 454, 455, 456, 457, 458, 324, 270, 393,
+	286, 295, 0, 0, 342, 374, 212, 433, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 181, 195, 290,
-	0, 362, 252, 459, 439, 435, 0, 0, 228, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 196, 205, 215, 227, 242, 250, 260,
-	265, 268, 273, 274, 277, 282, 300, 305, 306, 307,
-	308, 325, 326, 327, 330, 333, 334, 337, 339, 340,
-	343, 350, 351, 352, 353, 354, 356, 363, 367, 375,
-	376, 377, 378, 379, 381, 382, 386, 387, 388, 389,
-	397, 401, 419, 420, 431, 443, 448, 261, 427, 449,
-	0, 299, 0, 0, 301, 246, 264, 275, 0, 438,
-	398, 200, 369, 253, 189, 218, 203, 225, 240, 243,
-	279, 309, 316, 345, 349, 258, 237, 216, 366, 213,
-	384, 404, 405, 406, 408, 313, 232, 348, 409, 0,
-	297, 410, 411, 271, 0, 0, 0, 0, 0, 0,
-	332, 0, 0, 0, 0, 0, 0, 0, 0, 236,
-	1179, 0, 0, 0, 288, 233, 0, 0, 346, 0,
-	187, 0, 385, 221, 298, 295, 416, 247, 239, 235,
-	220, 272, 304, 344, 403, 338, 0, 292, 0, 0,
-	394, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 278, 219, 186,
-	329, 395, 251, 0, 0, 0, 0, 178, 179, 180,
-	0, 1178, 0, 0, 0, 0, 0, 0, 0, 210,
-	0, 217, 0, 0, 0, 0, 0, 231, 276, 238,
-	230, 413, 0, 0, 0, 0, 202, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 318, 0, 0, 0, 0, 445,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 284,
-	182, 198, 0, 0, 328, 368, 374, 0, 0, 0,
-	222, 0, 372, 342, 430, 206, 249, 365, 347, 370,
-	0, 0, 371, 293, 418, 360, 428, 446, 447, 229,
-	322, 436, 407, 442, 458, 199, 226, 336, 400, 433,
-	391, 315, 414, 415, 283, 390, 257, 185, 291, 452,
-	197, 380, 214, 204, 190, 402, 426, 211, 383, 0,
-	0, 460, 192, 424, 399, 311, 280, 281, 191, 0,
-	364, 234, 255, 224, 331, 421, 422, 223, 461, 201,
-	441, 194, 0, 440, 324, 417, 425, 312, 303, 193,
-	423, 310, 302, 286, 245, 266, 358, 296, 359, 267,
-	320, 319, 321, 0, 188, 0, 396, 434, 462, 207,
-	208, 209, 0, 244, 248, 254, 256, 262, 263, 270,
-	289, 335, 357, 355, 361, 0, 412, 429, 437, 444,
-	450, 451, 453, 454, 455, 456, 457, 323, 269, 392,
-	285, 294, 0, 0, 341, 373, 212, 432, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 0, 0, 0,
+	475, 476, 477, 478, 479, 480, 481, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 181, 195,
-	290, 0, 362, 252, 459, 439, 435, 0, 0, 228,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 196, 205, 215, 227, 242, 250,
-	260, 265, 268, 273, 274, 277, 282, 300, 305, 306,
-	307, 308, 325, 326, 327, 330, 333, 334, 337, 339,
-	340, 343, 350, 351, 352, 353, 354, 356, 363, 367,
-	375, 376, 377, 378, 379, 381, 382, 386, 387, 388,
-	389, 397, 401, 419, 420, 431, 443, 448, 261, 427,
-	449, 0, 299, 0, 0, 301, 246, 264, 275, 0,
-	438, 398, 200, 369, 253, 189, 218, 203, 225, 240,
-	243, 279, 309, 316, 345, 349, 258, 237, 216, 366,
-	213, 384, 404, 405, 406, 408, 313, 232, 348, 409,
-	0, 297, 410, 411, 271, 0, 0, 0, 0, 0,
-	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
-	236, 0, 0, 0, 0, 288, 233, 0, 0, 346,
-	0, 187, 0, 385, 221, 298, 295, 416, 247, 239,
-	235, 220, 272, 304, 344, 403, 338, 0, 292, 0,
-	0, 394, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 278, 219,
-	186, 329, 395, 251, 0, 0, 0, 0, 178, 179,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 217, 0, 0, 0, 0, 0, 231, 276,
-	238, 230, 413, 0, 0, 0, 0, 202, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 0, 318, 0, 0, 0, 0,
-	445, 0, 0, 0, 2352, 0, 0, 0, 287, 0,
-	284, 182, 198, 0, 0, 328, 368, 374, 0, 0,
-	0, 222, 0, 372, 342, 430, 206, 249, 365, 347,
-	370, 0, 0, 371, 293, 418, 360, 428, 446, 447,
-	229, 322, 436, 407, 442, 458, 199, 226, 336, 400,
-	433, 391, 315, 414, 415, 283, 390, 257, 185, 291,
-	452, 197, 380, 214, 204, 190, 402, 426, 211, 383,
-	0, 0, 460, 192, 424, 399, 311, 280, 281, 191,
-	0, 364, 234, 255, 224, 331, 421, 422, 223, 461,
-	201, 441, 194, 0, 440, 324, 417, 425, 312, 303,
-	193, 423, 310, 302, 286, 245, 266, 358, 296, 359,
-	267, 320, 319, 321, 0, 188, 0, 396, 434, 462,
-	207, 208, 209, 0, 244, 248, 254, 256, 262, 263,
-	270, 289, 335, 357, 355, 361, 0, 412, 429, 437,
-	444, 450, 451, 453, 454, 455, 456, 457, 323, 269,
-	392, 285, 294, 0, 0, 341, 373, 212, 432, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 478, 479, 480, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
-	195, 290, 0, 362, 252, 459, 439, 435, 0, 0,
-	228, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 184, 196, 205, 215, 227, 242,
-	250, 260, 265, 268, 273, 274, 277, 282, 300, 305,
-	306, 307, 308, 325, 326, 327, 330, 333, 334, 337,
-	339, 340, 343, 350, 351, 352, 353, 354, 356, 363,
-	367, 375, 376, 377, 378, 379, 381, 382, 386, 387,
-	388, 389, 397, 401, 419, 420, 431, 443, 448, 261,
-	427, 449, 0, 299, 0, 0, 301, 246, 264, 275,
-	0, 438, 398, 200, 369, 253, 189, 218, 203, 225,
-	240, 243, 279, 309, 316, 345, 349, 258, 237, 216,
-	366, 213, 384, 404, 405, 406, 408, 313, 232, 348,
-	409, 0, 297, 410, 411, 271, 0, 0, 0, 0,
-	0, 0, 332, 0, 0, 0, 0, 0, 0, 0,
-	0, 236, 0, 0, 0, 0, 288, 233, 0, 0,
-	346, 0, 187, 0, 385, 221, 298, 295, 416, 247,
-	239, 235, 220, 272, 304, 344, 403, 338, 0, 292,
-	0, 0, 394, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
-	219, 186, 329, 395, 251, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 210, 0, 217, 0, 0, 0, 0, 0, 231,
-	276, 238, 230, 413, 0, 0, 0, 0, 202, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 318, 0, 0, 0,
-	0, 445, 0, 0, 0, 2245, 0, 0, 0, 287,
-	0, 284, 182, 198, 0, 0, 328, 368, 374, 0,
-	0, 0, 222, 0, 372, 342, 430, 206, 249, 365,
-	347, 370, 0, 0, 371, 293, 418, 360, 428, 446,
-	447, 229, 322, 436, 407, 442, 458, 199, 226, 336,
-	400, 433, 391, 315, 414, 415, 283, 390, 257, 185,
-	291, 452, 197, 380, 214, 204, 190, 402, 426, 211,
-	383, 0, 0, 460, 192, 424, 399, 311, 280, 281,
-	191, 0, 364, 234, 255, 224, 331, 421, 422, 223,
-	461, 201, 441, 194, 0, 440, 324, 417, 425, 312,
-	303, 193, 423, 310, 302, 286, 245, 266, 358, 296,
-	359, 267, 320, 319, 321, 0, 188, 0, 396, 434,
-	462, 207, 208, 209, 0, 244, 248, 254, 256, 262,
-	263, 270, 289, 335, 357, 355, 361, 0, 412, 429,
-	437, 444, 450, 451, 453, 454, 455, 456, 457, 323,
-	269, 392, 285, 294, 0, 0, 341, 373, 212, 432,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	181, 195, 290, 0, 362, 252, 459, 439, 435, 0,
-	0, 228, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 196, 205, 215, 227,
-	242, 250, 260, 265, 268, 273, 274, 277, 282, 300,
-	305, 306, 307, 308, 325, 326, 327, 330, 333, 334,
-	337, 339, 340, 343, 350, 351, 352, 353, 354, 356,
-	363, 367, 375, 376, 377, 378, 379, 381, 382, 386,
-	387, 388, 389, 397, 401, 419, 420, 431, 443, 448,
-	261, 427, 449, 0, 299, 0, 0, 301, 246, 264,
-	275, 0, 438, 398, 200, 369, 253, 189, 218, 203,
-	225, 240, 243, 279, 309, 316, 345, 349, 258, 237,
-	216, 366, 213, 384, 404, 405, 406, 408, 313, 232,
-	348, 409, 0, 297, 410, 411, 271, 0, 0, 0,
-	0, 0, 0, 332, 0, 0, 0, 0, 0, 0,
-	0, 0, 236, 0, 0, 0, 0, 288, 233, 0,
-	0, 346, 0, 187, 0, 385, 221, 298, 295, 416,
-	247, 239, 235, 220, 272, 304, 344, 403, 338, 0,
-	292, 0, 0, 394, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	278, 219, 186, 329, 395, 251, 0, 81, 0, 0,
-	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 210, 0, 217, 0, 0, 0, 0, 0,
-	231, 276, 238, 230, 413, 0, 0, 0, 0, 202,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 318, 0, 0,
-	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
-	287, 0, 284, 182, 198, 0, 0, 328, 368, 374,
-	0, 0, 0, 222, 0, 372, 342, 430, 206, 249,
-	365, 347, 370, 0, 0, 371, 293, 418, 360, 428,
-	446, 447, 229, 322, 436, 407, 442, 458, 199, 226,
-	336, 400, 433, 391, 315, 414, 415, 283, 390, 257,
-	185, 291, 452, 197, 380, 214, 204, 190, 402, 426,
-	211, 383, 0, 0, 460, 192, 424, 399, 311, 280,
-	281, 191, 0, 364, 234, 255, 224, 331, 421, 422,
-	223, 461, 201, 441, 194, 0, 440, 324, 417, 425,
-	312, 303, 193, 423, 310, 302, 286, 245, 266, 358,
-	296, 359, 267, 320, 319, 321, 0, 188, 0, 396,
-	434, 462, 207, 208, 209, 0, 244, 248, 254, 256,
-	262, 263, 270, 289, 335, 357, 355, 361, 0, 412,
-	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
-	323, 269, 392, 285, 294, 0, 0, 341, 373, 212,
-	432, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 181, 195, 290, 0, 362, 252, 459, 439, 435,
-	0, 0, 228, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 184, 196, 205, 215,
-	227, 242, 250, 260, 265, 268, 273, 274, 277, 282,
-	300, 305, 306, 307, 308, 325, 326, 327, 330, 333,
-	334, 337, 339, 340, 343, 350, 351, 352, 353, 354,
-	356, 363, 367, 375, 376, 377, 378, 379, 381, 382,
-	386, 387, 388, 389, 397, 401, 419, 420, 431, 443,
-	448, 261, 427, 449, 0, 299, 0, 0, 301, 246,
-	264, 275, 0, 438, 398, 200, 369, 253, 189, 218,
-	203, 225, 240, 243, 279, 309, 316, 345, 349, 258,
-	237, 216, 366, 213, 384, 404, 405, 406, 408, 313,
-	232, 348, 409, 0, 297, 410, 411, 271, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 0, 0, 0,
-	0, 0, 0, 236, 0, 0, 0, 0, 288, 233,
-	0, 0, 346, 0, 187, 0, 385, 221, 298, 295,
-	416, 247, 239, 235, 220, 272, 304, 344, 403, 338,
-	0, 292, 0, 0, 394, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 278, 219, 186, 329, 395, 251, 0, 0, 0,
-	0, 178, 179, 180, 0, 1327, 0, 0, 0, 0,
+	291, 0, 363, 253, 460, 440, 436, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 184, 196, 205, 215, 228, 243, 251,
+	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
+	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
+	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
+	390, 398, 402, 420, 421, 432, 444, 449, 262, 428,
+	450, 0, 220, 300, 0, 0, 302, 247, 265, 276,
+	0, 439, 399, 200, 370, 254, 189, 218, 203, 226,
+	241, 244, 280, 310, 317, 346, 350, 259, 238, 216,
+	367, 213, 385, 405, 406, 407, 409, 314, 233, 349,
+	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 0, 0, 0, 0, 0,
+	0, 237, 1187, 0, 0, 0, 289, 234, 0, 0,
+	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
+	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
+	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	219, 186, 330, 396, 252, 0, 0, 0, 0, 178,
+	179, 180, 0, 1186, 0, 0, 0, 0, 0, 0,
+	0, 210, 0, 217, 0, 0, 0, 0, 0, 232,
+	277, 239, 231, 414, 0, 0, 0, 0, 202, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 260, 0, 319, 0, 0, 0,
+	0, 446, 0, 0, 0, 0, 0, 0, 0, 288,
+	0, 285, 182, 198, 0, 0, 329, 369, 375, 0,
+	0, 0, 223, 0, 373, 343, 431, 206, 250, 366,
+	348, 371, 0, 0, 372, 294, 419, 361, 429, 447,
+	448, 230, 323, 437, 408, 443, 459, 199, 227, 337,
+	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
+	292, 453, 197, 381, 214, 204, 190, 403, 427, 211,
+	384, 0, 0, 461, 192, 425, 400, 312, 281, 282,
+	191, 0, 365, 235, 256, 225, 332, 422, 423, 224,
+	462, 201, 442, 194, 0, 441, 325, 418, 426, 313,
+	304, 193, 424, 311, 303, 287, 246, 267, 359, 297,
+	360, 268, 321, 320, 322, 0, 188, 0, 397, 435,
+	463, 207, 208, 209, 0, 245, 249, 255, 257, 263,
+	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
+	438, 445, 451, 452, 454, 455, 456, 457, 458, 324,
+	270, 393, 286, 295, 0, 0, 342, 374, 212, 433,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 464, 465, 466, 467, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 477, 478, 479, 480, 481, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 195, 291, 0, 363, 253, 460, 440, 436, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 183, 184, 196, 205, 215, 228,
+	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
+	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
+	338, 340, 341, 344, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 382, 383, 387,
+	388, 389, 390, 398, 402, 420, 421, 432, 444, 449,
+	262, 428, 450, 0, 220, 300, 0, 0, 302, 247,
+	265, 276, 0, 439, 399, 200, 370, 254, 189, 218,
+	203, 226, 241, 244, 280, 310, 317, 346, 350, 259,
+	238, 216, 367, 213, 385, 405, 406, 407, 409, 314,
+	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 0, 0, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
+	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
+	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
+	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 219, 186, 330, 396, 252, 0, 0, 0,
+	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 210, 0, 217, 0, 0, 0, 0,
-	0, 231, 276, 238, 230, 413, 0, 0, 0, 0,
+	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
 	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 318, 0,
-	0, 0, 0, 445, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 284, 182, 198, 0, 0, 328, 368,
-	374, 0, 0, 0, 222, 0, 372, 342, 430, 206,
-	249, 365, 347, 370, 0, 0, 371, 293, 418, 360,
-	428, 446, 447, 229, 322, 436, 407, 442, 458, 199,
-	226, 336, 400, 433, 391, 315, 414, 415, 283, 390,
-	257, 185, 291, 452, 197, 380, 214, 204, 190, 402,
-	426, 211, 383, 0, 0, 460, 192, 424, 399, 311,
-	280, 281, 191, 0, 364, 234, 255, 224, 331, 421,
-	422, 223, 461, 201, 441, 194, 0, 440, 324, 417,
-	425, 312, 303, 193, 423, 310, 302, 286, 245, 266,
-	358, 296, 359, 267, 320, 319, 321, 0, 188, 0,
-	396, 434, 462, 207, 208, 209, 0, 244, 248, 254,
-	256, 262, 263, 270, 289, 335, 357, 355, 361, 0,
-	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
-	457, 323, 269, 392, 285, 294, 0, 0, 341, 373,
-	212, 432, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 181, 195, 290, 0, 362, 252, 459, 439,
-	435, 0, 0, 228, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
+	0, 0, 0, 446, 0, 0, 0, 2372, 0, 0,
+	0, 288, 0, 285, 182, 198, 0, 0, 329, 369,
+	375, 0, 0, 0, 223, 0, 373, 343, 431, 206,
+	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
+	429, 447, 448, 230, 323, 437, 408, 443, 459, 199,
+	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
+	258, 185, 292, 453, 197, 381, 214, 204, 190, 403,
+	427, 211, 384, 0, 0, 461, 192, 425, 400, 312,
+	281, 282, 191, 0, 365, 235, 256, 225, 332, 422,
+	423, 224, 462, 201, 442, 194, 0, 441, 325, 418,
+	426, 313, 304, 193, 424, 311, 303, 287, 246, 267,
+	359, 297, 360, 268, 321, 320, 322, 0, 188, 0,
+	397, 435, 463, 207, 208, 209, 0, 245, 249, 255,
+	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
+	413, 430, 438, 445, 451, 452, 454, 455, 456, 457,
+	458, 324, 270, 393, 286, 295, 0, 0, 342, 374,
+	212, 433, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 464, 465, 466, 467, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 181, 195, 291, 0, 363, 253, 460, 440,
+	436, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 183, 184, 196, 205,
-	215, 227, 242, 250, 260, 265, 268, 273, 274, 277,
-	282, 300, 305, 306, 307, 308, 325, 326, 327, 330,
-	333, 334, 337, 339, 340, 343, 350, 351, 352, 353,
-	354, 356, 363, 367, 375, 376, 377, 378, 379, 381,
-	382, 386, 387, 388, 389, 397, 401, 419, 420, 431,
-	443, 448, 261, 427, 449, 0, 299, 0, 0, 301,
-	246, 264, 275, 0, 438, 398, 200, 369, 253, 189,
-	218, 203, 225, 240, 243, 279, 309, 316, 345, 349,
-	258, 237, 216, 366, 213, 384, 404, 405, 406, 408,
-	313, 232, 348, 0, 409, 297, 410, 411, 271, 1573,
-	0, 0, 0, 0, 0, 0, 332, 0, 0, 0,
-	0, 0, 0, 0, 0, 236, 0, 0, 0, 0,
-	288, 233, 0, 0, 346, 0, 187, 0, 385, 221,
-	298, 295, 416, 247, 239, 235, 220, 272, 304, 344,
-	403, 338, 0, 292, 0, 0, 394, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 278, 219, 186, 329, 395, 251, 0,
+	215, 228, 243, 251, 261, 266, 269, 274, 275, 278,
+	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
+	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
+	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
+	444, 449, 262, 428, 450, 0, 220, 300, 0, 0,
+	302, 247, 265, 276, 0, 439, 399, 200, 370, 254,
+	189, 218, 203, 226, 241, 244, 280, 310, 317, 346,
+	350, 259, 238, 216, 367, 213, 385, 405, 406, 407,
+	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
+	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
+	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
+	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
+	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 219, 186, 330, 396, 252, 0,
 	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 210, 0, 217, 0, 0,
-	0, 0, 0, 231, 276, 238, 230, 413, 0, 0,
+	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
 	0, 0, 202, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
-	318, 0, 0, 0, 0, 445, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 284, 182, 198, 0, 0,
-	328, 368, 374, 0, 0, 0, 222, 0, 372, 342,
-	430, 206, 249, 365, 347, 370, 0, 0, 371, 293,
-	418, 360, 428, 446, 447, 229, 322, 436, 407, 442,
-	458, 199, 226, 336, 400, 433, 391, 315, 414, 415,
-	283, 390, 257, 185, 291, 452, 197, 380, 214, 204,
-	190, 402, 426, 211, 383, 0, 0, 460, 192, 424,
-	399, 311, 280, 281, 191, 0, 364, 234, 255, 224,
-	331, 421, 422, 223, 461, 201, 441, 194, 0, 440,
-	324, 417, 425, 312, 303, 193, 423, 310, 302, 286,
-	245, 266, 358, 296, 359, 267, 320, 319, 321, 0,
-	188, 0, 396, 434, 462, 207, 208, 209, 0, 244,
-	248, 254, 256, 262, 263, 270, 289, 335, 357, 355,
-	361, 0, 412, 429, 437, 444, 450, 451, 453, 454,
-	455, 456, 457, 323, 269, 392, 285, 294, 0, 0,
-	341, 373, 212, 432, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 181, 195, 290, 0, 362, 252,
-	459, 439, 435, 0, 0, 228, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 314, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
+	319, 0, 0, 0, 0, 446, 0, 0, 0, 2265,
+	0, 0, 0, 288, 0, 285, 182, 198, 0, 0,
+	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
+	431, 206, 250, 366, 348, 371, 0, 0, 372, 294,
+	419, 361, 429, 447, 448, 230, 323, 437, 408, 443,
+	459, 199, 227, 337, 401, 434, 392, 316, 415, 416,
+	284, 391, 258, 185, 292, 453, 197, 381, 214, 204,
+	190, 403, 427, 211, 384, 0, 0, 461, 192, 425,
+	400, 312, 281, 282, 191, 0, 365, 235, 256, 225,
+	332, 422, 423, 224, 462, 201, 442, 194, 0, 441,
+	325, 418, 426, 313, 304, 193, 424, 311, 303, 287,
+	246, 267, 359, 297, 360, 268, 321, 320, 322, 0,
+	188, 0, 397, 435, 463, 207, 208, 209, 0, 245,
+	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
+	362, 0, 413, 430, 438, 445, 451, 452, 454, 455,
+	456, 457, 458, 324, 270, 393, 286, 295, 0, 0,
+	342, 374, 212, 433, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 464, 465, 466, 467, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 181, 195, 291, 0, 363, 253,
+	460, 440, 436, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	196, 205, 215, 227, 242, 250, 260, 265, 268, 273,
-	274, 277, 282, 300, 305, 306, 307, 308, 325, 326,
-	327, 330, 333, 334, 337, 339, 340, 343, 350, 351,
-	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
-	379, 381, 382, 386, 387, 388, 389, 397, 401, 419,
-	420, 431, 443, 448, 261, 427, 449, 0, 299, 0,
-	0, 301, 246, 264, 275, 0, 438, 398, 200, 369,
-	253, 189, 218, 203, 225, 240, 243, 279, 309, 316,
-	345, 349, 258, 237, 216, 366, 213, 384, 404, 405,
-	406, 408, 313, 232, 348, 409, 0, 297, 410, 411,
-	271, 0, 0, 0, 0, 0, 0, 332, 0, 0,
-	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
-	0, 288, 233, 0, 0, 346, 0, 187, 0, 385,
-	221, 298, 295, 416, 247, 239, 235, 220, 272, 304,
-	344, 403, 338, 0, 292, 0, 0, 394, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 278, 219, 186, 329, 395, 251,
-	0, 0, 0, 0, 178, 179, 180, 0, 1158, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 217, 0,
-	0, 0, 0, 0, 231, 276, 238, 230, 413, 0,
-	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 318, 0, 0, 0, 0, 445, 0, 0, 0,
-	0, 0, 0, 0, 287, 0, 284, 182, 198, 0,
-	0, 328, 368, 374, 0, 0, 0, 222, 0, 372,
-	342, 430, 206, 249, 365, 347, 370, 0, 0, 371,
-	293, 418, 360, 428, 446, 447, 229, 322, 436, 407,
-	442, 458, 199, 226, 336, 400, 433, 391, 315, 414,
-	415, 283, 390, 257, 185, 291, 452, 197, 380, 214,
-	204, 190, 402, 426, 211, 383, 0, 0, 460, 192,
-	424, 399, 311, 280, 281, 191, 0, 364, 234, 255,
-	224, 331, 421, 422, 223, 461, 201, 441, 194, 0,
-	440, 324, 417, 425, 312, 303, 193, 423, 310, 302,
-	286, 245, 266, 358, 296, 359, 267, 320, 319, 321,
-	0, 188, 0, 396, 434, 462, 207, 208, 209, 0,
-	244, 248, 254, 256, 262, 263, 270, 289, 335, 357,
-	355, 361, 0, 412, 429, 437, 444, 450, 451, 453,
-	454, 455, 456, 457, 323, 269, 392, 285, 294, 0,
-	0, 341, 373, 212, 432, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 181, 195, 290, 0, 362,
-	252, 459, 439, 435, 0, 0, 228, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 196, 205, 215, 227, 242, 250, 260, 265, 268,
-	273, 274, 277, 282, 300, 305, 306, 307, 308, 325,
-	326, 327, 330, 333, 334, 337, 339, 340, 343, 350,
-	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
-	378, 379, 381, 382, 386, 387, 388, 389, 397, 401,
-	419, 420, 431, 443, 448, 261, 427, 449, 0, 299,
-	0, 0, 301, 246, 264, 275, 0, 438, 398, 200,
-	369, 253, 189, 218, 203, 225, 240, 243, 279, 309,
-	316, 345, 349, 258, 237, 216, 366, 213, 384, 404,
-	405, 406, 408, 313, 232, 348, 409, 0, 297, 410,
-	411, 271, 0, 0, 0, 0, 0, 0, 332, 0,
-	0, 0, 0, 0, 0, 0, 0, 236, 0, 0,
-	0, 0, 288, 233, 0, 0, 346, 0, 187, 0,
-	385, 221, 298, 295, 416, 247, 239, 235, 220, 272,
-	304, 344, 403, 338, 0, 292, 0, 0, 394, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 278, 219, 186, 329, 395,
-	251, 0, 0, 0, 0, 178, 179, 180, 0, 0,
+	196, 205, 215, 228, 243, 251, 261, 266, 269, 274,
+	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
+	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
+	421, 432, 444, 449, 262, 428, 450, 0, 220, 300,
+	0, 0, 302, 247, 265, 276, 0, 439, 399, 200,
+	370, 254, 189, 218, 203, 226, 241, 244, 280, 310,
+	317, 346, 350, 259, 238, 216, 367, 213, 385, 405,
+	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
+	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
+	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
+	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
+	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 219, 186, 330, 396,
+	252, 0, 81, 0, 0, 178, 179, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 210, 0, 217,
-	0, 0, 0, 0, 0, 231, 276, 238, 230, 413,
+	0, 0, 0, 0, 0, 232, 277, 239, 231, 414,
 	0, 0, 0, 0, 202, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1056, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 318, 0, 0, 0, 0, 445, 0, 0,
-	0, 0, 0, 0, 0, 287, 0, 284, 182, 198,
-	0, 0, 328, 368, 374, 0, 0, 0, 222, 0,
-	372, 342, 430, 206, 249, 365, 347, 370, 0, 0,
-	371, 293, 418, 360, 428, 446, 447, 229, 322, 436,
-	407, 442, 458, 199, 226, 336, 400, 433, 391, 315,
-	414, 415, 283, 390, 257, 185, 291, 452, 197, 380,
-	214, 204, 190, 402, 426, 211, 383, 0, 0, 460,
-	192, 424, 399, 311, 280, 281, 191, 0, 364, 234,
-	255, 224, 331, 421, 422, 223, 461, 201, 441, 194,
-	0, 440, 324, 417, 425, 312, 303, 193, 423, 310,
-	302, 286, 245, 266, 358, 296, 359, 267, 320, 319,
-	321, 0, 188, 0, 396, 434, 462, 207, 208, 209,
-	0, 244, 248, 254, 256, 262, 263, 270, 289, 335,
-	357, 355, 361, 0, 412, 429, 437, 444, 450, 451,
-	453, 454, 455, 456, 457, 323, 269, 392, 285, 294,
-	0, 0, 341, 373, 212, 432, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 181, 195, 290, 0,
-	362, 252, 459, 439, 435, 0, 0, 228, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 196, 205, 215, 227, 242, 250, 260, 265,
-	268, 273, 274, 277, 282, 300, 305, 306, 307, 308,
-	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
-	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
-	377, 378, 379, 381, 382, 386, 387, 388, 389, 397,
-	401, 419, 420, 431, 443, 448, 261, 427, 449, 0,
-	299, 0, 0, 301, 246, 264, 275, 0, 438, 398,
-	200, 369, 253, 189, 218, 203, 225, 240, 243, 279,
-	309, 316, 345, 349, 258, 237, 216, 366, 213, 384,
-	404, 405, 406, 408, 313, 232, 348, 409, 0, 297,
-	410, 411, 271, 0, 0, 0, 0, 0, 0, 332,
-	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
-	0, 0, 0, 288, 233, 0, 0, 346, 0, 187,
-	0, 385, 221, 298, 295, 416, 247, 239, 235, 220,
-	272, 304, 344, 403, 338, 0, 292, 0, 0, 394,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 278, 219, 186, 329,
-	395, 251, 0, 0, 0, 0, 178, 179, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	217, 0, 0, 0, 0, 0, 231, 276, 238, 230,
-	413, 0, 0, 0, 0, 202, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 318, 0, 0, 0, 0, 445, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 284, 182,
-	198, 0, 0, 328, 368, 374, 0, 0, 0, 222,
-	0, 372, 342, 430, 206, 249, 365, 347, 370, 0,
-	0, 371, 293, 418, 360, 428, 446, 447, 229, 322,
-	436, 407, 442, 458, 199, 226, 336, 400, 433, 391,
-	315, 414, 415, 283, 390, 257, 185, 291, 452, 197,
-	380, 214, 204, 190, 402, 426, 211, 383, 0, 0,
-	460, 192, 424, 399, 311, 280, 281, 191, 0, 364,
-	234, 255, 224, 331, 421, 422, 223, 461, 201, 441,
-	194, 0, 440, 324, 417, 425, 312, 303, 193, 423,
-	310, 302, 286, 245, 266, 358, 296, 359, 267, 320,
-	319, 321, 0, 188, 0, 396, 434, 462, 207, 208,
-	209, 0, 244, 248, 254, 256, 262, 263, 270, 289,
-	335, 357, 355, 361, 0, 412, 429, 437, 444, 450,
-	451, 453, 454, 455, 456, 457, 323, 269, 392, 285,
-	294, 0, 0, 341, 373, 212, 432, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 463, 464,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 0, 319, 0, 0, 0, 0, 446, 0, 0,
+	0, 0, 0, 0, 0, 288, 0, 285, 182, 198,
+	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
+	373, 343, 431, 206, 250, 366, 348, 371, 0, 0,
+	372, 294, 419, 361, 429, 447, 448, 230, 323, 437,
+	408, 443, 459, 199, 227, 337, 401, 434, 392, 316,
+	415, 416, 284, 391, 258, 185, 292, 453, 197, 381,
+	214, 204, 190, 403, 427, 211, 384, 0, 0, 461,
+	192, 425, 400, 312, 281, 282, 191, 0, 365, 235,
+	256, 225, 332, 422, 423, 224, 462, 201, 442, 194,
+	0, 441, 325, 418, 426, 313, 304, 193, 424, 311,
+	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
+	322, 0, 188, 0, 397, 435, 463, 207, 208, 209,
+	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
+	358, 356, 362, 0, 413, 430, 438, 445, 451, 452,
+	454, 455, 456, 457, 458, 324, 270, 393, 286, 295,
+	0, 0, 342, 374, 212, 433, 394, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 464, 465, 466,
+	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 195, 291, 0,
+	363, 253, 460, 440, 436, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 196, 205, 215, 228, 243, 251, 261, 266,
+	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
+	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
+	402, 420, 421, 432, 444, 449, 262, 428, 450, 0,
+	220, 300, 0, 0, 302, 247, 265, 276, 0, 439,
+	399, 200, 370, 254, 189, 218, 203, 226, 241, 244,
+	280, 310, 317, 346, 350, 259, 238, 216, 367, 213,
+	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
+	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
+	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
+	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
+	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 219, 186,
+	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
+	0, 1335, 0, 0, 0, 0, 0, 0, 0, 210,
+	0, 217, 0, 0, 0, 0, 0, 232, 277, 239,
+	231, 414, 0, 0, 0, 0, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 260, 0, 319, 0, 0, 0, 0, 446,
+	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
+	182, 198, 0, 0, 329, 369, 375, 0, 0, 0,
+	223, 0, 373, 343, 431, 206, 250, 366, 348, 371,
+	0, 0, 372, 294, 419, 361, 429, 447, 448, 230,
+	323, 437, 408, 443, 459, 199, 227, 337, 401, 434,
+	392, 316, 415, 416, 284, 391, 258, 185, 292, 453,
+	197, 381, 214, 204, 190, 403, 427, 211, 384, 0,
+	0, 461, 192, 425, 400, 312, 281, 282, 191, 0,
+	365, 235, 256, 225, 332, 422, 423, 224, 462, 201,
+	442, 194, 0, 441, 325, 418, 426, 313, 304, 193,
+	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
+	321, 320, 322, 0, 188, 0, 397, 435, 463, 207,
+	208, 209, 0, 245, 249, 255, 257, 263, 264, 271,
+	290, 336, 358, 356, 362, 0, 413, 430, 438, 445,
+	451, 452, 454, 455, 456, 457, 458, 324, 270, 393,
+	286, 295, 0, 0, 342, 374, 212, 433, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
 	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 181, 195, 290,
-	1418, 362, 252, 459, 439, 435, 0, 0, 228, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 196, 205, 215, 227, 242, 250, 260,
-	265, 268, 273, 274, 277, 282, 300, 305, 306, 307,
-	308, 325, 326, 327, 330, 333, 334, 337, 339, 340,
-	343, 350, 351, 352, 353, 354, 356, 363, 367, 375,
-	376, 377, 378, 379, 381, 382, 386, 387, 388, 389,
-	397, 401, 419, 420, 431, 443, 448, 261, 427, 449,
-	0, 299, 0, 0, 301, 246, 264, 275, 0, 438,
-	398, 200, 369, 253, 189, 218, 203, 225, 240, 243,
-	279, 309, 316, 345, 349, 258, 237, 216, 366, 213,
-	384, 404, 405, 406, 408, 313, 232, 348, 409, 0,
-	297, 410, 411, 271, 0, 0, 0, 0, 0, 0,
-	332, 0, 1299, 0, 0, 0, 0, 0, 0, 236,
-	0, 0, 0, 0, 288, 233, 0, 0, 346, 0,
-	187, 0, 385, 221, 298, 295, 416, 247, 239, 235,
-	220, 272, 304, 344, 403, 338, 0, 292, 0, 0,
-	394, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 278, 219, 186,
-	329, 395, 251, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 210,
-	0, 217, 0, 0, 0, 0, 0, 231, 276, 238,
-	230, 413, 0, 0, 0, 0, 202, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 318, 0, 0, 0, 0, 445,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 284,
-	182, 198, 0, 0, 328, 368, 374, 0, 0, 0,
-	222, 0, 372, 342, 430, 206, 249, 365, 347, 370,
-	0, 0, 371, 293, 418, 360, 428, 446, 447, 229,
-	322, 436, 407, 442, 458, 199, 226, 336, 400, 433,
-	391, 315, 414, 415, 283, 390, 257, 185, 291, 452,
-	197, 380, 214, 204, 190, 402, 426, 211, 383, 0,
-	0, 460, 192, 424, 399, 311, 280, 281, 191, 0,
-	364, 234, 255, 224, 331, 421, 422, 223, 461, 201,
-	441, 194, 0, 440, 324, 417, 425, 312, 303, 193,
-	423, 310, 302, 286, 245, 266, 358, 296, 359, 267,
-	320, 319, 321, 0, 188, 0, 396, 434, 462, 207,
-	208, 209, 0, 244, 248, 254, 256, 262, 263, 270,
-	289, 335, 357, 355, 361, 0, 412, 429, 437, 444,
-	450, 451, 453, 454, 455, 456, 457, 323, 269, 392,
-	285, 294, 0, 0, 341, 373, 212, 432, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 0, 0, 0,
+	475, 476, 477, 478, 479, 480, 481, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 181, 195,
-	290, 0, 362, 252, 459, 439, 435, 0, 0, 228,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 196, 205, 215, 227, 242, 250,
-	260, 265, 268, 273, 274, 277, 282, 300, 305, 306,
-	307, 308, 325, 326, 327, 330, 333, 334, 337, 339,
-	340, 343, 350, 351, 352, 353, 354, 356, 363, 367,
-	375, 376, 377, 378, 379, 381, 382, 386, 387, 388,
-	389, 397, 401, 419, 420, 431, 443, 448, 261, 427,
-	449, 0, 299, 0, 0, 301, 246, 264, 275, 0,
-	438, 398, 200, 369, 253, 189, 218, 203, 225, 240,
-	243, 279, 309, 316, 345, 349, 258, 237, 216, 366,
-	213, 384, 404, 405, 406, 408, 313, 232, 348, 409,
-	0, 297, 410, 411, 271, 0, 0, 0, 0, 0,
-	0, 332, 0, 1297, 0, 0, 0, 0, 0, 0,
-	236, 0, 0, 0, 0, 288, 233, 0, 0, 346,
-	0, 187, 0, 385, 221, 298, 295, 416, 247, 239,
-	235, 220, 272, 304, 344, 403, 338, 0, 292, 0,
-	0, 394, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 278, 219,
-	186, 329, 395, 251, 0, 0, 0, 0, 178, 179,
+	291, 0, 363, 253, 460, 440, 436, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 184, 196, 205, 215, 228, 243, 251,
+	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
+	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
+	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
+	390, 398, 402, 420, 421, 432, 444, 449, 262, 428,
+	450, 0, 220, 300, 0, 0, 302, 247, 265, 276,
+	0, 439, 399, 200, 370, 254, 189, 218, 203, 226,
+	241, 244, 280, 310, 317, 346, 350, 259, 238, 216,
+	367, 213, 385, 405, 406, 407, 409, 314, 233, 349,
+	0, 410, 298, 411, 412, 272, 1585, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 0, 0, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
+	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
+	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
+	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 219, 186, 330, 396, 252, 0, 0, 0, 0,
+	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 0, 217, 0, 0, 0, 0, 0,
+	232, 277, 239, 231, 414, 0, 0, 0, 0, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
+	0, 0, 446, 0, 0, 0, 0, 0, 0, 0,
+	288, 0, 285, 182, 198, 0, 0, 329, 369, 375,
+	0, 0, 0, 223, 0, 373, 343, 431, 206, 250,
+	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
+	447, 448, 230, 323, 437, 408, 443, 459, 199, 227,
+	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
+	185, 292, 453, 197, 381, 214, 204, 190, 403, 427,
+	211, 384, 0, 0, 461, 192, 425, 400, 312, 281,
+	282, 191, 0, 365, 235, 256, 225, 332, 422, 423,
+	224, 462, 201, 442, 194, 0, 441, 325, 418, 426,
+	313, 304, 193, 424, 311, 303, 287, 246, 267, 359,
+	297, 360, 268, 321, 320, 322, 0, 188, 0, 397,
+	435, 463, 207, 208, 209, 0, 245, 249, 255, 257,
+	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
+	430, 438, 445, 451, 452, 454, 455, 456, 457, 458,
+	324, 270, 393, 286, 295, 0, 0, 342, 374, 212,
+	433, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 195, 291, 0, 363, 253, 460, 440, 436,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 196, 205, 215,
+	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
+	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
+	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
+	387, 388, 389, 390, 398, 402, 420, 421, 432, 444,
+	449, 262, 428, 450, 0, 220, 300, 0, 0, 302,
+	247, 265, 276, 0, 439, 399, 200, 370, 254, 189,
+	218, 203, 226, 241, 244, 280, 310, 317, 346, 350,
+	259, 238, 216, 367, 213, 385, 405, 406, 407, 409,
+	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
+	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 1166, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 0, 0, 0,
+	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
+	0, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
+	0, 0, 0, 0, 446, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 182, 198, 0, 0, 329,
+	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
+	206, 250, 366, 348, 371, 0, 0, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 0, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 0, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	0, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 0, 0, 342,
+	374, 212, 433, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 195, 291, 0, 363, 253, 460,
+	440, 436, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 0,
+	0, 302, 247, 265, 276, 0, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
+	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
+	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
+	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 219, 186, 330, 396, 252,
+	0, 0, 0, 0, 178, 179, 180, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 0, 217, 0,
+	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
+	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1059, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 319, 0, 0, 0, 0, 446, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 198, 0,
+	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
+	343, 431, 206, 250, 366, 348, 371, 0, 0, 372,
+	294, 419, 361, 429, 447, 448, 230, 323, 437, 408,
+	443, 459, 199, 227, 337, 401, 434, 392, 316, 415,
+	416, 284, 391, 258, 185, 292, 453, 197, 381, 214,
+	204, 190, 403, 427, 211, 384, 0, 0, 461, 192,
+	425, 400, 312, 281, 282, 191, 0, 365, 235, 256,
+	225, 332, 422, 423, 224, 462, 201, 442, 194, 0,
+	441, 325, 418, 426, 313, 304, 193, 424, 311, 303,
+	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
+	0, 188, 0, 397, 435, 463, 207, 208, 209, 0,
+	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
+	356, 362, 0, 413, 430, 438, 445, 451, 452, 454,
+	455, 456, 457, 458, 324, 270, 393, 286, 295, 0,
+	0, 342, 374, 212, 433, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 465, 466, 467,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 195, 291, 0, 363,
+	253, 460, 440, 436, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+	184, 196, 205, 215, 228, 243, 251, 261, 266, 269,
+	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
+	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
+	420, 421, 432, 444, 449, 262, 428, 450, 0, 220,
+	300, 0, 0, 302, 247, 265, 276, 0, 439, 399,
+	200, 370, 254, 189, 218, 203, 226, 241, 244, 280,
+	310, 317, 346, 350, 259, 238, 216, 367, 213, 385,
+	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
+	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
+	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
+	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
+	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 219, 186, 330,
+	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
+	217, 0, 0, 0, 0, 0, 232, 277, 239, 231,
+	414, 0, 0, 0, 0, 202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 260, 0, 319, 0, 0, 0, 0, 446, 0,
+	0, 0, 0, 0, 0, 0, 288, 0, 285, 182,
+	198, 0, 0, 329, 369, 375, 0, 0, 0, 223,
+	0, 373, 343, 431, 206, 250, 366, 348, 371, 0,
+	0, 372, 294, 419, 361, 429, 447, 448, 230, 323,
+	437, 408, 443, 459, 199, 227, 337, 401, 434, 392,
+	316, 415, 416, 284, 391, 258, 185, 292, 453, 197,
+	381, 214, 204, 190, 403, 427, 211, 384, 0, 0,
+	461, 192, 425, 400, 312, 281, 282, 191, 0, 365,
+	235, 256, 225, 332, 422, 423, 224, 462, 201, 442,
+	194, 0, 441, 325, 418, 426, 313, 304, 193, 424,
+	311, 303, 287, 246, 267, 359, 297, 360, 268, 321,
+	320, 322, 0, 188, 0, 397, 435, 463, 207, 208,
+	209, 0, 245, 249, 255, 257, 263, 264, 271, 290,
+	336, 358, 356, 362, 0, 413, 430, 438, 445, 451,
+	452, 454, 455, 456, 457, 458, 324, 270, 393, 286,
+	295, 0, 0, 342, 374, 212, 433, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 465,
+	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 181, 195, 291,
+	1427, 363, 253, 460, 440, 436, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 183, 184, 196, 205, 215, 228, 243, 251, 261,
+	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
+	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
+	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
+	398, 402, 420, 421, 432, 444, 449, 262, 428, 450,
+	0, 220, 300, 0, 0, 302, 247, 265, 276, 0,
+	439, 399, 200, 370, 254, 189, 218, 203, 226, 241,
+	244, 280, 310, 317, 346, 350, 259, 238, 216, 367,
+	213, 385, 405, 406, 407, 409, 314, 233, 349, 410,
+	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
+	0, 333, 0, 1307, 0, 0, 0, 0, 0, 0,
+	237, 0, 0, 0, 0, 289, 234, 0, 0, 347,
+	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
+	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
+	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 219,
+	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
 	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 217, 0, 0, 0, 0, 0, 231, 276,
-	238, 230, 413, 0, 0, 0, 0, 202, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 0, 318, 0, 0, 0, 0,
-	445, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	284, 182, 198, 0, 0, 328, 368, 374, 0, 0,
-	0, 222, 0, 372, 342, 430, 206, 249, 365, 347,
-	370, 0, 0, 371, 293, 418, 360, 428, 446, 447,
-	229, 322, 436, 407, 442, 458, 199, 226, 336, 400,
-	433, 391, 315, 414, 415, 283, 390, 257, 185, 291,
-	452, 197, 380, 214, 204, 190, 402, 426, 211, 383,
-	0, 0, 460, 192, 424, 399, 311, 280, 281, 191,
-	0, 364, 234, 255, 224, 331, 421, 422, 223, 461,
-	201, 441, 194, 0, 440, 324, 417, 425, 312, 303,
-	193, 423, 310, 302, 286, 245, 266, 358, 296, 359,
-	267, 320, 319, 321, 0, 188, 0, 396, 434, 462,
-	207, 208, 209, 0, 244, 248, 254, 256, 262, 263,
-	270, 289, 335, 357, 355, 361, 0, 412, 429, 437,
-	444, 450, 451, 453, 454, 455, 456, 457, 323, 269,
-	392, 285, 294, 0, 0, 341, 373, 212, 432, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 478, 479, 480, 0, 0,
+	210, 0, 217, 0, 0, 0, 0, 0, 232, 277,
+	239, 231, 414, 0, 0, 0, 0, 202, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 319, 0, 0, 0, 0,
+	446, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 182, 198, 0, 0, 329, 369, 375, 0, 0,
+	0, 223, 0, 373, 343, 431, 206, 250, 366, 348,
+	371, 0, 0, 372, 294, 419, 361, 429, 447, 448,
+	230, 323, 437, 408, 443, 459, 199, 227, 337, 401,
+	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
+	453, 197, 381, 214, 204, 190, 403, 427, 211, 384,
+	0, 0, 461, 192, 425, 400, 312, 281, 282, 191,
+	0, 365, 235, 256, 225, 332, 422, 423, 224, 462,
+	201, 442, 194, 0, 441, 325, 418, 426, 313, 304,
+	193, 424, 311, 303, 287, 246, 267, 359, 297, 360,
+	268, 321, 320, 322, 0, 188, 0, 397, 435, 463,
+	207, 208, 209, 0, 245, 249, 255, 257, 263, 264,
+	271, 290, 336, 358, 356, 362, 0, 413, 430, 438,
+	445, 451, 452, 454, 455, 456, 457, 458, 324, 270,
+	393, 286, 295, 0, 0, 342, 374, 212, 433, 394,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
-	195, 290, 0, 362, 252, 459, 439, 435, 0, 0,
-	228, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 184, 196, 205, 215, 227, 242,
-	250, 260, 265, 268, 273, 274, 277, 282, 300, 305,
-	306, 307, 308, 325, 326, 327, 330, 333, 334, 337,
-	339, 340, 343, 350, 351, 352, 353, 354, 356, 363,
-	367, 375, 376, 377, 378, 379, 381, 382, 386, 387,
-	388, 389, 397, 401, 419, 420, 431, 443, 448, 261,
-	427, 449, 0, 299, 0, 0, 301, 246, 264, 275,
-	0, 438, 398, 200, 369, 253, 189, 218, 203, 225,
-	240, 243, 279, 309, 316, 345, 349, 258, 237, 216,
-	366, 213, 384, 404, 405, 406, 408, 313, 232, 348,
-	409, 0, 297, 410, 411, 271, 0, 0, 0, 0,
-	0, 0, 332, 0, 1295, 0, 0, 0, 0, 0,
-	0, 236, 0, 0, 0, 0, 288, 233, 0, 0,
-	346, 0, 187, 0, 385, 221, 298, 295, 416, 247,
-	239, 235, 220, 272, 304, 344, 403, 338, 0, 292,
-	0, 0, 394, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
-	219, 186, 329, 395, 251, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 210, 0, 217, 0, 0, 0, 0, 0, 231,
-	276, 238, 230, 413, 0, 0, 0, 0, 202, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 318, 0, 0, 0,
-	0, 445, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 284, 182, 198, 0, 0, 328, 368, 374, 0,
-	0, 0, 222, 0, 372, 342, 430, 206, 249, 365,
-	347, 370, 0, 0, 371, 293, 418, 360, 428, 446,
-	447, 229, 322, 436, 407, 442, 458, 199, 226, 336,
-	400, 433, 391, 315, 414, 415, 283, 390, 257, 185,
-	291, 452, 197, 380, 214, 204, 190, 402, 426, 211,
-	383, 0, 0, 460, 192, 424, 399, 311, 280, 281,
-	191, 0, 364, 234, 255, 224, 331, 421, 422, 223,
-	461, 201, 441, 194, 0, 440, 324, 417, 425, 312,
-	303, 193, 423, 310, 302, 286, 245, 266, 358, 296,
-	359, 267, 320, 319, 321, 0, 188, 0, 396, 434,
-	462, 207, 208, 209, 0, 244, 248, 254, 256, 262,
-	263, 270, 289, 335, 357, 355, 361, 0, 412, 429,
-	437, 444, 450, 451, 453, 454, 455, 456, 457, 323,
-	269, 392, 285, 294, 0, 0, 341, 373, 212, 432,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	181, 195, 290, 0, 362, 252, 459, 439, 435, 0,
-	0, 228, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 196, 205, 215, 227,
-	242, 250, 260, 265, 268, 273, 274, 277, 282, 300,
-	305, 306, 307, 308, 325, 326, 327, 330, 333, 334,
-	337, 339, 340, 343, 350, 351, 352, 353, 354, 356,
-	363, 367, 375, 376, 377, 378, 379, 381, 382, 386,
-	387, 388, 389, 397, 401, 419, 420, 431, 443, 448,
-	261, 427, 449, 0, 299, 0, 0, 301, 246, 264,
-	275, 0, 438, 398, 200, 369, 253, 189, 218, 203,
-	225, 240, 243, 279, 309, 316, 345, 349, 258, 237,
-	216, 366, 213, 384, 404, 405, 406, 408, 313, 232,
-	348, 409, 0, 297, 410, 411, 271, 0, 0, 0,
-	0, 0, 0, 332, 0, 1293, 0, 0, 0, 0,
-	0, 0, 236, 0, 0, 0, 0, 288, 233, 0,
-	0, 346, 0, 187, 0, 385, 221, 298, 295, 416,
-	247, 239, 235, 220, 272, 304, 344, 403, 338, 0,
-	292, 0, 0, 394, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	278, 219, 186, 329, 395, 251, 0, 0, 0, 0,
+	195, 291, 0, 363, 253, 460, 440, 436, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 184, 196, 205, 215, 228, 243,
+	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
+	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
+	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
+	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
+	389, 390, 398, 402, 420, 421, 432, 444, 449, 262,
+	428, 450, 0, 220, 300, 0, 0, 302, 247, 265,
+	276, 0, 439, 399, 200, 370, 254, 189, 218, 203,
+	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
+	216, 367, 213, 385, 405, 406, 407, 409, 314, 233,
+	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
+	0, 0, 0, 333, 0, 1305, 0, 0, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
+	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
+	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
+	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 219, 186, 330, 396, 252, 0, 0, 0, 0,
 	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 210, 0, 217, 0, 0, 0, 0, 0,
-	231, 276, 238, 230, 413, 0, 0, 0, 0, 202,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 318, 0, 0,
-	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
-	287, 0, 284, 182, 198, 0, 0, 328, 368, 374,
-	0, 0, 0, 222, 0, 372, 342, 430, 206, 249,
-	365, 347, 370, 0, 0, 371, 293, 418, 360, 428,
-	446, 447, 229, 322, 436, 407, 442, 458, 199, 226,
-	336, 400, 433, 391, 315, 414, 415, 283, 390, 257,
-	185, 291, 452, 197, 380, 214, 204, 190, 402, 426,
-	211, 383, 0, 0, 460, 192, 424, 399, 311, 280,
-	281, 191, 0, 364, 234, 255, 224, 331, 421, 422,
-	223, 461, 201, 441, 194, 0, 440, 324, 417, 425,
-	312, 303, 193, 423, 310, 302, 286, 245, 266, 358,
-	296, 359, 267, 320, 319, 321, 0, 188, 0, 396,
-	434, 462, 207, 208, 209, 0, 244, 248, 254, 256,
-	262, 263, 270, 289, 335, 357, 355, 361, 0, 412,
-	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
-	323, 269, 392, 285, 294, 0, 0, 341, 373, 212,
-	432, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 181, 195, 290, 0, 362, 252, 459, 439, 435,
-	0, 0, 228, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 314, 0, 0, 0, 0, 0,
+	232, 277, 239, 231, 414, 0, 0, 0, 0, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
+	0, 0, 446, 0, 0, 0, 0, 0, 0, 0,
+	288, 0, 285, 182, 198, 0, 0, 329, 369, 375,
+	0, 0, 0, 223, 0, 373, 343, 431, 206, 250,
+	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
+	447, 448, 230, 323, 437, 408, 443, 459, 199, 227,
+	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
+	185, 292, 453, 197, 381, 214, 204, 190, 403, 427,
+	211, 384, 0, 0, 461, 192, 425, 400, 312, 281,
+	282, 191, 0, 365, 235, 256, 225, 332, 422, 423,
+	224, 462, 201, 442, 194, 0, 441, 325, 418, 426,
+	313, 304, 193, 424, 311, 303, 287, 246, 267, 359,
+	297, 360, 268, 321, 320, 322, 0, 188, 0, 397,
+	435, 463, 207, 208, 209, 0, 245, 249, 255, 257,
+	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
+	430, 438, 445, 451, 452, 454, 455, 456, 457, 458,
+	324, 270, 393, 286, 295, 0, 0, 342, 374, 212,
+	433, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 195, 291, 0, 363, 253, 460, 440, 436,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 183, 184, 196, 205, 215,
-	227, 242, 250, 260, 265, 268, 273, 274, 277, 282,
-	300, 305, 306, 307, 308, 325, 326, 327, 330, 333,
-	334, 337, 339, 340, 343, 350, 351, 352, 353, 354,
-	356, 363, 367, 375, 376, 377, 378, 379, 381, 382,
-	386, 387, 388, 389, 397, 401, 419, 420, 431, 443,
-	448, 261, 427, 449, 0, 299, 0, 0, 301, 246,
-	264, 275, 0, 438, 398, 200, 369, 253, 189, 218,
-	203, 225, 240, 243, 279, 309, 316, 345, 349, 258,
-	237, 216, 366, 213, 384, 404, 405, 406, 408, 313,
-	232, 348, 409, 0, 297, 410, 411, 271, 0, 0,
-	0, 0, 0, 0, 332, 0, 1291, 0, 0, 0,
-	0, 0, 0, 236, 0, 0, 0, 0, 288, 233,
-	0, 0, 346, 0, 187, 0, 385, 221, 298, 295,
-	416, 247, 239, 235, 220, 272, 304, 344, 403, 338,
-	0, 292, 0, 0, 394, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 278, 219, 186, 329, 395, 251, 0, 0, 0,
-	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 210, 0, 217, 0, 0, 0, 0,
-	0, 231, 276, 238, 230, 413, 0, 0, 0, 0,
-	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 318, 0,
-	0, 0, 0, 445, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 284, 182, 198, 0, 0, 328, 368,
-	374, 0, 0, 0, 222, 0, 372, 342, 430, 206,
-	249, 365, 347, 370, 0, 0, 371, 293, 418, 360,
-	428, 446, 447, 229, 322, 436, 407, 442, 458, 199,
-	226, 336, 400, 433, 391, 315, 414, 415, 283, 390,
-	257, 185, 291, 452, 197, 380, 214, 204, 190, 402,
-	426, 211, 383, 0, 0, 460, 192, 424, 399, 311,
-	280, 281, 191, 0, 364, 234, 255, 224, 331, 421,
-	422, 223, 461, 201, 441, 194, 0, 440, 324, 417,
-	425, 312, 303, 193, 423, 310, 302, 286, 245, 266,
-	358, 296, 359, 267, 320, 319, 321, 0, 188, 0,
-	396, 434, 462, 207, 208, 209, 0, 244, 248, 254,
-	256, 262, 263, 270, 289, 335, 357, 355, 361, 0,
-	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
-	457, 323, 269, 392, 285, 294, 0, 0, 341, 373,
-	212, 432, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 181, 195, 290, 0, 362, 252, 459, 439,
-	435, 0, 0, 228, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 196, 205,
-	215, 227, 242, 250, 260, 265, 268, 273, 274, 277,
-	282, 300, 305, 306, 307, 308, 325, 326, 327, 330,
-	333, 334, 337, 339, 340, 343, 350, 351, 352, 353,
-	354, 356, 363, 367, 375, 376, 377, 378, 379, 381,
-	382, 386, 387, 388, 389, 397, 401, 419, 420, 431,
-	443, 448, 261, 427, 449, 0, 299, 0, 0, 301,
-	246, 264, 275, 0, 438, 398, 200, 369, 253, 189,
-	218, 203, 225, 240, 243, 279, 309, 316, 345, 349,
-	258, 237, 216, 366, 213, 384, 404, 405, 406, 408,
-	313, 232, 348, 409, 0, 297, 410, 411, 271, 0,
-	0, 0, 0, 0, 0, 332, 0, 1287, 0, 0,
-	0, 0, 0, 0, 236, 0, 0, 0, 0, 288,
-	233, 0, 0, 346, 0, 187, 0, 385, 221, 298,
-	295, 416, 247, 239, 235, 220, 272, 304, 344, 403,
-	338, 0, 292, 0, 0, 394, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 278, 219, 186, 329, 395, 251, 0, 0,
+	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
+	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
+	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
+	387, 388, 389, 390, 398, 402, 420, 421, 432, 444,
+	449, 262, 428, 450, 0, 220, 300, 0, 0, 302,
+	247, 265, 276, 0, 439, 399, 200, 370, 254, 189,
+	218, 203, 226, 241, 244, 280, 310, 317, 346, 350,
+	259, 238, 216, 367, 213, 385, 405, 406, 407, 409,
+	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
+	0, 0, 0, 0, 0, 333, 0, 1303, 0, 0,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
+	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 219, 186, 330, 396, 252, 0, 0,
 	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 210, 0, 217, 0, 0, 0,
-	0, 0, 231, 276, 238, 230, 413, 0, 0, 0,
+	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
 	0, 202, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 318,
-	0, 0, 0, 0, 445, 0, 0, 0, 0, 0,
-	0, 0, 287, 0, 284, 182, 198, 0, 0, 328,
-	368, 374, 0, 0, 0, 222, 0, 372, 342, 430,
-	206, 249, 365, 347, 370, 0, 0, 371, 293, 418,
-	360, 428, 446, 447, 229, 322, 436, 407, 442, 458,
-	199, 226, 336, 400, 433, 391, 315, 414, 415, 283,
-	390, 257, 185, 291, 452, 197, 380, 214, 204, 190,
-	402, 426, 211, 383, 0, 0, 460, 192, 424, 399,
-	311, 280, 281, 191, 0, 364, 234, 255, 224, 331,
-	421, 422, 223, 461, 201, 441, 194, 0, 440, 324,
-	417, 425, 312, 303, 193, 423, 310, 302, 286, 245,
-	266, 358, 296, 359, 267, 320, 319, 321, 0, 188,
-	0, 396, 434, 462, 207, 208, 209, 0, 244, 248,
-	254, 256, 262, 263, 270, 289, 335, 357, 355, 361,
-	0, 412, 429, 437, 444, 450, 451, 453, 454, 455,
-	456, 457, 323, 269, 392, 285, 294, 0, 0, 341,
-	373, 212, 432, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 181, 195, 290, 0, 362, 252, 459,
-	439, 435, 0, 0, 228, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 314, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
+	0, 0, 0, 0, 446, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 182, 198, 0, 0, 329,
+	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
+	206, 250, 366, 348, 371, 0, 0, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 0, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 0, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	0, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 0, 0, 342,
+	374, 212, 433, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 195, 291, 0, 363, 253, 460,
+	440, 436, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 183, 184, 196,
-	205, 215, 227, 242, 250, 260, 265, 268, 273, 274,
-	277, 282, 300, 305, 306, 307, 308, 325, 326, 327,
-	330, 333, 334, 337, 339, 340, 343, 350, 351, 352,
-	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
-	381, 382, 386, 387, 388, 389, 397, 401, 419, 420,
-	431, 443, 448, 261, 427, 449, 0, 299, 0, 0,
-	301, 246, 264, 275, 0, 438, 398, 200, 369, 253,
-	189, 218, 203, 225, 240, 243, 279, 309, 316, 345,
-	349, 258, 237, 216, 366, 213, 384, 404, 405, 406,
-	408, 313, 232, 348, 409, 0, 297, 410, 411, 271,
-	0, 0, 0, 0, 0, 0, 332, 0, 1285, 0,
-	0, 0, 0, 0, 0, 236, 0, 0, 0, 0,
-	288, 233, 0, 0, 346, 0, 187, 0, 385, 221,
-	298, 295, 416, 247, 239, 235, 220, 272, 304, 344,
-	403, 338, 0, 292, 0, 0, 394, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 278, 219, 186, 329, 395, 251, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 210, 0, 217, 0, 0,
-	0, 0, 0, 231, 276, 238, 230, 413, 0, 0,
-	0, 0, 202, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
-	318, 0, 0, 0, 0, 445, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 284, 182, 198, 0, 0,
-	328, 368, 374, 0, 0, 0, 222, 0, 372, 342,
-	430, 206, 249, 365, 347, 370, 0, 0, 371, 293,
-	418, 360, 428, 446, 447, 229, 322, 436, 407, 442,
-	458, 199, 226, 336, 400, 433, 391, 315, 414, 415,
-	283, 390, 257, 185, 291, 452, 197, 380, 214, 204,
-	190, 402, 426, 211, 383, 0, 0, 460, 192, 424,
-	399, 311, 280, 281, 191, 0, 364, 234, 255, 224,
-	331, 421, 422, 223, 461, 201, 441, 194, 0, 440,
-	324, 417, 425, 312, 303, 193, 423, 310, 302, 286,
-	245, 266, 358, 296, 359, 267, 320, 319, 321, 0,
-	188, 0, 396, 434, 462, 207, 208, 209, 0, 244,
-	248, 254, 256, 262, 263, 270, 289, 335, 357, 355,
-	361, 0, 412, 429, 437, 444, 450, 451, 453, 454,
-	455, 456, 457, 323, 269, 392, 285, 294, 0, 0,
-	341, 373, 212, 432, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 181, 195, 290, 0, 362, 252,
-	459, 439, 435, 0, 0, 228, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	196, 205, 215, 227, 242, 250, 260, 265, 268, 273,
-	274, 277, 282, 300, 305, 306, 307, 308, 325, 326,
-	327, 330, 333, 334, 337, 339, 340, 343, 350, 351,
-	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
-	379, 381, 382, 386, 387, 388, 389, 397, 401, 419,
-	420, 431, 443, 448, 261, 427, 449, 0, 299, 0,
-	0, 301, 246, 264, 275, 0, 438, 398, 200, 369,
-	253, 189, 218, 203, 225, 240, 243, 279, 309, 316,
-	345, 349, 258, 237, 216, 366, 213, 384, 404, 405,
-	406, 408, 313, 232, 348, 409, 0, 297, 410, 411,
-	271, 0, 0, 0, 0, 0, 0, 332, 0, 1283,
-	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
-	0, 288, 233, 0, 0, 346, 0, 187, 0, 385,
-	221, 298, 295, 416, 247, 239, 235, 220, 272, 304,
-	344, 403, 338, 0, 292, 0, 0, 394, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 278, 219, 186, 329, 395, 251,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 0,
+	0, 302, 247, 265, 276, 0, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
+	272, 0, 0, 0, 0, 0, 0, 333, 0, 1301,
+	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
+	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
+	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 219, 186, 330, 396, 252,
 	0, 0, 0, 0, 178, 179, 180, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 210, 0, 217, 0,
-	0, 0, 0, 0, 231, 276, 238, 230, 413, 0,
+	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
 	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 318, 0, 0, 0, 0, 445, 0, 0, 0,
-	0, 0, 0, 0, 287, 0, 284, 182, 198, 0,
-	0, 328, 368, 374, 0, 0, 0, 222, 0, 372,
-	342, 430, 206, 249, 365, 347, 370, 0, 0, 371,
-	293, 418, 360, 428, 446, 447, 229, 322, 436, 407,
-	442, 458, 199, 226, 336, 400, 433, 391, 315, 414,
-	415, 283, 390, 257, 185, 291, 452, 197, 380, 214,
-	204, 190, 402, 426, 211, 383, 0, 0, 460, 192,
-	424, 399, 311, 280, 281, 191, 0, 364, 234, 255,
-	224, 331, 421, 422, 223, 461, 201, 441, 194, 0,
-	440, 324, 417, 425, 312, 303, 193, 423, 310, 302,
-	286, 245, 266, 358, 296, 359, 267, 320, 319, 321,
-	0, 188, 0, 396, 434, 462, 207, 208, 209, 0,
-	244, 248, 254, 256, 262, 263, 270, 289, 335, 357,
-	355, 361, 0, 412, 429, 437, 444, 450, 451, 453,
-	454, 455, 456, 457, 323, 269, 392, 285, 294, 0,
-	0, 341, 373, 212, 432, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 181, 195, 290, 0, 362,
-	252, 459, 439, 435, 0, 0, 228, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 314, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 319, 0, 0, 0, 0, 446, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 198, 0,
+	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
+	343, 431, 206, 250, 366, 348, 371, 0, 0, 372,
+	294, 419, 361, 429, 447, 448, 230, 323, 437, 408,
+	443, 459, 199, 227, 337, 401, 434, 392, 316, 415,
+	416, 284, 391, 258, 185, 292, 453, 197, 381, 214,
+	204, 190, 403, 427, 211, 384, 0, 0, 461, 192,
+	425, 400, 312, 281, 282, 191, 0, 365, 235, 256,
+	225, 332, 422, 423, 224, 462, 201, 442, 194, 0,
+	441, 325, 418, 426, 313, 304, 193, 424, 311, 303,
+	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
+	0, 188, 0, 397, 435, 463, 207, 208, 209, 0,
+	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
+	356, 362, 0, 413, 430, 438, 445, 451, 452, 454,
+	455, 456, 457, 458, 324, 270, 393, 286, 295, 0,
+	0, 342, 374, 212, 433, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 465, 466, 467,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 195, 291, 0, 363,
+	253, 460, 440, 436, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 196, 205, 215, 227, 242, 250, 260, 265, 268,
-	273, 274, 277, 282, 300, 305, 306, 307, 308, 325,
-	326, 327, 330, 333, 334, 337, 339, 340, 343, 350,
-	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
-	378, 379, 381, 382, 386, 387, 388, 389, 397, 401,
-	419, 420, 431, 443, 448, 261, 427, 449, 0, 299,
-	0, 0, 301, 246, 264, 275, 0, 438, 398, 200,
-	369, 253, 189, 218, 203, 225, 240, 243, 279, 309,
-	316, 345, 349, 258, 237, 216, 366, 213, 384, 404,
-	405, 406, 408, 313, 232, 348, 409, 0, 297, 410,
-	411, 271, 0, 0, 0, 0, 0, 0, 332, 0,
-	0, 0, 0, 0, 0, 0, 0, 236, 0, 0,
-	0, 0, 288, 233, 0, 0, 346, 0, 187, 0,
-	385, 221, 298, 295, 416, 247, 239, 235, 220, 272,
-	304, 344, 403, 338, 0, 292, 0, 0, 394, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 278, 219, 186, 329, 395,
-	251, 0, 1260, 0, 0, 178, 179, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 210, 0, 217,
-	0, 0, 0, 0, 0, 231, 276, 238, 230, 413,
-	0, 0, 0, 0, 202, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 318, 0, 0, 0, 0, 445, 0, 0,
-	0, 0, 0, 0, 0, 287, 0, 284, 182, 198,
-	0, 0, 328, 368, 374, 0, 0, 0, 222, 0,
-	372, 342, 430, 206, 249, 365, 347, 370, 0, 0,
-	371, 293, 418, 360, 428, 446, 447, 229, 322, 436,
-	407, 442, 458, 199, 226, 336, 400, 433, 391, 315,
-	414, 415, 283, 390, 257, 185, 291, 452, 197, 380,
-	214, 204, 190, 402, 426, 211, 383, 0, 0, 460,
-	192, 424, 399, 311, 280, 281, 191, 0, 364, 234,
-	255, 224, 331, 421, 422, 223, 461, 201, 441, 194,
-	0, 440, 324, 417, 425, 312, 303, 193, 423, 310,
-	302, 286, 245, 266, 358, 296, 359, 267, 320, 319,
-	321, 0, 188, 0, 396, 434, 462, 207, 208, 209,
-	0, 244, 248, 254, 256, 262, 263, 270, 289, 335,
-	357, 355, 361, 0, 412, 429, 437, 444, 450, 451,
-	453, 454, 455, 456, 457, 323, 269, 392, 285, 294,
-	0, 0, 341, 373, 212, 432, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 181, 195, 290, 0,
-	362, 252, 459, 439, 435, 0, 0, 228, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 196, 205, 215, 227, 242, 250, 260, 265,
-	268, 273, 274, 277, 282, 300, 305, 306, 307, 308,
-	325, 326, 327, 330, 333, 334, 337, 339, 340, 343,
-	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
-	377, 378, 379, 381, 382, 386, 387, 388, 389, 397,
-	401, 419, 420, 431, 443, 448, 261, 427, 449, 0,
-	299, 0, 0, 301, 246, 264, 275, 0, 438, 398,
-	200, 369, 253, 189, 218, 203, 225, 240, 243, 279,
-	309, 316, 345, 349, 258, 237, 216, 366, 213, 384,
-	404, 405, 406, 408, 313, 232, 348, 409, 0, 297,
-	410, 411, 271, 0, 0, 0, 0, 0, 0, 332,
-	0, 0, 0, 0, 0, 0, 0, 1163, 236, 0,
-	0, 0, 0, 288, 233, 0, 0, 346, 0, 187,
-	0, 385, 221, 298, 295, 416, 247, 239, 235, 220,
-	272, 304, 344, 403, 338, 0, 292, 0, 0, 394,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 278, 219, 186, 329,
-	395, 251, 0, 0, 0, 0, 178, 179, 180, 0,
+	184, 196, 205, 215, 228, 243, 251, 261, 266, 269,
+	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
+	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
+	420, 421, 432, 444, 449, 262, 428, 450, 0, 220,
+	300, 0, 0, 302, 247, 265, 276, 0, 439, 399,
+	200, 370, 254, 189, 218, 203, 226, 241, 244, 280,
+	310, 317, 346, 350, 259, 238, 216, 367, 213, 385,
+	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
+	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
+	0, 1299, 0, 0, 0, 0, 0, 0, 237, 0,
+	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
+	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
+	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 219, 186, 330,
+	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	217, 0, 0, 0, 0, 0, 231, 276, 238, 230,
-	413, 0, 0, 0, 0, 202, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 318, 0, 0, 0, 0, 445, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 284, 182,
-	198, 0, 0, 328, 368, 374, 0, 0, 0, 222,
-	0, 372, 342, 430, 206, 249, 365, 347, 370, 0,
-	0, 371, 293, 418, 360, 428, 446, 447, 229, 322,
-	436, 407, 442, 458, 199, 226, 336, 400, 433, 391,
-	315, 414, 415, 283, 390, 257, 185, 291, 452, 197,
-	380, 214, 204, 190, 402, 426, 211, 383, 0, 0,
-	460, 192, 424, 399, 311, 280, 281, 191, 0, 364,
-	234, 255, 224, 331, 421, 422, 223, 461, 201, 441,
-	194, 0, 440, 324, 417, 425, 312, 303, 193, 423,
-	310, 302, 286, 245, 266, 358, 296, 359, 267, 320,
-	319, 321, 0, 188, 0, 396, 434, 462, 207, 208,
-	209, 0, 244, 248, 254, 256, 262, 263, 270, 289,
-	335, 357, 355, 361, 0, 412, 429, 437, 444, 450,
-	451, 453, 454, 455, 456, 457, 323, 269, 392, 285,
-	294, 0, 0, 341, 373, 212, 432, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 181, 195, 290,
-	0, 362, 252, 459, 439, 435, 0, 0, 228, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 196, 205, 215, 227, 242, 250, 260,
-	265, 268, 273, 274, 277, 282, 300, 305, 306, 307,
-	308, 325, 326, 327, 330, 333, 334, 337, 339, 340,
-	343, 350, 351, 352, 353, 354, 356, 363, 367, 375,
-	376, 377, 378, 379, 381, 382, 386, 387, 388, 389,
-	397, 401, 419, 420, 431, 443, 448, 261, 427, 449,
-	0, 299, 0, 0, 301, 246, 264, 275, 0, 438,
-	398, 200, 369, 253, 189, 218, 203, 225, 240, 243,
-	279, 309, 316, 345, 349, 258, 237, 216, 366, 213,
-	384, 404, 405, 406, 408, 313, 232, 348, 409, 0,
-	297, 410, 411, 271, 0, 0, 0, 0, 0, 0,
-	332, 0, 0, 0, 0, 0, 0, 0, 0, 236,
-	0, 0, 0, 0, 288, 233, 0, 0, 346, 0,
-	187, 0, 385, 221, 298, 295, 416, 247, 239, 235,
-	220, 272, 304, 344, 403, 338, 0, 292, 0, 0,
-	394, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 278, 219, 186,
-	329, 395, 251, 0, 0, 0, 0, 178, 179, 180,
-	0, 1003, 0, 0, 0, 0, 0, 0, 0, 210,
-	0, 217, 0, 0, 0, 0, 0, 231, 276, 238,
-	230, 413, 0, 0, 0, 0, 202, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 318, 0, 0, 0, 0, 445,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 284,
-	182, 198, 0, 0, 328, 368, 374, 0, 0, 0,
-	222, 0, 372, 342, 430, 206, 249, 365, 347, 370,
-	0, 0, 371, 293, 418, 360, 428, 446, 447, 229,
-	322, 436, 407, 442, 458, 199, 226, 336, 400, 433,
-	391, 315, 414, 415, 283, 390, 257, 185, 291, 452,
-	197, 380, 214, 204, 190, 402, 426, 211, 383, 0,
-	0, 460, 192, 424, 399, 311, 280, 281, 191, 0,
-	364, 234, 255, 224, 331, 421, 422, 223, 461, 201,
-	441, 194, 0, 440, 324, 417, 425, 312, 303, 193,
-	423, 310, 302, 286, 245, 266, 358, 296, 359, 267,
-	320, 319, 321, 0, 188, 0, 396, 434, 462, 207,
-	208, 209, 0, 244, 248, 254, 256, 262, 263, 270,
-	289, 335, 357, 355, 361, 0, 412, 429, 437, 444,
-	450, 451, 453, 454, 455, 456, 457, 323, 269, 392,
-	285, 294, 0, 0, 341, 373, 212, 432, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 181, 195,
-	290, 0, 362, 252, 459, 439, 435, 0, 0, 228,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 196, 205, 215, 227, 242, 250,
-	260, 265, 268, 273, 274, 277, 282, 300, 305, 306,
-	307, 308, 325, 326, 327, 330, 333, 334, 337, 339,
-	340, 343, 350, 351, 352, 353, 354, 356, 363, 367,
-	375, 376, 377, 378, 379, 381, 382, 386, 387, 388,
-	389, 397, 401, 419, 420, 431, 443, 448, 261, 427,
-	449, 0, 299, 0, 0, 301, 246, 264, 275, 0,
-	438, 398, 200, 369, 253, 189, 218, 203, 225, 240,
-	243, 279, 309, 316, 345, 349, 258, 237, 216, 366,
-	213, 384, 404, 405, 406, 408, 313, 232, 348, 409,
-	0, 297, 410, 411, 271, 0, 0, 0, 0, 0,
-	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
-	236, 0, 0, 0, 0, 288, 233, 0, 0, 346,
-	0, 187, 0, 385, 221, 298, 295, 416, 247, 239,
-	235, 220, 272, 304, 344, 403, 338, 0, 292, 0,
-	0, 394, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 278, 219,
-	186, 329, 395, 251, 0, 0, 0, 0, 178, 179,
+	217, 0, 0, 0, 0, 0, 232, 277, 239, 231,
+	414, 0, 0, 0, 0, 202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 260, 0, 319, 0, 0, 0, 0, 446, 0,
+	0, 0, 0, 0, 0, 0, 288, 0, 285, 182,
+	198, 0, 0, 329, 369, 375, 0, 0, 0, 223,
+	0, 373, 343, 431, 206, 250, 366, 348, 371, 0,
+	0, 372, 294, 419, 361, 429, 447, 448, 230, 323,
+	437, 408, 443, 459, 199, 227, 337, 401, 434, 392,
+	316, 415, 416, 284, 391, 258, 185, 292, 453, 197,
+	381, 214, 204, 190, 403, 427, 211, 384, 0, 0,
+	461, 192, 425, 400, 312, 281, 282, 191, 0, 365,
+	235, 256, 225, 332, 422, 423, 224, 462, 201, 442,
+	194, 0, 441, 325, 418, 426, 313, 304, 193, 424,
+	311, 303, 287, 246, 267, 359, 297, 360, 268, 321,
+	320, 322, 0, 188, 0, 397, 435, 463, 207, 208,
+	209, 0, 245, 249, 255, 257, 263, 264, 271, 290,
+	336, 358, 356, 362, 0, 413, 430, 438, 445, 451,
+	452, 454, 455, 456, 457, 458, 324, 270, 393, 286,
+	295, 0, 0, 342, 374, 212, 433, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 465,
+	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 181, 195, 291,
+	0, 363, 253, 460, 440, 436, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 183, 184, 196, 205, 215, 228, 243, 251, 261,
+	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
+	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
+	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
+	398, 402, 420, 421, 432, 444, 449, 262, 428, 450,
+	0, 220, 300, 0, 0, 302, 247, 265, 276, 0,
+	439, 399, 200, 370, 254, 189, 218, 203, 226, 241,
+	244, 280, 310, 317, 346, 350, 259, 238, 216, 367,
+	213, 385, 405, 406, 407, 409, 314, 233, 349, 410,
+	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
+	0, 333, 0, 1295, 0, 0, 0, 0, 0, 0,
+	237, 0, 0, 0, 0, 289, 234, 0, 0, 347,
+	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
+	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
+	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 219,
+	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
 	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 217, 0, 0, 0, 0, 0, 231, 276,
-	238, 230, 413, 0, 0, 0, 0, 202, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 0, 318, 0, 0, 0, 0,
-	445, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	284, 182, 198, 0, 0, 328, 368, 374, 0, 0,
-	0, 222, 0, 372, 342, 430, 206, 249, 365, 347,
-	370, 0, 0, 371, 293, 418, 360, 428, 446, 447,
-	229, 322, 436, 407, 442, 458, 199, 226, 336, 400,
-	433, 391, 315, 414, 415, 283, 390, 257, 185, 291,
-	452, 197, 380, 214, 204, 190, 402, 426, 211, 383,
-	0, 0, 460, 192, 424, 399, 311, 280, 281, 191,
-	0, 364, 234, 255, 224, 331, 421, 422, 223, 461,
-	201, 441, 194, 0, 440, 324, 417, 425, 312, 303,
-	193, 423, 310, 302, 286, 245, 266, 358, 296, 359,
-	267, 320, 319, 321, 0, 188, 0, 396, 434, 462,
-	207, 208, 209, 0, 244, 248, 254, 256, 262, 263,
-	270, 289, 335, 357, 355, 361, 0, 412, 429, 437,
-	444, 450, 451, 453, 454, 455, 456, 457, 323, 269,
-	392, 285, 294, 0, 0, 341, 373, 212, 432, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 478, 479, 480, 0, 0,
+	210, 0, 217, 0, 0, 0, 0, 0, 232, 277,
+	239, 231, 414, 0, 0, 0, 0, 202, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 319, 0, 0, 0, 0,
+	446, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 182, 198, 0, 0, 329, 369, 375, 0, 0,
+	0, 223, 0, 373, 343, 431, 206, 250, 366, 348,
+	371, 0, 0, 372, 294, 419, 361, 429, 447, 448,
+	230, 323, 437, 408, 443, 459, 199, 227, 337, 401,
+	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
+	453, 197, 381, 214, 204, 190, 403, 427, 211, 384,
+	0, 0, 461, 192, 425, 400, 312, 281, 282, 191,
+	0, 365, 235, 256, 225, 332, 422, 423, 224, 462,
+	201, 442, 194, 0, 441, 325, 418, 426, 313, 304,
+	193, 424, 311, 303, 287, 246, 267, 359, 297, 360,
+	268, 321, 320, 322, 0, 188, 0, 397, 435, 463,
+	207, 208, 209, 0, 245, 249, 255, 257, 263, 264,
+	271, 290, 336, 358, 356, 362, 0, 413, 430, 438,
+	445, 451, 452, 454, 455, 456, 457, 458, 324, 270,
+	393, 286, 295, 0, 0, 342, 374, 212, 433, 394,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
-	195, 290, 0, 362, 252, 459, 439, 435, 0, 0,
-	228, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 314, 0, 0, 0, 0, 0, 0, 638,
-	0, 0, 0, 183, 184, 196, 205, 215, 227, 242,
-	250, 260, 265, 268, 273, 274, 277, 282, 300, 305,
-	306, 307, 308, 325, 326, 327, 330, 333, 334, 337,
-	339, 340, 343, 350, 351, 352, 353, 354, 356, 363,
-	367, 375, 376, 377, 378, 379, 381, 382, 386, 387,
-	388, 389, 397, 401, 419, 420, 431, 443, 448, 261,
-	427, 449, 0, 299, 0, 0, 301, 246, 264, 275,
-	0, 438, 398, 200, 369, 253, 189, 218, 203, 225,
-	240, 243, 279, 309, 316, 345, 349, 258, 237, 216,
-	366, 213, 384, 404, 405, 406, 408, 313, 232, 348,
-	409, 0, 297, 410, 411, 271, 0, 0, 0, 0,
-	0, 0, 332, 0, 0, 0, 0, 0, 0, 0,
-	0, 236, 0, 0, 0, 0, 288, 233, 0, 0,
-	346, 0, 187, 0, 385, 221, 298, 295, 416, 247,
-	239, 235, 220, 272, 304, 344, 403, 338, 0, 292,
-	0, 0, 394, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
-	219, 186, 329, 395, 251, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 210, 0, 217, 0, 0, 0, 0, 0, 231,
-	276, 238, 230, 413, 0, 0, 0, 0, 202, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 536, 0, 259, 0, 318, 0, 0, 0,
-	0, 445, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 284, 182, 198, 0, 0, 328, 368, 374, 0,
-	0, 0, 222, 0, 372, 342, 430, 206, 249, 365,
-	347, 370, 0, 0, 371, 293, 418, 360, 428, 446,
-	447, 229, 322, 436, 407, 442, 458, 199, 226, 336,
-	400, 433, 391, 315, 414, 415, 283, 390, 257, 185,
-	291, 452, 197, 380, 214, 204, 190, 402, 426, 211,
-	383, 0, 0, 460, 192, 424, 399, 311, 280, 281,
-	191, 0, 364, 234, 255, 224, 331, 421, 422, 223,
-	461, 201, 441, 194, 0, 440, 324, 417, 425, 312,
-	303, 193, 423, 310, 302, 286, 245, 266, 358, 296,
-	359, 267, 320, 319, 321, 0, 188, 0, 396, 434,
-	462, 207, 208, 209, 0, 244, 248, 254, 256, 262,
-	263, 270, 289, 335, 357, 355, 361, 0, 412, 429,
-	437, 444, 450, 451, 453, 454, 455, 456, 457, 323,
-	269, 392, 285, 294, 0, 0, 341, 373, 212, 432,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	181, 195, 290, 0, 362, 252, 459, 439, 435, 0,
-	0, 228, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 196, 205, 215, 227,
-	242, 250, 260, 265, 268, 273, 274, 277, 282, 300,
-	305, 306, 307, 308, 325, 326, 327, 330, 333, 334,
-	337, 339, 340, 343, 350, 351, 352, 353, 354, 356,
-	363, 367, 375, 376, 377, 378, 379, 381, 382, 386,
-	387, 388, 389, 397, 401, 419, 420, 431, 443, 448,
-	535, 427, 449, 0, 299, 0, 0, 301, 246, 264,
-	275, 0, 438, 398, 200, 369, 253, 189, 218, 203,
-	225, 240, 243, 279, 309, 316, 345, 349, 258, 237,
-	216, 366, 213, 384, 404, 405, 406, 408, 313, 232,
-	348, 409, 0, 297, 410, 411, 271, 0, 0, 0,
-	0, 0, 0, 332, 0, 0, 0, 0, 0, 0,
-	0, 0, 236, 0, 0, 0, 0, 288, 233, 0,
-	0, 346, 0, 187, 0, 385, 221, 298, 295, 416,
-	247, 239, 235, 220, 272, 304, 344, 403, 338, 0,
-	292, 0, 0, 394, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	278, 219, 186, 329, 395, 251, 0, 0, 0, 0,
+	195, 291, 0, 363, 253, 460, 440, 436, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 184, 196, 205, 215, 228, 243,
+	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
+	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
+	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
+	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
+	389, 390, 398, 402, 420, 421, 432, 444, 449, 262,
+	428, 450, 0, 220, 300, 0, 0, 302, 247, 265,
+	276, 0, 439, 399, 200, 370, 254, 189, 218, 203,
+	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
+	216, 367, 213, 385, 405, 406, 407, 409, 314, 233,
+	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
+	0, 0, 0, 333, 0, 1293, 0, 0, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
+	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
+	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
+	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 219, 186, 330, 396, 252, 0, 0, 0, 0,
 	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 210, 0, 217, 0, 0, 0, 0, 0,
-	231, 276, 238, 230, 413, 0, 0, 0, 0, 202,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 318, 0, 0,
-	483, 0, 445, 0, 0, 0, 0, 0, 0, 0,
-	287, 0, 284, 182, 198, 0, 0, 328, 368, 374,
-	0, 0, 0, 222, 0, 372, 342, 430, 206, 249,
-	365, 347, 370, 0, 0, 371, 293, 418, 360, 428,
-	446, 447, 229, 322, 436, 407, 442, 458, 199, 226,
-	336, 400, 433, 391, 315, 414, 415, 283, 390, 257,
-	185, 291, 452, 197, 380, 214, 204, 190, 402, 426,
-	211, 383, 0, 0, 460, 192, 424, 399, 311, 280,
-	281, 191, 0, 364, 234, 255, 224, 331, 421, 422,
-	223, 461, 201, 441, 194, 0, 440, 324, 417, 425,
-	312, 303, 193, 423, 310, 302, 286, 245, 266, 358,
-	296, 359, 267, 320, 319, 321, 0, 188, 0, 396,
-	434, 462, 207, 208, 209, 0, 244, 248, 254, 256,
-	262, 263, 270, 289, 335, 357, 355, 361, 0, 412,
-	429, 437, 444, 450, 451, 453, 454, 455, 456, 457,
-	323, 269, 392, 285, 294, 0, 0, 341, 373, 212,
-	432, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 181, 195, 290, 0, 362, 252, 459, 439, 435,
-	0, 0, 228, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 314, 0, 0, 0, 0, 0,
+	232, 277, 239, 231, 414, 0, 0, 0, 0, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
+	0, 0, 446, 0, 0, 0, 0, 0, 0, 0,
+	288, 0, 285, 182, 198, 0, 0, 329, 369, 375,
+	0, 0, 0, 223, 0, 373, 343, 431, 206, 250,
+	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
+	447, 448, 230, 323, 437, 408, 443, 459, 199, 227,
+	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
+	185, 292, 453, 197, 381, 214, 204, 190, 403, 427,
+	211, 384, 0, 0, 461, 192, 425, 400, 312, 281,
+	282, 191, 0, 365, 235, 256, 225, 332, 422, 423,
+	224, 462, 201, 442, 194, 0, 441, 325, 418, 426,
+	313, 304, 193, 424, 311, 303, 287, 246, 267, 359,
+	297, 360, 268, 321, 320, 322, 0, 188, 0, 397,
+	435, 463, 207, 208, 209, 0, 245, 249, 255, 257,
+	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
+	430, 438, 445, 451, 452, 454, 455, 456, 457, 458,
+	324, 270, 393, 286, 295, 0, 0, 342, 374, 212,
+	433, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 195, 291, 0, 363, 253, 460, 440, 436,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 183, 184, 196, 205, 215,
-	227, 242, 250, 260, 265, 268, 273, 274, 277, 282,
-	300, 305, 306, 307, 308, 325, 326, 327, 330, 333,
-	334, 337, 339, 340, 343, 350, 351, 352, 353, 354,
-	356, 363, 367, 375, 376, 377, 378, 379, 381, 382,
-	386, 387, 388, 389, 397, 401, 419, 420, 431, 443,
-	448, 261, 427, 449, 0, 299, 0, 0, 301, 246,
-	264, 275, 0, 438, 398, 200, 369, 253, 189, 218,
-	203, 225, 240, 243, 279, 309, 316, 345, 349, 258,
-	237, 216, 366, 213, 384, 404, 405, 406, 408, 313,
-	232, 348, 409, 0, 297, 410, 411, 271, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 0, 0, 0,
-	0, 0, 0, 236, 0, 0, 0, 0, 288, 233,
-	0, 0, 346, 0, 187, 0, 385, 221, 298, 295,
-	416, 247, 239, 235, 220, 272, 304, 344, 403, 338,
-	0, 292, 0, 0, 394, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 278, 219, 186, 329, 395, 251, 0, 0, 0,
-	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 210, 0, 217, 0, 0, 0, 0,
-	0, 231, 276, 238, 230, 413, 0, 0, 0, 0,
-	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 318, 0,
-	0, 0, 0, 445, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 284, 182, 198, 0, 0, 328, 368,
-	374, 0, 0, 0, 222, 0, 372, 342, 430, 206,
-	249, 365, 347, 370, 0, 0, 371, 293, 418, 360,
-	428, 446, 447, 229, 322, 436, 407, 442, 458, 199,
-	226, 336, 400, 433, 391, 315, 414, 415, 283, 390,
-	257, 185, 291, 452, 197, 380, 214, 204, 190, 402,
-	426, 211, 383, 0, 0, 460, 192, 424, 399, 311,
-	280, 281, 191, 0, 364, 234, 255, 224, 331, 421,
-	422, 223, 461, 201, 441, 194, 0, 440, 324, 417,
-	425, 312, 303, 193, 423, 310, 302, 286, 245, 266,
-	358, 296, 359, 267, 320, 319, 321, 0, 188, 0,
-	396, 434, 462, 207, 208, 209, 0, 244, 248, 254,
-	256, 262, 263, 270, 289, 335, 357, 355, 361, 0,
-	412, 429, 437, 444, 450, 451, 453, 454, 455, 456,
-	457, 323, 269, 392, 285, 294, 0, 0, 341, 373,
-	212, 432, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 463, 464, 465, 466, 467, 468, 469,
+	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
+	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
+	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
+	387, 388, 389, 390, 398, 402, 420, 421, 432, 444,
+	449, 262, 428, 450, 0, 220, 300, 0, 0, 302,
+	247, 265, 276, 0, 439, 399, 200, 370, 254, 189,
+	218, 203, 226, 241, 244, 280, 310, 317, 346, 350,
+	259, 238, 216, 367, 213, 385, 405, 406, 407, 409,
+	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
+	0, 0, 0, 0, 0, 333, 0, 1291, 0, 0,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
+	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 0, 0, 0,
+	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
+	0, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
+	0, 0, 0, 0, 446, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 182, 198, 0, 0, 329,
+	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
+	206, 250, 366, 348, 371, 0, 0, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 0, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 0, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	0, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 0, 0, 342,
+	374, 212, 433, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 465, 466, 467, 468, 469,
 	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 181, 195, 290, 0, 362, 252, 459, 439,
-	435, 0, 0, 228, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 196, 205,
-	215, 227, 242, 250, 260, 265, 268, 273, 274, 277,
-	282, 300, 305, 306, 307, 308, 325, 326, 327, 330,
-	333, 334, 337, 339, 340, 343, 350, 351, 352, 353,
-	354, 356, 363, 367, 375, 376, 377, 378, 379, 381,
-	382, 386, 387, 388, 389, 397, 401, 419, 420, 431,
-	443, 448, 261, 427, 449, 0, 299, 0, 0, 301,
-	246, 264, 275, 0, 438, 398, 200, 369, 253, 189,
-	218, 203, 225, 240, 243, 279, 309, 316, 345, 349,
-	258, 237, 216, 366, 213, 384, 404, 405, 406, 408,
-	313, 232, 348, 0, 0, 297, 410, 411, 271,
+	480, 481, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 195, 291, 0, 363, 253, 460,
+	440, 436, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 262, 428, 450, 0, 220, 300, 0,
+	0, 302, 247, 265, 276, 0, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
+	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
+	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
+	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 219, 186, 330, 396, 252,
+	0, 1268, 0, 0, 178, 179, 180, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 0, 217, 0,
+	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
+	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 319, 0, 0, 0, 0, 446, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 198, 0,
+	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
+	343, 431, 206, 250, 366, 348, 371, 0, 0, 372,
+	294, 419, 361, 429, 447, 448, 230, 323, 437, 408,
+	443, 459, 199, 227, 337, 401, 434, 392, 316, 415,
+	416, 284, 391, 258, 185, 292, 453, 197, 381, 214,
+	204, 190, 403, 427, 211, 384, 0, 0, 461, 192,
+	425, 400, 312, 281, 282, 191, 0, 365, 235, 256,
+	225, 332, 422, 423, 224, 462, 201, 442, 194, 0,
+	441, 325, 418, 426, 313, 304, 193, 424, 311, 303,
+	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
+	0, 188, 0, 397, 435, 463, 207, 208, 209, 0,
+	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
+	356, 362, 0, 413, 430, 438, 445, 451, 452, 454,
+	455, 456, 457, 458, 324, 270, 393, 286, 295, 0,
+	0, 342, 374, 212, 433, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 465, 466, 467,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 195, 291, 0, 363,
+	253, 460, 440, 436, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+	184, 196, 205, 215, 228, 243, 251, 261, 266, 269,
+	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
+	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
+	420, 421, 432, 444, 449, 262, 428, 450, 0, 220,
+	300, 0, 0, 302, 247, 265, 276, 0, 439, 399,
+	200, 370, 254, 189, 218, 203, 226, 241, 244, 280,
+	310, 317, 346, 350, 259, 238, 216, 367, 213, 385,
+	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
+	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 1171, 237, 0,
+	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
+	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
+	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 219, 186, 330,
+	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
+	217, 0, 0, 0, 0, 0, 232, 277, 239, 231,
+	414, 0, 0, 0, 0, 202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 260, 0, 319, 0, 0, 0, 0, 446, 0,
+	0, 0, 0, 0, 0, 0, 288, 0, 285, 182,
+	198, 0, 0, 329, 369, 375, 0, 0, 0, 223,
+	0, 373, 343, 431, 206, 250, 366, 348, 371, 0,
+	0, 372, 294, 419, 361, 429, 447, 448, 230, 323,
+	437, 408, 443, 459, 199, 227, 337, 401, 434, 392,
+	316, 415, 416, 284, 391, 258, 185, 292, 453, 197,
+	381, 214, 204, 190, 403, 427, 211, 384, 0, 0,
+	461, 192, 425, 400, 312, 281, 282, 191, 0, 365,
+	235, 256, 225, 332, 422, 423, 224, 462, 201, 442,
+	194, 0, 441, 325, 418, 426, 313, 304, 193, 424,
+	311, 303, 287, 246, 267, 359, 297, 360, 268, 321,
+	320, 322, 0, 188, 0, 397, 435, 463, 207, 208,
+	209, 0, 245, 249, 255, 257, 263, 264, 271, 290,
+	336, 358, 356, 362, 0, 413, 430, 438, 445, 451,
+	452, 454, 455, 456, 457, 458, 324, 270, 393, 286,
+	295, 0, 0, 342, 374, 212, 433, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 465,
+	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 181, 195, 291,
+	0, 363, 253, 460, 440, 436, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 183, 184, 196, 205, 215, 228, 243, 251, 261,
+	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
+	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
+	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
+	398, 402, 420, 421, 432, 444, 449, 262, 428, 450,
+	0, 220, 300, 0, 0, 302, 247, 265, 276, 0,
+	439, 399, 200, 370, 254, 189, 218, 203, 226, 241,
+	244, 280, 310, 317, 346, 350, 259, 238, 216, 367,
+	213, 385, 405, 406, 407, 409, 314, 233, 349, 410,
+	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
+	237, 0, 0, 0, 0, 289, 234, 0, 0, 347,
+	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
+	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
+	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 219,
+	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
+	180, 0, 1006, 0, 0, 0, 0, 0, 0, 0,
+	210, 0, 217, 0, 0, 0, 0, 0, 232, 277,
+	239, 231, 414, 0, 0, 0, 0, 202, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 319, 0, 0, 0, 0,
+	446, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 182, 198, 0, 0, 329, 369, 375, 0, 0,
+	0, 223, 0, 373, 343, 431, 206, 250, 366, 348,
+	371, 0, 0, 372, 294, 419, 361, 429, 447, 448,
+	230, 323, 437, 408, 443, 459, 199, 227, 337, 401,
+	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
+	453, 197, 381, 214, 204, 190, 403, 427, 211, 384,
+	0, 0, 461, 192, 425, 400, 312, 281, 282, 191,
+	0, 365, 235, 256, 225, 332, 422, 423, 224, 462,
+	201, 442, 194, 0, 441, 325, 418, 426, 313, 304,
+	193, 424, 311, 303, 287, 246, 267, 359, 297, 360,
+	268, 321, 320, 322, 0, 188, 0, 397, 435, 463,
+	207, 208, 209, 0, 245, 249, 255, 257, 263, 264,
+	271, 290, 336, 358, 356, 362, 0, 413, 430, 438,
+	445, 451, 452, 454, 455, 456, 457, 458, 324, 270,
+	393, 286, 295, 0, 0, 342, 374, 212, 433, 394,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
+	195, 291, 0, 363, 253, 460, 440, 436, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 184, 196, 205, 215, 228, 243,
+	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
+	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
+	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
+	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
+	389, 390, 398, 402, 420, 421, 432, 444, 449, 262,
+	428, 450, 0, 220, 300, 0, 0, 302, 247, 265,
+	276, 0, 439, 399, 200, 370, 254, 189, 218, 203,
+	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
+	216, 367, 213, 385, 405, 406, 407, 409, 314, 233,
+	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 0, 0, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
+	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
+	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
+	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 219, 186, 330, 396, 252, 0, 0, 0, 0,
+	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 0, 217, 0, 0, 0, 0, 0,
+	232, 277, 239, 231, 414, 0, 0, 0, 0, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
+	0, 0, 446, 0, 0, 0, 0, 0, 0, 0,
+	288, 0, 285, 182, 198, 0, 0, 329, 369, 375,
+	0, 0, 0, 223, 0, 373, 343, 431, 206, 250,
+	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
+	447, 448, 230, 323, 437, 408, 443, 459, 199, 227,
+	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
+	185, 292, 453, 197, 381, 214, 204, 190, 403, 427,
+	211, 384, 0, 0, 461, 192, 425, 400, 312, 281,
+	282, 191, 0, 365, 235, 256, 225, 332, 422, 423,
+	224, 462, 201, 442, 194, 0, 441, 325, 418, 426,
+	313, 304, 193, 424, 311, 303, 287, 246, 267, 359,
+	297, 360, 268, 321, 320, 322, 0, 188, 0, 397,
+	435, 463, 207, 208, 209, 0, 245, 249, 255, 257,
+	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
+	430, 438, 445, 451, 452, 454, 455, 456, 457, 458,
+	324, 270, 393, 286, 295, 0, 0, 342, 374, 212,
+	433, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 465, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 195, 291, 0, 363, 253, 460, 440, 436,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
+	0, 640, 0, 0, 0, 183, 184, 196, 205, 215,
+	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
+	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
+	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
+	387, 388, 389, 390, 398, 402, 420, 421, 432, 444,
+	449, 262, 428, 450, 0, 220, 300, 0, 0, 302,
+	247, 265, 276, 0, 439, 399, 200, 370, 254, 189,
+	218, 203, 226, 241, 244, 280, 310, 317, 346, 350,
+	259, 238, 216, 367, 213, 385, 405, 406, 407, 409,
+	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
+	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
+	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
+	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 219, 186, 330, 396, 252, 0, 0,
+	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 0, 217, 0, 0, 0,
+	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
+	0, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 537, 0, 260, 0, 319,
+	0, 0, 0, 0, 446, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 182, 198, 0, 0, 329,
+	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
+	206, 250, 366, 348, 371, 0, 0, 372, 294, 419,
+	361, 429, 447, 448, 230, 323, 437, 408, 443, 459,
+	199, 227, 337, 401, 434, 392, 316, 415, 416, 284,
+	391, 258, 185, 292, 453, 197, 381, 214, 204, 190,
+	403, 427, 211, 384, 0, 0, 461, 192, 425, 400,
+	312, 281, 282, 191, 0, 365, 235, 256, 225, 332,
+	422, 423, 224, 462, 201, 442, 194, 0, 441, 325,
+	418, 426, 313, 304, 193, 424, 311, 303, 287, 246,
+	267, 359, 297, 360, 268, 321, 320, 322, 0, 188,
+	0, 397, 435, 463, 207, 208, 209, 0, 245, 249,
+	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
+	0, 413, 430, 438, 445, 451, 452, 454, 455, 456,
+	457, 458, 324, 270, 393, 286, 295, 0, 0, 342,
+	374, 212, 433, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 195, 291, 0, 363, 253, 460,
+	440, 436, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 184, 196,
+	205, 215, 228, 243, 251, 261, 266, 269, 274, 275,
+	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
+	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
+	432, 444, 449, 536, 428, 450, 0, 220, 300, 0,
+	0, 302, 247, 265, 276, 0, 439, 399, 200, 370,
+	254, 189, 218, 203, 226, 241, 244, 280, 310, 317,
+	346, 350, 259, 238, 216, 367, 213, 385, 405, 406,
+	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
+	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
+	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
+	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 219, 186, 330, 396, 252,
+	0, 0, 0, 0, 178, 179, 180, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 0, 217, 0,
+	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
+	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 319, 0, 0, 484, 0, 446, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 182, 198, 0,
+	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
+	343, 431, 206, 250, 366, 348, 371, 0, 0, 372,
+	294, 419, 361, 429, 447, 448, 230, 323, 437, 408,
+	443, 459, 199, 227, 337, 401, 434, 392, 316, 415,
+	416, 284, 391, 258, 185, 292, 453, 197, 381, 214,
+	204, 190, 403, 427, 211, 384, 0, 0, 461, 192,
+	425, 400, 312, 281, 282, 191, 0, 365, 235, 256,
+	225, 332, 422, 423, 224, 462, 201, 442, 194, 0,
+	441, 325, 418, 426, 313, 304, 193, 424, 311, 303,
+	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
+	0, 188, 0, 397, 435, 463, 207, 208, 209, 0,
+	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
+	356, 362, 0, 413, 430, 438, 445, 451, 452, 454,
+	455, 456, 457, 458, 324, 270, 393, 286, 295, 0,
+	0, 342, 374, 212, 433, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 465, 466, 467,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 195, 291, 0, 363,
+	253, 460, 440, 436, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+	184, 196, 205, 215, 228, 243, 251, 261, 266, 269,
+	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
+	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
+	420, 421, 432, 444, 449, 262, 428, 450, 0, 220,
+	300, 0, 0, 302, 247, 265, 276, 0, 439, 399,
+	200, 370, 254, 189, 218, 203, 226, 241, 244, 280,
+	310, 317, 346, 350, 259, 238, 216, 367, 213, 385,
+	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
+	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
+	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
+	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
+	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
+	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 219, 186, 330,
+	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
+	217, 0, 0, 0, 0, 0, 232, 277, 239, 231,
+	414, 0, 0, 0, 0, 202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 260, 0, 319, 0, 0, 0, 0, 446, 0,
+	0, 0, 0, 0, 0, 0, 288, 0, 285, 182,
+	198, 0, 0, 329, 369, 375, 0, 0, 0, 223,
+	0, 373, 343, 431, 206, 250, 366, 348, 371, 0,
+	0, 372, 294, 419, 361, 429, 447, 448, 230, 323,
+	437, 408, 443, 459, 199, 227, 337, 401, 434, 392,
+	316, 415, 416, 284, 391, 258, 185, 292, 453, 197,
+	381, 214, 204, 190, 403, 427, 211, 384, 0, 0,
+	461, 192, 425, 400, 312, 281, 282, 191, 0, 365,
+	235, 256, 225, 332, 422, 423, 224, 462, 201, 442,
+	194, 0, 441, 325, 418, 426, 313, 304, 193, 424,
+	311, 303, 287, 246, 267, 359, 297, 360, 268, 321,
+	320, 322, 0, 188, 0, 397, 435, 463, 207, 208,
+	209, 0, 245, 249, 255, 257, 263, 264, 271, 290,
+	336, 358, 356, 362, 0, 413, 430, 438, 445, 451,
+	452, 454, 455, 456, 457, 458, 324, 270, 393, 286,
+	295, 0, 0, 342, 374, 212, 433, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 465,
+	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 181, 195, 291,
+	0, 363, 253, 460, 440, 436, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 183, 184, 196, 205, 215, 228, 243, 251, 261,
+	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
+	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
+	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
+	398, 402, 420, 421, 432, 444, 449, 262, 428, 450,
+	0, 220, 300, 0, 0, 302, 247, 265, 276, 0,
+	439, 399, 200, 370, 254, 189, 218, 203, 226, 241,
+	244, 280, 310, 317, 346, 350, 259, 238, 216, 367,
+	213, 385, 405, 406, 407, 409, 314, 233, 349, 0,
+	0, 298, 411, 412, 272,
 }
 
 var yyPact = [...]int{
-	2700, -1000, -335, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	4548, -1000, -341, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1721, 1748, -1000, -1000, -1000, -1000, 1840,
-	-1000, 692, 1483, -1000, 1731, 2509, -1000, 30575, 521, -1000,
-	30074, 520, 90, 30575, -1000, 185, -1000, 140, 30575, 177,
-	29573, -1000, -1000, -248, 13037, 1683, 59, 50, 30575, -1000,
-	1823, 1495, -1000, 261, -1000, -1000, -1000, -1000, -1000, -1000,
-	29072, -1000, -1000, -1000, 1736, 1718, 1844, 616, 1674, -1000,
-	1770, 1495, -1000, 13037, 1803, 1760, 12536, -1000, 12536, 456,
-	-1000, -1000, 9523, -1000, -1000, 17047, 30575, 30575, 372, -1000,
-	1731, -1000, -1000, 307, -1000, 350, 1438, -1000, 1437, -1000,
-	595, 455, 380, 421, 410, 370, 367, 364, 357, 355,
-	354, 353, 352, 375, -1000, 657, 657, -146, -152, 326,
-	434, 434, 434, 481, 1701, 1698, -1000, 617, -1000, 657,
-	657, 283, 657, 657, 657, 657, 286, 284, 657, 657,
-	657, 657, 657, 657, 657, 657, 657, 657, 657, 657,
-	657, 657, 657, 338, 1731, 277, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1727, 1856, -1000, -1000, -1000, -1000, 1879,
+	-1000, 672, 1524, -1000, 1748, 2880, -1000, 30950, 512, -1000,
+	30448, 503, 277, 30950, -1000, 155, -1000, 145, 30950, 151,
+	29946, -1000, -1000, -257, 13377, 1683, 36, 34, 30950, -1000,
+	1859, 1489, -1000, 288, -1000, -1000, -1000, -1000, -1000, -1000,
+	29444, -1000, -1000, -1000, 1755, 1777, 1680, 613, 1687, -1000,
+	1796, 1489, -1000, 13377, 1841, 1788, 12875, -1000, 12875, 380,
+	-1000, -1000, 9856, -1000, -1000, 17395, 30950, 30950, 229, -1000,
+	1748, -1000, -1000, 283, -1000, 317, 1428, -1000, 1413, -1000,
+	372, 630, 311, 416, 413, 304, 303, 302, 301, 300,
+	295, 293, 292, 321, -1000, 637, 637, -148, -149, 2617,
+	358, 358, 358, 443, 1708, 1704, -1000, 667, -1000, 637,
+	637, 268, 637, 637, 637, 637, 248, 246, 637, 637,
+	637, 637, 637, 637, 637, 637, 637, 637, 637, 637,
+	637, 637, 637, 357, 1748, 237, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4345,27 +4385,28 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 30575, 189, 30575, -1000, 581, 30575, 817, 817, 80,
-	817, 817, 817, 817, 163, 571, 48, -1000, 162, 264,
-	151, 274, 754, 193, 125, -1000, -1000, 267, 754, 1180,
-	635, 143, -1000, 817, 7487, 7487, 7487, -1000, 1717, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 478, -1000, -1000,
-	-1000, -1000, 30575, 28571, 348, 701, -1000, -1000, -1000, 47,
-	-1000, -1000, 1319, 839, 13037, 909, -1000, 2349, 585, -1000,
-	-1000, -1000, -1000, -1000, 537, 13538, 13538, 13538, 13538, -1000,
-	-1000, 1449, 1449, 1449, 1449, 13538, 1449, 13538, 1449, 1449,
-	1449, 1449, 13037, 1449, 1449, 1449, -1000, 1449, 1449, 1449,
-	1449, 1449, 1449, 1449, 580, 1449, 1449, 1449, 1449, 1449,
-	-1000, -1000, -1000, -1000, 1449, 1449, 1449, 1449, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15041, -1000, 11033,
+	-1000, -1000, 30950, 180, 30950, -1000, 588, 30950, 751, 751,
+	72, 751, 751, 751, 751, 139, 606, 29, -1000, 138,
+	234, 169, 230, 769, 165, 110, -1000, -1000, 227, 769,
+	1115, 617, 130, -1000, 751, 7816, 7816, 7816, -1000, 1725,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 440, -1000,
+	-1000, -1000, -1000, 30950, 28942, 287, 675, -1000, -1000, -1000,
+	131, -1000, -1000, 1232, 743, 13377, 1314, -1000, 1081, 558,
+	-1000, -1000, -174, -1000, -1000, -1000, 530, 13879, 13879, 13879,
+	13879, -1000, -1000, 1463, 1463, 1463, 1463, 13879, 1463, 13879,
+	1463, 1463, 1463, 1463, 13377, 1463, 1463, 1463, -1000, 1463,
+	1463, 1463, 1463, 1463, 1463, 1463, 563, 1463, 1463, 1463,
+	1463, 1463, -1000, -1000, -1000, -1000, 1463, 1463, 1463, 1463,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15385,
+	-1000, 11369, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 30950, -1000, 1463, 174, 1796, 1489, -1000,
+	1859, 1849, 288, -1000, 1771, 1560, 1303, 1133, 1489, 1398,
+	30950, -1000, 1483, -1000, -1000, -155, -156, 1575, 1070, 1113,
+	-1000, -1000, -1000, -1000, 995, 13377, -1000, -1000, 1875, -1000,
+	14883, 561, 853, 1874, 28440, -1000, 380, 380, 1412, 9346,
+	-14, -1000, -1000, -1000, 665, 19403, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 30575, -1000, 1449, 195, 1770, 1495, -1000, 1823, 1780,
-	261, -1000, 1891, 1399, 1417, 1214, 1495, 1420, 30575, -1000,
-	1461, -1000, -1000, -1000, 1592, 1151, 1179, -1000, -1000, -1000,
-	-1000, 1106, 13037, -1000, -1000, 1836, -1000, 14540, 577, 869,
-	1835, 28070, -1000, 456, 456, 1428, 9014, -19, -1000, -1000,
-	-1000, 666, 19051, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1717, -1000,
+	-1000, 1725, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4378,209 +4419,211 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1392,
-	30575, -1000, -1000, 3133, 1168, -1000, 1478, -1000, 1390, -1000,
-	1464, 1489, 519, 1168, 506, 503, 498, -1000, -58, -1000,
-	-1000, -1000, -1000, -1000, 657, 657, 362, 2509, 4755, -1000,
-	-1000, -1000, 27569, 1476, 1168, -1000, 1474, -1000, 810, 499,
-	517, 517, 1168, -1000, -1000, 30575, 1168, 808, 794, 30575,
-	30575, -1000, 27068, -1000, 26567, 26066, 1088, 30575, 25565, 25064,
-	24563, 24062, 23561, -1000, 1550, -1000, 1484, -1000, -1000, -1000,
-	30575, 30575, 30575, 288, -1000, -1000, 30575, 1168, -1000, -1000,
-	1086, 1082, 657, 657, 1077, 1175, 1170, 1167, 657, 657,
-	1073, 1160, 21055, 313, 1051, 1047, 1045, 1102, 1156, 192,
-	1080, 1050, 1042, 30575, 1473, 30575, -1000, 258, 678, 342,
-	660, 1731, 1681, 1426, 473, 516, 1168, 437, 437, -1000,
-	7996, -1000, -1000, 1152, 13037, -1000, 755, 754, 754, -1000,
-	-1000, -1000, -1000, -1000, -1000, 817, 30575, 755, -1000, -1000,
-	-1000, 754, 817, 30575, 817, 817, 817, 817, 754, 754,
-	754, 817, 30575, 30575, 30575, 30575, 30575, 30575, 30575, 30575,
-	30575, 7487, 7487, 7487, 635, 817, -252, -1000, 1149, -1000,
-	1557, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 171,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -41,
-	1423, 23060, -1000, -254, -255, -256, -257, -1000, -1000, -1000,
-	-260, -267, -1000, -1000, -1000, 13037, 13037, 13037, 13037, -1000,
-	840, 13538, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1022,
-	668, 13538, 13538, 13538, 13538, 13538, 13538, 13538, 13538, 13538,
-	13538, 13538, 13538, 13538, 13538, 13538, 716, 1148, 1146, 585,
-	585, 585, 585, -1000, 12536, 13037, 13037, 585, -1000, 1168,
-	22559, 12536, 12536, 13037, 1707, 714, 839, 30575, -1000, 1214,
-	-1000, -1000, -1000, 931, -1000, 30575, 30575, 91, 10030, 7996,
-	12536, 12536, 12536, 12536, 12536, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 576, 1271, 1252, 1386,
-	-1000, 1422, -1000, -116, 16546, 13037, 1140, -1000, -1000, -1000,
-	1770, -1000, 1770, 1271, 1696, 1595, 12536, -1000, -1000, 1696,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1270, -1000,
-	30575, 1420, 1757, 30575, 1588, 1130, 288, -1000, 13037, 13037,
-	1419, -1000, 914, 30575, -1000, -1000, 22058, -1000, -1000, 6978,
-	-1000, 30575, 343, 30575, -1000, 20554, 21557, 8505, -19, -1000,
-	8505, 1401, -1000, 23, -6, 10531, 592, -1000, -1000, -1000,
-	326, 14039, 1275, 1689, 92, -1000, -1000, -1000, 1464, -1000,
-	1464, 1464, 1464, 1464, 288, 288, 288, 288, -1000, -1000,
-	-1000, -1000, -1000, 1472, 1471, -1000, 1464, 1464, 1464, 1464,
+	-1000, -1000, 1373, 30950, -1000, -1000, 4221, 1150, -1000, 1522,
+	-1000, 1370, -1000, 1487, 1504, 490, 1150, 485, 483, 480,
+	-1000, -56, -1000, -1000, -1000, -1000, -1000, 637, 637, 290,
+	2880, 4283, -1000, -1000, -1000, 27938, 1520, 1150, -1000, 1519,
+	-1000, 740, 474, 532, 532, 1150, -1000, -1000, 30950, 1150,
+	736, 726, 30950, 30950, -1000, 27436, -1000, 26934, 26432, 1013,
+	30950, 25930, 25428, 24926, 24424, 23922, -1000, 1608, -1000, 1515,
+	-1000, -1000, -1000, 30950, 30950, 30950, 251, -1000, -1000, 30950,
+	1150, -1000, -1000, 1010, 1005, 637, 637, 1003, 1107, 1103,
+	1102, 637, 637, 1002, 1099, 21411, 255, 986, 985, 980,
+	996, 1095, 175, 959, 927, 977, 30950, 1517, 30950, -1000,
+	219, 666, 312, 659, 1748, 1682, 1409, 392, 488, 1150,
+	362, 362, -1000, 8326, -1000, -1000, 1088, 13377, -1000, 789,
+	769, 769, -1000, -1000, -1000, -1000, -1000, -1000, 751, 30950,
+	789, -1000, -1000, -1000, 769, 751, 30950, 751, 751, 751,
+	751, 769, 769, 769, 751, 30950, 30950, 30950, 30950, 30950,
+	30950, 30950, 30950, 30950, 7816, 7816, 7816, 617, 751, -265,
+	-1000, 1087, -1000, 1574, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 148, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -61, 1403, 23420, -1000, -267, -268, -269, -270,
+	-1000, -1000, -1000, -272, -280, -1000, -1000, -1000, 13377, 13377,
+	13377, 13377, -1000, 757, 13879, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 954, 813, 13879, 13879, 13879, 13879, 13879, 13879,
+	13879, 13879, 13879, 13879, 13879, 13879, 13879, 13879, 13879, 801,
+	1463, 1079, 1078, 558, 558, 558, 558, -1000, 12875, 13377,
+	13377, 558, -1000, 1150, 22918, 12875, 12875, 13377, 1716, 670,
+	743, 30950, -1000, 1133, -1000, -1000, -1000, 988, -1000, 30950,
+	30950, 2193, 10364, 8326, 12875, 12875, 12875, 12875, 12875, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1470, 1470, 1470,
-	1465, 1465, 424, -1000, 13037, 248, 30575, 1745, 1040, 258,
-	440, 1511, 1168, 1168, 1168, 440, -1000, 1176, 1136, -1000,
-	1413, -1000, -1000, 1801, -1000, -1000, 667, 849, 842, 549,
-	30575, 202, 332, -1000, 409, -1000, 30575, 1168, 793, 517,
-	1168, -1000, 1168, -1000, -1000, -1000, -1000, -1000, 1168, 1412,
-	-1000, 1339, 861, 841, 845, 764, 1412, -1000, -1000, -81,
-	1412, -1000, 1412, -1000, 1412, -1000, 1412, -1000, 1412, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 669, 30575, 202,
-	716, -1000, 467, -1000, -1000, 716, 716, -1000, -1000, -1000,
-	-1000, 1127, 1126, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	560, 1243, 1257, 1358, -1000, 1399, -1000, -131, 16893, 13377,
+	1077, -1000, -1000, -1000, 1796, -1000, 1796, 1243, 1724, 1611,
+	12875, -1000, -1000, 1724, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1189, -1000, 30950, 1398, 1780, 30950, -1000, -202,
+	-1000, -203, 1623, 1074, 251, -1000, 13377, 13377, 1396, -1000,
+	917, 30950, -1000, -1000, 22416, -1000, -1000, 7306, -1000, 30950,
+	286, 30950, -1000, 20909, 21914, 8836, -14, -1000, 8836, 1291,
+	-1000, 2, 21, 10866, 566, -1000, -1000, -1000, 2617, 14381,
+	1217, 1691, 93, -1000, -1000, -1000, 1487, -1000, 1487, 1487,
+	1487, 1487, 251, 251, 251, 251, -1000, -1000, -1000, -1000,
+	-1000, 1516, 1514, -1000, 1487, 1487, 1487, 1487, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1510, 1510, 1510, 1490, 1490,
+	353, -1000, 13377, 200, 30950, 1754, 967, 219, 369, 1548,
+	1150, 1150, 1150, 369, -1000, 1223, 1205, -1000, 1383, -1000,
+	-1000, 1839, -1000, -1000, 721, 782, 767, 622, 30950, 186,
+	285, -1000, 344, -1000, 30950, 1150, 725, 532, 1150, -1000,
+	1150, -1000, -1000, -1000, -1000, -1000, 1150, 1379, -1000, 1441,
+	823, 765, 818, 758, 1379, -1000, -1000, -79, 1379, -1000,
+	1379, -1000, 1379, -1000, 1379, -1000, 1379, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 678, 30950, 186, 801, -1000,
+	386, -1000, -1000, 801, 801, -1000, -1000, -1000, -1000, 1073,
+	1068, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-314, 30575, -1000, 241, 659, 302, 359, 331, 30575, 298,
-	1763, 262, 281, 30575, 30575, 437, 1556, 30575, 1744, 30575,
-	-1000, -1000, -1000, -1000, 839, 30575, -1000, -1000, 817, 817,
-	-1000, -1000, 30575, 817, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 817, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -320, 30950,
+	-1000, 213, 658, 270, 282, 265, 30950, 185, 1784, 202,
+	245, 30950, 30950, 362, 1572, 30950, 1772, 30950, -1000, -1000,
+	-1000, -1000, 743, 30950, -1000, -1000, 751, 751, -1000, -1000,
+	30950, 751, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	751, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 30950, 30950,
+	-1000, -1000, -1000, -1000, -1000, -1000, 153, 7, 266, -1000,
+	-1000, -1000, -1000, -1000, 1792, -1000, 743, 793, 697, -1000,
+	-1000, -1000, 870, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	954, 13879, 13879, 13879, 1372, 375, 1411, 1357, 774, 1248,
+	1248, 700, 700, 599, 599, 599, 599, 599, -1000, -1000,
+	-1000, -1000, -1000, 291, -1000, -1000, 1340, -1000, 979, 942,
+	1133, -1000, 1340, 1340, 891, 12875, -1000, -1000, 691, -1000,
+	13377, 1133, -1000, -1000, 1133, 1378, 1377, 1869, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	30575, 30575, -1000, -1000, -1000, -1000, -1000, 109, 21, 236,
-	-1000, -1000, -1000, -1000, -1000, 1767, -1000, 839, 735, 727,
-	-1000, -1000, -1000, 926, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1022, 13538, 13538, 13538, 1966, 445, 757, 1527, 771,
-	739, 739, 784, 784, 602, 602, 602, 602, 602, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1372, -1000, 1014, 985,
-	1214, -1000, 1372, 1372, 1054, 12536, -1000, -1000, 765, -1000,
-	13037, 1214, -1000, -1000, 1214, 1410, 1403, 1834, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1214,
-	12536, 12536, 1402, 1449, 573, -1000, 1372, 1214, 1214, 1372,
-	1372, 7996, 1214, -1000, 30575, -1000, -245, -1000, -18, 557,
-	1449, -1000, 21055, 1214, 1319, -1000, -1000, -1000, -1000, -1000,
-	18550, 1394, 1696, -1000, -1000, 1449, 1383, -1000, -1000, 288,
-	76, 774, 839, 839, 13037, -1000, -1000, -1000, -1000, -1000,
-	-1000, 567, 1825, 292, 1449, -1000, 1453, 1528, -1000, -1000,
-	-1000, 1756, 16045, 30575, 1385, 1341, -1000, 563, -1000, 1401,
-	-19, 14, -1000, -1000, -1000, -1000, 839, -1000, 1114, 345,
-	1121, -1000, 415, -1000, -1000, -1000, -1000, 1311, 1754, 1687,
-	82, -1000, -1000, -1000, 288, 288, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1120, 1120, -1000, -1000, -1000, -1000, -1000, 1035,
-	-1000, -1000, -1000, 1024, -1000, -1000, 1074, 1541, 248, -1000,
-	-1000, 657, 1118, 1691, 30575, -1000, -1000, 1248, 241, 30575,
-	719, 1555, -1000, 1511, 1511, 1511, 30575, -1000, -1000, -1000,
-	-1000, 4389, 30575, 1380, -1000, 199, -1000, 1237, 30575, -1000,
-	1378, 1468, 1168, 1168, -1000, -1000, -1000, 30575, 1449, -1000,
-	-1000, -1000, -1000, 500, 1727, 1726, 202, 199, 592, 1168,
-	-1000, -1000, -1000, -1000, -1000, -313, 1374, 486, 233, 229,
-	30575, 30575, 30575, 30575, 30575, 547, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 279, 464, -1000, 30575, 30575, 529,
-	-1000, -1000, -1000, 754, -1000, -1000, 754, -1000, -1000, -1000,
-	-1000, -1000, 1708, 30575, 19, -285, -1000, -282, -1000, -1000,
-	-1000, -1000, 1199, 442, 757, 13538, 13538, 12536, -71, 454,
-	454, 716, -1000, -1000, -1000, 13037, 13037, 1368, 670, -1000,
-	13037, 718, -1000, -1000, 13037, 13037, 13037, -1000, 1372, 1372,
-	12536, 7996, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 494, 492, 491, 30575, -1000, -1000, 1771, -1000,
-	1627, 1610, 1833, 1825, -1000, 20554, 1696, -1000, -1000, 30575,
-	-239, -1000, 1675, 1644, -1000, -1000, -1000, -1000, 6469, 1770,
-	13037, 1551, 30575, 1449, -1000, 15543, 30575, 30575, 20554, 20554,
-	20554, 20554, 20554, -1000, 1580, 1577, -1000, 1570, 1568, 1617,
-	30575, -1000, 1370, 1214, 1780, 16045, 17548, 1397, 20554, -1000,
-	-1000, 20554, 30575, 5960, -1000, -1000, -2, -11, -1000, -1000,
-	-1000, -1000, 1800, 326, -1000, -1000, -1000, -1000, 892, 3448,
-	1843, -1000, 1117, -1000, 1065, -1000, 752, 749, -1000, 30575,
-	1467, -1000, -1000, -1000, -1000, -1000, 1365, -1000, 1357, 1395,
-	1345, 113, -1000, 1458, 1706, 657, 657, -1000, 1016, -1000,
-	1168, -1000, -1000, 483, -1000, 1741, 30575, 1546, 1544, 1529,
-	-1000, 1791, 1355, 30575, -1000, -1000, 30575, -1000, 1603, 248,
-	30575, -1000, -1000, -1000, 332, 30575, -1000, 1425, 199, -1000,
-	-1000, -1000, -1000, -1000, -1000, 30575, 245, -1000, 1466, 1193,
-	-1000, 1493, -1000, -1000, -1000, -1000, 170, 285, -1000, 30575,
-	508, 1541, 30575, -1000, -1000, -1000, 817, 817, -1000, -1000,
-	1704, -1000, 1168, 13538, 13538, -1000, 585, -1000, 1449, 1214,
-	1464, 1464, -1000, 1464, 1465, -1000, 1464, 167, 1464, 149,
-	1214, 1214, 998, 942, -65, -1000, 839, 13037, 929, 828,
-	992, -1000, -1000, 1214, -1000, 1449, 1449, 1449, 1295, 30575,
-	-1000, -1000, -1000, -1000, 1825, 1816, 1363, -1000, -1000, 76,
-	333, -1000, 1660, 1644, -1000, 1788, 1668, 1786, -1000, -1000,
-	-1000, 839, -1000, 1722, 1406, -1000, 652, 1302, -1000, -1000,
-	12035, 1316, 1601, 561, 1295, 1349, 1528, 1501, 1526, 1636,
-	-1000, -1000, -1000, -1000, 1569, -1000, 1537, -1000, -1000, 1461,
-	-1000, -1000, 1252, 343, 20554, 1307, 1307, -1000, 559, -1000,
-	-1000, -1000, -1000, -326, -1000, -1000, 13037, -1000, -1000, -1000,
-	-1000, -1000, -1000, 980, 980, 134, -1000, -1000, -1000, -1000,
-	-1000, 1463, 13037, 288, 1109, 288, 963, -1000, 919, -1000,
-	-1000, -188, -1000, -1000, 1459, 1540, -1000, -1000, 30575, -1000,
-	-1000, 30575, 30575, 30575, 30575, -1000, -1000, 299, -1000, 1273,
-	1254, -1000, -136, -1000, 13037, -1000, 1461, -1000, -1000, -1000,
-	1233, -1000, -117, 30575, 30575, 30575, 30575, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 585, 13538, -1000, -1000,
-	378, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13037,
-	-1000, 13037, -1000, 1770, 1107, 839, 13037, 13037, -1000, -1000,
-	18049, 20053, 20053, 17548, -1000, 1816, 1810, 1785, 1637, 1662,
-	1662, 1660, -1000, 1784, 1778, -1000, 1101, 1775, 1096, 748,
-	-1000, 30575, 13037, 1449, -1000, 294, 30575, 1449, 30575, -1000,
-	1781, -1000, -1000, 13037, 1462, -1000, 13037, -1000, -1000, -1000,
-	-1000, -1000, 1825, 1307, -1000, -1000, 609, 57, 295, -1000,
-	-1000, -1000, 992, -1000, -1000, -1000, 30575, 1062, -1000, -1000,
-	-1000, 1225, 1223, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1461, -1000, -1000, -1000, 1355, 306, 399, -1000, 332,
-	-1000, -153, -154, 992, 1751, -1000, -1000, 7996, -1000, -1000,
-	1460, 1502, -1000, 191, -1000, -1000, 992, 992, 1214, -1000,
-	992, 992, 1247, -1000, -1000, -1000, 1247, 1247, 557, 1810,
-	-1000, 13037, 13037, 1635, 898, -1000, -1000, -1000, -1000, 1095,
-	994, -1000, 962, -1000, 1842, -1000, 839, -1000, 1449, -1000,
-	548, 1302, -1000, 1770, 839, 30575, 839, 1781, -1000, 1452,
-	1431, -319, 13037, 1451, -1000, 1245, -1000, -1000, -1000, 1750,
-	1449, -1000, -1000, -1000, -1000, -1000, 261, 1326, -1000, 650,
-	30575, 30575, 1214, 234, -120, -1000, -1000, -1000, -1000, -1000,
-	19552, -1000, -1000, -1000, -1000, -1000, 839, 1319, -1000, 864,
-	-1000, -1000, -1000, -1000, -1000, 30575, 1302, 30575, -1000, 1243,
-	1770, 13037, 1448, 646, -325, 915, 1039, 30575, 1517, 653,
-	261, 11534, -100, 7996, 5451, 1240, -1000, -1000, 1586, -78,
-	-133, -1000, -1000, -1000, -1000, 1200, -1000, -1000, -1000, 1027,
-	30575, 913, 1443, 1772, -1000, -1000, 1229, 1514, -1000, 1830,
-	-1000, -1000, -1000, 883, 911, -1000, -1000, -1000, -100, 992,
-	1214, -1000, 0, -1000, -1000, -1000, -1000, -1000, 1493, -1000,
-	1585, -1000, -319, 1211, -1000, -1000, 332, -321, -1000, -1000,
-	1832, 578, 578, -1000, -1000, -1000, -1000, -1000, 422, -1000,
-	-1000, -117, -118, -325, -319, 1209, 55, -1000, -1000, -1000,
-	416, 960, -1000, 247, -1000, -128, 1443, -325, -1000, 1447,
-	1431, -1000, -1000, -1000, -1000, -139, -1000, 1443, 13037, 1440,
-	-1000, -1000, 937, 30575, -331, 1206, -1000, 903, -331, -1000,
-	-1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1133,
+	12875, 12875, 1376, 1463, 557, -1000, 1340, 1133, 1133, 1340,
+	1340, 8326, 1133, -1000, 30950, -1000, -261, -1000, -12, 611,
+	1463, -1000, 21411, 1133, 1232, -1000, -1000, -1000, -1000, -1000,
+	18901, 1452, 1724, -1000, -1000, 1463, 1353, -1000, -1000, -1000,
+	-1000, 251, 76, 807, 743, 743, 13377, -1000, -1000, -1000,
+	-1000, -1000, -1000, 556, 1860, 278, 1463, -1000, 1429, 1699,
+	-1000, -1000, -1000, 1779, 16391, 30950, 1499, 1394, -1000, 555,
+	-1000, 1291, -14, -15, -1000, -1000, -1000, -1000, 743, -1000,
+	1176, 289, 284, -1000, 371, -1000, -1000, -1000, -1000, 299,
+	1778, 1689, 65, -1000, -1000, -1000, 251, 251, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1067, 1067, -1000, -1000, -1000, -1000,
+	-1000, 953, -1000, -1000, -1000, 943, -1000, -1000, 991, 1599,
+	200, -1000, -1000, 637, 1062, 1697, 30950, -1000, -1000, 1209,
+	213, 30950, 687, 1570, -1000, 1548, 1548, 1548, 30950, -1000,
+	-1000, -1000, -1000, 3778, 30950, 1351, -1000, 167, -1000, 1195,
+	30950, -1000, 1344, 1508, 1150, 1150, -1000, -1000, -1000, 30950,
+	1463, -1000, -1000, -1000, -1000, 475, 1746, 1743, 186, 167,
+	566, 1150, -1000, -1000, -1000, -1000, -1000, -319, 1342, 462,
+	194, 228, 30950, 30950, 30950, 30950, 30950, 528, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 231, 382, -1000, 30950,
+	30950, 553, -1000, -1000, -1000, 769, -1000, -1000, 769, -1000,
+	-1000, -1000, -1000, -1000, 1728, 30950, -4, -295, -1000, -291,
+	-1000, -1000, -1000, -1000, 1207, 374, 1411, 13879, 13879, 1843,
+	1838, 12875, -70, 559, 559, 801, -1000, -1000, -1000, 13377,
+	13377, 1325, 668, -1000, 13377, 681, -1000, -1000, 13377, 13377,
+	13377, -1000, 1340, 1340, 12875, 8326, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 478, 476, 470, 30950,
+	-1000, -1000, 1799, -1000, 1641, 1638, 1868, 1860, -1000, 20909,
+	1724, -1000, -1000, 30950, -245, -1000, 1679, 1654, -1000, -1000,
+	-1000, -1000, 6796, 1796, 13377, 1568, 30950, 1463, -1000, 15888,
+	30950, 30950, 20909, 20909, 20909, 20909, 20909, -1000, 1603, 1602,
+	-1000, 1594, 1587, 1622, 30950, -1000, 1305, 1133, 1849, 16391,
+	17897, 1346, 20909, -1000, -1000, 20909, 30950, 6286, -1000, -1000,
+	-7, 1, -1000, -1000, -1000, -1000, 1835, 2617, -1000, -1000,
+	-1000, -1000, 844, 3065, 1884, -1000, 1060, -1000, 1094, -1000,
+	-1000, -1000, 722, 712, -1000, 30950, 1501, -1000, -1000, -1000,
+	-1000, -1000, 1301, -1000, 1281, 1251, 1279, 103, -1000, 1388,
+	1713, 637, 637, -1000, 937, -1000, 1150, -1000, -1000, 460,
+	-1000, 1760, 30950, 1567, 1566, 1565, -1000, 1834, 1238, 30950,
+	-1000, -1000, 30950, -1000, 1636, 200, 30950, -1000, -1000, -1000,
+	285, 30950, -1000, 1382, 167, -1000, -1000, -1000, -1000, -1000,
+	-1000, 30950, 206, -1000, 1498, 1084, -1000, 1523, -1000, -1000,
+	-1000, -1000, 159, 253, -1000, 30950, 551, 1599, 30950, -1000,
+	-1000, -1000, 751, 751, -1000, -1000, 1711, -1000, 1150, 13879,
+	13879, -1000, 558, 1133, 13377, -1000, 1463, 1133, 1487, 1487,
+	-1000, 1487, 1490, -1000, 1487, 137, 1487, 122, 1133, 1133,
+	750, 921, -63, -1000, 743, 13377, 1151, 1125, 847, -1000,
+	-1000, 1133, -1000, 1463, 1463, 1463, 1263, 30950, -1000, -1000,
+	-1000, -1000, 1860, 1857, 1249, -1000, -1000, 76, 320, -1000,
+	1656, 1654, -1000, 1821, 1675, 1813, -1000, -1000, -1000, 743,
+	-1000, 1750, 1184, -1000, 635, 1228, -1000, -1000, 12373, 1277,
+	1632, 552, 1263, 1330, 1699, 1557, 1564, 1554, -1000, -1000,
+	-1000, -1000, 1598, -1000, 1590, -1000, -1000, 1483, -1000, -1000,
+	1257, 286, 20909, 1284, 1284, -1000, 549, -1000, -1000, -1000,
+	-1000, -329, -1000, -1000, 13377, -1000, -1000, -1000, -1000, -1000,
+	-1000, 869, 869, 133, -1000, -1000, -1000, -1000, -1000, 1486,
+	13377, 251, 1051, 251, 915, -1000, 912, -1000, -1000, -190,
+	-1000, -1000, 1509, 1584, -1000, -1000, 30950, -1000, -1000, 30950,
+	30950, 30950, 30950, -1000, -1000, 272, -1000, 1261, 1253, -1000,
+	-143, -1000, 13377, -1000, 1483, -1000, -1000, -1000, 1158, -1000,
+	-83, 30950, 30950, 30950, 30950, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 558, -1000, 1232, 13879, -1000, -1000,
+	332, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13377,
+	-1000, 13377, -1000, 1796, 1036, 743, 13377, 13377, -1000, -1000,
+	18399, 20407, 20407, 17897, -1000, 1857, 1853, 1812, 1659, 1665,
+	1665, 1656, -1000, 1805, 1801, -1000, 1034, 1800, 1033, 709,
+	-1000, 30950, 13377, 1463, -1000, 240, 30950, 1463, 30950, -1000,
+	1843, -1000, -1000, 13377, 1485, -1000, 13377, -1000, -1000, -1000,
+	-1000, -1000, 1860, 1284, -1000, -1000, 605, 56, 273, -1000,
+	-1000, -1000, 847, -1000, -1000, -1000, 30950, 962, -1000, -1000,
+	-1000, 1154, 1149, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1483, -1000, -1000, -1000, 1238, 254, 315, -1000, 285,
+	-1000, -152, -153, 847, 1776, -1000, -1000, 8326, -1000, -1000,
+	1467, 1539, -1000, 585, -1000, -1000, 847, 847, 1133, -1000,
+	847, 847, 1247, -1000, -1000, -1000, 1247, 1247, 611, 1853,
+	-1000, 13377, 13377, 1645, 884, -1000, -1000, -1000, -1000, 1031,
+	1030, -1000, 1028, -1000, 1883, -1000, 743, -1000, 1463, -1000,
+	537, 1228, -1000, 1796, 743, 30950, 743, 1843, -1000, 1466,
+	1363, -325, 13377, 1465, -1000, 1198, -1000, -1000, -1000, 1775,
+	1463, -1000, -1000, -1000, -1000, -1000, 288, 1236, -1000, 628,
+	30950, 30950, 1133, 235, -91, -1000, -1000, -1000, -1000, -1000,
+	19905, -1000, -1000, -1000, -1000, -1000, 743, 1232, -1000, 865,
+	-1000, -1000, -1000, -1000, -1000, 30950, 1228, 30950, -1000, 1170,
+	1796, 13377, 1462, 626, -336, 901, 946, 30950, 1561, 223,
+	288, 11871, -80, 8326, 5776, 1165, -1000, -1000, 1619, -76,
+	-97, -1000, -1000, -1000, -1000, 1221, -1000, -1000, -1000, 911,
+	30950, 900, 1443, 1798, -1000, -1000, 1157, 1559, -1000, 1867,
+	-1000, -1000, -1000, 797, 997, -1000, -1000, -1000, -1000, -1000,
+	-80, 847, 1133, -1000, -10, -1000, -1000, -1000, -1000, -1000,
+	1523, -1000, 1614, -1000, -325, 1129, -1000, -1000, 285, -332,
+	-1000, -1000, 1882, 507, 507, -1000, -1000, -1000, -1000, -1000,
+	351, -1000, -1000, -83, -89, -336, -325, 1126, 55, -1000,
+	-1000, -1000, 338, 922, -1000, 203, -1000, -93, 1443, -336,
+	-1000, 1450, 1363, -1000, -1000, -1000, -1000, -102, -1000, 1443,
+	13377, 1304, -1000, -1000, 826, 30950, -338, 1123, -1000, 867,
+	-338, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 9, 2151, 6, 1, 4, 2150, 17, 76, 161,
-	16, 162, 83, 2149, 2148, 2147, 2146, 173, 171, 167,
-	2145, 2140, 2138, 2136, 2135, 2133, 2130, 2129, 2128, 2127,
-	169, 137, 160, 2126, 2125, 2124, 99, 143, 72, 74,
-	158, 2123, 2120, 50, 2119, 2102, 2100, 153, 149, 693,
-	2099, 146, 95, 2094, 2093, 2092, 2091, 2090, 2089, 2081,
-	2080, 2076, 2074, 2073, 2071, 2070, 2067, 201, 2066, 2064,
-	10, 2063, 64, 2062, 2060, 2058, 2057, 2056, 5, 2053,
-	2051, 2049, 2047, 124, 2046, 2045, 2044, 163, 2043, 2042,
-	209, 96, 98, 2041, 2040, 85, 138, 2038, 97, 123,
-	2036, 2034, 185, 2033, 58, 68, 2032, 46, 67, 65,
-	42, 2030, 2029, 2027, 73, 57, 2026, 84, 63, 2025,
-	87, 75, 2024, 41, 2023, 2022, 86, 2021, 2020, 2019,
-	77, 2018, 2016, 3297, 2015, 78, 111, 28, 32, 2013,
-	2011, 2010, 2009, 2008, 39, 2007, 2006, 2005, 120, 29,
-	2004, 19, 40, 24, 116, 2003, 62, 55, 2002, 118,
-	1997, 1996, 35, 31, 30, 1994, 21, 112, 129, 56,
-	79, 119, 1993, 1992, 34, 49, 1991, 1988, 1985, 1984,
-	1983, 1981, 43, 1979, 26, 1978, 166, 1977, 2, 25,
-	60, 44, 117, 47, 27, 1975, 155, 1974, 33, 134,
-	115, 136, 1972, 1968, 1967, 159, 194, 1965, 1963, 52,
-	144, 126, 132, 1962, 202, 1961, 1960, 80, 1392, 1969,
-	38, 135, 1958, 1957, 2825, 128, 121, 36, 1955, 105,
-	1951, 1950, 1949, 164, 139, 82, 965, 90, 1946, 1945,
-	1944, 1939, 1938, 1937, 1935, 180, 165, 37, 88, 152,
-	45, 1934, 1929, 1928, 102, 93, 1922, 133, 130, 104,
-	148, 1921, 145, 125, 151, 1920, 122, 1919, 1917, 1905,
-	1900, 66, 1898, 1891, 1890, 1884, 140, 127, 101, 70,
-	1883, 71, 100, 131, 142, 11, 3, 22, 156, 20,
-	1880, 8, 0, 1878, 12, 147, 196, 175, 1877, 1875,
-	7, 1873, 13, 1871, 1869, 113, 1868, 1866, 1864, 15,
-	23, 14, 1862, 1861, 1859, 3245, 2191, 110, 1858, 168,
+	0, 9, 2173, 5, 1, 3, 2172, 30, 79, 156,
+	16, 179, 76, 2170, 2169, 2168, 2166, 171, 168, 167,
+	2165, 2160, 2159, 2158, 2157, 2156, 2155, 2154, 2153, 2151,
+	165, 139, 151, 2150, 2149, 2148, 97, 146, 64, 67,
+	148, 2147, 2144, 56, 2143, 2142, 2140, 161, 158, 692,
+	2139, 154, 105, 2138, 2137, 2136, 2135, 2134, 2133, 2132,
+	2131, 2130, 2126, 2121, 2120, 2119, 2118, 229, 2117, 2116,
+	12, 2115, 62, 2108, 2106, 2105, 2104, 2099, 4, 2096,
+	2095, 2094, 2093, 125, 2092, 2090, 2088, 162, 2087, 2086,
+	209, 91, 87, 2084, 2083, 83, 155, 2082, 92, 132,
+	2081, 2067, 459, 2065, 65, 63, 2064, 80, 57, 60,
+	41, 2063, 2062, 2060, 71, 47, 2057, 85, 45, 2056,
+	82, 77, 2055, 42, 2051, 2050, 96, 2049, 2048, 2047,
+	73, 2046, 2045, 3237, 2044, 68, 114, 24, 55, 2043,
+	2042, 2041, 2040, 2039, 38, 2038, 2037, 2035, 2034, 120,
+	29, 2033, 18, 19, 26, 116, 2032, 40, 58, 2031,
+	123, 2030, 2029, 35, 2028, 20, 21, 2027, 84, 111,
+	135, 17, 74, 117, 2025, 2024, 34, 46, 2022, 2020,
+	2017, 2016, 2015, 2014, 49, 2013, 28, 2012, 178, 2011,
+	8, 37, 36, 39, 170, 43, 27, 2010, 160, 2003,
+	33, 142, 101, 138, 2002, 2001, 2000, 149, 190, 1999,
+	1997, 52, 159, 124, 129, 1996, 194, 1995, 1993, 72,
+	1092, 1611, 15, 141, 1990, 1979, 2522, 121, 112, 44,
+	1972, 134, 1969, 1967, 1964, 157, 169, 86, 955, 89,
+	1963, 1962, 1961, 1958, 1956, 1954, 1952, 216, 163, 95,
+	90, 133, 50, 1951, 1947, 1945, 100, 75, 1944, 136,
+	131, 113, 144, 1943, 143, 126, 98, 1942, 88, 1941,
+	1940, 1939, 1938, 78, 1937, 1934, 1933, 1931, 137, 127,
+	99, 70, 1930, 66, 93, 130, 128, 11, 2, 25,
+	147, 14, 1929, 7, 0, 1928, 10, 145, 193, 140,
+	1926, 1921, 6, 1918, 13, 1914, 1912, 115, 1911, 1909,
+	1906, 23, 32, 22, 1900, 1898, 1895, 3550, 1788, 110,
+	1879, 164,
 }
 
-//line sql.y:5842
+//line sql.y:5909
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -5068,158 +5111,160 @@ func (st *yySymType) withUnion() *With {
 }
 
 var yyR1 = [...]int{
-	0, 313, 314, 314, 6, 6, 6, 6, 6, 6,
+	0, 315, 316, 316, 6, 6, 6, 6, 6, 6,
 	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
 	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-	6, 6, 6, 6, 6, 292, 292, 292, 295, 295,
+	6, 6, 6, 6, 6, 294, 294, 294, 297, 297,
 	28, 62, 30, 30, 29, 29, 32, 32, 31, 7,
 	7, 7, 8, 8, 8, 8, 8, 8, 8, 8,
 	9, 9, 9, 9, 9, 10, 10, 10, 10, 12,
 	12, 12, 12, 12, 15, 16, 11, 11, 17, 17,
-	74, 74, 18, 19, 19, 19, 19, 317, 317, 128,
-	128, 126, 126, 127, 127, 191, 191, 20, 21, 21,
-	201, 201, 200, 200, 200, 202, 202, 202, 202, 236,
-	236, 22, 22, 22, 22, 22, 88, 88, 294, 294,
-	293, 291, 291, 290, 290, 289, 34, 35, 44, 44,
-	44, 44, 45, 46, 296, 296, 267, 50, 50, 49,
-	49, 49, 49, 51, 51, 48, 48, 47, 47, 269,
-	269, 256, 256, 268, 268, 268, 268, 268, 268, 268,
-	255, 255, 101, 101, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 308, 308, 173, 173, 173,
-	173, 173, 173, 106, 106, 114, 114, 114, 114, 104,
-	104, 105, 103, 103, 103, 109, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 312, 312, 312,
-	312, 113, 113, 110, 110, 111, 111, 111, 309, 309,
-	309, 309, 238, 238, 238, 238, 241, 241, 239, 239,
-	239, 239, 239, 239, 239, 239, 239, 240, 240, 240,
-	240, 240, 242, 242, 242, 242, 242, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 244, 244, 244, 244, 244, 244, 244, 244,
-	254, 254, 245, 245, 249, 249, 250, 250, 250, 251,
-	251, 251, 252, 252, 247, 247, 247, 247, 248, 248,
-	248, 257, 281, 281, 280, 280, 278, 278, 278, 278,
-	266, 266, 275, 275, 275, 275, 275, 265, 265, 261,
-	261, 261, 262, 262, 263, 263, 260, 260, 264, 264,
-	277, 277, 276, 258, 258, 259, 259, 283, 310, 310,
-	310, 310, 311, 311, 284, 301, 302, 300, 300, 300,
-	300, 300, 72, 72, 72, 213, 213, 213, 273, 273,
-	272, 272, 272, 274, 274, 271, 271, 271, 271, 271,
-	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
-	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
-	271, 271, 271, 208, 208, 208, 299, 299, 299, 299,
-	299, 299, 298, 298, 298, 270, 270, 270, 297, 297,
-	52, 52, 157, 157, 41, 41, 41, 41, 41, 41,
-	40, 40, 40, 36, 36, 36, 36, 36, 36, 36,
+	74, 74, 18, 19, 19, 19, 19, 319, 319, 128,
+	128, 126, 126, 127, 127, 193, 193, 20, 21, 21,
+	203, 203, 202, 202, 202, 204, 204, 204, 204, 238,
+	238, 22, 22, 22, 22, 22, 88, 88, 296, 296,
+	295, 293, 293, 292, 292, 291, 34, 35, 44, 44,
+	44, 44, 45, 46, 298, 298, 269, 50, 50, 49,
+	49, 49, 49, 51, 51, 48, 48, 47, 47, 271,
+	271, 258, 258, 270, 270, 270, 270, 270, 270, 270,
+	257, 257, 101, 101, 174, 174, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 310, 310, 175,
+	175, 175, 175, 175, 175, 175, 175, 106, 106, 114,
+	114, 114, 114, 104, 104, 105, 103, 103, 103, 109,
+	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
+	109, 314, 314, 314, 314, 113, 113, 110, 110, 111,
+	111, 111, 311, 311, 311, 311, 240, 240, 240, 240,
+	243, 243, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 242, 242, 242, 242, 242, 244, 244, 244, 244,
+	244, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 246, 246, 246, 246,
+	246, 246, 246, 246, 256, 256, 247, 247, 251, 251,
+	252, 252, 252, 253, 253, 253, 254, 254, 249, 249,
+	249, 249, 250, 250, 250, 259, 283, 283, 282, 282,
+	280, 280, 280, 280, 268, 268, 277, 277, 277, 277,
+	277, 267, 267, 263, 263, 263, 264, 264, 265, 265,
+	262, 262, 266, 266, 279, 279, 278, 260, 260, 261,
+	261, 285, 312, 312, 312, 312, 313, 313, 286, 303,
+	304, 302, 302, 302, 302, 302, 72, 72, 72, 215,
+	215, 215, 275, 275, 274, 274, 274, 276, 276, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 210, 210, 210,
+	301, 301, 301, 301, 301, 301, 300, 300, 300, 272,
+	272, 272, 299, 299, 52, 52, 158, 158, 41, 41,
+	41, 41, 41, 41, 40, 40, 40, 36, 36, 36,
+	36, 36, 36, 36, 36, 36, 36, 36, 36, 36,
 	36, 36, 36, 36, 36, 36, 36, 36, 36, 36,
-	36, 36, 36, 36, 36, 36, 42, 42, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 23, 23, 23,
+	42, 42, 37, 37, 37, 37, 37, 37, 37, 37,
+	37, 23, 23, 23, 23, 23, 23, 23, 23, 23,
 	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 76, 76, 76,
-	76, 78, 78, 78, 286, 286, 1, 1, 5, 5,
-	2, 2, 77, 77, 3, 3, 4, 4, 288, 288,
-	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
-	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
-	253, 253, 253, 285, 285, 287, 287, 24, 33, 33,
-	25, 25, 25, 25, 26, 26, 53, 54, 54, 54,
+	23, 76, 76, 76, 76, 78, 78, 78, 288, 288,
+	1, 1, 5, 5, 2, 2, 77, 77, 3, 3,
+	4, 4, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 255, 255, 255, 287, 287, 289,
+	289, 24, 33, 33, 25, 25, 25, 25, 26, 26,
+	53, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
-	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
-	54, 54, 54, 54, 54, 303, 303, 207, 207, 215,
-	215, 206, 206, 229, 229, 229, 209, 209, 209, 210,
-	210, 307, 307, 307, 55, 55, 57, 57, 58, 59,
-	59, 231, 231, 232, 232, 60, 61, 73, 73, 73,
-	73, 73, 73, 75, 75, 75, 14, 14, 14, 14,
-	69, 69, 69, 13, 13, 56, 56, 63, 304, 304,
-	305, 306, 306, 306, 306, 64, 66, 27, 27, 27,
-	27, 27, 27, 94, 94, 83, 83, 83, 83, 83,
-	83, 83, 83, 83, 83, 83, 83, 89, 89, 89,
-	84, 84, 318, 67, 68, 68, 87, 87, 87, 81,
-	81, 81, 86, 86, 86, 91, 91, 93, 93, 93,
-	93, 93, 95, 95, 95, 95, 95, 95, 95, 90,
-	90, 92, 92, 92, 92, 222, 222, 222, 221, 221,
-	117, 117, 119, 118, 118, 120, 120, 121, 121, 121,
-	155, 136, 136, 189, 189, 188, 188, 190, 190, 190,
-	190, 192, 192, 122, 122, 122, 122, 123, 123, 124,
-	124, 125, 125, 230, 230, 227, 227, 227, 226, 226,
-	129, 129, 129, 131, 130, 130, 130, 130, 132, 132,
-	134, 134, 133, 133, 135, 137, 137, 137, 137, 137,
-	138, 138, 102, 102, 102, 102, 102, 102, 112, 112,
-	112, 112, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 108, 108, 108, 108,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 305,
+	305, 209, 209, 217, 217, 208, 208, 231, 231, 231,
+	211, 211, 211, 212, 212, 309, 309, 309, 55, 55,
+	57, 57, 58, 59, 59, 233, 233, 234, 234, 60,
+	61, 73, 73, 73, 73, 73, 73, 73, 75, 75,
+	75, 14, 14, 14, 14, 69, 69, 69, 13, 13,
+	56, 56, 63, 306, 306, 307, 308, 308, 308, 308,
+	64, 66, 27, 27, 27, 27, 27, 27, 94, 94,
+	83, 83, 83, 83, 83, 83, 83, 83, 83, 83,
+	83, 83, 89, 89, 89, 84, 84, 320, 67, 68,
+	68, 87, 87, 87, 81, 81, 81, 86, 86, 86,
+	91, 91, 93, 93, 93, 93, 93, 95, 95, 95,
+	95, 95, 95, 95, 90, 90, 92, 92, 92, 92,
+	224, 224, 224, 223, 223, 117, 117, 119, 118, 118,
+	120, 120, 121, 121, 121, 156, 136, 136, 191, 191,
+	190, 190, 192, 192, 192, 192, 194, 194, 122, 122,
+	122, 122, 123, 123, 124, 124, 125, 125, 232, 232,
+	229, 229, 229, 228, 228, 129, 129, 129, 131, 130,
+	130, 130, 130, 132, 132, 134, 134, 133, 133, 135,
+	137, 137, 137, 137, 137, 138, 138, 102, 102, 102,
+	102, 102, 102, 112, 112, 112, 112, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 107, 107,
+	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
+	107, 108, 108, 108, 108, 108, 108, 108, 108, 108,
 	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 204,
-	204, 139, 139, 147, 147, 147, 147, 140, 140, 140,
-	140, 140, 140, 140, 148, 148, 148, 154, 149, 149,
-	145, 145, 145, 145, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 144, 144, 144, 144, 144, 144,
-	144, 144, 80, 80, 80, 80, 80, 80, 80, 80,
-	80, 80, 80, 80, 79, 79, 79, 79, 79, 79,
-	79, 79, 79, 319, 319, 246, 246, 246, 146, 146,
-	146, 146, 146, 85, 85, 85, 85, 85, 235, 235,
-	235, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 237, 237, 160, 160, 82, 82, 158, 158,
-	159, 161, 161, 156, 156, 156, 142, 142, 142, 162,
-	162, 163, 163, 164, 164, 166, 165, 165, 167, 168,
-	168, 168, 169, 169, 170, 170, 170, 43, 43, 43,
-	43, 43, 38, 38, 38, 38, 39, 39, 39, 96,
-	96, 96, 96, 98, 98, 97, 97, 70, 70, 71,
-	71, 71, 99, 99, 100, 100, 100, 100, 186, 186,
-	171, 171, 171, 178, 178, 178, 174, 174, 176, 176,
-	176, 177, 177, 177, 175, 183, 183, 185, 185, 184,
-	184, 180, 180, 181, 181, 182, 182, 182, 179, 179,
-	141, 141, 141, 141, 141, 187, 187, 187, 187, 193,
-	193, 151, 151, 153, 153, 152, 116, 194, 194, 198,
-	195, 195, 199, 199, 199, 199, 199, 196, 196, 197,
-	197, 223, 223, 223, 203, 203, 214, 214, 211, 211,
-	212, 212, 205, 205, 216, 216, 216, 65, 150, 150,
-	282, 282, 279, 219, 219, 220, 220, 224, 224, 228,
-	228, 225, 225, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
-	218, 218, 218, 218, 315, 316, 233, 234, 234, 234,
+	108, 108, 108, 108, 108, 206, 206, 139, 139, 148,
+	148, 148, 148, 140, 140, 140, 140, 140, 140, 140,
+	149, 149, 149, 155, 150, 150, 145, 145, 145, 145,
+	147, 164, 164, 143, 143, 143, 143, 143, 143, 143,
+	143, 143, 143, 144, 144, 144, 144, 144, 144, 144,
+	144, 80, 80, 80, 80, 80, 80, 80, 80, 80,
+	80, 80, 80, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 321, 321, 248, 248, 248, 146, 146, 146,
+	146, 146, 85, 85, 85, 85, 85, 237, 237, 237,
+	239, 239, 239, 239, 239, 239, 239, 239, 239, 239,
+	239, 239, 239, 161, 161, 82, 82, 159, 159, 160,
+	162, 162, 157, 157, 157, 142, 142, 142, 163, 163,
+	165, 165, 166, 166, 168, 167, 167, 169, 170, 170,
+	170, 171, 171, 172, 172, 172, 43, 43, 43, 43,
+	43, 38, 38, 38, 38, 39, 39, 39, 96, 96,
+	96, 96, 98, 98, 97, 97, 70, 70, 71, 71,
+	71, 99, 99, 100, 100, 100, 100, 188, 188, 188,
+	188, 188, 188, 188, 173, 173, 173, 180, 180, 180,
+	176, 176, 178, 178, 178, 179, 179, 179, 177, 185,
+	185, 187, 187, 186, 186, 182, 182, 183, 183, 184,
+	184, 184, 181, 181, 141, 141, 141, 141, 141, 189,
+	189, 189, 189, 195, 195, 152, 152, 154, 154, 153,
+	116, 196, 196, 200, 197, 197, 201, 201, 201, 201,
+	201, 198, 198, 199, 199, 225, 225, 225, 205, 205,
+	216, 216, 213, 213, 214, 214, 207, 207, 218, 218,
+	218, 65, 151, 151, 284, 284, 281, 221, 221, 222,
+	222, 226, 226, 230, 230, 227, 227, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 317,
+	318, 235, 236, 236, 236,
 }
 
 var yyR2 = [...]int{
@@ -5240,96 +5285,98 @@ var yyR2 = [...]int{
 	1, 2, 2, 0, 1, 4, 4, 4, 4, 2,
 	4, 1, 3, 1, 1, 3, 4, 3, 3, 3,
 	4, 9, 0, 2, 0, 2, 3, 5, 3, 4,
-	2, 3, 2, 3, 3, 1, 1, 0, 2, 2,
-	3, 3, 2, 1, 1, 2, 2, 2, 2, 1,
-	1, 1, 1, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 1,
-	2, 1, 3, 1, 1, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
-	2, 2, 1, 2, 2, 2, 2, 3, 3, 2,
-	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
-	5, 5, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
-	1, 1, 0, 1, 0, 2, 2, 2, 0, 2,
-	2, 5, 0, 1, 1, 2, 1, 3, 2, 3,
-	0, 1, 4, 3, 3, 4, 2, 0, 2, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	1, 3, 3, 3, 1, 3, 1, 7, 5, 6,
-	6, 7, 0, 1, 5, 3, 3, 1, 1, 2,
-	2, 2, 0, 1, 1, 0, 1, 2, 0, 1,
-	1, 3, 2, 1, 2, 3, 3, 4, 4, 3,
-	3, 3, 3, 4, 4, 3, 3, 3, 3, 3,
+	2, 3, 2, 3, 3, 2, 2, 1, 1, 0,
+	2, 2, 3, 3, 2, 2, 2, 1, 1, 2,
+	2, 2, 2, 1, 1, 1, 1, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 1, 2, 1, 3, 1, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
+	2, 3, 3, 2, 2, 2, 2, 2, 2, 1,
+	1, 1, 1, 1, 5, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
+	0, 3, 5, 0, 1, 1, 0, 1, 0, 2,
+	2, 2, 0, 2, 2, 5, 0, 1, 1, 2,
+	1, 3, 2, 3, 0, 1, 4, 3, 3, 4,
+	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
+	1, 7, 5, 6, 6, 7, 0, 1, 5, 3,
+	3, 1, 1, 2, 2, 2, 0, 1, 1, 0,
+	1, 2, 0, 1, 1, 3, 2, 1, 2, 3,
+	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 0, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	0, 1, 0, 2, 0, 1, 5, 1, 3, 7,
-	1, 3, 3, 1, 2, 2, 2, 5, 5, 5,
-	6, 8, 6, 5, 5, 2, 2, 2, 2, 3,
-	3, 3, 4, 1, 3, 5, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 2, 4, 4,
-	2, 11, 3, 6, 8, 6, 6, 6, 13, 8,
-	6, 10, 5, 5, 5, 5, 5, 0, 10, 11,
-	7, 0, 8, 9, 0, 3, 0, 1, 0, 3,
-	1, 1, 3, 4, 0, 2, 0, 2, 5, 3,
-	7, 4, 4, 4, 4, 3, 3, 3, 7, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	0, 2, 2, 1, 3, 8, 8, 3, 3, 5,
-	7, 7, 6, 5, 3, 2, 3, 3, 3, 7,
-	3, 3, 3, 3, 4, 7, 5, 2, 4, 4,
-	4, 4, 4, 5, 5, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 4, 2, 4, 2, 4,
-	5, 4, 4, 4, 4, 4, 3, 3, 3, 5,
-	2, 3, 3, 3, 3, 1, 1, 0, 1, 0,
-	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
-	2, 0, 1, 1, 2, 1, 1, 2, 1, 1,
-	5, 0, 1, 0, 1, 2, 3, 0, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 1, 3, 3, 2, 2, 3, 1, 3,
-	2, 1, 2, 1, 2, 2, 4, 3, 3, 6,
-	4, 7, 6, 1, 3, 2, 2, 2, 2, 1,
-	1, 1, 3, 2, 1, 1, 1, 0, 1, 1,
-	0, 3, 0, 2, 0, 2, 1, 2, 2, 0,
-	1, 1, 0, 1, 1, 0, 1, 0, 1, 2,
-	3, 4, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 3, 1, 1, 1, 4, 3,
-	3, 3, 7, 0, 3, 1, 3, 1, 1, 3,
-	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
-	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
-	1, 2, 2, 1, 2, 3, 2, 3, 2, 2,
-	2, 1, 1, 3, 3, 0, 5, 4, 5, 5,
-	0, 2, 3, 3, 3, 2, 3, 1, 3, 4,
-	3, 1, 3, 4, 5, 6, 3, 4, 5, 6,
-	3, 4, 1, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	3, 1, 1, 2, 2, 2, 2, 1, 1, 2,
-	9, 6, 6, 6, 2, 2, 3, 3, 3, 0,
-	3, 1, 1, 1, 2, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	4, 5, 5, 6, 4, 4, 8, 6, 8, 6,
-	8, 5, 4, 2, 2, 1, 2, 2, 2, 8,
-	8, 6, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 4, 5, 0, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 2, 0, 2, 3, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 2, 2, 0,
-	3, 0, 2, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 0, 1, 2, 4, 4, 0, 2, 2,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 0,
-	3, 3, 3, 0, 3, 1, 1, 0, 4, 0,
-	1, 1, 0, 3, 1, 3, 2, 1, 2, 4,
-	9, 3, 5, 0, 3, 3, 0, 1, 0, 2,
-	2, 0, 2, 2, 2, 0, 2, 1, 2, 3,
-	3, 0, 2, 1, 2, 3, 4, 3, 0, 1,
-	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
-	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
-	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
-	0, 3, 0, 1, 0, 1, 1, 5, 0, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 0,
+	1, 1, 0, 1, 0, 1, 0, 2, 0, 1,
+	5, 1, 3, 7, 1, 3, 3, 1, 2, 2,
+	2, 5, 5, 5, 6, 8, 6, 5, 5, 2,
+	2, 2, 2, 3, 3, 3, 4, 1, 3, 5,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 2, 4, 4, 2, 11, 3, 6, 8, 6,
+	6, 6, 13, 8, 6, 10, 5, 5, 5, 5,
+	5, 0, 10, 11, 7, 0, 8, 9, 0, 3,
+	0, 1, 0, 3, 1, 1, 3, 4, 0, 2,
+	0, 2, 5, 3, 7, 4, 4, 4, 4, 3,
+	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
+	8, 3, 3, 5, 7, 7, 6, 5, 3, 2,
+	3, 3, 3, 7, 3, 3, 3, 3, 4, 7,
+	5, 2, 4, 4, 4, 4, 4, 5, 5, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	2, 4, 2, 4, 5, 4, 4, 4, 4, 4,
+	3, 3, 3, 5, 2, 3, 3, 3, 3, 1,
+	1, 0, 1, 0, 1, 1, 1, 0, 2, 2,
+	0, 2, 2, 0, 2, 0, 1, 1, 2, 1,
+	1, 2, 1, 1, 5, 0, 1, 0, 1, 2,
+	3, 0, 3, 3, 3, 3, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 3, 3,
+	2, 2, 3, 1, 3, 2, 1, 2, 1, 2,
+	2, 4, 3, 3, 6, 4, 7, 6, 1, 3,
+	2, 2, 2, 2, 1, 1, 1, 3, 2, 1,
+	1, 1, 0, 1, 1, 0, 3, 0, 2, 0,
+	2, 1, 2, 2, 0, 1, 1, 0, 1, 1,
+	0, 1, 0, 1, 2, 3, 4, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 2, 3, 5,
+	0, 1, 2, 1, 1, 0, 1, 2, 1, 3,
+	1, 1, 1, 4, 3, 3, 3, 7, 0, 3,
+	1, 3, 1, 1, 3, 3, 1, 3, 4, 4,
+	4, 3, 2, 4, 0, 1, 0, 2, 0, 1,
+	0, 1, 2, 1, 1, 1, 2, 2, 1, 2,
+	3, 2, 3, 2, 2, 2, 1, 1, 3, 3,
+	0, 5, 4, 5, 5, 0, 2, 3, 3, 3,
+	2, 3, 1, 3, 4, 3, 1, 3, 4, 5,
+	6, 3, 4, 5, 6, 3, 4, 1, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 3, 1, 1, 2,
+	2, 2, 2, 1, 1, 2, 9, 6, 6, 6,
+	2, 2, 3, 3, 3, 0, 3, 1, 1, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 4, 5, 5, 6,
+	6, 0, 3, 4, 4, 8, 6, 8, 6, 8,
+	5, 4, 2, 2, 1, 2, 2, 2, 8, 8,
+	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 2, 0, 2, 3, 4, 4, 4,
+	4, 4, 0, 3, 4, 7, 3, 1, 1, 1,
+	2, 3, 3, 1, 2, 2, 1, 2, 1, 2,
+	2, 1, 2, 0, 1, 0, 2, 1, 2, 4,
+	0, 2, 1, 3, 5, 1, 2, 2, 0, 3,
+	0, 2, 0, 1, 3, 1, 3, 2, 0, 1,
+	1, 0, 1, 2, 4, 4, 0, 2, 2, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 0, 3,
+	3, 3, 0, 3, 1, 1, 0, 4, 0, 1,
+	1, 0, 3, 1, 3, 2, 1, 2, 3, 4,
+	2, 3, 4, 4, 9, 3, 5, 0, 3, 3,
+	0, 1, 0, 2, 2, 0, 2, 2, 2, 0,
+	2, 1, 2, 3, 3, 0, 2, 1, 2, 3,
+	4, 3, 0, 1, 2, 1, 5, 4, 4, 1,
+	3, 3, 5, 0, 5, 1, 3, 1, 2, 3,
+	1, 1, 3, 3, 1, 3, 3, 3, 3, 3,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 0, 2, 0, 3, 0, 1, 0, 1,
+	1, 5, 0, 1, 0, 1, 2, 1, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5374,505 +5421,509 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -313, -6, -10, -15, -16, -17, -18, -19, -20,
+	-1000, -315, -6, -10, -15, -16, -17, -18, -19, -20,
 	-21, -22, -23, -24, -25, -26, -53, -54, -55, -57,
 	-58, -59, -60, -61, -13, -56, -27, -28, -62, -63,
 	-64, -65, -66, -8, -7, -12, 7, 8, -74, -29,
 	30, -34, -44, 165, -45, -35, 166, -46, 168, 167,
 	203, 169, 196, 72, 243, 244, 246, 247, 248, 249,
 	-75, 201, 202, 171, 34, 43, 31, 32, 35, 174,
-	-9, -30, 6, -315, 9, 367, 198, 197, 26, -11,
-	380, 83, -314, 514, -186, -171, 20, 31, 27, -170,
-	-166, -87, -171, 18, 16, 5, -67, -318, -67, -67,
-	10, 11, -67, -267, -269, 83, 139, 83, -67, -50,
-	-49, -48, -47, -51, 29, -41, -42, -288, -40, -37,
+	-9, -30, 6, -317, 9, 367, 198, 197, 26, -11,
+	380, 83, -316, 515, -188, -173, 20, 31, 27, -172,
+	-168, -87, -173, 18, 16, 5, -67, -320, -67, -67,
+	10, 11, -67, -269, -271, 83, 139, 83, -67, -50,
+	-49, -48, -47, -51, 29, -41, -42, -290, -40, -37,
 	170, 167, 211, 106, 107, 203, 204, 205, 169, 187,
-	202, 206, 201, 220, -36, 78, 31, 380, 383, -274,
-	166, 172, 173, 368, 109, 108, 73, 168, -271, 293,
-	484, -51, 486, 98, 100, 485, 42, 177, 487, 488,
-	489, 490, 186, 491, 492, 493, 494, 500, 501, 502,
-	503, 110, 5, -67, -228, -224, -292, -218, 86, 87,
-	88, 377, 189, 411, 412, 236, 78, 39, 293, 484,
+	202, 206, 201, 220, -36, 78, 31, 380, 383, -276,
+	166, 172, 173, 368, 109, 108, 73, 168, -273, 293,
+	485, -51, 487, 98, 100, 486, 42, 177, 488, 489,
+	490, 491, 186, 492, 493, 494, 495, 501, 502, 503,
+	504, 110, 5, -67, -230, -226, -294, -220, 86, 87,
+	88, 377, 189, 411, 412, 236, 78, 39, 293, 485,
 	243, 257, 251, 278, 270, 378, 413, 239, 190, 224,
-	481, 268, 115, 486, 242, 414, 204, 298, 299, 300,
-	98, 246, 335, 499, 241, 415, 497, 100, 485, 77,
-	49, 42, 199, 266, 262, 487, 225, 416, 388, 218,
-	109, 106, 506, 34, 260, 48, 28, 496, 108, 47,
-	488, 130, 417, 489, 302, 283, 475, 46, 303, 205,
-	418, 81, 382, 483, 304, 261, 305, 235, 495, 171,
-	419, 467, 306, 307, 476, 420, 284, 288, 421, 327,
-	308, 513, 50, 422, 423, 477, 107, 424, 76, 490,
-	255, 256, 425, 233, 188, 329, 282, 186, 33, 309,
-	379, 237, 56, 212, 330, 44, 286, 510, 43, 471,
-	426, 474, 281, 277, 51, 427, 428, 429, 430, 491,
-	280, 254, 276, 505, 400, 230, 492, 60, 173, 290,
-	289, 291, 219, 326, 273, 431, 432, 433, 193, 79,
-	434, 263, 19, 435, 436, 310, 226, 437, 54, 438,
-	439, 333, 202, 440, 52, 493, 37, 207, 507, 494,
-	441, 442, 443, 444, 445, 312, 446, 311, 285, 287,
-	214, 313, 381, 447, 259, 206, 498, 448, 194, 482,
-	208, 211, 201, 334, 195, 449, 450, 451, 452, 453,
-	240, 454, 455, 247, 500, 41, 456, 457, 458, 459,
-	234, 229, 328, 337, 59, 80, 295, 460, 480, 253,
-	227, 461, 244, 53, 501, 502, 503, 221, 504, 7,
-	511, 512, 315, 110, 231, 232, 45, 274, 213, 462,
-	463, 264, 265, 279, 252, 275, 245, 468, 215, 316,
-	203, 464, 336, 228, 296, 385, 220, 317, 479, 384,
-	272, 269, 222, 465, 318, 178, 216, 217, 466, 469,
-	319, 320, 238, 321, 322, 323, 324, 325, 223, 383,
-	250, 267, 297, 348, 349, 350, 351, 352, 353, 354,
-	355, 356, 357, 358, 359, 360, 361, 362, 363, 364,
-	365, 176, -67, 176, -133, -224, 176, -196, 299, -215,
-	301, 314, 309, 319, 307, -207, 310, 312, 214, -307,
-	327, 176, 316, 165, 156, 302, 311, 320, 321, 238,
-	322, 325, 223, -303, -292, 489, 504, 115, 271, 306,
-	304, 328, 471, 324, 323, -224, 245, -231, 250, -219,
-	-292, -218, 248, -133, -73, 467, 169, -233, -233, -89,
-	471, 473, -149, -102, 123, -112, -115, -107, -108, -143,
-	-144, -145, -146, -113, -156, 145, 146, 153, 124, -154,
-	-116, 24, 376, 369, 368, 156, 29, 161, 66, 67,
-	371, 372, 126, 55, 344, 345, -114, 340, 346, 341,
-	374, 375, 101, -219, -224, 181, 339, 175, 151, 367,
-	-109, -105, -7, -152, 338, 342, 343, 347, -292, -218,
-	-111, -110, -139, 89, 94, 99, 95, -312, 102, -315,
-	90, 91, 92, 93, 103, 104, 157, 159, 158, 160,
-	42, 315, 315, -133, -67, -164, -87, -166, -9, -7,
-	-315, 6, -67, -7, -8, -12, -30, -32, 407, -31,
-	-224, -171, -186, 10, 141, 40, 48, -169, -170, -11,
-	-7, -102, 17, 21, 22, -92, 147, -102, -224, -68,
-	-92, -205, 180, -67, -67, -195, -236, 245, -199, 328,
-	327, -220, -197, -219, -217, -196, 326, 170, 386, 122,
-	23, 25, 125, 156, 17, 126, 35, 172, 271, 187,
-	155, 183, 368, 165, 70, 387, 340, 341, 338, 344,
-	370, 371, 339, 301, 29, 11, 389, 26, 197, 22,
-	36, 149, 167, 129, 200, 24, 198, 101, 104, 392,
-	20, 73, 192, 12, 185, 38, 14, 393, 394, 15,
-	181, 180, 141, 177, 68, 9, 161, 27, 138, 64,
-	395, 117, 396, 397, 398, 399, 66, 139, 18, 508,
-	342, 343, 31, 472, 376, 209, 151, 71, 57, 473,
-	123, 401, 402, 102, 403, 105, 74, 478, 119, 16,
-	69, 40, 404, 210, 405, 182, 509, 406, 331, 407,
-	140, 168, 367, 67, 408, 175, 314, 6, 373, 30,
-	196, 184, 112, 65, 409, 176, 128, 374, 375, 179,
-	103, 5, 116, 32, 10, 72, 75, 345, 346, 347,
-	55, 111, 380, 127, 13, 410, 332, 121, 115, -268,
-	139, -255, -259, -219, 191, -284, 187, -133, -277, -276,
-	-219, -88, -214, 177, 185, 184, 116, -296, 119, 231,
-	339, 175, -47, -48, -196, 155, 208, 84, 84, -259,
-	-258, -257, -297, 210, 191, -283, -275, 183, 192, -265,
-	184, 185, -260, 177, 117, -297, -260, 182, 192, 210,
-	210, 110, 210, 110, 210, 210, 210, 210, 210, 210,
-	210, 210, 210, 207, -266, 131, -266, 384, 384, -271,
-	-297, -297, -297, 179, 33, 33, -216, -260, 179, 23,
-	-266, -266, -196, 155, -266, -266, -266, -266, 218, 218,
-	-266, -266, -266, -266, -266, -266, -266, -266, -266, -266,
-	-266, -266, -266, -266, -266, 176, -296, -96, 325, 238,
-	78, -49, 220, -33, -133, -214, 177, 178, -296, -133,
-	162, -133, -209, 139, 13, -209, -206, 315, 313, 300,
-	305, -209, -209, -209, -209, 221, 298, -261, 177, 33,
-	188, 315, 221, 298, 221, 222, 221, 222, 308, 318,
-	221, -229, 12, 141, 339, 303, 307, 214, 176, 215,
-	178, 317, -292, 474, 222, -229, 90, -210, 139, 315,
-	217, -209, -234, -315, -220, 271, -234, -234, 30, 179,
-	-219, -69, -219, 90, -14, -10, -18, -17, -19, 131,
-	-94, 315, -83, 156, 489, 475, 476, 477, 474, 312,
-	482, 480, 478, 221, 479, 84, 119, 121, 122, -102,
-	138, -140, 131, 132, 133, 134, 135, 136, 137, 141,
-	123, 125, 139, 140, 120, 142, 143, 144, 145, 146,
-	147, 148, 150, 149, 151, 152, 155, 163, 164, -108,
-	-108, -108, -108, -154, -315, -315, -315, -108, -204, -315,
-	-108, -315, -315, -315, -315, -160, -102, -315, -319, -315,
-	-319, -319, -246, -315, -246, -315, -315, -315, -315, 162,
-	-315, -315, -315, -315, -315, -246, -246, -246, -246, 99,
-	94, 89, -156, 95, 90, -219, -224, -7, -8, -149,
-	-233, -304, -305, -136, -133, -315, 238, -169, -11, -7,
-	-164, -170, -166, -7, -67, -81, -93, 61, 62, -95,
-	22, 36, 65, 63, 21, -316, 85, -316, -186, -316,
-	84, -32, -189, 83, 59, 41, 90, 90, 84, 19,
-	-165, -167, -102, 12, -222, -221, 23, -219, 90, 162,
-	97, 12, -134, 27, -133, -205, -205, 84, 245, -199,
-	-236, -201, -200, 329, 331, 131, -223, -219, 90, 29,
-	85, 84, -133, -238, -241, -243, -242, -244, -239, -240,
-	268, 269, 156, 272, 274, 275, 276, 277, 278, 279,
-	280, 281, 282, 283, 30, 199, 264, 265, 266, 267,
-	284, 285, 286, 287, 288, 289, 290, 291, 251, 270,
-	378, 252, 253, 254, 255, 256, 257, 259, 260, 261,
-	262, 263, -295, -292, 83, 85, 84, -245, 83, -96,
-	176, -292, 177, 177, 177, -67, 367, -266, -266, 207,
-	-40, -37, -288, 16, -36, -37, 170, 106, 107, 167,
-	83, -255, 83, -264, -295, -292, 83, 117, 182, 116,
-	-263, -260, -263, -264, -292, -156, -292, 117, 117, -192,
-	-219, -192, -192, 21, -192, 21, -192, 21, 92, -219,
-	-192, 21, -192, 21, -192, 21, -192, 21, -192, 21,
-	29, 76, 77, 29, 79, 80, 81, -156, -156, -255,
-	-196, -133, -292, 92, 92, -266, -266, 92, 90, 90,
-	90, -266, -266, 92, 90, -226, -224, 90, -298, 193,
-	235, 237, 92, 92, 92, 92, 29, 90, -299, 29,
-	496, 495, 497, 498, 499, 92, 29, 92, 29, 92,
-	-219, 83, -133, -99, 225, 165, 167, 170, 74, 90,
-	239, 131, 42, 84, 179, 176, -292, -211, 181, -211,
-	-225, -224, -217, 90, -102, -262, 12, 141, -229, -229,
-	-209, -133, -262, -229, -209, -133, -209, -209, -209, -209,
-	-229, -229, -229, -209, -224, -224, -133, -133, -133, -133,
-	-133, -133, -133, -234, -234, -234, -210, -209, 474, 90,
-	74, -232, 248, 282, 468, 469, 470, 84, 380, -126,
-	-133, 474, 474, 474, 474, 474, 474, -102, -102, -102,
-	-102, -147, 102, 123, 103, 104, -115, -148, -152, -154,
-	96, 141, 125, 139, 140, -107, -108, -107, -107, -107,
-	-107, -107, -107, -107, -107, -107, -107, -107, -107, -107,
-	-235, -292, 90, 156, 90, 90, -90, -92, -102, -102,
-	-292, -219, -90, -90, -102, -86, 22, 36, -158, -159,
-	127, -156, -316, -316, 92, -219, -219, -80, -79, 349,
+	482, 268, 115, 487, 242, 414, 204, 298, 299, 300,
+	98, 246, 335, 500, 241, 415, 498, 100, 486, 77,
+	471, 49, 42, 199, 266, 262, 488, 225, 416, 388,
+	218, 109, 106, 507, 34, 260, 48, 28, 497, 108,
+	47, 489, 130, 417, 490, 302, 283, 476, 46, 303,
+	205, 418, 81, 382, 484, 304, 261, 305, 235, 496,
+	171, 419, 467, 306, 307, 477, 420, 284, 288, 421,
+	327, 308, 514, 50, 422, 423, 478, 107, 424, 76,
+	491, 255, 256, 425, 233, 188, 329, 282, 186, 33,
+	309, 379, 237, 56, 212, 330, 44, 286, 511, 43,
+	472, 426, 475, 281, 277, 51, 427, 428, 429, 430,
+	492, 280, 254, 276, 506, 400, 230, 493, 60, 173,
+	290, 289, 291, 219, 326, 273, 431, 432, 433, 193,
+	79, 434, 263, 19, 435, 436, 310, 226, 437, 54,
+	438, 439, 333, 202, 440, 52, 494, 37, 207, 508,
+	495, 441, 442, 443, 444, 445, 312, 446, 311, 285,
+	287, 214, 313, 381, 447, 259, 206, 499, 448, 194,
+	483, 208, 211, 201, 334, 195, 449, 450, 451, 452,
+	453, 240, 454, 455, 247, 501, 41, 456, 457, 458,
+	459, 234, 229, 328, 337, 59, 80, 295, 460, 481,
+	253, 227, 461, 244, 53, 502, 503, 504, 221, 505,
+	7, 512, 513, 315, 110, 231, 232, 45, 274, 213,
+	462, 463, 264, 265, 279, 252, 275, 245, 468, 215,
+	316, 203, 464, 336, 228, 296, 385, 220, 317, 480,
+	384, 272, 269, 222, 465, 318, 178, 216, 217, 466,
+	469, 319, 320, 238, 321, 322, 323, 324, 325, 223,
+	383, 250, 267, 297, 348, 349, 350, 351, 352, 353,
+	354, 355, 356, 357, 358, 359, 360, 361, 362, 363,
+	364, 365, 176, -67, 176, -133, -226, 176, -198, 299,
+	-217, 301, 314, 309, 319, 307, -209, 310, 312, 214,
+	-309, 327, 176, 316, 165, 156, 302, 311, 320, 321,
+	238, 322, 325, 223, -305, -294, 490, 505, 115, 271,
+	306, 304, 328, 472, 324, 323, -226, 245, -233, 250,
+	-221, -294, -220, 248, -133, -73, 467, 169, -235, -235,
+	-89, 472, 474, -150, -102, 123, -112, -115, -107, -108,
+	-143, -144, -145, -146, -147, -113, -157, 145, 146, 153,
+	124, -155, -116, 24, 376, 369, 368, 156, 29, 161,
+	66, 67, 371, 372, 126, 55, 344, 345, -114, 340,
+	346, 341, 374, 375, 101, -221, -226, 181, 339, 175,
+	151, 367, -109, -105, -7, -153, 338, 342, 343, 347,
+	-294, -220, -111, -110, -139, 89, 94, 99, 95, -314,
+	102, -317, 90, 91, 92, 93, 103, 104, 157, 159,
+	158, 160, 42, 315, 315, -133, -67, -166, -87, -168,
+	-9, -7, -317, 6, -67, -7, -8, -12, -30, -32,
+	407, -31, -226, -173, -188, 10, 59, 141, 40, 48,
+	-171, -172, -11, -7, -102, 17, 21, 22, -92, 147,
+	-102, -226, -68, -92, -207, 180, -67, -67, -197, -238,
+	245, -201, 328, 327, -222, -199, -221, -219, -198, 326,
+	170, 386, 122, 23, 25, 125, 156, 17, 126, 35,
+	172, 271, 187, 155, 183, 368, 165, 70, 387, 340,
+	341, 338, 344, 370, 371, 339, 301, 29, 11, 389,
+	26, 197, 22, 36, 149, 167, 129, 200, 24, 198,
+	101, 104, 392, 20, 73, 192, 12, 185, 38, 14,
+	393, 394, 15, 181, 180, 141, 177, 68, 9, 161,
+	27, 138, 64, 395, 117, 396, 397, 398, 399, 66,
+	139, 18, 509, 342, 343, 31, 473, 376, 209, 151,
+	71, 57, 474, 123, 401, 402, 102, 403, 105, 74,
+	479, 119, 16, 69, 40, 404, 210, 405, 182, 510,
+	406, 331, 407, 140, 168, 367, 67, 408, 175, 314,
+	6, 373, 30, 196, 184, 112, 65, 409, 176, 128,
+	374, 375, 179, 103, 5, 116, 32, 10, 72, 75,
+	345, 346, 347, 55, 111, 380, 127, 13, 410, 332,
+	121, 115, -270, 139, -257, -261, -221, 191, -286, 187,
+	-133, -279, -278, -221, -88, -216, 177, 185, 184, 116,
+	-298, 119, 231, 339, 175, -47, -48, -198, 155, 208,
+	84, 84, -261, -260, -259, -299, 210, 191, -285, -277,
+	183, 192, -267, 184, 185, -262, 177, 117, -299, -262,
+	182, 192, 210, 210, 110, 210, 110, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 207, -268, 131, -268,
+	384, 384, -273, -299, -299, -299, 179, 33, 33, -218,
+	-262, 179, 23, -268, -268, -198, 155, -268, -268, -268,
+	-268, 218, 218, -268, -268, -268, -268, -268, -268, -268,
+	-268, -268, -268, -268, -268, -268, -268, -268, 176, -298,
+	-96, 325, 238, 78, -49, 220, -33, -133, -216, 177,
+	178, -298, -133, 162, -133, -211, 139, 13, -211, -208,
+	315, 313, 300, 305, -211, -211, -211, -211, 221, 298,
+	-263, 177, 33, 188, 315, 221, 298, 221, 222, 221,
+	222, 308, 318, 221, -231, 12, 141, 339, 303, 307,
+	214, 176, 215, 178, 317, -294, 475, 222, -231, 90,
+	-212, 139, 315, 217, -211, -236, -317, -222, 271, -236,
+	-236, 30, 179, -221, -69, -221, 90, -14, -10, -18,
+	-17, -19, 131, -94, 315, -83, 156, 490, 476, 477,
+	478, 475, 312, 483, 481, 479, 221, 480, 84, 119,
+	121, 122, -102, 138, -140, 131, 132, 133, 134, 135,
+	136, 137, 141, 123, 125, 139, 140, 120, 142, 143,
+	144, 145, 146, 147, 148, 150, 149, 151, 152, 155,
+	404, 163, 164, -108, -108, -108, -108, -155, -317, -317,
+	-317, -108, -206, -317, -108, -317, -317, -317, -317, -161,
+	-102, -317, -321, -317, -321, -321, -248, -317, -248, -317,
+	-317, -317, -317, 162, -317, -317, -317, -317, -317, -248,
+	-248, -248, -248, 99, 94, 89, -157, 95, 90, -221,
+	-226, -7, -8, -150, -235, -306, -307, -136, -133, -317,
+	238, -171, -11, -7, -166, -172, -168, -7, -67, -81,
+	-93, 61, 62, -95, 22, 36, 65, 63, 21, -318,
+	85, -318, -188, -318, 84, -32, -191, 83, 433, 460,
+	433, 460, 59, 41, 90, 90, 84, 19, -167, -169,
+	-102, 12, -224, -223, 23, -221, 90, 162, 97, 12,
+	-134, 27, -133, -207, -207, 84, 245, -201, -238, -203,
+	-202, 329, 331, 131, -225, -221, 90, 29, 85, 84,
+	-133, -240, -243, -245, -244, -246, -241, -242, 268, 269,
+	156, 272, 274, 275, 276, 277, 278, 279, 280, 281,
+	282, 283, 30, 199, 264, 265, 266, 267, 284, 285,
+	286, 287, 288, 289, 290, 291, 251, 270, 378, 252,
+	253, 254, 255, 256, 257, 259, 260, 261, 262, 263,
+	-297, -294, 83, 85, 84, -247, 83, -96, 176, -294,
+	177, 177, 177, -67, 367, -268, -268, 207, -40, -37,
+	-290, 16, -36, -37, 170, 106, 107, 167, 83, -257,
+	83, -266, -297, -294, 83, 117, 182, 116, -265, -262,
+	-265, -266, -294, -157, -294, 117, 117, -194, -221, -194,
+	-194, 21, -194, 21, -194, 21, 92, -221, -194, 21,
+	-194, 21, -194, 21, -194, 21, -194, 21, 29, 76,
+	77, 29, 79, 80, 81, -157, -157, -257, -198, -133,
+	-294, 92, 92, -268, -268, 92, 90, 90, 90, -268,
+	-268, 92, 90, -228, -226, 90, -300, 193, 235, 237,
+	92, 92, 92, 92, 29, 90, -301, 29, 497, 496,
+	498, 499, 500, 92, 29, 92, 29, 92, -221, 83,
+	-133, -99, 225, 165, 167, 170, 74, 90, 239, 131,
+	42, 84, 179, 176, -294, -213, 181, -213, -227, -226,
+	-219, 90, -102, -264, 12, 141, -231, -231, -211, -133,
+	-264, -231, -211, -133, -211, -211, -211, -211, -231, -231,
+	-231, -211, -226, -226, -133, -133, -133, -133, -133, -133,
+	-133, -236, -236, -236, -212, -211, 475, 90, 74, -234,
+	248, 282, 468, 469, 470, 471, 84, 380, -126, -133,
+	475, 475, 475, 475, 475, 475, -102, -102, -102, -102,
+	-148, 102, 123, 103, 104, -115, -149, -153, -155, 96,
+	141, 125, 139, 140, -107, -108, -107, -107, -107, -107,
+	-107, -107, -107, -107, -107, -107, -107, -107, -107, -237,
+	-294, 90, 156, -317, 90, 90, -90, -92, -102, -102,
+	-294, -221, -90, -90, -102, -86, 22, 36, -159, -160,
+	127, -157, -318, -318, 92, -221, -221, -80, -79, 349,
 	350, 351, 352, 354, 355, 356, 359, 360, 364, 365,
 	348, 366, 353, 358, 361, 362, 363, 357, 267, -91,
-	22, 36, -90, -220, -225, -217, -90, -91, -91, -90,
-	-90, 162, -186, -316, 84, -306, 331, 332, 472, -227,
-	210, -226, 23, -150, -149, 90, -169, -169, 61, 62,
-	57, -90, -95, -316, -31, 23, -188, -219, 60, 90,
-	-247, -196, -102, -102, 84, -168, 25, 26, -133, -221,
-	147, -225, -133, -191, 210, -133, -118, -120, -121, -122,
-	-136, -155, -315, 12, -126, -127, -135, -224, -199, -201,
-	84, 330, 332, 333, 74, 105, -102, -248, 155, -273,
-	-272, -271, -255, -257, -258, -259, 85, -172, -101, 38,
-	-251, 296, 295, -245, -245, -245, -245, -245, -247, -247,
-	-247, -247, 83, 83, -245, -245, -245, -245, -249, 83,
-	-249, -249, -250, 83, -250, -284, -102, -281, -280, -278,
-	-279, 186, 98, 380, 75, -276, -168, 92, -99, -212,
-	181, -282, -279, -292, -292, -292, -212, -292, 90, -292,
-	90, 84, 17, -256, -255, -52, 235, -287, 210, -283,
-	-277, -264, 117, -263, -264, -264, -292, 84, 27, 110,
-	110, 110, 110, 380, 167, 30, -255, -52, -235, 179,
-	-235, -235, 90, 90, -208, 504, -126, -98, 227, 131,
-	216, 216, 176, 176, 229, -133, 240, 242, 241, 239,
-	21, 228, 230, 232, 218, -133, -133, -211, 74, -128,
-	-133, 24, -224, -133, -209, -209, -133, -209, -209, -133,
-	-219, -83, 331, 84, 380, 20, -84, 20, 102, 103,
-	104, -148, -107, -108, -107, 122, 200, 84, -316, 23,
-	84, 75, -316, -316, -316, 84, 12, -90, -161, -159,
-	129, -102, -316, -316, 84, 84, 12, -316, -90, -90,
-	-315, 162, -316, -316, -316, -316, -316, -220, -305, 471,
-	332, -137, 72, 180, 73, -315, -226, -316, -142, -219,
-	92, 95, -171, -117, -119, 12, -95, -154, 85, 84,
-	-247, -175, -180, -206, -292, 90, 156, -167, 162, -138,
-	13, -141, 30, 55, -10, -315, -315, 30, 84, -129,
-	-131, -130, -132, 64, 68, 70, 65, 66, 67, 71,
-	-230, 23, -118, -8, -7, -315, -133, -126, -317, 12,
-	75, -317, 84, 162, -200, -202, 334, 331, 337, -292,
-	90, -76, 210, 84, -271, -259, -311, 102, 123, 29,
-	74, 293, 98, -309, 155, -310, 182, 116, 117, 194,
-	23, 39, -252, 297, -247, -247, -254, 90, -254, 92,
-	92, 85, -43, -38, -39, 31, 78, -278, -266, 90,
-	37, -219, 85, -98, -133, 123, 74, -282, -282, -282,
-	-224, 16, -188, 84, 85, -157, 236, 85, -219, 85,
-	83, -264, -264, -219, -315, 176, 29, 29, -52, -157,
-	-248, -292, 506, 505, 85, 178, 234, -100, 344, 90,
-	86, -133, -133, -133, -133, -133, 170, 167, 219, 179,
-	-126, -133, 84, -72, 195, 190, -229, -229, 31, -133,
-	331, 483, 481, 122, 200, -115, -108, -92, 377, -237,
-	156, 268, 199, 266, 262, 282, 273, 295, 264, 296,
-	-237, -235, -102, -102, -164, 130, -102, 128, -102, -102,
-	-102, -316, -316, -91, -220, 177, 177, 177, -192, -203,
-	20, 12, 55, 55, -117, -138, -118, -95, -219, -178,
-	467, -183, 44, -181, -182, 45, -179, 46, 54, 147,
-	-169, -102, -193, 74, -194, -198, -156, -151, -153, -152,
-	-315, -187, -316, -219, -192, -194, -120, -121, -121, -120,
-	-121, 64, 64, 64, 69, 64, 69, 64, -130, -224,
-	-316, -316, -8, -227, 75, -118, -118, -135, -224, 147,
-	331, 335, 336, 17, -271, 102, -315, -106, -114, -104,
-	-103, -105, -109, 145, 146, 10, 90, 90, -292, 117,
-	117, -133, 83, 85, 84, 85, 84, 85, 84, -213,
-	417, 123, -39, -38, -266, -266, 92, -292, 178, 24,
-	-133, 74, 74, 74, 17, -255, -156, 55, -281, -188,
-	-285, -287, -133, -104, -315, -157, -133, -97, 225, 233,
-	83, 87, -294, 75, 216, 293, 216, -133, -72, -43,
-	-133, -209, -209, 31, -292, -115, -108, -315, -316, -245,
-	-245, -245, -250, -245, 256, -245, 256, -316, -316, 84,
-	-316, 20, -316, -82, 373, -102, 84, 84, -316, -316,
-	-315, -315, -315, -316, -133, -138, -162, 14, -175, 49,
-	274, -185, -184, 53, 45, -182, 17, 47, 17, 28,
-	-193, 84, 131, 84, -316, -316, 84, 55, 162, -316,
+	22, 36, -90, -222, -227, -219, -90, -91, -91, -90,
+	-90, 162, -188, -318, 84, -308, 331, 332, 473, -229,
+	210, -228, 23, -151, -150, 90, -171, -171, 61, 62,
+	57, -90, -95, -318, -31, 23, -190, -221, 426, 426,
+	60, 90, -249, -198, -102, -102, 84, -170, 25, 26,
+	-133, -223, 147, -227, -133, -193, 210, -133, -118, -120,
+	-121, -122, -136, -156, -317, 12, -126, -127, -135, -226,
+	-201, -203, 84, 330, 332, 333, 74, 105, -102, -250,
+	155, -275, -274, -273, -257, -259, -260, -261, 85, -174,
+	-101, 38, -253, 296, 295, -247, -247, -247, -247, -247,
+	-249, -249, -249, -249, 83, 83, -247, -247, -247, -247,
+	-251, 83, -251, -251, -252, 83, -252, -286, -102, -283,
+	-282, -280, -281, 186, 98, 380, 75, -278, -170, 92,
+	-99, -214, 181, -284, -281, -294, -294, -294, -214, -294,
+	90, -294, 90, 84, 17, -258, -257, -52, 235, -289,
+	210, -285, -279, -266, 117, -265, -266, -266, -294, 84,
+	27, 110, 110, 110, 110, 380, 167, 30, -257, -52,
+	-237, 179, -237, -237, 90, 90, -210, 505, -126, -98,
+	227, 131, 216, 216, 176, 176, 229, -133, 240, 242,
+	241, 239, 21, 228, 230, 232, 218, -133, -133, -213,
+	74, -128, -133, 24, -226, -133, -211, -211, -133, -211,
+	-211, -133, -221, -83, 331, 84, 380, 20, -84, 20,
+	102, 103, 104, -149, -107, -108, -107, 122, 200, -164,
+	210, 84, -318, 23, 84, 75, -318, -318, -318, 84,
+	12, -90, -162, -160, 129, -102, -318, -318, 84, 84,
+	12, -318, -90, -90, -317, 162, -318, -318, -318, -318,
+	-318, -222, -307, 472, 332, -137, 72, 180, 73, -317,
+	-228, -318, -142, -221, 92, 95, -173, -117, -119, 12,
+	-95, -155, 85, 84, -249, -177, -182, -208, -294, 90,
+	156, -169, 162, -138, 13, -141, 30, 55, -10, -317,
+	-317, 30, 84, -129, -131, -130, -132, 64, 68, 70,
+	65, 66, 67, 71, -232, 23, -118, -8, -7, -317,
+	-133, -126, -319, 12, 75, -319, 84, 162, -202, -204,
+	334, 331, 337, -294, 90, -76, 210, 84, -273, -261,
+	-313, 102, 123, 29, 74, 293, 98, -311, 155, 466,
+	425, -312, 182, 116, 117, 194, 23, 39, -254, 297,
+	-249, -249, -256, 90, -256, 92, 92, 85, -43, -38,
+	-39, 31, 78, -280, -268, 90, 37, -221, 85, -98,
+	-133, 123, 74, -284, -284, -284, -226, 16, -190, 84,
+	85, -158, 236, 85, -221, 85, 83, -266, -266, -221,
+	-317, 176, 29, 29, -52, -158, -250, -294, 507, 506,
+	85, 178, 234, -100, 344, 90, 86, -133, -133, -133,
+	-133, -133, 170, 167, 219, 179, -126, -133, 84, -72,
+	195, 190, -231, -231, 31, -133, 331, 484, 482, 122,
+	200, -115, -108, -166, 17, -92, 377, -239, 156, 268,
+	199, 266, 262, 282, 273, 295, 264, 296, -239, -237,
+	-102, -102, -166, 130, -102, 128, -102, -102, -102, -318,
+	-318, -91, -222, 177, 177, 177, -194, -205, 20, 12,
+	55, 55, -117, -138, -118, -95, -221, -180, 467, -185,
+	44, -183, -184, 45, -181, 46, 54, 147, -171, -102,
+	-195, 74, -196, -200, -157, -152, -154, -153, -317, -189,
+	-318, -221, -194, -196, -120, -121, -121, -120, -121, 64,
+	64, 64, 69, 64, 69, 64, -130, -226, -318, -318,
+	-8, -229, 75, -118, -118, -135, -226, 147, 331, 335,
+	336, 17, -273, 102, -317, -106, -114, -104, -103, -105,
+	-109, 145, 146, 10, 90, 90, -294, 117, 117, -133,
+	83, 85, 84, 85, 84, 85, 84, -215, 417, 123,
+	-39, -38, -268, -268, 92, -294, 178, 24, -133, 74,
+	74, 74, 17, -257, -157, 55, -283, -190, -287, -289,
+	-133, -104, -317, -158, -133, -97, 225, 233, 83, 87,
+	-296, 75, 216, 293, 216, -133, -72, -43, -133, -211,
+	-211, 31, -294, -115, -108, -318, -150, -317, -318, -247,
+	-247, -247, -252, -247, 256, -247, 256, -318, -318, 84,
+	-318, 20, -318, -82, 373, -102, 84, 84, -318, -318,
+	-317, -317, -317, -318, -133, -138, -163, 14, -177, 49,
+	274, -187, -186, 53, 45, -184, 17, 47, 17, 28,
+	-195, 84, 131, 84, -318, -318, 84, 55, 162, -318,
 	-138, -124, -123, 74, 75, -125, 74, -123, 64, 64,
-	-189, -316, -191, -118, -138, -138, 162, -1, -2, 508,
-	509, 510, -102, -110, -110, -144, 83, -102, -247, 90,
-	-247, 92, 92, 417, 29, 79, 80, 81, 29, 76,
-	77, -133, -133, -133, -133, -188, 212, 85, -316, 84,
-	-253, 380, 383, -102, -189, 85, -291, 380, -293, -219,
-	-219, -219, -219, -107, -247, -292, -102, -102, -169, 90,
-	-102, -102, -190, -316, -219, 182, -190, -190, -227, -162,
-	-163, 15, 17, -176, 51, -174, 50, -174, -184, 17,
-	17, 90, 17, 90, 117, -198, -102, -153, 55, -10,
-	-219, -151, -219, -164, -102, 83, -102, -138, -138, 513,
-	117, -77, 83, 300, -316, -188, 85, 85, 85, -189,
-	213, -310, -287, 384, 384, -316, 23, -290, -289, -220,
-	83, 75, -85, 141, 380, -316, -316, -316, -316, -316,
-	84, -316, -316, -316, -137, -163, -102, -149, -177, 52,
-	74, 105, 90, 90, 90, 10, -151, 162, -169, -188,
-	-164, 83, -5, 78, -3, 507, -102, 83, 85, -173,
-	23, -315, -10, 84, 131, -188, -133, -316, 378, 71,
-	381, -219, 182, 74, 105, -194, -219, 85, -169, -102,
-	83, 131, -78, 511, 92, 85, -188, -301, -302, 74,
-	-311, -308, 102, 123, 98, -309, 111, 112, -10, -102,
-	209, -70, 380, -289, -270, -220, 90, 92, 85, 60,
-	379, 382, 85, -188, 92, -286, 83, 17, 85, -302,
-	74, 11, 10, 102, 90, -70, -316, -316, -71, 224,
-	471, -294, 60, -3, 85, -285, -1, -300, 195, 190,
-	193, 30, -300, 187, -291, 380, -78, -3, 85, 513,
-	117, 189, 29, 102, 226, 381, -286, -78, 83, -5,
-	382, -286, -102, 83, 85, -188, -4, 512, 85, 92,
-	-4,
+	-191, -318, -193, -118, -138, -138, 162, -1, -2, 509,
+	510, 511, -102, -110, -110, -144, 83, -102, -249, 90,
+	-249, 92, 92, 417, 29, 79, 80, 81, 29, 76,
+	77, -133, -133, -133, -133, -190, 212, 85, -318, 84,
+	-255, 380, 383, -102, -191, 85, -293, 380, -295, -221,
+	-221, -221, -221, -107, -249, -294, -102, -102, -171, 90,
+	-102, -102, -192, -318, -221, 182, -192, -192, -229, -163,
+	-165, 15, 17, -178, 51, -176, 50, -176, -186, 17,
+	17, 90, 17, 90, 117, -200, -102, -154, 55, -10,
+	-221, -152, -221, -166, -102, 83, -102, -138, -138, 514,
+	117, -77, 83, 300, -318, -190, 85, 85, 85, -191,
+	213, -312, -289, 384, 384, -318, 23, -292, -291, -222,
+	83, 75, -85, 141, 380, -318, -318, -318, -318, -318,
+	84, -318, -318, -318, -137, -165, -102, -150, -179, 52,
+	74, 105, 90, 90, 90, 10, -152, 162, -171, -190,
+	-166, 83, -5, 78, -3, 508, -102, 83, 85, -175,
+	23, -317, -10, 84, 131, -190, -133, -318, 378, 71,
+	381, -221, 182, 74, 105, -196, -221, 85, -171, -102,
+	83, 131, -78, 512, 92, 85, -190, -303, -304, 74,
+	-313, -310, 102, 123, 98, 466, 425, -311, 111, 112,
+	-10, -102, 209, -70, 380, -291, -272, -222, 90, 92,
+	85, 60, 379, 382, 85, -190, 92, -288, 83, 17,
+	85, -304, 74, 11, 10, 102, 90, -70, -318, -318,
+	-71, 224, 472, -296, 60, -3, 85, -287, -1, -302,
+	195, 190, 193, 30, -302, 187, -293, 380, -78, -3,
+	85, 514, 117, 189, 29, 102, 226, 381, -288, -78,
+	83, -5, 382, -288, -102, 83, 85, -190, -4, 513,
+	85, 92, -4,
 }
 
 var yyDef = [...]int{
 	-2, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 65, 67, 68, 662, 662, 662, 0,
-	662, 0, 0, 662, -2, -2, 662, 1069, 0, 662,
-	0, 0, -2, 595, 596, 0, 598, -2, 0, 0,
-	607, 1516, 1516, 657, 0, 0, 0, 0, 0, 662,
-	933, 45, 662, 0, 80, 81, 613, 614, 615, 60,
-	0, 1514, 1, 3, 66, 70, 0, 0, 0, 53,
-	942, 0, 73, 0, 0, 666, 0, 664, 0, 1052,
-	662, 662, 0, 111, 112, 0, 0, 0, -2, 115,
-	-2, 139, 140, 0, 144, 447, 407, 450, 405, 436,
-	-2, 398, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 410, 300, 300, 0, 0, -2,
-	398, 398, 398, 0, 0, 0, 433, 1054, 353, 300,
-	300, 0, 300, 300, 300, 300, 0, 0, 300, 300,
-	300, 300, 300, 300, 300, 300, 300, 300, 300, 300,
-	300, 300, 300, 959, 143, 1070, 1067, 1068, 35, 36,
-	37, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
-	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
-	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
-	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
-	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
-	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
-	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
-	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
-	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
-	1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372,
-	1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382,
-	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392,
-	1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402,
-	1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412,
-	1413, 1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422,
-	1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432,
-	1433, 1434, 1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442,
-	1443, 1444, 1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452,
-	1453, 1454, 1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462,
-	1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472,
-	1473, 1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482,
-	1483, 1484, 1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492,
-	1493, 1494, 1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502,
-	1503, 1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512,
-	1513, 0, 1046, 0, 525, 752, 0, 586, 586, 0,
-	586, 586, 586, 586, 0, 0, 0, 537, 0, 0,
-	0, 0, 583, 0, 0, 556, 558, 0, 583, 0,
-	589, 0, 570, 586, 1517, 1517, 1517, 1037, 0, 580,
-	578, 592, 593, 575, 576, 594, 597, 0, 602, 605,
-	1063, 1064, 0, 620, 44, 1294, 612, 625, 626, 0,
-	658, 659, 40, 838, 0, 767, 771, 782, 795, 796,
-	797, 798, 799, 801, 802, 0, 0, 0, 0, 807,
-	808, 0, 0, 0, 0, 0, 819, 0, 0, 0,
-	0, 0, 914, 0, 883, 883, 855, 883, 885, 885,
-	0, 0, 0, 923, 0, 0, 0, 0, 0, 0,
-	211, 212, 837, 1026, 885, 885, 885, 885, -2, -2,
-	195, 196, 197, 198, 199, 200, 201, 0, 191, 0,
-	215, 216, 213, 214, 821, 822, 207, 208, 209, 210,
-	1516, 0, 635, 0, 0, 942, 0, 934, 933, 58,
-	0, 662, -2, 0, 0, 0, 0, 42, 0, 47,
-	713, 72, 71, 978, 0, 0, 0, 54, 943, 62,
-	64, 944, 0, 667, 668, 0, 691, 695, 0, 663,
-	0, 0, 1053, 1052, 1052, 97, 0, 1459, 1030, -2,
-	-2, 0, 0, 1065, 1066, 1039, -2, 1073, 1074, 1075,
-	1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085,
-	1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095,
-	1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105,
-	1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115,
-	1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125,
-	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
-	1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145,
-	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155,
-	1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165,
-	1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175,
-	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
-	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195,
-	1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205,
-	1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, -2, 0,
-	0, 153, 154, 0, 38, 326, 0, 149, 0, 320,
-	272, 959, 0, 0, 0, 0, 0, 662, 0, 1047,
-	134, 135, 141, 142, 300, 300, 0, 143, 143, 414,
-	415, 416, 0, 0, -2, 324, 0, 399, 0, 0,
-	314, 314, 318, 316, 317, 0, 0, 0, 0, 0,
-	0, 427, 0, 428, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 509, 0, 301, 0, 445, 446, 354,
-	0, 0, 0, 0, 425, 426, 0, 0, 1055, 1056,
-	0, 0, 300, 300, 0, 0, 0, 0, 300, 300,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 133, 972, 0, 0,
-	0, -2, 0, 517, 0, 0, 0, 1048, 1048, 524,
-	0, 526, 527, 0, 0, 528, 0, 583, 583, 581,
-	582, 530, 531, 532, 533, 586, 0, 0, 309, 310,
-	311, 583, 586, 0, 586, 586, 586, 586, 583, 583,
-	583, 586, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1517, 1517, 1517, 589, 586, 0, 566, 0, 567,
-	568, 571, 572, 1518, 1519, 1084, 573, 574, 1038, 603,
-	606, 623, 621, 622, 624, 616, 617, 618, 619, 0,
-	637, 638, 643, 0, 0, 0, 0, 649, 650, 651,
-	0, 0, 654, 655, 656, 0, 0, 0, 0, 765,
-	0, 0, 827, 828, 829, 830, 831, 832, 833, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 803,
-	804, 805, 806, 809, 0, 0, 0, 814, 815, 0,
-	0, 0, 0, 0, 672, 0, 915, 0, 853, 0,
-	854, 856, 857, 0, 858, 0, 0, 0, 675, 0,
-	0, 675, 675, 0, 0, 185, 186, 187, 188, 202,
-	203, 204, 205, 206, 217, 923, 0, 837, 0, 0,
-	41, 627, 628, 0, 735, 1058, 0, 52, 61, 63,
-	942, 56, 942, 0, 677, 0, 0, -2, -2, 678,
-	684, 685, 686, 687, 688, 49, 1515, 50, 0, 69,
-	0, 43, 0, 0, 0, 0, 284, 981, 0, 0,
-	935, 936, 939, 0, 692, 696, 0, 698, 699, 0,
-	665, 0, 95, 0, 751, 0, 0, 0, 1459, 1036,
-	0, 99, 100, 0, 0, 0, 288, 1041, 1042, 1043,
-	-2, 307, 0, -2, 279, 223, 224, 225, 272, 227,
-	272, 272, 272, 272, 284, 284, 284, 284, 255, 256,
-	257, 258, 259, 0, 0, 242, 272, 272, 272, 272,
-	262, 263, 264, 265, 266, 267, 268, 269, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 274, 274, 274,
-	276, 276, 0, 39, 0, 292, 0, 939, 0, 972,
-	1050, 1060, 0, 0, 0, 1050, 117, 0, 0, 448,
-	408, 437, 449, 0, 411, 412, -2, 0, 0, 398,
-	0, 400, 0, 308, 0, -2, 0, 318, 0, 314,
-	318, 315, 318, 306, 319, 429, 430, 431, 0, 489,
-	721, 0, 0, 0, 0, 0, 495, 496, 497, 0,
-	499, 500, 501, 502, 503, 504, 505, 506, 507, 508,
-	438, 439, 440, 441, 442, 443, 444, 0, 0, 400,
-	0, 434, 0, 355, 356, 0, 0, 359, 360, 361,
-	362, 0, 0, 365, 366, 367, 738, 739, 368, 392,
-	393, 394, 369, 370, 371, 372, 373, 374, 375, 386,
-	387, 388, 389, 390, 391, 376, 377, 378, 379, 380,
-	383, 0, 127, 963, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1048, 0, 0, 0, 0,
-	753, 1071, 1072, 587, 588, 0, 312, 313, 586, 586,
-	534, 557, 0, 586, 538, 559, 539, 541, 540, 542,
-	561, 562, 586, 545, 584, 585, 546, 547, 548, 549,
-	550, 551, 552, 553, 554, 555, 563, 564, 565, 590,
-	0, 0, 604, 608, 609, 610, 611, 0, 0, 640,
-	91, 645, 646, 647, 648, 660, 653, 839, 762, 763,
-	764, 766, 768, 0, 823, 825, 770, 772, 834, 835,
-	836, 0, 0, 0, 0, 0, 776, 780, 783, 784,
-	785, 786, 787, 788, 789, 790, 791, 792, 793, 794,
-	800, 898, 899, 900, 817, 818, 0, 689, 0, 0,
-	0, 816, 0, 0, 0, 0, 673, 674, 921, 918,
-	0, 0, 884, 886, 0, 0, 0, 0, 862, 863,
-	864, 865, 866, 867, 868, 869, 870, 871, 872, 873,
-	874, 875, 876, 877, 878, 879, 880, 881, 882, 0,
-	0, 0, 676, 924, 0, -2, 0, 0, 0, 0,
-	0, 0, 0, 1025, 0, 630, 631, 633, 0, 755,
-	0, 736, 0, 0, 1059, 636, 55, 57, 682, 683,
-	0, 700, 679, 51, 46, 0, 0, 715, 979, 284,
-	1001, 0, 945, 946, 0, 938, 940, 941, 74, 697,
-	693, 0, 760, 0, 0, 750, 0, 703, 705, 706,
-	707, 733, 0, 0, 0, 0, 93, 752, 1031, 98,
-	0, 0, 103, 104, 1032, 1033, 1034, 1035, 0, 467,
-	-2, 350, 155, 157, 158, 159, 150, 332, 0, 0,
-	282, 280, 281, 226, 284, 284, 249, 250, 251, 252,
-	253, 254, 0, 0, 243, 244, 245, 246, 237, 0,
-	238, 239, 240, 0, 241, 325, 0, 947, 293, 294,
-	296, 300, 0, 0, 0, 321, 322, 0, 963, 0,
-	0, 0, 1061, 1060, 1060, 1060, 0, 145, 146, 147,
-	148, 143, 0, 0, 151, 402, 401, 0, 0, 323,
-	0, 0, 318, 318, 303, 304, 432, 0, 0, 491,
-	492, 493, 494, 0, 0, 0, 400, 402, 288, 0,
-	357, 358, 363, 364, 381, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 462, 463, 464, 465,
-	466, 960, 961, 962, 0, 0, 518, 0, 0, 342,
-	89, 1049, 523, 583, 544, 560, 583, 536, 543, 569,
-	600, 644, 0, 0, 0, 0, 652, 0, 769, 824,
-	826, 773, 0, 777, 781, 0, 0, 0, 0, 0,
-	0, 0, 820, 844, 845, 0, 0, 933, 0, 919,
-	0, 0, 852, 887, 0, 0, 0, 840, 0, 0,
-	675, 0, 888, 889, 890, 891, 892, 924, 629, 632,
-	634, 711, 0, 0, 0, 0, 737, 1057, 0, 926,
-	0, 0, 700, 760, 701, 0, 680, 48, 714, 0,
-	983, 982, 995, 1008, 285, 286, 287, 937, 0, 942,
-	0, 1019, 0, 0, 1011, 0, 0, 0, 0, 0,
-	0, 0, 0, 740, 0, 0, 743, 0, 0, 0,
-	0, 734, 0, 0, 0, 0, -2, 0, 0, 87,
-	88, 0, 0, 0, 101, 102, 0, 0, 108, 289,
-	290, 136, 0, 143, 352, 156, 160, 165, 0, 0,
-	0, 170, 0, 172, 0, 333, 0, 219, 221, 0,
-	0, 163, 222, 283, 247, 248, 0, 270, 0, 0,
-	0, 345, 113, 951, 950, 300, 300, 295, 0, 298,
-	0, 1062, 273, 0, 126, 0, 0, 0, 0, 0,
-	132, 0, 406, 0, 417, 418, 0, 488, 0, 292,
-	0, 302, 305, 722, 0, 0, 419, 0, 402, 423,
-	424, 435, 384, 385, 382, 0, 0, 973, 974, 0,
-	977, 118, 455, 457, 456, 460, 0, 0, 453, 0,
-	342, 947, 0, 522, 343, 344, 586, 586, 639, 92,
-	0, 642, 0, 0, 0, 774, 778, 690, 0, 0,
-	272, 272, 904, 272, 276, 907, 272, 909, 272, 912,
-	0, 0, 0, 0, 916, 851, 922, 0, 0, 0,
-	0, 841, 842, 0, 925, 0, 0, 0, 0, 0,
-	1044, 1045, 927, 928, 760, 929, 702, 681, 716, 1001,
-	0, 994, 0, -2, 1003, 0, 0, 0, 1009, 694,
-	75, 761, 78, 0, 1019, 1027, 0, 1010, 1021, 1023,
-	0, 0, 0, 1015, 0, 760, 704, 729, 731, 0,
-	726, 741, 742, 744, 0, 746, 0, 748, 749, 713,
-	709, 710, 0, 95, 0, 760, 760, 94, 0, 754,
-	105, 106, 107, 476, 351, 166, 0, 168, 183, 184,
-	189, 190, 192, 0, 0, 0, 171, 173, 174, 218,
-	220, 0, 0, 284, 0, 284, 0, 277, 0, 334,
-	346, 0, 948, 949, 0, 0, 297, 299, 0, 1051,
-	128, 0, 0, 0, 0, 152, 403, 0, 291, 0,
-	0, 513, 510, 420, 0, 422, 713, 964, 965, 966,
-	0, 976, 121, 0, 0, 0, 0, 519, 520, 521,
-	90, 529, 535, 641, 661, 775, 779, 0, 811, 901,
-	284, 905, 906, 908, 910, 911, 913, 812, 813, 0,
-	847, 0, 849, 942, 0, 920, 0, 0, 861, 843,
-	0, 0, 0, 735, 59, 929, 931, 0, 988, 986,
-	986, 996, 997, 0, 0, 1004, 0, 0, 0, 0,
-	79, 0, 0, 0, 1024, 0, 0, 0, 0, 96,
-	933, 723, 730, 0, 0, 724, 0, 725, 745, 747,
-	708, -2, 760, 760, 85, 86, 0, 0, 0, 477,
-	480, 481, 0, 193, 194, 169, 0, 0, 260, 271,
-	261, 0, 0, 347, 952, 953, 954, 955, 956, 957,
-	958, 713, 129, 130, 131, 409, 0, 0, 490, 0,
-	498, 0, 0, 0, 0, 975, 454, 0, 119, 120,
-	0, 0, 459, 893, 902, 903, 0, 0, 0, 917,
-	0, 0, 0, 757, 717, 718, 0, 0, 755, 931,
-	77, 0, 0, 991, 0, 984, 987, 985, 998, 0,
-	0, 1005, 0, 1007, 0, 1028, 1029, 1022, 0, 1014,
-	1017, 1013, 1016, 942, 727, 0, 732, 933, 84, 0,
-	478, 484, 0, 0, 167, 0, 177, 275, 278, 0,
-	0, 327, 514, 511, 512, 421, 0, 122, 123, 0,
-	0, 0, 0, 0, 0, 846, 848, 850, 859, 860,
-	0, 756, 758, 759, 712, 76, 932, 930, 980, 0,
-	989, 990, 999, 1000, 1006, 0, 1012, 0, 82, 0,
-	942, 0, 0, 0, 471, 0, 0, 0, 328, 332,
-	0, 0, 967, 0, 0, 0, 461, 810, 0, 0,
-	0, 719, 720, 992, 993, 1020, 1018, 728, 83, 0,
-	0, 0, 474, 0, 485, 482, 0, 329, 330, 0,
-	161, 178, 179, 0, 0, 182, 175, 176, 967, 0,
-	0, 451, 969, 124, 125, 395, 396, 397, 118, 894,
-	0, 897, 484, 0, 479, 470, 0, 476, 483, 331,
-	0, 0, 0, 180, 181, 114, 515, 516, 0, 970,
-	971, 121, 895, 471, 484, 0, 0, 335, 337, 338,
-	0, 0, 336, 0, 458, 0, 474, 471, 475, 0,
-	478, 339, 340, 341, 968, 0, 468, 474, 0, 0,
-	896, 469, 0, 0, 486, 0, 472, 0, 486, 487,
-	473,
+	31, 32, 33, 65, 67, 68, 667, 667, 667, 0,
+	667, 0, 0, 667, -2, -2, 667, 1083, 0, 667,
+	0, 0, -2, 599, 600, 0, 602, -2, 0, 0,
+	611, 1531, 1531, 662, 0, 0, 0, 0, 0, 667,
+	942, 45, 667, 0, 80, 81, 618, 619, 620, 60,
+	0, 1529, 1, 3, 66, 70, 0, 0, 0, 53,
+	951, 0, 73, 0, 0, 671, 0, 669, 0, 1066,
+	667, 667, 0, 111, 112, 0, 0, 0, -2, 115,
+	-2, 139, 140, 0, 144, 451, 411, 454, 409, 440,
+	-2, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 414, 304, 304, 0, 0, -2,
+	402, 402, 402, 0, 0, 0, 437, 1068, 357, 304,
+	304, 0, 304, 304, 304, 304, 0, 0, 304, 304,
+	304, 304, 304, 304, 304, 304, 304, 304, 304, 304,
+	304, 304, 304, 968, 143, 1084, 1081, 1082, 35, 36,
+	37, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
+	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
+	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
+	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
+	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
+	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
+	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
+	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
+	1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346,
+	1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356,
+	1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366,
+	1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376,
+	1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386,
+	1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396,
+	1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406,
+	1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416,
+	1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426,
+	1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436,
+	1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446,
+	1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456,
+	1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466,
+	1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476,
+	1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486,
+	1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496,
+	1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506,
+	1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515, 1516,
+	1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524, 1525, 1526,
+	1527, 1528, 0, 1060, 0, 529, 757, 0, 590, 590,
+	0, 590, 590, 590, 590, 0, 0, 0, 541, 0,
+	0, 0, 0, 587, 0, 0, 560, 562, 0, 587,
+	0, 593, 0, 574, 590, 1532, 1532, 1532, 1051, 0,
+	584, 582, 596, 597, 579, 580, 598, 601, 0, 606,
+	609, 1077, 1078, 0, 625, 44, 1309, 617, 630, 631,
+	0, 663, 664, 40, 844, 0, 772, 776, 787, 800,
+	801, 802, 803, 804, 805, 807, 808, 0, 0, 0,
+	0, 813, 814, 0, 0, 0, 0, 0, 825, 0,
+	0, 0, 0, 0, 923, 0, 892, 892, 864, 892,
+	894, 894, 0, 0, 0, 932, 0, 0, 0, 0,
+	0, 0, 215, 216, 843, 1040, 894, 894, 894, 894,
+	-2, -2, 199, 200, 201, 202, 203, 204, 205, 0,
+	195, 0, 219, 220, 217, 218, 827, 828, 211, 212,
+	213, 214, 1531, 0, 640, 0, 0, 951, 0, 943,
+	942, 58, 0, 667, -2, 0, 0, 0, 0, 42,
+	0, 47, 718, 72, 71, 987, 990, 0, 0, 0,
+	54, 952, 62, 64, 953, 0, 672, 673, 0, 696,
+	700, 0, 668, 0, 0, 1067, 1066, 1066, 97, 0,
+	1474, 1044, -2, -2, 0, 0, 1079, 1080, 1053, -2,
+	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
+	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
+	1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116,
+	1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
+	1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
+	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146,
+	1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
+	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
+	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
+	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
+	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
+	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
+	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
+	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
+	1227, -2, 0, 0, 153, 154, 0, 38, 330, 0,
+	149, 0, 324, 276, 968, 0, 0, 0, 0, 0,
+	667, 0, 1061, 134, 135, 141, 142, 304, 304, 0,
+	143, 143, 418, 419, 420, 0, 0, -2, 328, 0,
+	403, 0, 0, 318, 318, 322, 320, 321, 0, 0,
+	0, 0, 0, 0, 431, 0, 432, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 513, 0, 305, 0,
+	449, 450, 358, 0, 0, 0, 0, 429, 430, 0,
+	0, 1069, 1070, 0, 0, 304, 304, 0, 0, 0,
+	0, 304, 304, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	981, 0, 0, 0, -2, 0, 521, 0, 0, 0,
+	1062, 1062, 528, 0, 530, 531, 0, 0, 532, 0,
+	587, 587, 585, 586, 534, 535, 536, 537, 590, 0,
+	0, 313, 314, 315, 587, 590, 0, 590, 590, 590,
+	590, 587, 587, 587, 590, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1532, 1532, 1532, 593, 590, 0,
+	570, 0, 571, 572, 575, 576, 1533, 1534, 1098, 577,
+	578, 1052, 607, 610, 628, 626, 627, 629, 621, 622,
+	623, 624, 0, 642, 643, 648, 0, 0, 0, 0,
+	654, 655, 656, 0, 0, 659, 660, 661, 0, 0,
+	0, 0, 770, 0, 0, 833, 834, 835, 836, 837,
+	838, 839, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 809, 810, 811, 812, 815, 0, 0,
+	0, 820, 821, 0, 0, 0, 0, 0, 677, 0,
+	924, 0, 862, 0, 863, 865, 866, 0, 867, 0,
+	0, 0, 680, 0, 0, 680, 680, 0, 0, 189,
+	190, 191, 192, 206, 207, 208, 209, 210, 221, 932,
+	0, 843, 0, 0, 41, 632, 633, 0, 740, 1072,
+	0, 52, 61, 63, 951, 56, 951, 0, 682, 0,
+	0, -2, -2, 683, 689, 690, 691, 692, 693, 49,
+	1530, 50, 0, 69, 0, 43, 0, 0, 988, 0,
+	991, 0, 0, 0, 288, 995, 0, 0, 944, 945,
+	948, 0, 697, 701, 0, 703, 704, 0, 670, 0,
+	95, 0, 756, 0, 0, 0, 1474, 1050, 0, 99,
+	100, 0, 0, 0, 292, 1055, 1056, 1057, -2, 311,
+	0, -2, 283, 227, 228, 229, 276, 231, 276, 276,
+	276, 276, 288, 288, 288, 288, 259, 260, 261, 262,
+	263, 0, 0, 246, 276, 276, 276, 276, 266, 267,
+	268, 269, 270, 271, 272, 273, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 278, 278, 278, 280, 280,
+	0, 39, 0, 296, 0, 948, 0, 981, 1064, 1074,
+	0, 0, 0, 1064, 117, 0, 0, 452, 412, 441,
+	453, 0, 415, 416, -2, 0, 0, 402, 0, 404,
+	0, 312, 0, -2, 0, 322, 0, 318, 322, 319,
+	322, 310, 323, 433, 434, 435, 0, 493, 726, 0,
+	0, 0, 0, 0, 499, 500, 501, 0, 503, 504,
+	505, 506, 507, 508, 509, 510, 511, 512, 442, 443,
+	444, 445, 446, 447, 448, 0, 0, 404, 0, 438,
+	0, 359, 360, 0, 0, 363, 364, 365, 366, 0,
+	0, 369, 370, 371, 743, 744, 372, 396, 397, 398,
+	373, 374, 375, 376, 377, 378, 379, 390, 391, 392,
+	393, 394, 395, 380, 381, 382, 383, 384, 387, 0,
+	127, 972, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1062, 0, 0, 0, 0, 758, 1085,
+	1086, 591, 592, 0, 316, 317, 590, 590, 538, 561,
+	0, 590, 542, 563, 543, 545, 544, 546, 565, 566,
+	590, 549, 588, 589, 550, 551, 552, 553, 554, 555,
+	556, 557, 558, 559, 567, 568, 569, 594, 0, 0,
+	608, 612, 613, 614, 615, 616, 0, 0, 645, 91,
+	650, 651, 652, 653, 665, 658, 845, 767, 768, 769,
+	771, 773, 0, 829, 831, 775, 777, 840, 841, 842,
+	0, 0, 0, 0, 0, 781, 785, 788, 789, 790,
+	791, 792, 793, 794, 795, 796, 797, 798, 799, 806,
+	907, 908, 909, 851, 823, 824, 0, 694, 0, 0,
+	0, 822, 0, 0, 0, 0, 678, 679, 930, 927,
+	0, 0, 893, 895, 0, 0, 0, 0, 871, 872,
+	873, 874, 875, 876, 877, 878, 879, 880, 881, 882,
+	883, 884, 885, 886, 887, 888, 889, 890, 891, 0,
+	0, 0, 681, 933, 0, -2, 0, 0, 0, 0,
+	0, 0, 0, 1039, 0, 635, 636, 638, 0, 760,
+	0, 741, 0, 0, 1073, 641, 55, 57, 687, 688,
+	0, 705, 684, 51, 46, 0, 0, 720, 989, 992,
+	993, 288, 1015, 0, 954, 955, 0, 947, 949, 950,
+	74, 702, 698, 0, 765, 0, 0, 755, 0, 708,
+	710, 711, 712, 738, 0, 0, 0, 0, 93, 757,
+	1045, 98, 0, 0, 103, 104, 1046, 1047, 1048, 1049,
+	0, 471, -2, 354, 155, 157, 158, 159, 150, 336,
+	0, 0, 286, 284, 285, 230, 288, 288, 253, 254,
+	255, 256, 257, 258, 0, 0, 247, 248, 249, 250,
+	241, 0, 242, 243, 244, 0, 245, 329, 0, 956,
+	297, 298, 300, 304, 0, 0, 0, 325, 326, 0,
+	972, 0, 0, 0, 1075, 1074, 1074, 1074, 0, 145,
+	146, 147, 148, 143, 0, 0, 151, 406, 405, 0,
+	0, 327, 0, 0, 322, 322, 307, 308, 436, 0,
+	0, 495, 496, 497, 498, 0, 0, 0, 404, 406,
+	292, 0, 361, 362, 367, 368, 385, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 466, 467,
+	468, 469, 470, 969, 970, 971, 0, 0, 522, 0,
+	0, 346, 89, 1063, 527, 587, 548, 564, 587, 540,
+	547, 573, 604, 649, 0, 0, 0, 0, 657, 0,
+	774, 830, 832, 778, 0, 782, 786, 0, 0, 942,
+	0, 0, 0, 0, 0, 0, 826, 853, 854, 0,
+	0, 942, 0, 928, 0, 0, 861, 896, 0, 0,
+	0, 846, 0, 0, 680, 0, 897, 898, 899, 900,
+	901, 933, 634, 637, 639, 716, 0, 0, 0, 0,
+	742, 1071, 0, 935, 0, 0, 705, 765, 706, 0,
+	685, 48, 719, 0, 997, 996, 1009, 1022, 289, 290,
+	291, 946, 0, 951, 0, 1033, 0, 0, 1025, 0,
+	0, 0, 0, 0, 0, 0, 0, 745, 0, 0,
+	748, 0, 0, 0, 0, 739, 0, 0, 0, 0,
+	-2, 0, 0, 87, 88, 0, 0, 0, 101, 102,
+	0, 0, 108, 293, 294, 136, 0, 143, 356, 156,
+	160, 165, 0, 0, 0, 170, 0, 172, 0, 175,
+	176, 337, 0, 223, 225, 0, 0, 163, 226, 287,
+	251, 252, 0, 274, 0, 0, 0, 349, 113, 960,
+	959, 304, 304, 299, 0, 302, 0, 1076, 277, 0,
+	126, 0, 0, 0, 0, 0, 132, 0, 410, 0,
+	421, 422, 0, 492, 0, 296, 0, 306, 309, 727,
+	0, 0, 423, 0, 406, 427, 428, 439, 388, 389,
+	386, 0, 0, 982, 983, 0, 986, 118, 459, 461,
+	460, 464, 0, 0, 457, 0, 346, 956, 0, 526,
+	347, 348, 590, 590, 644, 92, 0, 647, 0, 0,
+	0, 779, 783, 0, 0, 695, 0, 0, 276, 276,
+	913, 276, 280, 916, 276, 918, 276, 921, 0, 0,
+	0, 0, 925, 860, 931, 0, 0, 0, 0, 847,
+	848, 0, 934, 0, 0, 0, 0, 0, 1058, 1059,
+	936, 937, 765, 938, 707, 686, 721, 1015, 0, 1008,
+	0, -2, 1017, 0, 0, 0, 1023, 699, 75, 766,
+	78, 0, 1033, 1041, 0, 1024, 1035, 1037, 0, 0,
+	0, 1029, 0, 765, 709, 734, 736, 0, 731, 746,
+	747, 749, 0, 751, 0, 753, 754, 718, 714, 715,
+	0, 95, 0, 765, 765, 94, 0, 759, 105, 106,
+	107, 480, 355, 166, 0, 168, 187, 188, 193, 194,
+	196, 0, 0, 0, 171, 173, 174, 222, 224, 0,
+	0, 288, 0, 288, 0, 281, 0, 338, 350, 0,
+	957, 958, 0, 0, 301, 303, 0, 1065, 128, 0,
+	0, 0, 0, 152, 407, 0, 295, 0, 0, 517,
+	514, 424, 0, 426, 718, 973, 974, 975, 0, 985,
+	121, 0, 0, 0, 0, 523, 524, 525, 90, 533,
+	539, 646, 666, 780, 784, 850, 852, 0, 817, 910,
+	288, 914, 915, 917, 919, 920, 922, 818, 819, 0,
+	856, 0, 858, 951, 0, 929, 0, 0, 870, 849,
+	0, 0, 0, 740, 59, 938, 940, 0, 1002, 1000,
+	1000, 1010, 1011, 0, 0, 1018, 0, 0, 0, 0,
+	79, 0, 0, 0, 1038, 0, 0, 0, 0, 96,
+	942, 728, 735, 0, 0, 729, 0, 730, 750, 752,
+	713, -2, 765, 765, 85, 86, 0, 0, 0, 481,
+	484, 485, 0, 197, 198, 169, 0, 0, 264, 275,
+	265, 0, 0, 351, 961, 962, 963, 964, 965, 966,
+	967, 718, 129, 130, 131, 413, 0, 0, 494, 0,
+	502, 0, 0, 0, 0, 984, 458, 0, 119, 120,
+	0, 0, 463, 902, 911, 912, 0, 0, 0, 926,
+	0, 0, 0, 762, 722, 723, 0, 0, 760, 940,
+	77, 0, 0, 1005, 0, 998, 1001, 999, 1012, 0,
+	0, 1019, 0, 1021, 0, 1042, 1043, 1036, 0, 1028,
+	1031, 1027, 1030, 951, 732, 0, 737, 942, 84, 0,
+	482, 488, 0, 0, 167, 0, 179, 279, 282, 0,
+	0, 331, 518, 515, 516, 425, 0, 122, 123, 0,
+	0, 0, 0, 0, 0, 855, 857, 859, 868, 869,
+	0, 761, 763, 764, 717, 76, 941, 939, 994, 0,
+	1003, 1004, 1013, 1014, 1020, 0, 1026, 0, 82, 0,
+	951, 0, 0, 0, 475, 0, 0, 0, 332, 336,
+	0, 0, 976, 0, 0, 0, 465, 816, 0, 0,
+	0, 724, 725, 1006, 1007, 1034, 1032, 733, 83, 0,
+	0, 0, 478, 0, 489, 486, 0, 333, 334, 0,
+	161, 180, 181, 0, 0, 184, 185, 186, 177, 178,
+	976, 0, 0, 455, 978, 124, 125, 399, 400, 401,
+	118, 903, 0, 906, 488, 0, 483, 474, 0, 480,
+	487, 335, 0, 0, 0, 182, 183, 114, 519, 520,
+	0, 979, 980, 121, 904, 475, 488, 0, 0, 339,
+	341, 342, 0, 0, 340, 0, 462, 0, 478, 475,
+	479, 0, 482, 343, 344, 345, 977, 0, 472, 478,
+	0, 0, 905, 473, 0, 0, 490, 0, 476, 0,
+	490, 491, 477,
 }
 
 var yyTok1 = [...]int{
@@ -5881,7 +5932,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 124, 3, 3, 3, 150, 142, 3,
 	83, 85, 147, 145, 84, 146, 162, 148, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 514,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 515,
 	132, 131, 133, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -5968,7 +6019,8 @@ var yyTok3 = [...]int{
 	57820, 495, 57821, 496, 57822, 497, 57823, 498, 57824, 499,
 	57825, 500, 57826, 501, 57827, 502, 57828, 503, 57829, 504,
 	57830, 505, 57831, 506, 57832, 507, 57833, 508, 57834, 509,
-	57835, 510, 57836, 511, 57837, 512, 57838, 513, 0,
+	57835, 510, 57836, 511, 57837, 512, 57838, 513, 57839, 514,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -6397,7 +6449,7 @@ yydefault:
 		var yyLOCAL *With
 //line sql.y:542
 		{
-			yyLOCAL = &With{ctes: yyDollar[2].ctesUnion(), Recursive: false}
+			yyLOCAL = &With{CTEs: yyDollar[2].ctesUnion(), Recursive: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 43:
@@ -6405,7 +6457,7 @@ yydefault:
 		var yyLOCAL *With
 //line sql.y:546
 		{
-			yyLOCAL = &With{ctes: yyDollar[3].ctesUnion(), Recursive: true}
+			yyLOCAL = &With{CTEs: yyDollar[3].ctesUnion(), Recursive: true}
 		}
 		yyVAL.union = yyLOCAL
 	case 44:
@@ -7470,1897 +7522,1933 @@ yydefault:
 		}
 		yyVAL.union = yyLOCAL
 	case 175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1259
+		{
+			yyDollar[1].columnTypeOptionsUnion().Invisible = false
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1264
+		{
+			yyDollar[1].columnTypeOptionsUnion().Invisible = true
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1261
+//line sql.y:1271
 		{
 			yyLOCAL = VirtualStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1265
+//line sql.y:1275
 		{
 			yyLOCAL = StoredStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1270
+//line sql.y:1280
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1274
+//line sql.y:1284
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1279
+//line sql.y:1289
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1285
+//line sql.y:1295
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1291
+//line sql.y:1301
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 184:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1306
+		{
+			yyDollar[1].columnTypeOptionsUnion().Invisible = false
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 185:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1311
+		{
+			yyDollar[1].columnTypeOptionsUnion().Invisible = true
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1296
+//line sql.y:1316
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1303
+//line sql.y:1323
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1310
+//line sql.y:1330
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1314
+//line sql.y:1334
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1318
+//line sql.y:1338
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1322
+//line sql.y:1342
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1333
+//line sql.y:1353
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1340
+//line sql.y:1360
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1344
+//line sql.y:1364
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1350
+//line sql.y:1370
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1354
+//line sql.y:1374
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1358
+//line sql.y:1378
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1362
+//line sql.y:1382
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1366
+//line sql.y:1386
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1370
+//line sql.y:1390
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1374
+//line sql.y:1394
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1379
+//line sql.y:1399
 		{
 			yyLOCAL = &UnaryExpr{Operator: yyDollar[1].unaryExprOperatorUnion(), Expr: NewBitLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1383
+//line sql.y:1403
 		{
 			yyLOCAL = &UnaryExpr{Operator: yyDollar[1].unaryExprOperatorUnion(), Expr: NewHexNumLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1387
+//line sql.y:1407
 		{
 			yyLOCAL = &UnaryExpr{Operator: yyDollar[1].unaryExprOperatorUnion(), Expr: NewHexLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1391
+//line sql.y:1411
 		{
 			yyLOCAL = &UnaryExpr{Operator: yyDollar[1].unaryExprOperatorUnion(), Expr: yyDollar[2].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1395
+//line sql.y:1415
 		{
 			bindVariable(yylex, yyDollar[2].str[1:])
 			yyLOCAL = &UnaryExpr{Operator: yyDollar[1].unaryExprOperatorUnion(), Expr: NewArgument(yyDollar[2].str[1:])}
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UnaryExprOperator
-//line sql.y:1402
+//line sql.y:1422
 		{
 			yyLOCAL = UBinaryOp
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UnaryExprOperator
-//line sql.y:1406
+//line sql.y:1426
 		{
 			yyLOCAL = Utf8Op
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UnaryExprOperator
-//line sql.y:1410
+//line sql.y:1430
 		{
 			yyLOCAL = Utf8mb4Op
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UnaryExprOperator
-//line sql.y:1414
+//line sql.y:1434
 		{
 			yyLOCAL = Latin1Op
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1424
+//line sql.y:1444
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1428
+//line sql.y:1448
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1435
+//line sql.y:1455
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1439
+//line sql.y:1459
 		{
 			yyLOCAL = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1443
+//line sql.y:1463
 		{
 			yyLOCAL = &UnaryExpr{Operator: yyDollar[1].unaryExprOperatorUnion(), Expr: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1449
+//line sql.y:1469
 		{
 			yyLOCAL = colKeyPrimary
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1453
+//line sql.y:1473
 		{
 			yyLOCAL = colKeyUnique
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1457
+//line sql.y:1477
 		{
 			yyLOCAL = colKeyUniqueKey
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1461
+//line sql.y:1481
 		{
 			yyLOCAL = colKey
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1467
+//line sql.y:1487
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
 			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
 		}
-	case 226:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1478
+//line sql.y:1498
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
-	case 227:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1483
+//line sql.y:1503
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 228:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1489
+//line sql.y:1509
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 229:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1493
+//line sql.y:1513
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 230:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1497
+//line sql.y:1517
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 231:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1501
+//line sql.y:1521
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 232:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1505
+//line sql.y:1525
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 233:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1509
+//line sql.y:1529
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 234:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1513
+//line sql.y:1533
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 235:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1517
+//line sql.y:1537
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 236:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1521
+//line sql.y:1541
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 237:
+	case 241:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1527
+//line sql.y:1547
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 238:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1533
+//line sql.y:1553
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 239:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1539
+//line sql.y:1559
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 240:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1545
+//line sql.y:1565
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 241:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1551
+//line sql.y:1571
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 242:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1559
+//line sql.y:1579
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 243:
+	case 247:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1563
+//line sql.y:1583
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 244:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1567
+//line sql.y:1587
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 245:
+	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1571
+//line sql.y:1591
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 246:
+	case 250:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1575
+//line sql.y:1595
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 247:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1581
+//line sql.y:1601
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
-	case 248:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1585
+//line sql.y:1605
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
-	case 249:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1589
+//line sql.y:1609
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 250:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1593
+//line sql.y:1613
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 251:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1597
+//line sql.y:1617
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 252:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1601
+//line sql.y:1621
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 253:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1605
+//line sql.y:1625
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 254:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1609
+//line sql.y:1629
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 255:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1613
+//line sql.y:1633
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 256:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1617
+//line sql.y:1637
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 257:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1621
+//line sql.y:1641
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 258:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1625
+//line sql.y:1645
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 259:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1629
+//line sql.y:1649
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 260:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1633
+//line sql.y:1653
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
-	case 261:
+	case 265:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1638
+//line sql.y:1658
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
-	case 262:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1644
+//line sql.y:1664
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 263:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1648
+//line sql.y:1668
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 264:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1652
+//line sql.y:1672
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 265:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1656
+//line sql.y:1676
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 266:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1660
+//line sql.y:1680
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 267:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1664
+//line sql.y:1684
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 268:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1668
+//line sql.y:1688
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 269:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1672
+//line sql.y:1692
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 270:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1678
+//line sql.y:1698
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 271:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1683
+//line sql.y:1703
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 272:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1688
+//line sql.y:1708
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1692
+//line sql.y:1712
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 278:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1697
+//line sql.y:1717
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 275:
+	case 279:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1701
+//line sql.y:1721
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 276:
+	case 280:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1709
+//line sql.y:1729
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 277:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1713
+//line sql.y:1733
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 278:
+	case 282:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1719
+//line sql.y:1739
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 279:
+	case 283:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1727
+//line sql.y:1747
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1731
+//line sql.y:1751
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1735
+//line sql.y:1755
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 286:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1740
+//line sql.y:1760
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1744
+//line sql.y:1764
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 288:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1749
+//line sql.y:1769
 		{
 			yyVAL.str = ""
 		}
-	case 285:
+	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1753
+//line sql.y:1773
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 286:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1757
+//line sql.y:1777
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 287:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1761
+//line sql.y:1781
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 288:
+	case 292:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1766
+//line sql.y:1786
 		{
 			yyVAL.str = ""
 		}
-	case 289:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1770
+//line sql.y:1790
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 290:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1774
+//line sql.y:1794
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 291:
+	case 295:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1781
+//line sql.y:1801
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 296:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1786
+//line sql.y:1806
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1790
+//line sql.y:1810
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1796
+//line sql.y:1816
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1800
+//line sql.y:1820
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 296:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1806
+//line sql.y:1826
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1810
+//line sql.y:1830
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 302:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1815
+//line sql.y:1835
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1819
+//line sql.y:1839
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1825
+//line sql.y:1845
 		{
 			yyVAL.str = ""
 		}
-	case 301:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1829
+//line sql.y:1849
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 302:
+	case 306:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1835
+//line sql.y:1855
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1839
+//line sql.y:1859
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1843
+//line sql.y:1863
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 309:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1847
+//line sql.y:1867
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1851
+//line sql.y:1871
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 311:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1856
+//line sql.y:1876
 		{
 			yyVAL.str = ""
 		}
-	case 308:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1860
+//line sql.y:1880
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 309:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1866
+//line sql.y:1886
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 310:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1870
+//line sql.y:1890
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 311:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1874
+//line sql.y:1894
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 312:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1881
+//line sql.y:1901
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 313:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1885
+//line sql.y:1905
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 314:
+	case 318:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1890
+//line sql.y:1910
 		{
 			yyVAL.str = "key"
 		}
-	case 315:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1894
+//line sql.y:1914
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 316:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1900
+//line sql.y:1920
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 317:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1904
+//line sql.y:1924
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 318:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1909
+//line sql.y:1929
 		{
 			yyVAL.str = ""
 		}
-	case 319:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1913
+//line sql.y:1933
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 320:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1919
+//line sql.y:1939
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1923
+//line sql.y:1943
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 322:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1929
+//line sql.y:1949
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1935
+//line sql.y:1955
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1939
+//line sql.y:1959
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1945
+//line sql.y:1965
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1949
+//line sql.y:1969
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 331:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1955
+//line sql.y:1975
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 332:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1961
+//line sql.y:1981
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 333:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1965
+//line sql.y:1985
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 334:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1969
+//line sql.y:1989
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 335:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1973
+//line sql.y:1993
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1978
+//line sql.y:1998
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1982
+//line sql.y:2002
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 338:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1988
+//line sql.y:2008
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1994
+//line sql.y:2014
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2000
+//line sql.y:2020
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2006
+//line sql.y:2026
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2010
+//line sql.y:2030
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2014
+//line sql.y:2034
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2018
+//line sql.y:2038
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2022
+//line sql.y:2042
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2027
+//line sql.y:2047
 		{
 			yyVAL.str = ""
 		}
-	case 343:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2031
+//line sql.y:2051
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 344:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2035
+//line sql.y:2055
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 345:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2040
+//line sql.y:2060
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2044
+//line sql.y:2064
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2048
+//line sql.y:2068
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 352:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2053
+//line sql.y:2073
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2057
+//line sql.y:2077
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2063
+//line sql.y:2083
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2067
+//line sql.y:2087
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 352:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2071
+//line sql.y:2091
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 353:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2077
+//line sql.y:2097
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2081
+//line sql.y:2101
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 355:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2087
+//line sql.y:2107
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2091
+//line sql.y:2111
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 361:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2095
+//line sql.y:2115
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 362:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2099
+//line sql.y:2119
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2103
+//line sql.y:2123
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2107
+//line sql.y:2127
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2111
+//line sql.y:2131
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2115
+//line sql.y:2135
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 367:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2119
+//line sql.y:2139
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 368:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2123
+//line sql.y:2143
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2127
+//line sql.y:2147
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2131
+//line sql.y:2151
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2135
+//line sql.y:2155
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2139
+//line sql.y:2159
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2143
+//line sql.y:2163
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2147
+//line sql.y:2167
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2151
+//line sql.y:2171
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2155
+//line sql.y:2175
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2159
+//line sql.y:2179
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2163
+//line sql.y:2183
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2167
+//line sql.y:2187
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2171
+//line sql.y:2191
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2175
+//line sql.y:2195
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2179
+//line sql.y:2199
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2183
+//line sql.y:2203
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2187
+//line sql.y:2207
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2191
+//line sql.y:2211
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 386:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2195
+//line sql.y:2215
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 387:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2200
+//line sql.y:2220
 		{
 			yyVAL.str = ""
 		}
-	case 384:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2204
+//line sql.y:2224
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 385:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2208
+//line sql.y:2228
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 395:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2227
+//line sql.y:2247
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 396:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2231
+//line sql.y:2251
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 397:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2235
+//line sql.y:2255
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 398:
+	case 402:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2240
+//line sql.y:2260
 		{
 			yyVAL.str = ""
 		}
-	case 400:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2246
+//line sql.y:2266
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2250
+//line sql.y:2270
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2255
+//line sql.y:2275
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2259
+//line sql.y:2279
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 408:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2264
+//line sql.y:2284
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2268
+//line sql.y:2288
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2272
+//line sql.y:2292
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 407:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2276
+//line sql.y:2296
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2280
+//line sql.y:2300
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 409:
+	case 413:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2284
+//line sql.y:2304
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2290
+//line sql.y:2310
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2294
+//line sql.y:2314
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 412:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2298
+//line sql.y:2318
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 413:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2304
+//line sql.y:2324
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2308
+//line sql.y:2328
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2312
+//line sql.y:2332
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2316
+//line sql.y:2336
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 421:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2320
+//line sql.y:2340
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2324
+//line sql.y:2344
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2328
+//line sql.y:2348
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 424:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2332
+//line sql.y:2352
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 425:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2336
+//line sql.y:2356
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 426:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2340
+//line sql.y:2360
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].booleanUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 427:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2344
+//line sql.y:2364
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 428:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2348
+//line sql.y:2368
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2352
+//line sql.y:2372
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2356
+//line sql.y:2376
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2360
+//line sql.y:2380
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2364
+//line sql.y:2384
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2368
+//line sql.y:2388
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2372
+//line sql.y:2392
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2376
+//line sql.y:2396
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2380
+//line sql.y:2400
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2384
+//line sql.y:2404
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2388
+//line sql.y:2408
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 439:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2392
+//line sql.y:2412
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2398
+//line sql.y:2418
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2402
+//line sql.y:2422
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 438:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2408
+//line sql.y:2428
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2412
+//line sql.y:2432
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2416
+//line sql.y:2436
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2420
+//line sql.y:2440
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2424
+//line sql.y:2444
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2428
+//line sql.y:2448
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2432
+//line sql.y:2452
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2436
+//line sql.y:2456
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2440
+//line sql.y:2460
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2446
+//line sql.y:2466
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2452
+//line sql.y:2472
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -9368,10 +9456,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2459
+//line sql.y:2479
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -9379,28 +9467,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2466
+//line sql.y:2486
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 455:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2472
+//line sql.y:2492
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2476
+//line sql.y:2496
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -9408,10 +9496,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 457:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2483
+//line sql.y:2503
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -9419,10 +9507,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 458:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2490
+//line sql.y:2510
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -9435,10 +9523,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 459:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2502
+//line sql.y:2522
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -9449,26 +9537,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 460:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2512
+//line sql.y:2532
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 461:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2516
+//line sql.y:2536
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 462:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2520
+//line sql.y:2540
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -9482,10 +9570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 463:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2533
+//line sql.y:2553
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -9496,18 +9584,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 464:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2543
+//line sql.y:2563
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 465:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2547
+//line sql.y:2567
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -9519,10 +9607,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 466:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2558
+//line sql.y:2578
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -9530,10 +9618,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 467:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2565
+//line sql.y:2585
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CleanupMigrationType,
@@ -9541,10 +9629,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 468:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2572
+//line sql.y:2592
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -9552,10 +9640,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 469:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2579
+//line sql.y:2599
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -9563,28 +9651,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 470:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2586
+//line sql.y:2606
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 471:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2593
+//line sql.y:2613
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 472:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2598
+//line sql.y:2618
 		{
 			yyLOCAL = &PartitionOption{
 				Linear:       yyDollar[3].str,
@@ -9596,10 +9684,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 473:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2610
+//line sql.y:2630
 		{
 			yyLOCAL = &PartitionOption{
 				Linear:       yyDollar[3].str,
@@ -9612,10 +9700,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 474:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2623
+//line sql.y:2643
 		{
 			yyLOCAL = &PartitionOption{
 				RangeOrList:  yyDollar[3].str,
@@ -9626,18 +9714,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2634
+//line sql.y:2654
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 476:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2638
+//line sql.y:2658
 		{
 			yyLOCAL = &SubPartition{
 				Linear:        yyDollar[3].str,
@@ -9647,10 +9735,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 477:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2647
+//line sql.y:2667
 		{
 			yyLOCAL = &SubPartition{
 				Linear:        yyDollar[3].str,
@@ -9661,364 +9749,364 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 478:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2658
+//line sql.y:2678
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2662
+//line sql.y:2682
 		{
 			yyLOCAL = yyDollar[2].partDefsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 480:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2667
+//line sql.y:2687
 		{
 			yyVAL.str = ""
 		}
-	case 477:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2671
+//line sql.y:2691
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 478:
+	case 482:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2676
+//line sql.y:2696
 		{
 			yyVAL.str = ""
 		}
-	case 479:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2680
+//line sql.y:2700
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 480:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:2706
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 481:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2690
+//line sql.y:2710
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 482:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ExprOrColumns
-//line sql.y:2696
+//line sql.y:2716
 		{
 			yyLOCAL = &ExprOrColumns{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 487:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ExprOrColumns
-//line sql.y:2700
+//line sql.y:2720
 		{
 			yyLOCAL = &ExprOrColumns{ColumnList: yyDollar[3].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 488:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2705
+//line sql.y:2725
 		{
 			yyVAL.str = ""
 		}
-	case 485:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2709
+//line sql.y:2729
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 486:
+	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2714
+//line sql.y:2734
 		{
 			yyVAL.str = ""
 		}
-	case 487:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2718
+//line sql.y:2738
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 488:
+	case 492:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2724
+//line sql.y:2744
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2728
+//line sql.y:2748
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 494:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2732
+//line sql.y:2752
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 495:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2736
+//line sql.y:2756
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 496:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2740
+//line sql.y:2760
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 497:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2744
+//line sql.y:2764
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2748
+//line sql.y:2768
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2752
+//line sql.y:2772
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2756
+//line sql.y:2776
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2760
+//line sql.y:2780
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 502:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2764
+//line sql.y:2784
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2768
+//line sql.y:2788
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2772
+//line sql.y:2792
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2776
+//line sql.y:2796
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2780
+//line sql.y:2800
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2784
+//line sql.y:2804
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2788
+//line sql.y:2808
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2792
+//line sql.y:2812
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2796
+//line sql.y:2816
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2800
+//line sql.y:2820
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2804
+//line sql.y:2824
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2808
+//line sql.y:2828
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 514:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2813
+//line sql.y:2833
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2817
+//line sql.y:2837
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2821
+//line sql.y:2841
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2828
+//line sql.y:2848
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2832
+//line sql.y:2852
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 515:
+	case 519:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2838
+//line sql.y:2858
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 520:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2842
+//line sql.y:2862
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2848
+//line sql.y:2868
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2854
+//line sql.y:2874
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 523:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2858
+//line sql.y:2878
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 520:
+	case 524:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2864
+//line sql.y:2884
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 525:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2868
+//line sql.y:2888
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -10028,404 +10116,404 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 526:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2877
+//line sql.y:2897
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 527:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2881
+//line sql.y:2901
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2887
+//line sql.y:2907
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2891
+//line sql.y:2911
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2896
+//line sql.y:2916
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 531:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2902
+//line sql.y:2922
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2906
+//line sql.y:2926
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 533:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2910
+//line sql.y:2930
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2914
+//line sql.y:2934
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2918
+//line sql.y:2938
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2922
+//line sql.y:2942
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2926
+//line sql.y:2946
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2930
+//line sql.y:2950
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 539:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2934
+//line sql.y:2954
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 540:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2938
+//line sql.y:2958
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2942
+//line sql.y:2962
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 542:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2946
+//line sql.y:2966
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 543:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2950
+//line sql.y:2970
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 544:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2954
+//line sql.y:2974
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2958
+//line sql.y:2978
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2962
+//line sql.y:2982
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 547:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2966
+//line sql.y:2986
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 548:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2970
+//line sql.y:2990
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 549:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2974
+//line sql.y:2994
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2978
+//line sql.y:2998
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2982
+//line sql.y:3002
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 552:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2986
+//line sql.y:3006
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 553:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2990
+//line sql.y:3010
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 554:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2994
+//line sql.y:3014
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 555:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2998
+//line sql.y:3018
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 556:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3002
+//line sql.y:3022
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3006
+//line sql.y:3026
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 558:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3010
+//line sql.y:3030
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3014
+//line sql.y:3034
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3018
+//line sql.y:3038
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 561:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3022
+//line sql.y:3042
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3026
+//line sql.y:3046
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 563:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3030
+//line sql.y:3050
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 564:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3034
+//line sql.y:3054
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 565:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3038
+//line sql.y:3058
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3042
+//line sql.y:3062
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 567:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3046
+//line sql.y:3066
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 568:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3051
+//line sql.y:3071
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 569:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3055
+//line sql.y:3075
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3059
+//line sql.y:3079
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3064
+//line sql.y:3084
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3068
+//line sql.y:3088
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 573:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3072
+//line sql.y:3092
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3076
+//line sql.y:3096
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3081
+//line sql.y:3101
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -10433,1242 +10521,1250 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3095
+//line sql.y:3115
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3099
+//line sql.y:3119
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3103
+//line sql.y:3123
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3109
+//line sql.y:3129
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 576:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3113
+//line sql.y:3133
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 577:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3119
+//line sql.y:3139
 		{
 			yyVAL.str = ""
 		}
-	case 578:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3123
+//line sql.y:3143
 		{
 			yyVAL.str = "extended "
 		}
-	case 579:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3129
+//line sql.y:3149
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3133
+//line sql.y:3153
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3139
+//line sql.y:3159
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 582:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3143
+//line sql.y:3163
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 583:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3149
+//line sql.y:3169
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 584:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3153
+//line sql.y:3173
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 585:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3157
+//line sql.y:3177
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 586:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3163
+//line sql.y:3183
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3167
+//line sql.y:3187
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3171
+//line sql.y:3191
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 593:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3177
+//line sql.y:3197
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3181
+//line sql.y:3201
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3187
+//line sql.y:3207
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 592:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3191
+//line sql.y:3211
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 593:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3195
+//line sql.y:3215
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 594:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3201
+//line sql.y:3221
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3205
+//line sql.y:3225
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3211
+//line sql.y:3231
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3215
+//line sql.y:3235
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3221
+//line sql.y:3241
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3227
+//line sql.y:3247
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 604:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3231
+//line sql.y:3251
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3236
+//line sql.y:3256
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 602:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3238
+//line sql.y:3258
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 603:
+	case 607:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3241
+//line sql.y:3261
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 604:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3243
+//line sql.y:3263
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 605:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3248
+//line sql.y:3268
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3254
+//line sql.y:3274
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3259
+//line sql.y:3279
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3263
+//line sql.y:3283
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3267
+//line sql.y:3287
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3271
+//line sql.y:3291
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3275
+//line sql.y:3295
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 616:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL ExplainType
+//line sql.y:3299
+		{
+			yyLOCAL = CostType
+		}
+		yyVAL.union = yyLOCAL
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3279
+//line sql.y:3303
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3285
+//line sql.y:3309
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 614:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3289
+//line sql.y:3313
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 615:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3293
+//line sql.y:3317
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 616:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3299
+//line sql.y:3323
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3303
+//line sql.y:3327
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3307
+//line sql.y:3331
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3311
+//line sql.y:3335
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3316
+//line sql.y:3340
 		{
 			yyVAL.str = ""
 		}
-	case 621:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3320
+//line sql.y:3344
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 622:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3324
+//line sql.y:3348
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 623:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3330
+//line sql.y:3354
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3334
+//line sql.y:3358
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3340
+//line sql.y:3364
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3344
+//line sql.y:3368
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3350
+//line sql.y:3374
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:3356
+//line sql.y:3380
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3360
+//line sql.y:3384
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 630:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:3366
+//line sql.y:3390
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3372
+//line sql.y:3396
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3376
+//line sql.y:3400
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3380
+//line sql.y:3404
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3384
+//line sql.y:3408
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3390
+//line sql.y:3414
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 641:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3396
+//line sql.y:3420
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3402
+//line sql.y:3426
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3406
+//line sql.y:3430
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 644:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3410
+//line sql.y:3434
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 645:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3414
+//line sql.y:3438
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 646:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3418
+//line sql.y:3442
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 647:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3422
+//line sql.y:3446
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3428
+//line sql.y:3452
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 644:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3432
+//line sql.y:3456
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 645:
+	case 650:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3438
+//line sql.y:3462
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 646:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3442
+//line sql.y:3466
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 647:
+	case 652:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3446
+//line sql.y:3470
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 648:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3450
+//line sql.y:3474
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 649:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3454
+//line sql.y:3478
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 650:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3458
+//line sql.y:3482
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 651:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3462
+//line sql.y:3486
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 652:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3466
+//line sql.y:3490
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 653:
+	case 658:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3470
+//line sql.y:3494
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 654:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3474
+//line sql.y:3498
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 655:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3478
+//line sql.y:3502
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 656:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3482
+//line sql.y:3506
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 657:
+	case 662:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3487
+//line sql.y:3511
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3491
+//line sql.y:3515
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3495
+//line sql.y:3519
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3500
+//line sql.y:3524
 		{
 			yyVAL.str = ""
 		}
-	case 661:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3504
+//line sql.y:3528
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 662:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3509
+//line sql.y:3533
 		{
 			setAllowComments(yylex, true)
 		}
-	case 663:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3513
+//line sql.y:3537
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 664:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3519
+//line sql.y:3543
 		{
 			yyVAL.strs = nil
 		}
-	case 665:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3523
+//line sql.y:3547
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 666:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3529
+//line sql.y:3553
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3533
+//line sql.y:3557
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3537
+//line sql.y:3561
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 674:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3542
+//line sql.y:3566
 		{
 			yyVAL.str = ""
 		}
-	case 670:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3546
+//line sql.y:3570
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 671:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3550
+//line sql.y:3574
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 672:
+	case 677:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3555
+//line sql.y:3579
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3559
+//line sql.y:3583
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3563
+//line sql.y:3587
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 680:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3568
+//line sql.y:3592
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3572
+//line sql.y:3596
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 682:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3577
+//line sql.y:3601
 		{
 			yyVAL.strs = nil
 		}
-	case 678:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3581
+//line sql.y:3605
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 679:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3585
+//line sql.y:3609
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 680:
+	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3589
+//line sql.y:3613
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 681:
+	case 686:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3593
+//line sql.y:3617
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 682:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3599
+//line sql.y:3623
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 683:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3603
+//line sql.y:3627
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 684:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3607
+//line sql.y:3631
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 685:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3611
+//line sql.y:3635
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 686:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3615
+//line sql.y:3639
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 687:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3619
+//line sql.y:3643
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 688:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3623
+//line sql.y:3647
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 689:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3629
+//line sql.y:3653
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3633
+//line sql.y:3657
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 691:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3639
+//line sql.y:3663
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3643
+//line sql.y:3667
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3647
+//line sql.y:3671
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 699:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3651
+//line sql.y:3675
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 700:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3656
+//line sql.y:3680
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 696:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3660
+//line sql.y:3684
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 697:
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3664
+//line sql.y:3688
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 699:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3671
+//line sql.y:3695
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 700:
+	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3676
+//line sql.y:3700
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3680
+//line sql.y:3704
 		{
 			yyLOCAL = yyDollar[1].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3686
+//line sql.y:3710
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3692
+//line sql.y:3716
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3696
+//line sql.y:3720
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 707:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3706
+//line sql.y:3730
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 713:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3710
+//line sql.y:3734
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent, Columns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3714
+//line sql.y:3738
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3720
+//line sql.y:3744
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3726
+//line sql.y:3750
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 717:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3730
+//line sql.y:3754
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 718:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3735
+//line sql.y:3759
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3739
+//line sql.y:3763
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3745
+//line sql.y:3769
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3749
+//line sql.y:3773
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 717:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3755
+//line sql.y:3779
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3759
+//line sql.y:3783
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 724:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3763
+//line sql.y:3787
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 720:
+	case 725:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3767
+//line sql.y:3791
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 721:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3773
+//line sql.y:3797
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 727:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3777
+//line sql.y:3801
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 723:
+	case 728:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3790
+//line sql.y:3814
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 729:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3794
+//line sql.y:3818
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 730:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3798
+//line sql.y:3822
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3802
+//line sql.y:3826
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3808
+//line sql.y:3832
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 728:
+	case 733:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3810
+//line sql.y:3834
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 729:
+	case 734:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3814
+//line sql.y:3838
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 730:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3816
+//line sql.y:3840
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 731:
+	case 736:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3820
+//line sql.y:3844
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 732:
+	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3822
+//line sql.y:3846
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 733:
+	case 738:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3825
+//line sql.y:3849
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 734:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3827
+//line sql.y:3851
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 735:
+	case 740:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3830
+//line sql.y:3854
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 736:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3834
+//line sql.y:3858
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 737:
+	case 742:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3838
+//line sql.y:3862
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 739:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3845
+//line sql.y:3869
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 740:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3851
+//line sql.y:3875
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3855
+//line sql.y:3879
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3859
+//line sql.y:3883
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3865
+//line sql.y:3889
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3871
+//line sql.y:3895
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3875
+//line sql.y:3899
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3879
+//line sql.y:3903
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3883
+//line sql.y:3907
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 753:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3889
+//line sql.y:3913
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3893
+//line sql.y:3917
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -11677,528 +11773,536 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3903
+//line sql.y:3927
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 751:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3907
+//line sql.y:3931
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 752:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3913
+//line sql.y:3937
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 753:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3917
+//line sql.y:3941
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 754:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3923
+//line sql.y:3947
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 755:
+	case 760:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3928
+//line sql.y:3952
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 761:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3932
+//line sql.y:3956
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 762:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3936
+//line sql.y:3960
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 763:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3940
+//line sql.y:3964
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 764:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3944
+//line sql.y:3968
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3949
+//line sql.y:3973
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 766:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3953
+//line sql.y:3977
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3960
+//line sql.y:3984
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3964
+//line sql.y:3988
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3968
+//line sql.y:3992
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3972
+//line sql.y:3996
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 771:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3976
+//line sql.y:4000
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3980
+//line sql.y:4004
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 773:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3987
+//line sql.y:4011
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNullOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 774:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3991
+//line sql.y:4015
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNotNullOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3995
+//line sql.y:4019
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3999
+//line sql.y:4023
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 777:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4005
+//line sql.y:4029
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 778:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4009
+//line sql.y:4033
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 779:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4013
+//line sql.y:4037
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: true, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 780:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4017
+//line sql.y:4041
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: false, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 781:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4021
+//line sql.y:4045
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 782:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4025
+//line sql.y:4049
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 783:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4029
+//line sql.y:4053
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 784:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4033
+//line sql.y:4057
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 785:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4037
+//line sql.y:4061
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 786:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4041
+//line sql.y:4065
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4045
+//line sql.y:4069
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 788:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4051
+//line sql.y:4075
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 789:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4055
+//line sql.y:4079
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 790:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4059
+//line sql.y:4083
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 791:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4063
+//line sql.y:4087
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 792:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4067
+//line sql.y:4091
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 793:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4071
+//line sql.y:4095
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 794:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4075
+//line sql.y:4099
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 795:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4079
+//line sql.y:4103
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 796:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4083
+//line sql.y:4107
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 797:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4087
+//line sql.y:4111
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 798:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4091
+//line sql.y:4115
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 799:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4095
+//line sql.y:4119
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 800:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4099
+//line sql.y:4123
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 801:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4105
+//line sql.y:4129
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4109
+//line sql.y:4133
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 803:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4137
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4113
+//line sql.y:4141
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4117
+//line sql.y:4145
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4121
+//line sql.y:4149
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Collation: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 807:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4125
+//line sql.y:4153
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4129
+//line sql.y:4157
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4133
+//line sql.y:4161
 		{
 			yyLOCAL = yyDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4137
+//line sql.y:4165
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4141
+//line sql.y:4169
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4145
+//line sql.y:4173
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4149
+//line sql.y:4177
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 814:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4153
+//line sql.y:4181
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4157
+//line sql.y:4185
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 816:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4161
+//line sql.y:4189
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 817:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4165
+//line sql.y:4193
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 818:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4169
+//line sql.y:4197
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 819:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4173
+//line sql.y:4201
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 820:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4177
+//line sql.y:4205
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 821:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4181
+//line sql.y:4209
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 822:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4185
+//line sql.y:4213
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -12207,850 +12311,874 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4193
+//line sql.y:4221
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 824:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4197
+//line sql.y:4225
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 825:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4204
+//line sql.y:4232
 		{
 			yyVAL.str = ""
 		}
-	case 820:
+	case 826:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4208
+//line sql.y:4236
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 821:
+	case 827:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4214
+//line sql.y:4242
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 828:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4218
+//line sql.y:4246
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4225
+//line sql.y:4253
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4229
+//line sql.y:4257
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 831:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4233
+//line sql.y:4261
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 832:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4237
+//line sql.y:4265
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 833:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4243
+//line sql.y:4271
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 834:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4247
+//line sql.y:4275
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4251
+//line sql.y:4279
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4255
+//line sql.y:4283
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4259
+//line sql.y:4287
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 838:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4263
+//line sql.y:4291
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4267
+//line sql.y:4295
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4273
+//line sql.y:4301
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 841:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4277
+//line sql.y:4305
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4281
+//line sql.y:4309
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:4288
+//line sql.y:4316
 		{
 			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4294
+//line sql.y:4322
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 845:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4298
+//line sql.y:4326
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 840:
+	case 846:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4308
+//line sql.y:4336
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 847:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4312
+//line sql.y:4340
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 848:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4316
+//line sql.y:4344
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 849:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4320
+//line sql.y:4348
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 850:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4357
+		{
+			yyLOCAL = &WindowExpr{Func: yyDollar[1].exprUnion().(*FuncExpr), PartitionBy: yyDollar[4].exprsUnion(), OrderBy: yyDollar[5].orderByUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 851:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4362
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 852:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4366
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 853:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4330
+//line sql.y:4376
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 854:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4334
+//line sql.y:4380
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 855:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4338
+//line sql.y:4384
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 856:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4342
+//line sql.y:4388
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 857:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4346
+//line sql.y:4392
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 858:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4350
+//line sql.y:4396
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 859:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4354
+//line sql.y:4400
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 860:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4358
+//line sql.y:4404
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 861:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4362
+//line sql.y:4408
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4366
+//line sql.y:4412
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4377
+//line sql.y:4423
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4381
+//line sql.y:4427
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 865:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4387
+//line sql.y:4433
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 866:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4391
+//line sql.y:4437
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4396
+//line sql.y:4442
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 868:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4400
+//line sql.y:4446
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 869:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4404
+//line sql.y:4450
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 870:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4408
+//line sql.y:4454
 		{
 			yyLOCAL = &ExtractFuncExpr{IntervalTypes: yyDollar[3].intervalTypeUnion(), Expr: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 871:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4414
+//line sql.y:4460
 		{
 		}
-	case 863:
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4416
+//line sql.y:4462
 		{
 			yyLOCAL = IntervalDayHour
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4420
+//line sql.y:4466
 		{
 			yyLOCAL = IntervalDayMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 874:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4424
+//line sql.y:4470
 		{
 			yyLOCAL = IntervalDayMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4428
+//line sql.y:4474
 		{
 			yyLOCAL = IntervalDaySecond
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4432
+//line sql.y:4478
 		{
 			yyLOCAL = IntervalHourMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 877:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4436
+//line sql.y:4482
 		{
 			yyLOCAL = IntervalHourMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4440
+//line sql.y:4486
 		{
 			yyLOCAL = IntervalHourSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4444
+//line sql.y:4490
 		{
 			yyLOCAL = IntervalMinuteMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4448
+//line sql.y:4494
 		{
 			yyLOCAL = IntervalMinuteSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4452
+//line sql.y:4498
 		{
 			yyLOCAL = IntervalSecondMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4456
+//line sql.y:4502
 		{
 			yyLOCAL = IntervalYearMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 883:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4462
+//line sql.y:4508
 		{
 			yyLOCAL = IntervalDay
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4466
+//line sql.y:4512
 		{
 			yyLOCAL = IntervalWeek
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4470
+//line sql.y:4516
 		{
 			yyLOCAL = IntervalHour
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4474
+//line sql.y:4520
 		{
 			yyLOCAL = IntervalMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4478
+//line sql.y:4524
 		{
 			yyLOCAL = IntervalMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4482
+//line sql.y:4528
 		{
 			yyLOCAL = IntervalQuarter
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4486
+//line sql.y:4532
 		{
 			yyLOCAL = IntervalSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4490
+//line sql.y:4536
 		{
 			yyLOCAL = IntervalMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4494
+//line sql.y:4540
 		{
 			yyLOCAL = IntervalYear
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 894:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4504
+//line sql.y:4550
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 895:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4508
+//line sql.y:4554
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4512
+//line sql.y:4558
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 897:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4522
+//line sql.y:4568
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 898:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4526
+//line sql.y:4572
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 899:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4530
+//line sql.y:4576
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 900:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4534
+//line sql.y:4580
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 901:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4538
+//line sql.y:4584
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 902:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4544
+//line sql.y:4590
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 903:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4548
+//line sql.y:4594
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 904:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4552
+//line sql.y:4598
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 905:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4556
+//line sql.y:4602
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 906:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4560
+//line sql.y:4606
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4566
+//line sql.y:4612
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 899:
+	case 908:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4570
+//line sql.y:4616
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 900:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4574
+//line sql.y:4620
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 901:
+	case 910:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4580
+//line sql.y:4626
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 911:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4584
+//line sql.y:4630
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 912:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4588
+//line sql.y:4634
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4592
+//line sql.y:4638
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 914:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4596
+//line sql.y:4642
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 915:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4600
+//line sql.y:4646
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 916:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4606
+//line sql.y:4652
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4610
+//line sql.y:4656
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4614
+//line sql.y:4660
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4618
+//line sql.y:4664
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 920:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4622
+//line sql.y:4668
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4626
+//line sql.y:4672
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 922:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4630
+//line sql.y:4676
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 923:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4635
+//line sql.y:4681
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4639
+//line sql.y:4685
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 925:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4644
+//line sql.y:4690
 		{
 			yyVAL.str = string("")
 		}
-	case 917:
+	case 926:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4648
+//line sql.y:4694
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 918:
+	case 927:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4654
+//line sql.y:4700
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 928:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4658
+//line sql.y:4704
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 920:
+	case 929:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4664
+//line sql.y:4710
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 930:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4669
+//line sql.y:4715
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4673
+//line sql.y:4719
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4679
+//line sql.y:4725
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 933:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4683
+//line sql.y:4729
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 934:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4687
+//line sql.y:4733
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4693
+//line sql.y:4739
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -13060,707 +13188,747 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 936:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4702
+//line sql.y:4748
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 937:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4706
+//line sql.y:4752
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 938:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4712
+//line sql.y:4758
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 939:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4716
+//line sql.y:4762
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 940:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4721
+//line sql.y:4767
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 941:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4725
+//line sql.y:4771
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4730
+//line sql.y:4776
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4734
+//line sql.y:4780
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4740
+//line sql.y:4786
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4746
+//line sql.y:4792
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4750
+//line sql.y:4796
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 938:
+	case 947:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4756
+//line sql.y:4802
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 948:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4761
+//line sql.y:4807
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4765
+//line sql.y:4811
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4769
+//line sql.y:4815
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 951:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4774
+//line sql.y:4820
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4778
+//line sql.y:4824
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 953:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4784
+//line sql.y:4830
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 954:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4788
+//line sql.y:4834
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 955:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4792
+//line sql.y:4838
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 956:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4797
+//line sql.y:4843
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4801
+//line sql.y:4847
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 958:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4805
+//line sql.y:4851
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4809
+//line sql.y:4855
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4813
+//line sql.y:4859
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 961:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4820
+//line sql.y:4866
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4824
+//line sql.y:4870
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4828
+//line sql.y:4874
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4832
+//line sql.y:4878
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4838
+//line sql.y:4884
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 966:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4842
+//line sql.y:4888
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 967:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4846
+//line sql.y:4892
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 968:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4851
+//line sql.y:4897
 		{
 			yyVAL.str = ""
 		}
-	case 960:
+	case 969:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4855
+//line sql.y:4901
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 961:
+	case 970:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4859
+//line sql.y:4905
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 962:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4863
+//line sql.y:4909
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 963:
+	case 972:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4868
+//line sql.y:4914
 		{
 			yyVAL.str = ""
 		}
-	case 964:
+	case 973:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4872
+//line sql.y:4918
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 965:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4878
+//line sql.y:4924
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 966:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4882
+//line sql.y:4928
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 967:
+	case 976:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4887
+//line sql.y:4933
 		{
 			yyVAL.str = ""
 		}
-	case 968:
+	case 977:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4891
+//line sql.y:4937
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 969:
+	case 978:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4896
+//line sql.y:4942
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 970:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4900
+//line sql.y:4946
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 971:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4904
+//line sql.y:4950
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 972:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4909
+//line sql.y:4955
 		{
 			yyVAL.str = ""
 		}
-	case 973:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4913
+//line sql.y:4959
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 974:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4919
+//line sql.y:4965
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 975:
+	case 984:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4923
+//line sql.y:4969
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 976:
+	case 985:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4927
+//line sql.y:4973
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 977:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4931
+//line sql.y:4977
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 978:
+	case 987:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4937
+//line sql.y:4983
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 988:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:4987
+		{
+			yyLOCAL = ForUpdateLockNoWait
+		}
+		yyVAL.union = yyLOCAL
+	case 989:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:4991
+		{
+			yyLOCAL = ForUpdateLockSkipLocked
+		}
+		yyVAL.union = yyLOCAL
+	case 990:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:4995
+		{
+			yyLOCAL = ForShareLock
+		}
+		yyVAL.union = yyLOCAL
+	case 991:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:4999
+		{
+			yyLOCAL = ForShareLockNoWait
+		}
+		yyVAL.union = yyLOCAL
+	case 992:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Lock
+//line sql.y:5003
+		{
+			yyLOCAL = ForShareLockSkipLocked
+		}
+		yyVAL.union = yyLOCAL
+	case 993:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4941
+//line sql.y:5007
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 994:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4947
+//line sql.y:5013
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 995:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4951
+//line sql.y:5017
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 996:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4955
+//line sql.y:5021
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 997:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4960
+//line sql.y:5026
 		{
 			yyVAL.str = ""
 		}
-	case 984:
+	case 998:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4964
+//line sql.y:5030
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 985:
+	case 999:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4968
+//line sql.y:5034
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 986:
+	case 1000:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4973
+//line sql.y:5039
 		{
 			yyVAL.str = ""
 		}
-	case 987:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4977
+//line sql.y:5043
 		{
 			yyVAL.str = " header"
 		}
-	case 988:
+	case 1002:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4982
+//line sql.y:5048
 		{
 			yyVAL.str = ""
 		}
-	case 989:
+	case 1003:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4986
+//line sql.y:5052
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 990:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4990
+//line sql.y:5056
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 991:
+	case 1005:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4995
+//line sql.y:5061
 		{
 			yyVAL.str = ""
 		}
-	case 992:
+	case 1006:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4999
+//line sql.y:5065
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 993:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5003
+//line sql.y:5069
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 994:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5009
+//line sql.y:5075
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 995:
+	case 1009:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5014
+//line sql.y:5080
 		{
 			yyVAL.str = ""
 		}
-	case 996:
+	case 1010:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5018
+//line sql.y:5084
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 997:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5024
+//line sql.y:5090
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 998:
+	case 1012:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5028
+//line sql.y:5094
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 999:
+	case 1013:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5034
+//line sql.y:5100
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1000:
+	case 1014:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5038
+//line sql.y:5104
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1001:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5043
+//line sql.y:5109
 		{
 			yyVAL.str = ""
 		}
-	case 1002:
+	case 1016:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5047
+//line sql.y:5113
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 1003:
+	case 1017:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5053
+//line sql.y:5119
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1004:
+	case 1018:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5057
+//line sql.y:5123
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1005:
+	case 1019:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5063
+//line sql.y:5129
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1006:
+	case 1020:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5067
+//line sql.y:5133
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 1007:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5071
+//line sql.y:5137
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1008:
+	case 1022:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5076
+//line sql.y:5142
 		{
 			yyVAL.str = ""
 		}
-	case 1009:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5080
+//line sql.y:5146
 		{
 			yyVAL.str = " optionally"
 		}
-	case 1010:
+	case 1024:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5093
+//line sql.y:5159
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5097
+//line sql.y:5163
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1026:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5101
+//line sql.y:5167
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1027:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5105
+//line sql.y:5171
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1028:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5109
+//line sql.y:5175
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5115
+//line sql.y:5181
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1030:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5119
+//line sql.y:5185
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1031:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5123
+//line sql.y:5189
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 1018:
+	case 1032:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:5127
+//line sql.y:5193
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 1019:
+	case 1033:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5132
+//line sql.y:5198
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1034:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5136
+//line sql.y:5202
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:5142
+//line sql.y:5208
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1036:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5146
+//line sql.y:5212
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 1023:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5152
+//line sql.y:5218
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1038:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5156
+//line sql.y:5222
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1039:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5162
+//line sql.y:5228
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5167
+//line sql.y:5233
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -13769,329 +13937,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5177
+//line sql.y:5243
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5181
+//line sql.y:5247
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 1029:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:5187
+//line sql.y:5253
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1044:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:5193
+//line sql.y:5259
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1045:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5197
+//line sql.y:5263
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 1032:
+	case 1046:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5203
+//line sql.y:5269
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1047:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5207
+//line sql.y:5273
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1048:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5211
+//line sql.y:5277
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1049:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5215
+//line sql.y:5281
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1050:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5219
+//line sql.y:5285
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1052:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5227
+//line sql.y:5293
 		{
 			yyVAL.str = "charset"
 		}
-	case 1041:
+	case 1055:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5237
+//line sql.y:5303
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5241
+//line sql.y:5307
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1057:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5245
+//line sql.y:5311
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1060:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5254
+//line sql.y:5320
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1061:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5256
+//line sql.y:5322
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1062:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5259
+//line sql.y:5325
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1063:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5261
+//line sql.y:5327
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1064:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5264
+//line sql.y:5330
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1065:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5266
+//line sql.y:5332
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1066:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5269
+//line sql.y:5335
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1067:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5271
+//line sql.y:5337
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1068:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5274
+//line sql.y:5340
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1055:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5276
+//line sql.y:5342
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1056:
+	case 1070:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5278
+//line sql.y:5344
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1057:
+	case 1071:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:5282
+//line sql.y:5348
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1072:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5287
+//line sql.y:5353
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5291
+//line sql.y:5357
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1074:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5296
+//line sql.y:5362
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1075:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5298
+//line sql.y:5364
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1076:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:5302
+//line sql.y:5368
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1077:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5308
+//line sql.y:5374
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 1064:
+	case 1078:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5312
+//line sql.y:5378
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1066:
+	case 1080:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5319
+//line sql.y:5385
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1067:
+	case 1081:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5325
+//line sql.y:5391
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 1068:
+	case 1082:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5329
+//line sql.y:5395
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1069:
+	case 1083:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5335
+//line sql.y:5401
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 1070:
+	case 1084:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5339
+//line sql.y:5405
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 1072:
+	case 1086:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5346
+//line sql.y:5412
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1514:
+	case 1529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5812
+//line sql.y:5879
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1515:
+	case 1530:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5821
+//line sql.y:5888
 		{
 			decNesting(yylex)
 		}
-	case 1516:
+	case 1531:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5826
+//line sql.y:5893
 		{
 			skipToEnd(yylex)
 		}
-	case 1517:
+	case 1532:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5831
+//line sql.y:5898
 		{
 			skipToEnd(yylex)
 		}
-	case 1518:
+	case 1533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5835
+//line sql.y:5902
 		{
 			skipToEnd(yylex)
 		}
-	case 1519:
+	case 1534:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5839
+//line sql.y:5906
 		{
 			skipToEnd(yylex)
 		}
@@ -88,7 +88,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   when          *When
   with          *With
   cte           *CommonTableExpr
-  ctes          []*CommonTableExpr
+  CTEs          []*CommonTableExpr
   order         *Order
   limit         *Limit
 
@@ -287,7 +287,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
 // Explain tokens
-%token <str> FORMAT TREE VITESS TRADITIONAL
+%token <str> FORMAT TREE VITESS TRADITIONAL COST
 
 // Lock type tokens
 %token <str> LOCAL LOW_PRIORITY
@@ -310,7 +310,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement
 %type <with> with_clause_opt with_clause
 %type <cte> common_table_expr
-%type <ctes> with_list
+%type <CTEs> with_list
 %type <renameTablePairs> rename_list
 %type <createTable> create_table_prefix
 %type <alterTable> alter_table_prefix
@@ -357,7 +357,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <comparisonExprOperator> compare
 %type <ins> insert_data
 %type <expr> num_val
-%type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict
+%type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict function_call_window
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list expression_list_opt
@@ -369,7 +369,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <exprs> group_by_opt partition_by_opt
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list order_by_clause
 %type <order> order
@@ -540,11 +540,11 @@ load_statement:
 with_clause:
   WITH with_list
   {
-	$$ = &With{ctes: $2, Recursive: false}
+	$$ = &With{CTEs: $2, Recursive: false}
   }
 | WITH RECURSIVE with_list
   {
-	$$ = &With{ctes: $3, Recursive: true}
+	$$ = &With{CTEs: $3, Recursive: true}
   }
 
 with_clause_opt:
@@ -1255,6 +1255,16 @@ column_attribute_list_opt:
 	$1.Collate = string($3.String())
 	$$ = $1
   }
+| column_attribute_list_opt VISIBLE
+  {
+    $1.Invisible = false
+    $$ = $1
+  }
+| column_attribute_list_opt INVISIBLE
+  {
+    $1.Invisible = true
+    $$ = $1
+  }
 
 column_storage:
   VIRTUAL
@@ -1292,6 +1302,16 @@ generated_column_attribute_list_opt:
     $1.Comment = NewStrLiteral($3)
     $$ = $1
   }
+| generated_column_attribute_list_opt VISIBLE
+  {
+    $1.Invisible = false
+    $$ = $1
+  }
+| generated_column_attribute_list_opt INVISIBLE
+  {
+    $1.Invisible = true
+    $$ = $1
+  }
 | generated_column_attribute_list_opt keys
   {
     $1.KeyOpt = $2
@@ -3275,6 +3295,10 @@ explain_format_opt:
   {
     $$ = TraditionalType
   }
+| FORMAT '=' COST
+  {
+    $$ = CostType
+  }
 | ANALYZE
   {
     $$ = AnalyzeType
@@ -4117,6 +4141,10 @@ function_call_keyword
   {
   	$$ = $1
   }
+| function_call_window
+  {
+  	$$ = $1
+  }
 | simple_expr COLLATE charset %prec UNARY
   {
 	$$ = &CollateExpr{Expr: $1, Collation: $3}
@@ -4321,6 +4349,24 @@ function_call_generic:
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+/*
+  Function calls evaluated over a window of rows
+*/
+function_call_window:
+  function_call_generic OVER openb partition_by_opt order_by_opt closeb
+  {
+    $$ = &WindowExpr{Func: $1.(*FuncExpr), PartitionBy: $4, OrderBy: $5}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -4937,6 +4983,26 @@ FOR UPDATE
   {
     $$ = ForUpdateLock
   }
+| FOR UPDATE NOWAIT
+  {
+    $$ = ForUpdateLockNoWait
+  }
+| FOR UPDATE SKIP LOCKED
+  {
+    $$ = ForUpdateLockSkipLocked
+  }
+| FOR SHARE
+  {
+    $$ = ForShareLock
+  }
+| FOR SHARE NOWAIT
+  {
+    $$ = ForShareLockNoWait
+  }
+| FOR SHARE SKIP LOCKED
+  {
+    $$ = ForShareLockSkipLocked
+  }
 | LOCK IN SHARE MODE
   {
     $$ = ShareModeLock
@@ -5545,6 +5611,7 @@ non_reserved_keyword:
 | COMPRESSION
 | CONNECTION
 | COPY
+| COST
 | CSV
 | DATA
 | DATE
@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statssnapshot captures snapshots of the stats a process exports on
+// /debug/vars, and diffs two snapshots, to compare the state of a process
+// before and after an incident.
+package statssnapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// Capture returns a snapshot of the stats of the process. The stats are all
+// read in one pass, right after the time of the snapshot.
+func Capture() *vtctldatapb.StatsSnapshot {
+	snapshot := &vtctldatapb.StatsSnapshot{
+		Time:    protoutil.TimeToProto(time.Now()),
+		Values:  make(map[string]float64),
+		Strings: make(map[string]string),
+	}
+	expvar.Do(func(kv expvar.KeyValue) {
+		var value interface{}
+		if err := json.Unmarshal([]byte(kv.Value.String()), &value); err != nil {
+			snapshot.Strings[kv.Key] = kv.Value.String()
+			return
+		}
+		Flatten(snapshot, kv.Key, value)
+	})
+	return snapshot
+}
+
+// Flatten adds the leaves of a JSON value decoded by encoding/json to the
+// snapshot, under path.
+func Flatten(snapshot *vtctldatapb.StatsSnapshot, path string, value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			Flatten(snapshot, path+"."+key, child)
+		}
+	case []interface{}:
+		for i, child := range value {
+			Flatten(snapshot, path+"."+strconv.Itoa(i), child)
+		}
+	case float64:
+		snapshot.Values[path] = value
+	case bool:
+		if value {
+			snapshot.Values[path] = 1
+		} else {
+			snapshot.Values[path] = 0
+		}
+	case string:
+		snapshot.Strings[path] = value
+	case nil:
+		snapshot.Strings[path] = "null"
+	}
+}
+
+// Delta is the change of a numeric stat between two snapshots.
+type Delta struct {
+	Path   string
+	Before float64
+	After  float64
+	// Added and Removed are set for the stats which are only in the after
+	// and before snapshots.
+	Added   bool
+	Removed bool
+}
+
+// Change returns the change of the stat relative to its value before, which
+// is infinite for a stat which was zero or which was added.
+func (d *Delta) Change() float64 {
+	if d.Before == 0 {
+		if d.After < 0 {
+			return math.Inf(-1)
+		}
+		return math.Inf(1)
+	}
+	return (d.After - d.Before) / math.Abs(d.Before)
+}
+
+// StringDelta is the change of a stat which is not numeric.
+type StringDelta struct {
+	Path   string
+	Before string
+	After  string
+}
+
+// Diff is the difference between two snapshots.
+type Diff struct {
+	Before *vtctldatapb.StatsSnapshot
+	After  *vtctldatapb.StatsSnapshot
+	// Deltas are the numeric stats which changed, from the biggest relative
+	// change to the smallest.
+	Deltas []*Delta
+	// StringDeltas are the other stats which changed, by path.
+	StringDeltas []*StringDelta
+	// Unchanged is the number of stats which did not change.
+	Unchanged int
+}
+
+// Elapsed returns the time between the snapshots.
+func (d *Diff) Elapsed() time.Duration {
+	return protoutil.TimeFromProto(d.After.Time).Sub(protoutil.TimeFromProto(d.Before.Time))
+}
+
+// DiffSnapshots returns the difference between the before and after
+// snapshots.
+func DiffSnapshots(before, after *vtctldatapb.StatsSnapshot) *Diff {
+	diff := &Diff{Before: before, After: after}
+	for path, b := range before.Values {
+		a, ok := after.Values[path]
+		switch {
+		case !ok:
+			diff.Deltas = append(diff.Deltas, &Delta{Path: path, Before: b, Removed: true})
+		case a != b:
+			diff.Deltas = append(diff.Deltas, &Delta{Path: path, Before: b, After: a})
+		default:
+			diff.Unchanged++
+		}
+	}
+	for path, a := range after.Values {
+		if _, ok := before.Values[path]; !ok {
+			diff.Deltas = append(diff.Deltas, &Delta{Path: path, After: a, Added: true})
+		}
+	}
+	sort.Slice(diff.Deltas, func(i, j int) bool {
+		di, dj := diff.Deltas[i], diff.Deltas[j]
+		if ci, cj := math.Abs(di.Change()), math.Abs(dj.Change()); ci != cj {
+			return ci > cj
+		}
+		if ai, aj := math.Abs(di.After-di.Before), math.Abs(dj.After-dj.Before); ai != aj {
+			return ai > aj
+		}
+		return di.Path < dj.Path
+	})
+
+	for path, b := range before.Strings {
+		a := after.Strings[path]
+		if a != b {
+			diff.StringDeltas = append(diff.StringDeltas, &StringDelta{Path: path, Before: b, After: a})
+		} else {
+			diff.Unchanged++
+		}
+	}
+	for path, a := range after.Strings {
+		if _, ok := before.Strings[path]; !ok {
+			diff.StringDeltas = append(diff.StringDeltas, &StringDelta{Path: path, After: a})
+		}
+	}
+	sort.Slice(diff.StringDeltas, func(i, j int) bool {
+		return diff.StringDeltas[i].Path < diff.StringDeltas[j].Path
+	})
+	return diff
+}
+
+// Format returns a human-readable report of the diff, with its limit biggest
+// numeric changes, or all of them if limit is not positive. The changes by a
+// factor of two or more, and the added and removed stats, are highlighted
+// with a "!".
+func (d *Diff) Format(limit int) string {
+	buf := &bytes.Buffer{}
+	elapsed := d.Elapsed()
+	fmt.Fprintf(buf, "Stats diff from %v to %v (%v)", protoutil.TimeFromProto(d.Before.Time).UTC().Format(time.RFC3339), protoutil.TimeFromProto(d.After.Time).UTC().Format(time.RFC3339), elapsed)
+	fmt.Fprintf(buf, ": %d changed, %d unchanged\n", len(d.Deltas)+len(d.StringDeltas), d.Unchanged)
+
+	if len(d.StringDeltas) > 0 {
+		fmt.Fprintf(buf, "\nChanged values:\n")
+		for _, sd := range d.StringDeltas {
+			fmt.Fprintf(buf, "  %s: %q -> %q\n", sd.Path, sd.Before, sd.After)
+		}
+	}
+
+	deltas := d.Deltas
+	if limit > 0 && len(deltas) > limit {
+		deltas = deltas[:limit]
+	}
+	if len(deltas) == 0 {
+		return buf.String()
+	}
+	fmt.Fprintf(buf, "\nBiggest changes (%d of %d):\n", len(deltas), len(d.Deltas))
+	rows := [][]string{{"", "CHANGE", "BEFORE", "AFTER", "DELTA", "PER SECOND", "STAT"}}
+	for _, delta := range deltas {
+		change := delta.Change()
+		highlight := ""
+		if delta.Added || delta.Removed || math.Abs(change) >= 1 {
+			highlight = "!"
+		}
+		before, after := formatValue(delta.Before), formatValue(delta.After)
+		var changeStr string
+		switch {
+		case delta.Added:
+			changeStr, before = "added", ""
+		case delta.Removed:
+			changeStr, after = "removed", ""
+		case math.IsInf(change, 0):
+			changeStr = "from 0"
+		default:
+			changeStr = fmt.Sprintf("%+.1f%%", change*100)
+		}
+		perSecond := ""
+		if elapsed > 0 && !delta.Added && !delta.Removed {
+			perSecond = formatValue((delta.After - delta.Before) / elapsed.Seconds())
+		}
+		rows = append(rows, []string{highlight, changeStr, before, after, signed(delta.After - delta.Before), perSecond, delta.Path})
+	}
+	writeTable(buf, rows)
+	return buf.String()
+}
+
+// formatValue formats a value without its decimals if it is an integer, and
+// with up to three decimals otherwise.
+func formatValue(v float64) string {
+	if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(math.Round(v*1000)/1000, 'f', -1, 64)
+}
+
+func signed(v float64) string {
+	if v > 0 {
+		return "+" + formatValue(v)
+	}
+	return formatValue(v)
+}
+
+// writeTable writes rows of cells aligned in columns, with the numbers
+// aligned to the right.
+func writeTable(buf *bytes.Buffer, rows [][]string) {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	last := len(widths) - 1
+	for _, row := range rows {
+		buf.WriteString(" ")
+		for i, cell := range row {
+			buf.WriteString(" ")
+			switch {
+			case i == 0:
+				fmt.Fprintf(buf, "%-*s", widths[i], cell)
+			case i == last:
+				buf.WriteString(cell)
+			default:
+				fmt.Fprintf(buf, "%*s ", widths[i], cell)
+			}
+		}
+		buf.WriteString("\n")
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statssnapshot
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/stats"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestCapture(t *testing.T) {
+	counters := stats.NewCountersWithMultiLabels("StatsSnapshotTestCounters", "", []string{"Keyspace", "Shard"})
+	counters.Add([]string{"ks", "-80"}, 3)
+	stats.NewString("StatsSnapshotTestString").Set("SERVING")
+
+	snapshot := Capture()
+	assert.NotNil(t, snapshot.Time)
+	assert.Equal(t, float64(3), snapshot.Values["StatsSnapshotTestCounters.ks.-80"])
+	assert.Equal(t, "SERVING", snapshot.Strings["StatsSnapshotTestString"])
+}
+
+func TestFlatten(t *testing.T) {
+	var value interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"Counts": {"a": 1, "b": 2.5}, "Buckets": [3, 4], "Serving": true, "State": "SERVING", "None": null}`), &value))
+	snapshot := &vtctldatapb.StatsSnapshot{Values: map[string]float64{}, Strings: map[string]string{}}
+	Flatten(snapshot, "Stat", value)
+	assert.Equal(t, map[string]float64{
+		"Stat.Counts.a":  1,
+		"Stat.Counts.b":  2.5,
+		"Stat.Buckets.0": 3,
+		"Stat.Buckets.1": 4,
+		"Stat.Serving":   1,
+	}, snapshot.Values)
+	assert.Equal(t, map[string]string{
+		"Stat.State": "SERVING",
+		"Stat.None":  "null",
+	}, snapshot.Strings)
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	start := time.Date(2021, time.June, 1, 10, 0, 0, 0, time.UTC)
+	before := &vtctldatapb.StatsSnapshot{
+		Time: protoutil.TimeToProto(start),
+		Values: map[string]float64{
+			"Queries":        1000,
+			"Errors":         2,
+			"Unchanged":      7,
+			"Lag":            0,
+			"OnlyBefore":     5,
+			"ConnectionsMax": 100,
+		},
+		Strings: map[string]string{
+			"State":   "SERVING",
+			"Version": "12.0",
+		},
+	}
+	after := &vtctldatapb.StatsSnapshot{
+		Time: protoutil.TimeToProto(start.Add(100 * time.Second)),
+		Values: map[string]float64{
+			"Queries":        1100,
+			"Errors":         200,
+			"Unchanged":      7,
+			"Lag":            30.5,
+			"OnlyAfter":      1,
+			"ConnectionsMax": 100,
+		},
+		Strings: map[string]string{
+			"State":   "NOT_SERVING",
+			"Version": "12.0",
+		},
+	}
+
+	diff := DiffSnapshots(before, after)
+	assert.Equal(t, 100*time.Second, diff.Elapsed())
+	assert.Equal(t, 3, diff.Unchanged)
+	var paths []string
+	for _, delta := range diff.Deltas {
+		paths = append(paths, delta.Path)
+	}
+	assert.Equal(t, []string{"Lag", "OnlyAfter", "Errors", "OnlyBefore", "Queries"}, paths)
+	assert.Equal(t, []*StringDelta{{Path: "State", Before: "SERVING", After: "NOT_SERVING"}}, diff.StringDeltas)
+
+	want := `Stats diff from 2021-06-01T10:00:00Z to 2021-06-01T10:01:40Z (1m40s): 6 changed, 3 unchanged
+
+Changed values:
+  State: "SERVING" -> "NOT_SERVING"
+
+Biggest changes (4 of 5):
+      CHANGE  BEFORE  AFTER  DELTA  PER SECOND  STAT
+  !   from 0       0   30.5  +30.5       0.305  Lag
+  !    added              1     +1              OnlyAfter
+  ! +9900.0%       2    200   +198        1.98  Errors
+  !  removed       5            -5              OnlyBefore
+`
+	assert.Equal(t, want, diff.Format(4))
+}
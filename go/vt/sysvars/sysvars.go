@@ -47,14 +47,19 @@ var (
 	Charset                     = SystemVariable{Name: "charset", Default: utf8mb4, IdentifierAsString: true}
 	ClientFoundRows             = SystemVariable{Name: "client_found_rows", IsBoolean: true, Default: off}
 	SessionEnableSystemSettings = SystemVariable{Name: "enable_system_settings", IsBoolean: true, Default: on}
+	MaxShardsPerQuery           = SystemVariable{Name: "max_shards_per_query"}
 	Names                       = SystemVariable{Name: "names", Default: utf8mb4, IdentifierAsString: true}
+	ScatterPolicy               = SystemVariable{Name: "scatter_policy", IdentifierAsString: true}
 	SessionUUID                 = SystemVariable{Name: "session_uuid", IdentifierAsString: true}
 	SkipQueryPlanCache          = SystemVariable{Name: "skip_query_plan_cache", IsBoolean: true, Default: off}
 	Socket                      = SystemVariable{Name: "socket", Default: off}
 	SQLSelectLimit              = SystemVariable{Name: "sql_select_limit", Default: off}
 	TransactionMode             = SystemVariable{Name: "transaction_mode", IdentifierAsString: true}
+	TransactionIsolation        = SystemVariable{Name: "transaction_isolation", IdentifierAsString: true}
 	TransactionReadOnly         = SystemVariable{Name: "transaction_read_only", IsBoolean: true, Default: off}
+	TxIsolation                 = SystemVariable{Name: "tx_isolation", IdentifierAsString: true}
 	TxReadOnly                  = SystemVariable{Name: "tx_read_only", IsBoolean: true, Default: off}
+	TxTimeout                   = SystemVariable{Name: "vitess_tx_timeout"}
 	Workload                    = SystemVariable{Name: "workload", IdentifierAsString: true}
 
 	// Online DDL
@@ -66,6 +71,7 @@ var (
 	ReadAfterWriteGTID    = SystemVariable{Name: "read_after_write_gtid"}
 	ReadAfterWriteTimeOut = SystemVariable{Name: "read_after_write_timeout"}
 	SessionTrackGTIDs     = SystemVariable{Name: "session_track_gtids", IdentifierAsString: true}
+	ReadYourWrites        = SystemVariable{Name: "read_your_writes", IsBoolean: true, Default: off}
 
 	VitessAware = []SystemVariable{
 		Autocommit,
@@ -73,10 +79,15 @@ var (
 		SkipQueryPlanCache,
 		TxReadOnly,
 		TransactionReadOnly,
+		TxIsolation,
+		TransactionIsolation,
+		TxTimeout,
 		SQLSelectLimit,
 		TransactionMode,
 		DDLStrategy,
 		Workload,
+		ScatterPolicy,
+		MaxShardsPerQuery,
 		Charset,
 		Names,
 		SessionUUID,
@@ -84,6 +95,7 @@ var (
 		ReadAfterWriteGTID,
 		ReadAfterWriteTimeOut,
 		SessionTrackGTIDs,
+		ReadYourWrites,
 	}
 
 	ReadOnly = []SystemVariable{
@@ -94,19 +106,14 @@ var (
 
 	IgnoreThese = []SystemVariable{
 		{Name: "big_tables", IsBoolean: true},
-		{Name: "bulk_insert_buffer_size"},
 		{Name: "debug"},
 		{Name: "default_storage_engine"},
-		{Name: "default_tmp_storage_engine"},
-		{Name: "innodb_strict_mode", IsBoolean: true},
 		{Name: "innodb_support_xa", IsBoolean: true},
 		{Name: "innodb_table_locks", IsBoolean: true},
 		{Name: "innodb_tmpdir"},
-		{Name: "join_buffer_size"},
 		{Name: "keep_files_on_create", IsBoolean: true},
 		{Name: "lc_messages"},
 		{Name: "long_query_time"},
-		{Name: "low_priority_updates", IsBoolean: true},
 		{Name: "max_delayed_threads"},
 		{Name: "max_insert_delayed_threads"},
 		{Name: "multi_range_count"},
@@ -115,7 +122,6 @@ var (
 		{Name: "query_cache_type"},
 		{Name: "query_cache_wlock_invalidate", IsBoolean: true},
 		{Name: "query_prealloc_size"},
-		{Name: "sql_buffer_result", IsBoolean: true},
 		{Name: "transaction_alloc_block_size"},
 		{Name: "wait_timeout"},
 	}
@@ -162,15 +168,33 @@ var (
 		{Name: "transaction_write_set_extraction"},
 	}
 	UseReservedConn = []SystemVariable{
+		{Name: "block_encryption_mode"},
+		{Name: "bulk_insert_buffer_size"},
+		{Name: "cte_max_recursion_depth"},
+		{Name: "default_tmp_storage_engine"},
 		{Name: "default_week_format"},
+		{Name: "div_precision_increment"},
 		{Name: "end_markers_in_json", IsBoolean: true},
 		{Name: "eq_range_index_dive_limit"},
 		{Name: "explicit_defaults_for_timestamp"},
 		{Name: "foreign_key_checks", IsBoolean: true},
 		{Name: "group_concat_max_len"},
+		{Name: "histogram_generation_max_mem_size"},
 		{Name: "information_schema_stats_expiry"},
+		{Name: "innodb_lock_wait_timeout"},
+		{Name: "innodb_parallel_read_threads"},
+		{Name: "innodb_strict_mode", IsBoolean: true},
+		{Name: "internal_tmp_mem_storage_engine"},
+		{Name: "join_buffer_size"},
+		{Name: "lc_time_names"},
+		{Name: "lock_wait_timeout"},
+		{Name: "low_priority_updates", IsBoolean: true},
+		{Name: "max_execution_time"},
 		{Name: "max_heap_table_size"},
+		{Name: "max_join_size"},
+		{Name: "max_length_for_sort_data"},
 		{Name: "max_seeks_for_key"},
+		{Name: "max_sort_length"},
 		{Name: "max_tmp_tables"},
 		{Name: "min_examined_row_limit"},
 		{Name: "old_passwords"},
@@ -181,8 +205,6 @@ var (
 		{Name: "optimizer_trace_features"},
 		{Name: "optimizer_trace_limit"},
 		{Name: "optimizer_trace_max_mem_size"},
-		{Name: "transaction_isolation"},
-		{Name: "tx_isolation"},
 		{Name: "optimizer_trace_offset"},
 		{Name: "parser_max_mem_size"},
 		{Name: "profiling", IsBoolean: true},
@@ -195,10 +217,13 @@ var (
 		{Name: "show_create_table_verbosity", IsBoolean: true},
 		{Name: "show_old_temporals", IsBoolean: true},
 		{Name: "sort_buffer_size"},
+		{Name: "sql_auto_is_null", IsBoolean: true},
 		{Name: "sql_big_selects", IsBoolean: true},
+		{Name: "sql_buffer_result", IsBoolean: true},
 		{Name: "sql_mode"},
 		{Name: "sql_notes", IsBoolean: true},
 		{Name: "sql_quote_show_create", IsBoolean: true},
+		{Name: "sql_require_primary_key", IsBoolean: true},
 		{Name: "sql_safe_updates", IsBoolean: true},
 		{Name: "sql_warnings", IsBoolean: true},
 		{Name: "time_zone"},
@@ -206,13 +231,13 @@ var (
 		{Name: "transaction_prealloc_size"},
 		{Name: "unique_checks", IsBoolean: true},
 		{Name: "updatable_views_with_limit", IsBoolean: true},
+		{Name: "windowing_use_high_precision", IsBoolean: true},
 	}
 	CheckAndIgnore = []SystemVariable{
 		// TODO: Most of these settings should be moved into SysSetOpAware, and change Vitess behaviour.
 		// Until then, SET statements against these settings are allowed
 		// as long as they have the same value as the underlying database
 		{Name: "binlog_format"},
-		{Name: "block_encryption_mode"},
 		{Name: "character_set_client"},
 		{Name: "character_set_connection"},
 		{Name: "character_set_database"},
@@ -223,17 +248,9 @@ var (
 		{Name: "collation_database"},
 		{Name: "collation_server"},
 		{Name: "completion_type"},
-		{Name: "div_precision_increment"},
-		{Name: "innodb_lock_wait_timeout"},
 		{Name: "interactive_timeout"},
-		{Name: "lc_time_names"},
-		{Name: "lock_wait_timeout"},
 		{Name: "max_allowed_packet"},
 		{Name: "max_error_count"},
-		{Name: "max_execution_time"},
-		{Name: "max_join_size"},
-		{Name: "max_length_for_sort_data"},
-		{Name: "max_sort_length"},
 		{Name: "max_user_connections"},
 		{Name: "net_read_timeout"},
 		{Name: "net_retry_count"},
@@ -242,7 +259,6 @@ var (
 		{Name: "session_track_state_change", IsBoolean: true},
 		{Name: "session_track_system_variables"},
 		{Name: "session_track_transaction_info"},
-		{Name: "sql_auto_is_null", IsBoolean: true},
 		{Name: "version_tokens_session"},
 	}
 )
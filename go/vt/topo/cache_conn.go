@@ -0,0 +1,295 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var _ Conn = (*CacheConn)(nil)
+
+var (
+	topoCacheReads = stats.NewCountersWithMultiLabels(
+		"TopologyCacheReads",
+		"Reads of the topology cache, by result: hit, stale or miss",
+		[]string{"Cell", "Result"})
+
+	topoCacheStaleness = stats.NewTimings(
+		"TopologyCacheStaleness",
+		"Time since the watch of the files the topology cache served stale stopped",
+		"Cell")
+
+	topoCacheEntries = stats.NewGaugesWithSingleLabel(
+		"TopologyCacheEntries",
+		"Number of files cached by the topology cache",
+		"Cell")
+)
+
+// cacheWatchRetryDelay is the minimum delay between the restarts of the watch
+// of a file served stale, so that an unavailable topology server is not
+// hammered by the reads of the cache.
+const cacheWatchRetryDelay = time.Second
+
+const (
+	cacheHit   = "hit"
+	cacheStale = "stale"
+	cacheMiss  = "miss"
+)
+
+// cacheEntryState is the state of the watch of a cached file.
+type cacheEntryState int
+
+const (
+	// cacheEntryStarting means the watch is being started, and the
+	// entry has no value yet.
+	cacheEntryStarting cacheEntryState = iota
+	// cacheEntryWatching means the watch is running, so the value is
+	// up to date.
+	cacheEntryWatching
+	// cacheEntryBroken means the watch stopped, so the value may be stale.
+	cacheEntryBroken
+)
+
+type cacheEntry struct {
+	state    cacheEntryState
+	contents []byte
+	version  Version
+	// brokenAt is when the watch stopped.
+	brokenAt time.Time
+	// restarting is true while the watch of a broken entry is restarted,
+	// and restartedAt is when it was last restarted.
+	restarting  bool
+	restartedAt time.Time
+}
+
+// CacheConn is a wrapper for a Conn that caches the files it reads. The first
+// read of a file goes to the topology server and starts a watch of the file,
+// which keeps its cached value up to date, so that the next reads are served
+// from memory. If the watch stops, because the topology server is unavailable,
+// the cached value is served stale for staleWindow, while the watch is
+// restarted, before the reads go to the topology server again.
+//
+// The cache trades the strong consistency of the reads for the QPS of the
+// topology server, and is meant for the processes which mostly read it, like
+// vtgate. The files written through the CacheConn are updated in its cache.
+type CacheConn struct {
+	Conn
+
+	cell        string
+	staleWindow time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// NewCacheConn returns a CacheConn
+func NewCacheConn(cell string, conn Conn, staleWindow time.Duration) *CacheConn {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &CacheConn{
+		Conn:        conn,
+		cell:        cell,
+		staleWindow: staleWindow,
+		ctx:         ctx,
+		cancel:      cancel,
+		entries:     make(map[string]*cacheEntry),
+	}
+}
+
+// Get is part of the Conn interface
+func (cc *CacheConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	cc.mu.Lock()
+	entry, ok := cc.entries[filePath]
+	if ok {
+		switch entry.state {
+		case cacheEntryWatching:
+			contents, version := entry.contents, entry.version
+			cc.mu.Unlock()
+			topoCacheReads.Add([]string{cc.cell, cacheHit}, 1)
+			return contents, version, nil
+		case cacheEntryBroken:
+			if time.Since(entry.brokenAt) < cc.staleWindow {
+				contents, version, brokenAt := entry.contents, entry.version, entry.brokenAt
+				cc.restartWatchLocked(filePath, entry)
+				cc.mu.Unlock()
+				topoCacheReads.Add([]string{cc.cell, cacheStale}, 1)
+				topoCacheStaleness.Record(cc.cell, brokenAt)
+				return contents, version, nil
+			}
+			cc.deleteLocked(filePath)
+			ok = false
+		}
+	}
+	cc.mu.Unlock()
+
+	topoCacheReads.Add([]string{cc.cell, cacheMiss}, 1)
+	contents, version, err := cc.Conn.Get(ctx, filePath)
+	if err == nil && !ok {
+		cc.startWatch(filePath)
+	}
+	return contents, version, err
+}
+
+// Create is part of the Conn interface
+func (cc *CacheConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	version, err := cc.Conn.Create(ctx, filePath, contents)
+	if err == nil {
+		cc.written(filePath, contents, version)
+	}
+	return version, err
+}
+
+// Update is part of the Conn interface
+func (cc *CacheConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	newVersion, err := cc.Conn.Update(ctx, filePath, contents, version)
+	if err == nil {
+		cc.written(filePath, contents, newVersion)
+	}
+	return newVersion, err
+}
+
+// Delete is part of the Conn interface
+func (cc *CacheConn) Delete(ctx context.Context, filePath string, version Version) error {
+	err := cc.Conn.Delete(ctx, filePath, version)
+	if err == nil || IsErrType(err, NoNode) {
+		cc.mu.Lock()
+		cc.deleteLocked(filePath)
+		cc.mu.Unlock()
+	}
+	return err
+}
+
+// Close is part of the Conn interface
+func (cc *CacheConn) Close() {
+	cc.cancel()
+	cc.Conn.Close()
+}
+
+// written updates the cached value of a file written through the CacheConn,
+// ahead of its watch.
+func (cc *CacheConn) written(filePath string, contents []byte, version Version) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if entry, ok := cc.entries[filePath]; ok && entry.state != cacheEntryStarting {
+		entry.contents, entry.version = contents, version
+	}
+}
+
+func (cc *CacheConn) deleteLocked(filePath string) {
+	if _, ok := cc.entries[filePath]; ok {
+		delete(cc.entries, filePath)
+		topoCacheEntries.Add(cc.cell, -1)
+	}
+}
+
+// startWatch starts caching a file, unless it already is.
+func (cc *CacheConn) startWatch(filePath string) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if _, ok := cc.entries[filePath]; ok {
+		return
+	}
+	entry := &cacheEntry{state: cacheEntryStarting}
+	cc.entries[filePath] = entry
+	topoCacheEntries.Add(cc.cell, 1)
+	go cc.watch(filePath, entry)
+}
+
+// restartWatchLocked restarts the watch of a file whose watch stopped. The
+// entry keeps serving its stale value until the watch runs again.
+func (cc *CacheConn) restartWatchLocked(filePath string, entry *cacheEntry) {
+	if entry.restarting || time.Since(entry.restartedAt) < cacheWatchRetryDelay {
+		return
+	}
+	entry.restarting = true
+	entry.restartedAt = time.Now()
+	go cc.watch(filePath, entry)
+}
+
+// watch keeps the cached value of the file up to date until its watch stops.
+func (cc *CacheConn) watch(filePath string, entry *cacheEntry) {
+	current, changes, cancel := cc.Conn.Watch(cc.ctx, filePath)
+	if current.Err != nil {
+		cc.watchStopped(filePath, entry, current.Err)
+		return
+	}
+	defer cancel()
+
+	if !cc.update(filePath, entry, current) {
+		return
+	}
+	for {
+		select {
+		case <-cc.ctx.Done():
+			cc.watchStopped(filePath, entry, cc.ctx.Err())
+			return
+		case wd, ok := <-changes:
+			if !ok {
+				cc.watchStopped(filePath, entry, NewError(Interrupted, filePath))
+				return
+			}
+			if wd.Err != nil {
+				cc.watchStopped(filePath, entry, wd.Err)
+				return
+			}
+			if !cc.update(filePath, entry, wd) {
+				return
+			}
+		}
+	}
+}
+
+// update updates the cached value of a file from its watch, and returns false
+// if the file is not cached anymore.
+func (cc *CacheConn) update(filePath string, entry *cacheEntry, wd *WatchData) bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.entries[filePath] != entry {
+		return false
+	}
+	entry.state = cacheEntryWatching
+	entry.restarting = false
+	entry.contents, entry.version = wd.Contents, wd.Version
+	return true
+}
+
+// watchStopped stops caching a file which was deleted, or whose watch could
+// not start, and otherwise lets its cached value be served stale.
+func (cc *CacheConn) watchStopped(filePath string, entry *cacheEntry, err error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.entries[filePath] != entry {
+		return
+	}
+	entry.restarting = false
+	if entry.state == cacheEntryStarting || IsErrType(err, NoNode) || cc.ctx.Err() != nil {
+		cc.deleteLocked(filePath)
+		return
+	}
+	if entry.state == cacheEntryWatching {
+		log.Warningf("Watch of %v in the topology cache of cell %v stopped, serving its cached value for up to %v: %v", filePath, cc.cell, cc.staleWindow, err)
+		entry.brokenAt = time.Now()
+	}
+	entry.state = cacheEntryBroken
+}
@@ -295,6 +295,30 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 		return err
 	}
 
+	if err := ts.DeleteQueryRules(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+
+	if err := ts.DeleteTableACL(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+
+	if err := ts.DeleteSchemaOwnership(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+
+	if err := ts.DeleteOnlineDDLSchedulerConfig(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+
+	if err := ts.DeleteVDiffCheckpoints(ctx, keyspace); err != nil {
+		return err
+	}
+
+	if err := ts.DeletePendingSchemaMigrations(ctx, keyspace); err != nil {
+		return err
+	}
+
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
 		Keyspace:     nil,
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// GetMaskingPolicy returns the masking policy of the cluster, which is empty
+// if none was saved.
+func (ts *Server) GetMaskingPolicy(ctx context.Context) (*binlogdatapb.MaskingPolicy, error) {
+	policy := &binlogdatapb.MaskingPolicy{}
+	data, _, err := ts.globalCell.Get(ctx, MaskingPolicyFile)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return policy, nil
+		}
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, policy); err != nil {
+		return nil, vterrors.Wrapf(err, "bad masking policy data: %q", data)
+	}
+	return policy, nil
+}
+
+// SaveMaskingPolicy creates or replaces the masking policy of the cluster.
+// An empty policy deletes it.
+func (ts *Server) SaveMaskingPolicy(ctx context.Context, policy *binlogdatapb.MaskingPolicy) error {
+	data, err := proto.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		if err := ts.globalCell.Delete(ctx, MaskingPolicyFile, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	_, err = ts.globalCell.Update(ctx, MaskingPolicyFile, data, nil)
+	return err
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func onlineDDLSchedulerConfigPath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, OnlineDDLSchedulerConfigFile)
+}
+
+// GetOnlineDDLSchedulerConfig returns the configuration of the online DDL
+// scheduler of a keyspace, which is empty if none was saved.
+func (ts *Server) GetOnlineDDLSchedulerConfig(ctx context.Context, keyspace string) (*topodatapb.OnlineDDLSchedulerConfig, error) {
+	config := &topodatapb.OnlineDDLSchedulerConfig{}
+	data, _, err := ts.globalCell.Get(ctx, onlineDDLSchedulerConfigPath(keyspace))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return config, nil
+		}
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, config); err != nil {
+		return nil, vterrors.Wrapf(err, "bad online ddl scheduler config data: %q", data)
+	}
+	return config, nil
+}
+
+// SaveOnlineDDLSchedulerConfig creates or replaces the configuration of the
+// online DDL scheduler of a keyspace. An empty configuration deletes it.
+func (ts *Server) SaveOnlineDDLSchedulerConfig(ctx context.Context, keyspace string, config *topodatapb.OnlineDDLSchedulerConfig) error {
+	data, err := proto.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		if err := ts.DeleteOnlineDDLSchedulerConfig(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	_, err = ts.globalCell.Update(ctx, onlineDDLSchedulerConfigPath(keyspace), data, nil)
+	return err
+}
+
+// DeleteOnlineDDLSchedulerConfig deletes the configuration of the online DDL
+// scheduler of a keyspace.
+func (ts *Server) DeleteOnlineDDLSchedulerConfig(ctx context.Context, keyspace string) error {
+	return ts.globalCell.Delete(ctx, onlineDDLSchedulerConfigPath(keyspace), nil)
+}
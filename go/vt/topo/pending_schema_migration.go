@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func pendingSchemaMigrationPath(keyspace, uuid string) string {
+	return path.Join(KeyspacesPath, keyspace, PendingSchemaMigrationsPath, uuid)
+}
+
+// CreatePendingSchemaMigration saves a schema change which waits for approval.
+// It returns a NodeExists error if a pending schema change of the same UUID
+// already exists.
+func (ts *Server) CreatePendingSchemaMigration(ctx context.Context, migration *vtctldatapb.PendingSchemaMigration) error {
+	data, err := proto.Marshal(migration)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Create(ctx, pendingSchemaMigrationPath(migration.Keyspace, migration.Uuid), data)
+	return err
+}
+
+// GetPendingSchemaMigration returns a schema change which waits for approval,
+// along with the version to delete it with. It returns a NoNode error if there
+// is no such pending schema change.
+func (ts *Server) GetPendingSchemaMigration(ctx context.Context, keyspace, uuid string) (*vtctldatapb.PendingSchemaMigration, Version, error) {
+	data, version, err := ts.globalCell.Get(ctx, pendingSchemaMigrationPath(keyspace, uuid))
+	if err != nil {
+		return nil, nil, err
+	}
+	migration := &vtctldatapb.PendingSchemaMigration{}
+	if err := proto.Unmarshal(data, migration); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "bad pending schema migration data: %q", data)
+	}
+	return migration, version, nil
+}
+
+// GetPendingSchemaMigrations returns the schema changes of a keyspace which
+// wait for approval, ordered by UUID.
+func (ts *Server) GetPendingSchemaMigrations(ctx context.Context, keyspace string) ([]*vtctldatapb.PendingSchemaMigration, error) {
+	entries, err := ts.globalCell.ListDir(ctx, path.Join(KeyspacesPath, keyspace, PendingSchemaMigrationsPath), false /*full*/)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	migrations := make([]*vtctldatapb.PendingSchemaMigration, 0, len(entries))
+	for _, entry := range entries {
+		migration, _, err := ts.GetPendingSchemaMigration(ctx, keyspace, entry.Name)
+		if err != nil {
+			if IsErrType(err, NoNode) {
+				// approved or deleted in the meantime
+				continue
+			}
+			return nil, err
+		}
+		migrations = append(migrations, migration)
+	}
+	return migrations, nil
+}
+
+// DeletePendingSchemaMigration deletes a schema change which waits for
+// approval. If version is not nil, it only deletes that version, so that
+// concurrent callers cannot both take the same pending schema change.
+func (ts *Server) DeletePendingSchemaMigration(ctx context.Context, keyspace, uuid string, version Version) error {
+	return ts.globalCell.Delete(ctx, pendingSchemaMigrationPath(keyspace, uuid), version)
+}
+
+// DeletePendingSchemaMigrations deletes the schema changes of a keyspace
+// which wait for approval.
+func (ts *Server) DeletePendingSchemaMigrations(ctx context.Context, keyspace string) error {
+	entries, err := ts.globalCell.ListDir(ctx, path.Join(KeyspacesPath, keyspace, PendingSchemaMigrationsPath), false /*full*/)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if err := ts.DeletePendingSchemaMigration(ctx, keyspace, entry.Name, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+	}
+	return nil
+}
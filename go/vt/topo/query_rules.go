@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// WatchQueryRulesData is returned / streamed by WatchQueryRules.
+// The WatchQueryRules API guarantees exactly one of Value or Err will be set.
+type WatchQueryRulesData struct {
+	Value *topodatapb.QueryRules
+	Err   error
+}
+
+func queryRulesPath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, QueryRulesFile)
+}
+
+// GetQueryRules returns the custom query rules of a keyspace, along with
+// the topo version of the node. If the keyspace has no query rules, an
+// empty object and a nil version are returned.
+func (ts *Server) GetQueryRules(ctx context.Context, keyspace string) (*topodatapb.QueryRules, Version, error) {
+	qr := &topodatapb.QueryRules{}
+	data, version, err := ts.globalCell.Get(ctx, queryRulesPath(keyspace))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return qr, nil, nil
+		}
+		return nil, nil, err
+	}
+	if err := proto.Unmarshal(data, qr); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "bad query rules data: %q", data)
+	}
+	return qr, version, nil
+}
+
+// UpdateQueryRules reads the custom query rules of a keyspace, calls the
+// update function on them, and writes them back with their version
+// incremented. If the node was changed concurrently, it retries. If the
+// update function returns a NoUpdateNeeded error, nothing is written.
+func (ts *Server) UpdateQueryRules(ctx context.Context, keyspace string, update func(*topodatapb.QueryRules) error) (*topodatapb.QueryRules, error) {
+	nodePath := queryRulesPath(keyspace)
+	for {
+		qr, version, err := ts.GetQueryRules(ctx, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		if err := update(qr); err != nil {
+			if IsErrType(err, NoUpdateNeeded) {
+				return qr, nil
+			}
+			return nil, err
+		}
+		qr.Version++
+
+		data, err := proto.Marshal(qr)
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			_, err = ts.globalCell.Create(ctx, nodePath, data)
+		} else {
+			_, err = ts.globalCell.Update(ctx, nodePath, data, version)
+		}
+		switch {
+		case err == nil:
+			return qr, nil
+		case IsErrType(err, BadVersion), IsErrType(err, NodeExists):
+			// The rules were changed concurrently, try again.
+		default:
+			return nil, err
+		}
+	}
+}
+
+// DeleteQueryRules deletes the custom query rules of a keyspace.
+func (ts *Server) DeleteQueryRules(ctx context.Context, keyspace string) error {
+	return ts.globalCell.Delete(ctx, queryRulesPath(keyspace), nil)
+}
+
+// WatchQueryRules will set a watch on the custom query rules of a keyspace.
+// If the keyspace has no query rules, the returned error is NoNode.
+// Callers should consume the returned channel until it is closed.
+func (ts *Server) WatchQueryRules(ctx context.Context, keyspace string) (*WatchQueryRulesData, <-chan *WatchQueryRulesData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, queryRulesPath(keyspace))
+	if current.Err != nil {
+		return &WatchQueryRulesData{Err: current.Err}, nil, nil
+	}
+	value := &topodatapb.QueryRules{}
+	if err := proto.Unmarshal(current.Contents, value); err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchQueryRulesData{Err: vterrors.Wrapf(err, "error unpacking initial QueryRules object")}, nil, nil
+	}
+
+	changes := make(chan *WatchQueryRulesData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchQueryRulesData{Err: wd.Err}
+				return
+			}
+
+			value := &topodatapb.QueryRules{}
+			if err := proto.Unmarshal(wd.Contents, value); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchQueryRulesData{Err: vterrors.Wrapf(err, "error unpacking QueryRules object")}
+				return
+			}
+			changes <- &WatchQueryRulesData{Value: value}
+		}
+	}()
+
+	return &WatchQueryRulesData{Value: value}, changes, cancel
+}
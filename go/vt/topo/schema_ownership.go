@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func schemaOwnershipPath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, SchemaOwnershipFile)
+}
+
+// GetSchemaOwnership returns the ownership of the tables of a keyspace, which
+// is empty if none was saved.
+func (ts *Server) GetSchemaOwnership(ctx context.Context, keyspace string) (*vtctldatapb.SchemaOwnership, error) {
+	ownership := &vtctldatapb.SchemaOwnership{}
+	data, _, err := ts.globalCell.Get(ctx, schemaOwnershipPath(keyspace))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return ownership, nil
+		}
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, ownership); err != nil {
+		return nil, vterrors.Wrapf(err, "bad schema ownership data: %q", data)
+	}
+	return ownership, nil
+}
+
+// SaveSchemaOwnership creates or replaces the ownership of the tables of a
+// keyspace. An empty ownership deletes it.
+func (ts *Server) SaveSchemaOwnership(ctx context.Context, keyspace string, ownership *vtctldatapb.SchemaOwnership) error {
+	data, err := proto.Marshal(ownership)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		if err := ts.DeleteSchemaOwnership(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	_, err = ts.globalCell.Update(ctx, schemaOwnershipPath(keyspace), data, nil)
+	return err
+}
+
+// DeleteSchemaOwnership deletes the ownership of the tables of a keyspace.
+func (ts *Server) DeleteSchemaOwnership(ctx context.Context, keyspace string) error {
+	return ts.globalCell.Delete(ctx, schemaOwnershipPath(keyspace), nil)
+}
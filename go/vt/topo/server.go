@@ -46,6 +46,7 @@ import (
 	"flag"
 	"fmt"
 	"sync"
+	"time"
 
 	"context"
 
@@ -77,6 +78,12 @@ const (
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
 	ExternalClustersFile = "ExternalClusters"
+	QueryRulesFile       = "QueryRules"
+	TableACLFile         = "TableACL"
+	MaskingPolicyFile    = "MaskingPolicy"
+	SchemaOwnershipFile  = "SchemaOwnership"
+
+	OnlineDDLSchedulerConfigFile = "OnlineDDLSchedulerConfig"
 )
 
 // Path for all object types.
@@ -87,6 +94,13 @@ const (
 	ShardsPath       = "shards"
 	TabletsPath      = "tablets"
 	MetadataPath     = "metadata"
+	VDiffPath        = "vdiff"
+
+	PendingSchemaMigrationsPath = "pending_schema_migrations"
+
+	VStreamCheckpointsPath = "vstream_checkpoints"
+
+	VtgatesPath = "vtgates"
 
 	ExternalClusterMySQL  = "mysql"
 	ExternalClusterVitess = "vitess"
@@ -141,6 +155,9 @@ type Server struct {
 	// will read the list of addresses for that cell from the
 	// global cluster and create clients as needed.
 	cells map[string]Conn
+	// readCache is true if the reads are cached, see EnableReadCache.
+	readCache            bool
+	readCacheStaleWindow time.Duration
 }
 
 type cellsToAliasesMap struct {
@@ -273,6 +290,9 @@ func (ts *Server) ConnForCell(ctx context.Context, cell string) (Conn, error) {
 	switch {
 	case err == nil:
 		conn = NewStatsConn(cell, conn)
+		if ts.readCache {
+			conn = NewCacheConn(cell, conn, ts.readCacheStaleWindow)
+		}
 		ts.cells[cell] = conn
 		return conn, nil
 	case IsErrType(err, NoNode):
@@ -311,6 +331,24 @@ func GetAliasByCell(ctx context.Context, ts *Server, cell string) string {
 	return cell
 }
 
+// EnableReadCache makes the Server cache the files it reads from the cell
+// topo services, see CacheConn. The reads of the global topo service are not
+// cached, so that its locks and strong reads keep their meaning. It is meant
+// to be called right after the Server is opened, by the processes which
+// mostly read the topology, like vtgate.
+func (ts *Server) EnableReadCache(staleWindow time.Duration) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.readCache {
+		return
+	}
+	ts.readCache = true
+	ts.readCacheStaleWindow = staleWindow
+	for cell, conn := range ts.cells {
+		ts.cells[cell] = NewCacheConn(cell, conn, staleWindow)
+	}
+}
+
 // Close will close all connections to underlying topo Server.
 // It will nil all member variables, so any further access will panic.
 func (ts *Server) Close() {
@@ -582,6 +582,51 @@ func (ts *Server) MigrateServedType(ctx context.Context, keyspace string, shards
 	return nil
 }
 
+// UpdateSrvKeyspaceThrottlerConfig copies the throttler config of a keyspace to its SrvKeyspace
+// in the given cells, or in all the cells if none are given. The cells without a SrvKeyspace are
+// skipped, since the rebuild of their SrvKeyspace copies the config from the keyspace.
+func (ts *Server) UpdateSrvKeyspaceThrottlerConfig(ctx context.Context, keyspace string, cells []string, throttlerConfig *topodatapb.ThrottlerConfig) (err error) {
+	if err = CheckKeyspaceLocked(ctx, keyspace); err != nil {
+		return err
+	}
+
+	// The caller intents to update all cells in this case
+	if len(cells) == 0 {
+		cells, err = ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	rec := concurrency.AllErrorRecorder{}
+	for _, cell := range cells {
+		wg.Add(1)
+		go func(cell string) {
+			defer wg.Done()
+			srvKeyspace, err := ts.GetSrvKeyspace(ctx, cell, keyspace)
+			switch {
+			case err == nil:
+				srvKeyspace.ThrottlerConfig = throttlerConfig
+				if err := ts.UpdateSrvKeyspace(ctx, cell, keyspace, srvKeyspace); err != nil {
+					rec.RecordError(err)
+					return
+				}
+			case IsErrType(err, NoNode):
+				// NOOP
+			default:
+				rec.RecordError(err)
+				return
+			}
+		}(cell)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return NewError(PartialResult, rec.Error().Error())
+	}
+	return nil
+}
+
 // UpdateSrvKeyspace saves a new SrvKeyspace. It is a blind write.
 func (ts *Server) UpdateSrvKeyspace(ctx context.Context, cell, keyspace string, srvKeyspace *topodatapb.SrvKeyspace) error {
 	conn, err := ts.ConnForCell(ctx, cell)
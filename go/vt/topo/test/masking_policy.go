@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// checkMaskingPolicy runs the tests on the masking policy part of the API
+func checkMaskingPolicy(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	policy, err := ts.GetMaskingPolicy(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, policy.Rules)
+
+	want := &binlogdatapb.MaskingPolicy{
+		Keyspaces: []string{"staging"},
+		Rules: []*binlogdatapb.MaskingRule{{
+			Table:     "customer",
+			Column:    "email",
+			Transform: binlogdatapb.MaskingTransform_FAKE,
+		}},
+		Salt: []byte("salt"),
+	}
+	require.NoError(t, ts.SaveMaskingPolicy(ctx, want))
+
+	policy, err = ts.GetMaskingPolicy(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, policy)
+
+	require.NoError(t, ts.SaveMaskingPolicy(ctx, &binlogdatapb.MaskingPolicy{}))
+	policy, err = ts.GetMaskingPolicy(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, policy.Keyspaces)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// checkOnlineDDLSchedulerConfig runs the tests on the online DDL scheduler config part of the API
+func checkOnlineDDLSchedulerConfig(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	config, err := ts.GetOnlineDDLSchedulerConfig(ctx, "commerce")
+	require.NoError(t, err)
+	assert.Zero(t, config.MaxConcurrentMigrations)
+
+	want := &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 4}
+	require.NoError(t, ts.SaveOnlineDDLSchedulerConfig(ctx, "commerce", want))
+
+	config, err = ts.GetOnlineDDLSchedulerConfig(ctx, "commerce")
+	require.NoError(t, err)
+	utils.MustMatch(t, want, config)
+
+	config, err = ts.GetOnlineDDLSchedulerConfig(ctx, "customer")
+	require.NoError(t, err)
+	assert.Zero(t, config.MaxConcurrentMigrations)
+
+	require.NoError(t, ts.SaveOnlineDDLSchedulerConfig(ctx, "commerce", &topodatapb.OnlineDDLSchedulerConfig{}))
+	config, err = ts.GetOnlineDDLSchedulerConfig(ctx, "commerce")
+	require.NoError(t, err)
+	assert.Zero(t, config.MaxConcurrentMigrations)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// checkPendingSchemaMigration runs the tests on the pending schema migration part of the API
+func checkPendingSchemaMigration(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	migrations, err := ts.GetPendingSchemaMigrations(ctx, "commerce")
+	require.NoError(t, err)
+	assert.Empty(t, migrations)
+
+	_, _, err = ts.GetPendingSchemaMigration(ctx, "commerce", "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "expected NoNode, got %v", err)
+
+	first := &vtctldatapb.PendingSchemaMigration{
+		Uuid:        "b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a",
+		Keyspace:    "commerce",
+		Sql:         []string{"alter table t1 add column c int"},
+		SubmittedBy: "alice",
+	}
+	second := &vtctldatapb.PendingSchemaMigration{
+		Uuid:        "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a",
+		Keyspace:    "commerce",
+		Sql:         []string{"drop table t2"},
+		SubmittedBy: "bob",
+	}
+	require.NoError(t, ts.CreatePendingSchemaMigration(ctx, first))
+	require.NoError(t, ts.CreatePendingSchemaMigration(ctx, second))
+	err = ts.CreatePendingSchemaMigration(ctx, first)
+	assert.True(t, topo.IsErrType(err, topo.NodeExists), "expected NodeExists, got %v", err)
+
+	migrations, err = ts.GetPendingSchemaMigrations(ctx, "commerce")
+	require.NoError(t, err)
+	utils.MustMatch(t, []*vtctldatapb.PendingSchemaMigration{second, first}, migrations)
+
+	migration, version, err := ts.GetPendingSchemaMigration(ctx, "commerce", first.Uuid)
+	require.NoError(t, err)
+	utils.MustMatch(t, first, migration)
+	require.NoError(t, ts.DeletePendingSchemaMigration(ctx, "commerce", first.Uuid, version))
+	err = ts.DeletePendingSchemaMigration(ctx, "commerce", first.Uuid, version)
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "expected NoNode, got %v", err)
+
+	require.NoError(t, ts.DeletePendingSchemaMigrations(ctx, "commerce"))
+	migrations, err = ts.GetPendingSchemaMigrations(ctx, "commerce")
+	require.NoError(t, err)
+	assert.Empty(t, migrations)
+}
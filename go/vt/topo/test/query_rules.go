@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// checkQueryRules runs the tests on the QueryRules part of the API
+func checkQueryRules(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	require.NoError(t, ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}))
+
+	qr, version, err := ts.GetQueryRules(ctx, "test_keyspace")
+	require.NoError(t, err)
+	assert.Nil(t, version)
+	assert.Equal(t, int64(0), qr.Version)
+
+	current, _, _ := ts.WatchQueryRules(ctx, "test_keyspace")
+	assert.True(t, topo.IsErrType(current.Err, topo.NoNode), "WatchQueryRules: %v", current.Err)
+
+	qr, err = ts.UpdateQueryRules(ctx, "test_keyspace", func(qr *topodatapb.QueryRules) error {
+		qr.KeyspaceRules = `[{"Name":"r1"}]`
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), qr.Version)
+
+	current, changes, cancel := ts.WatchQueryRules(ctx, "test_keyspace")
+	require.NoError(t, current.Err)
+	assert.Equal(t, `[{"Name":"r1"}]`, current.Value.KeyspaceRules)
+
+	qr, err = ts.UpdateQueryRules(ctx, "test_keyspace", func(qr *topodatapb.QueryRules) error {
+		qr.ShardRules = map[string]string{"0": `[{"Name":"r2"}]`}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), qr.Version)
+
+	change := <-changes
+	require.NoError(t, change.Err)
+	assert.Equal(t, int64(2), change.Value.Version)
+	assert.Equal(t, `[{"Name":"r2"}]`, change.Value.ShardRules["0"])
+
+	cancel()
+	for range changes {
+	}
+
+	qr, err = ts.UpdateQueryRules(ctx, "test_keyspace", func(qr *topodatapb.QueryRules) error {
+		return topo.NewError(topo.NoUpdateNeeded, "test_keyspace")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), qr.Version)
+
+	require.NoError(t, ts.DeleteQueryRules(ctx, "test_keyspace"))
+	qr, _, err = ts.GetQueryRules(ctx, "test_keyspace")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), qr.Version)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// checkSchemaOwnership runs the tests on the schema ownership part of the API
+func checkSchemaOwnership(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	ownership, err := ts.GetSchemaOwnership(ctx, "commerce")
+	require.NoError(t, err)
+	assert.Empty(t, ownership.Tables)
+
+	want := &vtctldatapb.SchemaOwnership{
+		Tables: map[string]*vtctldatapb.TableOwnership{
+			"customer": {Owner: "payments", ApprovalPolicy: "payments-dba"},
+		},
+	}
+	require.NoError(t, ts.SaveSchemaOwnership(ctx, "commerce", want))
+
+	ownership, err = ts.GetSchemaOwnership(ctx, "commerce")
+	require.NoError(t, err)
+	utils.MustMatch(t, want, ownership)
+
+	ownership, err = ts.GetSchemaOwnership(ctx, "customer")
+	require.NoError(t, err)
+	assert.Empty(t, ownership.Tables)
+
+	require.NoError(t, ts.SaveSchemaOwnership(ctx, "commerce", &vtctldatapb.SchemaOwnership{}))
+	ownership, err = ts.GetSchemaOwnership(ctx, "commerce")
+	require.NoError(t, err)
+	assert.Empty(t, ownership.Tables)
+}
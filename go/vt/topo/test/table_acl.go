@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// checkTableACL runs the tests on the TableACL part of the API
+func checkTableACL(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	require.NoError(t, ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}))
+
+	_, err := ts.GetTableACL(ctx, "test_keyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "GetTableACL: %v", err)
+
+	current, _, _ := ts.WatchTableACL(ctx, "test_keyspace")
+	assert.True(t, topo.IsErrType(current.Err, topo.NoNode), "WatchTableACL: %v", current.Err)
+
+	err = ts.SaveTableACL(ctx, "test_keyspace", &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group1",
+			TableNamesOrPrefixes: []string{"t1"},
+			Readers:              []string{"u1"},
+		}},
+	})
+	require.NoError(t, err)
+
+	config, err := ts.GetTableACL(ctx, "test_keyspace")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u1"}, config.TableGroups[0].Readers)
+
+	current, changes, cancel := ts.WatchTableACL(ctx, "test_keyspace")
+	require.NoError(t, current.Err)
+	assert.Equal(t, "group1", current.Value.TableGroups[0].Name)
+
+	err = ts.SaveTableACL(ctx, "test_keyspace", &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group2",
+			TableNamesOrPrefixes: []string{"t2"},
+		}},
+	})
+	require.NoError(t, err)
+
+	change := <-changes
+	require.NoError(t, change.Err)
+	assert.Equal(t, "group2", change.Value.TableGroups[0].Name)
+
+	cancel()
+	for range changes {
+	}
+
+	require.NoError(t, ts.DeleteTableACL(ctx, "test_keyspace"))
+	_, err = ts.GetTableACL(ctx, "test_keyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "GetTableACL: %v", err)
+}
@@ -91,6 +91,46 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkRoutingRules(t, ts)
 	ts.Close()
 
+	t.Log("=== checkQueryRules")
+	ts = factory()
+	checkQueryRules(t, ts)
+	ts.Close()
+
+	t.Log("=== checkTableACL")
+	ts = factory()
+	checkTableACL(t, ts)
+	ts.Close()
+
+	t.Log("=== checkMaskingPolicy")
+	ts = factory()
+	checkMaskingPolicy(t, ts)
+	ts.Close()
+
+	t.Log("=== checkSchemaOwnership")
+	ts = factory()
+	checkSchemaOwnership(t, ts)
+	ts.Close()
+
+	t.Log("=== checkOnlineDDLSchedulerConfig")
+	ts = factory()
+	checkOnlineDDLSchedulerConfig(t, ts)
+	ts.Close()
+
+	t.Log("=== checkVDiffCheckpoint")
+	ts = factory()
+	checkVDiffCheckpoint(t, ts)
+	ts.Close()
+
+	t.Log("=== checkPendingSchemaMigration")
+	ts = factory()
+	checkPendingSchemaMigration(t, ts)
+	ts.Close()
+
+	t.Log("=== checkVtgates")
+	ts = factory()
+	checkVtgates(t, ts)
+	ts.Close()
+
 	t.Log("=== checkElection")
 	ts = factory()
 	checkElection(t, ts)
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// checkVDiffCheckpoint runs the tests on the vdiff checkpoint part of the API
+func checkVDiffCheckpoint(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	checkpoint, err := ts.GetVDiffCheckpoint(ctx, "commerce", "wf1")
+	require.NoError(t, err)
+	assert.Empty(t, checkpoint.Tables)
+
+	lastPK := sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "10"))
+	want := &vtctldatapb.VDiffCheckpoint{
+		Tables: map[string]*vtctldatapb.VDiffTableCheckpoint{
+			"customer": {LastPk: lastPK, StartedAt: 100, ProcessedRows: 10, MatchingRows: 10},
+		},
+	}
+	require.NoError(t, ts.SaveVDiffCheckpoint(ctx, "commerce", "wf1", want))
+	require.NoError(t, ts.SaveVDiffCheckpoint(ctx, "commerce", "wf2", want))
+
+	checkpoint, err = ts.GetVDiffCheckpoint(ctx, "commerce", "wf1")
+	require.NoError(t, err)
+	utils.MustMatch(t, want, checkpoint)
+
+	require.NoError(t, ts.DeleteVDiffCheckpoint(ctx, "commerce", "wf1"))
+	checkpoint, err = ts.GetVDiffCheckpoint(ctx, "commerce", "wf1")
+	require.NoError(t, err)
+	assert.Empty(t, checkpoint.Tables)
+
+	require.NoError(t, ts.DeleteVDiffCheckpoints(ctx, "commerce"))
+	checkpoint, err = ts.GetVDiffCheckpoint(ctx, "commerce", "wf2")
+	require.NoError(t, err)
+	assert.Empty(t, checkpoint.Tables)
+	require.NoError(t, ts.DeleteVDiffCheckpoints(ctx, "commerce"))
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// checkVtgates runs the tests on the vtgate registration part of the API
+func checkVtgates(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	addrs, err := ts.GetVtgateAddresses(ctx, LocalCellName)
+	require.NoError(t, err)
+	assert.Empty(t, addrs)
+
+	require.NoError(t, ts.RegisterVtgate(ctx, LocalCellName, "vtgate1:15001"))
+	require.NoError(t, ts.RegisterVtgate(ctx, LocalCellName, "vtgate2:15001"))
+	require.NoError(t, ts.RegisterVtgate(ctx, LocalCellName, "vtgate1:15001"))
+	addrs, err = ts.GetVtgateAddresses(ctx, LocalCellName)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"vtgate1:15001", "vtgate2:15001"}, addrs)
+
+	require.NoError(t, ts.UnregisterVtgate(ctx, LocalCellName, "vtgate1:15001"))
+	require.NoError(t, ts.UnregisterVtgate(ctx, LocalCellName, "vtgate1:15001"))
+	addrs, err = ts.GetVtgateAddresses(ctx, LocalCellName)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vtgate2:15001"}, addrs)
+}
@@ -163,6 +163,7 @@ var AllTabletTypes = []topodatapb.TabletType{
 	topodatapb.TabletType_BACKUP,
 	topodatapb.TabletType_RESTORE,
 	topodatapb.TabletType_DRAINED,
+	topodatapb.TabletType_BACKUP_ONLY,
 }
 
 // ParseTabletType parses the tablet type into the enum.
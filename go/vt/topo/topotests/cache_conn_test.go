@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+func TestCacheConn(t *testing.T) {
+	ctx := context.Background()
+	cell := "cell1"
+	ts, factory := memorytopo.NewServerAndFactory(cell)
+	ts.EnableReadCache(200 * time.Millisecond)
+	defer ts.Close()
+	conn, err := ts.ConnForCell(ctx, cell)
+	require.NoError(t, err)
+	_, ok := conn.(*topo.CacheConn)
+	require.True(t, ok, "conn is not cached: %T", conn)
+
+	// The writes of another server reach the cache through its watch.
+	writer, err := topo.NewWithFactory(factory, "", "")
+	require.NoError(t, err)
+	defer writer.Close()
+	writerConn, err := writer.ConnForCell(ctx, cell)
+	require.NoError(t, err)
+	version, err := writerConn.Create(ctx, "file", []byte("v1"))
+	require.NoError(t, err)
+
+	contents, _, err := conn.Get(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(contents))
+
+	_, err = writerConn.Update(ctx, "file", []byte("v2"), version)
+	require.NoError(t, err)
+	waitForContents(t, conn, "file", "v2")
+
+	// The writes through the cache are served right away.
+	version, err = conn.Update(ctx, "file", []byte("v3"), nil)
+	require.NoError(t, err)
+	contents, got, err := conn.Get(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, "v3", string(contents))
+	assert.Equal(t, version, got)
+
+	// The cached value is served stale while the topo is down, until the
+	// stale window ends.
+	factory.SetError(errors.New("topo down"))
+	_, _, err = writerConn.Get(ctx, "file")
+	require.EqualError(t, err, "topo down")
+	contents, _, err = conn.Get(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, "v3", string(contents))
+	assert.Eventually(t, func() bool {
+		_, _, err := conn.Get(ctx, "file")
+		return err != nil && err.Error() == "topo down"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The file is cached again once the topo is back, and its deletion
+	// reaches the cache.
+	factory.SetError(nil)
+	contents, _, err = conn.Get(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, "v3", string(contents))
+	waitForContents(t, conn, "file", "v3")
+	require.NoError(t, writerConn.Delete(ctx, "file", nil))
+	assert.Eventually(t, func() bool {
+		_, _, err := conn.Get(ctx, "file")
+		return topo.IsErrType(err, topo.NoNode)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// waitForContents waits for a Get of the file to return the given contents.
+func waitForContents(t *testing.T, conn topo.Conn, filePath, want string) {
+	t.Helper()
+	assert.Eventually(t, func() bool {
+		contents, _, err := conn.Get(context.Background(), filePath)
+		return err == nil && string(contents) == want
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func vdiffCheckpointPath(keyspace, workflow string) string {
+	return path.Join(KeyspacesPath, keyspace, VDiffPath, workflow)
+}
+
+// GetVDiffCheckpoint returns the checkpoint of the VDiff of a workflow, which
+// is empty if none was saved.
+func (ts *Server) GetVDiffCheckpoint(ctx context.Context, keyspace, workflow string) (*vtctldatapb.VDiffCheckpoint, error) {
+	checkpoint := &vtctldatapb.VDiffCheckpoint{}
+	data, _, err := ts.globalCell.Get(ctx, vdiffCheckpointPath(keyspace, workflow))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return checkpoint, nil
+		}
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, checkpoint); err != nil {
+		return nil, vterrors.Wrapf(err, "bad vdiff checkpoint data: %q", data)
+	}
+	return checkpoint, nil
+}
+
+// SaveVDiffCheckpoint creates or replaces the checkpoint of the VDiff of a
+// workflow. The last save wins, whatever the version of the checkpoint it
+// replaces.
+func (ts *Server) SaveVDiffCheckpoint(ctx context.Context, keyspace, workflow string, checkpoint *vtctldatapb.VDiffCheckpoint) error {
+	data, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, vdiffCheckpointPath(keyspace, workflow), data, nil)
+	return err
+}
+
+// DeleteVDiffCheckpoint deletes the checkpoint of the VDiff of a workflow.
+func (ts *Server) DeleteVDiffCheckpoint(ctx context.Context, keyspace, workflow string) error {
+	return ts.globalCell.Delete(ctx, vdiffCheckpointPath(keyspace, workflow), nil)
+}
+
+// DeleteVDiffCheckpoints deletes the checkpoints of the VDiffs of all the
+// workflows of a keyspace.
+func (ts *Server) DeleteVDiffCheckpoints(ctx context.Context, keyspace string) error {
+	entries, err := ts.globalCell.ListDir(ctx, path.Join(KeyspacesPath, keyspace, VDiffPath), false /*full*/)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if err := ts.DeleteVDiffCheckpoint(ctx, keyspace, entry.Name); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+	}
+	return nil
+}
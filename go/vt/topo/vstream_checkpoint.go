@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func vstreamCheckpointPath(consumerGroup string) string {
+	return path.Join(VStreamCheckpointsPath, consumerGroup)
+}
+
+// GetVStreamCheckpoint returns the checkpoint of a VStream consumer group and
+// its version, which are nil if none was saved.
+func (ts *Server) GetVStreamCheckpoint(ctx context.Context, consumerGroup string) (*vtgatepb.VStreamCheckpoint, Version, error) {
+	data, version, err := ts.globalCell.Get(ctx, vstreamCheckpointPath(consumerGroup))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	checkpoint := &vtgatepb.VStreamCheckpoint{}
+	if err := proto.Unmarshal(data, checkpoint); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "bad vstream checkpoint data: %q", data)
+	}
+	return checkpoint, version, nil
+}
+
+// SaveVStreamCheckpoint saves the checkpoint of a VStream consumer group if
+// its version in the topo is still version, or if there is none and version
+// is nil. It returns the new version of the checkpoint.
+func (ts *Server) SaveVStreamCheckpoint(ctx context.Context, consumerGroup string, checkpoint *vtgatepb.VStreamCheckpoint, version Version) (Version, error) {
+	data, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return ts.globalCell.Create(ctx, vstreamCheckpointPath(consumerGroup), data)
+	}
+	return ts.globalCell.Update(ctx, vstreamCheckpointPath(consumerGroup), data, version)
+}
+
+// DeleteVStreamCheckpoint deletes the checkpoint of a VStream consumer group,
+// so that its next stream starts from the vgtid of the request.
+func (ts *Server) DeleteVStreamCheckpoint(ctx context.Context, consumerGroup string) error {
+	return ts.globalCell.Delete(ctx, vstreamCheckpointPath(consumerGroup), nil)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+)
+
+func vtgatePath(addr string) string {
+	return path.Join(VtgatesPath, addr)
+}
+
+// RegisterVtgate records the web address of a vtgate in the topo of its cell.
+// The vtctld only collects the diagnostics of the registered vtgates.
+// Registering an address again is not an error.
+func (ts *Server) RegisterVtgate(ctx context.Context, cell, addr string) error {
+	conn, err := ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Create(ctx, vtgatePath(addr), []byte{}); err != nil && !IsErrType(err, NodeExists) {
+		return err
+	}
+	return nil
+}
+
+// UnregisterVtgate removes the web address of a vtgate from the topo of its
+// cell.
+func (ts *Server) UnregisterVtgate(ctx context.Context, cell, addr string) error {
+	conn, err := ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return err
+	}
+	if err := conn.Delete(ctx, vtgatePath(addr), nil); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+	return nil
+}
+
+// GetVtgateAddresses returns the web addresses of the vtgates registered in a
+// cell.
+func (ts *Server) GetVtgateAddresses(ctx context.Context, cell string) ([]string, error) {
+	conn, err := ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+	children, err := conn.ListDir(ctx, VtgatesPath, false /*full*/)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	addrs := make([]string, len(children))
+	for i, child := range children {
+		addrs[i] = child.Name
+	}
+	return addrs, nil
+}
@@ -42,11 +42,19 @@ func GetRoutingRules(ctx context.Context, ts *topo.Server) (map[string][]string,
 }
 
 // SaveRoutingRules converts a mapping of fromTable=>[]toTables into a
-// vschemapb.RoutingRules protobuf message and saves it in the topology.
+// vschemapb.RoutingRules protobuf message and saves it in the topology. The
+// versioned tables of the routing rules are kept.
 func SaveRoutingRules(ctx context.Context, ts *topo.Server, rules map[string][]string) error {
 	log.Infof("Saving routing rules %v\n", rules)
 
-	rrs := &vschemapb.RoutingRules{Rules: make([]*vschemapb.RoutingRule, 0, len(rules))}
+	current, err := ts.GetRoutingRules(ctx)
+	if err != nil {
+		return err
+	}
+	rrs := &vschemapb.RoutingRules{
+		Rules:           make([]*vschemapb.RoutingRule, 0, len(rules)),
+		VersionedTables: current.VersionedTables,
+	}
 	for from, to := range rules {
 		rrs.Rules = append(rrs.Rules, &vschemapb.RoutingRule{
 			FromTable: from,
@@ -24,7 +24,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
 
 func TestRoutingRulesRoundTrip(t *testing.T) {
@@ -45,6 +48,28 @@ func TestRoutingRulesRoundTrip(t *testing.T) {
 	assert.Equal(t, rules, roundtripRules)
 }
 
+func TestSaveRoutingRulesKeepsVersionedTables(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+
+	versioned := []*vschemapb.VersionedTable{{
+		FromTable:    "orders",
+		CurrentTable: "ks.orders",
+		NextTable:    "ks.orders_v2",
+		NextPercent:  10,
+	}}
+	err := ts.SaveRoutingRules(ctx, &vschemapb.RoutingRules{VersionedTables: versioned})
+	require.NoError(t, err)
+
+	err = SaveRoutingRules(ctx, ts, map[string][]string{"t1": {"ks.t2"}})
+	require.NoError(t, err)
+
+	rr, err := ts.GetRoutingRules(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, versioned, rr.VersionedTables)
+	assert.Len(t, rr.Rules, 1)
+}
+
 func TestRoutingRulesErrors(t *testing.T) {
 	ctx := context.Background()
 	ts, factory := memorytopo.NewServerAndFactory("zone1")
@@ -101,6 +101,11 @@ func (f *fakeVTGateService) ExecuteBatch(ctx context.Context, session *vtgatepb.
 	return session, nil, nil
 }
 
+// ExecuteScript is part of the VTGateService interface
+func (f *fakeVTGateService) ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	return session, nil, nil, errors.New("ExecuteScript: not implemented")
+}
+
 // StreamExecute is part of the VTGateService interface
 func (f *fakeVTGateService) StreamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	execCase, ok := execMap[sql]
@@ -167,6 +167,7 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 	router.HandleFunc("/shards/{cluster_id}", httpAPI.Adapt(vtadminhttp.DeleteShards)).Name("API.DeleteShards").Methods("DELETE")
 	router.HandleFunc("/srvvschema/{cluster_id}/{cell}", httpAPI.Adapt(vtadminhttp.GetSrvVSchema)).Name("API.GetSrvVSchema")
 	router.HandleFunc("/srvvschemas", httpAPI.Adapt(vtadminhttp.GetSrvVSchemas)).Name("API.GetSrvVSchemas")
+	router.HandleFunc("/storage_usage/{cluster_id}/{keyspace}", httpAPI.Adapt(vtadminhttp.GetStorageUsage)).Name("API.GetStorageUsage")
 	router.HandleFunc("/tablets", httpAPI.Adapt(vtadminhttp.GetTablets)).Name("API.GetTablets")
 	router.HandleFunc("/tablet/{tablet}", httpAPI.Adapt(vtadminhttp.GetTablet)).Name("API.GetTablet")
 	router.HandleFunc("/vschema/{cluster_id}/{keyspace}", httpAPI.Adapt(vtadminhttp.GetVSchema)).Name("API.GetVSchema")
@@ -174,6 +175,7 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 	router.HandleFunc("/vtctlds", httpAPI.Adapt(vtadminhttp.GetVtctlds)).Name("API.GetVtctlds")
 	router.HandleFunc("/vtexplain", httpAPI.Adapt(vtadminhttp.VTExplain)).Name("API.VTExplain")
 	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}", httpAPI.Adapt(vtadminhttp.GetWorkflow)).Name("API.GetWorkflow")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}/progress", httpAPI.Adapt(vtadminhttp.GetWorkflowProgress)).Name("API.GetWorkflowProgress")
 	router.HandleFunc("/workflows", httpAPI.Adapt(vtadminhttp.GetWorkflows)).Name("API.GetWorkflows")
 
 	experimentalRouter := router.PathPrefix("/experimental").Subrouter()
@@ -886,6 +888,26 @@ func (api *API) GetSrvVSchemas(ctx context.Context, req *vtadminpb.GetSrvVSchema
 	}, nil
 }
 
+// GetStorageUsage is part of the vtadminpb.VTAdminServer interface.
+func (api *API) GetStorageUsage(ctx context.Context, req *vtadminpb.GetStorageUsageRequest) (*vtctldatapb.GetStorageUsageResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.GetStorageUsage")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.KeyspaceResource, rbac.GetAction) {
+		return nil, fmt.Errorf("%w: cannot get storage usage of keyspace in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetStorageUsage(ctx, req.Keyspace)
+}
+
 // GetTablet is part of the vtadminpb.VTAdminServer interface.
 func (api *API) GetTablet(ctx context.Context, req *vtadminpb.GetTabletRequest) (*vtadminpb.Tablet, error) {
 	span, ctx := trace.NewSpan(ctx, "API.GetTablet")
@@ -1198,6 +1220,27 @@ func (api *API) GetWorkflow(ctx context.Context, req *vtadminpb.GetWorkflowReque
 	})
 }
 
+// GetWorkflowProgress is part of the vtadminpb.VTAdminServer interface.
+func (api *API) GetWorkflowProgress(ctx context.Context, req *vtadminpb.GetWorkflowProgressRequest) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.GetWorkflowProgress")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow_name", req.Name)
+
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.WorkflowResource, rbac.GetAction) {
+		return nil, fmt.Errorf("%w: cannot get progress of workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	c, err := api.getClusterForRequest(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetWorkflowProgress(ctx, req.Keyspace, req.Name)
+}
+
 // GetWorkflows is part of the vtadminpb.VTAdminServer interface.
 func (api *API) GetWorkflows(ctx context.Context, req *vtadminpb.GetWorkflowsRequest) (*vtadminpb.GetWorkflowsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "API.GetWorkflows")
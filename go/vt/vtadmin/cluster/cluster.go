@@ -1092,6 +1092,10 @@ func (c *Cluster) getSchemaFromTablets(ctx context.Context, keyspace string, tab
 				schema.TableDefinitions = resp.Schema.TableDefinitions
 			}
 
+			if len(resp.TableOwnership) > 0 {
+				schema.TableOwnership = resp.TableOwnership
+			}
+
 			if !opts.TableSizeOptions.AggregateSizes {
 				return
 			}
@@ -1331,6 +1335,35 @@ func (c *Cluster) GetSrvVSchemas(ctx context.Context, cells []string) ([]*vtadmi
 	return svs, nil
 }
 
+// GetStorageUsage returns the storage usage of the shards of a keyspace in
+// the cluster, proxying a GetStorageUsageRequest to a vtctld in that cluster.
+func (c *Cluster) GetStorageUsage(ctx context.Context, keyspace string) (*vtctldatapb.GetStorageUsageResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.GetStorageUsage")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+	span.Annotate("keyspace", keyspace)
+
+	if keyspace == "" {
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, fmt.Errorf("Vtctld.Dial(cluster=%s) failed: %w", c.ID, err)
+	}
+
+	// The sizes are read from the information_schema of the tablets, like the
+	// table sizes of the schemas.
+	if err := c.schemaReadPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("GetStorageUsage(%s) failed to acquire schemaReadPool: %w", keyspace, err)
+	}
+	defer c.schemaReadPool.Release()
+
+	return c.Vtctld.GetStorageUsage(ctx, &vtctldatapb.GetStorageUsageRequest{
+		Keyspace: keyspace,
+	})
+}
+
 // GetVSchema returns the vschema for a given keyspace in this cluster. The
 // caller is responsible for making at least one call to c.Vtctld.Dial prior to
 // calling this function.
@@ -1425,6 +1458,37 @@ func (c *Cluster) GetWorkflow(ctx context.Context, keyspace string, name string,
 	}
 }
 
+// GetWorkflowProgress returns the progress of a workflow in this cluster,
+// proxying a GetWorkflowProgressRequest to a vtctld in that cluster.
+func (c *Cluster) GetWorkflowProgress(ctx context.Context, keyspace string, name string) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.GetWorkflowProgress")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+	span.Annotate("keyspace", keyspace)
+	span.Annotate("workflow_name", name)
+
+	if keyspace == "" || name == "" {
+		return nil, fmt.Errorf("%w: keyspace and workflow name are required", errors.ErrInvalidRequest)
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, fmt.Errorf("GetWorkflowProgress(cluster = %v, keyspace = %v, workflow = %v) dial failed: %w", c.ID, keyspace, name, err)
+	}
+
+	// The progress is estimated from the information_schema of the tablets,
+	// like the storage usage.
+	if err := c.schemaReadPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("GetWorkflowProgress(%s, %s) failed to acquire schemaReadPool: %w", keyspace, name, err)
+	}
+	defer c.schemaReadPool.Release()
+
+	return c.Vtctld.GetWorkflowProgress(ctx, &vtctldatapb.GetWorkflowProgressRequest{
+		Keyspace: keyspace,
+		Workflow: name,
+	})
+}
+
 // GetWorkflowsOptions is the set of filtering options for GetWorkflows
 // requests.
 type GetWorkflowsOptions struct {
@@ -1253,6 +1253,9 @@ func TestGetSchema(t *testing.T) {
 									},
 								},
 							},
+							TableOwnership: map[string]*vtctldatapb.TableOwnership{
+								"some_table": {Owner: "payments"},
+							},
 						},
 					},
 				},
@@ -1280,6 +1283,9 @@ func TestGetSchema(t *testing.T) {
 					},
 				},
 				TableSizes: map[string]*vtadminpb.Schema_TableSize{},
+				TableOwnership: map[string]*vtctldatapb.TableOwnership{
+					"some_table": {Owner: "payments"},
+				},
 			},
 			shouldErr: false,
 		},
@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+
+	"github.com/gorilla/mux"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// GetStorageUsage implements the http wrapper for
+// /storage_usage/{cluster_id}/{keyspace}.
+func GetStorageUsage(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := mux.Vars(r.Request)
+	usage, err := api.server.GetStorageUsage(ctx, &vtadminpb.GetStorageUsageRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+	})
+
+	return NewJSONResponse(usage, err)
+}
@@ -44,6 +44,22 @@ func GetWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
 	return NewJSONResponse(workflow, err)
 }
 
+// GetWorkflowProgress implements the http wrapper for the
+// VTAdminServer.GetWorkflowProgress method.
+//
+// Its route is /workflow/{cluster_id}/{keyspace}/{name}/progress.
+func GetWorkflowProgress(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	progress, err := api.server.GetWorkflowProgress(ctx, &vtadminpb.GetWorkflowProgressRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+		Name:      vars["name"],
+	})
+
+	return NewJSONResponse(progress, err)
+}
+
 // GetWorkflows implements the http wrapper for the VTAdminServer.GetWorkflows
 // method.
 //
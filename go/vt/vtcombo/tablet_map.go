@@ -491,6 +491,27 @@ func (itc *internalTabletConn) StreamExecute(
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// StreamExecuteBatch is part of queryservice.QueryService
+func (itc *internalTabletConn) StreamExecuteBatch(
+	ctx context.Context,
+	target *querypb.Target,
+	queries []*querypb.BoundQuery,
+	transactionID int64,
+	reservedID int64,
+	options *querypb.ExecuteOptions,
+	callback func(queryIndex int, result *sqltypes.Result) error,
+) error {
+	q := make([]*querypb.BoundQuery, len(queries))
+	for i, query := range queries {
+		q[i] = &querypb.BoundQuery{
+			Sql:           query.Sql,
+			BindVariables: sqltypes.CopyBindVariables(query.BindVariables),
+		}
+	}
+	err := itc.tablet.qsc.QueryService().StreamExecuteBatch(ctx, target, q, transactionID, reservedID, options, callback)
+	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
 // Begin is part of queryservice.QueryService
 func (itc *internalTabletConn) Begin(
 	ctx context.Context,
@@ -917,6 +938,42 @@ func (itmc *internalTabletManagerClient) VExec(context.Context, *topodatapb.Tabl
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetUnresolvedTransactions(context.Context, *topodatapb.Tablet, time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error) {
+	return nil, nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ResolveTransaction(context.Context, *topodatapb.Tablet, string, bool, bool) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) SetRollbackTransaction(context.Context, *topodatapb.Tablet, string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetStorageUsage(context.Context, *topodatapb.Tablet) (*tabletmanagerdatapb.GetStorageUsageResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetDeadLetters(context.Context, *topodatapb.Tablet, string, int) (*querypb.QueryResult, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) RequeueDeadLetters(context.Context, *topodatapb.Tablet, string, []string) (int64, error) {
+	return 0, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) PurgeDeadLetters(context.Context, *topodatapb.Tablet, string, []string) (int64, error) {
+	return 0, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetSequences(context.Context, *topodatapb.Tablet, []string) ([]*tabletmanagerdatapb.SequenceState, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ResetSequence(context.Context, *topodatapb.Tablet, string, int64, int64) (*tabletmanagerdatapb.SequenceState, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) VReplicationExec(context.Context, *topodatapb.Tablet, string) (*querypb.QueryResult, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
@@ -46,7 +46,7 @@ func init() {
 		name:   "BackupShard",
 		method: commandBackupShard,
 		params: "[-allow_primary=false] <keyspace/shard>",
-		help:   "Chooses a tablet and creates a backup for a shard.",
+		help:   "Chooses a tablet and creates a backup for a shard. The most up to date BACKUP_ONLY tablet is chosen if there is one, or else the most up to date REPLICA, RDONLY or SPARE tablet.",
 	})
 	addCommand("Shards", command{
 		name:   "RemoveBackup",
@@ -116,24 +116,31 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	var tabletForBackup *topodatapb.Tablet
 	var secondsBehind uint32
 
-	for i := range tablets {
-		// find a replica, rdonly or spare tablet type to run the backup on
-		switch tablets[i].Type {
-		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_SPARE:
-		default:
-			continue
-		}
-		// choose the first tablet as the baseline
-		if tabletForBackup == nil {
-			tabletForBackup = tablets[i].Tablet
-			secondsBehind = stats[i].ReplicationLagSeconds
-			continue
-		}
+	// find a backup-only tablet to run the backup on, or else a replica,
+	// rdonly or spare tablet
+	for _, tabletTypes := range [][]topodatapb.TabletType{
+		{topodatapb.TabletType_BACKUP_ONLY},
+		{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_SPARE},
+	} {
+		for i := range tablets {
+			if !topoproto.IsTypeInList(tablets[i].Type, tabletTypes) {
+				continue
+			}
+			// choose the first tablet as the baseline
+			if tabletForBackup == nil {
+				tabletForBackup = tablets[i].Tablet
+				secondsBehind = stats[i].ReplicationLagSeconds
+				continue
+			}
 
-		// choose a new tablet if it is more up to date
-		if stats[i].ReplicationLagSeconds < secondsBehind {
-			tabletForBackup = tablets[i].Tablet
-			secondsBehind = stats[i].ReplicationLagSeconds
+			// choose a new tablet if it is more up to date
+			if stats[i].ReplicationLagSeconds < secondsBehind {
+				tabletForBackup = tablets[i].Tablet
+				secondsBehind = stats[i].ReplicationLagSeconds
+			}
+		}
+		if tabletForBackup != nil {
+			break
 		}
 	}
 
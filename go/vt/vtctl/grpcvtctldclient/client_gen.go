@@ -46,6 +46,24 @@ func (client *gRPCVtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata
 	return client.c.AddCellsAlias(ctx, in, opts...)
 }
 
+// ApplyMaskingPolicy is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ApplyMaskingPolicy(ctx context.Context, in *vtctldatapb.ApplyMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyMaskingPolicyResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ApplyMaskingPolicy(ctx, in, opts...)
+}
+
+// ApplyQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ApplyQueryRule(ctx context.Context, in *vtctldatapb.ApplyQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyQueryRuleResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ApplyQueryRule(ctx, in, opts...)
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldatapb.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	if client.c == nil {
@@ -55,6 +73,24 @@ func (client *gRPCVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctl
 	return client.c.ApplyRoutingRules(ctx, in, opts...)
 }
 
+// ApplySchemaOwnership is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ApplySchemaOwnership(ctx context.Context, in *vtctldatapb.ApplySchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplySchemaOwnershipResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ApplySchemaOwnership(ctx, in, opts...)
+}
+
+// ApplyTableACL is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ApplyTableACL(ctx context.Context, in *vtctldatapb.ApplyTableACLRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyTableACLResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ApplyTableACL(ctx, in, opts...)
+}
+
 // ApplyVSchema is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ApplyVSchema(ctx context.Context, in *vtctldatapb.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyVSchemaResponse, error) {
 	if client.c == nil {
@@ -64,6 +100,15 @@ func (client *gRPCVtctldClient) ApplyVSchema(ctx context.Context, in *vtctldatap
 	return client.c.ApplyVSchema(ctx, in, opts...)
 }
 
+// ApplyVersionedTable is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ApplyVersionedTable(ctx context.Context, in *vtctldatapb.ApplyVersionedTableRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyVersionedTableResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ApplyVersionedTable(ctx, in, opts...)
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ChangeTabletType(ctx context.Context, in *vtctldatapb.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	if client.c == nil {
@@ -73,6 +118,15 @@ func (client *gRPCVtctldClient) ChangeTabletType(ctx context.Context, in *vtctld
 	return client.c.ChangeTabletType(ctx, in, opts...)
 }
 
+// CollectDiagnostics is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) CollectDiagnostics(ctx context.Context, in *vtctldatapb.CollectDiagnosticsRequest, opts ...grpc.CallOption) (*vtctldatapb.CollectDiagnosticsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.CollectDiagnostics(ctx, in, opts...)
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) CreateKeyspace(ctx context.Context, in *vtctldatapb.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	if client.c == nil {
@@ -118,6 +172,15 @@ func (client *gRPCVtctldClient) DeleteKeyspace(ctx context.Context, in *vtctldat
 	return client.c.DeleteKeyspace(ctx, in, opts...)
 }
 
+// DeleteQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DeleteQueryRule(ctx context.Context, in *vtctldatapb.DeleteQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteQueryRuleResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DeleteQueryRule(ctx, in, opts...)
+}
+
 // DeleteShards is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) DeleteShards(ctx context.Context, in *vtctldatapb.DeleteShardsRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteShardsResponse, error) {
 	if client.c == nil {
@@ -136,6 +199,15 @@ func (client *gRPCVtctldClient) DeleteSrvVSchema(ctx context.Context, in *vtctld
 	return client.c.DeleteSrvVSchema(ctx, in, opts...)
 }
 
+// DeleteTableACL is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DeleteTableACL(ctx context.Context, in *vtctldatapb.DeleteTableACLRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteTableACLResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DeleteTableACL(ctx, in, opts...)
+}
+
 // DeleteTablets is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) DeleteTablets(ctx context.Context, in *vtctldatapb.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteTabletsResponse, error) {
 	if client.c == nil {
@@ -145,6 +217,15 @@ func (client *gRPCVtctldClient) DeleteTablets(ctx context.Context, in *vtctldata
 	return client.c.DeleteTablets(ctx, in, opts...)
 }
 
+// DeleteVersionedTable is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DeleteVersionedTable(ctx context.Context, in *vtctldatapb.DeleteVersionedTableRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteVersionedTableResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DeleteVersionedTable(ctx, in, opts...)
+}
+
 // EmergencyReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldatapb.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	if client.c == nil {
@@ -154,6 +235,15 @@ func (client *gRPCVtctldClient) EmergencyReparentShard(ctx context.Context, in *
 	return client.c.EmergencyReparentShard(ctx, in, opts...)
 }
 
+// EvacuateCell is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) EvacuateCell(ctx context.Context, in *vtctldatapb.EvacuateCellRequest, opts ...grpc.CallOption) (*vtctldatapb.EvacuateCellResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.EvacuateCell(ctx, in, opts...)
+}
+
 // ExecuteHook is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ExecuteHook(ctx context.Context, in *vtctldatapb.ExecuteHookRequest, opts ...grpc.CallOption) (*vtctldatapb.ExecuteHookResponse, error) {
 	if client.c == nil {
@@ -208,6 +298,15 @@ func (client *gRPCVtctldClient) GetCellsAliases(ctx context.Context, in *vtctlda
 	return client.c.GetCellsAliases(ctx, in, opts...)
 }
 
+// GetDeadLetters is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetDeadLetters(ctx context.Context, in *vtctldatapb.GetDeadLettersRequest, opts ...grpc.CallOption) (*vtctldatapb.GetDeadLettersResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetDeadLetters(ctx, in, opts...)
+}
+
 // GetKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetKeyspace(ctx context.Context, in *vtctldatapb.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.GetKeyspaceResponse, error) {
 	if client.c == nil {
@@ -226,6 +325,33 @@ func (client *gRPCVtctldClient) GetKeyspaces(ctx context.Context, in *vtctldatap
 	return client.c.GetKeyspaces(ctx, in, opts...)
 }
 
+// GetMaskingPolicy is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetMaskingPolicy(ctx context.Context, in *vtctldatapb.GetMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldatapb.GetMaskingPolicyResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetMaskingPolicy(ctx, in, opts...)
+}
+
+// GetOnlineDDLSchedulerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldatapb.GetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.GetOnlineDDLSchedulerConfigResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetOnlineDDLSchedulerConfig(ctx, in, opts...)
+}
+
+// GetQueryRules is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetQueryRules(ctx context.Context, in *vtctldatapb.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetQueryRulesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetQueryRules(ctx, in, opts...)
+}
+
 // GetRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetRoutingRules(ctx context.Context, in *vtctldatapb.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetRoutingRulesResponse, error) {
 	if client.c == nil {
@@ -244,6 +370,33 @@ func (client *gRPCVtctldClient) GetSchema(ctx context.Context, in *vtctldatapb.G
 	return client.c.GetSchema(ctx, in, opts...)
 }
 
+// GetSchemaMigrationProgress is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetSchemaMigrationProgress(ctx context.Context, in *vtctldatapb.GetSchemaMigrationProgressRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSchemaMigrationProgressResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetSchemaMigrationProgress(ctx, in, opts...)
+}
+
+// GetSchemaOwnership is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetSchemaOwnership(ctx context.Context, in *vtctldatapb.GetSchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSchemaOwnershipResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetSchemaOwnership(ctx, in, opts...)
+}
+
+// GetSequences is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetSequences(ctx context.Context, in *vtctldatapb.GetSequencesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSequencesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetSequences(ctx, in, opts...)
+}
+
 // GetShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetShard(ctx context.Context, in *vtctldatapb.GetShardRequest, opts ...grpc.CallOption) (*vtctldatapb.GetShardResponse, error) {
 	if client.c == nil {
@@ -289,6 +442,24 @@ func (client *gRPCVtctldClient) GetSrvVSchemas(ctx context.Context, in *vtctldat
 	return client.c.GetSrvVSchemas(ctx, in, opts...)
 }
 
+// GetStorageUsage is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetStorageUsage(ctx context.Context, in *vtctldatapb.GetStorageUsageRequest, opts ...grpc.CallOption) (*vtctldatapb.GetStorageUsageResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetStorageUsage(ctx, in, opts...)
+}
+
+// GetTableACL is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetTableACL(ctx context.Context, in *vtctldatapb.GetTableACLRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTableACLResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetTableACL(ctx, in, opts...)
+}
+
 // GetTablet is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetTablet(ctx context.Context, in *vtctldatapb.GetTabletRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTabletResponse, error) {
 	if client.c == nil {
@@ -298,6 +469,15 @@ func (client *gRPCVtctldClient) GetTablet(ctx context.Context, in *vtctldatapb.G
 	return client.c.GetTablet(ctx, in, opts...)
 }
 
+// GetTabletStatsSnapshot is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetTabletStatsSnapshot(ctx context.Context, in *vtctldatapb.GetTabletStatsSnapshotRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTabletStatsSnapshotResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetTabletStatsSnapshot(ctx, in, opts...)
+}
+
 // GetTablets is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetTablets(ctx context.Context, in *vtctldatapb.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTabletsResponse, error) {
 	if client.c == nil {
@@ -307,6 +487,15 @@ func (client *gRPCVtctldClient) GetTablets(ctx context.Context, in *vtctldatapb.
 	return client.c.GetTablets(ctx, in, opts...)
 }
 
+// GetUnresolvedTransactions is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetUnresolvedTransactions(ctx context.Context, in *vtctldatapb.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetUnresolvedTransactionsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetUnresolvedTransactions(ctx, in, opts...)
+}
+
 // GetVSchema is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetVSchema(ctx context.Context, in *vtctldatapb.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.GetVSchemaResponse, error) {
 	if client.c == nil {
@@ -316,6 +505,15 @@ func (client *gRPCVtctldClient) GetVSchema(ctx context.Context, in *vtctldatapb.
 	return client.c.GetVSchema(ctx, in, opts...)
 }
 
+// GetWorkflowProgress is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetWorkflowProgress(ctx context.Context, in *vtctldatapb.GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetWorkflowProgress(ctx, in, opts...)
+}
+
 // GetWorkflows is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetWorkflows(ctx context.Context, in *vtctldatapb.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowsResponse, error) {
 	if client.c == nil {
@@ -352,6 +550,15 @@ func (client *gRPCVtctldClient) PlannedReparentShard(ctx context.Context, in *vt
 	return client.c.PlannedReparentShard(ctx, in, opts...)
 }
 
+// PurgeDeadLetters is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) PurgeDeadLetters(ctx context.Context, in *vtctldatapb.PurgeDeadLettersRequest, opts ...grpc.CallOption) (*vtctldatapb.PurgeDeadLettersResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.PurgeDeadLetters(ctx, in, opts...)
+}
+
 // RebuildKeyspaceGraph is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RebuildKeyspaceGraph(ctx context.Context, in *vtctldatapb.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldatapb.RebuildKeyspaceGraphResponse, error) {
 	if client.c == nil {
@@ -442,6 +649,33 @@ func (client *gRPCVtctldClient) ReparentTablet(ctx context.Context, in *vtctldat
 	return client.c.ReparentTablet(ctx, in, opts...)
 }
 
+// RequeueDeadLetters is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RequeueDeadLetters(ctx context.Context, in *vtctldatapb.RequeueDeadLettersRequest, opts ...grpc.CallOption) (*vtctldatapb.RequeueDeadLettersResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RequeueDeadLetters(ctx, in, opts...)
+}
+
+// ResetSequence is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ResetSequence(ctx context.Context, in *vtctldatapb.ResetSequenceRequest, opts ...grpc.CallOption) (*vtctldatapb.ResetSequenceResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ResetSequence(ctx, in, opts...)
+}
+
+// ResolveTransaction is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ResolveTransaction(ctx context.Context, in *vtctldatapb.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtctldatapb.ResolveTransactionResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ResolveTransaction(ctx, in, opts...)
+}
+
 // RunHealthCheck is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RunHealthCheck(ctx context.Context, in *vtctldatapb.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldatapb.RunHealthCheckResponse, error) {
 	if client.c == nil {
@@ -469,6 +703,15 @@ func (client *gRPCVtctldClient) SetKeyspaceShardingInfo(ctx context.Context, in
 	return client.c.SetKeyspaceShardingInfo(ctx, in, opts...)
 }
 
+// SetOnlineDDLSchedulerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) SetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldatapb.SetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.SetOnlineDDLSchedulerConfigResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.SetOnlineDDLSchedulerConfig(ctx, in, opts...)
+}
+
 // SetShardIsPrimaryServing is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) SetShardIsPrimaryServing(ctx context.Context, in *vtctldatapb.SetShardIsPrimaryServingRequest, opts ...grpc.CallOption) (*vtctldatapb.SetShardIsPrimaryServingResponse, error) {
 	if client.c == nil {
@@ -559,6 +802,15 @@ func (client *gRPCVtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctld
 	return client.c.UpdateCellsAlias(ctx, in, opts...)
 }
 
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) UpdateThrottlerConfig(ctx context.Context, in *vtctldatapb.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.UpdateThrottlerConfigResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.UpdateThrottlerConfig(ctx, in, opts...)
+}
+
 // Validate is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) Validate(ctx context.Context, in *vtctldatapb.ValidateRequest, opts ...grpc.CallOption) (*vtctldatapb.ValidateResponse, error) {
 	if client.c == nil {
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// diagnosticsTimestampFormat is the format of the time in the names of the
+// diagnostics archives, it matches the one of the backups.
+const diagnosticsTimestampFormat = "2006-01-02.150405"
+
+var diagnosticsMaxSize = flag.Int("diagnostics_max_size", 8*1024*1024, "maximum size of the files collected by CollectDiagnostics, which fails if they are larger. The archive is returned in a single message, so it must be lower than -grpc_max_message_size.")
+
+// diagnosticsFile is a file of the diagnostics archive, fetched from a debug
+// endpoint of a vttablet or vtgate.
+type diagnosticsFile struct {
+	name string
+	path string
+	// stream is set for the endpoints which never end the response, they
+	// are read until duration is elapsed.
+	stream   bool
+	duration time.Duration
+}
+
+// diagnosticsFiles returns the files to collect, for a CPU profile and query
+// log of the given durations.
+func diagnosticsFiles(cpuProfile, queryLog time.Duration) []diagnosticsFile {
+	files := []diagnosticsFile{
+		{name: "cmdline.txt", path: "/debug/pprof/cmdline"},
+		{name: "env.html", path: "/debug/env"},
+		{name: "vars.json", path: "/debug/vars"},
+		{name: "goroutine.txt", path: "/debug/pprof/goroutine?debug=2"},
+		{name: "heap.pprof", path: "/debug/pprof/heap"},
+	}
+	if cpuProfile > 0 {
+		files = append(files, diagnosticsFile{
+			name:     "cpu.pprof",
+			path:     fmt.Sprintf("/debug/pprof/profile?seconds=%d", int64(cpuProfile/time.Second)),
+			duration: cpuProfile,
+		})
+	}
+	if queryLog > 0 {
+		files = append(files, diagnosticsFile{
+			name:     "querylog.txt",
+			path:     "/debug/querylog",
+			stream:   true,
+			duration: queryLog,
+		})
+	}
+	return files
+}
+
+// checkVtgateAddress fails if no vtgate registered the web address in the
+// topo of its cell, so that the diagnostics are only requested from vtgates.
+func checkVtgateAddress(ctx context.Context, ts *topo.Server, addr string) error {
+	cells, err := ts.GetKnownCells(ctx)
+	if err != nil {
+		return err
+	}
+	for _, cell := range cells {
+		addrs, err := ts.GetVtgateAddresses(ctx, cell)
+		if err != nil {
+			return err
+		}
+		for _, vtgateAddr := range addrs {
+			if vtgateAddr == addr {
+				return nil
+			}
+		}
+	}
+	return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no vtgate is registered in the topo with the web address %v", addr)
+}
+
+// collectDiagnostics fetches the diagnostics files from the web port at addr,
+// and returns them as a gzipped tar archive whose files are in the dir
+// directory. It fails if the files are larger than maxSize in total.
+func collectDiagnostics(ctx context.Context, client *http.Client, addr string, dir string, files []diagnosticsFile, maxSize int64) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+
+	now := time.Now()
+	for _, file := range files {
+		data, err := fetchDiagnosticsFile(ctx, client, addr, file, maxSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %v from %v: %w", file.path, addr, err)
+		}
+		maxSize -= int64(len(data))
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    dir + "/" + file.name,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: now,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fetchDiagnosticsFile fetches a diagnostics file from the web port at addr.
+// It fails if the file is larger than maxSize.
+func fetchDiagnosticsFile(ctx context.Context, client *http.Client, addr string, file diagnosticsFile, maxSize int64) ([]byte, error) {
+	var cancel context.CancelFunc
+	if file.stream {
+		ctx, cancel = context.WithTimeout(ctx, file.duration)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", "http://"+addr+file.path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %v", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil && !(file.stream && errors.Is(ctx.Err(), context.DeadlineExceeded)) {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("the diagnostics are larger than -diagnostics_max_size (%v bytes)", *diagnosticsMaxSize)
+	}
+	return data, nil
+}
+
+// fetchStatsSnapshot fetches the stats snapshot of the web port at addr.
+func fetchStatsSnapshot(ctx context.Context, client *http.Client, addr string, maxSize int64) (*vtctldatapb.StatsSnapshot, error) {
+	data, err := fetchDiagnosticsFile(ctx, client, addr, diagnosticsFile{path: "/debug/vars_snapshot"}, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &vtctldatapb.StatsSnapshot{}
+	if err := json2.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// writeDiagnosticsArchive adds the archive to the backup storage handle, as
+// its only file.
+func writeDiagnosticsArchive(ctx context.Context, bh backupstorage.BackupHandle, archive []byte) error {
+	w, err := bh.AddFile(ctx, "archive", int64(len(archive)))
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(archive); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// queryRuleScope identifies the tablets a set of query rules applies to:
+// the whole keyspace, a shard, or a single tablet.
+type queryRuleScope struct {
+	keyspace    string
+	shard       string
+	tabletAlias *topodatapb.TabletAlias
+}
+
+func (scope *queryRuleScope) get(qr *topodatapb.QueryRules) string {
+	switch {
+	case scope.tabletAlias != nil:
+		return qr.TabletRules[topoproto.TabletAliasString(scope.tabletAlias)]
+	case scope.shard != "":
+		return qr.ShardRules[scope.shard]
+	default:
+		return qr.KeyspaceRules
+	}
+}
+
+func (scope *queryRuleScope) set(qr *topodatapb.QueryRules, data string) {
+	switch {
+	case scope.tabletAlias != nil:
+		alias := topoproto.TabletAliasString(scope.tabletAlias)
+		if data == "" {
+			delete(qr.TabletRules, alias)
+			return
+		}
+		if qr.TabletRules == nil {
+			qr.TabletRules = make(map[string]string)
+		}
+		qr.TabletRules[alias] = data
+	case scope.shard != "":
+		if data == "" {
+			delete(qr.ShardRules, scope.shard)
+			return
+		}
+		if qr.ShardRules == nil {
+			qr.ShardRules = make(map[string]string)
+		}
+		qr.ShardRules[scope.shard] = data
+	default:
+		qr.KeyspaceRules = data
+	}
+}
+
+// validate checks the scope points to an existing keyspace, shard or tablet.
+func (scope *queryRuleScope) validate(ctx context.Context, ts *topo.Server) error {
+	if scope.keyspace == "" {
+		return vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "keyspace must be set")
+	}
+	if scope.shard != "" && scope.tabletAlias != nil {
+		return vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "cannot set both shard and tablet alias")
+	}
+
+	if _, err := ts.GetKeyspace(ctx, scope.keyspace); err != nil {
+		return vterrors.Wrapf(err, "GetKeyspace(%s)", scope.keyspace)
+	}
+
+	switch {
+	case scope.shard != "":
+		if _, err := ts.GetShard(ctx, scope.keyspace, scope.shard); err != nil {
+			return vterrors.Wrapf(err, "GetShard(%s/%s)", scope.keyspace, scope.shard)
+		}
+	case scope.tabletAlias != nil:
+		ti, err := ts.GetTablet(ctx, scope.tabletAlias)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetTablet(%s)", topoproto.TabletAliasString(scope.tabletAlias))
+		}
+		if ti.Keyspace != scope.keyspace {
+			return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet %s is in keyspace %s, not %s", topoproto.TabletAliasString(scope.tabletAlias), ti.Keyspace, scope.keyspace)
+		}
+	}
+	return nil
+}
+
+// updateQueryRules replaces the query rules of the scope with the ones
+// returned by the update function, in a single atomic topo update. If expectedVersion
+// is not zero, the update fails if the query rules of the keyspace are at a
+// different version.
+func updateQueryRules(ctx context.Context, ts *topo.Server, scope *queryRuleScope, expectedVersion int64, update func(qrs *rules.Rules) (*rules.Rules, error)) (*topodatapb.QueryRules, error) {
+	return ts.UpdateQueryRules(ctx, scope.keyspace, func(qr *topodatapb.QueryRules) error {
+		if expectedVersion != 0 && qr.Version != expectedVersion {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "query rules of keyspace %s are at version %d, expected version %d", scope.keyspace, qr.Version, expectedVersion)
+		}
+
+		qrs := rules.New()
+		if data := scope.get(qr); data != "" {
+			if err := qrs.UnmarshalJSON([]byte(data)); err != nil {
+				return vterrors.Wrapf(err, "bad query rules in topo")
+			}
+		}
+		qrs, err := update(qrs)
+		if err != nil {
+			return err
+		}
+
+		if qrs.Equal(rules.New()) {
+			scope.set(qr, "")
+			return nil
+		}
+		data, err := qrs.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			return err
+		}
+		scope.set(qr, buf.String())
+		return nil
+	})
+}
+
+// replaceQueryRule replaces the rule of qrs with the same name as qr, or
+// adds qr at the end of qrs if there is none. The order of the other rules
+// is kept, since the first matching rule wins.
+func replaceQueryRule(qrs *rules.Rules, qr *rules.Rule) *rules.Rules {
+	newqrs := rules.New()
+	replaced := false
+	for _, existing := range qrs.CopyUnderlying() {
+		if existing.Name == qr.Name {
+			existing = qr
+			replaced = true
+		}
+		newqrs.Add(existing)
+	}
+	if !replaced {
+		newqrs.Add(qr)
+	}
+	return newqrs
+}
@@ -18,9 +18,12 @@ package grpcvtctldserver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -33,16 +36,21 @@ import (
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/masking"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/mysqlctlproto"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
@@ -51,10 +59,13 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/schematools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -66,6 +77,11 @@ import (
 
 const (
 	initShardPrimaryOperation = "InitShardPrimary"
+
+	sqlSelectSchemaMigrationProgress = `select
+		migration_uuid, mysql_table, migration_status, stage, progress, eta_seconds,
+		rows_copied, table_rows, rows_copied_per_second, vreplication_lag_seconds
+		from _vt.schema_migrations`
 )
 
 // VtctldServer implements the Vtctld RPC service protocol.
@@ -128,6 +144,67 @@ func (s *VtctldServer) AddCellsAlias(ctx context.Context, req *vtctldatapb.AddCe
 	return &vtctldatapb.AddCellsAliasResponse{}, nil
 }
 
+// ApplyMaskingPolicy is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyMaskingPolicy(ctx context.Context, req *vtctldatapb.ApplyMaskingPolicyRequest) (*vtctldatapb.ApplyMaskingPolicyResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyMaskingPolicy")
+	defer span.Finish()
+
+	policy := req.MaskingPolicy
+	if policy == nil {
+		policy = &binlogdatapb.MaskingPolicy{}
+	}
+	span.Annotate("keyspaces", strings.Join(policy.Keyspaces, ","))
+
+	if err := masking.ValidatePolicy(policy); err != nil {
+		return nil, err
+	}
+
+	if err := s.ts.SaveMaskingPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ApplyMaskingPolicyResponse{}, nil
+}
+
+// ApplyQueryRule is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyQueryRule(ctx context.Context, req *vtctldatapb.ApplyQueryRuleRequest) (*vtctldatapb.ApplyQueryRuleResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyQueryRule")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("expected_version", req.ExpectedVersion)
+
+	scope := &queryRuleScope{keyspace: req.Keyspace, shard: req.Shard, tabletAlias: req.TabletAlias}
+	if err := scope.validate(ctx, s.ts); err != nil {
+		return nil, err
+	}
+
+	var ruleInfo map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(req.Rule))
+	dec.UseNumber()
+	if err := dec.Decode(&ruleInfo); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot parse rule")
+	}
+	qr, err := rules.BuildQueryRule(ruleInfo)
+	if err != nil {
+		return nil, err
+	}
+	if qr.Name == "" {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "rule must have a Name")
+	}
+
+	queryRules, err := updateQueryRules(ctx, s.ts, scope, req.ExpectedVersion, func(qrs *rules.Rules) (*rules.Rules, error) {
+		return replaceQueryRule(qrs, qr), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ApplyQueryRuleResponse{QueryRules: queryRules}, nil
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.ApplyRoutingRulesRequest) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyRoutingRules")
@@ -154,6 +231,63 @@ func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.A
 	return resp, nil
 }
 
+// ApplySchemaOwnership is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplySchemaOwnership(ctx context.Context, req *vtctldatapb.ApplySchemaOwnershipRequest) (*vtctldatapb.ApplySchemaOwnershipResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplySchemaOwnership")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	ownership := req.SchemaOwnership
+	if ownership == nil {
+		ownership = &vtctldatapb.SchemaOwnership{}
+	}
+	for table, tableOwnership := range ownership.Tables {
+		if table == "" {
+			return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "table name must be set")
+		}
+		if tableOwnership == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ownership of table %s must be set", table)
+		}
+	}
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		return nil, err
+	}
+
+	if err := s.ts.SaveSchemaOwnership(ctx, req.Keyspace, ownership); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ApplySchemaOwnershipResponse{}, nil
+}
+
+// ApplyTableACL is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyTableACL(ctx context.Context, req *vtctldatapb.ApplyTableACLRequest) (*vtctldatapb.ApplyTableACLResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyTableACL")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("dry_run", req.DryRun)
+
+	if req.TableAcl == nil {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "TableAcl must be set")
+	}
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		return nil, err
+	}
+	if err := tableacl.ValidateProto(req.TableAcl); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid table ACL: %v", err)
+	}
+
+	if !req.DryRun {
+		if err := s.ts.SaveTableACL(ctx, req.Keyspace, req.TableAcl); err != nil {
+			return nil, err
+		}
+	}
+
+	return &vtctldatapb.ApplyTableACLResponse{TableAcl: req.TableAcl}, nil
+}
+
 // ApplyVSchema is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyVSchemaRequest) (*vtctldatapb.ApplyVSchemaResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyVSchema")
@@ -226,6 +360,30 @@ func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyV
 	return &vtctldatapb.ApplyVSchemaResponse{VSchema: updatedVS}, nil
 }
 
+// ApplyVersionedTable is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyVersionedTable(ctx context.Context, req *vtctldatapb.ApplyVersionedTableRequest) (*vtctldatapb.ApplyVersionedTableResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyVersionedTable")
+	defer span.Finish()
+
+	span.Annotate("from_table", req.VersionedTable.GetFromTable())
+	span.Annotate("next_percent", req.VersionedTable.GetNextPercent())
+	span.Annotate("skip_rebuild", req.SkipRebuild)
+
+	rr, err := s.ts.GetRoutingRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVersionedTable(ctx, s.ts, req.VersionedTable, rr); err != nil {
+		return nil, err
+	}
+	setVersionedTable(rr, req.VersionedTable)
+	if err := s.saveRoutingRules(ctx, rr, req.SkipRebuild, req.RebuildCells); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ApplyVersionedTableResponse{RoutingRules: rr}, nil
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.ChangeTabletTypeRequest) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ChangeTabletType")
@@ -281,6 +439,80 @@ func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.Ch
 	}, nil
 }
 
+// CollectDiagnostics is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) CollectDiagnostics(ctx context.Context, req *vtctldatapb.CollectDiagnosticsRequest) (*vtctldatapb.CollectDiagnosticsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.CollectDiagnostics")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("vtgate_address", req.VtgateAddress)
+	span.Annotate("cpu_profile_seconds", req.CpuProfileSeconds)
+	span.Annotate("query_log_seconds", req.QueryLogSeconds)
+	span.Annotate("upload", req.Upload)
+
+	if (req.TabletAlias == nil) == (req.VtgateAddress == "") {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "CollectDiagnostics requires exactly one of a tablet alias and a vtgate address")
+	}
+	if req.CpuProfileSeconds < 0 || req.QueryLogSeconds < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "CollectDiagnostics requires non-negative durations")
+	}
+
+	var addr, target string
+	if req.TabletAlias != nil {
+		ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+		if err != nil {
+			return nil, err
+		}
+		if ti.Hostname == "" || ti.PortMap["vt"] == 0 {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "tablet %v has no web address", ti.AliasString())
+		}
+		addr, target = ti.Addr(), ti.AliasString()
+	} else {
+		if err := checkVtgateAddress(ctx, s.ts, req.VtgateAddress); err != nil {
+			return nil, err
+		}
+		addr, target = req.VtgateAddress, "vtgate-"+strings.NewReplacer(":", "-", "/", "-").Replace(req.VtgateAddress)
+	}
+
+	name := fmt.Sprintf("%v.%v", time.Now().UTC().Format(diagnosticsTimestampFormat), target)
+	files := diagnosticsFiles(time.Duration(req.CpuProfileSeconds)*time.Second, time.Duration(req.QueryLogSeconds)*time.Second)
+	archive, err := collectDiagnostics(ctx, http.DefaultClient, addr, name, files, int64(*diagnosticsMaxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vtctldatapb.CollectDiagnosticsResponse{
+		Archive: archive,
+	}
+	if !req.Upload {
+		return resp, nil
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	dir := filepath.Join("diagnostics", target)
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeDiagnosticsArchive(ctx, bh, archive); err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			log.Errorf("failed to abort the upload of diagnostics %v/%v: %v", dir, name, abortErr)
+		}
+		return nil, err
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		return nil, err
+	}
+
+	resp.UploadLocation = filepath.Join(dir, name)
+	return resp, nil
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.CreateKeyspace")
@@ -528,6 +760,38 @@ func (s *VtctldServer) DeleteKeyspace(ctx context.Context, req *vtctldatapb.Dele
 	return &vtctldatapb.DeleteKeyspaceResponse{}, nil
 }
 
+// DeleteQueryRule is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeleteQueryRule(ctx context.Context, req *vtctldatapb.DeleteQueryRuleRequest) (*vtctldatapb.DeleteQueryRuleResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DeleteQueryRule")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("name", req.Name)
+	span.Annotate("expected_version", req.ExpectedVersion)
+
+	scope := &queryRuleScope{keyspace: req.Keyspace, shard: req.Shard, tabletAlias: req.TabletAlias}
+	if scope.keyspace == "" {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "keyspace must be set")
+	}
+	if scope.shard != "" && scope.tabletAlias != nil {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "cannot set both shard and tablet alias")
+	}
+
+	queryRules, err := updateQueryRules(ctx, s.ts, scope, req.ExpectedVersion, func(qrs *rules.Rules) (*rules.Rules, error) {
+		if qrs.Delete(req.Name) == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no query rule named %s", req.Name)
+		}
+		return qrs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.DeleteQueryRuleResponse{QueryRules: queryRules}, nil
+}
+
 // DeleteShards is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) DeleteShards(ctx context.Context, req *vtctldatapb.DeleteShardsRequest) (*vtctldatapb.DeleteShardsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.DeleteShards")
@@ -562,6 +826,23 @@ func (s *VtctldServer) DeleteSrvVSchema(ctx context.Context, req *vtctldatapb.De
 	return &vtctldatapb.DeleteSrvVSchemaResponse{}, nil
 }
 
+// DeleteTableACL is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeleteTableACL(ctx context.Context, req *vtctldatapb.DeleteTableACLRequest) (*vtctldatapb.DeleteTableACLResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DeleteTableACL")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	if err := s.ts.DeleteTableACL(ctx, req.Keyspace); err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "keyspace %v has no table ACL", req.Keyspace)
+		}
+		return nil, err
+	}
+
+	return &vtctldatapb.DeleteTableACLResponse{}, nil
+}
+
 // DeleteTablets is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) DeleteTablets(ctx context.Context, req *vtctldatapb.DeleteTabletsRequest) (*vtctldatapb.DeleteTabletsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.DeleteTablets")
@@ -579,6 +860,28 @@ func (s *VtctldServer) DeleteTablets(ctx context.Context, req *vtctldatapb.Delet
 	return &vtctldatapb.DeleteTabletsResponse{}, nil
 }
 
+// DeleteVersionedTable is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeleteVersionedTable(ctx context.Context, req *vtctldatapb.DeleteVersionedTableRequest) (*vtctldatapb.DeleteVersionedTableResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DeleteVersionedTable")
+	defer span.Finish()
+
+	span.Annotate("from_table", req.FromTable)
+	span.Annotate("skip_rebuild", req.SkipRebuild)
+
+	rr, err := s.ts.GetRoutingRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !deleteVersionedTable(rr, req.FromTable) {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no versioned table %s in the routing rules", req.FromTable)
+	}
+	if err := s.saveRoutingRules(ctx, rr, req.SkipRebuild, req.RebuildCells); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.DeleteVersionedTableResponse{RoutingRules: rr}, nil
+}
+
 // EmergencyReparentShard is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldatapb.EmergencyReparentShardRequest) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.EmergencyReparentShard")
@@ -644,6 +947,143 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 	return resp, err
 }
 
+// EvacuateCell is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) EvacuateCell(ctx context.Context, req *vtctldatapb.EvacuateCellRequest) (*vtctldatapb.EvacuateCellResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.EvacuateCell")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("cell", req.Cell)
+	span.Annotate("target_cells", strings.Join(req.TargetCells, ","))
+	span.Annotate("concurrency", req.Concurrency)
+
+	if req.Keyspace == "" || req.Cell == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace and cell must be set")
+	}
+	for _, cell := range req.TargetCells {
+		if cell == req.Cell {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot evacuate cell %v to itself", req.Cell)
+		}
+	}
+
+	waitReplicasTimeout, ok, err := protoutil.DurationFromProto(req.WaitReplicasTimeout)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		waitReplicasTimeout = time.Second * 30
+	}
+
+	span.Annotate("wait_replicas_timeout_sec", waitReplicasTimeout.Seconds())
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "GetShardNames(%v) failed: %v", req.Keyspace, err)
+	}
+
+	// The shards are reparented one at a time by default, so that the writes
+	// are only paused in one shard at a time.
+	parallelism := 1
+	if req.Concurrency > 0 {
+		parallelism = int(req.Concurrency)
+	}
+
+	var (
+		wg                sync.WaitGroup
+		m                 sync.Mutex
+		done              int
+		rec               concurrency.AllErrorRecorder
+		sema              = sync2.NewSemaphore(parallelism, 0)
+		logger, getEvents = eventStreamLogger()
+		results           = make([]*vtctldatapb.EvacuateCellResponse_ShardResult, len(shards))
+	)
+
+	for i, shard := range shards {
+		wg.Add(1)
+		go func(i int, shard string) {
+			defer wg.Done()
+
+			sema.Acquire()
+			defer sema.Release()
+
+			result := s.evacuateCellShard(ctx, req.Keyspace, shard, req.Cell, req.TargetCells, waitReplicasTimeout, logger)
+			results[i] = result
+			if result.Error != "" {
+				rec.RecordError(fmt.Errorf("shard %v/%v: %v", req.Keyspace, shard, result.Error))
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			done++
+			logger.Infof("EvacuateCell(%v, %v): %d/%d shards done", req.Keyspace, req.Cell, done, len(shards))
+		}(i, shard)
+	}
+
+	wg.Wait()
+
+	resp := &vtctldatapb.EvacuateCellResponse{
+		Keyspace: req.Keyspace,
+		Shards:   results,
+		Events:   getEvents(),
+	}
+
+	return resp, rec.Error()
+}
+
+// evacuateCellShard moves the primary of the shard out of the cell. The
+// shard is skipped if its primary is not in the cell.
+func (s *VtctldServer) evacuateCellShard(ctx context.Context, keyspace string, shard string, cell string, targetCells []string, waitReplicasTimeout time.Duration, logger logutil.Logger) *vtctldatapb.EvacuateCellResponse_ShardResult {
+	result := &vtctldatapb.EvacuateCellResponse_ShardResult{
+		Shard: shard,
+	}
+
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	if si.PrimaryAlias == nil || si.PrimaryAlias.Cell != cell {
+		logger.Infof("shard %v/%v: primary %v is not in cell %v, skipping", keyspace, shard, topoproto.TabletAliasString(si.PrimaryAlias), cell)
+		result.Skipped = true
+		return result
+	}
+
+	tabletMap, err := s.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	newPrimary, err := reparentutil.ChooseNewPrimaryOutsideCell(ctx, s.tmc, tabletMap, cell, targetCells, waitReplicasTimeout, logger)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	if newPrimary == nil {
+		result.Error = fmt.Sprintf("cannot find a tablet to reparent to outside of cell %v", cell)
+		return result
+	}
+
+	logger.Infof("shard %v/%v: moving primary from %v to %v", keyspace, shard, topoproto.TabletAliasString(si.PrimaryAlias), topoproto.TabletAliasString(newPrimary))
+
+	_, err = reparentutil.NewPlannedReparenter(s.ts, s.tmc, logger).ReparentShard(ctx,
+		keyspace,
+		shard,
+		reparentutil.PlannedReparentOptions{
+			NewPrimaryAlias:     newPrimary,
+			WaitReplicasTimeout: waitReplicasTimeout,
+		},
+	)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.PromotedPrimary = newPrimary
+	return result
+}
+
 // ExecuteHook is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ExecuteHook(ctx context.Context, req *vtctldatapb.ExecuteHookRequest) (*vtctldatapb.ExecuteHookResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ExecuteHook")
@@ -817,6 +1257,73 @@ func (s *VtctldServer) GetCellsAliases(ctx context.Context, req *vtctldatapb.Get
 	return &vtctldatapb.GetCellsAliasesResponse{Aliases: aliases}, nil
 }
 
+// GetDeadLetters is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetDeadLetters(ctx context.Context, req *vtctldatapb.GetDeadLettersRequest) (*vtctldatapb.GetDeadLettersResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetDeadLetters")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("table", req.Table)
+	span.Annotate("limit", req.Limit)
+
+	if req.Keyspace == "" || req.Table == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "GetDeadLetters requires a keyspace and a table")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		m    sync.Mutex
+		wg   sync.WaitGroup
+		rec  concurrency.AllErrorRecorder
+		resp = &vtctldatapb.GetDeadLettersResponse{
+			ResultsByShard: map[string]*querypb.QueryResult{},
+		}
+	)
+
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+
+			primary, err := s.getShardPrimary(ctx, req.Keyspace, shard)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			qr, err := s.tmc.GetDeadLetters(ctx, primary, req.Table, limit)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetDeadLetters(%v) failed: %w", topoproto.TabletAliasString(primary.Alias), err))
+				return
+			}
+			if len(qr.Rows) == 0 {
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			resp.ResultsByShard[shard] = qr
+		}(shard)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	return resp, nil
+}
+
 // GetKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetKeyspace(ctx context.Context, req *vtctldatapb.GetKeyspaceRequest) (*vtctldatapb.GetKeyspaceResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetKeyspace")
@@ -861,6 +1368,49 @@ func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKey
 	return &vtctldatapb.GetKeyspacesResponse{Keyspaces: keyspaces}, nil
 }
 
+// GetMaskingPolicy is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetMaskingPolicy(ctx context.Context, req *vtctldatapb.GetMaskingPolicyRequest) (*vtctldatapb.GetMaskingPolicyResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetMaskingPolicy")
+	defer span.Finish()
+
+	policy, err := s.ts.GetMaskingPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetMaskingPolicyResponse{MaskingPolicy: policy}, nil
+}
+
+// GetOnlineDDLSchedulerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetOnlineDDLSchedulerConfig(ctx context.Context, req *vtctldatapb.GetOnlineDDLSchedulerConfigRequest) (*vtctldatapb.GetOnlineDDLSchedulerConfigResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetOnlineDDLSchedulerConfig")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	config, err := s.ts.GetOnlineDDLSchedulerConfig(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetOnlineDDLSchedulerConfigResponse{Config: config}, nil
+}
+
+// GetQueryRules is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetQueryRules(ctx context.Context, req *vtctldatapb.GetQueryRulesRequest) (*vtctldatapb.GetQueryRulesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetQueryRules")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	qr, _, err := s.ts.GetQueryRules(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetQueryRulesResponse{QueryRules: qr}, nil
+}
+
 // GetRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetRoutingRules(ctx context.Context, req *vtctldatapb.GetRoutingRulesRequest) (*vtctldatapb.GetRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRoutingRules")
@@ -918,11 +1468,161 @@ func (s *VtctldServer) GetSchema(ctx context.Context, req *vtctldatapb.GetSchema
 		sd.TableDefinitions = sizeTds
 	}
 
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	ownership, err := s.ts.GetSchemaOwnership(ctx, ti.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var tableOwnership map[string]*vtctldatapb.TableOwnership
+	for _, td := range sd.GetTableDefinitions() {
+		if o, ok := ownership.Tables[td.Name]; ok {
+			if tableOwnership == nil {
+				tableOwnership = map[string]*vtctldatapb.TableOwnership{}
+			}
+			tableOwnership[td.Name] = o
+		}
+	}
+
 	return &vtctldatapb.GetSchemaResponse{
-		Schema: sd,
+		Schema:         sd,
+		TableOwnership: tableOwnership,
 	}, nil
 }
 
+// GetSchemaMigrationProgress is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetSchemaMigrationProgress(ctx context.Context, req *vtctldatapb.GetSchemaMigrationProgressRequest) (*vtctldatapb.GetSchemaMigrationProgressResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetSchemaMigrationProgress")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "GetSchemaMigrationProgress requires a keyspace")
+	}
+
+	var (
+		query string
+		err   error
+	)
+	if req.Uuid != "" {
+		if !schema.IsOnlineDDLUUID(req.Uuid) {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid migration UUID %s", req.Uuid)
+		}
+		query, err = sqlparser.ParseAndBind(sqlSelectSchemaMigrationProgress+" where migration_uuid=%a", sqltypes.StringBindVariable(req.Uuid))
+	} else {
+		query, err = sqlparser.ParseAndBind(sqlSelectSchemaMigrationProgress+" where migration_status=%a", sqltypes.StringBindVariable(string(schema.OnlineDDLStatusRunning)))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg         sync.WaitGroup
+		rec        concurrency.AllErrorRecorder
+		migrations = make([][]*vtctldatapb.SchemaMigrationProgress, len(shards))
+	)
+
+	for i, shard := range shards {
+		wg.Add(1)
+		go func(i int, shard string) {
+			defer wg.Done()
+
+			primary, err := s.getShardPrimary(ctx, req.Keyspace, shard)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			qr, err := s.tmc.VExec(ctx, primary, query, req.Uuid, req.Keyspace)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("VExec(%v) failed: %w", topoproto.TabletAliasString(primary.Alias), err))
+				return
+			}
+
+			for _, row := range sqltypes.Proto3ToResult(qr).Named().Rows {
+				migrations[i] = append(migrations[i], &vtctldatapb.SchemaMigrationProgress{
+					Shard:                  shard,
+					TabletAlias:            primary.Alias,
+					Uuid:                   row.AsString("migration_uuid", ""),
+					Table:                  row.AsString("mysql_table", ""),
+					Status:                 row.AsString("migration_status", ""),
+					Stage:                  row.AsString("stage", ""),
+					Progress:               float32(row.AsFloat64("progress", 0)),
+					EtaSeconds:             row.AsInt64("eta_seconds", -1),
+					RowsCopied:             row.AsUint64("rows_copied", 0),
+					TableRows:              row.AsInt64("table_rows", 0),
+					RowsCopiedPerSecond:    float32(row.AsFloat64("rows_copied_per_second", 0)),
+					VreplicationLagSeconds: row.AsInt64("vreplication_lag_seconds", 0),
+				})
+			}
+		}(i, shard)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	resp := &vtctldatapb.GetSchemaMigrationProgressResponse{}
+	for _, shardMigrations := range migrations {
+		resp.Migrations = append(resp.Migrations, shardMigrations...)
+	}
+
+	return resp, nil
+}
+
+// GetSchemaOwnership is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetSchemaOwnership(ctx context.Context, req *vtctldatapb.GetSchemaOwnershipRequest) (*vtctldatapb.GetSchemaOwnershipResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetSchemaOwnership")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	ownership, err := s.ts.GetSchemaOwnership(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetSchemaOwnershipResponse{SchemaOwnership: ownership}, nil
+}
+
+// GetSequences is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetSequences(ctx context.Context, req *vtctldatapb.GetSequencesRequest) (*vtctldatapb.GetSequencesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetSequences")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("tables", strings.Join(req.Tables, ","))
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "GetSequences requires a keyspace")
+	}
+
+	primary, err := s.getSequencePrimary(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences, err := s.tmc.GetSequences(ctx, primary, req.Tables)
+	if err != nil {
+		return nil, fmt.Errorf("GetSequences(%v) failed: %w", topoproto.TabletAliasString(primary.Alias), err)
+	}
+
+	return &vtctldatapb.GetSequencesResponse{Sequences: sequences}, nil
+}
+
 // GetShard is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetShard(ctx context.Context, req *vtctldatapb.GetShardRequest) (*vtctldatapb.GetShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetShard")
@@ -1081,6 +1781,89 @@ func (s *VtctldServer) GetSrvVSchemas(ctx context.Context, req *vtctldatapb.GetS
 	}, nil
 }
 
+// GetStorageUsage is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetStorageUsage(ctx context.Context, req *vtctldatapb.GetStorageUsageRequest) (*vtctldatapb.GetStorageUsageResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetStorageUsage")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "GetStorageUsage requires a keyspace")
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg     sync.WaitGroup
+		rec    concurrency.AllErrorRecorder
+		now    = time.Now()
+		usages = make([]*vtctldatapb.ShardStorageUsage, len(shards))
+	)
+
+	for i, shard := range shards {
+		wg.Add(1)
+		go func(i int, shard string) {
+			defer wg.Done()
+
+			primary, err := s.getShardPrimary(ctx, req.Keyspace, shard)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			resp, err := s.tmc.GetStorageUsage(ctx, primary)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetStorageUsage(%v) failed: %w", topoproto.TabletAliasString(primary.Alias), err))
+				return
+			}
+
+			usages[i] = newShardStorageUsage(now, shard, primary.Alias, resp)
+		}(i, shard)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	resp := &vtctldatapb.GetStorageUsageResponse{Shards: usages}
+	for _, usage := range usages {
+		resp.UsedBytes += usage.UsedBytes
+		resp.GrowthBytesPerDay += usage.GrowthBytesPerDay
+		if usage.ForecastFull == nil {
+			continue
+		}
+		if resp.ForecastFull == nil || protoutil.TimeFromProto(usage.ForecastFull).Before(protoutil.TimeFromProto(resp.ForecastFull)) {
+			resp.ForecastFull = usage.ForecastFull
+		}
+	}
+
+	return resp, nil
+}
+
+// GetTableACL is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetTableACL(ctx context.Context, req *vtctldatapb.GetTableACLRequest) (*vtctldatapb.GetTableACLResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetTableACL")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	config, err := s.ts.GetTableACL(ctx, req.Keyspace)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "keyspace %v has no table ACL", req.Keyspace)
+		}
+		return nil, err
+	}
+
+	return &vtctldatapb.GetTableACLResponse{TableAcl: config}, nil
+}
+
 // GetTablet is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetTablet(ctx context.Context, req *vtctldatapb.GetTabletRequest) (*vtctldatapb.GetTabletResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetTablet")
@@ -1098,6 +1881,34 @@ func (s *VtctldServer) GetTablet(ctx context.Context, req *vtctldatapb.GetTablet
 	}, nil
 }
 
+// GetTabletStatsSnapshot is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetTabletStatsSnapshot(ctx context.Context, req *vtctldatapb.GetTabletStatsSnapshotRequest) (*vtctldatapb.GetTabletStatsSnapshotResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetTabletStatsSnapshot")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+	if req.TabletAlias == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "GetTabletStatsSnapshot requires a tablet alias")
+	}
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+	if ti.Hostname == "" || ti.PortMap["vt"] == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "tablet %v has no web address", ti.AliasString())
+	}
+
+	snapshot, err := fetchStatsSnapshot(ctx, http.DefaultClient, ti.Addr(), int64(*diagnosticsMaxSize))
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "failed to capture the stats snapshot of tablet %v", ti.AliasString())
+	}
+	snapshot.TabletAlias = ti.Alias
+	return &vtctldatapb.GetTabletStatsSnapshotResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
 // GetTablets is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTabletsRequest) (*vtctldatapb.GetTabletsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetTablets")
@@ -1270,6 +2081,205 @@ func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTable
 	}, nil
 }
 
+// GetUnresolvedTransactions is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetUnresolvedTransactions(ctx context.Context, req *vtctldatapb.GetUnresolvedTransactionsRequest) (*vtctldatapb.GetUnresolvedTransactionsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetUnresolvedTransactions")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("abandon_age_seconds", req.AbandonAgeSeconds)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "GetUnresolvedTransactions requires a keyspace")
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		m            sync.Mutex
+		wg           sync.WaitGroup
+		rec          concurrency.AllErrorRecorder
+		transactions = map[string]*vtctldatapb.UnresolvedTransaction{}
+		abandonAge   = time.Duration(req.AbandonAgeSeconds) * time.Second
+	)
+
+	// transaction returns the transaction of the dtid, which is added the
+	// first time it is seen on a shard. It must be called with m held.
+	transaction := func(dtid string) *vtctldatapb.UnresolvedTransaction {
+		if t, ok := transactions[dtid]; ok {
+			return t
+		}
+		t := &vtctldatapb.UnresolvedTransaction{Dtid: dtid}
+		if ss, err := dtids.ShardSession(dtid); err == nil {
+			t.Keyspace = ss.Target.Keyspace
+			t.Shard = ss.Target.Shard
+		}
+		transactions[dtid] = t
+		return t
+	}
+
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+
+			primary, err := s.getShardPrimary(ctx, req.Keyspace, shard)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			metadata, prepared, err := s.tmc.GetUnresolvedTransactions(ctx, primary, abandonAge)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetUnresolvedTransactions(%v) failed: %w", topoproto.TabletAliasString(primary.Alias), err))
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+
+			for _, md := range metadata {
+				t := transaction(md.Dtid)
+				t.State = md.State
+				t.TimeCreated = protoutil.TimeToProto(time.Unix(0, md.TimeCreated))
+				t.Participants = md.Participants
+			}
+
+			name := topoproto.KeyspaceShardString(req.Keyspace, shard)
+			for _, ptx := range prepared {
+				t := transaction(ptx.Dtid)
+				if ptx.Failed {
+					t.FailedShards = append(t.FailedShards, name)
+				} else {
+					t.PreparedShards = append(t.PreparedShards, name)
+				}
+				// The metadata manager has the time the transaction was
+				// created, it may not be scanned if it is in another keyspace.
+				if t.TimeCreated == nil {
+					t.TimeCreated = protoutil.TimeToProto(time.Unix(0, ptx.TimeCreated))
+				}
+			}
+		}(shard)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	resp := &vtctldatapb.GetUnresolvedTransactionsResponse{
+		Transactions: make([]*vtctldatapb.UnresolvedTransaction, 0, len(transactions)),
+	}
+	for _, t := range transactions {
+		sort.Strings(t.PreparedShards)
+		sort.Strings(t.FailedShards)
+		resp.Transactions = append(resp.Transactions, t)
+	}
+
+	// The oldest transactions first.
+	sort.Slice(resp.Transactions, func(i, j int) bool {
+		ti := protoutil.TimeFromProto(resp.Transactions[i].TimeCreated)
+		tj := protoutil.TimeFromProto(resp.Transactions[j].TimeCreated)
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return resp.Transactions[i].Dtid < resp.Transactions[j].Dtid
+	})
+
+	return resp, nil
+}
+
+// updateDeadLetters runs update, which requeues or purges the dead-lettered
+// messages of the table, on the primaries of all the shards of the keyspace,
+// and returns the total number of messages updated. Since the primaries
+// update all their dead-lettered messages when given no ids, all must be set
+// explicitly instead.
+func (s *VtctldServer) updateDeadLetters(ctx context.Context, name string, keyspace string, table string, ids []string, all bool, update func(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error)) (int64, error) {
+	if keyspace == "" || table == "" {
+		return 0, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%s requires a keyspace and a table", name)
+	}
+	if (len(ids) == 0) == !all {
+		return 0, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%s requires either ids or all", name)
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		wg    sync.WaitGroup
+		rec   concurrency.AllErrorRecorder
+		count sync2.AtomicInt64
+	)
+
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+
+			primary, err := s.getShardPrimary(ctx, keyspace, shard)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			n, err := update(ctx, primary, table, ids)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("%s(%v) failed: %w", name, topoproto.TabletAliasString(primary.Alias), err))
+				return
+			}
+			count.Add(n)
+		}(shard)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return 0, rec.Error()
+	}
+
+	return count.Get(), nil
+}
+
+// getShardPrimary returns the primary tablet of the shard.
+// getSequencePrimary returns the primary of the keyspace of sequence tables,
+// which must be unsharded.
+func (s *VtctldServer) getSequencePrimary(ctx context.Context, keyspace string) (*topodatapb.Tablet, error) {
+	shards, err := s.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shards) != 1 {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "sequence tables live in unsharded keyspaces, but keyspace %v has %d shards", keyspace, len(shards))
+	}
+
+	return s.getShardPrimary(ctx, keyspace, shards[0])
+}
+
+func (s *VtctldServer) getShardPrimary(ctx context.Context, keyspace string, shard string) (*topodatapb.Tablet, error) {
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+
+	if !si.HasPrimary() {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "shard %v/%v has no primary", keyspace, shard)
+	}
+
+	ti, err := s.ts.GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %w", topoproto.TabletAliasString(si.PrimaryAlias), err)
+	}
+
+	return ti.Tablet, nil
+}
+
 // GetVSchema is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetVSchema(ctx context.Context, req *vtctldatapb.GetVSchemaRequest) (*vtctldatapb.GetVSchemaResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetVSchema")
@@ -1287,6 +2297,21 @@ func (s *VtctldServer) GetVSchema(ctx context.Context, req *vtctldatapb.GetVSche
 	}, nil
 }
 
+// GetWorkflowProgress is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetWorkflowProgress(ctx context.Context, req *vtctldatapb.GetWorkflowProgressRequest) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetWorkflowProgress")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if req.Keyspace == "" || req.Workflow == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "GetWorkflowProgress requires a keyspace and a workflow")
+	}
+
+	return s.ws.GetWorkflowProgress(ctx, req)
+}
+
 // GetWorkflows is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetWorkflows(ctx context.Context, req *vtctldatapb.GetWorkflowsRequest) (*vtctldatapb.GetWorkflowsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetWorkflows")
@@ -1644,6 +2669,24 @@ func (s *VtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldatap
 	return resp, err
 }
 
+// PurgeDeadLetters is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) PurgeDeadLetters(ctx context.Context, req *vtctldatapb.PurgeDeadLettersRequest) (*vtctldatapb.PurgeDeadLettersResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.PurgeDeadLetters")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("table", req.Table)
+	span.Annotate("num_ids", len(req.Ids))
+	span.Annotate("all", req.All)
+
+	count, err := s.updateDeadLetters(ctx, "PurgeDeadLetters", req.Keyspace, req.Table, req.Ids, req.All, s.tmc.PurgeDeadLetters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.PurgeDeadLettersResponse{Count: count}, nil
+}
+
 // RebuildKeyspaceGraph is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) RebuildKeyspaceGraph(ctx context.Context, req *vtctldatapb.RebuildKeyspaceGraphRequest) (*vtctldatapb.RebuildKeyspaceGraphResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.RebuildKeyspaceGraph")
@@ -1934,6 +2977,128 @@ func (s *VtctldServer) ReparentTablet(ctx context.Context, req *vtctldatapb.Repa
 	}, nil
 }
 
+// RequeueDeadLetters is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RequeueDeadLetters(ctx context.Context, req *vtctldatapb.RequeueDeadLettersRequest) (*vtctldatapb.RequeueDeadLettersResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.RequeueDeadLetters")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("table", req.Table)
+	span.Annotate("num_ids", len(req.Ids))
+	span.Annotate("all", req.All)
+
+	count, err := s.updateDeadLetters(ctx, "RequeueDeadLetters", req.Keyspace, req.Table, req.Ids, req.All, s.tmc.RequeueDeadLetters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RequeueDeadLettersResponse{Count: count}, nil
+}
+
+// ResetSequence is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ResetSequence(ctx context.Context, req *vtctldatapb.ResetSequenceRequest) (*vtctldatapb.ResetSequenceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ResetSequence")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("table", req.Table)
+	span.Annotate("next_id", req.NextId)
+	span.Annotate("cache", req.Cache)
+
+	if req.Keyspace == "" || req.Table == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ResetSequence requires a keyspace and a table")
+	}
+	if req.NextId == 0 && req.Cache == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ResetSequence requires a next ID or a cache")
+	}
+
+	primary, err := s.getSequencePrimary(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := s.tmc.ResetSequence(ctx, primary, req.Table, req.NextId, req.Cache)
+	if err != nil {
+		return nil, fmt.Errorf("ResetSequence(%v) failed: %w", topoproto.TabletAliasString(primary.Alias), err)
+	}
+
+	return &vtctldatapb.ResetSequenceResponse{Sequence: sequence}, nil
+}
+
+// ResolveTransaction is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ResolveTransaction(ctx context.Context, req *vtctldatapb.ResolveTransactionRequest) (*vtctldatapb.ResolveTransactionResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ResolveTransaction")
+	defer span.Finish()
+
+	span.Annotate("dtid", req.Dtid)
+	span.Annotate("commit", req.Commit)
+
+	ss, err := dtids.ShardSession(req.Dtid)
+	if err != nil {
+		return nil, err
+	}
+
+	mm, err := s.getShardPrimary(ctx, ss.Target.Keyspace, ss.Target.Shard)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, _, err := s.tmc.GetUnresolvedTransactions(ctx, mm, 0)
+	if err != nil {
+		return nil, fmt.Errorf("GetUnresolvedTransactions(%v) failed: %w", topoproto.TabletAliasString(mm.Alias), err)
+	}
+
+	var metadata *querypb.TransactionMetadata
+	for _, t := range transactions {
+		if t.Dtid == req.Dtid {
+			metadata = t
+			break
+		}
+	}
+
+	if metadata == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "transaction %v not found on its metadata manager %v", req.Dtid, topoproto.TabletAliasString(mm.Alias))
+	}
+
+	// The metadata manager commits its own part of the transaction when the
+	// state becomes COMMIT, so a transaction can only be committed on its
+	// participants once it is in that state.
+	switch {
+	case req.Commit && metadata.State != querypb.TransactionState_COMMIT:
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "transaction %v is in state %v, only a transaction in state COMMIT can be committed", req.Dtid, metadata.State)
+	case !req.Commit && metadata.State == querypb.TransactionState_COMMIT:
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "transaction %v is in state COMMIT, it cannot be rolled back", req.Dtid)
+	}
+
+	// Like the transaction resolver of vtgate, the metadata manager first
+	// decides to roll back a transaction in state PREPARE, so that it can no
+	// longer be committed while its participants roll back their part.
+	if !req.Commit && metadata.State == querypb.TransactionState_PREPARE {
+		if err := s.tmc.SetRollbackTransaction(ctx, mm, req.Dtid); err != nil {
+			return nil, fmt.Errorf("SetRollbackTransaction(%v) failed: %w", topoproto.TabletAliasString(mm.Alias), err)
+		}
+	}
+
+	for _, participant := range metadata.Participants {
+		primary, err := s.getShardPrimary(ctx, participant.Keyspace, participant.Shard)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.tmc.ResolveTransaction(ctx, primary, req.Dtid, req.Commit, false); err != nil {
+			return nil, fmt.Errorf("ResolveTransaction(%v) failed: %w", topoproto.TabletAliasString(primary.Alias), err)
+		}
+	}
+
+	// The metadata is only removed once all the participants are resolved,
+	// so that a failed resolution can be retried.
+	if err := s.tmc.ResolveTransaction(ctx, mm, req.Dtid, req.Commit, true); err != nil {
+		return nil, fmt.Errorf("ResolveTransaction(%v) failed: %w", topoproto.TabletAliasString(mm.Alias), err)
+	}
+
+	return &vtctldatapb.ResolveTransactionResponse{}, nil
+}
+
 // RunHealthCheck is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) RunHealthCheck(ctx context.Context, req *vtctldatapb.RunHealthCheckRequest) (*vtctldatapb.RunHealthCheckResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.RunHealthCheck")
@@ -2051,6 +3216,33 @@ func (s *VtctldServer) SetKeyspaceShardingInfo(ctx context.Context, req *vtctlda
 	}, nil
 }
 
+// SetOnlineDDLSchedulerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SetOnlineDDLSchedulerConfig(ctx context.Context, req *vtctldatapb.SetOnlineDDLSchedulerConfigRequest) (*vtctldatapb.SetOnlineDDLSchedulerConfigResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetOnlineDDLSchedulerConfig")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	config := req.Config
+	if config == nil {
+		config = &topodatapb.OnlineDDLSchedulerConfig{}
+	}
+	span.Annotate("max_concurrent_migrations", config.MaxConcurrentMigrations)
+
+	if config.MaxConcurrentMigrations < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "max_concurrent_migrations must not be negative, got %d", config.MaxConcurrentMigrations)
+	}
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		return nil, err
+	}
+
+	if err := s.ts.SaveOnlineDDLSchedulerConfig(ctx, req.Keyspace, config); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.SetOnlineDDLSchedulerConfigResponse{Config: config}, nil
+}
+
 // SetShardIsPrimaryServing is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) SetShardIsPrimaryServing(ctx context.Context, req *vtctldatapb.SetShardIsPrimaryServingRequest) (*vtctldatapb.SetShardIsPrimaryServingResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetShardIsPrimaryServing")
@@ -2517,6 +3709,111 @@ func (s *VtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldatapb.Up
 	}, nil
 }
 
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) UpdateThrottlerConfig(ctx context.Context, req *vtctldatapb.UpdateThrottlerConfigRequest) (*vtctldatapb.UpdateThrottlerConfigResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.UpdateThrottlerConfig")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("enable", req.Enable)
+	span.Annotate("disable", req.Disable)
+	span.Annotate("threshold", req.Threshold)
+	span.Annotate("custom_query_set", req.CustomQuerySet)
+
+	if req.Enable && req.Disable {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot both enable and disable the throttler")
+	}
+	if req.CheckAsCheckSelf && req.CheckAsCheckShard {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot both check as check self and check as check shard")
+	}
+	if req.Threshold < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "threshold must not be negative, got %v", req.Threshold)
+	}
+	if app := req.ThrottledApp; app != nil && (app.Ratio < 0 || app.Ratio > 1) {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "throttled app ratio must be between 0 and 1, got %v", app.Ratio)
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "UpdateThrottlerConfig")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+
+	var err error
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	ki.ThrottlerConfig = updateThrottlerConfig(ki.ThrottlerConfig, req, time.Now())
+	if err = s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+
+	if err = s.ts.UpdateSrvKeyspaceThrottlerConfig(ctx, req.Keyspace, nil, ki.ThrottlerConfig); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.UpdateThrottlerConfigResponse{
+		ThrottlerConfig: ki.ThrottlerConfig,
+	}, nil
+}
+
+// defaultThrottledAppDuration is how long an app is throttled for when its rule has no expiration.
+const defaultThrottledAppDuration = time.Hour
+
+// updateThrottlerConfig returns a copy of the throttler config, with the changes of the request
+// applied, and without the expired throttled app rules.
+func updateThrottlerConfig(throttlerConfig *topodatapb.ThrottlerConfig, req *vtctldatapb.UpdateThrottlerConfigRequest, now time.Time) *topodatapb.ThrottlerConfig {
+	if throttlerConfig == nil {
+		throttlerConfig = &topodatapb.ThrottlerConfig{}
+	} else {
+		throttlerConfig = proto.Clone(throttlerConfig).(*topodatapb.ThrottlerConfig)
+	}
+
+	if req.Enable {
+		throttlerConfig.Enabled = true
+	}
+	if req.Disable {
+		throttlerConfig.Enabled = false
+	}
+	if req.Threshold > 0 {
+		throttlerConfig.Threshold = req.Threshold
+	}
+	if req.CustomQuerySet {
+		throttlerConfig.CustomQuery = req.CustomQuery
+	}
+	if req.CheckAsCheckSelf {
+		throttlerConfig.CheckAsCheckSelf = true
+	}
+	if req.CheckAsCheckShard {
+		throttlerConfig.CheckAsCheckSelf = false
+	}
+
+	if throttlerConfig.ThrottledApps == nil {
+		throttlerConfig.ThrottledApps = make(map[string]*topodatapb.ThrottledAppRule)
+	}
+	if app := req.ThrottledApp; app != nil && app.Name != "" {
+		if app.Ratio == 0 {
+			delete(throttlerConfig.ThrottledApps, app.Name)
+		} else {
+			app = proto.Clone(app).(*topodatapb.ThrottledAppRule)
+			if app.ExpiresAt == nil {
+				app.ExpiresAt = protoutil.TimeToProto(now.Add(defaultThrottledAppDuration))
+			}
+			throttlerConfig.ThrottledApps[app.Name] = app
+		}
+	}
+	for name, app := range throttlerConfig.ThrottledApps {
+		if app.ExpiresAt != nil && !protoutil.TimeFromProto(app.ExpiresAt).After(now) {
+			delete(throttlerConfig.ThrottledApps, name)
+		}
+	}
+
+	return throttlerConfig
+}
+
 // Validate is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) Validate(ctx context.Context, req *vtctldatapb.ValidateRequest) (*vtctldatapb.ValidateResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.Validate")
@@ -17,10 +17,20 @@ limitations under the License.
 package grpcvtctldserver
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,6 +40,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/logutil"
@@ -39,17 +50,21 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/proto/vttime"
 )
 
@@ -327,6 +342,332 @@ func TestApplyRoutingRules(t *testing.T) {
 	}
 }
 
+func TestApplyMaskingPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &binlogdatapb.MaskingPolicy{
+		Keyspaces: []string{"staging"},
+		Rules: []*binlogdatapb.MaskingRule{
+			{Table: "customer", Column: "email", Transform: binlogdatapb.MaskingTransform_FAKE},
+			{Table: "customer", Column: "phone", Transform: binlogdatapb.MaskingTransform_NULLIFY},
+		},
+		Salt: []byte("salt"),
+	}
+	tests := []struct {
+		name      string
+		existing  *binlogdatapb.MaskingPolicy
+		req       *vtctldatapb.ApplyMaskingPolicyRequest
+		expected  *binlogdatapb.MaskingPolicy
+		topoDown  bool
+		shouldErr bool
+	}{
+		{
+			name:     "success",
+			req:      &vtctldatapb.ApplyMaskingPolicyRequest{MaskingPolicy: policy},
+			expected: policy,
+		},
+		{
+			name:     "empty policy deletes it",
+			existing: policy,
+			req:      &vtctldatapb.ApplyMaskingPolicyRequest{},
+			expected: &binlogdatapb.MaskingPolicy{},
+		},
+		{
+			name:     "invalid policy",
+			existing: policy,
+			req: &vtctldatapb.ApplyMaskingPolicyRequest{
+				MaskingPolicy: &binlogdatapb.MaskingPolicy{
+					Keyspaces: []string{"staging"},
+					Rules: []*binlogdatapb.MaskingRule{
+						{Table: "customer", Column: "email"},
+						{Table: "customer", Column: "email", Transform: binlogdatapb.MaskingTransform_NULLIFY},
+					},
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "topo down",
+			req:       &vtctldatapb.ApplyMaskingPolicyRequest{MaskingPolicy: policy},
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory("zone1")
+			if tt.existing != nil {
+				require.NoError(t, ts.SaveMaskingPolicy(ctx, tt.existing))
+			}
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			_, err := vtctld.ApplyMaskingPolicy(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			got, err := ts.GetMaskingPolicy(ctx)
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, got)
+		})
+	}
+}
+
+func TestApplyQueryRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{Keyspace: "testkeyspace", Name: "-"})
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+	}, nil)
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{Keyspace: "otherkeyspace", Name: "-"})
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		Keyspace: "otherkeyspace",
+		Shard:    "-",
+	}, nil)
+
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	resp, err := vtctld.ApplyQueryRule(ctx, &vtctldatapb.ApplyQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Rule:     `{"Name": "r1", "TableNames": ["t1"], "Action": "FAIL"}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.QueryRules.Version)
+	assert.Equal(t, `[{"Description":"","Name":"r1","TableNames":["t1"],"Action":"FAIL"}]`, resp.QueryRules.KeyspaceRules)
+
+	resp, err = vtctld.ApplyQueryRule(ctx, &vtctldatapb.ApplyQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Rule:     `{"Name": "r2", "Action": "TIMEOUT", "Timeout": "1s"}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.QueryRules.Version)
+
+	// Replacing a rule keeps its position.
+	resp, err = vtctld.ApplyQueryRule(ctx, &vtctldatapb.ApplyQueryRuleRequest{
+		Keyspace:        "testkeyspace",
+		Rule:            `{"Name": "r1", "TableNames": ["t2"], "Action": "FAIL_RETRY"}`,
+		ExpectedVersion: 2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.QueryRules.Version)
+	assert.Equal(t, `[{"Description":"","Name":"r1","TableNames":["t2"],"Action":"FAIL_RETRY"},{"Description":"","Name":"r2","Action":"TIMEOUT","Timeout":"1s"}]`, resp.QueryRules.KeyspaceRules)
+
+	resp, err = vtctld.ApplyQueryRule(ctx, &vtctldatapb.ApplyQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Rule:     `{"Name": "r3", "Action": "FAIL"}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `[{"Description":"","Name":"r3","Action":"FAIL"}]`, resp.QueryRules.ShardRules["-"])
+
+	resp, err = vtctld.ApplyQueryRule(ctx, &vtctldatapb.ApplyQueryRuleRequest{
+		Keyspace:    "testkeyspace",
+		TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Rule:        `{"Name": "r4", "Action": "FAIL"}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `[{"Description":"","Name":"r4","Action":"FAIL"}]`, resp.QueryRules.TabletRules["zone1-0000000100"])
+
+	get, err := vtctld.GetQueryRules(ctx, &vtctldatapb.GetQueryRulesRequest{Keyspace: "testkeyspace"})
+	require.NoError(t, err)
+	utils.MustMatch(t, resp.QueryRules, get.QueryRules)
+
+	for _, req := range []*vtctldatapb.ApplyQueryRuleRequest{
+		// stale version
+		{Keyspace: "testkeyspace", Rule: `{"Name": "r5"}`, ExpectedVersion: 2},
+		// invalid rules
+		{Keyspace: "testkeyspace", Rule: `{"Name": "r5", "Action": "BLOCK"}`},
+		{Keyspace: "testkeyspace", Rule: `{"Action": "FAIL"}`},
+		{Keyspace: "testkeyspace", Rule: `not json`},
+		// invalid scopes
+		{Rule: `{"Name": "r5"}`},
+		{Keyspace: "nokeyspace", Rule: `{"Name": "r5"}`},
+		{Keyspace: "testkeyspace", Shard: "-80", Rule: `{"Name": "r5"}`},
+		{Keyspace: "testkeyspace", TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 200}, Rule: `{"Name": "r5"}`},
+		{Keyspace: "testkeyspace", Shard: "-", TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}, Rule: `{"Name": "r5"}`},
+	} {
+		_, err := vtctld.ApplyQueryRule(ctx, req)
+		assert.Error(t, err, "%v", req)
+	}
+}
+
+func TestApplySchemaOwnership(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ownership := &vtctldatapb.SchemaOwnership{
+		Tables: map[string]*vtctldatapb.TableOwnership{
+			"customer": {Owner: "payments", ApprovalPolicy: "payments-dba"},
+			"corder":   {Owner: "orders"},
+		},
+	}
+	tests := []struct {
+		name      string
+		existing  *vtctldatapb.SchemaOwnership
+		req       *vtctldatapb.ApplySchemaOwnershipRequest
+		expected  *vtctldatapb.SchemaOwnership
+		topoDown  bool
+		shouldErr bool
+	}{
+		{
+			name:     "success",
+			req:      &vtctldatapb.ApplySchemaOwnershipRequest{Keyspace: "commerce", SchemaOwnership: ownership},
+			expected: ownership,
+		},
+		{
+			name:     "empty ownership deletes it",
+			existing: ownership,
+			req:      &vtctldatapb.ApplySchemaOwnershipRequest{Keyspace: "commerce"},
+			expected: &vtctldatapb.SchemaOwnership{},
+		},
+		{
+			name: "missing table ownership",
+			req: &vtctldatapb.ApplySchemaOwnershipRequest{
+				Keyspace: "commerce",
+				SchemaOwnership: &vtctldatapb.SchemaOwnership{
+					Tables: map[string]*vtctldatapb.TableOwnership{"customer": nil},
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "no keyspace",
+			req:       &vtctldatapb.ApplySchemaOwnershipRequest{Keyspace: "customer", SchemaOwnership: ownership},
+			shouldErr: true,
+		},
+		{
+			name:      "topo down",
+			req:       &vtctldatapb.ApplySchemaOwnershipRequest{Keyspace: "commerce", SchemaOwnership: ownership},
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory("zone1")
+			testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{Name: "commerce", Keyspace: &topodatapb.Keyspace{}})
+			if tt.existing != nil {
+				require.NoError(t, ts.SaveSchemaOwnership(ctx, "commerce", tt.existing))
+			}
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			_, err := vtctld.ApplySchemaOwnership(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			got, err := ts.GetSchemaOwnership(ctx, "commerce")
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, got)
+		})
+	}
+}
+
+func TestApplyTableACL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{Name: "testkeyspace", Keyspace: &topodatapb.Keyspace{}})
+
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group1",
+			TableNamesOrPrefixes: []string{"t1", "t2%"},
+			Readers:              []string{"reader"},
+			Writers:              []string{"writer"},
+		}},
+	}
+
+	// A dry run does not save the table ACL.
+	_, err := vtctld.ApplyTableACL(ctx, &vtctldatapb.ApplyTableACLRequest{
+		Keyspace: "testkeyspace",
+		TableAcl: config,
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+	_, err = vtctld.GetTableACL(ctx, &vtctldatapb.GetTableACLRequest{Keyspace: "testkeyspace"})
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+
+	_, err = vtctld.ApplyTableACL(ctx, &vtctldatapb.ApplyTableACLRequest{
+		Keyspace: "testkeyspace",
+		TableAcl: config,
+	})
+	require.NoError(t, err)
+	resp, err := vtctld.GetTableACL(ctx, &vtctldatapb.GetTableACLRequest{Keyspace: "testkeyspace"})
+	require.NoError(t, err)
+	utils.MustMatch(t, config, resp.TableAcl)
+
+	// An invalid table ACL is rejected, and the previous one is kept.
+	_, err = vtctld.ApplyTableACL(ctx, &vtctldatapb.ApplyTableACLRequest{
+		Keyspace: "testkeyspace",
+		TableAcl: &tableaclpb.Config{
+			TableGroups: []*tableaclpb.TableGroupSpec{{
+				Name:                 "group2",
+				TableNamesOrPrefixes: []string{"t1", "t%"},
+			}},
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	resp, err = vtctld.GetTableACL(ctx, &vtctldatapb.GetTableACLRequest{Keyspace: "testkeyspace"})
+	require.NoError(t, err)
+	utils.MustMatch(t, config, resp.TableAcl)
+
+	_, err = vtctld.ApplyTableACL(ctx, &vtctldatapb.ApplyTableACLRequest{
+		Keyspace: "otherkeyspace",
+		TableAcl: config,
+	})
+	assert.Error(t, err)
+
+	_, err = vtctld.DeleteTableACL(ctx, &vtctldatapb.DeleteTableACLRequest{Keyspace: "testkeyspace"})
+	require.NoError(t, err)
+	_, err = vtctld.GetTableACL(ctx, &vtctldatapb.GetTableACLRequest{Keyspace: "testkeyspace"})
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+	_, err = vtctld.DeleteTableACL(ctx, &vtctldatapb.DeleteTableACLRequest{Keyspace: "testkeyspace"})
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+}
+
 func TestApplyVSchema(t *testing.T) {
 	t.Parallel()
 
@@ -483,6 +824,90 @@ func TestApplyVSchema(t *testing.T) {
 	}
 }
 
+func TestApplyVersionedTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.SaveVSchema(ctx, "ks", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"orders":    {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+			"orders_v2": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+		},
+	}))
+	require.NoError(t, ts.SaveRoutingRules(ctx, &vschemapb.RoutingRules{
+		Rules: []*vschemapb.RoutingRule{{FromTable: "t1", ToTables: []string{"ks.orders"}}},
+	}))
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	versioned := &vschemapb.VersionedTable{
+		FromTable:    "orders",
+		CurrentTable: "ks.orders",
+		NextTable:    "ks.orders_v2",
+		NextPercent:  10,
+	}
+	_, err := vtctld.ApplyVersionedTable(ctx, &vtctldatapb.ApplyVersionedTableRequest{VersionedTable: versioned})
+	require.NoError(t, err)
+
+	// Ramping the next version up replaces the versioned table.
+	versioned = proto.Clone(versioned).(*vschemapb.VersionedTable)
+	versioned.NextPercent = 50
+	resp, err := vtctld.ApplyVersionedTable(ctx, &vtctldatapb.ApplyVersionedTableRequest{VersionedTable: versioned})
+	require.NoError(t, err)
+	utils.MustMatch(t, []*vschemapb.VersionedTable{versioned}, resp.RoutingRules.VersionedTables)
+	assert.Len(t, resp.RoutingRules.Rules, 1)
+
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "zone1")
+	require.NoError(t, err)
+	utils.MustMatch(t, []*vschemapb.VersionedTable{versioned}, srvVSchema.RoutingRules.VersionedTables)
+
+	tests := []struct {
+		name      string
+		versioned *vschemapb.VersionedTable
+		err       string
+	}{
+		{
+			name:      "no from table",
+			versioned: &vschemapb.VersionedTable{CurrentTable: "ks.orders", NextTable: "ks.orders_v2"},
+			err:       "from_table of the versioned table must be set",
+		},
+		{
+			name:      "percent above 100",
+			versioned: &vschemapb.VersionedTable{FromTable: "orders", CurrentTable: "ks.orders", NextTable: "ks.orders_v2", NextPercent: 101},
+			err:       "next_percent must be between 0 and 100",
+		},
+		{
+			name:      "unqualified table",
+			versioned: &vschemapb.VersionedTable{FromTable: "orders", CurrentTable: "ks.orders", NextTable: "orders_v2"},
+			err:       "table orders_v2 must be qualified by its keyspace",
+		},
+		{
+			name:      "unknown table",
+			versioned: &vschemapb.VersionedTable{FromTable: "orders", CurrentTable: "ks.orders", NextTable: "ks.orders_v3"},
+			err:       "table orders_v3 is not in the vschema of keyspace ks",
+		},
+		{
+			name:      "routed table",
+			versioned: &vschemapb.VersionedTable{FromTable: "t1", CurrentTable: "ks.orders", NextTable: "ks.orders_v2"},
+			err:       "table t1 is already routed by a routing rule",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := vtctld.ApplyVersionedTable(ctx, &vtctldatapb.ApplyVersionedTableRequest{VersionedTable: tt.versioned})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
 func TestChangeTabletType(t *testing.T) {
 	t.Parallel()
 
@@ -713,43 +1138,217 @@ func TestChangeTabletType(t *testing.T) {
 	})
 }
 
-func TestCreateKeyspace(t *testing.T) {
-	t.Parallel()
+func TestCollectDiagnostics(t *testing.T) {
+	// Not parallel, since it uploads to the shared test backup storage.
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
 
-	cells := []string{"zone1", "zone2", "zone3"}
-	tests := []struct {
-		name               string
-		topo               map[string]*topodatapb.Keyspace
-		vschemas           map[string]*vschemapb.Keyspace
-		req                *vtctldatapb.CreateKeyspaceRequest
-		expected           *vtctldatapb.CreateKeyspaceResponse
-		shouldErr          bool
-		vschemaShouldExist bool
-		expectedVSchema    *vschemapb.Keyspace
-	}{
-		{
-			name: "normal keyspace",
-			topo: nil,
-			req: &vtctldatapb.CreateKeyspaceRequest{
-				Name: "testkeyspace",
-				Type: topodatapb.KeyspaceType_NORMAL,
-			},
-			expected: &vtctldatapb.CreateKeyspaceResponse{
-				Keyspace: &vtctldatapb.Keyspace{
-					Name: "testkeyspace",
-					Keyspace: &topodatapb.Keyspace{
-						KeyspaceType: topodatapb.KeyspaceType_NORMAL,
-					},
-				},
-			},
-			vschemaShouldExist: true,
-			expectedVSchema: &vschemapb.Keyspace{
-				Sharded: false,
-			},
-			shouldErr: false,
-		},
-		{
-			name: "snapshot keyspace",
+	mux := http.NewServeMux()
+	for _, path := range []string{"/debug/pprof/cmdline", "/debug/env", "/debug/vars", "/debug/pprof/goroutine", "/debug/pprof/heap", "/debug/pprof/profile"} {
+		path := path
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "%s?%s", path, r.URL.RawQuery)
+		})
+	}
+	mux.HandleFunc("/debug/querylog", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "select 1\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	testutil.AddTablets(ctx, t, ts, nil, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Hostname: host,
+		PortMap:  map[string]int32{"vt": int32(port)},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_REPLICA,
+	})
+
+	// readArchive returns the contents of the files of the archive, by
+	// their name without their directory.
+	readArchive := func(t *testing.T, archive []byte) (string, map[string]string) {
+		gzr, err := gzip.NewReader(bytes.NewReader(archive))
+		require.NoError(t, err)
+		tr := tar.NewReader(gzr)
+
+		var dir string
+		files := map[string]string{}
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			data, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			dir = path.Dir(hdr.Name)
+			files[path.Base(hdr.Name)] = string(data)
+		}
+		return dir, files
+	}
+
+	t.Run("tablet", func(t *testing.T) {
+		resp, err := vtctld.CollectDiagnostics(ctx, &vtctldatapb.CollectDiagnosticsRequest{
+			TabletAlias:       &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+			CpuProfileSeconds: 5,
+			QueryLogSeconds:   1,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.UploadLocation)
+
+		dir, files := readArchive(t, resp.Archive)
+		assert.True(t, strings.HasSuffix(dir, ".zone1-0000000100"), dir)
+		assert.Equal(t, map[string]string{
+			"cmdline.txt":   "/debug/pprof/cmdline?",
+			"env.html":      "/debug/env?",
+			"vars.json":     "/debug/vars?",
+			"goroutine.txt": "/debug/pprof/goroutine?debug=2",
+			"heap.pprof":    "/debug/pprof/heap?",
+			"cpu.pprof":     "/debug/pprof/profile?seconds=5",
+			"querylog.txt":  "select 1\n",
+		}, files)
+	})
+
+	t.Run("vtgate with upload", func(t *testing.T) {
+		require.NoError(t, ts.RegisterVtgate(ctx, "zone1", server.Listener.Addr().String()))
+		defer ts.UnregisterVtgate(ctx, "zone1", server.Listener.Addr().String())
+		resp, err := vtctld.CollectDiagnostics(ctx, &vtctldatapb.CollectDiagnosticsRequest{
+			VtgateAddress: server.Listener.Addr().String(),
+			Upload:        true,
+		})
+		require.NoError(t, err)
+
+		dir, files := readArchive(t, resp.Archive)
+		assert.True(t, strings.HasSuffix(dir, ".vtgate-"+host+"-"+portStr), dir)
+		assert.NotContains(t, files, "cpu.pprof")
+		assert.NotContains(t, files, "querylog.txt")
+		assert.Len(t, files, 5)
+
+		target := "diagnostics/vtgate-" + host + "-" + portStr
+		assert.Equal(t, path.Join(target, dir), resp.UploadLocation)
+		assert.Equal(t, []string{dir}, testutil.BackupStorage.Backups[target])
+		assert.Equal(t, resp.Archive, testutil.BackupStorage.Files[path.Join(resp.UploadLocation, "archive")])
+	})
+
+	t.Run("max size", func(t *testing.T) {
+		defer func(maxSize int) {
+			*diagnosticsMaxSize = maxSize
+		}(*diagnosticsMaxSize)
+		*diagnosticsMaxSize = 50
+		_, err := vtctld.CollectDiagnostics(ctx, &vtctldatapb.CollectDiagnosticsRequest{
+			TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the diagnostics are larger than -diagnostics_max_size (50 bytes)")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *vtctldatapb.CollectDiagnosticsRequest
+		}{
+			{
+				name: "no target",
+				req:  &vtctldatapb.CollectDiagnosticsRequest{},
+			},
+			{
+				name: "tablet and vtgate",
+				req: &vtctldatapb.CollectDiagnosticsRequest{
+					TabletAlias:   &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+					VtgateAddress: server.Listener.Addr().String(),
+				},
+			},
+			{
+				name: "negative duration",
+				req: &vtctldatapb.CollectDiagnosticsRequest{
+					TabletAlias:       &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+					CpuProfileSeconds: -1,
+				},
+			},
+			{
+				name: "tablet without web address",
+				req: &vtctldatapb.CollectDiagnosticsRequest{
+					TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+				},
+			},
+			{
+				name: "tablet not found",
+				req: &vtctldatapb.CollectDiagnosticsRequest{
+					TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 102},
+				},
+			},
+			{
+				name: "vtgate not registered",
+				req: &vtctldatapb.CollectDiagnosticsRequest{
+					VtgateAddress: server.Listener.Addr().String(),
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := vtctld.CollectDiagnostics(ctx, tt.req)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestCreateKeyspace(t *testing.T) {
+	t.Parallel()
+
+	cells := []string{"zone1", "zone2", "zone3"}
+	tests := []struct {
+		name               string
+		topo               map[string]*topodatapb.Keyspace
+		vschemas           map[string]*vschemapb.Keyspace
+		req                *vtctldatapb.CreateKeyspaceRequest
+		expected           *vtctldatapb.CreateKeyspaceResponse
+		shouldErr          bool
+		vschemaShouldExist bool
+		expectedVSchema    *vschemapb.Keyspace
+	}{
+		{
+			name: "normal keyspace",
+			topo: nil,
+			req: &vtctldatapb.CreateKeyspaceRequest{
+				Name: "testkeyspace",
+				Type: topodatapb.KeyspaceType_NORMAL,
+			},
+			expected: &vtctldatapb.CreateKeyspaceResponse{
+				Keyspace: &vtctldatapb.Keyspace{
+					Name: "testkeyspace",
+					Keyspace: &topodatapb.Keyspace{
+						KeyspaceType: topodatapb.KeyspaceType_NORMAL,
+					},
+				},
+			},
+			vschemaShouldExist: true,
+			expectedVSchema: &vschemapb.Keyspace{
+				Sharded: false,
+			},
+			shouldErr: false,
+		},
+		{
+			name: "snapshot keyspace",
 			topo: map[string]*topodatapb.Keyspace{
 				"testkeyspace": {
 					KeyspaceType: topodatapb.KeyspaceType_NORMAL,
@@ -1555,6 +2154,60 @@ func TestDeleteKeyspace(t *testing.T) {
 	}
 }
 
+func TestDeleteQueryRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{Keyspace: "testkeyspace", Name: "-"})
+
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	for _, rule := range []string{`{"Name": "r1"}`, `{"Name": "r2"}`} {
+		_, err := vtctld.ApplyQueryRule(ctx, &vtctldatapb.ApplyQueryRuleRequest{
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Rule:     rule,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := vtctld.DeleteQueryRule(ctx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace:        "testkeyspace",
+		Shard:           "-",
+		Name:            "r1",
+		ExpectedVersion: 1,
+	})
+	assert.Error(t, err, "stale version")
+
+	resp, err := vtctld.DeleteQueryRule(ctx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Name:     "r1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.QueryRules.Version)
+	assert.Equal(t, `[{"Description":"","Name":"r2","Action":"FAIL"}]`, resp.QueryRules.ShardRules["-"])
+
+	_, err = vtctld.DeleteQueryRule(ctx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Name:     "r1",
+	})
+	assert.Error(t, err, "rule already deleted")
+
+	// Deleting the last rule of a scope removes it.
+	resp, err = vtctld.DeleteQueryRule(ctx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Name:     "r2",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.QueryRules.ShardRules)
+}
+
 func TestDeleteShards(t *testing.T) {
 	t.Parallel()
 
@@ -2587,6 +3240,34 @@ func TestDeleteTablets(t *testing.T) {
 	}
 }
 
+func TestDeleteVersionedTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	rules := []*vschemapb.RoutingRule{{FromTable: "t1", ToTables: []string{"ks.t2"}}}
+	require.NoError(t, ts.SaveRoutingRules(ctx, &vschemapb.RoutingRules{
+		Rules: rules,
+		VersionedTables: []*vschemapb.VersionedTable{{
+			FromTable:    "orders",
+			CurrentTable: "ks.orders",
+			NextTable:    "ks.orders_v2",
+		}},
+	}))
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	_, err := vtctld.DeleteVersionedTable(ctx, &vtctldatapb.DeleteVersionedTableRequest{FromTable: "orders", SkipRebuild: true})
+	require.NoError(t, err)
+	rr, err := ts.GetRoutingRules(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, &vschemapb.RoutingRules{Rules: rules}, rr)
+
+	_, err = vtctld.DeleteVersionedTable(ctx, &vtctldatapb.DeleteVersionedTableRequest{FromTable: "orders", SkipRebuild: true})
+	assert.True(t, vterrors.Code(err) == vtrpcpb.Code_NOT_FOUND, "expected NOT_FOUND, got %v", err)
+}
+
 func TestEmergencyReparentShard(t *testing.T) {
 	t.Parallel()
 
@@ -2790,6 +3471,178 @@ func TestEmergencyReparentShard(t *testing.T) {
 	}
 }
 
+func TestEvacuateCell(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+			PrimaryTermStartTime: &vttime.Time{
+				Seconds: 100,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "-80",
+		},
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone2",
+				Uid:  200,
+			},
+			Type:     topodatapb.TabletType_REPLICA,
+			Keyspace: "testkeyspace",
+			Shard:    "-80",
+		},
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone2",
+				Uid:  300,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+			PrimaryTermStartTime: &vttime.Time{
+				Seconds: 100,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "80-",
+		},
+		{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  400,
+			},
+			Type:     topodatapb.TabletType_REPLICA,
+			Keyspace: "testkeyspace",
+			Shard:    "80-",
+		},
+	}
+	tmc := &testutil.TabletManagerClient{
+		ReplicationStatusResults: map[string]struct {
+			Position *replicationdatapb.Status
+			Error    error
+		}{
+			"zone2-0000000200": {
+				Position: &replicationdatapb.Status{
+					Position: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5",
+				},
+			},
+		},
+		DemotePrimaryResults: map[string]struct {
+			Status *replicationdatapb.PrimaryStatus
+			Error  error
+		}{
+			"zone1-0000000100": {
+				Status: &replicationdatapb.PrimaryStatus{
+					Position: "primary-demotion position",
+				},
+			},
+		},
+		PrimaryPositionResults: map[string]struct {
+			Position string
+			Error    error
+		}{
+			"zone1-0000000100": {
+				Position: "doesn't matter",
+			},
+		},
+		PopulateReparentJournalResults: map[string]error{
+			"zone2-0000000200": nil,
+		},
+		PromoteReplicaResults: map[string]struct {
+			Result string
+			Error  error
+		}{
+			"zone2-0000000200": {
+				Result: "promotion position",
+			},
+		},
+		SetReplicationSourceResults: map[string]error{
+			"zone2-0000000200": nil,
+			"zone1-0000000100": nil,
+		},
+		WaitForPositionResults: map[string]map[string]error{
+			"zone2-0000000200": {
+				"primary-demotion position": nil,
+			},
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		ts := memorytopo.NewServer("zone1", "zone2")
+		testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+			AlsoSetShardPrimary: true,
+		}, tablets...)
+
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return NewVtctldServer(ts)
+		})
+		resp, err := vtctld.EvacuateCell(ctx, &vtctldatapb.EvacuateCellRequest{
+			Keyspace:            "testkeyspace",
+			Cell:                "zone1",
+			WaitReplicasTimeout: protoutil.DurationToProto(time.Millisecond * 10),
+		})
+		require.NoError(t, err)
+		testutil.AssertLogutilEventsOccurred(t, resp, "expected events to occur during EvacuateCell")
+
+		resp.Events = nil
+		utils.MustMatch(t, &vtctldatapb.EvacuateCellResponse{
+			Keyspace: "testkeyspace",
+			Shards: []*vtctldatapb.EvacuateCellResponse_ShardResult{
+				{
+					Shard: "-80",
+					PromotedPrimary: &topodatapb.TabletAlias{
+						Cell: "zone2",
+						Uid:  200,
+					},
+				},
+				{
+					Shard:   "80-",
+					Skipped: true,
+				},
+			},
+		}, resp)
+	})
+
+	t.Run("no candidate", func(t *testing.T) {
+		t.Parallel()
+
+		ts := memorytopo.NewServer("zone1", "zone2")
+		testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+			AlsoSetShardPrimary: true,
+		}, tablets...)
+
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return NewVtctldServer(ts)
+		})
+		_, err := vtctld.EvacuateCell(ctx, &vtctldatapb.EvacuateCellRequest{
+			Keyspace:    "testkeyspace",
+			Cell:        "zone1",
+			TargetCells: []string{"zone3"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot find a tablet to reparent to outside of cell zone1")
+	})
+
+	t.Run("invalid target cells", func(t *testing.T) {
+		t.Parallel()
+
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, memorytopo.NewServer("zone1"), tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return NewVtctldServer(ts)
+		})
+		_, err := vtctld.EvacuateCell(ctx, &vtctldatapb.EvacuateCellRequest{
+			Keyspace:    "testkeyspace",
+			Cell:        "zone1",
+			TargetCells: []string{"zone1"},
+		})
+		assert.Error(t, err)
+	})
+}
+
 func TestExecuteHook(t *testing.T) {
 	t.Parallel()
 
@@ -3126,24 +3979,142 @@ func TestGetBackups(t *testing.T) {
 	})
 }
 
-func TestGetKeyspace(t *testing.T) {
+func TestGetDeadLetters(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	ts := memorytopo.NewServer("cell1")
-	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
-		return NewVtctldServer(ts)
-	})
-
-	expected := &vtctldatapb.GetKeyspaceResponse{
-		Keyspace: &vtctldatapb.Keyspace{
-			Name: "testkeyspace",
-			Keyspace: &topodatapb.Keyspace{
-				ShardingColumnName: "col1",
-			},
-		},
-	}
-	testutil.AddKeyspace(ctx, t, ts, expected.Keyspace)
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks1",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+
+	result := &querypb.QueryResult{
+		Fields: []*querypb.Field{{Name: "epoch", Type: querypb.Type_INT64}, {Name: "id", Type: querypb.Type_INT64}},
+		Rows:   []*querypb.Row{{Lengths: []int64{1, 1}, Values: []byte("51")}},
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.GetDeadLettersRequest
+		expected  *vtctldatapb.GetDeadLettersResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tmc: testutil.TabletManagerClient{
+				GetDeadLettersResults: map[string]struct {
+					Result *querypb.QueryResult
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Result: result,
+					},
+					"zone1-0000000200": {
+						// Shards without dead letters are omitted.
+						Result: &querypb.QueryResult{Fields: result.Fields},
+					},
+				},
+			},
+			req: &vtctldatapb.GetDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+			},
+			expected: &vtctldatapb.GetDeadLettersResponse{
+				ResultsByShard: map[string]*querypb.QueryResult{
+					"-80": result,
+				},
+			},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				GetDeadLettersResults: map[string]struct {
+					Result *querypb.QueryResult
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Result: result,
+					},
+					"zone1-0000000200": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.GetDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no table",
+			req: &vtctldatapb.GetDeadLettersRequest{
+				Keyspace: "ks1",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetDeadLetters(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetKeyspace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	expected := &vtctldatapb.GetKeyspaceResponse{
+		Keyspace: &vtctldatapb.Keyspace{
+			Name: "testkeyspace",
+			Keyspace: &topodatapb.Keyspace{
+				ShardingColumnName: "col1",
+			},
+		},
+	}
+	testutil.AddKeyspace(ctx, t, ts, expected.Keyspace)
 
 	ks, err := vtctld.GetKeyspace(ctx, &vtctldatapb.GetKeyspaceRequest{Keyspace: expected.Keyspace.Name})
 	assert.NoError(t, err)
@@ -3300,6 +4271,124 @@ func TestGetKeyspaces(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetMaskingPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		topoDown  bool
+		policyIn  *binlogdatapb.MaskingPolicy
+		expected  *binlogdatapb.MaskingPolicy
+		shouldErr bool
+	}{
+		{
+			name: "success",
+			policyIn: &binlogdatapb.MaskingPolicy{
+				Keyspaces: []string{"staging"},
+				Rules:     []*binlogdatapb.MaskingRule{{Table: "customer", Column: "email"}},
+			},
+			expected: &binlogdatapb.MaskingPolicy{
+				Keyspaces: []string{"staging"},
+				Rules:     []*binlogdatapb.MaskingRule{{Table: "customer", Column: "email"}},
+			},
+		},
+		{
+			name:     "no masking policy",
+			expected: &binlogdatapb.MaskingPolicy{},
+		},
+		{
+			name:      "topo error",
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory()
+			if tt.policyIn != nil {
+				require.NoError(t, ts.SaveMaskingPolicy(ctx, tt.policyIn))
+			}
+
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetMaskingPolicy(ctx, &vtctldatapb.GetMaskingPolicyRequest{})
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp.MaskingPolicy)
+		})
+	}
+}
+
+func TestGetOnlineDDLSchedulerConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		topoDown  bool
+		configIn  *topodatapb.OnlineDDLSchedulerConfig
+		expected  *topodatapb.OnlineDDLSchedulerConfig
+		shouldErr bool
+	}{
+		{
+			name:     "success",
+			configIn: &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 4},
+			expected: &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 4},
+		},
+		{
+			name:     "no config",
+			expected: &topodatapb.OnlineDDLSchedulerConfig{},
+		},
+		{
+			name:      "topo error",
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory()
+			if tt.configIn != nil {
+				require.NoError(t, ts.SaveOnlineDDLSchedulerConfig(ctx, "commerce", tt.configIn))
+			}
+
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetOnlineDDLSchedulerConfig(ctx, &vtctldatapb.GetOnlineDDLSchedulerConfigRequest{Keyspace: "commerce"})
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp.Config)
+		})
+	}
+}
+
 func TestGetRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -3390,8 +4479,16 @@ func TestGetSchema(t *testing.T) {
 		Uid:  100,
 	}
 	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
-		Alias: validAlias,
+		Alias:    validAlias,
+		Keyspace: "testkeyspace",
 	}, nil)
+	err := ts.SaveSchemaOwnership(ctx, "testkeyspace", &vtctldatapb.SchemaOwnership{
+		Tables: map[string]*vtctldatapb.TableOwnership{
+			"t1": {Owner: "payments", ApprovalPolicy: "payments-dba"},
+			"t2": {Owner: "payments"},
+		},
+	})
+	require.NoError(t, err)
 	otherAlias := &topodatapb.TabletAlias{
 		Cell: "zone1",
 		Uid:  101,
@@ -3466,6 +4563,9 @@ func TestGetSchema(t *testing.T) {
 						},
 					},
 				},
+				TableOwnership: map[string]*vtctldatapb.TableOwnership{
+					"t1": {Owner: "payments", ApprovalPolicy: "payments-dba"},
+				},
 			},
 			shouldErr: false,
 		},
@@ -3484,6 +4584,9 @@ func TestGetSchema(t *testing.T) {
 						},
 					},
 				},
+				TableOwnership: map[string]*vtctldatapb.TableOwnership{
+					"t1": {Owner: "payments", ApprovalPolicy: "payments-dba"},
+				},
 			},
 			shouldErr: false,
 		},
@@ -3505,6 +4608,9 @@ func TestGetSchema(t *testing.T) {
 						},
 					},
 				},
+				TableOwnership: map[string]*vtctldatapb.TableOwnership{
+					"t1": {Owner: "payments", ApprovalPolicy: "payments-dba"},
+				},
 			},
 			shouldErr: false,
 		},
@@ -3524,6 +4630,9 @@ func TestGetSchema(t *testing.T) {
 						},
 					},
 				},
+				TableOwnership: map[string]*vtctldatapb.TableOwnership{
+					"t1": {Owner: "payments", ApprovalPolicy: "payments-dba"},
+				},
 			},
 			shouldErr: false,
 		},
@@ -3565,35 +4674,393 @@ func TestGetSchema(t *testing.T) {
 	}
 }
 
-func TestGetShard(t *testing.T) {
+func TestGetSchemaMigrationProgress(t *testing.T) {
 	t.Parallel()
 
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks1",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+
+	fields := sqltypes.MakeTestFields(
+		"migration_uuid|mysql_table|migration_status|stage|progress|eta_seconds|rows_copied|table_rows|rows_copied_per_second|vreplication_lag_seconds",
+		"varchar|varchar|varchar|varchar|float32|int64|uint64|int64|float32|int64",
+	)
+	uuid := "a1dac193_4b86_11ec_a827_0a43f95f28a3"
+
 	tests := []struct {
 		name      string
-		topo      []*vtctldatapb.Shard
-		topoError error
-		req       *vtctldatapb.GetShardRequest
-		expected  *vtctldatapb.GetShardResponse
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.GetSchemaMigrationProgressRequest
+		expected  *vtctldatapb.GetSchemaMigrationProgressResponse
 		shouldErr bool
 	}{
 		{
-			name: "success",
-			topo: []*vtctldatapb.Shard{
-				{
-					Keyspace: "testkeyspace",
-					Name:     "-",
+			name: "ok",
+			tmc: testutil.TabletManagerClient{
+				VExecResults: map[string]struct {
+					Result *querypb.QueryResult
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+							uuid+"|t1|running|copying rows|40|90|400|1000|6.5|0",
+						)),
+					},
+					"zone1-0000000200": {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+							uuid+"|t1|running|waiting for cut-over|100|0|1200|1200|10|1",
+						)),
+					},
 				},
 			},
-			topoError: nil,
-			req: &vtctldatapb.GetShardRequest{
-				Keyspace:  "testkeyspace",
-				ShardName: "-",
+			req: &vtctldatapb.GetSchemaMigrationProgressRequest{
+				Keyspace: "ks1",
+				Uuid:     uuid,
 			},
-			expected: &vtctldatapb.GetShardResponse{
-				Shard: &vtctldatapb.Shard{
-					Keyspace: "testkeyspace",
-					Name:     "-",
-					Shard: &topodatapb.Shard{
+			expected: &vtctldatapb.GetSchemaMigrationProgressResponse{
+				Migrations: []*vtctldatapb.SchemaMigrationProgress{
+					{
+						Shard:               "-80",
+						TabletAlias:         tablets[0].Alias,
+						Uuid:                uuid,
+						Table:               "t1",
+						Status:              "running",
+						Stage:               "copying rows",
+						Progress:            40,
+						EtaSeconds:          90,
+						RowsCopied:          400,
+						TableRows:           1000,
+						RowsCopiedPerSecond: 6.5,
+					},
+					{
+						Shard:                  "80-",
+						TabletAlias:            tablets[1].Alias,
+						Uuid:                   uuid,
+						Table:                  "t1",
+						Status:                 "running",
+						Stage:                  "waiting for cut-over",
+						Progress:               100,
+						RowsCopied:             1200,
+						TableRows:              1200,
+						RowsCopiedPerSecond:    10,
+						VreplicationLagSeconds: 1,
+					},
+				},
+			},
+		},
+		{
+			name: "no running migrations",
+			tmc: testutil.TabletManagerClient{
+				VExecResults: map[string]struct {
+					Result *querypb.QueryResult
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields)),
+					},
+					"zone1-0000000200": {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields)),
+					},
+				},
+			},
+			req: &vtctldatapb.GetSchemaMigrationProgressRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.GetSchemaMigrationProgressResponse{},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				VExecResults: map[string]struct {
+					Result *querypb.QueryResult
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields)),
+					},
+					"zone1-0000000200": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.GetSchemaMigrationProgressRequest{
+				Keyspace: "ks1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid uuid",
+			req: &vtctldatapb.GetSchemaMigrationProgressRequest{
+				Keyspace: "ks1",
+				Uuid:     "not-a-uuid",
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "no keyspace",
+			req:       &vtctldatapb.GetSchemaMigrationProgressRequest{},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetSchemaMigrationProgress(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetSchemaOwnership(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		topoDown    bool
+		ownershipIn *vtctldatapb.SchemaOwnership
+		expected    *vtctldatapb.SchemaOwnership
+		shouldErr   bool
+	}{
+		{
+			name: "success",
+			ownershipIn: &vtctldatapb.SchemaOwnership{
+				Tables: map[string]*vtctldatapb.TableOwnership{"customer": {Owner: "payments"}},
+			},
+			expected: &vtctldatapb.SchemaOwnership{
+				Tables: map[string]*vtctldatapb.TableOwnership{"customer": {Owner: "payments"}},
+			},
+		},
+		{
+			name:     "no schema ownership",
+			expected: &vtctldatapb.SchemaOwnership{},
+		},
+		{
+			name:      "topo error",
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory()
+			if tt.ownershipIn != nil {
+				require.NoError(t, ts.SaveSchemaOwnership(ctx, "commerce", tt.ownershipIn))
+			}
+
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetSchemaOwnership(ctx, &vtctldatapb.GetSchemaOwnershipRequest{Keyspace: "commerce"})
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp.SchemaOwnership)
+		})
+	}
+}
+
+func TestGetSequences(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks2",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks2",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  300,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+
+	sequence := &tabletmanagerdatapb.SequenceState{
+		Name:     "seq",
+		NextId:   1000,
+		Cache:    100,
+		NextVal:  950,
+		LastVal:  1000,
+		MaxValue: 10000,
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.GetSequencesRequest
+		expected  *vtctldatapb.GetSequencesResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tmc: testutil.TabletManagerClient{
+				GetSequencesResults: map[string]struct {
+					Sequences []*tabletmanagerdatapb.SequenceState
+					Error     error
+				}{
+					"zone1-0000000100": {
+						Sequences: []*tabletmanagerdatapb.SequenceState{sequence},
+					},
+				},
+			},
+			req: &vtctldatapb.GetSequencesRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.GetSequencesResponse{
+				Sequences: []*tabletmanagerdatapb.SequenceState{sequence},
+			},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				GetSequencesResults: map[string]struct {
+					Sequences []*tabletmanagerdatapb.SequenceState
+					Error     error
+				}{
+					"zone1-0000000100": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.GetSequencesRequest{
+				Keyspace: "ks1",
+				Tables:   []string{"seq"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "sharded keyspace",
+			req: &vtctldatapb.GetSequencesRequest{
+				Keyspace: "ks2",
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "no keyspace",
+			req:       &vtctldatapb.GetSequencesRequest{},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetSequences(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetShard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		topo      []*vtctldatapb.Shard
+		topoError error
+		req       *vtctldatapb.GetShardRequest
+		expected  *vtctldatapb.GetShardResponse
+		shouldErr bool
+	}{
+		{
+			name: "success",
+			topo: []*vtctldatapb.Shard{
+				{
+					Keyspace: "testkeyspace",
+					Name:     "-",
+				},
+			},
+			topoError: nil,
+			req: &vtctldatapb.GetShardRequest{
+				Keyspace:  "testkeyspace",
+				ShardName: "-",
+			},
+			expected: &vtctldatapb.GetShardResponse{
+				Shard: &vtctldatapb.Shard{
+					Keyspace: "testkeyspace",
+					Name:     "-",
+					Shard: &topodatapb.Shard{
 						KeyRange:         &topodatapb.KeyRange{},
 						IsPrimaryServing: true,
 					},
@@ -4239,21 +5706,240 @@ func TestGetSrvVSchemas(t *testing.T) {
 	}
 }
 
-func TestGetTablet(t *testing.T) {
+func TestGetStorageUsage(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	ts := memorytopo.NewServer("cell1")
-	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
-		return NewVtctldServer(ts)
-	})
-
-	tablet := &topodatapb.Tablet{
-		Alias: &topodatapb.TabletAlias{
-			Cell: "cell1",
-			Uid:  100,
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
 		},
-		Hostname: "localhost",
+		{
+			Keyspace: "ks1",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+
+	samples := func(usedBytes ...uint64) []*tabletmanagerdatapb.StorageUsageSample {
+		samples := make([]*tabletmanagerdatapb.StorageUsageSample, len(usedBytes))
+		for i, used := range usedBytes {
+			samples[i] = &tabletmanagerdatapb.StorageUsageSample{
+				Time:      time.Unix(0, 0).Add(time.Duration(i) * 24 * time.Hour).UnixNano(),
+				UsedBytes: used,
+			}
+		}
+		return samples
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.GetStorageUsageRequest
+		expected  *vtctldatapb.GetStorageUsageResponse
+		forecast  time.Duration
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tmc: testutil.TabletManagerClient{
+				GetStorageUsageResults: map[string]struct {
+					Response *tabletmanagerdatapb.GetStorageUsageResponse
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Response: &tabletmanagerdatapb.GetStorageUsageResponse{
+							Tables: []*tabletmanagerdatapb.TableStorageUsage{
+								{Name: "t1", DataLength: 2000, IndexLength: 1000},
+							},
+							Samples:        samples(1000, 2000, 3000),
+							DiskTotalBytes: 100000,
+							DiskFreeBytes:  10000,
+						},
+					},
+					"zone1-0000000200": {
+						// No disk size when the tablet does not manage mysqld.
+						Response: &tabletmanagerdatapb.GetStorageUsageResponse{
+							Tables: []*tabletmanagerdatapb.TableStorageUsage{
+								{Name: "t1", DataLength: 1000},
+								{Name: "t2", IndexLength: 500},
+							},
+							Samples: samples(500, 1000, 1500),
+						},
+					},
+				},
+			},
+			req: &vtctldatapb.GetStorageUsageRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.GetStorageUsageResponse{
+				Shards: []*vtctldatapb.ShardStorageUsage{
+					{
+						Shard:       "-80",
+						TabletAlias: tablets[0].Alias,
+						Tables: []*tabletmanagerdatapb.TableStorageUsage{
+							{Name: "t1", DataLength: 2000, IndexLength: 1000},
+						},
+						UsedBytes:         3000,
+						DiskTotalBytes:    100000,
+						DiskFreeBytes:     10000,
+						GrowthBytesPerDay: 1000,
+					},
+					{
+						Shard:       "80-",
+						TabletAlias: tablets[1].Alias,
+						Tables: []*tabletmanagerdatapb.TableStorageUsage{
+							{Name: "t1", DataLength: 1000},
+							{Name: "t2", IndexLength: 500},
+						},
+						UsedBytes:         1500,
+						GrowthBytesPerDay: 500,
+					},
+				},
+				UsedBytes:         4500,
+				GrowthBytesPerDay: 1500,
+			},
+			forecast: 10 * 24 * time.Hour,
+		},
+		{
+			name: "no growth",
+			tmc: testutil.TabletManagerClient{
+				GetStorageUsageResults: map[string]struct {
+					Response *tabletmanagerdatapb.GetStorageUsageResponse
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Response: &tabletmanagerdatapb.GetStorageUsageResponse{
+							Samples:        samples(0),
+							DiskTotalBytes: 100000,
+							DiskFreeBytes:  10000,
+						},
+					},
+					"zone1-0000000200": {
+						Response: &tabletmanagerdatapb.GetStorageUsageResponse{
+							Samples:        samples(2000, 1000),
+							DiskTotalBytes: 100000,
+							DiskFreeBytes:  10000,
+						},
+					},
+				},
+			},
+			req: &vtctldatapb.GetStorageUsageRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.GetStorageUsageResponse{
+				Shards: []*vtctldatapb.ShardStorageUsage{
+					{
+						Shard:          "-80",
+						TabletAlias:    tablets[0].Alias,
+						DiskTotalBytes: 100000,
+						DiskFreeBytes:  10000,
+					},
+					{
+						Shard:             "80-",
+						TabletAlias:       tablets[1].Alias,
+						DiskTotalBytes:    100000,
+						DiskFreeBytes:     10000,
+						GrowthBytesPerDay: -1000,
+					},
+				},
+				GrowthBytesPerDay: -1000,
+			},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				GetStorageUsageResults: map[string]struct {
+					Response *tabletmanagerdatapb.GetStorageUsageResponse
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Response: &tabletmanagerdatapb.GetStorageUsageResponse{},
+					},
+					"zone1-0000000200": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.GetStorageUsageRequest{
+				Keyspace: "ks1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.GetStorageUsageRequest{
+				Keyspace: "ks2",
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "no keyspace",
+			req:       &vtctldatapb.GetStorageUsageRequest{},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			start := time.Now()
+			resp, err := vtctld.GetStorageUsage(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// The forecast is relative to the time of the call.
+			if tt.forecast > 0 {
+				require.NotNil(t, resp.ForecastFull)
+				assert.WithinDuration(t, start.Add(tt.forecast), protoutil.TimeFromProto(resp.ForecastFull), time.Since(start))
+				utils.MustMatch(t, resp.ForecastFull, resp.Shards[0].ForecastFull)
+				resp.ForecastFull = nil
+				resp.Shards[0].ForecastFull = nil
+			}
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetTablet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  100,
+		},
+		Hostname: "localhost",
 		Keyspace: "testkeyspace",
 		Shard:    "-",
 		Type:     topodatapb.TabletType_REPLICA,
@@ -4280,6 +5966,60 @@ func TestGetTablet(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetTabletStatsSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/vars_snapshot", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"time": {"seconds": "1622541600"}, "values": {"Queries.Select": 10}, "strings": {"TabletStateName": "SERVING"}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	testutil.AddTablets(ctx, t, ts, nil, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Hostname: host,
+		PortMap:  map[string]int32{"vt": int32(port)},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_REPLICA,
+	})
+
+	resp, err := vtctld.GetTabletStatsSnapshot(ctx, &vtctldatapb.GetTabletStatsSnapshotRequest{
+		TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtctldatapb.StatsSnapshot{
+		Time:        &vttime.Time{Seconds: 1622541600},
+		TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Values:      map[string]float64{"Queries.Select": 10},
+		Strings:     map[string]string{"TabletStateName": "SERVING"},
+	}, resp.Snapshot)
+
+	for _, alias := range []*topodatapb.TabletAlias{nil, {Cell: "zone1", Uid: 101}, {Cell: "zone1", Uid: 102}} {
+		_, err := vtctld.GetTabletStatsSnapshot(ctx, &vtctldatapb.GetTabletStatsSnapshotRequest{
+			TabletAlias: alias,
+		})
+		assert.Error(t, err, "tablet %v", topoproto.TabletAliasString(alias))
+	}
+}
+
 func TestGetTablets(t *testing.T) {
 	t.Parallel()
 
@@ -4741,86 +6481,274 @@ func TestGetTablets(t *testing.T) {
 	}
 }
 
-func TestGetVSchema(t *testing.T) {
+func TestGetUnresolvedTransactions(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	ts := memorytopo.NewServer("zone1")
-	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
-		return NewVtctldServer(ts)
-	})
-
-	t.Run("found", func(t *testing.T) {
-		t.Parallel()
-
-		err := ts.SaveVSchema(ctx, "testkeyspace", &vschemapb.Keyspace{
-			Sharded: true,
-			Vindexes: map[string]*vschemapb.Vindex{
-				"v1": {
-					Type: "hash",
-				},
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
 			},
-		})
-		require.NoError(t, err)
-
-		expected := &vtctldatapb.GetVSchemaResponse{
-			VSchema: &vschemapb.Keyspace{
-				Sharded: true,
-				Vindexes: map[string]*vschemapb.Vindex{
-					"v1": {
-						Type: "hash",
-					},
-				},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks1",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
 			},
-		}
-
-		resp, err := vtctld.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{
-			Keyspace: "testkeyspace",
-		})
-		assert.NoError(t, err)
-		utils.MustMatch(t, expected, resp)
-	})
-
-	t.Run("not found", func(t *testing.T) {
-		t.Parallel()
-
-		_, err := vtctld.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{
-			Keyspace: "doesnotexist",
-		})
-		assert.Error(t, err)
-	})
-}
-
-func TestPingTablet(t *testing.T) {
-	t.Parallel()
-
-	ctx := context.Background()
-	ts := memorytopo.NewServer("zone1")
-	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
-		Alias: &topodatapb.TabletAlias{
-			Cell: "zone1",
-			Uid:  100,
+			Type: topodatapb.TabletType_PRIMARY,
 		},
-		Keyspace: "testkeyspace",
-		Shard:    "-",
-	}, nil)
+	}
 
 	tests := []struct {
 		name      string
 		tmc       testutil.TabletManagerClient
-		req       *vtctldatapb.PingTabletRequest
-		expected  *vtctldatapb.PingTabletResponse
+		req       *vtctldatapb.GetUnresolvedTransactionsRequest
+		expected  *vtctldatapb.GetUnresolvedTransactionsResponse
 		shouldErr bool
 	}{
 		{
 			name: "ok",
 			tmc: testutil.TabletManagerClient{
-				PingResults: map[string]error{
-					"zone1-0000000100": nil,
+				GetUnresolvedTransactionsResults: map[string]struct {
+					Transactions []*querypb.TransactionMetadata
+					Prepared     []*tabletmanagerdatapb.PreparedTransaction
+					Error        error
+				}{
+					"zone1-0000000100": {
+						Transactions: []*querypb.TransactionMetadata{
+							{
+								Dtid:        "ks1:-80:1234",
+								State:       querypb.TransactionState_COMMIT,
+								TimeCreated: time.Unix(200, 0).UnixNano(),
+								Participants: []*querypb.Target{
+									{Keyspace: "ks1", Shard: "80-", TabletType: topodatapb.TabletType_PRIMARY},
+								},
+							},
+						},
+						Prepared: []*tabletmanagerdatapb.PreparedTransaction{
+							{
+								Dtid:        "ks1:80-:42",
+								TimeCreated: time.Unix(100, 0).UnixNano(),
+								Queries:     []string{"update t1 set c = 1"},
+							},
+						},
+					},
+					"zone1-0000000200": {
+						Transactions: []*querypb.TransactionMetadata{
+							{
+								Dtid:        "ks1:80-:42",
+								State:       querypb.TransactionState_PREPARE,
+								TimeCreated: time.Unix(100, 0).UnixNano(),
+								Participants: []*querypb.Target{
+									{Keyspace: "ks1", Shard: "-80", TabletType: topodatapb.TabletType_PRIMARY},
+								},
+							},
+						},
+						Prepared: []*tabletmanagerdatapb.PreparedTransaction{
+							{
+								Dtid:        "ks1:-80:1234",
+								TimeCreated: time.Unix(200, 0).UnixNano(),
+								Queries:     []string{"update t1 set c = 2"},
+								Failed:      true,
+							},
+						},
+					},
 				},
 			},
-			req: &vtctldatapb.PingTabletRequest{
-				TabletAlias: &topodatapb.TabletAlias{
+			req: &vtctldatapb.GetUnresolvedTransactionsRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.GetUnresolvedTransactionsResponse{
+				Transactions: []*vtctldatapb.UnresolvedTransaction{
+					{
+						Dtid:        "ks1:80-:42",
+						Keyspace:    "ks1",
+						Shard:       "80-",
+						State:       querypb.TransactionState_PREPARE,
+						TimeCreated: protoutil.TimeToProto(time.Unix(100, 0)),
+						Participants: []*querypb.Target{
+							{Keyspace: "ks1", Shard: "-80", TabletType: topodatapb.TabletType_PRIMARY},
+						},
+						PreparedShards: []string{"ks1/-80"},
+					},
+					{
+						Dtid:        "ks1:-80:1234",
+						Keyspace:    "ks1",
+						Shard:       "-80",
+						State:       querypb.TransactionState_COMMIT,
+						TimeCreated: protoutil.TimeToProto(time.Unix(200, 0)),
+						Participants: []*querypb.Target{
+							{Keyspace: "ks1", Shard: "80-", TabletType: topodatapb.TabletType_PRIMARY},
+						},
+						FailedShards: []string{"ks1/80-"},
+					},
+				},
+			},
+		},
+		{
+			name: "no unresolved transactions",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: map[string]struct {
+					Transactions []*querypb.TransactionMetadata
+					Prepared     []*tabletmanagerdatapb.PreparedTransaction
+					Error        error
+				}{
+					"zone1-0000000100": {},
+					"zone1-0000000200": {},
+				},
+			},
+			req: &vtctldatapb.GetUnresolvedTransactionsRequest{
+				Keyspace: "ks1",
+			},
+			expected: &vtctldatapb.GetUnresolvedTransactionsResponse{
+				Transactions: []*vtctldatapb.UnresolvedTransaction{},
+			},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: map[string]struct {
+					Transactions []*querypb.TransactionMetadata
+					Prepared     []*tabletmanagerdatapb.PreparedTransaction
+					Error        error
+				}{
+					"zone1-0000000100": {},
+					"zone1-0000000200": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.GetUnresolvedTransactionsRequest{
+				Keyspace: "ks1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.GetUnresolvedTransactionsRequest{
+				Keyspace: "ks2",
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "no keyspace",
+			req:       &vtctldatapb.GetUnresolvedTransactionsRequest{},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetUnresolvedTransactions(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetVSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	t.Run("found", func(t *testing.T) {
+		t.Parallel()
+
+		err := ts.SaveVSchema(ctx, "testkeyspace", &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"v1": {
+					Type: "hash",
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		expected := &vtctldatapb.GetVSchemaResponse{
+			VSchema: &vschemapb.Keyspace{
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"v1": {
+						Type: "hash",
+					},
+				},
+			},
+		}
+
+		resp, err := vtctld.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{
+			Keyspace: "testkeyspace",
+		})
+		assert.NoError(t, err)
+		utils.MustMatch(t, expected, resp)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := vtctld.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{
+			Keyspace: "doesnotexist",
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestPingTablet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "zone1",
+			Uid:  100,
+		},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+	}, nil)
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.PingTabletRequest
+		expected  *vtctldatapb.PingTabletResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tmc: testutil.TabletManagerClient{
+				PingResults: map[string]error{
+					"zone1-0000000100": nil,
+				},
+			},
+			req: &vtctldatapb.PingTabletRequest{
+				TabletAlias: &topodatapb.TabletAlias{
 					Cell: "zone1",
 					Uid:  100,
 				},
@@ -5067,6 +6995,153 @@ func TestPlannedReparentShard(t *testing.T) {
 	}
 }
 
+func TestPurgeDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks1",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.PurgeDeadLettersRequest
+		expected  *vtctldatapb.PurgeDeadLettersResponse
+		shouldErr bool
+	}{
+		{
+			name: "ids",
+			tmc: testutil.TabletManagerClient{
+				PurgeDeadLettersResults: map[string]struct {
+					Count int64
+					Error error
+				}{
+					"zone1-0000000100": {
+						Count: 1,
+					},
+					"zone1-0000000200": {
+						Count: 0,
+					},
+				},
+			},
+			req: &vtctldatapb.PurgeDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				Ids:      []string{"1", "2"},
+			},
+			expected: &vtctldatapb.PurgeDeadLettersResponse{
+				Count: 1,
+			},
+		},
+		{
+			name: "all",
+			tmc: testutil.TabletManagerClient{
+				PurgeDeadLettersResults: map[string]struct {
+					Count int64
+					Error error
+				}{
+					"zone1-0000000100": {
+						Count: 3,
+					},
+					"zone1-0000000200": {
+						Count: 2,
+					},
+				},
+			},
+			req: &vtctldatapb.PurgeDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				All:      true,
+			},
+			expected: &vtctldatapb.PurgeDeadLettersResponse{
+				Count: 5,
+			},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				PurgeDeadLettersResults: map[string]struct {
+					Count int64
+					Error error
+				}{
+					"zone1-0000000100": {
+						Count: 3,
+					},
+					"zone1-0000000200": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.PurgeDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				All:      true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "neither ids nor all",
+			req: &vtctldatapb.PurgeDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "both ids and all",
+			req: &vtctldatapb.PurgeDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				Ids:      []string{"1"},
+				All:      true,
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.PurgeDeadLetters(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestRebuildKeyspaceGraph(t *testing.T) {
 	t.Parallel()
 
@@ -6785,108 +8860,592 @@ func TestReparentTablet(t *testing.T) {
 					},
 				},
 			},
-			req: &vtctldatapb.ReparentTabletRequest{
-				Tablet: &topodatapb.TabletAlias{
-					Cell: "zone1",
-					Uid:  100,
+			req: &vtctldatapb.ReparentTabletRequest{
+				Tablet: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
+		{
+			name: "topo is down",
+			tmc: &testutil.TabletManagerClient{
+				SetReplicationSourceResults: map[string]error{
+					"zone1-0000000100": nil,
+				},
+			},
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Type:     topodatapb.TabletType_REPLICA,
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+				},
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone2",
+						Uid:  200,
+					},
+					Type:     topodatapb.TabletType_PRIMARY,
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					PrimaryTermStartTime: &vttime.Time{
+						Seconds: 1000,
+					},
+				},
+			},
+			shards: []*vtctldatapb.Shard{
+				{
+					Keyspace: "testkeyspace",
+					Name:     "-",
+					Shard: &topodatapb.Shard{
+						PrimaryAlias: &topodatapb.TabletAlias{
+							Cell: "zone2",
+							Uid:  200,
+						},
+						PrimaryTermStartTime: &vttime.Time{
+							Seconds: 1000,
+						},
+						IsPrimaryServing: true,
+					},
+				},
+			},
+			topoErr: assert.AnError,
+			req: &vtctldatapb.ReparentTabletRequest{
+				Tablet: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.req == nil {
+				t.Skip("focused on other test cases right now")
+			}
+
+			cells := []string{"zone1", "zone2", "zone3"}
+
+			ctx := context.Background()
+			ts, topofactory := memorytopo.NewServerAndFactory(cells...)
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				SkipShardCreation: true,
+			}, tt.tablets...)
+			testutil.AddShards(ctx, t, ts, tt.shards...)
+
+			if tt.topoErr != nil {
+				topofactory.SetError(tt.topoErr)
+			}
+
+			resp, err := vtctld.ReparentTablet(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestRequeueDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks1",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.RequeueDeadLettersRequest
+		expected  *vtctldatapb.RequeueDeadLettersResponse
+		shouldErr bool
+	}{
+		{
+			name: "ids",
+			tmc: testutil.TabletManagerClient{
+				RequeueDeadLettersResults: map[string]struct {
+					Count int64
+					Error error
+				}{
+					"zone1-0000000100": {
+						Count: 1,
+					},
+					"zone1-0000000200": {
+						Count: 0,
+					},
+				},
+			},
+			req: &vtctldatapb.RequeueDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				Ids:      []string{"1", "2"},
+			},
+			expected: &vtctldatapb.RequeueDeadLettersResponse{
+				Count: 1,
+			},
+		},
+		{
+			name: "all",
+			tmc: testutil.TabletManagerClient{
+				RequeueDeadLettersResults: map[string]struct {
+					Count int64
+					Error error
+				}{
+					"zone1-0000000100": {
+						Count: 3,
+					},
+					"zone1-0000000200": {
+						Count: 2,
+					},
+				},
+			},
+			req: &vtctldatapb.RequeueDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				All:      true,
+			},
+			expected: &vtctldatapb.RequeueDeadLettersResponse{
+				Count: 5,
+			},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				RequeueDeadLettersResults: map[string]struct {
+					Count int64
+					Error error
+				}{
+					"zone1-0000000100": {
+						Count: 3,
+					},
+					"zone1-0000000200": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.RequeueDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				All:      true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "neither ids nor all",
+			req: &vtctldatapb.RequeueDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "both ids and all",
+			req: &vtctldatapb.RequeueDeadLettersRequest{
+				Keyspace: "ks1",
+				Table:    "msg",
+				Ids:      []string{"1"},
+				All:      true,
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.RequeueDeadLetters(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestResetSequence(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks2",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks2",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  300,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+
+	sequence := &tabletmanagerdatapb.SequenceState{
+		Name:     "seq",
+		NextId:   1000,
+		Cache:    100,
+		NextVal:  950,
+		LastVal:  1000,
+		MaxValue: 10000,
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.ResetSequenceRequest
+		expected  *vtctldatapb.ResetSequenceResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tmc: testutil.TabletManagerClient{
+				ResetSequenceResults: map[string]struct {
+					Sequence *tabletmanagerdatapb.SequenceState
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Sequence: sequence,
+					},
+				},
+			},
+			req: &vtctldatapb.ResetSequenceRequest{
+				Keyspace: "ks1",
+				Table:    "seq",
+				NextId:   1000,
+			},
+			expected: &vtctldatapb.ResetSequenceResponse{
+				Sequence: sequence,
+			},
+		},
+		{
+			name: "tmc call failed",
+			tmc: testutil.TabletManagerClient{
+				ResetSequenceResults: map[string]struct {
+					Sequence *tabletmanagerdatapb.SequenceState
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.ResetSequenceRequest{
+				Keyspace: "ks1",
+				Table:    "seq",
+				Cache:    100,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "sharded keyspace",
+			req: &vtctldatapb.ResetSequenceRequest{
+				Keyspace: "ks2",
+				Table:    "seq",
+				NextId:   1000,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "nothing to reset",
+			req: &vtctldatapb.ResetSequenceRequest{
+				Keyspace: "ks1",
+				Table:    "seq",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.ResetSequence(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestResolveTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tablets := []*topodatapb.Tablet{
+		{
+			Keyspace: "ks1",
+			Shard:    "-80",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Keyspace: "ks1",
+			Shard:    "80-",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Type: topodatapb.TabletType_PRIMARY,
+		},
+	}
+	metadata := func(state querypb.TransactionState) map[string]struct {
+		Transactions []*querypb.TransactionMetadata
+		Prepared     []*tabletmanagerdatapb.PreparedTransaction
+		Error        error
+	} {
+		return map[string]struct {
+			Transactions []*querypb.TransactionMetadata
+			Prepared     []*tabletmanagerdatapb.PreparedTransaction
+			Error        error
+		}{
+			"zone1-0000000100": {
+				Transactions: []*querypb.TransactionMetadata{
+					{
+						Dtid:  "ks1:-80:1234",
+						State: state,
+						Participants: []*querypb.Target{
+							{Keyspace: "ks1", Shard: "80-", TabletType: topodatapb.TabletType_PRIMARY},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.ResolveTransactionRequest
+		shouldErr bool
+	}{
+		{
+			name: "commit",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_COMMIT),
+				ResolveTransactionResults: map[string]error{
+					"zone1-0000000200/ks1:-80:1234/commit": nil,
+					"zone1-0000000100/ks1:-80:1234/commit": nil,
+				},
+			},
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid:   "ks1:-80:1234",
+				Commit: true,
+			},
+		},
+		{
+			name: "rollback",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_PREPARE),
+				SetRollbackTransactionResults: map[string]error{
+					"zone1-0000000100/ks1:-80:1234": nil,
+				},
+				ResolveTransactionResults: map[string]error{
+					"zone1-0000000200/ks1:-80:1234/rollback": nil,
+					"zone1-0000000100/ks1:-80:1234/rollback": nil,
 				},
 			},
-			expected:  nil,
-			shouldErr: true,
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid: "ks1:-80:1234",
+			},
 		},
 		{
-			name: "topo is down",
-			tmc: &testutil.TabletManagerClient{
-				SetReplicationSourceResults: map[string]error{
-					"zone1-0000000100": nil,
+			name: "set rollback failed",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_PREPARE),
+				SetRollbackTransactionResults: map[string]error{
+					"zone1-0000000100/ks1:-80:1234": assert.AnError,
+				},
+				ResolveTransactionResults: map[string]error{
+					"zone1-0000000200/ks1:-80:1234/rollback": nil,
+					"zone1-0000000100/ks1:-80:1234/rollback": nil,
 				},
 			},
-			tablets: []*topodatapb.Tablet{
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone1",
-						Uid:  100,
-					},
-					Type:     topodatapb.TabletType_REPLICA,
-					Keyspace: "testkeyspace",
-					Shard:    "-",
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid: "ks1:-80:1234",
+			},
+			shouldErr: true,
+		},
+		{
+			// the rollback was already decided, and is resumed
+			name: "resume rollback",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_ROLLBACK),
+				ResolveTransactionResults: map[string]error{
+					"zone1-0000000200/ks1:-80:1234/rollback": nil,
+					"zone1-0000000100/ks1:-80:1234/rollback": nil,
 				},
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone2",
-						Uid:  200,
-					},
-					Type:     topodatapb.TabletType_PRIMARY,
-					Keyspace: "testkeyspace",
-					Shard:    "-",
-					PrimaryTermStartTime: &vttime.Time{
-						Seconds: 1000,
-					},
+			},
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid: "ks1:-80:1234",
+			},
+		},
+		{
+			name: "rollback a committed transaction",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_COMMIT),
+				ResolveTransactionResults: map[string]error{
+					"zone1-0000000200/ks1:-80:1234/rollback": nil,
+					"zone1-0000000100/ks1:-80:1234/rollback": nil,
 				},
 			},
-			shards: []*vtctldatapb.Shard{
-				{
-					Keyspace: "testkeyspace",
-					Name:     "-",
-					Shard: &topodatapb.Shard{
-						PrimaryAlias: &topodatapb.TabletAlias{
-							Cell: "zone2",
-							Uid:  200,
-						},
-						PrimaryTermStartTime: &vttime.Time{
-							Seconds: 1000,
-						},
-						IsPrimaryServing: true,
-					},
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid: "ks1:-80:1234",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "commit a prepared transaction",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_PREPARE),
+				ResolveTransactionResults: map[string]error{
+					"zone1-0000000200/ks1:-80:1234/commit": nil,
+					"zone1-0000000100/ks1:-80:1234/commit": nil,
 				},
 			},
-			topoErr: assert.AnError,
-			req: &vtctldatapb.ReparentTabletRequest{
-				Tablet: &topodatapb.TabletAlias{
-					Cell: "zone1",
-					Uid:  100,
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid:   "ks1:-80:1234",
+				Commit: true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "participant failed",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_COMMIT),
+				ResolveTransactionResults: map[string]error{
+					"zone1-0000000200/ks1:-80:1234/commit": assert.AnError,
+					"zone1-0000000100/ks1:-80:1234/commit": nil,
 				},
 			},
-			expected:  nil,
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid:   "ks1:-80:1234",
+				Commit: true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "transaction not found",
+			tmc: testutil.TabletManagerClient{
+				GetUnresolvedTransactionsResults: metadata(querypb.TransactionState_COMMIT),
+			},
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid:   "ks1:-80:42",
+				Commit: true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid dtid",
+			req: &vtctldatapb.ResolveTransactionRequest{
+				Dtid: "1234",
+			},
 			shouldErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		tt := tt
-
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			if tt.req == nil {
-				t.Skip("focused on other test cases right now")
-			}
-
-			cells := []string{"zone1", "zone2", "zone3"}
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
 
-			ctx := context.Background()
-			ts, topofactory := memorytopo.NewServerAndFactory(cells...)
-			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
 				return NewVtctldServer(ts)
 			})
-
-			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
-				SkipShardCreation: true,
-			}, tt.tablets...)
-			testutil.AddShards(ctx, t, ts, tt.shards...)
-
-			if tt.topoErr != nil {
-				topofactory.SetError(tt.topoErr)
-			}
-
-			resp, err := vtctld.ReparentTablet(ctx, tt.req)
+			_, err := vtctld.ResolveTransaction(ctx, tt.req)
 			if tt.shouldErr {
 				assert.Error(t, err)
 				return
 			}
 
-			assert.NoError(t, err)
-			utils.MustMatch(t, tt.expected, resp)
+			require.NoError(t, err)
 		})
 	}
 }
@@ -7229,6 +9788,69 @@ func TestSetKeyspaceShardingInfo(t *testing.T) {
 	}
 }
 
+func TestSetOnlineDDLSchedulerConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		existing  *topodatapb.OnlineDDLSchedulerConfig
+		req       *vtctldatapb.SetOnlineDDLSchedulerConfigRequest
+		expected  *topodatapb.OnlineDDLSchedulerConfig
+		shouldErr bool
+	}{
+		{
+			name:     "success",
+			req:      &vtctldatapb.SetOnlineDDLSchedulerConfigRequest{Keyspace: "commerce", Config: &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 4}},
+			expected: &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 4},
+		},
+		{
+			name:     "empty config deletes it",
+			existing: &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 4},
+			req:      &vtctldatapb.SetOnlineDDLSchedulerConfigRequest{Keyspace: "commerce"},
+			expected: &topodatapb.OnlineDDLSchedulerConfig{},
+		},
+		{
+			name:      "negative max concurrent migrations",
+			req:       &vtctldatapb.SetOnlineDDLSchedulerConfigRequest{Keyspace: "commerce", Config: &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: -1}},
+			shouldErr: true,
+		},
+		{
+			name:      "no keyspace",
+			req:       &vtctldatapb.SetOnlineDDLSchedulerConfigRequest{Keyspace: "customer", Config: &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 4}},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{Name: "commerce", Keyspace: &topodatapb.Keyspace{}})
+			if tt.existing != nil {
+				require.NoError(t, ts.SaveOnlineDDLSchedulerConfig(ctx, "commerce", tt.existing))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			_, err := vtctld.SetOnlineDDLSchedulerConfig(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			got, err := ts.GetOnlineDDLSchedulerConfig(ctx, "commerce")
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, got)
+		})
+	}
+}
+
 func TestSetShardIsPrimaryServing(t *testing.T) {
 	t.Parallel()
 
@@ -9037,6 +11659,197 @@ func TestUpdateCellsAlias(t *testing.T) {
 	}
 }
 
+func TestUpdateThrottlerConfig(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	later := protoutil.TimeToProto(now.Add(time.Hour))
+	earlier := protoutil.TimeToProto(now.Add(-time.Hour))
+
+	tests := []struct {
+		name      string
+		config    *topodatapb.ThrottlerConfig
+		req       *vtctldatapb.UpdateThrottlerConfigRequest
+		expected  *topodatapb.ThrottlerConfig
+		shouldErr bool
+	}{
+		{
+			name: "enable",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:         "testkeyspace",
+				Enable:           true,
+				Threshold:        2.5,
+				CustomQuery:      "show global status like 'threads_running'",
+				CustomQuerySet:   true,
+				CheckAsCheckSelf: true,
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				Enabled:          true,
+				Threshold:        2.5,
+				CustomQuery:      "show global status like 'threads_running'",
+				CheckAsCheckSelf: true,
+				ThrottledApps:    map[string]*topodatapb.ThrottledAppRule{},
+			},
+		},
+		{
+			name: "disable and keep the other options",
+			config: &topodatapb.ThrottlerConfig{
+				Enabled:          true,
+				Threshold:        2.5,
+				CustomQuery:      "show global status like 'threads_running'",
+				CheckAsCheckSelf: true,
+			},
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace: "testkeyspace",
+				Disable:  true,
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				Threshold:        2.5,
+				CustomQuery:      "show global status like 'threads_running'",
+				CheckAsCheckSelf: true,
+				ThrottledApps:    map[string]*topodatapb.ThrottledAppRule{},
+			},
+		},
+		{
+			name: "reset the custom query and check as check shard",
+			config: &topodatapb.ThrottlerConfig{
+				Enabled:          true,
+				CustomQuery:      "show global status like 'threads_running'",
+				CheckAsCheckSelf: true,
+			},
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:          "testkeyspace",
+				CustomQuerySet:    true,
+				CheckAsCheckShard: true,
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				Enabled:       true,
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{},
+			},
+		},
+		{
+			name: "throttle app",
+			config: &topodatapb.ThrottlerConfig{
+				Enabled: true,
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+					"online-ddl": {Name: "online-ddl", Ratio: 1, ExpiresAt: later},
+					"expired":    {Name: "expired", Ratio: 1, ExpiresAt: earlier},
+				},
+			},
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:     "testkeyspace",
+				ThrottledApp: &topodatapb.ThrottledAppRule{Name: "vreplication", Ratio: 0.5, ExpiresAt: later},
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				Enabled: true,
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+					"online-ddl":   {Name: "online-ddl", Ratio: 1, ExpiresAt: later},
+					"vreplication": {Name: "vreplication", Ratio: 0.5, ExpiresAt: later},
+				},
+			},
+		},
+		{
+			name: "unthrottle app",
+			config: &topodatapb.ThrottlerConfig{
+				Enabled: true,
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+					"online-ddl": {Name: "online-ddl", Ratio: 1, ExpiresAt: later},
+				},
+			},
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:     "testkeyspace",
+				ThrottledApp: &topodatapb.ThrottledAppRule{Name: "online-ddl"},
+			},
+			expected: &topodatapb.ThrottlerConfig{
+				Enabled:       true,
+				ThrottledApps: map[string]*topodatapb.ThrottledAppRule{},
+			},
+		},
+		{
+			name: "enable and disable",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace: "testkeyspace",
+				Enable:   true,
+				Disable:  true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "check as check self and check shard",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:          "testkeyspace",
+				CheckAsCheckSelf:  true,
+				CheckAsCheckShard: true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "negative threshold",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:  "testkeyspace",
+				Threshold: -1,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid app ratio",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace:     "testkeyspace",
+				ThrottledApp: &topodatapb.ThrottledAppRule{Name: "online-ddl", Ratio: 2},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.UpdateThrottlerConfigRequest{
+				Keyspace: "otherkeyspace",
+				Enable:   true,
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1", "zone2")
+			testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+				Name:     "testkeyspace",
+				Keyspace: &topodatapb.Keyspace{ThrottlerConfig: tt.config},
+			})
+			// Only zone1 serves the keyspace.
+			require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{ThrottlerConfig: tt.config}))
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.UpdateThrottlerConfig(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp.ThrottlerConfig)
+
+			ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, ki.ThrottlerConfig)
+
+			srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, srvKeyspace.ThrottlerConfig)
+
+			_, err = ts.GetSrvKeyspace(ctx, "zone2", "testkeyspace")
+			assert.True(t, topo.IsErrType(err, topo.NoNode), "zone2 should have no SrvKeyspace, got %v", err)
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 	t.Parallel()
 
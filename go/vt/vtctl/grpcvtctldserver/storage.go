@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"math"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const day = 24 * time.Hour
+
+// storageGrowthPerDay returns the slope, in bytes per day, of the least
+// squares fit of the used bytes of the samples over time. It is zero if the
+// samples do not span any time.
+func storageGrowthPerDay(samples []*tabletmanagerdatapb.StorageUsageSample) int64 {
+	if len(samples) < 2 {
+		return 0
+	}
+
+	var meanX, meanY float64
+	for _, sample := range samples {
+		meanX += float64(sample.Time)
+		meanY += float64(sample.UsedBytes)
+	}
+	meanX /= float64(len(samples))
+	meanY /= float64(len(samples))
+
+	var cov, variance float64
+	for _, sample := range samples {
+		dx := float64(sample.Time) - meanX
+		cov += dx * (float64(sample.UsedBytes) - meanY)
+		variance += dx * dx
+	}
+	if variance == 0 {
+		return 0
+	}
+	// The times are in nanoseconds.
+	return int64(math.Round(cov / variance * float64(day)))
+}
+
+// forecastFull returns the time at which free bytes are used at a growth of
+// growthPerDay, starting from now. ok is false if the usage does not grow, or
+// if the time is too far away to be represented.
+func forecastFull(now time.Time, free uint64, growthPerDay int64) (full time.Time, ok bool) {
+	if growthPerDay <= 0 {
+		return time.Time{}, false
+	}
+	d := float64(free) / float64(growthPerDay) * float64(day)
+	if d >= math.MaxInt64 {
+		return time.Time{}, false
+	}
+	return now.Add(time.Duration(d)), true
+}
+
+// newShardStorageUsage returns the storage usage of a shard, from the storage
+// usage of its primary.
+func newShardStorageUsage(now time.Time, shard string, alias *topodatapb.TabletAlias, resp *tabletmanagerdatapb.GetStorageUsageResponse) *vtctldatapb.ShardStorageUsage {
+	usage := &vtctldatapb.ShardStorageUsage{
+		Shard:             shard,
+		TabletAlias:       alias,
+		Tables:            resp.Tables,
+		DiskTotalBytes:    resp.DiskTotalBytes,
+		DiskFreeBytes:     resp.DiskFreeBytes,
+		GrowthBytesPerDay: storageGrowthPerDay(resp.Samples),
+	}
+	for _, table := range resp.Tables {
+		usage.UsedBytes += table.DataLength + table.IndexLength
+	}
+	if resp.DiskTotalBytes > 0 {
+		if full, ok := forecastFull(now, resp.DiskFreeBytes, usage.GrowthBytesPerDay); ok {
+			usage.ForecastFull = protoutil.TimeToProto(full)
+		}
+	}
+	return usage
+}
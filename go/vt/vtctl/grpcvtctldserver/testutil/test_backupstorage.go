@@ -17,7 +17,10 @@ limitations under the License.
 package testutil
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"path"
 	"sort"
 
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -31,6 +34,9 @@ type backupStorage struct {
 	Backups map[string][]string
 	// ListBackupsError is returned from ListBackups when it is non-nil.
 	ListBackupsError error
+	// Files is a mapping of dir/name/filename to the contents of the files
+	// added to the backups started with StartBackup.
+	Files map[string][]byte
 }
 
 // ListBackups is part of the backupstorage.BackupStorage interface.
@@ -54,6 +60,12 @@ func (bs *backupStorage) ListBackups(ctx context.Context, dir string) ([]backups
 	return handles, nil
 }
 
+// StartBackup is part of the backupstorage.BackupStorage interface.
+func (bs *backupStorage) StartBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	bs.Backups[dir] = append(bs.Backups[dir], name)
+	return &backupHandle{bs: bs, directory: dir, name: name}, nil
+}
+
 // Close is part of the backupstorage.BackupStorage interface.
 func (bs *backupStorage) Close() error { return nil }
 
@@ -61,6 +73,7 @@ func (bs *backupStorage) Close() error { return nil }
 type backupHandle struct {
 	backupstorage.BackupHandle
 
+	bs        *backupStorage
 	directory string
 	name      string
 }
@@ -68,6 +81,27 @@ type backupHandle struct {
 func (bh *backupHandle) Directory() string { return bh.directory }
 func (bh *backupHandle) Name() string      { return bh.name }
 
+func (bh *backupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	return &backupFile{bh: bh, filename: filename}, nil
+}
+
+func (bh *backupHandle) EndBackup(ctx context.Context) error   { return nil }
+func (bh *backupHandle) AbortBackup(ctx context.Context) error { return nil }
+
+// backupFile stores its contents in the Files of the backup storage when it
+// is closed.
+type backupFile struct {
+	bytes.Buffer
+
+	bh       *backupHandle
+	filename string
+}
+
+func (f *backupFile) Close() error {
+	f.bh.bs.Files[path.Join(f.bh.directory, f.bh.name, f.filename)] = f.Bytes()
+	return nil
+}
+
 // handlesByName implements the sort interface for backup handles by Name().
 type handlesByName []backupstorage.BackupHandle
 
@@ -85,6 +119,7 @@ const BackupStorageImplementation = "grpcvtctldserver.testutil"
 // state.
 var BackupStorage = &backupStorage{
 	Backups: map[string][]string{},
+	Files:   map[string][]byte{},
 }
 
 func init() {
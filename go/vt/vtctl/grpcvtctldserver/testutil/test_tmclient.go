@@ -158,8 +158,34 @@ type TabletManagerClient struct {
 		Error  error
 	}
 	// keyed by tablet alias.
+	GetDeadLettersResults map[string]struct {
+		Result *querypb.QueryResult
+		Error  error
+	}
+	// keyed by tablet alias.
+	GetSequencesResults map[string]struct {
+		Sequences []*tabletmanagerdatapb.SequenceState
+		Error     error
+	}
+	// keyed by tablet alias.
+	GetStorageUsageResults map[string]struct {
+		Response *tabletmanagerdatapb.GetStorageUsageResponse
+		Error    error
+	}
+	// keyed by tablet alias.
+	GetUnresolvedTransactionsResults map[string]struct {
+		Transactions []*querypb.TransactionMetadata
+		Prepared     []*tabletmanagerdatapb.PreparedTransaction
+		Error        error
+	}
+	// keyed by tablet alias.
 	PrimaryPositionDelays map[string]time.Duration
 	// keyed by tablet alias.
+	PurgeDeadLettersResults map[string]struct {
+		Count int64
+		Error error
+	}
+	// keyed by tablet alias.
 	PrimaryPositionResults map[string]struct {
 		Position string
 		Error    error
@@ -193,6 +219,18 @@ type TabletManagerClient struct {
 		Position *replicationdatapb.Status
 		Error    error
 	}
+	// keyed by tablet alias.
+	RequeueDeadLettersResults map[string]struct {
+		Count int64
+		Error error
+	}
+	// keyed by tablet alias.
+	ResetSequenceResults map[string]struct {
+		Sequence *tabletmanagerdatapb.SequenceState
+		Error    error
+	}
+	// keyed by `<tablet_alias>/<dtid>/<commit|rollback>`.
+	ResolveTransactionResults map[string]error
 	// keyed by tablet alias
 	RunHealthCheckDelays map[string]time.Duration
 	// keyed by tablet alias
@@ -209,6 +247,8 @@ type TabletManagerClient struct {
 	SetReadWriteDelays map[string]time.Duration
 	// keyed by tablet alias.
 	SetReadWriteResults map[string]error
+	// keyed by `<tablet_alias>/<dtid>`.
+	SetRollbackTransactionResults map[string]error
 	// keyed by tablet alias
 	SleepDelays map[string]time.Duration
 	// keyed by tablet alias
@@ -233,6 +273,11 @@ type TabletManagerClient struct {
 	UndoDemotePrimaryDelays map[string]time.Duration
 	// keyed by tablet alias
 	UndoDemotePrimaryResults map[string]error
+	// keyed by tablet alias.
+	VExecResults map[string]struct {
+		Result *querypb.QueryResult
+		Error  error
+	}
 	// tablet alias => duration
 	VReplicationExecDelays map[string]time.Duration
 	// tablet alias => query string => result
@@ -362,6 +407,62 @@ func (fake *TabletManagerClient) GetSchema(ctx context.Context, tablet *topodata
 	return nil, fmt.Errorf("%w: no schemas for %s", assert.AnError, key)
 }
 
+// GetDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) GetDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, limit int) (*querypb.QueryResult, error) {
+	if fake.GetDeadLettersResults == nil {
+		return nil, fmt.Errorf("%w: no GetDeadLetters results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.GetDeadLettersResults[key]; ok {
+		return result.Result, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no GetDeadLetters result set for tablet %s", assert.AnError, key)
+}
+
+// GetStorageUsage is part of the tmclient.TabletManagerClient interface.
+// GetSequences is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) GetSequences(ctx context.Context, tablet *topodatapb.Tablet, tables []string) ([]*tabletmanagerdatapb.SequenceState, error) {
+	if fake.GetSequencesResults == nil {
+		return nil, fmt.Errorf("%w: no GetSequences results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.GetSequencesResults[key]; ok {
+		return result.Sequences, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no GetSequences result set for tablet %s", assert.AnError, key)
+}
+
+func (fake *TabletManagerClient) GetStorageUsage(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetStorageUsageResponse, error) {
+	if fake.GetStorageUsageResults == nil {
+		return nil, fmt.Errorf("%w: no GetStorageUsage results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.GetStorageUsageResults[key]; ok {
+		return result.Response, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no GetStorageUsage result set for tablet %s", assert.AnError, key)
+}
+
+// GetUnresolvedTransactions is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) GetUnresolvedTransactions(ctx context.Context, tablet *topodatapb.Tablet, abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error) {
+	if fake.GetUnresolvedTransactionsResults == nil {
+		return nil, nil, fmt.Errorf("%w: no GetUnresolvedTransactions results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.GetUnresolvedTransactionsResults[key]; ok {
+		return result.Transactions, result.Prepared, result.Error
+	}
+
+	return nil, nil, fmt.Errorf("%w: no GetUnresolvedTransactions result set for tablet %s", assert.AnError, key)
+}
+
 // PrimaryPosition is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) PrimaryPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	if fake.PrimaryPositionResults == nil {
@@ -484,6 +585,20 @@ func (fake *TabletManagerClient) PromoteReplica(ctx context.Context, tablet *top
 	return "", assert.AnError
 }
 
+// PurgeDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) PurgeDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error) {
+	if fake.PurgeDeadLettersResults == nil {
+		return 0, fmt.Errorf("%w: no PurgeDeadLetters results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.PurgeDeadLettersResults[key]; ok {
+		return result.Count, result.Error
+	}
+
+	return 0, fmt.Errorf("%w: no PurgeDeadLetters result set for tablet %s", assert.AnError, key)
+}
+
 // RefreshState is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
 	if fake.RefreshStateResults == nil {
@@ -550,6 +665,53 @@ func (fake *TabletManagerClient) ReplicationStatus(ctx context.Context, tablet *
 	return nil, assert.AnError
 }
 
+// ResetSequence is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) ResetSequence(ctx context.Context, tablet *topodatapb.Tablet, table string, nextID, cache int64) (*tabletmanagerdatapb.SequenceState, error) {
+	if fake.ResetSequenceResults == nil {
+		return nil, fmt.Errorf("%w: no ResetSequence results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.ResetSequenceResults[key]; ok {
+		return result.Sequence, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no ResetSequence result set for tablet %s", assert.AnError, key)
+}
+
+// ResolveTransaction is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) ResolveTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string, commit, conclude bool) error {
+	if fake.ResolveTransactionResults == nil {
+		return fmt.Errorf("%w: no ResolveTransaction results on fake TabletManagerClient", assert.AnError)
+	}
+
+	decision := "rollback"
+	if commit {
+		decision = "commit"
+	}
+
+	key := path.Join(topoproto.TabletAliasString(tablet.Alias), dtid, decision)
+	if err, ok := fake.ResolveTransactionResults[key]; ok {
+		return err
+	}
+
+	return fmt.Errorf("%w: no ResolveTransaction result set for %s", assert.AnError, key)
+}
+
+// RequeueDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) RequeueDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error) {
+	if fake.RequeueDeadLettersResults == nil {
+		return 0, fmt.Errorf("%w: no RequeueDeadLetters results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.RequeueDeadLettersResults[key]; ok {
+		return result.Count, result.Error
+	}
+
+	return 0, fmt.Errorf("%w: no RequeueDeadLetters result set for tablet %s", assert.AnError, key)
+}
+
 // RunHealthCheck is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
 	if fake.RunHealthCheckResults == nil {
@@ -666,6 +828,20 @@ func (fake *TabletManagerClient) SetReadWrite(ctx context.Context, tablet *topod
 	return fmt.Errorf("%w: no result for key %s", assert.AnError, key)
 }
 
+// SetRollbackTransaction is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) SetRollbackTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string) error {
+	if fake.SetRollbackTransactionResults == nil {
+		return fmt.Errorf("%w: no SetRollbackTransaction results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := path.Join(topoproto.TabletAliasString(tablet.Alias), dtid)
+	if err, ok := fake.SetRollbackTransactionResults[key]; ok {
+		return err
+	}
+
+	return fmt.Errorf("%w: no SetRollbackTransaction result set for %s", assert.AnError, key)
+}
+
 // Sleep is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error {
 	if fake.SleepResults == nil {
@@ -860,6 +1036,20 @@ func (fake *TabletManagerClient) UndoDemotePrimary(ctx context.Context, tablet *
 	return assert.AnError
 }
 
+// VExec is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error) {
+	if fake.VExecResults == nil {
+		return nil, fmt.Errorf("%w: no VExec results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.VExecResults[key]; ok {
+		return result.Result, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no VExec result set for tablet %s", assert.AnError, key)
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerCLient interface.
 func (fake *TabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	if fake.VReplicationExecResults == nil {
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// validateVersionedTable checks the versioned table can be added to the
+// routing rules: its versions are tables of the vschema of their keyspaces,
+// and its name is not routed by a routing rule.
+func validateVersionedTable(ctx context.Context, ts *topo.Server, vt *vschemapb.VersionedTable, rr *vschemapb.RoutingRules) error {
+	if vt == nil || vt.FromTable == "" {
+		return vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "the from_table of the versioned table must be set")
+	}
+	if vt.NextPercent > 100 {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "next_percent must be between 0 and 100, got %d", vt.NextPercent)
+	}
+	if vt.CurrentTable == vt.NextTable {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "the current and next versions of %s must be different tables", vt.FromTable)
+	}
+	for _, rule := range rr.Rules {
+		if rule.FromTable == vt.FromTable {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "table %s is already routed by a routing rule", vt.FromTable)
+		}
+	}
+	for _, table := range []string{vt.CurrentTable, vt.NextTable} {
+		keyspace, name, err := sqlparser.ParseTable(table)
+		if err != nil {
+			return vterrors.Wrapf(err, "invalid table %s", table)
+		}
+		if keyspace == "" {
+			return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "table %s must be qualified by its keyspace", table)
+		}
+		vs, err := ts.GetVSchema(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetVSchema(%s)", keyspace)
+		}
+		// The tables of unsharded keyspaces do not need to be in their vschema.
+		if _, ok := vs.Tables[name]; vs.Sharded && !ok {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "table %s is not in the vschema of keyspace %s", name, keyspace)
+		}
+	}
+	return nil
+}
+
+// setVersionedTable replaces the versioned table of the same from_table in
+// the routing rules, or adds it.
+func setVersionedTable(rr *vschemapb.RoutingRules, vt *vschemapb.VersionedTable) {
+	for i, existing := range rr.VersionedTables {
+		if existing.FromTable == vt.FromTable {
+			rr.VersionedTables[i] = vt
+			return
+		}
+	}
+	rr.VersionedTables = append(rr.VersionedTables, vt)
+}
+
+// deleteVersionedTable deletes the versioned table of a from_table from the
+// routing rules. It returns false if there is none.
+func deleteVersionedTable(rr *vschemapb.RoutingRules, fromTable string) bool {
+	for i, existing := range rr.VersionedTables {
+		if existing.FromTable == fromTable {
+			rr.VersionedTables = append(rr.VersionedTables[:i], rr.VersionedTables[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// saveRoutingRules saves the routing rules in the topo, then rebuilds the
+// SrvVSchema of the cells unless skipRebuild is set.
+func (s *VtctldServer) saveRoutingRules(ctx context.Context, rr *vschemapb.RoutingRules, skipRebuild bool, cells []string) error {
+	if err := s.ts.SaveRoutingRules(ctx, rr); err != nil {
+		return err
+	}
+	if skipRebuild {
+		log.Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return nil
+	}
+	if err := s.ts.RebuildSrvVSchema(ctx, cells); err != nil {
+		return vterrors.Wrapf(err, "RebuildSrvVSchema(%v) failed: %v", cells, err)
+	}
+	return nil
+}
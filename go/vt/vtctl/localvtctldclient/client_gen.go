@@ -36,21 +36,51 @@ func (client *localVtctldClient) AddCellsAlias(ctx context.Context, in *vtctldat
 	return client.s.AddCellsAlias(ctx, in)
 }
 
+// ApplyMaskingPolicy is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ApplyMaskingPolicy(ctx context.Context, in *vtctldatapb.ApplyMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyMaskingPolicyResponse, error) {
+	return client.s.ApplyMaskingPolicy(ctx, in)
+}
+
+// ApplyQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ApplyQueryRule(ctx context.Context, in *vtctldatapb.ApplyQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyQueryRuleResponse, error) {
+	return client.s.ApplyQueryRule(ctx, in)
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldatapb.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	return client.s.ApplyRoutingRules(ctx, in)
 }
 
+// ApplySchemaOwnership is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ApplySchemaOwnership(ctx context.Context, in *vtctldatapb.ApplySchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplySchemaOwnershipResponse, error) {
+	return client.s.ApplySchemaOwnership(ctx, in)
+}
+
+// ApplyTableACL is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ApplyTableACL(ctx context.Context, in *vtctldatapb.ApplyTableACLRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyTableACLResponse, error) {
+	return client.s.ApplyTableACL(ctx, in)
+}
+
 // ApplyVSchema is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ApplyVSchema(ctx context.Context, in *vtctldatapb.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyVSchemaResponse, error) {
 	return client.s.ApplyVSchema(ctx, in)
 }
 
+// ApplyVersionedTable is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ApplyVersionedTable(ctx context.Context, in *vtctldatapb.ApplyVersionedTableRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyVersionedTableResponse, error) {
+	return client.s.ApplyVersionedTable(ctx, in)
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ChangeTabletType(ctx context.Context, in *vtctldatapb.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	return client.s.ChangeTabletType(ctx, in)
 }
 
+// CollectDiagnostics is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) CollectDiagnostics(ctx context.Context, in *vtctldatapb.CollectDiagnosticsRequest, opts ...grpc.CallOption) (*vtctldatapb.CollectDiagnosticsResponse, error) {
+	return client.s.CollectDiagnostics(ctx, in)
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) CreateKeyspace(ctx context.Context, in *vtctldatapb.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	return client.s.CreateKeyspace(ctx, in)
@@ -76,6 +106,11 @@ func (client *localVtctldClient) DeleteKeyspace(ctx context.Context, in *vtctlda
 	return client.s.DeleteKeyspace(ctx, in)
 }
 
+// DeleteQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DeleteQueryRule(ctx context.Context, in *vtctldatapb.DeleteQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteQueryRuleResponse, error) {
+	return client.s.DeleteQueryRule(ctx, in)
+}
+
 // DeleteShards is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) DeleteShards(ctx context.Context, in *vtctldatapb.DeleteShardsRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteShardsResponse, error) {
 	return client.s.DeleteShards(ctx, in)
@@ -86,16 +121,31 @@ func (client *localVtctldClient) DeleteSrvVSchema(ctx context.Context, in *vtctl
 	return client.s.DeleteSrvVSchema(ctx, in)
 }
 
+// DeleteTableACL is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DeleteTableACL(ctx context.Context, in *vtctldatapb.DeleteTableACLRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteTableACLResponse, error) {
+	return client.s.DeleteTableACL(ctx, in)
+}
+
 // DeleteTablets is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) DeleteTablets(ctx context.Context, in *vtctldatapb.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteTabletsResponse, error) {
 	return client.s.DeleteTablets(ctx, in)
 }
 
+// DeleteVersionedTable is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) DeleteVersionedTable(ctx context.Context, in *vtctldatapb.DeleteVersionedTableRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteVersionedTableResponse, error) {
+	return client.s.DeleteVersionedTable(ctx, in)
+}
+
 // EmergencyReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldatapb.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	return client.s.EmergencyReparentShard(ctx, in)
 }
 
+// EvacuateCell is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) EvacuateCell(ctx context.Context, in *vtctldatapb.EvacuateCellRequest, opts ...grpc.CallOption) (*vtctldatapb.EvacuateCellResponse, error) {
+	return client.s.EvacuateCell(ctx, in)
+}
+
 // ExecuteHook is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ExecuteHook(ctx context.Context, in *vtctldatapb.ExecuteHookRequest, opts ...grpc.CallOption) (*vtctldatapb.ExecuteHookResponse, error) {
 	return client.s.ExecuteHook(ctx, in)
@@ -126,6 +176,11 @@ func (client *localVtctldClient) GetCellsAliases(ctx context.Context, in *vtctld
 	return client.s.GetCellsAliases(ctx, in)
 }
 
+// GetDeadLetters is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetDeadLetters(ctx context.Context, in *vtctldatapb.GetDeadLettersRequest, opts ...grpc.CallOption) (*vtctldatapb.GetDeadLettersResponse, error) {
+	return client.s.GetDeadLetters(ctx, in)
+}
+
 // GetKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetKeyspace(ctx context.Context, in *vtctldatapb.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.GetKeyspaceResponse, error) {
 	return client.s.GetKeyspace(ctx, in)
@@ -136,6 +191,21 @@ func (client *localVtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata
 	return client.s.GetKeyspaces(ctx, in)
 }
 
+// GetMaskingPolicy is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetMaskingPolicy(ctx context.Context, in *vtctldatapb.GetMaskingPolicyRequest, opts ...grpc.CallOption) (*vtctldatapb.GetMaskingPolicyResponse, error) {
+	return client.s.GetMaskingPolicy(ctx, in)
+}
+
+// GetOnlineDDLSchedulerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldatapb.GetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.GetOnlineDDLSchedulerConfigResponse, error) {
+	return client.s.GetOnlineDDLSchedulerConfig(ctx, in)
+}
+
+// GetQueryRules is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetQueryRules(ctx context.Context, in *vtctldatapb.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetQueryRulesResponse, error) {
+	return client.s.GetQueryRules(ctx, in)
+}
+
 // GetRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetRoutingRules(ctx context.Context, in *vtctldatapb.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetRoutingRulesResponse, error) {
 	return client.s.GetRoutingRules(ctx, in)
@@ -146,6 +216,21 @@ func (client *localVtctldClient) GetSchema(ctx context.Context, in *vtctldatapb.
 	return client.s.GetSchema(ctx, in)
 }
 
+// GetSchemaMigrationProgress is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetSchemaMigrationProgress(ctx context.Context, in *vtctldatapb.GetSchemaMigrationProgressRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSchemaMigrationProgressResponse, error) {
+	return client.s.GetSchemaMigrationProgress(ctx, in)
+}
+
+// GetSchemaOwnership is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetSchemaOwnership(ctx context.Context, in *vtctldatapb.GetSchemaOwnershipRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSchemaOwnershipResponse, error) {
+	return client.s.GetSchemaOwnership(ctx, in)
+}
+
+// GetSequences is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetSequences(ctx context.Context, in *vtctldatapb.GetSequencesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSequencesResponse, error) {
+	return client.s.GetSequences(ctx, in)
+}
+
 // GetShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetShard(ctx context.Context, in *vtctldatapb.GetShardRequest, opts ...grpc.CallOption) (*vtctldatapb.GetShardResponse, error) {
 	return client.s.GetShard(ctx, in)
@@ -171,21 +256,46 @@ func (client *localVtctldClient) GetSrvVSchemas(ctx context.Context, in *vtctlda
 	return client.s.GetSrvVSchemas(ctx, in)
 }
 
+// GetStorageUsage is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetStorageUsage(ctx context.Context, in *vtctldatapb.GetStorageUsageRequest, opts ...grpc.CallOption) (*vtctldatapb.GetStorageUsageResponse, error) {
+	return client.s.GetStorageUsage(ctx, in)
+}
+
+// GetTableACL is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetTableACL(ctx context.Context, in *vtctldatapb.GetTableACLRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTableACLResponse, error) {
+	return client.s.GetTableACL(ctx, in)
+}
+
 // GetTablet is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetTablet(ctx context.Context, in *vtctldatapb.GetTabletRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTabletResponse, error) {
 	return client.s.GetTablet(ctx, in)
 }
 
+// GetTabletStatsSnapshot is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetTabletStatsSnapshot(ctx context.Context, in *vtctldatapb.GetTabletStatsSnapshotRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTabletStatsSnapshotResponse, error) {
+	return client.s.GetTabletStatsSnapshot(ctx, in)
+}
+
 // GetTablets is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetTablets(ctx context.Context, in *vtctldatapb.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetTabletsResponse, error) {
 	return client.s.GetTablets(ctx, in)
 }
 
+// GetUnresolvedTransactions is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetUnresolvedTransactions(ctx context.Context, in *vtctldatapb.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetUnresolvedTransactionsResponse, error) {
+	return client.s.GetUnresolvedTransactions(ctx, in)
+}
+
 // GetVSchema is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetVSchema(ctx context.Context, in *vtctldatapb.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.GetVSchemaResponse, error) {
 	return client.s.GetVSchema(ctx, in)
 }
 
+// GetWorkflowProgress is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetWorkflowProgress(ctx context.Context, in *vtctldatapb.GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	return client.s.GetWorkflowProgress(ctx, in)
+}
+
 // GetWorkflows is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetWorkflows(ctx context.Context, in *vtctldatapb.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowsResponse, error) {
 	return client.s.GetWorkflows(ctx, in)
@@ -206,6 +316,11 @@ func (client *localVtctldClient) PlannedReparentShard(ctx context.Context, in *v
 	return client.s.PlannedReparentShard(ctx, in)
 }
 
+// PurgeDeadLetters is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) PurgeDeadLetters(ctx context.Context, in *vtctldatapb.PurgeDeadLettersRequest, opts ...grpc.CallOption) (*vtctldatapb.PurgeDeadLettersResponse, error) {
+	return client.s.PurgeDeadLetters(ctx, in)
+}
+
 // RebuildKeyspaceGraph is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) RebuildKeyspaceGraph(ctx context.Context, in *vtctldatapb.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldatapb.RebuildKeyspaceGraphResponse, error) {
 	return client.s.RebuildKeyspaceGraph(ctx, in)
@@ -256,6 +371,21 @@ func (client *localVtctldClient) ReparentTablet(ctx context.Context, in *vtctlda
 	return client.s.ReparentTablet(ctx, in)
 }
 
+// RequeueDeadLetters is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) RequeueDeadLetters(ctx context.Context, in *vtctldatapb.RequeueDeadLettersRequest, opts ...grpc.CallOption) (*vtctldatapb.RequeueDeadLettersResponse, error) {
+	return client.s.RequeueDeadLetters(ctx, in)
+}
+
+// ResetSequence is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ResetSequence(ctx context.Context, in *vtctldatapb.ResetSequenceRequest, opts ...grpc.CallOption) (*vtctldatapb.ResetSequenceResponse, error) {
+	return client.s.ResetSequence(ctx, in)
+}
+
+// ResolveTransaction is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ResolveTransaction(ctx context.Context, in *vtctldatapb.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtctldatapb.ResolveTransactionResponse, error) {
+	return client.s.ResolveTransaction(ctx, in)
+}
+
 // RunHealthCheck is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) RunHealthCheck(ctx context.Context, in *vtctldatapb.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldatapb.RunHealthCheckResponse, error) {
 	return client.s.RunHealthCheck(ctx, in)
@@ -271,6 +401,11 @@ func (client *localVtctldClient) SetKeyspaceShardingInfo(ctx context.Context, in
 	return client.s.SetKeyspaceShardingInfo(ctx, in)
 }
 
+// SetOnlineDDLSchedulerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) SetOnlineDDLSchedulerConfig(ctx context.Context, in *vtctldatapb.SetOnlineDDLSchedulerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.SetOnlineDDLSchedulerConfigResponse, error) {
+	return client.s.SetOnlineDDLSchedulerConfig(ctx, in)
+}
+
 // SetShardIsPrimaryServing is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) SetShardIsPrimaryServing(ctx context.Context, in *vtctldatapb.SetShardIsPrimaryServingRequest, opts ...grpc.CallOption) (*vtctldatapb.SetShardIsPrimaryServingResponse, error) {
 	return client.s.SetShardIsPrimaryServing(ctx, in)
@@ -321,6 +456,11 @@ func (client *localVtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctl
 	return client.s.UpdateCellsAlias(ctx, in)
 }
 
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) UpdateThrottlerConfig(ctx context.Context, in *vtctldatapb.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.UpdateThrottlerConfigResponse, error) {
+	return client.s.UpdateThrottlerConfig(ctx, in)
+}
+
 // Validate is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) Validate(ctx context.Context, in *vtctldatapb.ValidateRequest, opts ...grpc.CallOption) (*vtctldatapb.ValidateResponse, error) {
 	return client.s.Validate(ctx, in)
@@ -20,10 +20,12 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"time"
 
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -48,6 +50,12 @@ func init() {
 		params: "-keyspace_shard=<keyspace/shard> [-new_primary=<tablet alias>] [-avoid_tablet=<tablet alias>] [-wait_replicas_timeout=<duration>]",
 		help:   "Reparents the shard to the new primary, or away from old primary. Both old and new primary need to be up and running.",
 	})
+	addCommand("Shards", command{
+		name:   "FailoverDrill",
+		method: commandFailoverDrill,
+		params: "-keyspace_shard=<keyspace/shard> -server=<vtgate> -table=<table> [-new_primary=<tablet alias>] [-avoid_tablet=<tablet alias>] [-wait_replicas_timeout=<duration>] [-before=<duration>] [-after=<duration>] [-interval=<duration>] [-buffered_latency=<duration>]",
+		help:   "Runs a PlannedReparentShard while sending reads and writes to the primary of the shard through vtgate, and prints a JSON report of the errors and latencies seen by the traffic. The table must have an 'id' bigint primary key and a 'ts' bigint column. Requires the -enable_queries flag.",
+	})
 	addCommand("Shards", command{
 		name:   "EmergencyReparentShard",
 		method: commandEmergencyReparentShard,
@@ -149,6 +157,73 @@ func commandPlannedReparentShard(ctx context.Context, wr *wrangler.Wrangler, sub
 	return wr.PlannedReparentShard(ctx, keyspace, shard, newPrimaryAlias, avoidTabletAlias, *waitReplicasTimeout)
 }
 
+func commandFailoverDrill(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if *mysqlctl.DisableActiveReparents {
+		return fmt.Errorf("active reparent commands disabled (unset the -disable_active_reparents flag to enable)")
+	}
+	if !*enableQueries {
+		return fmt.Errorf("query commands are disabled (set the -enable_queries flag to enable)")
+	}
+
+	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", *topo.RemoteOperationTimeout, "time to wait for replicas to catch up on replication before and after reparenting")
+	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
+	newPrimary := subFlags.String("new_primary", "", "alias of a tablet that should be the new primary")
+	avoidTablet := subFlags.String("avoid_tablet", "", "alias of a tablet that should not be the primary, i.e. reparent to any other tablet if this one is the primary")
+	server := subFlags.String("server", "", "VtGate server to send the traffic to")
+	table := subFlags.String("table", "", "table receiving the verification writes")
+	before := subFlags.Duration("before", 5*time.Second, "how long the traffic runs before the reparent")
+	after := subFlags.Duration("after", 10*time.Second, "how long the traffic runs after the reparent")
+	interval := subFlags.Duration("interval", 10*time.Millisecond, "delay between two writes of the traffic")
+	bufferedLatency := subFlags.Duration("buffered_latency", 100*time.Millisecond, "latency above which a successful request is counted as buffered by vtgate")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 || *server == "" || *table == "" {
+		return fmt.Errorf("action FailoverDrill requires -keyspace_shard=<keyspace/shard> -server=<vtgate> -table=<table>")
+	}
+	if *interval <= 0 {
+		return fmt.Errorf("-interval must be > 0")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(*keyspaceShard)
+	if err != nil {
+		return err
+	}
+	var newPrimaryAlias, avoidTabletAlias *topodatapb.TabletAlias
+	if *newPrimary != "" {
+		newPrimaryAlias, err = topoproto.ParseTabletAlias(*newPrimary)
+		if err != nil {
+			return err
+		}
+	}
+	if *avoidTablet != "" {
+		avoidTabletAlias, err = topoproto.ParseTabletAlias(*avoidTablet)
+		if err != nil {
+			return err
+		}
+	}
+
+	vtgateConn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return fmt.Errorf("error connecting to vtgate '%v': %v", *server, err)
+	}
+	defer vtgateConn.Close()
+	session := vtgateConn.Session(fmt.Sprintf("%s:%s@primary", keyspace, shard), nil)
+
+	report, err := wr.FailoverDrill(ctx, keyspace, shard, newPrimaryAlias, avoidTabletAlias, *waitReplicasTimeout, session, wrangler.FailoverDrillOptions{
+		Table:           *table,
+		Before:          *before,
+		After:           *after,
+		Interval:        *interval,
+		BufferedLatency: *bufferedLatency,
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), report)
+}
+
 func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if *mysqlctl.DisableActiveReparents {
 		return fmt.Errorf("active reparent commands disabled (unset the -disable_active_reparents flag to enable)")
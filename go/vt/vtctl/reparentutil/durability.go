@@ -86,8 +86,12 @@ func SetDurabilityPolicy(name string, durabilityParams map[string]string) error
 	return nil
 }
 
-// PromotionRule returns the promotion rule for the instance.
+// PromotionRule returns the promotion rule for the instance. BACKUP_ONLY
+// tablets are never promoted, whatever the durability policy.
 func PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	if tablet.Type == topodatapb.TabletType_BACKUP_ONLY {
+		return promotionrule.MustNot
+	}
 	curDurabilityPolicyMutex.Lock()
 	defer curDurabilityPolicyMutex.Unlock()
 	return curDurabilityPolicy.promotionRule(tablet)
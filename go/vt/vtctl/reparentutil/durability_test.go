@@ -151,6 +151,20 @@ func TestError(t *testing.T) {
 	assert.EqualError(t, err, "durability policy unknown not found")
 }
 
+func TestPromotionRuleBackupOnly(t *testing.T) {
+	err := SetDurabilityPolicy("specified", map[string]string{"cell-0000000001": string(promotionrule.Prefer)})
+	require.NoError(t, err)
+	defer SetDurabilityPolicy("none", nil)
+
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{Cell: "cell", Uid: 1},
+		Type:  topodatapb.TabletType_BACKUP_ONLY,
+	}
+	assert.Equal(t, promotionrule.MustNot, PromotionRule(tablet))
+	tablet.Type = topodatapb.TabletType_REPLICA
+	assert.Equal(t, promotionrule.Prefer, PromotionRule(tablet))
+}
+
 func TestDurabilitySpecified(t *testing.T) {
 	cellName := "cell"
 	durabilityRules := newDurabilitySpecified(
@@ -115,6 +115,7 @@ func (erp *EmergencyReparenter) ReparentShard(ctx context.Context, keyspace stri
 			ersFailureCounter.Add(1)
 			event.DispatchUpdate(ev, "failed EmergencyReparentShard: "+err.Error())
 		}
+		recordReparent(ctx, "EmergencyReparentShard", keyspace, shard, ev, err)
 	}()
 
 	err = erp.reparentShardLocked(ctx, ev, keyspace, shard, opts)
@@ -112,6 +112,7 @@ func (pr *PlannedReparenter) ReparentShard(ctx context.Context, keyspace string,
 		default:
 			event.DispatchUpdate(ev, "failed PlannedReparentShard: "+err.Error())
 		}
+		recordReparent(ctx, "PlannedReparentShard", keyspace, shard, ev, err)
 	}()
 
 	err = pr.reparentShardLocked(ctx, ev, keyspace, shard, opts)
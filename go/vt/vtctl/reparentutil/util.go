@@ -22,17 +22,46 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/audit"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/topotools/events"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
+// recordReparent records the audit event of a reparent of a shard.
+func recordReparent(ctx context.Context, action string, keyspace string, shard string, ev *events.Reparent, err error) {
+	event := &auditpb.Event{
+		Category:  auditpb.Category_REPARENT,
+		Principal: audit.PrincipalFromContext(ctx),
+		Action:    action,
+		Resource:  topoproto.KeyspaceShardString(keyspace, shard),
+		Details: map[string]string{
+			"keyspace": keyspace,
+			"shard":    shard,
+		},
+	}
+	if ev.OldPrimary != nil {
+		event.Details["old_primary"] = topoproto.TabletAliasString(ev.OldPrimary.Alias)
+	}
+	if ev.NewPrimary != nil {
+		event.Details["new_primary"] = topoproto.TabletAliasString(ev.NewPrimary.Alias)
+	}
+	if err != nil {
+		event.Outcome = auditpb.Outcome_FAILURE
+		event.Error = err.Error()
+	}
+	audit.Record(event)
+}
+
 // ChooseNewPrimary finds a tablet that should become a primary after reparent.
 // The criteria for the new primary-elect are (preferably) to be in the same
 // cell as the current primary, and to be different from avoidPrimaryAlias. The
@@ -94,6 +123,66 @@ func ChooseNewPrimary(
 	return nil, nil
 }
 
+// ChooseNewPrimaryOutsideCell finds a tablet that should become a primary
+// when the primary is moved out of the given cell. The candidates are the
+// replicas of the other cells, or only of targetCells if it is not empty,
+// which the durability policy allows to be promoted. The candidates with the
+// best promotion rule are preferred, and among them the tablet with the most
+// advanced replication position is chosen.
+//
+// The return value is nil if there is no candidate.
+func ChooseNewPrimaryOutsideCell(
+	ctx context.Context,
+	tmc tmclient.TabletManagerClient,
+	tabletMap map[string]*topo.TabletInfo,
+	cell string,
+	targetCells []string,
+	waitReplicasTimeout time.Duration,
+	logger logutil.Logger,
+) (*topodatapb.TabletAlias, error) {
+	candidates := make(map[promotionrule.CandidatePromotionRule][]*topodatapb.Tablet)
+	for _, tablet := range tabletMap {
+		switch {
+		case tablet.Alias.Cell == cell:
+			continue
+		case !topo.InCellList(tablet.Alias.Cell, targetCells):
+			continue
+		case tablet.Tablet.Type != topodatapb.TabletType_REPLICA:
+			continue
+		}
+
+		rule := PromotionRule(tablet.Tablet)
+		if rule == promotionrule.MustNot {
+			continue
+		}
+		candidates[rule] = append(candidates[rule], tablet.Tablet)
+	}
+
+	for _, rule := range []promotionrule.CandidatePromotionRule{promotionrule.Must, promotionrule.Prefer, promotionrule.Neutral, promotionrule.PreferNot} {
+		var (
+			searcher = topotools.NewMaxReplicationPositionSearcher(tmc, logger, waitReplicasTimeout)
+			wg       sync.WaitGroup
+		)
+
+		for _, tablet := range candidates[rule] {
+			wg.Add(1)
+
+			go func(tablet *topodatapb.Tablet) {
+				defer wg.Done()
+				searcher.ProcessTablet(ctx, tablet)
+			}(tablet)
+		}
+
+		wg.Wait()
+
+		if maxPosTablet := searcher.MaxPositionTablet(); maxPosTablet != nil {
+			return maxPosTablet.Alias, nil
+		}
+	}
+
+	return nil, nil
+}
+
 // FindCurrentPrimary returns the current primary tablet of a shard, if any. The
 // current primary is whichever tablet of type PRIMARY (if any) has the most
 // recent PrimaryTermStartTime, which is the same rule that vtgate uses to route
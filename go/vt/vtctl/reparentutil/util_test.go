@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/test/utils"
@@ -346,6 +347,99 @@ func TestChooseNewPrimary(t *testing.T) {
 	}
 }
 
+func TestChooseNewPrimaryOutsideCell(t *testing.T) {
+	t.Parallel()
+
+	err := SetDurabilityPolicy("none", nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	tmc := &chooseNewPrimaryTestTMClient{
+		// zone2-201 is behind zone2-202, zone1-101 is the most advanced
+		replicationStatuses: map[string]*replicationdatapb.Status{
+			"zone1-0000000101": {
+				Position: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-10",
+			},
+			"zone2-0000000201": {
+				Position: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1",
+			},
+			"zone2-0000000202": {
+				Position: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5",
+			},
+			"zone3-0000000301": {
+				Position: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-7",
+			},
+			"zone3-0000000302": {
+				Position: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-9",
+			},
+		},
+	}
+	tablet := func(cell string, uid uint32, typ topodatapb.TabletType) *topo.TabletInfo {
+		return &topo.TabletInfo{
+			Tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: cell,
+					Uid:  uid,
+				},
+				Type: typ,
+			},
+		}
+	}
+	tabletMap := map[string]*topo.TabletInfo{
+		"primary":  tablet("zone1", 100, topodatapb.TabletType_PRIMARY),
+		"replica1": tablet("zone1", 101, topodatapb.TabletType_REPLICA),
+		"replica2": tablet("zone2", 201, topodatapb.TabletType_REPLICA),
+		"replica3": tablet("zone2", 202, topodatapb.TabletType_REPLICA),
+		"replica4": tablet("zone3", 301, topodatapb.TabletType_REPLICA),
+		// rdonly tablets must not be promoted
+		"rdonly": tablet("zone3", 302, topodatapb.TabletType_RDONLY),
+	}
+
+	tests := []struct {
+		name        string
+		cell        string
+		targetCells []string
+		expected    *topodatapb.TabletAlias
+	}{
+		{
+			name: "any other cell",
+			cell: "zone1",
+			expected: &topodatapb.TabletAlias{
+				Cell: "zone3",
+				Uid:  301,
+			},
+		},
+		{
+			name:        "target cells",
+			cell:        "zone1",
+			targetCells: []string{"zone2"},
+			expected: &topodatapb.TabletAlias{
+				Cell: "zone2",
+				Uid:  202,
+			},
+		},
+		{
+			name:        "no candidate in target cells",
+			cell:        "zone1",
+			targetCells: []string{"zone4"},
+			expected:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ChooseNewPrimaryOutsideCell(ctx, tmc, tabletMap, tt.cell, tt.targetCells, time.Millisecond*50, logger)
+			assert.NoError(t, err)
+			utils.MustMatch(t, tt.expected, actual)
+		})
+	}
+}
+
 func TestFindCurrentPrimary(t *testing.T) {
 	t.Parallel()
 
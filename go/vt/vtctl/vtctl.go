@@ -117,6 +117,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -448,13 +449,13 @@ var commands = []commandGroup{
 			{
 				name:   "Reshard",
 				method: commandReshard,
-				params: "[-source_shards=<source_shards>] [-target_shards=<target_shards>] [-cells=<cells>] [-tablet_types=<source_tablet_types>]  [-skip_schema_copy] <action> 'action must be one of the following: Create, Complete, Cancel, SwitchTraffic, ReverseTrafffic, Show, or Progress' <keyspace.workflow>",
+				params: "[-source_shards=<source_shards>] [-target_shards=<target_shards>] [-cells=<cells>] [-tablet_types=<source_tablet_types>]  [-skip_schema_copy] [-throttler_app=<app>] [-throttle_ratio=<ratio>] [-cutover_windows=<cron expressions>] [-max_replication_lag_allowed=<duration>] <action> 'action must be one of the following: Create, Complete, Cancel, SwitchTraffic, ReverseTrafffic, Rollback, Show, or Progress' <keyspace.workflow>",
 				help:   "Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='primary,replica,rdonly'  ks.workflow001 '0' '-80,80-'",
 			},
 			{
 				name:   "MoveTables",
 				method: commandMoveTables,
-				params: "[-source=<sourceKs>] [-tables=<tableSpecs>] [-cells=<cells>] [-tablet_types=<source_tablet_types>] [-all] [-exclude=<tables>] [-auto_start] [-stop_after_copy] <action> 'action must be one of the following: Create, Complete, Cancel, SwitchTraffic, ReverseTrafffic, Show, or Progress' <targetKs.workflow>",
+				params: "[-source=<sourceKs>] [-tables=<tableSpecs>] [-cells=<cells>] [-tablet_types=<source_tablet_types>] [-all] [-exclude=<tables>] [-auto_start] [-stop_after_copy] [-copy_parallelism=<streams>] [-throttler_app=<app>] [-throttle_ratio=<ratio>] [-cutover_windows=<cron expressions>] <action> 'action must be one of the following: Create, Complete, Cancel, SwitchTraffic, ReverseTrafffic, Show, or Progress' <targetKs.workflow>",
 				help:   `Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'.`,
 			},
 			{
@@ -463,6 +464,12 @@ var commands = []commandGroup{
 				params: "[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
 				help:   `Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'.`,
 			},
+			{
+				name:   "ImportTenant",
+				method: commandImportTenant,
+				params: "[-external_mysql=<name>] [-tables=<tables>] [-tenant_column=<column>] [-tenant_id=<id>] [-on_conflict=fail|ignore|replace] [-auto_start] [-max_replication_lag_allowed=<duration>] [-keep_data] <action> 'action must be one of the following: Create, Show, Cutover or Cancel' <targetKs.workflow>",
+				help:   "Import the rows of one tenant from an external MySQL database, configured as an external connection of the target tablets, into an existing keyspace. The rows go to the shard owning the keyspace id of the tenant id in the primary vindex of the tables, sharded by the tenant column. Cutover deletes the streams once the writes of the tenant to the external database have been stopped and replicated, Cancel also deletes the rows inserted by the import unless -keep_data is set. Rows of other tenants with the same primary key fail the import, and tables owning lookup vindexes can't be imported.",
+			},
 			{
 				name:   "DropSources",
 				method: commandDropSources,
@@ -481,12 +488,24 @@ var commands = []commandGroup{
 				params: "<keyspace>.<vindex>",
 				help:   `Externalize a backfilled vindex.`,
 			},
+			{
+				name:   "BackfillLookupVindex",
+				method: commandBackfillLookupVindex,
+				params: "[-cells=<source_cells>] [-tablet_types=<source_tablet_types>] [-poll_interval=<duration>] <keyspace> <json_spec>",
+				help:   `Create a lookup vindex like CreateLookupVindex, wait for its backfill while reporting its progress, diff the lookup table against the source table with VDiff and externalize the vindex. The command fails if the backfill does not complete within the action timeout, and running it again resumes the backfill.`,
+			},
 			{
 				name:   "Materialize",
 				method: commandMaterialize,
 				params: `[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				help:   "Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL.",
 			},
+			{
+				name:   "CloneKeyspace",
+				method: commandCloneKeyspace,
+				params: "[-workflow=<workflow>] [-source_cluster=<external_cluster>] [-exclude=<tables>] [-schema_only] [-sample_keyrange=<keyrange>] [-mask] [-cells=<cells>] [-tablet_types=<source_tablet_types>] <source_keyspace> <target_keyspace>",
+				help:   "Copies the schema, the vschema and the data of a keyspace into a new keyspace, e.g. for an ephemeral testing environment. The data is copied by a workflow which stops once the copy is done. Use -sample_keyrange to only copy the rows of the sharded tables within a keyspace id range, and -mask to require the target keyspace to be masked by the masking policy.",
+			},
 			{
 				name:       "SplitClone",
 				method:     commandSplitClone,
@@ -504,8 +523,8 @@ var commands = []commandGroup{
 			{
 				name:   "VDiff",
 				method: commandVDiff,
-				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				help:   "Perform a diff of all tables in the workflow",
+				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] [-workers=1] [-resume | -incremental [-incremental_column=updated_at]] <keyspace.workflow>",
+				help:   "Perform a diff of all tables in the workflow. The progress of the diff is saved, so that -resume can resume a diff which failed or was stopped, and -incremental can only diff the rows modified since the last complete diff. Only one VDiff of a workflow must run at a time, since they share the saved progress.",
 			},
 			{
 				name:   "MigrateServedTypes",
@@ -641,8 +660,20 @@ var commands = []commandGroup{
 			{
 				name:   "ApplySchema",
 				method: commandApplySchema,
-				params: "[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] [-request_context=<unique-request-context>] [-skip_preflight] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				help:   "Applies the schema change to the specified keyspace on every primary, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. -ddl_strategy is used to instruct migrations via vreplication, gh-ost or pt-osc with optional parameters. -request_context allows the user to specify a custom request context for online DDL migrations. If -skip_preflight, SQL goes directly to shards without going through sanity checks.",
+				params: "[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] [-request_context=<unique-request-context>] [-skip_preflight] [-approval_token=<token>] [-desired_schema] [-require_approval] [-canary_shard=<shard> [-canary_soak_period=10m] [-canary_max_error_rate=0.01]] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				help:   "Applies the schema change to the specified keyspace on every primary, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. -ddl_strategy is used to instruct migrations via vreplication, gh-ost or pt-osc with optional parameters. -request_context allows the user to specify a custom request context for online DDL migrations. If -skip_preflight, SQL goes directly to shards without going through sanity checks. -approval_token is required to change the tables with an approval policy in the schema ownership of the keyspace. If -desired_schema, the SQL is the complete desired schema of the keyspace, and the diffs to it are applied as declarative online DDL migrations, rejecting destructive changes unless -ddl_strategy has -allow-destructive. If -require_approval, the schema change is validated and saved along with its impact on every shard, and is only applied once approved with ApproveSchemaMigration. If -canary_shard is set, the schema change is applied to that shard first, and to the other shards only once its migrations complete and its primary stays healthy for -canary_soak_period, with an error rate of at most -canary_max_error_rate. -canary_shard cannot be used with a -ddl_strategy with -postpone-completion.",
+			},
+			{
+				name:   "GetPendingSchemaMigrations",
+				method: commandGetPendingSchemaMigrations,
+				params: "<keyspace>",
+				help:   "Displays the schema changes of the keyspace which were submitted with ApplySchema -require_approval and wait for approval, along with who submitted them and their impact on every shard.",
+			},
+			{
+				name:   "ApproveSchemaMigration",
+				method: commandApproveSchemaMigration,
+				params: "[-approval_token=<token>] <keyspace> <uuid>",
+				help:   "Approves a schema change submitted with ApplySchema -require_approval, and applies it with the options it was submitted with. The approver must be a caller authenticated by the gRPC server of vtctld, other than the one who submitted the schema change. The approval token is not saved with the schema change, and -approval_token is required again to change the tables with an approval policy. With -desired_schema, the diff to the desired schema computed on submission is applied.",
 			},
 			{
 				name:   "CopySchemaShard",
@@ -778,8 +809,8 @@ var commands = []commandGroup{
 			{
 				name:   "Workflow",
 				method: commandWorkflow,
-				params: "<ks.workflow> <action> --dry-run",
-				help:   "Start/Stop/Delete/Show/ListAll/Tags Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start",
+				params: "<ks.workflow> <action> --dry-run [--throttler_app=<app>] [--throttle_ratio=<ratio>] [--cutover_windows=<cron expressions>]",
+				help:   "Start/Stop/Delete/Show/ListAll/Tags/Throttle/Cutover_Windows Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start",
 			},
 		},
 	},
@@ -2246,6 +2277,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
 	stopAfterCopy := subFlags.Bool("stop_after_copy", false, "Streams will be stopped once the copy phase is completed")
+	copyParallelism := subFlags.Int("copy_parallelism", 1, "The number of streams per source shard which copy the tables in parallel")
 	_ = subFlags.Bool("v1", true, "")
 
 	if err := subFlags.Parse(args); err != nil {
@@ -2271,7 +2303,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	target := subFlags.Arg(1)
 	tableSpecs := subFlags.Arg(2)
 	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cells, *tabletTypes, *allTables,
-		*excludes, *autoStart, *stopAfterCopy, "")
+		*excludes, *autoStart, *stopAfterCopy, "", *copyParallelism)
 }
 
 // VReplicationWorkflowAction defines subcommands passed to vtctl for movetables or reshard
@@ -2286,12 +2318,68 @@ const (
 	vReplicationWorkflowActionShow           = "show"
 	vReplicationWorkflowActionProgress       = "progress"
 	vReplicationWorkflowActionGetState       = "getstate"
+	vReplicationWorkflowActionRollback       = "rollback"
 )
 
 func commandMigrate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	return commandVRWorkflow(ctx, wr, subFlags, args, wrangler.MigrateWorkflow)
 }
 
+func commandImportTenant(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	externalMysql := subFlags.String("external_mysql", "", "Create only. The name of the external connection of the target tablets to the database of the tenant.")
+	tables := subFlags.String("tables", "", "Create only. The comma separated tables to import, which have the same names in the external database.")
+	tenantColumn := subFlags.String("tenant_column", "", "Create only. The column holding the tenant id in all the tables.")
+	tenantID := subFlags.String("tenant_id", "", "Create only. The id of the tenant to import.")
+	onConflict := subFlags.String("on_conflict", "fail", "Create only. What to do when an imported row conflicts with an existing row: fail stops the streams, ignore keeps the existing row and replace overwrites it.")
+	autoStart := subFlags.Bool("auto_start", true, "Create only. If false, streams will start in the Stopped state and will need to be explicitly started")
+	maxReplicationLagAllowed := subFlags.Duration("max_replication_lag_allowed", 30*time.Second, "Cutover only. The maximum lag of the streams for which the tenant may be cut over.")
+	keepData := subFlags.Bool("keep_data", false, "Cancel only. Do not delete the imported rows.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("two arguments are needed: action, keyspace.workflow")
+	}
+	action := subFlags.Arg(0)
+	keyspace, workflowName, err := splitKeyspaceWorkflow(subFlags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(action) {
+	case "create":
+		conflictAction, ok := binlogdatapb.Rule_OnConflict_value[strings.ToUpper(*onConflict)]
+		if !ok {
+			return fmt.Errorf("invalid -on_conflict: %s", *onConflict)
+		}
+		var tableList []string
+		if *tables != "" {
+			tableList = strings.Split(*tables, ",")
+		}
+		return wr.ImportTenant(ctx, &wrangler.TenantImport{
+			Workflow:       workflowName,
+			TargetKeyspace: keyspace,
+			ExternalMysql:  *externalMysql,
+			Tables:         tableList,
+			TenantColumn:   *tenantColumn,
+			TenantID:       *tenantID,
+			OnConflict:     binlogdatapb.Rule_OnConflict(conflictAction),
+			AutoStart:      *autoStart,
+		})
+	case "show":
+		res, err := wr.ShowWorkflow(ctx, workflowName, keyspace)
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), res)
+	case "cutover":
+		return wr.CutoverTenant(ctx, keyspace, workflowName, *maxReplicationLagAllowed)
+	case "cancel":
+		return wr.CancelTenantImport(ctx, keyspace, workflowName, *keepData)
+	}
+	return fmt.Errorf("found unsupported action %s", action)
+}
+
 // getSourceKeyspace expects a keyspace of the form "externalClusterName.keyspaceName" and returns the components
 func getSourceKeyspace(clusterKeyspace string) (clusterName string, sourceKeyspace string, err error) {
 	splits := strings.Split(clusterKeyspace, ".")
@@ -2308,18 +2396,22 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "primary,replica,rdonly", "Source tablet types to replicate from (e.g. primary, replica, rdonly). Defaults to -vreplication_tablet_type parameter value for the tablet, which has the default value of replica.")
-	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of SwitchReads and only reports the actions to be taken. -dry_run is only supported for SwitchTraffic, ReverseTraffic and Complete.")
+	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of SwitchReads and only reports the actions to be taken. For primary traffic it also rehearses the cutover: checks the stream lag, locks the keyspaces, rewrites the shard records and creates the reverse workflow, rolling back each step. -dry_run is only supported for SwitchTraffic, ReverseTraffic, Rollback and Complete.")
 	timeout := subFlags.Duration("timeout", 30*time.Second, "Specifies the maximum time to wait, in seconds, for vreplication to catch up on primary migrations. The migration will be cancelled on a timeout. -timeout is only supported for SwitchTraffic and ReverseTraffic.")
 	reverseReplication := subFlags.Bool("reverse_replication", true, "Also reverse the replication (default true). -reverse_replication is only supported for SwitchTraffic.")
 	keepData := subFlags.Bool("keep_data", false, "Do not drop tables or shards (if true, only vreplication artifacts are cleaned up).  -keep_data is only supported for Complete and Cancel.")
 	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
 	stopAfterCopy := subFlags.Bool("stop_after_copy", false, "Streams will be stopped once the copy phase is completed")
+	throttlerApp := subFlags.String("throttler_app", "", "Create only. The app the streams check the throttler with, after 'vreplication:'. Defaults to the workflow name.")
+	throttleRatio := subFlags.Float64("throttle_ratio", 0, "Create only. The ratio, between 0 and 1, of the throttler checks the streams reject by themselves. It can be changed later with the Workflow throttle action.")
+	cutoverWindows := subFlags.String("cutover_windows", "", "Create only. The semicolon separated cron expressions of the UTC times at which SwitchTraffic may switch the writes, like '* 2-4 * * 1-5'. Outside of them the workflow waits, ready to cutover. They can be changed later with the Workflow cutover_windows action.")
 
 	// MoveTables and Migrate params
 	tables := subFlags.String("tables", "", "MoveTables only. A table spec or a list of tables. Either table_specs or -all needs to be specified.")
 	allTables := subFlags.Bool("all", false, "MoveTables only. Move all tables from the source keyspace. Either table_specs or -all needs to be specified.")
 	excludes := subFlags.String("exclude", "", "MoveTables only. Tables to exclude (comma-separated) if -all is specified")
 	sourceKeyspace := subFlags.String("source", "", "MoveTables only. Source keyspace")
+	copyParallelism := subFlags.Int("copy_parallelism", 1, "MoveTables only. Create only. The number of streams per source shard which copy the tables in parallel. The tables are spread across the streams by size, and the tables larger than an even share are split into ranges of their primary key, if it is a single integral column.")
 
 	// MoveTables-only params
 	renameTables := subFlags.Bool("rename_tables", false, "MoveTables only. Rename tables instead of dropping them. -rename_tables is only supported for Complete.")
@@ -2328,6 +2420,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	sourceShards := subFlags.String("source_shards", "", "Reshard only. Source shards")
 	targetShards := subFlags.String("target_shards", "", "Reshard only. Target shards")
 	skipSchemaCopy := subFlags.Bool("skip_schema_copy", false, "Reshard only. Skip copying of schema to target shards")
+	maxReplicationLagAllowed := subFlags.Duration("max_replication_lag_allowed", 30*time.Second, "Reshard only. Rollback only. The maximum lag of the reverse streams for which the writes may be switched back to the source shards.")
 
 	_ = subFlags.Bool("v1", false, "Enables usage of v1 command structure. (default false). Must be added to run the command with -workflow")
 	_ = subFlags.Bool("v2", true, "")
@@ -2357,6 +2450,9 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		DryRun:         *dryRun,
 		AutoStart:      *autoStart,
 		StopAfterCopy:  *stopAfterCopy,
+		ThrottlerApp:   *throttlerApp,
+		ThrottleRatio:  *throttleRatio,
+		CutoverWindows: *cutoverWindows,
 	}
 
 	printDetails := func() error {
@@ -2444,6 +2540,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 			vrwp.ExcludeTables = *excludes
 			vrwp.Timeout = *timeout
 			vrwp.ExternalCluster = externalClusterName
+			vrwp.CopyParallelism = *copyParallelism
 		case wrangler.ReshardWorkflow:
 			if *sourceShards == "" || *targetShards == "" {
 				return fmt.Errorf("source and target shards are not specified")
@@ -2465,6 +2562,12 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		}
 		vrwp.Timeout = *timeout
 		vrwp.EnableReverseReplication = *reverseReplication
+	case vReplicationWorkflowActionRollback:
+		if workflowType != wrangler.ReshardWorkflow {
+			return fmt.Errorf("invalid action for MoveTables: Rollback is only supported for Reshard")
+		}
+		vrwp.Timeout = *timeout
+		vrwp.MaxReplicationLagAllowed = *maxReplicationLagAllowed
 	case vReplicationWorkflowActionCancel:
 		vrwp.KeepData = *keepData
 	case vReplicationWorkflowActionComplete:
@@ -2525,9 +2628,10 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	if *dryRun {
 		switch action {
-		case vReplicationWorkflowActionSwitchTraffic, vReplicationWorkflowActionReverseTraffic, vReplicationWorkflowActionComplete:
+		case vReplicationWorkflowActionSwitchTraffic, vReplicationWorkflowActionReverseTraffic, vReplicationWorkflowActionRollback,
+			vReplicationWorkflowActionComplete:
 		default:
-			return fmt.Errorf("-dry_run is only supported for SwitchTraffic, ReverseTraffic and Complete, not for %s", originalAction)
+			return fmt.Errorf("-dry_run is only supported for SwitchTraffic, ReverseTraffic, Rollback and Complete, not for %s", originalAction)
 		}
 	}
 
@@ -2609,6 +2713,8 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		dryRunResults, err = wf.SwitchTraffic(workflow.DirectionForward)
 	case vReplicationWorkflowActionReverseTraffic:
 		dryRunResults, err = wf.ReverseTraffic()
+	case vReplicationWorkflowActionRollback:
+		dryRunResults, err = wf.Rollback()
 	case vReplicationWorkflowActionComplete:
 		dryRunResults, err = wf.Complete()
 	case vReplicationWorkflowActionCancel:
@@ -2668,6 +2774,27 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
 }
 
+func commandBackfillLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cells := subFlags.String("cells", "", "Source cells to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	pollInterval := subFlags.Duration("poll_interval", 10*time.Second, "Interval at which the progress of the backfill is checked and reported.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("two arguments are required: keyspace and json_spec")
+	}
+	if *pollInterval <= 0 {
+		return fmt.Errorf("poll_interval must be positive: %v", *pollInterval)
+	}
+	keyspace := subFlags.Arg(0)
+	specs := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(subFlags.Arg(1)), specs); err != nil {
+		return err
+	}
+	return wr.BackfillLookupVindex(ctx, keyspace, specs, *cells, *tabletTypes, *pollInterval)
+}
+
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cells := subFlags.String("cells", "", "Source cells to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
@@ -2686,6 +2813,36 @@ func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	return wr.Materialize(ctx, ms)
 }
 
+func commandCloneKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	workflow := subFlags.String("workflow", "clone", "Name of the workflow copying the data.")
+	sourceCluster := subFlags.String("source_cluster", "", "Name of the mounted external cluster of the source keyspace, if any.")
+	excludes := subFlags.String("exclude", "", "Tables to exclude (comma-separated).")
+	schemaOnly := subFlags.Bool("schema_only", false, "Only copy the schema and the vschema, without any data.")
+	sampleKeyRange := subFlags.String("sample_keyrange", "", "Only copy the rows of the sharded tables whose keyspace id is within this key range, e.g. -10.")
+	mask := subFlags.Bool("mask", false, "Require the target keyspace to be a non-production keyspace of the masking policy.")
+	cells := subFlags.String("cells", "", "Source cells to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("two arguments are required: <source_keyspace> <target_keyspace>")
+	}
+	opts := &wrangler.CloneKeyspaceOptions{
+		Workflow:        *workflow,
+		ExternalCluster: *sourceCluster,
+		SchemaOnly:      *schemaOnly,
+		SampleKeyRange:  *sampleKeyRange,
+		Mask:            *mask,
+		Cell:            *cells,
+		TabletTypes:     *tabletTypes,
+	}
+	if *excludes != "" {
+		opts.ExcludeTables = strings.Split(*excludes, ",")
+	}
+	return wr.CloneKeyspace(ctx, subFlags.Arg(0), subFlags.Arg(1), opts)
+}
+
 func commandSplitClone(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2722,6 +2879,10 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	onlyPks := subFlags.Bool("only_pks", false, "When reporting missing rows, only show primary keys in the report.")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
+	resume := subFlags.Bool("resume", false, "Resume the previous vdiff: the tables it diffed are not diffed again, and the tables it did not finish are diffed after the last primary key it compared")
+	incremental := subFlags.Bool("incremental", false, "Only diff the rows whose -incremental_column is at or past the start of the last complete vdiff of their table. Rows deleted since then are not detected, the start is taken from the clock of vtctld, and it is compared with the column as a UTC datetime")
+	incrementalColumn := subFlags.String("incremental_column", "updated_at", "The column holding the time the rows were last modified, for -incremental")
+	workers := subFlags.Int("workers", 1, "The number of chunks of the primary key range of each table compared concurrently. Only the tables whose first primary key column is an integer are split")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2736,8 +2897,19 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
+	if *workers <= 0 {
+		return fmt.Errorf("the number of workers needs to be greater than 0")
+	}
+	if *resume && *incremental {
+		return fmt.Errorf("-resume and -incremental cannot be used together")
+	}
+	if !*incremental {
+		*incrementalColumn = ""
+	} else if *incrementalColumn == "" {
+		return fmt.Errorf("-incremental requires an -incremental_column")
+	}
 	_, err = wr.
-		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables, *debugQuery, *onlyPks)
+		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables, *debugQuery, *onlyPks, *resume, *incrementalColumn, *workers)
 	if err != nil {
 		log.Errorf("vdiff returning with error: %v", err)
 		if strings.Contains(err.Error(), "context deadline exceeded") {
@@ -3235,6 +3407,12 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	requestContext := subFlags.String("request_context", "", "For Only DDL, optionally supply a custom unique string used as context for the migration(s) in this command. By default a unique context is auto-generated by Vitess")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
 	skipPreflight := subFlags.Bool("skip_preflight", false, "Skip pre-apply schema checks, and directly forward schema change query to shards")
+	approvalToken := subFlags.String("approval_token", "", "Approval token of the schema change, validated against the approval policies of the tables it changes")
+	desiredSchema := subFlags.Bool("desired_schema", false, "The SQL is the complete desired schema of the keyspace, as CREATE TABLE statements. The tables are created, altered or dropped by declarative online DDL migrations to converge to it. Destructive changes require -allow-destructive in -ddl_strategy")
+	requireApproval := subFlags.Bool("require_approval", false, "Validate the schema change and save it for approval with ApproveSchemaMigration, by another caller, instead of applying it")
+	canaryShard := subFlags.String("canary_shard", "", "Apply the schema change to this shard first, and to the other shards only once it succeeds on it")
+	canarySoakPeriod := subFlags.Duration("canary_soak_period", 10*time.Minute, "The amount of time the primary of the canary shard must stay healthy after the schema change, before it is applied to the other shards")
+	canaryMaxErrorRate := subFlags.Float64("canary_max_error_rate", 0.01, "The maximum ratio of errors to queries of the primary of the canary shard during its soak period")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -3254,22 +3432,115 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if *requestContext == "" {
 		*requestContext = fmt.Sprintf("vtctl:%s", executionUUID)
 	}
-	executor := schemamanager.NewTabletExecutor(*requestContext, wr, *waitReplicasTimeout)
-	if *allowLongUnavailability {
+	migration := &vtctldatapb.PendingSchemaMigration{
+		DdlStrategy:             *ddlStrategy,
+		RequestContext:          *requestContext,
+		AllowLongUnavailability: *allowLongUnavailability,
+		SkipPreflight:           *skipPreflight,
+		DesiredSchema:           *desiredSchema,
+		WaitReplicasTimeout:     protoutil.DurationToProto(*waitReplicasTimeout),
+		CanaryShard:             *canaryShard,
+		CanarySoakPeriod:        protoutil.DurationToProto(*canarySoakPeriod),
+		CanaryMaxErrorRate:      *canaryMaxErrorRate,
+	}
+	executor, err := newApplySchemaExecutor(wr, migration, *approvalToken, false /* pending */)
+	if err != nil {
+		return err
+	}
+	controller := schemamanager.NewPlainController(change, keyspace)
+
+	if *requireApproval {
+		if err := schemamanager.Stage(ctx, controller, executor, migration); err != nil {
+			return err
+		}
+		wr.Logger().Printf("The schema change waits for approval with: ApproveSchemaMigration %s %s\n", keyspace, migration.Uuid)
+		return printJSON(wr.Logger(), migration)
+	}
+	if *desiredSchema {
+		wr.Logger().Printf("The migrations to the desired schema have the context %s. Track their convergence with: OnlineDDL %s show %s\n", *requestContext, keyspace, *requestContext)
+	}
+
+	return schemamanager.Run(ctx, controller, executor)
+}
+
+// newApplySchemaExecutor returns the executor of a schema change with the
+// options of ApplySchema, as saved in a pending schema migration. The SQL of
+// a pending schema migration with a desired schema is the diff to it.
+func newApplySchemaExecutor(wr *wrangler.Wrangler, migration *vtctldatapb.PendingSchemaMigration, approvalToken string, pending bool) (*schemamanager.TabletExecutor, error) {
+	waitReplicasTimeout, _, err := protoutil.DurationFromProto(migration.WaitReplicasTimeout)
+	if err != nil {
+		return nil, err
+	}
+	executor := schemamanager.NewTabletExecutor(migration.RequestContext, wr, waitReplicasTimeout)
+	if migration.AllowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
-	if *skipPreflight {
+	if migration.SkipPreflight {
 		executor.SkipPreflight()
 	}
-	if err := executor.SetDDLStrategy(*ddlStrategy); err != nil {
+	executor.SetApprovalToken(approvalToken)
+	if err := executor.SetDDLStrategy(migration.DdlStrategy); err != nil {
+		return nil, err
+	}
+	if migration.DesiredSchema {
+		setDesiredSchema := executor.SetDesiredSchema
+		if pending {
+			setDesiredSchema = executor.SetDesiredSchemaChanges
+		}
+		if err := setDesiredSchema(); err != nil {
+			return nil, err
+		}
+	}
+	if migration.CanaryShard != "" {
+		canarySoakPeriod, _, err := protoutil.DurationFromProto(migration.CanarySoakPeriod)
+		if err != nil {
+			return nil, err
+		}
+		executor.SetCanary(migration.CanaryShard, canarySoakPeriod, migration.CanaryMaxErrorRate)
+	}
+	return executor, nil
+}
+
+func commandGetPendingSchemaMigrations(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetPendingSchemaMigrations command")
+	}
 
-	return schemamanager.Run(
-		ctx,
-		schemamanager.NewPlainController(change, keyspace),
-		executor,
-	)
+	migrations, err := wr.TopoServer().GetPendingSchemaMigrations(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), migrations)
+}
+
+func commandApproveSchemaMigration(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	approvalToken := subFlags.String("approval_token", "", "Approval token of the schema change, validated against the approval policies of the tables it changes")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <uuid> arguments are required for the ApproveSchemaMigration command")
+	}
+
+	migration, version, err := wr.TopoServer().GetPendingSchemaMigration(ctx, subFlags.Arg(0), subFlags.Arg(1))
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return fmt.Errorf("schema migration %s of keyspace %s is not pending approval", subFlags.Arg(1), subFlags.Arg(0))
+		}
+		return err
+	}
+	executor, err := newApplySchemaExecutor(wr, migration, *approvalToken, true /* pending */)
+	if err != nil {
+		return err
+	}
+	if migration.DesiredSchema {
+		wr.Logger().Printf("The migrations to the desired schema have the context %s. Track their convergence with: OnlineDDL %s show %s\n", migration.RequestContext, migration.Keyspace, migration.RequestContext)
+	}
+
+	return schemamanager.Approve(ctx, wr.TopoServer(), migration, version, executor)
 }
 
 func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -3877,11 +4148,14 @@ func commandVExec(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 
 func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of Workflow and only reports the final query and list of tablets on which the operation will be applied")
+	throttlerApp := subFlags.String("throttler_app", "", "Throttle only. The app the streams check the throttler with, after 'vreplication:'. Keeps the current app if empty.")
+	throttleRatio := subFlags.Float64("throttle_ratio", 0, "Throttle only. The ratio, between 0 and 1, of the throttler checks the streams reject by themselves.")
+	cutoverWindows := subFlags.String("cutover_windows", "", "Cutover_windows only. The semicolon separated cron expressions of the UTC times at which the writes may be switched. Allows switching them at any time if empty.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() < 2 {
-		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/list/listall/tags [<tags>]")
+		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/list/listall/tags/throttle/cutover_windows [<tags>]")
 	}
 	keyspace := subFlags.Arg(0)
 	action := strings.ToLower(subFlags.Arg(1))
@@ -3915,6 +4189,22 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 		if err != nil {
 			return err
 		}
+	} else if action == "throttle" {
+		if subFlags.NArg() != 2 {
+			return fmt.Errorf("usage: Workflow --throttler_app=<app> --throttle_ratio=<ratio> keyspace.workflow throttle")
+		}
+		results, err = wr.WorkflowThrottleAction(ctx, keyspace, workflow, *throttlerApp, *throttleRatio)
+		if err != nil {
+			return err
+		}
+	} else if action == "cutover_windows" {
+		if subFlags.NArg() != 2 {
+			return fmt.Errorf("usage: Workflow --cutover_windows=<cron expressions> keyspace.workflow cutover_windows")
+		}
+		results, err = wr.WorkflowCutoverWindowsAction(ctx, keyspace, workflow, *cutoverWindows)
+		if err != nil {
+			return err
+		}
 	} else {
 		if subFlags.NArg() != 2 {
 			return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/list/listall")
@@ -0,0 +1,531 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/workflow/vexec"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// The phases of the progress of workflows, streams and tables.
+const (
+	ProgressPhaseCopying     = "Copying"
+	ProgressPhaseReplicating = "Replicating"
+	ProgressPhaseStopped     = "Stopped"
+	ProgressPhaseError       = "Error"
+	ProgressPhasePending     = "Pending"
+	ProgressPhaseCopied      = "Copied"
+)
+
+// progressStream is a stream of a workflow, with what is needed to compute
+// its progress.
+type progressStream struct {
+	progress   *vtctldatapb.WorkflowProgress_StreamProgress
+	bls        *binlogdatapb.BinlogSource
+	copyStates []*vtctldatapb.Workflow_Stream_CopyState
+	// copyStarted is the time the stream started to copy its tables, or the
+	// zero time if it is not known.
+	copyStarted time.Time
+}
+
+// tableSize is the size of a table, as estimated by the statistics of
+// information_schema.
+type tableSize struct {
+	rows, bytes uint64
+}
+
+// GetWorkflowProgress returns the progress of a workflow: how many rows and
+// bytes of its tables were copied, its replication lag and the estimated time
+// left to copy its tables, per stream and per table.
+//
+// The copied rows and bytes are estimated from the table statistics of the
+// primaries of the target shards, and the totals from the table statistics
+// of the primaries of the source shards, so they are approximate. The time
+// left is estimated from the rate the rows were copied since the streams
+// started to copy them.
+func (s *Server) GetWorkflowProgress(ctx context.Context, req *vtctldatapb.GetWorkflowProgressRequest) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.GetWorkflowProgress")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if req.Keyspace == "" || req.Workflow == "" {
+		return nil, fmt.Errorf("%w: a keyspace and a workflow are required", ErrInvalidWorkflow)
+	}
+
+	vx := vexec.NewVExec(req.Keyspace, req.Workflow, s.ts, s.tmc)
+	results, err := vx.QueryContext(ctx, "select id, source, state, message, db_name, time_updated, rows_copied from _vt.vreplication")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		m            sync.Mutex // guards streams, sourceSizes and targetSizes
+		wg           sync.WaitGroup
+		rec          concurrency.FirstErrorRecorder
+		now          = time.Now()
+		streams      []*progressStream
+		dbNames      = make(map[*topo.TabletInfo]string, len(results))
+		rowsByTablet = make(map[*topo.TabletInfo][][]sqltypes.Value, len(results))
+	)
+
+	for tablet, result := range results {
+		qr := sqltypes.Proto3ToResult(result)
+		if len(qr.Rows) == 0 {
+			continue
+		}
+		rowsByTablet[tablet] = qr.Rows
+		dbNames[tablet] = qr.Rows[0][4].ToString()
+	}
+
+	if len(rowsByTablet) == 0 {
+		return nil, fmt.Errorf("%w: no streams found for workflow %s in keyspace %s", ErrInvalidWorkflow, req.Workflow, req.Keyspace)
+	}
+
+	for tablet, rows := range rowsByTablet {
+		wg.Add(1)
+		go func(tablet *topo.TabletInfo, rows [][]sqltypes.Value) {
+			defer wg.Done()
+
+			for _, row := range rows {
+				stream, err := s.scanProgressStream(ctx, tablet, row, now)
+				if err != nil {
+					rec.RecordError(err)
+					return
+				}
+
+				m.Lock()
+				streams = append(streams, stream)
+				m.Unlock()
+			}
+		}(tablet, rows)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	var (
+		tables = make(map[string]bool)
+		// sourceTables maps the tables to the source tables they are copied
+		// from, and matchers are the regular expressions of the rules which
+		// match tables by expression, like the rule "/.*" of Reshard.
+		sourceTables    = make(map[string]string)
+		excluded        = make(map[string]bool)
+		matchers        []*regexp.Regexp
+		sourcePrimaries = make(map[string]*binlogdatapb.BinlogSource)
+	)
+	for _, stream := range streams {
+		if stream.bls.ExternalMysql == "" {
+			sourcePrimaries[stream.bls.Keyspace+"/"+stream.bls.Shard] = stream.bls
+		}
+		for _, rule := range stream.bls.Filter.GetRules() {
+			switch {
+			case rule.Filter == "exclude":
+				excluded[rule.Match] = true
+			case strings.HasPrefix(rule.Match, "/"):
+				re, err := regexp.Compile(rule.Match[1:])
+				if err != nil {
+					return nil, fmt.Errorf("%w: invalid table expression %s: %v", ErrInvalidWorkflow, rule.Match, err)
+				}
+				matchers = append(matchers, re)
+			default:
+				tables[rule.Match] = true
+				sourceTables[rule.Match] = ruleSourceTable(rule)
+			}
+		}
+		for _, cs := range stream.copyStates {
+			tables[cs.Table] = true
+		}
+	}
+
+	sourceSizes := make(map[string]tableSize)
+	for _, bls := range sourcePrimaries {
+		wg.Add(1)
+		go func(bls *binlogdatapb.BinlogSource) {
+			defer wg.Done()
+
+			primary, err := s.getShardPrimary(ctx, bls.Keyspace, bls.Shard)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			sizes, err := s.getTableSizes(ctx, primary, topoproto.TabletDbName(primary))
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			addTableSizes(sourceSizes, sizes)
+		}(bls)
+	}
+
+	targetSizes := make(map[string]tableSize)
+	for tablet := range rowsByTablet {
+		wg.Add(1)
+		go func(tablet *topo.TabletInfo) {
+			defer wg.Done()
+
+			sizes, err := s.getTableSizes(ctx, tablet.Tablet, dbNames[tablet])
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			addTableSizes(targetSizes, sizes)
+		}(tablet)
+	}
+
+	wg.Wait()
+
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	for table := range sourceSizes {
+		for _, re := range matchers {
+			if re.MatchString(table) {
+				tables[table] = true
+			}
+		}
+	}
+	for table := range excluded {
+		delete(tables, table)
+	}
+
+	progress := computeWorkflowProgress(streams, tables, sourceTables, sourceSizes, targetSizes, now)
+	progress.Keyspace = req.Keyspace
+	progress.Workflow = req.Workflow
+
+	return &vtctldatapb.GetWorkflowProgressResponse{
+		Progress: progress,
+	}, nil
+}
+
+func (s *Server) scanProgressStream(ctx context.Context, tablet *topo.TabletInfo, row []sqltypes.Value, now time.Time) (*progressStream, error) {
+	id, err := evalengine.ToInt64(row[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var bls binlogdatapb.BinlogSource
+	if err := prototext.Unmarshal(row[1].ToBytes(), &bls); err != nil {
+		return nil, err
+	}
+
+	timeUpdatedSeconds, err := evalengine.ToInt64(row[5])
+	if err != nil {
+		return nil, err
+	}
+
+	rowsCopied, err := evalengine.ToUint64(row[6])
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &progressStream{
+		progress: &vtctldatapb.WorkflowProgress_StreamProgress{
+			Id:                    id,
+			Shard:                 tablet.Shard,
+			Tablet:                tablet.Alias,
+			SourceShard:           bls.Shard,
+			Message:               row[3].ToString(),
+			RowsCopied:            rowsCopied,
+			ReplicationLagSeconds: int64(now.Sub(time.Unix(timeUpdatedSeconds, 0)).Seconds()),
+		},
+		bls: &bls,
+	}
+
+	stream.copyStates, err = s.getWorkflowCopyStates(ctx, tablet, id)
+	if err != nil {
+		return nil, err
+	}
+
+	state := row[2].ToString()
+	switch {
+	case state == binlogplayer.BlpError || strings.Contains(strings.ToLower(stream.progress.Message), "error"):
+		stream.progress.Phase = ProgressPhaseError
+	case len(stream.copyStates) > 0 || state == binlogplayer.VReplicationInit || state == binlogplayer.VReplicationCopying:
+		stream.progress.Phase = ProgressPhaseCopying
+	case state == binlogplayer.BlpRunning:
+		stream.progress.Phase = ProgressPhaseReplicating
+	default:
+		stream.progress.Phase = state
+	}
+
+	if len(stream.copyStates) == 0 {
+		return stream, nil
+	}
+
+	query := fmt.Sprintf("select created_at from _vt.vreplication_log where vrepl_id = %d and type = 'Started Copy Phase' order by id desc limit 1", id)
+	qr, err := s.tmc.VReplicationExec(ctx, tablet.Tablet, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := sqltypes.Proto3ToResult(qr)
+	if result != nil && len(result.Rows) > 0 {
+		stream.copyStarted, err = time.Parse("2006-01-02 15:04:05", result.Rows[0][0].ToString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stream, nil
+}
+
+// getTableSizes returns the estimated sizes of the tables of a database.
+func (s *Server) getTableSizes(ctx context.Context, tablet *topodatapb.Tablet, dbName string) (map[string]tableSize, error) {
+	query := fmt.Sprintf("select table_name, table_rows, data_length from information_schema.tables where table_schema = %s and table_type = 'BASE TABLE'", sqltypes.EncodeStringSQL(dbName))
+	p3qr, err := s.tmc.ExecuteFetchAsDba(ctx, tablet, true, []byte(query), 10000, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("getTableSizes(%v) failed: %w", topoproto.TabletAliasString(tablet.Alias), err)
+	}
+
+	qr := sqltypes.Proto3ToResult(p3qr)
+	sizes := make(map[string]tableSize, len(qr.Rows))
+	for _, row := range qr.Rows {
+		// The statistics are NULL for the tables which were never analyzed.
+		rows, _ := evalengine.ToUint64(row[1])
+		bytes, _ := evalengine.ToUint64(row[2])
+		sizes[row[0].ToString()] = tableSize{rows: rows, bytes: bytes}
+	}
+
+	return sizes, nil
+}
+
+func (s *Server) getShardPrimary(ctx context.Context, keyspace string, shard string) (*topodatapb.Tablet, error) {
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+
+	if si.PrimaryAlias == nil {
+		return nil, fmt.Errorf("shard %s/%s has no primary", keyspace, shard)
+	}
+
+	ti, err := s.ts.GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	return ti.Tablet, nil
+}
+
+func addTableSizes(sizes map[string]tableSize, add map[string]tableSize) {
+	for table, size := range add {
+		sum := sizes[table]
+		sum.rows += size.rows
+		sum.bytes += size.bytes
+		sizes[table] = sum
+	}
+}
+
+// ruleSourceTable returns the name of the source table of a rule, which is
+// the table its filter selects from, or the table it matches.
+func ruleSourceTable(rule *binlogdatapb.Rule) string {
+	stmt, err := sqlparser.Parse(rule.Filter)
+	if err != nil {
+		return rule.Match
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 {
+		return rule.Match
+	}
+	ate, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return rule.Match
+	}
+	tableName, ok := ate.Expr.(sqlparser.TableName)
+	if !ok {
+		return rule.Match
+	}
+	return tableName.Name.String()
+}
+
+// computeWorkflowProgress computes the progress of the streams of a workflow
+// and of its tables, from the sizes of the tables on the source and target
+// shards. sourceTables maps the tables which are not copied from a table of
+// the same name to their source table.
+func computeWorkflowProgress(streams []*progressStream, tables map[string]bool, sourceTables map[string]string, sourceSizes, targetSizes map[string]tableSize, now time.Time) *vtctldatapb.WorkflowProgress {
+	sort.Slice(streams, func(i, j int) bool {
+		if streams[i].progress.Shard != streams[j].progress.Shard {
+			return streams[i].progress.Shard < streams[j].progress.Shard
+		}
+		return streams[i].progress.Id < streams[j].progress.Id
+	})
+
+	progress := &vtctldatapb.WorkflowProgress{}
+
+	// A table is Pending until a stream starts to copy it, and Copied once
+	// none of them has it in its copy state anymore.
+	pending := make(map[string]int)
+	copying := make(map[string]bool)
+	var copyStarted time.Time
+	stopped := 0
+	for _, stream := range streams {
+		progress.Streams = append(progress.Streams, stream.progress)
+		if stream.progress.ReplicationLagSeconds > progress.MaxReplicationLagSeconds {
+			progress.MaxReplicationLagSeconds = stream.progress.ReplicationLagSeconds
+		}
+
+		switch stream.progress.Phase {
+		case ProgressPhaseError:
+			progress.Phase = ProgressPhaseError
+		case ProgressPhaseCopying:
+			if progress.Phase != ProgressPhaseError {
+				progress.Phase = ProgressPhaseCopying
+			}
+		case binlogplayer.BlpStopped:
+			stopped++
+		}
+
+		for _, cs := range stream.copyStates {
+			stream.progress.TablesToCopy = append(stream.progress.TablesToCopy, cs.Table)
+			if cs.LastPk == "" {
+				pending[cs.Table]++
+			} else {
+				copying[cs.Table] = true
+			}
+		}
+
+		sort.Strings(stream.progress.TablesToCopy)
+
+		if !stream.copyStarted.IsZero() && (copyStarted.IsZero() || stream.copyStarted.Before(copyStarted)) {
+			copyStarted = stream.copyStarted
+		}
+	}
+
+	if progress.Phase == "" {
+		if stopped == len(streams) {
+			progress.Phase = ProgressPhaseStopped
+		} else {
+			progress.Phase = ProgressPhaseReplicating
+		}
+	}
+
+	names := make([]string, 0, len(tables))
+	for table := range tables {
+		names = append(names, table)
+	}
+	sort.Strings(names)
+
+	for _, table := range names {
+		source := table
+		if name, ok := sourceTables[table]; ok {
+			source = name
+		}
+
+		tp := &vtctldatapb.WorkflowProgress_TableProgress{
+			Table:       table,
+			RowsCopied:  targetSizes[table].rows,
+			RowsTotal:   sourceSizes[source].rows,
+			BytesCopied: targetSizes[table].bytes,
+			BytesTotal:  sourceSizes[source].bytes,
+		}
+
+		switch {
+		case copying[table] || (pending[table] > 0 && pending[table] < len(streams)):
+			tp.Phase = ProgressPhaseCopying
+		case pending[table] > 0:
+			tp.Phase = ProgressPhasePending
+		default:
+			tp.Phase = ProgressPhaseCopied
+		}
+
+		progress.Tables = append(progress.Tables, tp)
+
+		progress.RowsCopied += tp.RowsCopied
+		progress.RowsTotal += tp.RowsTotal
+		progress.BytesCopied += tp.BytesCopied
+		progress.BytesTotal += tp.BytesTotal
+	}
+
+	// The rate is the rows copied by all the streams since the first one
+	// started to copy.
+	elapsed := now.Sub(copyStarted)
+	if copyStarted.IsZero() || elapsed <= 0 || progress.RowsCopied == 0 {
+		return progress
+	}
+	rate := float64(progress.RowsCopied) / elapsed.Seconds()
+
+	// The tables are copied one after the other, so the time left until
+	// a table is copied includes the time left to copy the tables before it.
+	var toCopy []*vtctldatapb.WorkflowProgress_TableProgress
+	for _, phase := range []string{ProgressPhaseCopying, ProgressPhasePending} {
+		for _, tp := range progress.Tables {
+			if tp.Phase == phase {
+				toCopy = append(toCopy, tp)
+			}
+		}
+	}
+
+	var remaining uint64
+	etas := make(map[string]time.Duration, len(toCopy))
+	for _, tp := range toCopy {
+		if tp.RowsTotal > tp.RowsCopied {
+			remaining += tp.RowsTotal - tp.RowsCopied
+		}
+		etas[tp.Table] = time.Duration(float64(remaining) / rate * float64(time.Second))
+		tp.Eta = protoutil.DurationToProto(etas[tp.Table])
+		progress.Eta = tp.Eta
+	}
+
+	for _, stream := range streams {
+		var eta time.Duration
+		for _, table := range stream.progress.TablesToCopy {
+			if d, ok := etas[table]; ok && d > eta {
+				eta = d
+			}
+		}
+		if eta > 0 {
+			stream.progress.Eta = protoutil.DurationToProto(eta)
+		}
+	}
+
+	return progress
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestGetWorkflowProgress(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{AlsoSetShardPrimary: true},
+		&topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}, Keyspace: "source", Shard: "0", Type: topodatapb.TabletType_PRIMARY},
+		&topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 200}, Keyspace: "target", Shard: "-80", Type: topodatapb.TabletType_PRIMARY},
+		&topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 300}, Keyspace: "target", Shard: "80-", Type: topodatapb.TabletType_PRIMARY},
+	)
+
+	bls, err := prototext.Marshal(&binlogdatapb.BinlogSource{
+		Keyspace: "source",
+		Shard:    "0",
+		Filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{
+			{Match: "t1", Filter: "select * from t1"},
+			{Match: "t2", Filter: "select * from t2"},
+		}},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	streams := func(state string, timeUpdated time.Time, rowsCopied int) *querypb.QueryResult {
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|source|state|message|db_name|time_updated|rows_copied", "int64|varbinary|varchar|varchar|varchar|int64|int64"),
+			fmt.Sprintf("1|%s|%s||vt_target|%d|%d", bls, state, timeUpdated.Unix(), rowsCopied),
+		))
+	}
+	copyStates := func(rows ...string) *querypb.QueryResult {
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name|lastpk", "varbinary|varbinary"), rows...))
+	}
+	copyStarted := sqltypes.ResultToProto3(sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("created_at", "timestamp"),
+		now.Add(-100*time.Second).UTC().Format("2006-01-02 15:04:05"),
+	))
+	tableSizes := func(rows ...string) *querypb.QueryResult {
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name|table_rows|data_length", "varchar|uint64|uint64"), rows...))
+	}
+
+	const (
+		streamsQuery     = "select id, source, state, message, db_name, time_updated, rows_copied from _vt.vreplication where db_name = 'vt_target' and workflow = 'wf'"
+		copyStateQuery   = "select table_name, lastpk from _vt.copy_state where vrepl_id = 1"
+		copyStartedQuery = "select created_at from _vt.vreplication_log where vrepl_id = 1 and type = 'Started Copy Phase' order by id desc limit 1"
+		sourceSizesQuery = "select table_name, table_rows, data_length from information_schema.tables where table_schema = 'vt_source' and table_type = 'BASE TABLE'"
+		targetSizesQuery = "select table_name, table_rows, data_length from information_schema.tables where table_schema = 'vt_target' and table_type = 'BASE TABLE'"
+	)
+
+	tmc := &fakeTMC{
+		vrepQueriesByTablet: map[string]map[string]*querypb.QueryResult{
+			// -80 copies t1, and did not start to copy t2.
+			"zone1-0000000200": {
+				streamsQuery:     streams("Copying", now, 300),
+				copyStateQuery:   copyStates("t1|id: 300", "t2|NULL"),
+				copyStartedQuery: copyStarted,
+			},
+			// 80- copied t1, and copies t2.
+			"zone1-0000000300": {
+				streamsQuery:     streams("Copying", now.Add(-5*time.Second), 1000),
+				copyStateQuery:   copyStates("t2|id: 200"),
+				copyStartedQuery: copyStarted,
+			},
+		},
+		dbaQueriesByTablet: map[string]map[string]*querypb.QueryResult{
+			"zone1-0000000100": {
+				sourceSizesQuery: tableSizes("t1|1000|100000", "t2|2000|200000", "t3|10|1000"),
+			},
+			"zone1-0000000200": {
+				targetSizesQuery: tableSizes("t1|300|30000", "t2|0|0"),
+			},
+			"zone1-0000000300": {
+				targetSizesQuery: tableSizes("t1|500|50000", "t2|200|20000"),
+			},
+		},
+	}
+
+	resp, err := NewServer(ts, tmc).GetWorkflowProgress(ctx, &vtctldatapb.GetWorkflowProgressRequest{Keyspace: "target", Workflow: "wf"})
+	require.NoError(t, err)
+	progress := resp.Progress
+
+	assert.Equal(t, "target", progress.Keyspace)
+	assert.Equal(t, "wf", progress.Workflow)
+	assert.Equal(t, ProgressPhaseCopying, progress.Phase)
+	assert.EqualValues(t, 1000, progress.RowsCopied)
+	assert.EqualValues(t, 3000, progress.RowsTotal)
+	assert.EqualValues(t, 100000, progress.BytesCopied)
+	assert.EqualValues(t, 300000, progress.BytesTotal)
+	assert.InDelta(t, 5, progress.MaxReplicationLagSeconds, 1)
+
+	// The rows are copied at 10 rows per second.
+	eta := func(d interface{ GetSeconds() int64 }) float64 {
+		require.NotNil(t, d)
+		return float64(d.GetSeconds())
+	}
+	assert.InDelta(t, 200, eta(progress.Eta), 3)
+
+	require.Len(t, progress.Tables, 2)
+	t1, t2 := progress.Tables[0], progress.Tables[1]
+	assert.Equal(t, "t1", t1.Table)
+	assert.Equal(t, ProgressPhaseCopying, t1.Phase)
+	assert.EqualValues(t, 800, t1.RowsCopied)
+	assert.EqualValues(t, 1000, t1.RowsTotal)
+	assert.InDelta(t, 20, eta(t1.Eta), 1)
+	assert.Equal(t, "t2", t2.Table)
+	assert.Equal(t, ProgressPhaseCopying, t2.Phase)
+	assert.EqualValues(t, 200, t2.RowsCopied)
+	assert.EqualValues(t, 2000, t2.RowsTotal)
+	assert.InDelta(t, 200, eta(t2.Eta), 3)
+
+	require.Len(t, progress.Streams, 2)
+	assert.Equal(t, "-80", progress.Streams[0].Shard)
+	assert.Equal(t, "0", progress.Streams[0].SourceShard)
+	assert.Equal(t, ProgressPhaseCopying, progress.Streams[0].Phase)
+	assert.EqualValues(t, 300, progress.Streams[0].RowsCopied)
+	assert.Equal(t, []string{"t1", "t2"}, progress.Streams[0].TablesToCopy)
+	assert.InDelta(t, 200, eta(progress.Streams[0].Eta), 3)
+	assert.Equal(t, "80-", progress.Streams[1].Shard)
+	assert.Equal(t, []string{"t2"}, progress.Streams[1].TablesToCopy)
+
+	// Once the tables are copied, the streams replicate and there is no ETA.
+	for _, alias := range []string{"zone1-0000000200", "zone1-0000000300"} {
+		tmc.vrepQueriesByTablet[alias][streamsQuery] = streams("Running", now, 0)
+		tmc.vrepQueriesByTablet[alias][copyStateQuery] = copyStates()
+	}
+	resp, err = NewServer(ts, tmc).GetWorkflowProgress(ctx, &vtctldatapb.GetWorkflowProgressRequest{Keyspace: "target", Workflow: "wf"})
+	require.NoError(t, err)
+	progress = resp.Progress
+	assert.Equal(t, ProgressPhaseReplicating, progress.Phase)
+	assert.Nil(t, progress.Eta)
+	for _, table := range progress.Tables {
+		assert.Equal(t, ProgressPhaseCopied, table.Phase)
+		assert.Nil(t, table.Eta)
+	}
+	for _, stream := range progress.Streams {
+		assert.Equal(t, ProgressPhaseReplicating, stream.Phase)
+		assert.Empty(t, stream.TablesToCopy)
+	}
+
+	_, err = NewServer(ts, tmc).GetWorkflowProgress(ctx, &vtctldatapb.GetWorkflowProgressRequest{Keyspace: "target"})
+	assert.ErrorIs(t, err, ErrInvalidWorkflow)
+}
+
+func TestComputeWorkflowProgressPending(t *testing.T) {
+	now := time.Now()
+	stream := func(id int64, copyStates ...*vtctldatapb.Workflow_Stream_CopyState) *progressStream {
+		return &progressStream{
+			progress:    &vtctldatapb.WorkflowProgress_StreamProgress{Id: id, Shard: "0", Phase: ProgressPhaseCopying},
+			bls:         &binlogdatapb.BinlogSource{},
+			copyStates:  copyStates,
+			copyStarted: now.Add(-10 * time.Second),
+		}
+	}
+	streams := []*progressStream{
+		stream(2, &vtctldatapb.Workflow_Stream_CopyState{Table: "t2"}),
+		stream(1, &vtctldatapb.Workflow_Stream_CopyState{Table: "t1", LastPk: "id: 1"}, &vtctldatapb.Workflow_Stream_CopyState{Table: "t2"}),
+	}
+	tables := map[string]bool{"t1": true, "t2": true, "t3": true}
+	// t3 is copied from a table of another name.
+	sourceTables := map[string]string{"t3": "s3"}
+	sourceSizes := map[string]tableSize{"t1": {rows: 200}, "t2": {rows: 300}, "s3": {rows: 50}}
+	targetSizes := map[string]tableSize{"t1": {rows: 50}, "t3": {rows: 50}}
+
+	progress := computeWorkflowProgress(streams, tables, sourceTables, sourceSizes, targetSizes, now)
+	assert.EqualValues(t, 1, progress.Streams[0].Id)
+	require.Len(t, progress.Tables, 3)
+	assert.Equal(t, ProgressPhaseCopying, progress.Tables[0].Phase)
+	assert.Equal(t, ProgressPhasePending, progress.Tables[1].Phase)
+	assert.Equal(t, ProgressPhaseCopied, progress.Tables[2].Phase)
+	assert.EqualValues(t, 50, progress.Tables[2].RowsTotal)
+	assert.Nil(t, progress.Tables[2].Eta)
+
+	// 100 rows were copied in 10 seconds, so t1 is copied in 15 seconds,
+	// then t2 in 30 more seconds.
+	etas := make([]time.Duration, 2)
+	for i := range etas {
+		var err error
+		etas[i], _, err = protoutil.DurationFromProto(progress.Tables[i].Eta)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []time.Duration{15 * time.Second, 45 * time.Second}, etas)
+	assert.Equal(t, progress.Tables[1].Eta, progress.Eta)
+	assert.Equal(t, progress.Tables[1].Eta, progress.Streams[1].Eta)
+}
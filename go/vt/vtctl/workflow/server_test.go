@@ -38,6 +38,7 @@ import (
 type fakeTMC struct {
 	tmclient.TabletManagerClient
 	vrepQueriesByTablet map[string]map[string]*querypb.QueryResult
+	dbaQueriesByTablet  map[string]map[string]*querypb.QueryResult
 }
 
 func (fake *fakeTMC) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
@@ -55,6 +56,21 @@ func (fake *fakeTMC) VReplicationExec(ctx context.Context, tablet *topodatapb.Ta
 	return p3qr, nil
 }
 
+func (fake *fakeTMC) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	tabletQueries, ok := fake.dbaQueriesByTablet[alias]
+	if !ok {
+		return nil, fmt.Errorf("no dba query map registered on fake for %s", alias)
+	}
+
+	p3qr, ok := tabletQueries[string(query)]
+	if !ok {
+		return nil, fmt.Errorf("no result on fake for dba query %q on tablet %s", query, alias)
+	}
+
+	return p3qr, nil
+}
+
 func TestCheckReshardingJournalExistsOnTablet(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"net/http"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/audit"
+
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
+)
+
+// checkAccessHTTP checks with the security policy that the HTTP request has
+// access to a role. The denied requests, and the granted requests of the
+// admin role, are recorded as audit events.
+func checkAccessHTTP(r *http.Request, role string) error {
+	err := acl.CheckAccessHTTP(r, role)
+	if err == nil && role != acl.ADMIN {
+		return nil
+	}
+	ev := &auditpb.Event{
+		Category:  auditpb.Category_ACCESS,
+		Principal: r.RemoteAddr,
+		Action:    r.Method,
+		Resource:  r.URL.Path,
+		Details:   map[string]string{"role": role},
+	}
+	if err != nil {
+		ev.Outcome = auditpb.Outcome_DENIED
+		ev.Error = err.Error()
+	}
+	audit.Record(ev)
+	return err
+}
@@ -23,7 +23,6 @@ import (
 
 	"context"
 
-	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -167,7 +166,7 @@ func (ar *ActionRepository) ApplyTabletAction(ctx context.Context, actionName st
 
 	// check the role
 	if action.role != "" {
-		if err := acl.CheckAccessHTTP(r, action.role); err != nil {
+		if err := checkAccessHTTP(r, action.role); err != nil {
 			result.error("Access denied")
 			return result
 		}
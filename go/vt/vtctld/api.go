@@ -576,7 +576,7 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 
 	// Vtctl Command
 	handleAPI("vtctl/", func(w http.ResponseWriter, r *http.Request) error {
-		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		if err := checkAccessHTTP(r, acl.ADMIN); err != nil {
 			http.Error(w, "403 Forbidden", http.StatusForbidden)
 			return nil
 		}
@@ -608,7 +608,7 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 
 	// Schema Change
 	handleAPI("schema/apply", func(w http.ResponseWriter, r *http.Request) error {
-		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		if err := checkAccessHTTP(r, acl.ADMIN); err != nil {
 			http.Error(w, "403 Forbidden", http.StatusForbidden)
 			return nil
 		}
@@ -616,6 +616,7 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 			Keyspace, SQL         string
 			ReplicaTimeoutSeconds int
 			DDLStrategy           string `json:"ddl_strategy,omitempty"`
+			ApprovalToken         string `json:"approval_token,omitempty"`
 		}{}
 		if err := unmarshalRequest(r, &req); err != nil {
 			return fmt.Errorf("can't unmarshal request: %v", err)
@@ -639,6 +640,7 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		if err := executor.SetDDLStrategy(req.DDLStrategy); err != nil {
 			return fmt.Errorf("error setting DDL strategy: %v", err)
 		}
+		executor.SetApprovalToken(req.ApprovalToken)
 
 		return schemamanager.Run(ctx,
 			schemamanager.NewUIController(req.SQL, req.Keyspace, w), executor)
@@ -646,7 +648,7 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 
 	// Features
 	handleAPI("features", func(w http.ResponseWriter, r *http.Request) error {
-		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		if err := checkAccessHTTP(r, acl.ADMIN); err != nil {
 			http.Error(w, "403 Forbidden", http.StatusForbidden)
 			return nil
 		}
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -58,6 +59,10 @@ func InitVtctld(ts *topo.Server) error {
 		log.Errorf("error in setting durability policy: %v", err)
 		return err
 	}
+	if err := vindexes.LoadFlagPlugins(); err != nil {
+		log.Errorf("error in loading the vindex plugins: %v", err)
+		return err
+	}
 
 	actionRepo := NewActionRepository(ts)
 
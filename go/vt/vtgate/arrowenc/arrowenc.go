@@ -0,0 +1,287 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package arrowenc encodes query results in the Apache Arrow IPC streaming
+// format, for the clients which read large results column by column.
+//
+// A stream is a schema message, followed by record batch messages, followed
+// by the end of stream marker. The integer and floating point columns are
+// encoded as their Arrow counterparts, the binary columns as Binary, and all
+// the other columns, including the decimal and temporal ones, as Utf8 in
+// their MySQL text representation. The MySQL type of every column is kept in
+// the "mysql_type" metadata of its field.
+package arrowenc
+
+import (
+	"encoding/binary"
+	"math"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The values of the Arrow flatbuffers enums and unions used by the encoder.
+const (
+	metadataVersionV5 = 4
+
+	headerSchema      = 1
+	headerRecordBatch = 3
+
+	typeInt           = 2
+	typeFloatingPoint = 3
+	typeBinary        = 4
+	typeUtf8          = 5
+
+	precisionSingle = 1
+	precisionDouble = 2
+)
+
+// MySQLTypeKey is the key of the field metadata holding the MySQL type of
+// the column.
+const MySQLTypeKey = "mysql_type"
+
+// continuation starts every encapsulated message.
+const continuation = 0xFFFFFFFF
+
+// EndOfStream returns the marker ending an Arrow stream.
+func EndOfStream() []byte {
+	return []byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}
+}
+
+// Encoder encodes the rows of a result in Arrow record batches.
+type Encoder struct {
+	fields []*querypb.Field
+}
+
+// NewEncoder returns an Encoder for the rows of the given fields.
+func NewEncoder(fields []*querypb.Field) *Encoder {
+	return &Encoder{fields: fields}
+}
+
+// Schema returns the schema message, which starts the stream.
+func (e *Encoder) Schema() []byte {
+	fields := make(fbTables, 0, len(e.fields))
+	for _, field := range e.fields {
+		typ, typeTable := arrowType(field.Type)
+		fields = append(fields, fbTable{
+			fbString(field.Name),
+			fbBool(true),
+			fbUint8(typ),
+			typeTable,
+			nil,
+			fbTables{},
+			fbTables{{fbString(MySQLTypeKey), fbString(field.Type.String())}},
+		})
+	}
+	return message(headerSchema, fbTable{nil, fields}, nil)
+}
+
+// RecordBatch returns the record batch message of the rows.
+func (e *Encoder) RecordBatch(rows [][]sqltypes.Value) ([]byte, error) {
+	var (
+		nodes   []byte
+		buffers []byte
+		body    []byte
+	)
+	addBuffer := func(b []byte) {
+		buffers = appendInt64(buffers, int64(len(body)))
+		buffers = appendInt64(buffers, int64(len(b)))
+		body = append(body, b...)
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+
+	for i, field := range e.fields {
+		validity := make([]byte, (len(rows)+7)/8)
+		nulls := 0
+		for j, row := range rows {
+			if i >= len(row) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "row %d has %d values, want %d", j, len(row), len(e.fields))
+			}
+			if row[i].IsNull() {
+				nulls++
+			} else {
+				validity[j/8] |= 1 << (j % 8)
+			}
+		}
+		nodes = appendInt64(nodes, int64(len(rows)))
+		nodes = appendInt64(nodes, int64(nulls))
+		addBuffer(validity)
+
+		typ, typeTable := arrowType(field.Type)
+		switch typ {
+		case typeInt:
+			data, err := intColumn(rows, i, int(typeTable[0].(fbInt32)), bool(typeTable[1].(fbBool)))
+			if err != nil {
+				return nil, err
+			}
+			addBuffer(data)
+		case typeFloatingPoint:
+			data, err := floatColumn(rows, i, typeTable[0].(fbInt16) == precisionSingle)
+			if err != nil {
+				return nil, err
+			}
+			addBuffer(data)
+		default:
+			offsets := make([]byte, 0, 4*(len(rows)+1))
+			var data []byte
+			offsets = appendInt32(offsets, 0)
+			for _, row := range rows {
+				data = append(data, row[i].Raw()...)
+				if len(data) > math.MaxInt32 {
+					return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "column %s exceeds the size of an Arrow record batch", field.Name)
+				}
+				offsets = appendInt32(offsets, int32(len(data)))
+			}
+			addBuffer(offsets)
+			addBuffer(data)
+		}
+	}
+
+	header := fbTable{
+		fbInt64(len(rows)),
+		fbStructs{count: len(e.fields), align: 8, data: nodes},
+		fbStructs{count: len(buffers) / 16, align: 8, data: buffers},
+	}
+	return message(headerRecordBatch, header, body), nil
+}
+
+// EncodeResult returns the complete Arrow stream of the result.
+func EncodeResult(qr *sqltypes.Result) ([]byte, error) {
+	e := NewEncoder(qr.Fields)
+	batch, err := e.RecordBatch(qr.Rows)
+	if err != nil {
+		return nil, err
+	}
+	stream := e.Schema()
+	stream = append(stream, batch...)
+	return append(stream, EndOfStream()...), nil
+}
+
+// arrowType returns the Arrow type of a MySQL type, as its union type and
+// table.
+func arrowType(t querypb.Type) (uint8, fbTable) {
+	switch {
+	case sqltypes.IsIntegral(t):
+		var width int32
+		switch t {
+		case sqltypes.Int8, sqltypes.Uint8:
+			width = 8
+		case sqltypes.Int16, sqltypes.Uint16, sqltypes.Year:
+			width = 16
+		case sqltypes.Int24, sqltypes.Uint24, sqltypes.Int32, sqltypes.Uint32:
+			width = 32
+		default:
+			width = 64
+		}
+		return typeInt, fbTable{fbInt32(width), fbBool(sqltypes.IsSigned(t))}
+	case t == sqltypes.Float32:
+		return typeFloatingPoint, fbTable{fbInt16(precisionSingle)}
+	case t == sqltypes.Float64:
+		return typeFloatingPoint, fbTable{fbInt16(precisionDouble)}
+	case sqltypes.IsBinary(t), t == sqltypes.Bit, t == sqltypes.Geometry:
+		return typeBinary, fbTable{}
+	default:
+		return typeUtf8, fbTable{}
+	}
+}
+
+func intColumn(rows [][]sqltypes.Value, col int, width int, signed bool) ([]byte, error) {
+	data := make([]byte, len(rows)*width/8)
+	for j, row := range rows {
+		v := row[col]
+		if v.IsNull() {
+			continue
+		}
+		var n uint64
+		if signed {
+			i, err := v.ToInt64()
+			if err != nil {
+				return nil, err
+			}
+			n = uint64(i)
+		} else {
+			u, err := v.ToUint64()
+			if err != nil {
+				return nil, err
+			}
+			n = u
+		}
+		switch width {
+		case 8:
+			data[j] = uint8(n)
+		case 16:
+			binary.LittleEndian.PutUint16(data[2*j:], uint16(n))
+		case 32:
+			binary.LittleEndian.PutUint32(data[4*j:], uint32(n))
+		default:
+			binary.LittleEndian.PutUint64(data[8*j:], n)
+		}
+	}
+	return data, nil
+}
+
+func floatColumn(rows [][]sqltypes.Value, col int, single bool) ([]byte, error) {
+	width := 8
+	if single {
+		width = 4
+	}
+	data := make([]byte, len(rows)*width)
+	for j, row := range rows {
+		v := row[col]
+		if v.IsNull() {
+			continue
+		}
+		f, err := v.ToFloat64()
+		if err != nil {
+			return nil, err
+		}
+		if single {
+			binary.LittleEndian.PutUint32(data[4*j:], math.Float32bits(float32(f)))
+		} else {
+			binary.LittleEndian.PutUint64(data[8*j:], math.Float64bits(f))
+		}
+	}
+	return data, nil
+}
+
+// message returns the encapsulated message of the header and body. The body
+// must be padded to 8 bytes.
+func message(headerType uint8, header fbTable, body []byte) []byte {
+	metadata := fbFinish(fbTable{
+		fbInt16(metadataVersionV5),
+		fbUint8(headerType),
+		header,
+		fbInt64(len(body)),
+	})
+	msg := make([]byte, 0, 8+len(metadata)+len(body))
+	msg = appendUint32(msg, continuation)
+	msg = appendInt32(msg, int32(len(metadata)))
+	msg = append(msg, metadata...)
+	return append(msg, body...)
+}
+
+func appendInt32(b []byte, v int32) []byte {
+	return appendUint32(b, uint32(v))
+}
+
+func appendInt64(b []byte, v int64) []byte {
+	return appendUint32(appendUint32(b, uint32(v)), uint32(v>>32))
+}
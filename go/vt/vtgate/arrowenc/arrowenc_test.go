@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arrowenc
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// fbReader reads the tables written by fbWriter.
+type fbReader []byte
+
+func (r fbReader) uint32(pos int) int {
+	return int(binary.LittleEndian.Uint32(r[pos:]))
+}
+
+func (r fbReader) root() int {
+	return r.uint32(0)
+}
+
+// field returns the position of a field of the table, 0 if it is absent.
+func (r fbReader) field(table int, id int) int {
+	vtable := table - int(int32(binary.LittleEndian.Uint32(r[table:])))
+	if 4+2*id >= int(binary.LittleEndian.Uint16(r[vtable:])) {
+		return 0
+	}
+	offset := int(binary.LittleEndian.Uint16(r[vtable+4+2*id:]))
+	if offset == 0 {
+		return 0
+	}
+	return table + offset
+}
+
+// ref returns the position of the object referenced by a field.
+func (r fbReader) ref(pos int) int {
+	return pos + r.uint32(pos)
+}
+
+func (r fbReader) string(pos int) string {
+	n := r.uint32(pos)
+	return string(r[pos+4 : pos+4+n])
+}
+
+func TestFlatbuffers(t *testing.T) {
+	buf := fbFinish(fbTable{
+		fbInt16(7),
+		nil,
+		fbString("name"),
+		fbInt64(-2),
+		fbTables{{fbBool(true)}, {fbUint8(3)}},
+		fbStructs{count: 2, align: 8, data: appendInt64(appendInt64(nil, 5), 6)},
+	})
+	require.Zero(t, len(buf)%8)
+
+	r := fbReader(buf)
+	root := r.root()
+	assert.Zero(t, root%8, "tables are aligned")
+	assert.EqualValues(t, 7, binary.LittleEndian.Uint16(r[r.field(root, 0):]))
+	assert.Zero(t, r.field(root, 1))
+	assert.Equal(t, "name", r.string(r.ref(r.field(root, 2))))
+	int64Pos := r.field(root, 3)
+	assert.Zero(t, int64Pos%8, "scalars are aligned")
+	assert.EqualValues(t, -2, int64(binary.LittleEndian.Uint64(r[int64Pos:])))
+
+	tables := r.ref(r.field(root, 4))
+	require.Equal(t, 2, r.uint32(tables))
+	first := r.ref(tables + 4)
+	assert.EqualValues(t, 1, r[r.field(first, 0)])
+	second := r.ref(tables + 8)
+	assert.EqualValues(t, 3, r[r.field(second, 0)])
+
+	structs := r.ref(r.field(root, 5))
+	require.Equal(t, 2, r.uint32(structs))
+	assert.Zero(t, (structs+4)%8, "structs are aligned")
+	assert.EqualValues(t, 5, binary.LittleEndian.Uint64(r[structs+4:]))
+	assert.EqualValues(t, 6, binary.LittleEndian.Uint64(r[structs+12:]))
+}
+
+// splitMessage returns the metadata and body of the first message of the
+// stream, and the rest of the stream.
+func splitMessage(t *testing.T, stream []byte) (fbReader, []byte, []byte) {
+	t.Helper()
+	require.GreaterOrEqual(t, len(stream), 8)
+	require.EqualValues(t, uint32(continuation), binary.LittleEndian.Uint32(stream))
+	n := int(binary.LittleEndian.Uint32(stream[4:]))
+	require.Zero(t, n%8, "metadata is padded")
+	metadata := fbReader(stream[8 : 8+n])
+	bodyLength := int(binary.LittleEndian.Uint64(metadata[metadata.field(metadata.root(), 3):]))
+	require.Zero(t, bodyLength%8, "body is padded")
+	body := stream[8+n : 8+n+bodyLength]
+	return metadata, body, stream[8+n+bodyLength:]
+}
+
+func TestEncodeResult(t *testing.T) {
+	qr := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|score|name|price", "int64|float64|varchar|decimal"),
+		"1|1.5|a|1.10",
+		"-2|null|bc|null",
+	)
+	stream, err := EncodeResult(qr)
+	require.NoError(t, err)
+
+	// The schema.
+	metadata, body, stream := splitMessage(t, stream)
+	assert.Empty(t, body)
+	root := metadata.root()
+	assert.EqualValues(t, metadataVersionV5, binary.LittleEndian.Uint16(metadata[metadata.field(root, 0):]))
+	assert.EqualValues(t, headerSchema, metadata[metadata.field(root, 1)])
+	schema := metadata.ref(metadata.field(root, 2))
+	fields := metadata.ref(metadata.field(schema, 1))
+	require.Equal(t, 4, metadata.uint32(fields))
+	wantTypes := []uint8{typeInt, typeFloatingPoint, typeUtf8, typeUtf8}
+	for i, want := range wantTypes {
+		field := metadata.ref(fields + 4 + 4*i)
+		assert.Equal(t, qr.Fields[i].Name, metadata.string(metadata.ref(metadata.field(field, 0))))
+		assert.Equal(t, want, metadata[metadata.field(field, 2)])
+		kv := metadata.ref(metadata.ref(metadata.field(field, 6)) + 4)
+		assert.Equal(t, MySQLTypeKey, metadata.string(metadata.ref(metadata.field(kv, 0))))
+		assert.Equal(t, qr.Fields[i].Type.String(), metadata.string(metadata.ref(metadata.field(kv, 1))))
+	}
+
+	// The record batch.
+	metadata, body, stream = splitMessage(t, stream)
+	root = metadata.root()
+	assert.EqualValues(t, headerRecordBatch, metadata[metadata.field(root, 1)])
+	batch := metadata.ref(metadata.field(root, 2))
+	assert.EqualValues(t, 2, binary.LittleEndian.Uint64(metadata[metadata.field(batch, 0):]))
+	nodes := metadata.ref(metadata.field(batch, 1))
+	require.Equal(t, 4, metadata.uint32(nodes))
+	wantNulls := []uint64{0, 1, 0, 1}
+	for i, want := range wantNulls {
+		assert.EqualValues(t, 2, binary.LittleEndian.Uint64(metadata[nodes+4+16*i:]))
+		assert.Equal(t, want, binary.LittleEndian.Uint64(metadata[nodes+12+16*i:]))
+	}
+	buffersPos := metadata.ref(metadata.field(batch, 2))
+	require.Equal(t, 10, metadata.uint32(buffersPos))
+	buffers := make([][]byte, 10)
+	for i := range buffers {
+		offset := binary.LittleEndian.Uint64(metadata[buffersPos+4+16*i:])
+		length := binary.LittleEndian.Uint64(metadata[buffersPos+12+16*i:])
+		assert.Zero(t, offset%8, "buffers are aligned")
+		buffers[i] = body[offset : offset+length]
+	}
+	// id
+	assert.Equal(t, []byte{0b11}, buffers[0])
+	assert.EqualValues(t, 1, int64(binary.LittleEndian.Uint64(buffers[1])))
+	assert.EqualValues(t, -2, int64(binary.LittleEndian.Uint64(buffers[1][8:])))
+	// score
+	assert.Equal(t, []byte{0b01}, buffers[2])
+	assert.Equal(t, 1.5, math.Float64frombits(binary.LittleEndian.Uint64(buffers[3])))
+	// name
+	assert.Equal(t, []byte{0b11}, buffers[4])
+	assert.Equal(t, appendInt32(appendInt32(appendInt32(nil, 0), 1), 3), buffers[5])
+	assert.Equal(t, "abc", string(buffers[6]))
+	// price
+	assert.Equal(t, []byte{0b01}, buffers[7])
+	assert.Equal(t, appendInt32(appendInt32(appendInt32(nil, 0), 4), 4), buffers[8])
+	assert.Equal(t, "1.10", string(buffers[9]))
+
+	assert.Equal(t, EndOfStream(), stream)
+}
+
+func TestRecordBatchErrors(t *testing.T) {
+	e := NewEncoder(sqltypes.MakeTestFields("a|b", "int64|int64"))
+	_, err := e.RecordBatch([][]sqltypes.Value{{sqltypes.NewInt64(1)}})
+	assert.EqualError(t, err, "row 0 has 1 values, want 2")
+
+	_, err = e.RecordBatch([][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}})
+	assert.Error(t, err)
+}
+
+func TestArrowType(t *testing.T) {
+	tcases := []struct {
+		typ   string
+		want  uint8
+		width int32
+		sign  bool
+	}{
+		{typ: "int8", want: typeInt, width: 8, sign: true},
+		{typ: "uint16", want: typeInt, width: 16},
+		{typ: "year", want: typeInt, width: 16},
+		{typ: "int24", want: typeInt, width: 32, sign: true},
+		{typ: "uint64", want: typeInt, width: 64},
+		{typ: "float32", want: typeFloatingPoint},
+		{typ: "blob", want: typeBinary},
+		{typ: "bit", want: typeBinary},
+		{typ: "text", want: typeUtf8},
+		{typ: "datetime", want: typeUtf8},
+		{typ: "json", want: typeUtf8},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.typ, func(t *testing.T) {
+			field := sqltypes.MakeTestFields("a", tcase.typ)[0]
+			typ, table := arrowType(field.Type)
+			assert.Equal(t, tcase.want, typ)
+			if typ == typeInt {
+				assert.Equal(t, fbTable{fbInt32(tcase.width), fbBool(tcase.sign)}, table)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arrowenc
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// This file implements the subset of the flatbuffers encoding needed by the
+// Arrow IPC metadata. The objects are described by value and written front
+// to back: every table is preceded by its vtable, and followed by the
+// objects it references, since flatbuffers offsets only point forward.
+
+// fbTable is a flatbuffers table. Its fields are indexed by their id, nil
+// for the absent ones. A union takes two ids: its type, as an fbUint8, and
+// its value, as an fbTable.
+type fbTable []interface{}
+
+type (
+	fbBool   bool
+	fbUint8  uint8
+	fbInt16  int16
+	fbInt32  int32
+	fbInt64  int64
+	fbString string
+	// fbTables is a vector of tables.
+	fbTables []fbTable
+	// fbStructs is a vector of structs, whose little-endian encoding is
+	// data.
+	fbStructs struct {
+		count int
+		align int
+		data  []byte
+	}
+)
+
+// fbFinish returns the flatbuffer whose root is the table.
+func fbFinish(root fbTable) []byte {
+	w := &fbWriter{buf: make([]byte, 4)}
+	pos := w.table(root)
+	binary.LittleEndian.PutUint32(w.buf, uint32(pos))
+	w.pad(8)
+	return w.buf
+}
+
+type fbWriter struct {
+	buf []byte
+}
+
+func (w *fbWriter) pad(align int) {
+	for len(w.buf)%align != 0 {
+		w.buf = append(w.buf, 0)
+	}
+}
+
+func (w *fbWriter) putUint32(pos int, v uint32) {
+	binary.LittleEndian.PutUint32(w.buf[pos:], v)
+}
+
+// inlineSize returns the size of a field in its table, which is also its
+// alignment.
+func inlineSize(field interface{}) int {
+	switch field.(type) {
+	case fbBool, fbUint8:
+		return 1
+	case fbInt16:
+		return 2
+	case fbInt64:
+		return 8
+	default:
+		// The scalars of 32 bits, and the offsets to the other objects.
+		return 4
+	}
+}
+
+func (w *fbWriter) table(t fbTable) int {
+	// The fields are laid out in order, aligned to their size from the
+	// start of the table, which is itself aligned to 8 bytes.
+	offsets := make([]int, len(t))
+	size := 4
+	for i, field := range t {
+		if field == nil {
+			continue
+		}
+		n := inlineSize(field)
+		for size%n != 0 {
+			size++
+		}
+		offsets[i] = size
+		size += n
+	}
+
+	w.pad(2)
+	vtable := len(w.buf)
+	w.buf = appendUint16(w.buf, uint16(4+2*len(t)))
+	w.buf = appendUint16(w.buf, uint16(size))
+	for _, offset := range offsets {
+		w.buf = appendUint16(w.buf, uint16(offset))
+	}
+
+	w.pad(8)
+	pos := len(w.buf)
+	w.buf = append(w.buf, make([]byte, size)...)
+	// The vtable is found by subtracting its signed offset from the table.
+	w.putUint32(pos, uint32(int32(pos-vtable)))
+
+	var refs []int
+	for i, field := range t {
+		at := pos + offsets[i]
+		switch v := field.(type) {
+		case nil:
+		case fbBool:
+			if v {
+				w.buf[at] = 1
+			}
+		case fbUint8:
+			w.buf[at] = uint8(v)
+		case fbInt16:
+			binary.LittleEndian.PutUint16(w.buf[at:], uint16(v))
+		case fbInt32:
+			w.putUint32(at, uint32(v))
+		case fbInt64:
+			binary.LittleEndian.PutUint64(w.buf[at:], uint64(v))
+		default:
+			refs = append(refs, i)
+		}
+	}
+	for _, i := range refs {
+		at := pos + offsets[i]
+		w.putUint32(at, uint32(w.object(t[i])-at))
+	}
+	return pos
+}
+
+// object writes an object referenced by a table or a vector, and returns
+// its position.
+func (w *fbWriter) object(o interface{}) int {
+	switch v := o.(type) {
+	case fbTable:
+		return w.table(v)
+	case fbString:
+		w.pad(4)
+		pos := len(w.buf)
+		w.buf = appendUint32(w.buf, uint32(len(v)))
+		w.buf = append(w.buf, v...)
+		w.buf = append(w.buf, 0)
+		return pos
+	case fbTables:
+		w.pad(4)
+		pos := len(w.buf)
+		w.buf = appendUint32(w.buf, uint32(len(v)))
+		w.buf = append(w.buf, make([]byte, 4*len(v))...)
+		for i, t := range v {
+			at := pos + 4 + 4*i
+			w.putUint32(at, uint32(w.table(t)-at))
+		}
+		return pos
+	case fbStructs:
+		// The structs, not the length, are aligned.
+		w.pad(4)
+		for (len(w.buf)+4)%v.align != 0 {
+			w.buf = append(w.buf, 0)
+		}
+		pos := len(w.buf)
+		w.buf = appendUint32(w.buf, uint32(v.count))
+		w.buf = append(w.buf, v.data...)
+		return pos
+	default:
+		panic(fmt.Sprintf("unexpected flatbuffers object %T", o))
+	}
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v), byte(v>>8))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	asOfMaxSkew = flag.Duration("as_of_max_skew", 5*time.Minute, "maximum amount of time a delayed replica can be ahead of the AS_OF timestamp of a query for the query to be served by it")
+)
+
+type asOfKey struct{}
+
+// withAsOf returns a context asking the gateway to send the queries to the
+// tablet whose replicated data is the closest to asOf.
+func withAsOf(ctx context.Context, asOf time.Time) context.Context {
+	return context.WithValue(ctx, asOfKey{}, asOf)
+}
+
+func asOfFromContext(ctx context.Context) (time.Time, bool) {
+	asOf, ok := ctx.Value(asOfKey{}).(time.Time)
+	return asOf, ok
+}
+
+// asOfTablets returns the tablet that serves the target data as of the given
+// time. The data of a replica is as of now minus its replication lag, so
+// delayed replicas can serve past points in time. The chosen tablet is the
+// one that covers asOf with the least amount of changes applied after it.
+func asOfTablets(target *querypb.Target, tablets []*discovery.TabletHealth, asOf, now time.Time) ([]*discovery.TabletHealth, error) {
+	var best *discovery.TabletHealth
+	var bestSkew time.Duration
+	for _, th := range tablets {
+		if !th.Serving || th.LastError != nil || th.Stats == nil || th.Stats.HealthError != "" {
+			continue
+		}
+		replicatedUpTo := now.Add(-time.Duration(th.Stats.ReplicationLagSeconds) * time.Second)
+		skew := replicatedUpTo.Sub(asOf)
+		if skew < 0 || skew > *asOfMaxSkew {
+			continue
+		}
+		if best == nil || skew < bestSkew {
+			best, bestSkew = th, skew
+		}
+	}
+	if best == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no delayed replica covers AS_OF %s within %v for '%s'", asOf.Format(time.RFC3339), *asOfMaxSkew, target.String())
+	}
+	return []*discovery.TabletHealth{best}, nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+)
+
+// The deadline budget of a request is the time left before its context
+// expires. It is set by the client, or by -mysql_server_query_timeout, and
+// gRPC carries it to vttablet. vttablet exports the same metrics for its own
+// hops, so that the budget consumption can be followed end to end.
+const (
+	deadlineHopVTGate   = "VTGate"
+	deadlineHopVTTablet = "VTTablet"
+)
+
+var (
+	deadlineRemaining = stats.NewTimings("VtgateDeadlineBudgetRemaining", "Deadline budget left to the requests when they reach a hop", "Hop")
+	deadlineConsumed  = stats.NewTimings("VtgateDeadlineBudgetConsumed", "Deadline budget consumed by the requests from the time they reach a hop until they complete", "Hop")
+)
+
+// recordDeadlineBudget records the budget left to the request when it
+// reaches the hop, and returns the function to call once the hop completed.
+// Requests without a deadline are not recorded.
+func recordDeadlineBudget(ctx context.Context, hop string) func() {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return func() {}
+	}
+	start := time.Now()
+	remaining := deadline.Sub(start)
+	if remaining < 0 {
+		remaining = 0
+	}
+	deadlineRemaining.Add(hop, remaining)
+	return func() {
+		deadlineConsumed.Record(hop, start)
+	}
+}
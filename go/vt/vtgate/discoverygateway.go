@@ -271,7 +271,11 @@ func (dg *DiscoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 		// b) no transaction was created yet.
 		if !bufferedOnce && !inTransaction && target.TabletType == topodatapb.TabletType_PRIMARY {
 			// The next call blocks if we should buffer during a failover.
+			bufferStart := time.Now()
 			retryDone, bufferErr := dg.buffer.WaitForFailoverEnd(ctx, target.Keyspace, target.Shard, err)
+			if retryDone != nil || bufferErr != nil {
+				addBufferedTime(ctx, time.Since(bufferStart))
+			}
 			if bufferErr != nil {
 				// Buffering failed e.g. buffer is already full. Do not retry.
 				err = vterrors.Errorf(
@@ -0,0 +1,247 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*CostEstimate)(nil)
+
+// QueryCost is the estimated cost of a query, computed without executing it.
+type QueryCost struct {
+	// Routes are the estimates of the routes of the plan.
+	Routes []*RouteCost
+	// ShardQueries is the number of queries sent to the shards by the routes.
+	ShardQueries int
+	// EstimatedRows is the number of rows the shards are expected to examine.
+	EstimatedRows int64
+}
+
+// RouteCost is the estimated cost of a route. The shards of the route are
+// resolved with the bind variables of the query, and the query of the route
+// is explained on the first shard. The rows it is expected to examine there
+// are extrapolated to all the shards of the route.
+type RouteCost struct {
+	Keyspace string
+	Variant  string
+	Query    string
+	// Shards is the number of shards the route is sent to, and TotalShards
+	// the number of shards of its keyspace.
+	Shards      int
+	TotalShards int
+	// EstimatedRows is the number of rows the shards are expected to
+	// examine, from the table statistics of the explained shard.
+	EstimatedRows int64
+	// Indexes are the indexes used on the explained shard, as table.index.
+	Indexes []string
+	// FullScans are the tables scanned without an index on the explained
+	// shard.
+	FullScans []string
+	// Error is set if the route could not be estimated, for instance
+	// because it depends on the rows of another route.
+	Error string `json:",omitempty"`
+}
+
+// EstimateCost estimates the cost of the routes of the plan. The routes
+// which are executed once per row of another route, like the right side of
+// a join, are only estimated once, and usually cannot be resolved without
+// the values of the other route.
+func EstimateCost(vcursor VCursor, plan Primitive, bindVars map[string]*querypb.BindVariable) *QueryCost {
+	cost := &QueryCost{}
+	estimateCost(vcursor, plan, bindVars, cost)
+	return cost
+}
+
+func estimateCost(vcursor VCursor, plan Primitive, bindVars map[string]*querypb.BindVariable, cost *QueryCost) {
+	if plan == nil {
+		return
+	}
+	if route, ok := plan.(*Route); ok {
+		rc := route.estimateCost(vcursor, bindVars)
+		cost.Routes = append(cost.Routes, rc)
+		cost.ShardQueries += rc.Shards
+		cost.EstimatedRows += rc.EstimatedRows
+		return
+	}
+	for _, input := range plan.Inputs() {
+		estimateCost(vcursor, input, bindVars, cost)
+	}
+}
+
+func (route *Route) estimateCost(vcursor VCursor, bindVars map[string]*querypb.BindVariable) *RouteCost {
+	rc := &RouteCost{
+		Keyspace: route.Keyspace.Name,
+		Variant:  route.Opcode.String(),
+		Query:    route.Query,
+	}
+	if all, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}}); err == nil {
+		rc.TotalShards = len(all)
+	}
+	rss, bvs, err := route.findRoute(vcursor, bindVars)
+	if err != nil {
+		rc.Error = err.Error()
+		return rc
+	}
+	rc.Shards = len(rss)
+	if len(rss) == 0 {
+		return rc
+	}
+
+	// The query of the route is bound, since the tablets do not bind the
+	// variables of the queries they pass through.
+	stmt, err := sqlparser.Parse(route.Query)
+	if err != nil {
+		rc.Error = err.Error()
+		return rc
+	}
+	query, err := sqlparser.NewParsedQuery(stmt).GenerateQuery(bvs[0], nil)
+	if err != nil {
+		rc.Error = err.Error()
+		return rc
+	}
+	qr, err := vcursor.ExecuteStandalone("explain "+query, nil, rss[0])
+	if err != nil {
+		rc.Error = err.Error()
+		return rc
+	}
+	rows := explainRows(qr, rc)
+	rc.EstimatedRows = rows * int64(len(rss))
+	return rc
+}
+
+// explainRows adds the indexes and full scans of the traditional explain
+// output of MySQL to the route cost, and returns the number of rows it is
+// expected to examine.
+func explainRows(qr *sqltypes.Result, rc *RouteCost) int64 {
+	columns := make(map[string]int)
+	for i, field := range qr.Fields {
+		columns[strings.ToLower(field.Name)] = i
+	}
+	value := func(row []sqltypes.Value, column string) (sqltypes.Value, bool) {
+		i, ok := columns[column]
+		if !ok || i >= len(row) || row[i].IsNull() {
+			return sqltypes.NULL, false
+		}
+		return row[i], true
+	}
+
+	var total int64
+	for _, row := range qr.Rows {
+		var table string
+		if v, ok := value(row, "table"); ok {
+			table = v.ToString()
+		}
+		if v, ok := value(row, "rows"); ok {
+			if n, err := strconv.ParseInt(v.ToString(), 10, 64); err == nil {
+				total += n
+			}
+		}
+		if v, ok := value(row, "key"); ok {
+			rc.Indexes = append(rc.Indexes, table+"."+v.ToString())
+		}
+		if v, ok := value(row, "type"); ok && strings.EqualFold(v.ToString(), "ALL") {
+			rc.FullScans = append(rc.FullScans, table)
+		}
+	}
+	return total
+}
+
+// CostEstimate is the primitive of EXPLAIN FORMAT=COST. It returns the
+// estimated cost of the routes of its input, without executing it.
+type CostEstimate struct {
+	Input Primitive
+
+	noTxNeeded
+}
+
+var costEstimateFields = []*querypb.Field{
+	{Name: "keyspace", Type: querypb.Type_VARCHAR},
+	{Name: "variant", Type: querypb.Type_VARCHAR},
+	{Name: "shards", Type: querypb.Type_INT64},
+	{Name: "total_shards", Type: querypb.Type_INT64},
+	{Name: "estimated_rows", Type: querypb.Type_INT64},
+	{Name: "indexes", Type: querypb.Type_VARCHAR},
+	{Name: "full_scans", Type: querypb.Type_VARCHAR},
+	{Name: "query", Type: querypb.Type_VARCHAR},
+	{Name: "error", Type: querypb.Type_VARCHAR},
+}
+
+// RouteType implements the Primitive interface
+func (c *CostEstimate) RouteType() string {
+	return "CostEstimate"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (c *CostEstimate) GetKeyspaceName() string {
+	return c.Input.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (c *CostEstimate) GetTableName() string {
+	return c.Input.GetTableName()
+}
+
+// TryExecute implements the Primitive interface
+func (c *CostEstimate) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
+	cost := EstimateCost(vcursor, c.Input, bindVars)
+	result := &sqltypes.Result{Fields: costEstimateFields}
+	for _, rc := range cost.Routes {
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(rc.Keyspace),
+			sqltypes.NewVarChar(rc.Variant),
+			sqltypes.NewInt64(int64(rc.Shards)),
+			sqltypes.NewInt64(int64(rc.TotalShards)),
+			sqltypes.NewInt64(rc.EstimatedRows),
+			sqltypes.NewVarChar(strings.Join(rc.Indexes, ",")),
+			sqltypes.NewVarChar(strings.Join(rc.FullScans, ",")),
+			sqltypes.NewVarChar(rc.Query),
+			sqltypes.NewVarChar(rc.Error),
+		})
+	}
+	return result, nil
+}
+
+// TryStreamExecute implements the Primitive interface
+func (c *CostEstimate) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := c.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (c *CostEstimate) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: costEstimateFields}, nil
+}
+
+// Inputs implements the Primitive interface
+func (c *CostEstimate) Inputs() []Primitive {
+	return []Primitive{c.Input}
+}
+
+func (c *CostEstimate) description() PrimitiveDescription {
+	return PrimitiveDescription{OperatorType: "CostEstimate"}
+}
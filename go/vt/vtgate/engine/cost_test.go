@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var explainFields = sqltypes.MakeTestFields(
+	"id|select_type|table|type|key|rows|Extra",
+	"int64|varchar|varchar|varchar|varchar|int64|varchar",
+)
+
+func TestEstimateCostScatter(t *testing.T) {
+	sel := NewRoute(
+		SelectScatter,
+		&vindexes.Keyspace{Name: "ks", Sharded: true},
+		"select * from t where name = :name",
+		"select * from t where 1 != 1",
+	)
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(explainFields,
+			"1|SIMPLE|t|ALL|null|1000|Using where",
+		)},
+	}
+
+	cost := EstimateCost(vc, sel, map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("a")})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		"ExecuteStandalone explain select * from t where `name` = 'a'  ks -20",
+	})
+	assert.Equal(t, 2, cost.ShardQueries)
+	assert.EqualValues(t, 2000, cost.EstimatedRows)
+	require.Len(t, cost.Routes, 1)
+	assert.Equal(t, &RouteCost{
+		Keyspace:      "ks",
+		Variant:       "SelectScatter",
+		Query:         "select * from t where name = :name",
+		Shards:        2,
+		TotalShards:   2,
+		EstimatedRows: 2000,
+		FullScans:     []string{"t"},
+	}, cost.Routes[0])
+}
+
+func TestEstimateCostJoin(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	left := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{Name: "ks", Sharded: true},
+		"select t.id from t where t.id = 1",
+		"select t.id from t where 1 != 1",
+	)
+	left.Vindex = vindex.(vindexes.SingleColumn)
+	left.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}}
+	right := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{Name: "ks", Sharded: true},
+		"select u.id from u where u.t_id = :t_id",
+		"select u.id from u where 1 != 1",
+	)
+	right.Vindex = vindex.(vindexes.SingleColumn)
+	right.Values = []sqltypes.PlanValue{{Key: "t_id"}}
+	join := &Join{
+		Opcode: InnerJoin,
+		Left:   left,
+		Right:  right,
+		Cols:   []int{-1, 1},
+		Vars:   map[string]int{"t_id": 0},
+	}
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(explainFields,
+			"1|SIMPLE|t|const|PRIMARY|1|null",
+		)},
+	}
+
+	cost := EstimateCost(vc, join, map[string]*querypb.BindVariable{})
+	require.Len(t, cost.Routes, 2)
+	assert.Equal(t, 1, cost.Routes[0].Shards)
+	assert.EqualValues(t, 1, cost.Routes[0].EstimatedRows)
+	assert.Equal(t, []string{"t.PRIMARY"}, cost.Routes[0].Indexes)
+	assert.Empty(t, cost.Routes[0].FullScans)
+	// The right side depends on the rows of the left side.
+	assert.Contains(t, cost.Routes[1].Error, "t_id")
+	assert.EqualValues(t, 1, cost.EstimatedRows)
+}
+
+func TestCostEstimateExecute(t *testing.T) {
+	sel := NewRoute(
+		SelectScatter,
+		&vindexes.Keyspace{Name: "ks", Sharded: true},
+		"select * from t",
+		"select * from t where 1 != 1",
+	)
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(explainFields,
+			"1|SIMPLE|t|index|idx_name|10|Using index",
+		)},
+	}
+
+	result, err := (&CostEstimate{Input: sel}).TryExecute(vc, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, `[VARCHAR("ks") VARCHAR("SelectScatter") INT64(2) INT64(2) INT64(20) VARCHAR("t.idx_name") VARCHAR("") VARCHAR("select * from t") VARCHAR("")]`, fmt.Sprintf("%v", result.Rows[0]))
+}
@@ -41,6 +41,10 @@ type DDL struct {
 	OnlineDDLEnabled bool
 
 	CreateTempTable bool
+	// SessionTempTable is set when the temporary table is in the temp table
+	// keyspace. Such tables are tracked by the session, which only needs a
+	// dedicated connection to that keyspace.
+	SessionTempTable bool
 
 	noTxNeeded
 
@@ -54,6 +58,9 @@ func (ddl *DDL) description() PrimitiveDescription {
 	if ddl.CreateTempTable {
 		other["TempTable"] = true
 	}
+	if ddl.SessionTempTable {
+		other["SessionTempTable"] = true
+	}
 	return PrimitiveDescription{
 		OperatorType: "DDL",
 		Keyspace:     ddl.Keyspace,
@@ -87,12 +94,19 @@ func (ddl *DDL) isOnlineSchemaDDL() bool {
 
 // TryExecute implements the Primitive interface
 func (ddl *DDL) TryExecute(vcursor VCursor, bindVars map[string]*query.BindVariable, wantfields bool) (result *sqltypes.Result, err error) {
+	if ddl.SessionTempTable {
+		return ddl.executeSessionTempTable(vcursor, bindVars, wantfields)
+	}
 	if ddl.CreateTempTable {
 		vcursor.Session().HasCreatedTempTable()
 		vcursor.Session().NeedsReservedConn()
 		return vcursor.ExecutePrimitive(ddl.NormalDDL, bindVars, wantfields)
 	}
 
+	if err := ddl.checkApprovalPolicies(vcursor); err != nil {
+		return nil, err
+	}
+
 	ddlStrategySetting, err := schema.ParseDDLStrategy(vcursor.Session().GetDDLStrategy())
 	if err != nil {
 		return nil, err
@@ -113,6 +127,41 @@ func (ddl *DDL) TryExecute(vcursor VCursor, bindVars map[string]*query.BindVaria
 	}
 }
 
+// checkApprovalPolicies rejects the DDLs which change a table with an
+// approval policy, whatever the ddl strategy, since vtgate cannot validate
+// approval tokens.
+func (ddl *DDL) checkApprovalPolicies(vcursor VCursor) error {
+	tables, err := vcursor.TablesWithApprovalPolicy(ddl.Keyspace.Name)
+	if err != nil {
+		return err
+	}
+	for _, table := range ddl.DDL.AffectedTables() {
+		if tables[table.Name.String()] {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "table %s.%s has an approval policy, its schema changes must be applied with ApplySchema", ddl.Keyspace.Name, table.Name.String())
+		}
+	}
+	return nil
+}
+
+// executeSessionTempTable creates or drops temporary tables in the temp
+// table keyspace. The tables are recorded in the session before they are
+// created, so that the statement already runs on the dedicated connection.
+func (ddl *DDL) executeSessionTempTable(vcursor VCursor, bindVars map[string]*query.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	vcursor.Session().HasCreatedTempTable()
+	if ddl.DDL.GetAction() == sqlparser.CreateDDLAction {
+		vcursor.Session().AddTempTable(ddl.DDL.GetTable().Name.String())
+		return vcursor.ExecutePrimitive(ddl.NormalDDL, bindVars, wantfields)
+	}
+	qr, err := vcursor.ExecutePrimitive(ddl.NormalDDL, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range ddl.DDL.GetFromTables() {
+		vcursor.Session().RemoveTempTable(table.Name.String())
+	}
+	return qr, nil
+}
+
 // TryStreamExecute implements the Primitive interface
 func (ddl *DDL) TryStreamExecute(vcursor VCursor, bindVars map[string]*query.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	results, err := ddl.TryExecute(vcursor, bindVars, wantfields)
@@ -46,6 +46,7 @@ import (
 
 var testMaxMemoryRows = 100
 var testIgnoreMaxMemoryRows = false
+var testQueryMemory *QueryMemory
 
 var _ VCursor = (*noopVCursor)(nil)
 var _ SessionActions = (*noopVCursor)(nil)
@@ -116,10 +117,22 @@ func (t *noopVCursor) SetSessionTrackGTIDs(b bool) {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetReadYourWrites(b bool) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) HasCreatedTempTable() {
 	panic("implement me")
 }
 
+func (t *noopVCursor) AddTempTable(name string) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) RemoveTempTable(name string) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	panic("implement me")
 }
@@ -132,6 +145,14 @@ func (t *noopVCursor) InTransactionAndIsDML() bool {
 	panic("implement me")
 }
 
+func (t *noopVCursor) InTransaction() bool {
+	panic("implement me")
+}
+
+func (t *noopVCursor) AfterTransaction(f func()) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) FindRoutedTable(sqlparser.TableName) (*vindexes.Table, error) {
 	panic("implement me")
 }
@@ -191,6 +212,22 @@ func (t *noopVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetTransactionIsolation(querypb.ExecuteOptions_TransactionIsolation) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetTransactionTimeout(time.Duration) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetScatterPolicy(querypb.ExecuteOptions_ScatterPolicy) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetMaxShardsPerQuery(int64) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion) {
 	panic("implement me")
 }
@@ -209,6 +246,10 @@ func (t *noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t *noopVCursor) QueryMemory() *QueryMemory {
+	return testQueryMemory
+}
+
 func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
@@ -223,6 +264,10 @@ func (t *noopVCursor) SetContextTimeout(timeout time.Duration) context.CancelFun
 	return cancel
 }
 
+func (t *noopVCursor) SetContextAsOf(asOf time.Time) (func(), error) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) ErrorGroupCancellableContext() (*errgroup.Group, func()) {
 	g, ctx := errgroup.WithContext(t.ctx)
 	t.ctx = ctx
@@ -264,6 +309,10 @@ func (t *noopVCursor) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) TablesWithApprovalPolicy(keyspace string) (map[string]bool, error) {
+	return nil, nil
+}
+
 func (t *noopVCursor) GetDBDDLPluginName() string {
 	panic("unimplemented")
 }
@@ -299,6 +348,8 @@ type loggingVCursor struct {
 	tableRoutes tableRoutes
 	dbDDLPlugin string
 	ksAvailable bool
+
+	approvalPolicyTables map[string]bool
 }
 
 type tableRoutes struct {
@@ -325,6 +376,14 @@ func (f *loggingVCursor) InTransactionAndIsDML() bool {
 	return false
 }
 
+func (f *loggingVCursor) InTransaction() bool {
+	return false
+}
+
+func (f *loggingVCursor) AfterTransaction(fn func()) {
+	fn()
+}
+
 func (f *loggingVCursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	panic("implement me")
 }
@@ -375,6 +434,11 @@ func (f *loggingVCursor) SetContextTimeout(timeout time.Duration) context.Cancel
 	return cancel
 }
 
+func (f *loggingVCursor) SetContextAsOf(asOf time.Time) (func(), error) {
+	f.log = append(f.log, fmt.Sprintf("SetContextAsOf %s", asOf.Format(time.RFC3339)))
+	return func() {}, nil
+}
+
 func (f *loggingVCursor) ErrorGroupCancellableContext() (*errgroup.Group, func()) {
 	panic("implement me")
 }
@@ -417,6 +481,10 @@ func (f *loggingVCursor) AutocommitApproval() bool {
 	return true
 }
 
+func (f *loggingVCursor) TablesWithApprovalPolicy(keyspace string) (map[string]bool, error) {
+	return f.approvalPolicyTables, nil
+}
+
 func (f *loggingVCursor) SubmitOnlineDDL(onlineDDL *schema.OnlineDDL) error {
 	f.log = append(f.log, fmt.Sprintf("SubmitOnlineDDL: %s", onlineDDL.ToString()))
 	return nil
@@ -555,6 +623,22 @@ func (f *loggingVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) SetTransactionIsolation(isolation querypb.ExecuteOptions_TransactionIsolation) {
+	f.log = append(f.log, fmt.Sprintf("Transaction isolation set to %v", isolation))
+}
+
+func (f *loggingVCursor) SetTransactionTimeout(timeout time.Duration) {
+	f.log = append(f.log, fmt.Sprintf("Transaction timeout set to %v", timeout))
+}
+
+func (f *loggingVCursor) SetScatterPolicy(policy querypb.ExecuteOptions_ScatterPolicy) {
+	f.log = append(f.log, fmt.Sprintf("Scatter policy set to %v", policy))
+}
+
+func (f *loggingVCursor) SetMaxShardsPerQuery(maxShards int64) {
+	f.log = append(f.log, fmt.Sprintf("Max shards per query set to %d", maxShards))
+}
+
 func (f *loggingVCursor) SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion) {
 	panic("implement me")
 }
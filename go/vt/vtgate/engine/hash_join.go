@@ -18,6 +18,7 @@ package engine
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"vitess.io/vitess/go/mysql/collations"
@@ -60,6 +61,13 @@ type HashJoin struct {
 	// collation and type are used to hash the incoming values correctly
 	Collation      collations.ID
 	ComparisonType querypb.Type
+
+	// BlockRows makes the streaming execution a block nested loop join if
+	// it's not 0: the probe table is built from blocks of at most BlockRows
+	// rows of the LHS, and the RHS is streamed and probed once per block.
+	// This bounds the memory of the join, and returns the first rows without
+	// waiting for the whole LHS, at the cost of reading the RHS many times.
+	BlockRows int `json:",omitempty"`
 }
 
 // TryExecute implements the Primitive interface
@@ -130,9 +138,23 @@ func (hj *HashJoin) buildProbeTable(lresult *sqltypes.Result) (map[evalengine.Ha
 
 // TryStreamExecute implements the Primitive interface
 func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	if hj.BlockRows > 0 {
+		return hj.streamBlocks(vcursor, bindVars, wantfields, callback)
+	}
 	// build the probe table from the LHS result
 	probeTable := map[evalengine.HashCode][]row{}
 	var lfields []*querypb.Field
+	// When the probe table exceeds the memory budget of the query, both
+	// inputs are partitioned on disk, and joined one partition at a time.
+	qm := vcursor.QueryMemory()
+	var used int64
+	defer func() { qm.Shrink(used) }()
+	var spilled *graceHashJoin
+	defer func() {
+		if spilled != nil {
+			spilled.close()
+		}
+	}()
 	err := vcursor.StreamExecutePrimitive(hj.Left, bindVars, wantfields, func(result *sqltypes.Result) error {
 		if len(lfields) == 0 && len(result.Fields) != 0 {
 			lfields = result.Fields
@@ -146,6 +168,36 @@ func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 			if err != nil {
 				return err
 			}
+			if spilled != nil {
+				if err := spilled.addLeft(hashcode, current); err != nil {
+					return err
+				}
+				continue
+			}
+			size := rowMemorySize(current)
+			if !qm.Grow(size) {
+				if !qm.CanSpill() {
+					return qm.exceededError()
+				}
+				if spilled, err = newGraceHashJoin(qm.spillDir); err != nil {
+					return err
+				}
+				for hashcode, rows := range probeTable {
+					for _, row := range rows {
+						if err := spilled.addLeft(hashcode, row); err != nil {
+							return err
+						}
+					}
+				}
+				probeTable = nil
+				qm.Shrink(used)
+				used = 0
+				if err := spilled.addLeft(hashcode, current); err != nil {
+					return err
+				}
+				continue
+			}
+			used += size
 			probeTable[hashcode] = append(probeTable[hashcode], current)
 		}
 		return nil
@@ -153,6 +205,9 @@ func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 	if err != nil {
 		return err
 	}
+	if spilled != nil {
+		return hj.streamSpilled(vcursor, bindVars, wantfields, lfields, spilled, callback)
+	}
 
 	return vcursor.StreamExecutePrimitive(hj.Right, bindVars, wantfields, func(result *sqltypes.Result) error {
 		// compare the results coming from the RHS with the probe-table
@@ -162,8 +217,51 @@ func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 				Fields: joinFields(lfields, result.Fields, hj.Cols),
 			}
 		}
-		for _, currentRHSRow := range result.Rows {
-			joinVal := currentRHSRow[hj.RHSKey]
+		var err error
+		if res.Rows, err = hj.probe(probeTable, result.Rows); err != nil {
+			return err
+		}
+		if len(res.Rows) != 0 || len(res.Fields) != 0 {
+			return callback(res)
+		}
+		return nil
+	})
+}
+
+// streamBlocks streams the join one block of rows of the LHS at a time.
+func (hj *HashJoin) streamBlocks(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var lfields []*querypb.Field
+	fieldsSent := false
+	probeTable := map[evalengine.HashCode][]row{}
+	blockRows := 0
+	blocks := 0
+	joinBlock := func() error {
+		blocks++
+		err := vcursor.StreamExecutePrimitive(hj.Right, bindVars, wantfields, func(result *sqltypes.Result) error {
+			res := &sqltypes.Result{}
+			if !fieldsSent && len(result.Fields) != 0 {
+				res.Fields = joinFields(lfields, result.Fields, hj.Cols)
+				fieldsSent = true
+			}
+			var err error
+			if res.Rows, err = hj.probe(probeTable, result.Rows); err != nil {
+				return err
+			}
+			if len(res.Rows) != 0 || len(res.Fields) != 0 {
+				return callback(res)
+			}
+			return nil
+		})
+		probeTable = map[evalengine.HashCode][]row{}
+		blockRows = 0
+		return err
+	}
+	err := vcursor.StreamExecutePrimitive(hj.Left, bindVars, wantfields, func(result *sqltypes.Result) error {
+		if len(lfields) == 0 && len(result.Fields) != 0 {
+			lfields = result.Fields
+		}
+		for _, current := range result.Rows {
+			joinVal := current[hj.LHSKey]
 			if joinVal.IsNull() {
 				continue
 			}
@@ -171,26 +269,157 @@ func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 			if err != nil {
 				return err
 			}
-			lftRows := probeTable[hashcode]
-			for _, currentLHSRow := range lftRows {
-				lhsVal := currentLHSRow[hj.LHSKey]
-				// hash codes can give false positives, so we need to check with a real comparison as well
-				cmp, err := evalengine.NullsafeCompare(joinVal, lhsVal, hj.Collation)
-				if err != nil {
+			probeTable[hashcode] = append(probeTable[hashcode], current)
+			blockRows++
+			if blockRows == hj.BlockRows {
+				if err := joinBlock(); err != nil {
 					return err
 				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// The last block is joined even if it's empty when no block was, for
+	// the fields of the RHS.
+	if blockRows != 0 || blocks == 0 {
+		return joinBlock()
+	}
+	return nil
+}
 
-				if cmp == 0 {
-					// we have a match!
-					res.Rows = append(res.Rows, joinRows(currentLHSRow, currentRHSRow, hj.Cols))
-				}
+// probe returns the joined rows of the RHS rows matching the rows of the
+// probe table.
+func (hj *HashJoin) probe(probeTable map[evalengine.HashCode][]row, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var joined [][]sqltypes.Value
+	for _, currentRHSRow := range rows {
+		joinVal := currentRHSRow[hj.RHSKey]
+		if joinVal.IsNull() {
+			continue
+		}
+		hashcode, err := evalengine.NullsafeHashcode(joinVal, hj.Collation, hj.ComparisonType)
+		if err != nil {
+			return nil, err
+		}
+		lftRows := probeTable[hashcode]
+		for _, currentLHSRow := range lftRows {
+			lhsVal := currentLHSRow[hj.LHSKey]
+			// hash codes can give false positives, so we need to check with a real comparison as well
+			cmp, err := evalengine.NullsafeCompare(joinVal, lhsVal, hj.Collation)
+			if err != nil {
+				return nil, err
+			}
+
+			if cmp == 0 {
+				// we have a match!
+				joined = append(joined, joinRows(currentLHSRow, currentRHSRow, hj.Cols))
 			}
 		}
-		if len(res.Rows) != 0 || len(res.Fields) != 0 {
-			return callback(res)
+	}
+	return joined, nil
+}
+
+// streamSpilled partitions the RHS on disk like the LHS of the join, and
+// joins the partitions one at a time. The probe table of each partition of
+// the LHS must fit in the memory budget of the query.
+func (hj *HashJoin) streamSpilled(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, lfields []*querypb.Field, spilled *graceHashJoin, callback func(*sqltypes.Result) error) error {
+	var rfields []*querypb.Field
+	err := vcursor.StreamExecutePrimitive(hj.Right, bindVars, wantfields, func(result *sqltypes.Result) error {
+		if len(rfields) == 0 && len(result.Fields) != 0 {
+			rfields = result.Fields
+		}
+		for _, current := range result.Rows {
+			joinVal := current[hj.RHSKey]
+			if joinVal.IsNull() {
+				continue
+			}
+			hashcode, err := evalengine.NullsafeHashcode(joinVal, hj.Collation, hj.ComparisonType)
+			if err != nil {
+				return err
+			}
+			if err := spilled.addRight(hashcode, current); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	if len(rfields) != 0 {
+		if err := callback(&sqltypes.Result{Fields: joinFields(lfields, rfields, hj.Cols)}); err != nil {
+			return err
+		}
+	}
+
+	qm := vcursor.QueryMemory()
+	for i := 0; i < graceHashJoinPartitions; i++ {
+		if err := hj.joinPartition(qm, spilled.left[i], spilled.right[i], callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (hj *HashJoin) joinPartition(qm *QueryMemory, left, right *spillFile, callback func(*sqltypes.Result) error) error {
+	var used int64
+	defer func() { qm.Shrink(used) }()
+	probeTable := map[evalengine.HashCode][]row{}
+	reader, err := left.reader()
+	if err != nil {
+		return err
+	}
+	for {
+		current, err := reader.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		size := rowMemorySize(current)
+		if !qm.Grow(size) {
+			return qm.exceededError()
+		}
+		used += size
+		hashcode, err := evalengine.NullsafeHashcode(current[hj.LHSKey], hj.Collation, hj.ComparisonType)
+		if err != nil {
+			return err
+		}
+		probeTable[hashcode] = append(probeTable[hashcode], current)
+	}
+
+	reader, err = right.reader()
+	if err != nil {
+		return err
+	}
+	batch := make([][]sqltypes.Value, 0, spillBatchRows)
+	for {
+		current, err := reader.next()
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if current != nil {
+			batch = append(batch, current)
+		}
+		if len(batch) == spillBatchRows || (err == io.EOF && len(batch) != 0) {
+			rows, err := hj.probe(probeTable, batch)
+			if err != nil {
+				return err
+			}
+			if len(rows) != 0 {
+				if err := callback(&sqltypes.Result{Rows: rows}); err != nil {
+					return err
+				}
+			}
+			batch = batch[:0]
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
 }
 
 // RouteType implements the Primitive interface
@@ -249,9 +478,14 @@ func (hj *HashJoin) description() PrimitiveDescription {
 	if coll != nil {
 		other["Collation"] = coll.Name()
 	}
+	variant := "Hash" + hj.Opcode.String()
+	if hj.BlockRows > 0 {
+		variant = "BlockNestedLoop" + hj.Opcode.String()
+		other["BlockRows"] = hj.BlockRows
+	}
 	return PrimitiveDescription{
 		OperatorType: "Join",
-		Variant:      "Hash" + hj.Opcode.String(),
+		Variant:      variant,
 		Other:        other,
 	}
 }
@@ -17,8 +17,10 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -144,3 +146,158 @@ func TestHashJoinExecuteDifferentType(t *testing.T) {
 		"5|c| 5.0toto|g",
 	))
 }
+
+func TestHashJoinStreamExecuteSpill(t *testing.T) {
+	defer func() { testQueryMemory = nil }()
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+				"4|d",
+				"null|e",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"1|w",
+				"3|x",
+				"5|y",
+				"3|z",
+				"null|n",
+			),
+		},
+	}
+	jn := &HashJoin{
+		Opcode:         InnerJoin,
+		Left:           leftPrim,
+		Right:          rightPrim,
+		Cols:           []int{-1, -2, 1, 2},
+		LHSKey:         0,
+		RHSKey:         0,
+		ComparisonType: querypb.Type_INT64,
+	}
+	joinedRows := func() ([]*querypb.Field, []string, error) {
+		leftPrim.rewind()
+		rightPrim.rewind()
+		var fields []*querypb.Field
+		var rows []string
+		err := jn.TryStreamExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true, func(qr *sqltypes.Result) error {
+			if len(qr.Fields) != 0 {
+				fields = qr.Fields
+			}
+			for _, row := range qr.Rows {
+				rows = append(rows, fmt.Sprintf("%v", row))
+			}
+			return nil
+		})
+		return fields, rows, err
+	}
+	want := []string{
+		`[INT64(1) VARCHAR("a") INT64(1) VARCHAR("w")]`,
+		`[INT64(3) VARCHAR("c") INT64(3) VARCHAR("x")]`,
+		`[INT64(3) VARCHAR("c") INT64(3) VARCHAR("z")]`,
+	}
+
+	// Each row takes 66 bytes, so that the probe table does not fit in the
+	// budget.
+	testQueryMemory = NewQueryMemory(150, t.TempDir())
+	spills := querySpills.Counts()["HashJoin"]
+	fields, rows, err := joinedRows()
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestFields("col1|col2|col3|col4", "int64|varchar|int64|varchar"), fields)
+	assert.ElementsMatch(t, want, rows)
+	assert.EqualValues(t, 1, querySpills.Counts()["HashJoin"]-spills)
+	assert.Zero(t, testQueryMemory.Used())
+
+	// The join does not spill within the budget.
+	testQueryMemory = NewQueryMemory(1000, t.TempDir())
+	_, rows, err = joinedRows()
+	require.NoError(t, err)
+	assert.Equal(t, want, rows)
+	assert.EqualValues(t, 1, querySpills.Counts()["HashJoin"]-spills)
+
+	// Without spilling, the query fails.
+	testQueryMemory = NewQueryMemory(150, "")
+	_, _, err = joinedRows()
+	require.EqualError(t, err, "query memory budget of 150 bytes exceeded")
+}
+
+func TestHashJoinStreamExecuteBlocks(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"null|e",
+				"2|b",
+				"3|c",
+				"4|d",
+			),
+		},
+	}
+	right := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col3|col4",
+			"int64|varchar",
+		),
+		"1|w",
+		"3|x",
+		"5|y",
+		"3|z",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{right, right},
+	}
+	jn := &HashJoin{
+		Opcode:         InnerJoin,
+		Left:           leftPrim,
+		Right:          rightPrim,
+		Cols:           []int{-1, -2, 1, 2},
+		LHSKey:         0,
+		RHSKey:         0,
+		ComparisonType: querypb.Type_INT64,
+		BlockRows:      2,
+	}
+	var fields []*querypb.Field
+	var rows []string
+	err := jn.TryStreamExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+		}
+		for _, row := range qr.Rows {
+			// The rows of each block are returned before the RHS is
+			// streamed for the next block.
+			rows = append(rows, fmt.Sprintf("%d %v", len(rightPrim.log), row))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The RHS is streamed once per block of two rows of the LHS.
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+		`StreamExecute  true`,
+	})
+	assert.Equal(t, sqltypes.MakeTestFields("col1|col2|col3|col4", "int64|varchar|int64|varchar"), fields)
+	assert.Equal(t, []string{
+		`1 [INT64(1) VARCHAR("a") INT64(1) VARCHAR("w")]`,
+		`2 [INT64(3) VARCHAR("c") INT64(3) VARCHAR("x")]`,
+		`2 [INT64(3) VARCHAR("c") INT64(3) VARCHAR("z")]`,
+	}, rows)
+	assert.Equal(t, "BlockNestedLoopJoin", jn.description().Variant)
+}
@@ -107,6 +107,16 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 		comparers: extractSlices(ms.OrderBy),
 		reverse:   true,
 	}
+	// The rows exceeding the memory budget of the query are sorted on disk.
+	qm := vcursor.QueryMemory()
+	var used int64
+	defer func() { qm.Shrink(used) }()
+	var spilled *externalSort
+	defer func() {
+		if spilled != nil {
+			spilled.close()
+		}
+	}()
 	err = vcursor.StreamExecutePrimitive(ms.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
 			if err := cb(&sqltypes.Result{Fields: qr.Fields}); err != nil {
@@ -114,11 +124,32 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 			}
 		}
 		for _, row := range qr.Rows {
+			size := rowMemorySize(row)
+			if !qm.Grow(size) {
+				if !qm.CanSpill() {
+					return qm.exceededError()
+				}
+				if spilled == nil {
+					spilled = &externalSort{dir: qm.spillDir}
+				}
+				if err := spilled.spill(sh); err != nil {
+					return err
+				}
+				qm.Shrink(used)
+				used = 0
+				if !qm.Grow(size) {
+					return qm.exceededError()
+				}
+			}
+			used += size
 			heap.Push(sh, row)
 			// Remove the highest element from the heap if the size is more than the count
 			// This optimization means that the maximum size of the heap is going to be (count + 1)
 			for len(sh.rows) > count {
-				_ = heap.Pop(sh)
+				popped := heap.Pop(sh).([]sqltypes.Value)
+				size := rowMemorySize(popped)
+				qm.Shrink(size)
+				used -= size
 			}
 		}
 		if vcursor.ExceedsMaxMemoryRows(len(sh.rows)) {
@@ -139,6 +170,11 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 		// Unreachable.
 		return sh.err
 	}
+	if spilled != nil {
+		return spilled.merge(sh.rows, sh.comparers, count, func(rows [][]sqltypes.Value) error {
+			return cb(&sqltypes.Result{Rows: rows})
+		})
+	}
 	return cb(&sqltypes.Result{Rows: sh.rows})
 }
 
@@ -19,6 +19,8 @@ package engine
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/test/utils"
 
@@ -663,3 +665,64 @@ func TestMemorySortExecuteNoVarChar(t *testing.T) {
 		t.Errorf("StreamExecute err: %v, want %v", err, want)
 	}
 }
+
+func TestMemorySortStreamExecuteSpill(t *testing.T) {
+	defer func() { testQueryMemory = nil }()
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"5|e",
+			"3|c",
+			"7|g",
+			"1|a",
+			"6|f",
+			"2|b",
+			"4|d",
+		)},
+	}
+	ms := &MemorySort{
+		OrderBy: []OrderByParams{{
+			Col:             0,
+			WeightStringCol: -1,
+		}},
+		Input: fp,
+	}
+	sortedRows := func(bv map[string]*querypb.BindVariable) ([]string, error) {
+		fp.rewind()
+		var rows []string
+		err := ms.TryStreamExecute(&noopVCursor{}, bv, true, func(qr *sqltypes.Result) error {
+			for _, row := range qr.Rows {
+				rows = append(rows, row[1].ToString())
+			}
+			return nil
+		})
+		return rows, err
+	}
+
+	// Each row takes 66 bytes, so that only two rows fit in the budget.
+	testQueryMemory = NewQueryMemory(150, t.TempDir())
+	spills := querySpills.Counts()["Sort"]
+	rows, err := sortedRows(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f", "g"}, rows)
+	assert.EqualValues(t, 3, querySpills.Counts()["Sort"]-spills)
+	assert.Zero(t, testQueryMemory.Used())
+
+	upperlimit, err := sqlparser.NewPlanValue(sqlparser.NewArgument("__upper_limit"))
+	require.NoError(t, err)
+	ms.UpperLimit = upperlimit
+	rows, err = sortedRows(map[string]*querypb.BindVariable{"__upper_limit": sqltypes.Int64BindVariable(3)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, rows)
+	ms.UpperLimit = sqltypes.PlanValue{}
+
+	// Without spilling, the query fails.
+	testQueryMemory = NewQueryMemory(150, "")
+	_, err = sortedRows(nil)
+	require.EqualError(t, err, "query memory budget of 150 bytes exceeded")
+	assert.Zero(t, testQueryMemory.Used())
+}
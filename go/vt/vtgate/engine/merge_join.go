@@ -0,0 +1,340 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*MergeJoin)(nil)
+
+// MergeJoin joins two inputs which are both ordered by their join column.
+// Both inputs are read at the same time, and the rows of the RHS are only
+// held in memory while they have the join value of the current LHS row, so
+// the streaming execution bounds its memory to the rows of a join value and
+// returns the joined rows as soon as they are found.
+type MergeJoin struct {
+	Opcode JoinOpcode
+
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They must both be ordered by their key.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. For results coming from the
+	// left query, the index values go as -1, -2, etc.
+	// For the right query, they're 1, 2, etc.
+	// If Cols is {-1, -2, 1, 2}, it means that
+	// the returned result will be {Left0, Left1, Right0, Right1}.
+	Cols []int `json:",omitempty"`
+
+	// The keys correspond to the column offset in the inputs where
+	// the join columns can be found
+	LHSKey, RHSKey int
+
+	// The join condition. Used for plan descriptions
+	ASTPred sqlparser.Expr
+
+	// Collation and ComparisonType are used to compare the join values
+	Collation      collations.ID
+	ComparisonType querypb.Type
+}
+
+// errMergeJoinDone stops the stream of the RHS once the LHS ended.
+var errMergeJoinDone = errors.New("merge join done")
+
+// TryExecute implements the Primitive interface
+func (mj *MergeJoin) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := vcursor.ExecutePrimitive(mj.Left, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := vcursor.ExecutePrimitive(mj.Right, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	fetched := false
+	merger := mj.newMerger(vcursor.QueryMemory(), func() (*sqltypes.Result, error) {
+		if fetched {
+			return nil, nil
+		}
+		fetched = true
+		return rresult, nil
+	})
+	defer merger.reset()
+	result := &sqltypes.Result{
+		Fields: joinFields(lresult.Fields, rresult.Fields, mj.Cols),
+	}
+	if result.Rows, err = merger.join(lresult.Rows); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TryStreamExecute implements the Primitive interface. The RHS is streamed
+// from another goroutine while the LHS is merged with it.
+func (mj *MergeJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	g, restoreCtx := vcursor.ErrorGroupCancellableContext()
+	defer restoreCtx()
+	ctx := vcursor.Context()
+
+	rresults := make(chan *sqltypes.Result, 1)
+	done := make(chan struct{})
+	g.Go(func() error {
+		defer close(rresults)
+		err := vcursor.StreamExecutePrimitive(mj.Right, bindVars, wantfields, func(result *sqltypes.Result) error {
+			select {
+			case rresults <- result:
+				return nil
+			case <-done:
+				return errMergeJoinDone
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err == errMergeJoinDone {
+			return nil
+		}
+		return err
+	})
+	g.Go(func() error {
+		defer close(done)
+		merger := mj.newMerger(vcursor.QueryMemory(), func() (*sqltypes.Result, error) {
+			select {
+			case result, ok := <-rresults:
+				if !ok {
+					return nil, nil
+				}
+				return result, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		})
+		defer merger.reset()
+		fieldsSent := false
+		return vcursor.StreamExecutePrimitive(mj.Left, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+			result := &sqltypes.Result{}
+			if !fieldsSent && len(lresult.Fields) != 0 {
+				// The fields of the RHS come with its first result,
+				// unless it failed.
+				if _, err := merger.head(); err != nil {
+					return err
+				}
+				if merger.fields != nil {
+					result.Fields = joinFields(lresult.Fields, merger.fields, mj.Cols)
+				}
+				fieldsSent = true
+			}
+			var err error
+			if result.Rows, err = merger.join(lresult.Rows); err != nil {
+				return err
+			}
+			if len(result.Rows) != 0 || len(result.Fields) != 0 {
+				return callback(result)
+			}
+			return nil
+		})
+	})
+	return g.Wait()
+}
+
+func (mj *MergeJoin) newMerger(qm *QueryMemory, fetch func() (*sqltypes.Result, error)) *mergeJoinMerger {
+	return &mergeJoinMerger{
+		mj:    mj,
+		qm:    qm,
+		fetch: fetch,
+	}
+}
+
+// mergeJoinMerger merges the rows of the LHS with the rows of the RHS it
+// fetches. It holds the rows of the RHS with the join value of the last
+// LHS row.
+type mergeJoinMerger struct {
+	mj    *MergeJoin
+	qm    *QueryMemory
+	fetch func() (*sqltypes.Result, error)
+
+	fields []*querypb.Field
+	rows   [][]sqltypes.Value
+	eof    bool
+
+	group    [][]sqltypes.Value
+	groupKey sqltypes.Value
+	used     int64
+}
+
+// head returns the next row of the RHS, or nil at its end.
+func (m *mergeJoinMerger) head() ([]sqltypes.Value, error) {
+	for len(m.rows) == 0 && !m.eof {
+		result, err := m.fetch()
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			m.eof = true
+			break
+		}
+		if m.fields == nil && len(result.Fields) != 0 {
+			m.fields = result.Fields
+		}
+		m.rows = result.Rows
+	}
+	if len(m.rows) == 0 {
+		return nil, nil
+	}
+	return m.rows[0], nil
+}
+
+// reset releases the rows of the current join value.
+func (m *mergeJoinMerger) reset() {
+	m.group = nil
+	m.qm.Shrink(m.used)
+	m.used = 0
+}
+
+// join returns the joined rows of the LHS rows, which must come in the
+// order of their join value after the rows previously joined.
+func (m *mergeJoinMerger) join(lrows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	mj := m.mj
+	var joined [][]sqltypes.Value
+	for _, lrow := range lrows {
+		key := lrow[mj.LHSKey]
+		if key.IsNull() {
+			continue
+		}
+		if len(m.group) != 0 {
+			cmp, err := evalengine.NullsafeCompare(key, m.groupKey, mj.Collation)
+			if err != nil {
+				return nil, err
+			}
+			if cmp < 0 {
+				continue
+			}
+			if cmp == 0 {
+				for _, rrow := range m.group {
+					joined = append(joined, joinRows(lrow, rrow, mj.Cols))
+				}
+				continue
+			}
+			m.reset()
+		}
+		for {
+			rrow, err := m.head()
+			if err != nil {
+				return nil, err
+			}
+			if rrow == nil {
+				break
+			}
+			rkey := rrow[mj.RHSKey]
+			cmp := -1
+			if !rkey.IsNull() {
+				if cmp, err = evalengine.NullsafeCompare(rkey, key, mj.Collation); err != nil {
+					return nil, err
+				}
+			}
+			if cmp > 0 {
+				break
+			}
+			m.rows = m.rows[1:]
+			if cmp < 0 {
+				continue
+			}
+			size := rowMemorySize(rrow)
+			if !m.qm.Grow(size) {
+				return nil, m.qm.exceededError()
+			}
+			m.used += size
+			m.group = append(m.group, rrow)
+			m.groupKey = rkey
+		}
+		for _, rrow := range m.group {
+			joined = append(joined, joinRows(lrow, rrow, mj.Cols))
+		}
+	}
+	return joined, nil
+}
+
+// RouteType implements the Primitive interface
+func (mj *MergeJoin) RouteType() string {
+	return "MergeJoin"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (mj *MergeJoin) GetKeyspaceName() string {
+	if mj.Left.GetKeyspaceName() == mj.Right.GetKeyspaceName() {
+		return mj.Left.GetKeyspaceName()
+	}
+	return mj.Left.GetKeyspaceName() + "_" + mj.Right.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (mj *MergeJoin) GetTableName() string {
+	return mj.Left.GetTableName() + "_" + mj.Right.GetTableName()
+}
+
+// GetFields implements the Primitive interface
+func (mj *MergeJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := mj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := mj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, mj.Cols)}, nil
+}
+
+// NeedsTransaction implements the Primitive interface
+func (mj *MergeJoin) NeedsTransaction() bool {
+	return mj.Right.NeedsTransaction() || mj.Left.NeedsTransaction()
+}
+
+// Inputs implements the Primitive interface
+func (mj *MergeJoin) Inputs() []Primitive {
+	return []Primitive{mj.Left, mj.Right}
+}
+
+// description implements the Primitive interface
+func (mj *MergeJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":         mj.GetTableName(),
+		"JoinColumnIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(mj.Cols)), ","), "[]"),
+		"Predicate":         sqlparser.String(mj.ASTPred),
+		"ComparisonType":    mj.ComparisonType.String(),
+	}
+	coll := collations.Local().LookupByID(mj.Collation)
+	if coll != nil {
+		other["Collation"] = coll.Name()
+	}
+	return PrimitiveDescription{
+		OperatorType: "Join",
+		Variant:      "Merge" + mj.Opcode.String(),
+		Other:        other,
+	}
+}
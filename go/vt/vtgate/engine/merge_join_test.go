@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newMergeJoinInputs() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"null|n",
+				"1|a",
+				"2|b",
+				"3|c",
+				"3|d",
+				"6|e",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"null|m",
+				"0|v",
+				"1|w",
+				"3|x",
+				"3|y",
+				"4|z",
+				"6|u",
+				"7|t",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+var mergeJoinWant = []string{
+	`[INT64(1) VARCHAR("a") INT64(1) VARCHAR("w")]`,
+	`[INT64(3) VARCHAR("c") INT64(3) VARCHAR("x")]`,
+	`[INT64(3) VARCHAR("c") INT64(3) VARCHAR("y")]`,
+	`[INT64(3) VARCHAR("d") INT64(3) VARCHAR("x")]`,
+	`[INT64(3) VARCHAR("d") INT64(3) VARCHAR("y")]`,
+	`[INT64(6) VARCHAR("e") INT64(6) VARCHAR("u")]`,
+}
+
+func TestMergeJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := newMergeJoinInputs()
+	jn := &MergeJoin{
+		Opcode:         InnerJoin,
+		Left:           leftPrim,
+		Right:          rightPrim,
+		Cols:           []int{-1, -2, 1, 2},
+		ComparisonType: querypb.Type_INT64,
+	}
+	r, err := jn.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestFields("col1|col2|col3|col4", "int64|varchar|int64|varchar"), r.Fields)
+	var rows []string
+	for _, row := range r.Rows {
+		rows = append(rows, fmt.Sprintf("%v", row))
+	}
+	assert.Equal(t, mergeJoinWant, rows)
+}
+
+func TestMergeJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := newMergeJoinInputs()
+	jn := &MergeJoin{
+		Opcode:         InnerJoin,
+		Left:           leftPrim,
+		Right:          rightPrim,
+		Cols:           []int{-1, -2, 1, 2},
+		ComparisonType: querypb.Type_INT64,
+	}
+	var fields []*querypb.Field
+	var rows []string
+	err := jn.TryStreamExecute(&noopVCursor{ctx: context.Background()}, map[string]*querypb.BindVariable{}, true, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+		}
+		for _, row := range qr.Rows {
+			rows = append(rows, fmt.Sprintf("%v", row))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestFields("col1|col2|col3|col4", "int64|varchar|int64|varchar"), fields)
+	assert.Equal(t, mergeJoinWant, rows)
+	leftPrim.ExpectLog(t, []string{`StreamExecute  true`})
+	rightPrim.ExpectLog(t, []string{`StreamExecute  true`})
+}
+
+func TestMergeJoinStreamExecuteError(t *testing.T) {
+	leftPrim, rightPrim := newMergeJoinInputs()
+	rightPrim.results = []*sqltypes.Result{nil}
+	rightPrim.sendErr = errors.New("right err")
+	jn := &MergeJoin{
+		Opcode:         InnerJoin,
+		Left:           leftPrim,
+		Right:          rightPrim,
+		Cols:           []int{-1, -2, 1, 2},
+		ComparisonType: querypb.Type_INT64,
+	}
+	err := jn.TryStreamExecute(&noopVCursor{ctx: context.Background()}, map[string]*querypb.BindVariable{}, true, func(qr *sqltypes.Result) error {
+		return nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "right err")
+
+	// The RHS stops once the LHS ended.
+	leftPrim, rightPrim = newMergeJoinInputs()
+	leftPrim.results[0].Rows = leftPrim.results[0].Rows[:2]
+	jn.Left, jn.Right = leftPrim, rightPrim
+	var rows []string
+	err = jn.TryStreamExecute(&noopVCursor{ctx: context.Background()}, map[string]*querypb.BindVariable{}, true, func(qr *sqltypes.Result) error {
+		for _, row := range qr.Rows {
+			rows = append(rows, fmt.Sprintf("%v", row))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, mergeJoinWant[:1], rows)
+}
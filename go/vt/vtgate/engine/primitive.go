@@ -62,9 +62,18 @@ type (
 		// if the max memory rows override directive is set to true
 		ExceedsMaxMemoryRows(numRows int) bool
 
+		// QueryMemory returns the memory accounting of the query, or nil
+		// if the query has no memory budget.
+		QueryMemory() *QueryMemory
+
 		// SetContextTimeout updates the context and sets a timeout.
 		SetContextTimeout(timeout time.Duration) context.CancelFunc
 
+		// SetContextAsOf updates the context and the tablet type to read
+		// the data as of the given time, from a delayed replica. The
+		// returned function restores the previous context and tablet type.
+		SetContextAsOf(asOf time.Time) (func(), error)
+
 		// ErrorGroupCancellableContext updates context that can be cancelled.
 		ErrorGroupCancellableContext() (*errgroup.Group, func())
 
@@ -92,6 +101,11 @@ type (
 
 		SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error
 
+		// TablesWithApprovalPolicy returns the tables of the keyspace which
+		// have an approval policy. Their schema changes must be applied with
+		// ApplySchema and an approval token, and cannot go through vtgate.
+		TablesWithApprovalPolicy(keyspace string) (map[string]bool, error)
+
 		Session() SessionActions
 
 		ConnCollation() collations.ID
@@ -100,6 +114,12 @@ type (
 
 		InTransactionAndIsDML() bool
 
+		InTransaction() bool
+
+		// AfterTransaction calls f once the transaction of the session
+		// commits or rolls back, or right away outside of a transaction.
+		AfterTransaction(f func())
+
 		LookupRowLockShardSession() vtgatepb.CommitOrder
 
 		FindRoutedTable(tablename sqlparser.TableName) (*vindexes.Table, error)
@@ -141,6 +161,10 @@ type (
 		SetSQLSelectLimit(int64) error
 		SetTransactionMode(vtgatepb.TransactionMode)
 		SetWorkload(querypb.ExecuteOptions_Workload)
+		SetTransactionIsolation(querypb.ExecuteOptions_TransactionIsolation)
+		SetTransactionTimeout(time.Duration)
+		SetScatterPolicy(querypb.ExecuteOptions_ScatterPolicy)
+		SetMaxShardsPerQuery(int64)
 		SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion)
 		SetFoundRows(uint64)
 
@@ -156,9 +180,15 @@ type (
 		SetReadAfterWriteGTID(string)
 		SetReadAfterWriteTimeout(float64)
 		SetSessionTrackGTIDs(bool)
+		// SetReadYourWrites makes the replica reads of the session wait for the writes of the session
+		SetReadYourWrites(bool) error
 
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
+		// AddTempTable records a temporary table created in the temp table keyspace
+		AddTempTable(name string)
+		// RemoveTempTable forgets a temporary table dropped from the temp table keyspace
+		RemoveTempTable(name string)
 		GetWarnings() []*querypb.QueryWarning
 	}
 
@@ -173,6 +203,11 @@ type (
 		Instructions Primitive               // Instructions contains the instructions needed to fulfil the query.
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
 		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
+		// MaxRows is the number of rows above which the query fails, and
+		// Workload the workload with which its queries are sent to the tablets,
+		// from the query limits of the vschema of its tables.
+		MaxRows  int
+		Workload querypb.ExecuteOptions_Workload
 
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
@@ -275,6 +310,8 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		QueryType    string
 		Original     string                `json:",omitempty"`
 		Instructions *PrimitiveDescription `json:",omitempty"`
+		MaxRows      int                   `json:",omitempty"`
+		Workload     string                `json:",omitempty"`
 		ExecCount    uint64                `json:",omitempty"`
 		ExecTime     time.Duration         `json:",omitempty"`
 		ShardQueries uint64                `json:",omitempty"`
@@ -285,6 +322,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
 		Instructions: instructions,
+		MaxRows:      p.MaxRows,
 		ExecCount:    atomic.LoadUint64(&p.ExecCount),
 		ExecTime:     time.Duration(atomic.LoadUint64(&p.ExecTime)),
 		ShardQueries: atomic.LoadUint64(&p.ShardQueries),
@@ -292,6 +330,9 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsReturned: atomic.LoadUint64(&p.RowsReturned),
 		Errors:       atomic.LoadUint64(&p.Errors),
 	}
+	if p.Workload != querypb.ExecuteOptions_UNSPECIFIED {
+		marshalPlan.Workload = p.Workload.String()
+	}
 	return json.Marshal(marshalPlan)
 }
 
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"sync/atomic"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// valueMemorySize is the size of a sqltypes.Value, without its bytes.
+const valueMemorySize = 32
+
+// QueryMemory accounts the memory the primitives of a query use to hold
+// intermediate results, like the rows of a sort or the probe table of a hash
+// join, within the memory budget of the query. The primitives which would
+// exceed the budget spill their intermediate results to disk if spilling is
+// enabled, and fail otherwise. A nil QueryMemory has no budget.
+type QueryMemory struct {
+	budget   int64
+	spillDir string
+	used     int64
+}
+
+// NewQueryMemory returns the memory accounting of a query with a budget in
+// bytes. It returns nil if the budget is not positive. If spillDir is empty,
+// the primitives do not spill to disk.
+func NewQueryMemory(budget int64, spillDir string) *QueryMemory {
+	if budget <= 0 {
+		return nil
+	}
+	return &QueryMemory{
+		budget:   budget,
+		spillDir: spillDir,
+	}
+}
+
+// Grow accounts size more bytes. It returns false, without accounting them,
+// if they would exceed the budget.
+func (qm *QueryMemory) Grow(size int64) bool {
+	if qm == nil {
+		return true
+	}
+	if atomic.AddInt64(&qm.used, size) > qm.budget {
+		atomic.AddInt64(&qm.used, -size)
+		return false
+	}
+	return true
+}
+
+// Shrink releases size bytes accounted by Grow.
+func (qm *QueryMemory) Shrink(size int64) {
+	if qm == nil {
+		return
+	}
+	atomic.AddInt64(&qm.used, -size)
+}
+
+// Used returns the number of bytes currently accounted.
+func (qm *QueryMemory) Used() int64 {
+	if qm == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&qm.used)
+}
+
+// CanSpill returns whether the primitives exceeding the budget spill to disk.
+func (qm *QueryMemory) CanSpill() bool {
+	return qm != nil && qm.spillDir != ""
+}
+
+func (qm *QueryMemory) exceededError() error {
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query memory budget of %d bytes exceeded", qm.budget)
+}
+
+// rowMemorySize estimates the memory used by a row.
+func rowMemorySize(row []sqltypes.Value) int64 {
+	size := int64(len(row)) * valueMemorySize
+	for _, v := range row {
+		size += int64(len(v.Raw()))
+	}
+	return size
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// CTEMaxRecursionDepth is the maximum number of iterations of a recursive
+// common table expression evaluated at vtgate, as the default of the
+// cte_max_recursion_depth variable of MySQL.
+const CTEMaxRecursionDepth = 1000
+
+var _ Primitive = (*RecursiveCTE)(nil)
+
+// RecursiveCTE is a primitive that evaluates a recursive common table
+// expression. The rows of the anchor are the first iteration. Each
+// following iteration is made of the rows the recursive part returns for
+// the rows of the previous one, until it returns no rows.
+type RecursiveCTE struct {
+	Anchor    Primitive
+	Recursive Primitive
+	// Vars are the bind variables of the recursive part, set to the
+	// columns of a row of the previous iteration.
+	Vars map[string]int
+	// Distinct removes the rows which were already returned, for a UNION
+	// DISTINCT.
+	Distinct bool
+	// Cols are the columns of the expression returned, and ColNames the
+	// names of their fields.
+	Cols     []int
+	ColNames []string
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (rc *RecursiveCTE) RouteType() string {
+	return rc.Anchor.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (rc *RecursiveCTE) GetKeyspaceName() string {
+	return rc.Anchor.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (rc *RecursiveCTE) GetTableName() string {
+	return rc.Anchor.GetTableName()
+}
+
+// TryExecute satisfies the Primitive interface.
+func (rc *RecursiveCTE) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	err := rc.evaluate(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		result.AppendResult(qr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TryStreamExecute satisfies the Primitive interface.
+func (rc *RecursiveCTE) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return rc.evaluate(vcursor, bindVars, wantfields, callback)
+}
+
+// evaluate returns the rows of each iteration to the callback.
+func (rc *RecursiveCTE) evaluate(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	anchor, err := vcursor.ExecutePrimitive(rc.Anchor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	if wantfields {
+		if err := callback(&sqltypes.Result{Fields: rc.fields(anchor.Fields)}); err != nil {
+			return err
+		}
+	}
+	seen := map[string]bool{}
+	rows := rc.newRows(anchor.Rows, seen)
+	for depth := 0; len(rows) > 0; depth++ {
+		// The rows already returned are kept to remove their duplicates.
+		if vcursor.ExceedsMaxMemoryRows(len(rows) + len(seen)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		if err := callback(&sqltypes.Result{Rows: rc.project(rows)}); err != nil {
+			return err
+		}
+		if depth == CTEMaxRecursionDepth {
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.", depth+1)
+		}
+		var next [][]sqltypes.Value
+		for _, row := range rows {
+			joinVars := make(map[string]*querypb.BindVariable, len(rc.Vars))
+			for name, col := range rc.Vars {
+				joinVars[name] = sqltypes.ValueBindVariable(row[col])
+			}
+			qr, err := vcursor.ExecutePrimitive(rc.Recursive, combineVars(bindVars, joinVars), false)
+			if err != nil {
+				return err
+			}
+			next = append(next, qr.Rows...)
+		}
+		rows = rc.newRows(next, seen)
+	}
+	return nil
+}
+
+// newRows returns the rows of an iteration which were not returned yet.
+func (rc *RecursiveCTE) newRows(rows [][]sqltypes.Value, seen map[string]bool) [][]sqltypes.Value {
+	if !rc.Distinct {
+		return rows
+	}
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		key := rowKey(row)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, row)
+	}
+	return out
+}
+
+func rowKey(row []sqltypes.Value) string {
+	var key strings.Builder
+	for _, v := range row {
+		if v.IsNull() {
+			key.WriteString("n")
+			continue
+		}
+		fmt.Fprintf(&key, "%d:", len(v.Raw()))
+		key.Write(v.Raw())
+	}
+	return key.String()
+}
+
+func (rc *RecursiveCTE) project(rows [][]sqltypes.Value) [][]sqltypes.Value {
+	out := make([][]sqltypes.Value, 0, len(rows))
+	for _, row := range rows {
+		projected := make([]sqltypes.Value, 0, len(rc.Cols))
+		for _, col := range rc.Cols {
+			projected = append(projected, row[col])
+		}
+		out = append(out, projected)
+	}
+	return out
+}
+
+func (rc *RecursiveCTE) fields(input []*querypb.Field) []*querypb.Field {
+	if input == nil {
+		return nil
+	}
+	fields := make([]*querypb.Field, 0, len(rc.Cols))
+	for i, col := range rc.Cols {
+		field := proto.Clone(input[col]).(*querypb.Field)
+		field.Name = rc.ColNames[i]
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// GetFields satisfies the Primitive interface.
+func (rc *RecursiveCTE) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := rc.Anchor.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: rc.fields(qr.Fields)}, nil
+}
+
+// Inputs returns the anchor and the recursive part of the expression.
+func (rc *RecursiveCTE) Inputs() []Primitive {
+	return []Primitive{rc.Anchor, rc.Recursive}
+}
+
+// NeedsTransaction implements the Primitive interface.
+func (rc *RecursiveCTE) NeedsTransaction() bool {
+	return rc.Anchor.NeedsTransaction() || rc.Recursive.NeedsTransaction()
+}
+
+func (rc *RecursiveCTE) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Vars":          rc.Vars,
+		"ResultColumns": strings.Join(rc.ColNames, ", "),
+	}
+	if rc.Distinct {
+		other["Distinct"] = true
+	}
+	return PrimitiveDescription{
+		OperatorType: "RecursiveCTE",
+		Other:        other,
+	}
+}
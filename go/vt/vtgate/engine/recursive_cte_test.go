@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+)
+
+func TestRecursiveCTEExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|parent", "int64|int64")
+	anchor := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|null")},
+	}
+	recursive := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "2|1", "3|1"),
+			sqltypes.MakeTestResult(fields, "4|2"),
+			sqltypes.MakeTestResult(fields),
+			sqltypes.MakeTestResult(fields),
+		},
+	}
+	rc := &RecursiveCTE{
+		Anchor:    anchor,
+		Recursive: recursive,
+		Vars:      map[string]int{"tree_id": 0},
+		Cols:      []int{1, 0},
+		ColNames:  []string{"p", "id"},
+	}
+
+	result, err := rc.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("p|id", "int64|int64"),
+		"null|1",
+		"1|2",
+		"1|3",
+		"2|4",
+	), result)
+	utils.MustMatch(t, []string{
+		`Execute tree_id: type:INT64 value:"1" false`,
+		`Execute tree_id: type:INT64 value:"2" false`,
+		`Execute tree_id: type:INT64 value:"3" false`,
+		`Execute tree_id: type:INT64 value:"4" false`,
+	}, recursive.log)
+}
+
+func TestRecursiveCTEDistinct(t *testing.T) {
+	fields := sqltypes.MakeTestFields("n", "int64")
+	rc := &RecursiveCTE{
+		Anchor: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1", "1")},
+		},
+		Recursive: &fakePrimitive{
+			results: []*sqltypes.Result{
+				sqltypes.MakeTestResult(fields, "1", "2"),
+				sqltypes.MakeTestResult(fields, "1"),
+			},
+		},
+		Vars:     map[string]int{"n": 0},
+		Distinct: true,
+		Cols:     []int{0},
+		ColNames: []string{"n"},
+	}
+
+	var results []*sqltypes.Result
+	err := rc.TryStreamExecute(&noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestStreamingResults(fields, "1", "---", "2")[1:], results)
+}
+
+func TestRecursiveCTEMaxRecursionDepth(t *testing.T) {
+	fields := sqltypes.MakeTestFields("n", "int64")
+	recursive := &fakePrimitive{}
+	for i := 0; i < CTEMaxRecursionDepth; i++ {
+		recursive.results = append(recursive.results, sqltypes.MakeTestResult(fields, "1"))
+	}
+	rc := &RecursiveCTE{
+		Anchor: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1")},
+		},
+		Recursive: recursive,
+		Vars:      map[string]int{"n": 0},
+		Cols:      []int{0},
+		ColNames:  []string{"n"},
+	}
+
+	_, err := rc.TryExecute(&noopVCursor{}, nil, false)
+	require.EqualError(t, err, "Recursive query aborted after 1001 iterations. Try increasing @@cte_max_recursion_depth to a larger value.")
+}
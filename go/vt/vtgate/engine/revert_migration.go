@@ -81,6 +81,17 @@ func (v *RevertMigration) TryExecute(vcursor VCursor, bindVars map[string]*query
 		Rows: [][]sqltypes.Value{},
 	}
 
+	// The table of the reverted migration is only known to the tablets, so
+	// the reverts are rejected if any table of the keyspace has an approval
+	// policy.
+	tables, err := vcursor.TablesWithApprovalPolicy(v.GetKeyspaceName())
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) != 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "keyspace %s has tables with an approval policy, its migrations must be reverted with ApplySchema", v.GetKeyspaceName())
+	}
+
 	sql := fmt.Sprintf("revert %s", v.Stmt.UUID)
 
 	ddlStrategySetting, err := schema.ParseDDLStrategy(vcursor.Session().GetDDLStrategy())
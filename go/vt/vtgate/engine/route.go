@@ -75,8 +75,10 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a multi-column vindex, they are the values of the columns, or of
+	// a prefix of the columns for a vindex which maps partial values.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -95,6 +97,9 @@ type Route struct {
 	// ScatterErrorsAsWarnings is true if results should be returned even if some shards have an error
 	ScatterErrorsAsWarnings bool
 
+	// AsOf is the optional point in time the data is read as of, from delayed replicas
+	AsOf time.Time
+
 	// The following two fields are used when routing information_schema queries
 	SysTableTableSchema []evalengine.Expr
 	SysTableTableName   map[string]evalengine.Expr
@@ -255,6 +260,13 @@ func (route *Route) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bin
 		cancel := vcursor.SetContextTimeout(time.Duration(route.QueryTimeout) * time.Millisecond)
 		defer cancel()
 	}
+	if !route.AsOf.IsZero() {
+		restore, err := vcursor.SetContextAsOf(route.AsOf)
+		if err != nil {
+			return nil, err
+		}
+		defer restore()
+	}
 	qr, err := route.executeInternal(vcursor, bindVars, wantfields)
 	if err != nil {
 		return nil, err
@@ -262,29 +274,32 @@ func (route *Route) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bin
 	return qr.Truncate(route.TruncateColumnCount), nil
 }
 
-func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	var rss []*srvtopo.ResolvedShard
-	var bvs []map[string]*querypb.BindVariable
-	var err error
+// findRoute returns the shards the query is sent to, and the bind variables
+// of each shard.
+func (route *Route) findRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	switch route.Opcode {
 	case SelectDBA:
-		rss, bvs, err = route.paramsSystemQuery(vcursor, bindVars)
+		return route.paramsSystemQuery(vcursor, bindVars)
 	case SelectUnsharded, SelectNext, SelectReference:
-		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
+		return route.paramsAnyShard(vcursor, bindVars)
 	case SelectScatter:
-		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
+		return route.paramsAllShards(vcursor, bindVars)
 	case SelectEqual, SelectEqualUnique:
-		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
+		return route.paramsSelectEqual(vcursor, bindVars)
 	case SelectIN:
-		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
+		return route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
-		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+		return route.paramsSelectMultiEqual(vcursor, bindVars)
 	case SelectNone:
-		rss, bvs, err = nil, nil, nil
+		return nil, nil, nil
 	default:
 		// Unreachable.
-		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported query route: %v", route)
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported query route: %v", route)
 	}
+}
+
+func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rss, bvs, err := route.findRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -333,31 +348,18 @@ func filterOutNilErrors(errs []error) []error {
 
 // TryStreamExecute performs a streaming exec.
 func (route *Route) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
-	var rss []*srvtopo.ResolvedShard
-	var bvs []map[string]*querypb.BindVariable
-	var err error
 	if route.QueryTimeout != 0 {
 		cancel := vcursor.SetContextTimeout(time.Duration(route.QueryTimeout) * time.Millisecond)
 		defer cancel()
 	}
-	switch route.Opcode {
-	case SelectDBA:
-		rss, bvs, err = route.paramsSystemQuery(vcursor, bindVars)
-	case SelectUnsharded, SelectNext, SelectReference:
-		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
-	case SelectScatter:
-		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
-	case SelectEqual, SelectEqualUnique:
-		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
-	case SelectIN:
-		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
-	case SelectMultiEqual:
-		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
-	case SelectNone:
-		rss, bvs, err = nil, nil, nil
-	default:
-		return fmt.Errorf("query %q cannot be used for streaming", route.Query)
+	if !route.AsOf.IsZero() {
+		restore, err := vcursor.SetContextAsOf(route.AsOf)
+		if err != nil {
+			return err
+		}
+		defer restore()
 	}
+	rss, bvs, err := route.findRoute(vcursor, bindVars)
 	if err != nil {
 		return err
 	}
@@ -588,11 +590,19 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, nil, err
+	var rss []*srvtopo.ResolvedShard
+	var err error
+	switch vindex := route.Vindex.(type) {
+	case vindexes.MultiColumn:
+		rss, err = resolveMultiColumnShards(vcursor, vindex, route.Keyspace, route.Values, bindVars)
+	default:
+		var key sqltypes.Value
+		key, err = route.Values[0].ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, err
+		}
+		rss, _, err = resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -631,7 +641,12 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	single, ok := vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex '%s' is not a single column vindex", vindex)
+	}
+
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
@@ -639,7 +654,7 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	}
 
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := single.Map(vcursor, vindexKeys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -648,6 +663,25 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	return vcursor.ResolveDestinations(keyspace.Name, ids, destinations)
 }
 
+// resolveMultiColumnShards returns the shards of the values of the columns
+// of a multi-column vindex, or of a prefix of its columns.
+func resolveMultiColumnShards(vcursor VCursor, vindex vindexes.MultiColumn, keyspace *vindexes.Keyspace, values []sqltypes.PlanValue, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, error) {
+	row := make([]sqltypes.Value, len(values))
+	for i, value := range values {
+		var err error
+		row[i], err = value.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+	}
+	destinations, err := vindex.Map(vcursor, [][]sqltypes.Value{row})
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
+	return rss, err
+}
+
 func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	var err error
 	// Since Result is immutable, we make a copy.
@@ -830,6 +864,9 @@ func (route *Route) description() PrimitiveDescription {
 	if route.QueryTimeout > 0 {
 		other["QueryTimeout"] = route.QueryTimeout
 	}
+	if !route.AsOf.IsZero() {
+		other["AsOf"] = route.AsOf.Format(time.RFC3339)
+	}
 	return PrimitiveDescription{
 		OperatorType:      "Route",
 		Variant:           routeName[route.Opcode],
@@ -330,6 +330,46 @@ func TestSelectEqualUniqueScatter(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectEqualMultiColumn(t *testing.T) {
+	vindex, _ := vindexes.NewRegionExperimental("", map[string]string{"region_bytes": "1"})
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(0x80)}, {Value: sqltypes.NewInt64(1)}}
+
+	vc := &loggingVCursor{
+		shardForKsid: []string{"80-"},
+		results:      []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(80166b40b44aba4bd6)`,
+		`ExecuteMultiShard ks.80-: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// A prefix of the columns is routed to the shards of its key range.
+	sel.Opcode = SelectEqual
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(0x20)}}
+	vc.Rewind()
+	vc.shardForKsid = []string{"20-80"}
+	result, err = wrapStreamExecute(sel, vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(20-21)`,
+		`StreamExecuteMulti dummy_select ks.20-80: {} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+}
+
 func TestSelectEqual(t *testing.T) {
 	vindex, _ := vindexes.NewLookup("", map[string]string{
 		"table": "lkp",
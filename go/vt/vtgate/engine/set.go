@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/vt/sysvars"
 
@@ -37,6 +38,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -74,6 +76,9 @@ type (
 		Keyspace          *vindexes.Keyspace
 		TargetDestination key.Destination `json:",omitempty"`
 		Expr              string
+		// Strict makes the setting fail, instead of being ignored, when its
+		// value differs from the one of the database.
+		Strict bool `json:",omitempty"`
 	}
 
 	// SysVarReservedConn implements the SetOp interface and will write the changes variable into the session
@@ -250,10 +255,17 @@ func (svci *SysVarCheckAndIgnore) Execute(vcursor VCursor, env *evalengine.Expre
 		// Rather than returning the error, we will just log the error
 		// as the intention for executing the query it to validate the current setting and eventually ignore it anyways.
 		// There is no benefit of returning the error back to client.
+		// Unless it is strict, since the setting can't be checked.
+		if svci.Strict {
+			return vterrors.Wrapf(err, "unable to validate the current settings for '%s'", svci.Name)
+		}
 		log.Warningf("unable to validate the current settings for '%s': %s", svci.Name, err.Error())
 		return nil
 	}
 	if len(result.Rows) == 0 {
+		if svci.Strict {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s: system setting can't be changed from the value of the database, and strict_system_settings is enabled", svci.Name)
+		}
 		log.Infof("Ignored inapplicable SET %v = %v", svci.Name, svci.Expr)
 	}
 	return nil
@@ -313,6 +325,23 @@ func (svs *SysVarReservedConn) Execute(vcursor VCursor, env *evalengine.Expressi
 	return vterrors.Aggregate(errs)
 }
 
+// setOnReservedConns sets the system variable on the connections the session
+// already reserved.
+func setOnReservedConns(vcursor VCursor, name string, expr string) error {
+	rss := vcursor.Session().ShardSession()
+	if len(rss) == 0 {
+		return nil
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := 0; i < len(rss); i++ {
+		queries[i] = &querypb.BoundQuery{
+			Sql: fmt.Sprintf("set @@%s = %s", name, expr),
+		}
+	}
+	_, errs := vcursor.ExecuteMultiShard(rss, queries, false /* rollbackOnError */, false /* canAutocommit */)
+	return vterrors.Aggregate(errs)
+}
+
 func (svs *SysVarReservedConn) execSetStatement(vcursor VCursor, rss []*srvtopo.ResolvedShard, env *evalengine.ExpressionEnv) error {
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := 0; i < len(rss); i++ {
@@ -327,7 +356,7 @@ func (svs *SysVarReservedConn) execSetStatement(vcursor VCursor, rss []*srvtopo.
 
 func (svs *SysVarReservedConn) checkAndUpdateSysVar(vcursor VCursor, res *evalengine.ExpressionEnv) (bool, error) {
 	sysVarExprValidationQuery := fmt.Sprintf("select %s from dual where @@%s != %s", svs.Expr, svs.Name, svs.Expr)
-	if svs.Name == "sql_mode" {
+	if svs.Name == "sql_mode" || flagsSysVars[svs.Name] {
 		sysVarExprValidationQuery = fmt.Sprintf("select @@%s orig, %s new", svs.Name, svs.Expr)
 	}
 	rss, _, err := vcursor.ResolveDestinations(svs.Keyspace.Name, nil, []key.Destination{key.DestinationKeyspaceID{0}})
@@ -344,12 +373,18 @@ func (svs *SysVarReservedConn) checkAndUpdateSysVar(vcursor VCursor, res *evalen
 	}
 
 	var value sqltypes.Value
-	if svs.Name == "sql_mode" {
+	switch {
+	case svs.Name == "sql_mode":
 		changed, value = sqlModeChangedValue(qr)
 		if !changed {
 			return false, nil
 		}
-	} else {
+	case flagsSysVars[svs.Name]:
+		changed, value = flagsChangedValue(qr)
+		if !changed {
+			return false, nil
+		}
+	default:
 		value = qr.Rows[0][0]
 	}
 	buf := new(bytes.Buffer)
@@ -401,8 +436,77 @@ func sqlModeChangedValue(qr *sqltypes.Result) (bool, sqltypes.Value) {
 	return changed, qr.Rows[0][1]
 }
 
+// flagsSysVars are the system variables whose value is a list of flags, of
+// which a SET only changes the ones it lists.
+var flagsSysVars = map[string]bool{
+	"optimizer_switch":         true,
+	"optimizer_trace":          true,
+	"optimizer_trace_features": true,
+}
+
+// flagsChangedValue returns whether the flags set by a SET change the
+// original flags, and the complete list of flags after the SET, so that
+// replaying it does not undo the previous SETs of the variable.
+func flagsChangedValue(qr *sqltypes.Result) (bool, sqltypes.Value) {
+	if len(qr.Fields) != 2 || len(qr.Rows[0]) != 2 {
+		return false, sqltypes.Value{}
+	}
+	newVal := qr.Rows[0][1].ToString()
+	if !strings.Contains(newVal, "=") {
+		// default resets all the flags.
+		return true, qr.Rows[0][1]
+	}
+
+	var names []string
+	flags := map[string]string{}
+	for _, flag := range strings.Split(qr.Rows[0][0].ToString(), ",") {
+		name, value := splitFlag(flag)
+		if _, ok := flags[name]; !ok {
+			names = append(names, name)
+		}
+		flags[name] = value
+	}
+	changed := false
+	for _, flag := range strings.Split(newVal, ",") {
+		name, value := splitFlag(flag)
+		orig, ok := flags[name]
+		if !ok {
+			names = append(names, name)
+		}
+		if orig != value {
+			changed = true
+		}
+		flags[name] = value
+	}
+	if !changed {
+		return false, sqltypes.Value{}
+	}
+	merged := make([]string, 0, len(names))
+	for _, name := range names {
+		merged = append(merged, name+"="+flags[name])
+	}
+	return true, sqltypes.NewVarChar(strings.Join(merged, ","))
+}
+
+func splitFlag(flag string) (string, string) {
+	name, value := flag, ""
+	if i := strings.IndexByte(flag, '='); i >= 0 {
+		name, value = flag[:i], flag[i+1:]
+	}
+	return strings.ToLower(strings.TrimSpace(name)), strings.ToLower(strings.TrimSpace(value))
+}
+
 var _ SetOp = (*SysVarSetAware)(nil)
 
+// transactionIsolations maps the values of the transaction_isolation system
+// variable to the isolation levels of the tablets.
+var transactionIsolations = map[string]querypb.ExecuteOptions_TransactionIsolation{
+	"READ-UNCOMMITTED": querypb.ExecuteOptions_READ_UNCOMMITTED,
+	"READ-COMMITTED":   querypb.ExecuteOptions_READ_COMMITTED,
+	"REPEATABLE-READ":  querypb.ExecuteOptions_REPEATABLE_READ,
+	"SERIALIZABLE":     querypb.ExecuteOptions_SERIALIZABLE,
+}
+
 // MarshalJSON marshals all the json
 func (svss *SysVarSetAware) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
@@ -437,6 +541,17 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env *evalengine.ExpressionE
 			return err
 		}
 		vcursor.Session().SetSQLSelectLimit(intValue) // nolint:errcheck
+	case sysvars.TxTimeout.Name:
+		seconds, err := svss.evalAsInt64(env)
+		if err != nil {
+			return err
+		}
+		// The tablets check the timeout against their maximum when the
+		// transactions begin, 0 uses their default.
+		if seconds < 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid %s: %d", svss.Name, seconds)
+		}
+		vcursor.Session().SetTransactionTimeout(time.Duration(seconds) * time.Second)
 	case sysvars.TransactionMode.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -457,6 +572,45 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env *evalengine.ExpressionE
 			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid workload: %s", str)
 		}
 		vcursor.Session().SetWorkload(querypb.ExecuteOptions_Workload(out))
+	case sysvars.ScatterPolicy.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		out, ok := querypb.ExecuteOptions_ScatterPolicy_value[strings.ToUpper(str)]
+		if !ok {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid scatter_policy: %s", str)
+		}
+		vcursor.Session().SetScatterPolicy(querypb.ExecuteOptions_ScatterPolicy(out))
+	case sysvars.MaxShardsPerQuery.Name:
+		maxShards, err := svss.evalAsInt64(env)
+		if err != nil {
+			return err
+		}
+		// 0 removes the limit
+		if maxShards < 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid %s: %d", svss.Name, maxShards)
+		}
+		vcursor.Session().SetMaxShardsPerQuery(maxShards)
+	case sysvars.TransactionIsolation.Name, sysvars.TxIsolation.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		// The tablets run the queries of the session with pooled
+		// connections of its isolation level.
+		out, ok := transactionIsolations[strings.ToUpper(str)]
+		if !ok {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid %s: %s", svss.Name, str)
+		}
+		vcursor.Session().SetTransactionIsolation(out)
+		// Reserved connections are not pooled, so the level is also set
+		// on them like the other system settings.
+		expr := sqlparser.String(sqlparser.NewStrLiteral(str))
+		vcursor.Session().SetSysVar(svss.Name, expr)
+		if err := setOnReservedConns(vcursor, svss.Name, expr); err != nil {
+			return err
+		}
 	case sysvars.DDLStrategy.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -492,6 +646,8 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env *evalengine.ExpressionE
 			return err
 		}
 		vcursor.Session().SetReadAfterWriteTimeout(val)
+	case sysvars.ReadYourWrites.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetReadYourWrites)
 	case sysvars.SessionTrackGTIDs.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
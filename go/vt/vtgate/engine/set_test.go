@@ -417,6 +417,89 @@ func TestSetTable(t *testing.T) {
 		qr: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("orig|new", "varchar|varchar"),
 			"|a",
 		)},
+	}, {
+		testName: "optimizer_switch no change",
+		setOps: []SetOp{
+			&SysVarReservedConn{
+				Name:     "optimizer_switch",
+				Keyspace: &vindexes.Keyspace{Name: "ks", Sharded: true},
+				Expr:     "'B=OFF'",
+			},
+		},
+		expectedQueryLog: []string{
+			`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+			`ExecuteMultiShard ks.-20: select @@optimizer_switch orig, 'B=OFF' new {} false false`,
+		},
+		qr: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("orig|new", "varchar|varchar"),
+			"a=on,b=off|B=OFF",
+		)},
+	}, {
+		testName: "optimizer_switch changed - merged with orig",
+		setOps: []SetOp{
+			&SysVarReservedConn{
+				Name:     "optimizer_switch",
+				Keyspace: &vindexes.Keyspace{Name: "ks", Sharded: true},
+				Expr:     "'b=on,c=off'",
+			},
+		},
+		expectedQueryLog: []string{
+			`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+			`ExecuteMultiShard ks.-20: select @@optimizer_switch orig, 'b=on,c=off' new {} false false`,
+			"SysVar set with (optimizer_switch,'a=on,b=on,c=off')",
+		},
+		qr: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("orig|new", "varchar|varchar"),
+			"a=on,b=off|b=on,c=off",
+		)},
+	}, {
+		testName: "optimizer_switch changed - default",
+		setOps: []SetOp{
+			&SysVarReservedConn{
+				Name:     "optimizer_switch",
+				Keyspace: &vindexes.Keyspace{Name: "ks", Sharded: true},
+				Expr:     "'default'",
+			},
+		},
+		expectedQueryLog: []string{
+			`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+			`ExecuteMultiShard ks.-20: select @@optimizer_switch orig, 'default' new {} false false`,
+			"SysVar set with (optimizer_switch,'default')",
+		},
+		qr: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("orig|new", "varchar|varchar"),
+			"a=on,b=off|default",
+		)},
+	}, {
+		testName: "strict sysvar check and error",
+		setOps: []SetOp{
+			&SysVarCheckAndIgnore{
+				Name:              "x",
+				Keyspace:          ks,
+				TargetDestination: key.DestinationAnyShard{},
+				Expr:              "dummy_expr",
+				Strict:            true,
+			},
+		},
+		expectedQueryLog: []string{
+			`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+			`ExecuteMultiShard ks.-20: select 1 from dual where @@x = dummy_expr {} false false`,
+		},
+		expectedError: "x: system setting can't be changed from the value of the database, and strict_system_settings is enabled",
+	}, {
+		testName: "strict sysvar checkAndIgnore execute error",
+		setOps: []SetOp{
+			&SysVarCheckAndIgnore{
+				Name:              "x",
+				Keyspace:          ks,
+				TargetDestination: key.DestinationAnyShard{},
+				Expr:              "dummy_expr",
+				Strict:            true,
+			},
+		},
+		expectedQueryLog: []string{
+			`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+			`ExecuteMultiShard ks.-20: select 1 from dual where @@x = dummy_expr {} false false`,
+		},
+		execErr:       errors.New("some random error"),
+		expectedError: "unable to validate the current settings for 'x': some random error",
 	}, {
 		testName: "sql_mode no change - empty new",
 		setOps: []SetOp{
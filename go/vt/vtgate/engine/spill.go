@@ -0,0 +1,349 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const (
+	// spillBatchRows is the number of rows of the results sent by the
+	// primitives which spilled to disk.
+	spillBatchRows = 1000
+	// graceHashJoinPartitionBits is the log2 of graceHashJoinPartitions,
+	// the number of partitions the hash joins spill their inputs to.
+	graceHashJoinPartitionBits = 4
+	graceHashJoinPartitions    = 1 << graceHashJoinPartitionBits
+)
+
+var (
+	querySpills      = stats.NewCountersWithSingleLabel("QuerySpills", "Number of intermediate results spilled to disk because their query exceeded its memory budget, by primitive", "Primitive")
+	querySpilledRows = stats.NewCountersWithSingleLabel("QuerySpilledRows", "Number of rows spilled to disk because their query exceeded its memory budget, by primitive", "Primitive")
+)
+
+// spillFile holds rows spilled to disk. The rows are written, then read
+// back in the same order.
+type spillFile struct {
+	file   *os.File
+	writer *bufio.Writer
+	buf    []byte
+}
+
+func newSpillFile(dir string) (*spillFile, error) {
+	file, err := os.CreateTemp(dir, "vtgate-spill-")
+	if err != nil {
+		return nil, err
+	}
+	return &spillFile{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+// write appends a row to the file. Each value is written as its type, the
+// length of its bytes and its bytes.
+func (sf *spillFile) write(row []sqltypes.Value) error {
+	sf.buf = appendUvarint(sf.buf[:0], uint64(len(row)))
+	for _, v := range row {
+		sf.buf = appendUvarint(sf.buf, uint64(v.Type()))
+		sf.buf = appendUvarint(sf.buf, uint64(len(v.Raw())))
+		sf.buf = append(sf.buf, v.Raw()...)
+	}
+	_, err := sf.writer.Write(sf.buf)
+	return err
+}
+
+// reader returns a reader of the rows of the file, from the first one. No
+// rows can be written once the file is read.
+func (sf *spillFile) reader() (*spillReader, error) {
+	if err := sf.writer.Flush(); err != nil {
+		return nil, err
+	}
+	if _, err := sf.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &spillReader{reader: bufio.NewReader(sf.file)}, nil
+}
+
+// close closes and removes the file.
+func (sf *spillFile) close() {
+	sf.file.Close()
+	if err := os.Remove(sf.file.Name()); err != nil {
+		log.Warningf("cannot remove the spill file %s: %v", sf.file.Name(), err)
+	}
+}
+
+type spillReader struct {
+	reader *bufio.Reader
+}
+
+// next returns the next row of the file, or io.EOF after the last one.
+func (sr *spillReader) next() ([]sqltypes.Value, error) {
+	count, err := binary.ReadUvarint(sr.reader)
+	if err != nil {
+		return nil, err
+	}
+	row := make([]sqltypes.Value, count)
+	for i := range row {
+		typ, err := binary.ReadUvarint(sr.reader)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		length, err := binary.ReadUvarint(sr.reader)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		var val []byte
+		if length > 0 {
+			val = make([]byte, length)
+			if _, err := io.ReadFull(sr.reader, val); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+		}
+		row[i] = sqltypes.MakeTrusted(querypb.Type(typ), val)
+	}
+	return row, nil
+}
+
+func appendUvarint(buf []byte, x uint64) []byte {
+	var varint [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(varint[:], x)
+	return append(buf, varint[:n]...)
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// externalSort sorts more rows than the memory budget of their query allows
+// by spilling them to disk in sorted runs, which it then merges.
+type externalSort struct {
+	dir  string
+	runs []*spillFile
+}
+
+// spill sorts the rows of the heap and writes them to a new run. It empties
+// the heap.
+func (es *externalSort) spill(sh *sortHeap) error {
+	run, err := newSpillFile(es.dir)
+	if err != nil {
+		return err
+	}
+	es.runs = append(es.runs, run)
+	reverse := sh.reverse
+	sh.reverse = false
+	sort.Sort(sh)
+	sh.reverse = reverse
+	if sh.err != nil {
+		return sh.err
+	}
+	for _, row := range sh.rows {
+		if err := run.write(row); err != nil {
+			return err
+		}
+	}
+	querySpills.Add("Sort", 1)
+	querySpilledRows.Add("Sort", int64(len(sh.rows)))
+	sh.rows = nil
+	return nil
+}
+
+// merge sends the first count rows of the runs and of the sorted rows left
+// in memory, in order, in batches of spillBatchRows rows.
+func (es *externalSort) merge(sorted [][]sqltypes.Value, comparers []*comparer, count int, callback func([][]sqltypes.Value) error) error {
+	mh := &mergeHeap{comparers: comparers}
+	var readers []*spillReader
+	for _, run := range es.runs {
+		reader, err := run.reader()
+		if err != nil {
+			return err
+		}
+		readers = append(readers, reader)
+	}
+	// The rows left in memory are the source len(readers).
+	advance := func(source int) error {
+		if source == len(readers) {
+			if len(sorted) != 0 {
+				heap.Push(mh, mergeHead{row: sorted[0], source: source})
+				sorted = sorted[1:]
+			}
+			return nil
+		}
+		row, err := readers[source].next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		heap.Push(mh, mergeHead{row: row, source: source})
+		return nil
+	}
+	for source := 0; source <= len(readers); source++ {
+		if err := advance(source); err != nil {
+			return err
+		}
+	}
+
+	var batch [][]sqltypes.Value
+	for sent := 0; mh.Len() > 0 && sent < count; sent++ {
+		head := heap.Pop(mh).(mergeHead)
+		if mh.err != nil {
+			return mh.err
+		}
+		batch = append(batch, head.row)
+		if len(batch) == spillBatchRows {
+			if err := callback(batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+		if err := advance(head.source); err != nil {
+			return err
+		}
+	}
+	if mh.err != nil {
+		return mh.err
+	}
+	if len(batch) != 0 {
+		return callback(batch)
+	}
+	return nil
+}
+
+func (es *externalSort) close() {
+	for _, run := range es.runs {
+		run.close()
+	}
+}
+
+type mergeHead struct {
+	row    []sqltypes.Value
+	source int
+}
+
+// mergeHeap orders the next rows of the sorted runs of an external sort.
+type mergeHeap struct {
+	heads     []mergeHead
+	comparers []*comparer
+	err       error
+}
+
+func (mh *mergeHeap) Len() int {
+	return len(mh.heads)
+}
+
+func (mh *mergeHeap) Less(i, j int) bool {
+	for _, c := range mh.comparers {
+		if mh.err != nil {
+			return true
+		}
+		cmp, err := c.compare(mh.heads[i].row, mh.heads[j].row)
+		if err != nil {
+			mh.err = err
+			return true
+		}
+		if cmp == 0 {
+			continue
+		}
+		return cmp < 0
+	}
+	// Keep the rows of equal keys in the order of their runs.
+	return mh.heads[i].source < mh.heads[j].source
+}
+
+func (mh *mergeHeap) Swap(i, j int) {
+	mh.heads[i], mh.heads[j] = mh.heads[j], mh.heads[i]
+}
+
+func (mh *mergeHeap) Push(x interface{}) {
+	mh.heads = append(mh.heads, x.(mergeHead))
+}
+
+func (mh *mergeHeap) Pop() interface{} {
+	n := len(mh.heads)
+	x := mh.heads[n-1]
+	mh.heads = mh.heads[:n-1]
+	return x
+}
+
+// graceHashJoin partitions the inputs of a hash join whose probe table
+// exceeds the memory budget of its query on disk, by the hash of their join
+// values, so that the partitions can be joined one at a time.
+type graceHashJoin struct {
+	left, right [graceHashJoinPartitions]*spillFile
+}
+
+func newGraceHashJoin(dir string) (*graceHashJoin, error) {
+	gj := &graceHashJoin{}
+	for i := 0; i < graceHashJoinPartitions; i++ {
+		var err error
+		if gj.left[i], err = newSpillFile(dir); err != nil {
+			gj.close()
+			return nil, err
+		}
+		if gj.right[i], err = newSpillFile(dir); err != nil {
+			gj.close()
+			return nil, err
+		}
+	}
+	querySpills.Add("HashJoin", 1)
+	return gj, nil
+}
+
+func (gj *graceHashJoin) addLeft(hashcode evalengine.HashCode, row []sqltypes.Value) error {
+	querySpilledRows.Add("HashJoin", 1)
+	return gj.left[graceHashJoinPartition(hashcode)].write(row)
+}
+
+func (gj *graceHashJoin) addRight(hashcode evalengine.HashCode, row []sqltypes.Value) error {
+	querySpilledRows.Add("HashJoin", 1)
+	return gj.right[graceHashJoinPartition(hashcode)].write(row)
+}
+
+// graceHashJoinPartition returns the partition of a hash code. The hash codes
+// of the numbers are their bits, so they are mixed by a multiplicative hash,
+// whose high bits are the partition.
+func graceHashJoinPartition(hashcode evalengine.HashCode) int {
+	return int((uint64(hashcode) * 0x9E3779B97F4A7C15) >> (64 - graceHashJoinPartitionBits))
+}
+
+func (gj *graceHashJoin) close() {
+	for i := 0; i < graceHashJoinPartitions; i++ {
+		if gj.left[i] != nil {
+			gj.left[i].close()
+		}
+		if gj.right[i] != nil {
+			gj.right[i].close()
+		}
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestSpillFile(t *testing.T) {
+	dir := t.TempDir()
+	sf, err := newSpillFile(dir)
+	require.NoError(t, err)
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NULL},
+		{sqltypes.NewInt64(-2), sqltypes.NewVarChar(""), sqltypes.NewFloat64(1.5)},
+		{},
+	}
+	for _, row := range rows {
+		require.NoError(t, sf.write(row))
+	}
+
+	reader, err := sf.reader()
+	require.NoError(t, err)
+	for _, want := range rows {
+		got, err := reader.next()
+		require.NoError(t, err)
+		assert.Equal(t, sqltypes.RowToProto3(want), sqltypes.RowToProto3(got))
+		for i := range want {
+			assert.Equal(t, want[i].Type(), got[i].Type())
+		}
+	}
+	_, err = reader.next()
+	assert.Equal(t, io.EOF, err)
+
+	sf.close()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestQueryMemory(t *testing.T) {
+	var unlimited *QueryMemory
+	assert.True(t, unlimited.Grow(1<<40))
+	assert.False(t, unlimited.CanSpill())
+	assert.Nil(t, NewQueryMemory(0, "/tmp"))
+
+	qm := NewQueryMemory(100, "")
+	assert.True(t, qm.Grow(60))
+	assert.False(t, qm.Grow(60))
+	assert.EqualValues(t, 60, qm.Used())
+	qm.Shrink(60)
+	assert.True(t, qm.Grow(100))
+	assert.False(t, qm.CanSpill())
+	assert.EqualError(t, qm.exceededError(), "query memory budget of 100 bytes exceeded")
+}
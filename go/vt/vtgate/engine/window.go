@@ -0,0 +1,363 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates functions over a window of rows,
+// for the window functions which cannot be pushed down to the shards.
+// Its input must be sorted by the partition and then by the order of the
+// window. The rows are streamed: only the peers of the current row, which
+// have the same order values, are kept in memory.
+type Window struct {
+	// PartitionBy and OrderBy are the offsets of the columns the window is
+	// partitioned and ordered by. Their values are compared as bytes, so
+	// they are usually weight strings.
+	PartitionBy []int
+	OrderBy     []int
+	Functions   []*WindowFunction
+	// Cols defines the columns of the result. A non-negative value is the
+	// offset of a column of the input, and -1-i is the function i.
+	Cols  []int
+	Input Primitive
+}
+
+// WindowOpcode is the window function evaluated by a Window.
+type WindowOpcode int
+
+// These constants list the window functions evaluated at vtgate.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowCount
+	WindowSum
+)
+
+var windowOpcodeName = map[WindowOpcode]string{
+	WindowRowNumber: "row_number",
+	WindowRank:      "rank",
+	WindowDenseRank: "dense_rank",
+	WindowCount:     "count",
+	WindowSum:       "sum",
+}
+
+// SupportedWindowFunctions maps the names of the window functions evaluated
+// at vtgate to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number": WindowRowNumber,
+	"rank":       WindowRank,
+	"dense_rank": WindowDenseRank,
+	"count":      WindowCount,
+	"sum":        WindowSum,
+}
+
+func (code WindowOpcode) String() string {
+	return windowOpcodeName[code]
+}
+
+// IsAggregate returns true for the aggregate functions, which take an
+// argument and are computed over the rows from the start of the partition
+// to the last peer of the current row.
+func (code WindowOpcode) IsAggregate() bool {
+	return code == WindowCount || code == WindowSum
+}
+
+// WindowFunction is a function evaluated by a Window.
+type WindowFunction struct {
+	Opcode WindowOpcode
+	// Col is the offset of the argument of an aggregate function. It is -1
+	// for count(*).
+	Col   int
+	Alias string
+}
+
+func (wf *WindowFunction) String() string {
+	if !wf.Opcode.IsAggregate() {
+		return wf.Opcode.String()
+	}
+	if wf.Col < 0 {
+		return wf.Opcode.String() + "(*)"
+	}
+	return fmt.Sprintf("%s(%d)", wf.Opcode.String(), wf.Col)
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// TryExecute satisfies the Primitive interface.
+func (w *Window) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(w.Input, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	state := &windowState{w: w}
+	out := &sqltypes.Result{}
+	if wantfields {
+		out.Fields = w.fields(result.Fields)
+	}
+	for _, row := range result.Rows {
+		rows, err := state.add(row)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, rows...)
+	}
+	rows, err := state.flush()
+	if err != nil {
+		return nil, err
+	}
+	out.Rows = append(out.Rows, rows...)
+	return out, nil
+}
+
+// TryStreamExecute satisfies the Primitive interface.
+func (w *Window) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	state := &windowState{w: w}
+	err := vcursor.StreamExecutePrimitive(w.Input, bindVars, true, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 && wantfields {
+			if err := callback(&sqltypes.Result{Fields: w.fields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		var out [][]sqltypes.Value
+		for _, row := range qr.Rows {
+			rows, err := state.add(row)
+			if err != nil {
+				return err
+			}
+			out = append(out, rows...)
+		}
+		if vcursor.ExceedsMaxMemoryRows(len(state.peers)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return callback(&sqltypes.Result{Rows: out})
+	})
+	if err != nil {
+		return err
+	}
+	rows, err := state.flush()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return callback(&sqltypes.Result{Rows: rows})
+}
+
+// GetFields satisfies the Primitive interface.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: w.fields(qr.Fields)}, nil
+}
+
+// Inputs returns the input of the window.
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface.
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) fields(input []*querypb.Field) []*querypb.Field {
+	if input == nil {
+		return nil
+	}
+	fields := make([]*querypb.Field, 0, len(w.Cols))
+	for _, col := range w.Cols {
+		if col >= 0 {
+			fields = append(fields, input[col])
+			continue
+		}
+		wf := w.Functions[-1-col]
+		typ := sqltypes.Uint64
+		switch wf.Opcode {
+		case WindowCount:
+			typ = sqltypes.Int64
+		case WindowSum:
+			typ = sqltypes.Decimal
+			if wf.Col >= 0 && sqltypes.IsFloat(input[wf.Col].Type) {
+				typ = sqltypes.Float64
+			}
+		}
+		fields = append(fields, &querypb.Field{Name: wf.Alias, Type: typ})
+	}
+	return fields
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions":     GenericJoin(w.Functions, func(i interface{}) string { return i.(*WindowFunction).String() }),
+		"ResultColumns": GenericJoin(w.Cols, func(i interface{}) string { return strconv.Itoa(i.(int)) }),
+	}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, func(i interface{}) string { return strconv.Itoa(i.(int)) })
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, func(i interface{}) string { return strconv.Itoa(i.(int)) })
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
+
+// windowState is the state of the evaluation of a window over the sorted
+// rows of its input.
+type windowState struct {
+	w *Window
+	// peers are the rows of the current partition with the same order
+	// values, whose functions are only known after their last row.
+	peers [][]sqltypes.Value
+	// rows is the number of rows of the partition before the peers, and
+	// groups the number of groups of peers.
+	rows, groups int
+	// aggregates are the values of the aggregate functions over the rows of
+	// the partition, including the peers.
+	aggregates []sqltypes.Value
+}
+
+// add adds the next row of the input, and returns the rows which are
+// complete once it was added.
+func (s *windowState) add(row []sqltypes.Value) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	if len(s.peers) != 0 {
+		last := s.peers[len(s.peers)-1]
+		samePartition := sameValues(last, row, s.w.PartitionBy)
+		if !samePartition || !sameValues(last, row, s.w.OrderBy) {
+			var err error
+			if out, err = s.flush(); err != nil {
+				return nil, err
+			}
+		}
+		if !samePartition {
+			s.rows, s.groups, s.aggregates = 0, 0, nil
+		}
+	}
+	if s.aggregates == nil {
+		s.aggregates = make([]sqltypes.Value, len(s.w.Functions))
+		for i, wf := range s.w.Functions {
+			if wf.Opcode == WindowCount {
+				s.aggregates[i] = sqltypes.NewInt64(0)
+			}
+		}
+	}
+	for i, wf := range s.w.Functions {
+		switch wf.Opcode {
+		case WindowCount:
+			if wf.Col >= 0 && row[wf.Col].IsNull() {
+				continue
+			}
+			count, err := evalengine.ToInt64(s.aggregates[i])
+			if err != nil {
+				return nil, err
+			}
+			s.aggregates[i] = sqltypes.NewInt64(count + 1)
+		case WindowSum:
+			value := row[wf.Col]
+			if value.IsNull() {
+				continue
+			}
+			typ := sqltypes.Decimal
+			if value.IsFloat() {
+				typ = sqltypes.Float64
+			}
+			sum, err := evalengine.NullSafeAdd(s.aggregates[i], value, typ)
+			if err != nil {
+				return nil, err
+			}
+			s.aggregates[i] = sum
+		}
+	}
+	s.peers = append(s.peers, row)
+	return out, nil
+}
+
+// flush returns the rows of the current peers, with their functions.
+func (s *windowState) flush() ([][]sqltypes.Value, error) {
+	if len(s.peers) == 0 {
+		return nil, nil
+	}
+	s.groups++
+	out := make([][]sqltypes.Value, 0, len(s.peers))
+	for i, row := range s.peers {
+		result := make([]sqltypes.Value, 0, len(s.w.Cols))
+		for _, col := range s.w.Cols {
+			if col >= 0 {
+				result = append(result, row[col])
+				continue
+			}
+			fn := -1 - col
+			switch s.w.Functions[fn].Opcode {
+			case WindowRowNumber:
+				result = append(result, sqltypes.NewUint64(uint64(s.rows+i+1)))
+			case WindowRank:
+				result = append(result, sqltypes.NewUint64(uint64(s.rows+1)))
+			case WindowDenseRank:
+				result = append(result, sqltypes.NewUint64(uint64(s.groups)))
+			default:
+				result = append(result, s.aggregates[fn])
+			}
+		}
+		out = append(out, result)
+	}
+	s.rows += len(s.peers)
+	s.peers = nil
+	return out, nil
+}
+
+func sameValues(row1, row2 []sqltypes.Value, cols []int) bool {
+	for _, col := range cols {
+		v1, v2 := row1[col], row2[col]
+		if v1.IsNull() != v2.IsNull() || !bytes.Equal(v1.Raw(), v2.Raw()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+)
+
+func newTestWindow(input Primitive) *Window {
+	return &Window{
+		PartitionBy: []int{3},
+		OrderBy:     []int{4},
+		Functions: []*WindowFunction{
+			{Opcode: WindowRowNumber, Alias: "row_number() over (partition by p order by o asc)"},
+			{Opcode: WindowRank, Alias: "r"},
+			{Opcode: WindowDenseRank, Alias: "dr"},
+			{Opcode: WindowCount, Col: -1, Alias: "c"},
+			{Opcode: WindowSum, Col: 2, Alias: "s"},
+		},
+		Cols:  []int{0, 1, -1, -2, -3, -4, -5},
+		Input: input,
+	}
+}
+
+func TestWindowExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"p|o|v|weight_string(p)|weight_string(o)",
+				"varchar|int64|int64|varbinary|varbinary",
+			),
+			"a|1|10|A|1",
+			"a|2|null|A|2",
+			"A|2|5|A|2",
+			"a|3|1|A|3",
+			"b|1|7|B|1",
+			"b|1|8|B|1",
+			"null|1|null|null|1",
+		)},
+	}
+
+	result, err := newTestWindow(fp).TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"p|o|row_number() over (partition by p order by o asc)|r|dr|c|s",
+			"varchar|int64|uint64|uint64|uint64|int64|decimal",
+		),
+		"a|1|1|1|1|1|10",
+		"a|2|2|2|2|3|15",
+		"A|2|3|2|2|3|15",
+		"a|3|4|4|3|4|16",
+		"b|1|1|1|1|2|15",
+		"b|1|2|1|1|2|15",
+		"null|1|1|1|1|1|null",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"p|o|v|weight_string(p)|weight_string(o)",
+		"varchar|int64|int64|varbinary|varbinary",
+	)
+	// The peers of a row are split across the results of the input.
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "a|1|10|A|1", "a|2|1|A|2"),
+			sqltypes.MakeTestResult(fields, "a|2|2|A|2", "b|1|7|B|1"),
+		},
+		allResultsInOneCall: true,
+	}
+
+	var results []*sqltypes.Result
+	err := newTestWindow(fp).TryStreamExecute(&noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(
+		sqltypes.MakeTestFields(
+			"p|o|row_number() over (partition by p order by o asc)|r|dr|c|s",
+			"varchar|int64|uint64|uint64|uint64|int64|decimal",
+		),
+		"a|1|1|1|1|1|10",
+		"---",
+		"a|2|2|2|2|3|13",
+		"a|2|3|2|2|3|13",
+		"---",
+		"b|1|1|1|1|1|7",
+	)
+	utils.MustMatch(t, wantResults[1:], results)
+}
+
+func TestWindowGetFields(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"p|o|v|weight_string(p)|weight_string(o)",
+			"varchar|int64|float64|varbinary|varbinary",
+		))},
+	}
+
+	result, err := newTestWindow(fp).GetFields(nil, nil)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"p|o|row_number() over (partition by p order by o asc)|r|dr|c|s",
+		"varchar|int64|uint64|uint64|uint64|int64|float64",
+	))
+	utils.MustMatch(t, wantResult, result)
+}
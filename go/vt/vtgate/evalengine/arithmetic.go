@@ -563,6 +563,42 @@ func divideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid arithmetic between: %s %s", v1.Value().String(), v2.Value().String())
 }
 
+// modNumericWithError returns the remainder of the division of two numbers,
+// which has the sign of the dividend, or NULL for a division by zero
+func modNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
+	v1 := makeNumeric(i1)
+	v2 := makeNumeric(i2)
+	switch {
+	case v1.typ == sqltypes.Float64 || v2.typ == sqltypes.Float64 || v1.typ == sqltypes.Decimal || v2.typ == sqltypes.Decimal:
+		f2 := coerceToFloat(v2)
+		if f2 == 0 {
+			return resultNull, nil
+		}
+		return EvalResult{typ: sqltypes.Float64, numval: math.Float64bits(math.Mod(coerceToFloat(v1), f2))}, nil
+	case v2.numval == 0:
+		return resultNull, nil
+	case v1.typ == sqltypes.Uint64:
+		divisor := v2.numval
+		if v2.typ == sqltypes.Int64 && int64(divisor) < 0 {
+			divisor = uint64(-int64(divisor))
+		}
+		return EvalResult{typ: sqltypes.Uint64, numval: v1.numval % divisor}, nil
+	case v2.typ == sqltypes.Uint64:
+		dividend := int64(v1.numval)
+		if dividend < 0 {
+			return EvalResult{typ: sqltypes.Int64, numval: uint64(-int64(uint64(-dividend) % v2.numval))}, nil
+		}
+		return EvalResult{typ: sqltypes.Int64, numval: uint64(dividend) % v2.numval}, nil
+	case v1.typ == sqltypes.Int64 && v2.typ == sqltypes.Int64:
+		divisor := int64(v2.numval)
+		if divisor == -1 {
+			return EvalResult{typ: sqltypes.Int64, numval: 0}, nil
+		}
+		return EvalResult{typ: sqltypes.Int64, numval: uint64(int64(v1.numval) % divisor)}, nil
+	}
+	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid arithmetic between: %s %s", v1.Value().String(), v2.Value().String())
+}
+
 // makeNumericAndPrioritize reorders the input parameters
 // to be Float64, Uint64, Int64.
 func makeNumericAndPrioritize(i1, i2 EvalResult) (EvalResult, EvalResult) {
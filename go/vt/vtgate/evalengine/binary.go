@@ -40,12 +40,14 @@ type (
 	Subtraction    struct{}
 	Multiplication struct{}
 	Division       struct{}
+	Modulo         struct{}
 )
 
 var _ BinaryOp = (*Addition)(nil)
 var _ BinaryOp = (*Subtraction)(nil)
 var _ BinaryOp = (*Multiplication)(nil)
 var _ BinaryOp = (*Division)(nil)
+var _ BinaryOp = (*Modulo)(nil)
 
 func (b *BinaryExpr) Collation() collations.TypedCollation {
 	return collationNumeric
@@ -61,6 +63,9 @@ func (b *BinaryExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
 	if err != nil {
 		return EvalResult{}, err
 	}
+	if hasNullEvalResult(lVal, rVal) {
+		return resultNull, nil
+	}
 	return b.Op.Evaluate(lVal, rVal)
 }
 
@@ -98,6 +103,11 @@ func (d *Division) Evaluate(left, right EvalResult) (EvalResult, error) {
 	return divideNumericWithError(left, right)
 }
 
+// Evaluate implements the BinaryOp interface
+func (m *Modulo) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return modNumericWithError(left, right)
+}
+
 // Type implements the BinaryOp interface
 func (a *Addition) Type(left querypb.Type) querypb.Type {
 	return left
@@ -118,6 +128,11 @@ func (d *Division) Type(querypb.Type) querypb.Type {
 	return sqltypes.Float64
 }
 
+// Type implements the BinaryOp interface
+func (m *Modulo) Type(left querypb.Type) querypb.Type {
+	return left
+}
+
 // String implements the BinaryOp interface
 func (a *Addition) String() string {
 	return "+"
@@ -137,3 +152,8 @@ func (m *Multiplication) String() string {
 func (d *Division) String() string {
 	return "/"
 }
+
+// String implements the BinaryOp interface
+func (m *Modulo) String() string {
+	return "%"
+}
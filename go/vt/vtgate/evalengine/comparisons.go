@@ -81,15 +81,19 @@ func (c *ComparisonExpr) evaluateComparisonExprs(env *ExpressionEnv) (EvalResult
 	if lVal, err = c.Left.Evaluate(env); err != nil {
 		return EvalResult{}, EvalResult{}, err
 	}
-	if sqltypes.IsText(lVal.typ) && c.CoerceLeft != nil {
-		lVal.bytes, _ = c.CoerceLeft(nil, lVal.bytes)
+	if evalResultIsString(lVal) && c.TypedCollation.Valid() {
+		if c.CoerceLeft != nil {
+			lVal.bytes, _ = c.CoerceLeft(nil, lVal.bytes)
+		}
 		lVal.collation = c.TypedCollation
 	}
 	if rVal, err = c.Right.Evaluate(env); err != nil {
 		return EvalResult{}, EvalResult{}, err
 	}
-	if sqltypes.IsText(rVal.typ) && c.CoerceRight != nil {
-		rVal.bytes, _ = c.CoerceRight(nil, rVal.bytes)
+	if evalResultIsString(rVal) && c.TypedCollation.Valid() {
+		if c.CoerceRight != nil {
+			rVal.bytes, _ = c.CoerceRight(nil, rVal.bytes)
+		}
 		rVal.collation = c.TypedCollation
 	}
 	return lVal, rVal, nil
@@ -99,8 +103,12 @@ func hasNullEvalResult(l, r EvalResult) bool {
 	return l.typ == sqltypes.Null || r.typ == sqltypes.Null
 }
 
+func evalResultIsString(e EvalResult) bool {
+	return sqltypes.IsText(e.typ) || sqltypes.IsBinary(e.typ)
+}
+
 func evalResultsAreStrings(l, r EvalResult) bool {
-	return (sqltypes.IsText(l.typ) || sqltypes.IsBinary(l.typ)) && (sqltypes.IsText(r.typ) || sqltypes.IsBinary(r.typ))
+	return evalResultIsString(l) && evalResultIsString(r)
 }
 
 func evalResultsAreSameNumericType(l, r EvalResult) bool {
@@ -258,7 +266,15 @@ func (e *EqualOp) String() string {
 
 // Evaluate implements the ComparisonOp interface
 func (n *NullSafeEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
-	panic("implement me")
+	leftNull, rightNull := left.typ == sqltypes.Null, right.typ == sqltypes.Null
+	if leftNull || rightNull {
+		return boolResult(leftNull && rightNull, false), nil
+	}
+	numeric, _, err := nullSafeCompare(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return boolResult(numeric == 0, false), nil
 }
 
 // Type implements the ComparisonOp interface
@@ -332,6 +348,13 @@ func (i *InOp) String() string {
 }
 
 func (l *LikeOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if hasNullEvalResult(left, right) {
+		return resultNull, nil
+	}
+	// numbers are matched with their text
+	if !evalResultIsString(left) {
+		left = EvalResult{typ: sqltypes.VarBinary, bytes: left.Value().Raw(), collation: right.collation}
+	}
 	if left.collation.Collation != right.collation.Collation {
 		panic("LikeOp: did not coerce")
 	}
@@ -227,6 +227,37 @@ func simplifyExpr(e Expr) (Expr, error) {
 			return &Literal{Val: res}, nil
 		}
 
+	case *LogicalExpr:
+		node.Left, err = simplifyExpr(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		node.Right, err = simplifyExpr(node.Right)
+		if err != nil {
+			return nil, err
+		}
+
+	case *NotExpr:
+		node.Inner, err = simplifyExpr(node.Inner)
+		if err != nil {
+			return nil, err
+		}
+
+	case *IsExpr:
+		node.Inner, err = simplifyExpr(node.Inner)
+		if err != nil {
+			return nil, err
+		}
+
+	case *CallExpr:
+		for i, expr := range node.Arguments {
+			expr, err = simplifyExpr(expr)
+			if err != nil {
+				return nil, err
+			}
+			node.Arguments[i] = expr
+		}
+
 	case TupleExpr:
 		var err error
 		for i, expr := range node {
@@ -251,8 +282,14 @@ func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 			return nil, err
 		}
 		collation := getCollation(node, lookup)
+		// like in MySQL, the collation of a column takes precedence over the
+		// one of a literal
+		collation.Coercibility = collations.CoerceImplicit
 		return NewColumn(idx, collation), nil
 	case *sqlparser.ComparisonExpr:
+		if node.Operator == sqlparser.RegexpOp || node.Operator == sqlparser.NotRegexpOp {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %s", ErrConvertExprNotSupported, node.Operator.ToString())
+		}
 		left, err := convertExpr(node.Left, lookup)
 		if err != nil {
 			return nil, err
@@ -261,27 +298,44 @@ func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 		if err != nil {
 			return nil, err
 		}
-		comp := &ComparisonExpr{
-			Op:    translateComparisonOperator(node.Operator),
-			Left:  left,
-			Right: right,
+		return newComparisonExpr(translateComparisonOperator(node.Operator), left, right)
+	case *sqlparser.BetweenExpr:
+		// `a BETWEEN b AND c` is evaluated as `a >= b AND a <= c`
+		from, err := convertComparison(sqlparser.GreaterEqualOp, node.Left, node.From, lookup)
+		if err != nil {
+			return nil, err
 		}
-
-		leftColl := left.Collation()
-		rightColl := right.Collation()
-		if leftColl.Valid() && rightColl.Valid() {
-			env := collations.Local()
-			comp.TypedCollation, comp.CoerceLeft, comp.CoerceRight, err =
-				env.MergeCollations(leftColl, rightColl, collations.CoercionOptions{
-					ConvertToSuperset:   true,
-					ConvertWithCoercion: true,
-				})
-			if err != nil {
-				return nil, err
-			}
+		to, err := convertComparison(sqlparser.LessEqualOp, node.Left, node.To, lookup)
+		if err != nil {
+			return nil, err
 		}
-
-		return comp, nil
+		var between Expr = &LogicalExpr{Op: &AndOp{}, Left: from, Right: to}
+		if !node.IsBetween {
+			between = &NotExpr{Inner: between}
+		}
+		return between, nil
+	case *sqlparser.AndExpr:
+		return convertLogicalExpr(&AndOp{}, node.Left, node.Right, lookup)
+	case *sqlparser.OrExpr:
+		return convertLogicalExpr(&OrOp{}, node.Left, node.Right, lookup)
+	case *sqlparser.XorExpr:
+		return convertLogicalExpr(&XorOp{}, node.Left, node.Right, lookup)
+	case *sqlparser.NotExpr:
+		inner, err := convertExpr(node.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Inner: inner}, nil
+	case *sqlparser.IsExpr:
+		inner, err := convertExpr(node.Left, lookup)
+		if err != nil {
+			return nil, err
+		}
+		check, err := isCheck(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &IsExpr{Inner: inner, Op: node.Right, Check: check}, nil
 	case sqlparser.Argument:
 		collation := getCollation(e, lookup)
 		return NewBindVar(string(node), collation), nil
@@ -313,6 +367,8 @@ func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 			op = &Multiplication{}
 		case sqlparser.DivOp:
 			op = &Division{}
+		case sqlparser.ModOp:
+			op = &Modulo{}
 		default:
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %T", ErrConvertExprNotSupported, e)
 		}
@@ -329,6 +385,54 @@ func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 			Left:  left,
 			Right: right,
 		}, nil
+	case *sqlparser.UnaryExpr:
+		if node.Operator != sqlparser.UMinusOp {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %s", ErrConvertExprNotSupported, node.Operator.ToString())
+		}
+		inner, err := convertExpr(node.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		return &BinaryExpr{Op: &Subtraction{}, Left: NewLiteralInt(0), Right: inner}, nil
+	case *sqlparser.FuncExpr:
+		if !node.Qualifier.IsEmpty() || node.Distinct {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %s", ErrConvertExprNotSupported, sqlparser.String(node))
+		}
+		// the function is checked before its arguments are converted, since
+		// looking up their columns can have side effects on the plan
+		method := node.Name.Lowered()
+		if _, ok := builtinFunctions[method]; !ok && method != "mod" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: function %s", ErrConvertExprNotSupported, method)
+		}
+		var args TupleExpr
+		for _, expr := range node.Exprs {
+			aliased, ok := expr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %s", ErrConvertExprNotSupported, sqlparser.String(node))
+			}
+			arg, err := convertExpr(aliased.Expr, lookup)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		if method == "mod" && len(args) == 2 {
+			return &BinaryExpr{Op: &Modulo{}, Left: args[0], Right: args[1]}, nil
+		}
+		return newCallExpr(method, args)
+	case *sqlparser.SubstrExpr:
+		var args TupleExpr
+		for _, expr := range []sqlparser.Expr{node.Name, node.From, node.To} {
+			if expr == nil {
+				continue
+			}
+			arg, err := convertExpr(expr, lookup)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		return newCallExpr("substring", args)
 	case sqlparser.ValTuple:
 		var exprs TupleExpr
 		for _, expr := range node {
@@ -361,3 +465,54 @@ func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %T", ErrConvertExprNotSupported, e)
 }
+
+// newComparisonExpr returns the comparison of two expressions, which are
+// compared using the collation merged from their collations
+func newComparisonExpr(op ComparisonOp, left, right Expr) (Expr, error) {
+	comp := &ComparisonExpr{
+		Op:    op,
+		Left:  left,
+		Right: right,
+	}
+
+	leftColl := left.Collation()
+	rightColl := right.Collation()
+	if leftColl.Valid() && rightColl.Valid() {
+		var err error
+		env := collations.Local()
+		comp.TypedCollation, comp.CoerceLeft, comp.CoerceRight, err =
+			env.MergeCollations(leftColl, rightColl, collations.CoercionOptions{
+				ConvertToSuperset:   true,
+				ConvertWithCoercion: true,
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return comp, nil
+}
+
+func convertComparison(op sqlparser.ComparisonExprOperator, left, right sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
+	l, err := convertExpr(left, lookup)
+	if err != nil {
+		return nil, err
+	}
+	r, err := convertExpr(right, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return newComparisonExpr(translateComparisonOperator(op), l, r)
+}
+
+func convertLogicalExpr(op LogicalOp, left, right sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
+	l, err := convertExpr(left, lookup)
+	if err != nil {
+		return nil, err
+	}
+	r, err := convertExpr(right, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &LogicalExpr{Op: op, Left: l, Right: r}, nil
+}
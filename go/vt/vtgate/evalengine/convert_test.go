@@ -203,6 +203,114 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: "(1,(1,2,3),(1,(1,NULL),4),2) = (1,(1,2,3),(1,(1,2),4),2)",
 		expected:   NULL,
+	}, {
+		expression: "1 = 1 and 2 = 2",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "1 = 2 and null",
+		expected:   sqltypes.NewInt32(0),
+	}, {
+		expression: "1 = 1 and null",
+		expected:   NULL,
+	}, {
+		expression: "1 = 1 or null",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "1 = 2 or null",
+		expected:   NULL,
+	}, {
+		expression: "1 xor 0",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "not 'abc'",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "not null",
+		expected:   NULL,
+	}, {
+		expression: "null is null",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "(1 = null) is not true",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "0 is false",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "null <=> null",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "1 <=> null",
+		expected:   sqltypes.NewInt32(0),
+	}, {
+		expression: "2 between 1 and 3",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "2 not between 1 and 3",
+		expected:   sqltypes.NewInt32(0),
+	}, {
+		expression: "1 + null",
+		expected:   NULL,
+	}, {
+		expression: "-7 % 3",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "mod(7, -3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "7 % 0",
+		expected:   NULL,
+	}, {
+		expression: "7.5 % 2",
+		expected:   sqltypes.NewFloat64(1.5),
+	}, {
+		expression: "-(1 + 2)",
+		expected:   sqltypes.NewInt64(-3),
+	}, {
+		expression: "abs(-42)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "lower('ÁBC')",
+		expected:   sqltypes.NewVarBinary("ábc"),
+	}, {
+		expression: "upper('ábc')",
+		expected:   sqltypes.NewVarBinary("ÁBC"),
+	}, {
+		expression: "upper(null)",
+		expected:   NULL,
+	}, {
+		expression: "trim('  abc  ')",
+		expected:   sqltypes.NewVarBinary("abc"),
+	}, {
+		expression: "length('ábc')",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "char_length('ábc')",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "substring('ábcd', 2, 2)",
+		expected:   sqltypes.NewVarBinary("bc"),
+	}, {
+		expression: "substr('ábcd', -3)",
+		expected:   sqltypes.NewVarBinary("bcd"),
+	}, {
+		expression: "left('ábcd', 2)",
+		expected:   sqltypes.NewVarBinary("áb"),
+	}, {
+		expression: "right('ábcd', 2)",
+		expected:   sqltypes.NewVarBinary("cd"),
+	}, {
+		expression: "concat('a', 1, 'b')",
+		expected:   sqltypes.NewVarBinary("a1b"),
+	}, {
+		expression: "coalesce(null, 42, 43)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "ifnull(null, 'abc')",
+		expected:   sqltypes.NewVarBinary("abc"),
+	}, {
+		expression: "lower('ABC') = 'abc'",
+		expected:   sqltypes.NewInt32(1),
 	}}
 
 	for _, test := range tests {
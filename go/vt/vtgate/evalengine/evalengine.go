@@ -408,7 +408,7 @@ func compareDateAndString(l, r EvalResult) (int, error) {
 }
 
 func mergeCollations(left, right EvalResult) (EvalResult, EvalResult, error) {
-	if !sqltypes.IsText(left.typ) || !sqltypes.IsText(right.typ) {
+	if !evalResultIsString(left) || !evalResultIsString(right) {
 		return left, right, nil
 	}
 	env := collations.Local()
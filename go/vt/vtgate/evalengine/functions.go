@@ -0,0 +1,394 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// CallExpr is a call to one of the builtinFunctions
+	CallExpr struct {
+		Arguments TupleExpr
+		Method    string
+		F         *builtin
+		// TypedCollation is the collation of the text arguments of the
+		// function, and of its text result
+		TypedCollation collations.TypedCollation
+		coerce         []collations.Coercion
+	}
+
+	builtin struct {
+		minArgs, maxArgs int
+		// text is true if the function works on the text of its
+		// arguments, which is converted to the collation of the function
+		text bool
+		// nullArgs is true if the function handles its NULL arguments
+		// itself; the other functions return NULL if any of their
+		// arguments is NULL
+		nullArgs bool
+		// typ is the type of the result, or NULL_TYPE if it is the type of
+		// the first argument
+		typ  querypb.Type
+		call func(args []EvalResult, coll collations.Collation) (EvalResult, error)
+	}
+)
+
+// builtinFunctions are the functions which can be evaluated by the engine.
+// They are deterministic: their result only depends on their arguments.
+var builtinFunctions = map[string]*builtin{
+	"lower":            {minArgs: 1, maxArgs: 1, text: true, typ: sqltypes.VarBinary, call: builtinLower},
+	"lcase":            {minArgs: 1, maxArgs: 1, text: true, typ: sqltypes.VarBinary, call: builtinLower},
+	"upper":            {minArgs: 1, maxArgs: 1, text: true, typ: sqltypes.VarBinary, call: builtinUpper},
+	"ucase":            {minArgs: 1, maxArgs: 1, text: true, typ: sqltypes.VarBinary, call: builtinUpper},
+	"trim":             {minArgs: 1, maxArgs: 1, text: true, typ: sqltypes.VarBinary, call: builtinTrim},
+	"length":           {minArgs: 1, maxArgs: 1, typ: sqltypes.Int64, call: builtinLength},
+	"octet_length":     {minArgs: 1, maxArgs: 1, typ: sqltypes.Int64, call: builtinLength},
+	"char_length":      {minArgs: 1, maxArgs: 1, text: true, typ: sqltypes.Int64, call: builtinCharLength},
+	"character_length": {minArgs: 1, maxArgs: 1, text: true, typ: sqltypes.Int64, call: builtinCharLength},
+	"substr":           {minArgs: 2, maxArgs: 3, text: true, typ: sqltypes.VarBinary, call: builtinSubstring},
+	"substring":        {minArgs: 2, maxArgs: 3, text: true, typ: sqltypes.VarBinary, call: builtinSubstring},
+	"left":             {minArgs: 2, maxArgs: 2, text: true, typ: sqltypes.VarBinary, call: builtinLeft},
+	"right":            {minArgs: 2, maxArgs: 2, text: true, typ: sqltypes.VarBinary, call: builtinRight},
+	"concat":           {minArgs: 1, maxArgs: math.MaxInt32, text: true, typ: sqltypes.VarBinary, call: builtinConcat},
+	"coalesce":         {minArgs: 1, maxArgs: math.MaxInt32, text: true, nullArgs: true, call: builtinCoalesce},
+	"ifnull":           {minArgs: 2, maxArgs: 2, text: true, nullArgs: true, call: builtinCoalesce},
+	"abs":              {minArgs: 1, maxArgs: 1, call: builtinAbs},
+}
+
+func newCallExpr(method string, args TupleExpr) (Expr, error) {
+	f, ok := builtinFunctions[method]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: function %s", ErrConvertExprNotSupported, method)
+	}
+	if len(args) < f.minArgs || len(args) > f.maxArgs {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", method)
+	}
+	call := &CallExpr{Arguments: args, Method: method, F: f, TypedCollation: collationNumeric}
+	if !f.text {
+		return call, nil
+	}
+
+	// The text arguments are converted to the collation of the function,
+	// like in `concat(utf8_column, latin1_column)`.
+	env := collations.Local()
+	var tc collations.TypedCollation
+	for _, arg := range args {
+		argColl := arg.Collation()
+		if !argColl.Valid() {
+			continue
+		}
+		if !tc.Valid() {
+			tc = argColl
+			continue
+		}
+		var err error
+		if tc, _, _, err = env.MergeCollations(tc, argColl, collations.CoercionOptions{ConvertToSuperset: true, ConvertWithCoercion: true}); err != nil {
+			return nil, err
+		}
+	}
+	if !tc.Valid() {
+		tc = getCollation(nil, nil)
+	}
+	call.TypedCollation = tc
+	call.coerce = make([]collations.Coercion, len(args))
+	for i, arg := range args {
+		argColl := arg.Collation()
+		if !argColl.Valid() || argColl.Collation == tc.Collation {
+			continue
+		}
+		_, _, coerce, err := env.MergeCollations(tc, argColl, collations.CoercionOptions{ConvertToSuperset: true, ConvertWithCoercion: true})
+		if err != nil {
+			return nil, err
+		}
+		call.coerce[i] = coerce
+	}
+	return call, nil
+}
+
+func (c *CallExpr) format(w *strings.Builder, _ bool) {
+	w.WriteString(c.Method)
+	w.WriteByte('(')
+	for i, expr := range c.Arguments {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		expr.format(w, false)
+	}
+	w.WriteByte(')')
+}
+
+// Evaluate implements the Expr interface
+func (c *CallExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	args := make([]EvalResult, 0, len(c.Arguments))
+	for i, expr := range c.Arguments {
+		arg, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if arg.typ == sqltypes.Null && !c.F.nullArgs {
+			return resultNull, nil
+		}
+		if c.F.text && evalResultIsString(arg) {
+			if c.coerce[i] != nil {
+				arg.bytes, _ = c.coerce[i](nil, arg.bytes)
+			}
+			arg.collation = c.TypedCollation
+		}
+		args = append(args, arg)
+	}
+	result, err := c.F.call(args, collations.Local().LookupByID(c.TypedCollation.Collation))
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if c.F.text && evalResultIsString(result) {
+		result.collation = c.TypedCollation
+	}
+	return result, nil
+}
+
+// Type implements the Expr interface
+func (c *CallExpr) Type(env *ExpressionEnv) (querypb.Type, error) {
+	if c.F.typ != querypb.Type_NULL_TYPE {
+		return c.F.typ, nil
+	}
+	return c.Arguments[0].Type(env)
+}
+
+// Collation implements the Expr interface
+func (c *CallExpr) Collation() collations.TypedCollation {
+	if sqltypes.IsNumber(c.F.typ) {
+		return collationNumeric
+	}
+	return c.TypedCollation
+}
+
+// textOf returns the text of an argument of a function, where the numbers
+// are converted to their text
+func textOf(arg EvalResult) []byte {
+	if evalResultIsString(arg) {
+		return arg.bytes
+	}
+	return arg.Value().Raw()
+}
+
+func newTextResult(text []byte) EvalResult {
+	return EvalResult{typ: sqltypes.VarBinary, bytes: text}
+}
+
+// isBinaryCollation returns true for the binary collation, of the binary
+// strings which are sequences of bytes instead of characters
+func isBinaryCollation(coll collations.Collation) bool {
+	return coll == nil || coll.ID() == collations.CollationBinaryID
+}
+
+// charOffsets returns the offsets of the characters of a text in the
+// charset of its collation, followed by the length of the text
+func charOffsets(text []byte, coll collations.Collation) []int {
+	offsets := make([]int, 0, len(text)+1)
+	for i := 0; i < len(text); {
+		offsets = append(offsets, i)
+		size := 1
+		if !isBinaryCollation(coll) {
+			if _, size = coll.Charset().DecodeRune(text[i:]); size <= 0 {
+				size = 1
+			}
+		}
+		i += size
+	}
+	return append(offsets, len(text))
+}
+
+// convertCase maps the characters of a text in the charset of its
+// collation. Like in MySQL, the binary strings are left as they are.
+func convertCase(text []byte, coll collations.Collation, mapping func(rune) rune) []byte {
+	if isBinaryCollation(coll) {
+		return text
+	}
+	cs := coll.Charset()
+	dst := make([]byte, 0, len(text))
+	var buf [utf8.UTFMax]byte
+	for len(text) > 0 {
+		r, size := cs.DecodeRune(text)
+		if size <= 0 {
+			size = 1
+		}
+		n := -1
+		if r != utf8.RuneError {
+			n = cs.EncodeRune(buf[:], mapping(r))
+		}
+		if n > 0 {
+			dst = append(dst, buf[:n]...)
+		} else {
+			dst = append(dst, text[:size]...)
+		}
+		text = text[size:]
+	}
+	return dst
+}
+
+func builtinLower(args []EvalResult, coll collations.Collation) (EvalResult, error) {
+	return newTextResult(convertCase(textOf(args[0]), coll, unicode.ToLower)), nil
+}
+
+func builtinUpper(args []EvalResult, coll collations.Collation) (EvalResult, error) {
+	return newTextResult(convertCase(textOf(args[0]), coll, unicode.ToUpper)), nil
+}
+
+func builtinTrim(args []EvalResult, coll collations.Collation) (EvalResult, error) {
+	text := textOf(args[0])
+	offsets := charOffsets(text, coll)
+	isSpace := func(i int) bool {
+		return offsets[i+1]-offsets[i] == 1 && text[offsets[i]] == ' '
+	}
+	start, end := 0, len(offsets)-1
+	for start < end && isSpace(start) {
+		start++
+	}
+	for end > start && isSpace(end-1) {
+		end--
+	}
+	return newTextResult(text[offsets[start]:offsets[end]]), nil
+}
+
+func builtinLength(args []EvalResult, _ collations.Collation) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.Int64, numval: uint64(len(textOf(args[0])))}, nil
+}
+
+func builtinCharLength(args []EvalResult, coll collations.Collation) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.Int64, numval: uint64(len(charOffsets(textOf(args[0]), coll)) - 1)}, nil
+}
+
+// intArgument returns the value of an integer argument of a function
+func intArgument(arg EvalResult) int64 {
+	arg = makeNumeric(arg)
+	switch arg.typ {
+	case sqltypes.Uint64:
+		if arg.numval > math.MaxInt64 {
+			return math.MaxInt64
+		}
+		return int64(arg.numval)
+	case sqltypes.Float64, sqltypes.Decimal:
+		return int64(math.Round(math.Float64frombits(arg.numval)))
+	default:
+		return int64(arg.numval)
+	}
+}
+
+// builtinSubstring returns the characters of a text from a position, which
+// starts at 1 and counts from the end if it is negative
+func builtinSubstring(args []EvalResult, coll collations.Collation) (EvalResult, error) {
+	text := textOf(args[0])
+	offsets := charOffsets(text, coll)
+	n := int64(len(offsets) - 1)
+	pos := intArgument(args[1])
+	switch {
+	case pos > 0:
+		pos--
+	case pos < 0:
+		pos += n
+	default:
+		return newTextResult(nil), nil
+	}
+	if pos < 0 || pos >= n {
+		return newTextResult(nil), nil
+	}
+	end := n
+	if len(args) == 3 {
+		count := intArgument(args[2])
+		if count <= 0 {
+			return newTextResult(nil), nil
+		}
+		if count < end-pos {
+			end = pos + count
+		}
+	}
+	return newTextResult(text[offsets[pos]:offsets[end]]), nil
+}
+
+func builtinLeft(args []EvalResult, coll collations.Collation) (EvalResult, error) {
+	text := textOf(args[0])
+	offsets := charOffsets(text, coll)
+	count := intArgument(args[1])
+	switch {
+	case count <= 0:
+		return newTextResult(nil), nil
+	case count < int64(len(offsets)-1):
+		text = text[:offsets[count]]
+	}
+	return newTextResult(text), nil
+}
+
+func builtinRight(args []EvalResult, coll collations.Collation) (EvalResult, error) {
+	text := textOf(args[0])
+	offsets := charOffsets(text, coll)
+	count := intArgument(args[1])
+	switch {
+	case count <= 0:
+		return newTextResult(nil), nil
+	case count < int64(len(offsets)-1):
+		text = text[offsets[int64(len(offsets)-1)-count]:]
+	}
+	return newTextResult(text), nil
+}
+
+func builtinConcat(args []EvalResult, _ collations.Collation) (EvalResult, error) {
+	var text []byte
+	for _, arg := range args {
+		text = append(text, textOf(arg)...)
+	}
+	return newTextResult(text), nil
+}
+
+func builtinCoalesce(args []EvalResult, _ collations.Collation) (EvalResult, error) {
+	for _, arg := range args {
+		if arg.typ != sqltypes.Null {
+			return arg, nil
+		}
+	}
+	return resultNull, nil
+}
+
+func builtinAbs(args []EvalResult, _ collations.Collation) (EvalResult, error) {
+	arg := makeNumeric(args[0])
+	switch {
+	case sqltypes.IsUnsigned(arg.typ):
+		return arg, nil
+	case sqltypes.IsSigned(arg.typ):
+		i := int64(arg.numval)
+		if i == math.MinInt64 {
+			return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in abs(%d)", i)
+		}
+		if i < 0 {
+			i = -i
+		}
+		return EvalResult{typ: sqltypes.Int64, numval: uint64(i)}, nil
+	case sqltypes.IsFloat(arg.typ) || arg.typ == sqltypes.Decimal:
+		return EvalResult{typ: arg.typ, numval: math.Float64bits(math.Abs(math.Float64frombits(arg.numval)))}, nil
+	}
+	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid argument to abs: %s", arg.Value().String())
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// columnLookup looks up the columns of a row with a text column in
+// utf8mb4_general_ci, a binary column and an integer column.
+type columnLookup struct{}
+
+var columnLookupNames = []string{"text", "bin", "num"}
+
+func (columnLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	for i, name := range columnLookupNames {
+		if col.Name.EqualString(name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown column %s", sqlparser.String(col))
+}
+
+func (columnLookup) CollationIDLookup(expr sqlparser.Expr) collations.ID {
+	if col, ok := expr.(*sqlparser.ColName); ok {
+		switch col.Name.Lowered() {
+		case "bin":
+			return collations.CollationBinaryID
+		case "num":
+			return collations.Unknown
+		}
+		return collations.ID(45)
+	}
+	return collations.ID(255)
+}
+
+func TestCallExprCollation(t *testing.T) {
+	row := []sqltypes.Value{sqltypes.NewVarChar("Ábc"), sqltypes.NewVarBinary("Ábc"), sqltypes.NewInt64(-5)}
+	testCases := []struct {
+		expression string
+		expected   sqltypes.Value
+	}{{
+		expression: "lower(text)",
+		expected:   sqltypes.NewVarBinary("ábc"),
+	}, {
+		// binary strings are not case-folded
+		expression: "lower(bin)",
+		expected:   sqltypes.NewVarBinary("Ábc"),
+	}, {
+		expression: "upper(bin) = 'ÁBC'",
+		expected:   sqltypes.NewInt32(0),
+	}, {
+		// text is compared using the collation of the column
+		expression: "lower(text) = 'ÁBC'",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "text in ('ábc', 'def')",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "char_length(text)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		// binary strings are sequences of bytes
+		expression: "char_length(bin)",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "left(bin, 1)",
+		expected:   sqltypes.NewVarBinary("\xc3"),
+	}, {
+		expression: "concat(text, num)",
+		expected:   sqltypes.NewVarBinary("Ábc-5"),
+	}, {
+		expression: "abs(num) = 5 and mod(num, 3) = -2",
+		expected:   sqltypes.NewInt32(1),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tc.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Convert(astExpr, columnLookup{})
+			require.NoError(t, err)
+			r, err := expr.Evaluate(&ExpressionEnv{Row: row})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, r.Value())
+		})
+	}
+}
+
+func TestCallExprErrors(t *testing.T) {
+	testCases := []struct {
+		expression string
+		err        string
+	}{{
+		expression: "sha1(text)",
+		err:        "expr cannot be converted, not supported: function sha1",
+	}, {
+		expression: "lower(text, bin)",
+		err:        "Incorrect parameter count in the call to native function 'lower'",
+	}, {
+		expression: "text regexp 'a'",
+		err:        "expr cannot be converted, not supported: regexp",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tc.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			_, err = Convert(astExpr, columnLookup{})
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
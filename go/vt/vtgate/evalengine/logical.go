@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// LogicalOp is one of the logical operators AND, OR and XOR, which
+	// combine the truth values of their operands
+	LogicalOp interface {
+		Evaluate(left, right boolean) boolean
+		String() string
+	}
+
+	LogicalExpr struct {
+		Op          LogicalOp
+		Left, Right Expr
+	}
+
+	NotExpr struct {
+		Inner Expr
+	}
+
+	// IsExpr checks the truth value of an expression, like `x IS NULL` or `x IS NOT TRUE`
+	IsExpr struct {
+		Inner Expr
+		Op    sqlparser.IsExprOperator
+		Check func(boolean) bool
+	}
+
+	// Logical ops
+	AndOp struct{}
+	OrOp  struct{}
+	XorOp struct{}
+)
+
+// boolean is the truth value of an expression in the three-valued logic of
+// MySQL, where NULL is neither true nor false
+type boolean int8
+
+const (
+	boolFalse boolean = iota
+	boolTrue
+	boolNULL
+)
+
+var _ LogicalOp = (*AndOp)(nil)
+var _ LogicalOp = (*OrOp)(nil)
+var _ LogicalOp = (*XorOp)(nil)
+
+func makeboolean(b bool) boolean {
+	if b {
+		return boolTrue
+	}
+	return boolFalse
+}
+
+func (b boolean) not() boolean {
+	switch b {
+	case boolFalse:
+		return boolTrue
+	case boolTrue:
+		return boolFalse
+	default:
+		return b
+	}
+}
+
+func (b boolean) evalResult() EvalResult {
+	switch b {
+	case boolFalse:
+		return resultFalse
+	case boolTrue:
+		return resultTrue
+	default:
+		return resultNull
+	}
+}
+
+// truthValue returns the truth value of an EvalResult used as a condition.
+// Like in MySQL, numbers are true when they are not zero, and strings are
+// converted to numbers first, so that a string which is not a number is false.
+func (e EvalResult) truthValue() (boolean, error) {
+	switch {
+	case e.typ == sqltypes.Null:
+		return boolNULL, nil
+	case sqltypes.IsIntegral(e.typ):
+		return makeboolean(e.numval != 0), nil
+	case sqltypes.IsFloat(e.typ) || e.typ == sqltypes.Decimal:
+		return makeboolean(math.Float64frombits(e.numval) != 0), nil
+	case e.typ == querypb.Type_TUPLE:
+		return boolNULL, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.OperandColumns, "Operand should contain 1 column(s)")
+	case sqltypes.IsText(e.typ) || sqltypes.IsBinary(e.typ):
+		return makeboolean(parseStringToFloat(string(e.bytes)) != 0), nil
+	default:
+		return makeboolean(len(e.bytes) > 0), nil
+	}
+}
+
+// EvaluateToBool evaluates an expression used as a condition, like the one of
+// a WHERE clause, which only matches when it is true
+func EvaluateToBool(expr Expr, env *ExpressionEnv) (bool, error) {
+	result, err := expr.Evaluate(env)
+	if err != nil {
+		return false, err
+	}
+	b, err := result.truthValue()
+	return b == boolTrue, err
+}
+
+func (l *LogicalExpr) format(w *strings.Builder, wrap bool) {
+	if wrap {
+		w.WriteByte('(')
+	}
+
+	l.Left.format(w, true)
+	w.WriteString(" ")
+	w.WriteString(l.Op.String())
+	w.WriteString(" ")
+	l.Right.format(w, true)
+
+	if wrap {
+		w.WriteByte(')')
+	}
+}
+
+func (n *NotExpr) format(w *strings.Builder, _ bool) {
+	w.WriteString("not ")
+	n.Inner.format(w, true)
+}
+
+func (i *IsExpr) format(w *strings.Builder, wrap bool) {
+	if wrap {
+		w.WriteByte('(')
+	}
+
+	i.Inner.format(w, true)
+	w.WriteString(" ")
+	w.WriteString(i.Op.ToString())
+
+	if wrap {
+		w.WriteByte(')')
+	}
+}
+
+// Evaluate implements the Expr interface
+func (l *LogicalExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	lVal, err := l.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	left, err := lVal.truthValue()
+	if err != nil {
+		return EvalResult{}, err
+	}
+	rVal, err := l.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := rVal.truthValue()
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return l.Op.Evaluate(left, right).evalResult(), nil
+}
+
+// Type implements the Expr interface
+func (l *LogicalExpr) Type(*ExpressionEnv) (querypb.Type, error) {
+	return querypb.Type_INT32, nil
+}
+
+// Collation implements the Expr interface
+func (l *LogicalExpr) Collation() collations.TypedCollation {
+	return collationNumeric
+}
+
+// Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	val, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	b, err := val.truthValue()
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return b.not().evalResult(), nil
+}
+
+// Type implements the Expr interface
+func (n *NotExpr) Type(*ExpressionEnv) (querypb.Type, error) {
+	return querypb.Type_INT32, nil
+}
+
+// Collation implements the Expr interface
+func (n *NotExpr) Collation() collations.TypedCollation {
+	return collationNumeric
+}
+
+// Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	val, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	b, err := val.truthValue()
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return makeboolean(i.Check(b)).evalResult(), nil
+}
+
+// Type implements the Expr interface
+func (i *IsExpr) Type(*ExpressionEnv) (querypb.Type, error) {
+	return querypb.Type_INT32, nil
+}
+
+// Collation implements the Expr interface
+func (i *IsExpr) Collation() collations.TypedCollation {
+	return collationNumeric
+}
+
+// Evaluate implements the LogicalOp interface
+func (a *AndOp) Evaluate(left, right boolean) boolean {
+	switch {
+	case left == boolFalse || right == boolFalse:
+		return boolFalse
+	case left == boolNULL || right == boolNULL:
+		return boolNULL
+	default:
+		return boolTrue
+	}
+}
+
+// Evaluate implements the LogicalOp interface
+func (o *OrOp) Evaluate(left, right boolean) boolean {
+	switch {
+	case left == boolTrue || right == boolTrue:
+		return boolTrue
+	case left == boolNULL || right == boolNULL:
+		return boolNULL
+	default:
+		return boolFalse
+	}
+}
+
+// Evaluate implements the LogicalOp interface
+func (x *XorOp) Evaluate(left, right boolean) boolean {
+	if left == boolNULL || right == boolNULL {
+		return boolNULL
+	}
+	return makeboolean(left != right)
+}
+
+// String implements the LogicalOp interface
+func (a *AndOp) String() string {
+	return "and"
+}
+
+// String implements the LogicalOp interface
+func (o *OrOp) String() string {
+	return "or"
+}
+
+// String implements the LogicalOp interface
+func (x *XorOp) String() string {
+	return "xor"
+}
+
+// isCheck returns the check of the truth value of an IsExpr
+func isCheck(op sqlparser.IsExprOperator) (func(boolean) bool, error) {
+	switch op {
+	case sqlparser.IsNullOp:
+		return func(b boolean) bool { return b == boolNULL }, nil
+	case sqlparser.IsNotNullOp:
+		return func(b boolean) bool { return b != boolNULL }, nil
+	case sqlparser.IsTrueOp:
+		return func(b boolean) bool { return b == boolTrue }, nil
+	case sqlparser.IsNotTrueOp:
+		return func(b boolean) bool { return b != boolTrue }, nil
+	case sqlparser.IsFalseOp:
+		return func(b boolean) bool { return b == boolFalse }, nil
+	case sqlparser.IsNotFalseOp:
+		return func(b boolean) bool { return b != boolFalse }, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %s", ErrConvertExprNotSupported, op.ToString())
+}
@@ -131,10 +131,11 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 	vschemaacl.Init()
 	// we subscribe to update from the VSchemaManager
 	e.vm = &VSchemaManager{
-		subscriber: e.SaveVSchema,
-		serv:       serv,
-		cell:       cell,
-		schema:     e.schemaTracker,
+		subscriber:     e.SaveVSchema,
+		serv:           serv,
+		cell:           cell,
+		schema:         e.schemaTracker,
+		discoverTables: *discoverTables,
 	}
 	serv.WatchSrvVSchema(ctx, cell, e.vm.VSchemaUpdate)
 
@@ -154,6 +155,7 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathQueryCost, e)
 	})
 	return e
 }
@@ -165,14 +167,20 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	trace.AnnotateSQL(span, sqlparser.Preview(sql))
 	defer span.Finish()
 
+	sql, requestID := withRequestID(sql)
 	logStats := NewLogStats(ctx, method, sql, bindVars)
+	logStats.RequestID = requestID
+	ctx = withBufferedTime(ctx, &logStats.BufferedTime)
+	ctx = withShardTimes(ctx, logStats)
 	stmtType, result, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+	err = errorWithRequestID(err, requestID)
 	logStats.Error = err
 	if result == nil {
 		saveSessionStats(safeSession, stmtType, 0, 0, 0, err)
 	} else {
 		saveSessionStats(safeSession, stmtType, result.RowsAffected, result.InsertID, len(result.Rows), err)
 	}
+	saveQueryUsage(safeSession, logStats, resultBytes(result))
 	if result != nil && len(result.Rows) > *warnMemoryRows {
 		warnings.Add("ResultsExceeded", 1)
 		piiSafeSQL, err := sqlparser.RedactSQLQuery(sql)
@@ -187,12 +195,13 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 }
 
 type streaminResultReceiver struct {
-	mu           sync.Mutex
-	stmtType     sqlparser.StatementType
-	rowsAffected uint64
-	rowsReturned int
-	insertID     uint64
-	callback     func(*sqltypes.Result) error
+	mu            sync.Mutex
+	stmtType      sqlparser.StatementType
+	rowsAffected  uint64
+	rowsReturned  int
+	bytesReturned uint64
+	insertID      uint64
+	callback      func(*sqltypes.Result) error
 }
 
 func (s *streaminResultReceiver) storeResultStats(typ sqlparser.StatementType, qr *sqltypes.Result) error {
@@ -200,7 +209,8 @@ func (s *streaminResultReceiver) storeResultStats(typ sqlparser.StatementType, q
 	defer s.mu.Unlock()
 	s.rowsAffected += qr.RowsAffected
 	s.rowsReturned += len(qr.Rows)
-	if qr.InsertID != 0 {
+	s.bytesReturned += resultBytes(qr)
+	if qr.InsertID != 0 && (s.insertID == 0 || qr.InsertID < s.insertID) {
 		s.insertID = qr.InsertID
 	}
 	s.stmtType = typ
@@ -221,7 +231,11 @@ func (e *Executor) StreamExecute(
 	trace.AnnotateSQL(span, sqlparser.Preview(sql))
 	defer span.Finish()
 
+	sql, requestID := withRequestID(sql)
 	logStats := NewLogStats(ctx, method, sql, bindVars)
+	logStats.RequestID = requestID
+	ctx = withBufferedTime(ctx, &logStats.BufferedTime)
+	ctx = withShardTimes(ctx, logStats)
 	srr := &streaminResultReceiver{callback: callback}
 	var err error
 
@@ -267,7 +281,11 @@ func (e *Executor) StreamExecute(
 		}
 
 		// 4: Execute!
+		var rowCount sync2.AtomicInt64
 		err := vc.StreamExecutePrimitive(plan.Instructions, bindVars, true, func(qr *sqltypes.Result) error {
+			if err := checkMaxRows(plan, int(rowCount.Add(int64(len(qr.Rows))))); err != nil {
+				return err
+			}
 			return srr.storeResultStats(plan.Type, qr)
 		})
 
@@ -303,9 +321,11 @@ func (e *Executor) StreamExecute(
 	}
 
 	err = e.newExecute(ctx, safeSession, sql, bindVars, logStats, resultHandler, srr.storeResultStats)
+	err = errorWithRequestID(err, requestID)
 
 	logStats.Error = err
 	saveSessionStats(safeSession, srr.stmtType, srr.rowsAffected, srr.insertID, srr.rowsReturned, err)
+	saveQueryUsage(safeSession, logStats, srr.bytesReturned)
 	if srr.rowsReturned > *warnMemoryRows {
 		warnings.Add("ResultsExceeded", 1)
 		piiSafeSQL, err := sqlparser.RedactSQLQuery(sql)
@@ -315,6 +335,7 @@ func (e *Executor) StreamExecute(
 		log.Warningf("%q exceeds warning threshold of max memory rows: %v", piiSafeSQL, *warnMemoryRows)
 	}
 
+	logStats.RowsReturned = uint64(srr.rowsReturned)
 	logStats.Send()
 	return err
 
@@ -331,10 +352,12 @@ func canReturnRows(stmtType sqlparser.StatementType) bool {
 
 func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType, rowsAffected, insertID uint64, rowsReturned int, err error) {
 	safeSession.RowCount = -1
+	foundRowsHandled := safeSession.foundRowsHandled
+	safeSession.foundRowsHandled = false
 	if err != nil {
 		return
 	}
-	if !safeSession.foundRowsHandled {
+	if !foundRowsHandled {
 		safeSession.FoundRows = uint64(rowsReturned)
 	}
 	if insertID > 0 {
@@ -409,7 +432,7 @@ func (e *Executor) legacyExecute(ctx context.Context, safeSession *SafeSession,
 		sqlparser.StmtDelete, sqlparser.StmtDDL, sqlparser.StmtUse, sqlparser.StmtExplain, sqlparser.StmtOther, sqlparser.StmtFlush:
 		return 0, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "[BUG] not reachable, should be handled with plan execute")
 	case sqlparser.StmtSet:
-		qr, err := e.handleSet(ctx, sql, logStats)
+		qr, err := e.handleSet(ctx, safeSession, sql, logStats)
 		return sqlparser.StmtSet, qr, err
 	case sqlparser.StmtShow:
 		qr, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -462,12 +485,30 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 			bindVars[key] = sqltypes.Int64BindVariable(v)
 		case sysvars.TransactionMode.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.TransactionMode.String())
+		case sysvars.TxTimeout.Name:
+			var v int64
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.TransactionTimeout / 1000
+			})
+			bindVars[key] = sqltypes.Int64BindVariable(v)
 		case sysvars.Workload.Name:
 			var v string
 			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
 				v = options.GetWorkload().String()
 			})
 			bindVars[key] = sqltypes.StringBindVariable(v)
+		case sysvars.ScatterPolicy.Name:
+			var v string
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.GetScatterPolicy().String()
+			})
+			bindVars[key] = sqltypes.StringBindVariable(v)
+		case sysvars.MaxShardsPerQuery.Name:
+			var v int64
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.MaxShardsPerQuery
+			})
+			bindVars[key] = sqltypes.Int64BindVariable(v)
 		case sysvars.DDLStrategy.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.DDLStrategy)
 		case sysvars.SessionUUID.Name:
@@ -486,6 +527,12 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = raw.ReadAfterWriteTimeout
 			})
 			bindVars[key] = sqltypes.Float64BindVariable(v)
+		case sysvars.ReadYourWrites.Name:
+			var v bool
+			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
+				v = raw.ReadYourWrites
+			})
+			bindVars[key] = sqltypes.BoolBindVariable(v)
 		case sysvars.SessionTrackGTIDs.Name:
 			v := "off"
 			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
@@ -563,6 +610,11 @@ func (e *Executor) Commit(ctx context.Context, safeSession *SafeSession) error {
 	return e.txConn.Commit(ctx, safeSession)
 }
 
+// AfterTransaction calls f once the transaction of the session ends.
+func (e *Executor) AfterTransaction(safeSession *SafeSession, f func()) {
+	e.txConn.AfterTransaction(safeSession, f)
+}
+
 func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession, logStats *LogStats) (*sqltypes.Result, error) {
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
@@ -573,41 +625,80 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
-func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, planType string, logStats *LogStats, nonTxResponse func(query string) (*sqltypes.Result, error), ignoreMaxMemoryRows bool) (*sqltypes.Result, error) {
+// handleSavepoint executes a savepoint, rollback to savepoint or release
+// savepoint statement on all the shards of the transaction, and records the
+// resulting savepoints in the session, so that they are replayed on the
+// shards which join the transaction later.
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, logStats *LogStats, ignoreMaxMemoryRows bool) (*sqltypes.Result, error) {
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
-	logStats.ShardQueries = uint64(len(safeSession.ShardSessions))
-	e.updateQueryCounts(planType, "", "", int64(logStats.ShardQueries))
 	defer func() {
 		logStats.ExecuteTime = time.Since(execStart)
 	}()
 
-	if len(safeSession.ShardSessions) == 0 {
-		if safeSession.InTransaction() {
-			// Storing, as this needs to be executed just after starting transaction on the shard.
-			safeSession.StoreSavepoint(sql)
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	var planType string
+	var name sqlparser.ColIdent
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		planType, name = "Savepoint", stmt.Name
+	case *sqlparser.SRollback:
+		planType, name = "Rollback Savepoint", stmt.Name
+	case *sqlparser.Release:
+		planType, name = "Release Savepoint", stmt.Name
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected savepoint statement: %s", sql)
+	}
+	shardSessions := safeSession.ShardSessionsByCommitOrder()
+	for _, sessions := range shardSessions {
+		logStats.ShardQueries += uint64(len(sessions))
+	}
+	e.updateQueryCounts(planType, "", "", int64(logStats.ShardQueries))
+
+	_, isSavepoint := stmt.(*sqlparser.Savepoint)
+	if !safeSession.InTransaction() || !isSavepoint && !safeSession.HasSavepoint(name) {
+		if isSavepoint {
+			// Safely to ignore as there is no transaction.
 			return &sqltypes.Result{}, nil
 		}
-		return nonTxResponse(sql)
-	}
-	var rss []*srvtopo.ResolvedShard
-	for _, shardSession := range safeSession.ShardSessions {
-		rss = append(rss, &srvtopo.ResolvedShard{
-			Target:  shardSession.Target,
-			Gateway: e.resolver.resolver.GetGateway(),
-		})
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT does not exist: %s", sql)
 	}
-	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: sql}
+
+	// The shard sessions of each commit order are only found by the
+	// executions in their commit order.
+	for _, co := range []vtgatepb.CommitOrder{vtgatepb.CommitOrder_PRE, vtgatepb.CommitOrder_NORMAL, vtgatepb.CommitOrder_POST} {
+		if len(shardSessions[co]) == 0 {
+			continue
+		}
+		rss := make([]*srvtopo.ResolvedShard, len(shardSessions[co]))
+		queries := make([]*querypb.BoundQuery, len(shardSessions[co]))
+		for i, shardSession := range shardSessions[co] {
+			rss[i] = &srvtopo.ResolvedShard{
+				Target:  shardSession.Target,
+				Gateway: e.resolver.resolver.GetGateway(),
+			}
+			queries[i] = &querypb.BoundQuery{Sql: sql}
+		}
+		safeSession.SetCommitOrder(co)
+		_, errs := e.ExecuteMultiShard(ctx, rss, queries, safeSession, false /*autocommit*/, ignoreMaxMemoryRows)
+		safeSession.SetCommitOrder(vtgatepb.CommitOrder_NORMAL)
+		if err := vterrors.Aggregate(errs); err != nil {
+			return nil, err
+		}
 	}
-	qr, errs := e.ExecuteMultiShard(ctx, rss, queries, safeSession, false /*autocommit*/, ignoreMaxMemoryRows)
-	err := vterrors.Aggregate(errs)
-	if err != nil {
-		return nil, err
+
+	switch stmt.(type) {
+	case *sqlparser.Savepoint:
+		safeSession.AddSavepoint(name)
+	case *sqlparser.SRollback:
+		safeSession.RollbackToSavepoint(name)
+	case *sqlparser.Release:
+		safeSession.ReleaseSavepoint(name)
 	}
-	safeSession.StoreSavepoint(sql)
-	return qr, nil
+	return &sqltypes.Result{}, nil
 }
 
 // CloseSession releases the current connection, which rollbacks open transactions and closes reserved connections.
@@ -616,7 +707,7 @@ func (e *Executor) CloseSession(ctx context.Context, safeSession *SafeSession) e
 	return e.txConn.ReleaseAll(ctx, safeSession)
 }
 
-func (e *Executor) handleSet(ctx context.Context, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, logStats *LogStats) (*sqltypes.Result, error) {
 	stmt, reserved, err := sqlparser.Parse2(sql)
 	if err != nil {
 		return nil, err
@@ -628,14 +719,23 @@ func (e *Executor) handleSet(ctx context.Context, sql string, logStats *LogStats
 	}
 	set, ok := rewrittenAST.AST.(*sqlparser.Set)
 	if !ok {
-		_, ok := rewrittenAST.AST.(*sqlparser.SetTransaction)
+		setTx, ok := rewrittenAST.AST.(*sqlparser.SetTransaction)
 		if !ok {
 			return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "unexpected statement type")
 		}
 		// Parser ensures set transaction is well-formed.
+		if setTx.Scope == sqlparser.SessionScope {
+			// The tablets run the queries of the session with pooled
+			// connections of its isolation level.
+			for _, char := range setTx.Characteristics {
+				if level, ok := char.(sqlparser.IsolationLevel); ok {
+					safeSession.GetOrCreateOptions().TransactionIsolation = isolationLevels[level]
+				}
+			}
+		}
 
-		// TODO: This is a NOP, modeled off of tx_isolation and tx_read_only.  It's incredibly
-		// dangerous that it's a NOP, but fixing that is left to.
+		// TODO: The access mode, and the isolation level of the next transaction only, are NOPs.
+		// It's incredibly dangerous, but fixing that is left to.
 		return &sqltypes.Result{}, nil
 	}
 
@@ -673,6 +773,15 @@ func (e *Executor) handleSet(ctx context.Context, sql string, logStats *LogStats
 	return &sqltypes.Result{}, nil
 }
 
+// isolationLevels maps the isolation levels of SET TRANSACTION to the ones of
+// the tablets.
+var isolationLevels = map[sqlparser.IsolationLevel]querypb.ExecuteOptions_TransactionIsolation{
+	sqlparser.ReadUncommitted: querypb.ExecuteOptions_READ_UNCOMMITTED,
+	sqlparser.ReadCommitted:   querypb.ExecuteOptions_READ_COMMITTED,
+	sqlparser.RepeatableRead:  querypb.ExecuteOptions_REPEATABLE_READ,
+	sqlparser.Serializable:    querypb.ExecuteOptions_SERIALIZABLE,
+}
+
 func getValueFor(expr *sqlparser.SetExpr) (interface{}, error) {
 	switch expr := expr.Expr.(type) {
 	case *sqlparser.Literal:
@@ -1376,6 +1485,19 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, errors.New("vschema not initialized")
 	}
 
+	planPrefixKey := vcursor.planPrefixKey()
+	ps := preparedStatementFromContext(vcursor.ctx)
+	if ps != nil {
+		if plan, ok := ps.plan(e, planPrefixKey, bindVars); ok {
+			vcursor.SetIgnoreMaxMemoryRows(ps.ignoreMaxMemoryRows)
+			if logStats != nil {
+				logStats.SQL = comments.Leading + ps.query + comments.Trailing
+				logStats.CachedPlan = true
+			}
+			return plan, nil
+		}
+	}
+
 	stmt, reserved, err := sqlparser.Parse2(sql)
 	if err != nil {
 		return nil, err
@@ -1408,13 +1530,19 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 
 	planHash := sha256.New()
-	_, _ = planHash.Write([]byte(vcursor.planPrefixKey()))
+	_, _ = planHash.Write([]byte(planPrefixKey))
 	_, _ = planHash.Write([]byte{':'})
 	_, _ = planHash.Write(hack.StringBytes(query))
 	planKey := hex.EncodeToString(planHash.Sum(nil))
 
 	if plan, ok := e.plans.Get(planKey); ok {
-		return plan.(*engine.Plan), nil
+		if logStats != nil {
+			logStats.CachedPlan = true
+		}
+		if ps != nil {
+			ps.save(planPrefixKey, planKey, query, reserved, bindVars, ignoreMaxMemoryRows)
+		}
+		return e.checkThatPlanIsValid(stmt, plan.(*engine.Plan), vcursor.safeSession)
 	}
 
 	plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds, *enableOnlineDDL, *enableDirectDDL)
@@ -1427,9 +1555,12 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 
 	if qo.cachePlan() && sqlparser.CachePlan(statement) {
 		e.plans.Set(planKey, plan)
+		if ps != nil {
+			ps.save(planPrefixKey, planKey, query, reserved, bindVars, ignoreMaxMemoryRows)
+		}
 	}
 
-	return e.checkThatPlanIsValid(stmt, plan)
+	return e.checkThatPlanIsValid(stmt, plan, vcursor.safeSession)
 }
 
 func (e *Executor) debugGetPlan(planKey string) (*engine.Plan, bool) {
@@ -1472,6 +1603,8 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
 		e.WriteScatterStats(response)
+	case pathQueryCost:
+		e.handleQueryCost(response, request)
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
@@ -1712,9 +1845,22 @@ func (e *Executor) startVStream(ctx context.Context, rss []*srvtopo.ResolvedShar
 	return nil
 }
 
-func (e *Executor) checkThatPlanIsValid(stmt sqlparser.Statement, plan *engine.Plan) (*engine.Plan, error) {
-	if e.allowScatter || sqlparser.AllowScatterDirective(stmt) {
-		return plan, nil
+// checkThatPlanIsValid fails plans with scatter queries when the scatter policy of the session, or the
+// `no_scatter` command line argument, disallows them.
+func (e *Executor) checkThatPlanIsValid(stmt sqlparser.Statement, plan *engine.Plan, safeSession *SafeSession) (*engine.Plan, error) {
+	policy := querypb.ExecuteOptions_DEFAULT_SCATTER
+	ifOptionsExist(safeSession, func(options *querypb.ExecuteOptions) {
+		policy = options.ScatterPolicy
+	})
+	switch policy {
+	case querypb.ExecuteOptions_DEFAULT_SCATTER:
+		if e.allowScatter || sqlparser.AllowScatterDirective(stmt) {
+			return plan, nil
+		}
+	case querypb.ExecuteOptions_SCATTER_WITH_DIRECTIVE:
+		if sqlparser.AllowScatterDirective(stmt) {
+			return plan, nil
+		}
 	}
 	// we go over all the primitives in the plan, searching for a route that is of SelectScatter opcode
 	badPrimitive := engine.Find(func(node engine.Primitive) bool {
@@ -1729,6 +1875,12 @@ func (e *Executor) checkThatPlanIsValid(stmt sqlparser.Statement, plan *engine.P
 		return plan, nil
 	}
 
+	switch policy {
+	case querypb.ExecuteOptions_SCATTER_WITH_DIRECTIVE:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "plan includes scatter, which is disallowed without the %s directive by the scatter_policy of the session", sqlparser.DirectiveAllowScatter)
+	case querypb.ExecuteOptions_NO_SCATTER:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "plan includes scatter, which is disallowed by the scatter_policy of the session")
+	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "plan includes scatter, which is disallowed using the `no_scatter` command line argument")
 }
 
@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -941,6 +942,45 @@ func TestInsertSharded(t *testing.T) {
 	}
 }
 
+func TestInsertShardedLastInsertID(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	executor.normalize = true
+	session := &vtgatepb.Session{TargetString: "@primary"}
+
+	// The insert of the lookup vindex does not change the LAST_INSERT_ID()
+	// and ROW_COUNT() of the statement.
+	sbclookup.SetResults([]*sqltypes.Result{{InsertID: 99, RowsAffected: 1}})
+	sbc1.SetResults([]*sqltypes.Result{{RowsAffected: 1}})
+	_, err := executorExecSession(executor, "insert into user(id, v, name) values (1, 2, 'myname')", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, session.LastInsertId)
+	assert.EqualValues(t, 1, session.RowCount)
+
+	// The insert id of a cross-shard insert does not depend on the order
+	// in which the shards answered.
+	sbc1.SetResults([]*sqltypes.Result{{InsertID: 5, RowsAffected: 1}})
+	sbc2.SetResults([]*sqltypes.Result{{InsertID: 4, RowsAffected: 1}})
+	_, err = executorExecSession(executor, "insert into user(id, v, name) values (1, 2, 'myname'), (3, 2, 'myname2')", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, session.LastInsertId)
+	assert.EqualValues(t, 2, session.RowCount)
+
+	// The generated sequence value supersedes the ids of the shards.
+	sbc1.SetResults([]*sqltypes.Result{{InsertID: 5, RowsAffected: 1}})
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("nextval", "int64"), "7")})
+	_, err = executorExecSession(executor, "insert into user(v, name) values (2, 'myname')", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, session.LastInsertId)
+
+	// A statement which does not insert keeps the insert id.
+	_, err = executorExecSession(executor, "delete from user where id = 1", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, session.LastInsertId)
+	result, err := executorExecSession(executor, "select last_insert_id()", nil, session)
+	require.NoError(t, err)
+	assert.Equal(t, `[[UINT64(7)]]`, fmt.Sprintf("%v", result.Rows))
+}
+
 func TestInsertShardedKeyrange(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 
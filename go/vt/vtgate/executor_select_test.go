@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"errors"
 	"fmt"
 	"runtime"
 	"strings"
@@ -39,6 +40,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
 	_ "vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
@@ -418,6 +420,7 @@ func TestSelectSystemVariables(t *testing.T) {
 		ReadAfterWriteGtid:    "a fine gtid",
 		ReadAfterWriteTimeout: 13,
 		SessionTrackGtids:     true,
+		ReadYourWrites:        true,
 	}
 	executor, _, _, _ := createLegacyExecutorEnv()
 	executor.normalize = true
@@ -426,7 +429,7 @@ func TestSelectSystemVariables(t *testing.T) {
 
 	sql := "select @@autocommit, @@client_found_rows, @@skip_query_plan_cache, @@enable_system_settings, " +
 		"@@sql_select_limit, @@transaction_mode, @@workload, @@read_after_write_gtid, " +
-		"@@read_after_write_timeout, @@session_track_gtids, @@ddl_strategy, @@socket"
+		"@@read_after_write_timeout, @@session_track_gtids, @@read_your_writes, @@ddl_strategy, @@socket"
 
 	result, err := executorExec(executor, sql, map[string]*querypb.BindVariable{})
 	wantResult := &sqltypes.Result{
@@ -441,6 +444,7 @@ func TestSelectSystemVariables(t *testing.T) {
 			{Name: "@@read_after_write_gtid", Type: sqltypes.VarBinary},
 			{Name: "@@read_after_write_timeout", Type: sqltypes.Float64},
 			{Name: "@@session_track_gtids", Type: sqltypes.VarBinary},
+			{Name: "@@read_your_writes", Type: sqltypes.Int64},
 			{Name: "@@ddl_strategy", Type: sqltypes.VarBinary},
 			{Name: "@@socket", Type: sqltypes.VarBinary},
 		},
@@ -457,6 +461,7 @@ func TestSelectSystemVariables(t *testing.T) {
 			sqltypes.NewVarBinary("a fine gtid"),
 			sqltypes.NewFloat64(13),
 			sqltypes.NewVarBinary("own_gtid"),
+			sqltypes.NewInt64(1),
 			sqltypes.NewVarBinary(""),
 			sqltypes.NewVarBinary(""),
 		}},
@@ -553,6 +558,23 @@ func TestFoundRows(t *testing.T) {
 	utils.MustMatch(t, wantResult, result, "Mismatch")
 }
 
+func TestFoundRowsHandled(t *testing.T) {
+	session := NewSafeSession(&vtgatepb.Session{})
+
+	// The found rows set by the plan are kept for the statement only.
+	session.FoundRows = 10
+	session.foundRowsHandled = true
+	saveSessionStats(session, sqlparser.StmtSelect, 0, 0, 2, nil)
+	assert.EqualValues(t, 10, session.FoundRows)
+	saveSessionStats(session, sqlparser.StmtSelect, 0, 0, 3, nil)
+	assert.EqualValues(t, 3, session.FoundRows)
+
+	session.foundRowsHandled = true
+	saveSessionStats(session, sqlparser.StmtSelect, 0, 0, 2, errors.New("err"))
+	saveSessionStats(session, sqlparser.StmtSelect, 0, 0, 4, nil)
+	assert.EqualValues(t, 4, session.FoundRows)
+}
+
 func TestRowCount(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 	executor.normalize = true
@@ -2666,6 +2688,85 @@ func TestSelectScatterFails(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSelectScatterPolicy(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+
+	session := &vtgatepb.Session{TargetString: "TestExecutor", Options: &querypb.ExecuteOptions{ScatterPolicy: querypb.ExecuteOptions_SCATTER_WITH_DIRECTIVE}}
+	// the plan is checked when it is cached too
+	for i := 0; i < 2; i++ {
+		_, err := executorExecSession(executor, "select id from user", nil, session)
+		require.EqualError(t, err, "plan includes scatter, which is disallowed without the ALLOW_SCATTER directive by the scatter_policy of the session")
+	}
+	_, err := executorExecSession(executor, "select /*vt+ ALLOW_SCATTER */ id from user", nil, session)
+	require.NoError(t, err)
+	_, err = executorExecSession(executor, "select id from user where id = 1", nil, session)
+	require.NoError(t, err)
+
+	session.Options.ScatterPolicy = querypb.ExecuteOptions_NO_SCATTER
+	_, err = executorExecSession(executor, "select /*vt+ ALLOW_SCATTER */ id from user", nil, session)
+	require.EqualError(t, err, "plan includes scatter, which is disallowed by the scatter_policy of the session")
+
+	// the session cannot allow scatter queries which the vtgate disallows
+	executor.allowScatter = false
+	session.Options.ScatterPolicy = querypb.ExecuteOptions_DEFAULT_SCATTER
+	_, err = executorExecSession(executor, "select id from user", nil, session)
+	require.EqualError(t, err, "plan includes scatter, which is disallowed using the `no_scatter` command line argument")
+}
+
+func TestSelectMaxShardsPerQuery(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+
+	session := &vtgatepb.Session{TargetString: "TestExecutor", Options: &querypb.ExecuteOptions{MaxShardsPerQuery: 2}}
+	_, err := executorExecSession(executor, "select id from user", nil, session)
+	require.EqualError(t, err, "query is sent to 8 shards, which exceeds the max_shards_per_query of the session: 2")
+	_, err = executorExecSession(executor, "select id from user where id = 1", nil, session)
+	require.NoError(t, err)
+	// the shards of all the routes of the query are counted
+	_, err = executorExecSession(executor, "select u.id from user as u join user_extra as e on u.col = e.col where u.id = 1", nil, session)
+	require.EqualError(t, err, "query is sent to 8 shards, which exceeds the max_shards_per_query of the session: 2")
+
+	session.Options.MaxShardsPerQuery = 8
+	_, err = executorExecSession(executor, "select id from user", nil, session)
+	require.NoError(t, err)
+}
+
+func TestSelectStaleReads(t *testing.T) {
+	defer func(keyspaces []string) {
+		staleReadsKeyspaces = keyspaces
+	}(staleReadsKeyspaces)
+	staleReadsKeyspaces = []string{KsTestUnsharded}
+	cell := "aa"
+	hc := discovery.NewFakeHealthCheck(nil)
+	createSandbox(KsTestUnsharded).VSchema = unshardedVSchema
+	serv := newSandboxForCells([]string{cell})
+	resolver := newTestResolver(hc, serv, cell)
+	primary := hc.AddTestTablet(cell, "0", 1, KsTestUnsharded, "0", topodatapb.TabletType_PRIMARY, false, 1, nil)
+	replica := hc.AddTestTablet(cell, "0", 2, KsTestUnsharded, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	executor := createExecutor(serv, cell, resolver)
+
+	session := &vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true}
+	_, err := executorExecSession(executor, "select id from music_user_map", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, primary.ExecCount.Get())
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+	require.Len(t, session.Warnings, 1)
+	assert.Equal(t, "stale read: no healthy primary for TestUnsharded/0 (replica), results were served by replicas and may not include the latest writes", session.Warnings[0].Message)
+
+	// Writes, sequences and transactions are not served stale.
+	for _, query := range []string{
+		"insert into music_user_map(id) values (1)",
+		"select next value from user_seq",
+		"select get_lock('lock', 10) from dual",
+	} {
+		_, err = executorExecSession(executor, query, nil, session)
+		require.Error(t, err, query)
+	}
+	session.InTransaction = true
+	_, err = executorExecSession(executor, "select id from music_user_map", nil, session)
+	require.Error(t, err)
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+}
+
 func TestGen4SelectStraightJoin(t *testing.T) {
 	executor, sbc1, _, _ := createExecutorEnv()
 	executor.normalize = true
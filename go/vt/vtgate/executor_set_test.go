@@ -206,19 +206,58 @@ func TestExecutorSet(t *testing.T) {
 		err: "variable 'transaction_read_only' can't be set to the value: 2 is not a boolean",
 	}, {
 		in:  "set session transaction isolation level repeatable read",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_REPEATABLE_READ}},
 	}, {
 		in:  "set session transaction isolation level read committed",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_READ_COMMITTED}},
 	}, {
 		in:  "set session transaction isolation level read uncommitted",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_READ_UNCOMMITTED}},
 	}, {
 		in:  "set session transaction isolation level serializable",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_SERIALIZABLE}},
 	}, {
 		in:  "set transaction isolation level serializable",
 		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set tx_isolation = 'read-committed'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_READ_COMMITTED}, SystemVariables: map[string]string{"tx_isolation": "'read-committed'"}},
+	}, {
+		in:  "set transaction_isolation = 'serializable'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_SERIALIZABLE}, SystemVariables: map[string]string{"transaction_isolation": "'serializable'"}},
+	}, {
+		in:  "set transaction_isolation = 'snapshot'",
+		err: "invalid transaction_isolation: snapshot",
+	}, {
+		in:  "set vitess_tx_timeout = 600",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionTimeout: 600000}},
+	}, {
+		in:  "set vitess_tx_timeout = 0",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{}},
+	}, {
+		in:  "set vitess_tx_timeout = -1",
+		err: "invalid vitess_tx_timeout: -1",
+	}, {
+		in:  "set scatter_policy = 'no_scatter'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{ScatterPolicy: querypb.ExecuteOptions_NO_SCATTER}},
+	}, {
+		in:  "set scatter_policy = scatter_with_directive",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{ScatterPolicy: querypb.ExecuteOptions_SCATTER_WITH_DIRECTIVE}},
+	}, {
+		in:  "set scatter_policy = 'always'",
+		err: "invalid scatter_policy: always",
+	}, {
+		in:  "set max_shards_per_query = 4",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{MaxShardsPerQuery: 4}},
+	}, {
+		in:  "set max_shards_per_query = -1",
+		err: "invalid max_shards_per_query: -1",
+	}, {
+		in:  "set read_your_writes = 1",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWrite: &vtgatepb.ReadAfterWrite{ReadYourWrites: true}},
+	}, {
+		in:  "set read_your_writes = 'abc'",
+		err: "variable 'read_your_writes' can't be set to the value: 'abc' is not a boolean",
 	}, {
 		in:  "set transaction read only",
 		out: &vtgatepb.Session{Autocommit: true},
@@ -366,6 +405,47 @@ func TestExecutorSetOp(t *testing.T) {
 	}
 }
 
+func TestExecutorSetOpStrict(t *testing.T) {
+	executor, _, _, sbclookup := createLegacyExecutorEnv()
+	defer func(strict bool) {
+		*strictSystemSettings = strict
+	}(*strictSystemSettings)
+	*strictSystemSettings = true
+
+	testcases := []struct {
+		in     string
+		result *sqltypes.Result
+		err    string
+	}{{
+		in:  "set big_tables = 1",
+		err: "big_tables: system setting is not applied by vitess, and strict_system_settings is enabled",
+	}, {
+		in:     "set net_write_timeout = 600",
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"),
+	}, {
+		in:     "set net_read_timeout = 600",
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64")),
+		err:    "net_read_timeout: system setting can't be changed from the value of the database, and strict_system_settings is enabled",
+	}, {
+		in:     "set global client_found_rows = 1",
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64")),
+		err:    "client_found_rows: system setting can't be changed from the value of the database, and strict_system_settings is enabled",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			session := NewAutocommitSession(primarySession)
+			session.TargetString = KsTestUnsharded
+			sbclookup.SetResults([]*sqltypes.Result{tcase.result})
+			_, err := executor.Execute(context.Background(), "TestExecute", session, tcase.in, nil)
+			if tcase.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tcase.err)
+			}
+		})
+	}
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
@@ -42,14 +42,17 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1111,6 +1114,44 @@ func TestExecutorComment(t *testing.T) {
 	}
 }
 
+func TestExecutorRequestID(t *testing.T) {
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	logChan := QueryLogger.Subscribe("Test")
+	defer QueryLogger.Unsubscribe(logChan)
+
+	// A request ID the client supplies is kept.
+	_, err := executorExec(executor, "/* request_id:abc */ select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/* request_id:abc */ select id from `user` where id = 1", sbc1.Queries[0].Sql)
+	logStats := getQueryLog(logChan)
+	require.NotNil(t, logStats)
+	assert.Equal(t, "abc", logStats.RequestID)
+
+	// No request ID is generated unless enabled.
+	sbc1.Queries = nil
+	_, err = executorExec(executor, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "select id from `user` where id = 1", sbc1.Queries[0].Sql)
+	logStats = getQueryLog(logChan)
+	require.NotNil(t, logStats)
+	assert.Empty(t, logStats.RequestID)
+
+	*generateRequestIDs = true
+	defer func() {
+		*generateRequestIDs = false
+	}()
+	sbc1.Queries = nil
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executorExec(executor, "select id from user where id = 1", nil)
+	logStats = getQueryLog(logChan)
+	require.NotNil(t, logStats)
+	require.NotEmpty(t, logStats.RequestID)
+	assert.Equal(t, sqlparser.RequestIDComment(logStats.RequestID)+"select id from `user` where id = 1", sbc1.Queries[0].Sql)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("(RequestID: %s)", logStats.RequestID))
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
 func TestExecutorOther(t *testing.T) {
 	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
 
@@ -1353,6 +1394,39 @@ func TestExecutorDDLFk(t *testing.T) {
 	}
 }
 
+func TestExecutorDDLApprovalPolicy(t *testing.T) {
+	executor, _, _, sbc := createExecutorEnv()
+	ts, err := executor.serv.GetTopoServer()
+	require.NoError(t, err)
+	err = ts.SaveSchemaOwnership(ctx, KsTestUnsharded, &vtctldatapb.SchemaOwnership{
+		Tables: map[string]*vtctldatapb.TableOwnership{
+			"owned":     {Owner: "payments", ApprovalPolicy: "payments-dba"},
+			"unguarded": {Owner: "payments"},
+		},
+	})
+	require.NoError(t, err)
+	defer ts.DeleteSchemaOwnership(ctx, KsTestUnsharded)
+
+	for _, ddlStrategy := range []string{"direct", "online"} {
+		t.Run(ddlStrategy, func(t *testing.T) {
+			session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, DDLStrategy: ddlStrategy})
+			_, err := executor.Execute(ctx, "TestExecutorDDLApprovalPolicy", session, "alter table owned add column c int", nil)
+			require.EqualError(t, err, "table "+KsTestUnsharded+".owned has an approval policy, its schema changes must be applied with ApplySchema")
+			_, err = executor.Execute(ctx, "TestExecutorDDLApprovalPolicy", session, "rename table unguarded to a, b to owned", nil)
+			require.Error(t, err)
+		})
+	}
+
+	sbc.ExecCount.Set(0)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded})
+	_, err = executor.Execute(ctx, "TestExecutorDDLApprovalPolicy", session, "alter table unguarded add column c int", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc.ExecCount.Get())
+
+	_, err = executor.Execute(ctx, "TestExecutorDDLApprovalPolicy", session, "revert vitess_migration 'bf9ac1cd_5a22_11eb_8f5e_f875a4d24e90'", nil)
+	require.EqualError(t, err, "keyspace "+KsTestUnsharded+" has tables with an approval policy, its migrations must be reverted with ApplySchema")
+}
+
 func TestExecutorAlterVSchemaKeyspace(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -1743,6 +1817,34 @@ func TestGetPlanCacheNormalized(t *testing.T) {
 	assertCacheSize(t, r.plans, 2)
 }
 
+func TestGetPlanPrepared(t *testing.T) {
+	r, _, _, _ := createLegacyExecutorEnv()
+	r.normalize = true
+	ps := &preparedStatement{}
+	vc, _ := newVCursorImpl(withPreparedStatement(ctx, ps), NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+	query := "select * from music_user_map where id = :v1 and name = 'foo'"
+
+	bindVars1 := map[string]*querypb.BindVariable{"v1": sqltypes.Int64BindVariable(1)}
+	plan1, logStats1 := getPlanCached(t, r, vc, query, makeComments(""), bindVars1, false)
+	require.NotEmpty(t, ps.planKey)
+	utils.MustMatch(t, map[string]*querypb.BindVariable{"vtg1": sqltypes.StringBindVariable("foo")}, ps.bindVars)
+
+	// The next execution reuses the plan without parsing the statement.
+	reused := preparedPlanCounts.Counts()["Reused"]
+	bindVars2 := map[string]*querypb.BindVariable{"v1": sqltypes.Int64BindVariable(2)}
+	plan2, logStats2 := getPlanCached(t, r, vc, query, makeComments(""), bindVars2, false)
+	assert.True(t, plan1 == plan2)
+	assert.Equal(t, reused+1, preparedPlanCounts.Counts()["Reused"])
+	assert.Equal(t, logStats1.SQL, logStats2.SQL)
+	utils.MustMatch(t, map[string]*querypb.BindVariable{"v1": sqltypes.Int64BindVariable(2), "vtg1": sqltypes.StringBindVariable("foo")}, bindVars2)
+
+	// Once the plan is out of the cache, the statement is planned again.
+	r.plans.Clear()
+	getPlanCached(t, r, vc, query, makeComments(""), map[string]*querypb.BindVariable{"v1": sqltypes.Int64BindVariable(3)}, false)
+	assert.Equal(t, reused+1, preparedPlanCounts.Counts()["Reused"])
+	assertCacheSize(t, r.plans, 1)
+}
+
 func TestGetPlanNormalized(t *testing.T) {
 	r, _, _, _ := createLegacyExecutorEnv()
 	r.normalize = true
@@ -2112,21 +2214,59 @@ func TestExecutorExplain(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t,
-		`[[VARCHAR("Route") VARCHAR("SelectScatter") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select * from `+"`user`"+`")]]`,
+		`[[VARCHAR("Route") VARCHAR("SelectScatter") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select * from `+"`user`"+`") VARCHAR("")]]`,
+		fmt.Sprintf("%v", result.Rows))
+
+	result, err = executorExec(executor, "explain format = vitess select /*vt+ FORCE_SCATTER SKIP_QUERY_PLAN_CACHE */ * from user where id = 1", bindVars)
+	require.NoError(t, err)
+	require.Equal(t,
+		`[[VARCHAR("Route") VARCHAR("SelectScatter") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select /*vt+ FORCE_SCATTER SKIP_QUERY_PLAN_CACHE */ * from `+"`user`"+` where id = 1") VARCHAR("SKIP_QUERY_PLAN_CACHE FORCE_SCATTER")]]`,
 		fmt.Sprintf("%v", result.Rows))
 
 	result, err = executorExec(executor, "explain format = vitess select 42", bindVars)
 	require.NoError(t, err)
 	expected :=
-		`[[VARCHAR("Projection") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")] ` +
-			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")]]`
+		`[[VARCHAR("Projection") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("") VARCHAR("")] ` +
+			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("") VARCHAR("")]]`
 	require.Equal(t,
-		`[[VARCHAR("Projection") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")] `+
-			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")]]`,
+		`[[VARCHAR("Projection") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("") VARCHAR("")] `+
+			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("") VARCHAR("")]]`,
 		expected,
 		fmt.Sprintf("%v", result.Rows), fmt.Sprintf("%v", result.Rows))
 }
 
+func TestExecutorEstimateCost(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	explainResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|select_type|table|type|key|rows|Extra",
+		"int64|varchar|varchar|varchar|varchar|int64|varchar"),
+		"1|SIMPLE|user|ALL|null|100|Using where",
+	)
+
+	sbc1.SetResults([]*sqltypes.Result{explainResult})
+	cost, err := executor.EstimateCost(context.Background(), "TestExecutor", "select id from user where foo = 'bar'", nil)
+	require.NoError(t, err)
+	require.Len(t, cost.Routes, 1)
+	require.Empty(t, cost.Routes[0].Error)
+	assert.Equal(t, 8, cost.Routes[0].Shards)
+	assert.Equal(t, 8, cost.Routes[0].TotalShards)
+	assert.EqualValues(t, 800, cost.EstimatedRows)
+	assert.Equal(t, []string{"user"}, cost.Routes[0].FullScans)
+	utils.MustMatch(t, []*querypb.BoundQuery{{
+		Sql:           "explain select id from `user` where foo = 'bar'",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}, sbc1.Queries)
+
+	sbc1.Queries = nil
+	sbc1.SetResults([]*sqltypes.Result{explainResult})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	result, err := executor.Execute(context.Background(), "TestExecutorEstimateCost", session, "explain format = cost select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t,
+		`[[VARCHAR("TestExecutor") VARCHAR("SelectEqualUnique") INT64(1) INT64(8) INT64(100) VARCHAR("") VARCHAR("user") VARCHAR("select id from `+"`user`"+` where id = 1") VARCHAR("")]]`,
+		fmt.Sprintf("%v", result.Rows))
+}
+
 func TestExecutorOtherAdmin(t *testing.T) {
 	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
 
@@ -2225,16 +2365,9 @@ func TestExecutorSavepointInTx(t *testing.T) {
 	require.NoError(t, err)
 	_, err = exec(executor, session, "rollback")
 	require.NoError(t, err)
+	// The released savepoints are not replayed on the shards which join the
+	// transaction later.
 	sbc1WantQueries := []*querypb.BoundQuery{{
-		Sql:           "savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "rollback to a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "release savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
 		Sql:           "select id from `user` where id = 1",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
@@ -2249,24 +2382,6 @@ func TestExecutorSavepointInTx(t *testing.T) {
 	}}
 
 	sbc2WantQueries := []*querypb.BoundQuery{{
-		Sql:           "savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "rollback to a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "release savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "savepoint b",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "rollback to b",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "release savepoint b",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
 		Sql:           "select id from `user` where id = 3",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}}
@@ -2324,14 +2439,9 @@ func TestExecutorSavepointInTxWithReservedConn(t *testing.T) {
 		Sql: "release savepoint a", BindVariables: emptyBV,
 	}}
 
+	// Releasing a also released b.
 	sbc2WantQueries := []*querypb.BoundQuery{{
 		Sql: "set @@sql_mode = ''", BindVariables: emptyBV,
-	}, {
-		Sql: "savepoint a", BindVariables: emptyBV,
-	}, {
-		Sql: "savepoint b", BindVariables: emptyBV,
-	}, {
-		Sql: "release savepoint a", BindVariables: emptyBV,
 	}, {
 		Sql: "select id from `user` where id = 3", BindVariables: emptyBV,
 	}}
@@ -2347,6 +2457,50 @@ func TestExecutorSavepointInTxWithReservedConn(t *testing.T) {
 	testQueryLog(t, logChan, "TestExecute", "COMMIT", "commit", 2)
 }
 
+func TestExecutorSavepointReplayOnJoiningShard(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: false, TargetString: "@primary"})
+	for _, sql := range []string{
+		"savepoint a",
+		"select id from user where id = 1",
+		"savepoint b",
+		"savepoint c",
+		"release savepoint c",
+		"rollback to A",
+		"select id from user where id = 3",
+	} {
+		_, err := exec(executor, session, sql)
+		require.NoError(t, err, sql)
+	}
+
+	// Only the savepoint which is still active is replayed on the shard
+	// joining the transaction.
+	sbc2WantQueries := []*querypb.BoundQuery{{
+		Sql:           "savepoint a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from `user` where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, sbc2WantQueries, sbc2.Queries, "")
+	require.Len(t, sbc1.Queries, 6)
+
+	for _, sql := range []string{"rollback to b", "release savepoint c"} {
+		_, err := exec(executor, session, sql)
+		require.Error(t, err, sql)
+		assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+		assert.Contains(t, err.Error(), "SAVEPOINT")
+	}
+	// The failed statements are not sent to the shards.
+	require.Len(t, sbc1.Queries, 6)
+	require.Len(t, sbc2.Queries, 2)
+
+	_, err := exec(executor, session, "release savepoint a")
+	require.NoError(t, err)
+	assert.Empty(t, session.Savepoints)
+}
+
 func TestExecutorSavepointWithoutTx(t *testing.T) {
 	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
 	logChan := QueryLogger.Subscribe("TestExecutorSavepoint")
@@ -2474,6 +2628,89 @@ func TestExecutorTempTable(t *testing.T) {
 	assert.Equal(t, before, executor.plans.Len())
 }
 
+func TestExecutorTempTableKeyspace(t *testing.T) {
+	executor, sbc1, sbc2, sbcUnsharded := createExecutorEnv()
+	defer func(ks string) { *tempTableKeyspace = ks }(*tempTableKeyspace)
+	*tempTableKeyspace = KsTestUnsharded
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor", Autocommit: true})
+	ctx := context.Background()
+
+	_, err := executor.Execute(ctx, "TestExecutorTempTableKeyspace", session, "create temporary table temp_t(id bigint primary key)", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbcUnsharded.ExecCount.Get())
+	assert.EqualValues(t, 1, sbcUnsharded.ReserveCount.Get())
+	assert.Equal(t, []string{"temp_t"}, session.TempTables)
+	assert.False(t, session.InReservedConn())
+
+	_, err = executor.Execute(ctx, "TestExecutorTempTableKeyspace", session, "select temp_t.id from temp_t join user on temp_t.id = user.id", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbcUnsharded.ExecCount.Get())
+	assert.EqualValues(t, 1, sbcUnsharded.ReserveCount.Get())
+	assert.Zero(t, sbc1.ReserveCount.Get()+sbc2.ReserveCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	assert.Equal(t, KsTestUnsharded, session.ShardSessions[0].Target.Keyspace)
+
+	// The dedicated connection is kept after a transaction.
+	_, err = executor.Execute(ctx, "TestExecutorTempTableKeyspace", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecutorTempTableKeyspace", session, "insert into temp_t(id) values (1)", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecutorTempTableKeyspace", session, "commit", nil)
+	require.NoError(t, err)
+	require.Len(t, session.ShardSessions, 1)
+	assert.NotZero(t, session.ShardSessions[0].ReservedId)
+
+	_, err = executor.Execute(ctx, "TestExecutorTempTableKeyspace", session, "drop temporary table temp_t", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.TempTables)
+
+	_, err = executor.Execute(ctx, "TestExecutorTempTableKeyspace", session, "create temporary table temp_t2(id bigint primary key)", nil)
+	require.NoError(t, err)
+	require.NoError(t, executor.CloseSession(ctx, session))
+	assert.EqualValues(t, 1, sbcUnsharded.ReleaseCount.Get())
+	assert.Empty(t, session.TempTables)
+	assert.Empty(t, session.ShardSessions)
+}
+
+func TestExecutorTempTableKeyspaceNotConfigured(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	_, err := executor.Execute(context.Background(), "TestExecutorTempTableKeyspaceNotConfigured", session, "create temporary table temp_t(id bigint primary key)", nil)
+	require.EqualError(t, err, "Temporary table not supported in sharded database TestExecutor")
+}
+
+func TestExecutorQueryUsage(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	ctx := context.Background()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", ReportQueryUsage: true})
+	result, err := executor.Execute(ctx, "TestExecutorQueryUsage", session, "select id from user", nil)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 8)
+	utils.MustMatch(t, &vtgatepb.QueryUsage{
+		ShardRows:        8,
+		BytesReturned:    32,
+		ShardQueries:     8,
+		BufferedDuration: &vttimepb.Duration{},
+	}, session.QueryUsage)
+
+	err = executor.StreamExecute(ctx, "TestExecutorQueryUsage", session, "select id from user where id = 1", nil, func(*sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtgatepb.QueryUsage{
+		ShardRows:        1,
+		BytesReturned:    4,
+		ShardQueries:     1,
+		BufferedDuration: &vttimepb.Duration{},
+	}, session.QueryUsage)
+
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+	_, err = executor.Execute(ctx, "TestExecutorQueryUsage", session, "select id from user", nil)
+	require.NoError(t, err)
+	assert.Nil(t, session.QueryUsage)
+}
+
 func TestExecutorShowVitessMigrations(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 	showQuery := "show vitess_migrations"
@@ -108,6 +108,11 @@ func (conn *FakeVTGateConn) ExecuteBatch(ctx context.Context, session *vtgatepb.
 	panic("not implemented")
 }
 
+// ExecuteScript please see vtgateconn.Impl.ExecuteScript
+func (conn *FakeVTGateConn) ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	panic("not implemented")
+}
+
 // StreamExecute please see vtgateconn.Impl.StreamExecute
 func (conn *FakeVTGateConn) StreamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVars map[string]*querypb.BindVariable) (sqltypes.ResultStream, error) {
 	response, ok := conn.execMap[sql]
@@ -124,6 +124,27 @@ func (conn *vtgateConn) ExecuteBatch(ctx context.Context, session *vtgatepb.Sess
 	return response.Session, sqltypes.Proto3ToQueryReponses(response.Results), nil
 }
 
+func (conn *vtgateConn) ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	request := &vtgatepb.ExecuteScriptRequest{
+		CallerId:   callerid.EffectiveCallerIDFromContext(ctx),
+		Session:    session,
+		Statements: statements,
+		Variables:  variables,
+	}
+	response, err := conn.c.ExecuteScript(ctx, request)
+	if err != nil {
+		return session, nil, nil, vterrors.FromGRPC(err)
+	}
+	if response.Error != nil {
+		return response.Session, nil, nil, vterrors.FromVTRPC(response.Error)
+	}
+	results := make([]*sqltypes.Result, len(response.Results))
+	for i, qr := range response.Results {
+		results[i] = sqltypes.Proto3ToResult(qr)
+	}
+	return response.Session, results, response.Variables, nil
+}
+
 type streamExecuteAdapter struct {
 	recv   func() (*querypb.QueryResult, error)
 	fields []*querypb.Field
@@ -155,11 +176,19 @@ func (conn *vtgateConn) StreamExecute(ctx context.Context, session *vtgatepb.Ses
 	}
 	return &streamExecuteAdapter{
 		recv: func() (*querypb.QueryResult, error) {
-			ser, err := stream.Recv()
-			if err != nil {
-				return nil, err
+			for {
+				ser, err := stream.Recv()
+				if err != nil {
+					return nil, err
+				}
+				if ser.Result == nil && ser.QueryUsage != nil {
+					// The trailer of the stream only reports the usage of
+					// the query.
+					session.QueryUsage = ser.QueryUsage
+					continue
+				}
+				return ser.Result, nil
 			}
-			return ser.Result, nil
 		},
 	}, nil
 }
@@ -155,6 +155,35 @@ func (f *fakeVTGateService) ExecuteBatch(ctx context.Context, session *vtgatepb.
 	}}, nil
 }
 
+// ExecuteScript is part of the VTGateService interface
+func (f *fakeVTGateService) ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	if f.hasError {
+		return session, nil, nil, errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "ExecuteScript")
+	execCase, ok := execMap[statements[0].Sql]
+	if !ok {
+		return session, nil, nil, fmt.Errorf("no match for: %s", statements[0].Sql)
+	}
+	query := &queryExecute{
+		SQL:           statements[0].Sql,
+		BindVariables: statements[0].BindVariables,
+		Session:       session,
+	}
+	if !query.equal(execCase.execQuery) {
+		f.t.Errorf("ExecuteScript: %+v, want %+v", query, execCase.execQuery)
+		return session, nil, nil, nil
+	}
+	if execCase.outSession != nil {
+		proto.Reset(session)
+		proto.Merge(session, execCase.outSession)
+	}
+	return session, []*sqltypes.Result{execCase.result}, variables, nil
+}
+
 // StreamExecute is part of the VTGateService interface
 func (f *fakeVTGateService) StreamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	if f.panics {
@@ -294,12 +323,14 @@ func RunTests(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGat
 	testExecute(t, session)
 	testStreamExecute(t, session)
 	testExecuteBatch(t, session)
+	testExecuteScript(t, session)
 	testPrepare(t, session)
 
 	// force a panic at every call, then test that works
 	fs.panics = true
 	testExecutePanic(t, session)
 	testExecuteBatchPanic(t, session)
+	testExecuteScriptPanic(t, session)
 	testStreamExecutePanic(t, session)
 	testPreparePanic(t, session)
 	fs.panics = false
@@ -319,6 +350,7 @@ func RunErrorTests(t *testing.T, fakeServer vtgateservice.VTGateService) {
 	fs.hasError = true
 	testExecuteError(t, session, fs)
 	testExecuteBatchError(t, session, fs)
+	testExecuteScriptError(t, session, fs)
 	testStreamExecuteError(t, session, fs)
 	testPrepareError(t, session, fs)
 	fs.hasError = false
@@ -419,6 +451,50 @@ func testExecuteBatchPanic(t *testing.T, session *vtgateconn.VTGateSession) {
 	expectPanic(t, err)
 }
 
+func testExecuteScript(t *testing.T, session *vtgateconn.VTGateSession) {
+	ctx := newContext()
+	execCase := execMap["request1"]
+	variables := map[string]*querypb.BindVariable{"v": sqltypes.Int64BindVariable(1)}
+	qrs, vars, err := session.ExecuteScript(ctx, []*vtgatepb.ScriptStatement{{
+		Sql:           execCase.execQuery.SQL,
+		BindVariables: execCase.execQuery.BindVariables,
+	}}, variables)
+	require.NoError(t, err)
+	if len(qrs) != 1 || !qrs[0].Equal(execCase.result) {
+		t.Errorf("Unexpected result from ExecuteScript: got\n%#v want\n%#v", qrs, execCase.result)
+	}
+	if !sqltypes.BindVariablesEqual(vars, variables) {
+		t.Errorf("Unexpected variables from ExecuteScript: got %v want %v", vars, variables)
+	}
+
+	_, _, err = session.ExecuteScript(ctx, []*vtgatepb.ScriptStatement{{Sql: "none"}}, nil)
+	want := "no match for: none"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("none request: %v, want %v", err, want)
+	}
+}
+
+func testExecuteScriptError(t *testing.T, session *vtgateconn.VTGateSession, fake *fakeVTGateService) {
+	ctx := newContext()
+	execCase := execMap["errorRequst"]
+
+	_, _, err := session.ExecuteScript(ctx, []*vtgatepb.ScriptStatement{{
+		Sql:           execCase.execQuery.SQL,
+		BindVariables: execCase.execQuery.BindVariables,
+	}}, nil)
+	verifyError(t, err, "ExecuteScript")
+}
+
+func testExecuteScriptPanic(t *testing.T, session *vtgateconn.VTGateSession) {
+	ctx := newContext()
+	execCase := execMap["request1"]
+	_, _, err := session.ExecuteScript(ctx, []*vtgatepb.ScriptStatement{{
+		Sql:           execCase.execQuery.SQL,
+		BindVariables: execCase.execQuery.BindVariables,
+	}}, nil)
+	expectPanic(t, err)
+}
+
 func testStreamExecute(t *testing.T, session *vtgateconn.VTGateSession) {
 	ctx := newContext()
 	execCase := execMap["request1"]
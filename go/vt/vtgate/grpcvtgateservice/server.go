@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate"
+	"vitess.io/vitess/go/vt/vtgate/arrowenc"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -117,10 +118,19 @@ func (vtg *VTGate) Execute(ctx context.Context, request *vtgatepb.ExecuteRequest
 		session.Options = request.Options
 	}
 	session, result, err := vtg.server.Execute(ctx, session, request.Query.Sql, request.Query.BindVariables)
+	var arrowResult []byte
+	if err == nil && request.ResultFormat == vtgatepb.ResultFormat_ARROW {
+		if arrowResult, err = arrowenc.EncodeResult(result); err == nil {
+			result = withoutRows(result)
+		} else {
+			result = nil
+		}
+	}
 	return &vtgatepb.ExecuteResponse{
-		Result:  sqltypes.ResultToProto3(result),
-		Session: session,
-		Error:   vterrors.ToVTRPC(err),
+		Result:      sqltypes.ResultToProto3(result),
+		ArrowResult: arrowResult,
+		Session:     session,
+		Error:       vterrors.ToVTRPC(err),
 	}, nil
 }
 
@@ -153,6 +163,29 @@ func (vtg *VTGate) ExecuteBatch(ctx context.Context, request *vtgatepb.ExecuteBa
 	}, nil
 }
 
+// ExecuteScript is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) ExecuteScript(ctx context.Context, request *vtgatepb.ExecuteScriptRequest) (response *vtgatepb.ExecuteScriptResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+
+	session := request.Session
+	if session == nil {
+		session = &vtgatepb.Session{Autocommit: true}
+	}
+
+	session, results, variables, err := vtg.server.ExecuteScript(ctx, session, request.Statements, request.Variables)
+	var qrs []*querypb.QueryResult
+	for _, result := range results {
+		qrs = append(qrs, sqltypes.ResultToProto3(result))
+	}
+	return &vtgatepb.ExecuteScriptResponse{
+		Results:   qrs,
+		Variables: variables,
+		Session:   session,
+		Error:     vterrors.ToVTRPC(err),
+	}, nil
+}
+
 // StreamExecute is the RPC version of vtgateservice.VTGateService method
 func (vtg *VTGate) StreamExecute(request *vtgatepb.StreamExecuteRequest, stream vtgateservicepb.Vitess_StreamExecuteServer) (err error) {
 	defer vtg.server.HandlePanic(&err)
@@ -171,14 +204,77 @@ func (vtg *VTGate) StreamExecute(request *vtgatepb.StreamExecuteRequest, stream
 	if session.Options == nil {
 		session.Options = request.Options
 	}
-	vtgErr := vtg.server.StreamExecute(ctx, session, request.Query.Sql, request.Query.BindVariables, func(value *sqltypes.Result) error {
+	var vtgErr error
+	if request.ResultFormat == vtgatepb.ResultFormat_ARROW {
+		vtgErr = vtg.streamExecuteArrow(ctx, session, request, stream)
+	} else {
+		vtgErr = vtg.server.StreamExecute(ctx, session, request.Query.Sql, request.Query.BindVariables, func(value *sqltypes.Result) error {
+			// Send is not safe to call concurrently, but vtgate
+			// guarantees that it's not.
+			return stream.Send(&vtgatepb.StreamExecuteResponse{
+				Result: sqltypes.ResultToProto3(value),
+			})
+		})
+	}
+	if vtgErr == nil && session.ReportQueryUsage && session.QueryUsage != nil {
+		// The usage is only known once the query is done, so it is sent
+		// as a trailer.
+		vtgErr = stream.Send(&vtgatepb.StreamExecuteResponse{
+			QueryUsage: session.QueryUsage,
+		})
+	}
+	return vterrors.ToGRPC(vtgErr)
+}
+
+// streamExecuteArrow streams the result as an Arrow stream: the schema is
+// sent with the fields, every batch of rows is sent as a record batch, and
+// the end of stream marker is sent once the query is done.
+func (vtg *VTGate) streamExecuteArrow(ctx context.Context, session *vtgatepb.Session, request *vtgatepb.StreamExecuteRequest, stream vtgateservicepb.Vitess_StreamExecuteServer) error {
+	var encoder *arrowenc.Encoder
+	err := vtg.server.StreamExecute(ctx, session, request.Query.Sql, request.Query.BindVariables, func(value *sqltypes.Result) error {
+		response := &vtgatepb.StreamExecuteResponse{}
+		if encoder == nil && value.Fields != nil {
+			encoder = arrowenc.NewEncoder(value.Fields)
+			response.ArrowBatch = encoder.Schema()
+		}
+		if len(value.Rows) > 0 {
+			if encoder == nil {
+				return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "received rows before the fields of the result")
+			}
+			batch, err := encoder.RecordBatch(value.Rows)
+			if err != nil {
+				return err
+			}
+			response.ArrowBatch = append(response.ArrowBatch, batch...)
+		}
+		if value.RowsAffected != 0 || value.InsertID != 0 {
+			response.Result = sqltypes.ResultToProto3(withoutRows(value))
+		}
+		if response.ArrowBatch == nil && response.Result == nil {
+			return nil
+		}
 		// Send is not safe to call concurrently, but vtgate
 		// guarantees that it's not.
-		return stream.Send(&vtgatepb.StreamExecuteResponse{
-			Result: sqltypes.ResultToProto3(value),
-		})
+		return stream.Send(response)
 	})
-	return vterrors.ToGRPC(vtgErr)
+	if err != nil || encoder == nil {
+		return err
+	}
+	return stream.Send(&vtgatepb.StreamExecuteResponse{
+		ArrowBatch: arrowenc.EndOfStream(),
+	})
+}
+
+// withoutRows returns the result without its fields and rows, which are
+// returned in the Arrow stream instead.
+func withoutRows(result *sqltypes.Result) *sqltypes.Result {
+	if result == nil {
+		return nil
+	}
+	return &sqltypes.Result{
+		RowsAffected: result.RowsAffected,
+		InsertID:     result.InsertID,
+	}
 }
 
 // Prepare is the RPC version of vtgateservice.VTGateService method
@@ -0,0 +1,370 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	loadBalancerRandom           = "random"
+	loadBalancerLeastOutstanding = "least_outstanding"
+	loadBalancerEWMA             = "ewma"
+
+	// loadBalancerWindow is the duration of the windows over which the
+	// imbalance of the picks is measured. The tablets which were not picked
+	// for a whole window are forgotten.
+	loadBalancerWindow = time.Minute
+	// loadBalancerFailurePenalty is the minimum latency recorded for a request
+	// which failed in a way the gateway retries on another tablet, so that
+	// a tablet which fails fast does not attract the requests.
+	loadBalancerFailurePenalty = time.Second
+)
+
+var (
+	loadBalancerPolicy           = flag.String("gateway_load_balancer_policy", loadBalancerRandom, "Policy the gateway uses to order the healthy tablets of a cell: random, least_outstanding (fewest requests in flight first) or ewma (lowest EWMA latency weighted by the requests in flight first)")
+	keyspaceLoadBalancerPolicies flagutil.StringMapValue
+	loadBalancerEWMADecay        = flag.Duration("gateway_load_balancer_ewma_decay", 10*time.Second, "Time constant of the decay of the EWMA latency of the tablets with the ewma load balancer policy")
+
+	loadBalancerPicks = stats.NewCountersWithMultiLabels(
+		"GatewayLoadBalancerPicks",
+		"Number of requests the gateway sent to each tablet",
+		[]string{"Keyspace", "ShardName", "TabletType", "Tablet"})
+)
+
+func init() {
+	flag.Var(&keyspaceLoadBalancerPolicies, "gateway_keyspace_load_balancer_policies", "comma separated list of keyspace:policy pairs overriding gateway_load_balancer_policy for these keyspaces")
+}
+
+// balancerPolicy scores the tablets for the load balancer. The tablets of a
+// cell are tried in the increasing order of their scores, and the ties in a
+// random order.
+type balancerPolicy interface {
+	score(load *tabletLoad) float64
+}
+
+type randomPolicy struct{}
+
+func (randomPolicy) score(*tabletLoad) float64 {
+	return 0
+}
+
+type leastOutstandingPolicy struct{}
+
+func (leastOutstandingPolicy) score(load *tabletLoad) float64 {
+	return float64(load.outstanding)
+}
+
+// ewmaPolicy scores the tablets with their EWMA latency multiplied by the
+// number of requests in flight plus one, so that the requests spread to
+// slower tablets as the faster ones get busy. A tablet without latency yet
+// is tried first, by one request at a time.
+type ewmaPolicy struct{}
+
+func (ewmaPolicy) score(load *tabletLoad) float64 {
+	if load.lastUpdate.IsZero() {
+		if load.outstanding == 0 {
+			return 0
+		}
+		return math.MaxFloat64
+	}
+	return load.ewma * float64(load.outstanding+1)
+}
+
+func newBalancerPolicy(name string) (balancerPolicy, error) {
+	switch name {
+	case loadBalancerRandom:
+		return randomPolicy{}, nil
+	case loadBalancerLeastOutstanding:
+		return leastOutstandingPolicy{}, nil
+	case loadBalancerEWMA:
+		return ewmaPolicy{}, nil
+	}
+	return nil, fmt.Errorf("unknown load balancer policy %q, expected %s, %s or %s", name, loadBalancerRandom, loadBalancerLeastOutstanding, loadBalancerEWMA)
+}
+
+// tabletLoad is the load of a tablet, as seen by this gateway.
+type tabletLoad struct {
+	keyspace   string
+	shard      string
+	tabletType topodatapb.TabletType
+	alias      string
+	cell       string
+
+	// outstanding is the number of requests in flight.
+	outstanding int64
+	// ewma is the EWMA latency of the requests, in nanoseconds.
+	ewma       float64
+	lastUpdate time.Time
+	// windowPicks and lastWindowPicks are the number of requests sent to the
+	// tablet in the current and in the last windows.
+	windowPicks     int64
+	lastWindowPicks int64
+}
+
+// observe updates the EWMA latency with the latency of a request. A latency
+// higher than the EWMA replaces it, so that the policy reacts immediately to
+// a slow tablet.
+func (load *tabletLoad) observe(latency time.Duration, now time.Time, decay time.Duration) {
+	sample := float64(latency)
+	if load.lastUpdate.IsZero() || sample > load.ewma {
+		load.ewma = sample
+	} else {
+		w := math.Exp(-float64(now.Sub(load.lastUpdate)) / float64(decay))
+		load.ewma = load.ewma*w + sample*(1-w)
+	}
+	load.lastUpdate = now
+}
+
+// loadBalancer orders the healthy tablets of a target for the gateway, and
+// tracks the load of the tablets.
+type loadBalancer struct {
+	localCell        string
+	defaultPolicy    balancerPolicy
+	keyspacePolicies map[string]balancerPolicy
+	decay            time.Duration
+	now              func() time.Time
+
+	// mu protects the fields of this group, and the fields of the tablet
+	// loads.
+	mu          sync.Mutex
+	tablets     map[string]*tabletLoad
+	windowStart time.Time
+}
+
+// newLoadBalancer returns the load balancer of the policy flags.
+func newLoadBalancer(localCell string) (*loadBalancer, error) {
+	defaultPolicy, err := newBalancerPolicy(*loadBalancerPolicy)
+	if err != nil {
+		return nil, err
+	}
+	lb := &loadBalancer{
+		localCell:        localCell,
+		defaultPolicy:    defaultPolicy,
+		keyspacePolicies: make(map[string]balancerPolicy),
+		decay:            *loadBalancerEWMADecay,
+		now:              time.Now,
+		tablets:          make(map[string]*tabletLoad),
+	}
+	for keyspace, name := range keyspaceLoadBalancerPolicies {
+		policy, err := newBalancerPolicy(name)
+		if err != nil {
+			return nil, fmt.Errorf("keyspace %s: %v", keyspace, err)
+		}
+		lb.keyspacePolicies[keyspace] = policy
+	}
+	return lb, nil
+}
+
+func (lb *loadBalancer) policy(keyspace string) balancerPolicy {
+	if policy, ok := lb.keyspacePolicies[keyspace]; ok {
+		return policy
+	}
+	return lb.defaultPolicy
+}
+
+// order sorts the tablets of a keyspace, which are shuffled with the tablets
+// of the local cell first, in the order of the policy of the keyspace within
+// each cell group.
+func (lb *loadBalancer) order(keyspace string, tablets []*discovery.TabletHealth) {
+	policy := lb.policy(keyspace)
+	if _, ok := policy.(randomPolicy); ok {
+		return
+	}
+	local := 0
+	for local < len(tablets) && tablets[local].Tablet.Alias.Cell == lb.localCell {
+		local++
+	}
+
+	scores := make(map[*discovery.TabletHealth]float64, len(tablets))
+	lb.mu.Lock()
+	for _, th := range tablets {
+		if load, ok := lb.tablets[topoproto.TabletAliasString(th.Tablet.Alias)]; ok {
+			scores[th] = policy.score(load)
+		}
+	}
+	lb.mu.Unlock()
+
+	byScore := func(tablets []*discovery.TabletHealth) {
+		sort.SliceStable(tablets, func(i, j int) bool {
+			return scores[tablets[i]] < scores[tablets[j]]
+		})
+	}
+	byScore(tablets[:local])
+	byScore(tablets[local:])
+}
+
+// start records a request sent to a tablet. The returned function must be
+// called when the request is done, with whether it failed in a way the
+// gateway retries on another tablet.
+func (lb *loadBalancer) start(th *discovery.TabletHealth) func(failed bool) {
+	alias := topoproto.TabletAliasString(th.Tablet.Alias)
+	now := lb.now()
+
+	lb.mu.Lock()
+	lb.rollWindow(now)
+	load, ok := lb.tablets[alias]
+	if !ok {
+		load = &tabletLoad{
+			keyspace:   th.Target.Keyspace,
+			shard:      th.Target.Shard,
+			tabletType: th.Target.TabletType,
+			alias:      alias,
+			cell:       th.Tablet.Alias.Cell,
+		}
+		lb.tablets[alias] = load
+	}
+	load.outstanding++
+	load.windowPicks++
+	lb.mu.Unlock()
+	loadBalancerPicks.Add([]string{load.keyspace, load.shard, topoproto.TabletTypeLString(load.tabletType), alias}, 1)
+
+	return func(failed bool) {
+		done := lb.now()
+		latency := done.Sub(now)
+		if failed && latency < loadBalancerFailurePenalty {
+			latency = loadBalancerFailurePenalty
+		}
+		lb.mu.Lock()
+		defer lb.mu.Unlock()
+		load.outstanding--
+		load.observe(latency, done, lb.decay)
+	}
+}
+
+// rollWindow starts a new window if the current one is over, and forgets
+// the tablets which were not picked in the last window. It must be called
+// with mu held.
+func (lb *loadBalancer) rollWindow(now time.Time) {
+	if now.Sub(lb.windowStart) < loadBalancerWindow {
+		return
+	}
+	for alias, load := range lb.tablets {
+		if load.windowPicks == 0 && load.outstanding == 0 {
+			delete(lb.tablets, alias)
+			continue
+		}
+		load.lastWindowPicks = load.windowPicks
+		load.windowPicks = 0
+	}
+	lb.windowStart = now
+}
+
+func tabletLoadKey(load *tabletLoad) string {
+	return strings.Join([]string{load.keyspace, load.shard, topoproto.TabletTypeLString(load.tabletType), load.alias}, ".")
+}
+
+// outstandingStats returns the number of requests in flight per tablet.
+func (lb *loadBalancer) outstandingStats() map[string]int64 {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	res := make(map[string]int64, len(lb.tablets))
+	for _, load := range lb.tablets {
+		res[tabletLoadKey(load)] = load.outstanding
+	}
+	return res
+}
+
+// latencyStats returns the EWMA latency per tablet, in microseconds.
+func (lb *loadBalancer) latencyStats() map[string]int64 {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	res := make(map[string]int64, len(lb.tablets))
+	for _, load := range lb.tablets {
+		res[tabletLoadKey(load)] = int64(load.ewma / float64(time.Microsecond))
+	}
+	return res
+}
+
+// imbalanceStats returns, per keyspace/shard/tablet type, the number of
+// picks of the most picked tablet in the last window as a percentage of the
+// average number of picks of the tablets, among the tablets of the local
+// cell if there are any. 100 means that the requests were evenly spread.
+func (lb *loadBalancer) imbalanceStats() map[string]int64 {
+	type targetPicks struct {
+		local, remote []int64
+	}
+	lb.mu.Lock()
+	picks := make(map[string]*targetPicks)
+	for _, load := range lb.tablets {
+		key := strings.Join([]string{load.keyspace, load.shard, topoproto.TabletTypeLString(load.tabletType)}, ".")
+		tp, ok := picks[key]
+		if !ok {
+			tp = &targetPicks{}
+			picks[key] = tp
+		}
+		if load.cell == lb.localCell {
+			tp.local = append(tp.local, load.lastWindowPicks)
+		} else {
+			tp.remote = append(tp.remote, load.lastWindowPicks)
+		}
+	}
+	lb.mu.Unlock()
+
+	res := make(map[string]int64, len(picks))
+	for key, tp := range picks {
+		counts := tp.local
+		if len(counts) == 0 {
+			counts = tp.remote
+		}
+		var total, max int64
+		for _, count := range counts {
+			total += count
+			if count > max {
+				max = count
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		res[key] = max * 100 * int64(len(counts)) / total
+	}
+	return res
+}
+
+// registerStats registers the stats of the load of the tablets.
+func (lb *loadBalancer) registerStats() {
+	labels := []string{"Keyspace", "ShardName", "TabletType", "Tablet"}
+	stats.NewGaugesFuncWithMultiLabels(
+		"GatewayLoadBalancerOutstanding",
+		"Number of requests in flight per tablet",
+		labels,
+		lb.outstandingStats)
+	stats.NewGaugesFuncWithMultiLabels(
+		"GatewayLoadBalancerLatencyEWMAMicros",
+		"EWMA latency of the requests per tablet, in microseconds",
+		labels,
+		lb.latencyStats)
+	stats.NewGaugesFuncWithMultiLabels(
+		"GatewayLoadBalancerImbalance",
+		"Picks of the most picked tablet of each target in the last minute, as a percentage of the average picks of its tablets",
+		[]string{"Keyspace", "ShardName", "TabletType"},
+		lb.imbalanceStats)
+}
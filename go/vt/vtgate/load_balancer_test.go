@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestTabletHealth(uid uint32, cell string) *discovery.TabletHealth {
+	return &discovery.TabletHealth{
+		Tablet:  topo.NewTablet(uid, cell, "host"),
+		Target:  &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Serving: true,
+	}
+}
+
+// newTestLoadBalancer returns a load balancer with a fake clock and the
+// policies of the flags.
+func newTestLoadBalancer(t *testing.T, policy string, keyspacePolicies map[string]string) (*loadBalancer, *time.Time) {
+	t.Helper()
+	defer func(policy string, keyspacePolicies flagutil.StringMapValue) {
+		*loadBalancerPolicy = policy
+		keyspaceLoadBalancerPolicies = keyspacePolicies
+	}(*loadBalancerPolicy, keyspaceLoadBalancerPolicies)
+	*loadBalancerPolicy = policy
+	keyspaceLoadBalancerPolicies = keyspacePolicies
+
+	lb, err := newLoadBalancer("cell1")
+	require.NoError(t, err)
+	now := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lb.now = func() time.Time { return now }
+	return lb, &now
+}
+
+func tabletUIDs(tablets []*discovery.TabletHealth) []uint32 {
+	var uids []uint32
+	for _, th := range tablets {
+		uids = append(uids, th.Tablet.Alias.Uid)
+	}
+	return uids
+}
+
+func TestLoadBalancerLeastOutstanding(t *testing.T) {
+	lb, _ := newTestLoadBalancer(t, loadBalancerLeastOutstanding, nil)
+	th1, th2, th3, th4 := newTestTabletHealth(1, "cell1"), newTestTabletHealth(2, "cell1"), newTestTabletHealth(3, "cell2"), newTestTabletHealth(4, "cell2")
+
+	// Two requests are in flight on tablet 1, one on tablets 2 and 4.
+	lb.start(th1)
+	lb.start(th1)
+	done2 := lb.start(th2)
+	lb.start(th4)
+
+	// The tablets of the local cell stay first.
+	tablets := []*discovery.TabletHealth{th1, th2, th4, th3}
+	lb.order("ks", tablets)
+	assert.Equal(t, []uint32{2, 1, 3, 4}, tabletUIDs(tablets))
+
+	done2(false)
+	for i := 0; i < 3; i++ {
+		lb.start(th2)
+	}
+	tablets = []*discovery.TabletHealth{th2, th1, th3, th4}
+	lb.order("ks", tablets)
+	assert.Equal(t, []uint32{1, 2, 3, 4}, tabletUIDs(tablets))
+}
+
+func TestLoadBalancerEWMA(t *testing.T) {
+	lb, now := newTestLoadBalancer(t, loadBalancerEWMA, nil)
+	th1, th2, th3 := newTestTabletHealth(1, "cell1"), newTestTabletHealth(2, "cell1"), newTestTabletHealth(3, "cell1")
+
+	request := func(th *discovery.TabletHealth, latency time.Duration, failed bool) {
+		done := lb.start(th)
+		*now = now.Add(latency)
+		done(failed)
+	}
+	request(th1, 10*time.Millisecond, false)
+	request(th2, 50*time.Millisecond, false)
+
+	// The tablet without latency is tried first, then the fastest one.
+	tablets := []*discovery.TabletHealth{th2, th1, th3}
+	lb.order("ks", tablets)
+	assert.Equal(t, []uint32{3, 1, 2}, tabletUIDs(tablets))
+
+	// A tablet without latency is probed by one request at a time.
+	lb.start(th3)
+	lb.order("ks", tablets)
+	assert.Equal(t, []uint32{1, 2, 3}, tabletUIDs(tablets))
+
+	// A slow request raises the latency of a tablet immediately.
+	request(th1, 100*time.Millisecond, false)
+	lb.order("ks", tablets)
+	assert.Equal(t, []uint32{2, 1, 3}, tabletUIDs(tablets))
+
+	// The latency decays to the latency of the fast requests.
+	for i := 0; i < 100; i++ {
+		*now = now.Add(time.Second)
+		request(th1, 10*time.Millisecond, false)
+	}
+	lb.order("ks", tablets)
+	assert.Equal(t, []uint32{1, 2, 3}, tabletUIDs(tablets))
+
+	// The requests in flight weigh the latency.
+	for i := 0; i < 5; i++ {
+		lb.start(th1)
+	}
+	lb.order("ks", tablets)
+	assert.Equal(t, []uint32{2, 1, 3}, tabletUIDs(tablets))
+
+	// A failed request counts as a slow one.
+	request(th2, time.Millisecond, true)
+	assert.Equal(t, float64(loadBalancerFailurePenalty), lb.tablets["cell1-0000000002"].ewma)
+}
+
+func TestLoadBalancerKeyspacePolicies(t *testing.T) {
+	lb, _ := newTestLoadBalancer(t, loadBalancerRandom, map[string]string{"ks": loadBalancerLeastOutstanding})
+	assert.Equal(t, leastOutstandingPolicy{}, lb.policy("ks"))
+	assert.Equal(t, randomPolicy{}, lb.policy("other"))
+
+	defer func(policy string) {
+		*loadBalancerPolicy = policy
+	}(*loadBalancerPolicy)
+	*loadBalancerPolicy = "fastest"
+	_, err := newLoadBalancer("cell1")
+	assert.EqualError(t, err, `unknown load balancer policy "fastest", expected random, least_outstanding or ewma`)
+	*loadBalancerPolicy = loadBalancerRandom
+	keyspaceLoadBalancerPolicies = map[string]string{"ks": "fastest"}
+	defer func() { keyspaceLoadBalancerPolicies = nil }()
+	_, err = newLoadBalancer("cell1")
+	assert.EqualError(t, err, `keyspace ks: unknown load balancer policy "fastest", expected random, least_outstanding or ewma`)
+}
+
+func TestLoadBalancerStats(t *testing.T) {
+	lb, now := newTestLoadBalancer(t, loadBalancerRandom, nil)
+	th1, th2, th3 := newTestTabletHealth(1, "cell1"), newTestTabletHealth(2, "cell1"), newTestTabletHealth(3, "cell2")
+
+	for i := 0; i < 3; i++ {
+		lb.start(th1)(false)
+	}
+	lb.start(th2)(false)
+	lb.start(th3)(false)
+	*now = now.Add(time.Millisecond)
+	done := lb.start(th2)
+	*now = now.Add(2 * time.Millisecond)
+	done(false)
+	lb.start(th1)
+
+	assert.Equal(t, map[string]int64{
+		"ks.0.replica.cell1-0000000001": 1,
+		"ks.0.replica.cell1-0000000002": 0,
+		"ks.0.replica.cell2-0000000003": 0,
+	}, lb.outstandingStats())
+	assert.Equal(t, int64(2000), lb.latencyStats()["ks.0.replica.cell1-0000000002"])
+
+	// The imbalance is measured on the last window, among the tablets of the
+	// local cell: tablet 1 got 4 picks and tablet 2 got 2.
+	assert.Empty(t, lb.imbalanceStats())
+	*now = now.Add(loadBalancerWindow)
+	lb.start(th2)(false)
+	assert.Equal(t, map[string]int64{"ks.0.replica": 133}, lb.imbalanceStats())
+
+	// The tablets which were not picked in the last window are forgotten.
+	*now = now.Add(loadBalancerWindow)
+	lb.start(th2)(false)
+	assert.Len(t, lb.tablets, 2)
+	assert.Contains(t, lb.tablets, "cell1-0000000001")
+}
+
+func TestTabletGatewayLoadBalancer(t *testing.T) {
+	defer func(policy string) {
+		*loadBalancerPolicy = policy
+	}(*loadBalancerPolicy)
+	*loadBalancerPolicy = loadBalancerLeastOutstanding
+
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	sbc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, target.Keyspace, target.Shard, target.TabletType, true, 10, nil)
+	sbc2 := hc.AddTestTablet("cell", "1.1.1.2", 1002, target.Keyspace, target.Shard, target.TabletType, true, 10, nil)
+
+	// The requests go to the tablet without requests in flight.
+	var busy *discovery.TabletHealth
+	for _, th := range hc.GetHealthyTabletStats(target) {
+		if th.Conn == sbc1 {
+			busy = th
+		}
+	}
+	tg.balancer.start(busy)
+	for i := 0; i < 5; i++ {
+		_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 5, sbc2.ExecCount.Get())
+}
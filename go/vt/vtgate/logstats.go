@@ -21,16 +21,20 @@ import (
 	"html/template"
 	"io"
 	"net/url"
+	"sort"
+	"sync"
 	"time"
 
 	"context"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -50,10 +54,24 @@ type LogStats struct {
 	ShardQueries  uint64
 	RowsAffected  uint64
 	RowsReturned  uint64
+	ShardRows     uint64
 	PlanTime      time.Duration
 	ExecuteTime   time.Duration
 	CommitTime    time.Duration
+	BufferedTime  sync2.AtomicDuration
 	Error         error
+	RequestID     string
+
+	// PlanType is the route type of the plan of the query, and CachedPlan
+	// is true if the plan came from the plan cache.
+	PlanType   string
+	CachedPlan bool
+
+	// shards are the keyspace/shard of the shards the query was sent to,
+	// and shardTimes the time these shards took to answer.
+	mu         sync.Mutex
+	shards     map[string]bool
+	shardTimes map[string]time.Duration
 }
 
 // NewLogStats constructs a new LogStats with supplied Method and ctx
@@ -72,6 +90,59 @@ func NewLogStats(ctx context.Context, methodName, sql string, bindVars map[strin
 func (stats *LogStats) Send() {
 	stats.EndTime = time.Now()
 	QueryLogger.Send(stats)
+	if isSlowQuery(stats) {
+		SlowQueryLogger.Send(stats)
+	}
+}
+
+// AddShards records the shards the query is sent to.
+func (stats *LogStats) AddShards(rss []*srvtopo.ResolvedShard) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if stats.shards == nil {
+		stats.shards = make(map[string]bool, len(rss))
+	}
+	for _, rs := range rss {
+		stats.shards[rs.Target.Keyspace+"/"+rs.Target.Shard] = true
+	}
+}
+
+// Shards returns the sorted keyspace/shard of the shards the query was sent
+// to.
+func (stats *LogStats) Shards() []string {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if len(stats.shards) == 0 {
+		return nil
+	}
+	shards := make([]string, 0, len(stats.shards))
+	for shard := range stats.shards {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	return shards
+}
+
+// AddShardTime adds the time the shard took to answer a query.
+func (stats *LogStats) AddShardTime(keyspace, shard string, d time.Duration) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if stats.shardTimes == nil {
+		stats.shardTimes = make(map[string]time.Duration)
+	}
+	stats.shardTimes[keyspace+"/"+shard] += d
+}
+
+// ShardTimes returns the time the tablets of each keyspace/shard took to
+// answer the queries.
+func (stats *LogStats) ShardTimes() map[string]time.Duration {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	shardTimes := make(map[string]time.Duration, len(stats.shardTimes))
+	for shard, d := range stats.shardTimes {
+		shardTimes[shard] = d
+	}
+	return shardTimes
 }
 
 // Context returns the context used by LogStats.
@@ -154,9 +225,9 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	var fmtString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t%q\t%q\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t%q\t%q\t%q\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q,  \"Keyspace\": %q, \"Table\": %q, \"TabletType\": %q}\n"
+		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q,  \"Keyspace\": %q, \"Table\": %q, \"TabletType\": %q, \"RequestID\": %q}\n"
 	}
 
 	_, err := fmt.Fprintf(
@@ -182,6 +253,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.Keyspace,
 		stats.Table,
 		stats.TabletType,
+		stats.RequestID,
 	)
 	return err
 }
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	lookupCacheVStream = flag.Bool("lookup_cache_vstream_invalidation", false, "Invalidate the caches of the lookup vindexes from a vstream of their lookup tables, so that the changes made through other vtgates are seen before the cached rows expire")
+
+	lookupCacheRefreshInterval = 30 * time.Second
+	lookupCacheRetryDelay      = 5 * time.Second
+)
+
+type vstreamFunc func(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error
+
+// lookupCacheInvalidator streams the changes of the lookup tables of the
+// vindexes which cache their rows, and invalidates the changed rows. The
+// vindexes are found in the current vschema, which is checked periodically.
+type lookupCacheInvalidator struct {
+	vschema func() *vindexes.VSchema
+	vstream vstreamFunc
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	streams map[string]*lookupTableStream
+}
+
+// lookupTableStream is the stream of a lookup table.
+type lookupTableStream struct {
+	keyspace, table string
+	cancel          context.CancelFunc
+
+	mu     sync.Mutex
+	caches []*vindexes.LookupCache
+}
+
+func newLookupCacheInvalidator(vschema func() *vindexes.VSchema, vstream vstreamFunc) *lookupCacheInvalidator {
+	return &lookupCacheInvalidator{
+		vschema: vschema,
+		vstream: vstream,
+		streams: make(map[string]*lookupTableStream),
+	}
+}
+
+func (lci *lookupCacheInvalidator) Open() {
+	ctx, cancel := context.WithCancel(context.Background())
+	lci.cancel = cancel
+	lci.wg.Add(1)
+	go func() {
+		defer lci.wg.Done()
+		ticker := time.NewTicker(lookupCacheRefreshInterval)
+		defer ticker.Stop()
+		for {
+			lci.refresh(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (lci *lookupCacheInvalidator) Close() {
+	if lci.cancel == nil {
+		return
+	}
+	lci.cancel()
+	lci.wg.Wait()
+	lci.cancel = nil
+}
+
+// refresh starts the streams of the new cached lookup tables, and stops the
+// streams of the tables which are not cached anymore.
+func (lci *lookupCacheInvalidator) refresh(ctx context.Context) {
+	caches := make(map[string][]*vindexes.LookupCache)
+	if vschema := lci.vschema(); vschema != nil {
+		for _, ks := range vschema.Keyspaces {
+			for _, vindex := range ks.Vindexes {
+				cacher, ok := vindex.(vindexes.LookupCacher)
+				if !ok || cacher.LookupCache() == nil {
+					continue
+				}
+				lc := cacher.LookupCache()
+				caches[lc.Table()] = append(caches[lc.Table()], lc)
+			}
+		}
+	}
+
+	lci.mu.Lock()
+	defer lci.mu.Unlock()
+	for name, stream := range lci.streams {
+		if _, ok := caches[name]; !ok {
+			stream.cancel()
+			delete(lci.streams, name)
+		}
+	}
+	for name, tableCaches := range caches {
+		if stream, ok := lci.streams[name]; ok {
+			stream.setCaches(tableCaches)
+			continue
+		}
+		keyspace, table, err := sqlparser.ParseTable(name)
+		if err != nil || keyspace == "" {
+			log.Warningf("Lookup table %s is not qualified by its keyspace, its cache is not invalidated from a vstream", name)
+			continue
+		}
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream := &lookupTableStream{keyspace: keyspace, table: table, cancel: cancel}
+		stream.setCaches(tableCaches)
+		lci.streams[name] = stream
+		lci.wg.Add(1)
+		go func() {
+			defer lci.wg.Done()
+			lci.stream(streamCtx, stream)
+		}()
+	}
+}
+
+// stream invalidates the changed rows until the context is done. The caches
+// are cleared every time the stream starts, since the changes made while it
+// was not running are lost.
+func (lci *lookupCacheInvalidator) stream(ctx context.Context, stream *lookupTableStream) {
+	vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{
+		Keyspace: stream.keyspace,
+		Gtid:     "current",
+	}}}
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{
+		Match: stream.table,
+	}}}
+	for {
+		stream.clear()
+		var fields []*querypb.Field
+		err := lci.vstream(ctx, topodatapb.TabletType_PRIMARY, vgtid, filter, nil, func(events []*binlogdatapb.VEvent) error {
+			for _, event := range events {
+				switch event.Type {
+				case binlogdatapb.VEventType_FIELD:
+					fields = event.FieldEvent.Fields
+				case binlogdatapb.VEventType_ROW:
+					stream.invalidate(fields, event.RowEvent)
+				}
+			}
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warningf("Lookup cache vstream of %s.%s failed, retrying: %v", stream.keyspace, stream.table, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(lookupCacheRetryDelay):
+		}
+	}
+}
+
+func (lts *lookupTableStream) setCaches(caches []*vindexes.LookupCache) {
+	lts.mu.Lock()
+	defer lts.mu.Unlock()
+	lts.caches = caches
+}
+
+func (lts *lookupTableStream) clear() {
+	lts.mu.Lock()
+	defer lts.mu.Unlock()
+	for _, lc := range lts.caches {
+		lc.Clear()
+	}
+}
+
+// invalidate removes the before and after values of the changed rows from
+// the caches.
+func (lts *lookupTableStream) invalidate(fields []*querypb.Field, event *binlogdatapb.RowEvent) {
+	lts.mu.Lock()
+	defer lts.mu.Unlock()
+	for _, lc := range lts.caches {
+		column := -1
+		for i, field := range fields {
+			if field.Name == lc.FromColumn() {
+				column = i
+				break
+			}
+		}
+		if column == -1 {
+			// The columns are unknown, none of the rows can be trusted.
+			lc.Clear()
+			continue
+		}
+		var ids []sqltypes.Value
+		for _, change := range event.RowChanges {
+			for _, row := range []*querypb.Row{change.Before, change.After} {
+				if row != nil {
+					ids = append(ids, sqltypes.MakeRowTrusted(fields, row)[column])
+				}
+			}
+		}
+		lc.Invalidate(ids)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestLookupCacheInvalidator(t *testing.T) {
+	vindex, err := vindexes.CreateVindex("lookup_hash", "lkp", map[string]string{
+		"table":      "lookup.lkp_table",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "10",
+	})
+	require.NoError(t, err)
+	unqualified, err := vindexes.CreateVindex("lookup_hash", "unqualified", map[string]string{
+		"table":      "lkp_table",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "10",
+	})
+	require.NoError(t, err)
+	vschema := &vindexes.VSchema{Keyspaces: map[string]*vindexes.KeyspaceSchema{
+		"user": {Vindexes: map[string]vindexes.Vindex{
+			"lkp":         vindex,
+			"unqualified": unqualified,
+		}},
+	}}
+
+	fields := sqltypes.MakeTestFields("fromc|toc", "int64|uint64")
+	streamed := make(chan *binlogdatapb.Filter, 1)
+	vstream := func(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
+		assert.Equal(t, topodatapb.TabletType_PRIMARY, tabletType)
+		assert.Equal(t, "lookup", vgtid.ShardGtids[0].Keyspace)
+		err := send([]*binlogdatapb.VEvent{{
+			Type:       binlogdatapb.VEventType_FIELD,
+			FieldEvent: &binlogdatapb.FieldEvent{TableName: "lkp_table", Fields: fields},
+		}, {
+			Type: binlogdatapb.VEventType_ROW,
+			RowEvent: &binlogdatapb.RowEvent{TableName: "lkp_table", RowChanges: []*binlogdatapb.RowChange{{
+				Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewUint64(1)}),
+				After:  sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewUint64(1)}),
+			}}},
+		}})
+		require.NoError(t, err)
+		streamed <- filter
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	invalidations := func() int64 {
+		return expvar.Get("VindexLookupCacheInvalidations").(*stats.CountersWithSingleLabel).Counts()["lookup.lkp_table"]
+	}
+	before := invalidations()
+	lci := newLookupCacheInvalidator(func() *vindexes.VSchema { return vschema }, vstream)
+	lci.Open()
+	defer lci.Close()
+
+	select {
+	case filter := <-streamed:
+		assert.Equal(t, "lkp_table", filter.Rules[0].Match)
+	case <-time.After(10 * time.Second):
+		t.Fatal("lookup table was not streamed")
+	}
+	assert.Equal(t, before+2, invalidations())
+	lci.mu.Lock()
+	assert.Len(t, lci.streams, 1)
+	lci.mu.Unlock()
+
+	// The streams of the vindexes which are not cached anymore are stopped.
+	vschema = &vindexes.VSchema{}
+	lci.refresh(context.Background())
+	lci.mu.Lock()
+	assert.Empty(t, lci.streams)
+	lci.mu.Unlock()
+}
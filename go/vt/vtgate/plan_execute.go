@@ -102,6 +102,8 @@ func (e *Executor) newExecute(
 		return err
 	}
 
+	defer withPlanWorkload(plan, safeSession)()
+
 	if plan.Instructions.NeedsTransaction() {
 		return e.insideTransaction(ctx, safeSession, logStats,
 			func() error {
@@ -109,6 +111,16 @@ func (e *Executor) newExecute(
 			})
 	}
 
+	if canReadStale(plan, safeSession) {
+		sr := &staleReads{}
+		vcursor.ctx = withStaleReads(vcursor.ctx, sr)
+		defer func() {
+			if warning := sr.warning(); warning != nil {
+				safeSession.RecordWarning(warning)
+			}
+		}()
+	}
+
 	return execPlan(plan, vcursor, bindVars, execStart)
 }
 
@@ -126,23 +138,8 @@ func (e *Executor) handleTransactions(ctx context.Context, safeSession *SafeSess
 	case sqlparser.StmtRollback:
 		qr, err := e.handleRollback(ctx, safeSession, logStats)
 		return qr, err
-	case sqlparser.StmtSavepoint:
-		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Savepoint", logStats, func(_ string) (*sqltypes.Result, error) {
-			// Safely to ignore as there is no transaction.
-			return &sqltypes.Result{}, nil
-		}, vcursor.ignoreMaxMemoryRows)
-		return qr, err
-	case sqlparser.StmtSRollback:
-		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Rollback Savepoint", logStats, func(query string) (*sqltypes.Result, error) {
-			// Error as there is no transaction, so there is no savepoint that exists.
-			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT does not exist: %s", query)
-		}, vcursor.ignoreMaxMemoryRows)
-		return qr, err
-	case sqlparser.StmtRelease:
-		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Release Savepoint", logStats, func(query string) (*sqltypes.Result, error) {
-			// Error as there is no transaction, so there is no savepoint that exists.
-			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT does not exist: %s", query)
-		}, vcursor.ignoreMaxMemoryRows)
+	case sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
+		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, logStats, vcursor.ignoreMaxMemoryRows)
 		return qr, err
 	}
 	return nil, nil
@@ -207,6 +204,11 @@ func (e *Executor) executePlan(
 
 	// 4: Execute!
 	qr, err := vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+	if err == nil && qr != nil {
+		if err = checkMaxRows(plan, len(qr.Rows)); err != nil {
+			qr = nil
+		}
+	}
 
 	// 5: Log and add statistics
 	e.setLogStats(logStats, plan, vcursor, execStart, err, qr)
@@ -248,6 +250,9 @@ func (e *Executor) logPlanningFinished(logStats *LogStats, plan *engine.Plan) ti
 	execStart := time.Now()
 	if plan != nil {
 		logStats.StmtType = plan.Type.String()
+		if plan.Instructions != nil {
+			logStats.PlanType = plan.Instructions.RouteType()
+		}
 	}
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 	return execStart
@@ -49,6 +49,9 @@ type ContextVSchema interface {
 	AnyKeyspace() (*vindexes.Keyspace, error)
 	FirstSortedKeyspace() (*vindexes.Keyspace, error)
 	SysVarSetEnabled() bool
+	// StrictSystemSettings returns true if the system settings which are
+	// not applied to the connections of the session are rejected.
+	StrictSystemSettings() bool
 	KeyspaceExists(keyspace string) bool
 	AllKeyspace() ([]*vindexes.Keyspace, error)
 	GetSemTable() *semantics.SemTable
@@ -70,6 +73,10 @@ type ContextVSchema interface {
 
 	// ForeignKeyMode returns the foreign_key flag value
 	ForeignKeyMode() string
+
+	// TempTableKeyspace returns the keyspace of the temporary tables created
+	// in sharded keyspaces, nil if there is none.
+	TempTableKeyspace() (*vindexes.Keyspace, error)
 }
 
 // PlannerVersion is an alias here to make the code more readable
@@ -123,12 +130,16 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, reservedVars *sqlpars
 	if err != nil {
 		return nil, err
 	}
+	if err := checkShardLocalJoins(stmt, instruction, vschema); err != nil {
+		return nil, err
+	}
 	plan := &engine.Plan{
 		Type:         sqlparser.ASTToStatementType(stmt),
 		Original:     query,
 		Instructions: instruction,
 		BindVarNeeds: bindVarNeeds,
 	}
+	applyQueryLimits(stmt, plan, vschema)
 	return plan, nil
 }
 
@@ -166,7 +177,19 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 		if err != nil {
 			return nil, err
 		}
-		return buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+		var plan engine.Primitive
+		if sqlparser.ContainsWindowFunction(stmt.SelectExprs) {
+			plan, err = buildWindowPlan(query, stmt, reservedVars, vschema, configuredPlanner)
+		} else {
+			plan, err = buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := checkNoWaitLock(stmt.Lock, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *sqlparser.Insert:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildInsertPlan)
 	case *sqlparser.Update:
@@ -178,7 +201,14 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 		if err != nil {
 			return nil, err
 		}
-		return buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+		plan, err := buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+		if err != nil {
+			return nil, err
+		}
+		if err := checkNoWaitLock(stmt.Lock, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	case *sqlparser.AlterMigration:
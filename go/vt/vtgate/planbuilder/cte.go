@@ -0,0 +1,446 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// expandCTEs replaces the references to the non-recursive common table
+// expressions of the statement, and of its subqueries, with derived tables,
+// which the planner then pushes down where possible. The statement is
+// modified in place.
+func expandCTEs(stmt sqlparser.SelectStatement, scope map[string]*sqlparser.CommonTableExpr) (sqlparser.SelectStatement, error) {
+	var err error
+	_ = sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case sqlparser.SelectStatement:
+			with := withOf(node)
+			if with == nil {
+				return true
+			}
+			innerScope := make(map[string]*sqlparser.CommonTableExpr, len(scope)+len(with.CTEs))
+			for name, cte := range scope {
+				innerScope[name] = cte
+			}
+			for _, cte := range with.CTEs {
+				if with.Recursive && referencesTable(cte.Subquery.Select, cte.TableID) {
+					err = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s in this position", cte.TableID.String())
+					return false
+				}
+				expanded := sqlparser.CloneRefOfCommonTableExpr(cte)
+				if _, err = expandCTEs(expanded.Subquery.Select, innerScope); err != nil {
+					return false
+				}
+				innerScope[cte.TableID.String()] = expanded
+			}
+			setWith(node, nil)
+			_, err = expandCTEs(node, innerScope)
+			return false
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				return true
+			}
+			cte, ok := scope[tableName.Name.String()]
+			if !ok {
+				return true
+			}
+			node.Expr = &sqlparser.DerivedTable{Select: sqlparser.CloneSelectStatement(cte.Subquery.Select)}
+			if node.As.IsEmpty() {
+				node.As = cte.TableID
+			}
+			if len(cte.Columns) > 0 {
+				node.Columns = cte.Columns
+			}
+			return false
+		}
+		return true
+	}, nil)
+	return stmt, err
+}
+
+func withOf(stmt sqlparser.SelectStatement) *sqlparser.With {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return stmt.With
+	case *sqlparser.Union:
+		return stmt.With
+	}
+	return nil
+}
+
+func setWith(stmt sqlparser.SelectStatement, with *sqlparser.With) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		stmt.With = with
+	case *sqlparser.Union:
+		stmt.With = with
+	}
+}
+
+// referencesTable returns true if the node selects from the table name,
+// without a qualifier.
+func referencesTable(node sqlparser.SQLNode, name sqlparser.TableIdent) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		ate, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return !found, nil
+		}
+		if tableName, ok := ate.Expr.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name.String() {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+// recursiveCTE returns the common table expression of the statement which
+// references itself, if any.
+func recursiveCTE(stmt sqlparser.SelectStatement) *sqlparser.CommonTableExpr {
+	with := withOf(stmt)
+	if with == nil || !with.Recursive {
+		return nil
+	}
+	for _, cte := range with.CTEs {
+		if referencesTable(cte.Subquery.Select, cte.TableID) {
+			return cte
+		}
+	}
+	return nil
+}
+
+// gen4PlanRecursiveCTE plans a statement with a recursive common table
+// expression. The statement is sent as is if all its tables are in the
+// same unsharded keyspace. Otherwise, the recursion is evaluated at vtgate
+// if its anchor is sent to a single shard: the recursive part is sent
+// again for each row of the previous iteration, with its columns in bind
+// variables.
+func gen4PlanRecursiveCTE(stmt sqlparser.SelectStatement, rec *sqlparser.CommonTableExpr, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+	if ks := unshardedKeyspaceOf(stmt, vschema); ks != nil {
+		return unshardedRoute(stmt, ks), nil
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression in union statement across shards")
+	}
+	name := rec.TableID.String()
+
+	others := &sqlparser.With{}
+	for _, cte := range sel.With.CTEs {
+		if cte == rec {
+			continue
+		}
+		if referencesTable(cte.Subquery.Select, rec.TableID) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: common table expression %s referencing the recursive common table expression %s across shards", cte.TableID.String(), name)
+		}
+		others.CTEs = append(others.CTEs, cte)
+	}
+	withOthers := func(stmt sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+		stmt = sqlparser.CloneSelectStatement(stmt)
+		if len(others.CTEs) > 0 {
+			setWith(stmt, others)
+		}
+		return expandCTEs(stmt, nil)
+	}
+
+	union, ok := rec.Subquery.Select.(*sqlparser.Union)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Recursive Common Table Expression '%s' should contain a UNION", name)
+	}
+	recursivePart, ok := union.Right.(*sqlparser.Select)
+	if !ok || referencesTable(union.Left, rec.TableID) || union.OrderBy != nil || union.Limit != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s which is not the union of an anchor and a recursive select across shards", name)
+	}
+
+	anchor, err := withOthers(union.Left)
+	if err != nil {
+		return nil, err
+	}
+	anchorPlan, err := gen4Planner(sqlparser.String(anchor))(anchor, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	if !sendsToSingleShard(anchorPlan) && !isDualProjection(anchorPlan) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s with an anchor which is not sent to a single shard", name)
+	}
+
+	columns, err := cteColumns(rec, sqlparser.GetFirstSelect(anchor))
+	if err != nil {
+		return nil, err
+	}
+	recursiveSel, err := withOthers(recursivePart)
+	if err != nil {
+		return nil, err
+	}
+	vars, err := bindRecursiveReference(recursiveSel.(*sqlparser.Select), rec.TableID, columns, reservedVars)
+	if err != nil {
+		return nil, err
+	}
+	recursivePlan, err := gen4Planner(sqlparser.String(recursiveSel))(recursiveSel, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, names, err := recursiveCTEProjection(sel, rec.TableID, columns)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.RecursiveCTE{
+		Anchor:    anchorPlan,
+		Recursive: recursivePlan,
+		Vars:      vars,
+		Distinct:  union.Distinct,
+		Cols:      cols,
+		ColNames:  names,
+	}, nil
+}
+
+// unshardedKeyspaceOf returns the keyspace of the tables of the statement
+// if they are all in the same unsharded keyspace.
+func unshardedKeyspaceOf(stmt sqlparser.SelectStatement, vschema ContextVSchema) *vindexes.Keyspace {
+	cteNames := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if cte, ok := node.(*sqlparser.CommonTableExpr); ok {
+			cteNames[cte.TableID.String()] = true
+		}
+		return true, nil
+	}, stmt)
+
+	var keyspace *vindexes.Keyspace
+	unsharded := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		ate, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := ate.Expr.(sqlparser.TableName)
+		if !ok || (tableName.Qualifier.IsEmpty() && cteNames[tableName.Name.String()]) {
+			return true, nil
+		}
+		table, _, _, _, err := vschema.FindTable(tableName)
+		if err != nil || table == nil || table.Keyspace.Sharded || (keyspace != nil && keyspace.Name != table.Keyspace.Name) {
+			unsharded = false
+			return false, nil
+		}
+		keyspace = table.Keyspace
+		return true, nil
+	}, stmt)
+	if !unsharded {
+		return nil
+	}
+	return keyspace
+}
+
+func unshardedRoute(stmt sqlparser.SelectStatement, keyspace *vindexes.Keyspace) engine.Primitive {
+	// The tables are in the keyspace of the route.
+	_ = sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		if tableName, ok := cursor.Node().(sqlparser.TableName); ok && !tableName.Qualifier.IsEmpty() && !sqlparser.SystemSchema(tableName.Qualifier.String()) {
+			cursor.Replace(sqlparser.TableName{Name: tableName.Name})
+		}
+		return true
+	}, nil)
+	buffer := sqlparser.NewTrackedBuffer(sqlparser.FormatImpossibleQuery)
+	fieldQuery := buffer.WriteNode(stmt).ParsedQuery().Query
+	return engine.NewRoute(engine.SelectUnsharded, keyspace, sqlparser.String(stmt), fieldQuery)
+}
+
+func isDualProjection(plan engine.Primitive) bool {
+	projection, ok := plan.(*engine.Projection)
+	if !ok {
+		return false
+	}
+	_, ok = projection.Input.(*engine.SingleRow)
+	return ok
+}
+
+// cteColumns returns the names of the columns of a common table
+// expression, which are given by its column list or by its anchor.
+func cteColumns(cte *sqlparser.CommonTableExpr, anchor *sqlparser.Select) ([]sqlparser.ColIdent, error) {
+	if len(cte.Columns) > 0 {
+		if len(cte.Columns) != len(anchor.SelectExprs) {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongNumberOfColumnsInSelect, "In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
+		}
+		return cte.Columns, nil
+	}
+	columns := make([]sqlparser.ColIdent, 0, len(anchor.SelectExprs))
+	for _, expr := range anchor.SelectExprs {
+		ae, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '%s' expression in the anchor of the recursive common table expression %s across shards", sqlparser.String(expr), cte.TableID.String())
+		}
+		switch {
+		case !ae.As.IsEmpty():
+			columns = append(columns, ae.As)
+		case sqlparser.IsColName(ae.Expr):
+			columns = append(columns, ae.Expr.(*sqlparser.ColName).Name)
+		default:
+			columns = append(columns, sqlparser.NewColIdent(sqlparser.String(ae.Expr)))
+		}
+	}
+	return columns, nil
+}
+
+// bindRecursiveReference removes the recursive reference from the FROM
+// clause of the recursive part, and replaces its columns with bind
+// variables. It returns the columns the variables are bound to.
+func bindRecursiveReference(sel *sqlparser.Select, name sqlparser.TableIdent, columns []sqlparser.ColIdent, reservedVars *sqlparser.ReservedVars) (map[string]int, error) {
+	isReference := func(expr sqlparser.TableExpr) (sqlparser.TableIdent, bool) {
+		ate, ok := expr.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return sqlparser.TableIdent{}, false
+		}
+		tableName, ok := ate.Expr.(sqlparser.TableName)
+		if !ok || !tableName.Qualifier.IsEmpty() || tableName.Name.String() != name.String() {
+			return sqlparser.TableIdent{}, false
+		}
+		if !ate.As.IsEmpty() {
+			return ate.As, true
+		}
+		return tableName.Name, true
+	}
+
+	var alias sqlparser.TableIdent
+	references := 0
+	var from sqlparser.TableExprs
+	for _, expr := range sel.From {
+		if a, ok := isReference(expr); ok {
+			alias = a
+			references++
+			continue
+		}
+		// An inner join with the reference is turned into a filter.
+		if join, ok := expr.(*sqlparser.JoinTableExpr); ok && join.Join == sqlparser.NormalJoinType && join.Condition.Using == nil {
+			if a, ok := isReference(join.LeftExpr); ok {
+				alias, expr = a, join.RightExpr
+				references++
+				sel.AddWhere(join.Condition.On)
+			} else if a, ok := isReference(join.RightExpr); ok {
+				alias, expr = a, join.LeftExpr
+				references++
+				sel.AddWhere(join.Condition.On)
+			}
+		}
+		from = append(from, expr)
+	}
+	if references != 1 || referencesTable(sqlparser.TableExprs(from), name) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive reference to %s which is not in the FROM clause of the recursive part, or joined to a single table, across shards", name.String())
+	}
+	onlyReference := len(from) == 0
+	if onlyReference {
+		from = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("dual")}}}
+	}
+	sel.From = from
+
+	vars := map[string]int{}
+	varNames := map[int]string{}
+	var err error
+	_ = sqlparser.Rewrite(sel, func(cursor *sqlparser.Cursor) bool {
+		col, ok := cursor.Node().(*sqlparser.ColName)
+		if !ok || err != nil {
+			return err == nil
+		}
+		if col.Qualifier.IsEmpty() && !onlyReference || !col.Qualifier.IsEmpty() && (!col.Qualifier.Qualifier.IsEmpty() || col.Qualifier.Name.String() != alias.String()) {
+			return true
+		}
+		for i, column := range columns {
+			if column.Equal(col.Name) {
+				varName, ok := varNames[i]
+				if !ok {
+					varName = reservedVars.ReserveColName(col)
+					varNames[i] = varName
+					vars[varName] = i
+				}
+				cursor.Replace(sqlparser.NewArgument(varName))
+				return true
+			}
+		}
+		err = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(col))
+		return false
+	}, nil)
+	return vars, err
+}
+
+// recursiveCTEProjection returns the columns of the recursive common table
+// expression the query selects, and their names. The query can only select
+// the columns of the expression.
+func recursiveCTEProjection(sel *sqlparser.Select, name sqlparser.TableIdent, columns []sqlparser.ColIdent) ([]int, []string, error) {
+	errUnsupported := vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: query which is not a projection of the recursive common table expression %s across shards", name.String())
+	if len(sel.From) != 1 || sel.Where != nil || sel.GroupBy != nil || sel.Having != nil || sel.Distinct || sel.OrderBy != nil || sel.Limit != nil {
+		return nil, nil, errUnsupported
+	}
+	ate, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, nil, errUnsupported
+	}
+	tableName, ok := ate.Expr.(sqlparser.TableName)
+	if !ok || !tableName.Qualifier.IsEmpty() || tableName.Name.String() != name.String() {
+		return nil, nil, errUnsupported
+	}
+	alias := tableName.Name
+	if !ate.As.IsEmpty() {
+		alias = ate.As
+	}
+	var cols []int
+	var names []string
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			if !expr.TableName.IsEmpty() && expr.TableName.Name.String() != alias.String() {
+				return nil, nil, errUnsupported
+			}
+			for i, column := range columns {
+				cols = append(cols, i)
+				names = append(names, column.String())
+			}
+		case *sqlparser.AliasedExpr:
+			col, ok := expr.Expr.(*sqlparser.ColName)
+			if !ok || !col.Qualifier.IsEmpty() && col.Qualifier.Name.String() != alias.String() {
+				return nil, nil, errUnsupported
+			}
+			found := false
+			for i, column := range columns {
+				if column.Equal(col.Name) {
+					cols = append(cols, i)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(col))
+			}
+			if expr.As.IsEmpty() {
+				names = append(names, col.Name.String())
+			} else {
+				names = append(names, expr.As.String())
+			}
+		default:
+			return nil, nil, errUnsupported
+		}
+	}
+	return cols, names, nil
+}
@@ -59,11 +59,28 @@ func buildGeneralDDLPlan(sql string, ddlStatement sqlparser.DDLStatement, reserv
 		return nil, err
 	}
 
+	sessionTempTable := false
 	if ddlStatement.IsTemporary() {
-		err := vschema.ErrorIfShardedF(normalDDLPlan.Keyspace, "temporary table", "Temporary table not supported in sharded database %s", normalDDLPlan.Keyspace.Name)
+		tempTableKeyspace, err := vschema.TempTableKeyspace()
 		if err != nil {
 			return nil, err
 		}
+		if tempTableKeyspace != nil && (normalDDLPlan.Keyspace.Sharded || normalDDLPlan.Keyspace.Name == tempTableKeyspace.Name) {
+			// The temporary tables of the sharded keyspaces are created in the
+			// temp table keyspace instead, where they can be joined with the
+			// tables of any keyspace.
+			normalDDLPlan = &engine.Send{
+				Keyspace:          tempTableKeyspace,
+				TargetDestination: key.DestinationAllShards{},
+				Query:             normalDDLPlan.Query,
+			}
+			sessionTempTable = true
+		} else {
+			err := vschema.ErrorIfShardedF(normalDDLPlan.Keyspace, "temporary table", "Temporary table not supported in sharded database %s", normalDDLPlan.Keyspace.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
 		onlineDDLPlan = nil // emptying this so it does not accidentally gets used somewhere
 	}
 
@@ -77,7 +94,8 @@ func buildGeneralDDLPlan(sql string, ddlStatement sqlparser.DDLStatement, reserv
 		DirectDDLEnabled: enableDirectDDL,
 		OnlineDDLEnabled: enableOnlineDDL,
 
-		CreateTempTable: ddlStatement.IsTemporary(),
+		CreateTempTable:  ddlStatement.IsTemporary(),
+		SessionTempTable: sessionTempTable,
 	}, nil
 }
 
@@ -34,8 +34,11 @@ func buildExplainPlan(stmt sqlparser.Explain, reservedVars *sqlparser.ReservedVa
 	case *sqlparser.ExplainTab:
 		return explainTabPlan(explain, vschema)
 	case *sqlparser.ExplainStmt:
-		if explain.Type == sqlparser.VitessType {
+		switch explain.Type {
+		case sqlparser.VitessType:
 			return buildVitessTypePlan(explain, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
+		case sqlparser.CostType:
+			return buildCostTypePlan(explain, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 		}
 		return buildOtherReadAndAdmin(sqlparser.String(explain), vschema)
 	}
@@ -67,9 +70,13 @@ func buildVitessTypePlan(explain *sqlparser.ExplainStmt, reservedVars *sqlparser
 		return nil, err
 	}
 	descriptions := treeLines(engine.PrimitiveToPlanDescription(innerInstruction))
+	var hints *plannerHints
+	if sel, ok := explain.Statement.(sqlparser.SelectStatement); ok {
+		hints = newPlannerHints(sel.GetComments())
+	}
 
 	var rows [][]sqltypes.Value
-	for _, line := range descriptions {
+	for i, line := range descriptions {
 		var targetDest string
 		if line.descr.TargetDestination != nil {
 			targetDest = line.descr.TargetDestination.String()
@@ -78,6 +85,7 @@ func buildVitessTypePlan(explain *sqlparser.ExplainStmt, reservedVars *sqlparser
 		if line.descr.Keyspace != nil {
 			keyspaceName = line.descr.Keyspace.Name
 		}
+		applied := strings.Join(hints.applied(line.descr, i == 0), " ")
 
 		rows = append(rows, []sqltypes.Value{
 			sqltypes.NewVarChar(line.header + line.descr.OperatorType), // operator
@@ -86,6 +94,7 @@ func buildVitessTypePlan(explain *sqlparser.ExplainStmt, reservedVars *sqlparser
 			sqltypes.NewVarChar(targetDest),                            // destination
 			sqltypes.NewVarChar(line.descr.TargetTabletType.String()),  // tabletType
 			sqltypes.NewVarChar(extractQuery(line.descr.Other)),        // query
+			sqltypes.NewVarChar(applied),                               // hints
 		})
 	}
 
@@ -96,11 +105,22 @@ func buildVitessTypePlan(explain *sqlparser.ExplainStmt, reservedVars *sqlparser
 		{Name: "destination", Type: querypb.Type_VARCHAR},
 		{Name: "tabletType", Type: querypb.Type_VARCHAR},
 		{Name: "query", Type: querypb.Type_VARCHAR},
+		{Name: "hints", Type: querypb.Type_VARCHAR},
 	}
 
 	return engine.NewRowsPrimitive(rows, fields), nil
 }
 
+// buildCostTypePlan builds the plan of EXPLAIN FORMAT=COST, which estimates
+// the cost of the routes of the statement instead of executing it.
+func buildCostTypePlan(explain *sqlparser.ExplainStmt, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema, enableOnlineDDL, enableDirectDDL bool) (engine.Primitive, error) {
+	innerInstruction, err := createInstructionFor(sqlparser.String(explain.Statement), explain.Statement, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.CostEstimate{Input: innerInstruction}, nil
+}
+
 func extractQuery(m map[string]interface{}) string {
 	queryObj, ok := m["Query"]
 	if !ok {
@@ -87,7 +87,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr, reservedVars *sqlpar
 		case *sqlparser.ExistsExpr:
 			constructsMap[node.Subquery] = node
 		case *sqlparser.Subquery:
-			spb := newPrimitiveBuilder(pb.vschema, pb.jt)
+			spb := pb.newChild()
 			switch stmt := node.Select.(type) {
 			case *sqlparser.Select:
 				if err := spb.processSelect(stmt, reservedVars, pb.st, ""); err != nil {
@@ -230,7 +230,7 @@ func (pb *primitiveBuilder) finalizeUnshardedDMLSubqueries(reservedVars *sqlpars
 				if !inSubQuery {
 					return true, nil
 				}
-				spb := newPrimitiveBuilder(pb.vschema, pb.jt)
+				spb := pb.newChild()
 				if err := spb.processSelect(nodeType, reservedVars, pb.st, ""); err != nil {
 					samePlan = false
 					return false, err
@@ -251,7 +251,7 @@ func (pb *primitiveBuilder) finalizeUnshardedDMLSubqueries(reservedVars *sqlpars
 				if !inSubQuery {
 					return true, nil
 				}
-				spb := newPrimitiveBuilder(pb.vschema, pb.jt)
+				spb := pb.newChild()
 				if err := spb.processUnion(nodeType, reservedVars, pb.st); err != nil {
 					samePlan = false
 					return false, err
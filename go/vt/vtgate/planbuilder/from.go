@@ -57,7 +57,7 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs, r
 	if err := pb.processTableExpr(tableExprs[0], reservedVars, where); err != nil {
 		return err
 	}
-	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb := pb.newChild()
 	if err := rpb.processTableExprs(tableExprs[1:], reservedVars, where); err != nil {
 		return err
 	}
@@ -104,7 +104,7 @@ func (pb *primitiveBuilder) processAliasedTable(tableExpr *sqlparser.AliasedTabl
 	case sqlparser.TableName:
 		return pb.buildTablePrimitive(tableExpr, expr)
 	case *sqlparser.DerivedTable:
-		spb := newPrimitiveBuilder(pb.vschema, pb.jt)
+		spb := pb.newChild()
 		switch stmt := expr.Select.(type) {
 		case *sqlparser.Select:
 			if err := spb.processSelect(stmt, reservedVars, nil, ""); err != nil {
@@ -284,7 +284,7 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr, reserved
 	if err := pb.processTableExpr(ajoin.LeftExpr, reservedVars, where); err != nil {
 		return err
 	}
-	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb := pb.newChild()
 	if err := rpb.processTableExpr(ajoin.RightExpr, reservedVars, where); err != nil {
 		return err
 	}
@@ -33,15 +33,12 @@ func gen4Planner(query string) func(sqlparser.Statement, *sqlparser.ReservedVars
 		if !ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%T not yet supported", stmt)
 		}
-		switch node := selStatement.(type) {
-		case *sqlparser.Select:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in select statement")
-			}
-		case *sqlparser.Union:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in union statement")
-			}
+		if cte := recursiveCTE(selStatement); cte != nil {
+			return gen4PlanRecursiveCTE(selStatement, cte, reservedVars, vschema)
+		}
+		selStatement, err := expandCTEs(selStatement, nil)
+		if err != nil {
+			return nil, err
 		}
 
 		sel, isSel := selStatement.(*sqlparser.Select)
@@ -190,7 +187,9 @@ func newPlanningContext(reservedVars *sqlparser.ReservedVars, semTable *semantic
 		reservedVars: reservedVars,
 		semTable:     semTable,
 		vschema:      vschema,
+		hints:        newPlannerHints(semTable.Comments),
 	}
+	ctx.hints.checkJoinStrategy(vschema)
 	return ctx
 }
 
@@ -282,12 +281,17 @@ func pushCommentDirectivesOnPlan(plan logicalPlan, stmt sqlparser.SelectStatemen
 		scatterAsWarns = true
 	}
 	queryTimeout := queryTimeout(directives)
-	if scatterAsWarns || queryTimeout > 0 {
+	asOf, err := asOf(directives)
+	if err != nil {
+		return nil, err
+	}
+	if scatterAsWarns || queryTimeout > 0 || !asOf.IsZero() {
 		_, _ = visit(plan, func(logicalPlan logicalPlan) (bool, logicalPlan, error) {
 			switch plan := logicalPlan.(type) {
 			case *route:
 				plan.eroute.ScatterErrorsAsWarnings = scatterAsWarns
 				plan.eroute.QueryTimeout = queryTimeout
+				plan.eroute.AsOf = asOf
 			}
 			return true, logicalPlan, nil
 		})
@@ -28,7 +28,8 @@ import (
 
 var _ logicalPlan = (*hashJoin)(nil)
 
-// hashJoin is used to build a HashJoin primitive.
+// hashJoin is used to build a HashJoin primitive, or the MergeJoin or the
+// block nested loop HashJoin of the same join.
 type hashJoin struct {
 	gen4Plan
 
@@ -48,6 +49,11 @@ type hashJoin struct {
 	ComparisonType querypb.Type
 
 	Collation collations.ID
+
+	// Strategy is joinStrategyHash, joinStrategyMerge or
+	// joinStrategyBlockNestedLoop. The inputs of a merge join are ordered by
+	// their key.
+	Strategy string
 }
 
 // WireupGen4 implements the logicalPlan interface
@@ -61,6 +67,23 @@ func (hj *hashJoin) WireupGen4(semTable *semantics.SemTable) error {
 
 // Primitive implements the logicalPlan interface
 func (hj *hashJoin) Primitive() engine.Primitive {
+	if hj.Strategy == joinStrategyMerge {
+		return &engine.MergeJoin{
+			Left:           hj.Left.Primitive(),
+			Right:          hj.Right.Primitive(),
+			Cols:           hj.Cols,
+			Opcode:         hj.Opcode,
+			LHSKey:         hj.LHSKey,
+			RHSKey:         hj.RHSKey,
+			ASTPred:        hj.Predicate,
+			ComparisonType: hj.ComparisonType,
+			Collation:      hj.Collation,
+		}
+	}
+	var blockRows int
+	if hj.Strategy == joinStrategyBlockNestedLoop {
+		blockRows = joinBlockRows
+	}
 	return &engine.HashJoin{
 		Left:           hj.Left.Primitive(),
 		Right:          hj.Right.Primitive(),
@@ -71,6 +94,7 @@ func (hj *hashJoin) Primitive() engine.Primitive {
 		ASTPred:        hj.Predicate,
 		ComparisonType: hj.ComparisonType,
 		Collation:      hj.Collation,
+		BlockRows:      blockRows,
 	}
 }
 
@@ -51,6 +51,10 @@ func (hp *horizonPlanning) planHorizon(ctx *planningContext, plan logicalPlan) (
 		return plan, nil
 	}
 
+	if err := checkAggregateUDFs(hp.sel, plan); err != nil {
+		return nil, err
+	}
+
 	qp, err := abstract.CreateQPFromSelect(hp.sel, ctx.semTable)
 	if err != nil {
 		return nil, err
@@ -815,9 +819,12 @@ func wrapAndPushExpr(expr sqlparser.Expr, weightStrExpr sqlparser.Expr, plan log
 	}
 	if !sqlparser.IsColName(expr) {
 		unary, ok := expr.(*sqlparser.UnaryExpr)
-		if ok && sqlparser.IsColName(unary.Expr) {
+		switch {
+		case ok && sqlparser.IsColName(unary.Expr):
 			expr = unary.Expr
-		} else {
+		case isShardLocalUDFExpr(expr, plan):
+			// The shards compute the weight string of the whole expression.
+		default:
 			return 0, 0, false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex order by expression: %s", sqlparser.String(expr))
 		}
 	}
@@ -851,6 +858,13 @@ func weightStringFor(expr sqlparser.Expr) sqlparser.Expr {
 }
 
 func (hp *horizonPlanning) planOrderByForHashJoin(ctx *planningContext, orderExprs []abstract.OrderBy, plan *hashJoin) (logicalPlan, error) {
+	if plan.Strategy == joinStrategyMerge {
+		// The inputs of a merge join are already ordered by its key.
+		if len(orderExprs) == 1 && isSpecialOrderBy(orderExprs[0]) {
+			return plan, nil
+		}
+		return hp.createMemorySortPlan(ctx, plan, orderExprs, true)
+	}
 	if len(orderExprs) == 1 && isSpecialOrderBy(orderExprs[0]) {
 		rhs, err := hp.planOrderBy(ctx, orderExprs, plan.Right)
 		if err != nil {
@@ -162,6 +162,8 @@ func (m *multiColIndex) IsUnique() bool { return true }
 
 func (m *multiColIndex) NeedsVCursor() bool { return false }
 
+func (m *multiColIndex) PartialVindex() bool { return true }
+
 func (m *multiColIndex) Map(vcursor vindexes.VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	return nil, nil
 }
@@ -218,12 +220,16 @@ func TestPlan(t *testing.T) {
 	testFile(t, "union_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "transaction_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "lock_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "cte_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "large_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "ddl_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "flush_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "show_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "stream_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "systemtables_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "planner_hints_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "query_limits_cases.txt", testOutputTempDir, vschemaWrapper)
 }
 
 func TestSysVarSetDisabled(t *testing.T) {
@@ -406,6 +412,23 @@ func TestWithDefaultKeyspaceFromFile(t *testing.T) {
 	testFile(t, "call_cases.txt", testOutputTempDir, vschema)
 }
 
+func TestTempTableKeyspaceFromFile(t *testing.T) {
+	testOutputTempDir, err := os.MkdirTemp("", "plan_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(testOutputTempDir)
+	vschema := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json", true),
+		keyspace: &vindexes.Keyspace{
+			Name:    "user",
+			Sharded: true,
+		},
+		tabletType:        topodatapb.TabletType_PRIMARY,
+		tempTableKeyspace: "main",
+	}
+
+	testFile(t, "temp_table_cases.txt", testOutputTempDir, vschema)
+}
+
 func TestWithSystemSchemaAsDefaultKeyspace(t *testing.T) {
 	// We are testing this separately so we can set a default keyspace
 	testOutputTempDir, err := os.MkdirTemp("", "plan_test")
@@ -471,25 +494,42 @@ func loadSchema(t testing.TB, filename string, setCollation bool) *vindexes.VSch
 var _ ContextVSchema = (*vschemaWrapper)(nil)
 
 type vschemaWrapper struct {
-	v             *vindexes.VSchema
-	keyspace      *vindexes.Keyspace
-	tabletType    topodatapb.TabletType
-	dest          key.Destination
-	sysVarEnabled bool
-	version       PlannerVersion
+	v                 *vindexes.VSchema
+	keyspace          *vindexes.Keyspace
+	tabletType        topodatapb.TabletType
+	dest              key.Destination
+	sysVarEnabled     bool
+	version           PlannerVersion
+	tempTableKeyspace string
+	warnings          []string
 }
 
 func (vw *vschemaWrapper) ConnCollation() collations.ID {
 	return collations.Unknown
 }
 
-func (vw *vschemaWrapper) PlannerWarning(_ string) {
+func (vw *vschemaWrapper) PlannerWarning(message string) {
+	if message == "" {
+		return
+	}
+	vw.warnings = append(vw.warnings, message)
 }
 
 func (vw *vschemaWrapper) ForeignKeyMode() string {
 	return "allow"
 }
 
+func (vw *vschemaWrapper) TempTableKeyspace() (*vindexes.Keyspace, error) {
+	if vw.tempTableKeyspace == "" {
+		return nil, nil
+	}
+	ks, ok := vw.v.Keyspaces[vw.tempTableKeyspace]
+	if !ok {
+		return nil, errors.New("temp table keyspace not found")
+	}
+	return ks.Keyspace, nil
+}
+
 func (vw *vschemaWrapper) AllKeyspace() ([]*vindexes.Keyspace, error) {
 	if vw.keyspace == nil {
 		return nil, errors.New("keyspace not available")
@@ -521,6 +561,10 @@ func (vw *vschemaWrapper) SysVarSetEnabled() bool {
 	return vw.sysVarEnabled
 }
 
+func (vw *vschemaWrapper) StrictSystemSettings() bool {
+	return false
+}
+
 func (vw *vschemaWrapper) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
 	var keyspaceName string
 	if vw.keyspace != nil {
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+const (
+	joinStrategyHash            = "hash"
+	joinStrategyNestedLoop      = "nested_loop"
+	joinStrategyMerge           = "merge"
+	joinStrategyBlockNestedLoop = "block_nested_loop"
+)
+
+// joinBlockRows is the number of rows of the LHS of the block nested loop
+// joins joined at a time.
+const joinBlockRows = 10000
+
+// plannerHints are the comment directives of a SELECT which override the
+// choices of the planner. A nil plannerHints has no hints.
+type plannerHints struct {
+	// joinStrategy is the strategy of the joins across shards, one of the
+	// joinStrategy* constants, or empty to let the planner choose.
+	joinStrategy string
+	// forceScatter makes the routes ignore their vindexes.
+	forceScatter bool
+	// vindex is the only vindex the routes can use, if not empty.
+	vindex string
+	// skipPlanCache is set if the plan of the statement is not cached.
+	skipPlanCache bool
+}
+
+func newPlannerHints(comments sqlparser.Comments) *plannerHints {
+	directives := sqlparser.ExtractCommentDirectives(comments)
+	hints := &plannerHints{
+		joinStrategy:  strings.ToLower(directives.GetString(sqlparser.DirectiveJoinStrategy, "")),
+		forceScatter:  directives.IsSet(sqlparser.DirectiveForceScatter),
+		vindex:        directives.GetString(sqlparser.DirectiveVindex, ""),
+		skipPlanCache: directives.IsSet(sqlparser.DirectiveSkipQueryPlanCache),
+	}
+	if directives.IsSet(sqlparser.DirectiveAllowHashJoin) && hints.joinStrategy == "" {
+		hints.joinStrategy = joinStrategyHash
+	}
+	return hints
+}
+
+// checkJoinStrategy warns about a join strategy the planner does not know.
+func (h *plannerHints) checkJoinStrategy(vschema ContextVSchema) {
+	if h == nil {
+		return
+	}
+	switch h.joinStrategy {
+	case "", joinStrategyHash, joinStrategyNestedLoop, joinStrategyMerge, joinStrategyBlockNestedLoop:
+	default:
+		vschema.PlannerWarning(fmt.Sprintf("unknown %s '%s' ignored, expected %s, %s, %s or %s", sqlparser.DirectiveJoinStrategy, h.joinStrategy, joinStrategyHash, joinStrategyNestedLoop, joinStrategyMerge, joinStrategyBlockNestedLoop))
+	}
+}
+
+// allowHashJoin returns whether the planner may join with a hash join.
+func (h *plannerHints) allowHashJoin() bool {
+	return h != nil && h.joinStrategy == joinStrategyHash
+}
+
+// equiJoinStrategy returns the strategy the planner joins with on an
+// equality of columns, if it's not a nested loop: a hash join, a merge join
+// or a block nested loop join. The last two stream the join, which bounds
+// its memory for the large joins of the OLAP workload.
+func (h *plannerHints) equiJoinStrategy() string {
+	if h == nil {
+		return ""
+	}
+	switch h.joinStrategy {
+	case joinStrategyHash, joinStrategyMerge, joinStrategyBlockNestedLoop:
+		return h.joinStrategy
+	}
+	return ""
+}
+
+// allowVindex returns whether the routes may use the vindex of the given name.
+func (h *plannerHints) allowVindex(name string) bool {
+	if h == nil {
+		return true
+	}
+	return !h.forceScatter && (h.vindex == "" || h.vindex == name)
+}
+
+// applied returns the hints which changed the plan of a primitive, to be
+// listed by EXPLAIN FORMAT=vitess. The hint of the plan cache applies to the
+// root primitive.
+func (h *plannerHints) applied(descr engine.PrimitiveDescription, root bool) []string {
+	if h == nil {
+		return nil
+	}
+	var applied []string
+	if root && h.skipPlanCache {
+		applied = append(applied, sqlparser.DirectiveSkipQueryPlanCache)
+	}
+	switch descr.OperatorType {
+	case "Join":
+		if joinVariantStrategy(descr.Variant) == h.joinStrategy {
+			applied = append(applied, sqlparser.DirectiveJoinStrategy+"="+h.joinStrategy)
+		}
+	case "Route":
+		if descr.Keyspace == nil || !descr.Keyspace.Sharded {
+			break
+		}
+		if h.forceScatter && descr.Variant == engine.SelectScatter.String() {
+			applied = append(applied, sqlparser.DirectiveForceScatter)
+		}
+		if vindex, _ := descr.Other["Vindex"].(string); h.vindex != "" && vindex == h.vindex {
+			applied = append(applied, sqlparser.DirectiveVindex+"="+h.vindex)
+		}
+	}
+	return applied
+}
+
+// joinVariantStrategy returns the join strategy of a variant of join.
+func joinVariantStrategy(variant string) string {
+	switch {
+	case strings.HasPrefix(variant, "Hash"):
+		return joinStrategyHash
+	case strings.HasPrefix(variant, "Merge"):
+		return joinStrategyMerge
+	case strings.HasPrefix(variant, "BlockNestedLoop"):
+		return joinStrategyBlockNestedLoop
+	}
+	return joinStrategyNestedLoop
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestPlannerHints(t *testing.T) {
+	hints := newPlannerHints(sqlparser.Comments{"/*vt+ ALLOW_HASH_JOIN VINDEX=name_user_map */"})
+	assert.True(t, hints.allowHashJoin())
+	assert.True(t, hints.allowVindex("name_user_map"))
+	assert.False(t, hints.allowVindex("user_index"))
+
+	hints = newPlannerHints(sqlparser.Comments{"/*vt+ ALLOW_HASH_JOIN JOIN_STRATEGY=NESTED_LOOP FORCE_SCATTER */"})
+	assert.False(t, hints.allowHashJoin())
+	assert.False(t, hints.allowVindex("user_index"))
+
+	hints = newPlannerHints(sqlparser.Comments{"/*vt+ JOIN_STRATEGY=MERGE */"})
+	assert.Equal(t, joinStrategyMerge, hints.equiJoinStrategy())
+	hints = newPlannerHints(sqlparser.Comments{"/*vt+ JOIN_STRATEGY=nested_loop */"})
+	assert.Empty(t, hints.equiJoinStrategy())
+
+	var none *plannerHints
+	assert.False(t, none.allowHashJoin())
+	assert.Empty(t, none.equiJoinStrategy())
+	assert.True(t, none.allowVindex("user_index"))
+	assert.Empty(t, none.applied(engine.PrimitiveDescription{OperatorType: "Join"}, true))
+}
+
+func TestPlannerHintsApplied(t *testing.T) {
+	sharded := &vindexes.Keyspace{Name: "user", Sharded: true}
+	hints := newPlannerHints(sqlparser.Comments{"/*vt+ JOIN_STRATEGY=hash VINDEX=name_user_map SKIP_QUERY_PLAN_CACHE */"})
+	assert.Equal(t, []string{"SKIP_QUERY_PLAN_CACHE", "JOIN_STRATEGY=hash"}, hints.applied(engine.PrimitiveDescription{OperatorType: "Join", Variant: "HashJoin"}, true))
+	assert.Empty(t, hints.applied(engine.PrimitiveDescription{OperatorType: "Join", Variant: "Join"}, false))
+	assert.Equal(t, []string{"VINDEX=name_user_map"}, hints.applied(engine.PrimitiveDescription{
+		OperatorType: "Route",
+		Variant:      "SelectEqual",
+		Keyspace:     sharded,
+		Other:        map[string]interface{}{"Vindex": "name_user_map"},
+	}, false))
+	assert.Empty(t, hints.applied(engine.PrimitiveDescription{
+		OperatorType: "Route",
+		Variant:      "SelectEqualUnique",
+		Keyspace:     sharded,
+		Other:        map[string]interface{}{"Vindex": "user_index"},
+	}, false))
+
+	hints = newPlannerHints(sqlparser.Comments{"/*vt+ JOIN_STRATEGY=merge */"})
+	assert.Equal(t, []string{"JOIN_STRATEGY=merge"}, hints.applied(engine.PrimitiveDescription{OperatorType: "Join", Variant: "MergeJoin"}, false))
+	assert.Empty(t, hints.applied(engine.PrimitiveDescription{OperatorType: "Join", Variant: "BlockNestedLoopJoin"}, false))
+
+	hints = newPlannerHints(sqlparser.Comments{"/*vt+ FORCE_SCATTER JOIN_STRATEGY=nested_loop */"})
+	assert.Equal(t, []string{"JOIN_STRATEGY=nested_loop"}, hints.applied(engine.PrimitiveDescription{OperatorType: "Join", Variant: "LeftJoin"}, false))
+	assert.Equal(t, []string{"FORCE_SCATTER"}, hints.applied(engine.PrimitiveDescription{OperatorType: "Route", Variant: "SelectScatter", Keyspace: sharded}, false))
+	assert.Empty(t, hints.applied(engine.PrimitiveDescription{OperatorType: "Route", Variant: "SelectUnsharded", Keyspace: &vindexes.Keyspace{Name: "main"}}, false))
+}
@@ -25,6 +25,7 @@ type primitiveBuilder struct {
 	jt      *jointab
 	plan    logicalPlan
 	st      *symtab
+	hints   *plannerHints
 }
 
 func newPrimitiveBuilder(vschema ContextVSchema, jt *jointab) *primitiveBuilder {
@@ -33,3 +34,11 @@ func newPrimitiveBuilder(vschema ContextVSchema, jt *jointab) *primitiveBuilder
 		jt:      jt,
 	}
 }
+
+// newChild creates a transient builder for a part of the statement,
+// which shares its jointab and planner hints.
+func (pb *primitiveBuilder) newChild() *primitiveBuilder {
+	child := newPrimitiveBuilder(pb.vschema, pb.jt)
+	child.hints = pb.hints
+	return child
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// applyQueryLimits applies the query limits of the vschema of the tables of
+// the statement to its plan. The routes and DMLs which do not have a
+// QUERY_TIMEOUT_MS directive get the smallest query timeout of the tables,
+// and the plan gets their smallest max rows and the workload of the first
+// table which has one.
+func applyQueryLimits(stmt sqlparser.Statement, plan *engine.Plan, vschema ContextVSchema) {
+	switch stmt.(type) {
+	case sqlparser.SelectStatement, *sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete:
+	default:
+		return
+	}
+	limits := statementQueryLimits(stmt, vschema)
+	if limits == nil {
+		return
+	}
+	plan.MaxRows = limits.MaxRows
+	plan.Workload = limits.Workload
+	if limits.QueryTimeout == 0 {
+		return
+	}
+	engine.Exists(func(p engine.Primitive) bool {
+		switch p := p.(type) {
+		case *engine.Route:
+			if p.QueryTimeout == 0 {
+				p.QueryTimeout = limits.QueryTimeout
+			}
+		case *engine.Insert:
+			if p.QueryTimeout == 0 {
+				p.QueryTimeout = limits.QueryTimeout
+			}
+		case *engine.Update:
+			if p.QueryTimeout == 0 {
+				p.QueryTimeout = limits.QueryTimeout
+			}
+		case *engine.Delete:
+			if p.QueryTimeout == 0 {
+				p.QueryTimeout = limits.QueryTimeout
+			}
+		}
+		return false
+	}, plan.Instructions)
+}
+
+// statementQueryLimits combines the query limits of the tables of the
+// statement, or returns nil if none of them has any.
+func statementQueryLimits(stmt sqlparser.Statement, vschema ContextVSchema) *vindexes.QueryLimits {
+	var tableNames []sqlparser.TableName
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok {
+				tableNames = append(tableNames, tableName)
+			}
+		case *sqlparser.Insert:
+			tableNames = append(tableNames, node.Table)
+		}
+		return true, nil
+	}, stmt)
+
+	var combined *vindexes.QueryLimits
+	for _, tableName := range tableNames {
+		table, _, _, _, err := vschema.FindTable(tableName)
+		if err != nil || table == nil {
+			continue
+		}
+		limits := table.Limits()
+		if limits == nil {
+			continue
+		}
+		if combined == nil {
+			combined = &vindexes.QueryLimits{}
+		}
+		combined.QueryTimeout = smallestLimit(combined.QueryTimeout, limits.QueryTimeout)
+		combined.MaxRows = smallestLimit(combined.MaxRows, limits.MaxRows)
+		if combined.Workload == querypb.ExecuteOptions_UNSPECIFIED {
+			combined.Workload = limits.Workload
+		}
+	}
+	return combined
+}
+
+// smallestLimit returns the smallest of two limits, zero meaning no limit.
+func smallestLimit(a, b int) int {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
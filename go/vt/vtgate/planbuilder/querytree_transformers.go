@@ -29,6 +29,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/vterrors"
@@ -429,10 +430,10 @@ func transformRoutePlan(ctx *planningContext, n *routeTree) (*route, error) {
 		where = &sqlparser.Where{Expr: predicates, Type: sqlparser.WhereClause}
 	}
 
-	var singleColumn vindexes.SingleColumn
+	var vindex vindexes.Vindex
 	var values []sqltypes.PlanValue
 	if n.selectedVindex() != nil {
-		singleColumn = n.selected.foundVindex.(vindexes.SingleColumn)
+		vindex = n.selected.foundVindex
 		values = n.selected.values
 	}
 
@@ -461,7 +462,7 @@ func transformRoutePlan(ctx *planningContext, n *routeTree) (*route, error) {
 
 	// TODO clean up when gen4 is the only planner
 	var condition sqlparser.Expr
-	if n.selected != nil && len(n.selected.valueExprs) > 0 {
+	if n.selected != nil && len(n.selected.valueExprs) == 1 {
 		condition = n.selected.valueExprs[0]
 	}
 	return &route{
@@ -469,7 +470,7 @@ func transformRoutePlan(ctx *planningContext, n *routeTree) (*route, error) {
 			Opcode:              n.routeOpCode,
 			TableName:           strings.Join(tableNames, ", "),
 			Keyspace:            n.keyspace,
-			Vindex:              singleColumn,
+			Vindex:              vindex,
 			Values:              values,
 			SysTableTableName:   n.SysTableTableName,
 			SysTableTableSchema: n.SysTableTableSchema,
@@ -506,6 +507,17 @@ func transformJoinPlan(ctx *planningContext, n *joinTree) (logicalPlan, error) {
 		if err != nil {
 			return nil, err
 		}
+		strategy := ctx.hints.equiJoinStrategy()
+		if strategy == joinStrategyMerge {
+			ordered, err := orderMergeJoinInputs(ctx, lhs, lhsInfo.offset, rhs, rhsInfo.offset)
+			if err != nil {
+				return nil, err
+			}
+			if !ordered {
+				// The join still streams if its inputs can't be ordered.
+				strategy = joinStrategyBlockNestedLoop
+			}
+		}
 		return &hashJoin{
 			Left:           lhs,
 			Right:          rhs,
@@ -516,6 +528,7 @@ func transformJoinPlan(ctx *planningContext, n *joinTree) (logicalPlan, error) {
 			Predicate:      sqlparser.AndExpressions(n.predicates...),
 			ComparisonType: coercedType,
 			Collation:      lhsInfo.typ.Collation,
+			Strategy:       strategy,
 		}, nil
 	}
 	return &joinGen4{
@@ -536,7 +549,7 @@ func transformJoinPlan(ctx *planningContext, n *joinTree) (logicalPlan, error) {
 func canHashJoin(ctx *planningContext, n *joinTree) (canHash bool, lhs, rhs joinColumnInfo, err error) {
 	if len(n.predicatesToRemoveFromHashJoin) != 1 ||
 		n.leftJoin ||
-		!sqlparser.ExtractCommentDirectives(ctx.semTable.Comments).IsSet(sqlparser.DirectiveAllowHashJoin) {
+		ctx.hints.equiJoinStrategy() == "" {
 		return
 	}
 	cmp, isCmp := n.predicatesToRemoveFromHashJoin[0].(*sqlparser.ComparisonExpr)
@@ -607,6 +620,48 @@ func canHashJoin(ctx *planningContext, n *joinTree) (canHash bool, lhs, rhs join
 	return
 }
 
+// orderMergeJoinInputs orders the routes of the inputs of a merge join by
+// their key, given by its offset in their columns. It returns false, without
+// ordering them, if they are not both routes of a single SELECT.
+func orderMergeJoinInputs(ctx *planningContext, lhs logicalPlan, lhsKey int, rhs logicalPlan, rhsKey int) (bool, error) {
+	lhsRoute, lhsExpr := mergeJoinInput(lhs, lhsKey)
+	rhsRoute, rhsExpr := mergeJoinInput(rhs, rhsKey)
+	if lhsExpr == nil || rhsExpr == nil {
+		return false, nil
+	}
+	for _, input := range []struct {
+		route *route
+		expr  sqlparser.Expr
+	}{{lhsRoute, lhsExpr}, {rhsRoute, rhsExpr}} {
+		order := abstract.OrderBy{
+			Inner:         &sqlparser.Order{Expr: input.expr, Direction: sqlparser.AscOrder},
+			WeightStrExpr: input.expr,
+		}
+		if _, _, err := planOrderByForRoute([]abstract.OrderBy{order}, input.route, ctx.semTable, false); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// mergeJoinInput returns the route of an input of a merge join and the
+// expression of its key, or nil if the input is not a route of a SELECT.
+func mergeJoinInput(plan logicalPlan, key int) (*route, sqlparser.Expr) {
+	r, ok := plan.(*route)
+	if !ok {
+		return nil, nil
+	}
+	sel, ok := r.Select.(*sqlparser.Select)
+	if !ok || key >= len(sel.SelectExprs) {
+		return nil, nil
+	}
+	expr, ok := sel.SelectExprs[key].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, nil
+	}
+	return r, expr.Expr
+}
+
 func relToTableExpr(t relation) (sqlparser.TableExpr, error) {
 	switch t := t.(type) {
 	case *routeTable:
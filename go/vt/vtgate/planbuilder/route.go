@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"time"
+
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
@@ -579,6 +581,9 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		rb.updateRoute(opcode, vindex, values)
 		return
 	}
+	if !pb.hints.allowVindex(vindex.String()) {
+		return
+	}
 	switch rb.eroute.Opcode {
 	case engine.SelectEqualUnique:
 		if opcode == engine.SelectEqualUnique && vindex.Cost() < rb.eroute.Vindex.Cost() {
@@ -873,3 +878,26 @@ func queryTimeout(d sqlparser.CommentDirectives) int {
 	}
 	return 0
 }
+
+// asOfLayouts are the formats accepted for the AS_OF directive.
+var asOfLayouts = []string{
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	time.RFC3339Nano,
+}
+
+// asOf returns the DirectiveAsOf value if set, otherwise returns the zero
+// time. Timestamps without a time zone are in the local time of vtgate.
+func asOf(d sqlparser.CommentDirectives) (time.Time, error) {
+	if _, ok := d[sqlparser.DirectiveAsOf]; !ok {
+		return time.Time{}, nil
+	}
+	val := d.GetString(sqlparser.DirectiveAsOf, "")
+	for _, layout := range asOfLayouts {
+		if t, err := time.ParseInLocation(layout, val, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid AS_OF timestamp: %s", val)
+}
@@ -39,6 +39,7 @@ type planningContext struct {
 	reservedVars *sqlparser.ReservedVars
 	semTable     *semantics.SemTable
 	vschema      ContextVSchema
+	hints        *plannerHints
 }
 
 func (c planningContext) isSubQueryToReplace(e sqlparser.Expr) bool {
@@ -1096,7 +1097,7 @@ func tryMerge(ctx *planningContext, a, b queryTree, joinPredicates []sqlparser.E
 			return nil, nil
 		}
 		r, err := merger(aRoute, bRoute)
-		r.pickBestAvailableVindex()
+		r.pickBestAvailableVindex(ctx)
 		return r, err
 	}
 	return nil, nil
@@ -19,8 +19,10 @@ package planbuilder
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
@@ -132,3 +134,22 @@ func TestUnionCanMerge(t *testing.T) {
 		}
 	}
 }
+
+func TestAsOfDirective(t *testing.T) {
+	stmt, err := sqlparser.Parse("select /*vt+ AS_OF='2021-05-01 10:00:00' */ * from t")
+	require.NoError(t, err)
+	got, err := asOf(sqlparser.ExtractCommentDirectives(stmt.(*sqlparser.Select).Comments))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 5, 1, 10, 0, 0, 0, time.Local), got)
+
+	got, err = asOf(sqlparser.CommentDirectives{sqlparser.DirectiveAsOf: "2021-05-01T10:00:00Z"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC), got.UTC())
+
+	got, err = asOf(nil)
+	require.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	_, err = asOf(sqlparser.CommentDirectives{sqlparser.DirectiveAsOf: "yesterday"})
+	assert.EqualError(t, err, "invalid AS_OF timestamp: yesterday")
+}
@@ -86,6 +86,11 @@ type (
 		opcode      engine.RouteOpcode
 		foundVindex vindexes.Vindex
 		cost        cost
+
+		// colValues and colExprs are the values of the columns of a
+		// multi-column vindex, which are nil for the columns without value.
+		colValues []*sqltypes.PlanValue
+		colExprs  []sqlparser.Expr
 	}
 )
 
@@ -189,7 +194,7 @@ func (rp *routeTree) addPredicate(ctx *planningContext, predicates ...sqlparser.
 
 		// if we didn't open up any new vindex options, no need to enter here
 		if newVindexFound {
-			rp.pickBestAvailableVindex()
+			rp.pickBestAvailableVindex(ctx)
 		}
 	}
 
@@ -512,7 +517,19 @@ func (rp *routeTree) haveMatchingVindex(
 		if !ctx.semTable.DirectDeps(column).IsSolvedBy(v.tableID) {
 			continue
 		}
-		// Ignore MultiColumn vindexes for finding matching Vindex.
+		if _, isMultiCol := v.colVindex.Vindex.(vindexes.MultiColumn); isMultiCol {
+			routeOpcode := opcode(v.colVindex)
+			if routeOpcode != engine.SelectEqualUnique && routeOpcode != engine.SelectEqual {
+				// only equalities can be combined into the values of the columns
+				continue
+			}
+			for idx, col := range v.colVindex.Columns {
+				if column.Name.Equal(col) {
+					newVindexFound = v.addMultiColumnValue(node, valueExpr, idx, value) || newVindexFound
+				}
+			}
+			continue
+		}
 		if _, isSingleCol := v.colVindex.Vindex.(vindexes.SingleColumn); !isSingleCol {
 			continue
 		}
@@ -537,9 +554,78 @@ func (rp *routeTree) haveMatchingVindex(
 	return newVindexFound
 }
 
+// addMultiColumnValue adds the value of the column at idx of a multi-column
+// vindex to the options of the vindex. The options are ready when they have
+// the values of all the columns, or of a prefix of the columns for a vindex
+// which maps partial values to the key range of their keyspace ids.
+func (vpp *vindexPlusPredicates) addMultiColumnValue(node, valueExpr sqlparser.Expr, idx int, value sqltypes.PlanValue) bool {
+	columns := len(vpp.colVindex.Columns)
+	newOptions := []*vindexOption{{
+		colValues: make([]*sqltypes.PlanValue, columns),
+		colExprs:  make([]sqlparser.Expr, columns),
+	}}
+	for _, option := range vpp.options {
+		if option.colExprs != nil && option.colExprs[idx] == nil {
+			newOptions = append(newOptions, option.copyColumns())
+		}
+	}
+
+	ready := false
+	for _, option := range newOptions {
+		option.colValues[idx] = &value
+		option.colExprs[idx] = valueExpr
+		option.predicates = append(option.predicates, node)
+		ready = option.updateMultiColumn(vpp.colVindex) || ready
+	}
+	vpp.options = append(vpp.options, newOptions...)
+	return ready
+}
+
+// copyColumns returns a copy of a multi-column option, to add the value of
+// another column to it.
+func (option *vindexOption) copyColumns() *vindexOption {
+	return &vindexOption{
+		colValues:  append([]*sqltypes.PlanValue{}, option.colValues...),
+		colExprs:   append([]sqlparser.Expr{}, option.colExprs...),
+		predicates: append([]sqlparser.Expr{}, option.predicates...),
+	}
+}
+
+// updateMultiColumn sets the routing of a multi-column option from the
+// values of the columns it has, and returns true if it is ready.
+func (option *vindexOption) updateMultiColumn(colVindex *vindexes.ColumnVindex) bool {
+	prefix := 0
+	for prefix < len(option.colExprs) && option.colExprs[prefix] != nil {
+		prefix++
+	}
+	switch {
+	case prefix == len(option.colExprs):
+		option.opcode = equalOrEqualUnique(colVindex)
+	case prefix > 0 && colVindex.Vindex.(vindexes.MultiColumn).PartialVindex():
+		option.opcode = engine.SelectEqual
+	default:
+		return false
+	}
+	option.values = nil
+	option.valueExprs = option.colExprs[:prefix]
+	for _, value := range option.colValues[:prefix] {
+		option.values = append(option.values, *value)
+	}
+	option.foundVindex = colVindex.Vindex
+	option.cost = costFor(colVindex.Vindex, option.opcode)
+	// a prefix of the columns maps to many keyspace ids
+	option.cost.isUnique = option.cost.isUnique && prefix == len(option.colExprs)
+	option.ready = true
+	return true
+}
+
 // pickBestAvailableVindex goes over the available vindexes for this route and picks the best one available.
-func (rp *routeTree) pickBestAvailableVindex() {
+// The hints of the query can restrict the vindexes the route can use.
+func (rp *routeTree) pickBestAvailableVindex(ctx *planningContext) {
 	for _, v := range rp.vindexPreds {
+		if !ctx.hints.allowVindex(v.colVindex.Name) {
+			continue
+		}
 		option := v.bestOption()
 		if option != nil && (rp.selected == nil || less(option.cost, rp.selected.cost)) {
 			rp.selected = option
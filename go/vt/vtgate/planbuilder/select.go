@@ -101,6 +101,33 @@ func shouldRetryWithCNFRewriting(plan logicalPlan) bool {
 
 }
 
+// checkNoWaitLock fails the plan of a select locking its rows with NOWAIT or
+// SKIP LOCKED unless it is sent to a single shard: the rows skipped or the
+// lock failures could otherwise differ from one shard to another.
+func checkNoWaitLock(lock sqlparser.Lock, plan engine.Primitive) error {
+	if !lock.NoWait() || sendsToSingleShard(plan) {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported:%s on a query which is not sent to a single shard", lock.ToString())
+}
+
+// sendsToSingleShard returns true if the plan sends its query to a single shard.
+func sendsToSingleShard(plan engine.Primitive) bool {
+	switch plan := plan.(type) {
+	case *engine.Route:
+		switch plan.Opcode {
+		case engine.SelectUnsharded, engine.SelectEqualUnique, engine.SelectReference, engine.SelectNone:
+			return true
+		}
+	case *engine.Send:
+		switch plan.TargetDestination.(type) {
+		case key.DestinationShard, key.DestinationKeyspaceID:
+			return true
+		}
+	}
+	return false
+}
+
 var errSQLCalcFoundRows = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CantUseOptionHere, "Incorrect usage/placement of 'SQL_CALC_FOUND_ROWS'")
 var errInto = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CantUseOptionHere, "Incorrect usage/placement of 'INTO'")
 
@@ -166,6 +193,12 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 		return errInto
 	}
 
+	// The hints of the statement apply to its subqueries.
+	if pb.hints == nil {
+		pb.hints = newPlannerHints(sel.Comments)
+		pb.hints.checkJoinStrategy(pb.vschema)
+	}
+
 	var where sqlparser.Expr
 	if sel.Where != nil {
 		where = sel.Where.Expr
@@ -178,6 +211,11 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 		// TODO(sougou): this can probably be improved.
 		directives := sqlparser.ExtractCommentDirectives(sel.Comments)
 		rb.eroute.QueryTimeout = queryTimeout(directives)
+		asOf, err := asOf(directives)
+		if err != nil {
+			return err
+		}
+		rb.eroute.AsOf = asOf
 		if rb.eroute.TargetDestination != nil {
 			return errors.New("unsupported: SELECT with a target destination")
 		}
@@ -185,6 +223,8 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 		if directives.IsSet(sqlparser.DirectiveScatterErrorsAsWarnings) {
 			rb.eroute.ScatterErrorsAsWarnings = true
 		}
+	} else if _, ok := sqlparser.ExtractCommentDirectives(sel.Comments)[sqlparser.DirectiveAsOf]; ok {
+		return errors.New("unsupported: AS_OF on a cross-shard query with the V3 planner")
 	}
 
 	// Set the outer symtab after processing of FROM clause.
@@ -195,6 +235,11 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 			return err
 		}
 	}
+	if rb, ok := pb.plan.(*route); !ok || !rb.isSingleShard() {
+		if err := checkAggregateUDFs(sel, pb.plan); err != nil {
+			return err
+		}
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
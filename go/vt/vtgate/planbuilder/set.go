@@ -116,6 +116,9 @@ func buildNotSupported(setting) planFunc {
 
 func buildSetOpIgnore(s setting) planFunc {
 	return func(expr *sqlparser.SetExpr, vschema ContextVSchema, _ *expressionConverter) (engine.SetOp, error) {
+		if vschema.StrictSystemSettings() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: system setting is not applied by vitess, and strict_system_settings is enabled", expr.Name)
+		}
 		value, err := extractValue(expr, s.boolean)
 		if err != nil {
 			return nil, err
@@ -148,6 +151,7 @@ func planSysVarCheckIgnore(expr *sqlparser.SetExpr, schema ContextVSchema, boole
 		Keyspace:          keyspace,
 		TargetDestination: dest,
 		Expr:              value,
+		Strict:            schema.StrictSystemSettings(),
 	}, nil
 }
 
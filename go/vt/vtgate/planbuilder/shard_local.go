@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// checkShardLocalJoins verifies the joins of the plan which vtgate executes
+// across shards. They fail the query if it is expected to be shard-local, and
+// are reported as a warning if they join tables declared as co-located,
+// since their join is then usually expected to be pushed down to the shards.
+func checkShardLocalJoins(stmt sqlparser.Statement, instruction engine.Primitive, vschema ContextVSchema) error {
+	return checkJoins(instruction, sqlparser.ShardLocalDirective(stmt), vschema)
+}
+
+func checkJoins(primitive engine.Primitive, shardLocal bool, vschema ContextVSchema) error {
+	if primitive == nil {
+		return nil
+	}
+	if left, right, ok := joinInputs(primitive); ok {
+		leftTables, rightTables := routedTables(left, vschema), routedTables(right, vschema)
+		if shardLocal {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "query is expected to be shard-local but joins %s and %s across shards", tableNames(leftTables), tableNames(rightTables))
+		}
+		for _, lt := range leftTables {
+			for _, rt := range rightTables {
+				if lt.IsColocatedWith(rt) {
+					vschema.PlannerWarning(fmt.Sprintf("the co-located tables %s and %s are joined across shards", lt.Name.String(), rt.Name.String()))
+				}
+			}
+		}
+	}
+	for _, input := range primitive.Inputs() {
+		if err := checkJoins(input, shardLocal, vschema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func joinInputs(primitive engine.Primitive) (engine.Primitive, engine.Primitive, bool) {
+	switch primitive := primitive.(type) {
+	case *engine.Join:
+		return primitive.Left, primitive.Right, true
+	case *engine.HashJoin:
+		return primitive.Left, primitive.Right, true
+	case *engine.MergeJoin:
+		return primitive.Left, primitive.Right, true
+	case *engine.SemiJoin:
+		return primitive.Left, primitive.Right, true
+	}
+	return nil, nil, false
+}
+
+// routedTables returns the vschema tables the routes of the primitive send
+// their queries to.
+func routedTables(primitive engine.Primitive, vschema ContextVSchema) []*vindexes.Table {
+	var tables []*vindexes.Table
+	if route, ok := primitive.(*engine.Route); ok && route.Keyspace != nil {
+		for _, name := range strings.Split(route.TableName, ", ") {
+			qualifier := route.Keyspace.Name
+			if i := strings.LastIndex(name, "."); i >= 0 {
+				qualifier, name = name[:i], name[i+1:]
+			}
+			table, _, _, _, err := vschema.FindTable(sqlparser.TableName{
+				Name:      sqlparser.NewTableIdent(strings.Trim(name, "`")),
+				Qualifier: sqlparser.NewTableIdent(strings.Trim(qualifier, "`")),
+			})
+			if err == nil && table != nil {
+				tables = append(tables, table)
+			}
+		}
+		return tables
+	}
+	for _, input := range primitive.Inputs() {
+		tables = append(tables, routedTables(input, vschema)...)
+	}
+	return tables
+}
+
+func tableNames(tables []*vindexes.Table) string {
+	names := make([]string, 0, len(tables))
+	for _, table := range tables {
+		names = append(names, table.Name.String())
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardLocalJoins(t *testing.T) {
+	testcases := []struct {
+		query   string
+		warning string
+		err     string
+	}{{
+		query: "select /*vt+ SHARD_LOCAL */ user.col from user join user_extra on user.id = user_extra.user_id",
+	}, {
+		query:   "select user.col from user join user_extra on user.col = user_extra.col",
+		warning: "the co-located tables user and user_extra are joined across shards",
+	}, {
+		query: "select user.col from user join music on user.col = music.col",
+	}, {
+		query: "select /*vt+ SHARD_LOCAL */ user.col from user join user_extra on user.col = user_extra.col",
+		err:   "query is expected to be shard-local but joins user and user_extra across shards",
+	}}
+	for _, version := range []PlannerVersion{V3, Gen4} {
+		for _, tc := range testcases {
+			t.Run(version.String()+": "+tc.query, func(t *testing.T) {
+				vschema := &vschemaWrapper{
+					v:       loadSchema(t, "schema_test.json", true),
+					version: version,
+				}
+				_, err := TestBuilder(tc.query, vschema, vschema.currentDb())
+				if tc.err != "" {
+					require.EqualError(t, err, tc.err)
+					return
+				}
+				require.NoError(t, err)
+				var warnings []string
+				if tc.warning != "" {
+					warnings = []string{tc.warning}
+				}
+				assert.Equal(t, warnings, vschema.warnings)
+			})
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// findUDF returns the declaration of the named UDF in one of the keyspaces
+// the plan is routed to, or nil if no keyspace declares it.
+func findUDF(plan logicalPlan, name string) *vindexes.UDF {
+	if rb, isRoute := plan.(*route); isRoute {
+		return rb.eroute.Keyspace.FindUDF(name)
+	}
+	for _, input := range plan.Inputs() {
+		if udf := findUDF(input, name); udf != nil {
+			return udf
+		}
+	}
+	return nil
+}
+
+// isShardLocalUDFExpr returns true if expr calls at least one declared UDF,
+// and is otherwise only made of columns, literals and operators. Such an
+// expression returns the same value for the same row on every evaluation,
+// so it can be computed by the shards and compared at vtgate.
+func isShardLocalUDFExpr(expr sqlparser.Expr, plan logicalPlan) bool {
+	hasUDF := false
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			udf := findUDF(plan, node.Name.Lowered())
+			if udf == nil || udf.Aggregate || !node.Qualifier.IsEmpty() {
+				return false, errNotShardLocal
+			}
+			hasUDF = true
+		case *sqlparser.Subquery, *sqlparser.ExistsExpr:
+			return false, errNotShardLocal
+		}
+		return true, nil
+	}, expr)
+	return err == nil && hasUDF
+}
+
+var errNotShardLocal = vterrors.New(vtrpcpb.Code_INTERNAL, "not a shard local expression")
+
+// checkAggregateUDFs fails if the query uses an aggregate UDF, since these
+// cannot be merged at vtgate when the query is not routed to a single shard.
+func checkAggregateUDFs(sel *sqlparser.Select, plan logicalPlan) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if udf := findUDF(plan, node.Name.Lowered()); udf != nil && udf.Aggregate {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregate udf '%s'", udf.Name)
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are planned separately.
+			return false, nil
+		}
+		return true, nil
+	}, sel.SelectExprs, sel.Having, sel.OrderBy)
+}
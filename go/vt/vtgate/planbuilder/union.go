@@ -52,7 +52,7 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, reservedVars *s
 		return err
 	}
 
-	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb := pb.newChild()
 	if err := rpb.processPart(union.Right, reservedVars, outer); err != nil {
 		return err
 	}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strconv"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// buildWindowPlan builds the plan of a select with window functions. The
+// query is pushed down as is if it is sent to a single shard, or if all its
+// windows are partitioned by the primary vindex column of its table, since
+// the rows of a partition are then all in the same shard. Otherwise the
+// window functions are evaluated at vtgate over the rows of the query,
+// which the shards return sorted by the window.
+func buildWindowPlan(query string, sel *sqlparser.Select, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema, planner selectPlanner) (engine.Primitive, error) {
+	plan, err := buildRoutePlan(sqlparser.CloneRefOfSelect(sel), reservedVars, vschema, planner(query))
+	if err != nil {
+		return nil, err
+	}
+	switch plan := plan.(type) {
+	case *engine.Send:
+		// The query targets shards explicitly.
+		return plan, nil
+	case *engine.Route:
+		if sendsToSingleShard(plan) || windowsPartitionedByVindex(sel, vschema) {
+			return plan, nil
+		}
+	}
+	// The columns keep the symbols V3 resolved them to, and need to be
+	// resolved again to be planned.
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			col.Metadata = nil
+		}
+		return true, nil
+	}, sel)
+	return buildWindowEvaluation(sel, reservedVars, vschema, planner)
+}
+
+// windowsPartitionedByVindex returns true if the query selects from a single
+// sharded table, and all its windows are partitioned by the column of its
+// primary vindex.
+func windowsPartitionedByVindex(sel *sqlparser.Select, vschema ContextVSchema) bool {
+	if len(sel.From) != 1 {
+		return false
+	}
+	ate, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	tableName, ok := ate.Expr.(sqlparser.TableName)
+	if !ok {
+		return false
+	}
+	table, _, _, _, err := vschema.FindTable(tableName)
+	if err != nil || table == nil || len(table.ColumnVindexes) == 0 || len(table.ColumnVindexes[0].Columns) != 1 {
+		return false
+	}
+	vindexColumn := table.ColumnVindexes[0].Columns[0]
+	partitioned := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.WindowExpr:
+			if !partitionedByColumn(node, vindexColumn) {
+				partitioned = false
+			}
+			return false, nil
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, sel.SelectExprs)
+	return partitioned
+}
+
+func partitionedByColumn(window *sqlparser.WindowExpr, column sqlparser.ColIdent) bool {
+	for _, expr := range window.PartitionBy {
+		if col, ok := expr.(*sqlparser.ColName); ok && col.Name.Equal(column) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildWindowEvaluation builds a plan evaluating the window functions at
+// vtgate. The shards return the columns of the query, the arguments of the
+// functions, and the partition and order expressions of the window with
+// their weight strings, sorted by the window. The functions are then
+// evaluated over the sorted rows, before the query is sorted and limited.
+func buildWindowEvaluation(sel *sqlparser.Select, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema, planner selectPlanner) (engine.Primitive, error) {
+	if sel.GroupBy != nil || sel.Having != nil || sel.Distinct || sqlparser.ContainsAggregation(sel.SelectExprs) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions with aggregations across shards")
+	}
+	var innerExprs sqlparser.SelectExprs
+	addColumn := func(expr sqlparser.Expr) int {
+		innerExprs = append(innerExprs, &sqlparser.AliasedExpr{Expr: sqlparser.CloneExpr(expr)})
+		return len(innerExprs) - 1
+	}
+
+	window := &engine.Window{}
+	var spec *sqlparser.WindowExpr
+	var args []sqlparser.Expr
+	for _, expr := range sel.SelectExprs {
+		ae, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '%s' expression with window functions across shards", sqlparser.String(expr))
+		}
+		windowExpr, ok := ae.Expr.(*sqlparser.WindowExpr)
+		if !ok {
+			if sqlparser.ContainsWindowFunction(ae.Expr) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function in the expression '%s' across shards", sqlparser.String(ae.Expr))
+			}
+			innerExprs = append(innerExprs, sqlparser.CloneRefOfAliasedExpr(ae))
+			window.Cols = append(window.Cols, len(innerExprs)-1)
+			continue
+		}
+		if spec == nil {
+			spec = windowExpr
+		} else if !sqlparser.EqualsExprs(spec.PartitionBy, windowExpr.PartitionBy) || !sqlparser.EqualsOrderBy(spec.OrderBy, windowExpr.OrderBy) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions over different windows across shards")
+		}
+		function, arg, err := windowFunction(windowExpr.Func)
+		if err != nil {
+			return nil, err
+		}
+		function.Alias = ae.As.String()
+		if ae.As.IsEmpty() {
+			function.Alias = sqlparser.String(windowExpr)
+		}
+		window.Functions = append(window.Functions, function)
+		window.Cols = append(window.Cols, -len(window.Functions))
+		args = append(args, arg)
+	}
+	for i, arg := range args {
+		if arg != nil {
+			window.Functions[i].Col = addColumn(arg)
+		}
+	}
+
+	var innerOrder sqlparser.OrderBy
+	for _, expr := range spec.PartitionBy {
+		innerOrder = append(innerOrder, &sqlparser.Order{Expr: columnPosition(addColumn(expr)), Direction: sqlparser.AscOrder})
+		window.PartitionBy = append(window.PartitionBy, addColumn(weightStringFor(expr)))
+	}
+	for _, order := range spec.OrderBy {
+		innerOrder = append(innerOrder, &sqlparser.Order{Expr: columnPosition(addColumn(order.Expr)), Direction: order.Direction})
+		window.OrderBy = append(window.OrderBy, addColumn(weightStringFor(order.Expr)))
+	}
+
+	// The columns of the query are sorted once the functions are evaluated.
+	var orderBy []engine.OrderByParams
+	for _, order := range sel.OrderBy {
+		col, err := windowOutputColumn(sel.SelectExprs, order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		params := engine.OrderByParams{Col: col, WeightStringCol: -1, Desc: order.Direction == sqlparser.DescOrder}
+		if window.Cols[col] >= 0 {
+			params.WeightStringCol = len(window.Cols)
+			window.Cols = append(window.Cols, addColumn(weightStringFor(innerExprs[window.Cols[col]].(*sqlparser.AliasedExpr).Expr)))
+		}
+		orderBy = append(orderBy, params)
+	}
+
+	inner := sqlparser.CloneRefOfSelect(sel)
+	inner.SelectExprs = innerExprs
+	inner.OrderBy = innerOrder
+	inner.Limit = nil
+	input, err := buildRoutePlan(inner, reservedVars, vschema, planner(sqlparser.String(inner)))
+	if err != nil {
+		return nil, err
+	}
+	window.Input = input
+
+	var plan engine.Primitive = window
+	if len(orderBy) > 0 {
+		sort := &engine.MemorySort{OrderBy: orderBy, Input: plan}
+		if len(window.Cols) > len(sel.SelectExprs) {
+			sort.TruncateColumnCount = len(sel.SelectExprs)
+		}
+		plan = sort
+	}
+	if sel.Limit != nil {
+		limit := &engine.Limit{Input: plan}
+		if limit.Count, err = sqlparser.NewPlanValue(sel.Limit.Rowcount); err != nil {
+			return nil, vterrors.Wrap(err, "unexpected expression in LIMIT")
+		}
+		if sel.Limit.Offset != nil {
+			if limit.Offset, err = sqlparser.NewPlanValue(sel.Limit.Offset); err != nil {
+				return nil, vterrors.Wrap(err, "unexpected expression in OFFSET")
+			}
+		}
+		plan = limit
+	}
+	return plan, nil
+}
+
+// windowFunction returns the function evaluated over a window at vtgate,
+// and its argument if it is an aggregate function.
+func windowFunction(funcExpr *sqlparser.FuncExpr) (*engine.WindowFunction, sqlparser.Expr, error) {
+	opcode, ok := engine.SupportedWindowFunctions[funcExpr.Name.Lowered()]
+	if !ok || funcExpr.Distinct {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function %s across shards", sqlparser.String(funcExpr))
+	}
+	function := &engine.WindowFunction{Opcode: opcode, Col: -1}
+	if !opcode.IsAggregate() {
+		if len(funcExpr.Exprs) != 0 {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to %s", funcExpr.Name.String())
+		}
+		return function, nil, nil
+	}
+	if len(funcExpr.Exprs) != 1 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to %s", funcExpr.Name.String())
+	}
+	switch arg := funcExpr.Exprs[0].(type) {
+	case *sqlparser.StarExpr:
+		if opcode == engine.WindowCount {
+			return function, nil, nil
+		}
+	case *sqlparser.AliasedExpr:
+		return function, arg.Expr, nil
+	}
+	return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function %s across shards", sqlparser.String(funcExpr))
+}
+
+// windowOutputColumn returns the column of the query an expression of its
+// ORDER BY refers to, by its alias, its expression, or its position.
+func windowOutputColumn(selectExprs sqlparser.SelectExprs, expr sqlparser.Expr) (int, error) {
+	if literal, ok := expr.(*sqlparser.Literal); ok && literal.Type == sqlparser.IntVal {
+		pos, err := strconv.Atoi(literal.Val)
+		if err == nil && pos >= 1 && pos <= len(selectExprs) {
+			return pos - 1, nil
+		}
+	}
+	for i, selectExpr := range selectExprs {
+		ae := selectExpr.(*sqlparser.AliasedExpr)
+		if col, ok := expr.(*sqlparser.ColName); ok && col.Qualifier.IsEmpty() && !ae.As.IsEmpty() && col.Name.Equal(ae.As) {
+			return i, nil
+		}
+		if sqlparser.EqualsExpr(ae.Expr, expr) {
+			return i, nil
+		}
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: order by '%s' which is not a column of a query with window functions across shards", sqlparser.String(expr))
+}
+
+func columnPosition(col int) sqlparser.Expr {
+	return sqlparser.NewIntLiteral(strconv.Itoa(col + 1))
+}
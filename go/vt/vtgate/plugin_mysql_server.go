@@ -35,6 +35,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
@@ -73,6 +74,7 @@ var (
 	mysqlConnReadTimeout  = flag.Duration("mysql_server_read_timeout", 0, "connection read timeout")
 	mysqlConnWriteTimeout = flag.Duration("mysql_server_write_timeout", 0, "connection write timeout")
 	mysqlQueryTimeout     = flag.Duration("mysql_server_query_timeout", 0, "mysql query timeout")
+	mysqlDrainTimeout     = flag.Duration("mysql_server_drain_timeout", 0, "On SIGTERM, how long the connections executing a query or in a transaction are given to finish it before their next statements fail with ERR 1053 server shutdown. 0 waits for them up to -onterm_timeout.")
 
 	mysqlDefaultWorkloadName = flag.String("mysql_default_workload", "OLTP", "Default session workload (OLTP, OLAP, DBA)")
 	mysqlDefaultWorkload     int32
@@ -87,8 +89,14 @@ type vtgateHandler struct {
 
 	vtg         *VTGate
 	connections map[*mysql.Conn]bool
+
+	// drained is set once the drain window of the shutdown elapsed, after
+	// which the statements of the connections fail.
+	drained sync2.AtomicBool
 }
 
+var errServerShutdown = mysql.NewSQLError(mysql.ERServerShutdown, mysql.SSNetError, "Server shutdown in progress")
+
 func newVtgateHandler(vtg *VTGate) *vtgateHandler {
 	return &vtgateHandler{
 		vtg:         vtg,
@@ -178,6 +186,9 @@ func startSpan(ctx context.Context, query, label string) (trace.Span, context.Co
 }
 
 func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	if vh.drained.Get() {
+		return errServerShutdown
+	}
 	ctx := context.Background()
 	var cancel context.CancelFunc
 	if *mysqlQueryTimeout != 0 {
@@ -243,6 +254,9 @@ func fillInTxStatusFlags(c *mysql.Conn, session *vtgatepb.Session) {
 
 // ComPrepare is the handler for command prepare.
 func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	if vh.drained.Get() {
+		return nil, errServerShutdown
+	}
 	var ctx context.Context
 	var cancel context.CancelFunc
 	if *mysqlQueryTimeout != 0 {
@@ -285,6 +299,9 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[st
 }
 
 func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	if vh.drained.Get() {
+		return errServerShutdown
+	}
 	var ctx context.Context
 	var cancel context.CancelFunc
 	if *mysqlQueryTimeout != 0 {
@@ -318,6 +335,9 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		}
 	}()
 
+	// The plan of the statement is reused by its next executions.
+	ctx = withPreparedStatement(ctx, connPreparedStatement(prepare))
+
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
@@ -497,24 +517,46 @@ func newMysqlUnixSocket(address string, authServer mysql.AuthServer, handler mys
 	}
 }
 
+// shutdownMysqlProtocolAndDrain stops accepting MySQL connections, makes
+// vtgate unhealthy and fails the pings of the existing connections so that
+// the load balancers and the connection pools move to other vtgates, and
+// gives the connections executing a query or in a transaction until the end
+// of -mysql_server_drain_timeout to finish it. The statements sent after
+// that fail with ERR 1053 server shutdown.
 func shutdownMysqlProtocolAndDrain() {
+	if rpcVTGate != nil {
+		rpcVTGate.draining.Set(true)
+	}
 	if mysqlListener != nil {
-		mysqlListener.Close()
+		mysqlListener.Shutdown()
 		mysqlListener = nil
 	}
 	if mysqlUnixListener != nil {
-		mysqlUnixListener.Close()
+		mysqlUnixListener.Shutdown()
 		mysqlUnixListener = nil
 	}
 	if sigChan != nil {
 		signal.Stop(sigChan)
 	}
 
+	drainConnections(*mysqlDrainTimeout)
+	if vtgateHandle != nil {
+		vtgateHandle.drained.Set(true)
+	}
+}
+
+// drainConnections waits for the busy connections to be idle, for at most
+// timeout if it is not 0.
+func drainConnections(timeout time.Duration) {
 	if atomic.LoadInt32(&busyConnections) > 0 {
 		log.Infof("Waiting for all client connections to be idle (%d active)...", atomic.LoadInt32(&busyConnections))
 		start := time.Now()
 		reported := start
 		for atomic.LoadInt32(&busyConnections) != 0 {
+			if timeout != 0 && time.Since(start) > timeout {
+				log.Warningf("Client connections did not go idle within %v (%d active), failing their next statements", timeout, atomic.LoadInt32(&busyConnections))
+				return
+			}
 			if time.Since(reported) > 2*time.Second {
 				log.Infof("Still waiting for client connections to be idle (%d active)...", atomic.LoadInt32(&busyConnections))
 				reported = time.Now()
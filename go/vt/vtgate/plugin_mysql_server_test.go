@@ -22,6 +22,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"testing"
 	"time"
@@ -253,6 +254,34 @@ func TestDefaultWorkloadOLAP(t *testing.T) {
 	}
 }
 
+func TestDrainConnections(t *testing.T) {
+	atomic.AddInt32(&busyConnections, 1)
+	defer atomic.AddInt32(&busyConnections, -1)
+
+	// The drain gives up on the busy connections after its timeout.
+	start := time.Now()
+	drainConnections(10 * time.Millisecond)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(10*time.Millisecond))
+
+	vtg := &VTGate{}
+	assert.NoError(t, vtg.IsHealthy())
+	vtg.draining.Set(true)
+	assert.EqualError(t, vtg.IsHealthy(), "vtgate is draining its connections to shut down")
+
+	// Once drained, the statements of the connections fail with ERR 1053.
+	vh := newVtgateHandler(vtg)
+	vh.drained.Set(true)
+	err := vh.ComQuery(&mysql.Conn{}, "select 1", func(*sqltypes.Result) error { return nil })
+	sqlErr, ok := err.(*mysql.SQLError)
+	if assert.True(t, ok, "%v is not a SQLError", err) {
+		assert.Equal(t, mysql.ERServerShutdown, sqlErr.Number())
+	}
+	_, err = vh.ComPrepare(&mysql.Conn{}, "select 1", nil)
+	assert.Equal(t, errServerShutdown, err)
+	err = vh.ComStmtExecute(&mysql.Conn{}, &mysql.PrepareData{}, func(*sqltypes.Result) error { return nil })
+	assert.Equal(t, errServerShutdown, err)
+}
+
 func TestInitTLSConfigWithoutServerCA(t *testing.T) {
 	testInitTLSConfig(t, false)
 }
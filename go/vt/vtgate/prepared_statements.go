@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var preparedPlanCounts = stats.NewCountersWithSingleLabel("PreparedStatementPlans", "Plans of the prepared statement executions, by whether they were reused or the statement was parsed again", "Plan", "Reused", "Parsed")
+
+// preparedStatement is the state of a prepared statement of a MySQL
+// connection, kept between its executions. It lets them reuse the plan of
+// the statement without parsing and normalizing it again.
+type preparedStatement struct {
+	// planPrefixKey and planKey are the keys of the plan in the plan cache.
+	// The plan is only reused while the session has the same target, and
+	// while the plan is in the cache, which is cleared on vschema changes.
+	planPrefixKey string
+	planKey       string
+	// query is the normalized query.
+	query string
+	// bindVars are the bind variables of the literals extracted from the
+	// statement by the normalizer.
+	bindVars            map[string]*querypb.BindVariable
+	ignoreMaxMemoryRows bool
+}
+
+// connPreparedStatement returns the state of the prepared statement, which is
+// kept with the statement on the connection.
+func connPreparedStatement(prepare *mysql.PrepareData) *preparedStatement {
+	ps, ok := prepare.ClientData.(*preparedStatement)
+	if !ok {
+		ps = &preparedStatement{}
+		prepare.ClientData = ps
+	}
+	return ps
+}
+
+type preparedStatementKey struct{}
+
+// withPreparedStatement returns a context on which the executor reuses and
+// records the plan of the prepared statement.
+func withPreparedStatement(ctx context.Context, ps *preparedStatement) context.Context {
+	return context.WithValue(ctx, preparedStatementKey{}, ps)
+}
+
+func preparedStatementFromContext(ctx context.Context) *preparedStatement {
+	ps, _ := ctx.Value(preparedStatementKey{}).(*preparedStatement)
+	return ps
+}
+
+// plan returns the plan of the previous executions of the statement, if it
+// is still valid, and adds the bind variables of its normalized literals.
+func (ps *preparedStatement) plan(e *Executor, planPrefixKey string, bindVars map[string]*querypb.BindVariable) (*engine.Plan, bool) {
+	if ps.planKey == "" || ps.planPrefixKey != planPrefixKey {
+		return nil, false
+	}
+	plan, ok := e.plans.Get(ps.planKey)
+	if !ok {
+		return nil, false
+	}
+	for k, v := range ps.bindVars {
+		bindVars[k] = v
+	}
+	preparedPlanCounts.Add("Reused", 1)
+	return plan.(*engine.Plan), true
+}
+
+// save records the plan of the statement for its next executions. The bind
+// variables which are not reserved by the statement come from its literals.
+func (ps *preparedStatement) save(planPrefixKey, planKey, query string, reserved sqlparser.BindVars, bindVars map[string]*querypb.BindVariable, ignoreMaxMemoryRows bool) {
+	ps.planPrefixKey = planPrefixKey
+	ps.planKey = planKey
+	ps.query = query
+	ps.ignoreMaxMemoryRows = ignoreMaxMemoryRows
+	ps.bindVars = make(map[string]*querypb.BindVariable)
+	for k, v := range bindVars {
+		if _, ok := reserved[k]; !ok {
+			ps.bindVars[k] = v
+		}
+	}
+	preparedPlanCounts.Add("Parsed", 1)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+const pathQueryCost = "/debug/query_cost"
+
+// queryCostTimeout bounds the explain queries sent to the shards by the
+// /debug/query_cost endpoint.
+var queryCostTimeout = 10 * time.Second
+
+// EstimateCost plans the query for the target, and estimates its cost
+// without executing it. It is the API behind EXPLAIN FORMAT=COST.
+func (e *Executor) EstimateCost(ctx context.Context, target, sql string, bindVars map[string]*querypb.BindVariable) (*engine.QueryCost, error) {
+	safeSession := NewSafeSession(&vtgatepb.Session{TargetString: target, Autocommit: true})
+	logStats := NewLogStats(ctx, "EstimateCost", sql, bindVars)
+	if bindVars == nil {
+		bindVars = make(map[string]*querypb.BindVariable)
+	}
+
+	query, comments := sqlparser.SplitMarginComments(sql)
+	vcursor, err := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := e.getPlan(vcursor, query, comments, bindVars, safeSession, logStats)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession); err != nil {
+		return nil, err
+	}
+	return engine.EstimateCost(vcursor, plan.Instructions, bindVars), nil
+}
+
+// handleQueryCost returns the estimated cost of the sql parameter as JSON.
+// The target parameter is the keyspace, or keyspace@tablet_type, the query
+// is planned for.
+func (e *Executor) handleQueryCost(response http.ResponseWriter, request *http.Request) {
+	if err := request.ParseForm(); err != nil {
+		http.Error(response, "cannot parse form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	sql := request.FormValue("sql")
+	if sql == "" {
+		http.Error(response, "missing sql parameter", http.StatusBadRequest)
+		return
+	}
+	ctx, cancel := context.WithTimeout(request.Context(), queryCostTimeout)
+	defer cancel()
+	cost, err := e.EstimateCost(ctx, request.FormValue("target"), sql, nil)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	returnAsJSON(response, cost)
+}
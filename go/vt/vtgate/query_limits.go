@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// withPlanWorkload makes the session send the queries of the plan to the
+// tablets with the workload of the vschema of its tables, unless the session
+// uses a workload other than OLTP, and returns the function restoring the
+// workload of the session.
+func withPlanWorkload(plan *engine.Plan, safeSession *SafeSession) func() {
+	if plan.Workload == querypb.ExecuteOptions_UNSPECIFIED {
+		return func() {}
+	}
+	options := safeSession.GetOrCreateOptions()
+	workload := options.Workload
+	if workload != querypb.ExecuteOptions_UNSPECIFIED && workload != querypb.ExecuteOptions_OLTP {
+		return func() {}
+	}
+	options.Workload = plan.Workload
+	return func() {
+		options.Workload = workload
+	}
+}
+
+// checkMaxRows fails a query returning more rows than the max rows of the
+// vschema of its tables.
+func checkMaxRows(plan *engine.Plan, rows int) error {
+	if plan.MaxRows == 0 || rows <= plan.MaxRows {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "row count exceeded the max_rows of %d of the vschema", plan.MaxRows)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestWithPlanWorkload(t *testing.T) {
+	plan := &engine.Plan{Workload: querypb.ExecuteOptions_OLAP}
+
+	session := NewSafeSession(&vtgatepb.Session{})
+	restore := withPlanWorkload(plan, session)
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, session.GetOrCreateOptions().Workload)
+	restore()
+	assert.Equal(t, querypb.ExecuteOptions_UNSPECIFIED, session.GetOrCreateOptions().Workload)
+
+	// A session using the DBA workload keeps it.
+	session = NewSafeSession(&vtgatepb.Session{Options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_DBA}})
+	restore = withPlanWorkload(plan, session)
+	assert.Equal(t, querypb.ExecuteOptions_DBA, session.GetOrCreateOptions().Workload)
+	restore()
+	assert.Equal(t, querypb.ExecuteOptions_DBA, session.GetOrCreateOptions().Workload)
+
+	// A plan without workload does not change the one of the session.
+	session = NewSafeSession(&vtgatepb.Session{Options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLTP}})
+	restore = withPlanWorkload(&engine.Plan{}, session)
+	assert.Equal(t, querypb.ExecuteOptions_OLTP, session.GetOrCreateOptions().Workload)
+	restore()
+}
+
+func TestCheckMaxRows(t *testing.T) {
+	assert.NoError(t, checkMaxRows(&engine.Plan{}, 1000))
+	assert.NoError(t, checkMaxRows(&engine.Plan{MaxRows: 10}, 10))
+	assert.EqualError(t, checkMaxRows(&engine.Plan{MaxRows: 10}, 11), "row count exceeded the max_rows of 10 of the vschema")
+}
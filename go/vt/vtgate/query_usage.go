@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+type bufferedTimeKey struct{}
+
+// withBufferedTime returns a context on which the gateway adds the time the
+// queries are buffered during failovers to bufferedTime.
+func withBufferedTime(ctx context.Context, bufferedTime *sync2.AtomicDuration) context.Context {
+	return context.WithValue(ctx, bufferedTimeKey{}, bufferedTime)
+}
+
+func addBufferedTime(ctx context.Context, d time.Duration) {
+	if bufferedTime, ok := ctx.Value(bufferedTimeKey{}).(*sync2.AtomicDuration); ok {
+		bufferedTime.Add(d)
+	}
+}
+
+type shardTimesKey struct{}
+
+// withShardTimes returns a context on which the scatter conn adds the time
+// the shards take to answer the queries to the shard times of logStats.
+func withShardTimes(ctx context.Context, logStats *LogStats) context.Context {
+	return context.WithValue(ctx, shardTimesKey{}, logStats)
+}
+
+func addShardTime(ctx context.Context, keyspace, shard string, d time.Duration) {
+	if logStats, ok := ctx.Value(shardTimesKey{}).(*LogStats); ok {
+		logStats.AddShardTime(keyspace, shard, d)
+	}
+}
+
+// saveQueryUsage records the execution metadata of the query in the session,
+// if the session reports them.
+func saveQueryUsage(safeSession *SafeSession, logStats *LogStats, bytesReturned uint64) {
+	if !safeSession.ReportQueryUsage {
+		return
+	}
+	safeSession.QueryUsage = &vtgatepb.QueryUsage{
+		ShardRows:        atomic.LoadUint64(&logStats.ShardRows),
+		BytesReturned:    bytesReturned,
+		ShardQueries:     atomic.LoadUint64(&logStats.ShardQueries),
+		BufferedDuration: protoutil.DurationToProto(logStats.BufferedTime.Get()),
+	}
+}
+
+// resultBytes returns the size of the values of the rows of the result.
+func resultBytes(qr *sqltypes.Result) uint64 {
+	if qr == nil {
+		return 0
+	}
+	var n uint64
+	for _, row := range qr.Rows {
+		for _, value := range row {
+			n += uint64(value.Len())
+		}
+	}
+	return n
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+)
+
+func TestBufferedTime(t *testing.T) {
+	var bufferedTime sync2.AtomicDuration
+	ctx := withBufferedTime(context.Background(), &bufferedTime)
+	addBufferedTime(ctx, time.Second)
+	addBufferedTime(ctx, 2*time.Second)
+	assert.Equal(t, 3*time.Second, bufferedTime.Get())
+
+	// Contexts without a buffered time are ignored.
+	addBufferedTime(context.Background(), time.Second)
+}
+
+func TestResultBytes(t *testing.T) {
+	qr := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|abc", "20|null")
+	assert.EqualValues(t, 6, resultBytes(qr))
+	assert.Zero(t, resultBytes(nil))
+}
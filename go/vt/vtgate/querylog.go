@@ -19,8 +19,11 @@ package vtgate
 import (
 	"flag"
 	"net/http"
+	"strings"
 
 	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vtgate/querylogsink"
 )
 
 var (
@@ -38,6 +41,10 @@ var (
 
 	// queryLogToFile controls whether query logs are sent to a file
 	queryLogToFile = flag.String("log_queries_to_file", "", "Enable query logging to the specified file")
+
+	// queryLogSinks are the sinks the query log events are streamed to
+	queryLogSinks          = flag.String("querylog_sinks", "", "Comma-separated list of the URLs of the sinks to stream the query log events to: file:///path, syslog:, syslog://host:port, grpc://host:port or kafka://host:port/topic, with the optional tables=t1,t2 and min_latency=100ms filter parameters")
+	queryLogSinkBufferSize = flag.Int("querylog_sink_buffer_size", 10000, "Number of query log events buffered by each query log sink before the events are dropped")
 )
 
 func initQueryLogger(vtg *VTGate) error {
@@ -49,6 +56,10 @@ func initQueryLogger(vtg *VTGate) error {
 		querylogzHandler(ch, w, r)
 	})
 
+	if *streamlog.QueryLogRecentSize > 0 {
+		QueryLogger.KeepRecent(*streamlog.QueryLogRecentSize).ServeLogs(RequestLogHandler, streamlog.GetFormatter(QueryLogger), matchRequestID)
+	}
+
 	http.HandleFunc(QueryzHandler, func(w http.ResponseWriter, r *http.Request) {
 		queryzHandler(vtg.executor, w, r)
 	})
@@ -60,5 +71,39 @@ func initQueryLogger(vtg *VTGate) error {
 		}
 	}
 
+	if err := initSlowQueryLogger(); err != nil {
+		return err
+	}
+
+	if *queryLogSinks != "" {
+		if err := streamQueryLogToSinks(strings.Split(*queryLogSinks, ",")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamQueryLogToSinks streams the query log events to sinks until vtgate
+// is closed.
+func streamQueryLogToSinks(urls []string) error {
+	streamer, err := querylogsink.New(urls, *queryLogSinkBufferSize)
+	if err != nil {
+		return err
+	}
+	ch := QueryLogger.Subscribe("QueryLogSinks")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for record := range ch {
+			streamer.Send(queryLogEvent(record.(*LogStats)))
+		}
+	}()
+	servenv.OnClose(func() {
+		QueryLogger.Unsubscribe(ch)
+		close(ch)
+		<-done
+		streamer.Close()
+	})
 	return nil
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+// redactedFingerprint is the fingerprint of the queries which could contain
+// values.
+const redactedFingerprint = "[REDACTED]"
+
+// queryLogEvent returns the query log event of a query, with the values of
+// its bind variables redacted.
+func queryLogEvent(stats *LogStats) *querylogpb.Event {
+	fingerprint, tables := queryFingerprint(stats.SQL)
+	if len(tables) == 0 && stats.Table != "" {
+		tables = []string{stats.Table}
+	}
+	var bindVariableTypes map[string]querypb.Type
+	if len(stats.BindVariables) > 0 {
+		bindVariableTypes = make(map[string]querypb.Type, len(stats.BindVariables))
+		for name, bv := range stats.BindVariables {
+			bindVariableTypes[name] = bv.Type
+		}
+	}
+	remoteAddr, username := stats.RemoteAddrUsername()
+	return &querylogpb.Event{
+		Method:            stats.Method,
+		Keyspace:          stats.Keyspace,
+		TabletType:        stats.TabletType,
+		StmtType:          stats.StmtType,
+		Fingerprint:       fingerprint,
+		Tables:            tables,
+		BindVariableTypes: bindVariableTypes,
+		Shards:            stats.Shards(),
+		ShardQueries:      stats.ShardQueries,
+		RowsAffected:      stats.RowsAffected,
+		RowsReturned:      stats.RowsReturned,
+		StartTime:         protoutil.TimeToProto(stats.StartTime),
+		TotalTime:         protoutil.DurationToProto(stats.TotalTime()),
+		PlanTime:          protoutil.DurationToProto(stats.PlanTime),
+		ExecuteTime:       protoutil.DurationToProto(stats.ExecuteTime),
+		CommitTime:        protoutil.DurationToProto(stats.CommitTime),
+		Error:             stats.ErrorStr(),
+		RemoteAddr:        remoteAddr,
+		Username:          username,
+		ImmediateCaller:   stats.ImmediateCaller(),
+		EffectiveCaller:   stats.EffectiveCaller(),
+		RequestId:         stats.RequestID,
+	}
+}
+
+// queryFingerprint returns the fingerprint of a query, which is the query
+// without its comments and with its literals replaced by bind variables, so
+// that it does not contain any value. It also returns the tables of the query.
+// The values of a query which does not parse or normalize can't be told
+// apart from the rest of it, so its fingerprint is redacted.
+func queryFingerprint(sql string) (string, []string) {
+	query, _ := sqlparser.SplitMarginComments(sql)
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return redactedFingerprint, nil
+	}
+	if sqlparser.CanNormalize(stmt) {
+		reserved := sqlparser.NewReservedVars("vtg", sqlparser.GetBindvars(stmt))
+		if err := sqlparser.Normalize(stmt, reserved, make(map[string]*querypb.BindVariable)); err != nil {
+			return redactedFingerprint, nil
+		}
+	}
+
+	var tables []string
+	seen := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			// The qualifier of a column may be the alias of a table.
+			return false, nil
+		case sqlparser.TableName:
+			name := node.Name.String()
+			if name != "" && !seen[name] {
+				seen[name] = true
+				tables = append(tables, name)
+			}
+		}
+		return true, nil
+	}, stmt)
+	return sqlparser.String(stmt), tables
+}
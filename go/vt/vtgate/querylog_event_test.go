@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/srvtopo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+func TestQueryFingerprint(t *testing.T) {
+	tcases := []struct {
+		sql         string
+		fingerprint string
+		tables      []string
+	}{{
+		sql:         "/* leading */ select a, 'secret' from t1 where id = 5 /* trailing */",
+		fingerprint: "select a, :vtg1 from t1 where id = :vtg2",
+		tables:      []string{"t1"},
+	}, {
+		sql:         "select o.id from orders as o join order_items on o.id = order_items.order_id where o.id in (1, 2)",
+		fingerprint: "select o.id from orders as o join order_items on o.id = order_items.order_id where o.id in ::vtg1",
+		tables:      []string{"orders", "order_items"},
+	}, {
+		sql:         "insert into ks.t2(id, name) values (:id, 'name')",
+		fingerprint: "insert into ks.t2(id, `name`) values (:id, :vtg1)",
+		tables:      []string{"t2"},
+	}, {
+		sql:         "show full processlist",
+		fingerprint: "show processlist",
+	}, {
+		sql:         "/* leading */ not a query where id = 'secret'",
+		fingerprint: "[REDACTED]",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			fingerprint, tables := queryFingerprint(tcase.sql)
+			assert.Equal(t, tcase.fingerprint, fingerprint)
+			assert.Equal(t, tcase.tables, tables)
+		})
+	}
+}
+
+func TestQueryLogEvent(t *testing.T) {
+	logStats := NewLogStats(context.Background(), "Execute", "select * from t1 where id = :id", map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)})
+	logStats.StartTime = time.Date(2021, time.January, 1, 1, 2, 3, 0, time.UTC)
+	logStats.EndTime = logStats.StartTime.Add(time.Second)
+	logStats.Keyspace = "ks"
+	logStats.TabletType = "PRIMARY"
+	logStats.StmtType = "SELECT"
+	logStats.ShardQueries = 2
+	logStats.RowsReturned = 3
+	logStats.ExecuteTime = time.Millisecond
+	logStats.Error = errors.New("error")
+	logStats.AddShards([]*srvtopo.ResolvedShard{{Target: &querypb.Target{Keyspace: "ks", Shard: "80-"}}})
+	logStats.AddShards([]*srvtopo.ResolvedShard{{Target: &querypb.Target{Keyspace: "ks", Shard: "-80"}}, {Target: &querypb.Target{Keyspace: "ks", Shard: "80-"}}})
+
+	want := &querylogpb.Event{
+		Method:            "Execute",
+		Keyspace:          "ks",
+		TabletType:        "PRIMARY",
+		StmtType:          "SELECT",
+		Fingerprint:       "select * from t1 where id = :id",
+		Tables:            []string{"t1"},
+		BindVariableTypes: map[string]querypb.Type{"id": querypb.Type_INT64},
+		Shards:            []string{"ks/-80", "ks/80-"},
+		ShardQueries:      2,
+		RowsReturned:      3,
+		StartTime:         protoutil.TimeToProto(logStats.StartTime),
+		TotalTime:         protoutil.DurationToProto(time.Second),
+		PlanTime:          protoutil.DurationToProto(0),
+		ExecuteTime:       protoutil.DurationToProto(time.Millisecond),
+		CommitTime:        protoutil.DurationToProto(0),
+		Error:             "error",
+	}
+	utils.MustMatch(t, want, queryLogEvent(logStats))
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package querylogsink
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+// filter selects the events delivered to a sink.
+type filter struct {
+	// tables are the tables of the events, or nil for all the tables.
+	tables map[string]bool
+	// minLatency is the minimum total time of the events.
+	minLatency time.Duration
+}
+
+// newFilter returns the filter of the parameters of the URL of a sink.
+func newFilter(params url.Values) (*filter, error) {
+	f := &filter{}
+	if tables := params.Get("tables"); tables != "" {
+		f.tables = make(map[string]bool)
+		for _, table := range strings.Split(tables, ",") {
+			f.tables[strings.TrimSpace(table)] = true
+		}
+	}
+	if minLatency := params.Get("min_latency"); minLatency != "" {
+		d, err := time.ParseDuration(minLatency)
+		if err != nil {
+			return nil, err
+		}
+		f.minLatency = d
+	}
+	return f, nil
+}
+
+func (f *filter) match(ev *querylogpb.Event) bool {
+	if f.minLatency > 0 {
+		latency, _, _ := protoutil.DurationFromProto(ev.TotalTime)
+		if latency < f.minLatency {
+			return false
+		}
+	}
+	if f.tables == nil {
+		return true
+	}
+	for _, table := range ev.Tables {
+		if f.tables[table] {
+			return true
+		}
+	}
+	return false
+}
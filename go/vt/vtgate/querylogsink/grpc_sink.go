@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package querylogsink
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+// grpcSink streams the events to a QueryLog gRPC service, on a stream which
+// is opened again after an error.
+//
+// The URL grpc://host:port connects without TLS, unless the cert, key, ca,
+// crl or server_name parameters are set, like
+// grpc://host:port?ca=/path/to/ca.pem&server_name=querylog.
+type grpcSink struct {
+	cc     *grpc.ClientConn
+	client querylogpb.QueryLogClient
+
+	// stream is nil until the first Write, and after an error.
+	stream querylogpb.QueryLog_PublishClient
+	cancel context.CancelFunc
+}
+
+func newGRPCSink(u *url.URL) (Sink, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("no address in %s", u.Redacted())
+	}
+	query := u.Query()
+	opt, err := grpcclient.SecureDialOption(query.Get("cert"), query.Get("key"), query.Get("ca"), query.Get("crl"), query.Get("server_name"))
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpcclient.Dial(u.Host, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcSink{
+		cc:     cc,
+		client: querylogpb.NewQueryLogClient(cc),
+	}, nil
+}
+
+// Write is part of the Sink interface.
+func (gs *grpcSink) Write(ctx context.Context, events []*querylogpb.Event) error {
+	if gs.stream == nil {
+		// The stream outlives the context of a Write.
+		streamCtx, cancel := context.WithCancel(context.Background())
+		stream, err := gs.client.Publish(streamCtx)
+		if err != nil {
+			cancel()
+			return err
+		}
+		gs.stream = stream
+		gs.cancel = cancel
+	}
+	for _, ev := range events {
+		if err := gs.stream.Send(ev); err != nil {
+			gs.closeStream()
+			return err
+		}
+	}
+	return nil
+}
+
+func (gs *grpcSink) closeStream() {
+	gs.cancel()
+	gs.stream = nil
+	gs.cancel = nil
+}
+
+// Close is part of the Sink interface.
+func (gs *grpcSink) Close() error {
+	if gs.stream != nil {
+		if _, err := gs.stream.CloseAndRecv(); err != nil {
+			gs.closeStream()
+			gs.cc.Close()
+			return err
+		}
+		gs.closeStream()
+	}
+	return gs.cc.Close()
+}
+
+func init() {
+	RegisterSink("grpc", newGRPCSink)
+}
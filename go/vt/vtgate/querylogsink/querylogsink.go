@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package querylogsink streams the structured query log events of vtgate to
+pluggable sinks. The sinks are configured as URLs whose scheme is the name of
+a registered sink:
+
+	file:///var/log/vitess/querylog.json   appends the events to a file, one JSON event per line
+	syslog:                                sends the events to the local syslog daemon
+	syslog://host:514?network=udp          sends the events to a remote syslog daemon
+	grpc://host:port                       streams the events to a QueryLog gRPC service
+	kafka://host:8082/topic                produces the events to a Kafka topic through a Kafka REST proxy
+
+The events a sink receives are filtered by the parameters of its URL:
+
+	tables=t1,t2       only the queries of these tables
+	min_latency=100ms  only the queries which took at least this long
+
+Each sink has its own buffer of events, and the events are dropped when the
+buffer of a sink is full or when the sink fails to deliver them, so that a
+slow sink neither slows down the queries nor the other sinks.
+*/
+package querylogsink
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+const (
+	// maxBatchSize is the maximum number of events delivered to a sink in
+	// one Write.
+	maxBatchSize = 100
+	// writeTimeout is the timeout of a Write.
+	writeTimeout = 10 * time.Second
+)
+
+var (
+	deliveredCount = stats.NewCountersWithSingleLabel("QueryLogSinkDelivered", "Number of query log events delivered by sink", "Sink")
+	droppedCount   = stats.NewCountersWithSingleLabel("QueryLogSinkDropped", "Number of query log events dropped by sink", "Sink")
+	errorCount     = stats.NewCountersWithSingleLabel("QueryLogSinkErrors", "Number of failed deliveries of batches of query log events by sink", "Sink")
+
+	sinkFactories = make(map[string]SinkFactory)
+)
+
+// Sink delivers query log events to an external system.
+type Sink interface {
+	// Write delivers a batch of events. It is called by a single goroutine.
+	Write(ctx context.Context, events []*querylogpb.Event) error
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// SinkFactory creates a sink from its URL.
+type SinkFactory func(u *url.URL) (Sink, error)
+
+// RegisterSink registers the factory of the sinks of a URL scheme. It should
+// be called in an init() function.
+func RegisterSink(scheme string, factory SinkFactory) {
+	if _, ok := sinkFactories[scheme]; ok {
+		log.Fatalf("query log sink %s is already registered", scheme)
+	}
+	sinkFactories[scheme] = factory
+}
+
+// Streamer streams the query log events to sinks.
+type Streamer struct {
+	hostname string
+	sinks    []*bufferedSink
+}
+
+// New returns a Streamer to the sinks of the URLs, each buffering up to
+// bufferSize events.
+func New(urls []string, bufferSize int) (*Streamer, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	s := &Streamer{hostname: hostname}
+	for _, rawURL := range urls {
+		bs, err := newBufferedSink(rawURL, bufferSize)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		s.sinks = append(s.sinks, bs)
+	}
+	return s, nil
+}
+
+// Send sends an event to the sinks whose filter it matches. It does not
+// block, and the event must not be modified after Send is called.
+func (s *Streamer) Send(ev *querylogpb.Event) {
+	if ev.Hostname == "" {
+		ev.Hostname = s.hostname
+	}
+	for _, bs := range s.sinks {
+		if !bs.filter.match(ev) {
+			continue
+		}
+		select {
+		case bs.events <- ev:
+		default:
+			droppedCount.Add(bs.name, 1)
+		}
+	}
+}
+
+// Close delivers the buffered events, and closes the sinks.
+func (s *Streamer) Close() {
+	for _, bs := range s.sinks {
+		close(bs.events)
+	}
+	for _, bs := range s.sinks {
+		<-bs.done
+		if err := bs.sink.Close(); err != nil {
+			log.Errorf("can't close query log sink %s: %v", bs.name, err)
+		}
+	}
+}
+
+// bufferedSink delivers the events of a sink from a goroutine.
+type bufferedSink struct {
+	name   string
+	sink   Sink
+	filter *filter
+	events chan *querylogpb.Event
+	done   chan struct{}
+	logger *logutil.ThrottledLogger
+}
+
+func newBufferedSink(rawURL string, bufferSize int) (*bufferedSink, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid query log sink %q: %v", rawURL, err)
+	}
+	factory, ok := sinkFactories[u.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("unknown query log sink %q", u.Scheme)
+	}
+	name := u.Redacted()
+	filter, err := newFilter(u.Query())
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter of query log sink %s: %v", name, err)
+	}
+	sink, err := factory(u)
+	if err != nil {
+		return nil, fmt.Errorf("can't create query log sink %s: %v", name, err)
+	}
+	bs := &bufferedSink{
+		name:   name,
+		sink:   sink,
+		filter: filter,
+		events: make(chan *querylogpb.Event, bufferSize),
+		done:   make(chan struct{}),
+		logger: logutil.NewThrottledLogger("QueryLogSink "+name, 5*time.Second),
+	}
+	go bs.run()
+	return bs, nil
+}
+
+func (bs *bufferedSink) run() {
+	defer close(bs.done)
+	for ev := range bs.events {
+		batch := []*querylogpb.Event{ev}
+	fill:
+		for len(batch) < maxBatchSize {
+			select {
+			case ev, ok := <-bs.events:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, ev)
+			default:
+				break fill
+			}
+		}
+		bs.write(batch)
+	}
+}
+
+func (bs *bufferedSink) write(batch []*querylogpb.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	if err := bs.sink.Write(ctx, batch); err != nil {
+		errorCount.Add(bs.name, 1)
+		droppedCount.Add(bs.name, int64(len(batch)))
+		bs.logger.Warningf("can't deliver %d events to query log sink %s: %v", len(batch), bs.name, err)
+		return
+	}
+	deliveredCount.Add(bs.name, int64(len(batch)))
+}
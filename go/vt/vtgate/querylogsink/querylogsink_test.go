@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package querylogsink
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+// fakeSink records the events it delivers, and fails the writes while it is
+// failing. A write blocks while the sink is blocked.
+type fakeSink struct {
+	mu      sync.Mutex
+	events  []*querylogpb.Event
+	failing bool
+	blocked chan struct{}
+}
+
+func (fs *fakeSink) Write(ctx context.Context, events []*querylogpb.Event) error {
+	if fs.blocked != nil {
+		<-fs.blocked
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.failing {
+		return fmt.Errorf("sink unavailable")
+	}
+	fs.events = append(fs.events, events...)
+	return nil
+}
+
+func (fs *fakeSink) Close() error {
+	return nil
+}
+
+func (fs *fakeSink) delivered() []string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var fingerprints []string
+	for _, ev := range fs.events {
+		fingerprints = append(fingerprints, ev.Fingerprint)
+	}
+	return fingerprints
+}
+
+var fakeSinks = struct {
+	mu    sync.Mutex
+	sinks map[string]*fakeSink
+}{sinks: make(map[string]*fakeSink)}
+
+// newFakeSink returns the fake sink of the host of the URL.
+func newFakeSink(u *url.URL) (Sink, error) {
+	fakeSinks.mu.Lock()
+	defer fakeSinks.mu.Unlock()
+	return fakeSinks.sinks[u.Host], nil
+}
+
+func setFakeSink(host string, sink *fakeSink) {
+	fakeSinks.mu.Lock()
+	defer fakeSinks.mu.Unlock()
+	fakeSinks.sinks[host] = sink
+}
+
+func init() {
+	RegisterSink("fake", newFakeSink)
+}
+
+func sendEvents(s *Streamer, fingerprints ...string) {
+	for _, fingerprint := range fingerprints {
+		s.Send(&querylogpb.Event{Fingerprint: fingerprint})
+	}
+}
+
+func TestStreamer(t *testing.T) {
+	all := &fakeSink{}
+	setFakeSink("all", all)
+	slow := &fakeSink{}
+	setFakeSink("slow", slow)
+	orders := &fakeSink{}
+	setFakeSink("orders", orders)
+	s, err := New([]string{"fake://all", "fake://slow?min_latency=1s", "fake://orders?tables=orders,order_items"}, 10)
+	require.NoError(t, err)
+
+	s.Send(&querylogpb.Event{Fingerprint: "fast", Tables: []string{"orders"}, TotalTime: protoutil.DurationToProto(time.Millisecond)})
+	s.Send(&querylogpb.Event{Fingerprint: "slow", Tables: []string{"users"}, TotalTime: protoutil.DurationToProto(2 * time.Second)})
+	s.Send(&querylogpb.Event{Fingerprint: "join", Tables: []string{"users", "order_items"}})
+	s.Close()
+
+	assert.Equal(t, []string{"fast", "slow", "join"}, all.delivered())
+	assert.Equal(t, []string{"slow"}, slow.delivered())
+	assert.Equal(t, []string{"fast", "join"}, orders.delivered())
+	assert.NotEmpty(t, all.events[0].Hostname)
+}
+
+func TestStreamerDrops(t *testing.T) {
+	failing := &fakeSink{failing: true}
+	setFakeSink("failing", failing)
+	blocked := &fakeSink{blocked: make(chan struct{})}
+	setFakeSink("blocked", blocked)
+	s, err := New([]string{"fake://failing", "fake://blocked"}, 1)
+	require.NoError(t, err)
+
+	// The events a sink fails to deliver are dropped.
+	dropped := droppedCount.Counts()["fake://failing"]
+	failures := errorCount.Counts()["fake://failing"]
+	sendEvents(s, "a")
+	assert.Eventually(t, func() bool {
+		return droppedCount.Counts()["fake://failing"]-dropped == 1
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, int64(1), errorCount.Counts()["fake://failing"]-failures)
+
+	// The events which do not fit in the buffer of a blocked sink are
+	// dropped.
+	dropped = droppedCount.Counts()["fake://blocked"]
+	sendEvents(s, "b", "c", "d")
+	assert.GreaterOrEqual(t, droppedCount.Counts()["fake://blocked"]-dropped, int64(1))
+	close(blocked.blocked)
+	s.Close()
+	assert.Contains(t, blocked.delivered(), "a")
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := New([]string{"unknown://host"}, 1)
+	assert.EqualError(t, err, `unknown query log sink "unknown"`)
+	_, err = New([]string{"fake://host?min_latency=soon"}, 1)
+	assert.EqualError(t, err, `invalid filter of query log sink fake://host?min_latency=soon: time: invalid duration "soon"`)
+	_, err = New([]string{"kafka://host"}, 1)
+	assert.EqualError(t, err, "can't create query log sink kafka://host: expected kafka://host:port/topic, got kafka://host")
+}
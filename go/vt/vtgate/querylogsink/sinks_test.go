@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package querylogsink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/kafkarest"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+var testEvents = []*querylogpb.Event{{
+	Hostname:          "host",
+	Method:            "Execute",
+	Keyspace:          "ks",
+	StmtType:          "SELECT",
+	Fingerprint:       "select * from t1 where id = :vtg1",
+	Tables:            []string{"t1"},
+	BindVariableTypes: map[string]querypb.Type{"vtg1": querypb.Type_INT64},
+	Shards:            []string{"ks/-80"},
+	ShardQueries:      1,
+	RowsReturned:      1,
+	TotalTime:         protoutil.DurationToProto(time.Millisecond),
+}, {
+	Hostname:    "host",
+	Method:      "Execute",
+	StmtType:    "INSERT",
+	Fingerprint: "insert into t2 values (:vtg1)",
+	Error:       "duplicate entry",
+}}
+
+func newTestSink(t *testing.T, rawURL string) Sink {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	sink, err := sinkFactories[u.Scheme](u)
+	require.NoError(t, err)
+	return sink
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "querylog.json")
+	sink := newTestSink(t, "file://"+path)
+	require.NoError(t, sink.Write(context.Background(), testEvents))
+	require.NoError(t, sink.Write(context.Background(), testEvents[:1]))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		ev := &querylogpb.Event{}
+		require.NoError(t, protojson.Unmarshal([]byte(line), ev))
+		utils.MustMatch(t, testEvents[i%2], ev)
+	}
+}
+
+func TestKafkaSink(t *testing.T) {
+	var body struct {
+		Records []kafkarest.Record `json:"records"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/querylog", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"offsets":[{"partition":0,"offset":1},{"partition":0,"offset":2}]}`))
+	}))
+	defer server.Close()
+
+	sink := newTestSink(t, "kafka://"+strings.TrimPrefix(server.URL, "http://")+"/querylog")
+	defer sink.Close()
+	require.NoError(t, sink.Write(context.Background(), testEvents))
+	require.Len(t, body.Records, 2)
+	assert.Equal(t, testEvents[0].Fingerprint, body.Records[0].Key)
+	ev := &querylogpb.Event{}
+	require.NoError(t, protojson.Unmarshal(body.Records[1].Value, ev))
+	utils.MustMatch(t, testEvents[1], ev)
+}
+
+type fakeQueryLogServer struct {
+	querylogpb.UnimplementedQueryLogServer
+	events chan *querylogpb.Event
+}
+
+func (s *fakeQueryLogServer) Publish(stream querylogpb.QueryLog_PublishServer) error {
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&querylogpb.PublishResponse{})
+		}
+		if err != nil {
+			return err
+		}
+		s.events <- ev
+	}
+}
+
+func TestGRPCSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collector := &fakeQueryLogServer{events: make(chan *querylogpb.Event, 10)}
+	querylogpb.RegisterQueryLogServer(server, collector)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	// The events of the writes are sent on the same stream.
+	sink := newTestSink(t, "grpc://"+listener.Addr().String())
+	require.NoError(t, sink.Write(context.Background(), testEvents[:1]))
+	require.NoError(t, sink.Write(context.Background(), testEvents[1:]))
+	require.NoError(t, sink.Close())
+	for _, want := range testEvents {
+		utils.MustMatch(t, want, <-collector.events)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// gtidExecutedQuery reads the GTID set a primary has executed.
+const gtidExecutedQuery = "select @@global.gtid_executed"
+
+var (
+	readYourWritesTimeout = flag.Duration("read_your_writes_timeout", 1*time.Second, "With the read_your_writes session option, how long a replica may wait to catch up with the writes of the session before the read falls back to the primary, unless the session sets read_after_write_timeout.")
+
+	readYourWritesFallbacks = stats.NewCountersWithSingleLabel("ReadYourWritesPrimaryFallbacks", "Replica reads of read_your_writes sessions sent to the primary because the replica had not caught up in time, by keyspace", "Keyspace")
+)
+
+// recordGTIDSets records the GTID sets the primaries of the shards have
+// executed after a write of a read_your_writes session, for the replica reads
+// of the session to wait for. Failing to read a GTID set does not fail the
+// write, which has been committed already.
+func recordGTIDSets(ctx context.Context, session *SafeSession, targets []*querypb.Target, qs queryservice.QueryService) {
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		if target.TabletType != topodatapb.TabletType_PRIMARY {
+			continue
+		}
+		wg.Add(1)
+		go func(target *querypb.Target) {
+			defer wg.Done()
+			gtidSet, err := readGTIDSet(ctx, qs, target)
+			if err != nil {
+				log.Warningf("read_your_writes: cannot read the GTID set of %s/%s: %v", target.Keyspace, target.Shard, err)
+				return
+			}
+			session.SetGTIDSet(target.Keyspace, target.Shard, gtidSet)
+		}(target)
+	}
+	wg.Wait()
+}
+
+func readGTIDSet(ctx context.Context, qs queryservice.QueryService, target *querypb.Target) (string, error) {
+	qr, err := qs.Execute(ctx, target, gtidExecutedQuery, nil, 0, 0, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s: %v", gtidExecutedQuery, qr.Rows)
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
+
+// readAfterWriteOptions returns the options for a read outside of a
+// transaction from a replica of a shard a read_your_writes session wrote to,
+// which make the replica wait until it has executed the writes of the session.
+// It returns nil if the read does not need to wait.
+func readAfterWriteOptions(session *SafeSession, target *querypb.Target, opts *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	if target.TabletType == topodatapb.TabletType_PRIMARY {
+		return nil
+	}
+	gtidSet := session.GetGTIDSet(target.Keyspace, target.Shard)
+	if gtidSet == "" {
+		return nil
+	}
+	timeout := *readYourWritesTimeout
+	if seconds := session.GetReadAfterWrite().GetReadAfterWriteTimeout(); seconds > 0 {
+		timeout = time.Duration(seconds * float64(time.Second))
+	}
+	waitOpts := &querypb.ExecuteOptions{}
+	if opts != nil {
+		waitOpts = proto.Clone(opts).(*querypb.ExecuteOptions)
+	}
+	waitOpts.WaitForGtidSet = gtidSet
+	waitOpts.WaitForGtidSetTimeout = timeout.Milliseconds()
+	return waitOpts
+}
+
+// shouldReadFromPrimary returns whether a read which waited for a replica to
+// catch up with the writes of the session falls back to the primary.
+func shouldReadFromPrimary(err error) bool {
+	return vterrors.Code(err) == vtrpcpb.Code_FAILED_PRECONDITION
+}
+
+func primaryTarget(target *querypb.Target) *querypb.Target {
+	primary := proto.Clone(target).(*querypb.Target)
+	primary.TabletType = topodatapb.TabletType_PRIMARY
+	return primary
+}
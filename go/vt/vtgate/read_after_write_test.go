@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestReadYourWritesReplicaReads(t *testing.T) {
+	keyspace := "TestReadYourWritesReplicaReads"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	primary := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	replica := hc.AddTestTablet("aa", "1", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+
+	rss := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sc.gateway,
+	}}
+	queries := []*querypb.BoundQuery{{Sql: "select 1"}}
+	session := NewSafeSession(&vtgatepb.Session{
+		Options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLTP},
+	})
+
+	// The reads do not wait until the session writes.
+	_, errs := sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.Empty(t, replica.Options[0].GetWaitForGtidSet())
+
+	session.SetReadYourWrites(true)
+	session.SetGTIDSet(keyspace, "0", "uuid:1-5")
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	require.Len(t, replica.Options, 2)
+	assert.Equal(t, "uuid:1-5", replica.Options[1].WaitForGtidSet)
+	assert.EqualValues(t, 1000, replica.Options[1].WaitForGtidSetTimeout)
+	assert.Equal(t, querypb.ExecuteOptions_OLTP, replica.Options[1].Workload)
+	// The options of the session do not change.
+	assert.Empty(t, session.Options.WaitForGtidSet)
+
+	session.SetReadAfterWriteTimeout(0.25)
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.EqualValues(t, 250, replica.Options[2].WaitForGtidSetTimeout)
+
+	// The read falls back to the primary when the replica has not caught up.
+	replica.MustFailCodes[vtrpcpb.Code_FAILED_PRECONDITION] = 1
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	require.Len(t, primary.Queries, 1)
+	assert.Empty(t, primary.Options[0].GetWaitForGtidSet())
+	assert.EqualValues(t, 1, readYourWritesFallbacks.Counts()[keyspace])
+
+	replica.MustFailCodes[vtrpcpb.Code_FAILED_PRECONDITION] = 1
+	errs = sc.StreamExecuteMulti(ctx, "select 1", rss, []map[string]*querypb.BindVariable{nil}, session, false, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, vterrors.Aggregate(errs))
+	require.Len(t, primary.Queries, 2)
+	assert.EqualValues(t, 2, readYourWritesFallbacks.Counts()[keyspace])
+
+	// Turning the option off forgets the writes of the session.
+	session.SetReadYourWrites(false)
+	assert.Empty(t, session.GetGTIDSet(keyspace, "0"))
+}
+
+func TestReadYourWritesCommit(t *testing.T) {
+	keyspace := "TestReadYourWritesCommit"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, keyspace, "1", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	sbc0.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid_executed", "varchar"), "uuid0:1-5")})
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid_executed", "varchar"), "uuid1:1-7")})
+
+	session := NewSafeSession(&vtgatepb.Session{
+		InTransaction:   true,
+		TransactionMode: vtgatepb.TransactionMode_MULTI,
+		ReadAfterWrite:  &vtgatepb.ReadAfterWrite{ReadYourWrites: true},
+		ShardSessions: []*vtgatepb.Session_ShardSession{{
+			Target:        &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_PRIMARY},
+			TabletAlias:   sbc0.Tablet().Alias,
+			TransactionId: 1,
+		}, {
+			Target:        &querypb.Target{Keyspace: keyspace, Shard: "1", TabletType: topodatapb.TabletType_PRIMARY},
+			TabletAlias:   sbc1.Tablet().Alias,
+			TransactionId: 1,
+		}},
+	})
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Equal(t, map[string]string{
+		keyspace + "/0": "uuid0:1-5",
+		keyspace + "/1": "uuid1:1-7",
+	}, session.ReadAfterWrite.GtidSets)
+	assert.Equal(t, gtidExecutedQuery, sbc0.Queries[0].Sql)
+}
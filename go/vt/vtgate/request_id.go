@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	generateRequestIDs = flag.Bool("enable_request_id", false, "Generate a request ID for the queries which do not carry one in a /* request_id:<id> */ comment. The request ID is sent to the tablets and MySQL in that comment, and is included in the query logs and in the errors.")
+
+	// RequestLogHandler is the debug UI path for searching the recent query logs by request ID
+	RequestLogHandler = "/debug/request_log"
+)
+
+// withRequestID returns the query with a leading comment carrying its request
+// ID, along with the request ID. The request ID the query carries is kept;
+// otherwise one is generated if enabled.
+func withRequestID(sql string) (string, string) {
+	_, comments := sqlparser.SplitMarginComments(sql)
+	if requestID := comments.RequestID(); requestID != "" {
+		return sql, requestID
+	}
+	if !*generateRequestIDs {
+		return sql, ""
+	}
+	requestID := uuid.New().String()
+	return sqlparser.RequestIDComment(requestID) + sql, requestID
+}
+
+// errorWithRequestID adds the request ID of a query to its error, unless
+// the tablet which returned the error already did.
+func errorWithRequestID(err error, requestID string) error {
+	if err == nil || requestID == "" {
+		return err
+	}
+	suffix := fmt.Sprintf("(RequestID: %s)", requestID)
+	if strings.Contains(err.Error(), suffix) {
+		return err
+	}
+	return vterrors.Errorf(vterrors.Code(err), "%v %s", err, suffix)
+}
+
+// matchRequestID returns whether a query log has the request ID of the request.
+func matchRequestID(params url.Values, message interface{}) bool {
+	stats, ok := message.(*LogStats)
+	return ok && stats.RequestID != "" && stats.RequestID == params.Get("request_id")
+}
@@ -75,6 +75,7 @@ func (res *Resolver) Execute(
 	}
 	if logStats != nil {
 		logStats.ShardQueries = uint64(len(rss))
+		logStats.AddShards(rss)
 	}
 
 	autocommit := len(rss) == 1 && canAutocommit && session.AutocommitApproval()
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	retryCodes   = flagutil.StringListValue{"UNAVAILABLE", "FAILED_PRECONDITION", "CLUSTER_EVENT"}
+	retryBackoff = flag.Duration("retry_backoff", 0, "delay before the first retry of a query on another tablet, doubling with every retry")
+	hedgeDelay   = flag.Duration("retry_hedge_delay", 0, "if set, a read of a replica outside of a transaction which did not get an answer within this delay is also sent to another replica, and the first answer is returned")
+
+	hedgedQueries = stats.NewCountersWithMultiLabels(
+		"GatewayHedgedQueries",
+		"Number of reads the gateway sent to a second replica because the first one did not answer within the hedge delay",
+		[]string{"Keyspace", "ShardName", "TabletType"})
+	hedgedQueriesWon = stats.NewCountersWithMultiLabels(
+		"GatewayHedgedQueriesWon",
+		"Number of hedged reads answered first by the second replica",
+		[]string{"Keyspace", "ShardName", "TabletType"})
+)
+
+func init() {
+	flag.Var(&retryCodes, "retry_codes", "comma separated list of the error codes for which a query is retried on another tablet")
+}
+
+// retryPolicy is how the gateway retries the queries it sends to the tablets.
+type retryPolicy struct {
+	// maxAttempts is the maximum number of tablets a query is sent to.
+	maxAttempts int
+	// codes are the error codes retried on another tablet.
+	codes []vtrpcpb.Code
+	// backoff is the delay before the first retry, doubling with every
+	// retry.
+	backoff time.Duration
+	// hedgeDelay, if set, is the delay after which the reads of the
+	// replicas are also sent to another replica.
+	hedgeDelay time.Duration
+}
+
+// newRetryPolicyFromFlags returns the retry policy of the vtgate flags.
+func newRetryPolicyFromFlags() (retryPolicy, error) {
+	codes, err := parseRetryCodes(retryCodes)
+	if err != nil {
+		return retryPolicy{}, err
+	}
+	return retryPolicy{
+		maxAttempts: *RetryCount + 1,
+		codes:       codes,
+		backoff:     *retryBackoff,
+		hedgeDelay:  *hedgeDelay,
+	}, nil
+}
+
+func parseRetryCodes(names []string) ([]vtrpcpb.Code, error) {
+	codes := make([]vtrpcpb.Code, 0, len(names))
+	for _, name := range names {
+		code, ok := vtrpcpb.Code_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("invalid retry code %q", name)
+		}
+		codes = append(codes, vtrpcpb.Code(code))
+	}
+	return codes, nil
+}
+
+// override returns the policy with the fields set by a session.
+func (p retryPolicy) override(session *querypb.RetryPolicy) retryPolicy {
+	if session == nil {
+		return p
+	}
+	if session.MaxAttempts > 0 {
+		p.maxAttempts = int(session.MaxAttempts)
+	}
+	if len(session.RetryCodes) != 0 {
+		p.codes = session.RetryCodes
+	}
+	if session.Backoff != 0 {
+		p.backoff = time.Duration(session.Backoff) * time.Millisecond
+	}
+	if session.HedgeDelay != 0 {
+		p.hedgeDelay = time.Duration(session.HedgeDelay) * time.Millisecond
+	}
+	return p
+}
+
+// wait waits for the backoff of the given retry, and returns false if the
+// context ended first.
+func (p retryPolicy) wait(ctx context.Context, retry int) bool {
+	if p.backoff <= 0 || retry < 1 {
+		return true
+	}
+	if retry > 16 {
+		retry = 16
+	}
+	timer := time.NewTimer(p.backoff << (retry - 1))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+type retryPolicyKey struct{}
+
+// withRetryPolicy returns a context making the gateway use the retry policy
+// of a session.
+func withRetryPolicy(ctx context.Context, policy *querypb.RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+func retryPolicyFromContext(ctx context.Context) *querypb.RetryPolicy {
+	policy, _ := ctx.Value(retryPolicyKey{}).(*querypb.RetryPolicy)
+	return policy
+}
+
+// hedgedTablets are the tablets used by the attempts of a hedged query, so
+// that its attempts go to different tablets.
+type hedgedTablets struct {
+	mu      sync.Mutex
+	aliases map[string]bool
+}
+
+type hedgedTabletsKey struct{}
+
+func withHedgedTablets(ctx context.Context) context.Context {
+	return context.WithValue(ctx, hedgedTabletsKey{}, &hedgedTablets{aliases: make(map[string]bool)})
+}
+
+func hedgedTabletsFromContext(ctx context.Context) *hedgedTablets {
+	ht, _ := ctx.Value(hedgedTabletsKey{}).(*hedgedTablets)
+	return ht
+}
+
+func (ht *hedgedTablets) add(alias string) {
+	if ht == nil {
+		return
+	}
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	ht.aliases[alias] = true
+}
+
+func (ht *hedgedTablets) has(alias string) bool {
+	if ht == nil {
+		return false
+	}
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	return ht.aliases[alias]
+}
+
+// hedge runs execute, and runs it a second time on another tablet if it did
+// not return within delay. It returns the first successful result, or the
+// error of the first attempt if both failed.
+func hedge(ctx context.Context, target *querypb.Target, delay time.Duration, execute func(context.Context) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	ctx, cancel := context.WithCancel(withHedgedTablets(ctx))
+	defer cancel()
+
+	type response struct {
+		qr     *sqltypes.Result
+		err    error
+		hedged bool
+	}
+	responses := make(chan response, 2)
+	run := func(hedged bool) {
+		qr, err := execute(ctx)
+		responses <- response{qr: qr, err: err, hedged: hedged}
+	}
+	go run(false)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	statsKey := []string{target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType)}
+	select {
+	case r := <-responses:
+		return r.qr, r.err
+	case <-timer.C:
+		hedgedQueries.Add(statsKey, 1)
+		go run(true)
+	}
+
+	var failed *response
+	for i := 0; i < 2; i++ {
+		r := <-responses
+		if r.err == nil {
+			if r.hedged {
+				hedgedQueriesWon.Add(statsKey, 1)
+			}
+			return r.qr, nil
+		}
+		if failed == nil || !r.hedged {
+			failed = &r
+		}
+	}
+	return failed.qr, failed.err
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestRetryPolicyOverride(t *testing.T) {
+	flags := retryPolicy{
+		maxAttempts: 3,
+		codes:       []vtrpcpb.Code{vtrpcpb.Code_UNAVAILABLE},
+		backoff:     time.Second,
+		hedgeDelay:  time.Second,
+	}
+	assert.Equal(t, flags, flags.override(nil))
+	assert.Equal(t, flags, flags.override(&querypb.RetryPolicy{}))
+	assert.Equal(t, retryPolicy{
+		maxAttempts: 1,
+		codes:       []vtrpcpb.Code{vtrpcpb.Code_ABORTED},
+		backoff:     -time.Millisecond,
+		hedgeDelay:  10 * time.Millisecond,
+	}, flags.override(&querypb.RetryPolicy{
+		MaxAttempts: 1,
+		RetryCodes:  []vtrpcpb.Code{vtrpcpb.Code_ABORTED},
+		Backoff:     -1,
+		HedgeDelay:  10,
+	}))
+}
+
+func TestParseRetryCodes(t *testing.T) {
+	codes, err := parseRetryCodes([]string{"unavailable", " ABORTED"})
+	require.NoError(t, err)
+	assert.Equal(t, []vtrpcpb.Code{vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_ABORTED}, codes)
+
+	_, err = parseRetryCodes([]string{"UNAVAILABLE", "NOPE"})
+	assert.EqualError(t, err, `invalid retry code "NOPE"`)
+}
+
+func TestRetryPolicyWait(t *testing.T) {
+	assert.True(t, retryPolicy{}.wait(context.Background(), 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, retryPolicy{backoff: time.Hour}.wait(ctx, 1))
+
+	start := time.Now()
+	assert.True(t, retryPolicy{backoff: 5 * time.Millisecond}.wait(context.Background(), 2))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(10*time.Millisecond))
+}
+
+func TestTabletGatewayRetryPolicy(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell", "1.1.1.1", 1002, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	// ABORTED is not retried by default.
+	sc1.MustFailCodes[vtrpcpb.Code_ABORTED] = 1
+	sc2.MustFailCodes[vtrpcpb.Code_ABORTED] = 1
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "ABORTED error", vtrpcpb.Code_ABORTED)
+	assert.EqualValues(t, 1, sc1.ExecCount.Get()+sc2.ExecCount.Get())
+
+	// The session retries ABORTED on the other tablet.
+	sc1.MustFailCodes[vtrpcpb.Code_ABORTED] = 1
+	sc2.MustFailCodes[vtrpcpb.Code_ABORTED] = 1
+	ctx := withRetryPolicy(context.Background(), &querypb.RetryPolicy{
+		RetryCodes: []vtrpcpb.Code{vtrpcpb.Code_ABORTED},
+	})
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "ABORTED error", vtrpcpb.Code_ABORTED)
+	assert.EqualValues(t, 3, sc1.ExecCount.Get()+sc2.ExecCount.Get())
+
+	// A single attempt does not retry.
+	sc1.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	sc2.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	ctx = withRetryPolicy(context.Background(), &querypb.RetryPolicy{MaxAttempts: 1})
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "UNAVAILABLE error", vtrpcpb.Code_UNAVAILABLE)
+	assert.EqualValues(t, 4, sc1.ExecCount.Get()+sc2.ExecCount.Get())
+
+	// The retry waits for the backoff.
+	sc1.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	sc2.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	ctx = withRetryPolicy(context.Background(), &querypb.RetryPolicy{Backoff: 20})
+	start := time.Now()
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "UNAVAILABLE error", vtrpcpb.Code_UNAVAILABLE)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(20*time.Millisecond))
+	assert.EqualValues(t, 6, sc1.ExecCount.Get()+sc2.ExecCount.Get())
+}
+
+func TestTabletGatewayHedgedTablets(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell", "1.1.1.1", 1002, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	// The attempt of a hedged query goes to the tablet the other attempt does
+	// not use.
+	for i := 0; i < 10; i++ {
+		ctx := withHedgedTablets(context.Background())
+		hedgedTabletsFromContext(ctx).add(topoproto.TabletAliasString(sc1.Tablet().Alias))
+		_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 0, sc1.ExecCount.Get())
+	assert.EqualValues(t, 10, sc2.ExecCount.Get())
+
+	// Only the reads of the replicas outside of transactions are hedged.
+	ctx := withRetryPolicy(context.Background(), &querypb.RetryPolicy{HedgeDelay: 10})
+	assert.False(t, tg.canHedge(context.Background(), target, 0, 0))
+	assert.True(t, tg.canHedge(ctx, target, 0, 0))
+	assert.False(t, tg.canHedge(ctx, target, 1, 0))
+	assert.False(t, tg.canHedge(ctx, target, 0, 1))
+	assert.False(t, tg.canHedge(withAsOf(ctx, time.Now()), target, 0, 0))
+	primary := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_PRIMARY}
+	assert.False(t, tg.canHedge(ctx, primary, 0, 0))
+}
+
+func TestHedge(t *testing.T) {
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	fast := &sqltypes.Result{RowsAffected: 1}
+	slow := &sqltypes.Result{RowsAffected: 2}
+
+	// A fast answer is not hedged.
+	calls := 0
+	qr, err := hedge(context.Background(), target, time.Hour, func(context.Context) (*sqltypes.Result, error) {
+		calls++
+		return fast, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fast, qr)
+	assert.Equal(t, 1, calls)
+
+	// The hedged attempt answers first, and the slow one is canceled.
+	attempts := make(chan int, 2)
+	attempts <- 1
+	attempts <- 2
+	qr, err = hedge(context.Background(), target, time.Millisecond, func(ctx context.Context) (*sqltypes.Result, error) {
+		if <-attempts == 1 {
+			<-ctx.Done()
+			return slow, ctx.Err()
+		}
+		return fast, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fast, qr)
+
+	// The error of the first attempt is returned if both fail.
+	attempts <- 1
+	attempts <- 2
+	_, err = hedge(context.Background(), target, time.Millisecond, func(ctx context.Context) (*sqltypes.Result, error) {
+		if <-attempts == 1 {
+			time.Sleep(10 * time.Millisecond)
+			return nil, errors.New("first")
+		}
+		return nil, errors.New("hedged")
+	})
+	assert.EqualError(t, err, "first")
+}
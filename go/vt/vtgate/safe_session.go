@@ -18,11 +18,14 @@ package vtgate
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -87,6 +90,7 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.ShardSessions = nil
 	newSession.PreSessions = nil
 	newSession.PostSessions = nil
+	newSession.TempTables = nil
 	newSession.Autocommit = true
 	newSession.Warnings = nil
 	return NewSafeSession(newSession)
@@ -102,12 +106,23 @@ func (session *SafeSession) ResetTx() {
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 	session.Savepoints = nil
 	if !session.Session.InReservedConn {
-		session.ShardSessions = nil
-		session.PreSessions = nil
-		session.PostSessions = nil
+		// The connections reserved for the temporary tables are kept.
+		session.ShardSessions = reservedShardSessions(session.ShardSessions)
+		session.PreSessions = reservedShardSessions(session.PreSessions)
+		session.PostSessions = reservedShardSessions(session.PostSessions)
 	}
 }
 
+func reservedShardSessions(shardSessions []*vtgatepb.Session_ShardSession) []*vtgatepb.Session_ShardSession {
+	var reserved []*vtgatepb.Session_ShardSession
+	for _, shardSession := range shardSessions {
+		if shardSession.ReservedId != 0 {
+			reserved = append(reserved, shardSession)
+		}
+	}
+	return reserved
+}
+
 // Reset clears the session
 func (session *SafeSession) Reset() {
 	session.mu.Lock()
@@ -120,6 +135,7 @@ func (session *SafeSession) Reset() {
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
+	session.TempTables = nil
 }
 
 // SavePoints returns the save points of the session. It's safe to use concurrently
@@ -237,7 +253,7 @@ func (session *SafeSession) AppendOrUpdate(shardSession *vtgatepb.Session_ShardS
 		// Should be unreachable
 		return vterrors.New(vtrpcpb.Code_INTERNAL, "[BUG] unexpected 'autocommitted' state in transaction")
 	}
-	if !(session.Session.InTransaction || session.Session.InReservedConn) {
+	if !(session.Session.InTransaction || session.Session.InReservedConn || len(session.TempTables) > 0) {
 		// Should be unreachable
 		return vterrors.New(vtrpcpb.Code_INTERNAL, "[BUG] current session neither in transaction nor in reserved connection")
 	}
@@ -346,11 +362,75 @@ func (session *SafeSession) SetOptions(options *querypb.ExecuteOptions) {
 	session.Options = options
 }
 
-// StoreSavepoint stores the savepoint and release savepoint queries in the session
-func (session *SafeSession) StoreSavepoint(sql string) {
+// ShardSessionsByCommitOrder returns the shard sessions of each commit order.
+func (session *SafeSession) ShardSessionsByCommitOrder() map[vtgatepb.CommitOrder][]*vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return map[vtgatepb.CommitOrder][]*vtgatepb.Session_ShardSession{
+		vtgatepb.CommitOrder_PRE:    session.PreSessions,
+		vtgatepb.CommitOrder_NORMAL: session.ShardSessions,
+		vtgatepb.CommitOrder_POST:   session.PostSessions,
+	}
+}
+
+// AddSavepoint records a savepoint of the transaction, replacing the savepoint
+// of the same name if any. The savepoints are replayed on the shards which
+// join the transaction later.
+func (session *SafeSession) AddSavepoint(name sqlparser.ColIdent) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	session.Savepoints = append(session.Savepoints, sql)
+	if i := session.findSavepoint(name); i >= 0 {
+		session.Savepoints = append(session.Savepoints[:i], session.Savepoints[i+1:]...)
+	}
+	session.Savepoints = append(session.Savepoints, savepointQuery(name))
+}
+
+// RollbackToSavepoint removes the savepoints recorded after the savepoint, as
+// a rollback to it does. It returns false if the savepoint does not exist.
+func (session *SafeSession) RollbackToSavepoint(name sqlparser.ColIdent) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	i := session.findSavepoint(name)
+	if i < 0 {
+		return false
+	}
+	session.Savepoints = session.Savepoints[:i+1]
+	return true
+}
+
+// ReleaseSavepoint removes the savepoint and the ones recorded after it, as
+// releasing it does. It returns false if the savepoint does not exist.
+func (session *SafeSession) ReleaseSavepoint(name sqlparser.ColIdent) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	i := session.findSavepoint(name)
+	if i < 0 {
+		return false
+	}
+	session.Savepoints = session.Savepoints[:i]
+	return true
+}
+
+// HasSavepoint returns true if the savepoint exists in the transaction.
+func (session *SafeSession) HasSavepoint(name sqlparser.ColIdent) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.findSavepoint(name) >= 0
+}
+
+func (session *SafeSession) findSavepoint(name sqlparser.ColIdent) int {
+	query := savepointQuery(name)
+	for i, savepoint := range session.Savepoints {
+		// Savepoint names are case insensitive.
+		if strings.EqualFold(savepoint, query) {
+			return i
+		}
+	}
+	return -1
+}
+
+func savepointQuery(name sqlparser.ColIdent) string {
+	return sqlparser.String(&sqlparser.Savepoint{Name: name})
 }
 
 // InReservedConn returns true if the session needs to execute on a dedicated connection
@@ -367,6 +447,50 @@ func (session *SafeSession) SetReservedConn(reservedConn bool) {
 	session.Session.InReservedConn = reservedConn
 }
 
+// AddTempTable records a temporary table created in the temp table keyspace.
+func (session *SafeSession) AddTempTable(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, table := range session.TempTables {
+		if table == name {
+			return
+		}
+	}
+	session.TempTables = append(session.TempTables, name)
+}
+
+// RemoveTempTable forgets a temporary table dropped from the temp table keyspace.
+func (session *SafeSession) RemoveTempTable(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for i, table := range session.TempTables {
+		if table == name {
+			session.TempTables = append(session.TempTables[:i], session.TempTables[i+1:]...)
+			return
+		}
+	}
+}
+
+// HasTempTable returns true if the session created the temporary table in the temp table keyspace.
+func (session *SafeSession) HasTempTable(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, table := range session.TempTables {
+		if table == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTempTables returns true if the session has temporary tables in the temp table keyspace,
+// which need a dedicated connection to the keyspace.
+func (session *SafeSession) HasTempTables() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return len(session.TempTables) > 0
+}
+
 // SetPreQueries returns the prequeries that need to be run when reserving a connection
 func (session *SafeSession) SetPreQueries() []string {
 	session.mu.Lock()
@@ -430,6 +554,7 @@ func (session *SafeSession) ResetAll() {
 	session.PreSessions = nil
 	session.PostSessions = nil
 	session.LockSession = nil
+	session.TempTables = nil
 }
 
 // ResetShard reset the shard session for the provided tablet alias.
@@ -529,6 +654,59 @@ func (session *SafeSession) SetSessionTrackGtids(enable bool) {
 	session.ReadAfterWrite.SessionTrackGtids = enable
 }
 
+// SetReadYourWrites sets the ReadYourWrites setting. Turning it off forgets
+// the GTID sets of the writes of the session.
+func (session *SafeSession) SetReadYourWrites(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.ReadAfterWrite == nil {
+		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
+	}
+	session.ReadAfterWrite.ReadYourWrites = enable
+	if !enable {
+		session.ReadAfterWrite.GtidSets = nil
+	}
+}
+
+// ReadYourWrites returns whether the replica reads of the session wait for
+// the writes of the session.
+func (session *SafeSession) ReadYourWrites() bool {
+	if session == nil {
+		return false
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadAfterWrite.GetReadYourWrites()
+}
+
+// SetGTIDSet records the GTID set the primary of a shard had executed after
+// a write of the session.
+func (session *SafeSession) SetGTIDSet(keyspace, shard, gtidSet string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.ReadAfterWrite == nil {
+		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
+	}
+	if session.ReadAfterWrite.GtidSets == nil {
+		session.ReadAfterWrite.GtidSets = make(map[string]string)
+	}
+	session.ReadAfterWrite.GtidSets[topoproto.KeyspaceShardString(keyspace, shard)] = gtidSet
+}
+
+// GetGTIDSet returns the GTID set a replica of the shard has to execute for
+// the replica reads of the session, if any.
+func (session *SafeSession) GetGTIDSet(keyspace, shard string) string {
+	if session == nil {
+		return ""
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.ReadAfterWrite.GetReadYourWrites() {
+		return ""
+	}
+	return session.ReadAfterWrite.GtidSets[topoproto.KeyspaceShardString(keyspace, shard)]
+}
+
 func removeShard(tabletAlias *topodatapb.TabletAlias, sessions []*vtgatepb.Session_ShardSession) ([]*vtgatepb.Session_ShardSession, error) {
 	idx := -1
 	for i, session := range sessions {
@@ -576,3 +754,31 @@ func (session *SafeSession) getSelectLimit() int {
 
 	return int(session.Options.SqlSelectLimit)
 }
+
+// sessionStats are the values returned by LAST_INSERT_ID(), FOUND_ROWS()
+// and ROW_COUNT() for a session.
+type sessionStats struct {
+	lastInsertID     uint64
+	foundRows        uint64
+	foundRowsHandled bool
+	rowCount         int64
+}
+
+// getSessionStats returns the values of LAST_INSERT_ID(), FOUND_ROWS()
+// and ROW_COUNT() of the session, so that setSessionStats can restore them
+// after the internal queries vtgate runs on behalf of the statement.
+func (session *SafeSession) getSessionStats() sessionStats {
+	return sessionStats{
+		lastInsertID:     session.LastInsertId,
+		foundRows:        session.FoundRows,
+		foundRowsHandled: session.foundRowsHandled,
+		rowCount:         session.RowCount,
+	}
+}
+
+func (session *SafeSession) setSessionStats(stats sessionStats) {
+	session.LastInsertId = stats.lastInsertID
+	session.FoundRows = stats.foundRows
+	session.foundRowsHandled = stats.foundRowsHandled
+	session.RowCount = stats.rowCount
+}
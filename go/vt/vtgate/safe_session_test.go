@@ -67,3 +67,38 @@ func TestPrequeries(t *testing.T) {
 		t.Errorf("got %v but wanted %v", preQueries, want)
 	}
 }
+
+func TestTempTables(t *testing.T) {
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session.AddTempTable("t1")
+	session.AddTempTable("t2")
+	session.AddTempTable("t1")
+	require.Equal(t, []string{"t1", "t2"}, session.TempTables)
+	require.True(t, session.HasTempTable("t1"))
+	require.False(t, session.HasTempTable("t3"))
+
+	reserved := &vtgatepb.Session_ShardSession{
+		Target:        &querypb.Target{Keyspace: "scratch", Shard: "0"},
+		TabletAlias:   &topodatapb.TabletAlias{Cell: "cell", Uid: 0},
+		TransactionId: 1,
+		ReservedId:    1,
+	}
+	require.NoError(t, session.AppendOrUpdate(reserved, vtgatepb.TransactionMode_MULTI))
+	require.NoError(t, session.AppendOrUpdate(&vtgatepb.Session_ShardSession{
+		Target:        &querypb.Target{Keyspace: "keyspace", Shard: "0"},
+		TabletAlias:   &topodatapb.TabletAlias{Cell: "cell", Uid: 1},
+		TransactionId: 1,
+	}, vtgatepb.TransactionMode_MULTI))
+
+	// The reserved connection of the temporary tables outlives the transaction.
+	session.ResetTx()
+	require.Equal(t, []*vtgatepb.Session_ShardSession{reserved}, session.ShardSessions)
+
+	session.RemoveTempTable("t1")
+	require.Equal(t, []string{"t2"}, session.TempTables)
+	require.True(t, session.HasTempTables())
+
+	session.ResetAll()
+	require.False(t, session.HasTempTables())
+	require.Empty(t, session.ShardSessions)
+}
@@ -124,7 +124,7 @@ func (stc *ScatterConn) startAction(name string, target *querypb.Target) (time.T
 	return startTime, statsKey
 }
 
-func (stc *ScatterConn) endAction(startTime time.Time, allErrors *concurrency.AllErrorRecorder, statsKey []string, err *error, session *SafeSession) {
+func (stc *ScatterConn) endAction(ctx context.Context, startTime time.Time, allErrors *concurrency.AllErrorRecorder, statsKey []string, err *error, session *SafeSession) {
 	if *err != nil {
 		allErrors.RecordError(*err)
 		// Don't increment the error counter for duplicate
@@ -139,6 +139,7 @@ func (stc *ScatterConn) endAction(startTime time.Time, allErrors *concurrency.Al
 		}
 	}
 	stc.timings.Record(statsKey, startTime)
+	addShardTime(ctx, statsKey[1], statsKey[2], time.Since(startTime))
 }
 
 type reset int
@@ -225,6 +226,14 @@ func (stc *ScatterConn) ExecuteMultiShard(
 
 			switch info.actionNeeded {
 			case nothing:
+				if waitOpts := readAfterWriteOptions(session, rs.Target, opts); waitOpts != nil && transactionID == 0 && reservedID == 0 {
+					innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, 0, 0, waitOpts)
+					if err != nil && shouldReadFromPrimary(err) {
+						readYourWritesFallbacks.Add(rs.Target.Keyspace, 1)
+						innerqr, err = rs.Gateway.Execute(ctx, primaryTarget(rs.Target), queries[i].Sql, queries[i].BindVariables, 0, 0, opts)
+					}
+					break
+				}
 				innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, info.transactionID, info.reservedID, opts)
 				if err != nil {
 					retryRequest(func() {
@@ -394,6 +403,15 @@ func (stc *ScatterConn) StreamExecuteMulti(
 
 			switch info.actionNeeded {
 			case nothing:
+				if waitOpts := readAfterWriteOptions(session, rs.Target, opts); waitOpts != nil && transactionID == 0 && reservedID == 0 {
+					// The replica fails the query before it streams any result if it has not caught up.
+					err = qs.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, 0, waitOpts, callback)
+					if err != nil && shouldReadFromPrimary(err) {
+						readYourWritesFallbacks.Add(rs.Target.Keyspace, 1)
+						err = rs.Gateway.StreamExecute(ctx, primaryTarget(rs.Target), query, bindVars[i], 0, 0, opts, callback)
+					}
+					break
+				}
 				err = qs.StreamExecute(ctx, rs.Target, query, bindVars[i], transactionID, reservedID, opts, callback)
 				if err != nil {
 					retryRequest(func() {
@@ -476,7 +494,7 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 	var mu sync.Mutex
 	fieldSent := false
 	lastErrors := newTimeTracker()
-	allErrors := stc.multiGo("MessageStream", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+	allErrors := stc.multiGo(ctx, "MessageStream", rss, func(rs *srvtopo.ResolvedShard, i int) error {
 		// This loop handles the case where a reparent happens, which can cause
 		// an individual stream to end. If we don't succeed on the retries for
 		// messageStreamGracePeriod, we abort and return an error.
@@ -549,6 +567,7 @@ func (stc *ScatterConn) GetHealthCheckCacheStatus() discovery.TabletsCacheStatus
 // shards in parallel. This does not handle any transaction state.
 // The action function must match the shardActionFunc2 signature.
 func (stc *ScatterConn) multiGo(
+	ctx context.Context,
 	name string,
 	rss []*srvtopo.ResolvedShard,
 	action shardActionFunc,
@@ -563,7 +582,7 @@ func (stc *ScatterConn) multiGo(
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		// Send a dummy session.
 		// TODO(sougou): plumb a real session through this call.
-		defer stc.endAction(startTime, allErrors, statsKey, &err, NewSafeSession(nil))
+		defer stc.endAction(ctx, startTime, allErrors, statsKey, &err, NewSafeSession(nil))
 		err = action(rs, i)
 	}
 
@@ -613,7 +632,7 @@ func (stc *ScatterConn) multiGoTransaction(
 	oneShard := func(rs *srvtopo.ResolvedShard, i int) {
 		var err error
 		startTime, statsKey := stc.startAction(name, rs.Target)
-		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
+		defer stc.endAction(ctx, startTime, allErrors, statsKey, &err, session)
 
 		shardActionInfo := actionInfo(rs.Target, session, autocommit)
 		updated, err := action(rs, i, shardActionInfo)
@@ -679,7 +698,7 @@ func (stc *ScatterConn) ExecuteLock(
 	)
 	allErrors := new(concurrency.AllErrorRecorder)
 	startTime, statsKey := stc.startAction("ExecuteLock", rs.Target)
-	defer stc.endAction(startTime, allErrors, statsKey, &err, session)
+	defer stc.endAction(ctx, startTime, allErrors, statsKey, &err, session)
 
 	if session == nil || session.Session == nil {
 		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "session cannot be nil")
@@ -755,7 +774,10 @@ func requireNewQS(err error, target *querypb.Target) bool {
 
 // actionInfo looks at the current session, and returns information about what needs to be done for this tablet
 func actionInfo(target *querypb.Target, session *SafeSession, autocommit bool) *shardActionInfo {
-	if !(session.InTransaction() || session.InReservedConn()) {
+	// The temporary tables of the session only need a dedicated connection
+	// to the temp table keyspace.
+	inReservedConn := session.InReservedConn() || (session.HasTempTables() && target.Keyspace == *tempTableKeyspace)
+	if !(session.InTransaction() || inReservedConn) {
 		return &shardActionInfo{}
 	}
 	// No need to protect ourselves from the race condition between
@@ -764,7 +786,7 @@ func actionInfo(target *querypb.Target, session *SafeSession, autocommit bool) *
 	// this at the same time.
 	transactionID, reservedID, alias := session.Find(target.Keyspace, target.Shard, target.TabletType)
 
-	shouldReserve := session.InReservedConn() && reservedID == 0
+	shouldReserve := inReservedConn && reservedID == 0
 	shouldBegin := session.InTransaction() && transactionID == 0 && !autocommit
 
 	var act = nothing
@@ -84,6 +84,8 @@ func (t *Tracker) LoadKeyspace(conn queryservice.QueryService, target *querypb.T
 	}
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	// the keyspace is reloaded from scratch, so that dropped tables are removed
+	delete(t.tables.m, target.Keyspace)
 	t.updateTables(target.Keyspace, res)
 	t.tracked[target.Keyspace].setLoaded(true)
 	log.Infof("finished loading schema for keyspace %s. Found %d tables", target.Keyspace, len(res.Rows))
@@ -124,7 +126,7 @@ func (t *Tracker) getKeyspaceUpdateController(th *discovery.TabletHealth) *updat
 }
 
 func (t *Tracker) newUpdateController() *updateController {
-	return &updateController{update: t.updateSchema, reloadKeyspace: t.initKeyspace, signal: t.signal, consumeDelay: t.consumeDelay}
+	return &updateController{update: t.updateSchema, reloadKeyspace: t.initKeyspace, applyDeltas: t.applySchemaDeltas, signal: t.signal, consumeDelay: t.consumeDelay}
 }
 
 func (t *Tracker) initKeyspace(th *discovery.TabletHealth) error {
@@ -191,21 +193,41 @@ func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
 	return true
 }
 
+// applySchemaDeltas replaces the tables changed by the schema changes of the
+// tablet health with the table definitions sent along with them.
+func (t *Tracker) applySchemaDeltas(th *discovery.TabletHealth) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, delta := range th.Stats.TableSchemaDeltas {
+		// dropped tables have no columns
+		t.tables.delete(th.Target.Keyspace, delta.Name)
+		if len(delta.Columns) == 0 {
+			continue
+		}
+		cols := make([]vindexes.Column, 0, len(delta.Columns))
+		for _, col := range delta.Columns {
+			cols = append(cols, newColumn(col.Name, col.DataType, col.CollationName))
+		}
+		t.tables.set(th.Target.Keyspace, delta.Name, cols)
+	}
+}
+
 func (t *Tracker) updateTables(keyspace string, res *sqltypes.Result) {
 	for _, row := range res.Rows {
 		tbl := row[0].ToString()
-		colName := row[1].ToString()
-		colType := row[2].ToString()
-		collation := row[3].ToString()
-
-		cType := sqlparser.ColumnType{Type: colType}
-		col := vindexes.Column{Name: sqlparser.NewColIdent(colName), Type: cType.SQLType(), CollationName: collation}
+		col := newColumn(row[1].ToString(), row[2].ToString(), row[3].ToString())
 		cols := t.tables.get(keyspace, tbl)
 
 		t.tables.set(keyspace, tbl, append(cols, col))
 	}
 }
 
+func newColumn(colName, colType, collation string) vindexes.Column {
+	cType := sqlparser.ColumnType{Type: colType}
+	return vindexes.Column{Name: sqlparser.NewColIdent(colName), Type: cType.SQLType(), CollationName: collation}
+}
+
 // RegisterSignalReceiver allows a function to register to be called when new schema is available
 func (t *Tracker) RegisterSignalReceiver(f func()) {
 	t.mu.Lock()
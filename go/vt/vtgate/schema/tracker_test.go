@@ -263,6 +263,108 @@ func TestTrackingUnHealthyTablet(t *testing.T) {
 	require.Equal(t, []string{mysql.FetchTables, mysql.FetchUpdatedTables, mysql.FetchTables}, sbc.StringQueries())
 }
 
+func TestTrackingSchemaDeltas(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_PRIMARY,
+		Cell:       "aa",
+	}
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "aa", Uid: 1},
+		Keyspace: target.Keyspace,
+		Shard:    target.Shard,
+		Type:     target.TabletType,
+	}
+	fields := sqltypes.MakeTestFields(
+		"table_name|col_name|col_type|collation_name",
+		"varchar|varchar|varchar|varchar",
+	)
+
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	ch := make(chan *discovery.TabletHealth)
+	tracker := NewTracker(ch, nil)
+	tracker.consumeDelay = 1 * time.Millisecond
+	tracker.Start()
+	defer tracker.Stop()
+
+	signals := make(chan struct{}, 10)
+	tracker.RegisterSignalReceiver(func() {
+		signals <- struct{}{}
+	})
+	send := func(stats *querypb.RealtimeStats) {
+		ch <- &discovery.TabletHealth{
+			Conn:    sbc,
+			Tablet:  tablet,
+			Target:  target,
+			Serving: true,
+			Stats:   stats,
+		}
+	}
+	waitSignal := func() {
+		select {
+		case <-signals:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "schema was updated but received no signal")
+		}
+	}
+
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "t1|id|int|", "t2|id|int|"),
+		sqltypes.MakeTestResult(fields, "t1|id|int|", "t1|name|varchar|utf8_bin", "t3|id|datetime|", "t4|id|int|"),
+	})
+
+	// initial load
+	send(&querypb.RealtimeStats{SchemaVersion: 10})
+	waitSignal()
+
+	// the schema changes are applied from their table definitions
+	send(&querypb.RealtimeStats{
+		SchemaVersion:      11,
+		TableSchemaChanged: []string{"t1", "t2", "t3"},
+		TableSchemaDeltas: []*querypb.TableSchemaDelta{{
+			Name: "t1",
+			Columns: []*querypb.ColumnSchema{
+				{Name: "id", DataType: "int"},
+				{Name: "name", DataType: "varchar", CollationName: "utf8_bin"},
+			},
+		}, {
+			Name: "t2",
+		}, {
+			Name:    "t3",
+			Columns: []*querypb.ColumnSchema{{Name: "id", DataType: "datetime"}},
+		}},
+	})
+	waitSignal()
+	require.Equal(t, []string{mysql.FetchTables}, sbc.StringQueries())
+	utils.MustMatch(t, map[string][]vindexes.Column{
+		"t1": {
+			{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT32},
+			{Name: sqlparser.NewColIdent("name"), Type: querypb.Type_VARCHAR, CollationName: "utf8_bin"}},
+		"t3": {
+			{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_DATETIME}},
+	}, tracker.Tables("ks"))
+
+	// the schema change of version 12 was missed, so the keyspace is reloaded
+	send(&querypb.RealtimeStats{SchemaVersion: 11})
+	send(&querypb.RealtimeStats{
+		SchemaVersion:      13,
+		TableSchemaChanged: []string{"t4"},
+		TableSchemaDeltas:  []*querypb.TableSchemaDelta{{Name: "t4", Columns: []*querypb.ColumnSchema{{Name: "id", DataType: "int"}}}},
+	})
+	waitSignal()
+	require.Equal(t, []string{mysql.FetchTables, mysql.FetchTables}, sbc.StringQueries())
+	utils.MustMatch(t, map[string][]vindexes.Column{
+		"t1": {
+			{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT32},
+			{Name: sqlparser.NewColIdent("name"), Type: querypb.Type_VARCHAR, CollationName: "utf8_bin"}},
+		"t3": {
+			{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_DATETIME}},
+		"t4": {
+			{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT32}},
+	}, tracker.Tables("ks"))
+}
+
 func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
 	c := make(chan struct{})
 	go func() {
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 
@@ -38,11 +40,15 @@ type (
 		consumeDelay   time.Duration
 		update         func(th *discovery.TabletHealth) bool
 		reloadKeyspace func(th *discovery.TabletHealth) error
+		applyDeltas    func(th *discovery.TabletHealth)
 		signal         func()
 		loaded         bool
 
 		// we'll only log a failed keyspace loading once
 		ignore bool
+
+		// schema version last received from each primary tablet
+		versions map[string]int64
 	}
 )
 
@@ -123,6 +129,20 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 		return
 	}
 
+	// The schema changes sent with their table definitions are applied right away,
+	// without going through the queue.
+	if u.enqueue(th) {
+		u.applyDeltas(th)
+		if u.signal != nil {
+			u.signal()
+		}
+	}
+}
+
+// enqueue queues the tablet health for a schema update if needed. It returns true
+// if the schema changes of the tablet health are to be applied from their table
+// definitions instead.
+func (u *updateController) enqueue(th *discovery.TabletHealth) bool {
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
@@ -130,12 +150,7 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 	// The connection will get reset and the tracker needs to reload the schema for the keyspace.
 	if !th.Serving {
 		u.loaded = false
-		return
-	}
-
-	// If the keyspace schema is loaded and there is no schema change detected. Then there is nothing to process.
-	if len(th.Stats.TableSchemaChanged) == 0 && u.loaded {
-		return
+		return false
 	}
 
 	if len(th.Stats.TableSchemaChanged) > 0 && u.ignore {
@@ -146,7 +161,33 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 
 	if u.ignore {
 		// keyspace marked as not working correctly, so we are ignoring it for now
-		return
+		return false
+	}
+
+	// Tablets which version their schema changes send the table definitions along
+	// with them. The keyspace is only reloaded if schema changes were missed.
+	if th.Stats.SchemaVersion != 0 {
+		alias := topoproto.TabletAliasString(th.Tablet.Alias)
+		version, known := u.versions[alias]
+		if u.versions == nil {
+			u.versions = make(map[string]int64)
+		}
+		u.versions[alias] = th.Stats.SchemaVersion
+		if u.loaded {
+			if known && th.Stats.SchemaVersion == version {
+				return false
+			}
+			if (!known || th.Stats.SchemaVersion == version+1) && len(th.Stats.TableSchemaDeltas) == len(th.Stats.TableSchemaChanged) {
+				return len(th.Stats.TableSchemaDeltas) > 0
+			}
+			log.Infof("schema version of tablet %s went from %d to %d, reloading the schema of keyspace %s", alias, version, th.Stats.SchemaVersion, th.Target.Keyspace)
+			u.loaded = false
+		}
+	}
+
+	// If the keyspace schema is loaded and there is no schema change detected. Then there is nothing to process.
+	if len(th.Stats.TableSchemaChanged) == 0 && u.loaded {
+		return false
 	}
 
 	if u.queue == nil {
@@ -154,6 +195,7 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 		go u.consume()
 	}
 	u.queue.items = append(u.queue.items, th)
+	return false
 }
 
 func (u *updateController) setLoaded(loaded bool) {
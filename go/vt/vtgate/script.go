@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/sysvars"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// executeScript executes the statements of a script in a transaction. The
+// transaction is rolled back if a statement fails or aborts the script, so
+// that the script is either fully applied or not at all.
+func (vtg *VTGate) executeScript(ctx context.Context, safeSession *SafeSession, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) ([]*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	if safeSession.InTransaction() {
+		return nil, nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "cannot execute a script in a transaction")
+	}
+	if err := validateScript(statements, variables); err != nil {
+		return nil, nil, err
+	}
+
+	vars := make(map[string]*querypb.BindVariable, len(variables))
+	for name, bv := range variables {
+		vars[name] = bv
+	}
+
+	if _, err := vtg.executor.Execute(ctx, "ExecuteScript", safeSession, "begin", nil); err != nil {
+		return nil, nil, err
+	}
+	results := make([]*sqltypes.Result, 0, len(statements))
+	for i, stmt := range statements {
+		qr, err := vtg.executeScriptStatement(ctx, safeSession, stmt, vars)
+		if err != nil {
+			vtg.rollbackScript(ctx, safeSession)
+			return nil, nil, vterrors.Wrapf(err, "statement %d of the script", i+1)
+		}
+		results = append(results, qr)
+	}
+	if _, err := vtg.executor.Execute(ctx, "ExecuteScript", safeSession, "commit", nil); err != nil {
+		vtg.rollbackScript(ctx, safeSession)
+		return nil, nil, err
+	}
+	return results, vars, nil
+}
+
+// executeScriptStatement executes a statement of a script, assigns the
+// variables of the script from its result and checks its abort condition.
+func (vtg *VTGate) executeScriptStatement(ctx context.Context, safeSession *SafeSession, stmt *vtgatepb.ScriptStatement, vars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	bindVars := make(map[string]*querypb.BindVariable, len(vars)+len(stmt.BindVariables))
+	for name, bv := range vars {
+		bindVars[name] = bv
+	}
+	for name, bv := range stmt.BindVariables {
+		bindVars[name] = bv
+	}
+	qr, err := vtg.executor.Execute(ctx, "ExecuteScript", safeSession, stmt.Sql, bindVars)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmt.Assign) > len(qr.Fields) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot assign %d variables from a result of %d columns", len(stmt.Assign), len(qr.Fields))
+	}
+	for i, name := range stmt.Assign {
+		if name == "" {
+			continue
+		}
+		if len(qr.Rows) == 0 {
+			vars[name] = sqltypes.NullBindVariable
+			continue
+		}
+		vars[name] = sqltypes.ValueBindVariable(qr.Rows[0][i])
+	}
+
+	empty := len(qr.Rows) == 0 && qr.RowsAffected == 0
+	switch stmt.AbortIf {
+	case vtgatepb.ScriptStatement_EMPTY:
+		if empty {
+			return nil, abortScriptError(stmt)
+		}
+	case vtgatepb.ScriptStatement_NOT_EMPTY:
+		if !empty {
+			return nil, abortScriptError(stmt)
+		}
+	}
+	return qr, nil
+}
+
+func (vtg *VTGate) rollbackScript(ctx context.Context, safeSession *SafeSession) {
+	if _, err := vtg.executor.Execute(ctx, "ExecuteScript", safeSession, "rollback", nil); err != nil {
+		log.Warningf("Failed to roll back the transaction of a script: %v", err)
+	}
+}
+
+func abortScriptError(stmt *vtgatepb.ScriptStatement) error {
+	if stmt.AbortMessage == "" {
+		return vterrors.New(vtrpcpb.Code_ABORTED, "script aborted")
+	}
+	return vterrors.Errorf(vtrpcpb.Code_ABORTED, "script aborted: %s", stmt.AbortMessage)
+}
+
+// validateScript checks the bind variables of the script, and rejects the
+// statements which would end its transaction. Setting autocommit is
+// rejected whatever the value, since enabling it commits the transaction.
+func validateScript(statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) error {
+	if err := sqltypes.ValidateBindVariables(variables); err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+	for i, stmt := range statements {
+		if err := sqltypes.ValidateBindVariables(stmt.BindVariables); err != nil {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "statement %d of the script: %v", i+1, err)
+		}
+		switch sqlparser.Preview(stmt.Sql) {
+		case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtDDL, sqlparser.StmtLockTables, sqlparser.StmtUnlockTables:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "statement %d of the script: %s is not allowed in a script", i+1, stmt.Sql)
+		case sqlparser.StmtSet:
+			setsAutocommit, err := setsAutocommit(stmt.Sql)
+			if err != nil {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "statement %d of the script: %v", i+1, err)
+			}
+			if setsAutocommit {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "statement %d of the script: %s is not allowed in a script", i+1, stmt.Sql)
+			}
+		}
+	}
+	return nil
+}
+
+// setsAutocommit returns true if the set statement sets autocommit, in any
+// scope.
+func setsAutocommit(sql string) (bool, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return false, err
+	}
+	set, ok := stmt.(*sqlparser.Set)
+	if !ok {
+		return false, nil
+	}
+	for _, expr := range set.Exprs {
+		name := strings.ToLower(strings.TrimPrefix(expr.Name.String(), "@@"))
+		for _, scope := range []string{"session.", "local.", "global.", "persist.", "persist_only."} {
+			name = strings.TrimPrefix(name, scope)
+		}
+		if name == sysvars.Autocommit.Name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
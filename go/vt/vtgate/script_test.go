@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestVTGateExecuteScript(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "5|a"),
+		{RowsAffected: 1},
+	})
+
+	session, results, vars, err := rpcVTGate.ExecuteScript(
+		context.Background(),
+		&vtgatepb.Session{TargetString: "@primary"},
+		[]*vtgatepb.ScriptStatement{{
+			Sql:    "select id, name from t1 where name = :name",
+			Assign: []string{"id"},
+		}, {
+			Sql:           "update t1 set v = :v where id = :id",
+			BindVariables: map[string]*querypb.BindVariable{"v": sqltypes.Int64BindVariable(3)},
+			AbortIf:       vtgatepb.ScriptStatement_EMPTY,
+		}},
+		map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("a")},
+	)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.EqualValues(t, 1, results[1].RowsAffected)
+	utils.MustMatch(t, map[string]*querypb.BindVariable{
+		"name": sqltypes.StringBindVariable("a"),
+		"id":   sqltypes.Int64BindVariable(5),
+	}, vars, "")
+	assert.False(t, session.InTransaction)
+
+	require.Len(t, sbc.Queries, 2)
+	utils.MustMatch(t, sqltypes.Int64BindVariable(5), sbc.Queries[1].BindVariables["id"], "")
+	utils.MustMatch(t, sqltypes.Int64BindVariable(3), sbc.Queries[1].BindVariables["v"], "")
+	assert.EqualValues(t, 1, sbc.CommitCount.Get())
+	assert.EqualValues(t, 0, sbc.RollbackCount.Get())
+}
+
+func TestVTGateExecuteScriptAborted(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64")),
+		{RowsAffected: 0},
+	})
+
+	session, _, vars, err := rpcVTGate.ExecuteScript(
+		context.Background(),
+		&vtgatepb.Session{TargetString: "@primary"},
+		[]*vtgatepb.ScriptStatement{{
+			Sql:    "select id from t1",
+			Assign: []string{"id"},
+		}, {
+			Sql:          "update t1 set v = 1 where id = :id",
+			AbortIf:      vtgatepb.ScriptStatement_EMPTY,
+			AbortMessage: "no row to update",
+		}},
+		nil,
+	)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "statement 2 of the script: script aborted: no row to update")
+	assert.Nil(t, vars)
+	assert.False(t, session.InTransaction)
+
+	// The variable was set to NULL since the select returned no row.
+	utils.MustMatch(t, sqltypes.NullBindVariable, sbc.Queries[1].BindVariables["id"], "")
+	assert.EqualValues(t, 0, sbc.CommitCount.Get())
+	assert.EqualValues(t, 1, sbc.RollbackCount.Get())
+}
+
+func TestVTGateExecuteScriptErrors(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+
+	tcases := []struct {
+		name       string
+		session    *vtgatepb.Session
+		statements []*vtgatepb.ScriptStatement
+		code       vtrpcpb.Code
+		err        string
+	}{{
+		name:       "in transaction",
+		session:    &vtgatepb.Session{TargetString: "@primary", InTransaction: true},
+		statements: []*vtgatepb.ScriptStatement{{Sql: "select id from t1"}},
+		code:       vtrpcpb.Code_FAILED_PRECONDITION,
+		err:        "cannot execute a script in a transaction",
+	}, {
+		name:    "transaction statement",
+		session: &vtgatepb.Session{TargetString: "@primary"},
+		statements: []*vtgatepb.ScriptStatement{
+			{Sql: "select id from t1"},
+			{Sql: "commit"},
+		},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "statement 2 of the script: commit is not allowed in a script",
+	}, {
+		name:    "ddl",
+		session: &vtgatepb.Session{TargetString: "@primary"},
+		statements: []*vtgatepb.ScriptStatement{
+			{Sql: "alter table t1 add column c int"},
+		},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "statement 1 of the script: alter table t1 add column c int is not allowed in a script",
+	}, {
+		name:    "autocommit",
+		session: &vtgatepb.Session{TargetString: "@primary"},
+		statements: []*vtgatepb.ScriptStatement{
+			{Sql: "set @@session.autocommit = 0"},
+		},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "statement 1 of the script: set @@session.autocommit = 0 is not allowed in a script",
+	}, {
+		name:    "autocommit among other variables",
+		session: &vtgatepb.Session{TargetString: "@primary"},
+		statements: []*vtgatepb.ScriptStatement{
+			{Sql: "set sql_mode = '', AutoCommit = 1"},
+		},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "statement 1 of the script: set sql_mode = '', AutoCommit = 1 is not allowed in a script",
+	}, {
+		name:    "too many assigned variables",
+		session: &vtgatepb.Session{TargetString: "@primary"},
+		statements: []*vtgatepb.ScriptStatement{
+			{Sql: "select id, value from t1", Assign: []string{"a", "b", "c"}},
+		},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "statement 1 of the script: cannot assign 3 variables from a result of 2 columns",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			sbc.CommitCount.Set(0)
+			_, _, _, err := rpcVTGate.ExecuteScript(context.Background(), tcase.session, tcase.statements, nil)
+			require.Error(t, err)
+			assert.Equal(t, tcase.code, vterrors.Code(err))
+			assert.Contains(t, err.Error(), tcase.err)
+			assert.EqualValues(t, 0, sbc.CommitCount.Get())
+		})
+	}
+
+	// A failing statement fails the script, and leaves no transaction open.
+	sbc.Queries = nil
+	sbc.CommitCount.Set(0)
+	sbc.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	sbc.SetResults([]*sqltypes.Result{{RowsAffected: 1}})
+	session, _, _, err := rpcVTGate.ExecuteScript(
+		context.Background(),
+		&vtgatepb.Session{TargetString: "@primary"},
+		[]*vtgatepb.ScriptStatement{
+			{Sql: "update t1 set v = 1 where id = 1"},
+			{Sql: "update t1 set v = 2 where id = 2"},
+		},
+		nil,
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "statement 1 of the script")
+	assert.False(t, session.InTransaction)
+	assert.EqualValues(t, 0, sbc.CommitCount.Get())
+}
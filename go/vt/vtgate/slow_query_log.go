@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	// SlowQueriesHandler is the debug UI path for exposing the most recent slow queries
+	SlowQueriesHandler = "/debug/slowqueries"
+
+	// SlowQueryLogger streams the queries which took longer than the slow query threshold
+	SlowQueryLogger = streamlog.New("VTGateSlowQueries", 10)
+
+	slowQueryThreshold = flag.Duration("slow_query_log_threshold", 0, "Queries taking longer than this duration are logged to the slow query log. 0 disables the slow query log.")
+	slowQueryLogToFile = flag.String("slow_query_log_file", "", "File the slow query log is written to")
+	slowQueryLogSize   = flag.Int("slow_query_log_size", 100, "Number of the most recent slow queries served at /debug/slowqueries")
+)
+
+func initSlowQueryLogger() error {
+	if *slowQueryThreshold <= 0 {
+		return nil
+	}
+	if *slowQueryLogSize > 0 {
+		SlowQueryLogger.KeepRecent(*slowQueryLogSize).ServeLogs(SlowQueriesHandler, slowQueryLogf, func(url.Values, interface{}) bool {
+			return true
+		})
+	}
+	if *slowQueryLogToFile != "" {
+		if _, err := SlowQueryLogger.LogToFile(*slowQueryLogToFile, slowQueryLogf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSlowQuery returns true if the query belongs to the slow query log.
+func isSlowQuery(stats *LogStats) bool {
+	return *slowQueryThreshold > 0 && stats.TotalTime() >= *slowQueryThreshold
+}
+
+// slowQuery is a record of the slow query log. Its SQL is normalized, so
+// that it neither carries the values of the query nor its bind variables.
+type slowQuery struct {
+	Start        string
+	TotalTime    float64
+	PlanTime     float64
+	ExecuteTime  float64
+	Method       string
+	Username     string
+	StmtType     string
+	PlanType     string
+	CachedPlan   bool
+	SQL          string
+	Keyspace     string
+	Table        string
+	TabletType   string
+	ShardQueries uint64
+	ShardTimes   map[string]float64
+	RowsAffected uint64
+	RowsReturned uint64
+	Error        string
+	RequestID    string
+}
+
+func newSlowQuery(stats *LogStats) *slowQuery {
+	sql, err := sqlparser.RedactSQLQuery(stats.SQL)
+	if err != nil {
+		sql = stats.StmtType
+	}
+	shardTimes := make(map[string]float64)
+	for shard, d := range stats.ShardTimes() {
+		shardTimes[shard] = d.Seconds()
+	}
+	_, username := stats.RemoteAddrUsername()
+	return &slowQuery{
+		Start:        stats.StartTime.Format("2006-01-02 15:04:05.000000"),
+		TotalTime:    stats.TotalTime().Seconds(),
+		PlanTime:     stats.PlanTime.Seconds(),
+		ExecuteTime:  stats.ExecuteTime.Seconds(),
+		Method:       stats.Method,
+		Username:     username,
+		StmtType:     stats.StmtType,
+		PlanType:     stats.PlanType,
+		CachedPlan:   stats.CachedPlan,
+		SQL:          sql,
+		Keyspace:     stats.Keyspace,
+		Table:        stats.Table,
+		TabletType:   stats.TabletType,
+		ShardQueries: stats.ShardQueries,
+		ShardTimes:   shardTimes,
+		RowsAffected: stats.RowsAffected,
+		RowsReturned: stats.RowsReturned,
+		Error:        stats.ErrorStr(),
+		RequestID:    stats.RequestID,
+	}
+}
+
+// formatShardTimes formats the shard times as a sorted list of
+// keyspace/shard:seconds.
+func (sq *slowQuery) formatShardTimes() string {
+	shards := make([]string, 0, len(sq.ShardTimes))
+	for shard := range sq.ShardTimes {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	for i, shard := range shards {
+		shards[i] = fmt.Sprintf("%s:%.6f", shard, sq.ShardTimes[shard])
+	}
+	return strings.Join(shards, ",")
+}
+
+// slowQueryLogf formats a record of the slow query log, either as a
+// tab-separated list of its fields or as JSON.
+func slowQueryLogf(w io.Writer, _ url.Values, message interface{}) error {
+	stats, ok := message.(*LogStats)
+	if !ok {
+		_, err := fmt.Fprintf(w, "Error: unexpected value of type %T in %s!", message, SlowQueryLogger.Name())
+		return err
+	}
+	sq := newSlowQuery(stats)
+	if *streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON {
+		b, err := json.Marshal(sq)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%v\t%.6f\t%.6f\t%.6f\t%v\t%v\t%v\t%v\t%v\t%q\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t%q\t%q\t\n",
+		sq.Start,
+		sq.TotalTime,
+		sq.PlanTime,
+		sq.ExecuteTime,
+		sq.Method,
+		sq.Username,
+		sq.StmtType,
+		sq.PlanType,
+		sq.CachedPlan,
+		sq.SQL,
+		sq.Keyspace,
+		sq.Table,
+		sq.TabletType,
+		sq.ShardQueries,
+		sq.formatShardTimes(),
+		sq.RowsAffected,
+		sq.RowsReturned,
+		sq.Error,
+		sq.RequestID,
+	)
+	return err
+}
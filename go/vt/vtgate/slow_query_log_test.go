@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/streamlog"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestIsSlowQuery(t *testing.T) {
+	defer func(threshold time.Duration) { *slowQueryThreshold = threshold }(*slowQueryThreshold)
+
+	start := time.Now()
+	stats := &LogStats{StartTime: start, EndTime: start.Add(2 * time.Second)}
+	*slowQueryThreshold = 0
+	assert.False(t, isSlowQuery(stats))
+	*slowQueryThreshold = time.Second
+	assert.True(t, isSlowQuery(stats))
+	*slowQueryThreshold = 3 * time.Second
+	assert.False(t, isSlowQuery(stats))
+}
+
+func TestSlowQueryLogf(t *testing.T) {
+	defer func(format string) { *streamlog.QueryLogFormat = format }(*streamlog.QueryLogFormat)
+
+	start := time.Date(2021, time.November, 1, 10, 0, 0, 0, time.UTC)
+	stats := NewLogStats(context.Background(), "Execute", "select * from user where name = 'secret'", map[string]*querypb.BindVariable{})
+	stats.StartTime = start
+	stats.EndTime = start.Add(1500 * time.Millisecond)
+	stats.StmtType = "SELECT"
+	stats.PlanType = "SelectScatter"
+	stats.CachedPlan = true
+	stats.RowsReturned = 3
+	stats.AddShardTime("ks", "80-", 500*time.Millisecond)
+	stats.AddShardTime("ks", "-80", time.Second)
+	stats.AddShardTime("ks", "-80", 250*time.Millisecond)
+
+	var buf bytes.Buffer
+	*streamlog.QueryLogFormat = streamlog.QueryLogFormatText
+	require.NoError(t, slowQueryLogf(&buf, nil, stats))
+	fields := strings.Split(buf.String(), "\t")
+	assert.Equal(t, "2021-11-01 10:00:00.000000", fields[0])
+	assert.Equal(t, "1.500000", fields[1])
+	assert.Equal(t, "SelectScatter", fields[7])
+	assert.Equal(t, "true", fields[8])
+	assert.Equal(t, "\"select * from `user` where `name` = :redacted1\"", fields[9])
+	assert.Equal(t, "ks/-80:1.250000,ks/80-:0.500000", fields[14])
+	assert.Equal(t, "3", fields[16])
+
+	buf.Reset()
+	*streamlog.QueryLogFormat = streamlog.QueryLogFormatJSON
+	require.NoError(t, slowQueryLogf(&buf, nil, stats))
+	var sq slowQuery
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &sq))
+	assert.Equal(t, "SelectScatter", sq.PlanType)
+	assert.True(t, sq.CachedPlan)
+	assert.Equal(t, "select * from `user` where `name` = :redacted1", sq.SQL)
+	assert.Equal(t, map[string]float64{"ks/-80": 1.25, "ks/80-": 0.5}, sq.ShardTimes)
+	assert.EqualValues(t, 3, sq.RowsReturned)
+}
+
+func TestSlowQueryLog(t *testing.T) {
+	defer func(threshold time.Duration) { *slowQueryThreshold = threshold }(*slowQueryThreshold)
+	*slowQueryThreshold = time.Nanosecond
+
+	executor, _, _, _ := createExecutorEnv()
+	logChan := SlowQueryLogger.Subscribe("Test")
+	defer SlowQueryLogger.Unsubscribe(logChan)
+
+	session := &vtgatepb.Session{TargetString: "@primary"}
+	for _, cached := range []bool{false, true} {
+		_, err := executorExecSession(executor, "select id from user", nil, session)
+		require.NoError(t, err)
+		stats := getQueryLog(logChan)
+		require.NotNil(t, stats)
+		assert.Equal(t, "SelectScatter", stats.PlanType)
+		assert.Equal(t, cached, stats.CachedPlan)
+		assert.EqualValues(t, 8, stats.ShardQueries)
+		assert.Len(t, stats.ShardTimes(), 8)
+	}
+}
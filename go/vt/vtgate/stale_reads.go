@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	staleReadsKeyspaces flagutil.StringListValue
+
+	staleReadsMaxFailoverDuration = flag.Duration("stale_reads_max_failover_duration", 30*time.Second, "stop serving the reads of a shard stale once its primary has been unhealthy for this long, since its failover is no longer in progress")
+
+	staleReadsCount = stats.NewCountersWithMultiLabels(
+		"GatewayStaleReads",
+		"Number of reads of a primary the gateway sent to a replica because the shard had no healthy primary",
+		[]string{"Keyspace", "ShardName", "TabletType"})
+)
+
+func init() {
+	flag.Var(&staleReadsKeyspaces, "stale_reads_on_primary_loss_keyspaces", "comma separated list of keyspaces whose autocommit reads are served by their replicas, with a warning, while the failover of a shard is in progress, instead of failing or being buffered until its end")
+}
+
+// staleReadsEnabled returns true if the keyspace serves stale reads while
+// its primaries are unavailable.
+func staleReadsEnabled(keyspace string) bool {
+	for _, ks := range staleReadsKeyspaces {
+		if ks == keyspace {
+			return true
+		}
+	}
+	return false
+}
+
+// staleReads records the shards whose reads were served by a replica.
+type staleReads struct {
+	mu     sync.Mutex
+	shards map[string]topodatapb.TabletType
+}
+
+type staleReadsKey struct{}
+
+// withStaleReads returns a context on which the gateway may send the reads
+// of the primary of a shard which has no healthy primary to its replicas,
+// and records them in sr.
+func withStaleReads(ctx context.Context, sr *staleReads) context.Context {
+	return context.WithValue(ctx, staleReadsKey{}, sr)
+}
+
+func staleReadsFromContext(ctx context.Context) *staleReads {
+	sr, _ := ctx.Value(staleReadsKey{}).(*staleReads)
+	return sr
+}
+
+func (sr *staleReads) add(target *querypb.Target) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	if sr.shards == nil {
+		sr.shards = make(map[string]topodatapb.TabletType)
+	}
+	sr.shards[target.Keyspace+"/"+target.Shard] = target.TabletType
+}
+
+// warning returns the warning telling the client its results are stale, or
+// nil if all the reads were served by primaries.
+func (sr *staleReads) warning() *querypb.QueryWarning {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	if len(sr.shards) == 0 {
+		return nil
+	}
+	shards := make([]string, 0, len(sr.shards))
+	for shard, tabletType := range sr.shards {
+		shards = append(shards, fmt.Sprintf("%s (%s)", shard, topoproto.TabletTypeLString(tabletType)))
+	}
+	sort.Strings(shards)
+	return &querypb.QueryWarning{
+		Code:    mysql.ERUnknownError,
+		Message: fmt.Sprintf("stale read: no healthy primary for %s, results were served by replicas and may not include the latest writes", strings.Join(shards, ", ")),
+	}
+}
+
+// canReadStale returns true if the plan only reads data, outside of any
+// transaction, so that its primary reads can be served by replicas.
+func canReadStale(plan *engine.Plan, safeSession *SafeSession) bool {
+	if len(staleReadsKeyspaces) == 0 || plan.Type != sqlparser.StmtSelect {
+		return false
+	}
+	if safeSession.InTransaction() || safeSession.InReservedConn() || plan.Instructions.NeedsTransaction() {
+		return false
+	}
+	// Sequences and locks must be served by the primary.
+	return !engine.Exists(func(p engine.Primitive) bool {
+		switch p := p.(type) {
+		case *engine.Route:
+			return p.Opcode == engine.SelectNext
+		case *engine.Lock:
+			return true
+		}
+		return false
+	}, plan.Instructions)
+}
+
+// staleReadTablets returns the healthy replicas, or else the healthy rdonly
+// tablets, of the shard of a primary target whose failover is in progress,
+// and the target to send them the query, if the context allows stale reads
+// and they are enabled for the keyspace. The shards being resharded are not
+// served stale, since their primaries stop serving on purpose.
+func (gw *TabletGateway) staleReadTablets(ctx context.Context, target *querypb.Target) (*querypb.Target, []*discovery.TabletHealth) {
+	if target.TabletType != topodatapb.TabletType_PRIMARY || !staleReadsEnabled(target.Keyspace) || staleReadsFromContext(ctx) == nil {
+		return nil, nil
+	}
+	if !gw.failoverInProgress(target) {
+		return nil, nil
+	}
+	if gw.kev != nil && gw.kev.TargetIsBeingResharded(target) {
+		return nil, nil
+	}
+	for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
+		staleTarget := &querypb.Target{
+			Keyspace:   target.Keyspace,
+			Shard:      target.Shard,
+			TabletType: tabletType,
+			Cell:       target.Cell,
+		}
+		if tablets := gw.hc.GetHealthyTabletStats(staleTarget); len(tablets) > 0 {
+			return staleTarget, tablets
+		}
+	}
+	return nil, nil
+}
+
+// failoverInProgress returns true if the shard of a primary target lost its
+// primary less than -stale_reads_max_failover_duration ago: it has no
+// healthy primary, but a primary which served before, as when an
+// EmergencyReparentShard is replacing it. The shards whose primary never
+// served, or which have been unavailable for longer, are not failing over,
+// and their reads fail as usual.
+func (gw *TabletGateway) failoverInProgress(target *querypb.Target) bool {
+	key := target.Keyspace + "/" + target.Shard
+	lost := len(gw.hc.GetHealthyTabletStats(target)) == 0
+	if lost {
+		lost = false
+		for _, th := range gw.hc.GetTabletStats(target) {
+			if th.PrimaryTermStartTime != 0 {
+				lost = true
+				break
+			}
+		}
+	}
+
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	if !lost {
+		delete(gw.primariesLost, key)
+		return false
+	}
+	since, ok := gw.primariesLost[key]
+	if !ok {
+		since = time.Now()
+		gw.primariesLost[key] = since
+	}
+	return time.Since(since) < *staleReadsMaxFailoverDuration
+}
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/srvtopo"
@@ -63,7 +64,7 @@ type TabletGateway struct {
 	kev                  *discovery.KeyspaceEventWatcher
 	srvTopoServer        srvtopo.Server
 	localCell            string
-	retryCount           int
+	retryPolicy          retryPolicy
 	defaultConnCollation uint32
 
 	// mu protects the fields of this group.
@@ -71,9 +72,16 @@ type TabletGateway struct {
 	// statusAggregators is a map indexed by the key
 	// keyspace/shard/tablet_type.
 	statusAggregators map[string]*TabletStatusAggregator
+	// primariesLost is a map indexed by keyspace/shard of the times at
+	// which the primaries of the shards were first found unhealthy by
+	// staleReadTablets.
+	primariesLost map[string]time.Time
 
 	// buffer, if enabled, buffers requests during a detected PRIMARY failover.
 	buffer *buffer.Buffer
+
+	// balancer orders the healthy tablets of the targets.
+	balancer *loadBalancer
 }
 
 func createTabletGateway(ctx context.Context, _ discovery.LegacyHealthCheck, serv srvtopo.Server, cell string, _ int) Gateway {
@@ -100,12 +108,22 @@ func NewTabletGateway(ctx context.Context, hc discovery.HealthCheck, serv srvtop
 		}
 		hc = createHealthCheck(ctx, *HealthCheckRetryDelay, *HealthCheckTimeout, topoServer, localCell, *CellsToWatch)
 	}
+	balancer, err := newLoadBalancer(localCell)
+	if err != nil {
+		log.Exitf("Unable to create new TabletGateway: %v", err)
+	}
+	policy, err := newRetryPolicyFromFlags()
+	if err != nil {
+		log.Exitf("Unable to create new TabletGateway: %v", err)
+	}
 	gw := &TabletGateway{
 		hc:                hc,
 		srvTopoServer:     serv,
 		localCell:         localCell,
-		retryCount:        *RetryCount,
+		retryPolicy:       policy,
 		statusAggregators: make(map[string]*TabletStatusAggregator),
+		primariesLost:     make(map[string]time.Time),
+		balancer:          balancer,
 	}
 	gw.setupBuffering(ctx)
 	gw.QueryService = queryservice.Wrap(nil, gw.withRetry)
@@ -176,6 +194,7 @@ func (gw *TabletGateway) QueryServiceByAlias(alias *topodatapb.TabletAlias, targ
 // and the checksum of the topology
 func (gw *TabletGateway) RegisterStats() {
 	gw.hc.RegisterStats()
+	gw.balancer.registerStats()
 }
 
 // WaitForTablets is part of the Gateway interface.
@@ -214,7 +233,8 @@ func (gw *TabletGateway) CacheStatus() TabletCacheStatusList {
 }
 
 // withRetry gets available connections and executes the action. If there are retryable errors,
-// it retries retryCount times before failing. It does not retry if the connection is in
+// it retries as allowed by the retry policy of the session or of the flags before failing,
+// waiting for its backoff. It does not retry if the connection is in
 // the middle of a transaction. While returning the error check if it maybe a result of
 // a resharding event, and set the re-resolve bit and let the upper layers
 // re-resolve and retry.
@@ -241,16 +261,33 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		}
 	}
 
+	policy := gw.retryPolicy.override(retryPolicyFromContext(ctx))
+	ctx = queryservice.WithRetryCodes(ctx, policy.codes)
+	hedged := hedgedTabletsFromContext(ctx)
+
 	bufferedOnce := false
-	for i := 0; i < gw.retryCount+1; i++ {
+	retries := 0
+	for i := 0; i < policy.maxAttempts; i++ {
+		// Reads which can be served stale by the replicas of a shard without
+		// a healthy primary are neither buffered nor failed.
+		var staleTarget *querypb.Target
+		var staleTablets []*discovery.TabletHealth
+		if !inTransaction {
+			staleTarget, staleTablets = gw.staleReadTablets(ctx, target)
+		}
+
 		// Check if we should buffer PRIMARY queries which failed due to an ongoing
 		// failover.
 		// Note: We only buffer once and only "!inTransaction" queries i.e.
 		// a) no transaction is necessary (e.g. critical reads) or
 		// b) no transaction was created yet.
-		if !bufferedOnce && !inTransaction && target.TabletType == topodatapb.TabletType_PRIMARY {
+		if !bufferedOnce && !inTransaction && target.TabletType == topodatapb.TabletType_PRIMARY && staleTarget == nil {
 			// The next call blocks if we should buffer during a failover.
+			bufferStart := time.Now()
 			retryDone, bufferErr := gw.buffer.WaitForFailoverEnd(ctx, target.Keyspace, target.Shard, err)
+			if retryDone != nil || bufferErr != nil {
+				addBufferedTime(ctx, time.Since(bufferStart))
+			}
 
 			// Request may have been buffered.
 			if retryDone != nil {
@@ -268,31 +305,57 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 			}
 		}
 
-		tablets := gw.hc.GetHealthyTabletStats(target)
-		if len(tablets) == 0 {
-			// if we have a keyspace event watcher, check if the reason why our primary is not available is that it's currently being resharded
-			// or if a reparent operation is in progress.
-			if kev := gw.kev; kev != nil {
-				if kev.TargetIsBeingResharded(target) {
-					err = vterrors.Errorf(vtrpcpb.Code_CLUSTER_EVENT, "current keyspace is being resharded")
-					continue
-				}
-				if kev.PrimaryIsNotServing(target) {
-					err = vterrors.Errorf(vtrpcpb.Code_CLUSTER_EVENT, "primary is not serving, there is a reparent operation in progress")
-					continue
-				}
+		queryTarget := target
+		var tablets []*discovery.TabletHealth
+		if staleTarget != nil {
+			queryTarget, tablets = staleTarget, staleTablets
+			gw.shuffleTablets(gw.localCell, tablets)
+			gw.balancer.order(target.Keyspace, tablets)
+		} else if asOf, ok := asOfFromContext(ctx); ok && target.TabletType != topodatapb.TabletType_PRIMARY {
+			// AS_OF queries go to the delayed replica which covers the
+			// requested point in time, even if it is lagging.
+			var asOfErr error
+			tablets, asOfErr = asOfTablets(target, gw.hc.GetTabletStats(target), asOf, time.Now())
+			if asOfErr != nil {
+				err = asOfErr
+				break
 			}
+		} else {
+			tablets = gw.hc.GetHealthyTabletStats(target)
+			if len(tablets) == 0 {
+				// if we have a keyspace event watcher, check if the reason why our primary is not available is that it's currently being resharded
+				// or if a reparent operation is in progress.
+				if kev := gw.kev; kev != nil {
+					if kev.TargetIsBeingResharded(target) {
+						err = vterrors.Errorf(vtrpcpb.Code_CLUSTER_EVENT, "current keyspace is being resharded")
+						continue
+					}
+					if kev.PrimaryIsNotServing(target) {
+						err = vterrors.Errorf(vtrpcpb.Code_CLUSTER_EVENT, "primary is not serving, there is a reparent operation in progress")
+						continue
+					}
+				}
 
-			// fail fast if there is no tablet
-			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", target.String())
-			break
+				// fail fast if there is no tablet
+				err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", target.String())
+				break
+			}
+			gw.shuffleTablets(gw.localCell, tablets)
+			gw.balancer.order(target.Keyspace, tablets)
 		}
-		gw.shuffleTablets(gw.localCell, tablets)
 
 		var th *discovery.TabletHealth
-		// skip tablets we tried before
+		// skip tablets we tried before, and prefer the tablets which
+		// the other attempts of a hedged query do not use
 		for _, t := range tablets {
-			if _, ok := invalidTablets[topoproto.TabletAliasString(t.Tablet.Alias)]; !ok {
+			alias := topoproto.TabletAliasString(t.Tablet.Alias)
+			if _, ok := invalidTablets[alias]; ok {
+				continue
+			}
+			if th == nil {
+				th = t
+			}
+			if !hedged.has(alias) {
 				th = t
 				break
 			}
@@ -306,6 +369,7 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		}
 
 		tabletLastUsed = th.Tablet
+		hedged.add(topoproto.TabletAliasString(tabletLastUsed.Alias))
 		// execute
 		if th.Conn == nil {
 			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no connection for tablet %v", tabletLastUsed)
@@ -317,10 +381,22 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 
 		startTime := time.Now()
 		var canRetry bool
-		canRetry, err = inner(ctx, target, th.Conn)
-		gw.updateStats(target, startTime, err)
+		recordDone := recordDeadlineBudget(ctx, deadlineHopVTTablet)
+		balancerDone := gw.balancer.start(th)
+		canRetry, err = inner(ctx, queryTarget, th.Conn)
+		balancerDone(canRetry && err != nil)
+		recordDone()
+		gw.updateStats(queryTarget, startTime, err)
+		if staleTarget != nil && err == nil {
+			staleReadsFromContext(ctx).add(staleTarget)
+			staleReadsCount.Add([]string{staleTarget.Keyspace, staleTarget.Shard, topoproto.TabletTypeLString(staleTarget.TabletType)}, 1)
+		}
 		if canRetry {
 			invalidTablets[topoproto.TabletAliasString(tabletLastUsed.Alias)] = true
+			retries++
+			if i+1 < policy.maxAttempts && !policy.wait(ctx, retries) {
+				break
+			}
 			continue
 		}
 		break
@@ -328,6 +404,34 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 	return NewShardError(err, target)
 }
 
+// Execute implements the QueryService interface. The reads of the replicas
+// outside of a transaction are hedged if the retry policy has a hedge delay.
+func (gw *TabletGateway) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	if !gw.canHedge(ctx, target, transactionID, reservedID) {
+		return gw.QueryService.Execute(ctx, target, query, bindVars, transactionID, reservedID, options)
+	}
+	delay := gw.retryPolicy.override(retryPolicyFromContext(ctx)).hedgeDelay
+	return hedge(ctx, target, delay, func(ctx context.Context) (*sqltypes.Result, error) {
+		return gw.QueryService.Execute(ctx, target, query, bindVars, transactionID, reservedID, options)
+	})
+}
+
+// canHedge returns true if a query can be hedged: it must read a replica
+// outside of a transaction, which has another healthy replica to be sent
+// to, and not be an AS_OF query, which goes to a specific replica.
+func (gw *TabletGateway) canHedge(ctx context.Context, target *querypb.Target, transactionID, reservedID int64) bool {
+	if gw.retryPolicy.override(retryPolicyFromContext(ctx)).hedgeDelay <= 0 {
+		return false
+	}
+	if target == nil || target.TabletType == topodatapb.TabletType_PRIMARY || transactionID != 0 || reservedID != 0 {
+		return false
+	}
+	if _, ok := asOfFromContext(ctx); ok {
+		return false
+	}
+	return len(gw.hc.GetHealthyTabletStats(target)) > 1
+}
+
 func (gw *TabletGateway) updateStats(target *querypb.Target, startTime time.Time, err error) {
 	elapsed := time.Since(startTime)
 	aggr := gw.getStatsAggregator(target)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 )
 
 func TestTabletGatewayExecute(t *testing.T) {
@@ -181,6 +183,153 @@ func TestTabletGatewayReplicaTransactionError(t *testing.T) {
 	verifyContainsError(t, err, "query service can only be used for non-transactional queries on replicas", vtrpcpb.Code_INTERNAL)
 }
 
+func TestTabletGatewayAsOf(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	tabletType := topodatapb.TabletType_REPLICA
+	host := "1.1.1.1"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: tabletType,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+
+	sbcs := map[int64]*sandboxconn.SandboxConn{}
+	for i, lag := range []int64{0, 1800, 3600} {
+		sbcs[lag] = hc.AddTestTablet("cell", host, int32(1001+i), keyspace, shard, tabletType, true, 10, nil)
+	}
+	for _, th := range hc.GetTabletStats(target) {
+		for lag, sbc := range sbcs {
+			if th.Conn == sbc {
+				th.Stats.ReplicationLagSeconds = uint32(lag)
+			}
+		}
+	}
+
+	// The replica delayed by 30 minutes is the closest one covering 32 minutes ago.
+	ctx := withAsOf(context.Background(), time.Now().Add(-32*time.Minute))
+	_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbcs[0].ExecCount.Get())
+	assert.EqualValues(t, 1, sbcs[1800].ExecCount.Get())
+	assert.EqualValues(t, 0, sbcs[3600].ExecCount.Get())
+
+	// No replica is delayed by two hours.
+	ctx = withAsOf(context.Background(), time.Now().Add(-2*time.Hour))
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no delayed replica covers AS_OF", vtrpcpb.Code_UNAVAILABLE)
+}
+
+func TestTabletGatewayDeadlineBudget(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, target.Keyspace, target.Shard, target.TabletType, true, 10, nil)
+	deadlineRemaining.Reset()
+	deadlineConsumed.Reset()
+
+	// Requests without a deadline have no budget to account for.
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Zero(t, deadlineRemaining.Counts()[deadlineHopVTTablet])
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, deadlineRemaining.Counts()[deadlineHopVTTablet])
+	assert.EqualValues(t, 1, deadlineConsumed.Counts()[deadlineHopVTTablet])
+	assert.Greater(t, deadlineRemaining.Time(), int64(50*time.Second))
+}
+
+func TestTabletGatewayStaleReads(t *testing.T) {
+	defer func(keyspaces []string) {
+		staleReadsKeyspaces = keyspaces
+	}(staleReadsKeyspaces)
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_PRIMARY,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	primary := hc.AddTestTablet("cell", "1.1.1.1", 1001, target.Keyspace, target.Shard, topodatapb.TabletType_PRIMARY, false, 10, nil)
+	replica := hc.AddTestTablet("cell", "1.1.1.1", 1002, target.Keyspace, target.Shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	staleReadsCount.ResetAll()
+
+	// Stale reads are not enabled for the keyspace.
+	sr := &staleReads{}
+	ctx := withStaleReads(context.Background(), sr)
+	_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no healthy tablet available", vtrpcpb.Code_UNAVAILABLE)
+
+	// The query may not be served stale.
+	staleReadsKeyspaces = []string{"ks"}
+	_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no healthy tablet available", vtrpcpb.Code_UNAVAILABLE)
+	assert.Nil(t, sr.warning())
+
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, primary.ExecCount.Get())
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+	assert.Equal(t, "stale read: no healthy primary for ks/0 (replica), results were served by replicas and may not include the latest writes", sr.warning().Message)
+	assert.EqualValues(t, 1, staleReadsCount.Counts()["ks.0.replica"])
+
+	// Transactions are never served stale.
+	_, err = tg.Execute(ctx, target, "query", nil, 1, 0, nil)
+	require.Error(t, err)
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+
+	// The reads are served by the primary as soon as it is healthy again.
+	for _, th := range hc.GetTabletStats(target) {
+		th.Serving = true
+	}
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, primary.ExecCount.Get())
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+
+	// The reads fail once the failover took too long.
+	for _, th := range hc.GetTabletStats(target) {
+		th.Serving = false
+	}
+	defer func(d time.Duration) {
+		*staleReadsMaxFailoverDuration = d
+	}(*staleReadsMaxFailoverDuration)
+	*staleReadsMaxFailoverDuration = 0
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no healthy tablet available", vtrpcpb.Code_UNAVAILABLE)
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+}
+
+func TestTabletGatewayStaleReadsWithoutPrimary(t *testing.T) {
+	defer func(keyspaces []string) {
+		staleReadsKeyspaces = keyspaces
+	}(staleReadsKeyspaces)
+	staleReadsKeyspaces = []string{"ks"}
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_PRIMARY,
+	}
+	hc := discovery.NewFakeHealthCheck(nil)
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	replica := hc.AddTestTablet("cell", "1.1.1.1", 1002, target.Keyspace, target.Shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	// A shard whose primary never served is not failing over.
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, target.Keyspace, target.Shard, topodatapb.TabletType_PRIMARY, false, 0, nil)
+	_, err := tg.Execute(withStaleReads(context.Background(), &staleReads{}), target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no healthy tablet available", vtrpcpb.Code_UNAVAILABLE)
+	assert.EqualValues(t, 0, replica.ExecCount.Get())
+}
+
 func testTabletGatewayGeneric(t *testing.T, f func(tg *TabletGateway, target *querypb.Target) error) {
 	t.Helper()
 	keyspace := "ks"
@@ -39,6 +39,11 @@ import (
 type TxConn struct {
 	gateway Gateway
 	mode    vtgatepb.TransactionMode
+
+	mu sync.Mutex
+	// afterTransaction are the functions to call once the transactions of the
+	// sessions end, by session UUID.
+	afterTransaction map[string][]func()
 }
 
 // NewTxConn builds a new TxConn.
@@ -64,6 +69,7 @@ func (txc *TxConn) Begin(ctx context.Context, session *SafeSession) error {
 // Commit commits the current transaction. The type of commit can be
 // best effort or 2pc depending on the session setting.
 func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
+	defer txc.runAfterTransaction(session)
 	defer session.ResetTx()
 	if !session.InTransaction() {
 		return nil
@@ -76,10 +82,56 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	case vtgatepb.TransactionMode_UNSPECIFIED:
 		twopc = txc.mode == vtgatepb.TransactionMode_TWOPC
 	}
+	var err error
 	if twopc {
-		return txc.commit2PC(ctx, session)
+		err = txc.commit2PC(ctx, session)
+	} else {
+		err = txc.commitNormal(ctx, session)
+	}
+	if err == nil && session.ReadYourWrites() {
+		var targets []*querypb.Target
+		for _, shardSessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+			for _, shardSession := range shardSessions {
+				targets = append(targets, shardSession.Target)
+			}
+		}
+		recordGTIDSets(ctx, session, targets, txc.gateway)
+	}
+	return err
+}
+
+// AfterTransaction calls f once the transaction of the session commits or
+// rolls back, or right away if the session is not in a transaction. The
+// sessions without a UUID, like the sessions of the gRPC clients, cannot be
+// tracked across their requests, and f is called right away for them too.
+func (txc *TxConn) AfterTransaction(session *SafeSession, f func()) {
+	uuid := session.GetSessionUUID()
+	if !session.InTransaction() || uuid == "" {
+		f()
+		return
+	}
+	txc.mu.Lock()
+	defer txc.mu.Unlock()
+	if txc.afterTransaction == nil {
+		txc.afterTransaction = make(map[string][]func())
+	}
+	txc.afterTransaction[uuid] = append(txc.afterTransaction[uuid], f)
+}
+
+// runAfterTransaction calls the functions registered by AfterTransaction
+// for the session.
+func (txc *TxConn) runAfterTransaction(session *SafeSession) {
+	uuid := session.GetSessionUUID()
+	if uuid == "" {
+		return
+	}
+	txc.mu.Lock()
+	fs := txc.afterTransaction[uuid]
+	delete(txc.afterTransaction, uuid)
+	txc.mu.Unlock()
+	for _, f := range fs {
+		f()
 	}
-	return txc.commitNormal(ctx, session)
 }
 
 func (txc *TxConn) queryService(alias *topodatapb.TabletAlias) (queryservice.QueryService, error) {
@@ -189,6 +241,7 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 
 // Rollback rolls back the current transaction. There are no retries on this operation.
 func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
+	defer txc.runAfterTransaction(session)
 	if !session.InTransaction() {
 		return nil
 	}
@@ -224,7 +277,8 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 
 //Release releases the reserved connection and/or rollbacks the transaction
 func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
-	if !session.InTransaction() && !session.InReservedConn() {
+	defer txc.runAfterTransaction(session)
+	if !session.InTransaction() && !session.InReservedConn() && !session.HasTempTables() {
 		return nil
 	}
 	defer session.Reset()
@@ -276,7 +330,8 @@ func (txc *TxConn) ReleaseLock(ctx context.Context, session *SafeSession) error
 
 //ReleaseAll releases all the shard sessions and lock session.
 func (txc *TxConn) ReleaseAll(ctx context.Context, session *SafeSession) error {
-	if !session.InTransaction() && !session.InReservedConn() && !session.InLockSession() {
+	defer txc.runAfterTransaction(session)
+	if !session.InTransaction() && !session.InReservedConn() && !session.InLockSession() && !session.HasTempTables() {
 		return nil
 	}
 	defer session.ResetAll()
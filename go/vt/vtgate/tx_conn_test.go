@@ -113,6 +113,33 @@ func TestTxConnCommitSuccess(t *testing.T) {
 	assert.EqualValues(t, 1, sbc1.CommitCount.Get(), "sbc1.CommitCount")
 }
 
+func TestTxConnAfterTransaction(t *testing.T) {
+	sc, _, _, rss0, _, _ := newTestTxConnEnv(t, "TestTxConn")
+	calls := 0
+	f := func() { calls++ }
+
+	// Sessions which are not in a transaction, or cannot be tracked, do not wait.
+	sc.txConn.AfterTransaction(NewSafeSession(&vtgatepb.Session{SessionUUID: "a"}), f)
+	assert.Equal(t, 1, calls)
+	sc.txConn.AfterTransaction(NewSafeSession(&vtgatepb.Session{InTransaction: true}), f)
+	assert.Equal(t, 2, calls)
+
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, SessionUUID: "a"})
+	sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
+	sc.txConn.AfterTransaction(session, f)
+	assert.Equal(t, 2, calls)
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Equal(t, 3, calls)
+
+	session = NewSafeSession(&vtgatepb.Session{InTransaction: true, SessionUUID: "a"})
+	sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
+	sc.txConn.AfterTransaction(session, f)
+	require.NoError(t, sc.txConn.Rollback(ctx, session))
+	assert.Equal(t, 4, calls)
+	require.NoError(t, sc.txConn.Rollback(ctx, session))
+	assert.Equal(t, 4, calls)
+}
+
 func TestTxConnReservedCommitSuccess(t *testing.T) {
 	sc, sbc0, sbc1, rss0, _, rss01 := newTestTxConnEnv(t, "TestTxConn")
 	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
@@ -19,8 +19,11 @@ package vtgate
 import (
 	"context"
 	"fmt"
+	"hash/crc32"
+	"math/rand"
 	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -66,6 +69,7 @@ type iExecute interface {
 	StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, rollbackOnError bool, autocommit bool, callback func(reply *sqltypes.Result) error) []error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
+	AfterTransaction(safeSession *SafeSession, f func())
 	ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error
 	ExecuteVStream(ctx context.Context, rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error
 
@@ -100,12 +104,21 @@ type vcursorImpl struct {
 	// must be forced to rollback.
 	rollbackOnPartialExec bool
 	ignoreMaxMemoryRows   bool
+	queryMemory           *engine.QueryMemory
 	vschema               *vindexes.VSchema
 	vm                    VSchemaOperator
 	semTable              *semantics.SemTable
 	warnShardedOnly       bool // when using sharded only features, a warning will be warnings field
 
 	warnings []*querypb.QueryWarning // any warnings that are accumulated during the planning phase are stored here
+
+	// versionedTableBucket decides the versions of the versioned tables
+	// served to the session.
+	versionedTableBucket uint32
+
+	// shards are the shards the query was sent to, counted against the max_shards_per_query of the session
+	shardsMu sync.Mutex
+	shards   map[string]bool
 }
 
 // newVcursorImpl creates a vcursorImpl. Before creating this object, you have to separate out any marginComments that came with
@@ -159,6 +172,10 @@ func newVCursorImpl(
 		connCollation = coll.ID()
 	}
 
+	if policy := safeSession.GetOptions().GetRetryPolicy(); policy != nil {
+		ctx = withRetryPolicy(ctx, policy)
+	}
+
 	return &vcursorImpl{
 		ctx:             ctx,
 		safeSession:     safeSession,
@@ -174,6 +191,9 @@ func newVCursorImpl(
 		vm:              vm,
 		topoServer:      ts,
 		warnShardedOnly: warnShardedOnly,
+		queryMemory:     engine.NewQueryMemory(*queryMemoryBudget, *querySpillDir),
+
+		versionedTableBucket: versionedTableBucket(safeSession),
 	}, nil
 }
 
@@ -198,6 +218,11 @@ func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
 	return !vc.ignoreMaxMemoryRows && numRows > *maxMemoryRows
 }
 
+// QueryMemory implements the VCursor interface.
+func (vc *vcursorImpl) QueryMemory() *engine.QueryMemory {
+	return vc.queryMemory
+}
+
 // SetIgnoreMaxMemoryRows sets the ignoreMaxMemoryRows value.
 func (vc *vcursorImpl) SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows bool) {
 	vc.ignoreMaxMemoryRows = ignoreMaxMemoryRows
@@ -210,6 +235,22 @@ func (vc *vcursorImpl) SetContextTimeout(timeout time.Duration) context.CancelFu
 	return cancel
 }
 
+// SetContextAsOf updates context and tablet type to read from the replica
+// which covers asOf.
+func (vc *vcursorImpl) SetContextAsOf(asOf time.Time) (func(), error) {
+	if vc.safeSession.InTransaction() {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "AS_OF is not supported inside a transaction")
+	}
+	origCtx, origTabletType := vc.ctx, vc.tabletType
+	vc.ctx = withAsOf(vc.ctx, asOf)
+	if vc.tabletType == topodatapb.TabletType_PRIMARY {
+		vc.tabletType = topodatapb.TabletType_REPLICA
+	}
+	return func() {
+		vc.ctx, vc.tabletType = origCtx, origTabletType
+	}, nil
+}
+
 // ErrorGroupCancellableContext updates context that can be cancelled.
 func (vc *vcursorImpl) ErrorGroupCancellableContext() (*errgroup.Group, func()) {
 	origCtx := vc.ctx
@@ -232,6 +273,9 @@ func (vc *vcursorImpl) FindTable(name sqlparser.TableName) (*vindexes.Table, str
 	if err != nil {
 		return nil, "", destTabletType, nil, err
 	}
+	if destKeyspace == "" && vc.isSessionTempTable(name) {
+		destKeyspace = *tempTableKeyspace
+	}
 	if destKeyspace == "" {
 		destKeyspace = vc.keyspace
 	}
@@ -247,10 +291,16 @@ func (vc *vcursorImpl) FindRoutedTable(name sqlparser.TableName) (*vindexes.Tabl
 	if err != nil {
 		return nil, err
 	}
+	if destKeyspace == "" && vc.isSessionTempTable(name) {
+		destKeyspace = *tempTableKeyspace
+	}
 	if destKeyspace == "" {
 		destKeyspace = vc.keyspace
 	}
 
+	if table, ok, err := vc.findVersionedTable(destKeyspace, name.Name.String(), destTabletType); ok {
+		return table, err
+	}
 	table, err := vc.vschema.FindRoutedTable(destKeyspace, name.Name.String(), destTabletType)
 	if err != nil {
 		return nil, err
@@ -259,15 +309,47 @@ func (vc *vcursorImpl) FindRoutedTable(name sqlparser.TableName) (*vindexes.Tabl
 	return table, nil
 }
 
+// findVersionedTable returns the version of a versioned table served to the
+// session. It returns false if the table is not versioned.
+func (vc *vcursorImpl) findVersionedTable(keyspace, tablename string, tabletType topodatapb.TabletType) (*vindexes.Table, bool, error) {
+	vt := vc.vschema.FindVersionedTable(keyspace, tablename, tabletType)
+	if vt == nil {
+		return nil, false, nil
+	}
+	table, err := vt.Pick(vc.versionedTableBucket)
+	return table, true, err
+}
+
+// versionedTableBucket returns the bucket of a session, from 0 to 99, so
+// that the session is served the same versions of the versioned tables for
+// all its queries. The sessions without a UUID get a random bucket for each
+// query.
+func versionedTableBucket(safeSession *SafeSession) uint32 {
+	uuid := safeSession.GetSessionUUID()
+	if uuid == "" {
+		return uint32(rand.Intn(100))
+	}
+	return crc32.ChecksumIEEE([]byte(uuid)) % 100
+}
+
 // FindTableOrVindex finds the specified table or vindex.
 func (vc *vcursorImpl) FindTableOrVindex(name sqlparser.TableName) (*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, dest, err := vc.executor.ParseDestinationTarget(name.Qualifier.String())
 	if err != nil {
 		return nil, nil, "", destTabletType, nil, err
 	}
+	if destKeyspace == "" && vc.isSessionTempTable(name) {
+		destKeyspace = *tempTableKeyspace
+	}
 	if destKeyspace == "" {
 		destKeyspace = vc.getActualKeyspace()
 	}
+	if table, ok, err := vc.findVersionedTable(destKeyspace, name.Name.String(), vc.tabletType); ok {
+		if err != nil {
+			return nil, nil, "", destTabletType, nil, err
+		}
+		return table, nil, destKeyspace, destTabletType, dest, nil
+	}
 	table, vindex, err := vc.vschema.FindTableOrVindex(destKeyspace, name.Name.String(), vc.tabletType)
 	if err != nil {
 		return nil, nil, "", destTabletType, nil, err
@@ -275,6 +357,13 @@ func (vc *vcursorImpl) FindTableOrVindex(name sqlparser.TableName) (*vindexes.Ta
 	return table, vindex, destKeyspace, destTabletType, dest, nil
 }
 
+// isSessionTempTable returns true if the unqualified table is a temporary
+// table the session created in the temp table keyspace. Those tables shadow
+// the tables of the keyspace of the session, as they do in MySQL.
+func (vc *vcursorImpl) isSessionTempTable(name sqlparser.TableName) bool {
+	return name.Qualifier.IsEmpty() && vc.safeSession.HasTempTable(name.Name.String())
+}
+
 func (vc *vcursorImpl) getActualKeyspace() string {
 	if !sqlparser.SystemSchema(vc.keyspace) {
 		return vc.keyspace
@@ -344,6 +433,11 @@ func (vc *vcursorImpl) SysVarSetEnabled() bool {
 	return vc.GetSessionEnableSystemSettings()
 }
 
+// StrictSystemSettings implements the ContextVSchema interface
+func (vc *vcursorImpl) StrictSystemSettings() bool {
+	return *strictSystemSettings
+}
+
 // KeyspaceExists provides whether the keyspace exists or not.
 func (vc *vcursorImpl) KeyspaceExists(ks string) bool {
 	return vc.vschema.Keyspaces[ks] != nil
@@ -430,6 +524,9 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 	} else {
 		session.SetCommitOrder(co)
 		defer session.SetCommitOrder(vtgatepb.CommitOrder_NORMAL)
+		// The queries of the vindexes must not change the LAST_INSERT_ID(),
+		// FOUND_ROWS() and ROW_COUNT() of the statement they run for.
+		defer session.setSessionStats(session.getSessionStats())
 	}
 
 	qr, err := vc.executor.Execute(vc.ctx, method, session, vc.marginComments.Leading+query+vc.marginComments.Trailing, bindVars)
@@ -442,14 +539,33 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 // ExecuteMultiShard is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, autocommit bool) (*sqltypes.Result, []error) {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(queries)))
+	vc.logStats.AddShards(rss)
 	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, commentedShardQueries(queries, vc.marginComments), vc.safeSession, autocommit, vc.ignoreMaxMemoryRows)
+	if qr != nil {
+		atomic.AddUint64(&vc.logStats.ShardRows, uint64(len(qr.Rows))+qr.RowsAffected)
+	}
 
 	if errs == nil && rollbackOnError {
 		vc.rollbackOnPartialExec = true
+		// Outside of a transaction the write is committed already.
+		if vc.safeSession.ReadYourWrites() && !vc.safeSession.InTransaction() {
+			vc.recordGTIDSets(rss)
+		}
 	}
 	return qr, errs
 }
 
+func (vc *vcursorImpl) recordGTIDSets(rss []*srvtopo.ResolvedShard) {
+	if len(rss) == 0 {
+		return
+	}
+	targets := make([]*querypb.Target, 0, len(rss))
+	for _, rs := range rss {
+		targets = append(targets, rs.Target)
+	}
+	recordGTIDSets(vc.ctx, vc.safeSession, targets, rss[0].Gateway)
+}
+
 func (vc *vcursorImpl) InTransactionAndIsDML() bool {
 	if !vc.safeSession.InTransaction() {
 		return false
@@ -461,6 +577,16 @@ func (vc *vcursorImpl) InTransactionAndIsDML() bool {
 	return false
 }
 
+// InTransaction implements the VCursor interface
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession.InTransaction()
+}
+
+// AfterTransaction implements the VCursor interface
+func (vc *vcursorImpl) AfterTransaction(f func()) {
+	vc.executor.AfterTransaction(vc.safeSession, f)
+}
+
 func (vc *vcursorImpl) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	switch vc.logStats.StmtType {
 	case "DELETE", "UPDATE":
@@ -497,7 +623,11 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 // StreamExecuteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, rollbackOnError bool, autocommit bool, callback func(reply *sqltypes.Result) error) []error {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(rss)))
-	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession, vc.rollbackOnPartialExec, autocommit, callback)
+	vc.logStats.AddShards(rss)
+	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession, vc.rollbackOnPartialExec, autocommit, func(reply *sqltypes.Result) error {
+		atomic.AddUint64(&vc.logStats.ShardRows, uint64(len(reply.Rows))+reply.RowsAffected)
+		return callback(reply)
+	})
 }
 
 // ExecuteKeyspaceID is part of the engine.VCursor interface.
@@ -523,7 +653,39 @@ func (vc *vcursorImpl) ExecuteKeyspaceID(keyspace string, ksid []byte, query str
 }
 
 func (vc *vcursorImpl) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
-	return vc.resolver.ResolveDestinations(vc.ctx, keyspace, vc.tabletType, ids, destinations)
+	rss, values, err := vc.resolver.ResolveDestinations(vc.ctx, keyspace, vc.tabletType, ids, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := vc.checkShardsPerQuery(rss); err != nil {
+		return nil, nil, err
+	}
+	return rss, values, nil
+}
+
+// checkShardsPerQuery takes note of the shards the query is sent to, and fails the query if they are more than
+// the max_shards_per_query of the session.
+func (vc *vcursorImpl) checkShardsPerQuery(rss []*srvtopo.ResolvedShard) error {
+	var maxShards int64
+	ifOptionsExist(vc.safeSession, func(options *querypb.ExecuteOptions) {
+		maxShards = options.MaxShardsPerQuery
+	})
+	if maxShards == 0 {
+		return nil
+	}
+
+	vc.shardsMu.Lock()
+	defer vc.shardsMu.Unlock()
+	if vc.shards == nil {
+		vc.shards = make(map[string]bool)
+	}
+	for _, rs := range rss {
+		vc.shards[rs.Target.Keyspace+"/"+rs.Target.Shard] = true
+	}
+	if int64(len(vc.shards)) > maxShards {
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query is sent to %d shards, which exceeds the max_shards_per_query of the session: %d", len(vc.shards), maxShards)
+	}
+	return nil
 }
 
 func (vc *vcursorImpl) Session() engine.SessionActions {
@@ -598,6 +760,24 @@ func (vc *vcursorImpl) TabletType() topodatapb.TabletType {
 }
 
 // SubmitOnlineDDL implements the VCursor interface
+// TablesWithApprovalPolicy implements the VCursor interface
+func (vc *vcursorImpl) TablesWithApprovalPolicy(keyspace string) (map[string]bool, error) {
+	if vc.topoServer == nil {
+		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "Unable to check the approval policies of the tables, toposerver unavailable, ensure this vtgate is not using filtered keyspaces")
+	}
+	ownership, err := vc.topoServer.GetSchemaOwnership(vc.ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	tables := make(map[string]bool)
+	for table, tableOwnership := range ownership.Tables {
+		if tableOwnership.ApprovalPolicy != "" {
+			tables[table] = true
+		}
+	}
+	return tables, nil
+}
+
 func (vc *vcursorImpl) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	if vc.topoServer == nil {
 		return vterrors.New(vtrpcpb.Code_INTERNAL, "Unable to apply DDL toposerver unavailable, ensure this vtgate is not using filtered keyspaces")
@@ -679,6 +859,26 @@ func (vc *vcursorImpl) SetWorkload(workload querypb.ExecuteOptions_Workload) {
 	vc.safeSession.GetOrCreateOptions().Workload = workload
 }
 
+// SetTransactionIsolation implements the SessionActions interface
+func (vc *vcursorImpl) SetTransactionIsolation(isolation querypb.ExecuteOptions_TransactionIsolation) {
+	vc.safeSession.GetOrCreateOptions().TransactionIsolation = isolation
+}
+
+// SetTransactionTimeout implements the SessionActions interface
+func (vc *vcursorImpl) SetTransactionTimeout(timeout time.Duration) {
+	vc.safeSession.GetOrCreateOptions().TransactionTimeout = timeout.Milliseconds()
+}
+
+// SetScatterPolicy implements the SessionActions interface
+func (vc *vcursorImpl) SetScatterPolicy(policy querypb.ExecuteOptions_ScatterPolicy) {
+	vc.safeSession.GetOrCreateOptions().ScatterPolicy = policy
+}
+
+// SetMaxShardsPerQuery implements the SessionActions interface
+func (vc *vcursorImpl) SetMaxShardsPerQuery(maxShards int64) {
+	vc.safeSession.GetOrCreateOptions().MaxShardsPerQuery = maxShards
+}
+
 // SetPlannerVersion implements the SessionActions interface
 func (vc *vcursorImpl) SetPlannerVersion(v planbuilder.PlannerVersion) {
 	vc.safeSession.GetOrCreateOptions().PlannerVersion = v
@@ -731,11 +931,27 @@ func (vc *vcursorImpl) SetSessionTrackGTIDs(enable bool) {
 	vc.safeSession.SetSessionTrackGtids(enable)
 }
 
+// SetReadYourWrites implements the SessionActions interface
+func (vc *vcursorImpl) SetReadYourWrites(enable bool) error {
+	vc.safeSession.SetReadYourWrites(enable)
+	return nil
+}
+
 // HasCreatedTempTable implements the SessionActions interface
 func (vc *vcursorImpl) HasCreatedTempTable() {
 	vc.safeSession.GetOrCreateOptions().HasCreatedTempTables = true
 }
 
+// AddTempTable implements the SessionActions interface
+func (vc *vcursorImpl) AddTempTable(name string) {
+	vc.safeSession.AddTempTable(name)
+}
+
+// RemoveTempTable implements the SessionActions interface
+func (vc *vcursorImpl) RemoveTempTable(name string) {
+	vc.safeSession.RemoveTempTable(name)
+}
+
 // GetWarnings implements the SessionActions interface
 func (vc *vcursorImpl) GetWarnings() []*querypb.QueryWarning {
 	return vc.safeSession.GetWarnings()
@@ -791,6 +1007,21 @@ func (vc *vcursorImpl) ForeignKeyMode() string {
 	return strings.ToLower(*foreignKeyMode)
 }
 
+// TempTableKeyspace implements the ContextVSchema interface
+func (vc *vcursorImpl) TempTableKeyspace() (*vindexes.Keyspace, error) {
+	if tempTableKeyspace == nil || *tempTableKeyspace == "" {
+		return nil, nil
+	}
+	ks, ok := vc.vschema.Keyspaces[*tempTableKeyspace]
+	if !ok {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadDb, "Unknown database '%s' in vschema", *tempTableKeyspace)
+	}
+	if ks.Keyspace.Sharded {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "temp table keyspace %s must be unsharded", *tempTableKeyspace)
+	}
+	return ks.Keyspace, nil
+}
+
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
 func parseDestinationTarget(targetString string, vschema *vindexes.VSchema) (string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, dest, err := topoprotopb.ParseDestination(targetString, defaultTabletType)
@@ -803,7 +1034,18 @@ func parseDestinationTarget(targetString string, vschema *vindexes.VSchema) (str
 	return destKeyspace, destTabletType, dest, err
 }
 
+// planPrefixKey returns the prefix of the keys of the plans of the session.
+// The plans of the sessions served different versions of the versioned
+// tables are different.
 func (vc *vcursorImpl) planPrefixKey() string {
+	key := vc.targetPlanPrefixKey()
+	if next := vc.vschema.NextVersionedTables(vc.versionedTableBucket); len(next) > 0 {
+		key += fmt.Sprintf("+NextVersions(%s)", strings.Join(next, ","))
+	}
+	return key
+}
+
+func (vc *vcursorImpl) targetPlanPrefixKey() string {
 	if vc.destination != nil {
 		switch vc.destination.(type) {
 		case key.DestinationKeyspaceID, key.DestinationKeyspaceIDs:
@@ -868,6 +1110,7 @@ func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.Alt
 
 func (vc *vcursorImpl) MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(rss)))
+	vc.logStats.AddShards(rss)
 	return vc.executor.ExecuteMessageStream(vc.ctx, rss, tableName, callback)
 }
 
@@ -294,6 +294,61 @@ func TestPlanPrefixKey(t *testing.T) {
 	}
 }
 
+func TestVersionedTables(t *testing.T) {
+	vs := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			VersionedTables: []*vschemapb.VersionedTable{{
+				FromTable:    "orders",
+				CurrentTable: "ks1.orders",
+				NextTable:    "ks1.orders_v2",
+				NextPercent:  20,
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"orders":    {},
+					"orders_v2": {},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		bucket                uint32
+		expectedTable         string
+		expectedPlanPrefixKey string
+	}{{
+		bucket:                19,
+		expectedTable:         "orders_v2",
+		expectedPlanPrefixKey: "ks1@primary+NextVersions(orders)",
+	}, {
+		bucket:                20,
+		expectedTable:         "orders",
+		expectedPlanPrefixKey: "ks1@primary",
+	}}
+	for _, tc := range tests {
+		t.Run(strconv.Itoa(int(tc.bucket)), func(t *testing.T) {
+			vc, err := newVCursorImpl(context.Background(), NewSafeSession(&vtgatepb.Session{TargetString: "ks1"}), sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: vs}, vs, srvtopo.NewResolver(&fakeTopoServer{}, nil, ""), nil, false)
+			require.NoError(t, err)
+			vc.versionedTableBucket = tc.bucket
+
+			table, ok, err := vc.findVersionedTable("", "orders", topodatapb.TabletType_PRIMARY)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, tc.expectedTable, table.Name.String())
+			require.Equal(t, tc.expectedPlanPrefixKey, vc.planPrefixKey())
+
+			_, ok, _ = vc.findVersionedTable("ks1", "orders_v2", topodatapb.TabletType_PRIMARY)
+			require.False(t, ok)
+		})
+	}
+
+	ss := NewSafeSession(&vtgatepb.Session{SessionUUID: "uuid"})
+	require.Equal(t, versionedTableBucket(ss), versionedTableBucket(ss))
+	require.Less(t, versionedTableBucket(ss), uint32(100))
+}
+
 func TestFirstSortedKeyspace(t *testing.T) {
 	ks1Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "xks1"}}
 	ks2Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "aks2"}}
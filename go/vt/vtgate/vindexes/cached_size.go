@@ -126,6 +126,26 @@ func (cached *ColumnVindex) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ConsistentHash) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	// field shards []vitess.io/vitess/go/vt/vtgate/vindexes.consistentHashShard
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.shards)) * int64(16))
+	}
+	// field ring []vitess.io/vitess/go/vt/vtgate/vindexes.consistentHashPoint
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ring)) * int64(16))
+	}
+	return size
+}
 func (cached *ConsistentLookup) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -162,16 +182,55 @@ func (cached *Hash) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
 	return size
 }
+
+//go:nocheckptr
 func (cached *Keyspace) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(48)
 	}
 	// field Name string
 	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field UDFs map[string]*vitess.io/vitess/go/vt/vtgate/vindexes.UDF
+	if cached.UDFs != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.UDFs)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.UDFs) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k, v := range cached.UDFs {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+			size += v.CachedSize(true)
+		}
+	}
+	// field QueryLimits *vitess.io/vitess/go/vt/vtgate/vindexes.QueryLimits
+	if cached.QueryLimits != nil {
+		size += hack.RuntimeAllocSize(int64(20))
+	}
+	return size
+}
+func (cached *LookupCache) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field table string
+	size += hack.RuntimeAllocSize(int64(len(cached.table)))
+	// field fromColumn string
+	size += hack.RuntimeAllocSize(int64(len(cached.fromColumn)))
+	// field entries vitess.io/vitess/go/vt/vtgate/vindexes.lookupCacheEntries
+	if cc, ok := cached.entries.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
 	return size
 }
 func (cached *LookupHash) CachedSize(alloc bool) int64 {
@@ -365,7 +424,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(208)
 	}
 	// field Type string
 	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
@@ -407,6 +466,29 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Pinned)))
 	}
+	// field ColocatedWith []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ColocatedWith)) * int64(16))
+		for _, elem := range cached.ColocatedWith {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	// field QueryLimits *vitess.io/vitess/go/vt/vtgate/vindexes.QueryLimits
+	if cached.QueryLimits != nil {
+		size += hack.RuntimeAllocSize(int64(20))
+	}
+	return size
+}
+func (cached *UDF) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
 	return size
 }
 func (cached *UnicodeLooseMD5) CachedSize(alloc bool) int64 {
@@ -451,7 +533,7 @@ func (cached *clCommon) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(256)
+		size += int64(288)
 	}
 	// field name string
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
@@ -484,7 +566,7 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
 	// field Table string
 	size += hack.RuntimeAllocSize(int64(len(cached.Table)))
@@ -503,6 +585,8 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.ver)))
 	// field del string
 	size += hack.RuntimeAllocSize(int64(len(cached.del)))
+	// field cache *vitess.io/vitess/go/vt/vtgate/vindexes.LookupCache
+	size += cached.cache.CachedSize(true)
 	return size
 }
 func (cached *prefixCFC) CachedSize(alloc bool) int64 {
@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const defaultConsistentHashVNodes = 160
+
+var (
+	_ SingleColumn = (*ConsistentHash)(nil)
+)
+
+func init() {
+	Register("consistent_hash", NewConsistentHash)
+}
+
+// ConsistentHash defines a vindex which maps the values to the key ranges
+// of a ketama ring, on which each key range has many virtual nodes, and to
+// a keyspace id within their key range.
+// Adding a key range to the ring only maps to it the values of the arcs
+// taken over by its virtual nodes, about 1/n of the values of every other
+// key range, and the other values keep their keyspace ids, whereas a hash
+// vindex moves most rows when the key ranges of all the shards are re-split
+// for one more shard.
+// It's Unique and works on any platform giving identical results.
+//
+// The key ranges of the ring are part of the definition of the vindex, like
+// the params of any other vindex: they are not read from the topo, and the
+// keyspace ids don't depend on the shards of the keyspace. The keyspace can
+// be resharded with Reshard like with any other vindex, since its shards
+// only hold the rows of the keyspace ids of their key ranges. The ring
+// itself must not be changed in the vschema of a keyspace which has rows,
+// since the rows whose keyspace ids change would not be found anymore.
+// Instead, the tables are moved with MoveTables to a keyspace whose vschema
+// has the new ring: the streams of the target keyspace map the rows with
+// its vindex, and VDiff and SwitchTraffic then work as for any MoveTables.
+type ConsistentHash struct {
+	name   string
+	shards []consistentHashShard
+	ring   []consistentHashPoint
+}
+
+// consistentHashShard is the key range of a shard, as the first keyspace id
+// of the range and the number of keyspace ids of the range, which is zero
+// for the whole key space.
+type consistentHashShard struct {
+	start, width uint64
+}
+
+// consistentHashPoint is a virtual node of a shard on the ring.
+type consistentHashPoint struct {
+	hash  uint64
+	shard int
+}
+
+// NewConsistentHash creates a new ConsistentHash. It requires a shards
+// param, which is the comma separated list of the key ranges of the ring.
+// They usually are the key ranges of the shards of the keyspace when the
+// vindex is created, but don't have to stay so. The optional vnodes param is
+// the number of virtual nodes of each key range, 160 by default.
+func NewConsistentHash(name string, m map[string]string) (Vindex, error) {
+	if m["shards"] == "" {
+		return nil, fmt.Errorf("consistent_hash missing shards param")
+	}
+	vnodes := defaultConsistentHashVNodes
+	if s, ok := m["vnodes"]; ok {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("consistent_hash vnodes must be a positive integer: %v", s)
+		}
+		vnodes = n
+	}
+
+	vind := &ConsistentHash{name: name}
+	var keyRanges []*topodatapb.KeyRange
+	for _, shard := range strings.Split(m["shards"], ",") {
+		shard = strings.TrimSpace(shard)
+		kr, err := key.ParseShardingSpec(shard)
+		if err != nil || len(kr) != 1 {
+			return nil, fmt.Errorf("consistent_hash shards must be key ranges: %v", shard)
+		}
+		if len(kr[0].Start) > 8 || len(kr[0].End) > 8 {
+			return nil, fmt.Errorf("consistent_hash shards must be key ranges of keyspace ids of at most 8 bytes: %v", shard)
+		}
+		for _, other := range keyRanges {
+			if key.KeyRangesIntersect(kr[0], other) {
+				return nil, fmt.Errorf("consistent_hash shards %v and %v overlap", key.KeyRangeString(other), shard)
+			}
+		}
+		keyRanges = append(keyRanges, kr[0])
+
+		chs := consistentHashShard{start: uint64Prefix(kr[0].Start)}
+		// The width of the last key range wraps around to the end of the
+		// key space, and is zero for the whole key space.
+		chs.width = uint64Prefix(kr[0].End) - chs.start
+		for i := 0; i < vnodes; i++ {
+			vind.ring = append(vind.ring, consistentHashPoint{
+				hash:  xxhash.Sum64String(key.KeyRangeString(kr[0]) + "#" + strconv.Itoa(i)),
+				shard: len(vind.shards),
+			})
+		}
+		vind.shards = append(vind.shards, chs)
+	}
+	sort.Slice(vind.ring, func(i, j int) bool {
+		if vind.ring[i].hash != vind.ring[j].hash {
+			return vind.ring[i].hash < vind.ring[j].hash
+		}
+		return vind.ring[i].shard < vind.ring[j].shard
+	})
+	return vind, nil
+}
+
+// String returns the name of the vindex.
+func (vind *ConsistentHash) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this index as 1.
+func (vind *ConsistentHash) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (vind *ConsistentHash) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *ConsistentHash) NeedsVCursor() bool {
+	return false
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *ConsistentHash) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(ids))
+	for i := range ids {
+		out[i] = key.DestinationKeyspaceID(vind.keyspaceID(ids[i].ToBytes()))
+	}
+	return out, nil
+}
+
+// Verify returns true if ids maps to ksids.
+func (vind *ConsistentHash) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		out[i] = bytes.Equal(vind.keyspaceID(ids[i].ToBytes()), ksids[i])
+	}
+	return out, nil
+}
+
+// keyspaceID returns the keyspace id of a value: the hash of the value is
+// mapped to the shard of the first virtual node at or after it on the ring,
+// and then to a keyspace id of the key range of the shard.
+func (vind *ConsistentHash) keyspaceID(value []byte) []byte {
+	hash := xxhash.Sum64(value)
+	i := sort.Search(len(vind.ring), func(i int) bool {
+		return vind.ring[i].hash >= hash
+	})
+	if i == len(vind.ring) {
+		i = 0
+	}
+	shard := vind.shards[vind.ring[i].shard]
+	ksid := hash
+	if shard.width != 0 {
+		ksid = shard.start + hash%shard.width
+	}
+	var out [8]byte
+	binary.BigEndian.PutUint64(out[:], ksid)
+	return out[:]
+}
+
+// uint64Prefix returns the keyspace id of a key range bound as an uint64.
+func uint64Prefix(bound []byte) uint64 {
+	var padded [8]byte
+	copy(padded[:], bound)
+	return binary.BigEndian.Uint64(padded[:])
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+func createConsistentHash(t *testing.T, shards string) SingleColumn {
+	t.Helper()
+	vindex, err := CreateVindex("consistent_hash", "consistent_hash_name", map[string]string{"shards": shards})
+	require.NoError(t, err)
+	return vindex.(SingleColumn)
+}
+
+func TestConsistentHashInfo(t *testing.T) {
+	vindex := createConsistentHash(t, "-80,80-")
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, "consistent_hash_name", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+}
+
+func TestConsistentHashParams(t *testing.T) {
+	tcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "consistent_hash missing shards param",
+	}, {
+		params: map[string]string{"shards": "-80,80-", "vnodes": "0"},
+		err:    "consistent_hash vnodes must be a positive integer: 0",
+	}, {
+		params: map[string]string{"shards": "-80,zz-"},
+		err:    "consistent_hash shards must be key ranges: zz-",
+	}, {
+		params: map[string]string{"shards": "-0102030405060708090a"},
+		err:    "consistent_hash shards must be key ranges of keyspace ids of at most 8 bytes: -0102030405060708090a",
+	}, {
+		params: map[string]string{"shards": "-80,40-"},
+		err:    "consistent_hash shards -80 and 40- overlap",
+	}, {
+		params: map[string]string{"shards": "-40, 40-80 ,80-", "vnodes": "10"},
+	}, {
+		params: map[string]string{"shards": "0"},
+	}}
+	for _, tcase := range tcases {
+		_, err := CreateVindex("consistent_hash", "consistent_hash_name", tcase.params)
+		if tcase.err == "" {
+			assert.NoError(t, err, tcase.params)
+		} else {
+			assert.EqualError(t, err, tcase.err, tcase.params)
+		}
+	}
+}
+
+func TestConsistentHashMap(t *testing.T) {
+	shards := []string{"-40", "40-80", "80-c0", "c0-"}
+	vindex := createConsistentHash(t, "-40,40-80,80-c0,c0-")
+
+	var ids []sqltypes.Value
+	for i := 0; i < 4000; i++ {
+		ids = append(ids, sqltypes.NewInt64(int64(i)))
+	}
+	ids = append(ids, sqltypes.NewVarChar("test1"), sqltypes.NULL)
+	got, err := vindex.Map(nil, ids)
+	require.NoError(t, err)
+
+	counts := make(map[string]int)
+	for i, dest := range got {
+		ksid := []byte(dest.(key.DestinationKeyspaceID))
+		require.Len(t, ksid, 8)
+		shard := ""
+		for _, s := range shards {
+			kr, err := key.ParseShardingSpec(s)
+			require.NoError(t, err)
+			if key.KeyRangeContains(kr[0], ksid) {
+				shard = s
+			}
+		}
+		require.NotEmpty(t, shard, ids[i])
+		counts[shard]++
+
+		// The mapping is stable.
+		again, err := vindex.Map(nil, []sqltypes.Value{ids[i]})
+		require.NoError(t, err)
+		assert.Equal(t, dest, again[0])
+	}
+	// The virtual nodes spread the values over all the shards.
+	for _, s := range shards {
+		assert.Greater(t, counts[s], 700, s)
+	}
+}
+
+func TestConsistentHashAddShard(t *testing.T) {
+	before := createConsistentHash(t, "-40,40-80,80-c0")
+	after := createConsistentHash(t, "-40,40-80,80-c0,c0-")
+
+	var ids []sqltypes.Value
+	for i := 0; i < 4000; i++ {
+		ids = append(ids, sqltypes.NewInt64(int64(i)))
+	}
+	ksidsBefore, err := before.Map(nil, ids)
+	require.NoError(t, err)
+	ksidsAfter, err := after.Map(nil, ids)
+	require.NoError(t, err)
+
+	moved := 0
+	for i := range ids {
+		b := []byte(ksidsBefore[i].(key.DestinationKeyspaceID))
+		a := []byte(ksidsAfter[i].(key.DestinationKeyspaceID))
+		if bytes.Equal(a, b) {
+			continue
+		}
+		// Only the values taken over by the new shard move.
+		moved++
+		assert.GreaterOrEqual(t, a[0], byte(0xc0), ids[i])
+	}
+	assert.Greater(t, moved, 700)
+	assert.Less(t, moved, 1300)
+}
+
+func TestConsistentHashVerify(t *testing.T) {
+	vindex := createConsistentHash(t, "-80,80-")
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+	dests, err := vindex.Map(nil, ids)
+	require.NoError(t, err)
+	ksid := []byte(dests[0].(key.DestinationKeyspaceID))
+
+	got, err := vindex.Verify(nil, ids, [][]byte{ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+}
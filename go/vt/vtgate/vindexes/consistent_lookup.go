@@ -37,9 +37,11 @@ import (
 var (
 	_ SingleColumn  = (*ConsistentLookupUnique)(nil)
 	_ Lookup        = (*ConsistentLookupUnique)(nil)
+	_ LookupCacher  = (*ConsistentLookupUnique)(nil)
 	_ WantOwnerInfo = (*ConsistentLookupUnique)(nil)
 	_ SingleColumn  = (*ConsistentLookup)(nil)
 	_ Lookup        = (*ConsistentLookup)(nil)
+	_ LookupCacher  = (*ConsistentLookup)(nil)
 	_ WantOwnerInfo = (*ConsistentLookup)(nil)
 )
 
@@ -300,6 +302,7 @@ func (lu *clCommon) handleDup(vcursor VCursor, values []sqltypes.Value, ksid []b
 		if _, err := vcursor.Execute("VindexCreate", lu.updateLookupQuery, bindVars, true /* rollbackOnError */, vtgatepb.CommitOrder_PRE); err != nil {
 			return err
 		}
+		lu.lkp.invalidate(vcursor, [][]sqltypes.Value{values})
 	default:
 		return fmt.Errorf("unexpected rows: %v from consistent lookup vindex", qr.Rows)
 	}
@@ -332,6 +335,11 @@ func (lu *clCommon) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []b
 	return lu.Create(vcursor, [][]sqltypes.Value{newValues}, [][]byte{ksid}, false /* ignoreMode */)
 }
 
+// LookupCache implements the LookupCacher interface.
+func (lu *clCommon) LookupCache() *LookupCache {
+	return lu.lkp.cache
+}
+
 // MarshalJSON returns a JSON representation of clCommon.
 func (lu *clCommon) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lu.lkp)
@@ -511,6 +511,14 @@ func (vc *loggingVCursor) InTransactionAndIsDML() bool {
 	return false
 }
 
+func (vc *loggingVCursor) InTransaction() bool {
+	return false
+}
+
+func (vc *loggingVCursor) AfterTransaction(f func()) {
+	f()
+}
+
 type bv struct {
 	Name string
 	Bv   string
@@ -29,8 +29,10 @@ import (
 var (
 	_ SingleColumn = (*LookupUnique)(nil)
 	_ Lookup       = (*LookupUnique)(nil)
+	_ LookupCacher = (*LookupUnique)(nil)
 	_ SingleColumn = (*LookupNonUnique)(nil)
 	_ Lookup       = (*LookupNonUnique)(nil)
+	_ LookupCacher = (*LookupNonUnique)(nil)
 )
 
 func init() {
@@ -129,6 +131,11 @@ func (ln *LookupNonUnique) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 	return ln.lkp.Update(vcursor, oldValues, ksid, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), newValues)
 }
 
+// LookupCache implements the LookupCacher interface.
+func (ln *LookupNonUnique) LookupCache() *LookupCache {
+	return ln.lkp.cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ln.lkp)
@@ -143,6 +150,8 @@ func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of ids whose rows are cached by vtgate, 0 disables the cache.
+//   cache_ttl: how long the rows are cached, like "30s". The default is a minute.
 func NewLookup(name string, m map[string]string) (Vindex, error) {
 	lookup := &LookupNonUnique{name: name}
 
@@ -190,6 +199,8 @@ type LookupUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of ids whose rows are cached by vtgate, 0 disables the cache.
+//   cache_ttl: how long the rows are cached, like "30s". The default is a minute.
 func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	lu := &LookupUnique{name: name}
 
@@ -282,6 +293,11 @@ func (lu *LookupUnique) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value
 	return lu.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_NORMAL)
 }
 
+// LookupCache implements the LookupCacher interface.
+func (lu *LookupUnique) LookupCache() *LookupCache {
+	return lu.lkp.cache
+}
+
 // MarshalJSON returns a JSON representation of LookupUnique.
 func (lu *LookupUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lu.lkp)
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	lookupCacheHits          = stats.NewCountersWithSingleLabel("VindexLookupCacheHits", "Number of lookup vindex ids found in the lookup cache", "Table")
+	lookupCacheMisses        = stats.NewCountersWithSingleLabel("VindexLookupCacheMisses", "Number of lookup vindex ids looked up in their table", "Table")
+	lookupCacheInvalidations = stats.NewCountersWithSingleLabel("VindexLookupCacheInvalidations", "Number of lookup vindex ids invalidated in the lookup cache because their rows changed", "Table")
+)
+
+// defaultLookupCacheTTL is the TTL of the lookup cache entries if the vindex
+// does not set cache_ttl.
+const defaultLookupCacheTTL = time.Minute
+
+// LookupCacher is implemented by the lookup vindexes, which can cache the
+// rows of their lookup table.
+type LookupCacher interface {
+	// LookupCache returns nil if the vindex does not cache its rows.
+	LookupCache() *LookupCache
+}
+
+// LookupCache caches the rows of a lookup table by from value, so that the
+// lookups of hot ids do not query the table every time. Entries expire
+// after a TTL, and are invalidated when vtgate modifies the rows of an owned
+// vindex, and again once the transaction which modified them ends. Changes
+// made through other vtgates are only seen once the entries expire, unless
+// the cache is also invalidated from a vstream of the table.
+//
+// The ids are cached by their value converted to the type of the from
+// column, as returned by the lookups, so that the ids which match the same
+// rows share an entry however they are typed, like 1 and '01' for an
+// integral column. Only the ids of integral and binary columns are cached:
+// the other types are compared with collations or conversions, which would
+// let ids that differ match the same rows.
+type LookupCache struct {
+	table      string
+	fromColumn string
+	ttl        time.Duration
+	entries    lookupCacheEntries
+	// columnType is the type of the from column, or NULL_TYPE until a
+	// lookup returns it.
+	columnType sync2.AtomicInt32
+}
+
+// lookupCacheEntries is the part of the cache.LRUCache used by the lookup
+// cache. The cached rows are not accounted in the size of the plans which
+// use the vindex, and are not walked when the plans are sized.
+type lookupCacheEntries interface {
+	Get(key string) (interface{}, bool)
+	Set(key string, value interface{}) bool
+	Delete(key string)
+	Clear()
+}
+
+type lookupCacheEntry struct {
+	rows   [][]sqltypes.Value
+	expiry time.Time
+}
+
+// newLookupCache returns nil if cache_size is not set. The cache is bounded
+// by the number of cached ids.
+func newLookupCache(m map[string]string, table, fromColumn string) (*LookupCache, error) {
+	val, ok := m["cache_size"]
+	if !ok {
+		return nil, nil
+	}
+	size, err := strconv.Atoi(val)
+	if err != nil || size < 0 {
+		return nil, fmt.Errorf("cache_size value must be a positive integer: '%s'", val)
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	ttl := defaultLookupCacheTTL
+	if val, ok := m["cache_ttl"]; ok {
+		ttl, err = time.ParseDuration(val)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("cache_ttl value must be a positive duration: '%s'", val)
+		}
+	}
+	return &LookupCache{
+		table:      table,
+		fromColumn: fromColumn,
+		ttl:        ttl,
+		entries:    cache.NewLRUCache(int64(size), func(interface{}) int64 { return 1 }),
+	}, nil
+}
+
+// Table returns the lookup table.
+func (lc *LookupCache) Table() string {
+	return lc.table
+}
+
+// FromColumn returns the column of the lookup table the ids are looked up
+// by.
+func (lc *LookupCache) FromColumn() string {
+	return lc.fromColumn
+}
+
+// setColumnType records the type of the from column, from the fields of a
+// lookup.
+func (lc *LookupCache) setColumnType(fields []*querypb.Field) {
+	if len(fields) != 0 {
+		lc.columnType.Set(int32(fields[0].Type))
+	}
+}
+
+// key returns the key of the rows of an id, which is the id converted to the
+// type of the from column, along with that type. It returns false if the id
+// is not cached.
+func (lc *LookupCache) key(id sqltypes.Value) (string, bool) {
+	typ := querypb.Type(lc.columnType.Get())
+	switch {
+	case sqltypes.IsIntegral(typ):
+		if ival, err := evalengine.ToInt64(id); err == nil {
+			return typ.String() + ":" + strconv.FormatInt(ival, 10), true
+		}
+		if uval, err := evalengine.ToUint64(id); err == nil {
+			return typ.String() + ":" + strconv.FormatUint(uval, 10), true
+		}
+	case sqltypes.IsBinary(typ):
+		// numbers are compared to a binary column as numbers
+		if id.IsQuoted() {
+			return typ.String() + ":" + id.ToString(), true
+		}
+	}
+	return "", false
+}
+
+func (lc *LookupCache) get(id sqltypes.Value) ([][]sqltypes.Value, bool) {
+	key, ok := lc.key(id)
+	if ok {
+		if v, ok := lc.entries.Get(key); ok {
+			entry := v.(*lookupCacheEntry)
+			if time.Now().Before(entry.expiry) {
+				lookupCacheHits.Add(lc.table, 1)
+				return entry.rows, true
+			}
+			lc.entries.Delete(key)
+		}
+	}
+	lookupCacheMisses.Add(lc.table, 1)
+	return nil, false
+}
+
+func (lc *LookupCache) put(id sqltypes.Value, rows [][]sqltypes.Value) {
+	if key, ok := lc.key(id); ok {
+		lc.entries.Set(key, &lookupCacheEntry{rows: rows, expiry: time.Now().Add(lc.ttl)})
+	}
+}
+
+// Invalidate removes the rows of the ids from the cache.
+func (lc *LookupCache) Invalidate(ids []sqltypes.Value) {
+	for _, id := range ids {
+		if key, ok := lc.key(id); ok {
+			lc.entries.Delete(key)
+		}
+	}
+	lookupCacheInvalidations.Add(lc.table, int64(len(ids)))
+}
+
+// Clear removes all the rows from the cache.
+func (lc *LookupCache) Clear() {
+	lc.entries.Clear()
+}
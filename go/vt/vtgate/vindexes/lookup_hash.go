@@ -31,8 +31,10 @@ import (
 var (
 	_ SingleColumn = (*LookupHash)(nil)
 	_ Lookup       = (*LookupHash)(nil)
+	_ LookupCacher = (*LookupHash)(nil)
 	_ SingleColumn = (*LookupHashUnique)(nil)
 	_ Lookup       = (*LookupHashUnique)(nil)
+	_ LookupCacher = (*LookupHashUnique)(nil)
 )
 
 func init() {
@@ -186,6 +188,11 @@ func (lh *LookupHash) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 	return lh.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// LookupCache implements the LookupCacher interface.
+func (lh *LookupHash) LookupCache() *LookupCache {
+	return lh.lkp.cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (lh *LookupHash) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lh.lkp)
@@ -340,6 +347,11 @@ func (lhu *LookupHashUnique) Update(vcursor VCursor, oldValues []sqltypes.Value,
 	return lhu.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// LookupCache implements the LookupCacher interface.
+func (lhu *LookupHashUnique) LookupCache() *LookupCache {
+	return lhu.lkp.cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHashUnique.
 func (lhu *LookupHashUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lhu.lkp)
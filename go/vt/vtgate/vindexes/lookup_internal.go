@@ -39,6 +39,7 @@ type lookupInternal struct {
 	IgnoreNulls   bool     `json:"ignore_nulls,omitempty"`
 	BatchLookup   bool     `json:"batch_lookup,omitempty"`
 	sel, ver, del string
+	cache         *LookupCache
 }
 
 func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit, upsert bool) error {
@@ -69,14 +70,47 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	lkp.sel = fmt.Sprintf("select %s, %s from %s where %s in ::%s", lkp.FromColumns[0], lkp.To, lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0])
 	lkp.ver = fmt.Sprintf("select %s from %s where %s = :%s and %s = :%s", lkp.FromColumns[0], lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0], lkp.To, lkp.To)
 	lkp.del = lkp.initDelStmt()
-	return nil
+	lkp.cache, err = newLookupCache(lookupQueryParams, lkp.Table, lkp.FromColumns[0])
+	return err
 }
 
-// Lookup performs a lookup for the ids.
+// Lookup performs a lookup for the ids. The ids found in the cache are not
+// looked up, unless the lookup is part of a transaction, which needs to see
+// its own changes and to lock the rows of its DMLs.
 func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtgatepb.CommitOrder) ([]*sqltypes.Result, error) {
 	if vcursor == nil {
 		return nil, fmt.Errorf("cannot perform lookup: no vcursor provided")
 	}
+	if lkp.cache == nil || vcursor.InTransaction() {
+		return lkp.lookup(vcursor, ids, co)
+	}
+
+	results := make([]*sqltypes.Result, len(ids))
+	var missing []sqltypes.Value
+	var missingIndexes []int
+	for i, id := range ids {
+		if rows, ok := lkp.cache.get(id); ok {
+			results[i] = &sqltypes.Result{Rows: rows}
+			continue
+		}
+		missing = append(missing, id)
+		missingIndexes = append(missingIndexes, i)
+	}
+	if len(missing) == 0 {
+		return results, nil
+	}
+	missingResults, err := lkp.lookup(vcursor, missing, co)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range missingResults {
+		lkp.cache.put(missing[i], result.Rows)
+		results[missingIndexes[i]] = result
+	}
+	return results, nil
+}
+
+func (lkp *lookupInternal) lookup(vcursor VCursor, ids []sqltypes.Value, co vtgatepb.CommitOrder) ([]*sqltypes.Result, error) {
 	results := make([]*sqltypes.Result, 0, len(ids))
 	if lkp.Autocommit {
 		co = vtgatepb.CommitOrder_AUTOCOMMIT
@@ -98,6 +132,9 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtga
 		if err != nil {
 			return nil, fmt.Errorf("lookup.Map: %v", err)
 		}
+		if lkp.cache != nil {
+			lkp.cache.setColumnType(result.Fields)
+		}
 		resultMap := make(map[string][][]sqltypes.Value)
 		for _, row := range result.Rows {
 			resultMap[row[0].ToString()] = append(resultMap[row[0].ToString()], []sqltypes.Value{row[1]})
@@ -123,6 +160,9 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtga
 			if err != nil {
 				return nil, fmt.Errorf("lookup.Map: %v", err)
 			}
+			if lkp.cache != nil {
+				lkp.cache.setColumnType(result.Fields)
+			}
 			rows := make([][]sqltypes.Value, 0, len(result.Rows))
 			for _, row := range result.Rows {
 				rows = append(rows, []sqltypes.Value{row[1]})
@@ -272,6 +312,7 @@ nextRow:
 	if _, err := vcursor.Execute("VindexCreate", buf.String(), bindVars, true /* rollbackOnError */, co); err != nil {
 		return fmt.Errorf("lookup.Create: %v", err)
 	}
+	lkp.invalidate(vcursor, trimmedRowsCols)
 	return nil
 }
 
@@ -315,9 +356,26 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 			return fmt.Errorf("lookup.Delete: %v", err)
 		}
 	}
+	lkp.invalidate(vcursor, rowsColValues)
 	return nil
 }
 
+// invalidate removes the rows modified by this vtgate from the cache. If the
+// modification is part of a transaction, the rows can be cached again by
+// other sessions before it commits, so they are invalidated again once the
+// transaction ends.
+func (lkp *lookupInternal) invalidate(vcursor VCursor, rowsColValues [][]sqltypes.Value) {
+	if lkp.cache == nil {
+		return
+	}
+	ids := make([]sqltypes.Value, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		ids = append(ids, row[0])
+	}
+	lkp.cache.Invalidate(ids)
+	vcursor.AfterTransaction(func() { lkp.cache.Invalidate(ids) })
+}
+
 // Update implements the update functionality.
 func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, toValue sqltypes.Value, newValues []sqltypes.Value) error {
 	if err := lkp.Delete(vcursor, [][]sqltypes.Value{oldValues}, toValue, vtgatepb.CommitOrder_NORMAL); err != nil {
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/test/utils"
 
@@ -42,7 +43,10 @@ import (
 var _ VCursor = (*vcursor)(nil)
 
 type vcursor struct {
-	mustFail    bool
+	mustFail bool
+	inTx     bool
+	// afterTx are the functions to call once the transaction ends
+	afterTx     []func()
 	numRows     int
 	result      *sqltypes.Result
 	queries     []*querypb.BoundQuery
@@ -59,6 +63,26 @@ func (vc *vcursor) InTransactionAndIsDML() bool {
 	return false
 }
 
+func (vc *vcursor) InTransaction() bool {
+	return vc.inTx
+}
+
+func (vc *vcursor) AfterTransaction(f func()) {
+	if !vc.inTx {
+		f()
+		return
+	}
+	vc.afterTx = append(vc.afterTx, f)
+}
+
+// endTx ends the transaction of the vcursor.
+func (vc *vcursor) endTx() {
+	for _, f := range vc.afterTx {
+		f()
+	}
+	vc.inTx, vc.afterTx = false, nil
+}
+
 func (vc *vcursor) Execute(method string, query string, bindvars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
 	switch co {
 	case vtgatepb.CommitOrder_PRE:
@@ -572,3 +596,125 @@ func createLookup(t *testing.T, name string, writeOnly bool) SingleColumn {
 	}
 	return l.(SingleColumn)
 }
+
+func TestLookupNonUniqueCache(t *testing.T) {
+	l, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "10",
+	})
+	require.NoError(t, err)
+	lookupNonUnique := l.(SingleColumn)
+	cache := l.(LookupCacher).LookupCache()
+	require.NotNil(t, cache)
+	assert.Equal(t, "t", cache.Table())
+	assert.Equal(t, "fromc", cache.FromColumn())
+	vc := &vcursor{numRows: 1}
+
+	hits, misses := lookupCacheHits.Counts()["t"], lookupCacheMisses.Counts()["t"]
+	want := []key.Destination{key.DestinationKeyspaceIDs([][]byte{[]byte("1")})}
+	got, err := lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	got, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Len(t, vc.queries, 1)
+	assert.Equal(t, hits+1, lookupCacheHits.Counts()["t"])
+	assert.Equal(t, misses+1, lookupCacheMisses.Counts()["t"])
+
+	// Only the missing ids are looked up.
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 2)
+	vars, err := sqltypes.BuildBindVariable([]interface{}{sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	utils.MustMatch(t, vars, vc.queries[1].BindVariables["fromc"])
+
+	// The modified ids are invalidated.
+	err = lookupNonUnique.(Lookup).Delete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, []byte("1"))
+	require.NoError(t, err)
+	err = lookupNonUnique.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(2)}}, [][]byte{[]byte("2")}, false)
+	require.NoError(t, err)
+	vc.queries = nil
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 1)
+
+	// Transactions do not use the cache, and the ids they modify are
+	// invalidated again once they end.
+	vc.inTx = true
+	vc.queries = nil
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 1)
+	err = lookupNonUnique.(Lookup).Delete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, []byte("1"))
+	require.NoError(t, err)
+	require.Len(t, vc.afterTx, 1)
+	cache.put(sqltypes.NewInt64(1), nil)
+	vc.endTx()
+	_, ok := cache.get(sqltypes.NewInt64(1))
+	assert.False(t, ok, "entry cached during the transaction")
+
+	cache.Clear()
+	vc.queries = nil
+	_, err = lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 1)
+}
+
+func TestLookupCacheKey(t *testing.T) {
+	lc, err := newLookupCache(map[string]string{"cache_size": "10"}, "t", "fromc")
+	require.NoError(t, err)
+
+	// Nothing is cached until the type of the from column is known.
+	lc.put(sqltypes.NewInt64(1), nil)
+	_, ok := lc.get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+
+	lc.setColumnType(sqltypes.MakeTestFields("fromc|toc", "int64|varbinary"))
+	lc.put(sqltypes.NewInt64(1), [][]sqltypes.Value{{sqltypes.NewInt64(1)}})
+	for _, id := range []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewUint64(1), sqltypes.NewVarChar("01")} {
+		_, ok = lc.get(id)
+		assert.True(t, ok, "%v", id)
+	}
+	lc.Invalidate([]sqltypes.Value{sqltypes.NewVarChar("1")})
+	_, ok = lc.get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+
+	lc.Clear()
+	lc.setColumnType(sqltypes.MakeTestFields("fromc|toc", "varbinary|varbinary"))
+	lc.put(sqltypes.NewVarBinary("a"), nil)
+	_, ok = lc.get(sqltypes.NewVarChar("a"))
+	assert.True(t, ok)
+	lc.put(sqltypes.NewInt64(1), nil)
+	_, ok = lc.get(sqltypes.NewInt64(1))
+	assert.False(t, ok, "numbers are not cached for binary columns")
+
+	// Collations can match ids which differ, so text columns are not cached.
+	lc.Clear()
+	lc.setColumnType(sqltypes.MakeTestFields("fromc|toc", "varchar|varbinary"))
+	lc.put(sqltypes.NewVarChar("a"), nil)
+	_, ok = lc.get(sqltypes.NewVarChar("a"))
+	assert.False(t, ok)
+}
+
+func TestLookupCacheParams(t *testing.T) {
+	lc, err := newLookupCache(map[string]string{"cache_size": "0"}, "t", "fromc")
+	require.NoError(t, err)
+	assert.Nil(t, lc)
+
+	lc, err = newLookupCache(map[string]string{"cache_size": "1", "cache_ttl": "1ms"}, "t", "fromc")
+	require.NoError(t, err)
+	lc.setColumnType(sqltypes.MakeTestFields("fromc", "int64"))
+	lc.put(sqltypes.NewInt64(1), nil)
+	time.Sleep(2 * time.Millisecond)
+	_, ok := lc.get(sqltypes.NewInt64(1))
+	assert.False(t, ok, "expired entry")
+
+	_, err = newLookupCache(map[string]string{"cache_size": "a"}, "t", "fromc")
+	assert.EqualError(t, err, "cache_size value must be a positive integer: 'a'")
+	_, err = newLookupCache(map[string]string{"cache_size": "1", "cache_ttl": "1"}, "t", "fromc")
+	assert.EqualError(t, err, "cache_ttl value must be a positive duration: '1'")
+}
@@ -32,8 +32,10 @@ import (
 var (
 	_ SingleColumn = (*LookupUnicodeLooseMD5Hash)(nil)
 	_ Lookup       = (*LookupUnicodeLooseMD5Hash)(nil)
+	_ LookupCacher = (*LookupUnicodeLooseMD5Hash)(nil)
 	_ SingleColumn = (*LookupUnicodeLooseMD5HashUnique)(nil)
 	_ Lookup       = (*LookupUnicodeLooseMD5HashUnique)(nil)
+	_ LookupCacher = (*LookupUnicodeLooseMD5HashUnique)(nil)
 )
 
 func init() {
@@ -211,6 +213,11 @@ func (lh *LookupUnicodeLooseMD5Hash) Delete(vcursor VCursor, rowsColValues [][]s
 	return lh.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// LookupCache implements the LookupCacher interface.
+func (lh *LookupUnicodeLooseMD5Hash) LookupCache() *LookupCache {
+	return lh.lkp.cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (lh *LookupUnicodeLooseMD5Hash) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lh.lkp)
@@ -376,6 +383,11 @@ func (lhu *LookupUnicodeLooseMD5HashUnique) Update(vcursor VCursor, oldValues []
 	return lhu.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// LookupCache implements the LookupCacher interface.
+func (lhu *LookupUnicodeLooseMD5HashUnique) LookupCache() *LookupCache {
+	return lhu.lkp.cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHashUnique.
 func (lhu *LookupUnicodeLooseMD5HashUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lhu.lkp)
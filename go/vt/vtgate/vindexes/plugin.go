@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"flag"
+	"fmt"
+	"plugin"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// Custom vindexes are registered like the vindexes of this package, by
+// calling Register from the init function of their package, and are then
+// referenced by their vindex type in the VSchema. Their package can either
+// be linked into the binaries, by importing it from a plugin_*.go file of
+// their main package, or be compiled as a Go plugin with
+// go build -buildmode=plugin, against the same Vitess sources as the
+// binaries, and loaded from the -vindex_plugins flag. Every binary building
+// a VSchema, like vtgate, vttablet, vtctld or vtctl, loads them before it
+// creates its first vindex.
+//
+// A vindex must implement either SingleColumn or MultiColumn, return the
+// name it was created with from String, have a non-negative Cost, and need
+// a VCursor if it is a Lookup vindex. CreateVindex checks this contract for
+// the vindexes of the plugins whenever the VSchema creates one, and recovers
+// from their panics, so that a vindex breaking it fails the load of the
+// VSchema of its keyspace instead of being used.
+
+// vindexPlugins are the Go plugins registering custom vindex types.
+var vindexPlugins flagutil.StringListValue
+
+func init() {
+	flag.Var(&vindexPlugins, "vindex_plugins", "comma separated list of paths of Go plugins registering custom vindex types, which are loaded before the first vindex is created. The plugins must be built with -buildmode=plugin against the same Vitess sources as the binary")
+}
+
+var (
+	loadFlagPluginsOnce sync.Once
+	loadFlagPluginsErr  error
+)
+
+// LoadFlagPlugins loads the plugins of -vindex_plugins, the first time it
+// is called. CreateVindex calls it, and the servers call it when they start
+// so that they fail right away if a plugin can't be loaded.
+func LoadFlagPlugins() error {
+	loadFlagPluginsOnce.Do(func() {
+		loadFlagPluginsErr = LoadPlugins(vindexPlugins)
+	})
+	return loadFlagPluginsErr
+}
+
+// pluginTypes are the vindex types registered by the Go plugins loaded by
+// LoadPlugins, with the path of their plugin.
+var pluginTypes = make(map[string]string)
+
+// LoadPlugins loads the Go plugins of paths, which register their vindex
+// types from their init functions. It fails if a plugin can't be loaded,
+// panics while loading, or does not register any vindex type.
+func LoadPlugins(paths []string) error {
+	for _, path := range paths {
+		types, err := loadPlugin(path)
+		if err != nil {
+			return err
+		}
+		log.Infof("Loaded vindex plugin %s, registering vindex types %v", path, types)
+	}
+	return nil
+}
+
+func loadPlugin(path string) (types []string, err error) {
+	registered := make(map[string]bool, len(registry))
+	for vindexType := range registry {
+		registered[vindexType] = true
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("vindex plugin %s panicked while loading: %v", path, r)
+		}
+	}()
+	if _, err := plugin.Open(path); err != nil {
+		return nil, fmt.Errorf("cannot load vindex plugin %s: %v", path, err)
+	}
+	for vindexType := range registry {
+		if !registered[vindexType] {
+			pluginTypes[vindexType] = path
+			types = append(types, vindexType)
+		}
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("vindex plugin %s did not register any vindex type", path)
+	}
+	sort.Strings(types)
+	return types, nil
+}
+
+// createPluginVindex creates a vindex of a type registered by a plugin, and
+// validates it.
+func createPluginVindex(f NewVindexFunc, path, vindexType, name string, params map[string]string) (vindex Vindex, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			vindex, err = nil, fmt.Errorf("vindexType %q of plugin %s panicked creating %s: %v", vindexType, path, name, r)
+		}
+	}()
+	vindex, err = f(name, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVindex(vindexType, name, vindex); err != nil {
+		return nil, err
+	}
+	return vindex, nil
+}
+
+// validateVindex returns an error if the vindex does not satisfy the
+// contract of the vindexes.
+func validateVindex(vindexType, name string, vindex Vindex) error {
+	if vindex == nil {
+		return fmt.Errorf("vindexType %q created a nil vindex for %s", vindexType, name)
+	}
+	switch vindex.(type) {
+	case SingleColumn, MultiColumn:
+	default:
+		return fmt.Errorf("vindexType %q does not implement SingleColumn or MultiColumn: %T", vindexType, vindex)
+	}
+	if vindex.String() != name {
+		return fmt.Errorf("vindexType %q created vindex %s with name %s", vindexType, name, vindex.String())
+	}
+	if cost := vindex.Cost(); cost < 0 {
+		return fmt.Errorf("vindexType %q has a negative cost: %d", vindexType, cost)
+	}
+	if _, ok := vindex.(Lookup); ok && !vindex.NeedsVCursor() {
+		return fmt.Errorf("vindexType %q is a lookup vindex which does not need a VCursor", vindexType)
+	}
+	return nil
+}
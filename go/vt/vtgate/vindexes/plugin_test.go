@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// badVindex is a Vindex which implements neither SingleColumn nor
+// MultiColumn.
+type badVindex struct {
+	name string
+	cost int
+}
+
+func (v *badVindex) String() string   { return v.name }
+func (v *badVindex) Cost() int        { return v.cost }
+func (*badVindex) IsUnique() bool     { return true }
+func (*badVindex) NeedsVCursor() bool { return false }
+
+// badLookup is a lookup vindex which does not need a VCursor.
+type badLookup struct {
+	stFU
+}
+
+func (*badLookup) Create(VCursor, [][]sqltypes.Value, [][]byte, bool) error         { return nil }
+func (*badLookup) Delete(VCursor, [][]sqltypes.Value, []byte) error                 { return nil }
+func (*badLookup) Update(VCursor, []sqltypes.Value, []byte, []sqltypes.Value) error { return nil }
+
+func init() {
+	Register("contract_nil", func(string, map[string]string) (Vindex, error) {
+		return nil, nil
+	})
+	Register("contract_no_map", func(name string, _ map[string]string) (Vindex, error) {
+		return &badVindex{name: name}, nil
+	})
+	Register("contract_name", func(name string, _ map[string]string) (Vindex, error) {
+		return &stFU{name: "other"}, nil
+	})
+	Register("contract_cost", func(name string, _ map[string]string) (Vindex, error) {
+		return &cheapVindexWithCost{stFU: stFU{name: name}, cost: -1}, nil
+	})
+	Register("contract_lookup", func(name string, _ map[string]string) (Vindex, error) {
+		return &badLookup{stFU: stFU{name: name}}, nil
+	})
+	Register("contract_panic", func(string, map[string]string) (Vindex, error) {
+		panic("bad plugin")
+	})
+	Register("contract_valid", NewSTFU)
+}
+
+type cheapVindexWithCost struct {
+	stFU
+	cost int
+}
+
+func (v *cheapVindexWithCost) Cost() int { return v.cost }
+
+// withContractPlugin makes the contract_* vindex types plugin types.
+func withContractPlugin(t *testing.T) {
+	t.Helper()
+	for vindexType := range registry {
+		if strings.HasPrefix(vindexType, "contract_") {
+			pluginTypes[vindexType] = "contract.so"
+		}
+	}
+	t.Cleanup(func() {
+		for vindexType, path := range pluginTypes {
+			if path == "contract.so" {
+				delete(pluginTypes, vindexType)
+			}
+		}
+	})
+}
+
+func TestCreateVindexContract(t *testing.T) {
+	withContractPlugin(t)
+
+	tcases := []struct {
+		vindexType string
+		err        string
+	}{{
+		vindexType: "contract_nil",
+		err:        `vindexType "contract_nil" created a nil vindex for v`,
+	}, {
+		vindexType: "contract_no_map",
+		err:        `vindexType "contract_no_map" does not implement SingleColumn or MultiColumn: *vindexes.badVindex`,
+	}, {
+		vindexType: "contract_name",
+		err:        `vindexType "contract_name" created vindex v with name other`,
+	}, {
+		vindexType: "contract_cost",
+		err:        `vindexType "contract_cost" has a negative cost: -1`,
+	}, {
+		vindexType: "contract_lookup",
+		err:        `vindexType "contract_lookup" is a lookup vindex which does not need a VCursor`,
+	}, {
+		vindexType: "contract_panic",
+		err:        `vindexType "contract_panic" of plugin contract.so panicked creating v: bad plugin`,
+	}, {
+		vindexType: "contract_valid",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.vindexType, func(t *testing.T) {
+			vindex, err := CreateVindex(tcase.vindexType, "v", nil)
+			if tcase.err == "" {
+				require.NoError(t, err)
+				assert.Equal(t, "v", vindex.String())
+				return
+			}
+			assert.EqualError(t, err, tcase.err)
+			assert.Nil(t, vindex)
+		})
+	}
+}
+
+func TestVSchemaPluginContract(t *testing.T) {
+	withContractPlugin(t)
+
+	// A vindex breaking the contract fails the load of its keyspace only.
+	vschema := BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"bad": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"v": {Type: "contract_panic"},
+				},
+			},
+			"good": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"v": {Type: "stfu"},
+				},
+			},
+		},
+	})
+	assert.EqualError(t, vschema.Keyspaces["bad"].Error, `vindexType "contract_panic" of plugin contract.so panicked creating v: bad plugin`)
+	assert.NoError(t, vschema.Keyspaces["good"].Error)
+}
+
+func TestLoadPlugins(t *testing.T) {
+	require.NoError(t, LoadPlugins(nil))
+
+	err := LoadPlugins([]string{"/nonexistent/vindexes.so"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot load vindex plugin /nonexistent/vindexes.so")
+}
@@ -25,6 +25,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var (
@@ -87,11 +89,17 @@ func (ge *RegionExperimental) NeedsVCursor() bool {
 	return false
 }
 
+// PartialVindex returns true since the region alone maps to the key range
+// of the region.
+func (ge *RegionExperimental) PartialVindex() bool {
+	return true
+}
+
 // Map satisfies MultiColumn.
 func (ge *RegionExperimental) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	destinations := make([]key.Destination, 0, len(rowsColValues))
 	for _, row := range rowsColValues {
-		if len(row) != 2 {
+		if len(row) != 1 && len(row) != 2 {
 			destinations = append(destinations, key.DestinationNone{})
 			continue
 		}
@@ -104,6 +112,12 @@ func (ge *RegionExperimental) Map(vcursor VCursor, rowsColValues [][]sqltypes.Va
 		r := make([]byte, 2, 2+8)
 		binary.BigEndian.PutUint16(r, uint16(rn))
 
+		// A region alone maps to the key range of the region.
+		if len(row) == 1 {
+			destinations = append(destinations, ge.regionKeyRange(r))
+			continue
+		}
+
 		// Compute hash.
 		hn, err := evalengine.ToUint64(row[1])
 		if err != nil {
@@ -122,6 +136,24 @@ func (ge *RegionExperimental) Map(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	return destinations, nil
 }
 
+// regionKeyRange returns the key range of the keyspace ids of a region,
+// whose prefix is r.
+func (ge *RegionExperimental) regionKeyRange(r []byte) key.Destination {
+	prefix := r[len(r)-ge.regionBytes:]
+	kr := &topodatapb.KeyRange{Start: prefix}
+	// The key range ends at the next prefix, or at the end of the key space
+	// for the last region.
+	next := append([]byte(nil), prefix...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			kr.End = next
+			break
+		}
+	}
+	return key.DestinationKeyRange{KeyRange: kr}
+}
+
 // Verify satisfies MultiColumn.
 func (ge *RegionExperimental) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
 	result := make([]bool, len(rowsColValues))
@@ -25,6 +25,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func TestRegionExperimentalMisc(t *testing.T) {
@@ -34,6 +36,7 @@ func TestRegionExperimentalMisc(t *testing.T) {
 	assert.Equal(t, "region_experimental", ge.String())
 	assert.True(t, ge.IsUnique())
 	assert.False(t, ge.NeedsVCursor())
+	assert.True(t, ge.(MultiColumn).PartialVindex())
 }
 
 func TestRegionExperimentalMap(t *testing.T) {
@@ -47,8 +50,14 @@ func TestRegionExperimentalMap(t *testing.T) {
 	}, {
 		sqltypes.NewInt64(256), sqltypes.NewInt64(1),
 	}, {
-		// Invalid length.
+		// Region only.
 		sqltypes.NewInt64(1),
+	}, {
+		// Last region only.
+		sqltypes.NewInt64(255),
+	}, {
+		// Invalid length.
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
 	}, {
 		// Invalid region.
 		sqltypes.NewVarBinary("abcd"), sqltypes.NewInt64(256),
@@ -62,6 +71,8 @@ func TestRegionExperimentalMap(t *testing.T) {
 		key.DestinationKeyspaceID([]byte("\x01\x16k@\xb4J\xbaK\xd6")),
 		key.DestinationKeyspaceID([]byte("\xff\x16k@\xb4J\xbaK\xd6")),
 		key.DestinationKeyspaceID([]byte("\x00\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte{0x01}, End: []byte{0x02}}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte{0xff}}},
 		key.DestinationNone{},
 		key.DestinationNone{},
 		key.DestinationNone{},
@@ -81,6 +92,10 @@ func TestRegionExperimentalMapMulti2(t *testing.T) {
 		sqltypes.NewInt64(256), sqltypes.NewInt64(1),
 	}, {
 		sqltypes.NewInt64(0x10000), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(255),
+	}, {
+		sqltypes.NewInt64(0xffff),
 	}})
 	assert.NoError(t, err)
 
@@ -89,6 +104,8 @@ func TestRegionExperimentalMapMulti2(t *testing.T) {
 		key.DestinationKeyspaceID([]byte("\x00\xff\x16k@\xb4J\xbaK\xd6")),
 		key.DestinationKeyspaceID([]byte("\x01\x00\x16k@\xb4J\xbaK\xd6")),
 		key.DestinationKeyspaceID([]byte("\x00\x00\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte{0x00, 0xff}, End: []byte{0x01, 0x00}}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte{0xff, 0xff}}},
 	}
 	assert.Equal(t, want, got)
 }
@@ -101,6 +101,11 @@ func (rv *RegionJSON) IsUnique() bool {
 	return true
 }
 
+// PartialVindex returns false since the region is not the first column.
+func (rv *RegionJSON) PartialVindex() bool {
+	return false
+}
+
 // Map satisfies MultiColumn.
 func (rv *RegionJSON) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	destinations := make([]key.Destination, 0, len(rowsColValues))
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// VersionedTable serves a new version of a table under the name of the
+// table to a percentage of the sessions.
+type VersionedTable struct {
+	Current     *Table
+	Next        *Table
+	NextPercent uint32
+	Error       error
+}
+
+// Pick returns the version of the table served to the sessions of a bucket,
+// from 0 to 99. The sessions of the buckets lower than NextPercent are
+// served the next version.
+func (vt *VersionedTable) Pick(bucket uint32) (*Table, error) {
+	if vt.Error != nil {
+		return nil, vt.Error
+	}
+	if bucket < vt.NextPercent {
+		return vt.Next, nil
+	}
+	return vt.Current, nil
+}
+
+// MarshalJSON returns a JSON representation of VersionedTable.
+func (vt *VersionedTable) MarshalJSON() ([]byte, error) {
+	if vt.Error != nil {
+		return json.Marshal(vt.Error.Error())
+	}
+	return json.Marshal(struct {
+		Current     string `json:"current"`
+		Next        string `json:"next"`
+		NextPercent uint32 `json:"next_percent"`
+	}{
+		Current:     vt.Current.Keyspace.Name + "." + vt.Current.Name.String(),
+		Next:        vt.Next.Keyspace.Name + "." + vt.Next.Name.String(),
+		NextPercent: vt.NextPercent,
+	})
+}
+
+func buildVersionedTables(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	if len(source.RoutingRules.GetVersionedTables()) == 0 {
+		return
+	}
+	vschema.VersionedTables = make(map[string]*VersionedTable)
+	for _, rule := range source.RoutingRules.VersionedTables {
+		if _, ok := vschema.VersionedTables[rule.FromTable]; ok {
+			vschema.VersionedTables[rule.FromTable] = &VersionedTable{
+				Error: fmt.Errorf("duplicate versioned table %s", rule.FromTable),
+			}
+			continue
+		}
+		vschema.VersionedTables[rule.FromTable] = buildVersionedTable(rule, vschema)
+	}
+}
+
+func buildVersionedTable(rule *vschemapb.VersionedTable, vschema *VSchema) *VersionedTable {
+	if _, ok := vschema.RoutingRules[rule.FromTable]; ok {
+		return &VersionedTable{
+			Error: fmt.Errorf("table %s has both a routing rule and a versioned table", rule.FromTable),
+		}
+	}
+	if rule.NextPercent > 100 {
+		return &VersionedTable{
+			Error: fmt.Errorf("versioned table %s serves its next version to %d%% of the sessions, more than 100%%", rule.FromTable, rule.NextPercent),
+		}
+	}
+	find := func(name string) (*Table, error) {
+		ks, tabname, err := sqlparser.ParseTable(name)
+		if err != nil {
+			return nil, err
+		}
+		if ks == "" {
+			return nil, fmt.Errorf("table %s must be qualified", name)
+		}
+		return vschema.FindTable(ks, tabname)
+	}
+	current, err := find(rule.CurrentTable)
+	if err != nil {
+		return &VersionedTable{Error: err}
+	}
+	next, err := find(rule.NextTable)
+	if err != nil {
+		return &VersionedTable{Error: err}
+	}
+	return &VersionedTable{
+		Current:     current,
+		Next:        next,
+		NextPercent: rule.NextPercent,
+	}
+}
+
+// FindVersionedTable returns the versioned table of a table name, looked up
+// like the routing rules, or nil if the table is not versioned.
+func (vschema *VSchema) FindVersionedTable(keyspace, tablename string, tabletType topodatapb.TabletType) *VersionedTable {
+	if len(vschema.VersionedTables) == 0 {
+		return nil
+	}
+	qualified := tablename
+	if keyspace != "" {
+		qualified = keyspace + "." + tablename
+	}
+	for _, name := range []string{qualified + TabletTypeSuffix[tabletType], qualified} {
+		if vt, ok := vschema.VersionedTables[name]; ok {
+			return vt
+		}
+	}
+	return nil
+}
+
+// NextVersionedTables returns the sorted names of the versioned tables whose
+// next version is served to the sessions of a bucket.
+func (vschema *VSchema) NextVersionedTables(bucket uint32) []string {
+	var names []string
+	for name, vt := range vschema.VersionedTables {
+		if vt.Error == nil && bucket < vt.NextPercent {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -38,6 +38,10 @@ type VCursor interface {
 	Execute(method string, query string, bindvars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 	ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError, autocommit bool) (*sqltypes.Result, error)
 	InTransactionAndIsDML() bool
+	InTransaction() bool
+	// AfterTransaction calls f once the transaction of the session commits
+	// or rolls back, or right away if the session is not in a transaction.
+	AfterTransaction(f func())
 	LookupRowLockShardSession() vtgatepb.CommitOrder
 }
 
@@ -89,6 +93,12 @@ type MultiColumn interface {
 	Vindex
 	Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+
+	// PartialVindex returns true if the vindex can map the values of a
+	// prefix of its columns, in which case the rows passed to Map may have
+	// fewer values than columns, and are mapped to the key range of all the
+	// keyspace ids they can have.
+	PartialVindex() bool
 }
 
 // A Reversible vindex is one that can perform a
@@ -160,11 +170,19 @@ func Register(vindexType string, newVindexFunc NewVindexFunc) {
 
 // CreateVindex creates a vindex of the specified type using the
 // supplied params. The type must have been previously registered.
+// The vindexes of the Go plugins are rejected if they do not satisfy the
+// contract of the vindexes, or panic.
 func CreateVindex(vindexType, name string, params map[string]string) (Vindex, error) {
+	if err := LoadFlagPlugins(); err != nil {
+		return nil, err
+	}
 	f, ok := registry[vindexType]
 	if !ok {
 		return nil, fmt.Errorf("vindexType %q not found", vindexType)
 	}
+	if path, ok := pluginTypes[vindexType]; ok {
+		return createPluginVindex(f, path, vindexType, name, params)
+	}
 	return f(name, params)
 }
 
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -57,10 +58,11 @@ const (
 // VSchema represents the denormalized version of SrvVSchema,
 // used for building routing plans.
 type VSchema struct {
-	RoutingRules   map[string]*RoutingRule `json:"routing_rules"`
-	uniqueTables   map[string]*Table
-	uniqueVindexes map[string]Vindex
-	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+	RoutingRules    map[string]*RoutingRule    `json:"routing_rules"`
+	VersionedTables map[string]*VersionedTable `json:"versioned_tables,omitempty"`
+	uniqueTables    map[string]*Table
+	uniqueVindexes  map[string]Vindex
+	Keyspaces       map[string]*KeyspaceSchema `json:"keyspaces"`
 }
 
 // RoutingRule represents one routing rule.
@@ -93,12 +95,48 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	ColocatedWith           []string             `json:"colocated_with,omitempty"`
+	QueryLimits             *QueryLimits         `json:"query_limits,omitempty"`
+}
+
+// Limits returns the query limits of the table, which default to the ones
+// of its keyspace, or nil if it has none.
+func (t *Table) Limits() *QueryLimits {
+	if t.QueryLimits != nil || t.Keyspace == nil {
+		return t.QueryLimits
+	}
+	return t.Keyspace.QueryLimits
 }
 
 // Keyspace contains the keyspcae info for each Table.
 type Keyspace struct {
-	Name    string
-	Sharded bool
+	Name        string
+	Sharded     bool
+	UDFs        map[string]*UDF `json:"-"`
+	QueryLimits *QueryLimits    `json:"-"`
+}
+
+// QueryLimits are the defaults vtgate applies to the queries of a table.
+type QueryLimits struct {
+	// QueryTimeout is in milliseconds.
+	QueryTimeout int                             `json:"query_timeout_ms,omitempty"`
+	MaxRows      int                             `json:"max_rows,omitempty"`
+	Workload     querypb.ExecuteOptions_Workload `json:"workload,omitempty"`
+}
+
+// UDF describes a user defined function declared in a keyspace.
+type UDF struct {
+	Name      string `json:"name"`
+	Aggregate bool   `json:"aggregate,omitempty"`
+}
+
+// FindUDF returns the declaration of the named UDF, or nil
+// if the function is not declared in the keyspace.
+func (ks *Keyspace) FindUDF(name string) *UDF {
+	if ks == nil {
+		return nil
+	}
+	return ks.UDFs[strings.ToLower(name)]
 }
 
 // ColumnVindex contains the index info for each index of a table.
@@ -142,11 +180,13 @@ func (ks *KeyspaceSchema) MarshalJSON() ([]byte, error) {
 		Sharded  bool              `json:"sharded,omitempty"`
 		Tables   map[string]*Table `json:"tables,omitempty"`
 		Vindexes map[string]Vindex `json:"vindexes,omitempty"`
+		UDFs     map[string]*UDF   `json:"udfs,omitempty"`
 		Error    string            `json:"error,omitempty"`
 	}{
 		Sharded:  ks.Keyspace.Sharded,
 		Tables:   ks.Tables,
 		Vindexes: ks.Vindexes,
+		UDFs:     ks.Keyspace.UDFs,
 		Error: func(ks *KeyspaceSchema) string {
 			if ks.Error == nil {
 				return ""
@@ -174,6 +214,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema) {
 	resolveAutoIncrement(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
+	buildVersionedTables(source, vschema)
 	return vschema
 }
 
@@ -223,6 +264,14 @@ func buildKeyspaces(source *vschemapb.SrvVSchema, vschema *VSchema) {
 
 func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSchema) error {
 	keyspace := ksvschema.Keyspace
+	if err := buildUDFs(ks, keyspace); err != nil {
+		return err
+	}
+	limits, err := buildQueryLimits(ks.QueryLimits, nil, "keyspace "+keyspace.Name)
+	if err != nil {
+		return err
+	}
+	keyspace.QueryLimits = limits
 	for vname, vindexInfo := range ks.Vindexes {
 		vindex, err := CreateVindex(vindexInfo.Type, vname, vindexInfo.Params)
 		if err != nil {
@@ -245,6 +294,10 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
 		}
+		t.QueryLimits, err = buildQueryLimits(table.QueryLimits, keyspace.QueryLimits, "table "+tname)
+		if err != nil {
+			return err
+		}
 		switch table.Type {
 		case "", TypeReference:
 			t.Type = table.Type
@@ -344,9 +397,110 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		}
 		ksvschema.Tables[tname] = t
 	}
+	return buildColocatedTables(ks, ksvschema)
+}
+
+// buildColocatedTables checks that the tables declared as co-located have
+// the same primary vindex, so that their joins on it are shard-local.
+func buildColocatedTables(ks *vschemapb.Keyspace, ksvschema *KeyspaceSchema) error {
+	for tname, table := range ks.Tables {
+		t := ksvschema.Tables[tname]
+		for _, oname := range table.ColocatedWith {
+			other, ok := ksvschema.Tables[oname]
+			if !ok {
+				return fmt.Errorf("co-located table %s not found for table %s", oname, tname)
+			}
+			if !ksvschema.Keyspace.Sharded {
+				return fmt.Errorf("table %s cannot be co-located with table %s in unsharded keyspace %s", tname, oname, ksvschema.Keyspace.Name)
+			}
+			if len(t.ColumnVindexes) == 0 || len(other.ColumnVindexes) == 0 {
+				return fmt.Errorf("table %s cannot be co-located with table %s without a primary vindex", tname, oname)
+			}
+			primary, otherPrimary := t.ColumnVindexes[0], other.ColumnVindexes[0]
+			if primary.Name != otherPrimary.Name || len(primary.Columns) != len(otherPrimary.Columns) {
+				return fmt.Errorf("table %s is not co-located with table %s: primary vindex %s differs from %s", tname, oname, primary.Name, otherPrimary.Name)
+			}
+			t.ColocatedWith = append(t.ColocatedWith, oname)
+		}
+	}
 	return nil
 }
 
+// IsColocatedWith returns true if the table is declared as co-located with
+// the other table, by either of them.
+func (t *Table) IsColocatedWith(other *Table) bool {
+	if t.Keyspace == nil || other.Keyspace == nil || t.Keyspace.Name != other.Keyspace.Name {
+		return false
+	}
+	for _, name := range t.ColocatedWith {
+		if name == other.Name.String() {
+			return true
+		}
+	}
+	for _, name := range other.ColocatedWith {
+		if name == t.Name.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func buildUDFs(ks *vschemapb.Keyspace, keyspace *Keyspace) error {
+	if len(ks.Udfs) == 0 {
+		return nil
+	}
+	keyspace.UDFs = make(map[string]*UDF, len(ks.Udfs))
+	for _, udf := range ks.Udfs {
+		name := strings.ToLower(udf.Name)
+		if name == "" {
+			return fmt.Errorf("udf name is empty in keyspace %s", keyspace.Name)
+		}
+		if sqlparser.Aggregates[name] {
+			return fmt.Errorf("udf %s shadows a built-in aggregate function in keyspace %s", udf.Name, keyspace.Name)
+		}
+		if _, ok := keyspace.UDFs[name]; ok {
+			return fmt.Errorf("duplicate udf %s in keyspace %s", udf.Name, keyspace.Name)
+		}
+		keyspace.UDFs[name] = &UDF{
+			Name:      name,
+			Aggregate: udf.Aggregate,
+		}
+	}
+	return nil
+}
+
+// buildQueryLimits returns the query limits of the input, whose unset values
+// default to the ones of defaults.
+func buildQueryLimits(input *vschemapb.QueryLimits, defaults *QueryLimits, owner string) (*QueryLimits, error) {
+	if input == nil {
+		return defaults, nil
+	}
+	limits := &QueryLimits{}
+	if defaults != nil {
+		*limits = *defaults
+	}
+	if input.QueryTimeoutMs < 0 {
+		return nil, fmt.Errorf("negative query_timeout_ms for %s: %d", owner, input.QueryTimeoutMs)
+	}
+	if input.QueryTimeoutMs > 0 {
+		limits.QueryTimeout = int(input.QueryTimeoutMs)
+	}
+	if input.MaxRows < 0 {
+		return nil, fmt.Errorf("negative max_rows for %s: %d", owner, input.MaxRows)
+	}
+	if input.MaxRows > 0 {
+		limits.MaxRows = int(input.MaxRows)
+	}
+	if input.Workload != "" {
+		workload, ok := querypb.ExecuteOptions_Workload_value[strings.ToUpper(input.Workload)]
+		if !ok || workload == int32(querypb.ExecuteOptions_UNSPECIFIED) {
+			return nil, fmt.Errorf("invalid workload for %s: %s, must be oltp, olap or dba", owner, input.Workload)
+		}
+		limits.Workload = querypb.ExecuteOptions_Workload(workload)
+	}
+	return limits, nil
+}
+
 func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	for ksname, ks := range source.Keyspaces {
 		ksvschema := vschema.Keyspaces[ksname]
@@ -497,6 +651,25 @@ func (vschema *VSchema) findTable(keyspace, tablename string) (*Table, error) {
 	return table, nil
 }
 
+// AddDiscoveredTable routes the references to a table which is not in the
+// vschema, and which is not qualified by a keyspace, to the tables of that
+// name found in the keyspaces. The tables of the vschema take precedence, and
+// the references are ambiguous if the table was found in more than one
+// keyspace. It returns false if the table conflicts with a table of the same
+// name in another keyspace.
+func (vschema *VSchema) AddDiscoveredTable(tables []*Table) bool {
+	tname := tables[0].Name.String()
+	if _, ok := vschema.uniqueTables[tname]; ok {
+		return false
+	}
+	if len(tables) > 1 {
+		vschema.uniqueTables[tname] = nil
+		return false
+	}
+	vschema.uniqueTables[tname] = tables[0]
+	return true
+}
+
 // FindRoutedTable finds a table checking the routing rules.
 func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType topodatapb.TabletType) (*Table, error) {
 	qualified := tablename
@@ -812,6 +812,100 @@ func TestVSchemaRoutingRules(t *testing.T) {
 	assert.Equal(t, string(wantb), string(gotb), string(gotb))
 }
 
+func TestVSchemaVersionedTables(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable: "routed",
+				ToTables:  []string{"ks1.t1"},
+			}},
+			VersionedTables: []*vschemapb.VersionedTable{{
+				FromTable:    "t1",
+				CurrentTable: "ks1.t1",
+				NextTable:    "ks1.t1_v2",
+				NextPercent:  30,
+			}, {
+				FromTable:    "ks1.t1@replica",
+				CurrentTable: "ks1.t1",
+				NextTable:    "ks1.t1_v2",
+				NextPercent:  100,
+			}, {
+				FromTable:    "routed",
+				CurrentTable: "ks1.t1",
+				NextTable:    "ks1.t1_v2",
+			}, {
+				FromTable:    "dup",
+				CurrentTable: "ks1.t1",
+				NextTable:    "ks1.t1_v2",
+			}, {
+				FromTable:    "dup",
+				CurrentTable: "ks1.t1",
+				NextTable:    "ks1.t1_v2",
+			}, {
+				FromTable:    "unqualified",
+				CurrentTable: "ks1.t1",
+				NextTable:    "t1_v2",
+			}, {
+				FromTable:    "badkeyspace",
+				CurrentTable: "ks1.t1",
+				NextTable:    "ks2.t1_v2",
+			}, {
+				FromTable:    "toomany",
+				CurrentTable: "ks1.t1",
+				NextTable:    "ks1.t1_v2",
+				NextPercent:  101,
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"t1":    {},
+					"t1_v2": {},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&input)
+	t1, err := got.FindTable("ks1", "t1")
+	require.NoError(t, err)
+	t1v2, err := got.FindTable("ks1", "t1_v2")
+	require.NoError(t, err)
+
+	wantErrors := map[string]string{
+		"routed":      "table routed has both a routing rule and a versioned table",
+		"dup":         "duplicate versioned table dup",
+		"unqualified": "table t1_v2 must be qualified",
+		"badkeyspace": "Unknown database 'ks2' in vschema",
+		"toomany":     "versioned table toomany serves its next version to 101% of the sessions, more than 100%",
+	}
+	for name, want := range wantErrors {
+		_, err := got.VersionedTables[name].Pick(0)
+		assert.EqualError(t, err, want, name)
+	}
+
+	vt := got.FindVersionedTable("", "t1", topodatapb.TabletType_PRIMARY)
+	require.NotNil(t, vt)
+	table, err := vt.Pick(29)
+	require.NoError(t, err)
+	assert.Equal(t, t1v2, table)
+	table, err = vt.Pick(30)
+	require.NoError(t, err)
+	assert.Equal(t, t1, table)
+
+	vt = got.FindVersionedTable("ks1", "t1", topodatapb.TabletType_REPLICA)
+	require.NotNil(t, vt)
+	table, err = vt.Pick(99)
+	require.NoError(t, err)
+	assert.Equal(t, t1v2, table)
+
+	assert.Nil(t, got.FindVersionedTable("ks1", "t1", topodatapb.TabletType_PRIMARY))
+	assert.Nil(t, got.FindVersionedTable("", "t1_v2", topodatapb.TabletType_PRIMARY))
+
+	assert.Equal(t, []string{"ks1.t1@replica", "t1"}, got.NextVersionedTables(0))
+	assert.Equal(t, []string{"ks1.t1@replica"}, got.NextVersionedTables(30))
+	assert.Empty(t, BuildVSchema(&vschemapb.SrvVSchema{}).NextVersionedTables(0))
+}
+
 func TestChooseVindexForType(t *testing.T) {
 	testcases := []struct {
 		in  querypb.Type
@@ -2113,6 +2207,80 @@ func TestBadShardedSequence(t *testing.T) {
 	}
 }
 
+func TestVSchemaQueryLimits(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				QueryLimits: &vschemapb.QueryLimits{
+					QueryTimeoutMs: 1000,
+					MaxRows:        100,
+					Workload:       "oltp",
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {
+						QueryLimits: &vschemapb.QueryLimits{
+							QueryTimeoutMs: 5000,
+							Workload:       "olap",
+						},
+					},
+				},
+			},
+			"unlimited": {
+				Tables: map[string]*vschemapb.Table{
+					"t3": {},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&good)
+	ks := got.Keyspaces["ks"]
+	require.NoError(t, ks.Error)
+	assert.Equal(t, &QueryLimits{QueryTimeout: 1000, MaxRows: 100, Workload: querypb.ExecuteOptions_OLTP}, ks.Tables["t1"].Limits())
+	assert.Equal(t, &QueryLimits{QueryTimeout: 5000, MaxRows: 100, Workload: querypb.ExecuteOptions_OLAP}, ks.Tables["t2"].Limits())
+	require.NoError(t, got.Keyspaces["unlimited"].Error)
+	assert.Nil(t, got.Keyspaces["unlimited"].Tables["t3"].Limits())
+
+	// A table without query limits of its own has the ones of its keyspace.
+	table := &Table{Keyspace: ks.Keyspace}
+	assert.Equal(t, ks.Keyspace.QueryLimits, table.Limits())
+
+	bad := []struct {
+		keyspace *vschemapb.Keyspace
+		err      string
+	}{{
+		keyspace: &vschemapb.Keyspace{
+			QueryLimits: &vschemapb.QueryLimits{QueryTimeoutMs: -1},
+		},
+		err: "negative query_timeout_ms for keyspace ks: -1",
+	}, {
+		keyspace: &vschemapb.Keyspace{
+			Tables: map[string]*vschemapb.Table{
+				"t1": {QueryLimits: &vschemapb.QueryLimits{MaxRows: -1}},
+			},
+		},
+		err: "negative max_rows for table t1: -1",
+	}, {
+		keyspace: &vschemapb.Keyspace{
+			QueryLimits: &vschemapb.QueryLimits{Workload: "unspecified"},
+		},
+		err: "invalid workload for keyspace ks: unspecified, must be oltp, olap or dba",
+	}, {
+		keyspace: &vschemapb.Keyspace{
+			Tables: map[string]*vschemapb.Table{
+				"t1": {QueryLimits: &vschemapb.QueryLimits{Workload: "batch"}},
+			},
+		},
+		err: "invalid workload for table t1: batch, must be oltp, olap or dba",
+	}}
+	for _, tcase := range bad {
+		got := BuildVSchema(&vschemapb.SrvVSchema{
+			Keyspaces: map[string]*vschemapb.Keyspace{"ks": tcase.keyspace},
+		})
+		assert.EqualError(t, got.Keyspaces["ks"].Error, tcase.err)
+	}
+}
+
 func TestFindTable(t *testing.T) {
 	input := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -2383,6 +2551,102 @@ func TestBuildKeyspaceSchema(t *testing.T) {
 	}
 }
 
+func TestBuildKeyspaceSchemaUDFs(t *testing.T) {
+	input := &vschemapb.Keyspace{
+		Sharded: true,
+		Udfs: []*vschemapb.UDF{
+			{Name: "MyUDF"},
+			{Name: "my_agg", Aggregate: true},
+		},
+	}
+	got, err := BuildKeyspaceSchema(input, "ks")
+	require.NoError(t, err)
+	assert.Equal(t, &UDF{Name: "myudf"}, got.Keyspace.FindUDF("myudf"))
+	assert.Equal(t, &UDF{Name: "myudf"}, got.Keyspace.FindUDF("MYUDF"))
+	assert.Equal(t, &UDF{Name: "my_agg", Aggregate: true}, got.Keyspace.FindUDF("my_agg"))
+	assert.Nil(t, got.Keyspace.FindUDF("other"))
+
+	testcases := []struct {
+		udfs []*vschemapb.UDF
+		err  string
+	}{{
+		udfs: []*vschemapb.UDF{{Name: ""}},
+		err:  "udf name is empty in keyspace ks",
+	}, {
+		udfs: []*vschemapb.UDF{{Name: "f"}, {Name: "F"}},
+		err:  "duplicate udf F in keyspace ks",
+	}, {
+		udfs: []*vschemapb.UDF{{Name: "count", Aggregate: true}},
+		err:  "udf count shadows a built-in aggregate function in keyspace ks",
+	}}
+	for _, tc := range testcases {
+		_, err := BuildKeyspaceSchema(&vschemapb.Keyspace{Udfs: tc.udfs}, "ks")
+		assert.EqualError(t, err, tc.err)
+	}
+}
+
+func TestBuildKeyspaceSchemaColocatedTables(t *testing.T) {
+	input := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash":   {Type: "hash"},
+			"xxhash": {Type: "xxhash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"user": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+			},
+			"user_extra": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "user_id", Name: "hash"}},
+				ColocatedWith:  []string{"user"},
+			},
+			"music": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "xxhash"}},
+			},
+			"ref": {
+				Type: "reference",
+			},
+		},
+	}
+	got, err := BuildKeyspaceSchema(input, "ks")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user"}, got.Tables["user_extra"].ColocatedWith)
+	assert.True(t, got.Tables["user"].IsColocatedWith(got.Tables["user_extra"]))
+	assert.True(t, got.Tables["user_extra"].IsColocatedWith(got.Tables["user"]))
+	assert.False(t, got.Tables["user"].IsColocatedWith(got.Tables["music"]))
+
+	testcases := []struct {
+		table, colocatedWith string
+		err                  string
+	}{{
+		table:         "user",
+		colocatedWith: "other",
+		err:           "co-located table other not found for table user",
+	}, {
+		table:         "user",
+		colocatedWith: "music",
+		err:           "table user is not co-located with table music: primary vindex hash differs from xxhash",
+	}, {
+		table:         "user",
+		colocatedWith: "ref",
+		err:           "table user cannot be co-located with table ref without a primary vindex",
+	}}
+	for _, tc := range testcases {
+		input.Tables[tc.table].ColocatedWith = []string{tc.colocatedWith}
+		_, err := BuildKeyspaceSchema(input, "ks")
+		assert.EqualError(t, err, tc.err)
+		input.Tables[tc.table].ColocatedWith = nil
+	}
+
+	_, err = BuildKeyspaceSchema(&vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{
+			"t1": {ColocatedWith: []string{"t2"}},
+			"t2": {},
+		},
+	}, "ks")
+	assert.EqualError(t, err, "table t1 cannot be co-located with table t2 in unsharded keyspace ks")
+}
+
 func TestValidate(t *testing.T) {
 	good := &vschemapb.Keyspace{
 		Tables: map[string]*vschemapb.Table{
@@ -2642,3 +2906,45 @@ func TestFindSingleKeyspace(t *testing.T) {
 		t.Errorf("FindTable(\"\"): %v, want %s", err, wantErr)
 	}
 }
+
+func TestAddDiscoveredTable(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ksa": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+				},
+			},
+			"ksb": {},
+			"ksc": {},
+		},
+	}
+	vschema := BuildVSchema(&input)
+	discovered := func(ks, name string) *Table {
+		return &Table{
+			Name:     sqlparser.NewTableIdent(name),
+			Keyspace: vschema.Keyspaces[ks].Keyspace,
+		}
+	}
+
+	t2 := discovered("ksb", "t2")
+	assert.True(t, vschema.AddDiscoveredTable([]*Table{t2}))
+	got, err := vschema.FindTable("", "t2")
+	require.NoError(t, err)
+	assert.Equal(t, t2, got)
+
+	// The tables of the vschema take precedence.
+	assert.False(t, vschema.AddDiscoveredTable([]*Table{discovered("ksb", "t1")}))
+	got, err = vschema.FindTable("", "t1")
+	require.NoError(t, err)
+	assert.Equal(t, "ksa", got.Keyspace.Name)
+
+	// A table found in more than one keyspace is ambiguous.
+	assert.False(t, vschema.AddDiscoveredTable([]*Table{discovered("ksb", "t3"), discovered("ksc", "t3")}))
+	_, err = vschema.FindTable("", "t3")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ambiguous table reference: t3")
+	got, err = vschema.FindTable("ksc", "t3")
+	require.NoError(t, err)
+	assert.Equal(t, "ksc", got.Keyspace.Name)
+}
@@ -18,8 +18,11 @@ package vtgate
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 
@@ -34,6 +37,8 @@ import (
 
 var _ VSchemaOperator = (*VSchemaManager)(nil)
 
+var discoveredTableConflicts = stats.NewGauge("VSchemaDiscoveredTableConflicts", "Number of tables found by the schema tracker which conflict with a table of the same name in another keyspace, and must be qualified by their keyspace")
+
 // VSchemaManager is used to watch for updates to the vschema and to implement
 // the DDL commands to add / remove vindexes
 type VSchemaManager struct {
@@ -44,6 +49,11 @@ type VSchemaManager struct {
 	cell              string
 	subscriber        func(vschema *vindexes.VSchema, stats *VSchemaStats)
 	schema            SchemaInfo
+
+	// discoverTables routes the tables of the unsharded keyspaces found by
+	// the schema tracker, which are not in the vschema, without a keyspace
+	// qualifier.
+	discoverTables bool
 }
 
 // SchemaInfo is an interface to schema tracker.
@@ -182,12 +192,13 @@ func (vm *VSchemaManager) Rebuild() {
 func (vm *VSchemaManager) buildAndEnhanceVSchema(v *vschemapb.SrvVSchema) *vindexes.VSchema {
 	vschema := vindexes.BuildVSchema(v)
 	if vm.schema != nil {
-		vm.updateFromSchema(vschema)
+		vm.updateFromSchema(v, vschema)
 	}
 	return vschema
 }
 
-func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
+func (vm *VSchemaManager) updateFromSchema(v *vschemapb.SrvVSchema, vschema *vindexes.VSchema) {
+	discovered := make(map[string][]*vindexes.Table)
 	for ksName, ks := range vschema.Keyspaces {
 		m := vm.schema.Tables(ksName)
 
@@ -195,12 +206,16 @@ func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
 			vTbl := ks.Tables[tblName]
 			if vTbl == nil {
 				// a table that is unknown by the vschema. we add it as a normal table
-				ks.Tables[tblName] = &vindexes.Table{
+				vTbl = &vindexes.Table{
 					Name:                    sqlparser.NewTableIdent(tblName),
 					Keyspace:                ks.Keyspace,
 					Columns:                 columns,
 					ColumnListAuthoritative: true,
 				}
+				ks.Tables[tblName] = vTbl
+				if vm.discoverTables && !ks.Keyspace.Sharded && !v.Keyspaces[ksName].GetRequireExplicitRouting() {
+					discovered[tblName] = append(discovered[tblName], vTbl)
+				}
 				continue
 			}
 			if !vTbl.ColumnListAuthoritative {
@@ -210,4 +225,30 @@ func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
 			}
 		}
 	}
+	if vm.discoverTables {
+		addDiscoveredTables(vschema, discovered)
+	}
+}
+
+// addDiscoveredTables routes the discovered tables without a keyspace
+// qualifier, and reports the tables which conflict with a table of the same
+// name in another keyspace.
+func addDiscoveredTables(vschema *vindexes.VSchema, discovered map[string][]*vindexes.Table) {
+	var conflicts []string
+	for tblName, tables := range discovered {
+		if vschema.AddDiscoveredTable(tables) {
+			continue
+		}
+		keyspaces := make([]string, 0, len(tables))
+		for _, t := range tables {
+			keyspaces = append(keyspaces, t.Keyspace.Name)
+		}
+		sort.Strings(keyspaces)
+		conflicts = append(conflicts, tblName+" ("+strings.Join(keyspaces, ", ")+")")
+	}
+	discoveredTableConflicts.Set(int64(len(conflicts)))
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		log.Warningf("Discovered tables conflict with tables of the same name in other keyspaces, and must be qualified by their keyspace: %s", strings.Join(conflicts, ", "))
+	}
 }
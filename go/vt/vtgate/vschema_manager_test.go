@@ -3,6 +3,9 @@ package vtgate
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/test/utils"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -258,3 +261,75 @@ var _ SchemaInfo = (*fakeSchema)(nil)
 func (f *fakeSchema) Tables(string) map[string][]vindexes.Column {
 	return f.t
 }
+
+func TestVSchemaDiscoverTables(t *testing.T) {
+	cols := []vindexes.Column{{
+		Name: sqlparser.NewColIdent("id"),
+		Type: querypb.Type_INT64,
+	}}
+	srvVschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"uks1": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+			"uks2": {},
+			"uks3": {RequireExplicitRouting: true},
+			"sks":  {Sharded: true},
+		},
+	}
+	schema := fakeKeyspaceSchema{
+		"uks1": {"t1": cols, "t2": cols},
+		"uks2": {"t1": cols, "t2": cols, "t3": cols},
+		"uks3": {"t4": cols},
+		"sks":  {"t5": cols},
+	}
+
+	var vs *vindexes.VSchema
+	vm := &VSchemaManager{
+		schema: schema,
+		subscriber: func(vschema *vindexes.VSchema, _ *VSchemaStats) {
+			vs = vschema
+		},
+		discoverTables: true,
+	}
+	vm.VSchemaUpdate(srvVschema, nil)
+	require.NotNil(t, vs)
+
+	// The tables of the vschema take precedence.
+	tbl, err := vs.FindTable("", "t1")
+	require.NoError(t, err)
+	assert.Equal(t, "uks1", tbl.Keyspace.Name)
+
+	_, err = vs.FindTable("", "t2")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ambiguous table reference: t2")
+	tbl, err = vs.FindTable("uks2", "t2")
+	require.NoError(t, err)
+	assert.Equal(t, cols, tbl.Columns)
+
+	tbl, err = vs.FindTable("", "t3")
+	require.NoError(t, err)
+	assert.Equal(t, "uks2", tbl.Keyspace.Name)
+	assert.Equal(t, cols, tbl.Columns)
+
+	// Neither the keyspaces which require explicit routing, nor the sharded
+	// keyspaces, are routed without a keyspace qualifier.
+	for _, name := range []string{"t4", "t5"} {
+		_, err = vs.FindTable("", name)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	}
+	assert.EqualValues(t, 2, discoveredTableConflicts.Get())
+
+	vm.discoverTables = false
+	vm.Rebuild()
+	_, err = vs.FindTable("", "t3")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "table t3 not found")
+}
+
+type fakeKeyspaceSchema map[string]map[string][]vindexes.Column
+
+var _ SchemaInfo = (fakeKeyspaceSchema)(nil)
+
+func (f fakeKeyspaceSchema) Tables(ks string) map[string][]vindexes.Column {
+	return f[ks]
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var vstreamConsumerGroupHeartbeatInterval = flag.Duration("vstream_consumer_group_heartbeat_interval", 5*time.Second, "how often a VStream with a consumer group refreshes its claim on the group in the topo; a claim not refreshed for 3 intervals expires")
+
+// consumerGroupClaim is the claim of a stream on its VStream consumer group,
+// saved in the checkpoint of the group: only one stream of a consumer group
+// can run at a time.
+type consumerGroupClaim struct {
+	ts    *topo.Server
+	group string
+
+	// mu protects checkpoint and version, which are updated by the
+	// heartbeats of the claim.
+	mu         sync.Mutex
+	checkpoint *vtgatepb.VStreamCheckpoint
+	version    topo.Version
+}
+
+// claimConsumerGroup claims a consumer group for a new stream and returns the
+// vgtid the stream starts from. If the request has a vgtid, it is the last
+// vgtid acked by the client, which is saved as the checkpoint of the group.
+// Otherwise the stream resumes from the checkpoint.
+func claimConsumerGroup(ctx context.Context, ts *topo.Server, group string, vgtid *binlogdatapb.VGtid) (*consumerGroupClaim, *binlogdatapb.VGtid, error) {
+	checkpoint, version, err := ts.GetVStreamCheckpoint(ctx, group)
+	if err != nil {
+		return nil, nil, err
+	}
+	if checkpoint == nil {
+		checkpoint = &vtgatepb.VStreamCheckpoint{}
+	}
+	now := time.Now()
+	if checkpoint.Owner != "" && now.Sub(time.Unix(checkpoint.OwnerHeartbeat, 0)) < 3*(*vstreamConsumerGroupHeartbeatInterval) {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "consumer group %s already has a running stream: %s", group, checkpoint.Owner)
+	}
+	switch {
+	case len(vgtid.GetShardGtids()) != 0:
+		log.Infof("Starting vstream of consumer group %s from acked vgtid %v", group, vgtid)
+		checkpoint.Vgtid = vgtid
+	case checkpoint.Vgtid != nil:
+		log.Infof("Resuming vstream of consumer group %s from %v", group, checkpoint.Vgtid)
+	default:
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s has no checkpoint: the vgtid to start from must be specified", group)
+	}
+	hostname, _ := os.Hostname()
+	checkpoint.Owner = fmt.Sprintf("%s-%d", hostname, now.UnixNano())
+	checkpoint.OwnerHeartbeat = now.Unix()
+	if version, err = ts.SaveVStreamCheckpoint(ctx, group, checkpoint, version); err != nil {
+		if topo.IsErrType(err, topo.BadVersion) || topo.IsErrType(err, topo.NodeExists) {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "consumer group %s was claimed by another stream", group)
+		}
+		return nil, nil, err
+	}
+	claim := &consumerGroupClaim{
+		ts:         ts,
+		group:      group,
+		checkpoint: checkpoint,
+		version:    version,
+	}
+	return claim, proto.Clone(checkpoint.Vgtid).(*binlogdatapb.VGtid), nil
+}
+
+// keepAlive refreshes the claim until ctx is done. It returns an error if
+// another stream claimed the group, once the claim expired.
+func (c *consumerGroupClaim) keepAlive(ctx context.Context) error {
+	ticker := time.NewTicker(*vstreamConsumerGroupHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		err := c.save(ctx, func(checkpoint *vtgatepb.VStreamCheckpoint) {
+			checkpoint.OwnerHeartbeat = time.Now().Unix()
+		})
+		switch {
+		case topo.IsErrType(err, topo.BadVersion):
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "the claim of the stream on consumer group %s expired and was taken by another stream", c.group)
+		case err != nil:
+			log.Warningf("Error refreshing the claim on vstream consumer group %s: %v", c.group, err)
+		}
+	}
+}
+
+// release clears the claim when the stream ends.
+func (c *consumerGroupClaim) release() {
+	// The context of the stream is done by now.
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	err := c.save(ctx, func(checkpoint *vtgatepb.VStreamCheckpoint) {
+		checkpoint.Owner = ""
+		checkpoint.OwnerHeartbeat = 0
+	})
+	if err != nil {
+		log.Warningf("Error releasing vstream consumer group %s, it will be claimable once the claim expires: %v", c.group, err)
+	}
+}
+
+func (c *consumerGroupClaim) save(ctx context.Context, update func(checkpoint *vtgatepb.VStreamCheckpoint)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	checkpoint := proto.Clone(c.checkpoint).(*vtgatepb.VStreamCheckpoint)
+	update(checkpoint)
+	version, err := c.ts.SaveVStreamCheckpoint(ctx, c.group, checkpoint, c.version)
+	if err != nil {
+		return err
+	}
+	c.checkpoint, c.version = checkpoint, version
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -103,6 +104,10 @@ type vstream struct {
 	eventCh           chan []*binlogdatapb.VEvent
 	heartbeatInterval uint32
 	ts                *topo.Server
+
+	// claim is the claim of the stream on the consumer group of the client,
+	// if it has one.
+	claim *consumerGroupClaim
 }
 
 type journalEvent struct {
@@ -121,10 +126,6 @@ func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell str
 
 func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
-	vgtid, filter, flags, err := vsm.resolveParams(ctx, tabletType, vgtid, filter, flags)
-	if err != nil {
-		return err
-	}
 	ts, err := vsm.toposerv.GetTopoServer()
 	if err != nil {
 		return err
@@ -133,6 +134,20 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		log.Errorf("unable to get topo server in VStream()")
 		return fmt.Errorf("unable to get topo server")
 	}
+	var claim *consumerGroupClaim
+	if consumerGroup := flags.GetConsumerGroup(); consumerGroup != "" {
+		if strings.Contains(consumerGroup, "/") {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid consumer group %s: it must not contain a '/'", consumerGroup)
+		}
+		if claim, vgtid, err = claimConsumerGroup(ctx, ts, consumerGroup, vgtid); err != nil {
+			return err
+		}
+		defer claim.release()
+	}
+	vgtid, filter, flags, err = vsm.resolveParams(ctx, tabletType, vgtid, filter, flags)
+	if err != nil {
+		return err
+	}
 	vs := &vstream{
 		vgtid:              vgtid,
 		tabletType:         tabletType,
@@ -148,6 +163,7 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		eventCh:            make(chan []*binlogdatapb.VEvent),
 		heartbeatInterval:  flags.GetHeartbeatInterval(),
 		ts:                 ts,
+		claim:              claim,
 	}
 	return vs.stream(ctx)
 }
@@ -238,6 +254,16 @@ func (vs *vstream) stream(ctx context.Context) error {
 	for _, sgtid := range copylist {
 		vs.startOneStream(ctx, sgtid)
 	}
+	if vs.claim != nil {
+		go func() {
+			if err := vs.claim.keepAlive(ctx); err != nil {
+				vs.once.Do(func() {
+					vs.setError(err)
+				})
+				vs.cancel()
+			}
+		}()
+	}
 	vs.wg.Wait()
 
 	return vs.getError()
@@ -474,6 +500,9 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 		}()
 
 		log.Infof("Starting to vstream from %s", tablet.Alias.String())
+		// lastSent is when the events of the shard were last sent, to send
+		// a heartbeat for the shard if its stream stays idle.
+		lastSent := time.Now()
 		// Safe to access sgtid.Gtid here (because it can't change until streaming begins).
 		err = tabletConn.VStream(ctx, target, sgtid.Gtid, sgtid.TablePKs, vs.filter, func(events []*binlogdatapb.VEvent) error {
 			// We received a valid event. Reset error count.
@@ -521,13 +550,30 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					}
 					eventss = nil
 					sendevents = nil
+					lastSent = time.Now()
 				case binlogdatapb.VEventType_HEARTBEAT:
-					// Remove all heartbeat events for now.
-					// Otherwise they can accumulate indefinitely if there are no real events.
-					// TODO(sougou): figure out a model for this.
+					// The heartbeats of the tablets are only sent to the client
+					// once the stream of the shard has been idle for the heartbeat
+					// interval. Otherwise they can accumulate indefinitely if
+					// there are no real events.
 					if err := vs.alignStreams(ctx, event, sgtid.Keyspace, sgtid.Shard); err != nil {
 						return err
 					}
+					if vs.heartbeatInterval == 0 || len(sendevents) != 0 || len(eventss) != 0 ||
+						time.Since(lastSent) < time.Duration(vs.heartbeatInterval)*time.Second {
+						continue
+					}
+					heartbeat := &binlogdatapb.VEvent{
+						Type:        binlogdatapb.VEventType_HEARTBEAT,
+						Timestamp:   event.Timestamp,
+						CurrentTime: event.CurrentTime,
+						Keyspace:    sgtid.Keyspace,
+						Shard:       sgtid.Shard,
+					}
+					if err := vs.sendAll(sgtid, [][]*binlogdatapb.VEvent{{heartbeat}}); err != nil {
+						return err
+					}
+					lastSent = time.Now()
 
 				case binlogdatapb.VEventType_JOURNAL:
 					journal := event.Journal
@@ -606,6 +652,9 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 					Type:  binlogdatapb.VEventType_VGTID,
 					Vgtid: proto.Clone(vs.vgtid).(*binlogdatapb.VGtid),
 				}
+			} else if event.Type == binlogdatapb.VEventType_HEARTBEAT {
+				// The heartbeat of a shard carries the current position.
+				event.Vgtid = proto.Clone(vs.vgtid).(*binlogdatapb.VGtid)
 			} else if event.Type == binlogdatapb.VEventType_LASTPK {
 				var foundIndex = -1
 				eventTablePK := event.LastPKEvent.TableLastPK
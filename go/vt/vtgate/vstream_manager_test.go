@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/test/utils"
 
 	"vitess.io/vitess/go/vt/topo"
 
@@ -198,6 +199,84 @@ func TestVStreamEvents(t *testing.T) {
 	<-ch
 }
 
+func TestVStreamConsumerGroup(t *testing.T) {
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(context.Background(), cell, ks, []string{"-20"})
+
+	vsm := newTestVStreamManager(hc, st, cell)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+
+	vgtid := func(gtid string) *binlogdatapb.VGtid {
+		return &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: ks, Shard: "-20", Gtid: gtid}}}
+	}
+	flags := &vtgatepb.VStreamFlags{ConsumerGroup: "cg"}
+	// startVStream starts a stream of the group receiving one transaction,
+	// and returns the function ending it.
+	startVStream := func(start *binlogdatapb.VGtid, gtid string) func() {
+		sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: gtid},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := make(chan *binlogdatapb.VStreamResponse)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, start, nil, flags, func(events []*binlogdatapb.VEvent) error {
+				ch <- &binlogdatapb.VStreamResponse{Events: events}
+				return nil
+			})
+		}()
+		verifyEvents(t, ch, &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid(gtid)},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}})
+		return func() {
+			cancel()
+			<-done
+		}
+	}
+	getCheckpoint := func() *vtgatepb.VStreamCheckpoint {
+		checkpoint, _, err := st.topoServer.GetVStreamCheckpoint(context.Background(), "cg")
+		require.NoError(t, err)
+		return checkpoint
+	}
+
+	// The first stream of the group saves the vgtid of the request, and no
+	// other stream of the group can run until it ends.
+	sbc0.StartPos = "pos"
+	end := startVStream(vgtid("pos"), "gtid01")
+	checkpoint := getCheckpoint()
+	utils.MustMatch(t, vgtid("pos"), checkpoint.Vgtid)
+	assert.NotEmpty(t, checkpoint.Owner)
+	err := vsm.VStream(context.Background(), topodatapb.TabletType_PRIMARY, nil, nil, flags, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consumer group cg already has a running stream")
+	end()
+
+	// The vgtids sent are not saved: they were not acked by the client.
+	checkpoint = getCheckpoint()
+	utils.MustMatch(t, vgtid("pos"), checkpoint.Vgtid)
+	assert.Empty(t, checkpoint.Owner)
+
+	// A stream without a vgtid resumes from the checkpoint.
+	startVStream(nil, "gtid02")()
+
+	// The vgtid of the request acks the events up to it.
+	sbc0.StartPos = "gtid02"
+	startVStream(vgtid("gtid02"), "gtid03")()
+	utils.MustMatch(t, vgtid("gtid02"), getCheckpoint().Vgtid)
+
+	err = vsm.VStream(context.Background(), topodatapb.TabletType_PRIMARY, nil, nil, &vtgatepb.VStreamFlags{ConsumerGroup: "other"}, nil)
+	assert.EqualError(t, err, "consumer group other has no checkpoint: the vgtid to start from must be specified")
+	err = vsm.VStream(context.Background(), topodatapb.TabletType_PRIMARY, vgtid("pos"), nil, &vtgatepb.VStreamFlags{ConsumerGroup: "a/b"}, nil)
+	assert.EqualError(t, err, "invalid consumer group a/b: it must not contain a '/'")
+}
+
 // TestVStreamChunks ensures that a transaction that's broken
 // into chunks is sent together.
 func TestVStreamChunks(t *testing.T) {
@@ -1062,6 +1141,63 @@ func TestVStreamIdleHeartbeat(t *testing.T) {
 	}
 }
 
+func TestVStreamShardHeartbeat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cell := "aa"
+	ks := "TestVStream"
+	_ = createSandbox(ks)
+	hc := discovery.NewFakeHealthCheck(nil)
+	st := getSandboxTopo(ctx, cell, ks, []string{"-20"})
+	vsm := newTestVStreamManager(hc, st, cell)
+	sbc0 := hc.AddTestTablet(cell, "1.1.1.1", 1001, ks, "-20", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	addTabletToSandboxTopo(t, st, ks, "-20", sbc0.Tablet())
+	// The sandbox sends a heartbeat every second the stream is idle.
+	sbc0.VStreamCh = make(chan *binlogdatapb.VEvent)
+	go func() {
+		sbc0.VStreamCh <- &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"}
+		sbc0.VStreamCh <- &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT}
+	}()
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: ks,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	ch := make(chan *binlogdatapb.VEvent)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, &vtgatepb.VStreamFlags{HeartbeatInterval: 1}, func(events []*binlogdatapb.VEvent) error {
+			for _, event := range events {
+				// The heartbeats of vtgate itself have no shard.
+				if event.Type == binlogdatapb.VEventType_HEARTBEAT && event.Shard != "" {
+					select {
+					case ch <- event:
+					case <-ctx.Done():
+					}
+				}
+			}
+			return nil
+		})
+	}()
+
+	select {
+	case event := <-ch:
+		assert.Equal(t, ks, event.Keyspace)
+		assert.Equal(t, "-20", event.Shard)
+		utils.MustMatch(t, &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: ks,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}},
+		}, event.Vgtid)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no heartbeat was sent for the idle shard")
+	}
+}
+
 func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell string) *vstreamManager {
 	gw := NewTabletGateway(context.Background(), hc, serv, cell)
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
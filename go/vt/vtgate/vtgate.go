@@ -34,6 +34,7 @@ import (
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
@@ -44,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
@@ -65,6 +67,8 @@ var (
 	queryPlanCacheLFU    = flag.Bool("gate_query_cache_lfu", cache.DefaultConfig.LFU, "gate server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
 	_                    = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows        = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
+	queryMemoryBudget    = flag.Int64("query_memory_budget", 0, "Maximum number of bytes a query may use in vtgate to hold the intermediate results of its cross-shard sorts and hash joins. The queries exceeding it spill these results to -query_spill_dir. 0 disables the budget.")
+	querySpillDir        = flag.String("query_spill_dir", os.TempDir(), "Directory where the queries exceeding -query_memory_budget spill their intermediate results. If empty, these queries fail instead.")
 	warnMemoryRows       = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
@@ -81,7 +85,9 @@ var (
 
 	// Put set-passthrough under a flag.
 	sysVarSetEnabled = flag.Bool("enable_system_settings", true, "This will enable the system settings to be changed per session at the database connection level")
-	plannerVersion   = flag.String("planner_version", "v3", "Sets the default planner to use when the session has not changed it. Valid values are: V3, Gen4, Gen4Greedy and Gen4Fallback. Gen4Fallback tries the new gen4 planner and falls back to the V3 planner if the gen4 fails. All Gen4 versions should be considered experimental!")
+	// strictSystemSettings rejects the system settings vtgate would otherwise ignore.
+	strictSystemSettings = flag.Bool("strict_system_settings", false, "Reject the SET statements of the system settings which are not applied to the database connections of the session, and of the ones checked against the database which have a different value, instead of ignoring them")
+	plannerVersion       = flag.String("planner_version", "v3", "Sets the default planner to use when the session has not changed it. Valid values are: V3, Gen4, Gen4Greedy and Gen4Fallback. Gen4Fallback tries the new gen4 planner and falls back to the V3 planner if the gen4 fails. All Gen4 versions should be considered experimental!")
 
 	// lockHeartbeatTime is used to set the next heartbeat time.
 	lockHeartbeatTime = flag.Duration("lock_heartbeat_time", 5*time.Second, "If there is lock function used. This will keep the lock connection active by using this heartbeat")
@@ -89,12 +95,18 @@ var (
 
 	foreignKeyMode = flag.String("foreign_key_mode", "allow", "This is to provide how to handle foreign key constraint in create/alter table. Valid values are: allow, disallow")
 
+	// flag to create the temporary tables of the sessions targeting sharded keyspaces in an unsharded keyspace
+	tempTableKeyspace = flag.String("temp_table_keyspace", "", "Unsharded keyspace in which the temporary tables created in sharded keyspaces are created. These tables are only visible to the session creating them, and can be joined with the tables of any keyspace. When empty, temporary tables are not supported in sharded keyspaces")
+
 	// flags to enable/disable online and direct DDL statements
 	enableOnlineDDL = flag.Bool("enable_online_ddl", true, "Allow users to submit, review and control Online DDL")
 	enableDirectDDL = flag.Bool("enable_direct_ddl", true, "Allow users to submit direct DDL statements")
 
 	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker; requires queryserver-config-schema-change-signal to be enabled on the underlying vttablets for this to work")
 	schemaChangeUser         = flag.String("schema_change_signal_user", "", "User to be used to send down query to vttablet to retrieve schema changes")
+
+	// flag to route the tables of the unsharded keyspaces found by the schema tracker
+	discoverTables = flag.Bool("schema_change_discover_tables", false, "Route the tables found by the schema tracker in unsharded keyspaces, which are not in the vschema, without a keyspace qualifier. A table found in more than one keyspace must be qualified by its keyspace. Requires schema_change_signal")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -150,6 +162,10 @@ type VTGate struct {
 	// the throttled loggers for all errors, one per API entry
 	logExecute       *logutil.ThrottledLogger
 	logStreamExecute *logutil.ThrottledLogger
+
+	// draining is set once vtgate started draining its connections to shut
+	// down, which makes it unhealthy for the load balancers.
+	draining sync2.AtomicBool
 }
 
 // RegisterVTGate defines the type of registration mechanism.
@@ -163,6 +179,9 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	if rpcVTGate != nil {
 		log.Fatalf("VTGate already initialized")
 	}
+	if err := vindexes.LoadFlagPlugins(); err != nil {
+		log.Fatalf("Unable to load the vindex plugins: %v", err)
+	}
 
 	// vschemaCounters needs to be initialized before planner to
 	// catch the initial load stats.
@@ -272,6 +291,11 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 
 	warnings = stats.NewCountersWithSingleLabel("VtGateWarnings", "Vtgate warnings", "type", "IgnoredSet", "ResultsExceeded", "WarnPayloadSizeExceeded")
 
+	var lci *lookupCacheInvalidator
+	if *lookupCacheVStream {
+		lci = newLookupCacheInvalidator(executor.VSchema, vsm.VStream)
+	}
+
 	servenv.OnRun(func() {
 		for _, f := range RegisterVTGates {
 			f(rpcVTGate)
@@ -279,11 +303,17 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		if st != nil && *enableSchemaChangeSignal {
 			st.Start()
 		}
+		if lci != nil {
+			lci.Open()
+		}
 	})
 	servenv.OnTerm(func() {
 		if st != nil && *enableSchemaChangeSignal {
 			st.Stop()
 		}
+		if lci != nil {
+			lci.Close()
+		}
 	})
 	rpcVTGate.registerDebugHealthHandler()
 	rpcVTGate.registerDebugEnvHandler()
@@ -349,6 +379,7 @@ func (vtg *VTGate) registerDebugHealthHandler() {
 		}
 		w.Header().Set("Content-Type", "text/plain")
 		if err := vtg.IsHealthy(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
 			w.Write([]byte("not ok"))
 			return
 		}
@@ -359,6 +390,9 @@ func (vtg *VTGate) registerDebugHealthHandler() {
 // IsHealthy returns nil if server is healthy.
 // Otherwise, it returns an error indicating the reason.
 func (vtg *VTGate) IsHealthy() error {
+	if vtg.draining.Get() {
+		return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vtgate is draining its connections to shut down")
+	}
 	return nil
 }
 
@@ -373,6 +407,7 @@ func (vtg *VTGate) Execute(ctx context.Context, session *vtgatepb.Session, sql s
 	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
 	statsKey := []string{"Execute", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
 	defer vtg.timings.Record(statsKey, time.Now())
+	defer recordDeadlineBudget(ctx, deadlineHopVTGate)()
 
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
@@ -424,6 +459,35 @@ func (vtg *VTGate) ExecuteBatch(ctx context.Context, session *vtgatepb.Session,
 	return session, qrl, nil
 }
 
+// ExecuteScript executes a script of statements in a single transaction,
+// which is rolled back if any of them fails or aborts the script. It returns
+// the results of the statements and the variables of the script. This is a
+// V3 function.
+func (vtg *VTGate) ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	// In this context, we don't care if we can't fully parse destination
+	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
+	statsKey := []string{"ExecuteScript", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
+	defer vtg.timings.Record(statsKey, time.Now())
+	defer recordDeadlineBudget(ctx, deadlineHopVTGate)()
+
+	results, vars, err := vtg.executeScript(ctx, NewSafeSession(session), statements, variables)
+	if err == nil {
+		for _, qr := range results {
+			vtg.rowsReturned.Add(statsKey, int64(len(qr.Rows)))
+			vtg.rowsAffected.Add(statsKey, int64(qr.RowsAffected))
+		}
+		return session, results, vars, nil
+	}
+
+	query := map[string]interface{}{
+		"Statements": statements,
+		"Variables":  variables,
+		"Session":    session,
+	}
+	err = recordAndAnnotateError(err, statsKey, query, vtg.logExecute)
+	return session, nil, nil, err
+}
+
 // StreamExecute executes a streaming query. This is a V3 function.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines.
@@ -433,6 +497,7 @@ func (vtg *VTGate) StreamExecute(ctx context.Context, session *vtgatepb.Session,
 	statsKey := []string{"StreamExecute", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
 
 	defer vtg.timings.Record(statsKey, time.Now())
+	defer recordDeadlineBudget(ctx, deadlineHopVTGate)()
 
 	var err error
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
@@ -174,6 +174,42 @@ func TestVTGateExecuteWithKeyspaceShard(t *testing.T) {
 	require.Contains(t, err.Error(), `no healthy tablet available for 'keyspace:"TestUnsharded" shard:"noshard" tablet_type:PRIMARY`)
 }
 
+func TestVTGateExecuteBatchSavepoint(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+
+	session, qrl, err := rpcVTGate.ExecuteBatch(
+		context.Background(),
+		&vtgatepb.Session{TargetString: "@primary"},
+		[]string{
+			"begin",
+			"savepoint a",
+			"update t1 set v = 1 where id = 1",
+			"rollback to a",
+			"rollback to b",
+			"commit",
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, qrl, 6)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, qrl[i].QueryError)
+	}
+	require.Error(t, qrl[4].QueryError)
+	assert.Contains(t, qrl[4].QueryError.Error(), "SAVEPOINT does not exist: rollback to b")
+	require.NoError(t, qrl[5].QueryError)
+	assert.False(t, session.InTransaction)
+
+	var got []string
+	for _, query := range sbc.Queries {
+		got = append(got, query.Sql)
+	}
+	assert.Equal(t, []string{"savepoint a", "update t1 set v = :vtg1 where id = :vtg2", "rollback to a"}, got)
+	assert.EqualValues(t, 1, sbc.CommitCount.Get())
+}
+
 func TestVTGateStreamExecute(t *testing.T) {
 	ks := KsTestUnsharded
 	shard := "0"
@@ -104,6 +104,16 @@ func (sn *VTGateSession) ExecuteBatch(ctx context.Context, query []string, bindV
 	return res, errs
 }
 
+// ExecuteScript executes a script of statements on vtgate in a single
+// transaction, which is rolled back if any of them fails or aborts the
+// script. It returns the results of the statements and the variables of the
+// script. The session must not be in a transaction.
+func (sn *VTGateSession) ExecuteScript(ctx context.Context, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) ([]*sqltypes.Result, map[string]*querypb.BindVariable, error) {
+	session, res, vars, err := sn.impl.ExecuteScript(ctx, sn.session, statements, variables)
+	sn.session = session
+	return res, vars, err
+}
+
 // StreamExecute executes a streaming query on vtgate.
 // It returns a ResultStream and an error. First check the
 // error. Then you can pull values from the ResultStream until io.EOF,
@@ -135,6 +145,9 @@ type Impl interface {
 	// ExecuteBatch executes a non-streaming queries on vtgate. This is a V3 function.
 	ExecuteBatch(ctx context.Context, session *vtgatepb.Session, queryList []string, bindVarsList []map[string]*querypb.BindVariable) (*vtgatepb.Session, []sqltypes.QueryResponse, error)
 
+	// ExecuteScript executes a script of statements on vtgate in a single transaction. This is a V3 function.
+	ExecuteScript(ctx context.Context, session *vtgatepb.Session, statements []*vtgatepb.ScriptStatement, variables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*sqltypes.Result, map[string]*querypb.BindVariable, error)
+
 	// StreamExecute executes a streaming query on vtgate. This is a V3 function.
 	StreamExecute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (sqltypes.ResultStream, error)
 
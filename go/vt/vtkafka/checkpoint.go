@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtkafka
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// Checkpointer saves the position of a connector.
+type Checkpointer interface {
+	// Load returns the saved position, or nil if there is none.
+	Load(ctx context.Context) (*binlogdatapb.VGtid, error)
+	Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error
+}
+
+// fileCheckpointer saves the position in a JSON file.
+type fileCheckpointer struct {
+	name string
+}
+
+// NewFileCheckpointer returns a Checkpointer which saves the position in a
+// file.
+func NewFileCheckpointer(name string) Checkpointer {
+	return &fileCheckpointer{name: name}
+}
+
+// Load is part of the Checkpointer interface.
+func (fc *fileCheckpointer) Load(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	data, err := os.ReadFile(fc.name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := json2.Unmarshal(data, vgtid); err != nil {
+		return nil, vterrors.Wrapf(err, "bad checkpoint in %s", fc.name)
+	}
+	return vgtid, nil
+}
+
+// Save is part of the Checkpointer interface. The file is replaced
+// atomically, so that a crash never leaves a partial checkpoint.
+func (fc *fileCheckpointer) Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error {
+	data, err := json2.MarshalPB(vgtid)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(path.Dir(fc.name), path.Base(fc.name)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fc.name)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtkafka
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/kafkarest"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// RowChange is a change of a row of a table.
+type RowChange struct {
+	Keyspace string
+	Shard    string
+	Table    string
+	// Op is insert, update or delete.
+	Op string
+	// Timestamp is the time of the binlog event of the change, in seconds.
+	Timestamp int64
+	Fields    []*querypb.Field
+	// Before and After are nil for the inserts and the deletes.
+	Before, After []sqltypes.Value
+}
+
+// SchemaChange is a DDL applied to a keyspace. The DDLs applied to every
+// shard of a keyspace are streamed once per shard.
+type SchemaChange struct {
+	Keyspace  string `json:"keyspace"`
+	Timestamp int64  `json:"timestamp"`
+	DDL       string `json:"ddl"`
+}
+
+// Encoder encodes the changes into the values of the records published to
+// Kafka. The encoders with schemas publish them along the records, and the
+// REST proxy registers them in its schema registry.
+type Encoder interface {
+	// ContentType is the content type of the records for the REST proxy.
+	ContentType() string
+	// RowSchemas returns the schemas of the records of the row changes of a
+	// table, or nil if the records have no schemas.
+	RowSchemas(keyspace, table string, fields []*querypb.Field) (*kafkarest.Schemas, error)
+	EncodeRow(change *RowChange) (json.RawMessage, error)
+	// SchemaChangeSchemas returns the schemas of the records of the schema
+	// changes, or nil if the records have no schemas.
+	SchemaChangeSchemas() *kafkarest.Schemas
+	EncodeSchemaChange(change *SchemaChange) (json.RawMessage, error)
+}
+
+var encoders = make(map[string]func() Encoder)
+
+// RegisterEncoder registers an encoder under the name of its format.
+func RegisterEncoder(format string, newEncoder func() Encoder) {
+	if _, ok := encoders[format]; ok {
+		panic(fmt.Sprintf("encoder %s is already registered", format))
+	}
+	encoders[format] = newEncoder
+}
+
+// NewEncoder returns the encoder of a format.
+func NewEncoder(format string) (Encoder, error) {
+	newEncoder, ok := encoders[format]
+	if !ok {
+		formats := make([]string, 0, len(encoders))
+		for format := range encoders {
+			formats = append(formats, format)
+		}
+		sort.Strings(formats)
+		return nil, fmt.Errorf("unknown format %q, expected one of %v", format, formats)
+	}
+	return newEncoder(), nil
+}
+
+// jsonEncoder encodes the changes in JSON, with the values of the columns
+// typed as JSON numbers or strings. The binary values are base64 encoded and
+// the decimals are strings, to not lose their precision.
+type jsonEncoder struct{}
+
+type jsonRowChange struct {
+	Keyspace  string                 `json:"keyspace"`
+	Shard     string                 `json:"shard"`
+	Table     string                 `json:"table"`
+	Op        string                 `json:"op"`
+	Timestamp int64                  `json:"timestamp"`
+	Before    map[string]interface{} `json:"before"`
+	After     map[string]interface{} `json:"after"`
+}
+
+// ContentType is part of the Encoder interface.
+func (*jsonEncoder) ContentType() string {
+	return kafkarest.ContentType
+}
+
+// RowSchemas is part of the Encoder interface.
+func (*jsonEncoder) RowSchemas(keyspace, table string, fields []*querypb.Field) (*kafkarest.Schemas, error) {
+	return nil, nil
+}
+
+// EncodeRow is part of the Encoder interface.
+func (*jsonEncoder) EncodeRow(change *RowChange) (json.RawMessage, error) {
+	return json.Marshal(&jsonRowChange{
+		Keyspace:  change.Keyspace,
+		Shard:     change.Shard,
+		Table:     change.Table,
+		Op:        change.Op,
+		Timestamp: change.Timestamp,
+		Before:    jsonValues(change.Fields, change.Before),
+		After:     jsonValues(change.Fields, change.After),
+	})
+}
+
+// SchemaChangeSchemas is part of the Encoder interface.
+func (*jsonEncoder) SchemaChangeSchemas() *kafkarest.Schemas {
+	return nil
+}
+
+// EncodeSchemaChange is part of the Encoder interface.
+func (*jsonEncoder) EncodeSchemaChange(change *SchemaChange) (json.RawMessage, error) {
+	return json.Marshal(change)
+}
+
+// jsonValues returns the typed values of a row by column, or nil if there is
+// no row.
+func jsonValues(fields []*querypb.Field, row []sqltypes.Value) map[string]interface{} {
+	if row == nil {
+		return nil
+	}
+	values := make(map[string]interface{}, len(fields))
+	for i, value := range row {
+		values[fields[i].Name] = jsonValue(value)
+	}
+	return values
+}
+
+func jsonValue(value sqltypes.Value) interface{} {
+	switch {
+	case value.IsNull():
+		return nil
+	case value.IsIntegral() || value.IsFloat():
+		return json.Number(value.ToString())
+	case isBytes(value.Type()):
+		return base64.StdEncoding.EncodeToString(value.Raw())
+	}
+	return value.ToString()
+}
+
+// isBytes returns true for the types whose values are arbitrary bytes.
+func isBytes(typ querypb.Type) bool {
+	return sqltypes.IsBinary(typ) || typ == querypb.Type_BIT || typ == querypb.Type_GEOMETRY
+}
+
+func init() {
+	RegisterEncoder("json", func() Encoder { return &jsonEncoder{} })
+}
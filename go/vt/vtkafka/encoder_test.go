@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtkafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/kafkarest"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	typedFields = []*querypb.Field{
+		{Name: "id", Type: querypb.Type_INT64},
+		{Name: "price", Type: querypb.Type_DECIMAL},
+		{Name: "ratio", Type: querypb.Type_FLOAT64},
+		{Name: "data", Type: querypb.Type_VARBINARY},
+		{Name: "note-1", Type: querypb.Type_VARCHAR},
+	}
+	typedChange = &RowChange{
+		Keyspace:  "ks",
+		Shard:     "-80",
+		Table:     "t1",
+		Op:        "insert",
+		Timestamp: 10,
+		Fields:    typedFields,
+		After: []sqltypes.Value{
+			sqltypes.NewInt64(1),
+			sqltypes.MakeTrusted(querypb.Type_DECIMAL, []byte("10.50")),
+			sqltypes.NewFloat64(0.5),
+			sqltypes.NewVarBinary("\x00\xff"),
+			sqltypes.NULL,
+		},
+	}
+)
+
+func TestJSONEncoder(t *testing.T) {
+	encoder, err := NewEncoder("json")
+	require.NoError(t, err)
+	value, err := encoder.EncodeRow(typedChange)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"keyspace": "ks", "shard": "-80", "table": "t1", "op": "insert", "timestamp": 10,
+		"before": null,
+		"after": {"id": 1, "price": "10.50", "ratio": 0.5, "data": "AP8=", "note-1": null}
+	}`, string(value))
+}
+
+func TestAvroEncoder(t *testing.T) {
+	encoder, err := NewEncoder("avro")
+	require.NoError(t, err)
+	assert.Equal(t, kafkarest.AvroContentType, encoder.ContentType())
+
+	schemas, err := encoder.RowSchemas("ks", "t1", typedFields)
+	require.NoError(t, err)
+	assert.Equal(t, `"string"`, schemas.KeySchema)
+	assert.JSONEq(t, `{
+		"type": "record", "name": "t1", "namespace": "vitess.ks",
+		"fields": [
+			{"name": "keyspace", "type": "string"},
+			{"name": "shard", "type": "string"},
+			{"name": "table", "type": "string"},
+			{"name": "op", "type": "string"},
+			{"name": "timestamp", "type": "long"},
+			{"name": "before", "default": null, "type": ["null", {
+				"type": "record", "name": "t1_row",
+				"fields": [
+					{"name": "id", "type": ["null", "long"], "default": null},
+					{"name": "price", "type": ["null", "string"], "default": null},
+					{"name": "ratio", "type": ["null", "double"], "default": null},
+					{"name": "data", "type": ["null", "bytes"], "default": null},
+					{"name": "note_1", "type": ["null", "string"], "default": null}
+				]
+			}]},
+			{"name": "after", "type": ["null", "t1_row"], "default": null}
+		]
+	}`, schemas.ValueSchema)
+
+	value, err := encoder.EncodeRow(typedChange)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"keyspace": "ks", "shard": "-80", "table": "t1", "op": "insert", "timestamp": 10,
+		"before": null,
+		"after": {"vitess.ks.t1_row": {
+			"id": {"long": 1}, "price": {"string": "10.50"}, "ratio": {"double": 0.5},
+			"data": {"bytes": "\u0000ÿ"}, "note_1": null
+		}}
+	}`, string(value))
+}
+
+func TestJSONSchemaEncoder(t *testing.T) {
+	encoder, err := NewEncoder("jsonschema")
+	require.NoError(t, err)
+	assert.Equal(t, kafkarest.JSONSchemaContentType, encoder.ContentType())
+
+	schemas, err := encoder.RowSchemas("ks", "t1", typedFields)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title": "ks.t1",
+		"type": "object",
+		"properties": {
+			"keyspace": {"type": "string"},
+			"shard": {"type": "string"},
+			"table": {"type": "string"},
+			"op": {"type": "string", "enum": ["insert", "update", "delete"]},
+			"timestamp": {"type": "integer"},
+			"before": {"oneOf": [{"type": "null"}, {"$ref": "#/definitions/row"}]},
+			"after": {"oneOf": [{"type": "null"}, {"$ref": "#/definitions/row"}]}
+		},
+		"definitions": {"row": {"type": "object", "properties": {
+			"id": {"type": ["null", "integer"]},
+			"price": {"type": ["null", "string"]},
+			"ratio": {"type": ["null", "number"]},
+			"data": {"type": ["null", "string"], "contentEncoding": "base64"},
+			"note-1": {"type": ["null", "string"]}
+		}}}
+	}`, schemas.ValueSchema)
+
+	// The values are encoded like the json format.
+	value, err := encoder.EncodeRow(typedChange)
+	require.NoError(t, err)
+	jsonValue, err := (&jsonEncoder{}).EncodeRow(typedChange)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(jsonValue), string(value))
+}
+
+func TestConnectorSchemaChanges(t *testing.T) {
+	events := testEvents()
+	events = append(events, []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: testVGtid},
+		{Type: binlogdatapb.VEventType_DDL, Timestamp: 20, Statement: "alter table t1 add column c int"},
+	})
+	publisher := &fakePublisher{}
+	c, err := NewConnector(&Config{
+		Keyspace:      "ks",
+		Tables:        []string{"t1"},
+		TopicTemplate: "{table}",
+		Partitioning:  PartitionByPK,
+		Format:        "avro",
+		SchemaTopic:   "{keyspace}.schema",
+	}, &fakeVTGate{events: events}, publisher, &memoryCheckpointer{})
+	require.NoError(t, err)
+
+	require.Error(t, c.stream(context.Background()))
+	require.Len(t, publisher.batches, 2)
+	rows, schemaChanges := publisher.batches[0], publisher.batches[1]
+	assert.Equal(t, kafkarest.AvroContentType, rows.ContentType)
+	wantSchemas, err := (&avroEncoder{}).RowSchemas("ks", "t1", testFields)
+	require.NoError(t, err)
+	assert.Equal(t, wantSchemas, rows.Schemas)
+
+	assert.Equal(t, "ks.schema", schemaChanges.Topic)
+	assert.Equal(t, avroSchemaChangeSchemas, schemaChanges.Schemas)
+	require.Len(t, schemaChanges.Records, 1)
+	assert.Equal(t, "ks", schemaChanges.Records[0].Key)
+	assert.JSONEq(t, `{"keyspace":"ks","timestamp":20,"ddl":"alter table t1 add column c int"}`, string(schemaChanges.Records[0].Value))
+}
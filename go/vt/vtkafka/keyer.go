@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtkafka
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// keyer computes the key of the records of the rows of a table.
+type keyer interface {
+	key(row []sqltypes.Value) (string, error)
+}
+
+// pkKeyer keys the records by the JSON array of the values of the primary
+// key of the rows.
+type pkKeyer struct {
+	columns []int
+}
+
+func (pk *pkKeyer) key(row []sqltypes.Value) (string, error) {
+	values := make([]string, 0, len(pk.columns))
+	for _, col := range pk.columns {
+		values = append(values, row[col].ToString())
+	}
+	key, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+// keyspaceIDKeyer keys the records by the hex encoded keyspace id of the
+// rows, computed with the primary vindex of the table.
+type keyspaceIDKeyer struct {
+	vindex  vindexes.Vindex
+	columns []int
+}
+
+func (ki *keyspaceIDKeyer) key(row []sqltypes.Value) (string, error) {
+	values := make([]sqltypes.Value, 0, len(ki.columns))
+	for _, col := range ki.columns {
+		values = append(values, row[col])
+	}
+	destinations, err := vindexes.Map(ki.vindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return "", err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return "", fmt.Errorf("vindex %s did not map the row to a single keyspace id: %v", ki.vindex.String(), destinations[0])
+	}
+	return hex.EncodeToString(ksid), nil
+}
+
+func (c *Connector) newKeyer(ctx context.Context, t *table) (keyer, error) {
+	if c.config.Partitioning == PartitionByPK {
+		pk := &pkKeyer{}
+		for i, field := range t.fields {
+			if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0 {
+				pk.columns = append(pk.columns, i)
+			}
+		}
+		if len(pk.columns) == 0 {
+			return nil, fmt.Errorf("table %s has no primary key to partition its records by", t.name)
+		}
+		return pk, nil
+	}
+
+	// The primary vindex of the table is the first one listed by vtgate.
+	qr, err := c.vtgate.Execute(ctx, fmt.Sprintf("show vschema vindexes on %s.%s", sqlescape.EscapeID(t.keyspace), sqlescape.EscapeID(t.name)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("table %s has no primary vindex to partition its records by", t.name)
+	}
+	row := sqltypes.ToNamedResult(qr).Rows[0]
+	params := make(map[string]string)
+	if s := row.AsString("Params", ""); s != "" {
+		for _, param := range strings.Split(s, "; ") {
+			kv := strings.SplitN(param, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("unexpected parameter %q of vindex %s", param, row.AsString("Name", ""))
+			}
+			params[kv[0]] = kv[1]
+		}
+	}
+	vindex, err := vindexes.CreateVindex(row.AsString("Type", ""), row.AsString("Name", ""), params)
+	if err != nil {
+		return nil, err
+	}
+	if vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("the primary vindex %s of table %s needs to query the database: partition its records by pk instead", vindex.String(), t.name)
+	}
+	ki := &keyspaceIDKeyer{vindex: vindex}
+	for _, name := range strings.Split(row.AsString("Columns", ""), ", ") {
+		col := -1
+		for i, field := range t.fields {
+			if strings.EqualFold(field.Name, name) {
+				col = i
+				break
+			}
+		}
+		if col == -1 {
+			return nil, fmt.Errorf("column %s of the primary vindex of table %s is not streamed", name, t.name)
+		}
+		ki.columns = append(ki.columns, col)
+	}
+	return ki, nil
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtkafka
+
+import (
+	"encoding/json"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/kafkarest"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// avroEncoder encodes the changes in the JSON encoding of Avro, which the
+// REST proxy converts to Avro with the schemas of the records. The record of
+// the changes of a table is named after the table, in the namespace
+// vitess.<keyspace>, and the nullable columns are unions with null. The
+// decimals and the unsigned bigints are strings, to not lose their precision.
+type avroEncoder struct{}
+
+type avroField struct {
+	Name    string          `json:"name"`
+	Type    interface{}     `json:"type"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+// avroNull is the default of the nullable fields.
+var avroNull = json.RawMessage("null")
+
+type avroRecord struct {
+	Type      string       `json:"type"`
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace,omitempty"`
+	Fields    []*avroField `json:"fields"`
+}
+
+var avroSchemaChangeSchemas = mustMarshalSchemas(`"string"`, &avroRecord{
+	Type:      "record",
+	Name:      "SchemaChange",
+	Namespace: "vitess",
+	Fields: []*avroField{
+		{Name: "keyspace", Type: "string"},
+		{Name: "timestamp", Type: "long"},
+		{Name: "ddl", Type: "string"},
+	},
+})
+
+// ContentType is part of the Encoder interface.
+func (*avroEncoder) ContentType() string {
+	return kafkarest.AvroContentType
+}
+
+// RowSchemas is part of the Encoder interface.
+func (*avroEncoder) RowSchemas(keyspace, table string, fields []*querypb.Field) (*kafkarest.Schemas, error) {
+	row := &avroRecord{
+		Type: "record",
+		Name: avroName(table) + "_row",
+	}
+	for _, field := range fields {
+		row.Fields = append(row.Fields, &avroField{
+			Name:    avroName(field.Name),
+			Type:    []string{"null", avroType(field.Type)},
+			Default: avroNull,
+		})
+	}
+	value := &avroRecord{
+		Type:      "record",
+		Name:      avroName(table),
+		Namespace: avroNamespace(keyspace),
+		Fields: []*avroField{
+			{Name: "keyspace", Type: "string"},
+			{Name: "shard", Type: "string"},
+			{Name: "table", Type: "string"},
+			{Name: "op", Type: "string"},
+			{Name: "timestamp", Type: "long"},
+			{Name: "before", Type: []interface{}{"null", row}, Default: avroNull},
+			{Name: "after", Type: []interface{}{"null", row.Name}, Default: avroNull},
+		},
+	}
+	return marshalSchemas(`"string"`, value)
+}
+
+// EncodeRow is part of the Encoder interface.
+func (*avroEncoder) EncodeRow(change *RowChange) (json.RawMessage, error) {
+	rowType := avroNamespace(change.Keyspace) + "." + avroName(change.Table) + "_row"
+	return json.Marshal(&struct {
+		Keyspace  string      `json:"keyspace"`
+		Shard     string      `json:"shard"`
+		Table     string      `json:"table"`
+		Op        string      `json:"op"`
+		Timestamp int64       `json:"timestamp"`
+		Before    interface{} `json:"before"`
+		After     interface{} `json:"after"`
+	}{
+		Keyspace:  change.Keyspace,
+		Shard:     change.Shard,
+		Table:     change.Table,
+		Op:        change.Op,
+		Timestamp: change.Timestamp,
+		Before:    avroRow(rowType, change.Fields, change.Before),
+		After:     avroRow(rowType, change.Fields, change.After),
+	})
+}
+
+// SchemaChangeSchemas is part of the Encoder interface.
+func (*avroEncoder) SchemaChangeSchemas() *kafkarest.Schemas {
+	return avroSchemaChangeSchemas
+}
+
+// EncodeSchemaChange is part of the Encoder interface.
+func (*avroEncoder) EncodeSchemaChange(change *SchemaChange) (json.RawMessage, error) {
+	return json.Marshal(change)
+}
+
+// avroRow returns the value of the union of null and the record of a row.
+func avroRow(rowType string, fields []*querypb.Field, row []sqltypes.Value) interface{} {
+	if row == nil {
+		return nil
+	}
+	values := make(map[string]interface{}, len(fields))
+	for i, value := range row {
+		if value.IsNull() {
+			values[avroName(fields[i].Name)] = nil
+			continue
+		}
+		typ := avroType(fields[i].Type)
+		var v interface{}
+		switch typ {
+		case "int", "long", "float", "double":
+			v = json.Number(value.ToString())
+		case "bytes":
+			// The JSON encoding of Avro encodes the bytes as the code
+			// points of a string.
+			raw := value.Raw()
+			runes := make([]rune, len(raw))
+			for i, b := range raw {
+				runes[i] = rune(b)
+			}
+			v = string(runes)
+		default:
+			v = value.ToString()
+		}
+		values[avroName(fields[i].Name)] = map[string]interface{}{typ: v}
+	}
+	return map[string]interface{}{rowType: values}
+}
+
+func avroType(typ querypb.Type) string {
+	switch {
+	case typ == querypb.Type_UINT32 || typ == querypb.Type_INT64:
+		return "long"
+	case typ == querypb.Type_UINT64:
+		return "string"
+	case sqltypes.IsIntegral(typ):
+		return "int"
+	case typ == querypb.Type_FLOAT32:
+		return "float"
+	case typ == querypb.Type_FLOAT64:
+		return "double"
+	case isBytes(typ):
+		return "bytes"
+	}
+	return "string"
+}
+
+func avroNamespace(keyspace string) string {
+	return "vitess." + avroName(keyspace)
+}
+
+// avroName replaces the characters which Avro names can't have with
+// underscores.
+func avroName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteRune('_')
+			}
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// jsonSchemaEncoder encodes the changes like the json encoder, with a JSON
+// Schema for the records of each table.
+type jsonSchemaEncoder struct {
+	jsonEncoder
+}
+
+type jsonSchema map[string]interface{}
+
+var jsonSchemaChangeSchemas = mustMarshalSchemas(`{"type":"string"}`, jsonSchema{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"title":   "vitess.SchemaChange",
+	"type":    "object",
+	"properties": jsonSchema{
+		"keyspace":  jsonSchema{"type": "string"},
+		"timestamp": jsonSchema{"type": "integer"},
+		"ddl":       jsonSchema{"type": "string"},
+	},
+})
+
+// ContentType is part of the Encoder interface.
+func (*jsonSchemaEncoder) ContentType() string {
+	return kafkarest.JSONSchemaContentType
+}
+
+// RowSchemas is part of the Encoder interface.
+func (*jsonSchemaEncoder) RowSchemas(keyspace, table string, fields []*querypb.Field) (*kafkarest.Schemas, error) {
+	columns := make(jsonSchema, len(fields))
+	for _, field := range fields {
+		column := jsonSchema{}
+		switch {
+		case sqltypes.IsIntegral(field.Type):
+			column["type"] = []string{"null", "integer"}
+		case sqltypes.IsFloat(field.Type):
+			column["type"] = []string{"null", "number"}
+		case isBytes(field.Type):
+			column["type"] = []string{"null", "string"}
+			column["contentEncoding"] = "base64"
+		default:
+			column["type"] = []string{"null", "string"}
+		}
+		columns[field.Name] = column
+	}
+	row := jsonSchema{"oneOf": []jsonSchema{{"type": "null"}, {"$ref": "#/definitions/row"}}}
+	value := jsonSchema{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title":   keyspace + "." + table,
+		"type":    "object",
+		"properties": jsonSchema{
+			"keyspace":  jsonSchema{"type": "string"},
+			"shard":     jsonSchema{"type": "string"},
+			"table":     jsonSchema{"type": "string"},
+			"op":        jsonSchema{"type": "string", "enum": []string{"insert", "update", "delete"}},
+			"timestamp": jsonSchema{"type": "integer"},
+			"before":    row,
+			"after":     row,
+		},
+		"definitions": jsonSchema{
+			"row": jsonSchema{"type": "object", "properties": columns},
+		},
+	}
+	return marshalSchemas(`{"type":"string"}`, value)
+}
+
+// SchemaChangeSchemas is part of the Encoder interface.
+func (*jsonSchemaEncoder) SchemaChangeSchemas() *kafkarest.Schemas {
+	return jsonSchemaChangeSchemas
+}
+
+func marshalSchemas(keySchema string, value interface{}) (*kafkarest.Schemas, error) {
+	valueSchema, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkarest.Schemas{KeySchema: keySchema, ValueSchema: string(valueSchema)}, nil
+}
+
+func mustMarshalSchemas(keySchema string, value interface{}) *kafkarest.Schemas {
+	schemas, err := marshalSchemas(keySchema, value)
+	if err != nil {
+		panic(err)
+	}
+	return schemas
+}
+
+func init() {
+	RegisterEncoder("avro", func() Encoder { return &avroEncoder{} })
+	RegisterEncoder("jsonschema", func() Encoder { return &jsonSchemaEncoder{} })
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vtkafka publishes the row changes streamed by the VStream API of
+// vtgate to Kafka topics.
+//
+// The changes of a table are published to their own topic, keyed by the
+// keyspace id or the primary key of the row, so that the changes of a row are
+// published to the same partition in order. The changes of a transaction are
+// published before the VGTID following them is checkpointed: a connector
+// restarted from its checkpoint publishes again the changes which followed it,
+// so every change is published at least once. The heartbeats which vtgate
+// sends on the idle shards are checkpointed too, so that a connector of quiet
+// tables doesn't restart from an old position.
+//
+// The records are encoded in one of the registered formats: json, or avro
+// and jsonschema, whose schemas are registered in the schema registry of the
+// Kafka REST proxy. The DDLs can be published to a topic of schema changes.
+package vtkafka
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/kafkarest"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	recordsPublished = stats.NewCountersWithSingleLabel("VtKafkaRecordsPublished", "Records published to Kafka per topic", "Topic")
+	streamErrors     = stats.NewCounter("VtKafkaStreamErrors", "Errors which restarted the stream of the connector")
+)
+
+// Partitioning is how the records of a table are keyed, and so partitioned.
+type Partitioning string
+
+const (
+	// PartitionByKeyspaceID keys the records by the keyspace id of the row,
+	// computed with the primary vindex of the table.
+	PartitionByKeyspaceID = Partitioning("keyspace_id")
+	// PartitionByPK keys the records by the primary key of the row.
+	PartitionByPK = Partitioning("pk")
+)
+
+// Config is the configuration of a connector.
+type Config struct {
+	// Keyspace and Tables are the tables to publish.
+	Keyspace string
+	Tables   []string
+	// TabletType is the type of the tablets to stream from.
+	TabletType topodatapb.TabletType
+	// Copy publishes the existing rows of the tables before their changes
+	// when the connector has no checkpoint. Otherwise, only the changes
+	// following the current position are published.
+	Copy bool
+	// TopicTemplate is the name of the topic of a table, where {keyspace}
+	// and {table} are replaced with the names of the keyspace and the table.
+	TopicTemplate string
+	// Partitioning is how the records are keyed.
+	Partitioning Partitioning
+	// Format is the format of the records, with a registered encoder.
+	Format string
+	// SchemaTopic is the name of the topic of the schema changes of the
+	// keyspace, where {keyspace} is replaced with its name. The schema
+	// changes are not published if it is empty.
+	SchemaTopic string
+	// RetryDelay is the delay before the stream is restarted from the
+	// checkpoint after an error.
+	RetryDelay time.Duration
+	// HeartbeatInterval is how often vtgate sends heartbeats on the idle
+	// shards, whose position is checkpointed. It is rounded down to
+	// seconds, and the idle shards send no heartbeats if it is zero.
+	HeartbeatInterval time.Duration
+}
+
+// VTGate is the part of the vtgate API used by a connector.
+type VTGate interface {
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
+		filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error)
+	Execute(ctx context.Context, query string) (*sqltypes.Result, error)
+}
+
+type vtgate struct {
+	conn *vtgateconn.VTGateConn
+}
+
+// NewVTGate returns the VTGate of a connection.
+func NewVTGate(conn *vtgateconn.VTGateConn) VTGate {
+	return &vtgate{conn: conn}
+}
+
+func (vtg *vtgate) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
+	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
+	return vtg.conn.VStream(ctx, tabletType, vgtid, filter, flags)
+}
+
+func (vtg *vtgate) Execute(ctx context.Context, query string) (*sqltypes.Result, error) {
+	return vtg.conn.Session("", nil).Execute(ctx, query, nil)
+}
+
+// Connector publishes the changes of the configured tables to Kafka.
+type Connector struct {
+	config       *Config
+	vtgate       VTGate
+	encoder      Encoder
+	publisher    Publisher
+	checkpointer Checkpointer
+
+	// tables is keyed by the qualified name of the tables, and is reset
+	// every time the stream is restarted.
+	tables map[string]*table
+}
+
+// table is what is known of a streamed table.
+type table struct {
+	keyspace string
+	name     string
+	topic    string
+	fields   []*querypb.Field
+	keyer    keyer
+	schemas  *kafkarest.Schemas
+}
+
+// NewConnector returns a connector.
+func NewConnector(config *Config, vtg VTGate, publisher Publisher, checkpointer Checkpointer) (*Connector, error) {
+	if config.Keyspace == "" || len(config.Tables) == 0 {
+		return nil, fmt.Errorf("a keyspace and tables to publish are required")
+	}
+	switch config.Partitioning {
+	case PartitionByKeyspaceID, PartitionByPK:
+	default:
+		return nil, fmt.Errorf("unknown partitioning %q, expected %s or %s", config.Partitioning, PartitionByKeyspaceID, PartitionByPK)
+	}
+	if config.TopicTemplate == "" {
+		return nil, fmt.Errorf("a topic template is required")
+	}
+	encoder, err := NewEncoder(config.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &Connector{
+		config:       config,
+		vtgate:       vtg,
+		encoder:      encoder,
+		publisher:    publisher,
+		checkpointer: checkpointer,
+	}, nil
+}
+
+// Run publishes the changes until the context is done. The stream is
+// restarted from the checkpoint after every error.
+func (c *Connector) Run(ctx context.Context) error {
+	for {
+		err := c.stream(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		streamErrors.Add(1)
+		log.Warningf("vtkafka: stream of keyspace %s failed, restarting it from the checkpoint in %v: %v", c.config.Keyspace, c.config.RetryDelay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.config.RetryDelay):
+		}
+	}
+}
+
+// stream publishes the changes following the checkpoint until an error.
+func (c *Connector) stream(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	vgtid, err := c.checkpointer.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if vgtid == nil {
+		if vgtid, err = c.startVGtid(ctx); err != nil {
+			return err
+		}
+	}
+	filter := &binlogdatapb.Filter{}
+	for _, name := range c.config.Tables {
+		filter.Rules = append(filter.Rules, &binlogdatapb.Rule{
+			Match:  name,
+			Filter: fmt.Sprintf("select * from %s", sqlparser.String(sqlparser.NewTableIdent(name))),
+		})
+	}
+	reader, err := c.vtgate.VStream(ctx, c.config.TabletType, vgtid, filter, &vtgatepb.VStreamFlags{
+		HeartbeatInterval: uint32(c.config.HeartbeatInterval / time.Second),
+	})
+	if err != nil {
+		return err
+	}
+	c.tables = make(map[string]*table)
+	for {
+		events, err := reader.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("stream ended")
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.publish(ctx, events); err != nil {
+			return err
+		}
+	}
+}
+
+// startVGtid returns the position to start from without a checkpoint. The
+// copy of the tables needs to list their shards, since vtgate only expands
+// the shards of a keyspace from the current position.
+func (c *Connector) startVGtid(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	if !c.config.Copy {
+		return &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: c.config.Keyspace, Gtid: "current"}}}, nil
+	}
+	qr, err := c.vtgate.Execute(ctx, "show vitess_shards")
+	if err != nil {
+		return nil, err
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	for _, row := range qr.Rows {
+		keyspace, shard, err := topoproto.ParseKeyspaceShard(row[0].ToString())
+		if err != nil {
+			return nil, err
+		}
+		if keyspace == c.config.Keyspace {
+			vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{Keyspace: keyspace, Shard: shard})
+		}
+	}
+	if len(vgtid.ShardGtids) == 0 {
+		return nil, fmt.Errorf("keyspace %s has no shards", c.config.Keyspace)
+	}
+	return vgtid, nil
+}
+
+// publish publishes the row changes and the schema changes of a batch of
+// events, and then saves the last VGTID of the batch. vtgate ends the batches
+// at the end of the transactions.
+func (c *Connector) publish(ctx context.Context, events []*binlogdatapb.VEvent) error {
+	var vgtid *binlogdatapb.VGtid
+	var batches []*Batch
+	tables := make(map[*table]*Batch)
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_FIELD:
+			if err := c.updateTable(ctx, event.FieldEvent); err != nil {
+				return err
+			}
+			// The next changes of the table are published in a new batch,
+			// with the new schemas.
+			delete(tables, c.tables[event.FieldEvent.TableName])
+		case binlogdatapb.VEventType_ROW:
+			t, ok := c.tables[event.RowEvent.TableName]
+			if !ok {
+				return fmt.Errorf("received a row event for table %s before its field event", event.RowEvent.TableName)
+			}
+			batch, ok := tables[t]
+			if !ok {
+				batch = &Batch{Topic: t.topic, ContentType: c.encoder.ContentType(), Schemas: t.schemas}
+				tables[t] = batch
+				batches = append(batches, batch)
+			}
+			for _, change := range event.RowEvent.RowChanges {
+				record, err := c.record(t, event.RowEvent.Shard, event.Timestamp, change)
+				if err != nil {
+					return err
+				}
+				batch.Records = append(batch.Records, record)
+			}
+		case binlogdatapb.VEventType_DDL:
+			if c.config.SchemaTopic == "" {
+				continue
+			}
+			value, err := c.encoder.EncodeSchemaChange(&SchemaChange{
+				Keyspace:  c.config.Keyspace,
+				Timestamp: event.Timestamp,
+				DDL:       event.Statement,
+			})
+			if err != nil {
+				return err
+			}
+			batches = append(batches, &Batch{
+				Topic:       strings.ReplaceAll(c.config.SchemaTopic, "{keyspace}", c.config.Keyspace),
+				ContentType: c.encoder.ContentType(),
+				Schemas:     c.encoder.SchemaChangeSchemas(),
+				Records:     []kafkarest.Record{{Key: c.config.Keyspace, Value: value}},
+			})
+		case binlogdatapb.VEventType_VGTID:
+			vgtid = event.Vgtid
+		case binlogdatapb.VEventType_HEARTBEAT:
+			// The heartbeats of the idle shards carry the current position.
+			if event.Vgtid != nil {
+				vgtid = event.Vgtid
+			}
+		}
+	}
+	for _, batch := range batches {
+		if err := c.publisher.Publish(ctx, batch); err != nil {
+			return err
+		}
+		recordsPublished.Add(batch.Topic, int64(len(batch.Records)))
+	}
+	if vgtid == nil {
+		return nil
+	}
+	return c.checkpointer.Save(ctx, vgtid)
+}
+
+// updateTable records the fields of a table, which change with its schema.
+func (c *Connector) updateTable(ctx context.Context, fieldEvent *binlogdatapb.FieldEvent) error {
+	t, ok := c.tables[fieldEvent.TableName]
+	if !ok {
+		keyspace, name := c.config.Keyspace, fieldEvent.TableName
+		if parts := strings.SplitN(fieldEvent.TableName, ".", 2); len(parts) == 2 {
+			keyspace, name = parts[0], parts[1]
+		}
+		t = &table{
+			keyspace: keyspace,
+			name:     name,
+			topic:    strings.NewReplacer("{keyspace}", keyspace, "{table}", name).Replace(c.config.TopicTemplate),
+		}
+		c.tables[fieldEvent.TableName] = t
+	}
+	t.fields = fieldEvent.Fields
+	keyer, err := c.newKeyer(ctx, t)
+	if err != nil {
+		return err
+	}
+	t.keyer = keyer
+	t.schemas, err = c.encoder.RowSchemas(t.keyspace, t.name, t.fields)
+	return err
+}
+
+// record returns the record of a row change.
+func (c *Connector) record(t *table, shard string, timestamp int64, change *binlogdatapb.RowChange) (kafkarest.Record, error) {
+	rowChange := &RowChange{
+		Keyspace:  t.keyspace,
+		Shard:     shard,
+		Table:     t.name,
+		Timestamp: timestamp,
+		Fields:    t.fields,
+	}
+	if change.Before != nil {
+		rowChange.Before = sqltypes.MakeRowTrusted(t.fields, change.Before)
+	}
+	if change.After != nil {
+		rowChange.After = sqltypes.MakeRowTrusted(t.fields, change.After)
+	}
+	row := rowChange.After
+	switch {
+	case change.Before == nil:
+		rowChange.Op = "insert"
+	case change.After == nil:
+		rowChange.Op = "delete"
+		row = rowChange.Before
+	default:
+		rowChange.Op = "update"
+	}
+	key, err := t.keyer.key(row)
+	if err != nil {
+		return kafkarest.Record{}, fmt.Errorf("can't compute the key of a row of table %s: %v", t.name, err)
+	}
+	value, err := c.encoder.EncodeRow(rowChange)
+	if err != nil {
+		return kafkarest.Record{}, err
+	}
+	return kafkarest.Record{Key: key, Value: value}, nil
+}
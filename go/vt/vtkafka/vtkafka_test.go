@@ -0,0 +1,275 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtkafka
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/kafkarest"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+type fakeVTGate struct {
+	vgtid   *binlogdatapb.VGtid
+	filter  *binlogdatapb.Filter
+	flags   *vtgatepb.VStreamFlags
+	events  [][]*binlogdatapb.VEvent
+	results map[string]*sqltypes.Result
+}
+
+func (vtg *fakeVTGate) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
+	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
+	vtg.vgtid, vtg.filter, vtg.flags = vgtid, filter, flags
+	return vtg, nil
+}
+
+func (vtg *fakeVTGate) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(vtg.events) == 0 {
+		return nil, io.EOF
+	}
+	events := vtg.events[0]
+	vtg.events = vtg.events[1:]
+	return events, nil
+}
+
+func (vtg *fakeVTGate) Execute(ctx context.Context, query string) (*sqltypes.Result, error) {
+	qr, ok := vtg.results[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	return qr, nil
+}
+
+type fakePublisher struct {
+	batches []*Batch
+	records []kafkarest.Record
+	err     error
+}
+
+func (fp *fakePublisher) Publish(ctx context.Context, batch *Batch) error {
+	if fp.err != nil {
+		return fp.err
+	}
+	fp.batches = append(fp.batches, batch)
+	fp.records = append(fp.records, batch.Records...)
+	return nil
+}
+
+func (fp *fakePublisher) Close() {}
+
+type memoryCheckpointer struct {
+	vgtid *binlogdatapb.VGtid
+}
+
+func (mc *memoryCheckpointer) Load(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	return mc.vgtid, nil
+}
+
+func (mc *memoryCheckpointer) Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error {
+	mc.vgtid = vgtid
+	return nil
+}
+
+var (
+	testFields = []*querypb.Field{
+		{Name: "id", Type: querypb.Type_INT64, Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG)},
+		{Name: "name", Type: querypb.Type_VARCHAR},
+	}
+	testVGtid = &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "-80", Gtid: "pos1"}}}
+)
+
+func testEvents() [][]*binlogdatapb.VEvent {
+	row := func(values ...string) *querypb.Row {
+		var row []sqltypes.Value
+		for i, value := range values {
+			if value == "null" {
+				row = append(row, sqltypes.NULL)
+				continue
+			}
+			row = append(row, sqltypes.MakeTrusted(testFields[i].Type, []byte(value)))
+		}
+		return sqltypes.RowToProto3(row)
+	}
+	return [][]*binlogdatapb.VEvent{{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: testFields}},
+		{Type: binlogdatapb.VEventType_ROW, Timestamp: 10, RowEvent: &binlogdatapb.RowEvent{
+			TableName: "ks.t1",
+			Shard:     "-80",
+			RowChanges: []*binlogdatapb.RowChange{
+				{After: row("1", "a")},
+				{Before: row("1", "a"), After: row("1", "null")},
+				{Before: row("2", "b")},
+			},
+		}},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: testVGtid},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}
+}
+
+func TestConnectorPK(t *testing.T) {
+	vtg := &fakeVTGate{events: testEvents()}
+	publisher := &fakePublisher{}
+	checkpointer := &memoryCheckpointer{}
+	c, err := NewConnector(&Config{
+		Keyspace:      "ks",
+		Tables:        []string{"t1"},
+		TopicTemplate: "cdc.{keyspace}.{table}",
+		Partitioning:  PartitionByPK,
+		Format:        "json",
+	}, vtg, publisher, checkpointer)
+	require.NoError(t, err)
+
+	err = c.stream(context.Background())
+	assert.EqualError(t, err, "stream ended")
+	utils.MustMatch(t, &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Gtid: "current"}}}, vtg.vgtid)
+	utils.MustMatch(t, &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select * from t1"}}}, vtg.filter)
+
+	require.Len(t, publisher.batches, 1)
+	assert.Equal(t, "cdc.ks.t1", publisher.batches[0].Topic)
+	assert.Equal(t, kafkarest.ContentType, publisher.batches[0].ContentType)
+	assert.Nil(t, publisher.batches[0].Schemas)
+	want := []string{
+		`{"keyspace":"ks","shard":"-80","table":"t1","op":"insert","timestamp":10,"before":null,"after":{"id":1,"name":"a"}}`,
+		`{"keyspace":"ks","shard":"-80","table":"t1","op":"update","timestamp":10,"before":{"id":1,"name":"a"},"after":{"id":1,"name":null}}`,
+		`{"keyspace":"ks","shard":"-80","table":"t1","op":"delete","timestamp":10,"before":{"id":2,"name":"b"},"after":null}`,
+	}
+	require.Len(t, publisher.records, len(want))
+	for i, record := range publisher.records {
+		assert.JSONEq(t, want[i], string(record.Value))
+	}
+	assert.Equal(t, `["1"]`, publisher.records[0].Key)
+	assert.Equal(t, `["2"]`, publisher.records[2].Key)
+	utils.MustMatch(t, testVGtid, checkpointer.vgtid)
+
+	// The stream resumes from the checkpoint.
+	vtg.events = nil
+	require.Error(t, c.stream(context.Background()))
+	utils.MustMatch(t, testVGtid, vtg.vgtid)
+}
+
+func TestConnectorKeyspaceID(t *testing.T) {
+	vtg := &fakeVTGate{
+		events: testEvents(),
+		results: map[string]*sqltypes.Result{
+			"show vschema vindexes on `ks`.`t1`": sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("Columns|Name|Type|Params|Owner", "varchar|varchar|varchar|varchar|varchar"),
+				"id|hash|hash||",
+			),
+			"show vitess_shards": sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("Shards", "varchar"),
+				"ks/-80", "ks/80-", "other/0",
+			),
+		},
+	}
+	publisher := &fakePublisher{}
+	c, err := NewConnector(&Config{
+		Keyspace:      "ks",
+		Tables:        []string{"t1"},
+		Copy:          true,
+		TopicTemplate: "{table}",
+		Partitioning:  PartitionByKeyspaceID,
+		Format:        "json",
+	}, vtg, publisher, &memoryCheckpointer{})
+	require.NoError(t, err)
+
+	require.Error(t, c.stream(context.Background()))
+	utils.MustMatch(t, &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{
+		{Keyspace: "ks", Shard: "-80"},
+		{Keyspace: "ks", Shard: "80-"},
+	}}, vtg.vgtid)
+	require.Len(t, publisher.records, 3)
+	// The keyspace ids of 1 and 2 with the hash vindex.
+	assert.Equal(t, "166b40b44aba4bd6", publisher.records[0].Key)
+	assert.Equal(t, "06e7ea22ce92708f", publisher.records[2].Key)
+}
+
+func TestConnectorPublishError(t *testing.T) {
+	checkpointer := &memoryCheckpointer{}
+	c, err := NewConnector(&Config{
+		Keyspace:      "ks",
+		Tables:        []string{"t1"},
+		TopicTemplate: "{table}",
+		Partitioning:  PartitionByPK,
+		Format:        "json",
+	}, &fakeVTGate{events: testEvents()}, &fakePublisher{err: fmt.Errorf("proxy unavailable")}, checkpointer)
+	require.NoError(t, err)
+
+	assert.EqualError(t, c.stream(context.Background()), "proxy unavailable")
+	// The changes which were not published are not checkpointed.
+	assert.Nil(t, checkpointer.vgtid)
+}
+
+func TestConnectorHeartbeat(t *testing.T) {
+	heartbeatVGtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "-80", Gtid: "pos2"}}}
+	vtg := &fakeVTGate{events: [][]*binlogdatapb.VEvent{
+		// The heartbeats of vtgate itself have no position.
+		{{Type: binlogdatapb.VEventType_HEARTBEAT}},
+		{{Type: binlogdatapb.VEventType_HEARTBEAT, Keyspace: "ks", Shard: "-80", Vgtid: heartbeatVGtid}},
+	}}
+	checkpointer := &memoryCheckpointer{vgtid: testVGtid}
+	c, err := NewConnector(&Config{
+		Keyspace:          "ks",
+		Tables:            []string{"t1"},
+		TopicTemplate:     "{table}",
+		Partitioning:      PartitionByPK,
+		Format:            "json",
+		HeartbeatInterval: 10 * time.Second,
+	}, vtg, &fakePublisher{}, checkpointer)
+	require.NoError(t, err)
+
+	require.Error(t, c.stream(context.Background()))
+	utils.MustMatch(t, &vtgatepb.VStreamFlags{HeartbeatInterval: 10}, vtg.flags)
+	utils.MustMatch(t, heartbeatVGtid, checkpointer.vgtid)
+}
+
+func TestNewConnector(t *testing.T) {
+	_, err := NewConnector(&Config{Keyspace: "ks", Tables: []string{"t1"}, TopicTemplate: "{table}", Partitioning: "shard"}, nil, nil, nil)
+	assert.EqualError(t, err, `unknown partitioning "shard", expected keyspace_id or pk`)
+	_, err = NewConnector(&Config{Keyspace: "ks", Tables: []string{"t1"}, TopicTemplate: "{table}", Partitioning: PartitionByPK, Format: "xml"}, nil, nil, nil)
+	assert.EqualError(t, err, `unknown format "xml", expected one of [avro json jsonschema]`)
+	_, err = NewConnector(&Config{Keyspace: "ks", TopicTemplate: "{table}", Partitioning: PartitionByPK}, nil, nil, nil)
+	assert.EqualError(t, err, "a keyspace and tables to publish are required")
+}
+
+func TestFileCheckpointer(t *testing.T) {
+	ctx := context.Background()
+	fc := NewFileCheckpointer(path.Join(t.TempDir(), "checkpoint.json"))
+	vgtid, err := fc.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, vgtid)
+
+	require.NoError(t, fc.Save(ctx, testVGtid))
+	vgtid, err = fc.Load(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, testVGtid, vgtid)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vtreplay replays a recorded query trace against two clusters,
+// usually running different Vitess versions, and reports the differences of
+// their plans, results and latencies, to verify that an upgrade does not
+// change the behavior of the workload.
+package vtreplay
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Conn executes the queries on one of the clusters. It is usually a vtgate
+// session.
+type Conn interface {
+	Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
+}
+
+// Options control the replay.
+type Options struct {
+	// AllowWrites replays the statements which are not selects. They are
+	// skipped by default, since replaying them modifies both clusters.
+	AllowWrites bool
+	// ComparePlans compares the vtgate plans of the selects, as returned
+	// by explain format=vitess.
+	ComparePlans bool
+	// LatencyRegression is the ratio between the candidate and the
+	// baseline latencies of a query above which it is reported. 0 disables
+	// the latency comparison.
+	LatencyRegression float64
+	// MinLatency is the latency below which queries are not reported as
+	// regressions, since small latencies are mostly noise.
+	MinLatency time.Duration
+	// MaxDiffs is the number of differences kept in the report. 0 keeps
+	// all of them.
+	MaxDiffs int
+}
+
+// Kinds of differences.
+const (
+	DiffPlan    = "plan"
+	DiffResult  = "result"
+	DiffError   = "error"
+	DiffLatency = "latency"
+)
+
+// Diff is a difference between the two clusters for one query.
+type Diff struct {
+	Kind      string
+	SQL       string
+	Baseline  string
+	Candidate string
+}
+
+// LatencySummary summarizes the latencies of the queries on one cluster.
+type LatencySummary struct {
+	Total time.Duration
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// Report is the upgrade-readiness report of a replay.
+type Report struct {
+	Queries int
+	// Skipped counts the queries which were not replayed.
+	Skipped int
+
+	PlanDiffs    int
+	ResultDiffs  int
+	ErrorDiffs   int
+	LatencyDiffs int
+	Diffs        []Diff `json:",omitempty"`
+
+	Baseline  LatencySummary
+	Candidate LatencySummary
+
+	maxDiffs int
+}
+
+// Ready returns true if the candidate returned the same results and errors
+// as the baseline. Plan and latency differences are expected across
+// versions, so they are left to the judgement of the operator.
+func (r *Report) Ready() bool {
+	return r.ResultDiffs == 0 && r.ErrorDiffs == 0
+}
+
+func (r *Report) addDiff(d Diff) {
+	switch d.Kind {
+	case DiffPlan:
+		r.PlanDiffs++
+	case DiffResult:
+		r.ResultDiffs++
+	case DiffError:
+		r.ErrorDiffs++
+	case DiffLatency:
+		r.LatencyDiffs++
+	}
+	if r.maxDiffs == 0 || len(r.Diffs) < r.maxDiffs {
+		r.Diffs = append(r.Diffs, d)
+	}
+}
+
+// Replay runs the queries one after the other on both clusters, and compares
+// their outcomes. It only returns an error if the context is done.
+func Replay(ctx context.Context, queries []*Query, baseline, candidate Conn, opts Options) (*Report, error) {
+	report := &Report{maxDiffs: opts.MaxDiffs}
+	var baselineLatencies, candidateLatencies []time.Duration
+	for _, q := range queries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		isSelect := sqlparser.Preview(q.SQL) == sqlparser.StmtSelect
+		if !isSelect && !opts.AllowWrites {
+			report.Skipped++
+			continue
+		}
+		report.Queries++
+
+		if isSelect && opts.ComparePlans {
+			basePlan := explain(ctx, baseline, q)
+			candidatePlan := explain(ctx, candidate, q)
+			if basePlan != candidatePlan {
+				report.addDiff(Diff{Kind: DiffPlan, SQL: q.SQL, Baseline: basePlan, Candidate: candidatePlan})
+			}
+		}
+
+		baseOutcome, baseLatency := execute(ctx, baseline, q)
+		candidateOutcome, candidateLatency := execute(ctx, candidate, q)
+		baselineLatencies = append(baselineLatencies, baseLatency)
+		candidateLatencies = append(candidateLatencies, candidateLatency)
+		switch {
+		case baseOutcome.err != candidateOutcome.err:
+			report.addDiff(Diff{Kind: DiffError, SQL: q.SQL, Baseline: baseOutcome.String(), Candidate: candidateOutcome.String()})
+		case baseOutcome.checksum != candidateOutcome.checksum:
+			report.addDiff(Diff{Kind: DiffResult, SQL: q.SQL, Baseline: baseOutcome.String(), Candidate: candidateOutcome.String()})
+		}
+		if opts.LatencyRegression > 0 && candidateLatency >= opts.MinLatency &&
+			float64(candidateLatency) > opts.LatencyRegression*float64(baseLatency) {
+			report.addDiff(Diff{Kind: DiffLatency, SQL: q.SQL, Baseline: baseLatency.String(), Candidate: candidateLatency.String()})
+		}
+	}
+	report.Baseline = summarizeLatencies(baselineLatencies)
+	report.Candidate = summarizeLatencies(candidateLatencies)
+	return report, nil
+}
+
+// outcome is the comparable outcome of a query: either its error or the
+// checksum of its result.
+type outcome struct {
+	err      string
+	checksum string
+	rows     int
+}
+
+func (o outcome) String() string {
+	if o.err != "" {
+		return "error: " + o.err
+	}
+	return fmt.Sprintf("%d rows, checksum %s", o.rows, o.checksum)
+}
+
+func execute(ctx context.Context, conn Conn, q *Query) (outcome, time.Duration) {
+	start := time.Now()
+	qr, err := conn.Execute(ctx, q.SQL, q.BindVars)
+	latency := time.Since(start)
+	if err != nil {
+		return outcome{err: err.Error()}, latency
+	}
+	return outcome{checksum: checksum(qr), rows: len(qr.Rows)}, latency
+}
+
+// checksum hashes the columns and the rows of the result. The rows are
+// sorted, since the order of the rows of a query without an ORDER BY depends
+// on the plan.
+func checksum(qr *sqltypes.Result) string {
+	h := sha256.New()
+	for _, field := range qr.Fields {
+		fmt.Fprintf(h, "%s:%s,", field.Name, field.Type)
+	}
+	rows := make([]string, len(qr.Rows))
+	for i, row := range qr.Rows {
+		var buf strings.Builder
+		for _, v := range row {
+			if v.IsNull() {
+				buf.WriteString("NULL,")
+				continue
+			}
+			fmt.Fprintf(&buf, "%d:%s,", len(v.Raw()), v.Raw())
+		}
+		rows[i] = buf.String()
+	}
+	sort.Strings(rows)
+	fmt.Fprintf(h, "\n%d\n", qr.RowsAffected)
+	for _, row := range rows {
+		h.Write([]byte(row))
+		h.Write([]byte("\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// explain returns the vtgate plan of the query, one primitive per line.
+func explain(ctx context.Context, conn Conn, q *Query) string {
+	qr, err := conn.Execute(ctx, "explain format=vitess "+q.SQL, q.BindVars)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	lines := make([]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		values := make([]string, 0, len(row))
+		for _, v := range row {
+			values = append(values, v.ToString())
+		}
+		lines = append(lines, strings.Join(values, "\t"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func summarizeLatencies(latencies []time.Duration) LatencySummary {
+	if len(latencies) == 0 {
+		return LatencySummary{}
+	}
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(p int) time.Duration {
+		return sorted[(len(sorted)-1)*p/100]
+	}
+	var summary LatencySummary
+	for _, l := range sorted {
+		summary.Total += l
+	}
+	summary.P50 = percentile(50)
+	summary.P90 = percentile(90)
+	summary.P99 = percentile(99)
+	summary.Max = sorted[len(sorted)-1]
+	return summary
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtreplay
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestReadTrace(t *testing.T) {
+	trace := strings.Join([]string{
+		"-- plain statements",
+		"select 1 from dual;",
+		`{"Method": "Execute", "SQL": "select * from t where id = :id", "BindVars": {"id": {"type": "INT64", "value": 1}, "name": {"type": "VARCHAR", "value": "a\"b"}}, "RowsAffected": 0}`,
+		`{"Method": "Execute", "SQL": "select * from t where id = :id", "BindVars": "[REDACTED]"}`,
+		"Execute\t127.0.0.1:1\tuser\t'user'\t''\t2021-01-01 00:00:00.000000\t2021-01-01 00:00:00.000100\t0.000100\t0.000010\t0.000090\t0.000000\tSELECT\t\"select * from t\"\tmap[]\t1\t0\t\"\"\t\"ks\"\t\"t\"\t\"PRIMARY\"\t",
+		"Execute\t127.0.0.1:1\tuser\t'user'\t''\t2021-01-01 00:00:00.000000\t2021-01-01 00:00:00.000100\t0.000100\t0.000010\t0.000090\t0.000000\tSELECT\t\"select * from t where id = :id\"\tmap[id:type:INT64 value:\"1\"]\t1\t0\t\"\"\t\"ks\"\t\"t\"\t\"PRIMARY\"\t",
+		"",
+	}, "\n")
+	queries, skipped, err := ReadTrace(strings.NewReader(trace))
+	require.NoError(t, err)
+	assert.Equal(t, 2, skipped)
+	utils.MustMatch(t, []*Query{
+		{SQL: "select 1 from dual"},
+		{
+			SQL: "select * from t where id = :id",
+			BindVars: map[string]*querypb.BindVariable{
+				"id":   sqltypes.Int64BindVariable(1),
+				"name": {Type: querypb.Type_VARCHAR, Value: []byte(`a"b`)},
+			},
+		},
+		{SQL: "select * from t"},
+	}, queries)
+
+	_, _, err = ReadTrace(strings.NewReader("a\tb\n"))
+	assert.EqualError(t, err, "line 1: expected at least 14 fields in query log entry, got 2")
+}
+
+type fakeConn struct {
+	results map[string]*sqltypes.Result
+	delay   time.Duration
+}
+
+func (fc *fakeConn) Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	time.Sleep(fc.delay)
+	if qr, ok := fc.results[query]; ok {
+		return qr, nil
+	}
+	return nil, errors.New("unknown query")
+}
+
+func TestReplay(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|name", "int64|varchar")
+	baseline := &fakeConn{results: map[string]*sqltypes.Result{
+		"select id, name from t":                                    sqltypes.MakeTestResult(fields, "1|a", "2|b"),
+		"select id, name from t where id = 1":                       sqltypes.MakeTestResult(fields, "1|a"),
+		"select id, name from t where id = 2":                       sqltypes.MakeTestResult(fields, "2|b"),
+		"explain format=vitess select id, name from t":              sqltypes.MakeTestResult(sqltypes.MakeTestFields("operator", "varchar"), "Route"),
+		"explain format=vitess select id, name from t where id = 1": sqltypes.MakeTestResult(sqltypes.MakeTestFields("operator", "varchar"), "Route"),
+	}}
+	candidate := &fakeConn{results: map[string]*sqltypes.Result{
+		// Same rows in another order.
+		"select id, name from t":                                    sqltypes.MakeTestResult(fields, "2|b", "1|a"),
+		"select id, name from t where id = 1":                       sqltypes.MakeTestResult(fields, "1|c"),
+		"explain format=vitess select id, name from t":              sqltypes.MakeTestResult(sqltypes.MakeTestFields("operator", "varchar"), "Route"),
+		"explain format=vitess select id, name from t where id = 1": sqltypes.MakeTestResult(sqltypes.MakeTestFields("operator", "varchar"), "Scatter"),
+	}}
+	queries := []*Query{
+		{SQL: "select id, name from t"},
+		{SQL: "select id, name from t where id = 1"},
+		{SQL: "select id, name from t where id = 2"},
+		{SQL: "delete from t"},
+	}
+
+	report, err := Replay(context.Background(), queries, baseline, candidate, Options{ComparePlans: true})
+	require.NoError(t, err)
+	assert.False(t, report.Ready())
+	assert.Equal(t, 3, report.Queries)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 1, report.PlanDiffs)
+	assert.Equal(t, 1, report.ResultDiffs)
+	assert.Equal(t, 1, report.ErrorDiffs)
+	require.Len(t, report.Diffs, 3)
+	assert.Equal(t, Diff{Kind: DiffPlan, SQL: "select id, name from t where id = 1", Baseline: "Route", Candidate: "Scatter"}, report.Diffs[0])
+	assert.Equal(t, DiffResult, report.Diffs[1].Kind)
+	assert.Equal(t, Diff{Kind: DiffError, SQL: "select id, name from t where id = 2", Baseline: "1 rows, checksum " + checksum(baseline.results["select id, name from t where id = 2"]), Candidate: "error: unknown query"}, report.Diffs[2])
+
+	report, err = Replay(context.Background(), queries[:1], baseline, candidate, Options{})
+	require.NoError(t, err)
+	assert.True(t, report.Ready())
+	assert.Empty(t, report.Diffs)
+
+	candidate.delay = 5 * time.Millisecond
+	report, err = Replay(context.Background(), queries[:1], baseline, candidate, Options{LatencyRegression: 2, MinLatency: time.Millisecond, MaxDiffs: 1})
+	require.NoError(t, err)
+	assert.True(t, report.Ready())
+	assert.Equal(t, 1, report.LatencyDiffs)
+	assert.GreaterOrEqual(t, report.Candidate.Max, 5*time.Millisecond)
+}
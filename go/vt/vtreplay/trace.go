@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtreplay
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Query is a recorded query of the trace.
+type Query struct {
+	SQL      string
+	BindVars map[string]*querypb.BindVariable
+}
+
+// position of the SQL and of the bind variables in the text query log format
+// of vtgate.
+const (
+	textLogSQLField      = 12
+	textLogBindVarsField = 13
+)
+
+// maxTraceLine is the size of the longest query of a trace.
+const maxTraceLine = 16 * 1024 * 1024
+
+// ReadTrace reads the queries of a trace. Every line is either a vtgate query
+// log entry, in the json or text format, or a plain SQL statement. It also
+// returns the number of entries which cannot be replayed, because their bind
+// variables were not logged in a parsable form.
+//
+// The bind variables of the json entries are only usable if the log contains
+// their full values, and not their sizes.
+func ReadTrace(r io.Reader) ([]*Query, int, error) {
+	var queries []*Query
+	skipped := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxTraceLine)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "--") {
+			continue
+		}
+		q, err := parseTraceLine(line)
+		if err != nil {
+			return nil, 0, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+		if q == nil {
+			skipped++
+			continue
+		}
+		queries = append(queries, q)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+	return queries, skipped, nil
+}
+
+// parseTraceLine returns nil if the line cannot be replayed.
+func parseTraceLine(line string) (*Query, error) {
+	switch {
+	case strings.HasPrefix(line, "{"):
+		return parseJSONLogLine(line)
+	case strings.Contains(line, "\t"):
+		return parseTextLogLine(line)
+	}
+	return &Query{SQL: strings.TrimSuffix(line, ";")}, nil
+}
+
+type jsonLogEntry struct {
+	SQL string
+	// BindVars is a string if the bind variables were redacted.
+	BindVars json.RawMessage
+}
+
+type jsonLogBindVar struct {
+	Type  string
+	Value json.RawMessage
+}
+
+func parseJSONLogLine(line string) (*Query, error) {
+	var entry jsonLogEntry
+	if err := json.Unmarshal([]byte(line), &entry); err != nil {
+		return nil, err
+	}
+	if entry.SQL == "" || strings.HasPrefix(string(entry.BindVars), "\"") {
+		return nil, nil
+	}
+	var bindVars map[string]jsonLogBindVar
+	if len(entry.BindVars) > 0 {
+		if err := json.Unmarshal(entry.BindVars, &bindVars); err != nil {
+			return nil, err
+		}
+	}
+	q := &Query{SQL: entry.SQL}
+	if len(bindVars) > 0 {
+		q.BindVars = make(map[string]*querypb.BindVariable, len(bindVars))
+	}
+	for name, bv := range bindVars {
+		typ, ok := querypb.Type_value[bv.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown type %s for bind variable %s", bv.Type, name)
+		}
+		// Numbers are logged as json numbers, other values as strings.
+		value := string(bv.Value)
+		if strings.HasPrefix(value, "\"") {
+			if err := json.Unmarshal(bv.Value, &value); err != nil {
+				return nil, err
+			}
+		}
+		q.BindVars[name] = &querypb.BindVariable{Type: querypb.Type(typ), Value: []byte(value)}
+	}
+	return q, nil
+}
+
+func parseTextLogLine(line string) (*Query, error) {
+	fields := strings.Split(line, "\t")
+	if len(fields) <= textLogBindVarsField {
+		return nil, fmt.Errorf("expected at least %d fields in query log entry, got %d", textLogBindVarsField+1, len(fields))
+	}
+	sql, err := strconv.Unquote(fields[textLogSQLField])
+	if err != nil {
+		return nil, fmt.Errorf("cannot unquote SQL %s: %v", fields[textLogSQLField], err)
+	}
+	// The text format logs the bind variables as a go map.
+	if fields[textLogBindVarsField] != "map[]" {
+		return nil, nil
+	}
+	return &Query{SQL: sql}, nil
+}
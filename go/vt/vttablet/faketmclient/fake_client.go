@@ -256,6 +256,51 @@ func (client *FakeTabletManagerClient) VExec(ctx context.Context, tablet *topoda
 	return sqltypes.ResultToProto3(result), nil
 }
 
+// GetUnresolvedTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetUnresolvedTransactions(ctx context.Context, tablet *topodatapb.Tablet, abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error) {
+	return nil, nil, nil
+}
+
+// ResolveTransaction is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ResolveTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string, commit, conclude bool) error {
+	return nil
+}
+
+// SetRollbackTransaction is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetRollbackTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string) error {
+	return nil
+}
+
+// GetStorageUsage is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetStorageUsage(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetStorageUsageResponse, error) {
+	return &tabletmanagerdatapb.GetStorageUsageResponse{}, nil
+}
+
+// GetDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, limit int) (*querypb.QueryResult, error) {
+	return &querypb.QueryResult{}, nil
+}
+
+// RequeueDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RequeueDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error) {
+	return 0, nil
+}
+
+// PurgeDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) PurgeDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error) {
+	return 0, nil
+}
+
+// GetSequences is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetSequences(ctx context.Context, tablet *topodatapb.Tablet, tables []string) ([]*tabletmanagerdatapb.SequenceState, error) {
+	return nil, nil
+}
+
+// ResetSequence is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ResetSequence(ctx context.Context, tablet *topodatapb.Tablet, table string, nextID, cache int64) (*tabletmanagerdatapb.SequenceState, error) {
+	return &tabletmanagerdatapb.SequenceState{Name: table, NextId: nextID, Cache: cache}, nil
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	// This result satisfies 'select pos from _vt.vreplication...' called from split clone unit tests in go/vt/worker.
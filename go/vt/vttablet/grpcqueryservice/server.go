@@ -88,6 +88,22 @@ func (q *query) StreamExecute(request *querypb.StreamExecuteRequest, stream quer
 	return vterrors.ToGRPC(err)
 }
 
+// StreamExecuteBatch is part of the queryservice.QueryServer interface
+func (q *query) StreamExecuteBatch(request *querypb.StreamExecuteBatchRequest, stream queryservicepb.Query_StreamExecuteBatchServer) (err error) {
+	defer q.server.HandlePanic(&err)
+	ctx := callerid.NewContext(callinfo.GRPCCallInfo(stream.Context()),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	err = q.server.StreamExecuteBatch(ctx, request.Target, request.Queries, request.TransactionId, request.ReservedId, request.Options, func(queryIndex int, reply *sqltypes.Result) error {
+		return stream.Send(&querypb.StreamExecuteBatchResponse{
+			QueryIndex: int64(queryIndex),
+			Result:     sqltypes.ResultToProto3(reply),
+		})
+	})
+	return vterrors.ToGRPC(err)
+}
+
 // Begin is part of the queryservice.QueryServer interface
 func (q *query) Begin(ctx context.Context, request *querypb.BeginRequest) (response *querypb.BeginResponse, err error) {
 	defer q.server.HandlePanic(&err)
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctabletconn
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	compression = flag.String("tablet_grpc_compression", "", "compressor of the query service gRPC messages sent to the tablets, whose results are compressed the same way: snappy or zstd. A tablet which does not support the compressor is sent uncompressed messages. Default: nothing")
+
+	compressionFallbacks = stats.NewCountersWithSingleLabel("TabletGRPCCompressionFallbacks", "Number of tablet connections which fell back to uncompressed messages because the tablet does not support the compressor", "Compressor")
+)
+
+// compressionNegotiator compresses the messages of a tablet connection until
+// the tablet rejects a compressed message, in which case the rejected call
+// is retried uncompressed, and so are the next ones.
+type compressionNegotiator struct {
+	compressor string
+	tablet     string
+	disabled   sync2.AtomicBool
+}
+
+// compressionDialOptions returns the dial options compressing the messages
+// of the connection to the tablet.
+func compressionDialOptions(tablet string) ([]grpc.DialOption, error) {
+	if *compression == "" {
+		return nil, nil
+	}
+	if encoding.GetCompressor(*compression) == nil {
+		return nil, fmt.Errorf("unsupported tablet_grpc_compression %q", *compression)
+	}
+	cn := &compressionNegotiator{compressor: *compression, tablet: tablet}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(cn.unary),
+		grpc.WithChainStreamInterceptor(cn.stream),
+	}, nil
+}
+
+// isCompressorRejection returns true if the error is the one the server
+// returns for a message compressed with a compressor it does not support.
+func isCompressorRejection(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.Unimplemented && strings.Contains(st.Message(), "Decompressor is not installed")
+}
+
+func (cn *compressionNegotiator) disable() {
+	if cn.disabled.CompareAndSwap(false, true) {
+		compressionFallbacks.Add(cn.compressor, 1)
+		log.Warningf("tablet %v does not support the %v gRPC compressor, its messages are not compressed", cn.tablet, cn.compressor)
+	}
+}
+
+func (cn *compressionNegotiator) compressed(opts []grpc.CallOption) []grpc.CallOption {
+	return append(append([]grpc.CallOption{}, opts...), grpc.UseCompressor(cn.compressor))
+}
+
+func (cn *compressionNegotiator) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if cn.disabled.Get() {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	err := invoker(ctx, method, req, reply, cc, cn.compressed(opts)...)
+	if !isCompressorRejection(err) {
+		return err
+	}
+	cn.disable()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (cn *compressionNegotiator) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if cn.disabled.Get() {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	s, err := streamer(ctx, desc, cc, method, cn.compressed(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return &negotiatingStream{
+		ClientStream: s,
+		negotiator:   cn,
+		newStream: func() (grpc.ClientStream, error) {
+			return streamer(ctx, desc, cc, method, opts...)
+		},
+	}, nil
+}
+
+// negotiatingStream is a stream of compressed messages, which is replayed
+// uncompressed if the tablet rejects it before sending any message back.
+// The messages sent are kept until then.
+type negotiatingStream struct {
+	grpc.ClientStream
+	negotiator *compressionNegotiator
+	newStream  func() (grpc.ClientStream, error)
+	sent       []interface{}
+	closedSend bool
+	// settled is set once the stream can't be replayed anymore.
+	settled bool
+}
+
+func (ns *negotiatingStream) SendMsg(m interface{}) error {
+	if !ns.settled {
+		ns.sent = append(ns.sent, m)
+	}
+	return ns.ClientStream.SendMsg(m)
+}
+
+func (ns *negotiatingStream) CloseSend() error {
+	ns.closedSend = true
+	return ns.ClientStream.CloseSend()
+}
+
+func (ns *negotiatingStream) RecvMsg(m interface{}) error {
+	err := ns.ClientStream.RecvMsg(m)
+	if ns.settled {
+		return err
+	}
+	if !isCompressorRejection(err) {
+		ns.settled, ns.sent = true, nil
+		return err
+	}
+	ns.settled = true
+	ns.negotiator.disable()
+	if err := ns.replay(); err != nil {
+		return err
+	}
+	return ns.ClientStream.RecvMsg(m)
+}
+
+func (ns *negotiatingStream) replay() error {
+	s, err := ns.newStream()
+	if err != nil {
+		return err
+	}
+	ns.ClientStream = s
+	sent := ns.sent
+	ns.sent = nil
+	for _, m := range sent {
+		if err := s.SendMsg(m); err != nil {
+			return err
+		}
+	}
+	if ns.closedSend {
+		return s.CloseSend()
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctabletconn
+
+import (
+	"context"
+	"expvar"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vttablet/grpcqueryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconntest"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This test makes sure the go rpc service works with compressed messages.
+func TestGRPCTabletConnCompression(t *testing.T) {
+	defer func(c string) {
+		*compression = c
+	}(*compression)
+	*compression = "zstd"
+	compressionBytes := expvar.Get("GRPCCompressionBytes").(*stats.CountersWithMultiLabels)
+	compressionBytes.ResetAll()
+
+	service := tabletconntest.CreateFakeServer(t)
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	host := listener.Addr().(*net.TCPAddr).IP.String()
+	port := listener.Addr().(*net.TCPAddr).Port
+	server := grpc.NewServer()
+	grpcqueryservice.Register(server, service)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	tabletconntest.TestSuite(t, protocolName, &topodatapb.Tablet{
+		Keyspace: tabletconntest.TestTarget.Keyspace,
+		Shard:    tabletconntest.TestTarget.Shard,
+		Type:     tabletconntest.TestTarget.TabletType,
+		Alias:    tabletconntest.TestAlias,
+		Hostname: host,
+		PortMap: map[string]int32{
+			"grpc": int32(port),
+		},
+	}, service, nil)
+	// The client and the server share the stats of the process.
+	assert.NotZero(t, compressionBytes.Counts()["zstd.compress.raw"])
+	assert.NotZero(t, compressionBytes.Counts()["zstd.decompress.raw"])
+}
+
+var errRejected = status.Error(codes.Unimplemented, `grpc: Decompressor is not installed for grpc-encoding "zstd"`)
+
+func isCompressed(opts []grpc.CallOption) bool {
+	for _, opt := range opts {
+		if c, ok := opt.(grpc.CompressorCallOption); ok && c.CompressorType == "zstd" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCompressionNegotiatorUnary(t *testing.T) {
+	compressionFallbacks.ResetAll()
+	var calls []bool
+	invoker := func(rejects bool) grpc.UnaryInvoker {
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			calls = append(calls, isCompressed(opts))
+			if rejects && isCompressed(opts) {
+				return errRejected
+			}
+			return nil
+		}
+	}
+
+	// The tablet supports the compressor.
+	cn := &compressionNegotiator{compressor: "zstd", tablet: "cell-1"}
+	require.NoError(t, cn.unary(context.Background(), "Execute", nil, nil, nil, invoker(false)))
+	assert.Equal(t, []bool{true}, calls)
+
+	// The tablet rejects the compressed call, which is retried uncompressed,
+	// as are the next calls.
+	calls = nil
+	cn = &compressionNegotiator{compressor: "zstd", tablet: "cell-1"}
+	require.NoError(t, cn.unary(context.Background(), "Execute", nil, nil, nil, invoker(true)))
+	require.NoError(t, cn.unary(context.Background(), "Execute", nil, nil, nil, invoker(true)))
+	assert.Equal(t, []bool{true, false, false}, calls)
+	assert.EqualValues(t, 1, compressionFallbacks.Counts()["zstd"])
+}
+
+type fakeClientStream struct {
+	grpc.ClientStream
+	compressed bool
+	sent       []interface{}
+	closedSend bool
+}
+
+func (s *fakeClientStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func (s *fakeClientStream) CloseSend() error {
+	s.closedSend = true
+	return nil
+}
+
+func (s *fakeClientStream) RecvMsg(m interface{}) error {
+	if s.compressed {
+		return errRejected
+	}
+	return nil
+}
+
+func TestCompressionNegotiatorStream(t *testing.T) {
+	var streams []*fakeClientStream
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		s := &fakeClientStream{compressed: isCompressed(opts)}
+		streams = append(streams, s)
+		return s, nil
+	}
+	cn := &compressionNegotiator{compressor: "zstd", tablet: "cell-1"}
+	s, err := cn.stream(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, "StreamExecute", streamer)
+	require.NoError(t, err)
+	require.NoError(t, s.SendMsg("request"))
+	require.NoError(t, s.CloseSend())
+
+	// The rejected stream is replayed uncompressed.
+	require.NoError(t, s.RecvMsg(nil))
+	require.Len(t, streams, 2)
+	assert.True(t, streams[0].compressed)
+	assert.False(t, streams[1].compressed)
+	assert.Equal(t, []interface{}{"request"}, streams[1].sent)
+	assert.True(t, streams[1].closedSend)
+
+	// The next streams are not compressed.
+	_, err = cn.stream(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, "StreamExecute", streamer)
+	require.NoError(t, err)
+	require.Len(t, streams, 3)
+	assert.False(t, streams[2].compressed)
+}
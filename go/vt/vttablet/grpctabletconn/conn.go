@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 
@@ -78,7 +79,11 @@ func DialTablet(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (querys
 	if err != nil {
 		return nil, err
 	}
-	cc, err := grpcclient.Dial(addr, failFast, opt)
+	compressionOpts, err := compressionDialOptions(topoproto.TabletAliasString(tablet.Alias))
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpcclient.Dial(addr, failFast, append([]grpc.DialOption{opt}, compressionOpts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +208,58 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 	}
 }
 
+// StreamExecuteBatch executes a list of queries and streams their results.
+func (conn *gRPCQueryClient) StreamExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, transactionID int64, reservedID int64, options *querypb.ExecuteOptions, callback func(int, *sqltypes.Result) error) error {
+	// See StreamExecute for the code pattern.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := func() (queryservicepb.Query_StreamExecuteBatchClient, error) {
+		conn.mu.RLock()
+		defer conn.mu.RUnlock()
+		if conn.cc == nil {
+			return nil, tabletconn.ConnClosed
+		}
+
+		req := &querypb.StreamExecuteBatchRequest{
+			Target:            target,
+			EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+			Queries:           queries,
+			Options:           options,
+			TransactionId:     transactionID,
+			ReservedId:        reservedID,
+		}
+		stream, err := conn.c.StreamExecuteBatch(ctx, req)
+		if err != nil {
+			return nil, tabletconn.ErrorFromGRPC(err)
+		}
+		return stream, nil
+	}()
+	if err != nil {
+		return err
+	}
+	// The fields are only sent in the first result of every query.
+	var fields []*querypb.Field
+	queryIndex := int64(-1)
+	for {
+		ser, err := stream.Recv()
+		if err != nil {
+			return tabletconn.ErrorFromGRPC(err)
+		}
+		if ser.QueryIndex != queryIndex {
+			queryIndex = ser.QueryIndex
+			fields = ser.Result.Fields
+		}
+		if err := callback(int(queryIndex), sqltypes.CustomProto3ToResult(fields, ser.Result)); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // Begin starts a transaction.
 func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
@@ -77,6 +77,10 @@ func (b *BenchmarkService) StreamExecute(ctx context.Context, target *querypb.Ta
 	panic("should not be called")
 }
 
+func (b *BenchmarkService) StreamExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, transactionID int64, reservedID int64, options *querypb.ExecuteOptions, callback func(int, *sqltypes.Result) error) error {
+	panic("should not be called")
+}
+
 func (b *BenchmarkService) ExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) ([]sqltypes.Result, error) {
 	return b.batchResult, nil
 }
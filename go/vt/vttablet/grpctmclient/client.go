@@ -688,6 +688,122 @@ func (client *Client) VExec(ctx context.Context, tablet *topodatapb.Tablet, quer
 	return response.Result, nil
 }
 
+// GetUnresolvedTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetUnresolvedTransactions(ctx context.Context, tablet *topodatapb.Tablet, abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closer.Close()
+	response, err := c.GetUnresolvedTransactions(ctx, &tabletmanagerdatapb.GetUnresolvedTransactionsRequest{AbandonAgeSeconds: int64(abandonAge.Seconds())})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Transactions, response.Prepared, nil
+}
+
+// ResolveTransaction is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ResolveTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string, commit, conclude bool) error {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+	_, err = c.ResolveTransaction(ctx, &tabletmanagerdatapb.ResolveTransactionRequest{Dtid: dtid, Commit: commit, Conclude: conclude})
+	return err
+}
+
+// SetRollbackTransaction is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetRollbackTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string) error {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+	_, err = c.SetRollbackTransaction(ctx, &tabletmanagerdatapb.SetRollbackTransactionRequest{Dtid: dtid})
+	return err
+}
+
+// GetStorageUsage is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetStorageUsage(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetStorageUsageResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return c.GetStorageUsage(ctx, &tabletmanagerdatapb.GetStorageUsageRequest{})
+}
+
+// GetDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, limit int) (*querypb.QueryResult, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.GetDeadLetters(ctx, &tabletmanagerdatapb.GetDeadLettersRequest{Table: table, Limit: int64(limit)})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// RequeueDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RequeueDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+	response, err := c.RequeueDeadLetters(ctx, &tabletmanagerdatapb.RequeueDeadLettersRequest{Table: table, Ids: ids})
+	if err != nil {
+		return 0, err
+	}
+	return response.Count, nil
+}
+
+// PurgeDeadLetters is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PurgeDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+	response, err := c.PurgeDeadLetters(ctx, &tabletmanagerdatapb.PurgeDeadLettersRequest{Table: table, Ids: ids})
+	if err != nil {
+		return 0, err
+	}
+	return response.Count, nil
+}
+
+// GetSequences is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetSequences(ctx context.Context, tablet *topodatapb.Tablet, tables []string) ([]*tabletmanagerdatapb.SequenceState, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.GetSequences(ctx, &tabletmanagerdatapb.GetSequencesRequest{Tables: tables})
+	if err != nil {
+		return nil, err
+	}
+	return response.Sequences, nil
+}
+
+// ResetSequence is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ResetSequence(ctx context.Context, tablet *topodatapb.Tablet, table string, nextID, cache int64) (*tabletmanagerdatapb.SequenceState, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.ResetSequence(ctx, &tabletmanagerdatapb.ResetSequenceRequest{Table: table, NextId: nextID, Cache: cache})
+	if err != nil {
+		return nil, err
+	}
+	return response.Sequence, nil
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *Client) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -361,6 +361,74 @@ func (s *server) VExec(ctx context.Context, request *tabletmanagerdatapb.VExecRe
 	return response, err
 }
 
+func (s *server) GetUnresolvedTransactions(ctx context.Context, request *tabletmanagerdatapb.GetUnresolvedTransactionsRequest) (response *tabletmanagerdatapb.GetUnresolvedTransactionsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetUnresolvedTransactions", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetUnresolvedTransactionsResponse{}
+	response.Transactions, response.Prepared, err = s.tm.GetUnresolvedTransactions(ctx, time.Duration(request.AbandonAgeSeconds)*time.Second)
+	return response, err
+}
+
+func (s *server) ResolveTransaction(ctx context.Context, request *tabletmanagerdatapb.ResolveTransactionRequest) (response *tabletmanagerdatapb.ResolveTransactionResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ResolveTransaction", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ResolveTransactionResponse{}
+	return response, s.tm.ResolveTransaction(ctx, request.Dtid, request.Commit, request.Conclude)
+}
+
+func (s *server) SetRollbackTransaction(ctx context.Context, request *tabletmanagerdatapb.SetRollbackTransactionRequest) (response *tabletmanagerdatapb.SetRollbackTransactionResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "SetRollbackTransaction", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetRollbackTransactionResponse{}
+	return response, s.tm.SetRollbackTransaction(ctx, request.Dtid)
+}
+
+func (s *server) GetStorageUsage(ctx context.Context, request *tabletmanagerdatapb.GetStorageUsageRequest) (response *tabletmanagerdatapb.GetStorageUsageResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetStorageUsage", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.GetStorageUsage(ctx)
+}
+
+func (s *server) GetDeadLetters(ctx context.Context, request *tabletmanagerdatapb.GetDeadLettersRequest) (response *tabletmanagerdatapb.GetDeadLettersResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetDeadLetters", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetDeadLettersResponse{}
+	response.Result, err = s.tm.GetDeadLetters(ctx, request.Table, int(request.Limit))
+	return response, err
+}
+
+func (s *server) RequeueDeadLetters(ctx context.Context, request *tabletmanagerdatapb.RequeueDeadLettersRequest) (response *tabletmanagerdatapb.RequeueDeadLettersResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "RequeueDeadLetters", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.RequeueDeadLettersResponse{}
+	response.Count, err = s.tm.RequeueDeadLetters(ctx, request.Table, request.Ids)
+	return response, err
+}
+
+func (s *server) PurgeDeadLetters(ctx context.Context, request *tabletmanagerdatapb.PurgeDeadLettersRequest) (response *tabletmanagerdatapb.PurgeDeadLettersResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "PurgeDeadLetters", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.PurgeDeadLettersResponse{}
+	response.Count, err = s.tm.PurgeDeadLetters(ctx, request.Table, request.Ids)
+	return response, err
+}
+
+func (s *server) GetSequences(ctx context.Context, request *tabletmanagerdatapb.GetSequencesRequest) (response *tabletmanagerdatapb.GetSequencesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetSequences", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetSequencesResponse{}
+	response.Sequences, err = s.tm.GetSequences(ctx, request.Tables)
+	return response, err
+}
+
+func (s *server) ResetSequence(ctx context.Context, request *tabletmanagerdatapb.ResetSequenceRequest) (response *tabletmanagerdatapb.ResetSequenceResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ResetSequence", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ResetSequenceResponse{}
+	response.Sequence, err = s.tm.ResetSequence(ctx, request.Table, request.NextId, request.Cache)
+	return response, err
+}
+
 func (s *server) VReplicationExec(ctx context.Context, request *tabletmanagerdatapb.VReplicationExecRequest) (response *tabletmanagerdatapb.VReplicationExecResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "VReplicationExec", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -109,6 +109,9 @@ var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Mi
 var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long should vttablet keep an old migrated table before purging it")
 var migrationNextCheckIntervals = []time.Duration{1 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second}
 
+// schedulerConfigRefreshInterval is how long the online DDL scheduler config of the keyspace is cached
+var schedulerConfigRefreshInterval = 1 * time.Minute
+
 const (
 	maxPasswordLength                        = 32 // MySQL's *replication* password may not exceed 32 characters
 	staleMigrationMinutes                    = 10
@@ -122,6 +125,29 @@ const (
 	vreplicationTestSuiteWaitSeconds         = 5
 )
 
+// The stages of a running vreplication migration, as reported in the stage column of _vt.schema_migrations.
+const (
+	stageCopyingRows    = "copying rows"
+	stageApplyingBinlog = "applying binlog events"
+	stageWaitingCutOver = "waiting for cut-over"
+	stageCuttingOver    = "cutting over"
+)
+
+// vreplMigrationStage returns the stage of a running vreplication migration, whose stream is in the given
+// state. readyToCutOver is whether the migration is done copying and caught up with the binlogs, and
+// cutOver is whether it cuts over now, as it is not postponed or waiting for a cut-over window.
+func vreplMigrationStage(state string, readyToCutOver, cutOver bool) string {
+	switch {
+	case cutOver:
+		return stageCuttingOver
+	case readyToCutOver:
+		return stageWaitingCutOver
+	case state == binlogplayer.VReplicationInit || state == binlogplayer.VReplicationCopying:
+		return stageCopyingRows
+	}
+	return stageApplyingBinlog
+}
+
 var (
 	migrationLogFileName     = "migration.log"
 	migrationFailureFileName = "migration-failure.log"
@@ -163,6 +189,12 @@ type Executor struct {
 	ticks             *timer.Timer
 	isOpen            bool
 	schemaInitialized bool
+
+	// maxConcurrent caches the max concurrent migrations of the online DDL scheduler config of the
+	// keyspace, which is read from the topo at most once per schedulerConfigRefreshInterval.
+	schedulerConfigMutex    sync.Mutex
+	maxConcurrent           int
+	schedulerConfigReadTime time.Time
 }
 
 type cancellableMigration struct {
@@ -341,6 +373,14 @@ func (e *Executor) deleteGhostPostponeFlagFile(uuid string) error {
 	return os.RemoveAll(e.ghostPostponeFlagFileName(uuid))
 }
 
+func (e *Executor) createGhostPostponeFlagFile(uuid string) error {
+	f, err := os.OpenFile(e.ghostPostponeFlagFileName(uuid), os.O_RDONLY|os.O_CREATE, 0666)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
 func (e *Executor) ptPidFileName(uuid string) string {
 	return path.Join(os.TempDir(), fmt.Sprintf("pt-online-schema-change.%s.pid", uuid))
 }
@@ -851,13 +891,13 @@ func (e *Executor) initVreplicationRevertMigration(ctx context.Context, onlineDD
 
 // ExecuteWithVReplication sets up the grounds for a vreplication schema migration
 func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schema.OnlineDDL, revertMigration *schema.OnlineDDL) error {
+	if err := e.validateConcurrentMigration(ctx, onlineDDL, sqlparser.AlterStr); err != nil {
+		return err
+	}
+
 	// make sure there's no vreplication workflow running under same name
 	_ = e.terminateVReplMigration(ctx, onlineDDL.UUID)
 
-	if e.isAnyMigrationRunning() {
-		return ErrExecutorMigrationAlreadyRunning
-	}
-
 	if e.tabletTypeFunc() != topodatapb.TabletType_PRIMARY {
 		return ErrExecutorNotWritableTablet
 	}
@@ -884,6 +924,14 @@ func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schem
 	if err != nil {
 		return err
 	}
+	v.throttlerApp = onlineDDL.StrategySetting().ThrottlerApp()
+	if v.throttlerApp == "" {
+		// The stream checks the throttler as an online DDL workflow, like gh-ost and pt-osc migrations do
+		v.throttlerApp = "online-ddl:" + onlineDDL.UUID
+	}
+	if v.throttleRatio, err = onlineDDL.StrategySetting().ThrottleRatio(); err != nil {
+		return err
+	}
 	if err := v.analyze(ctx, conn); err != nil {
 		return err
 	}
@@ -1093,7 +1141,10 @@ exit $exit_code
 		if onlineDDL.StrategySetting().IsAllowZeroInDateFlag() {
 			args = append(args, "--allow-zero-in-date")
 		}
-		if execute && onlineDDL.StrategySetting().IsPostponeCompletion() {
+		// The cut-over of a migration with cut-over windows is postponed outside of its windows, by
+		// reviewRunningMigrations.
+		cutOverWindows, _ := onlineDDL.StrategySetting().CutOverWindows()
+		if execute && (onlineDDL.StrategySetting().IsPostponeCompletion() || len(cutOverWindows) > 0) {
 			args = append(args, "--postpone-cut-over-flag-file", e.ghostPostponeFlagFileName(onlineDDL.UUID))
 		}
 
@@ -1549,12 +1600,13 @@ func (e *Executor) CancelPendingMigrations(ctx context.Context, message string)
 
 // scheduleNextMigration attemps to schedule a single migration to run next.
 // possibly there's no migrations to run. Possibly there's a migration running right now,
-// in which cases nothing happens.
+// in which cases nothing happens, unless the keyspace allows concurrent migrations.
+// The next migration is picked by nextQueuedMigration, which fails the migrations whose dependencies failed.
 func (e *Executor) scheduleNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if e.isAnyMigrationRunning() {
+	if e.isAnyMigrationRunning() && e.maxConcurrentMigrations(ctx) <= 1 {
 		return ErrExecutorMigrationAlreadyRunning
 	}
 
@@ -1576,11 +1628,119 @@ func (e *Executor) scheduleNextMigration(ctx context.Context) error {
 		}
 	} // Cool, seems like no migration is ready. Let's try and make a single 'queued' migration 'ready'
 
-	_, err := e.execQuery(ctx, sqlScheduleSingleMigration)
+	r, err := e.execQuery(ctx, sqlSelectQueuedMigrations)
+	if err != nil {
+		return err
+	}
+	var queued []*queuedMigration
+	statuses := map[string]schema.OnlineDDLStatus{}
+	for _, row := range r.Named().Rows {
+		m := newQueuedMigration(row)
+		queued = append(queued, m)
+		for _, uuid := range m.dependsOn {
+			if _, ok := statuses[uuid]; ok {
+				continue
+			}
+			dependency, _, err := e.readMigration(ctx, uuid)
+			if err == ErrMigrationNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			statuses[uuid] = dependency.Status
+		}
+	}
+	next, failed := nextQueuedMigration(queued, time.Now(), statuses)
+	for uuid, message := range failed {
+		onlineDDL, _, err := e.readMigration(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		_ = e.failMigration(ctx, onlineDDL, errors.New(message))
+	}
+	if next == nil {
+		return nil
+	}
 
+	query, err := sqlparser.ParseAndBind(sqlScheduleMigration,
+		sqltypes.StringBindVariable(next.uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
 	return err
 }
 
+// maxConcurrentMigrations returns the number of migrations which may run at the same time, as configured
+// in the online DDL scheduler config of the keyspace. The config is cached for schedulerConfigRefreshInterval.
+// It is 1 when the config has never been read successfully.
+func (e *Executor) maxConcurrentMigrations(ctx context.Context) int {
+	if e.ts == nil {
+		return 1
+	}
+	e.schedulerConfigMutex.Lock()
+	defer e.schedulerConfigMutex.Unlock()
+	if time.Since(e.schedulerConfigReadTime) < schedulerConfigRefreshInterval {
+		return e.maxConcurrent
+	}
+	// Errors are not retried before the next refresh either, so that a topo outage is not hammered on every tick.
+	e.schedulerConfigReadTime = time.Now()
+	if e.maxConcurrent < 1 {
+		e.maxConcurrent = 1
+	}
+	config, err := e.ts.GetOnlineDDLSchedulerConfig(ctx, e.keyspace)
+	if err != nil {
+		log.Errorf("Executor.maxConcurrentMigrations(): cannot read online ddl scheduler config of keyspace %s: %v", e.keyspace, err)
+		return e.maxConcurrent
+	}
+	e.maxConcurrent = 1
+	if config.MaxConcurrentMigrations > 1 {
+		e.maxConcurrent = int(config.MaxConcurrentMigrations)
+	}
+	return e.maxConcurrent
+}
+
+// readRunningMigrations returns the migrations which run right now
+func (e *Executor) readRunningMigrations(ctx context.Context) (running []*runningMigration, err error) {
+	r, err := e.execQuery(ctx, sqlSelectRunningMigrations)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range r.Named().Rows {
+		running = append(running, &runningMigration{
+			uuid:     row["migration_uuid"].ToString(),
+			table:    row["mysql_table"].ToString(),
+			strategy: schema.DDLStrategy(row["strategy"].ToString()),
+		})
+	}
+	return running, nil
+}
+
+// validateConcurrentMigration checks that the given migration may start alongside the migrations which run
+// right now, and returns ErrExecutorMigrationAlreadyRunning if it may not.
+func (e *Executor) validateConcurrentMigration(ctx context.Context, onlineDDL *schema.OnlineDDL, ddlAction string) error {
+	if !e.isAnyMigrationRunning() {
+		return nil
+	}
+	if _, ok := e.ownedRunningMigrations.Load(onlineDDL.UUID); ok {
+		return ErrExecutorMigrationAlreadyRunning
+	}
+	running, err := e.readRunningMigrations(ctx)
+	if err != nil {
+		return err
+	}
+	if len(running) == 0 {
+		// The owned migrations have yet to be marked as running
+		return ErrExecutorMigrationAlreadyRunning
+	}
+	if !canRunConcurrently(ddlAction, onlineDDL.Strategy, onlineDDL.Table, running, e.maxConcurrentMigrations(ctx)) {
+		return ErrExecutorMigrationAlreadyRunning
+	}
+	return nil
+}
+
 // reviewQueuedMigrations iterates queued migrations and sees if any information needs to be updated
 func (e *Executor) reviewQueuedMigrations(ctx context.Context) error {
 	e.migrationMutex.Lock()
@@ -1648,7 +1808,7 @@ func (e *Executor) reviewQueuedMigrations(ctx context.Context) error {
 	return nil
 }
 
-func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigration *schema.OnlineDDL) (err error) {
+func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigration *schema.OnlineDDL, row sqltypes.RowNamedValues) (err error) {
 	// Validation: migration to revert exists and is in complete state
 	action, actionStr, err := revertMigration.GetActionStr()
 	if err != nil {
@@ -1656,8 +1816,12 @@ func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigrat
 	}
 	switch action {
 	case sqlparser.AlterDDLAction:
-		if revertMigration.Strategy != schema.DDLStrategyOnline {
-			return fmt.Errorf("can only revert a %s strategy migration. Migration %s has %s strategy", schema.DDLStrategyOnline, revertMigration.UUID, revertMigration.Strategy)
+		switch {
+		case revertMigration.Strategy == schema.DDLStrategyOnline:
+		case row["revert_statement"].ToString() != "":
+			// A -revertible gh-ost or pt-osc migration, which recorded how to revert it
+		default:
+			return fmt.Errorf("can only revert a %s strategy migration, or a -revertible migration. Migration %s has %s strategy", schema.DDLStrategyOnline, revertMigration.UUID, revertMigration.Strategy)
 		}
 	case sqlparser.RevertDDLAction:
 	case sqlparser.CreateDDLAction:
@@ -1668,6 +1832,30 @@ func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigrat
 	if revertMigration.Status != schema.OnlineDDLStatusComplete {
 		return fmt.Errorf("can only revert a migration in a '%s' state. Migration %s is in '%s' state", schema.OnlineDDLStatusComplete, revertMigration.UUID, revertMigration.Status)
 	}
+	{
+		// Validation: the artifacts of the migration, which the revert needs, are still retained
+		query, err := sqlparser.ParseAndBind(sqlSelectRevertibleMigration,
+			sqltypes.StringBindVariable(revertMigration.UUID),
+			sqltypes.Int64BindVariable(int64((*retainOnlineDDLTables).Seconds())),
+		)
+		if err != nil {
+			return err
+		}
+		r, err := e.execQuery(ctx, query)
+		if err != nil {
+			return err
+		}
+		if len(r.Rows) == 0 {
+			retainArtifacts := time.Duration(row.AsInt64("retain_artifacts_seconds", 0)) * time.Second
+			switch {
+			case retainArtifacts < 0:
+				return fmt.Errorf("can no longer revert migration %s: its artifacts were cleaned up", revertMigration.UUID)
+			case retainArtifacts == 0:
+				retainArtifacts = *retainOnlineDDLTables
+			}
+			return fmt.Errorf("can no longer revert migration %s: a migration is revertible for %v after it completes, and it completed at %s. Use -retain-artifacts to extend it", revertMigration.UUID, retainArtifacts, row.AsString("completed_timestamp", ""))
+		}
+	}
 	{
 		// Validation: see if there's a pending migration on this table:
 		r, err := e.execQuery(ctx, sqlSelectPendingMigrations)
@@ -1724,7 +1912,7 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 	if err != nil {
 		return err
 	}
-	if err := e.validateMigrationRevertible(ctx, revertMigration); err != nil {
+	if err := e.validateMigrationRevertible(ctx, revertMigration, row); err != nil {
 		return err
 	}
 	revertedActionStr := row["ddl_action"].ToString()
@@ -1812,6 +2000,11 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 		}
 	case sqlparser.AlterStr:
 		{
+			if revertStatement := row["revert_statement"].ToString(); revertStatement != "" {
+				// We are reverting a -revertible gh-ost or pt-osc migration, by running the ALTER it recorded
+				// with the same tool.
+				return e.executeRevertStatement(ctx, onlineDDL, revertMigration, revertStatement)
+			}
 			if err := e.ExecuteWithVReplication(ctx, onlineDDL, revertMigration); err != nil {
 				return err
 			}
@@ -1823,6 +2016,94 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 	return nil
 }
 
+// readCreateTable reads and parses the CREATE TABLE statement of the given table
+func (e *Executor) readCreateTable(ctx context.Context, tableName string) (*sqlparser.CreateTable, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateTable, tableName)
+	rs, err := e.execQuery(ctx, parsed.Query)
+	if err != nil {
+		return nil, err
+	}
+	row := rs.Named().Row()
+	if row == nil {
+		return nil, fmt.Errorf("table %s not found", tableName)
+	}
+	stmt, err := sqlparser.Parse(row.AsString("Create Table", ""))
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := stmt.(*sqlparser.CreateTable)
+	if !ok {
+		return nil, fmt.Errorf("expected a CREATE TABLE statement for table %s, found: %s", tableName, sqlparser.String(stmt))
+	}
+	return createTable, nil
+}
+
+// prepareRevertibleAlter is called for gh-ost and pt-osc ALTER migrations before they run. If the migration is
+// -revertible, it rewrites its ALTER so that the columns it drops are retained as shadow columns, and records
+// the ALTER which reverts it.
+func (e *Executor) prepareRevertibleAlter(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	if !onlineDDL.StrategySetting().IsRevertible() {
+		return nil
+	}
+	stmt, err := sqlparser.Parse(onlineDDL.SQL)
+	if err != nil {
+		return err
+	}
+	alterTable, ok := stmt.(*sqlparser.AlterTable)
+	if !ok {
+		return fmt.Errorf("-revertible only applies to ALTER TABLE migrations, found: %s", onlineDDL.SQL)
+	}
+	createTable, err := e.readCreateTable(ctx, onlineDDL.Table)
+	if err != nil {
+		return err
+	}
+	alter, revert, err := revertibleAlter(onlineDDL.UUID, createTable, alterTable)
+	if err != nil {
+		return err
+	}
+	if err := e.updateMigrationRevertStatement(ctx, onlineDDL.UUID, sqlparser.String(revert)); err != nil {
+		return err
+	}
+	onlineDDL.SQL = sqlparser.String(alter)
+	return nil
+}
+
+// validateRevertibleMySQLVersion checks that the server supports invisible columns, which -revertible migrations
+// rename the columns they drop to. They were introduced in MySQL 8.0.23.
+func (e *Executor) validateRevertibleMySQLVersion(ctx context.Context) error {
+	variables, err := e.readMySQLVariables(ctx)
+	if err != nil {
+		return err
+	}
+	flavor := tengo.ParseFlavor(variables.version, variables.versionComment)
+	if !flavor.MySQLishMinVersion(8, 0, 23) {
+		return fmt.Errorf("-revertible requires MySQL 8.0.23 or later, found: %s", variables.version)
+	}
+	return nil
+}
+
+// executeRevertStatement reverts a -revertible gh-ost or pt-osc migration by running the ALTER it recorded,
+// with the same tool.
+func (e *Executor) executeRevertStatement(ctx context.Context, onlineDDL *schema.OnlineDDL, revertMigration *schema.OnlineDDL, revertStatement string) error {
+	onlineDDL.Table = revertMigration.Table
+	if err := e.updateMySQLTable(ctx, onlineDDL.UUID, onlineDDL.Table); err != nil {
+		return err
+	}
+	// The running migration is reviewed, and possibly cancelled, according to its strategy
+	onlineDDL.Strategy = revertMigration.Strategy
+	if err := e.updateMigrationStrategy(ctx, onlineDDL.UUID, onlineDDL.Strategy); err != nil {
+		return err
+	}
+	onlineDDL.SQL = revertStatement
+	switch onlineDDL.Strategy {
+	case schema.DDLStrategyGhost:
+		return e.ExecuteWithGhost(ctx, onlineDDL)
+	case schema.DDLStrategyPTOSC:
+		return e.ExecuteWithPTOSC(ctx, onlineDDL)
+	}
+	return fmt.Errorf("cannot run migration %s reverting %s: unexpected strategy %s", onlineDDL.UUID, revertMigration.UUID, onlineDDL.Strategy)
+}
+
 // evaluateDeclarativeDiff is called for -declarative CREATE statements, where the table already exists. The function generates a SQL diff, which can be:
 // - empty, in which case the migration is noop and implicitly successful, or
 // - non-empty, in which case the migration turns to be an ALTER
@@ -1930,11 +2211,16 @@ func (e *Executor) evaluateDeclarativeDiff(ctx context.Context, onlineDDL *schem
 			// No change. alterClause remains empty
 			return nil
 		}
+		// The changes of a desired schema which may lose data, like dropping a column, must be explicitly allowed.
+		strategySetting := onlineDDL.StrategySetting()
 		mods := tengo.StatementModifiers{
-			AllowUnsafe: true,
+			AllowUnsafe: !strategySetting.IsDesiredSchema() || strategySetting.IsAllowDestructive(),
 			NextAutoInc: tengo.NextAutoIncIfIncreased,
 		}
 		alterClause, err = diff.Clauses(mods)
+		if tengo.IsForbiddenDiff(err) {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "desired schema of table %s implies a destructive change: %s. Use -allow-destructive to apply it", onlineDDL.Table, alterClause)
+		}
 		if err != nil {
 			return err
 		}
@@ -1988,7 +2274,11 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 				return failMigration(err)
 			}
 			if exists {
-				// table does exist, so this declarative DROP turns out to really be an actual DROP. No further action is needed here
+				// table does exist, so this declarative DROP turns out to really be an actual DROP. No further action is needed here,
+				// unless the DROP is part of a desired schema, where it must be explicitly allowed.
+				if strategySetting := onlineDDL.StrategySetting(); strategySetting.IsDesiredSchema() && !strategySetting.IsAllowDestructive() {
+					return failMigration(vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "desired schema drops table %s. Use -allow-destructive to drop it", onlineDDL.Table))
+				}
 			} else {
 				// table does not exist. We mark this DROP as implicitly sucessful
 				_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow, rowsCopiedUnknown)
@@ -2148,6 +2438,10 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 				e.migrationMutex.Lock()
 				defer e.migrationMutex.Unlock()
 
+				if err := e.prepareRevertibleAlter(ctx, onlineDDL); err != nil {
+					failMigration(err)
+					return
+				}
 				if err := e.ExecuteWithGhost(ctx, onlineDDL); err != nil {
 					failMigration(err)
 				}
@@ -2157,6 +2451,10 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 				e.migrationMutex.Lock()
 				defer e.migrationMutex.Unlock()
 
+				if err := e.prepareRevertibleAlter(ctx, onlineDDL); err != nil {
+					failMigration(err)
+					return
+				}
 				if err := e.ExecuteWithPTOSC(ctx, onlineDDL); err != nil {
 					failMigration(err)
 				}
@@ -2183,10 +2481,6 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if e.isAnyMigrationRunning() {
-		return ErrExecutorMigrationAlreadyRunning
-	}
-
 	r, err := e.execQuery(ctx, sqlSelectReadyMigration)
 	if err != nil {
 		return err
@@ -2203,6 +2497,9 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 			Options:  row["options"].ToString(),
 			Status:   schema.OnlineDDLStatus(row["migration_status"].ToString()),
 		}
+		if err := e.validateConcurrentMigration(ctx, onlineDDL, row["ddl_action"].ToString()); err != nil {
+			return err
+		}
 		{
 			// We strip out any VT query comments because our simplified parser doesn't work well with comments
 			ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
@@ -2420,6 +2717,10 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 		strategySettings := schema.NewDDLStrategySetting(strategy, row["options"].ToString())
 		postponeCompletion := row.AsBool("postpone_completion", false)
 		elapsedSeconds := row.AsInt64("elapsed_seconds", 0)
+		cutOverWindows, err := strategySettings.CutOverWindows()
+		if err != nil {
+			return countRunnning, cancellable, err
+		}
 
 		uuidsFoundRunning[uuid] = true
 
@@ -2458,10 +2759,23 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 							isReady = false
 						}
 					}
+					readyToCutOver := isReady
 					if postponeCompletion {
 						// override. Even if migration is ready, we do not complet it.
 						isReady = false
 					}
+					if isReady && len(cutOverWindows) > 0 {
+						// The migration waits, ready to cut over, until one of its cut-over windows opens.
+						message := cutOverWaitMessage(cutOverWindows, time.Now())
+						_ = e.updateMigrationMessage(ctx, uuid, message)
+						isReady = message == ""
+					}
+					stage := vreplMigrationStage(s.state, readyToCutOver, isReady)
+					_ = e.updateMigrationStage(ctx, uuid, stage)
+					_ = e.updateMigrationVReplicationLag(ctx, uuid, s.lagSeconds(time.Now()))
+					if stage == stageCopyingRows {
+						_ = e.updateMigrationRowsCopiedPerSecond(ctx, uuid)
+					}
 					if isReady {
 						if err := e.cutOverVReplMigration(ctx, s); err != nil {
 							return countRunnning, cancellable, err
@@ -2498,6 +2812,14 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 					// is missing. So we may as well cancel it.
 					message := fmt.Sprintf("cancelling a gh-ost running migration %s which is not owned (not started, or is assumed to be terminated) by this executor", uuid)
 					cancellable = append(cancellable, newCancellableMigration(uuid, message))
+				} else if len(cutOverWindows) > 0 && !postponeCompletion {
+					// gh-ost postpones its cut-over while the postpone flag file exists. The file of a
+					// postponed migration is left for CompleteMigration to remove.
+					if cutOverWaitMessage(cutOverWindows, time.Now()) == "" {
+						_ = e.deleteGhostPostponeFlagFile(uuid)
+					} else {
+						_ = e.createGhostPostponeFlagFile(uuid)
+					}
 				}
 			}
 		}
@@ -2599,6 +2921,54 @@ func (e *Executor) gcArtifactTable(ctx context.Context, artifactTable, uuid stri
 	return err
 }
 
+// gcShadowColumns drops the shadow columns of a -revertible migration which can no longer be reverted. As the
+// table may be large, they are dropped by a new migration, which runs with the same tool.
+func (e *Executor) gcShadowColumns(ctx context.Context, row sqltypes.RowNamedValues) error {
+	strategySetting := schema.NewDDLStrategySetting(schema.DDLStrategy(row["strategy"].ToString()), row["options"].ToString())
+	if !strategySetting.IsRevertible() {
+		return nil
+	}
+	uuid := row["migration_uuid"].ToString()
+	table := row["mysql_table"].ToString()
+	prefix := shadowColumnsPrefix(uuid)
+	query, err := sqlparser.ParseAndBind(sqlSelectShadowColumns,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(table),
+		sqltypes.StringBindVariable(prefix),
+		sqltypes.StringBindVariable(prefix),
+	)
+	if err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	alterTable := &sqlparser.AlterTable{Table: sqlparser.TableName{Name: sqlparser.NewTableIdent(table)}}
+	for _, row := range r.Named().Rows {
+		alterTable.AlterOptions = append(alterTable.AlterOptions, &sqlparser.DropColumn{
+			Name: &sqlparser.ColName{Name: sqlparser.NewColIdent(row.AsString("column_name", ""))},
+		})
+	}
+	if len(alterTable.AlterOptions) == 0 {
+		// The migration was reverted, or its shadow columns were already dropped
+		return nil
+	}
+	onlineDDL, err := schema.NewOnlineDDL(e.keyspace, table, sqlparser.String(alterTable), schema.NewDDLStrategySetting(strategySetting.Strategy, ""), row["migration_context"].ToString())
+	if err != nil {
+		return err
+	}
+	stmt, err := sqlparser.Parse(onlineDDL.SQL)
+	if err != nil {
+		return err
+	}
+	if _, err := e.SubmitMigration(ctx, stmt); err != nil {
+		return err
+	}
+	log.Infof("Executor.gcShadowColumns: submitted migration %s to drop the shadow columns of migration %s", onlineDDL.UUID, uuid)
+	return nil
+}
+
 // gcArtifacts garbage-collects migration artifacts from completed/failed migrations
 func (e *Executor) gcArtifacts(ctx context.Context) error {
 	e.migrationMutex.Lock()
@@ -2626,6 +2996,10 @@ func (e *Executor) gcArtifacts(ctx context.Context) error {
 		artifacts := row["artifacts"].ToString()
 		logPath := row["log_path"].ToString()
 
+		if err := e.gcShadowColumns(ctx, row); err != nil {
+			return err
+		}
+
 		// Remove tables:
 		artifactTables := textutil.SplitDelimitedList(artifacts)
 
@@ -2872,6 +3246,30 @@ func (e *Executor) updateMySQLTable(ctx context.Context, uuid string, tableName
 	return err
 }
 
+func (e *Executor) updateMigrationRevertStatement(ctx context.Context, uuid string, revertStatement string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationRevertStatement,
+		sqltypes.StringBindVariable(revertStatement),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) updateMigrationStrategy(ctx context.Context, uuid string, strategy schema.DDLStrategy) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationStrategy,
+		sqltypes.StringBindVariable(string(strategy)),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationETASeconds(ctx context.Context, uuid string, etaSeconds int64) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationETASeconds,
 		sqltypes.Int64BindVariable(etaSeconds),
@@ -2925,6 +3323,41 @@ func (e *Executor) updateMigrationETASecondsByProgress(ctx context.Context, uuid
 	return err
 }
 
+func (e *Executor) updateMigrationStage(ctx context.Context, uuid string, stage string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationStage,
+		sqltypes.StringBindVariable(stage),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) updateMigrationVReplicationLag(ctx context.Context, uuid string, lagSeconds int64) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationVReplicationLag,
+		sqltypes.Int64BindVariable(lagSeconds),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) updateMigrationRowsCopiedPerSecond(ctx context.Context, uuid string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationRowsCopiedPerSecond,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationTableRows(ctx context.Context, uuid string, tableRows int64) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationTableRows,
 		sqltypes.Int64BindVariable(tableRows),
@@ -3056,7 +3489,25 @@ func (e *Executor) SubmitMigration(
 		return nil, err
 	}
 
+	if onlineDDL.StrategySetting().IsRevertible() {
+		alterTable, ok := stmt.(*sqlparser.AlterTable)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "-revertible only applies to ALTER TABLE migrations, found: %s", actionStr)
+		}
+		if err := validateRevertibleAlter(alterTable); err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Error submitting migration %s: %v", onlineDDL.UUID, err)
+		}
+		if err := e.validateRevertibleMySQLVersion(ctx); err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Error submitting migration %s: %v", onlineDDL.UUID, err)
+		}
+	}
+
 	retainArtifactsSeconds := int64((*retainOnlineDDLTables).Seconds())
+	if retainArtifacts, err := onlineDDL.StrategySetting().RetainArtifacts(); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Error submitting migration %s: %v", onlineDDL.UUID, err)
+	} else if retainArtifacts > 0 {
+		retainArtifactsSeconds = int64(retainArtifacts.Seconds())
+	}
 	query, err := sqlparser.ParseAndBind(sqlInsertMigration,
 		sqltypes.StringBindVariable(onlineDDL.UUID),
 		sqltypes.StringBindVariable(e.keyspace),
@@ -3183,6 +3634,13 @@ func (e *Executor) onSchemaMigrationStatus(ctx context.Context,
 	if err = e.updateMigrationStatus(ctx, uuid, status); err != nil {
 		return err
 	}
+	switch status {
+	case schema.OnlineDDLStatusComplete, schema.OnlineDDLStatusFailed, schema.OnlineDDLStatusCancelled:
+		// The stage is only relevant while the migration runs.
+		if err = e.updateMigrationStage(ctx, uuid, ""); err != nil {
+			return err
+		}
+	}
 	if err = e.updateMigrationProgress(ctx, uuid, progressPct); err != nil {
 		return err
 	}
@@ -19,3 +19,73 @@ Functionality of this Executor is tested in go/test/endtoend/onlineddl/...
 */
 
 package onlineddl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestVReplMigrationStage(t *testing.T) {
+	tcases := []struct {
+		state          string
+		readyToCutOver bool
+		cutOver        bool
+		stage          string
+	}{
+		{state: binlogplayer.VReplicationInit, stage: stageCopyingRows},
+		{state: binlogplayer.VReplicationCopying, stage: stageCopyingRows},
+		{state: binlogplayer.BlpRunning, stage: stageApplyingBinlog},
+		{state: binlogplayer.BlpRunning, readyToCutOver: true, stage: stageWaitingCutOver},
+		{state: binlogplayer.BlpRunning, readyToCutOver: true, cutOver: true, stage: stageCuttingOver},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.stage, func(t *testing.T) {
+			assert.Equal(t, tcase.stage, vreplMigrationStage(tcase.state, tcase.readyToCutOver, tcase.cutOver))
+		})
+	}
+}
+
+func TestVReplStreamLagSeconds(t *testing.T) {
+	now := time.Unix(1634218620, 0)
+
+	s := &VReplStream{}
+	assert.Equal(t, int64(0), s.lagSeconds(now))
+
+	s.transactionTimestamp = now.Unix() - 17
+	assert.Equal(t, int64(17), s.lagSeconds(now))
+
+	s.transactionTimestamp = now.Unix() + 3
+	assert.Equal(t, int64(0), s.lagSeconds(now))
+}
+
+func TestMaxConcurrentMigrations(t *testing.T) {
+	ctx := context.Background()
+	ts, factory := memorytopo.NewServerAndFactory("zone1")
+	e := &Executor{ts: ts, keyspace: "ks"}
+
+	// no config
+	assert.Equal(t, 1, e.maxConcurrentMigrations(ctx))
+
+	// the config is cached
+	require.NoError(t, ts.SaveOnlineDDLSchedulerConfig(ctx, "ks", &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 3}))
+	assert.Equal(t, 1, e.maxConcurrentMigrations(ctx))
+
+	e.schedulerConfigReadTime = time.Now().Add(-schedulerConfigRefreshInterval)
+	assert.Equal(t, 3, e.maxConcurrentMigrations(ctx))
+
+	// the last read config is kept when it cannot be read
+	require.NoError(t, ts.SaveOnlineDDLSchedulerConfig(ctx, "ks", &topodatapb.OnlineDDLSchedulerConfig{MaxConcurrentMigrations: 5}))
+	factory.SetError(errors.New("topo is down"))
+	e.schedulerConfigReadTime = time.Now().Add(-schedulerConfigRefreshInterval)
+	assert.Equal(t, 3, e.maxConcurrentMigrations(ctx))
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+const (
+	shadowColumnNamePrefix = "_vt_shd_"
+	maxColumnNameLength    = 64
+)
+
+// shadowColumnsPrefix returns the prefix of the names of the shadow columns of a -revertible migration, which
+// retain the data of the columns it drops.
+func shadowColumnsPrefix(uuid string) string {
+	sum := sha256.Sum256([]byte(uuid))
+	return fmt.Sprintf("%s%s_", shadowColumnNamePrefix, hex.EncodeToString(sum[:])[:8])
+}
+
+// shadowColumnName returns the name of the shadow column retaining the given dropped column. The name is
+// truncated to the maximum length of a column name.
+func shadowColumnName(uuid string, column string) string {
+	name := shadowColumnsPrefix(uuid) + column
+	if len(name) > maxColumnNameLength {
+		name = name[:maxColumnNameLength]
+	}
+	return name
+}
+
+// validateRevertibleAlter checks that a -revertible ALTER only adds and drops columns and indexes, which are the
+// changes we know to revert without vreplication.
+func validateRevertibleAlter(alterTable *sqlparser.AlterTable) error {
+	if alterTable.PartitionSpec != nil {
+		return fmt.Errorf("a revertible ALTER may not change partitions")
+	}
+	if len(alterTable.AlterOptions) == 0 {
+		return fmt.Errorf("a revertible ALTER must change columns or indexes")
+	}
+	for _, option := range alterTable.AlterOptions {
+		switch option := option.(type) {
+		case *sqlparser.AddColumns, *sqlparser.DropColumn:
+		case *sqlparser.AddIndexDefinition:
+			if option.IndexDefinition.Info.Primary {
+				return fmt.Errorf("a revertible ALTER may not add a primary key")
+			}
+			if option.IndexDefinition.Info.Name.IsEmpty() {
+				return fmt.Errorf("a revertible ALTER must name the indexes it adds")
+			}
+		case *sqlparser.DropKey:
+			if option.Type != sqlparser.NormalKeyType {
+				return fmt.Errorf("a revertible ALTER may not drop a %s", option.Type.ToString())
+			}
+		default:
+			return fmt.Errorf("a revertible ALTER may only add and drop columns and indexes, found: %s", sqlparser.String(option))
+		}
+	}
+	return nil
+}
+
+// revertibleAlter rewrites the ALTER of a -revertible migration on the table created by createTable, and
+// returns the rewritten ALTER along with the ALTER which reverts it:
+//   - added columns and indexes are dropped by the revert,
+//   - dropped indexes are added back by the revert, as defined in createTable,
+//   - dropped columns are not dropped, but renamed to invisible and nullable shadow columns, which the revert
+//     renames back with their original definition. The data of the columns is thus retained. The indexes
+//     covering them are dropped, so that the shadow columns do not enforce unique keys, and are added back by
+//     the revert.
+func revertibleAlter(uuid string, createTable *sqlparser.CreateTable, alterTable *sqlparser.AlterTable) (alter *sqlparser.AlterTable, revert *sqlparser.AlterTable, err error) {
+	if err := validateRevertibleAlter(alterTable); err != nil {
+		return nil, nil, err
+	}
+	columns := map[string]*sqlparser.ColumnDefinition{}
+	for _, col := range createTable.TableSpec.Columns {
+		columns[col.Name.Lowered()] = col
+	}
+	indexes := map[string]*sqlparser.IndexDefinition{}
+	var indexList []*sqlparser.IndexDefinition
+	pkColumns := map[string]bool{}
+	for _, index := range createTable.TableSpec.Indexes {
+		if index.Info.Primary {
+			for _, col := range index.Columns {
+				pkColumns[col.Column.Lowered()] = true
+			}
+			continue
+		}
+		indexes[index.Info.Name.Lowered()] = index
+		indexList = append(indexList, index)
+	}
+	// droppedIndexes are the indexes the ALTER drops explicitly, or because they cover a dropped column
+	droppedIndexes := map[string]bool{}
+	for _, option := range alterTable.AlterOptions {
+		if option, ok := option.(*sqlparser.DropKey); ok {
+			droppedIndexes[option.Name.Lowered()] = true
+		}
+	}
+
+	alter = &sqlparser.AlterTable{Table: alterTable.Table, FullyParsed: true}
+	revert = &sqlparser.AlterTable{Table: alterTable.Table, FullyParsed: true}
+	// revertOptions are reversed at the end, so that the revert undoes the changes in the opposite order
+	var revertOptions []sqlparser.AlterOption
+	shadowColumns := map[string]string{}
+	for _, option := range alterTable.AlterOptions {
+		switch option := option.(type) {
+		case *sqlparser.AddColumns:
+			alter.AlterOptions = append(alter.AlterOptions, option)
+			for _, col := range option.Columns {
+				revertOptions = append(revertOptions, &sqlparser.DropColumn{Name: &sqlparser.ColName{Name: col.Name}})
+			}
+		case *sqlparser.DropColumn:
+			name := option.Name.Name.Lowered()
+			col, ok := columns[name]
+			if !ok {
+				return nil, nil, fmt.Errorf("cannot drop column %s: not found in table %s", option.Name.Name.String(), createTable.Table.Name.String())
+			}
+			if pkColumns[name] {
+				return nil, nil, fmt.Errorf("a revertible ALTER may not drop column %s, which is part of the primary key", col.Name.String())
+			}
+			if col.Type.Options != nil && col.Type.Options.Autoincrement {
+				return nil, nil, fmt.Errorf("a revertible ALTER may not drop column %s, which is auto_increment", col.Name.String())
+			}
+			shadowName := shadowColumnName(uuid, col.Name.String())
+			if other, ok := shadowColumns[strings.ToLower(shadowName)]; ok {
+				return nil, nil, fmt.Errorf("columns %s and %s have the same shadow column %s", other, col.Name.String(), shadowName)
+			}
+			shadowColumns[strings.ToLower(shadowName)] = col.Name.String()
+
+			alter.AlterOptions = append(alter.AlterOptions, &sqlparser.ChangeColumn{
+				OldColumn:        &sqlparser.ColName{Name: col.Name},
+				NewColDefinition: shadowColumnDefinition(col, shadowName),
+			})
+			revertOptions = append(revertOptions, &sqlparser.ChangeColumn{
+				OldColumn:        &sqlparser.ColName{Name: sqlparser.NewColIdent(shadowName)},
+				NewColDefinition: col,
+			})
+			for _, index := range indexList {
+				if droppedIndexes[index.Info.Name.Lowered()] || !indexCoversColumn(index, name) {
+					continue
+				}
+				droppedIndexes[index.Info.Name.Lowered()] = true
+				alter.AlterOptions = append(alter.AlterOptions, &sqlparser.DropKey{Type: sqlparser.NormalKeyType, Name: index.Info.Name})
+				revertOptions = append(revertOptions, &sqlparser.AddIndexDefinition{IndexDefinition: index})
+			}
+		case *sqlparser.AddIndexDefinition:
+			alter.AlterOptions = append(alter.AlterOptions, option)
+			revertOptions = append(revertOptions, &sqlparser.DropKey{Type: sqlparser.NormalKeyType, Name: option.IndexDefinition.Info.Name})
+		case *sqlparser.DropKey:
+			index, ok := indexes[option.Name.Lowered()]
+			if !ok {
+				return nil, nil, fmt.Errorf("cannot drop index %s: not found in table %s", option.Name.String(), createTable.Table.Name.String())
+			}
+			alter.AlterOptions = append(alter.AlterOptions, option)
+			revertOptions = append(revertOptions, &sqlparser.AddIndexDefinition{IndexDefinition: index})
+		}
+	}
+	for i := len(revertOptions) - 1; i >= 0; i-- {
+		revert.AlterOptions = append(revert.AlterOptions, revertOptions[i])
+	}
+	return alter, revert, nil
+}
+
+// indexCoversColumn returns true if the index has a part on the given lowercase column.
+func indexCoversColumn(index *sqlparser.IndexDefinition, column string) bool {
+	for _, col := range index.Columns {
+		if col.Column.Lowered() == column {
+			return true
+		}
+	}
+	return false
+}
+
+// shadowColumnDefinition returns the definition of the shadow column which retains the given column. The
+// shadow column is invisible, so that it is not returned by SELECT *, and nullable without a default, so that
+// inserts which do not know about the column do not fail, and write NULL rather than made up values. Its ON
+// UPDATE is dropped too, so that updates do not change the retained data.
+func shadowColumnDefinition(col *sqlparser.ColumnDefinition, shadowName string) *sqlparser.ColumnDefinition {
+	shadow := &sqlparser.ColumnDefinition{
+		Name: sqlparser.NewColIdent(shadowName),
+		Type: col.Type,
+	}
+	options := &sqlparser.ColumnTypeOptions{}
+	if col.Type.Options != nil {
+		*options = *col.Type.Options
+	}
+	null := true
+	options.Null = &null
+	options.Default = nil
+	options.OnUpdate = nil
+	options.Invisible = true
+	options.Reference = nil
+	options.KeyOpt = 0
+	shadow.Type.Options = options
+	return shadow
+}
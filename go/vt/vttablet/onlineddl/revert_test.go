@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestShadowColumnName(t *testing.T) {
+	uuid := "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"
+	prefix := shadowColumnsPrefix(uuid)
+	assert.True(t, strings.HasPrefix(prefix, shadowColumnNamePrefix))
+	assert.Equal(t, len(shadowColumnNamePrefix)+9, len(prefix))
+	assert.NotEqual(t, prefix, shadowColumnsPrefix("b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"))
+
+	assert.Equal(t, prefix+"c1", shadowColumnName(uuid, "c1"))
+	name := shadowColumnName(uuid, strings.Repeat("x", maxColumnNameLength))
+	assert.Equal(t, maxColumnNameLength, len(name))
+	assert.True(t, strings.HasPrefix(name, prefix))
+}
+
+func TestRevertibleAlter(t *testing.T) {
+	uuid := "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"
+	prefix := shadowColumnsPrefix(uuid)
+	createTable := "create table t (id int auto_increment, c1 varchar(32) not null default 'x' comment 'hi', c2 int, c3 int, primary key (id), key c2_idx (c2), unique key c2_c3_uidx (c2, c3))"
+	tcases := []struct {
+		alter  string
+		expect string
+		revert string
+		err    string
+	}{
+		{
+			alter:  "alter table t add column c4 int",
+			expect: "alter table t add column c4 int",
+			revert: "alter table t drop column c4",
+		},
+		{
+			alter:  "alter table t drop column c1",
+			expect: "alter table t change column c1 " + prefix + "c1 varchar(32) null invisible comment 'hi'",
+			revert: "alter table t change column " + prefix + "c1 c1 varchar(32) not null default 'x' comment 'hi'",
+		},
+		{
+			alter:  "alter table t drop column c2",
+			expect: "alter table t change column c2 " + prefix + "c2 int null invisible, drop key c2_idx, drop key c2_c3_uidx",
+			revert: "alter table t add unique key c2_c3_uidx (c2, c3), add key c2_idx (c2), change column " + prefix + "c2 c2 int",
+		},
+		{
+			alter:  "alter table t drop column c3, drop key c2_c3_uidx",
+			expect: "alter table t change column c3 " + prefix + "c3 int null invisible, drop key c2_c3_uidx",
+			revert: "alter table t add unique key c2_c3_uidx (c2, c3), change column " + prefix + "c3 c3 int",
+		},
+		{
+			alter:  "alter table t add index c1_idx (c1), drop key c2_idx",
+			expect: "alter table t add index c1_idx (c1), drop key c2_idx",
+			revert: "alter table t add key c2_idx (c2), drop key c1_idx",
+		},
+		{
+			alter: "alter table t drop column id",
+			err:   "a revertible ALTER may not drop column id, which is part of the primary key",
+		},
+		{
+			alter: "alter table t drop column c5",
+			err:   "cannot drop column c5: not found in table t",
+		},
+		{
+			alter: "alter table t drop key c4_idx",
+			err:   "cannot drop index c4_idx: not found in table t",
+		},
+		{
+			alter: "alter table t add index (c1)",
+			err:   "a revertible ALTER must name the indexes it adds",
+		},
+		{
+			alter: "alter table t modify column c2 bigint",
+			err:   "a revertible ALTER may only add and drop columns and indexes, found: modify column c2 bigint",
+		},
+	}
+	stmt, err := sqlparser.Parse(createTable)
+	require.NoError(t, err)
+	for _, tcase := range tcases {
+		t.Run(tcase.alter, func(t *testing.T) {
+			alterStmt, err := sqlparser.Parse(tcase.alter)
+			require.NoError(t, err)
+			alter, revert, err := revertibleAlter(uuid, stmt.(*sqlparser.CreateTable), alterStmt.(*sqlparser.AlterTable))
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.expect, sqlparser.String(alter))
+			assert.Equal(t, tcase.revert, sqlparser.String(revert))
+		})
+	}
+}
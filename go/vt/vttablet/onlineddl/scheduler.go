@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// queuedMigration is a migration waiting in the queue, along with the settings which decide when it is scheduled
+type queuedMigration struct {
+	uuid               string
+	ddlAction          string
+	postponeCompletion bool
+	priority           schema.MigrationPriority
+	dependsOn          []string
+	postponeUntil      time.Time
+}
+
+// newQueuedMigration reads a queued migration from a row of sqlSelectQueuedMigrations. The strategy options
+// were validated when the migration was submitted.
+func newQueuedMigration(row sqltypes.RowNamedValues) *queuedMigration {
+	strategySetting := schema.NewDDLStrategySetting(schema.DDLStrategy(row["strategy"].ToString()), row["options"].ToString())
+	m := &queuedMigration{
+		uuid:               row["migration_uuid"].ToString(),
+		ddlAction:          row["ddl_action"].ToString(),
+		postponeCompletion: row.AsBool("postpone_completion", false),
+		priority:           schema.MigrationPriorityNormal,
+	}
+	if priority, err := strategySetting.Priority(); err == nil {
+		m.priority = priority
+	}
+	m.dependsOn, _ = strategySetting.DependsOn()
+	m.postponeUntil, _ = strategySetting.PostponeUntil()
+	return m
+}
+
+// nextQueuedMigration returns the migration to schedule next out of the given queued migrations, which are
+// ordered by the time they were requested. Migrations of a higher priority are scheduled first, and among
+// migrations of the same priority the earliest requested one is. A migration is not scheduled:
+//   - if it is a CREATE or DROP with -postpone-completion, since these complete as soon as they run,
+//   - before its -postpone-until time,
+//   - before all the migrations it -depends-on are complete. statuses are the statuses of these migrations.
+//
+// A migration whose dependency failed, was cancelled, or does not exist is never scheduled, and neither is
+// a migration which depends on itself, directly or through other queued migrations. It is returned in
+// failed, along with the reason, for the caller to fail it.
+func nextQueuedMigration(queued []*queuedMigration, now time.Time, statuses map[string]schema.OnlineDDLStatus) (next *queuedMigration, failed map[string]string) {
+	failed = map[string]string{}
+	queuedByUUID := make(map[string]*queuedMigration, len(queued))
+	for _, m := range queued {
+		queuedByUUID[m.uuid] = m
+	}
+	for _, m := range queued {
+		if cycle := dependencyCycle(m.uuid, queuedByUUID); cycle != nil {
+			failed[m.uuid] = fmt.Sprintf("migration has a circular dependency: %s", strings.Join(cycle, " -> "))
+			continue
+		}
+		if m.postponeCompletion && m.ddlAction != sqlparser.AlterStr {
+			continue
+		}
+		if now.Before(m.postponeUntil) {
+			continue
+		}
+		ready := true
+		for _, uuid := range m.dependsOn {
+			status, ok := statuses[uuid]
+			if !ok {
+				failed[m.uuid] = fmt.Sprintf("migration %s, which this migration depends on, does not exist", uuid)
+				ready = false
+				break
+			}
+			switch status {
+			case schema.OnlineDDLStatusComplete:
+			case schema.OnlineDDLStatusFailed, schema.OnlineDDLStatusCancelled:
+				failed[m.uuid] = fmt.Sprintf("migration %s, which this migration depends on, is %s", uuid, status)
+				ready = false
+			default:
+				ready = false
+			}
+			if !ready {
+				break
+			}
+		}
+		if !ready {
+			continue
+		}
+		if next == nil || m.priority.Rank() > next.priority.Rank() {
+			next = m
+		}
+	}
+	return next, failed
+}
+
+// dependencyCycle returns the chain of dependencies through which the given queued migration depends on
+// itself, starting and ending with it, or nil if it does not.
+func dependencyCycle(uuid string, queuedByUUID map[string]*queuedMigration) []string {
+	visited := map[string]bool{}
+	var visit func(path []string) []string
+	visit = func(path []string) []string {
+		m := queuedByUUID[path[len(path)-1]]
+		if m == nil {
+			return nil
+		}
+		for _, dependency := range m.dependsOn {
+			if dependency == uuid {
+				return append(path, dependency)
+			}
+			if visited[dependency] {
+				continue
+			}
+			visited[dependency] = true
+			if cycle := visit(append(path[:len(path):len(path)], dependency)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit([]string{uuid})
+}
+
+// runningMigration is a migration which runs right now
+type runningMigration struct {
+	uuid     string
+	table    string
+	strategy schema.DDLStrategy
+}
+
+// canRunConcurrently returns whether a migration may start alongside the given running migrations, when at
+// most maxConcurrent migrations may run. Only online ALTER migrations run concurrently, and only on distinct
+// tables: gh-ost, pt-osc, CREATE, DROP and REVERT migrations wait for all other migrations to complete.
+func canRunConcurrently(ddlAction string, strategy schema.DDLStrategy, table string, running []*runningMigration, maxConcurrent int) bool {
+	if len(running) == 0 {
+		return true
+	}
+	if len(running) >= maxConcurrent {
+		return false
+	}
+	if ddlAction != sqlparser.AlterStr || strategy != schema.DDLStrategyOnline {
+		return false
+	}
+	for _, r := range running {
+		if r.strategy != schema.DDLStrategyOnline || r.table == table {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestNextQueuedMigration(t *testing.T) {
+	now := time.Date(2021, 10, 1, 2, 0, 0, 0, time.UTC)
+	alter := func(uuid string) *queuedMigration {
+		return &queuedMigration{uuid: uuid, ddlAction: sqlparser.AlterStr, priority: schema.MigrationPriorityNormal}
+	}
+	{
+		next, failed := nextQueuedMigration(nil, now, nil)
+		assert.Nil(t, next)
+		assert.Empty(t, failed)
+	}
+	{
+		// earliest requested first
+		next, _ := nextQueuedMigration([]*queuedMigration{alter("a"), alter("b")}, now, nil)
+		require.NotNil(t, next)
+		assert.Equal(t, "a", next.uuid)
+	}
+	{
+		// higher priority first, regardless of request time
+		low := alter("a")
+		low.priority = schema.MigrationPriorityLow
+		high := alter("c")
+		high.priority = schema.MigrationPriorityHigh
+		next, _ := nextQueuedMigration([]*queuedMigration{low, alter("b"), high}, now, nil)
+		require.NotNil(t, next)
+		assert.Equal(t, "c", next.uuid)
+	}
+	{
+		// postponed CREATE and DROP are never scheduled
+		create := alter("a")
+		create.ddlAction = sqlparser.CreateStr
+		create.postponeCompletion = true
+		next, _ := nextQueuedMigration([]*queuedMigration{create, alter("b")}, now, nil)
+		require.NotNil(t, next)
+		assert.Equal(t, "b", next.uuid)
+	}
+	{
+		postponed := alter("a")
+		postponed.postponeUntil = now.Add(time.Hour)
+		next, _ := nextQueuedMigration([]*queuedMigration{postponed}, now, nil)
+		assert.Nil(t, next)
+
+		next, _ = nextQueuedMigration([]*queuedMigration{postponed}, now.Add(2*time.Hour), nil)
+		require.NotNil(t, next)
+		assert.Equal(t, "a", next.uuid)
+	}
+	{
+		dependent := alter("b")
+		dependent.dependsOn = []string{"a"}
+		statuses := map[string]schema.OnlineDDLStatus{"a": schema.OnlineDDLStatusRunning}
+		next, failed := nextQueuedMigration([]*queuedMigration{dependent}, now, statuses)
+		assert.Nil(t, next)
+		assert.Empty(t, failed)
+
+		statuses["a"] = schema.OnlineDDLStatusComplete
+		next, failed = nextQueuedMigration([]*queuedMigration{dependent}, now, statuses)
+		require.NotNil(t, next)
+		assert.Equal(t, "b", next.uuid)
+		assert.Empty(t, failed)
+
+		statuses["a"] = schema.OnlineDDLStatusFailed
+		next, failed = nextQueuedMigration([]*queuedMigration{dependent}, now, statuses)
+		assert.Nil(t, next)
+		assert.Equal(t, map[string]string{"b": "migration a, which this migration depends on, is failed"}, failed)
+
+		next, failed = nextQueuedMigration([]*queuedMigration{dependent}, now, nil)
+		assert.Nil(t, next)
+		assert.Equal(t, map[string]string{"b": "migration a, which this migration depends on, does not exist"}, failed)
+	}
+	{
+		// circular dependencies are failed, the migrations depending on them wait for them to fail
+		a := alter("a")
+		a.dependsOn = []string{"b"}
+		b := alter("b")
+		b.dependsOn = []string{"c"}
+		c := alter("c")
+		c.dependsOn = []string{"a"}
+		d := alter("d")
+		d.dependsOn = []string{"d"}
+		e := alter("e")
+		e.dependsOn = []string{"a"}
+		statuses := map[string]schema.OnlineDDLStatus{
+			"a": schema.OnlineDDLStatusQueued,
+			"b": schema.OnlineDDLStatusQueued,
+			"c": schema.OnlineDDLStatusQueued,
+			"d": schema.OnlineDDLStatusQueued,
+		}
+		next, failed := nextQueuedMigration([]*queuedMigration{a, b, c, d, e, alter("f")}, now, statuses)
+		require.NotNil(t, next)
+		assert.Equal(t, "f", next.uuid)
+		assert.Equal(t, map[string]string{
+			"a": "migration has a circular dependency: a -> b -> c -> a",
+			"b": "migration has a circular dependency: b -> c -> a -> b",
+			"c": "migration has a circular dependency: c -> a -> b -> c",
+			"d": "migration has a circular dependency: d -> d",
+		}, failed)
+	}
+}
+
+func TestCanRunConcurrently(t *testing.T) {
+	onlineT1 := &runningMigration{uuid: "a", table: "t1", strategy: schema.DDLStrategyOnline}
+	ghostT1 := &runningMigration{uuid: "a", table: "t1", strategy: schema.DDLStrategyGhost}
+	tcases := []struct {
+		name          string
+		ddlAction     string
+		strategy      schema.DDLStrategy
+		table         string
+		running       []*runningMigration
+		maxConcurrent int
+		expect        bool
+	}{
+		{
+			name:          "nothing running",
+			ddlAction:     sqlparser.CreateStr,
+			strategy:      schema.DDLStrategyGhost,
+			table:         "t1",
+			maxConcurrent: 1,
+			expect:        true,
+		},
+		{
+			name:          "single migration at a time",
+			ddlAction:     sqlparser.AlterStr,
+			strategy:      schema.DDLStrategyOnline,
+			table:         "t2",
+			running:       []*runningMigration{onlineT1},
+			maxConcurrent: 1,
+		},
+		{
+			name:          "distinct tables",
+			ddlAction:     sqlparser.AlterStr,
+			strategy:      schema.DDLStrategyOnline,
+			table:         "t2",
+			running:       []*runningMigration{onlineT1},
+			maxConcurrent: 2,
+			expect:        true,
+		},
+		{
+			name:          "same table",
+			ddlAction:     sqlparser.AlterStr,
+			strategy:      schema.DDLStrategyOnline,
+			table:         "t1",
+			running:       []*runningMigration{onlineT1},
+			maxConcurrent: 2,
+		},
+		{
+			name:          "gh-ost migration",
+			ddlAction:     sqlparser.AlterStr,
+			strategy:      schema.DDLStrategyGhost,
+			table:         "t2",
+			running:       []*runningMigration{onlineT1},
+			maxConcurrent: 2,
+		},
+		{
+			name:          "gh-ost running",
+			ddlAction:     sqlparser.AlterStr,
+			strategy:      schema.DDLStrategyOnline,
+			table:         "t2",
+			running:       []*runningMigration{ghostT1},
+			maxConcurrent: 2,
+		},
+		{
+			name:          "drop",
+			ddlAction:     sqlparser.DropStr,
+			strategy:      schema.DDLStrategyOnline,
+			table:         "t2",
+			running:       []*runningMigration{onlineT1},
+			maxConcurrent: 2,
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			assert.Equal(t, tcase.expect, canRunConcurrently(tcase.ddlAction, tcase.strategy, tcase.table, tcase.running, tcase.maxConcurrent))
+		})
+	}
+}
@@ -63,6 +63,10 @@ const (
 	alterSchemaMigrationsTableLogFile            = "ALTER TABLE _vt.schema_migrations add column log_file varchar(1024) NOT NULL DEFAULT ''"
 	alterSchemaMigrationsTableRetainArtifacts    = "ALTER TABLE _vt.schema_migrations add column retain_artifacts_seconds bigint NOT NULL DEFAULT 0"
 	alterSchemaMigrationsTablePostponeCompletion = "ALTER TABLE _vt.schema_migrations add column postpone_completion tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableStage              = "ALTER TABLE _vt.schema_migrations add column stage varchar(64) NOT NULL DEFAULT ''"
+	alterSchemaMigrationsTableVReplicationLag    = "ALTER TABLE _vt.schema_migrations add column vreplication_lag_seconds bigint NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableRowsPerSecond      = "ALTER TABLE _vt.schema_migrations add column rows_copied_per_second float NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableRevertStatement    = "ALTER TABLE _vt.schema_migrations add column revert_statement TEXT NOT NULL"
 
 	sqlInsertMigration = `INSERT IGNORE INTO _vt.schema_migrations (
 		migration_uuid,
@@ -84,19 +88,14 @@ const (
 		%a, %a, %a, %a, %a, %a, %a, %a, %a, FROM_UNIXTIME(NOW()), %a, %a, %a, %a, %a
 	)`
 
-	sqlScheduleSingleMigration = `UPDATE _vt.schema_migrations
+	sqlScheduleMigration = `UPDATE _vt.schema_migrations
 		SET
 			migration_status='ready',
 			ready_timestamp=NOW()
 		WHERE
-			migration_status='queued'
-			AND (
-				postpone_completion=0 OR ddl_action='alter'
-			)
-		ORDER BY
-			requested_timestamp ASC
-		LIMIT 1
-	`  // if the migration is CREATE or DROP, and postpone_completion=1, we just don't schedule it
+			migration_uuid=%a
+			AND migration_status='queued'
+	`
 	sqlUpdateMySQLTable = `UPDATE _vt.schema_migrations
 			SET mysql_table=%a
 		WHERE
@@ -122,6 +121,35 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlUpdateMigrationStage = `UPDATE _vt.schema_migrations
+			SET stage=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationVReplicationLag = `UPDATE _vt.schema_migrations
+			SET vreplication_lag_seconds=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationRowsCopiedPerSecond = `UPDATE _vt.schema_migrations
+			SET
+				rows_copied_per_second=CASE
+					WHEN IFNULL(TIMESTAMPDIFF(SECOND, started_timestamp, NOW()), 0)=0 THEN 0
+					ELSE rows_copied/TIMESTAMPDIFF(SECOND, started_timestamp, NOW())
+				END
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationRevertStatement = `UPDATE _vt.schema_migrations
+			SET revert_statement=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationStrategy = `UPDATE _vt.schema_migrations
+			SET strategy=%a
+		WHERE
+			migration_uuid=%a
+	`
 	sqlUpdateMigrationStartedTimestamp = `UPDATE _vt.schema_migrations SET
 			started_timestamp =IFNULL(started_timestamp,  NOW()),
 			liveness_timestamp=IFNULL(liveness_timestamp, NOW())
@@ -248,6 +276,7 @@ const (
 	`
 	sqlSelectRunningMigrations = `SELECT
 			migration_uuid,
+			mysql_table,
 			strategy,
 			options,
 			postpone_completion,
@@ -287,6 +316,18 @@ const (
 		WHERE
 			migration_status IN ('queued', 'ready', 'running')
 	`
+	sqlSelectQueuedMigrations = `SELECT
+			migration_uuid,
+			ddl_action,
+			postpone_completion,
+			strategy,
+			options
+		FROM _vt.schema_migrations
+		WHERE
+			migration_status='queued'
+		ORDER BY
+			requested_timestamp ASC
+	`
 	sqlSelectQueuedRevertMigrations = `SELECT
 			migration_uuid
 		FROM _vt.schema_migrations
@@ -296,6 +337,10 @@ const (
 	`
 	sqlSelectUncollectedArtifacts = `SELECT
 			migration_uuid,
+			mysql_table,
+			strategy,
+			options,
+			migration_context,
 			artifacts,
 			log_path
 		FROM _vt.schema_migrations
@@ -307,6 +352,17 @@ const (
 				NOW() - INTERVAL retain_artifacts_seconds SECOND
 			)
 	`
+	sqlSelectRevertibleMigration = `SELECT
+			migration_uuid
+		FROM _vt.schema_migrations
+		WHERE
+			migration_uuid=%a
+			AND cleanup_timestamp IS NULL
+			AND completed_timestamp > IF(retain_artifacts_seconds=0,
+				NOW() - INTERVAL %a SECOND,
+				NOW() - INTERVAL retain_artifacts_seconds SECOND
+			)
+	`
 	sqlFixCompletedTimestamp = `UPDATE _vt.schema_migrations
 		SET
 			completed_timestamp=NOW()
@@ -341,7 +397,8 @@ const (
 			removed_unique_keys,
 			migration_context,
 			retain_artifacts_seconds,
-			postpone_completion
+			postpone_completion,
+			revert_statement
 		FROM _vt.schema_migrations
 		WHERE
 			migration_uuid=%a
@@ -388,6 +445,14 @@ const (
 			AND ACTION_TIMING='AFTER'
 			AND LEFT(TRIGGER_NAME, 7)='pt_osc_'
 		`
+	sqlSelectShadowColumns = `SELECT
+			COLUMN_NAME as column_name
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+			AND LEFT(COLUMN_NAME, CHAR_LENGTH(%a))=%a
+		`
 	sqlSelectColumnTypes = `
 		select
 				*
@@ -478,6 +543,7 @@ const (
 	sqlAlterTableOptions = "ALTER TABLE `%a` %s"
 	sqlShowColumnsFrom   = "SHOW COLUMNS FROM `%a`"
 	sqlShowTableStatus   = "SHOW TABLE STATUS LIKE '%a'"
+	sqlShowCreateTable   = "SHOW CREATE TABLE `%a`"
 	sqlGetAutoIncrement  = `
 		SELECT
 			AUTO_INCREMENT
@@ -558,4 +624,8 @@ var ApplyDDL = []string{
 	alterSchemaMigrationsTableLogFile,
 	alterSchemaMigrationsTableRetainArtifacts,
 	alterSchemaMigrationsTablePostponeCompletion,
+	alterSchemaMigrationsTableStage,
+	alterSchemaMigrationsTableVReplicationLag,
+	alterSchemaMigrationsTableRowsPerSecond,
+	alterSchemaMigrationsTableRevertStatement,
 }
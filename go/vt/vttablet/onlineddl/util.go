@@ -28,6 +28,7 @@ import (
 	"strings"
 	"time"
 
+	"vitess.io/vitess/go/cron"
 	"vitess.io/vitess/go/vt/log"
 )
 
@@ -103,3 +104,16 @@ func ToReadableTimestamp(t time.Time) string {
 func ReadableTimestamp() string {
 	return ToReadableTimestamp(time.Now())
 }
+
+// cutOverWaitMessage returns the message of a migration which is ready to cut over but waits for one of its
+// cut-over windows, or an empty string if the migration can cut over at the given time.
+func cutOverWaitMessage(windows cron.Windows, now time.Time) string {
+	if len(windows) == 0 || windows.Contains(now) {
+		return ""
+	}
+	next := windows.NextOpen(now)
+	if next.IsZero() {
+		return fmt.Sprintf("ready to cut over, but cut-over windows %s never open", windows)
+	}
+	return fmt.Sprintf("ready to cut over, waiting for cut-over windows %s opening at %s", windows, next.Format(time.RFC3339))
+}
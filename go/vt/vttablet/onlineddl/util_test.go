@@ -21,6 +21,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/cron"
 )
 
 func TestRandomHash(t *testing.T) {
@@ -39,3 +42,18 @@ func TestToReadableTimestamp(t *testing.T) {
 	readableTimestamp := ToReadableTimestamp(ti)
 	assert.Equal(t, readableTimestamp, "20150225110639")
 }
+
+func TestCutOverWaitMessage(t *testing.T) {
+	now := time.Date(2021, 10, 14, 13, 37, 0, 0, time.UTC)
+	assert.Empty(t, cutOverWaitMessage(nil, now))
+
+	windows, err := cron.ParseWindows("* 2-4 * * *; * 13 * * *")
+	require.NoError(t, err)
+	assert.Empty(t, cutOverWaitMessage(windows, now))
+	assert.Equal(t, "ready to cut over, waiting for cut-over windows * 2-4 * * *; * 13 * * * opening at 2021-10-15T02:00:00Z",
+		cutOverWaitMessage(windows, now.Add(time.Hour)))
+
+	windows, err = cron.ParseWindows("* * 31 2 *")
+	require.NoError(t, err)
+	assert.Equal(t, "ready to cut over, but cut-over windows * * 31 2 * never open", cutOverWaitMessage(windows, now))
+}
@@ -29,6 +29,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -58,6 +59,18 @@ type VReplStream struct {
 	bls                  *binlogdatapb.BinlogSource
 }
 
+// lagSeconds returns how far behind the stream is, by the timestamp of the last transaction it applied.
+// It is 0 as long as the stream applied no transaction.
+func (v *VReplStream) lagSeconds(now time.Time) int64 {
+	if v.transactionTimestamp == 0 {
+		return 0
+	}
+	if lag := now.Unix() - v.transactionTimestamp; lag > 0 {
+		return lag
+	}
+	return 0
+}
+
 // VRepl is an online DDL helper for VReplication based migrations (ddl_strategy="online")
 type VRepl struct {
 	workflow     string
@@ -85,6 +98,9 @@ type VRepl struct {
 	enumToTextMap map[string]string
 	bls           *binlogdatapb.BinlogSource
 
+	throttlerApp  string
+	throttleRatio float64
+
 	parser *vrepl.AlterTableParser
 
 	convertCharset map[string](*binlogdatapb.CharsetConversion)
@@ -628,6 +644,8 @@ func (v *VRepl) analyzeBinlogSource(ctx context.Context) {
 		Shard:         v.shard,
 		Filter:        &binlogdatapb.Filter{},
 		StopAfterCopy: false,
+		ThrottlerApp:  v.throttlerApp,
+		ThrottleRatio: v.throttleRatio,
 	}
 
 	encodeColumns := func(columns *vrepl.ColumnList) string {
@@ -57,6 +57,15 @@ func Wrap(impl QueryService, wrapper WrapperFunc) QueryService {
 	}
 }
 
+type retryCodesKey struct{}
+
+// WithRetryCodes returns a context making the wrapped services retry the
+// errors with the given codes on a different vttablet, instead of the
+// default UNAVAILABLE, FAILED_PRECONDITION and CLUSTER_EVENT.
+func WithRetryCodes(ctx context.Context, codes []vtrpcpb.Code) context.Context {
+	return context.WithValue(ctx, retryCodesKey{}, codes)
+}
+
 // canRetry returns true if the error is retryable on a different vttablet.
 // Nil error or a canceled context make it return
 // false. Otherwise, the error code determines the outcome.
@@ -71,7 +80,16 @@ func canRetry(ctx context.Context, err error) bool {
 	default:
 	}
 
-	switch vterrors.Code(err) {
+	code := vterrors.Code(err)
+	if codes, ok := ctx.Value(retryCodesKey{}).([]vtrpcpb.Code); ok {
+		for _, c := range codes {
+			if c == code {
+				return true
+			}
+		}
+		return false
+	}
+	switch code {
 	case vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_FAILED_PRECONDITION, vtrpcpb.Code_CLUSTER_EVENT:
 		return true
 	}
@@ -205,6 +223,21 @@ func (ws *wrappedService) StreamExecute(ctx context.Context, target *querypb.Tar
 	})
 }
 
+// StreamExecuteBatch implements the QueryService interface
+func (ws *wrappedService) StreamExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, transactionID int64, reservedID int64, options *querypb.ExecuteOptions, callback func(int, *sqltypes.Result) error) error {
+	inDedicatedConn := transactionID != 0 || reservedID != 0
+	return ws.wrapper(ctx, target, ws.impl, "StreamExecuteBatch", inDedicatedConn, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		streamingStarted := false
+		innerErr := conn.StreamExecuteBatch(ctx, target, queries, transactionID, reservedID, options, func(queryIndex int, qr *sqltypes.Result) error {
+			streamingStarted = true
+			return callback(queryIndex, qr)
+		})
+		// You cannot restart a stream once it's sent results.
+		retryable := canRetry(ctx, innerErr) && (!streamingStarted)
+		return retryable, innerErr
+	})
+}
+
 func (ws *wrappedService) ExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) (qrs []sqltypes.Result, err error) {
 	inTransaction := transactionID != 0
 	err = ws.wrapper(ctx, target, ws.impl, "ExecuteBatch", inTransaction, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
@@ -236,6 +236,22 @@ func (sbc *SandboxConn) StreamExecute(ctx context.Context, target *querypb.Targe
 	return nil
 }
 
+// StreamExecuteBatch is part of the QueryService interface.
+func (sbc *SandboxConn) StreamExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, transactionID int64, reservedID int64, options *querypb.ExecuteOptions, callback func(int, *sqltypes.Result) error) error {
+	sbc.ExecCount.Add(1)
+	if err := sbc.getError(); err != nil {
+		return err
+	}
+	sbc.BatchQueries = append(sbc.BatchQueries, queries)
+	sbc.Options = append(sbc.Options, options)
+	for i := range queries {
+		if err := callback(i, sbc.getNextResult(nil)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Begin is part of the QueryService interface.
 func (sbc *SandboxConn) Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (int64, *topodatapb.TabletAlias, error) {
 	return sbc.begin(ctx, target, nil, 0, options)
@@ -580,6 +580,39 @@ func (f *FakeQueryService) ExecuteBatch(ctx context.Context, target *querypb.Tar
 	return ExecuteBatchQueryResultList, nil
 }
 
+// StreamExecuteBatch is part of the queryservice.QueryService interface.
+// The result of every query is streamed as its fields, followed by its rows.
+func (f *FakeQueryService) StreamExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, transactionID int64, reservedID int64, options *querypb.ExecuteOptions, callback func(int, *sqltypes.Result) error) error {
+	if f.HasError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	if !proto.Equal(
+		&querypb.StreamExecuteBatchRequest{Queries: queries},
+		&querypb.StreamExecuteBatchRequest{Queries: ExecuteBatchQueries},
+	) {
+		f.t.Errorf("invalid StreamExecuteBatch.Queries: got %v expected %v", queries, ExecuteBatchQueries)
+	}
+	if !proto.Equal(options, TestExecuteOptions) {
+		f.t.Errorf("invalid StreamExecuteBatch.ExecuteOptions: got %v expected %v", options, TestExecuteOptions)
+	}
+	f.checkTargetCallerID(ctx, "StreamExecuteBatch", target)
+	if transactionID != f.ExpectedTransactionID {
+		f.t.Errorf("invalid StreamExecuteBatch.TransactionId: got %v expected %v", transactionID, f.ExpectedTransactionID)
+	}
+	for i, qr := range ExecuteBatchQueryResultList {
+		if err := callback(i, &sqltypes.Result{Fields: qr.Fields}); err != nil {
+			return err
+		}
+		if err := callback(i, &sqltypes.Result{RowsAffected: qr.RowsAffected, InsertID: qr.InsertID, Rows: qr.Rows}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BeginExecute combines Begin and Execute.
 func (f *FakeQueryService) BeginExecute(ctx context.Context, target *querypb.Target, _ []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	transactionID, _, err := f.Begin(ctx, target, options)
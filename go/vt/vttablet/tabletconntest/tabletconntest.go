@@ -615,6 +615,58 @@ func testExecuteBatchPanics(t *testing.T, conn queryservice.QueryService, f *Fak
 	})
 }
 
+func testStreamExecuteBatch(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testStreamExecuteBatch")
+	f.ExpectedTransactionID = ExecuteBatchTransactionID
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	var qrl []sqltypes.Result
+	err := conn.StreamExecuteBatch(ctx, TestTarget, ExecuteBatchQueries, ExecuteBatchTransactionID, 0, TestExecuteOptions, func(queryIndex int, qr *sqltypes.Result) error {
+		if queryIndex == len(qrl) {
+			qrl = append(qrl, sqltypes.Result{})
+		}
+		if queryIndex != len(qrl)-1 {
+			t.Errorf("Unexpected query index from StreamExecuteBatch: got %v after %v", queryIndex, len(qrl)-1)
+			return nil
+		}
+		if qr.Fields != nil {
+			qrl[queryIndex].Fields = qr.Fields
+		}
+		qrl[queryIndex].RowsAffected += qr.RowsAffected
+		if qr.InsertID != 0 {
+			qrl[queryIndex].InsertID = qr.InsertID
+		}
+		qrl[queryIndex].Rows = append(qrl[queryIndex].Rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamExecuteBatch failed: %v", err)
+	}
+	if !sqltypes.ResultsEqual(qrl, ExecuteBatchQueryResultList) {
+		t.Errorf("Unexpected result from StreamExecuteBatch: got %v wanted %v", qrl, ExecuteBatchQueryResultList)
+	}
+}
+
+func testStreamExecuteBatchError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testStreamExecuteBatchError")
+	f.HasError = true
+	testErrorHelper(t, f, "StreamExecuteBatch", func(ctx context.Context) error {
+		return conn.StreamExecuteBatch(ctx, TestTarget, ExecuteBatchQueries, ExecuteBatchTransactionID, 0, TestExecuteOptions, func(int, *sqltypes.Result) error {
+			return nil
+		})
+	})
+	f.HasError = false
+}
+
+func testStreamExecuteBatchPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testStreamExecuteBatchPanics")
+	testPanicHelper(t, f, "StreamExecuteBatch", func(ctx context.Context) error {
+		return conn.StreamExecuteBatch(ctx, TestTarget, ExecuteBatchQueries, ExecuteBatchTransactionID, 0, TestExecuteOptions, func(int, *sqltypes.Result) error {
+			return nil
+		})
+	})
+}
+
 func testBeginExecuteBatch(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testBeginExecuteBatch")
 	f.ExpectedTransactionID = beginTransactionID
@@ -801,6 +853,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginExecute,
 		testStreamExecute,
 		testExecuteBatch,
+		testStreamExecuteBatch,
 		testBeginExecuteBatch,
 		testMessageStream,
 		testMessageAck,
@@ -822,6 +875,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginExecuteErrorInExecute,
 		testStreamExecuteError,
 		testExecuteBatchError,
+		testStreamExecuteBatchError,
 		testBeginExecuteBatchErrorInBegin,
 		testBeginExecuteBatchErrorInExecuteBatch,
 		testMessageStreamError,
@@ -843,6 +897,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginExecutePanics,
 		testStreamExecutePanics,
 		testExecuteBatchPanics,
+		testStreamExecuteBatchPanics,
 		testBeginExecuteBatchPanics,
 		testMessageStreamPanics,
 		testMessageAckPanics,
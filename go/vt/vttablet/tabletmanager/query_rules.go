@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	watchQueryRules           = flag.Bool("watch_query_rules", true, "watch the custom query rules of the keyspace in the topo, as managed by the ApplyQueryRule and DeleteQueryRule vtctld commands")
+	queryRulesWatchRetryDelay = flag.Duration("query_rules_watch_retry_delay", 10*time.Second, "delay between retries of the watch of the keyspace query rules in the topo")
+
+	statsQueryRulesVersion = stats.NewGauge("QueryRulesVersion", "Version of the keyspace query rules applied by the tablet")
+)
+
+// keyspaceQueryRules is the query rule source for the rules managed through
+// vtctld, and stored in the topo.
+const keyspaceQueryRules string = "KeyspaceQueryRules"
+
+func (tm *TabletManager) startQueryRulesWatch() {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	tm._queryRulesDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	tm._queryRulesCancel = cancel
+
+	go tm.queryRulesWatchLoop(ctx, tm._queryRulesDone)
+}
+
+func (tm *TabletManager) stopQueryRulesWatch() {
+	var doneChan <-chan struct{}
+
+	tm.mutex.Lock()
+	if tm._queryRulesCancel != nil {
+		tm._queryRulesCancel()
+	}
+	doneChan = tm._queryRulesDone
+	tm.mutex.Unlock()
+
+	// If the watch loop was running, wait for it to fully stop.
+	if doneChan != nil {
+		<-doneChan
+	}
+}
+
+// queryRulesWatchLoop keeps a watch on the query rules of the keyspace, and
+// applies them every time they change. If the keyspace has no query rules,
+// it polls until some are created.
+func (tm *TabletManager) queryRulesWatchLoop(ctx context.Context, doneChan chan<- struct{}) {
+	defer close(doneChan)
+
+	keyspace := tm.Tablet().Keyspace
+	for {
+		err := tm.watchQueryRulesOnce(ctx, keyspace)
+		if ctx.Err() != nil {
+			return
+		}
+		if topo.IsErrType(err, topo.NoNode) {
+			// There are no query rules, or they were deleted.
+			tm.applyQueryRules(keyspace, &topodatapb.QueryRules{})
+		} else {
+			log.Warningf("Watch of the query rules of keyspace %v failed: %v", keyspace, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*queryRulesWatchRetryDelay):
+		}
+	}
+}
+
+func (tm *TabletManager) watchQueryRulesOnce(ctx context.Context, keyspace string) error {
+	current, changes, cancel := tm.TopoServer.WatchQueryRules(ctx, keyspace)
+	if current.Err != nil {
+		return current.Err
+	}
+	defer func() {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range changes {
+		}
+	}()
+
+	tm.applyQueryRules(keyspace, current.Value)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case change, ok := <-changes:
+			if !ok {
+				return fmt.Errorf("watch terminated with no error")
+			}
+			if change.Err != nil {
+				return change.Err
+			}
+			tm.applyQueryRules(keyspace, change.Value)
+		}
+	}
+}
+
+// applyQueryRules merges the keyspace, shard and tablet rules that apply to
+// this tablet, and swaps them in all at once. If any of them is invalid,
+// the previous rules are kept.
+func (tm *TabletManager) applyQueryRules(keyspace string, qr *topodatapb.QueryRules) {
+	tablet := tm.Tablet()
+	qrs, err := mergeQueryRules(qr, tablet.Shard, topoproto.TabletAliasString(tablet.Alias))
+	if err != nil {
+		log.Errorf("Not applying version %v of the query rules of keyspace %v: %v", qr.Version, keyspace, err)
+		return
+	}
+	if err := tm.QueryServiceControl.SetQueryRules(keyspaceQueryRules, qrs); err != nil {
+		log.Errorf("Failed to apply version %v of the query rules of keyspace %v: %v", qr.Version, keyspace, err)
+		return
+	}
+	statsQueryRulesVersion.Set(qr.Version)
+	log.Infof("Applied version %v of the query rules of keyspace %v", qr.Version, keyspace)
+}
+
+// mergeQueryRules returns the rules of the keyspace, then the rules of the
+// shard, then the rules of the tablet.
+func mergeQueryRules(qr *topodatapb.QueryRules, shard, alias string) (*rules.Rules, error) {
+	qrs := rules.New()
+	for _, data := range []string{qr.KeyspaceRules, qr.ShardRules[shard], qr.TabletRules[alias]} {
+		if data == "" {
+			continue
+		}
+		scoped := rules.New()
+		if err := scoped.UnmarshalJSON([]byte(data)); err != nil {
+			return nil, err
+		}
+		qrs.Append(scoped)
+	}
+	return qrs, nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestMergeQueryRules(t *testing.T) {
+	qr := &topodatapb.QueryRules{
+		Version:       3,
+		KeyspaceRules: `[{"Name":"ks_rule","Action":"FAIL"}]`,
+		ShardRules: map[string]string{
+			"-80": `[{"Name":"shard_rule","Action":"FAIL_RETRY"}]`,
+			"80-": `[{"Name":"other_shard_rule","Action":"FAIL"}]`,
+		},
+		TabletRules: map[string]string{
+			"zone1-0000000100": `[{"Name":"tablet_rule","Action":"TIMEOUT","Timeout":"1s"}]`,
+		},
+	}
+
+	qrs, err := mergeQueryRules(qr, "-80", "zone1-0000000100")
+	require.NoError(t, err)
+	assert.NotNil(t, qrs.Find("ks_rule"))
+	assert.NotNil(t, qrs.Find("shard_rule"))
+	assert.NotNil(t, qrs.Find("tablet_rule"))
+	assert.Nil(t, qrs.Find("other_shard_rule"))
+
+	qrs, err = mergeQueryRules(qr, "80-", "zone1-0000000200")
+	require.NoError(t, err)
+	assert.NotNil(t, qrs.Find("ks_rule"))
+	assert.NotNil(t, qrs.Find("other_shard_rule"))
+	assert.Nil(t, qrs.Find("tablet_rule"))
+
+	qr.KeyspaceRules = `[{"Name":"bad","Action":"UNKNOWN"}]`
+	_, err = mergeQueryRules(qr, "-80", "zone1-0000000100")
+	assert.Error(t, err)
+}
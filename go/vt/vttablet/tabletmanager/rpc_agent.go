@@ -109,6 +109,23 @@ type RPCTM interface {
 	VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, id int, pos string) error
 
+	// 2PC related methods
+	GetUnresolvedTransactions(ctx context.Context, abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error)
+	ResolveTransaction(ctx context.Context, dtid string, commit, conclude bool) error
+	SetRollbackTransaction(ctx context.Context, dtid string) error
+
+	// Storage usage related methods
+	GetStorageUsage(ctx context.Context) (*tabletmanagerdatapb.GetStorageUsageResponse, error)
+
+	// Message related methods
+	GetDeadLetters(ctx context.Context, table string, limit int) (*querypb.QueryResult, error)
+	RequeueDeadLetters(ctx context.Context, table string, ids []string) (int64, error)
+	PurgeDeadLetters(ctx context.Context, table string, ids []string) (int64, error)
+
+	// Sequence related methods
+	GetSequences(ctx context.Context, tables []string) ([]*tabletmanagerdatapb.SequenceState, error)
+	ResetSequence(ctx context.Context, table string, nextID, cache int64) (*tabletmanagerdatapb.SequenceState, error)
+
 	// Reparenting related functions
 
 	ResetReplication(ctx context.Context) error
@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// GetDeadLetters returns at most limit messages of the message table which
+// were parked after their last attempt.
+func (tm *TabletManager) GetDeadLetters(ctx context.Context, table string, limit int) (*querypb.QueryResult, error) {
+	tablet := tm.Tablet()
+	target := &querypb.Target{Keyspace: tablet.Keyspace, Shard: tablet.Shard, TabletType: tablet.Type}
+	qr, err := tm.QueryServiceControl.GetDeadLetters(ctx, target, table, limit)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+// RequeueDeadLetters schedules the dead-lettered messages of the message
+// table to be sent again, or all of them if ids is empty.
+func (tm *TabletManager) RequeueDeadLetters(ctx context.Context, table string, ids []string) (int64, error) {
+	tablet := tm.Tablet()
+	target := &querypb.Target{Keyspace: tablet.Keyspace, Shard: tablet.Shard, TabletType: tablet.Type}
+	return tm.QueryServiceControl.RequeueDeadLetters(ctx, target, table, ids)
+}
+
+// PurgeDeadLetters deletes the dead-lettered messages of the message table,
+// or all of them if ids is empty.
+func (tm *TabletManager) PurgeDeadLetters(ctx context.Context, table string, ids []string) (int64, error) {
+	tablet := tm.Tablet()
+	target := &querypb.Target{Keyspace: tablet.Keyspace, Shard: tablet.Shard, TabletType: tablet.Type}
+	return tm.QueryServiceControl.PurgeDeadLetters(ctx, target, table, ids)
+}
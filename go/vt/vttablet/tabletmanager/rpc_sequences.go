@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// GetSequences returns the state of the sequence tables, or of all the
+// sequences of the tablet if tables is empty.
+func (tm *TabletManager) GetSequences(ctx context.Context, tables []string) ([]*tabletmanagerdatapb.SequenceState, error) {
+	tablet := tm.Tablet()
+	target := &querypb.Target{Keyspace: tablet.Keyspace, Shard: tablet.Shard, TabletType: tablet.Type}
+	states, err := tm.QueryServiceControl.GetSequences(ctx, target, tables)
+	if err != nil {
+		return nil, err
+	}
+	sequences := make([]*tabletmanagerdatapb.SequenceState, 0, len(states))
+	for _, state := range states {
+		sequences = append(sequences, sequenceStateToProto(state))
+	}
+	return sequences, nil
+}
+
+// ResetSequence sets the next ID and the cache size of a sequence, and drops
+// its cached values.
+func (tm *TabletManager) ResetSequence(ctx context.Context, table string, nextID, cache int64) (*tabletmanagerdatapb.SequenceState, error) {
+	tablet := tm.Tablet()
+	target := &querypb.Target{Keyspace: tablet.Keyspace, Shard: tablet.Shard, TabletType: tablet.Type}
+	state, err := tm.QueryServiceControl.ResetSequence(ctx, target, table, nextID, cache)
+	if err != nil {
+		return nil, err
+	}
+	return sequenceStateToProto(state), nil
+}
+
+func sequenceStateToProto(state *schema.SequenceState) *tabletmanagerdatapb.SequenceState {
+	return &tabletmanagerdatapb.SequenceState{
+		Name:            state.Name,
+		NextId:          state.NextID,
+		Cache:           state.Cache,
+		NextVal:         state.NextVal,
+		LastVal:         state.LastVal,
+		MaxValue:        state.MaxValue,
+		ExhaustionAlert: state.ExhaustionAlert,
+		StrictMonotonic: state.StrictMonotonic,
+	}
+}
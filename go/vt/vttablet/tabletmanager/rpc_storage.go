@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var (
+	storageUsageSampleInterval = flag.Duration("storage_usage_sample_interval", 1*time.Hour, "interval at which the size of the tables is sampled, to report the storage growth of the tablet. Zero disables the sampling")
+	storageUsageSampleCount    = flag.Int("storage_usage_sample_count", 168, "number of samples of the size of the tables kept by the tablet")
+)
+
+// storageUsageSamples is the history of the size of the tables of the
+// tablet, the oldest first.
+type storageUsageSamples struct {
+	mu      sync.Mutex
+	samples []*tabletmanagerdatapb.StorageUsageSample
+}
+
+// add adds a sample, and drops the oldest ones past max.
+func (s *storageUsageSamples) add(sample *tabletmanagerdatapb.StorageUsageSample, max int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.samples = append(s.samples, sample)
+	if n := len(s.samples) - max; n > 0 {
+		s.samples = append(s.samples[:0:0], s.samples[n:]...)
+	}
+}
+
+// get returns a copy of the samples.
+func (s *storageUsageSamples) get() []*tabletmanagerdatapb.StorageUsageSample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*tabletmanagerdatapb.StorageUsageSample(nil), s.samples...)
+}
+
+// GetStorageUsage returns the size of the tables of the tablet, its past
+// samples followed by the current one, and the size of the disk of mysqld.
+func (tm *TabletManager) GetStorageUsage(ctx context.Context) (*tabletmanagerdatapb.GetStorageUsageResponse, error) {
+	tables, err := tm.tableStorageUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tabletmanagerdatapb.GetStorageUsageResponse{
+		Tables:  tables,
+		Samples: append(tm.storageUsage.get(), newStorageUsageSample(tables)),
+	}
+	if tm.Cnf != nil {
+		var fs syscall.Statfs_t
+		if err := syscall.Statfs(tm.Cnf.DataDir, &fs); err != nil {
+			return nil, err
+		}
+		resp.DiskTotalBytes = uint64(fs.Blocks) * uint64(fs.Bsize)
+		resp.DiskFreeBytes = uint64(fs.Bavail) * uint64(fs.Bsize)
+	}
+	return resp, nil
+}
+
+// tableStorageUsage returns the size of the base tables of the database, as
+// reported by information_schema.
+func (tm *TabletManager) tableStorageUsage(ctx context.Context) ([]*tabletmanagerdatapb.TableStorageUsage, error) {
+	query := "SELECT table_name, data_length, index_length FROM information_schema.tables WHERE table_schema = " +
+		sqltypes.EncodeStringSQL(tm.DBConfigs.DBName) + " AND table_type = 'BASE TABLE' ORDER BY table_name"
+	qr, err := tm.MysqlDaemon.FetchSuperQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]*tabletmanagerdatapb.TableStorageUsage, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		table := &tabletmanagerdatapb.TableStorageUsage{Name: row[0].ToString()}
+		// The lengths are NULL for the tables whose engine does not report them.
+		if !row[1].IsNull() {
+			if table.DataLength, err = evalengine.ToUint64(row[1]); err != nil {
+				return nil, err
+			}
+		}
+		if !row[2].IsNull() {
+			if table.IndexLength, err = evalengine.ToUint64(row[2]); err != nil {
+				return nil, err
+			}
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+func newStorageUsageSample(tables []*tabletmanagerdatapb.TableStorageUsage) *tabletmanagerdatapb.StorageUsageSample {
+	sample := &tabletmanagerdatapb.StorageUsageSample{Time: time.Now().UnixNano()}
+	for _, table := range tables {
+		sample.UsedBytes += table.DataLength + table.IndexLength
+	}
+	return sample
+}
+
+func (tm *TabletManager) startStorageUsageSampling() {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	tm._storageUsageDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	tm._storageUsageCancel = cancel
+
+	go tm.storageUsageSampleLoop(ctx, tm._storageUsageDone)
+}
+
+func (tm *TabletManager) stopStorageUsageSampling() {
+	var doneChan <-chan struct{}
+
+	tm.mutex.Lock()
+	if tm._storageUsageCancel != nil {
+		tm._storageUsageCancel()
+	}
+	doneChan = tm._storageUsageDone
+	tm.mutex.Unlock()
+
+	// If the sample loop was running, wait for it to fully stop.
+	if doneChan != nil {
+		<-doneChan
+	}
+}
+
+// storageUsageSampleLoop samples the size of the tables every
+// storage_usage_sample_interval, until ctx is done.
+func (tm *TabletManager) storageUsageSampleLoop(ctx context.Context, doneChan chan<- struct{}) {
+	defer close(doneChan)
+
+	ticker := time.NewTicker(*storageUsageSampleInterval)
+	defer ticker.Stop()
+	for {
+		tables, err := tm.tableStorageUsage(ctx)
+		if err == nil {
+			tm.storageUsage.add(newStorageUsageSample(tables), *storageUsageSampleCount)
+		} else if ctx.Err() == nil {
+			log.Warningf("Failed to sample the storage usage of the tablet: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestTabletManager_GetStorageUsage(t *testing.T) {
+	ctx := context.Background()
+	cp := mysql.ConnParams{}
+	db := fakesqldb.New(t)
+	defer db.Close()
+	daemon := fakemysqldaemon.NewFakeMysqlDaemon(db)
+	daemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SELECT table_name, data_length, index_length FROM information_schema.tables WHERE table_schema = 'vt_ks' AND table_type = 'BASE TABLE' ORDER BY table_name": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("table_name|data_length|index_length", "varchar|uint64|uint64"),
+			"t1|16384|4096",
+			"t2|null|null",
+		),
+	}
+
+	tm := &TabletManager{
+		MysqlDaemon: daemon,
+		DBConfigs:   dbconfigs.NewTestDBConfigs(cp, cp, "vt_ks"),
+	}
+	tm.storageUsage.add(&tabletmanagerdatapb.StorageUsageSample{Time: 1, UsedBytes: 1000}, 2)
+
+	resp, err := tm.GetStorageUsage(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, []*tabletmanagerdatapb.TableStorageUsage{
+		{Name: "t1", DataLength: 16384, IndexLength: 4096},
+		{Name: "t2"},
+	}, resp.Tables)
+
+	// The current usage is the last sample.
+	require.Len(t, resp.Samples, 2)
+	assert.EqualValues(t, 1000, resp.Samples[0].UsedBytes)
+	assert.EqualValues(t, 20480, resp.Samples[1].UsedBytes)
+
+	// Without a mycnf, the size of the disk is unknown.
+	assert.Zero(t, resp.DiskTotalBytes)
+	assert.Zero(t, resp.DiskFreeBytes)
+}
+
+func TestStorageUsageSamples(t *testing.T) {
+	var samples storageUsageSamples
+	for i := int64(1); i <= 5; i++ {
+		samples.add(&tabletmanagerdatapb.StorageUsageSample{Time: i}, 3)
+	}
+
+	got := samples.get()
+	require.Len(t, got, 3)
+	for i, sample := range got {
+		assert.EqualValues(t, i+3, sample.Time)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// GetUnresolvedTransactions returns the distributed transactions the tablet
+// is the metadata manager of, and the transactions prepared on the tablet,
+// which are older than abandonAge.
+func (tm *TabletManager) GetUnresolvedTransactions(ctx context.Context, abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error) {
+	distributed, prepared, failed, err := tm.QueryServiceControl.ReadTwopcInflight(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	cutoff := time.Now().Add(-abandonAge)
+
+	var transactions []*querypb.TransactionMetadata
+	for _, dtx := range distributed {
+		if dtx.Created.After(cutoff) {
+			continue
+		}
+		metadata := &querypb.TransactionMetadata{
+			Dtid:        dtx.Dtid,
+			State:       querypb.TransactionState(querypb.TransactionState_value[dtx.State]),
+			TimeCreated: dtx.Created.UnixNano(),
+		}
+		for i := range dtx.Participants {
+			participant := &dtx.Participants[i]
+			metadata.Participants = append(metadata.Participants, &querypb.Target{
+				Keyspace:   participant.Keyspace,
+				Shard:      participant.Shard,
+				TabletType: participant.TabletType,
+			})
+		}
+		transactions = append(transactions, metadata)
+	}
+
+	var preparedTransactions []*tabletmanagerdatapb.PreparedTransaction
+	add := func(ptxs []*tx.PreparedTx, failed bool) {
+		for _, ptx := range ptxs {
+			if ptx.Time.After(cutoff) {
+				continue
+			}
+			preparedTransactions = append(preparedTransactions, &tabletmanagerdatapb.PreparedTransaction{
+				Dtid:        ptx.Dtid,
+				TimeCreated: ptx.Time.UnixNano(),
+				Queries:     ptx.Queries,
+				Failed:      failed,
+			})
+		}
+	}
+	add(prepared, false)
+	add(failed, true)
+	return transactions, preparedTransactions, nil
+}
+
+// ResolveTransaction commits or rolls back the transaction prepared on the
+// tablet for the distributed transaction. If conclude is set, the metadata of
+// the distributed transaction is then removed from the tablet, which must be
+// its metadata manager.
+func (tm *TabletManager) ResolveTransaction(ctx context.Context, dtid string, commit, conclude bool) error {
+	tablet := tm.Tablet()
+	target := &querypb.Target{
+		Keyspace:   tablet.Keyspace,
+		Shard:      tablet.Shard,
+		TabletType: tablet.Type,
+	}
+	qs := tm.QueryServiceControl.QueryService()
+	var err error
+	if commit {
+		err = qs.CommitPrepared(ctx, target, dtid)
+	} else {
+		err = qs.RollbackPrepared(ctx, target, dtid, 0)
+	}
+	if err != nil {
+		return err
+	}
+	if !conclude {
+		return nil
+	}
+	return qs.ConcludeTransaction(ctx, target, dtid)
+}
+
+// SetRollbackTransaction decides to roll back the distributed transaction,
+// which must be in state PREPARE, and of which the tablet must be the metadata
+// manager: it rolls back the part of the transaction on the tablet, and moves
+// the transaction to state ROLLBACK, so that it can no longer be committed.
+func (tm *TabletManager) SetRollbackTransaction(ctx context.Context, dtid string) error {
+	ss, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+	tablet := tm.Tablet()
+	target := &querypb.Target{
+		Keyspace:   tablet.Keyspace,
+		Shard:      tablet.Shard,
+		TabletType: tablet.Type,
+	}
+	return tm.QueryServiceControl.QueryService().SetRollback(ctx, target, dtid, ss.TransactionId)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+)
+
+var (
+	watchTableACL           = flag.Bool("watch_table_acl", true, "watch the table ACL of the keyspace in the topo, as managed by the ApplyTableACL and DeleteTableACL vtctld commands. It overrides the -table-acl-config file while it exists")
+	tableACLWatchRetryDelay = flag.Duration("table_acl_watch_retry_delay", 10*time.Second, "delay between retries of the watch of the keyspace table ACL in the topo")
+
+	statsTableACLFromTopo = stats.NewGauge("TableACLFromTopo", "Whether the table ACL of the keyspace in the topo is applied by the tablet, instead of the table ACL config file")
+)
+
+func (tm *TabletManager) startTableACLWatch() {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	tm._tableACLDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	tm._tableACLCancel = cancel
+
+	go tm.tableACLWatchLoop(ctx, tm._tableACLDone)
+}
+
+func (tm *TabletManager) stopTableACLWatch() {
+	var doneChan <-chan struct{}
+
+	tm.mutex.Lock()
+	if tm._tableACLCancel != nil {
+		tm._tableACLCancel()
+	}
+	doneChan = tm._tableACLDone
+	tm.mutex.Unlock()
+
+	// If the watch loop was running, wait for it to fully stop.
+	if doneChan != nil {
+		<-doneChan
+	}
+}
+
+// tableACLWatchLoop keeps a watch on the table ACL of the keyspace, and
+// applies it every time it changes. If the keyspace has no table ACL, the
+// table ACL of the config file is applied, and it polls until one is
+// created.
+func (tm *TabletManager) tableACLWatchLoop(ctx context.Context, doneChan chan<- struct{}) {
+	defer close(doneChan)
+
+	keyspace := tm.Tablet().Keyspace
+	for {
+		err := tm.watchTableACLOnce(ctx, keyspace)
+		if ctx.Err() != nil {
+			return
+		}
+		if topo.IsErrType(err, topo.NoNode) {
+			// There is no table ACL, or it was deleted.
+			tm.applyTableACL(keyspace, nil)
+		} else {
+			log.Warningf("Watch of the table ACL of keyspace %v failed: %v", keyspace, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*tableACLWatchRetryDelay):
+		}
+	}
+}
+
+func (tm *TabletManager) watchTableACLOnce(ctx context.Context, keyspace string) error {
+	current, changes, cancel := tm.TopoServer.WatchTableACL(ctx, keyspace)
+	if current.Err != nil {
+		return current.Err
+	}
+	defer func() {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range changes {
+		}
+	}()
+
+	tm.applyTableACL(keyspace, current.Value)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case change, ok := <-changes:
+			if !ok {
+				return fmt.Errorf("watch terminated with no error")
+			}
+			if change.Err != nil {
+				return change.Err
+			}
+			tm.applyTableACL(keyspace, change.Value)
+		}
+	}
+}
+
+// applyTableACL applies the table ACL of the keyspace, or the table ACL of
+// the config file if config is nil. If the table ACL is invalid, the
+// previous one is kept.
+func (tm *TabletManager) applyTableACL(keyspace string, config *tableaclpb.Config) {
+	if err := tm.QueryServiceControl.SetTableACL(config); err != nil {
+		log.Errorf("Failed to apply the table ACL of keyspace %v: %v", keyspace, err)
+		return
+	}
+	if config == nil {
+		statsTableACLFromTopo.Set(0)
+		return
+	}
+	statsTableACLFromTopo.Set(1)
+	log.Infof("Applied the table ACL of keyspace %v", keyspace)
+}
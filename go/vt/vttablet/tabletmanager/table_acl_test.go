@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
+
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+)
+
+func TestTableACLWatch(t *testing.T) {
+	defer func(delay time.Duration) { *tableACLWatchRetryDelay = delay }(*tableACLWatchRetryDelay)
+	*tableACLWatchRetryDelay = 10 * time.Millisecond
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+	qsc := tm.QueryServiceControl.(*tabletservermock.Controller)
+
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group1",
+			TableNamesOrPrefixes: []string{"t1"},
+			Readers:              []string{"reader"},
+		}},
+	}
+	require.NoError(t, ts.SaveTableACL(ctx, "ks", config))
+	waitForTableACL(t, qsc, func(got *tableaclpb.Config) bool { return got != nil })
+	assert.Equal(t, "group1", qsc.GetTableACL().TableGroups[0].Name)
+
+	config.TableGroups[0].Name = "group2"
+	require.NoError(t, ts.SaveTableACL(ctx, "ks", config))
+	waitForTableACL(t, qsc, func(got *tableaclpb.Config) bool {
+		return got != nil && got.TableGroups[0].Name == "group2"
+	})
+
+	require.NoError(t, ts.DeleteTableACL(ctx, "ks"))
+	waitForTableACL(t, qsc, func(got *tableaclpb.Config) bool { return got == nil })
+}
+
+func waitForTableACL(t *testing.T, qsc *tabletservermock.Controller, check func(*tableaclpb.Config) bool) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if check(qsc.GetTableACL()) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("table ACL was not applied, got: %v", qsc.GetTableACL())
+}
@@ -156,6 +156,10 @@ type TabletManager struct {
 	// first before other mutexes.
 	actionSema *sync2.Semaphore
 
+	// storageUsage are the samples of the size of the tables, taken by the
+	// storage usage sample goroutine.
+	storageUsage storageUsageSamples
+
 	// orc is an optional client for Orchestrator HTTP API calls.
 	// If this is nil, those calls will be skipped.
 	// It's only set once in NewTabletManager() and never modified after that.
@@ -187,6 +191,27 @@ type TabletManager struct {
 	// in progress
 	_rebuildKeyspaceCancel context.CancelFunc
 
+	// _queryRulesDone is a channel for waiting until the query rules watch
+	// goroutine has really finished after _queryRulesCancel was called.
+	_queryRulesDone chan struct{}
+
+	// _queryRulesCancel is the function to stop the query rules watch goroutine.
+	_queryRulesCancel context.CancelFunc
+
+	// _tableACLDone is a channel for waiting until the table ACL watch
+	// goroutine has really finished after _tableACLCancel was called.
+	_tableACLDone chan struct{}
+
+	// _tableACLCancel is the function to stop the table ACL watch goroutine.
+	_tableACLCancel context.CancelFunc
+
+	// _storageUsageDone is a channel for waiting until the storage usage
+	// sample goroutine has really finished after _storageUsageCancel was called.
+	_storageUsageDone chan struct{}
+
+	// _storageUsageCancel is the function to stop the storage usage sample goroutine.
+	_storageUsageCancel context.CancelFunc
+
 	// _lockTablesConnection is used to get and release the table read locks to pause replication
 	_lockTablesConnection *dbconnpool.DBConnection
 	_lockTablesTimer      *time.Timer
@@ -223,9 +248,9 @@ func BuildTabletFromInput(alias *topodatapb.TabletAlias, port, grpcPort int32, d
 		return nil, err
 	}
 	switch tabletType {
-	case topodatapb.TabletType_SPARE, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY:
+	case topodatapb.TabletType_SPARE, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_BACKUP_ONLY:
 	default:
-		return nil, fmt.Errorf("invalid init_tablet_type %v; can only be REPLICA, RDONLY or SPARE", tabletType)
+		return nil, fmt.Errorf("invalid init_tablet_type %v; can only be REPLICA, RDONLY, SPARE or BACKUP_ONLY", tabletType)
 	}
 
 	buildTags, err := getBuildTags(servenv.AppVersion.ToStringMap(), *skipBuildInfoTags)
@@ -364,6 +389,9 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		return vterrors.Wrap(err, "failed to InitDBConfig")
 	}
 	tm.QueryServiceControl.RegisterQueryRuleSource(denyListQueryList)
+	if *watchQueryRules {
+		tm.QueryServiceControl.RegisterQueryRuleSource(keyspaceQueryRules)
+	}
 
 	if tm.UpdateStream != nil {
 		tm.UpdateStream.InitDBConfig(tm.DBConfigs)
@@ -373,12 +401,22 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 
 	if tm.VREngine != nil {
 		tm.VREngine.InitDBConfig(tm.DBConfigs)
+		tm.VREngine.InitKeyspace(tablet.Keyspace)
 		servenv.OnTerm(tm.VREngine.Close)
 	}
 
 	// The following initializations don't need to be done
 	// in any specific order.
 	tm.startShardSync()
+	if *watchQueryRules {
+		tm.startQueryRulesWatch()
+	}
+	if *watchTableACL {
+		tm.startTableACLWatch()
+	}
+	if *storageUsageSampleInterval > 0 {
+		tm.startStorageUsageSampling()
+	}
 	tm.exportStats()
 	orc, err := newOrcClient()
 	if err != nil {
@@ -413,6 +451,9 @@ func (tm *TabletManager) Close() {
 	// running during lame duck.
 	tm.stopShardSync()
 	tm.stopRebuildKeyspace()
+	tm.stopQueryRulesWatch()
+	tm.stopTableACLWatch()
+	tm.stopStorageUsageSampling()
 
 	// cleanup initialized fields in the tablet entry
 	f := func(tablet *topodatapb.Tablet) error {
@@ -444,6 +485,9 @@ func (tm *TabletManager) Stop() {
 	// here in addition to in Close() because tests do not call Close().
 	tm.stopShardSync()
 	tm.stopRebuildKeyspace()
+	tm.stopQueryRulesWatch()
+	tm.stopTableACLWatch()
+	tm.stopStorageUsageSampling()
 
 	if tm.UpdateStream != nil {
 		tm.UpdateStream.Disable()
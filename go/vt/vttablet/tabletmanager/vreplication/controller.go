@@ -148,7 +148,11 @@ func (ct *controller) run(ctx context.Context) {
 		close(ct.done)
 	}()
 
+	// retries counts the consecutive failures of the stream, and
+	// persistentRetries the ones which were not transient.
+	retries, persistentRetries := 0, 0
 	for {
+		started := time.Now()
 		err := ct.runBlp(ctx)
 		if err == nil {
 			return
@@ -160,9 +164,28 @@ func (ct *controller) run(ctx context.Context) {
 			return
 		default:
 		}
-		binlogplayer.LogError(fmt.Sprintf("error in stream %v, retrying after %v", ct.id, *retryDelay), err)
 		ct.blpStats.ErrorCounts.Add([]string{"Stream Error"}, 1)
-		timer := time.NewTimer(*retryDelay)
+
+		// A stream which ran for longer than the maximum delay before it
+		// failed again had recovered from its previous failures.
+		if time.Since(started) > *maxRetryDelay {
+			retries, persistentRetries = 0, 0
+		}
+		retries++
+		class := classifyError(err)
+		if class == retryPersistent {
+			persistentRetries++
+			if *maxRetries > 0 && persistentRetries > *maxRetries {
+				binlogplayer.LogError(fmt.Sprintf("error in stream %v, stopping after %d retries", ct.id, *maxRetries), err)
+				ct.setErrorState(fmt.Sprintf("Stopped after %d retries: %v", *maxRetries, err))
+				return
+			}
+		}
+
+		delay := retryBackoff(retries)
+		binlogplayer.LogError(fmt.Sprintf("error in stream %v, retrying after %v", ct.id, delay), err)
+		ct.blpStats.RetryCounts.Add(class, 1)
+		timer := time.NewTimer(delay)
 		select {
 		case <-ctx.Done():
 			log.Warningf("context canceled: %s", err.Error())
@@ -267,7 +290,7 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		}
 		defer vsClient.Close(ctx)
 
-		vr := newVReplicator(ct.id, ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
+		vr := newVReplicator(ct.id, ct.workflow, ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
 		return vr.Replicate(ctx)
 	}
 	ct.blpStats.ErrorCounts.Add([]string{"Invalid Source"}, 1)
@@ -285,6 +308,34 @@ func (ct *controller) setMessage(dbClient binlogplayer.DBClient, message string)
 	}
 	return nil
 }
+
+// setErrorState puts the stream in the Error state, in which it stays until
+// it is restarted.
+func (ct *controller) setErrorState(message string) {
+	ct.blpStats.State.Set(binlogplayer.BlpError)
+	ct.blpStats.History.Add(&binlogplayer.StatsHistoryRecord{
+		Time:    time.Now(),
+		Message: message,
+	})
+
+	dbClient := ct.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("stream %v: can't connect to database to set the error state: %v", ct.id, err)
+		return
+	}
+	defer dbClient.Close()
+
+	message = binlogplayer.MessageTruncate(message)
+	query := fmt.Sprintf("update _vt.vreplication set state='%v', message=%v where id=%v", binlogplayer.BlpError, encodeString(message), ct.id)
+	if _, err := dbClient.ExecuteFetch(query, 1); err != nil {
+		log.Errorf("stream %v: could not set the error state: %v: %v", ct.id, query, err)
+		return
+	}
+	if err := insertLog(newVDBClient(dbClient, ct.blpStats), LogError, ct.id, binlogplayer.BlpError, message); err != nil {
+		log.Errorf("stream %v: %v", ct.id, err)
+	}
+}
+
 func (ct *controller) Stop() {
 	ct.cancel()
 	<-ct.done
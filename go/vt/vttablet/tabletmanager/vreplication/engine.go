@@ -61,6 +61,12 @@ const (
   table_name varbinary(128),
   lastpk varbinary(2000),
   primary key (vrepl_id, table_name))`
+
+	createTenantImportRows = `create table if not exists _vt.tenant_import_rows (
+  vrepl_id int,
+  table_name varbinary(128),
+  pk varbinary(3072),
+  primary key (vrepl_id, table_name, pk))`
 )
 
 var withDDL *withddl.WithDDL
@@ -73,7 +79,7 @@ const (
 func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
-	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, createReshardingJournalTable, createCopyState, createTenantImportRows)
 	allddls = append(allddls, createVReplicationLogTable)
 	withDDL = withddl.New(allddls)
 
@@ -120,11 +126,16 @@ type Engine struct {
 	dbClientFactoryFiltered func() binlogplayer.DBClient
 	dbClientFactoryDba      func() binlogplayer.DBClient
 	dbName                  string
+	keyspace                string
 
 	journaler map[string]*journalEvent
 	ec        *externalConnector
 
-	throttlerClient *throttle.Client
+	lagThrottler *throttle.Throttler
+
+	// copySlots limits the number of streams copying at the same time, if
+	// -vreplication_max_concurrent_copies is set.
+	copySlots *sync2.Semaphore
 }
 
 type journalEvent struct {
@@ -137,13 +148,14 @@ type journalEvent struct {
 // A nil ts means that the Engine is disabled.
 func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, lagThrottler *throttle.Throttler) *Engine {
 	vre := &Engine{
-		controllers:     make(map[int]*controller),
-		ts:              ts,
-		cell:            cell,
-		mysqld:          mysqld,
-		journaler:       make(map[string]*journalEvent),
-		ec:              newExternalConnector(config.ExternalConnections),
-		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
+		controllers:  make(map[int]*controller),
+		ts:           ts,
+		cell:         cell,
+		mysqld:       mysqld,
+		journaler:    make(map[string]*journalEvent),
+		ec:           newExternalConnector(config.ExternalConnections),
+		lagThrottler: lagThrottler,
+		copySlots:    newCopySlots(),
 	}
 
 	return vre
@@ -164,6 +176,13 @@ func (vre *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
 	vre.dbName = dbcfgs.DBName
 }
 
+// InitKeyspace should be invoked after the keyspace of the tablet is known.
+// The streams into the non-production keyspaces of the masking policy mask
+// the values they copy.
+func (vre *Engine) InitKeyspace(keyspace string) {
+	vre.keyspace = keyspace
+}
+
 // NewTestEngine creates a new Engine for testing.
 func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, dbClientFactoryFiltered func() binlogplayer.DBClient, dbClientFactoryDba func() binlogplayer.DBClient, dbname string, externalConfig map[string]*dbconfigs.DBConfigs) *Engine {
 	vre := &Engine{
@@ -176,10 +195,34 @@ func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, db
 		dbName:                  dbname,
 		journaler:               make(map[string]*journalEvent),
 		ec:                      newExternalConnector(externalConfig),
+		copySlots:               newCopySlots(),
 	}
 	return vre
 }
 
+func newCopySlots() *sync2.Semaphore {
+	if *maxConcurrentCopies <= 0 {
+		return nil
+	}
+	return sync2.NewSemaphore(*maxConcurrentCopies, 0)
+}
+
+// acquireCopySlot waits until the stream can copy, if the number of streams
+// copying at the same time is limited. The streams release their slot at the
+// end of every copy phase loop, and the streams waiting for a slot get it in
+// turn, so that the streams of a large workflow don't delay the others until
+// they're copied. It returns the function releasing the slot, or false if the
+// context was canceled first.
+func (vre *Engine) acquireCopySlot(ctx context.Context) (func(), bool) {
+	if vre.copySlots == nil {
+		return func() {}, true
+	}
+	if !vre.copySlots.AcquireContext(ctx) {
+		return nil, false
+	}
+	return vre.copySlots.Release, true
+}
+
 // Open starts the Engine service.
 func (vre *Engine) Open(ctx context.Context) {
 	vre.mu.Lock()
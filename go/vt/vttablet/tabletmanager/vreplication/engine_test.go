@@ -564,3 +564,26 @@ func TestGetDBClient(t *testing.T) {
 	shouldBeFilteredClient := vre.getDBClient(false /*runAsAdmin*/)
 	assert.Equal(t, shouldBeFilteredClient, dbClientFiltered)
 }
+
+func TestCopySlots(t *testing.T) {
+	vre := NewTestEngine(nil, "cell", nil, nil, nil, "db", nil)
+	release, ok := vre.acquireCopySlot(context.Background())
+	require.True(t, ok)
+	release()
+
+	defer func(max int) { *maxConcurrentCopies = max }(*maxConcurrentCopies)
+	*maxConcurrentCopies = 1
+	vre = NewTestEngine(nil, "cell", nil, nil, nil, "db", nil)
+	release, ok = vre.acquireCopySlot(context.Background())
+	require.True(t, ok)
+
+	// The next stream waits until the slot is released.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, ok = vre.acquireCopySlot(ctx)
+	assert.False(t, ok)
+	release()
+	release, ok = vre.acquireCopySlot(context.Background())
+	require.True(t, ok)
+	release()
+}
@@ -86,6 +86,9 @@ func (ec *externalConnector) Get(name string) (*mysqlConnector, error) {
 	if config.DB == nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "external mysqlConnector %v not found", name)
 	}
+	if err := config.DB.ValidateTLS(); err != nil {
+		return nil, vterrors.Wrapf(err, "external mysqlConnector: %v", name)
+	}
 	c := &mysqlConnector{}
 	c.env = tabletenv.NewEnv(config, name)
 	c.se = schema.NewEngine(c.env)
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/proto"
@@ -29,6 +30,7 @@ import (
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/masking"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -55,7 +57,10 @@ type ReplicatorPlan struct {
 	TargetTables  map[string]*TablePlan
 	TablePlans    map[string]*TablePlan
 	ColInfoMap    map[string][]*ColumnInfo
-	stats         *binlogplayer.Stats
+	// VReplID is the id of the stream, which records the rows inserted by
+	// a tenant import.
+	VReplID uint32
+	stats   *binlogplayer.Stats
 }
 
 // buildExecution plan uses the field info as input and the partially built
@@ -79,26 +84,31 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 			trimmed.Name = strings.Trim(trimmed.Name, "`")
 			tplanv.Fields = append(tplanv.Fields, trimmed)
 		}
+		tplanv.VReplID = rp.VReplID
 		return &tplanv, nil
 	}
 	// select * construct was used. We need to use the field names.
-	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, fieldEvent.Fields)
+	tplan, err := rp.buildFromFields(prelim, fieldEvent.Fields)
 	if err != nil {
 		return nil, err
 	}
 	tplan.Fields = fieldEvent.Fields
+	tplan.VReplID = rp.VReplID
 	return tplan, nil
 }
 
 // buildFromFields builds a full TablePlan, but uses the field info as the
 // full column list. This happens when the query used was a 'select *', which
 // requires us to wait for the field info sent by the source.
-func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, fields []*querypb.Field) (*TablePlan, error) {
+func (rp *ReplicatorPlan) buildFromFields(prelim *TablePlan, fields []*querypb.Field) (*TablePlan, error) {
+	tableName := prelim.TargetName
 	tpb := &tablePlanBuilder{
-		name:     sqlparser.NewTableIdent(tableName),
-		lastpk:   lastpk,
-		colInfos: rp.ColInfoMap[tableName],
-		stats:    rp.stats,
+		name:         sqlparser.NewTableIdent(tableName),
+		onConflict:   prelim.OnConflict,
+		tenantColumn: prelim.TenantColumn,
+		lastpk:       prelim.Lastpk,
+		colInfos:     rp.ColInfoMap[tableName],
+		stats:        rp.stats,
 	}
 	for _, field := range fields {
 		colName := sqlparser.NewColIdent(field.Name)
@@ -131,7 +141,15 @@ func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Res
 	if err := tpb.analyzePK(rp.ColInfoMap[tableName]); err != nil {
 		return nil, err
 	}
-	return tpb.generate(), nil
+	if err := tpb.analyzeTenantColumn(); err != nil {
+		return nil, err
+	}
+	tablePlan := tpb.generate()
+	var err error
+	if tablePlan.Masks, err = tpb.generateMasks(tablePlan.PKReferences); err != nil {
+		return nil, err
+	}
+	return tablePlan, nil
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -201,6 +219,23 @@ type TablePlan struct {
 	FieldsToSkip            map[string]bool
 	ConvertCharset          map[string](*binlogdatapb.CharsetConversion)
 	HasExtraSourcePkColumns bool
+	// Masks are the masks of the fields copied into the masked columns of
+	// the masking policy.
+	Masks map[string]*masking.Mask
+	// OnConflict is the action taken when an inserted row conflicts with
+	// an existing row.
+	OnConflict binlogdatapb.Rule_OnConflict
+	// TenantColumn, TenantRow, TenantConflict and TenantRowKey are set for
+	// the tables of a tenant import. TenantRow matches the target row of an
+	// inserted row by its primary key, and TenantConflict only matches it if
+	// it belongs to another tenant. TenantRowKey computes the key of a
+	// target row in _vt.tenant_import_rows, which records the rows inserted
+	// by the stream VReplID.
+	TenantColumn   string
+	TenantRow      *sqlparser.ParsedQuery
+	TenantConflict *sqlparser.ParsedQuery
+	TenantRowKey   string
+	VReplID        uint32
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -234,18 +269,135 @@ func (tp *TablePlan) applyBulkInsert(sqlbuffer *bytes2.Buffer, rows *binlogdatap
 	sqlbuffer.WriteString(tp.BulkInsertFront.Query)
 	sqlbuffer.WriteString(" values ")
 
+	var tenantRows []map[string]*querypb.BindVariable
 	for i, row := range rows.Rows {
 		if i > 0 {
 			sqlbuffer.WriteString(", ")
 		}
+		if len(tp.Masks) > 0 {
+			var err error
+			if row, err = tp.maskRow(row); err != nil {
+				return nil, err
+			}
+		}
 		if err := tp.BulkInsertValues.AppendFromRow(sqlbuffer, tp.Fields, row, tp.FieldsToSkip); err != nil {
 			return nil, err
 		}
+		if tp.TenantRow != nil {
+			bindvars, err := tp.rowBindVars(row, "a_")
+			if err != nil {
+				return nil, err
+			}
+			tenantRows = append(tenantRows, bindvars)
+		}
 	}
 	if tp.BulkInsertOnDup != nil {
 		sqlbuffer.WriteString(tp.BulkInsertOnDup.Query)
 	}
-	return executor(sqlbuffer.StringUnsafe())
+	return tp.applyTenantInsert(tenantRows, executor, func() (*sqltypes.Result, error) {
+		return executor(sqlbuffer.StringUnsafe())
+	})
+}
+
+// applyTenantInsert runs insert, which inserts the rows of a tenant import
+// with the bind vars of rows. Unless conflicts fail the insert, it fails if
+// one of the rows conflicts with the row of another tenant, which would be
+// kept or overwritten. It then records the primary keys of the rows which
+// didn't exist before in _vt.tenant_import_rows, for the rows inserted by the
+// import to be deleted if it is cancelled.
+func (tp *TablePlan) applyTenantInsert(rows []map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error), insert func() (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if tp.TenantRow == nil {
+		return insert()
+	}
+	table := sqlparser.NewTableIdent(tp.TargetName)
+	match, err := tenantRowsCondition(tp.TenantRow, rows)
+	if err != nil {
+		return nil, err
+	}
+	var existing []string
+	if tp.OnConflict != binlogdatapb.Rule_FAIL {
+		conflict, err := tenantRowsCondition(tp.TenantConflict, rows)
+		if err != nil {
+			return nil, err
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select 1 from %v where %s limit 1", table, conflict)
+		qr, err := executor(buf.String())
+		if err != nil {
+			return nil, err
+		}
+		if len(qr.Rows) != 0 {
+			return nil, fmt.Errorf("rows inserted in table %s conflict with rows of another tenant", tp.TargetName)
+		}
+		buf = sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select %s from %v where %s", tp.TenantRowKey, table, match)
+		if qr, err = executor(buf.String()); err != nil {
+			return nil, err
+		}
+		for _, row := range qr.Rows {
+			existing = append(existing, encodeString(row[0].ToString()))
+		}
+	}
+	qr, err := insert()
+	if err != nil {
+		return nil, err
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert ignore into _vt.tenant_import_rows(vrepl_id, table_name, pk) select %s, %s, %s from %v where %s",
+		strconv.Itoa(int(tp.VReplID)), encodeString(tp.TargetName), tp.TenantRowKey, table, match)
+	if len(existing) != 0 {
+		buf.Myprintf(" and %s not in (%s)", tp.TenantRowKey, strings.Join(existing, ", "))
+	}
+	if _, err := executor(buf.String()); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// tenantRowsCondition returns the condition matching any of rows with the
+// condition pq matching one row.
+func tenantRowsCondition(pq *sqlparser.ParsedQuery, rows []map[string]*querypb.BindVariable) (string, error) {
+	conds := make([]string, 0, len(rows))
+	for _, bindvars := range rows {
+		cond, err := pq.GenerateQuery(bindvars, nil)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, cond)
+	}
+	return "(" + strings.Join(conds, " or ") + ")", nil
+}
+
+// rowBindVars returns the bind vars of the values of row, named after the
+// fields with prefix.
+func (tp *TablePlan) rowBindVars(row *querypb.Row, prefix string) (map[string]*querypb.BindVariable, error) {
+	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
+	vals := sqltypes.MakeRowTrusted(tp.Fields, row)
+	for i, field := range tp.Fields {
+		bindVar, err := tp.bindFieldVal(field, &vals[i])
+		if err != nil {
+			return nil, err
+		}
+		bindvars[prefix+field.Name] = bindVar
+	}
+	return bindvars, nil
+}
+
+// maskRow returns the row with the values of the masked fields masked.
+func (tp *TablePlan) maskRow(row *querypb.Row) (*querypb.Row, error) {
+	vals := sqltypes.MakeRowTrusted(tp.Fields, row)
+	for i, field := range tp.Fields {
+		mask, ok := tp.Masks[field.Name]
+		if !ok {
+			continue
+		}
+		masked, err := mask.Apply(vals[i])
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot mask field %s", field.Name)
+		}
+		vals[i] = masked
+	}
+	return sqltypes.RowToProto3(vals), nil
 }
 
 // During the copy phase we run catchup and fastforward, which stream binlogs. While streaming we should only process
@@ -295,10 +447,18 @@ func (tp *TablePlan) isOutsidePKRange(bindvars map[string]*querypb.BindVariable,
 
 // bindFieldVal returns a bind variable based on given field and value.
 // Most values will just bind directly. But some values may need manipulation:
+// - masked values
 // - text values with charset conversion
 // - enum values converted to text via Online DDL
 // - ...any other future possible values
 func (tp *TablePlan) bindFieldVal(field *querypb.Field, val *sqltypes.Value) (*querypb.BindVariable, error) {
+	if mask, ok := tp.Masks[field.Name]; ok {
+		masked, err := mask.Apply(*val)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot mask field %s", field.Name)
+		}
+		val = &masked
+	}
 	if conversion, ok := tp.ConvertCharset[field.Name]; ok && !val.IsNull() {
 		// Non-null string value, for which we have a charset conversion instruction
 		valString := val.ToString()
@@ -364,7 +524,7 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 		if tp.isOutsidePKRange(bindvars, before, after, "insert") {
 			return nil, nil
 		}
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		return tp.applyInsert(bindvars, executor)
 	case before && !after:
 		if tp.Delete == nil {
 			return nil, nil
@@ -382,12 +542,18 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 		if tp.isOutsidePKRange(bindvars, before, after, "insert") {
 			return nil, nil
 		}
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		return tp.applyInsert(bindvars, executor)
 	}
 	// Unreachable.
 	return nil, nil
 }
 
+func (tp *TablePlan) applyInsert(bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	return tp.applyTenantInsert([]map[string]*querypb.BindVariable{bindvars}, executor, func() (*sqltypes.Result, error) {
+		return execParsedQuery(tp.Insert, bindvars, executor)
+	})
+}
+
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	sql, err := pq.GenerateQuery(bindvars, nil)
 	if err != nil {
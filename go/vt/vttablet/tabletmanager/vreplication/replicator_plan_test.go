@@ -18,15 +18,20 @@ package vreplication
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
+	"vitess.io/vitess/go/bytes2"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/masking"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type TestReplicatorPlan struct {
@@ -768,3 +773,269 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestBuildPlayerPlanMasks(t *testing.T) {
+	mask := masking.NewMask(binlogdatapb.MaskingTransform_NULLIFY, nil)
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			&ColumnInfo{Name: "id", IsPK: true},
+			&ColumnInfo{Name: "mail", Mask: mask},
+			&ColumnInfo{Name: "label"},
+		},
+	}
+	fields := []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64},
+		{Name: "email", Type: sqltypes.VarChar},
+		{Name: "name", Type: sqltypes.VarChar},
+	}
+
+	plan, err := buildReplicatorPlan(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, email as mail, name as label from t",
+		}},
+	}, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*masking.Mask{"email": mask}, plan.TargetTables["t1"].Masks)
+
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t", Fields: fields})
+	require.NoError(t, err)
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{}, nil
+	}
+	row := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a@b.c"), sqltypes.NewVarChar("joe")})
+	_, err = tp.applyChange(&binlogdatapb.RowChange{After: row}, executor)
+	require.NoError(t, err)
+	_, err = tp.applyBulkInsert(&bytes2.Buffer{}, &binlogdatapb.VStreamRowsResponse{Fields: fields, Rows: []*querypb.Row{row}}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"insert into t1(id,mail,label) values (1,null,'joe')",
+		"insert into t1(id,mail,label) values (1,null,'joe')",
+	}, queries)
+
+	// select * builds the masks when it gets the fields.
+	colInfoMap["t"] = []*ColumnInfo{
+		{Name: "id", IsPK: true},
+		{Name: "email", Mask: mask},
+		{Name: "name"},
+	}
+	plan, err = buildReplicatorPlan(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t"}},
+	}, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	assert.Nil(t, plan.TargetTables["t"].Masks)
+	tp, err = plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t", Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*masking.Mask{"email": mask}, tp.Masks)
+
+	tcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id, concat(email, name) as mail, name as label from t",
+		err:    "masked column mail of table t1 is not copied from a source column",
+	}, {
+		filter: "select id, count(*) as mail, name as label from t group by id",
+		err:    "masked column mail of table t1 is not copied from a source column",
+	}, {
+		filter: "select id, id as mail, name as label from t",
+		err:    "masked column mail of table t1 is referenced by the primary key",
+	}}
+	delete(colInfoMap, "t")
+	for _, tcase := range tcases {
+		_, err := buildReplicatorPlan(&binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+		}, colInfoMap, nil, binlogplayer.NewStats())
+		assert.EqualError(t, err, tcase.err, tcase.filter)
+	}
+}
+
+func TestBuildPlayerPlanMinMax(t *testing.T) {
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			&ColumnInfo{Name: "g", IsPK: true},
+			&ColumnInfo{Name: "mn"},
+			&ColumnInfo{Name: "mx"},
+			&ColumnInfo{Name: "cnt"},
+		},
+	}
+	fields := []*querypb.Field{
+		{Name: "g", Type: sqltypes.Int64},
+		{Name: "a", Type: sqltypes.Int64},
+		{Name: "b", Type: sqltypes.Int64},
+	}
+	rule := &binlogdatapb.Rule{
+		Match:  "t1",
+		Filter: "select g, min(a) as mn, max(b) as mx, count(*) as cnt from t group by g",
+	}
+	_, err := buildReplicatorPlan(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{rule}}, colInfoMap, nil, binlogplayer.NewStats())
+	assert.EqualError(t, err, "min is only supported for append-only tables: min(a)")
+
+	rule.AppendOnly = true
+	plan, err := buildReplicatorPlan(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{rule}}, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t", Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, "insert into t1(g,mn,mx,cnt) values (:a_g,:a_a,:a_b,1) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), cnt=cnt+1", tp.Insert.Query)
+	assert.Equal(t, "update t1 set mn=least(ifnull(mn, :a_a), ifnull(:a_a, mn)), mx=greatest(ifnull(mx, :a_b), ifnull(:a_b, mx)), cnt=cnt where g=:b_g", tp.Update.Query)
+	assert.Equal(t, "update t1 set mn=mn, mx=mx, cnt=cnt-1 where g=:b_g", tp.Delete.Query)
+}
+
+func TestBuildPlayerPlanOnConflict(t *testing.T) {
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			&ColumnInfo{Name: "id", IsPK: true},
+			&ColumnInfo{Name: "val"},
+		},
+		"t2": {
+			&ColumnInfo{Name: "id", IsPK: true},
+		},
+	}
+	fields := []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64},
+		{Name: "val", Type: sqltypes.VarChar},
+	}
+	tcases := []struct {
+		onConflict binlogdatapb.Rule_OnConflict
+		filter     string
+		insert     string
+		bulkInsert string
+	}{{
+		onConflict: binlogdatapb.Rule_FAIL,
+		insert:     "insert into t1(id,val) values (:a_id,:a_val)",
+		bulkInsert: "insert into t1(id,val) values (1,'a')",
+	}, {
+		onConflict: binlogdatapb.Rule_IGNORE,
+		insert:     "insert ignore into t1(id,val) values (:a_id,:a_val)",
+		bulkInsert: "insert ignore into t1(id,val) values (1,'a')",
+	}, {
+		onConflict: binlogdatapb.Rule_REPLACE,
+		insert:     "insert into t1(id,val) values (:a_id,:a_val) on duplicate key update val=values(val)",
+		bulkInsert: "insert into t1(id,val) values (1,'a') on duplicate key update val=values(val)",
+	}, {
+		onConflict: binlogdatapb.Rule_REPLACE,
+		filter:     "select id, val from t",
+		insert:     "insert into t1(id,val) values (:a_id,:a_val) on duplicate key update val=values(val)",
+		bulkInsert: "insert into t1(id,val) values (1,'a') on duplicate key update val=values(val)",
+	}}
+	for _, tcase := range tcases {
+		t.Run(fmt.Sprintf("%v %s", tcase.onConflict, tcase.filter), func(t *testing.T) {
+			plan, err := buildReplicatorPlan(&binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter, OnConflict: tcase.onConflict}},
+			}, colInfoMap, nil, binlogplayer.NewStats())
+			require.NoError(t, err)
+			tableName := "t1"
+			if tcase.filter != "" {
+				tableName = "t"
+			}
+			tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: tableName, Fields: fields})
+			require.NoError(t, err)
+			assert.Equal(t, tcase.insert, tp.Insert.Query)
+			assert.Equal(t, "update t1 set val=:a_val where id=:b_id", tp.Update.Query)
+
+			var queries []string
+			executor := func(query string) (*sqltypes.Result, error) {
+				queries = append(queries, query)
+				return &sqltypes.Result{}, nil
+			}
+			row := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")})
+			_, err = tp.applyBulkInsert(&bytes2.Buffer{}, &binlogdatapb.VStreamRowsResponse{Fields: fields, Rows: []*querypb.Row{row}}, executor)
+			require.NoError(t, err)
+			assert.Equal(t, []string{tcase.bulkInsert}, queries)
+		})
+	}
+
+	// A table with only pk columns has no columns to overwrite.
+	plan, err := buildReplicatorPlan(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t2", OnConflict: binlogdatapb.Rule_REPLACE}},
+	}, colInfoMap, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t2", Fields: fields[:1]})
+	require.NoError(t, err)
+	assert.Equal(t, "insert ignore into t2(id) values (:a_id)", tp.Insert.Query)
+}
+
+func TestBuildPlayerPlanTenant(t *testing.T) {
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			&ColumnInfo{Name: "id", IsPK: true},
+			&ColumnInfo{Name: "tenant_id"},
+			&ColumnInfo{Name: "val"},
+		},
+	}
+	fields := []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64},
+		{Name: "tenant_id", Type: sqltypes.Int64},
+		{Name: "val", Type: sqltypes.VarChar},
+	}
+	buildPlan := func(onConflict binlogdatapb.Rule_OnConflict) *TablePlan {
+		plan, err := buildReplicatorPlan(&binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:        "t1",
+				Filter:       "select * from t1 where tenant_id = 1",
+				OnConflict:   onConflict,
+				TenantColumn: "tenant_id",
+			}},
+		}, colInfoMap, nil, binlogplayer.NewStats())
+		require.NoError(t, err)
+		plan.VReplID = 5
+		tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+		require.NoError(t, err)
+		return tp
+	}
+	rows := &binlogdatapb.VStreamRowsResponse{Fields: fields, Rows: []*querypb.Row{
+		sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}),
+		sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(1), sqltypes.NewVarChar("b")}),
+	}}
+
+	// Updates and deletes don't change the rows of other tenants, which are
+	// not overwritten by conflicting rows either.
+	tp := buildPlan(binlogdatapb.Rule_REPLACE)
+	assert.Equal(t, "insert into t1(id,tenant_id,val) values (:a_id,:a_tenant_id,:a_val) on duplicate key update val=values(val)", tp.Insert.Query)
+	assert.Equal(t, "update t1 set tenant_id=:a_tenant_id, val=:a_val where id=:b_id and tenant_id=:b_tenant_id", tp.Update.Query)
+	assert.Equal(t, "delete from t1 where id=:b_id and tenant_id=:b_tenant_id", tp.Delete.Query)
+
+	// The rows which existed before the insert are not recorded.
+	tp = buildPlan(binlogdatapb.Rule_IGNORE)
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		if strings.HasPrefix(query, "select convert") {
+			return sqltypes.MakeTestResult(sqltypes.MakeTestFields("pk", "varchar"), "[2]"), nil
+		}
+		return &sqltypes.Result{}, nil
+	}
+	_, err := tp.applyBulkInsert(&bytes2.Buffer{}, rows, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"select 1 from t1 where ((id=1 and not tenant_id<=>1) or (id=2 and not tenant_id<=>1)) limit 1",
+		"select convert(json_array(t1.id) using utf8mb4) from t1 where ((id=1) or (id=2))",
+		"insert ignore into t1(id,tenant_id,val) values (1,1,'a'), (2,1,'b')",
+		"insert ignore into _vt.tenant_import_rows(vrepl_id, table_name, pk) select 5, 't1', convert(json_array(t1.id) using utf8mb4) from t1 where ((id=1) or (id=2)) and convert(json_array(t1.id) using utf8mb4) not in ('[2]')",
+	}, queries)
+
+	// A conflict with a row of another tenant fails the insert.
+	executor = func(query string) (*sqltypes.Result, error) {
+		if strings.HasPrefix(query, "select 1") {
+			return sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"), nil
+		}
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	_, err = tp.applyChange(&binlogdatapb.RowChange{After: rows.Rows[0]}, executor)
+	assert.EqualError(t, err, "rows inserted in table t1 conflict with rows of another tenant")
+
+	// Without conflicts to ignore, all the inserted rows are recorded.
+	tp = buildPlan(binlogdatapb.Rule_FAIL)
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{After: rows.Rows[0]}, func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"insert into t1(id,tenant_id,val) values (1,1,'a')",
+		"insert ignore into _vt.tenant_import_rows(vrepl_id, table_name, pk) select 5, 't1', convert(json_array(t1.id) using utf8mb4) from t1 where ((id=1))",
+	}, queries)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"flag"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	maxRetryDelay = flag.Duration("vreplication_max_retry_delay", 5*time.Minute, "maximum delay before retrying a failed stream; the delay doubles from -vreplication_retry_delay with each consecutive failure")
+	maxRetries    = flag.Int("vreplication_max_retries", 0, "number of consecutive retries of a stream failing with an error which is not transient, after which the stream is put in the Error state until it is restarted; 0 retries it forever")
+)
+
+// The classes of the errors of the streams, as exported in the
+// VReplicationRetries stats.
+const (
+	// retryTransient errors, like deadlocks and lost connections, go away
+	// by themselves, so they are retried forever.
+	retryTransient = "Transient"
+	// retryPersistent errors, like a missing column, need an operator to
+	// fix them, so they are retried at most -vreplication_max_retries times.
+	retryPersistent = "Persistent"
+)
+
+// classifyError returns the class of an error of a stream.
+func classifyError(err error) string {
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_ABORTED, vtrpcpb.Code_DEADLINE_EXCEEDED, vtrpcpb.Code_RESOURCE_EXHAUSTED:
+		return retryTransient
+	}
+
+	// The errors of the source tablets come back as strings.
+	if msg := err.Error(); strings.Contains(msg, "vstream ended") || strings.Contains(msg, "connection refused") {
+		return retryTransient
+	}
+
+	sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
+	if !ok {
+		return retryPersistent
+	}
+	if mysql.IsConnErr(sqlErr) || mysql.IsTooManyConnectionsErr(sqlErr) {
+		return retryTransient
+	}
+	switch sqlErr.Number() {
+	case mysql.ERLockDeadlock, mysql.ERLockWaitTimeout,
+		mysql.ERConCount, mysql.ERTooManyUserConnections, mysql.ERServerShutdown,
+		// The primary is read-only while it is demoted by a reparent.
+		mysql.EROptionPreventsStatement, mysql.ERReadOnlyTransaction:
+		return retryTransient
+	}
+	return retryPersistent
+}
+
+// retryBackoff returns the delay before the given retry of a failed stream,
+// where the first retry is 1.
+func retryBackoff(retry int) time.Duration {
+	delay := *retryDelay
+	for i := 1; i < retry && delay < *maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > *maxRetryDelay {
+		delay = *maxRetryDelay
+	}
+	return delay
+}
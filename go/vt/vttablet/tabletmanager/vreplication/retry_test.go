@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestClassifyError(t *testing.T) {
+	tcases := []struct {
+		err   error
+		class string
+	}{
+		{mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSLockDeadlock, "Deadlock found when trying to get lock"), retryTransient},
+		{mysql.NewSQLError(mysql.CRServerLost, mysql.SSUnknownSQLState, "Lost connection to MySQL server during query"), retryTransient},
+		// The errors are often wrapped, or only come back as strings.
+		{fmt.Errorf("error applying event: %w", mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "Lock wait timeout exceeded")), retryTransient},
+		{errors.New("Error 1290: The MySQL server is running with the --super-read-only option (errno 1290) (sqlstate HY000)"), retryTransient},
+		{vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet"), retryTransient},
+		{errors.New("vstream ended"), retryTransient},
+		{mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown column 'c1' in 'field list'"), retryPersistent},
+		{errors.New("Duplicate entry '1' for key 'PRIMARY' (errno 1062) (sqlstate 23000)"), retryPersistent},
+		{errors.New("missing source"), retryPersistent},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tcase.class, classifyError(tcase.err))
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	savedDelay, savedMaxDelay := *retryDelay, *maxRetryDelay
+	defer func() { *retryDelay, *maxRetryDelay = savedDelay, savedMaxDelay }()
+	*retryDelay, *maxRetryDelay = 5*time.Second, time.Minute
+
+	var delays []time.Duration
+	for retry := 1; retry <= 6; retry++ {
+		delays = append(delays, retryBackoff(retry))
+	}
+	assert.Equal(t, []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute}, delays)
+
+	// The maximum delay is the delay when it is lower than the initial one.
+	*maxRetryDelay = time.Second
+	assert.Equal(t, time.Second, retryBackoff(1))
+}
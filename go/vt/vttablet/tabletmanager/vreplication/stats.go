@@ -341,6 +341,21 @@ func (st *vrStats) register() {
 			}
 			return result
 		})
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationRetries",
+		"Retries of failed vreplication streams per workflow",
+		[]string{"workflow", "class"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64)
+			for _, ct := range st.controllers {
+				for key, val := range ct.blpStats.RetryCounts.Counts() {
+					result[ct.workflow+"."+key] += val
+				}
+			}
+			return result
+		})
 	stats.NewGaugesFuncWithMultiLabels(
 		"VReplicationHeartbeat",
 		"Time when last heartbeat was received from a vstreamer",
@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/masking"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/schema"
@@ -50,6 +51,9 @@ type tablePlanBuilder struct {
 	selColumns        map[string]bool
 	colExprs          []*colExpr
 	onInsert          insertType
+	onConflict        binlogdatapb.Rule_OnConflict
+	tenantColumn      string
+	appendOnly        bool
 	pkCols            []*colExpr
 	extraSourcePkCols []*colExpr
 	lastpk            *sqltypes.Result
@@ -65,6 +69,7 @@ type colExpr struct {
 	// operation==opExpr: full expression is set
 	// operation==opCount: nothing is set.
 	// operation==opSum: for 'sum(a)', expr is set to 'a'.
+	// operation==opMin, opMax: for 'min(a)' or 'max(a)', expr is set to 'a'.
 	operation operation
 	// expr stores the expected field name from vstreamer and dictates
 	// the generated bindvar names, like a_col or b_col.
@@ -86,6 +91,8 @@ const (
 	opExpr = operation(iota)
 	opCount
 	opSum
+	opMin
+	opMax
 )
 
 // insertType describes the type of insert statement to generate.
@@ -235,6 +242,8 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 			Stats:          stats,
 			EnumValuesMap:  enumValuesMap,
 			ConvertCharset: rule.ConvertCharset,
+			OnConflict:     rule.OnConflict,
+			TenantColumn:   rule.TenantColumn,
 		}
 
 		return tablePlan, nil
@@ -246,10 +255,13 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 			From:  sel.From,
 			Where: sel.Where,
 		},
-		selColumns: make(map[string]bool),
-		lastpk:     lastpk,
-		colInfos:   colInfos,
-		stats:      stats,
+		selColumns:   make(map[string]bool),
+		onConflict:   rule.OnConflict,
+		tenantColumn: rule.TenantColumn,
+		appendOnly:   rule.AppendOnly,
+		lastpk:       lastpk,
+		colInfos:     colInfos,
+		stats:        stats,
 	}
 
 	if err := tpb.analyzeExprs(sel.SelectExprs); err != nil {
@@ -286,6 +298,9 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	if err := tpb.analyzeExtraSourcePkCols(colInfos, sourceKeyTargetColumnNames); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeTenantColumn(); err != nil {
+		return nil, err
+	}
 
 	// if there are no columns being selected the select expression can be empty, so we "select 1" so we have a valid
 	// select to get a row back
@@ -309,9 +324,14 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	sendRule.Filter = sqlparser.String(tpb.sendSelect)
 
 	tablePlan := tpb.generate()
+	if tablePlan.Masks, err = tpb.generateMasks(tablePlan.PKReferences); err != nil {
+		return nil, err
+	}
 	tablePlan.SendRule = sendRule
 	tablePlan.EnumValuesMap = enumValuesMap
 	tablePlan.ConvertCharset = rule.ConvertCharset
+	tablePlan.OnConflict = rule.OnConflict
+	tablePlan.TenantColumn = rule.TenantColumn
 	return tablePlan, nil
 }
 
@@ -355,9 +375,48 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		Stats:                   tpb.stats,
 		FieldsToSkip:            fieldsToSkip,
 		HasExtraSourcePkColumns: (len(tpb.extraSourcePkCols) > 0),
+		OnConflict:              tpb.onConflict,
+		TenantColumn:            tpb.tenantColumn,
+		TenantRow:               tpb.generateTenantRow(false),
+		TenantConflict:          tpb.generateTenantRow(true),
+		TenantRowKey:            tpb.generateTenantRowKey(),
 	}
 }
 
+// generateMasks returns the masks of the source fields copied into the masked
+// columns, by field name. A masked field can't be referenced by the primary
+// key, whose values are compared to the lastpk of the source.
+func (tpb *tablePlanBuilder) generateMasks(pkrefs []string) (map[string]*masking.Mask, error) {
+	var masks map[string]*masking.Mask
+	for _, cexpr := range tpb.colExprs {
+		var mask *masking.Mask
+		for _, colInfo := range tpb.colInfos {
+			if strings.EqualFold(colInfo.Name, cexpr.colName.String()) {
+				mask = colInfo.Mask
+				break
+			}
+		}
+		if mask == nil {
+			continue
+		}
+		col, ok := cexpr.expr.(*sqlparser.ColName)
+		if cexpr.operation != opExpr || !ok {
+			return nil, fmt.Errorf("masked column %v of table %v is not copied from a source column", cexpr.colName, tpb.name)
+		}
+		field := col.Name.String()
+		for _, pkref := range pkrefs {
+			if strings.EqualFold(pkref, field) {
+				return nil, fmt.Errorf("masked column %v of table %v is referenced by the primary key", cexpr.colName, tpb.name)
+			}
+		}
+		if masks == nil {
+			masks = make(map[string]*masking.Mask)
+		}
+		masks[field] = mask
+	}
+	return masks, nil
+}
+
 func analyzeSelectFrom(query string) (sel *sqlparser.Select, from string, err error) {
 	statement, err := sqlparser.Parse(query)
 	if err != nil {
@@ -435,7 +494,7 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			}
 			cexpr.operation = opCount
 			return cexpr, nil
-		case "sum":
+		case "sum", "min", "max":
 			if len(expr.Exprs) != 1 {
 				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
@@ -450,7 +509,11 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			if !innerCol.Qualifier.IsEmpty() {
 				return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
 			}
-			cexpr.operation = opSum
+			// MIN and MAX can't be recomputed when a value is removed.
+			if fname != "sum" && !tpb.appendOnly {
+				return nil, fmt.Errorf("%s is only supported for append-only tables: %v", fname, sqlparser.String(expr))
+			}
+			cexpr.operation = aggregateOps[fname]
 			cexpr.expr = innerCol
 			tpb.addCol(innerCol.Name)
 			cexpr.references[innerCol.Name.Lowered()] = true
@@ -490,6 +553,13 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 	return cexpr, nil
 }
 
+// aggregateOps are the operations of the aggregates of a column.
+var aggregateOps = map[string]operation{
+	"sum": opSum,
+	"min": opMin,
+	"max": opMax,
+}
+
 // addCol adds the specified column to the send query
 // if it's not already present.
 func (tpb *tablePlanBuilder) addCol(ident sqlparser.ColIdent) {
@@ -647,7 +717,7 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 }
 
 func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
-	if tpb.onInsert == insertIgnore {
+	if tpb.onInsert == insertIgnore || tpb.ignoresConflicts() {
 		buf.Myprintf("insert ignore into %v(", tpb.name)
 	} else {
 		buf.Myprintf("insert into %v(", tpb.name)
@@ -685,6 +755,8 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 		case opSum:
 			// NULL values must be treated as 0 for SUM.
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.Myprintf(")")
@@ -708,6 +780,8 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 			buf.WriteString("1")
 		case opSum:
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.WriteString(" from dual where ")
@@ -715,7 +789,31 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 	return buf.ParsedQuery()
 }
 
+// ignoresConflicts returns true if the inserts of rows conflicting with existing
+// rows must be ignored: rows are replaced by updating their non-pk columns, so
+// a table with only pk columns ignores conflicts in both modes.
+func (tpb *tablePlanBuilder) ignoresConflicts() bool {
+	if tpb.onInsert != insertNormal {
+		return false
+	}
+	switch tpb.onConflict {
+	case binlogdatapb.Rule_IGNORE:
+		return true
+	case binlogdatapb.Rule_REPLACE:
+		for _, cexpr := range tpb.colExprs {
+			if !cexpr.isPK && !tpb.isColumnGenerated(cexpr.colName) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
 func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
+	if tpb.onInsert == insertNormal && tpb.onConflict == binlogdatapb.Rule_REPLACE && !tpb.ignoresConflicts() {
+		return tpb.generateReplacePart(buf)
+	}
 	if tpb.onInsert != insertOnDup {
 		return nil
 	}
@@ -745,7 +843,27 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			buf.Myprintf("+ifnull(values(%v), 0)", cexpr.colName)
+		case opMin, opMax:
+			// NULL values are ignored by MIN and MAX.
+			buf.Myprintf("%s(ifnull(%v, values(%v)), ifnull(values(%v), %v))", extremeFunc(cexpr), cexpr.colName, cexpr.colName, cexpr.colName, cexpr.colName)
+		}
+	}
+	return buf.ParsedQuery()
+}
+
+// generateReplacePart generates the on duplicate key clause overwriting the
+// columns of an existing row with the values of the conflicting row.
+func (tpb *tablePlanBuilder) generateReplacePart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
+	buf.Myprintf(" on duplicate key update ")
+	separator := ""
+	for _, cexpr := range tpb.colExprs {
+		// The tenant of a row of another tenant must not be overwritten:
+		// such conflicts fail the insert, see TablePlan.TenantConflict.
+		if cexpr.isPK || tpb.isColumnGenerated(cexpr.colName) || cexpr == tpb.tenantExpr() {
+			continue
 		}
+		buf.Myprintf("%s%v=values(%v)", separator, cexpr.colName, cexpr.colName)
+		separator = ", "
 	}
 	return buf.ParsedQuery()
 }
@@ -783,6 +901,13 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 			buf.Myprintf("-ifnull(%v, 0)", cexpr.expr)
 			bvf.mode = bvAfter
 			buf.Myprintf("+ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax:
+			// The before value is kept: the tables are append-only, and
+			// MIN and MAX are computed over all the inserted values.
+			bvf.mode = bvAfter
+			buf.Myprintf("%s(ifnull(%v, ", extremeFunc(cexpr), cexpr.colName)
+			buf.Myprintf("%v), ifnull(", cexpr.expr)
+			buf.Myprintf("%v, %v))", cexpr.expr, cexpr.colName)
 		}
 	}
 	tpb.generateWhere(buf, bvf)
@@ -813,6 +938,9 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 				buf.Myprintf("%v-1", cexpr.colName)
 			case opSum:
 				buf.Myprintf("%v-ifnull(%v, 0)", cexpr.colName, cexpr.expr)
+			case opMin, opMax:
+				// The deleted value is kept, like on updates.
+				buf.Myprintf("%v", cexpr.colName)
 			}
 		}
 		tpb.generateWhere(buf, bvf)
@@ -822,6 +950,15 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 	return buf.ParsedQuery()
 }
 
+// extremeFunc returns the function which combines two values of a MIN or a
+// MAX column.
+func extremeFunc(cexpr *colExpr) string {
+	if cexpr.operation == opMax {
+		return "greatest"
+	}
+	return "least"
+}
+
 func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
 	buf.WriteString(" where ")
 	bvf.mode = bvBefore
@@ -843,12 +980,103 @@ func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bi
 	}
 	addWhereColumns(tpb.pkCols)
 	addWhereColumns(tpb.extraSourcePkCols)
+	// The row of another tenant with the same primary key is never changed.
+	if tenant := tpb.tenantExpr(); tenant != nil && !tenant.isPK {
+		addWhereColumns([]*colExpr{tenant})
+	}
 	if tpb.lastpk != nil {
 		buf.WriteString(" and ")
 		tpb.generatePKConstraint(buf, bvf)
 	}
 }
 
+// analyzeTenantColumn checks the tenant column of a tenant import, which is
+// copied from a source column of the rows of the tenant.
+func (tpb *tablePlanBuilder) analyzeTenantColumn() error {
+	if tpb.tenantColumn == "" {
+		return nil
+	}
+	if tpb.onInsert != insertNormal {
+		return fmt.Errorf("table %v of a tenant import can't be grouped", tpb.name)
+	}
+	cexpr := tpb.tenantExpr()
+	if cexpr == nil {
+		return fmt.Errorf("tenant column %s of table %v not found in select list", tpb.tenantColumn, tpb.name)
+	}
+	if _, ok := cexpr.expr.(*sqlparser.ColName); !ok || cexpr.operation != opExpr {
+		return fmt.Errorf("tenant column %s of table %v is not copied from a source column", tpb.tenantColumn, tpb.name)
+	}
+	return nil
+}
+
+// tenantExpr returns the expression of the tenant column, or nil if the table
+// isn't part of a tenant import.
+func (tpb *tablePlanBuilder) tenantExpr() *colExpr {
+	if tpb.tenantColumn == "" {
+		return nil
+	}
+	return tpb.findCol(sqlparser.NewColIdent(tpb.tenantColumn))
+}
+
+// generateTenantRow generates the condition matching the target row of an
+// inserted row of a tenant import by its primary key. If conflict is set, it
+// only matches the row if it belongs to another tenant.
+func (tpb *tablePlanBuilder) generateTenantRow(conflict bool) *sqlparser.ParsedQuery {
+	tenant := tpb.tenantExpr()
+	if tenant == nil {
+		return nil
+	}
+	bvf := &bindvarFormatter{mode: bvAfter}
+	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+	separator := "("
+	for _, cexpr := range tpb.pkCols {
+		buf.Myprintf("%s%v=", separator, cexpr.colName)
+		if _, ok := cexpr.expr.(*sqlparser.ColName); ok {
+			buf.Myprintf("%v", cexpr.expr)
+		} else {
+			buf.Myprintf("(%v)", cexpr.expr)
+		}
+		separator = " and "
+	}
+	if conflict {
+		buf.Myprintf("%snot %v<=>%v", separator, tenant.colName, tenant.expr)
+	}
+	buf.WriteString(")")
+	return buf.ParsedQuery()
+}
+
+// generateTenantRowKey generates the key of a target row in
+// _vt.tenant_import_rows.
+func (tpb *tablePlanBuilder) generateTenantRowKey() string {
+	if tpb.tenantExpr() == nil {
+		return ""
+	}
+	var pkCols []string
+	for _, colInfo := range tpb.colInfos {
+		if colInfo.IsPK {
+			pkCols = append(pkCols, colInfo.Name)
+		}
+	}
+	return TenantImportRowKey(tpb.name, pkCols)
+}
+
+// TenantImportRowKey returns the expression computing the key of a row of a
+// table in _vt.tenant_import_rows from its primary key columns.
+func TenantImportRowKey(table sqlparser.TableIdent, pkCols []string) string {
+	pkCols = append([]string(nil), pkCols...)
+	sort.Strings(pkCols)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString("convert(json_array(")
+	for i, pkCol := range pkCols {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", &sqlparser.ColName{Name: sqlparser.NewColIdent(pkCol), Qualifier: sqlparser.TableName{Name: table}})
+	}
+	buf.WriteString(") using utf8mb4)")
+	return buf.String()
+}
+
 func (tpb *tablePlanBuilder) getCharsetAndCollation(pkname string) (charSet string, collation string) {
 	for _, colInfo := range tpb.colInfos {
 		if colInfo.IsPK && strings.EqualFold(colInfo.Name, pkname) {
@@ -60,12 +60,12 @@ const (
 	// LogStateChange is used when the state of the stream changes
 	LogStateChange = "State Changed"
 
-	// TODO: LogError is not used atm. Currently irrecoverable errors, resumable errors and informational messages
-	//  are all treated the same: the message column is updated and state left as Running.
-	//  Every five seconds we reset the message and retry streaming so that we can automatically resume from a temporary
-	//  loss of connectivity or a reparent. We need to detect if errors are not recoverable and set an Error status.
-	//  Since this device (of overloading the message) is strewn across the code, and incorrectly flagging resumable
-	//  errors can stall workflows, we have deferred implementing it.
+	// Most errors only update the message, and leave the state as Running: the
+	// stream is retried with a backoff so that it automatically resumes from a
+	// temporary loss of connectivity or a reparent. Since incorrectly flagging
+	// resumable errors can stall workflows, a stream is only put in the Error
+	// state when it failed -vreplication_max_retries times in a row with errors
+	// which are not transient.
 
 	// LogError indicates that there is an error from which we cannot recover and the operator needs to intervene.
 	LogError = "Error"
@@ -204,6 +204,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	if err != nil {
 		return err
 	}
+	plan.VReplID = vc.vr.id
 
 	initialPlan, ok := plan.TargetTables[tableName]
 	if !ok {
@@ -236,7 +237,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			default:
 			}
 			// verify throttler is happy, otherwise keep looping
-			if vc.vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+			if vc.vr.throttlerClient.ThrottleCheckOKOrWait(ctx) {
 				break
 			}
 		}
@@ -272,7 +273,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		if err := vc.vr.dbClient.Begin(); err != nil {
 			return err
 		}
-		_, err = vc.tablePlan.applyBulkInsert(&sqlbuffer, rows, func(sql string) (*sqltypes.Result, error) {
+		qr, err := vc.tablePlan.applyBulkInsert(&sqlbuffer, rows, func(sql string) (*sqltypes.Result, error) {
 			start := time.Now()
 
 			qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
@@ -280,13 +281,13 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 				return nil, err
 			}
 			vc.vr.stats.QueryTimings.Record("copy", start)
-			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
 			vc.vr.stats.QueryCount.Add("copy", 1)
 			return qr, err
 		})
 		if err != nil {
 			return err
 		}
+		vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
 
 		var buf []byte
 		buf, err = prototext.Marshal(&querypb.QueryResult{
@@ -111,6 +111,7 @@ func (vp *vplayer) play(ctx context.Context) error {
 		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
 		return err
 	}
+	plan.VReplID = vp.vr.id
 	vp.replicatorPlan = plan
 
 	// We can't run in statement mode if there are filters defined.
@@ -336,7 +337,7 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 	var sbm int64 = -1
 	for {
 		// check throttler.
-		if !vp.vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+		if !vp.vr.throttlerClient.ThrottleCheckOKOrWait(ctx) {
 			continue
 		}
 
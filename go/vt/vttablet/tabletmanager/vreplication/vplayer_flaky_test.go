@@ -1306,6 +1306,65 @@ func TestPlayerRowMove(t *testing.T) {
 	validateQueryCountStat(t, "replicate", 5)
 }
 
+func TestPlayerMinMax(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	execStatements(t, []string{
+		"create table src(id int, val1 int, val2 int, primary key(id))",
+		fmt.Sprintf("create table %s.dst(val1 int, mn int, mx int, rcount int, primary key(val1))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src",
+		fmt.Sprintf("drop table %s.dst", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:      "dst",
+			Filter:     "select val1, min(val2) as mn, max(val2) as mx, count(*) as rcount from src group by val1",
+			AppendOnly: true,
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+
+	execStatements(t, []string{
+		"insert into src values(1, 1, 1), (2, 1, 3), (3, 1, 2)",
+	})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into dst(val1,mn,mx,rcount) values (1,1,1,1) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), rcount=rcount+1",
+		"insert into dst(val1,mn,mx,rcount) values (1,3,3,1) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), rcount=rcount+1",
+		"insert into dst(val1,mn,mx,rcount) values (1,2,2,1) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), rcount=rcount+1",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+	expectData(t, "dst", [][]string{
+		{"1", "1", "3", "3"},
+	})
+
+	// A deleted value is kept in the min and the max.
+	execStatements(t, []string{
+		"delete from src where id=1",
+	})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"update dst set mn=mn, mx=mx, rcount=rcount-1 where val1=1",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+	expectData(t, "dst", [][]string{
+		{"1", "1", "3", "2"},
+	})
+}
+
 func TestPlayerTypes(t *testing.T) {
 	log.Errorf("TestPlayerTypes: flavor is %s", env.Flavor)
 	enableJSONColumnTesting := false
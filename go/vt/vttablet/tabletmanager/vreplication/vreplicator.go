@@ -37,7 +37,9 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/masking"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -54,6 +56,7 @@ var (
 
 	copyPhaseDuration   = flag.Duration("vreplication_copy_phase_duration", 1*time.Hour, "Duration for each copy phase loop (before running the next catchup: default 1h)")
 	replicaLagTolerance = flag.Duration("vreplication_replica_lag_tolerance", 1*time.Minute, "Replica lag threshold duration: once lag is below this we switch from copy phase to the replication (streaming) phase")
+	maxConcurrentCopies = flag.Int("vreplication_max_concurrent_copies", 0, "Maximum number of streams copying at the same time on the tablet; the other streams wait for their turn, taken after each copy phase loop. Unlimited if 0")
 
 	// vreplicationHeartbeatUpdateInterval determines how often the time_updated column is updated if there are no real events on the source and the source
 	// vstream is only sending heartbeats for this long. Keep this low if you expect high QPS and are monitoring this column to alert about potential
@@ -86,11 +89,13 @@ type vreplicator struct {
 	mysqld     mysqlctl.MysqlDaemon
 	colInfoMap map[string][]*ColumnInfo
 
+	throttlerClient *throttle.Client
+
 	originalFKCheckSetting int64
 }
 
 // newVReplicator creates a new vreplicator. The valid fields from the source are:
-// Keyspce, Shard, Filter, OnDdl, ExternalMySql and StopAfterCopy.
+// Keyspce, Shard, Filter, OnDdl, ExternalMySql, StopAfterCopy, ThrottlerApp and ThrottleRatio.
 // The Filter consists of Rules. Each Rule has a Match and an (inner) Filter field.
 // The Match can be a table name or, if it begins with a "/", a wildcard.
 // The Filter can be empty: get all rows and columns.
@@ -110,11 +115,13 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
-func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+func newVReplicator(id uint32, workflow string, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
 	if *vreplicationHeartbeatUpdateInterval > vreplicationMinimumHeartbeatUpdateInterval {
 		log.Warningf("the supplied value for vreplication_heartbeat_update_interval:%d seconds is larger than the maximum allowed:%d seconds, vreplication will fallback to %d",
 			*vreplicationHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval)
 	}
+	throttlerClient := throttle.NewBackgroundClient(vre.lagThrottler, vreplicationThrottlerAppName(workflow, source), throttle.ThrottleCheckPrimaryWrite)
+	throttlerClient.SetRatio(source.ThrottleRatio)
 	return &vreplicator{
 		vre:             vre,
 		id:              id,
@@ -123,7 +130,23 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 		stats:           stats,
 		dbClient:        newVDBClient(dbClient, stats),
 		mysqld:          mysqld,
+		throttlerClient: throttlerClient,
+	}
+}
+
+// vreplicationThrottlerAppName returns the app name a stream checks the throttler with. The throttler
+// throttles an app if any of its ':' separated parts is throttled, so throttling "vreplication" throttles
+// all the streams, while throttling the throttler app of a workflow only throttles the streams of the
+// workflow.
+func vreplicationThrottlerAppName(workflow string, source *binlogdatapb.BinlogSource) string {
+	app := source.ThrottlerApp
+	if app == "" {
+		app = workflow
+	}
+	if app == "" {
+		return throttlerAppName
 	}
+	return throttlerAppName + ":" + app
 }
 
 // Replicate starts a vreplication stream. It can be in one of three phases:
@@ -162,6 +185,9 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err := vr.setMasks(ctx, colInfo); err != nil {
+		return err
+	}
 	vr.colInfoMap = colInfo
 	if err := vr.getSettingFKCheck(); err != nil {
 		return err
@@ -193,7 +219,13 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 				log.Warningf("Unable to clear FK check %v", err)
 				return err
 			}
-			if err := newVCopier(vr).copyNext(ctx, settings); err != nil {
+			release, ok := vr.vre.acquireCopySlot(ctx)
+			if !ok {
+				return nil
+			}
+			err := newVCopier(vr).copyNext(ctx, settings)
+			release()
+			if err != nil {
 				vr.stats.ErrorCounts.Add([]string{"Copy"}, 1)
 				return err
 			}
@@ -237,6 +269,8 @@ type ColumnInfo struct {
 	ColumnType  string
 	IsPK        bool
 	IsGenerated bool
+	// Mask is set if the values copied into the column are masked.
+	Mask *masking.Mask
 }
 
 func (vr *vreplicator) buildColInfoMap(ctx context.Context) (map[string][]*ColumnInfo, error) {
@@ -314,6 +348,27 @@ func (vr *vreplicator) buildColInfoMap(ctx context.Context) (map[string][]*Colum
 	return colInfoMap, nil
 }
 
+// setMasks sets the masks of the columns of the masking policy, if the stream
+// replicates into one of its non-production keyspaces from another keyspace.
+// The values copied from a non-production keyspace, like by Reshard or Online
+// DDL, are already masked.
+func (vr *vreplicator) setMasks(ctx context.Context, colInfoMap map[string][]*ColumnInfo) error {
+	policy, err := vr.vre.ts.GetMaskingPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if !masking.IsMasked(policy, vr.vre.keyspace) || masking.IsMasked(policy, vr.source.Keyspace) {
+		return nil
+	}
+	for tableName, colInfos := range colInfoMap {
+		masks := masking.TableMasks(policy, tableName)
+		for _, colInfo := range colInfos {
+			colInfo.Mask = masks[strings.ToLower(colInfo.Name)]
+		}
+	}
+	return nil
+}
+
 func (vr *vreplicator) readSettings(ctx context.Context) (settings binlogplayer.VRSettings, numTablesToCopy int64, err error) {
 	settings, err = binlogplayer.ReadVRSettings(vr.dbClient, vr.id)
 	if err != nil {
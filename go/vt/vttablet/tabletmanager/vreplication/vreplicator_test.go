@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
 
 func TestRecalculatePKColsInfoByColumnNames(t *testing.T) {
@@ -68,3 +70,9 @@ func TestRecalculatePKColsInfoByColumnNames(t *testing.T) {
 		})
 	}
 }
+
+func TestVReplicationThrottlerAppName(t *testing.T) {
+	assert.Equal(t, "vreplication", vreplicationThrottlerAppName("", &binlogdatapb.BinlogSource{}))
+	assert.Equal(t, "vreplication:wf", vreplicationThrottlerAppName("wf", &binlogdatapb.BinlogSource{}))
+	assert.Equal(t, "vreplication:backfill", vreplicationThrottlerAppName("wf", &binlogdatapb.BinlogSource{ThrottlerApp: "backfill"}))
+}
@@ -69,13 +69,18 @@ func NewDBConn(ctx context.Context, cp *Pool, appParams dbconfigs.Connector) (*D
 		cp.env.CheckMySQL()
 		return nil, err
 	}
-	return &DBConn{
+	dbc := &DBConn{
 		conn:    c,
 		info:    appParams,
 		pool:    cp,
 		dbaPool: cp.dbaPool,
 		stats:   cp.env.Stats(),
-	}, nil
+	}
+	if err := dbc.setIsolationLevel(); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return dbc, nil
 }
 
 // NewDBConnNoPool creates a new DBConn without a pool.
@@ -396,7 +401,17 @@ func (dbc *DBConn) reconnect(ctx context.Context) error {
 	dbc.err = nil
 	dbc.errmu.Unlock()
 	dbc.conn = newConn
-	return nil
+	return dbc.setIsolationLevel()
+}
+
+// setIsolationLevel sets the session transaction isolation level of the
+// connection to the one of its pool, if any.
+func (dbc *DBConn) setIsolationLevel() error {
+	if dbc.pool == nil || dbc.pool.isolationLevel == "" {
+		return nil
+	}
+	_, err := dbc.conn.ExecuteFetch("set session transaction isolation level "+dbc.pool.isolationLevel, 1, false)
+	return err
 }
 
 // setDeadline starts a goroutine that will kill the currently executing query
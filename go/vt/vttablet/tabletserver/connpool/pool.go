@@ -61,6 +61,10 @@ type Pool struct {
 	waiterCount        sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+
+	// isolationLevel is the transaction isolation level of the
+	// connections of the pool, if it is not the MySQL default.
+	isolationLevel string
 }
 
 // NewPool creates a new Pool. The name is used
@@ -93,6 +97,14 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 	return cp
 }
 
+// NewIsolationLevelPool creates a new Pool whose connections use the given
+// session transaction isolation level, like "READ COMMITTED".
+func NewIsolationLevelPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig, isolationLevel string) *Pool {
+	cp := NewPool(env, name, cfg)
+	cp.isolationLevel = isolationLevel
+	return cp
+}
+
 func (cp *Pool) pool() (p *pools.ResourcePool) {
 	cp.mu.Lock()
 	p = cp.connections
@@ -19,6 +19,7 @@ package tabletserver
 import (
 	"context"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo"
@@ -26,11 +27,13 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	"time"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -79,6 +82,10 @@ type Controller interface {
 	// SetQueryRules sets the query rules for this QueryService
 	SetQueryRules(ruleSource string, qrs *rules.Rules) error
 
+	// SetTableACL overrides the table ACL of the config file, or restores
+	// it if the config is nil
+	SetTableACL(config *tableaclpb.Config) error
+
 	// QueryService returns the QueryService object used by this Controller
 	QueryService() queryservice.QueryService
 
@@ -93,6 +100,24 @@ type Controller interface {
 
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// ReadTwopcInflight returns the unresolved 2pc transactions
+	ReadTwopcInflight(ctx context.Context) (distributed []*tx.DistributedTx, prepared, failed []*tx.PreparedTx, err error)
+
+	// GetDeadLetters returns the dead-lettered messages of a message table
+	GetDeadLetters(ctx context.Context, target *querypb.Target, name string, limit int) (*sqltypes.Result, error)
+
+	// RequeueDeadLetters schedules dead-lettered messages to be sent again
+	RequeueDeadLetters(ctx context.Context, target *querypb.Target, name string, ids []string) (int64, error)
+
+	// PurgeDeadLetters deletes dead-lettered messages
+	PurgeDeadLetters(ctx context.Context, target *querypb.Target, name string, ids []string) (int64, error)
+
+	// GetSequences returns the state of sequences
+	GetSequences(ctx context.Context, target *querypb.Target, names []string) ([]*schema.SequenceState, error)
+
+	// ResetSequence sets the next ID and the cache size of a sequence
+	ResetSequence(ctx context.Context, target *querypb.Target, name string, nextID, cache int64) (*schema.SequenceState, error)
 }
 
 // Ensure TabletServer satisfies Controller interface.
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// The deadline budget of a request is the time left before its context
+// expires. The deadline set by vtgate is carried by gRPC, and is shortened
+// by the query timeout of vttablet if needed. The budget is recorded at every
+// hop, so that the time consumed by each of them can be compared to what the
+// callers allow.
+const (
+	deadlineHopVTTablet = "VTTablet"
+	deadlineHopMySQL    = "MySQL"
+)
+
+// recordDeadlineBudget records the budget left to the request when it
+// reaches the hop, and returns the function to call once the hop completed.
+// Requests without a deadline are not recorded.
+func recordDeadlineBudget(ctx context.Context, stats *tabletenv.Stats, hop string) func() {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return func() {}
+	}
+	start := time.Now()
+	remaining := deadline.Sub(start)
+	if remaining < 0 {
+		remaining = 0
+	}
+	stats.DeadlineRemaining.Add(hop, remaining)
+	return func() {
+		stats.DeadlineConsumed.Record(hop, start)
+	}
+}
+
+const selectPrefix = "select "
+
+// withMaxExecutionTime adds a MAX_EXECUTION_TIME optimizer hint to the
+// select, derived from the remaining deadline budget of the request, so that
+// MySQL stops executing it once the caller gave up waiting for it.
+func (qre *QueryExecutor) withMaxExecutionTime(query string) string {
+	if !qre.tsv.config.DeriveMaxExecutionTime {
+		return query
+	}
+	switch qre.plan.PlanID {
+	case planbuilder.PlanSelect, planbuilder.PlanSelectNoWait, planbuilder.PlanSelectStream:
+	default:
+		return query
+	}
+	deadline, ok := qre.ctx.Deadline()
+	if !ok || !strings.HasPrefix(query, selectPrefix) {
+		return query
+	}
+	// MySQL ignores a limit of 0, so the budget is at least a millisecond.
+	ms := time.Until(deadline).Milliseconds()
+	if ms < 1 {
+		ms = 1
+	}
+	var buf strings.Builder
+	buf.Grow(len(query) + 32)
+	buf.WriteString(selectPrefix)
+	buf.WriteString("/*+ MAX_EXECUTION_TIME(")
+	buf.WriteString(strconv.FormatInt(ms, 10))
+	buf.WriteString(") */ ")
+	buf.WriteString(query[len(selectPrefix):])
+	return buf.String()
+}
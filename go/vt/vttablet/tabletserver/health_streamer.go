@@ -92,7 +92,7 @@ func newHealthStreamer(env tabletenv.Env, alias *topodatapb.TabletAlias) *health
 			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
 		})
 	}
-	return &healthStreamer{
+	hs := &healthStreamer{
 		stats:              env.Stats(),
 		degradedThreshold:  env.Config().Healthcheck.DegradedThresholdSeconds.Get(),
 		unhealthyThreshold: sync2.NewAtomicDuration(env.Config().Healthcheck.UnhealthyThresholdSeconds.Get()),
@@ -111,6 +111,12 @@ func newHealthStreamer(env tabletenv.Env, alias *topodatapb.TabletAlias) *health
 		conns:                  pool,
 		signalWhenSchemaChange: env.Config().SignalWhenSchemaChange,
 	}
+	if hs.signalWhenSchemaChange {
+		// The schema versions start from the startup time, so that the clients
+		// notice the schema changes they missed while the tablet restarted.
+		hs.state.RealtimeStats.SchemaVersion = time.Now().UnixNano()
+	}
+	return hs
 }
 
 func (hs *healthStreamer) InitDBConfig(target *querypb.Target, cp dbconfigs.Connector) {
@@ -228,6 +234,7 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 
 	hs.state.RealtimeStats.FilteredReplicationLagSeconds, hs.state.RealtimeStats.BinlogPlayersCount = blpFunc()
 	hs.state.RealtimeStats.Qps = hs.stats.QPSRates.TotalRate()
+	hs.state.RealtimeStats.ErrorsPerSecond = hs.stats.ErrorRates.TotalRate()
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
 
@@ -379,15 +386,47 @@ func (hs *healthStreamer) reload() error {
 		return err
 	}
 
+	// Send the new definitions of the changed tables along with their names,
+	// so that the clients don't have to fetch them. The dropped tables have
+	// no columns.
+	deltas := make(map[string]*querypb.TableSchemaDelta, len(tables))
+	tableDeltas := make([]*querypb.TableSchemaDelta, 0, len(tables))
+	for _, table := range tables {
+		delta := &querypb.TableSchemaDelta{Name: table}
+		deltas[table] = delta
+		tableDeltas = append(tableDeltas, delta)
+	}
+	deltaCallback := func(qr *sqltypes.Result) error {
+		for _, row := range qr.Rows {
+			delta, ok := deltas[row[0].ToString()]
+			if !ok {
+				continue
+			}
+			delta.Columns = append(delta.Columns, &querypb.ColumnSchema{
+				Name:          row[1].ToString(),
+				DataType:      row[2].ToString(),
+				CollationName: row[3].ToString(),
+			})
+		}
+		return nil
+	}
+	err = conn.Stream(ctx, fmt.Sprintf(mysql.FetchTablesWhere, tableNamePredicate), deltaCallback, alloc, bufferSize, 0)
+	if err != nil {
+		return err
+	}
+
 	_, err = conn.Exec(ctx, "commit", 1, false)
 	if err != nil {
 		return err
 	}
 
+	hs.state.RealtimeStats.SchemaVersion++
 	hs.state.RealtimeStats.TableSchemaChanged = tables
+	hs.state.RealtimeStats.TableSchemaDeltas = tableDeltas
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
 	hs.broadCastToClients(shr)
 	hs.state.RealtimeStats.TableSchemaChanged = nil
+	hs.state.RealtimeStats.TableSchemaDeltas = nil
 
 	return nil
 }
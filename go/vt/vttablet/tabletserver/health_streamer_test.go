@@ -28,6 +28,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -184,7 +185,16 @@ func TestReloadSchema(t *testing.T) {
 		"product",
 		"users",
 	))
+	db.AddQueryPattern("select table_name, column_name, data_type, collation_name.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"table_name|column_name|data_type|collation_name",
+			"varchar|varchar|varchar|varchar",
+		),
+		"product|id|int|null",
+		"product|name|varchar|utf8mb4_general_ci",
+	))
 
+	startVersion := hs.state.RealtimeStats.SchemaVersion
 	hs.InitDBConfig(target, configs.DbaWithDB())
 	hs.Open()
 	defer hs.Close()
@@ -194,6 +204,17 @@ func TestReloadSchema(t *testing.T) {
 		hs.Stream(ctx, func(response *querypb.StreamHealthResponse) error {
 			if response.RealtimeStats.TableSchemaChanged != nil {
 				assert.Equal(t, []string{"product", "users"}, response.RealtimeStats.TableSchemaChanged)
+				// users was dropped
+				utils.MustMatch(t, []*querypb.TableSchemaDelta{{
+					Name: "product",
+					Columns: []*querypb.ColumnSchema{
+						{Name: "id", DataType: "int"},
+						{Name: "name", DataType: "varchar", CollationName: "utf8mb4_general_ci"},
+					},
+				}, {
+					Name: "users",
+				}}, response.RealtimeStats.TableSchemaDeltas)
+				assert.Greater(t, response.RealtimeStats.SchemaVersion, startVersion)
 				wg.Done()
 			}
 			return nil
@@ -297,6 +318,14 @@ func TestInitialReloadSchema(t *testing.T) {
 		"product",
 		"users",
 	))
+	db.AddQueryPattern("select table_name, column_name, data_type, collation_name.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"table_name|column_name|data_type|collation_name",
+			"varchar|varchar|varchar|varchar",
+		),
+		"product|id|int|null",
+		"product|name|varchar|utf8mb4_general_ci",
+	))
 
 	hs.InitDBConfig(target, configs.DbaWithDB())
 	hs.Open()
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// maxRecentLargeTransactions is the number of large transactions
+	// listed by /debug/large_transactions.
+	maxRecentLargeTransactions = 100
+	// maxLargeTransactionQueries is the number of queries kept for each of
+	// the listed large transactions.
+	maxLargeTransactionQueries = 5
+)
+
+var logLargeTransaction = logutil.NewThrottledLogger("LargeTransaction", 1*time.Second)
+
+// largeTransaction describes a transaction which exceeded a large transaction
+// threshold.
+type largeTransaction struct {
+	Time            time.Time
+	EffectiveCaller string
+	ImmediateCaller string
+	RowsAffected    int64
+	StatementBytes  int64
+	DurationSeconds float64
+	// Exceeded are the thresholds exceeded by the transaction.
+	Exceeded []string
+	// Refused is true if the commit of the transaction was refused.
+	Refused bool
+	Queries []string
+}
+
+// largeTransactions detects the transactions which modify too many rows,
+// run too large statements or last too long, and could make the replicas lag
+// when they apply them. Depending on the mode, it only records them, or also
+// refuses their commit.
+type largeTransactions struct {
+	config tabletenv.LargeTransactionConfig
+	env    tabletenv.Env
+
+	mu sync.Mutex
+	// recent are the last large transactions, the oldest first.
+	recent []*largeTransaction
+}
+
+func newLargeTransactions(env tabletenv.Env) *largeTransactions {
+	return &largeTransactions{
+		config: env.Config().LargeTransaction,
+		env:    env,
+	}
+}
+
+// check is called before the commit of a transaction. It records the
+// transaction if it exceeds a threshold, and returns an error if its commit
+// is refused. The commit of autocommit transactions cannot be refused, as
+// MySQL already committed their statements.
+func (lt *largeTransactions) check(props *tx.Properties) error {
+	if lt.config.Mode == tabletenv.Disable || props == nil {
+		return nil
+	}
+	duration := time.Since(props.StartTime)
+	var exceeded, reasons []string
+	if limit := lt.config.MaxRows; limit > 0 && props.RowsAffected > limit {
+		exceeded = append(exceeded, "Rows")
+		reasons = append(reasons, fmt.Sprintf("it modified %d rows, more than %d", props.RowsAffected, limit))
+	}
+	if limit := lt.config.MaxStatementBytes; limit > 0 && props.StatementBytes > limit {
+		exceeded = append(exceeded, "StatementBytes")
+		reasons = append(reasons, fmt.Sprintf("its statements which modified rows total %d bytes, more than %d", props.StatementBytes, limit))
+	}
+	if limit := lt.config.MaxDurationSeconds.Get(); limit > 0 && duration > limit {
+		exceeded = append(exceeded, "Duration")
+		reasons = append(reasons, fmt.Sprintf("it lasted %v, longer than %v", duration.Round(time.Millisecond), limit))
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	refused := lt.config.Mode == tabletenv.Enable && !props.Autocommit
+	lt.record(props, duration, exceeded, refused)
+	logLargeTransaction.Warningf("large transaction of %s (refused: %v): %s", callerid.GetPrincipal(props.EffectiveCaller), refused, strings.Join(reasons, ", "))
+	if !refused {
+		return nil
+	}
+	guidance := "split it into smaller transactions"
+	if limit := lt.config.MaxRows; limit > 0 {
+		guidance = fmt.Sprintf("split it into transactions modifying at most %d rows each, e.g. with a LIMIT on the DML", limit)
+	}
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "large transaction refused and rolled back: %s; %s", strings.Join(reasons, ", "), guidance)
+}
+
+func (lt *largeTransactions) record(props *tx.Properties, duration time.Duration, exceeded []string, refused bool) {
+	for _, threshold := range exceeded {
+		lt.env.Stats().LargeTransactions.Add(threshold, 1)
+	}
+	ltx := &largeTransaction{
+		Time:            time.Now(),
+		EffectiveCaller: callerid.GetPrincipal(props.EffectiveCaller),
+		ImmediateCaller: callerid.GetUsername(props.ImmediateCaller),
+		RowsAffected:    props.RowsAffected,
+		StatementBytes:  props.StatementBytes,
+		DurationSeconds: duration.Seconds(),
+		Exceeded:        exceeded,
+		Refused:         refused,
+	}
+	for i, query := range props.Queries {
+		if i == maxLargeTransactionQueries {
+			ltx.Queries = append(ltx.Queries, fmt.Sprintf("[%d more queries]", len(props.Queries)-i))
+			break
+		}
+		ltx.Queries = append(ltx.Queries, sqlparser.TruncateForUI(query))
+	}
+
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	if len(lt.recent) == maxRecentLargeTransactions {
+		lt.recent = lt.recent[1:]
+	}
+	lt.recent = append(lt.recent, ltx)
+}
+
+// Recent returns the last large transactions, the most recent first.
+func (lt *largeTransactions) Recent() []*largeTransaction {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	recent := make([]*largeTransaction, 0, len(lt.recent))
+	for i := len(lt.recent) - 1; i >= 0; i-- {
+		recent = append(recent, lt.recent[i])
+	}
+	return recent
+}
+
+// ServeHTTP lists the last large transactions in JSON.
+func (lt *largeTransactions) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(lt.Recent(), "", "  ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	response.Write(b)
+}
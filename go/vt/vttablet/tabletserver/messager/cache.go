@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 )
 
 //_______________________________________________
@@ -70,6 +71,15 @@ func (mh *messageHeap) Pop() interface{} {
 
 //_______________________________________________
 
+// cacheLane is the part of the cache reserved for the messages
+// of a priority lane.
+type cacheLane struct {
+	maxPriority int64
+	size        int
+	// count is the number of messages of the lane in sendQueue.
+	count int
+}
+
 // cache is the cache for the messager. Messages initially
 // start in the sendQueue. When they are popped, they move
 // to the inFlight set. They are eventually discarded
@@ -78,9 +88,14 @@ func (mh *messageHeap) Pop() interface{} {
 // update to a message (like an ack). If so, such messages
 // are marked as defunct in the cache, and are eventually
 // discarded when popped.
+// The cache is split in lanes: each priority lane can hold
+// its own number of messages, so that the most urgent messages
+// still fit in the cache when it's full of less urgent ones.
+// The last lane holds the messages which are in no priority lane.
 type cache struct {
-	mu   sync.Mutex
-	size int
+	mu    sync.Mutex
+	size  int
+	lanes []cacheLane
 
 	sendQueue messageHeap
 	// inQueue is used to efficiently find items in sendQueue.
@@ -94,15 +109,31 @@ type cache struct {
 }
 
 // NewMessagerCache creates a new cache.
-func newCache(size int) *cache {
+func newCache(size int, priorityLanes []schema.PriorityLane) *cache {
 	mc := &cache{
 		size:     size,
 		inQueue:  make(map[string]*MessageRow),
 		inFlight: make(map[string]bool),
 	}
+	for _, lane := range priorityLanes {
+		mc.lanes = append(mc.lanes, cacheLane{maxPriority: lane.MaxPriority, size: lane.CacheSize})
+		mc.size += lane.CacheSize
+	}
+	mc.lanes = append(mc.lanes, cacheLane{size: size})
 	return mc
 }
 
+// lane returns the lane of the message.
+func (mc *cache) lane(mr *MessageRow) *cacheLane {
+	last := len(mc.lanes) - 1
+	for i := 0; i < last; i++ {
+		if mr.Priority <= mc.lanes[i].maxPriority {
+			return &mc.lanes[i]
+		}
+	}
+	return &mc.lanes[last]
+}
+
 func (mc *cache) IsEmpty() bool {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
@@ -116,14 +147,18 @@ func (mc *cache) Clear() {
 	mc.sendQueue = nil
 	mc.inQueue = make(map[string]*MessageRow)
 	mc.inFlight = make(map[string]bool)
+	for i := range mc.lanes {
+		mc.lanes[i].count = 0
+	}
 }
 
 // Add adds a MessageRow to the cache. It returns
-// false if the cache is full.
+// false if the lane of the message is full.
 func (mc *cache) Add(mr *MessageRow) bool {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
-	if len(mc.sendQueue) >= mc.size {
+	lane := mc.lane(mr)
+	if lane.count >= lane.size {
 		return false
 	}
 	id := mr.Row[0].ToString()
@@ -135,6 +170,7 @@ func (mc *cache) Add(mr *MessageRow) bool {
 	}
 	heap.Push(&mc.sendQueue, mr)
 	mc.inQueue[id] = mr
+	lane.count++
 	return true
 }
 
@@ -152,6 +188,7 @@ func (mc *cache) Pop() *MessageRow {
 			return nil
 		}
 		mr := heap.Pop(&mc.sendQueue).(*MessageRow)
+		mc.lane(mr).count--
 		// If message was previously marked as defunct, drop
 		// it and continue.
 		if mr.defunct {
@@ -181,7 +218,7 @@ func (mc *cache) Discard(ids []string) {
 	}
 }
 
-// Size returns the max size of cache.
+// Size returns the max size of cache, including all the lanes.
 func (mc *cache) Size() int {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
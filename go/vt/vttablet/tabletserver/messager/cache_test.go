@@ -21,10 +21,11 @@ import (
 	"testing"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 )
 
 func TestMessagerCacheOrder(t *testing.T) {
-	mc := newCache(10)
+	mc := newCache(10, nil)
 	if !mc.Add(&MessageRow{
 		Priority: 1,
 		TimeNext: 1,
@@ -82,7 +83,7 @@ func TestMessagerCacheOrder(t *testing.T) {
 }
 
 func TestMessagerCacheDupKey(t *testing.T) {
-	mc := newCache(10)
+	mc := newCache(10, nil)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -116,7 +117,7 @@ func TestMessagerCacheDupKey(t *testing.T) {
 }
 
 func TestMessagerCacheDiscard(t *testing.T) {
-	mc := newCache(10)
+	mc := newCache(10, nil)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -166,7 +167,7 @@ func TestMessagerCacheDiscard(t *testing.T) {
 }
 
 func TestMessagerCacheFull(t *testing.T) {
-	mc := newCache(2)
+	mc := newCache(2, nil)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -190,8 +191,62 @@ func TestMessagerCacheFull(t *testing.T) {
 	}
 }
 
+func TestMessagerCachePriorityLanes(t *testing.T) {
+	mc := newCache(1, []schema.PriorityLane{
+		{MaxPriority: 0, CacheSize: 1},
+		{MaxPriority: 5, CacheSize: 2},
+	})
+	if got, want := mc.Size(), 4; got != want {
+		t.Errorf("Size: %d, want %d", got, want)
+	}
+	add := func(id string, priority int64) bool {
+		return mc.Add(&MessageRow{
+			Priority: priority,
+			TimeNext: 1,
+			Row:      []sqltypes.Value{sqltypes.NewVarBinary(id)},
+		})
+	}
+	if !add("low1", 10) {
+		t.Fatal("Add returned false")
+	}
+	if add("low2", 10) {
+		t.Error("Add(full default lane): returned true, want false")
+	}
+	// The urgent messages have their own lanes.
+	if !add("mid1", 3) || !add("mid2", 4) {
+		t.Fatal("Add returned false")
+	}
+	if add("mid3", 5) {
+		t.Error("Add(full lane): returned true, want false")
+	}
+	if !add("urgent1", -1) {
+		t.Fatal("Add returned false")
+	}
+
+	// The most urgent messages are sent first, and free their lane.
+	if row := mc.Pop(); row == nil || row.Row[0].ToString() != "urgent1" {
+		t.Errorf("Pop: want urgent1, got %v", row)
+	}
+	if !add("urgent2", 0) {
+		t.Fatal("Add returned false")
+	}
+	var got []string
+	for row := mc.Pop(); row != nil; row = mc.Pop() {
+		got = append(got, row.Row[0].ToString())
+	}
+	want := []string{"urgent2", "mid1", "mid2", "low1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop: %v, want %v", got, want)
+	}
+
+	mc.Clear()
+	if !add("low3", 10) {
+		t.Fatal("Add after Clear returned false")
+	}
+}
+
 func TestMessagerCacheEmpty(t *testing.T) {
-	mc := newCache(2)
+	mc := newCache(2, nil)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -111,6 +111,21 @@ func (me *Engine) GetGenerator(name string) (QueryGenerator, error) {
 	return mm, nil
 }
 
+// GetDeadLetterGenerator returns the generator of the dead-letter queries of
+// a message table. The table must park its messages after vt_max_attempts.
+func (me *Engine) GetDeadLetterGenerator(name string) (DeadLetterQueryGenerator, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	if mm.maxAttempts == 0 || mm.deadLetter != schema.DeadLetterPark {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "message table %s does not park its messages after vt_max_attempts", name)
+	}
+	return mm, nil
+}
+
 // Subscribe subscribes to messages from the requested table.
 // The function returns a done channel that will be closed when
 // the subscription ends, which can be initiated by the send function
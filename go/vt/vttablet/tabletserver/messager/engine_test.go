@@ -155,6 +155,35 @@ func TestEngineGenerate(t *testing.T) {
 	}
 }
 
+func TestEngineGenerateDeadLetters(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	engine := newTestEngine(db)
+	defer engine.Close()
+	parking := &schema.MessageInfo{}
+	*parking = *meTable.MessageInfo
+	parking.MaxAttempts = 3
+	engine.schemaChanged(map[string]*schema.Table{
+		"t1": meTable,
+		"t2": {
+			Type:        schema.Message,
+			MessageInfo: parking,
+		},
+	}, []string{"t1", "t2"}, nil, nil)
+
+	if _, err := engine.GetDeadLetterGenerator("t2"); err != nil {
+		t.Error(err)
+	}
+	_, err := engine.GetDeadLetterGenerator("t1")
+	if got, want := vterrors.Code(err), vtrpcpb.Code_FAILED_PRECONDITION; got != want {
+		t.Errorf("engine.GetDeadLetterGenerator(not parking) error code: %v, want %v", got, want)
+	}
+	_, err = engine.GetDeadLetterGenerator("t3")
+	if got, want := vterrors.Code(err), vtrpcpb.Code_INVALID_ARGUMENT; got != want {
+		t.Errorf("engine.GetDeadLetterGenerator(invalid) error code: %v, want %v", got, want)
+	}
+}
+
 func newTestEngine(db *fakesqldb.DB) *Engine {
 	config := tabletenv.NewDefaultConfig()
 	tsv := &fakeTabletServer{
@@ -53,6 +53,16 @@ type QueryGenerator interface {
 	GeneratePurgeQuery(timeCutoff int64) (string, map[string]*querypb.BindVariable)
 }
 
+// DeadLetterQueryGenerator generates the queries on the dead-lettered
+// messages of a table, which are the messages parked after being sent
+// vt_max_attempts times. If ids is empty, the queries apply to all the
+// dead-lettered messages.
+type DeadLetterQueryGenerator interface {
+	GenerateDeadLetterListQuery(limit int) (string, map[string]*querypb.BindVariable)
+	GenerateRequeueQuery(ids []string) (string, map[string]*querypb.BindVariable)
+	GenerateDeadLetterPurgeQuery(ids []string) (string, map[string]*querypb.BindVariable)
+}
+
 type messageReceiver struct {
 	ctx     context.Context
 	errChan chan error
@@ -174,6 +184,10 @@ type messageManager struct {
 	purgeAfter   time.Duration
 	minBackoff   time.Duration
 	maxBackoff   time.Duration
+	multiplier   float64
+	jitter       float64
+	maxAttempts  int
+	deadLetter   schema.DeadLetterAction
 	batchSize    int
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
@@ -217,6 +231,14 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+
+	// The dead-letter queries, the All ones apply to all the dead-lettered
+	// messages instead of a list of ids.
+	deadLetterListQuery     *sqlparser.ParsedQuery
+	requeueQuery            *sqlparser.ParsedQuery
+	requeueAllQuery         *sqlparser.ParsedQuery
+	deadLetterPurgeQuery    *sqlparser.ParsedQuery
+	deadLetterPurgeAllQuery *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -234,8 +256,12 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		purgeAfter:      table.MessageInfo.PurgeAfterDuration,
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
+		multiplier:      table.MessageInfo.BackoffMultiplier,
+		jitter:          table.MessageInfo.BackoffJitter,
+		maxAttempts:     table.MessageInfo.MaxAttempts,
+		deadLetter:      table.MessageInfo.DeadLetter,
 		batchSize:       table.MessageInfo.BatchSize,
-		cache:           newCache(table.MessageInfo.CacheSize),
+		cache:           newCache(table.MessageInfo.CacheSize, table.MessageInfo.PriorityLanes),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
 		purgeTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
 		postponeSema:    postponeSema,
@@ -260,28 +286,65 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 	mm.purgeQuery = sqlparser.BuildParsedQuery(
 		"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
 
-	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
+	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff, mm.multiplier, mm.maxAttempts, mm.deadLetter)
+
+	// Parked messages are never acked, and are not scheduled any more.
+	deadLettered := "time_acked is null and time_next is null and epoch >= %a"
+	mm.deadLetterListQuery = sqlparser.BuildParsedQuery(
+		"select epoch, %s from %v where "+deadLettered+" order by id limit %a",
+		columnList, mm.name, ":max_attempts", ":max")
+	// The requeued messages are sent right away, as if they were new.
+	mm.requeueQuery = sqlparser.BuildParsedQuery(
+		"update %v set time_next = %a, epoch = 0 where "+deadLettered+" and id in %a",
+		mm.name, ":time_now", ":max_attempts", "::ids")
+	mm.requeueAllQuery = sqlparser.BuildParsedQuery(
+		"update %v set time_next = %a, epoch = 0 where "+deadLettered,
+		mm.name, ":time_now", ":max_attempts")
+	mm.deadLetterPurgeQuery = sqlparser.BuildParsedQuery(
+		"delete from %v where "+deadLettered+" and id in %a",
+		mm.name, ":max_attempts", "::ids")
+	mm.deadLetterPurgeAllQuery = sqlparser.BuildParsedQuery(
+		"delete from %v where "+deadLettered,
+		mm.name, ":max_attempts")
 
 	return mm
 }
 
-func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.Duration) *sqlparser.ParsedQuery {
+// buildPostponeQuery builds the query which postpones the messages after
+// they're sent. If maxAttempts is set, the messages which were sent as many
+// times are dead-lettered instead.
+func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.Duration, multiplier float64, maxAttempts int, deadLetter schema.DeadLetterAction) *sqlparser.ParsedQuery {
 	var args []interface{}
 
+	buf := bytes.NewBufferString("update %v set time_next = ")
+	args = append(args, name)
+
+	// dead-lettered messages are not sent any more
+	if maxAttempts > 0 {
+		buf.WriteString("IF(ifnull(epoch, 0)+1 >= %a, NULL, ")
+		args = append(args, ":max_attempts")
+	}
+
 	// since messages are immediately postponed upon sending, we need to add exponential backoff on top
 	// of the ackWaitTime, otherwise messages will be resent too quickly.
-	buf := bytes.NewBufferString("update %v set time_next = %a + %a + ")
-	args = append(args, name, ":time_now", ":wait_time")
+	buf.WriteString("%a + %a + ")
+	args = append(args, ":time_now", ":wait_time")
 
-	// have backoff be +/- 33%, whenever this is injected, append (:min_backoff, :jitter)
+	// have backoff be +/- the jitter, whenever this is injected, append jitteredBackoffArgs
 	jitteredBackoff := "FLOOR((%a<<ifnull(epoch, 0)) * %a)"
+	jitteredBackoffArgs := []interface{}{":min_backoff", ":jitter"}
+	if multiplier != 0 {
+		jitteredBackoff = "FLOOR(%a * POW(%a, ifnull(epoch, 0)) * %a)"
+		jitteredBackoffArgs = []interface{}{":min_backoff", ":backoff_multiplier", ":jitter"}
+	}
 
 	//
 	// if the jittered backoff is less than min_backoff, just set it to :min_backoff
 	//
 	buf.WriteString(fmt.Sprintf("IF(%s < %%a, %%a, ", jitteredBackoff))
 	// jitteredBackoff < :min_backoff
-	args = append(args, ":min_backoff", ":jitter", ":min_backoff")
+	args = append(args, jitteredBackoffArgs...)
+	args = append(args, ":min_backoff")
 	// if it is less, then use :min_backoff
 	args = append(args, ":min_backoff")
 
@@ -289,21 +352,32 @@ func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.D
 	if maxBackoff == 0 {
 		// if there is no max_backoff, just use jitteredBackoff
 		buf.WriteString(jitteredBackoff)
-		args = append(args, ":min_backoff", ":jitter")
+		args = append(args, jitteredBackoffArgs...)
 	} else {
 		// make sure that it doesn't exceed max_backoff
 		buf.WriteString(fmt.Sprintf("IF(%s > %%a, %%a, %s)", jitteredBackoff, jitteredBackoff))
 		// jitteredBackoff > :max_backoff
-		args = append(args, ":min_backoff", ":jitter", ":max_backoff")
+		args = append(args, jitteredBackoffArgs...)
+		args = append(args, ":max_backoff")
 		// if it is greater, then use :max_backoff
 		args = append(args, ":max_backoff")
 		// otherwise just use jitteredBackoff
-		args = append(args, ":min_backoff", ":jitter")
+		args = append(args, jitteredBackoffArgs...)
 	}
 
 	// close the if statement
 	buf.WriteString(")")
 
+	if maxAttempts > 0 {
+		// close the dead-letter if statement
+		buf.WriteString(")")
+		if deadLetter == schema.DeadLetterAck {
+			// epoch is not incremented yet
+			buf.WriteString(", time_acked = IF(ifnull(epoch, 0)+1 >= %a, %a, NULL)")
+			args = append(args, ":max_attempts", ":time_now")
+		}
+	}
+
 	// now that we've identified time_next, finish the statement
 	buf.WriteString(", epoch = ifnull(epoch, 0)+1 where id in %a and time_acked is null")
 	args = append(args, "::ids")
@@ -730,6 +804,10 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 		if mr.TimeAcked != 0 || mr.TimeNext > now {
 			continue
 		}
+		// Dead-lettered messages are not sent any more.
+		if mm.maxAttempts > 0 && mr.Epoch >= int64(mm.maxAttempts) {
+			continue
+		}
 		mm.Add(mr)
 	}
 	return nil
@@ -784,9 +862,10 @@ func (mm *messageManager) runPoller() {
 			log.Errorf("Error reading message row: %v", err)
 			continue
 		}
+		// The rows are read in order of priority, the lanes of the
+		// next rows may not be full yet.
 		if !mm.cache.Add(mr) {
 			mm.messagesPending = true
-			return
 		}
 	}
 }
@@ -849,13 +928,19 @@ func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[strin
 		"time_now":    sqltypes.Int64BindVariable(time.Now().UnixNano()),
 		"wait_time":   sqltypes.Int64BindVariable(int64(mm.ackWaitTime)),
 		"min_backoff": sqltypes.Int64BindVariable(int64(mm.minBackoff)),
-		"jitter":      sqltypes.Float64BindVariable(.666666 + rand.Float64()*.666666),
+		"jitter":      sqltypes.Float64BindVariable(1 - mm.jitter + rand.Float64()*2*mm.jitter),
 		"ids":         idbvs,
 	}
 
 	if mm.maxBackoff > 0 {
 		bvs["max_backoff"] = sqltypes.Int64BindVariable(int64(mm.maxBackoff))
 	}
+	if mm.multiplier != 0 {
+		bvs["backoff_multiplier"] = sqltypes.Float64BindVariable(mm.multiplier)
+	}
+	if mm.maxAttempts > 0 {
+		bvs["max_attempts"] = sqltypes.Int64BindVariable(int64(mm.maxAttempts))
+	}
 
 	return mm.postponeQuery.Query, bvs
 }
@@ -867,6 +952,56 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GenerateDeadLetterListQuery returns the query and bind vars for reading at
+// most limit dead-lettered messages, with their epoch as first column.
+func (mm *messageManager) GenerateDeadLetterListQuery(limit int) (string, map[string]*querypb.BindVariable) {
+	return mm.deadLetterListQuery.Query, map[string]*querypb.BindVariable{
+		"max_attempts": sqltypes.Int64BindVariable(int64(mm.maxAttempts)),
+		"max":          sqltypes.Int64BindVariable(int64(limit)),
+	}
+}
+
+// GenerateRequeueQuery returns the query and bind vars for scheduling
+// dead-lettered messages again, with a reset epoch.
+func (mm *messageManager) GenerateRequeueQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+	bvs := map[string]*querypb.BindVariable{
+		"time_now":     sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"max_attempts": sqltypes.Int64BindVariable(int64(mm.maxAttempts)),
+	}
+	if len(ids) == 0 {
+		return mm.requeueAllQuery.Query, bvs
+	}
+	bvs["ids"] = idsBindVariable(ids)
+	return mm.requeueQuery.Query, bvs
+}
+
+// GenerateDeadLetterPurgeQuery returns the query and bind vars for deleting
+// dead-lettered messages.
+func (mm *messageManager) GenerateDeadLetterPurgeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+	bvs := map[string]*querypb.BindVariable{
+		"max_attempts": sqltypes.Int64BindVariable(int64(mm.maxAttempts)),
+	}
+	if len(ids) == 0 {
+		return mm.deadLetterPurgeAllQuery.Query, bvs
+	}
+	bvs["ids"] = idsBindVariable(ids)
+	return mm.deadLetterPurgeQuery.Query, bvs
+}
+
+func idsBindVariable(ids []string) *querypb.BindVariable {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARBINARY,
+			Value: []byte(id),
+		})
+	}
+	return idbvs
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
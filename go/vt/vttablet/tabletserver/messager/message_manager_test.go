@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/test/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
@@ -204,6 +205,35 @@ func TestMessageManagerAdd(t *testing.T) {
 	}
 }
 
+func TestMessageManagerAddPriorityLanes(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.CacheSize = 1
+	ti.MessageInfo.PriorityLanes = []schema.PriorityLane{{MaxPriority: 0, CacheSize: 1}}
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(0)
+	go func() { <-r1.ch }()
+	mm.Subscribe(context.Background(), r1.rcv)
+
+	if !mm.Add(&MessageRow{Priority: 5, Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}}) {
+		t.Error("Add(1 receiver): false, want true")
+	}
+	// Make sure message is enqueued.
+	r1.WaitForCount(2)
+	// This will fill up the default lane.
+	mm.Add(&MessageRow{Priority: 5, Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
+
+	if mm.Add(&MessageRow{Priority: 5, Row: []sqltypes.Value{sqltypes.NewVarBinary("3")}}) {
+		t.Error("Add(lane full): true, want false")
+	}
+	// The urgent message still fits in its lane.
+	if !mm.Add(&MessageRow{Priority: 0, Row: []sqltypes.Value{sqltypes.NewVarBinary("4")}}) {
+		t.Error("Add(urgent): false, want true")
+	}
+}
+
 func TestMessageManagerSend(t *testing.T) {
 	tsv := newFakeTabletServer()
 	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
@@ -824,6 +854,100 @@ func TestMMGenerateWithBackoff(t *testing.T) {
 	}
 }
 
+func TestMMGenerateWithBackoffPolicy(t *testing.T) {
+	ti := newMMTableWithBackoff()
+	ti.MessageInfo.BackoffMultiplier = 1.5
+	ti.MessageInfo.BackoffJitter = 0
+	ti.MessageInfo.MaxAttempts = 3
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	query, bv := mm.GeneratePostponeQuery([]string{"1", "2"})
+	wantQuery := "update foo set time_next = IF(ifnull(epoch, 0)+1 >= :max_attempts, NULL, :time_now + :wait_time + IF(FLOOR(:min_backoff * POW(:backoff_multiplier, ifnull(epoch, 0)) * :jitter) < :min_backoff, :min_backoff, IF(FLOOR(:min_backoff * POW(:backoff_multiplier, ifnull(epoch, 0)) * :jitter) > :max_backoff, :max_backoff, FLOOR(:min_backoff * POW(:backoff_multiplier, ifnull(epoch, 0)) * :jitter)))), epoch = ifnull(epoch, 0)+1 where id in ::ids and time_acked is null"
+	assert.Equal(t, wantQuery, query)
+	delete(bv, "time_now")
+	wantbv := map[string]*querypb.BindVariable{
+		"wait_time":          sqltypes.Int64BindVariable(1e10),
+		"min_backoff":        sqltypes.Int64BindVariable(1e9),
+		"max_backoff":        sqltypes.Int64BindVariable(4e9),
+		"backoff_multiplier": sqltypes.Float64BindVariable(1.5),
+		"max_attempts":       sqltypes.Int64BindVariable(3),
+		// There is no jitter.
+		"jitter": sqltypes.Float64BindVariable(1),
+		"ids":    sqltypes.TestBindVariable([]interface{}{"1", "2"}),
+	}
+	assert.Equal(t, wantbv, bv)
+
+	// The messages which exhausted their attempts are acked.
+	ti.MessageInfo.DeadLetter = schema.DeadLetterAck
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	query, _ = mm.GeneratePostponeQuery([]string{"1"})
+	assert.Contains(t, query, "))), time_acked = IF(ifnull(epoch, 0)+1 >= :max_attempts, :time_now, NULL), epoch = ifnull(epoch, 0)+1 where")
+}
+
+func TestMMGenerateDeadLetterQueries(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.MaxAttempts = 3
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+
+	query, bv := mm.GenerateDeadLetterListQuery(10)
+	assert.Equal(t, "select epoch, id, message from foo where time_acked is null and time_next is null and epoch >= :max_attempts order by id limit :max", query)
+	assert.Equal(t, map[string]*querypb.BindVariable{
+		"max_attempts": sqltypes.Int64BindVariable(3),
+		"max":          sqltypes.Int64BindVariable(10),
+	}, bv)
+
+	query, bv = mm.GenerateRequeueQuery([]string{"1", "2"})
+	assert.Equal(t, "update foo set time_next = :time_now, epoch = 0 where time_acked is null and time_next is null and epoch >= :max_attempts and id in ::ids", query)
+	// time_now cannot be compared.
+	delete(bv, "time_now")
+	wantbv := map[string]*querypb.BindVariable{
+		"max_attempts": sqltypes.Int64BindVariable(3),
+		"ids":          sqltypes.TestBindVariable([]interface{}{"1", "2"}),
+	}
+	utils.MustMatch(t, wantbv, bv, "did not match")
+
+	query, _ = mm.GenerateRequeueQuery(nil)
+	assert.Equal(t, "update foo set time_next = :time_now, epoch = 0 where time_acked is null and time_next is null and epoch >= :max_attempts", query)
+
+	query, bv = mm.GenerateDeadLetterPurgeQuery([]string{"1", "2"})
+	assert.Equal(t, "delete from foo where time_acked is null and time_next is null and epoch >= :max_attempts and id in ::ids", query)
+	utils.MustMatch(t, wantbv, bv, "did not match")
+
+	query, bv = mm.GenerateDeadLetterPurgeQuery(nil)
+	assert.Equal(t, "delete from foo where time_acked is null and time_next is null and epoch >= :max_attempts", query)
+	assert.Equal(t, map[string]*querypb.BindVariable{
+		"max_attempts": sqltypes.Int64BindVariable(3),
+	}, bv)
+}
+
+func TestMessageManagerSkipsDeadLetters(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.MaxAttempts = 2
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	err := mm.processRowEvent(testDBFields, &binlogdatapb.RowEvent{
+		TableName: "foo",
+		RowChanges: []*binlogdatapb.RowChange{{
+			// Parked after its second attempt.
+			After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(0), sqltypes.NULL, sqltypes.NewInt64(2), sqltypes.NULL, sqltypes.NewInt64(1), sqltypes.NewVarBinary("1")}),
+		}, {
+			After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(0), sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NULL, sqltypes.NewInt64(2), sqltypes.NewVarBinary("2")}),
+		}},
+	})
+	require.NoError(t, err)
+
+	qr := <-r1.ch
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(2), sqltypes.NewVarBinary("2")}}, qr.Rows)
+}
+
 type fakeTabletServer struct {
 	tabletenv.Env
 	postponeCount sync2.AtomicInt64
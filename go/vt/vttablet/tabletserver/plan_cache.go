@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func (ep *TabletPlan) recordCacheHit() {
+	atomic.AddUint64(&ep.CacheHits, 1)
+	atomic.StoreInt64(&ep.LastUsed, time.Now().UnixNano())
+}
+
+// tables returns the tables the plan depends on.
+func (ep *TabletPlan) tables() []string {
+	var tables []string
+	seen := make(map[string]bool)
+	add := func(table string) {
+		if table != "" && !seen[table] {
+			seen[table] = true
+			tables = append(tables, table)
+		}
+	}
+	add(ep.TableName().String())
+	for _, p := range ep.Permissions {
+		add(p.TableName)
+	}
+	return tables
+}
+
+// PlanCacheEntry describes a plan of the plan cache.
+type PlanCacheEntry struct {
+	Query      string
+	Tables     []string
+	Plan       planbuilder.PlanType
+	CacheHits  uint64
+	LastUsed   time.Time
+	QueryCount uint64
+}
+
+// PlanCacheEntries returns the cached plans, the most recently used first.
+func (qe *QueryEngine) PlanCacheEntries() []PlanCacheEntry {
+	var entries []PlanCacheEntry
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		entries = append(entries, PlanCacheEntry{
+			Query:      sqlparser.TruncateForUI(plan.Original),
+			Tables:     plan.tables(),
+			Plan:       plan.PlanID,
+			CacheHits:  atomic.LoadUint64(&plan.CacheHits),
+			LastUsed:   time.Unix(0, atomic.LoadInt64(&plan.LastUsed)),
+			QueryCount: atomic.LoadUint64(&plan.QueryCount),
+		})
+		return true
+	})
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].LastUsed.After(entries[j].LastUsed)
+	})
+	return entries
+}
+
+// EvictPlans removes the plans which depend on the table, and whose query
+// matches the pattern, from the plan cache, so that they are compiled again
+// when they are next used. An empty table, or a nil pattern, matches all the
+// plans. It returns the number of evicted plans.
+func (qe *QueryEngine) EvictPlans(table string, pattern *regexp.Regexp) int {
+	var evicted []string
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		if pattern != nil && !pattern.MatchString(plan.Original) {
+			return true
+		}
+		if table != "" {
+			found := false
+			for _, t := range plan.tables() {
+				if t == table {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return true
+			}
+		}
+		evicted = append(evicted, plan.Original)
+		return true
+	})
+	for _, sql := range evicted {
+		qe.plans.Delete(sql)
+	}
+	return len(evicted)
+}
+
+func (qe *QueryEngine) handleHTTPPlanCache(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(qe.PlanCacheEntries(), "", "  ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	response.Write(b)
+}
+
+// handleHTTPPlanCacheEvict evicts the plans matching the table and pattern
+// parameters. If reload_schema is set, the schema is reloaded first, so that
+// the evicted plans are compiled with the current schema of the tables.
+func (qe *QueryEngine) handleHTTPPlanCacheEvict(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	if err := request.ParseForm(); err != nil {
+		http.Error(response, fmt.Sprintf("cannot parse form: %s", err), http.StatusBadRequest)
+		return
+	}
+	var pattern *regexp.Regexp
+	if p := request.FormValue("pattern"); p != "" {
+		var err error
+		if pattern, err = regexp.Compile(p); err != nil {
+			http.Error(response, fmt.Sprintf("invalid pattern: %s", err), http.StatusBadRequest)
+			return
+		}
+	}
+	if request.FormValue("reload_schema") != "" {
+		if err := qe.se.Reload(tabletenv.LocalContext()); err != nil {
+			http.Error(response, fmt.Sprintf("cannot reload schema: %s", err), http.StatusInternalServerError)
+			return
+		}
+	}
+	evicted := qe.EvictPlans(request.FormValue("table"), pattern)
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	fmt.Fprintf(response, "{\"Evicted\": %d}\n", evicted)
+}
@@ -75,6 +75,10 @@ const (
 	PlanAlterMigration
 	PlanRevertMigration
 	PlanShowMigrationLogs
+	// PlanSelectNoWait is for selects locking their rows with NOWAIT or
+	// SKIP LOCKED, which do not wait for the rows locked by other
+	// transactions.
+	PlanSelectNoWait
 	NumPlans
 )
 
@@ -107,6 +111,7 @@ var planName = []string{
 	"AlterMigration",
 	"RevertMigration",
 	"ShowMigrationLogs",
+	"SelectNoWait",
 }
 
 func (pt PlanType) String() string {
@@ -138,7 +143,7 @@ func PlanByNameIC(s string) (pt PlanType, ok bool) {
 
 // IsSelect returns true if PlanType is about a select query.
 func (pt PlanType) IsSelect() bool {
-	return pt == PlanSelect || pt == PlanSelectImpossible
+	return pt == PlanSelect || pt == PlanSelectImpossible || pt == PlanSelectNoWait
 }
 
 // MarshalJSON returns a json string for PlanType.
@@ -60,6 +60,9 @@ type TabletPlan struct {
 	Fields     []*querypb.Field
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
+	// ResultCacheTables are the tables read by the query if its results
+	// can be cached, nil otherwise.
+	ResultCacheTables []string
 
 	QueryCount   uint64
 	Time         uint64
@@ -67,6 +70,11 @@ type TabletPlan struct {
 	RowsAffected uint64
 	RowsReturned uint64
 	ErrorCount   uint64
+
+	// CacheHits counts the lookups of the plan cache which returned the
+	// plan, and LastUsed is the time of the last one, in unix nanoseconds.
+	CacheHits uint64
+	LastUsed  int64
 }
 
 // AddStats updates the stats for the current TabletPlan.
@@ -121,6 +129,12 @@ type QueryEngine struct {
 	// Pools
 	conns       *connpool.Pool
 	streamConns *connpool.Pool
+	// isolationConns are the partitions of conns dedicated to the
+	// queries of sessions which changed their isolation level.
+	isolationConns map[querypb.ExecuteOptions_TransactionIsolation]*connpool.Pool
+	// dbaConns is the pool of the DBA workload. It is nil if the
+	// workload shares the other pools.
+	dbaConns *connpool.Pool
 
 	// Services
 	consolidator       *sync2.Consolidator
@@ -131,6 +145,10 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	// resultCache is nil unless queryserver-config-result-cache-memory is set.
+	resultCache *resultCache
+	// memory limits the memory of the results of the queries.
+	memory *memoryTracker
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -177,6 +195,15 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
+	if config.IsolationLevelPool.Size > 0 {
+		qe.isolationConns = map[querypb.ExecuteOptions_TransactionIsolation]*connpool.Pool{
+			querypb.ExecuteOptions_READ_COMMITTED:  connpool.NewIsolationLevelPool(env, "ReadCommittedConnPool", config.IsolationLevelPool, txIsolations[querypb.ExecuteOptions_READ_COMMITTED].setIsolationLevel),
+			querypb.ExecuteOptions_REPEATABLE_READ: connpool.NewIsolationLevelPool(env, "RepeatableReadConnPool", config.IsolationLevelPool, txIsolations[querypb.ExecuteOptions_REPEATABLE_READ].setIsolationLevel),
+		}
+	}
+	if config.DbaPool.Size > 0 {
+		qe.dbaConns = connpool.NewPool(env, "DbaConnPool", config.DbaPool)
+	}
 	qe.consolidatorMode.Set(config.Consolidator)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
@@ -184,6 +211,8 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
 	}
 	qe.txSerializer = txserializer.New(env)
+	qe.resultCache = newResultCache(env)
+	qe.memory = newMemoryTracker(env)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -228,7 +257,10 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
+	env.Exporter().HandleFunc("/debug/hotrows/config", qe.txSerializer.ServeConfigHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
+	env.Exporter().HandleFunc("/debug/plan_cache", qe.handleHTTPPlanCache)
+	env.Exporter().HandleFunc("/debug/plan_cache/evict", qe.handleHTTPPlanCacheEvict)
 	env.Exporter().HandleFunc("/debug/query_stats", qe.handleHTTPQueryStats)
 	env.Exporter().HandleFunc("/debug/query_rules", qe.handleHTTPQueryRules)
 	env.Exporter().HandleFunc("/debug/consolidations", qe.handleHTTPConsolidations)
@@ -262,6 +294,12 @@ func (qe *QueryEngine) Open() error {
 	}
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	for _, pool := range qe.isolationConns {
+		pool.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	}
+	if qe.dbaConns != nil {
+		qe.dbaConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	}
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
 	qe.isOpen = true
 	return nil
@@ -277,7 +315,16 @@ func (qe *QueryEngine) Close() {
 	// Close in reverse order of Open.
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
+	if qe.resultCache != nil {
+		qe.resultCache.entries.Clear()
+	}
 	qe.tables = make(map[string]*schema.Table)
+	if qe.dbaConns != nil {
+		qe.dbaConns.Close()
+	}
+	for _, pool := range qe.isolationConns {
+		pool.Close()
+	}
 	qe.streamConns.Close()
 	qe.conns.Close()
 	qe.isOpen = false
@@ -291,6 +338,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 
 	if plan := qe.getQuery(sql); plan != nil {
 		logStats.CachedPlan = true
+		plan.recordCacheHit()
 		return plan, nil
 	}
 
@@ -330,10 +378,14 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 			}
 			plan.Fields = r.Fields
 		}
+		if qe.resultCache != nil && plan.PlanID == planbuilder.PlanSelect && isResultCacheable(statement) {
+			plan.ResultCacheTables = readTables(splan)
+		}
 	} else if plan.PlanID == planbuilder.PlanDDL || plan.PlanID == planbuilder.PlanSet {
 		return plan, nil
 	}
 	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) {
+		atomic.StoreInt64(&plan.LastUsed, time.Now().UnixNano())
 		qe.plans.Set(sql, plan)
 	}
 	return plan, nil
@@ -26,6 +26,7 @@ import (
 	"os"
 	"path"
 	"reflect"
+	"regexp"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -220,6 +221,60 @@ func TestQueryPlanCache(t *testing.T) {
 	qe.ClearQueryPlanCache()
 }
 
+func TestPlanCacheEvict(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{})
+	db.AddQuery("select * from test_table_02 where 1 != 1", &sqltypes.Result{})
+
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	queries := []string{
+		"select * from test_table_01",
+		"select * from test_table_01 where pk = 1",
+		"select * from test_table_02",
+	}
+	for _, query := range queries {
+		_, err := qe.GetPlan(ctx, logStats, query, false, false /* inReservedConn */)
+		require.NoError(t, err)
+	}
+	qe.plans.Wait()
+	_, err := qe.GetPlan(ctx, logStats, queries[2], false, false /* inReservedConn */)
+	require.NoError(t, err)
+
+	entries := qe.PlanCacheEntries()
+	require.Len(t, entries, 3)
+	require.Equal(t, queries[2], entries[0].Query)
+	require.Equal(t, []string{"test_table_02"}, entries[0].Tables)
+	require.EqualValues(t, 1, entries[0].CacheHits)
+	require.EqualValues(t, 0, entries[1].CacheHits)
+
+	request, _ := http.NewRequest("GET", "/debug/plan_cache/evict?table=test_table_01&pattern=pk", nil)
+	response := httptest.NewRecorder()
+	qe.handleHTTPPlanCacheEvict(response, request)
+	require.Equal(t, "{\"Evicted\": 1}\n", response.Body.String())
+	qe.plans.Wait()
+	require.Equal(t, 2, qe.plans.Len())
+
+	require.Equal(t, 1, qe.EvictPlans("test_table_02", nil))
+	require.Equal(t, 1, qe.EvictPlans("", regexp.MustCompile("test_table")))
+	qe.plans.Wait()
+	require.Equal(t, 0, qe.plans.Len())
+
+	request, _ = http.NewRequest("GET", "/debug/plan_cache/evict?pattern=(", nil)
+	response = httptest.NewRecorder()
+	qe.handleHTTPPlanCacheEvict(response, request)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+}
+
 func TestNoQueryPlanCache(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
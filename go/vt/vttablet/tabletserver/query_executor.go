@@ -23,6 +23,8 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"context"
@@ -30,18 +32,22 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/audit"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	p "vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
+	auditpb "vitess.io/vitess/go/vt/proto/audit"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -59,6 +65,13 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// ruleTimeout is set by checkPermissions if a query rule with the
+	// TIMEOUT action fired.
+	ruleTimeout time.Duration
+
+	// memory is the memory of the results, set by trackMemory.
+	memory *queryMemory
 }
 
 const streamRowsSize = 256
@@ -91,7 +104,13 @@ var sequenceFields = []*querypb.Field{
 	},
 }
 
+var logSequenceExhaustion = logutil.NewThrottledLogger("SequenceExhaustion", 1*time.Minute)
+
 func (qre *QueryExecutor) shouldConsolidate() bool {
+	// A query started before the replica caught up could return stale rows.
+	if qre.options.GetWaitForGtidSet() != "" {
+		return false
+	}
 	cm := qre.tsv.qe.consolidatorMode.Get()
 	return cm == tabletenv.Enable || ((cm == tabletenv.NotOnMaster || cm == tabletenv.NotOnPrimary) && qre.tabletType != topodatapb.TabletType_PRIMARY)
 }
@@ -101,6 +120,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	planName := qre.plan.PlanID.String()
 	qre.logStats.PlanType = planName
 	defer func(start time.Time) {
+		qre.memory.done()
 		duration := time.Since(start)
 		qre.tsv.stats.QueryTimings.Add(planName, duration)
 		qre.recordUserQuery("Execute", int64(duration))
@@ -126,6 +146,8 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	cancel := qre.applyRuleTimeout()
+	defer cancel()
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -151,13 +173,26 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	}
 
 	switch qre.plan.PlanID {
-	case p.PlanSelect, p.PlanSelectImpossible, p.PlanShow:
+	case p.PlanSelect, p.PlanSelectNoWait, p.PlanSelectImpossible, p.PlanShow:
+		if err := qre.waitForGTIDSet(); err != nil {
+			return nil, err
+		}
 		maxrows := qre.getSelectLimit()
 		qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
 		if qre.bindVars[sqltypes.BvReplaceSchemaName] != nil {
 			qre.bindVars[sqltypes.BvSchemaName] = sqltypes.StringBindVariable(qre.tsv.config.DB.DBName)
 		}
-		qr, err := qre.execSelect()
+		var qr *sqltypes.Result
+		var err error
+		// Results read with a non default isolation level may include
+		// uncommitted rows, so they are not cached. Neither are the results
+		// of the replicas, whose tables are modified by the replication
+		// stream rather than by their query service.
+		if rc := qre.tsv.qe.resultCache; rc != nil && qre.plan.ResultCacheTables != nil && qre.tabletType == topodatapb.TabletType_PRIMARY && qre.options.GetTransactionIsolation() == querypb.ExecuteOptions_DEFAULT && qre.options.GetWaitForGtidSet() == "" {
+			qr, err = qre.execSelectCached(rc)
+		} else {
+			qr, err = qre.execSelect()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -189,6 +224,7 @@ func (qre *QueryExecutor) execAutocommit(f func(conn *StatefulConnection) (*sqlt
 	if qre.options == nil {
 		qre.options = &querypb.ExecuteOptions{}
 	}
+	isolation := qre.options.TransactionIsolation
 	qre.options.TransactionIsolation = querypb.ExecuteOptions_AUTOCOMMIT
 
 	conn, _, err := qre.tsv.te.txPool.Begin(qre.ctx, qre.options, false, 0, nil)
@@ -198,6 +234,14 @@ func (qre *QueryExecutor) execAutocommit(f func(conn *StatefulConnection) (*sqlt
 	}
 	defer qre.tsv.te.txPool.RollbackAndRelease(qre.ctx, conn)
 
+	// The statement is its own transaction, so it has to run with the
+	// isolation level of the session.
+	if queries, ok := txIsolations[isolation]; ok && queries.setIsolationLevel != "" {
+		if err := conn.execWithRetry(qre.ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
+			return nil, err
+		}
+	}
+
 	return f(conn)
 }
 
@@ -222,6 +266,11 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*s
 		return nil, err
 	}
 
+	if err := qre.tsv.te.txPool.largeTxs.check(conn.TxProperties()); err != nil {
+		// The deferred RollbackAndRelease rolls the transaction back.
+		defer qre.logStats.AddRewrittenSQL("rollback", time.Now())
+		return nil, err
+	}
 	defer qre.logStats.AddRewrittenSQL("commit", time.Now())
 	if _, err := qre.tsv.te.txPool.Commit(qre.ctx, conn); err != nil {
 		return nil, err
@@ -230,6 +279,9 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*s
 }
 
 func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result, error) {
+	if rc := qre.tsv.qe.resultCache; rc != nil {
+		defer rc.trackWrites(conn, qre.plan.Plan)()
+	}
 	switch qre.plan.PlanID {
 	case p.PlanInsert, p.PlanUpdate, p.PlanDelete, p.PlanSet:
 		return qre.txFetch(conn, true)
@@ -242,7 +294,7 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 		return qre.execStatefulConn(conn, qre.query, true)
 	case p.PlanSavepoint, p.PlanRelease, p.PlanSRollback:
 		return qre.execStatefulConn(conn, qre.query, true)
-	case p.PlanSelect, p.PlanSelectImpossible, p.PlanShow:
+	case p.PlanSelect, p.PlanSelectNoWait, p.PlanSelectImpossible, p.PlanShow:
 		maxrows := qre.getSelectLimit()
 		qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
 		if qre.bindVars[sqltypes.BvReplaceSchemaName] != nil {
@@ -271,6 +323,7 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
 	defer func(start time.Time) {
+		qre.memory.done()
 		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
 	}(time.Now())
@@ -278,6 +331,14 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	cancel := qre.applyRuleTimeout()
+	defer cancel()
+
+	if qre.connID == 0 {
+		if err := qre.waitForGTIDSet(); err != nil {
+			return err
+		}
+	}
 
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -371,6 +432,18 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 	return nil
 }
 
+// applyRuleTimeout shortens the deadline of the query context if a query
+// rule with the TIMEOUT action fired. The returned function must be called
+// once the query is done.
+func (qre *QueryExecutor) applyRuleTimeout() context.CancelFunc {
+	if qre.ruleTimeout == 0 {
+		return func() {}
+	}
+	ctx, cancel := context.WithTimeout(qre.ctx, qre.ruleTimeout)
+	qre.ctx = ctx
+	return cancel
+}
+
 // checkPermissions returns an error if the query does not pass all checks
 // (denied query, table ACL).
 func (qre *QueryExecutor) checkPermissions() error {
@@ -387,12 +460,14 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars, qre.marginComments)
+	action, timeout, desc := qre.plan.Rules.GetActionWithTimeout(remoteAddr, username, qre.bindVars, qre.marginComments)
 	switch action {
 	case rules.QRFail:
 		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
 	case rules.QRFailRetry:
 		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	case rules.QRTimeout:
+		qre.ruleTimeout = timeout
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -451,6 +526,19 @@ func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName
 			errStr := fmt.Sprintf("%s command denied to user '%s'%s for table '%s' (ACL check error)", qre.plan.PlanID.String(), callerID.Username, groupStr, tableName)
 			qre.tsv.Stats().TableaclDenied.Add(statsKey, 1)
 			qre.tsv.qe.accessCheckerLogger.Infof("%s", errStr)
+			audit.Record(&auditpb.Event{
+				Category:  auditpb.Category_TABLE_ACL,
+				Principal: callerID.Username,
+				Action:    qre.plan.PlanID.String(),
+				Resource:  tableName,
+				Outcome:   auditpb.Outcome_DENIED,
+				Error:     errStr,
+				Details: map[string]string{
+					"table_group": authorized.GroupName,
+					"keyspace":    qre.tsv.sm.target.Keyspace,
+					"tablet":      topoproto.TabletAliasString(qre.tsv.alias),
+				},
+			})
 			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%s", errStr)
 		}
 		return nil
@@ -523,6 +611,20 @@ func (*QueryExecutor) BeginAgain(ctx context.Context, dc *StatefulConnection) er
 	return nil
 }
 
+// recordSequenceRefill updates the stats of a sequence which reserved values in
+// its table, and alerts if it is close to its max value.
+func (qre *QueryExecutor) recordSequenceRefill(tableName string, maxValue int64) {
+	si := qre.plan.Table.SequenceInfo
+	remaining := maxValue + 1 - si.LastVal
+	stats := qre.tsv.stats
+	stats.SequenceRefills.Add(tableName, 1)
+	stats.SequenceRemaining.Set(tableName, remaining)
+	if si.ExhaustionAlert > 0 && float64(remaining) < si.ExhaustionAlert*float64(maxValue) {
+		stats.SequenceExhaustionAlerts.Add(tableName, 1)
+		logSequenceExhaustion.Warningf("Sequence %s has %d values left before its max value %d", tableName, remaining, maxValue)
+	}
+}
+
 func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 	inc, err := resolveNumber(qre.plan.NextCount, qre.bindVars)
 	if err != nil {
@@ -536,7 +638,10 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 	t := qre.plan.Table
 	t.SequenceInfo.Lock()
 	defer t.SequenceInfo.Unlock()
-	if t.SequenceInfo.NextVal == 0 || t.SequenceInfo.NextVal+inc > t.SequenceInfo.LastVal {
+	maxValue := t.SequenceInfo.MaxSequenceValue()
+	// A strict monotonic sequence caches no values, so that it reserves
+	// the values of every call in the sequence table.
+	if t.SequenceInfo.StrictMonotonic || t.SequenceInfo.NextVal == 0 || t.SequenceInfo.NextVal+inc > t.SequenceInfo.LastVal {
 		_, err := qre.execAsTransaction(func(conn *StatefulConnection) (*sqltypes.Result, error) {
 			query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
 			qr, err := qre.execStatefulConn(conn, query, false)
@@ -568,9 +673,20 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 			if cache < 1 {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid cache value for sequence %s: %d", tableName, cache)
 			}
-			newLast := nextID + cache
-			for newLast < t.SequenceInfo.NextVal+inc {
-				newLast += cache
+			if inc-1 > maxValue-t.SequenceInfo.NextVal {
+				return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "sequence %s is exhausted: cannot reserve %d values after %d with a max value of %d", tableName, inc, t.SequenceInfo.NextVal, maxValue)
+			}
+			newLast := t.SequenceInfo.NextVal + inc
+			if !t.SequenceInfo.StrictMonotonic {
+				// The cache never reserves values past the max value.
+				newLast = nextID
+				for newLast < t.SequenceInfo.NextVal+inc {
+					if cache > maxValue+1-newLast {
+						newLast = maxValue + 1
+						break
+					}
+					newLast += cache
+				}
 			}
 			query = fmt.Sprintf("update %s set next_id = %d where id = 0", sqlparser.String(tableName), newLast)
 			conn.TxProperties().RecordQuery(query)
@@ -584,6 +700,7 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 		if err != nil {
 			return nil, err
 		}
+		qre.recordSequenceRefill(tableName.String(), maxValue)
 	}
 	ret := t.SequenceInfo.NextVal
 	t.SequenceInfo.NextVal += inc
@@ -621,6 +738,74 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	return qre.execDBConn(conn, sql, true)
 }
 
+// execSelectCached serves the query from the result cache if possible, and
+// caches its result otherwise.
+func (qre *QueryExecutor) execSelectCached(rc *resultCache) (*sqltypes.Result, error) {
+	// The key does not include the margin comments, so that annotated
+	// queries share their results, but includes the options, which can
+	// change them.
+	key, err := qre.plan.FullQuery.GenerateQuery(qre.bindVars, nil)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
+	}
+	if qre.options != nil {
+		options, err := proto.MarshalOptions{Deterministic: true}.Marshal(qre.options)
+		if err != nil {
+			return nil, err
+		}
+		key += "\x00" + string(options)
+	}
+	tables := qre.plan.ResultCacheTables
+	qr, generations := rc.get(key, tables)
+	if qr != nil {
+		qre.logStats.QuerySources |= tabletenv.QuerySourceResultCache
+		return qr, nil
+	}
+	qr, err = qre.execSelect()
+	if err != nil {
+		return nil, err
+	}
+	rc.put(key, tables, generations, qr)
+	return qr, nil
+}
+
+// waitForGTIDSet makes a replica wait until it has executed the GTID set of
+// the options, so that the read sees the writes the client made on the primary.
+func (qre *QueryExecutor) waitForGTIDSet() error {
+	gtidSet := qre.options.GetWaitForGtidSet()
+	if gtidSet == "" || qre.tabletType == topodatapb.TabletType_PRIMARY {
+		return nil
+	}
+	span, _ := trace.NewSpan(qre.ctx, "QueryExecutor.waitForGTIDSet")
+	defer span.Finish()
+
+	timeout := time.Duration(qre.options.GetWaitForGtidSetTimeout()) * time.Millisecond
+	if timeout <= 0 {
+		// A timeout of 0 would make MySQL wait indefinitely.
+		timeout = time.Millisecond
+	}
+	conn, err := qre.getConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	start := time.Now()
+	var sql strings.Builder
+	sql.WriteString("select wait_for_executed_gtid_set(")
+	sqltypes.BufEncodeStringSQL(&sql, gtidSet)
+	fmt.Fprintf(&sql, ", %.3f)", timeout.Seconds())
+	qr, err := qre.execDBConn(conn, sql.String(), false)
+	qre.tsv.stats.WaitTimings.Record("GTIDSet", start)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || qr.Rows[0][0].ToString() != "0" {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "replica has not executed the GTID set %s within %v", gtidSet, timeout)
+	}
+	return nil
+}
+
 func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Result, error) {
 	maxrows := qre.tsv.qe.maxResultSize.Get()
 	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
@@ -650,6 +835,29 @@ func (qre *QueryExecutor) verifyRowCount(count, maxrows int64) error {
 	return nil
 }
 
+// trackMemory returns nil if the memory of the query is not limited.
+func (qre *QueryExecutor) trackMemory() *queryMemory {
+	if qre.memory == nil {
+		qre.memory = qre.tsv.qe.memory.track()
+	}
+	return qre.memory
+}
+
+// checkMemory accounts a buffered result, which is held until the query
+// returns. MySQL already returned the whole result, so the query cannot be
+// killed early: the result is dropped instead.
+func (qre *QueryExecutor) checkMemory(result *sqltypes.Result, err error) (*sqltypes.Result, error) {
+	memory := qre.trackMemory()
+	if err != nil || memory == nil {
+		return result, err
+	}
+	if err := memory.add(result.CachedSize(true)); err != nil {
+		memory.free()
+		return nil, err
+	}
+	return result, nil
+}
+
 func (qre *QueryExecutor) execOther() (*sqltypes.Result, error) {
 	conn, err := qre.getConn()
 	if err != nil {
@@ -664,11 +872,24 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.conns.Get(ctx)
+	pool, isolationPool := qre.workloadPool(false), false
+	if pool == nil {
+		pool, isolationPool = qre.tsv.qe.isolationConns[qre.options.GetTransactionIsolation()]
+		if !isolationPool {
+			pool = qre.tsv.qe.conns
+		}
+	}
+	conn, err := pool.Get(ctx)
 
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
+		if !isolationPool {
+			if err := qre.setNextIsolationLevel(ctx, conn); err != nil {
+				conn.Recycle()
+				return nil, err
+			}
+		}
 		return conn, nil
 	case connpool.ErrConnPoolClosed:
 		return nil, err
@@ -681,10 +902,14 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.streamConns.Get(ctx)
+	conn, err := qre.workloadPool(true).Get(ctx)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
+		if err := qre.setNextIsolationLevel(ctx, conn); err != nil {
+			conn.Recycle()
+			return nil, err
+		}
 		return conn, nil
 	case connpool.ErrConnPoolClosed:
 		return nil, err
@@ -692,6 +917,37 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	return nil, err
 }
 
+// workloadPool returns the pool dedicated to the workload of the request, so
+// that analytics and administrative queries do not starve the OLTP queries of
+// connections. Stream queries always have one, regular queries only if they
+// are not OLTP; nil means they use the query pools.
+func (qre *QueryExecutor) workloadPool(stream bool) *connpool.Pool {
+	switch qre.options.GetWorkload() {
+	case querypb.ExecuteOptions_DBA:
+		if qre.tsv.qe.dbaConns != nil {
+			return qre.tsv.qe.dbaConns
+		}
+	case querypb.ExecuteOptions_OLAP:
+		return qre.tsv.qe.streamConns
+	}
+	if stream {
+		return qre.tsv.qe.streamConns
+	}
+	return nil
+}
+
+// setNextIsolationLevel makes the next query of the connection, which runs in
+// its own implicit transaction, use the isolation level of the session. This
+// is needed when there is no pool dedicated to that isolation level.
+func (qre *QueryExecutor) setNextIsolationLevel(ctx context.Context, conn *connpool.DBConn) error {
+	queries, ok := txIsolations[qre.options.GetTransactionIsolation()]
+	if !ok || queries.setIsolationLevel == "" {
+		return nil
+	}
+	_, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false)
+	return err
+}
+
 func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(parsedQuery, bindVars)
 	if err != nil {
@@ -773,14 +1029,17 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 		qre.marginComments.Leading = buf.String()
 	}
 
+	// The hint is not part of the query without comments, which is used to
+	// consolidate identical queries.
+	sql := qre.withMaxExecutionTime(query)
 	if qre.marginComments.Leading == "" && qre.marginComments.Trailing == "" {
-		return query, query, nil
+		return sql, query, nil
 	}
 
 	var buf strings.Builder
-	buf.Grow(len(qre.marginComments.Leading) + len(query) + len(qre.marginComments.Trailing))
+	buf.Grow(len(qre.marginComments.Leading) + len(sql) + len(qre.marginComments.Trailing))
 	buf.WriteString(qre.marginComments.Leading)
-	buf.WriteString(query)
+	buf.WriteString(sql)
 	buf.WriteString(qre.marginComments.Trailing)
 	return buf.String(), query, nil
 }
@@ -910,7 +1169,8 @@ func (qre *QueryExecutor) execDBConn(conn *connpool.DBConn, sql string, wantfiel
 	qre.tsv.statelessql.Add(qd)
 	defer qre.tsv.statelessql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	defer recordDeadlineBudget(ctx, qre.tsv.stats, deadlineHopMySQL)()
+	return qre.checkMemory(conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields))
 }
 
 func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string, wantfields bool) (*sqltypes.Result, error) {
@@ -923,7 +1183,8 @@ func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string,
 	qre.tsv.statefulql.Add(qd)
 	defer qre.tsv.statefulql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	defer recordDeadlineBudget(ctx, qre.tsv.stats, deadlineHopMySQL)()
+	return qre.checkMemory(conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields))
 }
 
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
@@ -938,9 +1199,29 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 	qre.tsv.olapql.Add(qd)
 	defer qre.tsv.olapql.Remove(qd)
 
+	defer recordDeadlineBudget(ctx, qre.tsv.stats, deadlineHopMySQL)()
 	start := time.Now()
+	var memoryErr error
+	if memory := qre.trackMemory(); memory != nil {
+		// The query is killed, so that MySQL does not send the rest of
+		// the results.
+		send := callBackClosingSpan
+		callBackClosingSpan = func(result *sqltypes.Result) error {
+			if err := memory.add(result.CachedSize(true)); err != nil {
+				memoryErr = err
+				memory.free()
+				conn.Kill("query memory limits", time.Since(start))
+				return err
+			}
+			defer memory.free()
+			return send(result)
+		}
+	}
 	err := conn.Stream(ctx, sql, callBackClosingSpan, allocStreamResult, int(qre.tsv.qe.streamBufferSize.Get()), sqltypes.IncludeFieldsOrDefault(qre.options))
 	qre.logStats.AddRewrittenSQL(sql, start)
+	if memoryErr != nil {
+		return memoryErr
+	}
 	if err != nil {
 		// MySQL error that isn't due to a connection issue
 		return err
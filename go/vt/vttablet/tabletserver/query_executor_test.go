@@ -21,8 +21,11 @@ import (
 	"io"
 	"math/rand"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -34,9 +37,11 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
@@ -112,6 +117,19 @@ func TestQueryExecutorPlans(t *testing.T) {
 		// Because the fields would have been cached before, the field query will
 		// not get re-executed.
 		inTxWant: "select * from t limit 1",
+	}, {
+		input: "select * from t for update skip locked",
+		dbResponses: []dbResponse{{
+			query:  "select * from t where 1 != 1",
+			result: fieldResult,
+		}, {
+			query:  "select * from t limit 10001 for update skip locked",
+			result: selectResult,
+		}},
+		resultWant: selectResult,
+		planWant:   "SelectNoWait",
+		logWant:    "select * from t where 1 != 1; select * from t limit 10001 for update skip locked",
+		inTxWant:   "select * from t limit 10001 for update skip locked",
 	}, {
 		input: "show engines",
 		dbResponses: []dbResponse{{
@@ -773,6 +791,73 @@ func TestQueryExecutorPlanNextval(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanNextvalOptions(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	selQuery := "select next_id, cache from seq where id = 0 for update"
+	addSeqRow := func(nextID, cache int64) {
+		db.AddQuery(selQuery, &sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.Int64},
+			},
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewInt64(nextID),
+				sqltypes.NewInt64(cache),
+			}},
+		})
+	}
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	si := tsv.se.GetTable(sqlparser.NewTableIdent("seq")).SequenceInfo
+	nextval := func(query string) (int64, error) {
+		qr, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+		if err != nil {
+			return 0, err
+		}
+		return qr.Rows[0][0].ToInt64()
+	}
+
+	// A strict monotonic sequence reserves the values of every call.
+	si.StrictMonotonic = true
+	addSeqRow(1, 100)
+	db.AddQuery("update seq set next_id = 2 where id = 0", &sqltypes.Result{})
+	got, err := nextval("select next value from seq")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, got)
+	addSeqRow(2, 100)
+	db.AddQuery("update seq set next_id = 4 where id = 0", &sqltypes.Result{})
+	got, err = nextval("select next 2 values from seq")
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, got)
+	assert.EqualValues(t, 4, si.NextVal)
+	assert.EqualValues(t, 4, si.LastVal)
+
+	// The cache stops at the max value, past which the sequence is
+	// exhausted.
+	si.StrictMonotonic = false
+	si.MaxValue = 10
+	si.ExhaustionAlert = 0.5
+	alerts := tsv.stats.SequenceExhaustionAlerts.Counts()["seq"]
+	addSeqRow(4, 100)
+	db.AddQuery("update seq set next_id = 11 where id = 0", &sqltypes.Result{})
+	got, err = nextval("select next value from seq")
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, got)
+	assert.EqualValues(t, 11, si.LastVal)
+	assert.EqualValues(t, 0, tsv.stats.SequenceRemaining.Counts()["seq"])
+	assert.EqualValues(t, alerts+1, tsv.stats.SequenceExhaustionAlerts.Counts()["seq"])
+
+	addSeqRow(11, 100)
+	_, err = nextval("select next 7 values from seq")
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	got, err = nextval("select next 6 values from seq")
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, got)
+}
+
 func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
 	tableacl.Register(aclName, &simpleacl.Factory{})
@@ -1221,6 +1306,329 @@ func TestQueryExecutorDenyListQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorQRTimeout(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	expandedQuery := "select pk from test_table use index (`index`) where name = 1 limit 1000"
+	expected := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, expected)
+	db.AddQuery(expandedQuery, expected)
+	db.AddQuery("select * from test_table where `name` = 1 limit 1000", expected)
+
+	timeoutRule := rules.NewQueryRule("short timeout", "short timeout", rules.QRTimeout)
+	timeoutRule.SetTimeout(time.Minute)
+	timeoutRule.AddTableCond("test_table")
+
+	rulesName := "timeoutRulesQRTimeout"
+	rules := rules.New()
+	rules.Add(timeoutRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, rules); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	defer tsv.StopService()
+
+	require.NoError(t, qre.checkPermissions())
+	assert.Equal(t, time.Minute, qre.ruleTimeout)
+	cancel := qre.applyRuleTimeout()
+	defer cancel()
+	deadline, ok := qre.ctx.Deadline()
+	require.True(t, ok, "query context should have a deadline")
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 10*time.Second)
+
+	_, err := qre.Execute()
+	require.NoError(t, err)
+}
+
+func TestQueryExecutorTransactionIsolation(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("set transaction isolation level READ COMMITTED", &sqltypes.Result{})
+	db.AddQuery("set session transaction isolation level READ COMMITTED", &sqltypes.Result{})
+
+	ctx := context.Background()
+	options := &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_READ_COMMITTED}
+
+	// Without isolation level pools, the isolation level is set on the
+	// regular connection, for the next statement only.
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = options
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, 1, db.GetQueryCalledNum("set transaction isolation level READ COMMITTED"))
+	tsv.StopService()
+
+	// With isolation level pools, the query runs on a connection whose
+	// session is already at the isolation level.
+	tsv = newTestTabletServer(ctx, isolationLevelPools, db)
+	defer tsv.StopService()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = options
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, 1, db.GetQueryCalledNum("set transaction isolation level READ COMMITTED"))
+	assert.Equal(t, 1, db.GetQueryCalledNum("set session transaction isolation level READ COMMITTED"))
+}
+
+func TestQueryExecutorWorkloadPools(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, dbaPool, db)
+	defer tsv.StopService()
+	require.NotNil(t, tsv.qe.dbaConns)
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_DBA}
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.EqualValues(t, 1, tsv.qe.dbaConns.Active())
+	assert.EqualValues(t, 0, tsv.qe.streamConns.Active())
+
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.EqualValues(t, 1, tsv.qe.streamConns.Active())
+
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_DBA}
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, tsv.qe.dbaConns.Active())
+	assert.EqualValues(t, 1, tsv.qe.streamConns.Active())
+}
+
+func TestQueryExecutorResultCache(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("delete from test_table limit 10001", &sqltypes.Result{RowsAffected: 1})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResultCache, db)
+	defer tsv.StopService()
+
+	execute := func(sql string, txID int64) (*sqltypes.Result, *QueryExecutor) {
+		t.Helper()
+		qre := newTestQueryExecutor(ctx, tsv, sql, txID)
+		qre.tabletType = topodatapb.TabletType_PRIMARY
+		got, err := qre.Execute()
+		require.NoError(t, err)
+		return got, qre
+	}
+
+	got, qre := execute(query, 0)
+	utils.MustMatch(t, want, got)
+	assert.Zero(t, qre.logStats.QuerySources&tabletenv.QuerySourceResultCache)
+	got, qre = execute(query, 0)
+	utils.MustMatch(t, want, got)
+	assert.NotZero(t, qre.logStats.QuerySources&tabletenv.QuerySourceResultCache)
+	assert.Equal(t, 1, db.GetQueryCalledNum(query))
+
+	// A DML on the table invalidates the cached result.
+	execute("delete from test_table", 0)
+	execute(query, 0)
+	assert.Equal(t, 2, db.GetQueryCalledNum(query))
+
+	// Nothing is cached while a transaction which modified the table is open.
+	txID := newTransaction(tsv, nil)
+	execute("delete from test_table", txID)
+	execute(query, 0)
+	execute(query, 0)
+	assert.Equal(t, 4, db.GetQueryCalledNum(query))
+	_, err := tsv.Commit(ctx, tsv.sm.Target(), txID)
+	require.NoError(t, err)
+	execute(query, 0)
+	execute(query, 0)
+	assert.Equal(t, 5, db.GetQueryCalledNum(query))
+
+	// Locking reads are never cached.
+	db.AddQuery("select * from test_table limit 10001 for update", want)
+	execute("select * from test_table for update", 0)
+	execute("select * from test_table for update", 0)
+	assert.Equal(t, 2, db.GetQueryCalledNum("select * from test_table limit 10001 for update"))
+
+	// The results depend on the options.
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.tabletType = topodatapb.TabletType_PRIMARY
+	qre.options = &querypb.ExecuteOptions{IncludedFields: querypb.ExecuteOptions_TYPE_ONLY}
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Zero(t, qre.logStats.QuerySources&tabletenv.QuerySourceResultCache)
+	assert.Equal(t, 6, db.GetQueryCalledNum(query))
+
+	// Replicas don't cache their results.
+	for i := 0; i < 2; i++ {
+		qre = newTestQueryExecutor(ctx, tsv, query, 0)
+		qre.tabletType = topodatapb.TabletType_REPLICA
+		_, err = qre.Execute()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 8, db.GetQueryCalledNum(query))
+}
+
+func TestQueryExecutorMemoryLimits(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}},
+	}
+	db.AddQuery(query, want)
+	db.AddQueryPattern("kill .*", &sqltypes.Result{})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	kills := tsv.qe.memory.kills
+
+	// The limits are disabled by default.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	assert.Nil(t, qre.trackMemory())
+	_, err := qre.Execute()
+	require.NoError(t, err)
+
+	tsv.SetQueryMemoryLimit(1 << 20)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.EqualValues(t, 0, tsv.qe.memory.used.Get())
+
+	tsv.SetQueryMemoryLimit(1)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualValues(t, 1, kills.Counts()["QueryLimit"])
+
+	// The streamed results count towards the limit of the query.
+	tsv.SetQueryMemoryLimit(1)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualValues(t, 2, kills.Counts()["QueryLimit"])
+	assert.EqualValues(t, 0, tsv.qe.memory.used.Get())
+
+	// The results held by the running queries count towards the budget.
+	tsv.SetQueryMemoryLimit(0)
+	tsv.SetQueryMemoryBudget(3)
+	tsv.qe.memory.used.Set(2)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualValues(t, 1, kills.Counts()["Budget"])
+	assert.EqualValues(t, 2, tsv.qe.memory.used.Get())
+}
+
+func TestQueryExecutorMaxExecutionTime(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	want := &sqltypes.Result{Fields: getTestTableFields()}
+	db.AddQueryPattern(`select /\*\+ MAX_EXECUTION_TIME\(\d+\) \*/ \* from test_table limit 10001`, want)
+	db.AddQuery("select * from test_table limit 10001", want)
+	db.AddQuery("delete from test_table limit 10001", &sqltypes.Result{RowsAffected: 1})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.config.DeriveMaxExecutionTime = true
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+
+	m := regexp.MustCompile(`MAX_EXECUTION_TIME\((\d+)\)`).FindStringSubmatch(qre.logStats.RewrittenSQL())
+	require.Len(t, m, 2, qre.logStats.RewrittenSQL())
+	ms, err := strconv.Atoi(m[1])
+	require.NoError(t, err)
+	assert.LessOrEqual(t, ms, 10000)
+	assert.Greater(t, ms, 5000)
+	assert.NotZero(t, tsv.stats.DeadlineRemaining.Counts()["TabletServerTest."+deadlineHopMySQL])
+	assert.NotZero(t, tsv.stats.DeadlineConsumed.Counts()["TabletServerTest."+deadlineHopMySQL])
+
+	// Only selects get the hint.
+	qre = newTestQueryExecutor(ctx, tsv, "delete from test_table", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.NotContains(t, qre.logStats.RewrittenSQL(), "MAX_EXECUTION_TIME")
+
+	// Neither do requests without a deadline.
+	qre = newTestQueryExecutor(context.Background(), tsv, "select * from test_table", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.NotContains(t, qre.logStats.RewrittenSQL(), "MAX_EXECUTION_TIME")
+}
+
+func TestQueryExecutorWaitForGTIDSet(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	want := &sqltypes.Result{Fields: getTestTableFields()}
+	db.AddQuery("select * from test_table limit 10001", want)
+	db.AddQuery("select wait_for_executed_gtid_set('uuid:1-5', 0.200)", sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "0"))
+	db.AddQuery("select wait_for_executed_gtid_set('uuid:1-6', 0.200)", sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "1"))
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	qre.tabletType = topodatapb.TabletType_REPLICA
+	qre.options = &querypb.ExecuteOptions{WaitForGtidSet: "uuid:1-5", WaitForGtidSetTimeout: 200}
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	utils.MustMatch(t, want, got)
+
+	qre = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	qre.tabletType = topodatapb.TabletType_REPLICA
+	qre.options = &querypb.ExecuteOptions{WaitForGtidSet: "uuid:1-6", WaitForGtidSetTimeout: 200}
+	_, err = qre.Execute()
+	require.EqualError(t, err, "replica has not executed the GTID set uuid:1-6 within 200ms")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	// The primary does not wait.
+	qre = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	qre.tabletType = topodatapb.TabletType_PRIMARY
+	qre.options = &querypb.ExecuteOptions{WaitForGtidSet: "uuid:1-6", WaitForGtidSetTimeout: 200}
+	_, err = qre.Execute()
+	require.NoError(t, err)
+}
+
 type executorFlags int64
 
 const (
@@ -1231,6 +1639,9 @@ const (
 	shortTwopcAge
 	smallResultSize
 	disableOnlineDDL
+	isolationLevelPools
+	enableResultCache
+	dbaPool
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1266,6 +1677,16 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&isolationLevelPools > 0 {
+		config.IsolationLevelPool.Size = 1
+	}
+	if flags&dbaPool > 0 {
+		config.DbaPool.Size = 1
+	}
+	if flags&enableResultCache > 0 {
+		config.ResultCacheMemory = 1 << 20
+		config.ResultCacheTTLSeconds = 60
+	}
 	dbconfigs := newDBConfigs(db)
 	config.DB = dbconfigs
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
@@ -1323,7 +1744,7 @@ func initQueryExecutorTestDB(db *fakesqldb.DB) {
 		Rows: [][]sqltypes.Value{
 			mysql.BaseShowTablesRow("test_table", false, ""),
 			mysql.BaseShowTablesRow("seq", false, "vitess_sequence"),
-			mysql.BaseShowTablesRow("msg", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30"),
+			mysql.BaseShowTablesRow("msg", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_attempts=5"),
 		},
 	})
 	db.AddQuery("show status like 'Innodb_rows_read'", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// memoryTracker accounts the size of the results fetched from MySQL by the
+// running queries. A query exceeds its limit once the results it fetched,
+// including the ones it already streamed, are larger than the per-query
+// limit. The budget bounds the results held by all the queries: buffered
+// results are held until the query returns, streamed results until they are
+// sent.
+type memoryTracker struct {
+	queryLimit sync2.AtomicInt64
+	budget     sync2.AtomicInt64
+	used       sync2.AtomicInt64
+
+	kills     *stats.CountersWithSingleLabel
+	histogram *stats.Histogram
+}
+
+func newMemoryTracker(env tabletenv.Env) *memoryTracker {
+	config := env.Config()
+	mt := &memoryTracker{
+		queryLimit: sync2.NewAtomicInt64(config.QueryMemoryLimit),
+		budget:     sync2.NewAtomicInt64(config.QueryMemoryBudget),
+		kills:      env.Exporter().NewCountersWithSingleLabel("QueryMemoryKills", "Number of queries killed because their results exceeded the memory limits", "Reason", "QueryLimit", "Budget"),
+		histogram:  env.Exporter().NewHistogram("QueryMemory", "Size in bytes of the results fetched by the queries", []int64{1 << 10, 1 << 14, 1 << 17, 1 << 20, 1 << 23, 1 << 26, 1 << 30}),
+	}
+	env.Exporter().NewGaugeFunc("QueryMemoryUsed", "Size in bytes of the results held by the running queries", mt.used.Get)
+	env.Exporter().NewGaugeFunc("QueryMemoryLimit", "Query memory limit per query", mt.queryLimit.Get)
+	env.Exporter().NewGaugeFunc("QueryMemoryBudget", "Query memory budget of all the queries", mt.budget.Get)
+	return mt
+}
+
+// track returns nil if the memory of the queries is not limited.
+func (mt *memoryTracker) track() *queryMemory {
+	if mt.queryLimit.Get() <= 0 && mt.budget.Get() <= 0 {
+		return nil
+	}
+	return &queryMemory{mt: mt}
+}
+
+// queryMemory is the memory of a query. It's not safe for concurrent use.
+type queryMemory struct {
+	mt *memoryTracker
+
+	// fetched is the size of all the results of the query, held is the
+	// size of the ones which are not released yet.
+	fetched, held int64
+}
+
+// add accounts a result of the query. It returns a RESOURCE_EXHAUSTED error
+// if the query must be killed.
+func (qm *queryMemory) add(size int64) error {
+	qm.fetched += size
+	qm.held += size
+	used := qm.mt.used.Add(size)
+	if limit := qm.mt.queryLimit.Get(); limit > 0 && qm.fetched > limit {
+		qm.mt.kills.Add("QueryLimit", 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query results of %d bytes exceeded the query memory limit of %d bytes", qm.fetched, limit)
+	}
+	if budget := qm.mt.budget.Get(); budget > 0 && used > budget {
+		qm.mt.kills.Add("Budget", 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query results of %d bytes exceeded the query memory budget, %d bytes are held by the running queries and the budget is %d bytes", qm.fetched, used, budget)
+	}
+	return nil
+}
+
+// free releases the results held by the query.
+func (qm *queryMemory) free() {
+	if qm == nil {
+		return
+	}
+	qm.mt.used.Add(-qm.held)
+	qm.held = 0
+}
+
+// done releases the results held by the query, and records its size.
+func (qm *queryMemory) done() {
+	if qm == nil {
+		return
+	}
+	qm.free()
+	qm.mt.histogram.Add(qm.fetched)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package repltracker
 
 import (
+	"expvar"
 	"fmt"
 	"testing"
 	"time"
@@ -65,6 +66,7 @@ func TestReaderReadHeartbeat(t *testing.T) {
 	assert.Equal(t, expectedCumLag, cumulativeLagNs.Get(), "wrong cumulative lag")
 	assert.Equal(t, int64(1), reads.Get(), "wrong read count")
 	assert.Equal(t, int64(0), readErrors.Get(), "wrong read error count")
+	assert.Equal(t, "10", expvar.Get("HeartbeatSecondsBehind").String(), "wrong seconds behind")
 	expectedHisto := map[string]int64{
 		"0":      int64(0),
 		"1ms":    int64(0),
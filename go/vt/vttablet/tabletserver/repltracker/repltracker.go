@@ -48,6 +48,14 @@ var (
 		[]string{"0", "1ms", "10ms", "100ms", "1s", "10s", "100s", "1000s", ">1000s"}, "Count", "Total")
 )
 
+func init() {
+	// HeartbeatSecondsBehind is the current lag in seconds, with the
+	// microsecond precision of the delay reported by pt-heartbeat.
+	stats.Publish("HeartbeatSecondsBehind", stats.FloatFunc(func() float64 {
+		return time.Duration(currentLagNs.Get()).Round(time.Microsecond).Seconds()
+	}))
+}
+
 // ReplTracker tracks replication lag.
 type ReplTracker struct {
 	mode           string
@@ -65,7 +73,7 @@ type ReplTracker struct {
 func NewReplTracker(env tabletenv.Env, alias *topodatapb.TabletAlias) *ReplTracker {
 	return &ReplTracker{
 		mode:           env.Config().ReplicationTracker.Mode,
-		forceHeartbeat: env.Config().EnableLagThrottler,
+		forceHeartbeat: env.Config().EnableLagThrottler || env.Config().ThrottlerConfigViaTopo,
 		hw:             newHeartbeatWriter(env, alias),
 		hr:             newHeartbeatReader(env),
 		poller:         &poller{},
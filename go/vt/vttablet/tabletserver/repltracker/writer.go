@@ -27,6 +27,7 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/dbconnpool"
@@ -48,6 +49,23 @@ const (
   ts BIGINT UNSIGNED NOT NULL
         ) engine=InnoDB`
 	sqlUpsertHeartbeat = "INSERT INTO %s.heartbeat (ts, tabletUid, keyspaceShard) VALUES (%a, %a, %a) ON DUPLICATE KEY UPDATE ts=VALUES(ts), tabletUid=VALUES(tabletUid)"
+
+	// The pt-heartbeat table has the schema created by pt-heartbeat
+	// --create-table. Only ts and server_id are written, the replication
+	// coordinates are informational.
+	sqlCreatePtHeartbeatTable = `CREATE TABLE IF NOT EXISTS %s (
+  ts VARCHAR(26) NOT NULL,
+  server_id INT UNSIGNED NOT NULL PRIMARY KEY,
+  file VARCHAR(255) DEFAULT NULL,
+  position BIGINT UNSIGNED DEFAULT NULL,
+  relay_master_log_file VARCHAR(255) DEFAULT NULL,
+  exec_master_log_pos BIGINT UNSIGNED DEFAULT NULL
+        ) engine=InnoDB`
+	sqlUpsertPtHeartbeat = "INSERT INTO %s (ts, server_id) VALUES (%a, @@global.server_id) ON DUPLICATE KEY UPDATE ts=VALUES(ts)"
+
+	// ptHeartbeatTimeFormat is the format of the timestamps written by
+	// pt-heartbeat. They are written in UTC, like pt-heartbeat --utc does.
+	ptHeartbeatTimeFormat = "2006-01-02T15:04:05.000000"
 )
 
 var withDDL = withddl.New([]string{
@@ -67,6 +85,11 @@ type heartbeatWriter struct {
 	now           func() time.Time
 	errorLog      *logutil.ThrottledLogger
 
+	// ptTable is the escaped name of the pt-heartbeat table, empty if it
+	// is not written.
+	ptTable   string
+	ptWithDDL *withddl.WithDDL
+
 	mu     sync.Mutex
 	isOpen bool
 	pool   *dbconnpool.ConnectionPool
@@ -77,12 +100,13 @@ type heartbeatWriter struct {
 func newHeartbeatWriter(env tabletenv.Env, alias *topodatapb.TabletAlias) *heartbeatWriter {
 	config := env.Config()
 
-	// config.EnableLagThrottler is a feature flag for the throttler; if throttler runs, then heartbeat must also run
-	if config.ReplicationTracker.Mode != tabletenv.Heartbeat && !config.EnableLagThrottler {
+	// config.EnableLagThrottler is a feature flag for the throttler; if throttler runs, then heartbeat must also run.
+	// With config.ThrottlerConfigViaTopo, the throttler may be enabled at any time.
+	if config.ReplicationTracker.Mode != tabletenv.Heartbeat && !config.EnableLagThrottler && !config.ThrottlerConfigViaTopo {
 		return &heartbeatWriter{}
 	}
 	heartbeatInterval := config.ReplicationTracker.HeartbeatIntervalSeconds.Get()
-	return &heartbeatWriter{
+	w := &heartbeatWriter{
 		env:         env,
 		enabled:     true,
 		tabletAlias: proto.Clone(alias).(*topodatapb.TabletAlias),
@@ -94,6 +118,19 @@ func newHeartbeatWriter(env tabletenv.Env, alias *topodatapb.TabletAlias) *heart
 		// stats from incrementing continually, and causing concern
 		pool: dbconnpool.NewConnectionPool("HeartbeatWritePool", 2, *mysqlctl.DbaIdleTimeout, *mysqlctl.PoolDynamicHostnameResolution),
 	}
+	if name := config.ReplicationTracker.PtHeartbeatTable; name != "" {
+		database, table, err := sqlparser.ParseTable(name)
+		if err != nil || database == "" {
+			log.Errorf("Invalid pt-heartbeat table %s, it is not written: %v", name, err)
+			return w
+		}
+		w.ptTable = sqlescape.EscapeID(database) + "." + sqlescape.EscapeID(table)
+		w.ptWithDDL = withddl.New([]string{
+			fmt.Sprintf(sqlCreateSidecarDB, sqlescape.EscapeID(database)),
+			fmt.Sprintf(sqlCreatePtHeartbeatTable, w.ptTable),
+		})
+	}
+	return w
 }
 
 // InitDBConfig initializes the target name for the heartbeatWriter.
@@ -153,6 +190,16 @@ func (w *heartbeatWriter) bindHeartbeatVars(query string) (string, error) {
 	return bound, nil
 }
 
+// bindPtHeartbeatVars binds the pt-heartbeat write. The row is keyed by the
+// server_id of MySQL, like the ones written by pt-heartbeat.
+func (w *heartbeatWriter) bindPtHeartbeatVars() (string, error) {
+	bindVars := map[string]*querypb.BindVariable{
+		"ts": sqltypes.StringBindVariable(w.now().UTC().Format(ptHeartbeatTimeFormat)),
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlUpsertPtHeartbeat, w.ptTable, ":ts")
+	return parsed.GenerateQuery(bindVars, nil)
+}
+
 // writeHeartbeat updates the heartbeat row for this tablet with the current time in nanoseconds.
 func (w *heartbeatWriter) writeHeartbeat() {
 	if err := w.write(); err != nil {
@@ -179,7 +226,15 @@ func (w *heartbeatWriter) write() error {
 	if err != nil {
 		return err
 	}
-	return nil
+	if w.ptTable == "" {
+		return nil
+	}
+	upsert, err = w.bindPtHeartbeatVars()
+	if err != nil {
+		return err
+	}
+	_, err = w.ptWithDDL.Exec(ctx, upsert, conn.ExecuteFetch)
+	return err
 }
 
 func (w *heartbeatWriter) recordError(err error) {
@@ -79,6 +79,32 @@ func TestWriteHeartbeat(t *testing.T) {
 	assert.Equal(t, int64(0), writeErrors.Get())
 }
 
+func TestWritePtHeartbeat(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	tw := newTestWriterWithConfig(db, mockNowFunc, func(config *tabletenv.TabletConfig) {
+		config.ReplicationTracker.PtHeartbeatTable = "percona.heartbeat"
+	})
+	db.OrderMatters()
+	upsert := fmt.Sprintf("INSERT INTO %s.heartbeat (ts, tabletUid, keyspaceShard) VALUES (%d, %d, '%s') ON DUPLICATE KEY UPDATE ts=VALUES(ts), tabletUid=VALUES(tabletUid)",
+		"_vt", now.UnixNano(), tw.tabletAlias.Uid, tw.keyspaceShard)
+	ptUpsert := fmt.Sprintf("INSERT INTO `percona`.`heartbeat` (ts, server_id) VALUES ('%s', @@global.server_id) ON DUPLICATE KEY UPDATE ts=VALUES(ts)",
+		now.UTC().Format("2006-01-02T15:04:05.000000"))
+	db.AddExpectedQuery(upsert, nil)
+	// The pt-heartbeat table is created when it is missing.
+	db.AddExpectedExecuteFetch(fakesqldb.ExpectedExecuteFetch{
+		Query: ptUpsert,
+		Error: mysql.NewSQLError(mysql.ERNoSuchTable, "", "table doesn't exist"),
+	})
+	db.AddExpectedQuery("create database if not exists `percona`", nil)
+	db.AddExpectedQuery(fmt.Sprintf(sqlCreatePtHeartbeatTable, "`percona`.`heartbeat`"), nil)
+	db.AddExpectedQuery(ptUpsert, nil)
+
+	err := tw.write()
+	require.NoError(t, err)
+}
+
 func TestWriteHeartbeatError(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -94,9 +120,14 @@ func TestWriteHeartbeatError(t *testing.T) {
 }
 
 func newTestWriter(db *fakesqldb.DB, nowFunc func() time.Time) *heartbeatWriter {
+	return newTestWriterWithConfig(db, nowFunc, func(*tabletenv.TabletConfig) {})
+}
+
+func newTestWriterWithConfig(db *fakesqldb.DB, nowFunc func() time.Time, configure func(*tabletenv.TabletConfig)) *heartbeatWriter {
 	config := tabletenv.NewDefaultConfig()
 	config.ReplicationTracker.Mode = tabletenv.Heartbeat
 	config.ReplicationTracker.HeartbeatIntervalSeconds = 1
+	configure(config)
 
 	params, _ := db.ConnParams().MysqlParams()
 	cp := *params
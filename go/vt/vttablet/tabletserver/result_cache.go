@@ -0,0 +1,283 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// resultCache caches the results of read-only and deterministic queries
+// executed on a primary outside of transactions. The entries expire after a
+// TTL, and are invalidated when this tablet modifies one of their tables.
+// The replicas don't cache their results, since their tables are modified
+// by the replication stream.
+//
+// Every table has a generation, which is incremented when the table is
+// modified. An entry records the generations of its tables when its query
+// started, and is stale as soon as one of them changed. While a transaction
+// which modified a table is open, the results of the table are not cached,
+// since the transaction can commit at any time.
+type resultCache struct {
+	ttl     time.Duration
+	entries *cache.LRUCache
+
+	mu          sync.Mutex
+	generations map[string]uint64
+	writers     map[string]int
+
+	hits, misses, invalidations *stats.Counter
+}
+
+type resultCacheEntry struct {
+	result      *sqltypes.Result
+	expiry      time.Time
+	tables      []string
+	generations []uint64
+}
+
+// newResultCache returns nil if the result cache is disabled.
+func newResultCache(env tabletenv.Env) *resultCache {
+	config := env.Config()
+	if config.ResultCacheMemory <= 0 {
+		return nil
+	}
+	return &resultCache{
+		ttl: config.ResultCacheTTLSeconds.Get(),
+		entries: cache.NewLRUCache(config.ResultCacheMemory, func(v interface{}) int64 {
+			return v.(*resultCacheEntry).result.CachedSize(true)
+		}),
+		generations:   make(map[string]uint64),
+		writers:       make(map[string]int),
+		hits:          env.Exporter().NewCounter("ResultCacheHits", "Number of queries served from the result cache"),
+		misses:        env.Exporter().NewCounter("ResultCacheMisses", "Number of cacheable queries not found in the result cache"),
+		invalidations: env.Exporter().NewCounter("ResultCacheInvalidations", "Number of table modifications which invalidated the result cache"),
+	}
+}
+
+// get returns a copy of the cached result of the query. If there is none, it
+// returns the generations to pass to put.
+func (rc *resultCache) get(key string, tables []string) (*sqltypes.Result, []uint64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if v, ok := rc.entries.Get(key); ok {
+		entry := v.(*resultCacheEntry)
+		if time.Now().Before(entry.expiry) && rc.isCurrentLocked(entry.tables, entry.generations) {
+			rc.hits.Add(1)
+			return entry.result.Copy(), nil
+		}
+		rc.entries.Delete(key)
+	}
+	rc.misses.Add(1)
+
+	generations := make([]uint64, len(tables))
+	for i, table := range tables {
+		generations[i] = rc.generations[table]
+	}
+	return nil, generations
+}
+
+// put caches the result if its tables were not modified since get returned
+// the generations.
+func (rc *resultCache) put(key string, tables []string, generations []uint64, result *sqltypes.Result) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if !rc.isCurrentLocked(tables, generations) {
+		return
+	}
+	for _, table := range tables {
+		if rc.writers[table] > 0 {
+			return
+		}
+	}
+	rc.entries.Set(key, &resultCacheEntry{
+		result:      result.Copy(),
+		expiry:      time.Now().Add(rc.ttl),
+		tables:      tables,
+		generations: generations,
+	})
+}
+
+func (rc *resultCache) isCurrentLocked(tables []string, generations []uint64) bool {
+	for i, table := range tables {
+		if rc.generations[table] != generations[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// beginWrite must be called before a statement modifies the tables, and
+// endWrite once the modification is committed or rolled back.
+func (rc *resultCache) beginWrite(tables []string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for _, table := range tables {
+		rc.writers[table]++
+		rc.generations[table]++
+	}
+	rc.invalidations.Add(int64(len(tables)))
+}
+
+func (rc *resultCache) endWrite(tables []string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for _, table := range tables {
+		rc.writers[table]--
+		if rc.writers[table] <= 0 {
+			delete(rc.writers, table)
+		}
+		rc.generations[table]++
+	}
+}
+
+// trackWrites must be called before the statement of the plan runs on conn,
+// and the returned function once it completed. The tables modified in a
+// transaction are released when it completes.
+func (rc *resultCache) trackWrites(conn *StatefulConnection, plan *planbuilder.Plan) func() {
+	tables := writtenTables(plan)
+	if len(tables) == 0 {
+		return func() {}
+	}
+	if !conn.IsInTransaction() {
+		rc.beginWrite(tables)
+		return func() { rc.endWrite(tables) }
+	}
+
+	// No result of the tables is cached until the transaction completes,
+	// so every table only needs to be counted once per transaction.
+	props := conn.TxProperties()
+	var added []string
+	for _, table := range tables {
+		found := false
+		for _, written := range props.WrittenTables {
+			if written == table {
+				found = true
+				break
+			}
+		}
+		if !found {
+			added = append(added, table)
+		}
+	}
+	props.WrittenTables = append(props.WrittenTables, added...)
+	rc.beginWrite(added)
+	return func() {}
+}
+
+// nonDeterministicFuncs are the functions whose result can change between
+// two executions of the same query on the same data.
+var nonDeterministicFuncs = map[string]bool{
+	"benchmark":         true,
+	"connection_id":     true,
+	"curdate":           true,
+	"current_date":      true,
+	"current_role":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"curtime":           true,
+	"database":          true,
+	"found_rows":        true,
+	"get_lock":          true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"master_pos_wait":   true,
+	"now":               true,
+	"rand":              true,
+	"release_all_locks": true,
+	"release_lock":      true,
+	"row_count":         true,
+	"schema":            true,
+	"session_user":      true,
+	"sleep":             true,
+	"source_pos_wait":   true,
+	"sysdate":           true,
+	"system_user":       true,
+	"unix_timestamp":    true,
+	"user":              true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"uuid_short":        true,
+}
+
+// isResultCacheable returns true if the results of the statement only depend
+// on the data of its tables.
+func isResultCacheable(stmt sqlparser.Statement) bool {
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Lock != sqlparser.NoLock || sel.Into != nil || sel.SQLCalcFoundRows {
+		return false
+	}
+	cacheable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFuncs[node.Name.Lowered()] {
+				cacheable = false
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			cacheable = false
+		case *sqlparser.ColName:
+			// System and user defined variables.
+			if strings.HasPrefix(node.Name.String(), "@") {
+				cacheable = false
+			}
+		}
+		return cacheable, nil
+	}, sel)
+	return cacheable
+}
+
+// readTables returns the tables read by the plan.
+func readTables(plan *planbuilder.Plan) []string {
+	var tables []string
+	for _, p := range plan.Permissions {
+		if p.Role == tableacl.READER {
+			tables = append(tables, p.TableName)
+		}
+	}
+	return tables
+}
+
+// writtenTables returns the tables modified by the plan.
+func writtenTables(plan *planbuilder.Plan) []string {
+	var tables []string
+	for _, p := range plan.Permissions {
+		if p.Role != tableacl.READER {
+			tables = append(tables, p.TableName)
+		}
+	}
+	return tables
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func newTestResultCache(t *testing.T, ttl time.Duration) *resultCache {
+	config := tabletenv.NewDefaultConfig()
+	config.ResultCacheMemory = 1 << 20
+	config.ResultCacheTTLSeconds.Set(ttl)
+	rc := newResultCache(tabletenv.NewEnv(config, t.Name()))
+	require.NotNil(t, rc)
+	return rc
+}
+
+func TestResultCacheDisabled(t *testing.T) {
+	assert.Nil(t, newResultCache(tabletenv.NewEnv(tabletenv.NewDefaultConfig(), t.Name())))
+}
+
+func TestResultCacheGetPut(t *testing.T) {
+	rc := newTestResultCache(t, time.Minute)
+	tables := []string{"a", "b"}
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	got, generations := rc.get("select", tables)
+	assert.Nil(t, got)
+	rc.put("select", tables, generations, result)
+
+	got, _ = rc.get("select", tables)
+	utils.MustMatch(t, result, got)
+	// Callers can modify the returned result.
+	got.Rows = nil
+	got, _ = rc.get("select", tables)
+	utils.MustMatch(t, result, got)
+	assert.EqualValues(t, 2, rc.hits.Get())
+	assert.EqualValues(t, 1, rc.misses.Get())
+}
+
+func TestResultCacheInvalidation(t *testing.T) {
+	rc := newTestResultCache(t, time.Minute)
+	tables := []string{"a", "b"}
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	_, generations := rc.get("select", tables)
+	rc.put("select", tables, generations, result)
+	rc.beginWrite([]string{"b"})
+	got, generations := rc.get("select", tables)
+	assert.Nil(t, got)
+
+	// Results are not cached while a table is being written.
+	rc.put("select", tables, generations, result)
+	got, _ = rc.get("select", tables)
+	assert.Nil(t, got)
+
+	// A result read during a write is stale once the write completes.
+	_, generations = rc.get("select", tables)
+	rc.endWrite([]string{"b"})
+	rc.put("select", tables, generations, result)
+	got, generations = rc.get("select", tables)
+	assert.Nil(t, got)
+
+	rc.put("select", tables, generations, result)
+	got, _ = rc.get("select", tables)
+	utils.MustMatch(t, result, got)
+	assert.EqualValues(t, 1, rc.invalidations.Get())
+}
+
+func TestResultCacheTTL(t *testing.T) {
+	rc := newTestResultCache(t, 10*time.Millisecond)
+	tables := []string{"a"}
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	_, generations := rc.get("select", tables)
+	rc.put("select", tables, generations, result)
+	time.Sleep(20 * time.Millisecond)
+	got, _ := rc.get("select", tables)
+	assert.Nil(t, got)
+}
+
+func TestIsResultCacheable(t *testing.T) {
+	testcases := []struct {
+		query string
+		want  bool
+	}{
+		{"select * from a where id = 1", true},
+		{"select a.id, b.name from a join b on a.id = b.id", true},
+		{"select count(*), concat(name, 'x') from a group by name", true},
+		{"select * from a where id = 1 for update", false},
+		{"select * from a where id = 1 lock in share mode", false},
+		{"select sql_calc_found_rows * from a", false},
+		{"select * from a into outfile 'x'", false},
+		{"select now() from a", false},
+		{"select * from a where ts > utc_timestamp()", false},
+		{"select rand() from a", false},
+		{"select * from a where id = last_insert_id()", false},
+		{"select * from a where id = @id", false},
+		{"select @@version from a", false},
+		{"select * from a where id in (select id from b where uuid() = id)", false},
+		{"select * from a union select * from b", false},
+		{"update a set id = 1", false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, isResultCacheable(stmt))
+		})
+	}
+}
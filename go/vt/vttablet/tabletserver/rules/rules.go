@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -110,10 +111,7 @@ func (qrs *Rules) Find(name string) (qr *Rule) {
 func (qrs *Rules) Delete(name string) (qr *Rule) {
 	for i, qr := range qrs.rules {
 		if qr.Name == name {
-			for j := i; j < len(qrs.rules)-i-1; j++ {
-				qrs.rules[j] = qrs.rules[j+1]
-			}
-			qrs.rules = qrs.rules[:len(qrs.rules)-1]
+			qrs.rules = append(qrs.rules[:i], qrs.rules[i+1:]...)
 			return qr
 		}
 	}
@@ -173,12 +171,24 @@ func (qrs *Rules) GetAction(
 	bindVars map[string]*querypb.BindVariable,
 	marginComments sqlparser.MarginComments,
 ) (action Action, desc string) {
+	action, _, desc = qrs.GetActionWithTimeout(ip, user, bindVars, marginComments)
+	return action, desc
+}
+
+// GetActionWithTimeout is like GetAction, but it also returns the timeout
+// of the rule that fired if its action is QRTimeout.
+func (qrs *Rules) GetActionWithTimeout(
+	ip,
+	user string,
+	bindVars map[string]*querypb.BindVariable,
+	marginComments sqlparser.MarginComments,
+) (action Action, timeout time.Duration, desc string) {
 	for _, qr := range qrs.rules {
 		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue {
-			return act, qr.Description
+			return act, qr.timeout, qr.Description
 		}
 	}
-	return QRContinue, ""
+	return QRContinue, 0, ""
 }
 
 //-----------------------------------------------
@@ -211,6 +221,9 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// timeout is the query timeout to use for the QRTimeout action.
+	timeout time.Duration
 }
 
 type namedRegexp struct {
@@ -252,7 +265,8 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.timeout == other.timeout)
 }
 
 // Copy performs a deep copy of a Rule.
@@ -266,6 +280,7 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		leadingComment:  qr.leadingComment,
 		trailingComment: qr.trailingComment,
 		act:             qr.act,
+		timeout:         qr.timeout,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -314,6 +329,9 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.timeout != 0 {
+		safeEncode(b, `,"Timeout":`, qr.timeout.String())
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -369,6 +387,12 @@ func (qr *Rule) SetTrailingCommentCond(pattern string) (err error) {
 	return
 }
 
+// SetTimeout sets the query timeout to use when the rule fires. It is only
+// used by the QRTimeout action.
+func (qr *Rule) SetTimeout(timeout time.Duration) {
+	qr.timeout = timeout
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -528,11 +552,14 @@ func bvMatch(bvcond BindVarCond, bindVars map[string]*querypb.BindVariable) bool
 // when a Rule is triggered.
 type Action int
 
-// These are actions.
+// These are actions. There is no action redirecting the query elsewhere,
+// since the tablet can only run it or fail it: QRFailRetry makes vtgate
+// retry it on another tablet.
 const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRTimeout
 )
 
 // MarshalJSON marshals to JSON.
@@ -544,6 +571,8 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRTimeout:
+		str = "TIMEOUT"
 	default:
 		str = "INVALID"
 	}
@@ -834,7 +863,7 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 		var lv []interface{}
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment", "Timeout":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
@@ -914,11 +943,21 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "TIMEOUT":
+				qr.act = QRTimeout
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "Timeout":
+			qr.timeout, err = time.ParseDuration(sv)
+			if err != nil || qr.timeout <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Timeout %s", sv)
+			}
 		}
 	}
+	if (qr.act == QRTimeout) != (qr.timeout != 0) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Timeout must be set if and only if Action is TIMEOUT")
+	}
 	return qr, nil
 }
 
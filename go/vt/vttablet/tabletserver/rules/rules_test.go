@@ -23,8 +23,10 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -729,6 +731,54 @@ func TestBuildQueryRuleActionFail(t *testing.T) {
 	}
 }
 
+func TestDeleteKeepsOrder(t *testing.T) {
+	qrs := New()
+	qr1 := NewQueryRule("rule 1", "r1", QRFail)
+	qr2 := NewQueryRule("rule 2", "r2", QRFail)
+	qr3 := NewQueryRule("rule 3", "r3", QRFail)
+	qr4 := NewQueryRule("rule 4", "r4", QRFail)
+	qrs.Add(qr1)
+	qrs.Add(qr2)
+	qrs.Add(qr3)
+	qrs.Add(qr4)
+
+	assert.Equal(t, qr2, qrs.Delete("r2"))
+	assert.Equal(t, []*Rule{qr1, qr3, qr4}, qrs.rules)
+}
+
+func TestBuildQueryRuleActionTimeout(t *testing.T) {
+	var ruleInfo map[string]interface{}
+	err := json.Unmarshal([]byte(`{"Name": "r1", "Action": "TIMEOUT", "Timeout": "1.5s"}`), &ruleInfo)
+	require.NoError(t, err)
+	qr, err := BuildQueryRule(ruleInfo)
+	require.NoError(t, err)
+	assert.Equal(t, QRTimeout, qr.act)
+	assert.Equal(t, 1500*time.Millisecond, qr.timeout)
+
+	data, err := json.Marshal(qr)
+	require.NoError(t, err)
+	assert.Equal(t, `{"Description":"","Name":"r1","Action":"TIMEOUT","Timeout":"1.5s"}`, string(data))
+
+	qrs := New()
+	qrs.Add(qr)
+	action, timeout, _ := qrs.GetActionWithTimeout("", "", nil, sqlparser.MarginComments{})
+	assert.Equal(t, QRTimeout, action)
+	assert.Equal(t, 1500*time.Millisecond, timeout)
+
+	for _, input := range []string{
+		`{"Action": "TIMEOUT"}`,
+		`{"Action": "FAIL", "Timeout": "1s"}`,
+		`{"Action": "TIMEOUT", "Timeout": "-1s"}`,
+		`{"Action": "TIMEOUT", "Timeout": "soon"}`,
+	} {
+		var ruleInfo map[string]interface{}
+		err := json.Unmarshal([]byte(input), &ruleInfo)
+		require.NoError(t, err)
+		_, err = BuildQueryRule(ruleInfo)
+		assert.Error(t, err, input)
+	}
+}
+
 func TestBadAddBindVarCond(t *testing.T) {
 	qr1 := NewQueryRule("rule 1", "r1", QRFail)
 	err := qr1.AddBindVarCond("a", true, false, QRMatch, uint64(1))
@@ -25,7 +25,7 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(144)
 	}
 	// field Fields []*vitess.io/vitess/go/vt/proto/query.Field
 	{
@@ -34,6 +34,10 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field PriorityLanes []vitess.io/vitess/go/vt/vttablet/tabletserver/schema.PriorityLane
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PriorityLanes)) * int64(16))
+	}
 	return size
 }
 func (cached *Table) CachedSize(alloc bool) int64 {
@@ -59,7 +63,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	// field SequenceInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.SequenceInfo
 	if cached.SequenceInfo != nil {
-		size += hack.RuntimeAllocSize(int64(24))
+		size += hack.RuntimeAllocSize(int64(41))
 	}
 	// field MessageInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.MessageInfo
 	size += cached.MessageInfo.CachedSize(true)
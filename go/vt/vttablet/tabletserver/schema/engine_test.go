@@ -493,6 +493,7 @@ func initialSchema() map[string]*Table {
 				AckWaitDuration:    30 * time.Second,
 				PurgeAfterDuration: 120 * time.Second,
 				MinBackoff:         30 * time.Second,
+				BackoffJitter:      1.0 / 3,
 				BatchSize:          1,
 				CacheSize:          10,
 				PollInterval:       30 * time.Second,
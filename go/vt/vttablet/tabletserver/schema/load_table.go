@@ -18,6 +18,7 @@ package schema
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -36,8 +37,10 @@ func LoadTable(conn *connpool.DBConn, tableName string, comment string) (*Table,
 	}
 	switch {
 	case strings.Contains(comment, "vitess_sequence"):
+		if err := loadSequenceInfo(ta, comment); err != nil {
+			return nil, err
+		}
 		ta.Type = Sequence
-		ta.SequenceInfo = &SequenceInfo{}
 	case strings.Contains(comment, "vitess_message"):
 		if err := loadMessageInfo(ta, comment); err != nil {
 			return nil, err
@@ -56,6 +59,47 @@ func fetchColumns(ta *Table, conn *connpool.DBConn, sqlTableName string) error {
 	return nil
 }
 
+func loadSequenceInfo(ta *Table, comment string) error {
+	ta.SequenceInfo = &SequenceInfo{}
+	keyvals := extractKeyvals(comment)
+
+	var err error
+	if sv := keyvals["vt_max_value"]; sv != "" {
+		if ta.SequenceInfo.MaxValue, err = strconv.ParseInt(sv, 10, 64); err != nil {
+			return err
+		}
+		if ta.SequenceInfo.MaxValue < 1 || ta.SequenceInfo.MaxValue == math.MaxInt64 {
+			return fmt.Errorf("vt_max_value must be between 1 and %d for sequence table: %s", int64(math.MaxInt64-1), ta.Name.String())
+		}
+	}
+	if ta.SequenceInfo.ExhaustionAlert, err = getOptionalFloat(keyvals, "vt_exhaustion_alert", 0); err != nil {
+		return err
+	}
+	if ta.SequenceInfo.ExhaustionAlert < 0 || ta.SequenceInfo.ExhaustionAlert >= 1 {
+		return fmt.Errorf("vt_exhaustion_alert must be in [0, 1) for sequence table: %s", ta.Name.String())
+	}
+	if sv := keyvals["vt_strict_monotonic"]; sv != "" {
+		if ta.SequenceInfo.StrictMonotonic, err = strconv.ParseBool(sv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractKeyvals returns the key=value options of a table comment.
+func extractKeyvals(comment string) map[string]string {
+	keyvals := make(map[string]string)
+	inputs := strings.Split(comment, ",")
+	for _, input := range inputs {
+		kv := strings.Split(input, "=")
+		if len(kv) != 2 {
+			continue
+		}
+		keyvals[kv[0]] = kv[1]
+	}
+	return keyvals
+}
+
 func loadMessageInfo(ta *Table, comment string) error {
 	hiddenCols := map[string]struct{}{
 		"priority":   {},
@@ -73,16 +117,7 @@ func loadMessageInfo(ta *Table, comment string) error {
 	}
 
 	ta.MessageInfo = &MessageInfo{}
-	// Extract keyvalues.
-	keyvals := make(map[string]string)
-	inputs := strings.Split(comment, ",")
-	for _, input := range inputs {
-		kv := strings.Split(input, "=")
-		if len(kv) != 2 {
-			continue
-		}
-		keyvals[kv[0]] = kv[1]
-	}
+	keyvals := extractKeyvals(comment)
 
 	var err error
 	if ta.MessageInfo.AckWaitDuration, err = getDuration(keyvals, "vt_ack_wait"); err != nil {
@@ -110,6 +145,43 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	if ta.MessageInfo.BackoffMultiplier, err = getOptionalFloat(keyvals, "vt_backoff_multiplier", 0); err != nil {
+		return err
+	}
+	if ta.MessageInfo.BackoffMultiplier != 0 && ta.MessageInfo.BackoffMultiplier < 1 {
+		return fmt.Errorf("vt_backoff_multiplier must be at least 1 for message table: %s", ta.Name.String())
+	}
+	// the default jitter is the one the backoff always had
+	if ta.MessageInfo.BackoffJitter, err = getOptionalFloat(keyvals, "vt_backoff_jitter", 1.0/3); err != nil {
+		return err
+	}
+	if ta.MessageInfo.BackoffJitter < 0 || ta.MessageInfo.BackoffJitter >= 1 {
+		return fmt.Errorf("vt_backoff_jitter must be in [0, 1) for message table: %s", ta.Name.String())
+	}
+	if sv := keyvals["vt_max_attempts"]; sv != "" {
+		if ta.MessageInfo.MaxAttempts, err = strconv.Atoi(sv); err != nil {
+			return err
+		}
+		if ta.MessageInfo.MaxAttempts < 0 {
+			return fmt.Errorf("vt_max_attempts must not be negative for message table: %s", ta.Name.String())
+		}
+	}
+	if sv := keyvals["vt_dead_letter"]; sv != "" {
+		found := false
+		for action, name := range DeadLetterActionNames {
+			if sv == name {
+				ta.MessageInfo.DeadLetter, found = action, true
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid vt_dead_letter %s for message table: %s", sv, ta.Name.String())
+		}
+	}
+
+	if ta.MessageInfo.PriorityLanes, err = getPriorityLanes(keyvals, "vt_priority_lanes"); err != nil {
+		return err
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -139,6 +211,14 @@ func getDuration(in map[string]string, key string) (time.Duration, error) {
 	return time.Duration(v * 1e9), nil
 }
 
+func getOptionalFloat(in map[string]string, key string, def float64) (float64, error) {
+	sv := in[key]
+	if sv == "" {
+		return def, nil
+	}
+	return strconv.ParseFloat(sv, 64)
+}
+
 func getNum(in map[string]string, key string) (int, error) {
 	sv := in[key]
 	if sv == "" {
@@ -150,3 +230,35 @@ func getNum(in map[string]string, key string) (int, error) {
 	}
 	return v, nil
 }
+
+// getPriorityLanes parses the optional priority lanes, specified as
+// max_priority:cache_size pairs separated by semicolons.
+func getPriorityLanes(in map[string]string, key string) ([]PriorityLane, error) {
+	sv := in[key]
+	if sv == "" {
+		return nil, nil
+	}
+	var lanes []PriorityLane
+	for _, lane := range strings.Split(sv, ";") {
+		parts := strings.Split(lane, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid priority lane %q for message table, expected max_priority:cache_size", lane)
+		}
+		maxPriority, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		cacheSize, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if cacheSize <= 0 {
+			return nil, fmt.Errorf("invalid priority lane %q for message table, cache_size must be positive", lane)
+		}
+		if n := len(lanes); n > 0 && maxPriority <= lanes[n-1].MaxPriority {
+			return nil, fmt.Errorf("invalid priority lanes %q for message table, max_priority must be increasing", sv)
+		}
+		lanes = append(lanes, PriorityLane{MaxPriority: maxPriority, CacheSize: cacheSize})
+	}
+	return lanes, nil
+}
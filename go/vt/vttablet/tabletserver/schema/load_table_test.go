@@ -84,6 +84,32 @@ func TestLoadTableSequence(t *testing.T) {
 	}
 }
 
+func TestLoadTableSequenceOptions(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range getTestLoadTableQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "vitess_sequence,vt_max_value=1000,vt_exhaustion_alert=0.2,vt_strict_monotonic=1", db)
+	require.NoError(t, err)
+	assert.Equal(t, &SequenceInfo{
+		MaxValue:        1000,
+		ExhaustionAlert: 0.2,
+		StrictMonotonic: true,
+	}, table.SequenceInfo)
+
+	for _, comment := range []string{
+		"vitess_sequence,vt_max_value=0",
+		"vitess_sequence,vt_max_value=9223372036854775807",
+		"vitess_sequence,vt_max_value=a",
+		"vitess_sequence,vt_exhaustion_alert=1",
+		"vitess_sequence,vt_strict_monotonic=a",
+	} {
+		_, err := newTestLoadTable("USER_TABLE", comment, db)
+		assert.Error(t, err, comment)
+	}
+}
+
 func TestLoadTableMessage(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -127,6 +153,7 @@ func TestLoadTableMessage(t *testing.T) {
 			AckWaitDuration:    30 * time.Second,
 			PurgeAfterDuration: 120 * time.Second,
 			MinBackoff:         30 * time.Second,
+			BackoffJitter:      1.0 / 3,
 			BatchSize:          1,
 			CacheSize:          10,
 			PollInterval:       30 * time.Second,
@@ -141,6 +168,34 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading priority lanes
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_priority_lanes=-1:5;3:20", db)
+	require.NoError(t, err)
+	want.MessageInfo.PriorityLanes = []PriorityLane{
+		{MaxPriority: -1, CacheSize: 5},
+		{MaxPriority: 3, CacheSize: 20},
+	}
+	assert.Equal(t, want, table)
+
+	// Test loading the backoff policy
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_priority_lanes=-1:5;3:20,vt_backoff_multiplier=1.5,vt_backoff_jitter=0,vt_max_attempts=5,vt_dead_letter=ack", db)
+	require.NoError(t, err)
+	want.MessageInfo.BackoffMultiplier = 1.5
+	want.MessageInfo.BackoffJitter = 0
+	want.MessageInfo.MaxAttempts = 5
+	want.MessageInfo.DeadLetter = DeadLetterAck
+	assert.Equal(t, want, table)
+
+	for _, policy := range []string{"vt_backoff_multiplier=0.5", "vt_backoff_multiplier=a", "vt_backoff_jitter=1", "vt_backoff_jitter=-0.1", "vt_max_attempts=-1", "vt_max_attempts=a", "vt_dead_letter=drop"} {
+		_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,"+policy, db)
+		assert.Error(t, err, policy)
+	}
+
+	for _, lanes := range []string{"1", "1:a", "1:0", "3:5;1:5", "1:5;1:5"} {
+		_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_priority_lanes="+lanes, db)
+		assert.Error(t, err, lanes)
+	}
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
@@ -17,6 +17,7 @@ limitations under the License.
 package schema
 
 import (
+	"math"
 	"sync"
 	"time"
 
@@ -66,6 +67,45 @@ type SequenceInfo struct {
 	sync.Mutex
 	NextVal int64
 	LastVal int64
+
+	// MaxValue is the largest value handed out by the sequence, or 0 if
+	// it is only bounded by the type of its next_id column.
+	MaxValue int64
+	// ExhaustionAlert is the fraction of the values of the sequence
+	// below which the values left raise an alert, or 0 for no alert.
+	ExhaustionAlert float64
+	// StrictMonotonic disables the cache of the sequence: every call
+	// reserves its values in the sequence table, so that no cached values
+	// are skipped when the primary changes, and changes of the table take
+	// effect right away, at the cost of a transaction per call.
+	StrictMonotonic bool
+}
+
+// MaxSequenceValue returns the largest value handed out by the sequence. The
+// next_id column holds the value after the last reserved one, so an unbounded
+// sequence stops one short of the largest int64.
+func (si *SequenceInfo) MaxSequenceValue() int64 {
+	if si.MaxValue > 0 {
+		return si.MaxValue
+	}
+	return math.MaxInt64 - 1
+}
+
+// SequenceState is the state of a sequence, as stored in its table and
+// cached by the primary.
+type SequenceState struct {
+	Name string
+	// NextID and Cache are the values of the sequence table.
+	NextID int64
+	Cache  int64
+	// NextVal and LastVal are the range of the cached values, both 0 if
+	// no values are cached.
+	NextVal int64
+	LastVal int64
+
+	MaxValue        int64
+	ExhaustionAlert float64
+	StrictMonotonic bool
 }
 
 // MessageInfo contains info specific to message tables.
@@ -103,6 +143,56 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// BackoffMultiplier specifies the factor by which the backoff
+	// grows at every attempt. Zero means the backoff doubles.
+	BackoffMultiplier float64
+
+	// BackoffJitter specifies the fraction by which the backoff
+	// is randomly increased or decreased.
+	BackoffJitter float64
+
+	// MaxAttempts specifies the number of times a message is sent
+	// before it's dead-lettered. Zero means no limit.
+	MaxAttempts int
+
+	// DeadLetter specifies what happens to the messages which
+	// were sent MaxAttempts times.
+	DeadLetter DeadLetterAction
+
+	// PriorityLanes reserve parts of the cache for the most
+	// urgent messages, in increasing order of MaxPriority.
+	// The messages which are in no lane use CacheSize.
+	PriorityLanes []PriorityLane
+}
+
+// DeadLetterAction is what happens to a message which exhausted
+// its attempts.
+type DeadLetterAction int
+
+const (
+	// DeadLetterPark keeps the message in the table, but it's not
+	// sent any more: its time_next is set to NULL.
+	DeadLetterPark = DeadLetterAction(iota)
+	// DeadLetterAck acks the message, it's then purged like the
+	// messages acked by the receivers.
+	DeadLetterAck
+)
+
+// DeadLetterActionNames are the names of the dead-letter actions, as
+// specified in the table comments.
+var DeadLetterActionNames = map[DeadLetterAction]string{
+	DeadLetterPark: "park",
+	DeadLetterAck:  "ack",
+}
+
+// PriorityLane reserves a part of the message cache for the
+// messages whose priority is at most MaxPriority, and greater
+// than the MaxPriority of the previous lane. Lower priorities
+// are more urgent.
+type PriorityLane struct {
+	MaxPriority int64
+	CacheSize   int
 }
 
 // NewTable creates a new Table.
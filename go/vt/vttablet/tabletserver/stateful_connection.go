@@ -100,6 +100,7 @@ func (sc *StatefulConnection) Exec(ctx context.Context, query string, maxrows in
 		}
 		return nil, err
 	}
+	sc.txProps.RecordWrite(query, r.RowsAffected)
 	return r, nil
 }
 
@@ -142,6 +142,18 @@ func (sf *StatefulConnectionPool) GetOutdated(age time.Duration, purpose string)
 	return mapToTxConn(sf.active.GetOutdated(age, purpose))
 }
 
+// GetExpired returns a list of connections that are older than the timeout
+// of their transaction, or than timeout if their transaction does not set it.
+// It does not return any connections that are in use.
+func (sf *StatefulConnectionPool) GetExpired(timeout time.Duration, purpose string) []*StatefulConnection {
+	return mapToTxConn(sf.active.GetOutdatedFunc(func(val interface{}) time.Duration {
+		if conn := val.(*StatefulConnection); conn.txProps != nil && conn.txProps.Timeout > 0 {
+			return conn.txProps.Timeout
+		}
+		return timeout
+	}, purpose))
+}
+
 func mapToTxConn(outdated []interface{}) []*StatefulConnection {
 	result := make([]*StatefulConnection, len(outdated))
 	for i, el := range outdated {
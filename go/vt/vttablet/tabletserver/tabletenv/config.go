@@ -20,6 +20,8 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/encoding/prototext"
@@ -49,8 +51,9 @@ var (
 		DB: &dbconfigs.GlobalDBConfigs,
 	}
 
-	queryLogHandler = flag.String("query-log-stream-handler", "/debug/querylog", "URL handler for streaming queries log")
-	txLogHandler    = flag.String("transaction-log-stream-handler", "/debug/txlog", "URL handler for streaming transactions log")
+	queryLogHandler   = flag.String("query-log-stream-handler", "/debug/querylog", "URL handler for streaming queries log")
+	txLogHandler      = flag.String("transaction-log-stream-handler", "/debug/txlog", "URL handler for streaming transactions log")
+	requestLogHandler = flag.String("request-log-handler", "/debug/request_log", "URL handler for searching the recent queries log by request ID")
 
 	// TxLogger can be used to enable logging of transactions.
 	// Call TxLogger.ServeLogs in your main program to enable logging.
@@ -71,17 +74,19 @@ var (
 	deprecatedFoundRowsPoolSize             int
 
 	// The following vars are used for custom initialization of Tabletconfig.
-	enableHotRowProtection       bool
-	enableHotRowProtectionDryRun bool
-	enableConsolidator           bool
-	enableConsolidatorReplicas   bool
-	enableHeartbeat              bool
-	heartbeatInterval            time.Duration
-	healthCheckInterval          time.Duration
-	degradedThreshold            time.Duration
-	unhealthyThreshold           time.Duration
-	transitionGracePeriod        time.Duration
-	enableReplicationReporter    bool
+	enableHotRowProtection                 bool
+	enableHotRowProtectionDryRun           bool
+	enableLargeTransactionProtection       bool
+	enableLargeTransactionProtectionDryRun bool
+	enableConsolidator                     bool
+	enableConsolidatorReplicas             bool
+	enableHeartbeat                        bool
+	heartbeatInterval                      time.Duration
+	healthCheckInterval                    time.Duration
+	degradedThreshold                      time.Duration
+	unhealthyThreshold                     time.Duration
+	transitionGracePeriod                  time.Duration
+	enableReplicationReporter              bool
 )
 
 func init() {
@@ -89,13 +94,16 @@ func init() {
 	flag.IntVar(&currentConfig.OltpReadPool.PrefillParallelism, "queryserver-config-pool-prefill-parallelism", defaultConfig.OltpReadPool.PrefillParallelism, "query server read pool prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
 	flag.IntVar(&currentConfig.OlapReadPool.Size, "queryserver-config-stream-pool-size", defaultConfig.OlapReadPool.Size, "query server stream connection pool size, stream pool is used by stream queries: queries that return results to client in a streaming fashion")
 	flag.IntVar(&currentConfig.OlapReadPool.PrefillParallelism, "queryserver-config-stream-pool-prefill-parallelism", defaultConfig.OlapReadPool.PrefillParallelism, "query server stream pool prefill parallelism, a non-zero value will prefill the pool using the specified parallelism")
+	flag.IntVar(&currentConfig.IsolationLevelPool.Size, "queryserver-config-isolation-level-pool-size", defaultConfig.IsolationLevelPool.Size, "query server isolation level pools size, if not 0 regular queries of sessions which set their transaction isolation level to READ COMMITTED or REPEATABLE READ use a pool of that size dedicated to their isolation level")
 	flag.IntVar(&deprecatedMessagePoolSize, "queryserver-config-message-conn-pool-size", 0, "DEPRECATED")
 	flag.IntVar(&deprecatedMessagePoolPrefillParallelism, "queryserver-config-message-conn-pool-prefill-parallelism", 0, "DEPRECATED: Unused.")
+	flag.IntVar(&currentConfig.DbaPool.Size, "queryserver-config-dba-pool-size", defaultConfig.DbaPool.Size, "query server dba pool size, if not 0 the queries of the dba workload use a pool of that size instead of the query and stream pools")
 	flag.IntVar(&currentConfig.TxPool.Size, "queryserver-config-transaction-cap", defaultConfig.TxPool.Size, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
 	flag.IntVar(&currentConfig.TxPool.PrefillParallelism, "queryserver-config-transaction-prefill-parallelism", defaultConfig.TxPool.PrefillParallelism, "query server transaction prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
 	flag.IntVar(&currentConfig.MessagePostponeParallelism, "queryserver-config-message-postpone-cap", defaultConfig.MessagePostponeParallelism, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&deprecatedFoundRowsPoolSize, "client-found-rows-pool-size", 0, "DEPRECATED: queryserver-config-transaction-cap will be used instead.")
 	SecondsVar(&currentConfig.Oltp.TxTimeoutSeconds, "queryserver-config-transaction-timeout", defaultConfig.Oltp.TxTimeoutSeconds, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
+	SecondsVar(&currentConfig.Oltp.MaxTxTimeoutSeconds, "queryserver-config-max-transaction-timeout", defaultConfig.Oltp.MaxTxTimeoutSeconds, "query server maximum transaction timeout (in seconds), the sessions can override the transaction timeout of their transactions up to this value. If it is lower than the transaction timeout, the transaction timeout is the maximum")
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "how long to wait (in seconds) for queries and transactions to complete during graceful shutdown.")
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "transaction_shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "DEPRECATED: use shutdown_grace_period instead.")
 	flag.IntVar(&currentConfig.Oltp.MaxRows, "queryserver-config-max-result-size", defaultConfig.Oltp.MaxRows, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
@@ -105,8 +113,14 @@ func init() {
 	flag.BoolVar(&deprecateAllowUnsafeDMLs, "queryserver-config-allowunsafe-dmls", false, "deprecated")
 
 	flag.IntVar(&currentConfig.StreamBufferSize, "queryserver-config-stream-buffer-size", defaultConfig.StreamBufferSize, "query server stream buffer size, the maximum number of bytes sent from vttablet for each stream call. It's recommended to keep this value in sync with vtgate's stream_buffer_size.")
+	flag.Int64Var(&currentConfig.QueryMemoryLimit, "queryserver-config-query-memory-limit", defaultConfig.QueryMemoryLimit, "query server query memory limit in bytes, the maximum size of the results fetched from MySQL by a single query. For streaming queries, this includes all the rows streamed so far. Queries exceeding it are killed. 0 disables the limit.")
+	flag.Int64Var(&currentConfig.QueryMemoryBudget, "queryserver-config-query-memory-budget", defaultConfig.QueryMemoryBudget, "query server query memory budget in bytes, the maximum size of the results held by all the queries running in vttablet. The query whose results make the total exceed it is killed. 0 disables the budget.")
+	flag.IntVar(&currentConfig.BatchMaxQueries, "queryserver-config-batch-max-queries", defaultConfig.BatchMaxQueries, "query server batch max queries, the maximum number of queries executed per round of an ExecuteBatch. Larger batches are split in several rounds, unless they run as a transaction, in which case they are rejected. 0 disables the limit.")
+	flag.Int64Var(&currentConfig.BatchMaxBindVarsSize, "queryserver-config-batch-max-bind-vars-size", defaultConfig.BatchMaxBindVarsSize, "query server batch max bind vars size in bytes, the maximum total size of the bind variables of the queries executed per round of an ExecuteBatch. Larger batches are split in several rounds, unless they run as a transaction, in which case they are rejected. 0 disables the limit.")
 	flag.IntVar(&currentConfig.QueryCacheSize, "queryserver-config-query-cache-size", defaultConfig.QueryCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	flag.Int64Var(&currentConfig.QueryCacheMemory, "queryserver-config-query-cache-memory", defaultConfig.QueryCacheMemory, "query server query cache size in bytes, maximum amount of memory to be used for caching. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
+	flag.Int64Var(&currentConfig.ResultCacheMemory, "queryserver-config-result-cache-memory", defaultConfig.ResultCacheMemory, "query server result cache size in bytes. The results of read-only and deterministic queries executed on a primary outside of transactions are cached, and invalidated when this tablet runs a DML or DDL on their tables. Writes which do not go through the query service of this tablet, e.g. the ones of vreplication, are only bounded by -queryserver-config-result-cache-ttl. Replicas do not cache results. 0 disables the cache.")
+	SecondsVar(&currentConfig.ResultCacheTTLSeconds, "queryserver-config-result-cache-ttl", defaultConfig.ResultCacheTTLSeconds, "query server result cache TTL (in seconds), how long a result can be served from the result cache.")
 	flag.BoolVar(&currentConfig.QueryCacheLFU, "queryserver-config-query-cache-lfu", defaultConfig.QueryCacheLFU, "query server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
 	SecondsVar(&currentConfig.SchemaReloadIntervalSeconds, "queryserver-config-schema-reload-time", defaultConfig.SchemaReloadIntervalSeconds, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
 	SecondsVar(&currentConfig.SignalSchemaChangeReloadIntervalSeconds, "queryserver-config-schema-change-signal-interval", defaultConfig.SignalSchemaChangeReloadIntervalSeconds, "query server schema change signal interval defines at which interval the query server shall send schema updates to vtgate.")
@@ -114,6 +128,7 @@ func init() {
 	SecondsVar(&currentConfig.Oltp.QueryTimeoutSeconds, "queryserver-config-query-timeout", defaultConfig.Oltp.QueryTimeoutSeconds, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
 	SecondsVar(&currentConfig.OltpReadPool.TimeoutSeconds, "queryserver-config-query-pool-timeout", defaultConfig.OltpReadPool.TimeoutSeconds, "query server query pool timeout (in seconds), it is how long vttablet waits for a connection from the query pool. If set to 0 (default) then the overall query timeout is used instead.")
 	SecondsVar(&currentConfig.OlapReadPool.TimeoutSeconds, "queryserver-config-stream-pool-timeout", defaultConfig.OlapReadPool.TimeoutSeconds, "query server stream pool timeout (in seconds), it is how long vttablet waits for a connection from the stream pool. If set to 0 (default) then there is no timeout.")
+	SecondsVar(&currentConfig.DbaPool.TimeoutSeconds, "queryserver-config-dba-pool-timeout", defaultConfig.DbaPool.TimeoutSeconds, "query server dba pool timeout (in seconds), it is how long vttablet waits for a connection from the dba pool. If set to 0 (default) then there is no timeout.")
 	SecondsVar(&currentConfig.TxPool.TimeoutSeconds, "queryserver-config-txpool-timeout", defaultConfig.TxPool.TimeoutSeconds, "query server transaction pool timeout, it is how long vttablet waits if tx pool is full")
 	SecondsVar(&currentConfig.OltpReadPool.IdleTimeoutSeconds, "queryserver-config-idle-timeout", defaultConfig.OltpReadPool.IdleTimeoutSeconds, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
 	flag.IntVar(&currentConfig.OltpReadPool.MaxWaiters, "queryserver-config-query-pool-waiter-cap", defaultConfig.OltpReadPool.MaxWaiters, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
@@ -124,6 +139,7 @@ func init() {
 	flag.StringVar(&currentConfig.TableACLExemptACL, "queryserver-config-acl-exempt-acl", defaultConfig.TableACLExemptACL, "an acl that exempt from table acl checking (this acl is free to access any vitess tables).")
 	flag.BoolVar(&currentConfig.TerseErrors, "queryserver-config-terse-errors", defaultConfig.TerseErrors, "prevent bind vars from escaping in returned or logged errors")
 	flag.BoolVar(&currentConfig.AnnotateQueries, "queryserver-config-annotate-queries", defaultConfig.AnnotateQueries, "prefix queries to MySQL backend with comment indicating vtgate principal (user) and target tablet type")
+	flag.BoolVar(&currentConfig.DeriveMaxExecutionTime, "queryserver-config-derive-max-execution-time", defaultConfig.DeriveMaxExecutionTime, "add a MAX_EXECUTION_TIME optimizer hint to the selects sent to MySQL, derived from the remaining deadline budget of the request, so that MySQL aborts them by itself once the caller stopped waiting")
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
 	flag.BoolVar(&currentConfig.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to update schema when it sees a DDL.")
 	flag.BoolVar(&currentConfig.TrackSchemaVersions, "track_schema_versions", false, "When enabled, vttablet will store versions of schemas at each position that a DDL is applied and allow retrieval of the schema corresponding to a position")
@@ -141,6 +157,12 @@ func init() {
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
 
+	flag.BoolVar(&enableLargeTransactionProtection, "enable_large_transaction_protection", false, "If true, the commit of the transactions exceeding one of the -large_transaction_max_* thresholds is refused, and the transactions are rolled back.")
+	flag.BoolVar(&enableLargeTransactionProtectionDryRun, "enable_large_transaction_protection_dry_run", false, "If true, large transaction protection is not enforced but the transactions exceeding one of the -large_transaction_max_* thresholds are logged and counted.")
+	flag.Int64Var(&currentConfig.LargeTransaction.MaxRows, "large_transaction_max_rows", defaultConfig.LargeTransaction.MaxRows, "Maximum number of rows a transaction may modify before it is considered large. 0 disables the threshold.")
+	flag.Int64Var(&currentConfig.LargeTransaction.MaxStatementBytes, "large_transaction_max_statement_bytes", defaultConfig.LargeTransaction.MaxStatementBytes, "Maximum total size of the statements which modified rows in a transaction, in bytes, before it is considered large. It approximates the size of the binlog events of the transaction, which is only known once it is committed. 0 disables the threshold.")
+	SecondsVar(&currentConfig.LargeTransaction.MaxDurationSeconds, "large_transaction_max_duration", defaultConfig.LargeTransaction.MaxDurationSeconds, "Maximum duration of a transaction (in seconds) before it is considered large. 0 disables the threshold.")
+
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
 	flag.Float64Var(&currentConfig.TransactionLimitPerUser, "transaction_limit_per_user", defaultConfig.TransactionLimitPerUser, "Maximum number of transactions a single user is allowed to use at any time, represented as fraction of -transaction_cap.")
@@ -151,7 +173,9 @@ func init() {
 
 	flag.BoolVar(&enableHeartbeat, "heartbeat_enable", false, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&heartbeatInterval, "heartbeat_interval", 1*time.Second, "How frequently to read and write replication heartbeat.")
+	flag.StringVar(&currentConfig.ReplicationTracker.PtHeartbeatTable, "heartbeat_pt_table", defaultConfig.ReplicationTracker.PtHeartbeatTable, "If set, the heartbeat writer also records the current time in this pt-heartbeat compatible table, given as database.table, so that the tools reading pt-heartbeat tables can measure the replication lag.")
 	flagutil.DualFormatBoolVar(&currentConfig.EnableLagThrottler, "enable_lag_throttler", defaultConfig.EnableLagThrottler, "If true, vttablet will run a throttler service, and will implicitly enable heartbeats")
+	flagutil.DualFormatBoolVar(&currentConfig.ThrottlerConfigViaTopo, "throttler_config_via_topo", defaultConfig.ThrottlerConfigViaTopo, "If true, the throttler runs with the configuration of the keyspace in the topo (see vtctldclient UpdateThrottlerConfig), applied without restarts, and heartbeats are implicitly enabled. Until the configuration is first set, the throttler flags apply")
 
 	flag.BoolVar(&currentConfig.EnforceStrictTransTables, "enforce_strict_trans_tables", defaultConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
@@ -173,6 +197,11 @@ func Init() {
 	// TODO(sougou): Make a decision on whether this should be global or per-pool.
 	currentConfig.OlapReadPool.IdleTimeoutSeconds = currentConfig.OltpReadPool.IdleTimeoutSeconds
 	currentConfig.TxPool.IdleTimeoutSeconds = currentConfig.OltpReadPool.IdleTimeoutSeconds
+	// The isolation level pools are partitions of the query pool.
+	currentConfig.IsolationLevelPool.IdleTimeoutSeconds = currentConfig.OltpReadPool.IdleTimeoutSeconds
+	currentConfig.IsolationLevelPool.TimeoutSeconds = currentConfig.OltpReadPool.TimeoutSeconds
+	currentConfig.IsolationLevelPool.MaxWaiters = currentConfig.OltpReadPool.MaxWaiters
+	currentConfig.DbaPool.IdleTimeoutSeconds = currentConfig.OltpReadPool.IdleTimeoutSeconds
 
 	if enableHotRowProtection {
 		if enableHotRowProtectionDryRun {
@@ -184,6 +213,15 @@ func Init() {
 		currentConfig.HotRowProtection.Mode = Disable
 	}
 
+	switch {
+	case enableLargeTransactionProtectionDryRun:
+		currentConfig.LargeTransaction.Mode = Dryrun
+	case enableLargeTransactionProtection:
+		currentConfig.LargeTransaction.Mode = Enable
+	default:
+		currentConfig.LargeTransaction.Mode = Disable
+	}
+
 	switch {
 	case enableConsolidatorReplicas:
 		currentConfig.Consolidator = NotOnPrimary
@@ -229,6 +267,10 @@ func Init() {
 	if *txLogHandler != "" {
 		TxLogger.ServeLogs(*txLogHandler, streamlog.GetFormatter(TxLogger))
 	}
+
+	if *requestLogHandler != "" && *streamlog.QueryLogRecentSize > 0 {
+		StatsLogger.KeepRecent(*streamlog.QueryLogRecentSize).ServeLogs(*requestLogHandler, streamlog.GetFormatter(StatsLogger), matchRequestID)
+	}
 }
 
 // TabletConfig contains all the configuration for query service
@@ -239,8 +281,17 @@ type TabletConfig struct {
 	OlapReadPool ConnPoolConfig `json:"olapReadPool,omitempty"`
 	TxPool       ConnPoolConfig `json:"txPool,omitempty"`
 
+	// IsolationLevelPool is the config of each of the pools dedicated to a
+	// transaction isolation level. They are disabled if the size is 0.
+	IsolationLevelPool ConnPoolConfig `json:"isolationLevelPool,omitempty"`
+	// DbaPool is the config of the pool used by the queries of the DBA
+	// workload, so that they do not compete with the application queries.
+	// It is disabled if the size is 0.
+	DbaPool ConnPoolConfig `json:"dbaPool,omitempty"`
+
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
+	LargeTransaction LargeTransactionConfig `json:"largeTransaction,omitempty"`
 
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
@@ -254,15 +305,22 @@ type TabletConfig struct {
 	StreamBufferSize                        int     `json:"streamBufferSize,omitempty"`
 	ConsolidatorStreamTotalSize             int64   `json:"consolidatorStreamTotalSize,omitempty"`
 	ConsolidatorStreamQuerySize             int64   `json:"consolidatorStreamQuerySize,omitempty"`
+	QueryMemoryLimit                        int64   `json:"queryMemoryLimit,omitempty"`
+	QueryMemoryBudget                       int64   `json:"queryMemoryBudget,omitempty"`
+	BatchMaxQueries                         int     `json:"batchMaxQueries,omitempty"`
+	BatchMaxBindVarsSize                    int64   `json:"batchMaxBindVarsSize,omitempty"`
 	QueryCacheSize                          int     `json:"queryCacheSize,omitempty"`
 	QueryCacheMemory                        int64   `json:"queryCacheMemory,omitempty"`
 	QueryCacheLFU                           bool    `json:"queryCacheLFU,omitempty"`
+	ResultCacheMemory                       int64   `json:"resultCacheMemory,omitempty"`
+	ResultCacheTTLSeconds                   Seconds `json:"resultCacheTTLSeconds,omitempty"`
 	SchemaReloadIntervalSeconds             Seconds `json:"schemaReloadIntervalSeconds,omitempty"`
 	SignalSchemaChangeReloadIntervalSeconds Seconds `json:"signalSchemaChangeReloadIntervalSeconds,omitempty"`
 	WatchReplication                        bool    `json:"watchReplication,omitempty"`
 	TrackSchemaVersions                     bool    `json:"trackSchemaVersions,omitempty"`
 	TerseErrors                             bool    `json:"terseErrors,omitempty"`
 	AnnotateQueries                         bool    `json:"annotateQueries,omitempty"`
+	DeriveMaxExecutionTime                  bool    `json:"deriveMaxExecutionTime,omitempty"`
 	MessagePostponeParallelism              int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields                       bool    `json:"cacheResultFields,omitempty"`
 	SignalWhenSchemaChange                  bool    `json:"signalWhenSchemaChange,omitempty"`
@@ -280,7 +338,8 @@ type TabletConfig struct {
 	TxThrottlerConfig           string   `json:"-"`
 	TxThrottlerHealthCheckCells []string `json:"-"`
 
-	EnableLagThrottler bool `json:"-"`
+	EnableLagThrottler     bool `json:"-"`
+	ThrottlerConfigViaTopo bool `json:"-"`
 
 	TransactionLimitConfig `json:"-"`
 
@@ -301,6 +360,7 @@ type ConnPoolConfig struct {
 type OltpConfig struct {
 	QueryTimeoutSeconds Seconds `json:"queryTimeoutSeconds,omitempty"`
 	TxTimeoutSeconds    Seconds `json:"txTimeoutSeconds,omitempty"`
+	MaxTxTimeoutSeconds Seconds `json:"maxTxTimeoutSeconds,omitempty"`
 	MaxRows             int     `json:"maxRows,omitempty"`
 	WarnRows            int     `json:"warnRows,omitempty"`
 }
@@ -312,6 +372,56 @@ type HotRowProtectionConfig struct {
 	MaxQueueSize       int    `json:"maxQueueSize,omitempty"`
 	MaxGlobalQueueSize int    `json:"maxGlobalQueueSize,omitempty"`
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
+	// Overrides are per table or per row range limits which take precedence
+	// over the ones above. The first matching override applies.
+	Overrides []HotRowProtectionOverride `json:"overrides,omitempty"`
+}
+
+// HotRowProtectionOverride contains the hot row protection limits of the
+// row ranges of a table, or of the row ranges matching a pattern.
+// Zero values inherit the limits of HotRowProtectionConfig.
+type HotRowProtectionOverride struct {
+	Table string `json:"table,omitempty"`
+	// Pattern is a regular expression matched against the row range, i.e.
+	// the table name followed by the WHERE clause, e.g. "counters where id = 1".
+	Pattern string `json:"pattern,omitempty"`
+	// MaxQueueSize is the queue limit per row range.
+	MaxQueueSize int `json:"maxQueueSize,omitempty"`
+	// MaxGlobalQueueSize is the queue limit across all the row ranges of
+	// the override. The process wide limit still applies.
+	MaxGlobalQueueSize int `json:"maxGlobalQueueSize,omitempty"`
+	MaxConcurrency     int `json:"maxConcurrency,omitempty"`
+	// WaitTimeoutSeconds limits how long a transaction is queued. By default,
+	// it is only limited by the query timeout.
+	WaitTimeoutSeconds Seconds `json:"waitTimeoutSeconds,omitempty"`
+}
+
+// Verify checks the override for sanity.
+func (o *HotRowProtectionOverride) Verify() error {
+	if o.Table == "" && o.Pattern == "" {
+		return errors.New("hot row protection override must have a table or a pattern")
+	}
+	if _, err := regexp.Compile(o.Pattern); err != nil {
+		return fmt.Errorf("invalid hot row protection override pattern %q: %v", o.Pattern, err)
+	}
+	if o.MaxQueueSize < 0 || o.MaxGlobalQueueSize < 0 || o.MaxConcurrency < 0 || o.WaitTimeoutSeconds < 0 {
+		return fmt.Errorf("hot row protection override limits must be >= 0: %+v", *o)
+	}
+	return nil
+}
+
+// LargeTransactionConfig contains the config for the detection of the
+// transactions which modify too many rows, write too much binlog or last too
+// long, and make the replicas lag when they apply them.
+// A threshold of 0 is disabled.
+type LargeTransactionConfig struct {
+	// Mode can be disable, dryRun or enable. Default is disable.
+	// In dryRun mode, the large transactions are logged and counted. In
+	// enable mode, their commit is also refused.
+	Mode               string  `json:"mode,omitempty"`
+	MaxRows            int64   `json:"maxRows,omitempty"`
+	MaxStatementBytes  int64   `json:"maxStatementBytes,omitempty"`
+	MaxDurationSeconds Seconds `json:"maxDurationSeconds,omitempty"`
 }
 
 // HealthcheckConfig contains the config for healthcheck.
@@ -333,6 +443,9 @@ type ReplicationTrackerConfig struct {
 	// Mode can be disable, polling or heartbeat. Default is disable.
 	Mode                     string  `json:"mode,omitempty"`
 	HeartbeatIntervalSeconds Seconds `json:"heartbeatIntervalSeconds,omitempty"`
+	// PtHeartbeatTable is the pt-heartbeat table also written by the
+	// heartbeat writer, as database.table. It is not written if empty.
+	PtHeartbeatTable string `json:"ptHeartbeatTable,omitempty"`
 }
 
 // TransactionLimitConfig captures configuration of transaction pool slots
@@ -383,6 +496,17 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	for i := range c.HotRowProtection.Overrides {
+		if err := c.HotRowProtection.Overrides[i].Verify(); err != nil {
+			return err
+		}
+	}
+	if c.LargeTransaction.MaxRows < 0 || c.LargeTransaction.MaxStatementBytes < 0 || c.LargeTransaction.MaxDurationSeconds < 0 {
+		return fmt.Errorf("-large_transaction_max_* thresholds must be >= 0: %+v", c.LargeTransaction)
+	}
+	if v := c.ReplicationTracker.PtHeartbeatTable; v != "" && !strings.Contains(v, ".") {
+		return fmt.Errorf("-heartbeat_pt_table must be qualified by its database (specified value: %v)", v)
+	}
 	return nil
 }
 
@@ -457,6 +581,11 @@ var defaultConfig = TabletConfig{
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
 	},
+	LargeTransaction: LargeTransactionConfig{
+		Mode:              Disable,
+		MaxRows:           100000,
+		MaxStatementBytes: 100 * 1024 * 1024,
+	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
 	ConsolidatorStreamQuerySize: 2 * 1024 * 1024,
@@ -470,6 +599,7 @@ var defaultConfig = TabletConfig{
 	QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
 	QueryCacheMemory:                        cache.DefaultConfig.MaxMemoryUsage,
 	QueryCacheLFU:                           cache.DefaultConfig.LFU,
+	ResultCacheTTLSeconds:                   1,
 	SchemaReloadIntervalSeconds:             30 * 60,
 	SignalSchemaChangeReloadIntervalSeconds: 5,
 	MessagePostponeParallelism:              4,
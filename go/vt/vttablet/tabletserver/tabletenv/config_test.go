@@ -67,9 +67,12 @@ func TestConfigParse(t *testing.T) {
   repl:
     password: '****'
   socket: a
+dbaPool: {}
 gracePeriods: {}
 healthcheck: {}
 hotRowProtection: {}
+isolationLevelPool: {}
+largeTransaction: {}
 olapReadPool: {}
 oltp: {}
 oltpReadPool:
@@ -113,6 +116,7 @@ func TestDefaultConfig(t *testing.T) {
 consolidator: enable
 consolidatorStreamQuerySize: 2097152
 consolidatorStreamTotalSize: 134217728
+dbaPool: {}
 gracePeriods: {}
 healthcheck:
   degradedThresholdSeconds: 30
@@ -123,6 +127,11 @@ hotRowProtection:
   maxGlobalQueueSize: 1000
   maxQueueSize: 20
   mode: disable
+isolationLevelPool: {}
+largeTransaction:
+  maxRows: 100000
+  maxStatementBytes: 104857600
+  mode: disable
 messagePostponeParallelism: 4
 olapReadPool:
   idleTimeoutSeconds: 1800
@@ -141,6 +150,7 @@ queryCacheSize: 5000
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
+resultCacheTTLSeconds: 1
 schemaReloadIntervalSeconds: 1800
 signalSchemaChangeReloadIntervalSeconds: 5
 streamBufferSize: 32768
@@ -156,6 +166,7 @@ txPool:
 func TestClone(t *testing.T) {
 	*queryLogHandler = ""
 	*txLogHandler = ""
+	*requestLogHandler = ""
 
 	cfg1 := &TabletConfig{
 		OltpReadPool: ConnPoolConfig{
@@ -197,10 +208,15 @@ func TestFlags(t *testing.T) {
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		LargeTransaction: LargeTransactionConfig{
+			MaxRows:           100000,
+			MaxStatementBytes: 100 * 1024 * 1024,
+		},
 		StreamBufferSize:                        32768,
 		QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:                        cache.DefaultConfig.MaxMemoryUsage,
 		QueryCacheLFU:                           cache.DefaultConfig.LFU,
+		ResultCacheTTLSeconds:                   1,
 		SchemaReloadIntervalSeconds:             1800,
 		SignalSchemaChangeReloadIntervalSeconds: 5,
 		TrackSchemaVersions:                     false,
@@ -224,7 +240,11 @@ func TestFlags(t *testing.T) {
 	Init()
 	want.OlapReadPool.IdleTimeoutSeconds = 1800
 	want.TxPool.IdleTimeoutSeconds = 1800
+	want.IsolationLevelPool.IdleTimeoutSeconds = 1800
+	want.IsolationLevelPool.MaxWaiters = 5000
+	want.DbaPool.IdleTimeoutSeconds = 1800
 	want.HotRowProtection.Mode = Disable
+	want.LargeTransaction.Mode = Disable
 	want.Consolidator = Enable
 	want.Healthcheck.IntervalSeconds = 20
 	want.Healthcheck.DegradedThresholdSeconds = 30
@@ -258,6 +278,22 @@ func TestFlags(t *testing.T) {
 	want.HotRowProtection.Mode = Disable
 	assert.Equal(t, want, currentConfig)
 
+	enableLargeTransactionProtection = true
+	Init()
+	want.LargeTransaction.Mode = Enable
+	assert.Equal(t, want, currentConfig)
+
+	enableLargeTransactionProtectionDryRun = true
+	Init()
+	want.LargeTransaction.Mode = Dryrun
+	assert.Equal(t, want, currentConfig)
+
+	enableLargeTransactionProtection = false
+	enableLargeTransactionProtectionDryRun = false
+	Init()
+	want.LargeTransaction.Mode = Disable
+	assert.Equal(t, want, currentConfig)
+
 	enableConsolidator = true
 	enableConsolidatorReplicas = true
 	Init()
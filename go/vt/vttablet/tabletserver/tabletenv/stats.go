@@ -29,8 +29,12 @@ type Stats struct {
 	MySQLTimings           *servenv.TimingsWrapper        // Time spent executing MySQL commands
 	QueryTimings           *servenv.TimingsWrapper        // Query timings
 	QPSRates               *stats.Rates                   // Human readable QPS rates
+	ErrorRates             *stats.Rates                   // Human readable rates of ErrorCounters
 	WaitTimings            *servenv.TimingsWrapper        // waits like Consolidations etc
+	DeadlineRemaining      *servenv.TimingsWrapper        // Deadline budget left to the requests when they reach a hop
+	DeadlineConsumed       *servenv.TimingsWrapper        // Deadline budget consumed by the requests from the time they reach a hop
 	KillCounters           *stats.CountersWithSingleLabel // Connection and transaction kills
+	LargeTransactions      *stats.CountersWithSingleLabel // Transactions exceeding a large transaction threshold
 	ErrorCounters          *stats.CountersWithSingleLabel
 	InternalErrors         *stats.CountersWithSingleLabel
 	Warnings               *stats.CountersWithSingleLabel
@@ -47,15 +51,22 @@ type Stats struct {
 	UserActiveReservedCount *stats.CountersWithSingleLabel // Per CallerID active reserved connection counts
 	UserReservedCount       *stats.CountersWithSingleLabel // Per CallerID reserved connection counts
 	UserReservedTimesNs     *stats.CountersWithSingleLabel // Per CallerID reserved connection duration
+
+	SequenceRefills          *stats.CountersWithSingleLabel // Per sequence reservations of values in the sequence table
+	SequenceRemaining        *stats.GaugesWithSingleLabel   // Per sequence values left before the max value
+	SequenceExhaustionAlerts *stats.CountersWithSingleLabel // Per sequence reservations leaving fewer values than the exhaustion alert
 }
 
 // NewStats instantiates a new set of stats scoped by exporter.
 func NewStats(exporter *servenv.Exporter) *Stats {
 	stats := &Stats{
-		MySQLTimings: exporter.NewTimings("Mysql", "MySQl query time", "operation"),
-		QueryTimings: exporter.NewTimings("Queries", "MySQL query timings", "plan_type"),
-		WaitTimings:  exporter.NewTimings("Waits", "Wait operations", "type"),
-		KillCounters: exporter.NewCountersWithSingleLabel("Kills", "Number of connections being killed", "query_type", "Transactions", "Queries", "ReservedConnection"),
+		MySQLTimings:      exporter.NewTimings("Mysql", "MySQl query time", "operation"),
+		QueryTimings:      exporter.NewTimings("Queries", "MySQL query timings", "plan_type"),
+		WaitTimings:       exporter.NewTimings("Waits", "Wait operations", "type"),
+		DeadlineRemaining: exporter.NewTimings("DeadlineBudgetRemaining", "Deadline budget left to the requests when they reach a hop", "hop"),
+		DeadlineConsumed:  exporter.NewTimings("DeadlineBudgetConsumed", "Deadline budget consumed by the requests from the time they reach a hop until they complete", "hop"),
+		KillCounters:      exporter.NewCountersWithSingleLabel("Kills", "Number of connections being killed", "query_type", "Transactions", "Queries", "ReservedConnection"),
+		LargeTransactions: exporter.NewCountersWithSingleLabel("LargeTransactions", "Number of transactions exceeding a large transaction threshold", "threshold", "Rows", "StatementBytes", "Duration"),
 		ErrorCounters: exporter.NewCountersWithSingleLabel(
 			"Errors",
 			"Critical errors",
@@ -94,7 +105,12 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		UserActiveReservedCount: exporter.NewCountersWithSingleLabel("UserActiveReservedCount", "active reserved connection for each CallerID", "CallerID"),
 		UserReservedCount:       exporter.NewCountersWithSingleLabel("UserReservedCount", "reserved connection received for each CallerID", "CallerID"),
 		UserReservedTimesNs:     exporter.NewCountersWithSingleLabel("UserReservedTimesNs", "Total reserved connection latency for each CallerID", "CallerID"),
+
+		SequenceRefills:          exporter.NewCountersWithSingleLabel("SequenceRefills", "Reservations of values in the table of each sequence", "TableName"),
+		SequenceRemaining:        exporter.NewGaugesWithSingleLabel("SequenceRemaining", "Values left before the max value of each sequence", "TableName"),
+		SequenceExhaustionAlerts: exporter.NewCountersWithSingleLabel("SequenceExhaustionAlerts", "Reservations leaving fewer values than the exhaustion alert of each sequence", "TableName"),
 	}
 	stats.QPSRates = exporter.NewRates("QPS", stats.QueryTimings, 15*60/5, 5*time.Second)
+	stats.ErrorRates = exporter.NewRates("ErrorRates", stats.ErrorCounters, 15*60/5, 5*time.Second)
 	return stats
 }
@@ -46,6 +46,7 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/servenv"
@@ -65,6 +66,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
@@ -100,6 +102,10 @@ type TabletServer struct {
 	enableHotRowProtection bool
 	topoServer             *topo.Server
 
+	// batchLimits counts the batches split or rejected because they
+	// exceeded the batch limits.
+	batchLimits *stats.CountersWithSingleLabel
+
 	// These are sub-components of TabletServer.
 	statelessql  *QueryList
 	statefulql   *QueryList
@@ -123,6 +129,12 @@ type TabletServer struct {
 
 	// alias is used for identifying this tabletserver in healthcheck responses.
 	alias *topodatapb.TabletAlias
+
+	// aclMu protects the sources of the table ACL: the config file, and
+	// the table ACL of the keyspace in the topo, which overrides it.
+	aclMu         sync.Mutex
+	aclConfigFile string
+	topoTableACL  *tableaclpb.Config
 }
 
 var _ queryservice.QueryService = (*TabletServer)(nil)
@@ -167,10 +179,12 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 		return tsv.sm.Target().TabletType
 	}
 
+	tsv.batchLimits = exporter.NewCountersWithSingleLabel("ExecuteBatchLimits", "Number of ExecuteBatch calls split or rejected because they exceeded the batch limits", "Action", "Split", "Rejected")
+
 	tsv.statelessql = NewQueryList("oltp-stateless")
 	tsv.statefulql = NewQueryList("oltp-stateful")
 	tsv.olapql = NewQueryList("olap")
-	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
+	tsv.lagThrottler = throttle.NewThrottler(tsv, srvTopoServer, topoServer, alias.Cell, tabletTypeFunc)
 	tsv.hs = newHealthStreamer(tsv, alias)
 	tsv.se = schema.NewEngine(tsv)
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
@@ -180,6 +194,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
 	tsv.te = NewTxEngine(tsv)
+	tsv.te.txPool.resultCache = tsv.qe.resultCache
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
 	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
@@ -219,6 +234,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.registerQueryzHandler()
 	tsv.registerQueryListHandlers([]*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql})
 	tsv.registerTwopczHandler()
+	tsv.registerLargeTransactionsHandler()
 	tsv.registerMigrationStatusHandler()
 	tsv.registerThrottlerHandlers()
 	tsv.registerDebugEnvHandler()
@@ -299,6 +315,14 @@ func (tsv *TabletServer) SetQueryRules(ruleSource string, qrs *rules.Rules) erro
 }
 
 func (tsv *TabletServer) initACL(tableACLConfigFile string, enforceTableACLConfig bool) {
+	tsv.aclMu.Lock()
+	defer tsv.aclMu.Unlock()
+	tsv.aclConfigFile = tableACLConfigFile
+	if tsv.topoTableACL != nil {
+		log.Infof("Not loading the Table ACL from %v, the Table ACL of the keyspace is applied", tableACLConfigFile)
+		return
+	}
+
 	// tabletacl.Init loads ACL from file if *tableACLConfig is not empty
 	err := tableacl.Init(
 		tableACLConfigFile,
@@ -336,6 +360,35 @@ func (tsv *TabletServer) InitACL(tableACLConfigFile string, enforceTableACLConfi
 	}
 }
 
+// SetTableACL applies the table ACL of the keyspace, which overrides the
+// table ACL of the config file. A nil config restores the table ACL of the
+// config file.
+func (tsv *TabletServer) SetTableACL(config *tableaclpb.Config) error {
+	tsv.aclMu.Lock()
+	defer tsv.aclMu.Unlock()
+	var err error
+	switch {
+	case config != nil:
+		if err = tableacl.InitFromProto(config); err == nil {
+			tsv.topoTableACL = config
+		}
+	case tsv.topoTableACL == nil:
+		return nil
+	case tsv.aclConfigFile != "":
+		tsv.topoTableACL = nil
+		err = tableacl.Init(tsv.aclConfigFile, tsv.ClearQueryPlanCache)
+	default:
+		tsv.topoTableACL = nil
+		err = tableacl.InitFromProto(&tableaclpb.Config{})
+	}
+	if err != nil {
+		return err
+	}
+	// The plans hold the ACLs of their tables.
+	tsv.ClearQueryPlanCache()
+	return nil
+}
+
 // SetServingType changes the serving type of the tabletserver. It starts or
 // stops internal services as deemed necessary.
 // Returns true if the state of QueryService or the tablet type changed.
@@ -682,6 +735,18 @@ func (tsv *TabletServer) ReadTransaction(ctx context.Context, target *querypb.Ta
 	return metadata, err
 }
 
+// ReadTwopcInflight returns the distributed transactions the tablet is the
+// metadata manager of, and the transactions prepared on the tablet, which are
+// not resolved yet.
+func (tsv *TabletServer) ReadTwopcInflight(ctx context.Context) (distributed []*tx.DistributedTx, prepared, failed []*tx.PreparedTx, err error) {
+	txe := &TxExecutor{
+		ctx:      ctx,
+		logStats: tabletenv.NewLogStats(ctx, "ReadTwopcInflight"),
+		te:       tsv.te,
+	}
+	return txe.ReadTwopcInflight()
+}
+
 // Execute executes the query and returns the result as response.
 func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, err error) {
 	span, ctx := trace.NewSpan(ctx, "TabletServer.Execute")
@@ -698,7 +763,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 		allowOnShutdown = true
 		// Use the smaller of the two values (0 means infinity).
 		// TODO(sougou): Assign deadlines to each transaction and set query timeout accordingly.
-		timeout = smallerTimeout(timeout, tsv.txTimeout.Get())
+		timeout = smallerTimeout(timeout, tsv.transactionTimeout(options))
 	}
 	err = tsv.execRequest(
 		ctx, timeout,
@@ -741,7 +806,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			// Change database name in mysql output to the keyspace name
 			if tsv.sm.target.Keyspace != tsv.config.DB.DBName && sqltypes.IncludeFieldsOrDefault(options) == querypb.ExecuteOptions_ALL {
 				switch qre.plan.PlanID {
-				case planbuilder.PlanSelect, planbuilder.PlanSelectImpossible:
+				case planbuilder.PlanSelect, planbuilder.PlanSelectNoWait, planbuilder.PlanSelectImpossible:
 					dbName := tsv.config.DB.DBName
 					ksName := tsv.sm.target.Keyspace
 					for _, f := range result.Fields {
@@ -785,7 +850,7 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 	if transactionID != 0 {
 		allowOnShutdown = true
 		// Use the transaction timeout.
-		timeout = tsv.txTimeout.Get()
+		timeout = tsv.transactionTimeout(options)
 	}
 
 	return tsv.execRequest(
@@ -827,6 +892,8 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 // ExecuteBatch can be called for an existing transaction, or it can be called with
 // the AsTransaction flag which will execute all statements inside an independent
 // transaction. If AsTransaction is true, TransactionId must be 0.
+// Batches exceeding the batch limits are executed in several rounds, or
+// rejected if AsTransaction is true.
 // TODO(reserve-conn): Validate the use-case and Add support for reserve connection in ExecuteBatch
 func (tsv *TabletServer) ExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) (results []sqltypes.Result, err error) {
 	span, ctx := trace.NewSpan(ctx, "TabletServer.ExecuteBatch")
@@ -839,6 +906,31 @@ func (tsv *TabletServer) ExecuteBatch(ctx context.Context, target *querypb.Targe
 		return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.CantDoThisInTransaction, "You are not allowed to execute this command in a transaction")
 	}
 
+	rounds := splitBatch(queries, tsv.config.BatchMaxQueries, tsv.config.BatchMaxBindVarsSize)
+	if len(rounds) == 1 {
+		return tsv.executeBatch(ctx, target, queries, asTransaction, transactionID, options)
+	}
+	if asTransaction {
+		tsv.batchLimits.Add("Rejected", 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "batch of %d queries exceeds the batch limits of %d queries and %d bytes of bind variables, and cannot be split since it runs as a transaction", len(queries), tsv.config.BatchMaxQueries, tsv.config.BatchMaxBindVarsSize)
+	}
+	// The queries which are not executed as a transaction are independent,
+	// or part of the transaction of the caller, so they can be executed as
+	// several requests.
+	tsv.batchLimits.Add("Split", 1)
+	results = make([]sqltypes.Result, 0, len(queries))
+	for _, round := range rounds {
+		roundResults, err := tsv.executeBatch(ctx, target, round, false, transactionID, options)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, roundResults...)
+	}
+	return results, nil
+}
+
+func (tsv *TabletServer) executeBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) (results []sqltypes.Result, err error) {
+
 	if tsv.enableHotRowProtection && asTransaction {
 		// Serialize transactions which target the same hot row range.
 		// NOTE: We put this intentionally at this place *before* StartRequest()
@@ -914,6 +1006,56 @@ func (tsv *TabletServer) ExecuteBatch(ctx context.Context, target *querypb.Targe
 	return results, nil
 }
 
+// splitBatch splits the queries in rounds holding at most maxQueries queries,
+// and at most maxBindVarsSize bytes of bind variables unless a single query
+// holds more. A limit of 0 is unlimited.
+func splitBatch(queries []*querypb.BoundQuery, maxQueries int, maxBindVarsSize int64) [][]*querypb.BoundQuery {
+	if maxQueries <= 0 && maxBindVarsSize <= 0 {
+		return [][]*querypb.BoundQuery{queries}
+	}
+	var rounds [][]*querypb.BoundQuery
+	start := 0
+	var size int64
+	for i, bound := range queries {
+		var querySize int64
+		if maxBindVarsSize > 0 {
+			for _, bv := range bound.BindVariables {
+				querySize += int64(bv.SizeVT())
+			}
+		}
+		if i > start && ((maxQueries > 0 && i-start >= maxQueries) || (maxBindVarsSize > 0 && size+querySize > maxBindVarsSize)) {
+			rounds = append(rounds, queries[start:i])
+			start = i
+			size = 0
+		}
+		size += querySize
+	}
+	return append(rounds, queries[start:])
+}
+
+// StreamExecuteBatch executes the queries one after the other, and streams the
+// results of each of them like StreamExecute does, so that large results do
+// not have to be buffered. It stops at the first query which fails.
+func (tsv *TabletServer) StreamExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, transactionID int64, reservedID int64, options *querypb.ExecuteOptions, callback func(queryIndex int, result *sqltypes.Result) error) error {
+	span, ctx := trace.NewSpan(ctx, "TabletServer.StreamExecuteBatch")
+	defer span.Finish()
+
+	if len(queries) == 0 {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.EmptyQuery, "Query was empty")
+	}
+	for i, bound := range queries {
+		// Every query is its own request, so it is logged and accounted
+		// for like a StreamExecute.
+		err := tsv.StreamExecute(ctx, target, bound.Sql, bound.BindVariables, transactionID, reservedID, options, func(qr *sqltypes.Result) error {
+			return callback(i, qr)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BeginExecute combines Begin and Execute.
 func (tsv *TabletServer) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 
@@ -1111,6 +1253,186 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// GetDeadLetters returns at most limit messages dead-lettered in a message
+// table, with their epoch as first column.
+func (tsv *TabletServer) GetDeadLetters(ctx context.Context, target *querypb.Target, name string, limit int) (*sqltypes.Result, error) {
+	querygen, err := tsv.messager.GetDeadLetterGenerator(name)
+	if err != nil {
+		return nil, err
+	}
+	query, bv := querygen.GenerateDeadLetterListQuery(limit)
+	return tsv.Execute(ctx, target, query, bv, 0, 0, nil)
+}
+
+// RequeueDeadLetters schedules the dead-lettered messages of a message table
+// to be sent again, or all of them if ids is empty. It returns the number of
+// messages requeued.
+func (tsv *TabletServer) RequeueDeadLetters(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	querygen, err := tsv.messager.GetDeadLetterGenerator(name)
+	if err != nil {
+		return 0, err
+	}
+	count, err = tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
+		query, bv := querygen.GenerateRequeueQuery(ids)
+		return query, bv, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	messager.MessageStats.Add([]string{name, "Requeued"}, count)
+	return count, nil
+}
+
+// PurgeDeadLetters deletes the dead-lettered messages of a message table, or
+// all of them if ids is empty. It returns the number of messages purged.
+func (tsv *TabletServer) PurgeDeadLetters(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	querygen, err := tsv.messager.GetDeadLetterGenerator(name)
+	if err != nil {
+		return 0, err
+	}
+	count, err = tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
+		query, bv := querygen.GenerateDeadLetterPurgeQuery(ids)
+		return query, bv, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	messager.MessageStats.Add([]string{name, "DeadLettersPurged"}, count)
+	return count, nil
+}
+
+// GetSequences returns the state of the named sequences, or of all the
+// sequences of the tablet if names is empty.
+func (tsv *TabletServer) GetSequences(ctx context.Context, target *querypb.Target, names []string) ([]*schema.SequenceState, error) {
+	if len(names) == 0 {
+		for name, table := range tsv.se.GetSchema() {
+			if table.Type == schema.Sequence {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+	states := make([]*schema.SequenceState, 0, len(names))
+	for _, name := range names {
+		table, err := tsv.getSequenceTable(name)
+		if err != nil {
+			return nil, err
+		}
+		query := fmt.Sprintf("select next_id, cache from %s where id = 0", sqlparser.String(table.Name))
+		qr, err := tsv.Execute(ctx, target, query, nil, 0, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+		state, err := newSequenceState(table, qr)
+		if err != nil {
+			return nil, err
+		}
+		table.SequenceInfo.Lock()
+		state.NextVal, state.LastVal = table.SequenceInfo.NextVal, table.SequenceInfo.LastVal
+		table.SequenceInfo.Unlock()
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// ResetSequence sets the next ID and the cache size of a sequence, and drops
+// its cached values, so that the next value is reserved from the new state. A
+// next ID or cache of 0 keeps the current value. The next ID cannot go below
+// its current value, as the sequence could then hand out duplicate values.
+func (tsv *TabletServer) ResetSequence(ctx context.Context, target *querypb.Target, name string, nextID, cache int64) (state *schema.SequenceState, err error) {
+	table, err := tsv.getSequenceTable(name)
+	if err != nil {
+		return nil, err
+	}
+	if nextID < 0 || cache < 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid next ID %d or cache %d for sequence %s", nextID, cache, name)
+	}
+
+	// Holding the lock of the sequence keeps its values from being
+	// reserved until the reset is committed.
+	table.SequenceInfo.Lock()
+	defer table.SequenceInfo.Unlock()
+
+	transactionID, _, err := tsv.Begin(ctx, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	// If transaction was not committed by the end, it means
+	// that there was an error, roll it back.
+	defer func() {
+		if transactionID != 0 {
+			tsv.Rollback(ctx, target, transactionID)
+		}
+	}()
+	tableName := sqlparser.String(table.Name)
+	qr, err := tsv.Execute(ctx, target, fmt.Sprintf("select next_id, cache from %s where id = 0 for update", tableName), nil, transactionID, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	state, err = newSequenceState(table, qr)
+	if err != nil {
+		return nil, err
+	}
+	if nextID == 0 {
+		nextID = state.NextID
+	}
+	if cache == 0 {
+		cache = state.Cache
+	}
+	switch {
+	case nextID < state.NextID:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "next ID %d of sequence %s is below its current value %d", nextID, name, state.NextID)
+	case nextID-1 > table.SequenceInfo.MaxSequenceValue():
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "next ID %d of sequence %s is past its max value %d", nextID, name, table.SequenceInfo.MaxSequenceValue())
+	}
+	query := fmt.Sprintf("update %s set next_id = %d, cache = %d where id = 0", tableName, nextID, cache)
+	if _, err = tsv.Execute(ctx, target, query, nil, transactionID, 0, nil); err != nil {
+		return nil, err
+	}
+	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
+		transactionID = 0
+		return nil, err
+	}
+	transactionID = 0
+	log.Infof("Sequence %s reset from next ID %d and cache %d to next ID %d and cache %d", name, state.NextID, state.Cache, nextID, cache)
+
+	table.SequenceInfo.NextVal = 0
+	table.SequenceInfo.LastVal = 0
+	state.NextID, state.Cache = nextID, cache
+	return state, nil
+}
+
+func (tsv *TabletServer) getSequenceTable(name string) (*schema.Table, error) {
+	table := tsv.se.GetTable(sqlparser.NewTableIdent(name))
+	if table == nil || table.Type != schema.Sequence {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "sequence %s not found in schema", name)
+	}
+	return table, nil
+}
+
+// newSequenceState returns the state of a sequence from the row of its table.
+func newSequenceState(table *schema.Table, qr *sqltypes.Result) (*schema.SequenceState, error) {
+	if len(qr.Rows) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "unexpected rows from reading sequence %s: %d", table.Name.String(), len(qr.Rows))
+	}
+	nextID, err := qr.Rows[0][0].ToInt64()
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "error loading sequence %s", table.Name.String())
+	}
+	cache, err := qr.Rows[0][1].ToInt64()
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "error loading sequence %s", table.Name.String())
+	}
+	return &schema.SequenceState{
+		Name:            table.Name.String(),
+		NextID:          nextID,
+		Cache:           cache,
+		MaxValue:        table.SequenceInfo.MaxSequenceValue(),
+		ExhaustionAlert: table.SequenceInfo.ExhaustionAlert,
+		StrictMonotonic: table.SequenceInfo.StrictMonotonic,
+	}, nil
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
@@ -1256,7 +1578,7 @@ func (tsv *TabletServer) ReserveExecute(ctx context.Context, target *querypb.Tar
 	if transactionID != 0 {
 		allowOnShutdown = true
 		// Use the smaller of the two values (0 means infinity).
-		timeout = smallerTimeout(timeout, tsv.txTimeout.Get())
+		timeout = smallerTimeout(timeout, tsv.transactionTimeout(options))
 	}
 
 	err = tsv.execRequest(
@@ -1302,7 +1624,7 @@ func (tsv *TabletServer) ReserveStreamExecute(
 	if transactionID != 0 {
 		allowOnShutdown = true
 		// Use the transaction timeout.
-		timeout = tsv.txTimeout.Get()
+		timeout = tsv.transactionTimeout(options)
 	}
 
 	err = tsv.execRequest(
@@ -1377,6 +1699,8 @@ func (tsv *TabletServer) execRequest(
 	logStats.Target = target
 	logStats.OriginalSQL = sql
 	logStats.BindVariables = bindVariables
+	_, comments := sqlparser.SplitMarginComments(sql)
+	logStats.RequestID = comments.RequestID()
 	defer tsv.handlePanicAndSendLogStats(sql, bindVariables, logStats)
 
 	if err = tsv.sm.StartRequest(ctx, target, allowOnShutdown); err != nil {
@@ -1388,6 +1712,7 @@ func (tsv *TabletServer) execRequest(
 		cancel()
 		tsv.sm.EndRequest()
 	}()
+	defer recordDeadlineBudget(ctx, tsv.stats, deadlineHopVTTablet)()
 
 	err = exec(ctx, logStats)
 	if err != nil {
@@ -1445,6 +1770,9 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 	if cid != nil {
 		callerID = fmt.Sprintf(" (CallerID: %s)", cid.Username)
 	}
+	if _, comments := sqlparser.SplitMarginComments(sql); comments.RequestID() != "" {
+		callerID += fmt.Sprintf(" (RequestID: %s)", comments.RequestID())
+	}
 
 	logMethod := log.Errorf
 	// Suppress or demote some errors in logs.
@@ -1723,6 +2051,10 @@ func (tsv *TabletServer) registerTwopczHandler() {
 	})
 }
 
+func (tsv *TabletServer) registerLargeTransactionsHandler() {
+	tsv.exporter.HandleFunc("/debug/large_transactions", tsv.te.txPool.largeTxs.ServeHTTP)
+}
+
 func (tsv *TabletServer) registerMigrationStatusHandler() {
 	tsv.exporter.HandleFunc("/schema-migration/report-status", func(w http.ResponseWriter, r *http.Request) {
 		ctx := tabletenv.LocalContext()
@@ -1890,6 +2222,17 @@ func (tsv *TabletServer) TxTimeout() time.Duration {
 	return tsv.txTimeout.Get()
 }
 
+// transactionTimeout returns the timeout of the transactions of the queries
+// executed with the options. The transactions which requested a timeout the
+// tablet does not allow failed to begin, so it falls back to the transaction
+// timeout.
+func (tsv *TabletServer) transactionTimeout(options *querypb.ExecuteOptions) time.Duration {
+	if timeout, err := tsv.te.txPool.txTimeout(options); err == nil {
+		return timeout
+	}
+	return tsv.txTimeout.Get()
+}
+
 // SetQueryPlanCacheCap changes the pool size to the specified value.
 func (tsv *TabletServer) SetQueryPlanCacheCap(val int) {
 	tsv.qe.SetQueryPlanCacheCap(val)
@@ -1930,6 +2273,26 @@ func (tsv *TabletServer) WarnResultSize() int {
 	return int(tsv.qe.warnResultSize.Get())
 }
 
+// SetQueryMemoryLimit changes the query memory limit per query.
+func (tsv *TabletServer) SetQueryMemoryLimit(val int) {
+	tsv.qe.memory.queryLimit.Set(int64(val))
+}
+
+// QueryMemoryLimit returns the query memory limit per query.
+func (tsv *TabletServer) QueryMemoryLimit() int {
+	return int(tsv.qe.memory.queryLimit.Get())
+}
+
+// SetQueryMemoryBudget changes the query memory budget of all the queries.
+func (tsv *TabletServer) SetQueryMemoryBudget(val int) {
+	tsv.qe.memory.budget.Set(int64(val))
+}
+
+// QueryMemoryBudget returns the query memory budget of all the queries.
+func (tsv *TabletServer) QueryMemoryBudget() int {
+	return int(tsv.qe.memory.budget.Get())
+}
+
 // SetThrottleMetricThreshold changes the throttler metric threshold
 func (tsv *TabletServer) SetThrottleMetricThreshold(val float64) {
 	tsv.lagThrottler.MetricsThreshold.Set(val)
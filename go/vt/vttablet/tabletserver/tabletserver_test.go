@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
@@ -50,9 +51,11 @@ import (
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -761,6 +764,41 @@ func TestTabletServerStreamExecuteComments(t *testing.T) {
 		t.Fatal("stats are empty")
 	}
 }
+func TestTabletServerStreamExecuteBatch(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	sql1 := "select * from test_table where pk = 1 limit 1000"
+	sql2 := "select * from test_table where pk = 2 limit 1000"
+	db.AddQuery(sql1, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("row01")}},
+	})
+	db.AddQuery(sql2, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("row02")}},
+	})
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	var rows []string
+	err := tsv.StreamExecuteBatch(ctx, &target, []*querypb.BoundQuery{
+		{Sql: sql1},
+		{Sql: sql2},
+	}, 0, 0, nil, func(queryIndex int, qr *sqltypes.Result) error {
+		for _, row := range qr.Rows {
+			rows = append(rows, fmt.Sprintf("%d:%s", queryIndex, row[0].ToString()))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0:row01", "1:row02"}, rows)
+
+	err = tsv.StreamExecuteBatch(ctx, &target, nil, 0, 0, nil, func(int, *sqltypes.Result) error { return nil })
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
 func TestTabletServerExecuteBatch(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -783,6 +821,56 @@ func TestTabletServerExecuteBatch(t *testing.T) {
 	}
 }
 
+func TestTabletServerExecuteBatchLimits(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+	tsv.config.BatchMaxQueries = 2
+
+	sql := "select * from test_table where pk = 1"
+	db.AddQuery(sql+" limit 10001", &sqltypes.Result{})
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	queries := []*querypb.BoundQuery{{Sql: sql}, {Sql: sql}, {Sql: sql}}
+
+	results, err := tsv.ExecuteBatch(ctx, &target, queries, false, 0, nil)
+	require.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.EqualValues(t, 1, tsv.batchLimits.Counts()["Split"])
+
+	_, err = tsv.ExecuteBatch(ctx, &target, queries, true, 0, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "batch of 3 queries exceeds the batch limits")
+	assert.EqualValues(t, 1, tsv.batchLimits.Counts()["Rejected"])
+}
+
+func TestSplitBatch(t *testing.T) {
+	bv := sqltypes.StringBindVariable("0123456789")
+	size := int64(bv.SizeVT())
+	queries := []*querypb.BoundQuery{
+		{Sql: "q1", BindVariables: map[string]*querypb.BindVariable{"a": bv}},
+		{Sql: "q2", BindVariables: map[string]*querypb.BindVariable{"a": bv, "b": bv}},
+		{Sql: "q3"},
+		{Sql: "q4", BindVariables: map[string]*querypb.BindVariable{"a": bv}},
+	}
+	sqls := func(rounds [][]*querypb.BoundQuery) (out [][]string) {
+		for _, round := range rounds {
+			var sqls []string
+			for _, bound := range round {
+				sqls = append(sqls, bound.Sql)
+			}
+			out = append(out, sqls)
+		}
+		return out
+	}
+
+	assert.Equal(t, [][]string{{"q1", "q2", "q3", "q4"}}, sqls(splitBatch(queries, 0, 0)))
+	assert.Equal(t, [][]string{{"q1", "q2", "q3"}, {"q4"}}, sqls(splitBatch(queries, 3, 0)))
+	assert.Equal(t, [][]string{{"q1"}, {"q2", "q3"}, {"q4"}}, sqls(splitBatch(queries, 0, 2*size)))
+	// A query holding more than the limit is executed alone.
+	assert.Equal(t, [][]string{{"q1"}, {"q2"}, {"q3", "q4"}}, sqls(splitBatch(queries, 0, size)))
+	assert.Equal(t, [][]string{{"q1"}, {"q2", "q3"}, {"q4"}}, sqls(splitBatch(queries, 2, 2*size)))
+}
+
 func TestTabletServerExecuteBatchFailEmptyQueryList(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -1714,6 +1802,78 @@ func TestPurgeMessages(t *testing.T) {
 	require.EqualValues(t, 1, count)
 }
 
+func TestDeadLetters(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+
+	_, err := tsv.GetDeadLetters(ctx, &target, "nonmsg", 10)
+	want := "message table nonmsg not found in schema"
+	require.Error(t, err)
+	require.Contains(t, err.Error(), want)
+
+	fields := sqltypes.MakeTestFields("epoch|id|message", "int64|int64|varchar")
+	db.AddQuery("select epoch, id, message from msg where 1 != 1", &sqltypes.Result{Fields: fields})
+	db.AddQuery("select epoch, id, message from msg where time_acked is null and time_next is null and epoch >= 5 order by id asc limit 10", &sqltypes.Result{
+		Fields: fields,
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt64(5), sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}},
+	})
+	qr, err := tsv.GetDeadLetters(ctx, &target, "msg", 10)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+
+	db.AddQueryPattern("update msg set time_next = .*, epoch = 0 where time_acked is null and time_next is null and epoch >= 5 and id in \\('1', '2'\\) limit 10001", &sqltypes.Result{RowsAffected: 2})
+	count, err := tsv.RequeueDeadLetters(ctx, &target, "msg", []string{"1", "2"})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, count)
+
+	db.AddQuery("delete from msg where time_acked is null and time_next is null and epoch >= 5 limit 10001", &sqltypes.Result{RowsAffected: 3})
+	count, err = tsv.PurgeDeadLetters(ctx, &target, "msg", nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, count)
+}
+
+func TestSequences(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+
+	_, err := tsv.GetSequences(ctx, &target, []string{"msg"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sequence msg not found in schema")
+
+	fields := sqltypes.MakeTestFields("next_id|cache", "int64|int64")
+	db.AddQuery("select next_id, cache from seq where 1 != 1", &sqltypes.Result{Fields: fields})
+	db.AddQuery("select next_id, cache from seq where id = 0 limit 10001", sqltypes.MakeTestResult(fields, "10|100"))
+	states, err := tsv.GetSequences(ctx, &target, nil)
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, &schema.SequenceState{
+		Name:     "seq",
+		NextID:   10,
+		Cache:    100,
+		MaxValue: math.MaxInt64 - 1,
+	}, states[0])
+
+	si := tsv.se.GetTable(sqlparser.NewTableIdent("seq")).SequenceInfo
+	si.NextVal, si.LastVal = 5, 10
+	db.AddQuery("select next_id, cache from seq where id = 0 limit 10001 for update", sqltypes.MakeTestResult(fields, "10|100"))
+	_, err = tsv.ResetSequence(ctx, &target, "seq", 9, 0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "next ID 9 of sequence seq is below its current value 10")
+	assert.EqualValues(t, 5, si.NextVal)
+
+	db.AddQuery("update seq set next_id = 20, cache = 100 where id = 0 limit 10001", &sqltypes.Result{})
+	state, err := tsv.ResetSequence(ctx, &target, "seq", 20, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 20, state.NextID)
+	assert.EqualValues(t, 100, state.Cache)
+	assert.EqualValues(t, 0, si.NextVal)
+	assert.EqualValues(t, 0, si.LastVal)
+}
+
 func TestHandleExecUnknownError(t *testing.T) {
 	logStats := tabletenv.NewLogStats(ctx, "TestHandleExecError")
 	config := tabletenv.NewDefaultConfig()
@@ -1973,8 +2133,10 @@ var aclJSON2 = `{
   ]
 }`
 
+var registerSimpleACL sync.Once
+
 func TestACLHUP(t *testing.T) {
-	tableacl.Register("simpleacl", &simpleacl.Factory{})
+	registerSimpleACL.Do(func() { tableacl.Register("simpleacl", &simpleacl.Factory{}) })
 	config := tabletenv.NewDefaultConfig()
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
 
@@ -2018,6 +2180,50 @@ func TestACLHUP(t *testing.T) {
 	}
 }
 
+func TestSetTableACL(t *testing.T) {
+	registerSimpleACL.Do(func() { tableacl.Register("simpleacl", &simpleacl.Factory{}) })
+	config := tabletenv.NewDefaultConfig()
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
+
+	f, err := os.CreateTemp("", "tableacl")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = io.WriteString(f, aclJSON1)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	tsv.InitACL(f.Name(), true, 0)
+	assert.Equal(t, "group01", tableacl.GetCurrentConfig().TableGroups[0].Name)
+
+	err = tsv.SetTableACL(&tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "topo_group",
+			TableNamesOrPrefixes: []string{"t1"},
+			Readers:              []string{"vt"},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "topo_group", tableacl.GetCurrentConfig().TableGroups[0].Name)
+
+	// Reloading the config file keeps the table ACL of the keyspace.
+	tsv.initACL(f.Name(), true)
+	assert.Equal(t, "topo_group", tableacl.GetCurrentConfig().TableGroups[0].Name)
+
+	// An invalid table ACL keeps the previous one.
+	err = tsv.SetTableACL(&tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "bad_group",
+			TableNamesOrPrefixes: []string{"t%", "t1"},
+		}},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "topo_group", tableacl.GetCurrentConfig().TableGroups[0].Name)
+
+	// Removing the table ACL of the keyspace restores the config file.
+	require.NoError(t, tsv.SetTableACL(nil))
+	assert.Equal(t, "group01", tableacl.GetCurrentConfig().TableGroups[0].Name)
+}
+
 func TestConfigChanges(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+)
+
+// QueryFunc runs a query on the tablet's own MySQL server and returns its single value. The query is
+// either a `SELECT` (returning a single row, single value) or a `SHOW GLOBAL ... LIKE ...` query.
+type QueryFunc func(ctx context.Context, query string) (float64, error)
+
+// MetricSource reads a metric of the tablet's own MySQL server or host, which the throttler can
+// combine with the replication lag
+type MetricSource interface {
+	// Read returns the current value of the metric
+	Read(ctx context.Context, query QueryFunc) (float64, error)
+}
+
+// MetricSourceFunc is an adapter allowing a function to be used as a MetricSource
+type MetricSourceFunc func(ctx context.Context, query QueryFunc) (float64, error)
+
+// Read implements MetricSource
+func (f MetricSourceFunc) Read(ctx context.Context, query QueryFunc) (float64, error) {
+	return f(ctx, query)
+}
+
+// errorMetricResult is a result which failed to be read
+type errorMetricResult struct {
+	err error
+}
+
+// NewErrorMetricResult creates a result for a metric which failed to be read
+func NewErrorMetricResult(err error) MetricResult {
+	return &errorMetricResult{err: err}
+}
+
+// Get implements MetricResult
+func (metricResult *errorMetricResult) Get() (float64, error) {
+	return 0, metricResult.err
+}
@@ -22,7 +22,9 @@ type MetricResultFunc func() (metricResult MetricResult, threshold float64)
 
 // ErrThresholdExceeded is the common error one may get checking on metric result
 var ErrThresholdExceeded = errors.New("Threshold exceeded")
-var errNoResultYet = errors.New("Metric not collected yet")
+
+// ErrNoResultYet is for when a metric was not collected yet
+var ErrNoResultYet = errors.New("Metric not collected yet")
 
 // ErrNoSuchMetric is for when a user requests a metric by an unknown metric name
 var ErrNoSuchMetric = errors.New("No such metric")
@@ -52,7 +54,7 @@ type noMetricResultYet struct{}
 
 // Get implements MetricResult
 func (metricResult *noMetricResultYet) Get() (float64, error) {
-	return 0, errNoResultYet
+	return 0, ErrNoResultYet
 }
 
 // NoMetricResultYet is a result indicating "no data"
@@ -0,0 +1,33 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+// WorkflowShare is the share of the throttler's threshold a running workflow gets
+// - Share: [0..1], the workflow's priority divided by the sum of the priorities of the running workflows
+type WorkflowShare struct {
+	Priority int
+	Share    float64
+}
+
+// NewWorkflowShare creates a WorkflowShare struct
+func NewWorkflowShare(priority int, share float64) *WorkflowShare {
+	result := &WorkflowShare{
+		Priority: priority,
+		Share:    share,
+	}
+	return result
+}
@@ -67,6 +67,14 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 	if flags.OverrideThreshold > 0 {
 		threshold = flags.OverrideThreshold
 	}
+	var metrics map[string]float64
+	if storeType == "mysql" {
+		metricResult, threshold, metrics = check.throttler.combineCustomMetrics(appName, metricResult, threshold)
+		if flags.LowPriority {
+			// workflows running at the same time share the threshold
+			threshold *= check.throttler.workflowShare(appName)
+		}
+	}
 	value, err := metricResult.Get()
 	if appName == "" {
 		return NewCheckResult(http.StatusExpectationFailed, value, threshold, fmt.Errorf("no app indicated"))
@@ -96,7 +104,9 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 		// all good!
 		statusCode = http.StatusOK // 200
 	}
-	return NewCheckResult(statusCode, value, threshold, err)
+	checkResult = NewCheckResult(statusCode, value, threshold, err)
+	checkResult.Metrics = metrics
+	return checkResult
 }
 
 // Check is the core function that runs when a user wants to check a metric
@@ -109,6 +119,12 @@ func (check *ThrottlerCheck) Check(ctx context.Context, appName string, storeTyp
 				return check.throttler.getMySQLClusterMetrics(ctx, storeName)
 			}
 		}
+	case customStoreType:
+		{
+			metricResultFunc = func() (metricResult base.MetricResult, threshold float64) {
+				return check.throttler.getCustomMetric(storeName)
+			}
+		}
 	}
 	if metricResultFunc == nil {
 		return NoSuchMetricCheckResult
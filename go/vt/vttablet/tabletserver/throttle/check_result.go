@@ -19,6 +19,8 @@ type CheckResult struct {
 	Threshold  float64 `json:"Threshold"`
 	Error      error   `json:"-"`
 	Message    string  `json:"Message"`
+	// Metrics holds the values of the metrics combined into Value, when custom metrics are configured
+	Metrics map[string]float64 `json:"Metrics,omitempty"`
 }
 
 // NewCheckResult returns a CheckResult
@@ -18,6 +18,7 @@ package throttle
 
 import (
 	"context"
+	"math/rand"
 	"net/http"
 	"sync"
 	"sync/atomic"
@@ -49,6 +50,7 @@ type Client struct {
 	appName   string
 	checkType ThrottleCheckType
 	flags     CheckFlags
+	ratio     float64
 
 	lastSuccessfulThrottle int64
 }
@@ -80,6 +82,13 @@ func NewBackgroundClient(throttler *Throttler, appName string, checkType Throttl
 	}
 }
 
+// SetRatio sets the ratio of the checks which the client rejects by itself, regardless of the throttler.
+// Like the ratio of a throttled app, it lets an app run at a fraction of its throughput.
+// The function is not thread safe.
+func (c *Client) SetRatio(ratio float64) {
+	c.ratio = ratio
+}
+
 // ThrottleCheckOK checks the throttler, and returns 'true' when the throttler is satisfied.
 // It does not sleep.
 // The function caches results for a brief amount of time, hence it's safe and efficient to
@@ -98,6 +107,10 @@ func (c *Client) ThrottleCheckOK(ctx context.Context) (throttleCheckOK bool) {
 		// if last check was OK just very recently there is no need to check again
 		return true
 	}
+	if c.ratio > 0 && rand.Float64() < c.ratio {
+		// the app throttles itself
+		return false
+	}
 	// It's time to run a throttler check
 	checkResult := c.throttler.CheckByType(ctx, c.appName, "", &c.flags, c.checkType)
 	if checkResult.StatusCode != http.StatusOK {
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestClientRatio(t *testing.T) {
+	env := tabletenv.NewEnv(tabletenv.NewDefaultConfig(), "TestClientRatio")
+	throttler := NewThrottler(env, nil, nil, "zone1", func() topodatapb.TabletType { return topodatapb.TabletType_PRIMARY })
+	ctx := context.Background()
+
+	// The throttler is disabled, and satisfied.
+	client := NewBackgroundClient(throttler, "vreplication:wf", ThrottleCheckPrimaryWrite)
+	// The results are cached until the first tick.
+	for atomic.LoadInt64(&throttleTicks) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, client.ThrottleCheckOK(ctx))
+
+	client = NewBackgroundClient(throttler, "vreplication:wf", ThrottleCheckPrimaryWrite)
+	client.SetRatio(1)
+	for i := 0; i < 10; i++ {
+		assert.False(t, client.ThrottleCheckOK(ctx))
+	}
+	client.SetRatio(0)
+	assert.True(t, client.ThrottleCheckOK(ctx))
+
+	// Without a throttler, the client never throttles.
+	client = NewBackgroundClient(nil, "vreplication:wf", ThrottleCheckPrimaryWrite)
+	client.SetRatio(1)
+	assert.True(t, client.ThrottleCheckOK(ctx))
+}
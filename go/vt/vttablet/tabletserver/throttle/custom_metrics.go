@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/textutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
+
+	"github.com/patrickmn/go-cache"
+)
+
+const (
+	customStoreType              = "custom"
+	lagMetricName                = "lag"
+	customMetricsCollectInterval = time.Second
+)
+
+var (
+	throttleCustomMetrics = flag.String("throttle_custom_metrics", "", "Comma separated metrics the throttler combines with the replication lag, with their thresholds. example: 'threads_running=100,history_list_length=1000000,disk_io_utilization=0.9'. A check then compares the highest ratio of a metric to its threshold with 1")
+	throttleAppThresholds = flag.String("throttle_app_thresholds", "", "Comma separated app specific thresholds, overriding those of -throttle_threshold (as 'lag') and -throttle_custom_metrics. example: 'online-ddl:lag=5,online-ddl:threads_running=50'")
+)
+
+var (
+	metricSourcesMutex sync.Mutex
+	metricSources      = map[string]base.MetricSource{}
+)
+
+func init() {
+	RegisterMetricSource("threads_running", queryMetricSource(`show global status like 'threads_running'`))
+	RegisterMetricSource("history_list_length", queryMetricSource(`select count from information_schema.innodb_metrics where name = 'trx_rseg_history_len'`))
+	RegisterMetricSource("disk_io_utilization", newDiskIOMetricSource("/proc/diskstats"))
+}
+
+// RegisterMetricSource makes a metric source available to -throttle_custom_metrics under the given name.
+// It is meant to be called from an init function, and panics if the name is already registered.
+func RegisterMetricSource(name string, source base.MetricSource) {
+	metricSourcesMutex.Lock()
+	defer metricSourcesMutex.Unlock()
+
+	if _, ok := metricSources[name]; ok || name == lagMetricName {
+		panic(fmt.Sprintf("throttler metric source %s is already registered", name))
+	}
+	metricSources[name] = source
+}
+
+func getMetricSource(name string) (base.MetricSource, bool) {
+	metricSourcesMutex.Lock()
+	defer metricSourcesMutex.Unlock()
+
+	source, ok := metricSources[name]
+	return source, ok
+}
+
+// queryMetricSource reads a metric with a query on the tablet's own MySQL server
+func queryMetricSource(query string) base.MetricSource {
+	return base.MetricSourceFunc(func(ctx context.Context, queryFunc base.QueryFunc) (float64, error) {
+		return queryFunc(ctx, query)
+	})
+}
+
+// diskIOMetricSource reads the utilization of the busiest disk of the host, that is the fraction of
+// time it spent doing I/O since the previous read
+type diskIOMetricSource struct {
+	path string
+
+	mu        sync.Mutex
+	lastTime  time.Time
+	lastTicks map[string]int64
+}
+
+func newDiskIOMetricSource(path string) *diskIOMetricSource {
+	return &diskIOMetricSource{path: path}
+}
+
+// Read implements base.MetricSource
+func (source *diskIOMetricSource) Read(ctx context.Context, query base.QueryFunc) (float64, error) {
+	data, err := os.ReadFile(source.path)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	ticks, err := parseDiskStats(string(data))
+	if err != nil {
+		return 0, err
+	}
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	lastTime, lastTicks := source.lastTime, source.lastTicks
+	source.lastTime, source.lastTicks = now, ticks
+	if lastTicks == nil {
+		return 0, errors.New("disk I/O utilization is only known from the second read")
+	}
+	return diskIOUtilization(lastTicks, ticks, now.Sub(lastTime)), nil
+}
+
+// parseDiskStats returns the milliseconds each disk of /proc/diskstats spent doing I/O
+func parseDiskStats(data string) (map[string]int64, error) {
+	ticks := make(map[string]int64)
+	for _, line := range strings.Split(data, "\n") {
+		// major, minor, device name, then the statistics, the 10th of which is the time spent doing I/O
+		fields := strings.Fields(line)
+		if len(fields) < 13 {
+			continue
+		}
+		device := fields[2]
+		if strings.HasPrefix(device, "loop") || strings.HasPrefix(device, "ram") {
+			continue
+		}
+		ioTicks, err := strconv.ParseInt(fields[12], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid disk stats for %s: %v", device, err)
+		}
+		ticks[device] = ioTicks
+	}
+	return ticks, nil
+}
+
+// diskIOUtilization returns the highest utilization of the disks between two reads
+func diskIOUtilization(lastTicks, ticks map[string]int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	utilization := 0.0
+	for device, ioTicks := range ticks {
+		lastIOTicks, ok := lastTicks[device]
+		if !ok || ioTicks < lastIOTicks {
+			continue
+		}
+		utilization = math.Max(utilization, float64(ioTicks-lastIOTicks)/float64(elapsed.Milliseconds()))
+	}
+	return math.Min(utilization, 1)
+}
+
+// parseMetricThresholds parses a comma separated list of `metric=threshold`
+func parseMetricThresholds(value string) (map[string]float64, error) {
+	thresholds := make(map[string]float64)
+	for _, token := range textutil.SplitDelimitedList(value) {
+		name, threshold, err := parseMetricThreshold(token)
+		if err != nil {
+			return nil, err
+		}
+		if name == lagMetricName {
+			return nil, fmt.Errorf("the %s threshold is set by -throttle_threshold", lagMetricName)
+		}
+		thresholds[name] = threshold
+	}
+	return thresholds, nil
+}
+
+// parseAppThresholds parses a comma separated list of `app:metric=threshold`
+func parseAppThresholds(value string) (map[string]map[string]float64, error) {
+	appThresholds := make(map[string]map[string]float64)
+	for _, token := range textutil.SplitDelimitedList(value) {
+		i := strings.LastIndex(token, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid app threshold %s, expected app:metric=threshold", token)
+		}
+		name, threshold, err := parseMetricThreshold(token[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		appName := token[:i]
+		if appThresholds[appName] == nil {
+			appThresholds[appName] = make(map[string]float64)
+		}
+		appThresholds[appName][name] = threshold
+	}
+	return appThresholds, nil
+}
+
+func parseMetricThreshold(token string) (name string, threshold float64, err error) {
+	tokens := strings.Split(token, "=")
+	if len(tokens) != 2 {
+		return "", 0, fmt.Errorf("invalid metric threshold %s, expected metric=threshold", token)
+	}
+	name = strings.TrimSpace(tokens[0])
+	if _, ok := getMetricSource(name); !ok && name != lagMetricName {
+		return "", 0, fmt.Errorf("unknown throttler metric %s", name)
+	}
+	threshold, err = strconv.ParseFloat(strings.TrimSpace(tokens[1]), 64)
+	if err != nil || threshold <= 0 {
+		return "", 0, fmt.Errorf("invalid threshold for metric %s: %s", name, tokens[1])
+	}
+	return name, threshold, nil
+}
+
+// initCustomMetrics reads the user supplied throttle_custom_metrics and throttle_app_thresholds and sets these
+// for the duration of this tablet's lifetime
+func (throttler *Throttler) initCustomMetrics() {
+	customMetricThresholds, err := parseMetricThresholds(*throttleCustomMetrics)
+	if err != nil {
+		log.Errorf("Throttler: ignoring -throttle_custom_metrics: %v", err)
+	} else {
+		throttler.customMetricThresholds = customMetricThresholds
+	}
+	appThresholds, err := parseAppThresholds(*throttleAppThresholds)
+	if err != nil {
+		log.Errorf("Throttler: ignoring -throttle_app_thresholds: %v", err)
+	} else {
+		throttler.appThresholds = appThresholds
+	}
+}
+
+// queryFunc returns a base.QueryFunc running queries on this very tablet's backend mysql
+func (throttler *Throttler) queryFunc() base.QueryFunc {
+	return func(ctx context.Context, query string) (float64, error) {
+		return throttler.readSelfValue(ctx, query, mysql.GetMetricsQueryType(query))
+	}
+}
+
+// collectCustomMetrics reads the custom metrics, which are always those of this tablet's own MySQL server
+// and host
+func (throttler *Throttler) collectCustomMetrics(ctx context.Context) {
+	if len(throttler.customMetricThresholds) == 0 {
+		return
+	}
+	// Avoid reading the metrics twice at the same time, if the previous read is still running
+	if !atomic.CompareAndSwapInt64(&throttler.customMetricsInProgress, 0, 1) {
+		return
+	}
+	go func() {
+		defer atomic.StoreInt64(&throttler.customMetricsInProgress, 0)
+
+		ctx, cancel := context.WithTimeout(ctx, customMetricsCollectInterval)
+		defer cancel()
+		for name := range throttler.customMetricThresholds {
+			source, _ := getMetricSource(name)
+			var metricResult base.MetricResult
+			if value, err := source.Read(ctx, throttler.queryFunc()); err != nil {
+				metricResult = base.NewErrorMetricResult(fmt.Errorf("%s: %v", name, err))
+			} else {
+				metricResult = base.NewSimpleMetricResult(value)
+			}
+			throttler.aggregatedMetrics.Set(fmt.Sprintf("%s/%s", customStoreType, name), metricResult, cache.DefaultExpiration)
+		}
+	}()
+}
+
+// getCustomMetric returns the last read value of a custom metric, and its threshold
+func (throttler *Throttler) getCustomMetric(name string) (base.MetricResult, float64) {
+	threshold, ok := throttler.customMetricThresholds[name]
+	if !ok {
+		return base.NoSuchMetric, 0
+	}
+	return throttler.getNamedMetric(fmt.Sprintf("%s/%s", customStoreType, name)), threshold
+}
+
+// appThreshold returns the threshold of a metric for the given app, which may be a colon separated
+// list of app names, like IsAppThrottled supports
+func (throttler *Throttler) appThreshold(appName string, metricName string, threshold float64) float64 {
+	if appThreshold, ok := throttler.appThresholds[appName][metricName]; ok {
+		return appThreshold
+	}
+	for _, singleAppName := range strings.Split(appName, ":") {
+		if appThreshold, ok := throttler.appThresholds[singleAppName][metricName]; ok {
+			return appThreshold
+		}
+	}
+	return threshold
+}
+
+// combineCustomMetrics combines the replication lag with the custom metrics, if any. Each metric is normalized by
+// dividing it by the app's threshold, and the combined result is the highest of these ratios, with a threshold
+// of 1. The values of the metrics are returned alongside.
+func (throttler *Throttler) combineCustomMetrics(appName string, lagResult base.MetricResult, lagThreshold float64) (base.MetricResult, float64, map[string]float64) {
+	lagThreshold = throttler.appThreshold(appName, lagMetricName, lagThreshold)
+	if len(throttler.customMetricThresholds) == 0 {
+		return lagResult, lagThreshold, nil
+	}
+	lag, err := lagResult.Get()
+	if err != nil {
+		return lagResult, lagThreshold, nil
+	}
+
+	metrics := map[string]float64{lagMetricName: lag}
+	score := lag / lagThreshold
+	// The metrics are checked in order, so that the first missing one is consistently reported
+	names := make([]string, 0, len(throttler.customMetricThresholds))
+	for name := range throttler.customMetricThresholds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		metricResult, threshold := throttler.getCustomMetric(name)
+		value, err := metricResult.Get()
+		if err != nil {
+			if err == base.ErrNoSuchMetric {
+				err = fmt.Errorf("%s: %v", name, base.ErrNoResultYet)
+			}
+			return base.NewErrorMetricResult(err), 1, metrics
+		}
+		metrics[name] = value
+		score = math.Max(score, value/throttler.appThreshold(appName, name, threshold))
+	}
+	return base.NewSimpleMetricResult(score), 1, metrics
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetricThresholds(t *testing.T) {
+	thresholds, err := parseMetricThresholds("threads_running=100, history_list_length=1000000,disk_io_utilization=0.9")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"threads_running": 100, "history_list_length": 1000000, "disk_io_utilization": 0.9}, thresholds)
+
+	thresholds, err = parseMetricThresholds("")
+	require.NoError(t, err)
+	assert.Empty(t, thresholds)
+
+	for _, value := range []string{"threads_running", "threads_running=0", "threads_running=x", "no_such_metric=1", "lag=1"} {
+		_, err := parseMetricThresholds(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseAppThresholds(t *testing.T) {
+	thresholds, err := parseAppThresholds("online-ddl:lag=5,online-ddl:threads_running=50,vreplication:history_list_length=10")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]float64{
+		"online-ddl":   {"lag": 5, "threads_running": 50},
+		"vreplication": {"history_list_length": 10},
+	}, thresholds)
+
+	for _, value := range []string{"lag=5", ":lag=5", "online-ddl:no_such_metric=5", "online-ddl:lag=-1"} {
+		_, err := parseAppThresholds(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestDiskIOUtilization(t *testing.T) {
+	ticks, err := parseDiskStats(`   7       0 loop0 59 0 2100 12 0 0 0 0 0 40 12 0 0 0 0
+   8       0 sda 8075 2689 487920 3365 4419 6009 128824 8163 0 9000 11828 0 0 0 0
+   8       1 sda1 7893 2689 479684 3330 4419 6009 128824 8163 0 8800 11493 0 0 0 0
+ 259       0 nvme0n1 100 0 800 10 200 0 1600 20 0 500 30
+`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"sda": 9000, "sda1": 8800, "nvme0n1": 500}, ticks)
+
+	nextTicks := map[string]int64{"sda": 9250, "sda1": 9000, "nvme0n1": 900}
+	assert.Equal(t, 0.4, diskIOUtilization(ticks, nextTicks, time.Second))
+	assert.Equal(t, 1.0, diskIOUtilization(ticks, nextTicks, 100*time.Millisecond))
+	assert.Equal(t, 0.0, diskIOUtilization(ticks, nextTicks, 0))
+
+	_, err = parseDiskStats("8 0 sda 1 2 3 4 5 6 7 8 9 x 11")
+	assert.Error(t, err)
+}
+
+func newCustomMetricsThrottler(customMetricThresholds map[string]float64, appThresholds map[string]map[string]float64) *Throttler {
+	throttler := &Throttler{
+		customMetricThresholds:             customMetricThresholds,
+		appThresholds:                      appThresholds,
+		throttledApps:                      cache.New(cache.NoExpiration, 0),
+		recentApps:                         cache.New(recentAppsExpiration, 0),
+		aggregatedMetrics:                  cache.New(aggregatedMetricsExpiration, 0),
+		nonLowPriorityAppRequestsThrottled: cache.New(nonDeprioritizedAppMapExpiration, 0),
+		activeWorkflows:                    cache.New(activeWorkflowExpiration, 0),
+	}
+	throttler.check = NewThrottlerCheck(throttler)
+	return throttler
+}
+
+func TestCombineCustomMetrics(t *testing.T) {
+	throttler := newCustomMetricsThrottler(
+		map[string]float64{"threads_running": 100, "history_list_length": 1000},
+		map[string]map[string]float64{"online-ddl": {"lag": 0.5, "threads_running": 50}},
+	)
+	lag := base.NewSimpleMetricResult(0.4)
+
+	// not collected yet
+	metricResult, threshold, _ := throttler.combineCustomMetrics("app", lag, 1)
+	_, err := metricResult.Get()
+	assert.EqualError(t, err, "history_list_length: Metric not collected yet")
+	assert.Equal(t, 1.0, threshold)
+
+	throttler.aggregatedMetrics.SetDefault("custom/threads_running", base.NewSimpleMetricResult(60))
+	throttler.aggregatedMetrics.SetDefault("custom/history_list_length", base.NewSimpleMetricResult(300))
+
+	metricResult, threshold, metrics := throttler.combineCustomMetrics("app", lag, 1)
+	value, err := metricResult.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 0.6, value)
+	assert.Equal(t, 1.0, threshold)
+	assert.Equal(t, map[string]float64{"lag": 0.4, "threads_running": 60, "history_list_length": 300}, metrics)
+
+	// app specific thresholds
+	metricResult, _, _ = throttler.combineCustomMetrics("vreplication:online-ddl", lag, 1)
+	value, err = metricResult.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 1.2, value)
+
+	// errors are passed through
+	metricResult, _, metrics = throttler.combineCustomMetrics("app", base.AppDeniedMetric, 1)
+	_, err = metricResult.Get()
+	assert.Equal(t, base.ErrAppDenied, err)
+	assert.Nil(t, metrics)
+
+	throttler.aggregatedMetrics.SetDefault("custom/history_list_length", base.NewErrorMetricResult(assert.AnError))
+	metricResult, _, _ = throttler.combineCustomMetrics("app", lag, 1)
+	_, err = metricResult.Get()
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestCombineCustomMetricsLagOnly(t *testing.T) {
+	throttler := newCustomMetricsThrottler(nil, map[string]map[string]float64{"online-ddl": {"lag": 5}})
+	lag := base.NewSimpleMetricResult(2)
+
+	metricResult, threshold, metrics := throttler.combineCustomMetrics("app", lag, 1)
+	assert.Equal(t, lag, metricResult)
+	assert.Equal(t, 1.0, threshold)
+	assert.Nil(t, metrics)
+
+	_, threshold, _ = throttler.combineCustomMetrics("online-ddl", lag, 1)
+	assert.Equal(t, 5.0, threshold)
+}
+
+func TestCheckCustomMetrics(t *testing.T) {
+	throttler := newCustomMetricsThrottler(map[string]float64{"threads_running": 100}, nil)
+	throttler.aggregatedMetrics.SetDefault("custom/threads_running", base.NewSimpleMetricResult(150))
+	lagFunc := func() (base.MetricResult, float64) {
+		return base.NewSimpleMetricResult(0.5), 1
+	}
+
+	checkResult := throttler.check.checkAppMetricResult(context.Background(), "app", "mysql", "self", lagFunc, StandardCheckFlags)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, 1.5, checkResult.Value)
+	assert.Equal(t, 1.0, checkResult.Threshold)
+	assert.Equal(t, map[string]float64{"lag": 0.5, "threads_running": 150}, checkResult.Metrics)
+
+	checkResult = throttler.check.Check(context.Background(), "app", "custom", "threads_running", "local", StandardCheckFlags)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, 150.0, checkResult.Value)
+	assert.Equal(t, 100.0, checkResult.Threshold)
+	assert.Nil(t, checkResult.Metrics)
+}
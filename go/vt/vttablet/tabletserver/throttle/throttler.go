@@ -19,6 +19,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
@@ -26,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -101,6 +103,7 @@ func init() {
 type Throttler struct {
 	keyspace string
 	shard    string
+	cell     string
 
 	check    *ThrottlerCheck
 	isLeader int64
@@ -110,6 +113,7 @@ type Throttler struct {
 	pool           *connpool.Pool
 	tabletTypeFunc func() topodatapb.TabletType
 	ts             *topo.Server
+	srvTopoServer  srvtopo.Server
 
 	throttleTabletTypesMap map[topodatapb.TabletType]bool
 
@@ -119,18 +123,30 @@ type Throttler struct {
 
 	mysqlInventory *mysql.Inventory
 
-	metricsQuery     string
+	// metricsQuery and MetricsThreshold are updated at runtime with -throttler_config_via_topo
+	metricsQuery     sync2.AtomicString
 	MetricsThreshold sync2.AtomicFloat64
-	metricsQueryType mysql.MetricsQueryType
+
+	customMetricThresholds  map[string]float64
+	appThresholds           map[string]map[string]float64
+	customMetricsInProgress int64
+
+	workflowPriorities map[string]int
 
 	mysqlClusterThresholds *cache.Cache
 	aggregatedMetrics      *cache.Cache
 	throttledApps          *cache.Cache
 	recentApps             *cache.Cache
 	metricsHealth          *cache.Cache
+	activeWorkflows        *cache.Cache
 
 	lastCheckTimeNano int64
 
+	// throttlerConfig is the configuration of the keyspace in the topo, with -throttler_config_via_topo.
+	// It is nil until the configuration is first set, and the flags apply.
+	throttlerConfig      *topodatapb.ThrottlerConfig
+	throttlerConfigMutex sync.Mutex
+
 	initMutex          sync.Mutex
 	throttledAppsMutex sync.Mutex
 	tickers            [](*timer.SuspendableTicker)
@@ -147,34 +163,38 @@ type ThrottlerStatus struct {
 	IsLeader  bool
 	IsOpen    bool
 	IsDormant bool
+	IsEnabled bool
 
 	AggregatedMetrics map[string]base.MetricResult
 	MetricsHealth     base.MetricHealthMap
+	Workflows         map[string](*base.WorkflowShare)
 }
 
 // NewThrottler creates a Throttler
-func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
+func NewThrottler(env tabletenv.Env, srvTopoServer srvtopo.Server, ts *topo.Server, cell string, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
 	throttler := &Throttler{
 		isLeader: 0,
 		isOpen:   0,
 
+		cell:           cell,
 		env:            env,
 		tabletTypeFunc: tabletTypeFunc,
 		ts:             ts,
+		srvTopoServer:  srvTopoServer,
 		pool: connpool.NewPool(env, "ThrottlerPool", tabletenv.ConnPoolConfig{
 			Size:               2,
 			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
 		}),
 	}
 
-	if env.Config().EnableLagThrottler {
+	if throttler.isRunning() {
 		throttler.mysqlThrottleMetricChan = make(chan *mysql.MySQLThrottleMetric)
 
 		throttler.mysqlInventoryChan = make(chan *mysql.Inventory, 1)
 		throttler.mysqlClusterProbesChan = make(chan *mysql.ClusterProbes)
 		throttler.mysqlInventory = mysql.NewInventory()
 
-		throttler.metricsQuery = replicationLagQuery
+		throttler.metricsQuery.Set(replicationLagQuery)
 		throttler.MetricsThreshold = sync2.NewAtomicFloat64(throttleThreshold.Seconds())
 
 		throttler.throttledApps = cache.New(cache.NoExpiration, 10*time.Second)
@@ -182,12 +202,15 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		throttler.aggregatedMetrics = cache.New(aggregatedMetricsExpiration, aggregatedMetricsCleanup)
 		throttler.recentApps = cache.New(recentAppsExpiration, time.Minute)
 		throttler.metricsHealth = cache.New(cache.NoExpiration, 0)
+		throttler.activeWorkflows = cache.New(activeWorkflowExpiration, activeWorkflowCleanup)
 
 		throttler.tickers = [](*timer.SuspendableTicker){}
 		throttler.nonLowPriorityAppRequestsThrottled = cache.New(nonDeprioritizedAppMapExpiration, nonDeprioritizedAppMapInterval)
 
 		throttler.httpClient = base.SetupHTTPClient(0)
 		throttler.initThrottleTabletTypes()
+		throttler.initCustomMetrics()
+		throttler.initWorkflowPriorities()
 		throttler.ThrottleApp("abusing-app", time.Now().Add(time.Hour*24*365*10), defaultThrottleRatio)
 		throttler.check = NewThrottlerCheck(throttler)
 		throttler.initConfig("")
@@ -216,9 +239,92 @@ func (throttler *Throttler) initThrottleTabletTypes() {
 func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 	throttler.keyspace = keyspace
 	throttler.shard = shard
-	if throttler.env.Config().EnableLagThrottler {
+	if throttler.isRunning() {
 		go throttler.Operate(context.Background())
 	}
+	if throttler.env.Config().ThrottlerConfigViaTopo {
+		go throttler.watchThrottlerConfig(context.Background())
+	}
+}
+
+// isRunning returns true when the throttler service runs: either it is enabled, or it may be enabled
+// at runtime via the topo
+func (throttler *Throttler) isRunning() bool {
+	return throttler.env.Config().EnableLagThrottler || throttler.env.Config().ThrottlerConfigViaTopo
+}
+
+// IsEnabled returns true when the throttler checks the metrics. A disabled throttler accepts all checks.
+func (throttler *Throttler) IsEnabled() bool {
+	if throttlerConfig := throttler.getThrottlerConfig(); throttlerConfig != nil {
+		return throttlerConfig.Enabled
+	}
+	return throttler.env.Config().EnableLagThrottler
+}
+
+// checkAsCheckSelf returns true when the checks of primary writes check the tablet's own metric
+func (throttler *Throttler) checkAsCheckSelf() bool {
+	if throttlerConfig := throttler.getThrottlerConfig(); throttlerConfig != nil {
+		return throttlerConfig.CheckAsCheckSelf
+	}
+	return *throttlerCheckAsCheckSelf
+}
+
+func (throttler *Throttler) getThrottlerConfig() *topodatapb.ThrottlerConfig {
+	throttler.throttlerConfigMutex.Lock()
+	defer throttler.throttlerConfigMutex.Unlock()
+	return throttler.throttlerConfig
+}
+
+// watchThrottlerConfig applies the throttler configuration of the keyspace whenever it changes in the topo
+func (throttler *Throttler) watchThrottlerConfig(ctx context.Context) {
+	throttler.srvTopoServer.WatchSrvKeyspace(ctx, throttler.cell, throttler.keyspace, func(srvKeyspace *topodatapb.SrvKeyspace, err error) bool {
+		switch {
+		case err == nil:
+			throttler.applyThrottlerConfig(srvKeyspace.ThrottlerConfig)
+		case topo.IsErrType(err, topo.NoNode):
+			// The keyspace is not served in this cell yet
+		default:
+			log.Errorf("Throttler: error watching the throttler config: %v", err)
+		}
+		return true
+	})
+}
+
+// applyThrottlerConfig applies the throttler configuration of the keyspace, unless it was never set
+func (throttler *Throttler) applyThrottlerConfig(throttlerConfig *topodatapb.ThrottlerConfig) {
+	if throttlerConfig == nil {
+		return
+	}
+	throttler.initMutex.Lock()
+	defer throttler.initMutex.Unlock()
+
+	throttler.throttlerConfigMutex.Lock()
+	previousConfig := throttler.throttlerConfig
+	throttler.throttlerConfig = throttlerConfig
+	throttler.throttlerConfigMutex.Unlock()
+
+	// The throttler account, if any, is unchanged
+	password := ""
+	if config.Instance != nil {
+		if clusterSettings, ok := config.Settings().Stores.MySQL.Clusters[shardStoreName]; ok {
+			password = clusterSettings.Password
+		}
+	}
+	throttler.initConfig(password)
+	// The thresholds are otherwise updated on the next inventory refresh
+	for clusterName := range throttler.mysqlClusterThresholds.Items() {
+		throttler.mysqlClusterThresholds.Set(clusterName, throttler.MetricsThreshold.Get(), cache.DefaultExpiration)
+	}
+
+	for appName := range previousConfig.GetThrottledApps() {
+		if _, ok := throttlerConfig.ThrottledApps[appName]; !ok {
+			throttler.UnthrottleApp(appName)
+		}
+	}
+	for appName, appRule := range throttlerConfig.ThrottledApps {
+		throttler.ThrottleApp(appName, protoutil.TimeFromProto(appRule.ExpiresAt), appRule.Ratio)
+	}
+	log.Infof("Throttler: applied config %v", throttlerConfig)
 }
 
 // initThrottler initializes config
@@ -232,18 +338,30 @@ func (throttler *Throttler) initConfig(password string) {
 			},
 		},
 	}
+	metricsQuery := replicationLagQuery
 	if *throttleMetricQuery != "" {
-		throttler.metricsQuery = *throttleMetricQuery
+		metricsQuery = *throttleMetricQuery
 	}
 	if *throttleMetricThreshold != math.MaxFloat64 {
-		throttler.MetricsThreshold = sync2.NewAtomicFloat64(*throttleMetricThreshold)
+		throttler.MetricsThreshold.Set(*throttleMetricThreshold)
 	}
-	throttler.metricsQueryType = mysql.GetMetricsQueryType(throttler.metricsQuery)
+	if throttlerConfig := throttler.getThrottlerConfig(); throttlerConfig != nil {
+		if throttlerConfig.CustomQuery != "" {
+			metricsQuery = throttlerConfig.CustomQuery
+		}
+		switch {
+		case throttlerConfig.Threshold > 0:
+			throttler.MetricsThreshold.Set(throttlerConfig.Threshold)
+		case *throttleMetricThreshold == math.MaxFloat64:
+			throttler.MetricsThreshold.Set(throttleThreshold.Seconds())
+		}
+	}
+	throttler.metricsQuery.Set(metricsQuery)
 
 	config.Instance.Stores.MySQL.Clusters[selfStoreName] = &config.MySQLClusterConfigurationSettings{
 		User:              "", // running on local tablet server, will use vttablet DBA user
 		Password:          "", // running on local tablet server, will use vttablet DBA user
-		MetricQuery:       throttler.metricsQuery,
+		MetricQuery:       metricsQuery,
 		ThrottleThreshold: throttler.MetricsThreshold.Get(),
 		IgnoreHostsCount:  0,
 	}
@@ -251,7 +369,7 @@ func (throttler *Throttler) initConfig(password string) {
 		config.Instance.Stores.MySQL.Clusters[shardStoreName] = &config.MySQLClusterConfigurationSettings{
 			User:              throttlerUser,
 			Password:          password,
-			MetricQuery:       throttler.metricsQuery,
+			MetricQuery:       metricsQuery,
 			ThrottleThreshold: throttler.MetricsThreshold.Get(),
 			IgnoreHostsCount:  0,
 		}
@@ -361,39 +479,42 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 		Value:       0,
 		Err:         nil,
 	}
-	ctx := context.Background()
+	metricsQuery := throttler.metricsQuery.Get()
+	metric.Value, metric.Err = throttler.readSelfValue(context.Background(), metricsQuery, mysql.GetMetricsQueryType(metricsQuery))
+	return metric
+}
+
+// readSelfValue runs a metrics query on this very tablet's backend mysql, and returns its single value
+func (throttler *Throttler) readSelfValue(ctx context.Context, query string, queryType mysql.MetricsQueryType) (value float64, err error) {
 	conn, err := throttler.pool.Get(ctx)
 	if err != nil {
-		metric.Err = err
-		return metric
+		return 0, err
 	}
 	defer conn.Recycle()
 
-	tm, err := conn.Exec(ctx, throttler.metricsQuery, 1, true)
+	tm, err := conn.Exec(ctx, query, 1, true)
 	if err != nil {
-		metric.Err = err
-		return metric
+		return 0, err
 	}
 	row := tm.Named().Row()
 	if row == nil {
-		metric.Err = fmt.Errorf("no results for readSelfMySQLThrottleMetric")
-		return metric
+		return 0, fmt.Errorf("no results for query %s", query)
 	}
 
-	switch throttler.metricsQueryType {
+	switch queryType {
 	case mysql.MetricsQueryTypeSelect:
 		// We expect a single row, single column result.
 		// The "for" iteration below is just a way to get first result without knowning column name
 		for k := range row {
-			metric.Value, metric.Err = row.ToFloat64(k)
+			value, err = row.ToFloat64(k)
 		}
 	case mysql.MetricsQueryTypeShowGlobal:
-		metric.Value, metric.Err = strconv.ParseFloat(row["Value"].ToString(), 64)
+		value, err = strconv.ParseFloat(row["Value"].ToString(), 64)
 	default:
-		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", throttler.metricsQuery)
+		err = fmt.Errorf("Unsupported metrics query type for query %s", query)
 	}
 
-	return metric
+	return value, err
 }
 
 // ThrottledAppsSnapshot returns a snapshot (a copy) of current throttled apps
@@ -426,6 +547,7 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 	mysqlRefreshTicker := addTicker(mysqlRefreshInterval)
 	mysqlAggregateTicker := addTicker(mysqlAggregateInterval)
 	throttledAppsTicker := addTicker(throttledAppsSnapshotInterval)
+	customMetricsCollectTicker := addTicker(customMetricsCollectInterval)
 
 	shouldCreateThrottlerUser := false
 	for {
@@ -514,6 +636,12 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					go throttler.expireThrottledApps()
 				}
 			}
+		case <-customMetricsCollectTicker.C:
+			{
+				if atomic.LoadInt64(&throttler.isOpen) > 0 {
+					throttler.collectCustomMetrics(ctx)
+				}
+			}
 		}
 	}
 }
@@ -815,7 +943,7 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 
 // checkStore checks the aggregated value of given MySQL store
 func (throttler *Throttler) checkStore(ctx context.Context, appName string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
-	if !throttler.env.Config().EnableLagThrottler {
+	if !throttler.IsEnabled() {
 		return okMetricCheckResult
 	}
 	return throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
@@ -837,7 +965,7 @@ func (throttler *Throttler) CheckByType(ctx context.Context, appName string, rem
 	case ThrottleCheckSelf:
 		return throttler.checkSelf(ctx, appName, remoteAddr, flags)
 	case ThrottleCheckPrimaryWrite:
-		if *throttlerCheckAsCheckSelf {
+		if throttler.checkAsCheckSelf() {
 			return throttler.checkSelf(ctx, appName, remoteAddr, flags)
 		}
 		return throttler.checkShard(ctx, appName, remoteAddr, flags)
@@ -855,8 +983,10 @@ func (throttler *Throttler) Status() *ThrottlerStatus {
 		IsLeader:  (atomic.LoadInt64(&throttler.isLeader) > 0),
 		IsOpen:    (atomic.LoadInt64(&throttler.isOpen) > 0),
 		IsDormant: throttler.isDormant(),
+		IsEnabled: throttler.IsEnabled(),
 
 		AggregatedMetrics: throttler.aggregatedMetricsSnapshot(),
 		MetricsHealth:     throttler.metricsHealthSnapshot(),
+		Workflows:         throttler.WorkflowSharesMap(),
 	}
 }
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyThrottlerConfig(t *testing.T) {
+	cfg := tabletenv.NewDefaultConfig()
+	cfg.ThrottlerConfigViaTopo = true
+	env := tabletenv.NewEnv(cfg, "TestApplyThrottlerConfig")
+	throttler := NewThrottler(env, nil, nil, "zone1", func() topodatapb.TabletType { return topodatapb.TabletType_PRIMARY })
+	throttler.mysqlClusterThresholds.Set(selfStoreName, throttleThreshold.Seconds(), cache.DefaultExpiration)
+	ctx := context.Background()
+
+	// Until the config is set, the flags apply
+	throttler.applyThrottlerConfig(nil)
+	assert.False(t, throttler.IsEnabled())
+	checkResult := throttler.CheckByType(ctx, "app", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+
+	throttler.applyThrottlerConfig(&topodatapb.ThrottlerConfig{
+		Enabled:          true,
+		Threshold:        2.5,
+		CustomQuery:      "show global status like 'threads_running'",
+		CheckAsCheckSelf: true,
+		ThrottledApps: map[string]*topodatapb.ThrottledAppRule{
+			"online-ddl": {Name: "online-ddl", Ratio: 1, ExpiresAt: protoutil.TimeToProto(time.Now().Add(time.Hour))},
+		},
+	})
+	assert.True(t, throttler.IsEnabled())
+	assert.True(t, throttler.checkAsCheckSelf())
+	assert.Equal(t, "show global status like 'threads_running'", throttler.metricsQuery.Get())
+	assert.Equal(t, 2.5, throttler.MetricsThreshold.Get())
+	threshold, ok := throttler.mysqlClusterThresholds.Get(selfStoreName)
+	require.True(t, ok)
+	assert.Equal(t, 2.5, threshold)
+	assert.True(t, throttler.IsAppThrottled("online-ddl"))
+	// No metric was collected
+	checkResult = throttler.CheckByType(ctx, "app", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusNotFound, checkResult.StatusCode)
+
+	throttler.applyThrottlerConfig(&topodatapb.ThrottlerConfig{})
+	assert.False(t, throttler.IsEnabled())
+	assert.False(t, throttler.checkAsCheckSelf())
+	assert.Equal(t, replicationLagQuery, throttler.metricsQuery.Get())
+	assert.Equal(t, throttleThreshold.Seconds(), throttler.MetricsThreshold.Get())
+	assert.False(t, throttler.IsAppThrottled("online-ddl"))
+	checkResult = throttler.CheckByType(ctx, "app", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+}
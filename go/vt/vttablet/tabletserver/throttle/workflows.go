@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/textutil"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+)
+
+const (
+	// activeWorkflowExpiration is the time after its last check when a workflow is no longer considered running
+	activeWorkflowExpiration = 5 * time.Second
+	activeWorkflowCleanup    = 10 * time.Second
+)
+
+var (
+	throttleWorkflowPriorities = flag.String("throttle_workflow_priorities", "online-ddl=1,vreplication=1", "Comma separated priorities of the apps which run workflows, such as online DDL migrations and VReplication streams. The workflows running at the same time share the threshold of the throttler in proportion of their priorities. example: 'online-ddl=2,vreplication=1'")
+)
+
+// parseWorkflowPriorities parses a comma separated list of `app=priority`
+func parseWorkflowPriorities(value string) (map[string]int, error) {
+	priorities := make(map[string]int)
+	for _, token := range textutil.SplitDelimitedList(value) {
+		tokens := strings.Split(token, "=")
+		if len(tokens) != 2 || strings.TrimSpace(tokens[0]) == "" {
+			return nil, fmt.Errorf("invalid workflow priority %s, expected app=priority", token)
+		}
+		appName := strings.TrimSpace(tokens[0])
+		priority, err := strconv.Atoi(strings.TrimSpace(tokens[1]))
+		if err != nil || priority <= 0 {
+			return nil, fmt.Errorf("invalid priority for app %s: %s", appName, tokens[1])
+		}
+		priorities[appName] = priority
+	}
+	return priorities, nil
+}
+
+// initWorkflowPriorities reads the user supplied throttle_workflow_priorities and sets these
+// for the duration of this tablet's lifetime
+func (throttler *Throttler) initWorkflowPriorities() {
+	priorities, err := parseWorkflowPriorities(*throttleWorkflowPriorities)
+	if err != nil {
+		log.Errorf("Throttler: ignoring -throttle_workflow_priorities: %v", err)
+		return
+	}
+	throttler.workflowPriorities = priorities
+}
+
+// workflowPriority returns the priority of the workflow an app runs, if it runs one. The app may be a colon
+// separated list of app names, like IsAppThrottled supports, in which case the most specific name with a
+// priority applies: e.g. the priority of `online-ddl` applies to `vreplication:online-ddl:<uuid>`.
+func (throttler *Throttler) workflowPriority(appName string) (priority int, ok bool) {
+	if priority, ok := throttler.workflowPriorities[appName]; ok {
+		return priority, true
+	}
+	singleAppNames := strings.Split(appName, ":")
+	for i := len(singleAppNames) - 1; i >= 0; i-- {
+		if priority, ok := throttler.workflowPriorities[singleAppNames[i]]; ok {
+			return priority, true
+		}
+	}
+	return 0, false
+}
+
+// workflowShare takes note that a workflow is running, and returns the share of the threshold it gets among
+// the workflows running at the same time. An app which does not run a workflow gets the whole threshold.
+func (throttler *Throttler) workflowShare(appName string) float64 {
+	priority, ok := throttler.workflowPriority(appName)
+	if !ok {
+		return 1
+	}
+	throttler.activeWorkflows.SetDefault(appName, priority)
+	totalPriority := 0
+	for _, item := range throttler.activeWorkflows.Items() {
+		totalPriority += item.Object.(int)
+	}
+	return float64(priority) / float64(totalPriority)
+}
+
+// WorkflowSharesMap returns a (copy) map of the running workflows, with their share of the threshold
+func (throttler *Throttler) WorkflowSharesMap() (result map[string](*base.WorkflowShare)) {
+	result = make(map[string](*base.WorkflowShare))
+
+	items := throttler.activeWorkflows.Items()
+	totalPriority := 0
+	for _, item := range items {
+		totalPriority += item.Object.(int)
+	}
+	for appName, item := range items {
+		priority := item.Object.(int)
+		result[appName] = base.NewWorkflowShare(priority, float64(priority)/float64(totalPriority))
+	}
+	return result
+}
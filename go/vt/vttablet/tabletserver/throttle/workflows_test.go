@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorkflowPriorities(t *testing.T) {
+	priorities, err := parseWorkflowPriorities("online-ddl=2, vreplication=1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"online-ddl": 2, "vreplication": 1}, priorities)
+
+	priorities, err = parseWorkflowPriorities("")
+	require.NoError(t, err)
+	assert.Empty(t, priorities)
+
+	for _, value := range []string{"online-ddl", "=1", "online-ddl=0", "online-ddl=1.5", "online-ddl=x"} {
+		_, err := parseWorkflowPriorities(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestWorkflowShares(t *testing.T) {
+	throttler := newCustomMetricsThrottler(nil, nil)
+	throttler.workflowPriorities = map[string]int{"online-ddl": 3, "vreplication": 1}
+
+	priority, ok := throttler.workflowPriority("vreplication:online-ddl:8a797518_f5ef_11eb_9d3c_0a43f95f28a3")
+	assert.True(t, ok)
+	assert.Equal(t, 3, priority)
+	_, ok = throttler.workflowPriority("tablegc")
+	assert.False(t, ok)
+
+	// a single workflow gets the whole threshold
+	assert.Equal(t, 1.0, throttler.workflowShare("vreplication:commerce2customer"))
+	assert.Equal(t, 1.0, throttler.workflowShare("tablegc"))
+	assert.Equal(t, 0.75, throttler.workflowShare("online-ddl:gh-ost:8a797518_f5ef_11eb_9d3c_0a43f95f28a3"))
+	assert.Equal(t, 0.25, throttler.workflowShare("vreplication:commerce2customer"))
+	assert.Equal(t, map[string]*base.WorkflowShare{
+		"online-ddl:gh-ost:8a797518_f5ef_11eb_9d3c_0a43f95f28a3": {Priority: 3, Share: 0.75},
+		"vreplication:commerce2customer":                         {Priority: 1, Share: 0.25},
+	}, throttler.WorkflowSharesMap())
+}
+
+func TestCheckWorkflowShares(t *testing.T) {
+	throttler := newCustomMetricsThrottler(nil, nil)
+	throttler.workflowPriorities = map[string]int{"online-ddl": 1, "vreplication": 1}
+	lagFunc := func() (base.MetricResult, float64) {
+		return base.NewSimpleMetricResult(0.6), 1
+	}
+	lowPriorityFlags := &CheckFlags{LowPriority: true}
+
+	checkResult := throttler.check.checkAppMetricResult(context.Background(), "vreplication:commerce2customer", "mysql", "shard", lagFunc, lowPriorityFlags)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Equal(t, 1.0, checkResult.Threshold)
+
+	// the workflows share the threshold while they run at the same time
+	checkResult = throttler.check.checkAppMetricResult(context.Background(), "online-ddl:gh-ost:8a797518_f5ef_11eb_9d3c_0a43f95f28a3", "mysql", "shard", lagFunc, lowPriorityFlags)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, 0.5, checkResult.Threshold)
+
+	// apps which do not run workflows keep the whole threshold
+	checkResult = throttler.check.checkAppMetricResult(context.Background(), "app", "mysql", "shard", lagFunc, StandardCheckFlags)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Equal(t, 1.0, checkResult.Threshold)
+}
@@ -56,6 +56,18 @@ type (
 		Autocommit      bool
 		Conclusion      string
 		LogToFile       bool
+		// WrittenTables are the tables modified by the transaction which
+		// are tracked by the result cache.
+		WrittenTables []string
+		// Timeout is the transaction timeout requested by the session. It
+		// is zero if the transaction uses the timeout of the pool.
+		Timeout time.Duration
+		// RowsAffected is the number of rows modified by the transaction.
+		RowsAffected int64
+		// StatementBytes is the total size of the statements which modified
+		// rows. It approximates the size of the binlog events of the
+		// transaction, which are only written when it commits.
+		StatementBytes int64
 
 		Stats *servenv.TimingsWrapper
 	}
@@ -121,6 +133,15 @@ func (p *Properties) RecordQuery(query string) {
 	p.Queries = append(p.Queries, query)
 }
 
+// RecordWrite records the rows modified by a query of this transaction.
+func (p *Properties) RecordWrite(query string, rowsAffected uint64) {
+	if p == nil || rowsAffected == 0 {
+		return
+	}
+	p.RowsAffected += int64(rowsAffected)
+	p.StatementBytes += int64(len(query))
+}
+
 // InTransaction returns true as soon as this struct is not nil
 func (p *Properties) InTransaction() bool { return p != nil }
 
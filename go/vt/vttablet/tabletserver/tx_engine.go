@@ -244,6 +244,12 @@ func (te *TxEngine) Commit(ctx context.Context, transactionID int64) (int64, str
 	var query string
 	var err error
 	connID, err := te.txFinish(transactionID, tx.TxCommit, func(conn *StatefulConnection) error {
+		if err := te.txPool.largeTxs.check(conn.TxProperties()); err != nil {
+			if rollbackErr := te.txPool.Rollback(ctx, conn); rollbackErr != nil {
+				log.Errorf("tried to rollback a large transaction, but failed with: %v", rollbackErr)
+			}
+			return err
+		}
 		query, err = te.txPool.Commit(ctx, conn)
 		return err
 	})
@@ -218,6 +218,69 @@ func TestTxEngineRenewFails(t *testing.T) {
 	assert.True(t, dbConn.IsClosed(), "underlying connection was not closed")
 }
 
+func TestTxEngineLargeTransactions(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("update t set a = 1", &sqltypes.Result{RowsAffected: 3})
+	db.AddQuery("select * from t", &sqltypes.Result{})
+	config := tabletenv.NewDefaultConfig()
+	config.DB = newDBConfigs(db)
+	config.LargeTransaction.Mode = tabletenv.Dryrun
+	config.LargeTransaction.MaxRows = 5
+	te := NewTxEngine(tabletenv.NewEnv(config, "TabletServerTest"))
+	te.AcceptReadWrite()
+	defer te.Close()
+	te.txPool.env.Stats().LargeTransactions.ResetAll()
+
+	execInTransaction := func(queries ...string) error {
+		connID, _, err := te.Begin(ctx, nil, 0, &querypb.ExecuteOptions{})
+		require.NoError(t, err)
+		conn, err := te.txPool.GetAndLock(connID, "for test")
+		require.NoError(t, err)
+		for _, query := range queries {
+			_, err := conn.Exec(ctx, query, 1, false)
+			require.NoError(t, err)
+			conn.TxProperties().RecordQuery(query)
+		}
+		conn.Unlock()
+		_, _, err = te.Commit(ctx, connID)
+		return err
+	}
+
+	// Reads do not count.
+	require.NoError(t, execInTransaction("update t set a = 1", "select * from t", "select * from t"))
+	assert.Empty(t, te.txPool.largeTxs.Recent())
+
+	// In dry run mode, large transactions commit.
+	db.ResetQueryLog()
+	require.NoError(t, execInTransaction("update t set a = 1", "update t set a = 1"))
+	assert.Contains(t, db.QueryLog(), "commit")
+	recent := te.txPool.largeTxs.Recent()
+	require.Len(t, recent, 1)
+	assert.EqualValues(t, 6, recent[0].RowsAffected)
+	assert.EqualValues(t, 2*len("update t set a = 1"), recent[0].StatementBytes)
+	assert.Equal(t, []string{"Rows"}, recent[0].Exceeded)
+	assert.False(t, recent[0].Refused)
+	assert.Equal(t, []string{"update t set a = 1", "update t set a = 1"}, recent[0].Queries)
+	assert.EqualValues(t, 1, te.txPool.env.Stats().LargeTransactions.Counts()["Rows"])
+
+	// When enabled, their commit is refused and they are rolled back.
+	te.txPool.largeTxs.config.Mode = tabletenv.Enable
+	te.txPool.largeTxs.config.MaxStatementBytes = 10
+	db.ResetQueryLog()
+	err := execInTransaction("update t set a = 1", "update t set a = 1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "large transaction refused and rolled back: it modified 6 rows, more than 5, its statements which modified rows total 36 bytes, more than 10; split it into transactions modifying at most 5 rows each")
+	assert.Contains(t, db.QueryLog(), "rollback")
+	assert.NotContains(t, db.QueryLog(), "commit")
+	recent = te.txPool.largeTxs.Recent()
+	require.Len(t, recent, 2)
+	assert.Equal(t, []string{"Rows", "StatementBytes"}, recent[0].Exceeded)
+	assert.True(t, recent[0].Refused)
+	assert.EqualValues(t, 2, te.txPool.env.Stats().LargeTransactions.Counts()["Rows"])
+	assert.EqualValues(t, 1, te.txPool.env.Stats().LargeTransactions.Counts()["StatementBytes"])
+}
+
 type TxType int
 
 const (
@@ -63,6 +63,13 @@ func (txe *TxExecutor) Prepare(transactionID int64, dtid string) error {
 		return nil
 	}
 
+	// A prepared transaction must commit, so large transactions are refused
+	// before they are prepared.
+	if err := txe.te.txPool.largeTxs.check(conn.TxProperties()); err != nil {
+		txe.te.txPool.RollbackAndRelease(txe.ctx, conn)
+		return err
+	}
+
 	err = txe.te.preparedPool.Put(conn, dtid)
 	if err != nil {
 		txe.te.txPool.RollbackAndRelease(txe.ctx, conn)
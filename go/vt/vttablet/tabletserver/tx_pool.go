@@ -61,12 +61,21 @@ type (
 		env                tabletenv.Env
 		scp                *StatefulConnectionPool
 		transactionTimeout sync2.AtomicDuration
-		ticks              *timer.Timer
-		limiter            txlimiter.TxLimiter
+		// maxTransactionTimeout bounds the transaction timeouts requested
+		// by the sessions.
+		maxTransactionTimeout sync2.AtomicDuration
+		ticks                 *timer.Timer
+		limiter               txlimiter.TxLimiter
 
 		logMu   sync.Mutex
 		lastLog time.Time
 		txStats *servenv.TimingsWrapper
+
+		// resultCache is notified of the completion of the transactions
+		// which modified its tables. It is nil if disabled.
+		resultCache *resultCache
+		// largeTxs detects the large transactions before their commit.
+		largeTxs *largeTransactions
 	}
 	queries struct {
 		setIsolationLevel string
@@ -79,12 +88,14 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 	config := env.Config()
 	transactionTimeout := config.Oltp.TxTimeoutSeconds.Get()
 	axp := &TxPool{
-		env:                env,
-		scp:                NewStatefulConnPool(env),
-		transactionTimeout: sync2.NewAtomicDuration(transactionTimeout),
-		ticks:              timer.NewTimer(transactionTimeout / 10),
-		limiter:            limiter,
-		txStats:            env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
+		env:                   env,
+		scp:                   NewStatefulConnPool(env),
+		transactionTimeout:    sync2.NewAtomicDuration(transactionTimeout),
+		maxTransactionTimeout: sync2.NewAtomicDuration(config.Oltp.MaxTxTimeoutSeconds.Get()),
+		ticks:                 timer.NewTimer(transactionTimeout / 10),
+		limiter:               limiter,
+		txStats:               env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
+		largeTxs:              newLargeTransactions(env),
 	}
 	// Careful: conns also exports name+"xxx" vars,
 	// but we know it doesn't export Timeout.
@@ -122,8 +133,12 @@ func (tp *TxPool) Shutdown(ctx context.Context) {
 
 func (tp *TxPool) transactionKiller() {
 	defer tp.env.LogError()
-	for _, conn := range tp.scp.GetOutdated(tp.Timeout(), "for tx killer rollback") {
-		log.Warningf("killing transaction (exceeded timeout: %v): %s", tp.Timeout(), conn.String())
+	for _, conn := range tp.scp.GetExpired(tp.Timeout(), "for tx killer rollback") {
+		timeout := tp.Timeout()
+		if conn.txProps != nil && conn.txProps.Timeout > 0 {
+			timeout = conn.txProps.Timeout
+		}
+		log.Warningf("killing transaction (exceeded timeout: %v): %s", timeout, conn.String())
 		switch {
 		case conn.IsTainted():
 			conn.Close()
@@ -142,7 +157,7 @@ func (tp *TxPool) transactionKiller() {
 		if conn.IsInTransaction() {
 			tp.txComplete(conn, tx.TxKill)
 		}
-		conn.Releasef("exceeded timeout: %v", tp.Timeout())
+		conn.Releasef("exceeded timeout: %v", timeout)
 	}
 }
 
@@ -227,8 +242,11 @@ func (tp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions, re
 	span, ctx := trace.NewSpan(ctx, "TxPool.Begin")
 	defer span.Finish()
 
+	timeout, err := tp.txTimeout(options)
+	if err != nil {
+		return nil, "", err
+	}
 	var conn *StatefulConnection
-	var err error
 	if reservedID != 0 {
 		conn, err = tp.scp.GetAndLock(reservedID, "start transaction on reserve conn")
 		if err != nil {
@@ -258,6 +276,9 @@ func (tp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions, re
 		conn.Release(tx.ConnInitFail)
 		return nil, "", err
 	}
+	if timeout != tp.Timeout() {
+		conn.txProps.Timeout = timeout
+	}
 	return conn, sql, nil
 }
 
@@ -344,6 +365,26 @@ func (tp *TxPool) Timeout() time.Duration {
 	return tp.transactionTimeout.Get()
 }
 
+// txTimeout returns the timeout of a transaction started with the
+// options. The sessions can request a timeout up to the maximum transaction
+// timeout, or up to the transaction timeout if it is larger.
+func (tp *TxPool) txTimeout(options *querypb.ExecuteOptions) (time.Duration, error) {
+	timeout := tp.Timeout()
+	requested := time.Duration(options.GetTransactionTimeout()) * time.Millisecond
+	if requested <= 0 {
+		return timeout, nil
+	}
+	max := tp.maxTransactionTimeout.Get()
+	if max < timeout {
+		max = timeout
+	}
+	// A zero timeout means the transactions are never killed.
+	if timeout != 0 && requested > max {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "transaction timeout %v exceeds the maximum transaction timeout %v", requested, max)
+	}
+	return requested, nil
+}
+
 // SetTimeout sets the transaction timeout.
 func (tp *TxPool) SetTimeout(timeout time.Duration) {
 	tp.transactionTimeout.Set(timeout)
@@ -353,5 +394,8 @@ func (tp *TxPool) SetTimeout(timeout time.Duration) {
 func (tp *TxPool) txComplete(conn *StatefulConnection, reason tx.ReleaseReason) {
 	conn.LogTransaction(reason)
 	tp.limiter.Release(conn.TxProperties().ImmediateCaller, conn.TxProperties().EffectiveCaller)
+	if tables := conn.TxProperties().WrittenTables; tp.resultCache != nil && len(tables) > 0 {
+		tp.resultCache.endWrite(tables)
+	}
 	conn.CleanTxState()
 }
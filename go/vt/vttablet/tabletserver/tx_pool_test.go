@@ -427,6 +427,53 @@ func TestTxTimeoutKillsTransactions(t *testing.T) {
 		}, limiter.Actions())
 }
 
+func TestTxTimeoutOverride(t *testing.T) {
+	env := newEnv("TabletServerTest")
+	env.Config().Oltp.TxTimeoutSeconds = 0.5
+	env.Config().Oltp.MaxTxTimeoutSeconds = 2
+	_, txPool, _, closer := setupWithEnv(t, env)
+	defer closer()
+	startingKills := txPool.env.Stats().KillCounters.Counts()["Transactions"]
+
+	// The timeout cannot exceed the maximum.
+	_, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{TransactionTimeout: 3000}, false, 0, nil)
+	require.Error(t, err)
+	require.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	require.Contains(t, err.Error(), "transaction timeout 3s exceeds the maximum transaction timeout 2s")
+
+	conn1, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	conn1.Unlock()
+	conn2, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{TransactionTimeout: 1500}, false, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1500*time.Millisecond, conn2.txProps.Timeout)
+	conn2.Unlock()
+	conn3, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{TransactionTimeout: 100}, false, 0, nil)
+	require.NoError(t, err)
+	conn3.Unlock()
+
+	// Only the transactions with the default and the shorter timeouts are
+	// killed.
+	time.Sleep(800 * time.Millisecond)
+	require.Equal(t, int64(2), txPool.env.Stats().KillCounters.Counts()["Transactions"]-startingKills)
+	conn, err := txPool.GetAndLock(conn2.ReservedID(), "")
+	require.NoError(t, err)
+	txPool.RollbackAndRelease(ctx, conn)
+}
+
+func TestTxTimeoutOverrideUnlimited(t *testing.T) {
+	env := newEnv("TabletServerTest")
+	env.Config().Oltp.TxTimeoutSeconds = 0
+	_, txPool, _, closer := setupWithEnv(t, env)
+	defer closer()
+
+	// The transactions are never killed, any timeout is allowed.
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{TransactionTimeout: 3600 * 1000}, false, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, conn.txProps.Timeout)
+	txPool.RollbackAndRelease(ctx, conn)
+}
+
 func newTxPool() (*TxPool, *fakeLimiter) {
 	return newTxPoolWithEnv(newEnv("TabletServerTest"))
 }
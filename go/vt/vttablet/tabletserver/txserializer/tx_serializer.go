@@ -19,8 +19,10 @@ limitations under the License.
 package txserializer
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 	"sync"
 	"time"
 
@@ -30,6 +32,7 @@ import (
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -77,8 +80,11 @@ type TxSerializer struct {
 	// been rejected due to exceeding the max queue size per row (range).
 	//
 	// globalQueueExceeded is the same as queueExceeded but for the global queue.
-	waits, waitsDryRun, queueExceeded, queueExceededDryRun *stats.CountersWithSingleLabel
-	globalQueueExceeded, globalQueueExceededDryRun         *stats.Counter
+	//
+	// waitTimeouts counts per table how many transactions were rejected because
+	// they waited longer than the wait timeout of their override.
+	waits, waitsDryRun, queueExceeded, queueExceededDryRun, waitTimeouts *stats.CountersWithSingleLabel
+	globalQueueExceeded, globalQueueExceededDryRun                       *stats.Counter
 
 	log                          *logutil.ThrottledLogger
 	logDryRun                    *logutil.ThrottledLogger
@@ -89,12 +95,15 @@ type TxSerializer struct {
 	mu         sync.Mutex
 	queues     map[string]*queue
 	globalSize int
+	// overrides take precedence over the immutable limits above for the
+	// row ranges they match. They can be changed with SetOverrides.
+	overrides []*override
 }
 
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
-	return &TxSerializer{
+	txs := &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
 		dryRun:                 config.HotRowProtection.Mode == tabletenv.Dryrun,
@@ -117,6 +126,10 @@ func New(env tabletenv.Env) *TxSerializer {
 			"TxSerializerQueueExceededDryRun",
 			"Dry-run Number of transactions that were rejected because the max queue size was exceeded",
 			"table_name"),
+		waitTimeouts: env.Exporter().NewCountersWithSingleLabel(
+			"TxSerializerWaitTimeouts",
+			"Number of transactions that were rejected because they were queued for longer than the wait timeout",
+			"table_name"),
 		globalQueueExceeded: env.Exporter().NewCounter(
 			"TxSerializerGlobalQueueExceeded",
 			"Number of transactions that were rejected on the global queue because of exceeding the max queue size per row range"),
@@ -130,7 +143,57 @@ func New(env tabletenv.Env) *TxSerializer {
 		logGlobalQueueExceededDryRun: logutil.NewThrottledLogger("HotRowProtection GlobalQueueExceeded DryRun", 5*time.Second),
 		queues:                       make(map[string]*queue),
 	}
+	if err := txs.SetOverrides(config.HotRowProtection.Overrides); err != nil {
+		// The config was verified at startup.
+		log.Errorf("Ignoring the hot row protection overrides: %v", err)
+	}
+	return txs
+}
 
+// SetOverrides replaces the per table and per row range limits. Row ranges
+// which already have queued transactions keep their limits until their
+// queue is empty.
+func (txs *TxSerializer) SetOverrides(configs []tabletenv.HotRowProtectionOverride) error {
+	overrides := make([]*override, 0, len(configs))
+	for _, config := range configs {
+		o, err := txs.newOverride(config)
+		if err != nil {
+			return err
+		}
+		overrides = append(overrides, o)
+	}
+
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+	txs.overrides = overrides
+	return nil
+}
+
+// Overrides returns the current per table and per row range limits.
+func (txs *TxSerializer) Overrides() []tabletenv.HotRowProtectionOverride {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	configs := make([]tabletenv.HotRowProtectionOverride, 0, len(txs.overrides))
+	for _, o := range txs.overrides {
+		configs = append(configs, o.config)
+	}
+	return configs
+}
+
+// overrideLocked returns the first override which matches the row range, or
+// nil if the default limits apply.
+func (txs *TxSerializer) overrideLocked(key, table string) *override {
+	for _, o := range txs.overrides {
+		if o.config.Table != "" && o.config.Table != table {
+			continue
+		}
+		if o.pattern != nil && !o.pattern.MatchString(key) {
+			continue
+		}
+		return o
+	}
+	return nil
 }
 
 // DoneFunc is returned by Wait() and must be called by the caller.
@@ -165,11 +228,21 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	q, ok := txs.queues[key]
 	if !ok {
 		// First transaction in the queue i.e. we don't wait and return immediately.
-		txs.queues[key] = newQueueForFirstTransaction(txs.concurrentTransactions)
+		o := txs.overrideLocked(key, table)
+		txs.queues[key] = newQueueForFirstTransaction(o)
 		txs.globalSize++
+		if o != nil {
+			o.size++
+		}
 		return false, nil
 	}
 
+	maxQueueSize, concurrentTransactions := txs.maxQueueSize, txs.concurrentTransactions
+	var waitTimeout time.Duration
+	if o := q.override; o != nil {
+		maxQueueSize, concurrentTransactions, waitTimeout = o.maxQueueSize, o.concurrentTransactions, o.waitTimeout
+	}
+
 	if txs.globalSize >= txs.maxGlobalQueueSize {
 		if txs.dryRun {
 			txs.globalQueueExceededDryRun.Add(1)
@@ -181,14 +254,25 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 		}
 	}
 
-	if q.size >= txs.maxQueueSize {
+	if o := q.override; o != nil && o.maxGlobalQueueSize > 0 && o.size >= o.maxGlobalQueueSize {
+		if txs.dryRun {
+			txs.queueExceededDryRun.Add(table, 1)
+			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the rows of override '%v'", o.size, o.maxGlobalQueueSize, o)
+		} else {
+			txs.queueExceeded.Add(table, 1)
+			return false, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+				"hot row protection: too many queued transactions (%d >= %d) for the rows of override '%v'", o.size, o.maxGlobalQueueSize, o)
+		}
+	}
+
+	if q.size >= maxQueueSize {
 		if txs.dryRun {
 			txs.queueExceededDryRun.Add(table, 1)
-			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, maxQueueSize, key)
 		} else {
 			txs.queueExceeded.Add(table, 1)
 			return false, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
-				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, maxQueueSize, key)
 		}
 	}
 
@@ -197,7 +281,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 		// first time.
 
 		// As an optimization, we deferred the creation of the channel until now.
-		q.availableSlots = make(chan struct{}, txs.concurrentTransactions)
+		q.availableSlots = make(chan struct{}, concurrentTransactions)
 		q.availableSlots <- struct{}{}
 
 		// Include first transaction in the count at /debug/hotrows. (It was not
@@ -206,6 +290,9 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	}
 
 	txs.globalSize++
+	if q.override != nil {
+		q.override.size++
+	}
 	q.size++
 	q.count++
 	if q.size > q.max {
@@ -235,11 +322,21 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 
 	// Blocking wait for the next available slot.
 	txs.waits.Add(table, 1)
+	var timeout <-chan time.Time
+	if waitTimeout > 0 {
+		timer := time.NewTimer(waitTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
 	select {
 	case q.availableSlots <- struct{}{}:
 		return true, nil
 	case <-ctx.Done():
 		return true, ctx.Err()
+	case <-timeout:
+		txs.waitTimeouts.Add(table, 1)
+		return true, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+			"hot row protection: queued for longer than %v for the same row (table + WHERE clause: '%v')", waitTimeout, key)
 	}
 }
 
@@ -254,6 +351,9 @@ func (txs *TxSerializer) unlockLocked(key string, returnSlot bool) {
 	q := txs.queues[key]
 	q.size--
 	txs.globalSize--
+	if q.override != nil {
+		q.override.size--
+	}
 
 	if q.size == 0 {
 		// This is the last transaction in flight.
@@ -335,6 +435,86 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 	}
 }
 
+// ServeConfigHTTP displays the per table and per row range limits as JSON.
+// A POST request with a JSON list of overrides replaces them.
+func (txs *TxSerializer) ServeConfigHTTP(response http.ResponseWriter, request *http.Request) {
+	role := acl.DEBUGGING
+	if request.Method == http.MethodPost {
+		role = acl.ADMIN
+	}
+	if err := acl.CheckAccessHTTP(request, role); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	if request.Method == http.MethodPost {
+		var configs []tabletenv.HotRowProtectionOverride
+		if err := json.NewDecoder(request.Body).Decode(&configs); err != nil {
+			http.Error(response, fmt.Sprintf("invalid overrides: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := txs.SetOverrides(configs); err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	data, err := json.MarshalIndent(txs.Overrides(), "", "  ")
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json")
+	response.Write(data)
+}
+
+// override holds the limits of the row ranges matching a
+// tabletenv.HotRowProtectionOverride.
+type override struct {
+	config  tabletenv.HotRowProtectionOverride
+	pattern *regexp.Regexp
+
+	maxQueueSize           int
+	maxGlobalQueueSize     int
+	concurrentTransactions int
+	waitTimeout            time.Duration
+
+	// size counts how many transactions are currently queued/in flight for
+	// all the row ranges of the override.
+	// NOTE: This field is guarded by TxSerializer.mu.
+	size int
+}
+
+// newOverride returns the override for the config. Zero limits are inherited
+// from the TxSerializer.
+func (txs *TxSerializer) newOverride(config tabletenv.HotRowProtectionOverride) (*override, error) {
+	if err := config.Verify(); err != nil {
+		return nil, err
+	}
+	o := &override{
+		config:                 config,
+		maxQueueSize:           txs.maxQueueSize,
+		maxGlobalQueueSize:     config.MaxGlobalQueueSize,
+		concurrentTransactions: txs.concurrentTransactions,
+		waitTimeout:            config.WaitTimeoutSeconds.Get(),
+	}
+	if config.Pattern != "" {
+		o.pattern = regexp.MustCompile(config.Pattern)
+	}
+	if config.MaxQueueSize > 0 {
+		o.maxQueueSize = config.MaxQueueSize
+	}
+	if config.MaxConcurrency > 0 {
+		o.concurrentTransactions = config.MaxConcurrency
+	}
+	return o, nil
+}
+
+func (o *override) String() string {
+	if o.config.Pattern == "" {
+		return o.config.Table
+	}
+	return fmt.Sprintf("%s %s", o.config.Table, o.config.Pattern)
+}
+
 // queue represents the local queue for a particular row (range).
 //
 // Note that we don't use a dedicated queue structure for all waiting
@@ -362,12 +542,17 @@ type queue struct {
 	// NOTE: As an optimization, we defer the creation of the channel until
 	// a second transaction for the same hot row is running.
 	availableSlots chan struct{}
+
+	// override holds the limits of the row range. It is nil if the default
+	// limits apply.
+	override *override
 }
 
-func newQueueForFirstTransaction(concurrentTransactions int) *queue {
+func newQueueForFirstTransaction(o *override) *queue {
 	return &queue{
-		size:  1,
-		count: 1,
-		max:   1,
+		size:     1,
+		count:    1,
+		max:      1,
+		override: o,
 	}
 }
@@ -39,6 +39,7 @@ func resetVariables(txs *TxSerializer) {
 	txs.waitsDryRun.ResetAll()
 	txs.queueExceeded.ResetAll()
 	txs.queueExceededDryRun.ResetAll()
+	txs.waitTimeouts.ResetAll()
 	txs.globalQueueExceeded.Reset()
 	txs.globalQueueExceededDryRun.Reset()
 }
@@ -521,6 +522,197 @@ func TestTxSerializerPending(t *testing.T) {
 	}
 }
 
+func TestTxSerializerOverrides(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 5
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 1
+	config.HotRowProtection.Overrides = []tabletenv.HotRowProtectionOverride{{
+		Table:        "counters",
+		MaxQueueSize: 1,
+	}, {
+		Pattern:        "^t2 where id = 1$",
+		MaxConcurrency: 2,
+	}}
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	// The queue of the counters table is limited to 1.
+	done1, _, err1 := txs.Wait(context.Background(), "counters where1", "counters")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	_, _, err2 := txs.Wait(context.Background(), "counters where1", "counters")
+	if got, want := vterrors.Code(err2), vtrpcpb.Code_RESOURCE_EXHAUSTED; got != want {
+		t.Errorf("wrong error code: got = %v, want = %v", got, want)
+	}
+	if got, want := err2.Error(), "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'counters where1')"; got != want {
+		t.Errorf("wrong error message: got = %v, want = %v", got, want)
+	}
+	done1()
+
+	// Two transactions of the row matching the pattern can run concurrently.
+	done1, _, err1 = txs.Wait(context.Background(), "t2 where id = 1", "t2")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	done2, waited2, err2 := txs.Wait(context.Background(), "t2 where id = 1", "t2")
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+	if waited2 {
+		t.Error("tx2 must not wait")
+	}
+	done2()
+	done1()
+
+	// Other rows keep the default limits.
+	if err := testDefaultLimits(txs, "t2 where id = 2", "t2"); err != nil {
+		t.Error(err)
+	}
+
+	// The overrides can be changed at runtime.
+	if err := txs.SetOverrides(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := testDefaultLimits(txs, "counters where1", "counters"); err != nil {
+		t.Error(err)
+	}
+	if err := txs.SetOverrides([]tabletenv.HotRowProtectionOverride{{MaxQueueSize: 1}}); err == nil {
+		t.Error("an override without table and pattern must be rejected")
+	}
+}
+
+// testDefaultLimits checks that a second transaction for the row range is
+// queued, instead of being rejected or let through.
+func testDefaultLimits(txs *TxSerializer, key, table string) error {
+	done1, _, err1 := txs.Wait(context.Background(), key, table)
+	if err1 != nil {
+		return err1
+	}
+	defer done1()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, waited2, err2 := txs.Wait(ctx, key, table)
+	if !waited2 || err2 != context.DeadlineExceeded {
+		return fmt.Errorf("tx2 must wait until its context is done: waited = %v, err = %v", waited2, err2)
+	}
+	return nil
+}
+
+func TestTxSerializerOverrideGlobalQueueSize(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 5
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 5
+	config.HotRowProtection.Overrides = []tabletenv.HotRowProtectionOverride{{
+		Table:              "t1",
+		MaxGlobalQueueSize: 2,
+	}}
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	done1, _, err1 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	defer done1()
+	done2, _, err2 := txs.Wait(context.Background(), "t1 where2", "t1")
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+	defer done2()
+
+	// The rows of t1 already have 2 queued transactions.
+	_, _, err3 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if got, want := vterrors.Code(err3), vtrpcpb.Code_RESOURCE_EXHAUSTED; got != want {
+		t.Errorf("wrong error code: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.queueExceeded.Counts()["t1"], int64(1); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+
+	// The other tables are not affected.
+	done4, _, err4 := txs.Wait(context.Background(), "t2 where1", "t2")
+	if err4 != nil {
+		t.Fatal(err4)
+	}
+	done4()
+}
+
+func TestTxSerializerOverrideWaitTimeout(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 5
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 1
+	config.HotRowProtection.Overrides = []tabletenv.HotRowProtectionOverride{{
+		Table:              "t1",
+		WaitTimeoutSeconds: 0.01,
+	}}
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	done1, _, err1 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	_, waited2, err2 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if !waited2 {
+		t.Error("tx2 must wait")
+	}
+	if got, want := vterrors.Code(err2), vtrpcpb.Code_RESOURCE_EXHAUSTED; got != want {
+		t.Errorf("wrong error code: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.waitTimeouts.Counts()["t1"], int64(1); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+	done1()
+
+	if txs.queues["t1 where1"] != nil {
+		t.Error("queue object was not deleted after last transaction")
+	}
+}
+
+func TestTxSerializerConfigHTTP(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+
+	req, err := http.NewRequest("POST", "/debug/hotrows/config", strings.NewReader(`[{"table": "counters", "maxQueueSize": 3, "waitTimeoutSeconds": 2}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	txs.ServeConfigHTTP(rr, req)
+	if got, want := rr.Code, http.StatusOK; got != want {
+		t.Fatalf("wrong status: got = %v, want = %v: %s", got, want, rr.Body.String())
+	}
+	want := []tabletenv.HotRowProtectionOverride{{Table: "counters", MaxQueueSize: 3, WaitTimeoutSeconds: 2}}
+	if got := txs.Overrides(); len(got) != 1 || got[0] != want[0] {
+		t.Errorf("wrong overrides: got = %v, want = %v", got, want)
+	}
+
+	req, err = http.NewRequest("POST", "/debug/hotrows/config", strings.NewReader(`[{"pattern": "("}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr = httptest.NewRecorder()
+	txs.ServeConfigHTTP(rr, req)
+	if got, want := rr.Code, http.StatusBadRequest; got != want {
+		t.Errorf("wrong status: got = %v, want = %v", got, want)
+	}
+
+	req, err = http.NewRequest("GET", "/debug/hotrows/config", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr = httptest.NewRecorder()
+	txs.ServeConfigHTTP(rr, req)
+	if !strings.Contains(rr.Body.String(), `"table": "counters"`) {
+		t.Errorf("overrides not displayed: %s", rr.Body.String())
+	}
+}
+
 func BenchmarkTxSerializer_NoHotRow(b *testing.B) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.MaxQueueSize = 1
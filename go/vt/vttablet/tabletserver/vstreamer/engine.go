@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
 
@@ -74,6 +75,13 @@ type Engine struct {
 	watcherOnce sync.Once
 	lvschema    *localVSchema
 
+	// invisibleColumns caches the fields of the tables with invisible
+	// columns by database and table name, see withInvisibleColumns.
+	// invisibleColumnsGeneration is incremented when it's invalidated.
+	invisibleColumnsMu         sync.Mutex
+	invisibleColumns           map[string][]*querypb.Field
+	invisibleColumnsGeneration int64
+
 	// stats variables
 	vschemaErrors  *stats.Counter
 	vschemaUpdates *stats.Counter
@@ -109,7 +117,8 @@ func NewEngine(env tabletenv.Env, ts srvtopo.Server, se *schema.Engine, lagThrot
 		rowStreamers:    make(map[int]*rowStreamer),
 		resultStreamers: make(map[int]*resultStreamer),
 
-		lvschema: &localVSchema{vschema: &vindexes.VSchema{}},
+		lvschema:         &localVSchema{vschema: &vindexes.VSchema{}},
+		invisibleColumns: make(map[string][]*querypb.Field),
 
 		vschemaErrors:  env.Exporter().NewCounter("VSchemaErrors", "Count of VSchema errors"),
 		vschemaUpdates: env.Exporter().NewCounter("VSchemaUpdates", "Count of VSchema updates. Does not include errors"),
@@ -144,6 +153,7 @@ func (vse *Engine) Open() {
 		return
 	}
 	log.Info("VStreamer: opening")
+	vse.se.RegisterNotifier("vstreamer", vse.schemaChanged)
 	vse.isOpen = true
 }
 
@@ -172,6 +182,7 @@ func (vse *Engine) Close() {
 		for _, s := range vse.resultStreamers {
 			s.Cancel()
 		}
+		vse.se.UnregisterNotifier("vstreamer")
 		vse.isOpen = false
 	}()
 
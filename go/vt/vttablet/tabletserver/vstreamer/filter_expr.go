@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ValidateFilterWhere returns an error if the where clause of the filter of a
+// stream has conditions which cannot be evaluated by the vstreamer, so that
+// the filters of the workflows are validated when they are created. The
+// columns and the in_keyrange constructs are not validated, since they depend
+// on the schema and the vschema of the source keyspace.
+func ValidateFilterWhere(where *sqlparser.Where) error {
+	if where == nil {
+		return nil
+	}
+	ti := &Table{Name: "filter"}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && ti.FindColumn(col.Name) == -1 {
+			ti.Fields = append(ti.Fields, &querypb.Field{Name: col.Name.String(), Type: sqltypes.VarBinary})
+		}
+		return true, nil
+	}, where)
+	plan := &Plan{Table: ti}
+	for _, expr := range splitAndExpression(nil, where.Expr) {
+		if funcExpr, ok := expr.(*sqlparser.FuncExpr); ok && funcExpr.Name.EqualString("in_keyrange") {
+			continue
+		}
+		if err := plan.analyzeWhere(nil, &sqlparser.Where{Type: sqlparser.WhereClause, Expr: expr}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compileFilterExpr converts a condition of the where clause of a filter,
+// like "id in (1, val)" or "mod(tenant_id, 4) = 1 or lower(region) = 'eu'",
+// to an expression evaluated by the evalengine on the rows of the table.
+func compileFilterExpr(ti *Table, expr sqlparser.Expr) (evalengine.Expr, error) {
+	compiled, err := evalengine.Convert(expr, &filterLookup{ti: ti})
+	if err != nil {
+		if vterrors.Code(err) == vtrpcpb.Code_UNIMPLEMENTED {
+			return nil, fmt.Errorf("unsupported constraint: %v: %v", sqlparser.String(expr), err.Error())
+		}
+		return nil, err
+	}
+	return compiled, nil
+}
+
+// filterLookup resolves the columns of a condition to the columns of the
+// table of the filter.
+type filterLookup struct {
+	ti *Table
+}
+
+// ColumnLookup implements evalengine.ConverterLookup.
+func (fl *filterLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if !col.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+	}
+	return findColumn(fl.ti, col.Name)
+}
+
+// CollationIDLookup implements evalengine.ConverterLookup. The text columns
+// are compared using their collation, and the literals using the default
+// collation, which has a lower precedence. The text without a collation is
+// compared as bytes.
+func (fl *filterLookup) CollationIDLookup(expr sqlparser.Expr) collations.ID {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		coll, err := collations.Local().ResolveCollation("", "")
+		if err != nil {
+			return collations.CollationBinaryID
+		}
+		return coll.ID()
+	}
+	colnum := fl.ti.FindColumn(col.Name)
+	if colnum == -1 {
+		return collations.Unknown
+	}
+	field := fl.ti.Fields[colnum]
+	if !sqltypes.IsText(field.Type) && !sqltypes.IsBinary(field.Type) {
+		return collations.Unknown
+	}
+	if field.Charset == 0 || collations.Local().LookupByID(collations.ID(field.Charset)) == nil {
+		return collations.CollationBinaryID
+	}
+	return collations.ID(field.Charset)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// withInvisibleColumns returns the table with its invisible columns, or the
+// table itself if it has none. The invisible columns are not returned by
+// select *, so the schema engine does not know them, but they are in the
+// binlog row images and must be copied along with the other columns.
+func (vse *Engine) withInvisibleColumns(ctx context.Context, cp dbconfigs.Connector, database string, st *binlogdatapb.MinimalTable) (*binlogdatapb.MinimalTable, error) {
+	fields, err := vse.invisibleColumnsFields(ctx, cp, database, st.Name)
+	if err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return st, nil
+	}
+
+	// The primary key columns are indexes of the fields, they shift with the
+	// invisible columns.
+	var pkColumns []int64
+	for _, pk := range st.PKColumns {
+		if pk >= int64(len(st.Fields)) {
+			return nil, fmt.Errorf("primary key %d refers to non-existent column", pk)
+		}
+		for i, field := range fields {
+			if strings.EqualFold(field.Name, st.Fields[pk].Name) {
+				pkColumns = append(pkColumns, int64(i))
+				break
+			}
+		}
+	}
+	if len(pkColumns) != len(st.PKColumns) {
+		return nil, fmt.Errorf("primary key columns of %s not found in %v", st.Name, fields)
+	}
+	return &binlogdatapb.MinimalTable{
+		Name:      st.Name,
+		Fields:    fields,
+		PKColumns: pkColumns,
+	}, nil
+}
+
+// invisibleColumnsFields returns the fields of all the columns of a table,
+// or nil if it has no invisible columns. They are cached until the schema
+// engine reports a schema change, so that information_schema is not
+// queried every time a plan is built.
+func (vse *Engine) invisibleColumnsFields(ctx context.Context, cp dbconfigs.Connector, database, table string) ([]*querypb.Field, error) {
+	key := database + "." + table
+	vse.invisibleColumnsMu.Lock()
+	fields, ok := vse.invisibleColumns[key]
+	generation := vse.invisibleColumnsGeneration
+	vse.invisibleColumnsMu.Unlock()
+	if ok {
+		return fields, nil
+	}
+
+	fields, err := loadInvisibleColumnsFields(ctx, cp, database, table)
+	if err != nil {
+		return nil, err
+	}
+	vse.invisibleColumnsMu.Lock()
+	defer vse.invisibleColumnsMu.Unlock()
+	// Don't cache fields that may have been loaded before a schema change.
+	if generation == vse.invisibleColumnsGeneration {
+		vse.invisibleColumns[key] = fields
+	}
+	return fields, nil
+}
+
+// invalidateInvisibleColumns drops the cached invisible columns.
+func (vse *Engine) invalidateInvisibleColumns() {
+	vse.invisibleColumnsMu.Lock()
+	defer vse.invisibleColumnsMu.Unlock()
+	vse.invisibleColumns = make(map[string][]*querypb.Field)
+	vse.invisibleColumnsGeneration++
+}
+
+// schemaChanged is the schema engine notifier of the Engine.
+func (vse *Engine) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
+	if len(created) == 0 && len(altered) == 0 && len(dropped) == 0 {
+		return
+	}
+	vse.invalidateInvisibleColumns()
+}
+
+// loadInvisibleColumnsFields returns the fields of all the columns of a
+// table, or nil if it has no invisible columns.
+func loadInvisibleColumnsFields(ctx context.Context, cp dbconfigs.Connector, database, table string) ([]*querypb.Field, error) {
+	conn, err := cp.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	query := fmt.Sprintf("select column_name, extra from information_schema.columns where table_schema=%s and table_name=%s order by ordinal_position", encodeString(database), encodeString(table))
+	qr, err := conn.ExecuteFetch(query, 10000, false)
+	if err != nil {
+		return nil, err
+	}
+	invisible := false
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, row := range qr.Rows {
+		if strings.Contains(strings.ToUpper(row[1].ToString()), "INVISIBLE") {
+			invisible = true
+		}
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(row[0].ToString()))
+	}
+	if !invisible {
+		return nil, nil
+	}
+	buf.Myprintf(" from %v.%v where 1 != 1", sqlparser.NewTableIdent(database), sqlparser.NewTableIdent(table))
+	qr, err = conn.ExecuteFetch(buf.String(), 0, true)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Fields, nil
+}
@@ -74,6 +74,17 @@ const (
 	GreaterThanEqual
 	// NotEqual is used to filter a comparable column if != specific value
 	NotEqual
+	// In is used to filter a comparable column if it is equal to one of a list of values
+	In
+	// NotIn is used to filter a comparable column if it is not equal to any of a list of values
+	NotIn
+	// IsNull is used to filter a column if it is null
+	IsNull
+	// IsNotNull is used to filter a column if it is not null
+	IsNotNull
+	// Expression is used to filter the rows matching any other condition,
+	// like "mod(tenant_id, 4) = 1 or region in ('eu', 'us')"
+	Expression
 )
 
 // Filter contains opcodes for filtering.
@@ -81,6 +92,10 @@ type Filter struct {
 	Opcode Opcode
 	ColNum int
 	Value  sqltypes.Value
+	// Values is the list of values for In and NotIn.
+	Values []sqltypes.Value
+	// Collation is the collation of the column, used to compare text values.
+	Collation collations.ID
 
 	// Parameters for VindexMatch.
 	// Vindex, VindexColumns and KeyRange, if set, will be used
@@ -90,6 +105,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the condition of an Expression, evaluated by the evalengine
+	// on the columns of the table.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -151,6 +170,10 @@ func getOpcode(comparison *sqlparser.ComparisonExpr) (Opcode, error) {
 		opcode = GreaterThanEqual
 	case sqlparser.NotEqualOp:
 		opcode = NotEqual
+	case sqlparser.InOp:
+		opcode = In
+	case sqlparser.NotInOp:
+		opcode = NotIn
 	default:
 		return -1, fmt.Errorf("comparison operator %s not supported", comparison.Operator.ToString())
 	}
@@ -158,15 +181,14 @@ func getOpcode(comparison *sqlparser.ComparisonExpr) (Opcode, error) {
 }
 
 // compare returns true after applying the comparison specified in the Filter to the actual data in the column
-func compare(comparison Opcode, columnValue, filterValue sqltypes.Value) (bool, error) {
+func compare(comparison Opcode, columnValue, filterValue sqltypes.Value, collation collations.ID) (bool, error) {
 	// use null semantics: return false if either value is null
 	if columnValue.IsNull() || filterValue.IsNull() {
 		return false, nil
 	}
 	// at this point neither values can be null
 	// NullsafeCompare returns 0 if values match, -1 if columnValue < filterValue, 1 if columnValue > filterValue
-	// TODO(king-11) make collation aware
-	result, err := evalengine.NullsafeCompare(columnValue, filterValue, collations.Unknown)
+	result, err := evalengine.NullsafeCompare(columnValue, filterValue, collation)
 	if err != nil {
 		return false, err
 	}
@@ -202,6 +224,18 @@ func compare(comparison Opcode, columnValue, filterValue sqltypes.Value) (bool,
 	return false, nil
 }
 
+// in returns true if the column is equal to one of the filter values. Like in
+// mysql, a null column is neither in nor not in the list.
+func in(columnValue sqltypes.Value, filterValues []sqltypes.Value, collation collations.ID) (bool, error) {
+	for _, filterValue := range filterValues {
+		match, err := compare(Equal, columnValue, filterValue, collation)
+		if err != nil || match {
+			return match, err
+		}
+	}
+	return false, nil
+}
+
 // filter filters the row against the plan. It returns false if the row did not match.
 // The output of the filtering operation is stored in the 'result' argument because
 // filtering cannot be performed in-place. The result argument must be a slice of
@@ -220,8 +254,35 @@ func (plan *Plan) filter(values, result []sqltypes.Value) (bool, error) {
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil
 			}
+		case IsNull:
+			if !values[filter.ColNum].IsNull() {
+				return false, nil
+			}
+		case IsNotNull:
+			if values[filter.ColNum].IsNull() {
+				return false, nil
+			}
+		case Expression:
+			match, err := evalengine.EvaluateToBool(filter.Expr, &evalengine.ExpressionEnv{Row: values})
+			if err != nil {
+				return false, err
+			}
+			if !match {
+				return false, nil
+			}
+		case In, NotIn:
+			if values[filter.ColNum].IsNull() {
+				return false, nil
+			}
+			match, err := in(values[filter.ColNum], filter.Values, filter.Collation)
+			if err != nil {
+				return false, err
+			}
+			if match != (filter.Opcode == In) {
+				return false, nil
+			}
 		default:
-			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value)
+			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value, filter.Collation)
 			if err != nil {
 				return false, err
 			}
@@ -479,56 +540,115 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	}
 	exprs := splitAndExpression(nil, where.Expr)
 	for _, expr := range exprs {
-		switch expr := expr.(type) {
-		case *sqlparser.ComparisonExpr:
-			opcode, err := getOpcode(expr)
-			if err != nil {
+		if funcExpr, ok := expr.(*sqlparser.FuncExpr); ok && funcExpr.Name.EqualString("in_keyrange") {
+			if err := plan.analyzeInKeyRange(vschema, funcExpr.Exprs); err != nil {
 				return err
 			}
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			continue
+		}
+		filter, ok, err := plan.analyzeConstraint(expr)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// The other conditions are evaluated as expressions.
+			compiled, err := compileFilterExpr(plan.Table, expr)
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
+			filter = Filter{Opcode: Expression, Expr: compiled}
+		}
+		plan.Filters = append(plan.Filters, filter)
+	}
+	return nil
+}
+
+// analyzeConstraint returns the filter of a comparison of a column with
+// literals, or of a null check of a column. It returns false if the
+// constraint is another condition.
+func (plan *Plan) analyzeConstraint(expr sqlparser.Expr) (Filter, bool, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ComparisonExpr:
+		opcode, err := getOpcode(expr)
+		if err != nil {
+			return Filter{}, false, nil
+		}
+		qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+		if !ok {
+			return Filter{}, false, nil
+		}
+		if !qualifiedName.Qualifier.IsEmpty() {
+			return Filter{}, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+		}
+		colnum, err := findColumn(plan.Table, qualifiedName.Name)
+		if err != nil {
+			return Filter{}, false, err
+		}
+		filter := Filter{
+			Opcode:    opcode,
+			ColNum:    colnum,
+			Collation: collations.ID(plan.Table.Fields[colnum].Charset),
+		}
+		if opcode == In || opcode == NotIn {
+			tuple, ok := expr.Right.(sqlparser.ValTuple)
 			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := sqlparser.NewPlanValue(val)
-			if err != nil {
-				return err
+				return Filter{}, false, nil
 			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
-				return err
-			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: opcode,
-				ColNum: colnum,
-				Value:  resolved,
-			})
-		case *sqlparser.FuncExpr:
-			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
-			}
-			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
-				return err
+			for _, elem := range tuple {
+				val, err := filterValue(elem)
+				if err != nil {
+					return Filter{}, false, nil
+				}
+				filter.Values = append(filter.Values, val)
 			}
+		} else if filter.Value, err = filterValue(expr.Right); err != nil {
+			return Filter{}, false, nil
+		}
+		return filter, true, nil
+	case *sqlparser.IsExpr:
+		col, ok := expr.Left.(*sqlparser.ColName)
+		if !ok {
+			return Filter{}, false, nil
+		}
+		if !col.Qualifier.IsEmpty() {
+			return Filter{}, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+		}
+		colnum, err := findColumn(plan.Table, col.Name)
+		if err != nil {
+			return Filter{}, false, err
+		}
+		var opcode Opcode
+		switch expr.Right {
+		case sqlparser.IsNullOp:
+			opcode = IsNull
+		case sqlparser.IsNotNullOp:
+			opcode = IsNotNull
 		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			return Filter{}, false, nil
 		}
+		return Filter{
+			Opcode: opcode,
+			ColNum: colnum,
+		}, true, nil
 	}
-	return nil
+	return Filter{}, false, nil
+}
+
+// filterValue returns the value of a literal of a where clause. Strings are
+// compared using the collation of their column.
+func filterValue(expr sqlparser.Expr) (sqltypes.Value, error) {
+	val, ok := expr.(*sqlparser.Literal)
+	if !ok {
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return pv.ResolveValue(nil)
 }
 
 // splitAndExpression breaks up the Expr into AND-separated conditions
@@ -30,7 +30,9 @@ import (
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -496,7 +498,7 @@ func TestPlanBuilder(t *testing.T) {
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
-		outErr:  `unsupported constraint: max(id)`,
+		outErr:  `unsupported constraint: max(id): expr cannot be converted, not supported: function max`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -643,6 +645,28 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 			{Opcode: Equal, ColNum: 0, Value: sqltypes.NewInt64(2)},
 			{Opcode: NotEqual, ColNum: 1, Value: sqltypes.NewVarBinary("xyz")},
 		},
+	}, {
+		name:       "in",
+		inFilter:   "select * from t1 where id in (1, 2)",
+		outFilters: []Filter{{Opcode: In, ColNum: 0, Values: []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}}},
+	}, {
+		name:       "not-in",
+		inFilter:   "select * from t1 where val not in ('abc', 'xyz')",
+		outFilters: []Filter{{Opcode: NotIn, ColNum: 1, Values: []sqltypes.Value{sqltypes.NewVarBinary("abc"), sqltypes.NewVarBinary("xyz")}}},
+	}, {
+		name:     "is-null",
+		inFilter: "select * from t1 where val is null and id is not null",
+		outFilters: []Filter{{Opcode: IsNull, ColNum: 1},
+			{Opcode: IsNotNull, ColNum: 0},
+		},
+	}, {
+		name:     "unsupported-function",
+		inFilter: "select * from t1 where id = 1 and sha1(val) = 'abc'",
+		outErr:   "unsupported constraint: sha1(val) = 'abc': expr cannot be converted, not supported: function sha1",
+	}, {
+		name:     "unknown-column",
+		inFilter: "select * from t1 where id = 1 or name = 'abc'",
+		outErr:   "column `name` not found in table t1",
 	}}
 
 	for _, tcase := range testcases {
@@ -662,12 +686,151 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 	}
 }
 
+func TestPlanFilterExpression(t *testing.T) {
+	ci, ok := collations.Local().LookupID("utf8mb4_general_ci")
+	require.True(t, ok)
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "tenant_id",
+			Type: sqltypes.Uint64,
+		}, {
+			Name:    "region",
+			Type:    sqltypes.VarChar,
+			Charset: uint32(ci),
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}},
+	}
+	row := func(id int64, tenantID uint64, region, val string) []sqltypes.Value {
+		values := []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewUint64(tenantID), sqltypes.NULL, sqltypes.NULL}
+		if region != "" {
+			values[2] = sqltypes.NewVarChar(region)
+		}
+		if val != "" {
+			values[3] = sqltypes.NewVarBinary(val)
+		}
+		return values
+	}
+	testcases := []struct {
+		where string
+		rows  [][]sqltypes.Value
+		want  []bool
+	}{{
+		where: "id in (1, tenant_id)",
+		rows:  [][]sqltypes.Value{row(1, 5, "", ""), row(2, 2, "", ""), row(3, 5, "", "")},
+		want:  []bool{true, true, false},
+	}, {
+		where: "mod(tenant_id, 4) = 1 or tenant_id % 4 = 2",
+		rows:  [][]sqltypes.Value{row(1, 5, "", ""), row(1, 6, "", ""), row(1, 7, "", "")},
+		want:  []bool{true, true, false},
+	}, {
+		// The region is compared using its collation.
+		where: "lower(region) = 'eu' and upper(region) in ('EU', 'US')",
+		rows:  [][]sqltypes.Value{row(1, 1, "EU", ""), row(1, 1, "Us", ""), row(1, 1, "", "")},
+		want:  []bool{true, false, false},
+	}, {
+		where: "region = 'eu'",
+		rows:  [][]sqltypes.Value{row(1, 1, "EU", ""), row(1, 1, "us", "")},
+		want:  []bool{true, false},
+	}, {
+		where: "not (region <> 'eu' or val is null)",
+		rows:  [][]sqltypes.Value{row(1, 1, "eu", "x"), row(1, 1, "", "x"), row(1, 1, "eu", "")},
+		want:  []bool{true, false, false},
+	}, {
+		// A value is neither in nor not in a list with a null.
+		where: "id not in (1, null)",
+		rows:  [][]sqltypes.Value{row(1, 1, "", ""), row(2, 1, "", "")},
+		want:  []bool{false, false},
+	}, {
+		where: "coalesce(region, val, 'none') = 'none' and ifnull(val, 'x') = 'x'",
+		rows:  [][]sqltypes.Value{row(1, 1, "", ""), row(1, 1, "eu", ""), row(1, 1, "", "y")},
+		want:  []bool{true, false, false},
+	}, {
+		where: "id between 2 and 4 and id not between 3 and 3",
+		rows:  [][]sqltypes.Value{row(1, 1, "", ""), row(2, 1, "", ""), row(3, 1, "", ""), row(4, 1, "", "")},
+		want:  []bool{false, true, false, true},
+	}, {
+		where: "substring(val, 2, 2) = 'bc' and left(val, 1) = 'a' and right(val, 1) = 'd' and length(val) = 4 and char_length(concat(val, region)) = 6",
+		rows:  [][]sqltypes.Value{row(1, 1, "eu", "abcd"), row(1, 1, "eu", "abce"), row(1, 1, "", "abcd")},
+		want:  []bool{true, false, false},
+	}, {
+		where: "abs(id - 10) < 2 and id * 2 > 18",
+		rows:  [][]sqltypes.Value{row(9, 1, "", ""), row(10, 1, "", ""), row(12, 1, "", "")},
+		want:  []bool{false, true, false},
+	}, {
+		where: "val <=> null xor id = 1",
+		rows:  [][]sqltypes.Value{row(1, 1, "", ""), row(1, 1, "", "x"), row(2, 1, "", "")},
+		want:  []bool{false, true, true},
+	}, {
+		where: "(region = 'eu') is not true",
+		rows:  [][]sqltypes.Value{row(1, 1, "eu", ""), row(1, 1, "us", ""), row(1, 1, "", "")},
+		want:  []bool{false, true, true},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.where, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select * from t1 where " + tcase.where}},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, plan)
+			result := make([]sqltypes.Value, len(plan.ColExprs))
+			var got []bool
+			for _, values := range tcase.rows {
+				match, err := plan.filter(values, result)
+				require.NoError(t, err)
+				got = append(got, match)
+			}
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+}
+
+func TestValidateFilterWhere(t *testing.T) {
+	testcases := []struct {
+		where  string
+		outErr string
+	}{{
+		where: "in_keyrange(id, 'hash', '{{.keyrange}}') and (tenant_id in (1, 2) or lower(region) = 'eu')",
+	}, {
+		where:  "id = 1 and max(id) = 1",
+		outErr: "unsupported constraint: max(id) = 1: expr cannot be converted, not supported: function max",
+	}, {
+		where: "val like 'a%' and not val <=> null",
+	}, {
+		where:  "val regexp 'a'",
+		outErr: "unsupported constraint: val regexp 'a': expr cannot be converted, not supported: regexp",
+	}, {
+		where:  "t1.id = 1",
+		outErr: "unsupported qualifier for column: t1.id",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.where, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select * from t1 where " + tcase.where)
+			require.NoError(t, err)
+			err = ValidateFilterWhere(stmt.(*sqlparser.Select).Where)
+			if tcase.outErr != "" {
+				assert.EqualError(t, err, tcase.outErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestCompare(t *testing.T) {
 	type testcase struct {
 		opcode                   Opcode
 		columnValue, filterValue sqltypes.Value
+		collation                collations.ID
 		want                     bool
 	}
+	ci, ok := collations.Local().LookupID("utf8mb4_general_ci")
+	require.True(t, ok)
 	int1 := sqltypes.NewInt32(1)
 	int2 := sqltypes.NewInt32(2)
 	testcases := []*testcase{
@@ -688,10 +851,46 @@ func TestCompare(t *testing.T) {
 		{opcode: LessThanEqual, columnValue: int2, filterValue: int1, want: false},
 		{opcode: GreaterThanEqual, columnValue: int1, filterValue: int1, want: true},
 		{opcode: LessThanEqual, columnValue: int1, filterValue: int2, want: true},
+		{opcode: Equal, columnValue: sqltypes.NewVarChar("ABC"), filterValue: sqltypes.NewVarBinary("abc"), collation: ci, want: true},
+		{opcode: LessThan, columnValue: sqltypes.NewVarChar("abc"), filterValue: sqltypes.NewVarBinary("ABD"), collation: ci, want: true},
 	}
 	for _, tc := range testcases {
 		t.Run("", func(t *testing.T) {
-			got, err := compare(tc.opcode, tc.columnValue, tc.filterValue)
+			got, err := compare(tc.opcode, tc.columnValue, tc.filterValue, tc.collation)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPlanFilterInAndNull(t *testing.T) {
+	int1 := sqltypes.NewInt64(1)
+	int2 := sqltypes.NewInt64(2)
+	int3 := sqltypes.NewInt64(3)
+	testcases := []struct {
+		filter Filter
+		value  sqltypes.Value
+		want   bool
+	}{
+		{filter: Filter{Opcode: In, Values: []sqltypes.Value{int1, int2}}, value: int2, want: true},
+		{filter: Filter{Opcode: In, Values: []sqltypes.Value{int1, int2}}, value: int3, want: false},
+		{filter: Filter{Opcode: In, Values: []sqltypes.Value{int1, int2}}, value: sqltypes.NULL, want: false},
+		{filter: Filter{Opcode: NotIn, Values: []sqltypes.Value{int1, int2}}, value: int2, want: false},
+		{filter: Filter{Opcode: NotIn, Values: []sqltypes.Value{int1, int2}}, value: int3, want: true},
+		{filter: Filter{Opcode: NotIn, Values: []sqltypes.Value{int1, int2}}, value: sqltypes.NULL, want: false},
+		{filter: Filter{Opcode: IsNull}, value: sqltypes.NULL, want: true},
+		{filter: Filter{Opcode: IsNull}, value: int1, want: false},
+		{filter: Filter{Opcode: IsNotNull}, value: sqltypes.NULL, want: false},
+		{filter: Filter{Opcode: IsNotNull}, value: int1, want: true},
+	}
+	for _, tc := range testcases {
+		t.Run("", func(t *testing.T) {
+			plan := &Plan{
+				Filters:  []Filter{tc.filter},
+				ColExprs: []ColExpr{{ColNum: 0}},
+			}
+			result := make([]sqltypes.Value, 1)
+			got, err := plan.filter([]sqltypes.Value{tc.value}, result)
 			require.NoError(t, err)
 			require.Equal(t, tc.want, got)
 		})
@@ -124,6 +124,13 @@ func (rs *rowStreamer) buildPlan() error {
 	if err != nil {
 		return err
 	}
+	params, err := rs.cp.MysqlParams()
+	if err != nil {
+		return err
+	}
+	if st, err = rs.vse.withInvisibleColumns(rs.ctx, rs.cp, params.DbName, st); err != nil {
+		return err
+	}
 	ti := &Table{
 		Name:   st.Name,
 		Fields: st.Fields,
@@ -207,11 +214,15 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 		prefix = ", "
 	}
 	buf.Myprintf(" from %v", sqlparser.NewTableIdent(rs.plan.Table.Name))
+	pkFilters := rs.pkFilters()
 	if len(rs.lastpk) != 0 {
 		if len(rs.lastpk) != len(rs.pkColumns) {
 			return "", fmt.Errorf("primary key values don't match length: %v vs %v", rs.lastpk, rs.pkColumns)
 		}
 		buf.WriteString(" where ")
+		if len(pkFilters) != 0 {
+			buf.WriteString("(")
+		}
 		prefix := ""
 		// This loop handles the case for composite pks. For example,
 		// if lastpk was (1,2), the where clause would be:
@@ -230,6 +241,18 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 			rs.lastpk[lastcol].EncodeSQL(buf)
 			buf.Myprintf(")")
 		}
+		if len(pkFilters) != 0 {
+			buf.WriteString(")")
+		}
+	}
+	for i, filter := range pkFilters {
+		if i == 0 && len(rs.lastpk) == 0 {
+			buf.WriteString(" where ")
+		} else {
+			buf.WriteString(" and ")
+		}
+		buf.Myprintf("%v %s ", sqlparser.NewColIdent(rs.plan.Table.Fields[filter.ColNum].Name), filterOperators[filter.Opcode].ToString())
+		filter.Value.EncodeSQL(buf)
 	}
 	buf.Myprintf(" order by ", sqlparser.NewTableIdent(rs.plan.Table.Name))
 	prefix = ""
@@ -240,6 +263,37 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 	return buf.String(), nil
 }
 
+// filterOperators are the operators of the filters which can be added to the
+// copy query.
+var filterOperators = map[Opcode]sqlparser.ComparisonExprOperator{
+	Equal:            sqlparser.EqualOp,
+	LessThan:         sqlparser.LessThanOp,
+	LessThanEqual:    sqlparser.LessEqualOp,
+	GreaterThan:      sqlparser.GreaterThanOp,
+	GreaterThanEqual: sqlparser.GreaterEqualOp,
+}
+
+// pkFilters returns the filters on the leading primary key column which can be
+// added to the copy query, so that the copy of a range of the primary key only
+// reads that range. Only the integral columns are compared, to not depend on
+// the collation of MySQL. The rows are still filtered by the plan.
+func (rs *rowStreamer) pkFilters() []Filter {
+	if len(rs.pkColumns) == 0 {
+		return nil
+	}
+	var filters []Filter
+	for _, filter := range rs.plan.Filters {
+		if _, ok := filterOperators[filter.Opcode]; !ok || filter.ColNum != rs.pkColumns[0] {
+			continue
+		}
+		if !sqltypes.IsIntegral(rs.plan.Table.Fields[filter.ColNum].Type) || !filter.Value.IsIntegral() {
+			continue
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
 func (rs *rowStreamer) streamQuery(conn *snapshotConn, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	log.Infof("Streaming query: %v\n", rs.sendQuery)
 	gtid, err := conn.streamWithSnapshot(rs.ctx, rs.plan.Table.Name, rs.sendQuery)
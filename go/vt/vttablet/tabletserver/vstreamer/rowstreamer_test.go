@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 func TestStreamRowsScan(t *testing.T) {
@@ -440,3 +441,36 @@ func expectStreamError(t *testing.T, query string, want string) {
 		require.EqualError(t, err, want, "Got incorrect error")
 	}()
 }
+
+func TestBuildSelectPKFilters(t *testing.T) {
+	plan := &Plan{
+		Table: &Table{
+			Name: "t1",
+			Fields: []*querypb.Field{
+				{Name: "id", Type: querypb.Type_INT64},
+				{Name: "val", Type: querypb.Type_VARBINARY},
+			},
+		},
+		Filters: []Filter{
+			{Opcode: GreaterThanEqual, ColNum: 0, Value: sqltypes.NewInt64(100)},
+			{Opcode: LessThan, ColNum: 0, Value: sqltypes.NewInt64(200)},
+			// The filters on other columns are not added to the query.
+			{Opcode: Equal, ColNum: 1, Value: sqltypes.NewVarBinary("a")},
+		},
+	}
+	rs := &rowStreamer{plan: plan, pkColumns: []int{0}}
+	query, err := rs.buildSelect()
+	require.NoError(t, err)
+	require.Equal(t, "select id, val from t1 where id >= 100 and id < 200 order by id", query)
+
+	rs.lastpk = []sqltypes.Value{sqltypes.NewInt64(150)}
+	query, err = rs.buildSelect()
+	require.NoError(t, err)
+	require.Equal(t, "select id, val from t1 where ((id > 150)) and id >= 100 and id < 200 order by id", query)
+
+	// The filters on the other primary key columns are not added either.
+	rs = &rowStreamer{plan: plan, pkColumns: []int{1, 0}}
+	query, err = rs.buildSelect()
+	require.NoError(t, err)
+	require.Equal(t, "select id, val from t1 order by val, id", query)
+}
@@ -102,7 +102,8 @@ type streamerPlan struct {
 //   "select * from t where in_keyrange(col1, 'hash', '-80')",
 //   "select col1, col2 from t where...",
 //   "select col1, keyspace_id() from t where...".
-//   Only "in_keyrange" and limited comparison operators (see enum Opcode in planbuilder.go) are supported in the where clause.
+//   The where clause can have "in_keyrange" constructs, and conditions built from the columns, literals,
+//   comparison, logical and arithmetic operators, and the functions which can be evaluated by the evalengine.
 //   Other constructs like joins, group by, etc. are not supported.
 // vschema: the current vschema. This value can later be changed through the SetVSchema method.
 // send: callback function to send events.
@@ -480,6 +481,9 @@ func (vs *vstreamer) parseEvent(ev mysql.BinlogEvent) ([]*binlogdatapb.VEvent, e
 			}
 			if schema.MustReloadSchemaOnDDL(q.SQL, vs.cp.DBName()) {
 				vs.se.ReloadAt(context.Background(), vs.pos)
+				// Instant DDLs, like making a column invisible, may not
+				// be detected by the schema engine.
+				vs.vse.invalidateInvisibleColumns()
 			}
 		case sqlparser.StmtSavepoint:
 			mustSend := mustSendStmt(q, vs.cp.DBName())
@@ -708,6 +712,11 @@ func (vs *vstreamer) buildTableColumns(tm *mysql.TableMap) ([]*querypb.Field, er
 		return fields, nil
 	}
 
+	if len(st.Fields) < len(tm.Types) {
+		if st, err = vs.vse.withInvisibleColumns(vs.ctx, vs.cp, tm.Database, st); err != nil {
+			return nil, err
+		}
+	}
 	if len(st.Fields) < len(tm.Types) {
 		if vs.filter.FieldEventMode == binlogdatapb.Filter_ERR_ON_MISMATCH {
 			log.Infof("Cannot determine columns for table %s", tm.Name)
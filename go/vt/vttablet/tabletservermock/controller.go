@@ -26,6 +26,7 @@ import (
 
 	"time"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/servenv"
@@ -34,9 +35,11 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -95,6 +98,9 @@ type Controller struct {
 
 	// queryRulesMap has the latest query rules.
 	queryRulesMap map[string]*rules.Rules
+
+	// tableACL has the latest table ACL.
+	tableACL *tableaclpb.Config
 }
 
 // NewController returns a mock of tabletserver.Controller
@@ -201,6 +207,14 @@ func (tqsc *Controller) SetQueryRules(ruleSource string, qrs *rules.Rules) error
 	return nil
 }
 
+// SetTableACL is part of the tabletserver.Controller interface
+func (tqsc *Controller) SetTableACL(config *tableaclpb.Config) error {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+	tqsc.tableACL = config
+	return nil
+}
+
 // QueryService is part of the tabletserver.Controller interface
 func (tqsc *Controller) QueryService() queryservice.QueryService {
 	return nil
@@ -226,6 +240,36 @@ func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// ReadTwopcInflight is part of the tabletserver.Controller interface.
+func (tqsc *Controller) ReadTwopcInflight(ctx context.Context) ([]*tx.DistributedTx, []*tx.PreparedTx, []*tx.PreparedTx, error) {
+	return nil, nil, nil, nil
+}
+
+// GetDeadLetters is part of the tabletserver.Controller interface.
+func (tqsc *Controller) GetDeadLetters(ctx context.Context, target *querypb.Target, name string, limit int) (*sqltypes.Result, error) {
+	return &sqltypes.Result{}, nil
+}
+
+// RequeueDeadLetters is part of the tabletserver.Controller interface.
+func (tqsc *Controller) RequeueDeadLetters(ctx context.Context, target *querypb.Target, name string, ids []string) (int64, error) {
+	return 0, nil
+}
+
+// PurgeDeadLetters is part of the tabletserver.Controller interface.
+func (tqsc *Controller) PurgeDeadLetters(ctx context.Context, target *querypb.Target, name string, ids []string) (int64, error) {
+	return 0, nil
+}
+
+// GetSequences is part of the tabletserver.Controller interface.
+func (tqsc *Controller) GetSequences(ctx context.Context, target *querypb.Target, names []string) ([]*schema.SequenceState, error) {
+	return nil, nil
+}
+
+// ResetSequence is part of the tabletserver.Controller interface.
+func (tqsc *Controller) ResetSequence(ctx context.Context, target *querypb.Target, name string, nextID, cache int64) (*schema.SequenceState, error) {
+	return &schema.SequenceState{Name: name, NextID: nextID, Cache: cache}, nil
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
@@ -248,3 +292,10 @@ func (tqsc *Controller) GetQueryRules(ruleSource string) *rules.Rules {
 	defer tqsc.mu.Unlock()
 	return tqsc.queryRulesMap[ruleSource]
 }
+
+// GetTableACL allows a test to check what was set.
+func (tqsc *Controller) GetTableACL() *tableaclpb.Config {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+	return tqsc.tableACL
+}
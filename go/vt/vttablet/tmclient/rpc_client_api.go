@@ -153,6 +153,61 @@ type TabletManagerClient interface {
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
 
+	//
+	// 2PC related methods
+	//
+
+	// GetUnresolvedTransactions returns the distributed transactions
+	// the tablet is the metadata manager of, and the transactions prepared
+	// on the tablet, which are older than abandonAge
+	GetUnresolvedTransactions(ctx context.Context, tablet *topodatapb.Tablet, abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error)
+
+	// ResolveTransaction commits or rolls back the transaction prepared
+	// on the tablet, and removes the metadata of the distributed transaction
+	// if conclude is set
+	ResolveTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string, commit, conclude bool) error
+
+	// SetRollbackTransaction decides to roll back the distributed transaction
+	// in state PREPARE, of which the tablet is the metadata manager, before
+	// it is rolled back on its participants
+	SetRollbackTransaction(ctx context.Context, tablet *topodatapb.Tablet, dtid string) error
+
+	//
+	// Storage usage related methods
+	//
+
+	// GetStorageUsage returns the size of the tables of the tablet, with
+	// its recent samples and the size of its disk
+	GetStorageUsage(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetStorageUsageResponse, error)
+
+	//
+	// Message related methods
+	//
+
+	// GetDeadLetters returns at most limit messages of the message table
+	// parked after their last attempt
+	GetDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, limit int) (*querypb.QueryResult, error)
+
+	// RequeueDeadLetters schedules dead-lettered messages to be sent again,
+	// or all of them if ids is empty
+	RequeueDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error)
+
+	// PurgeDeadLetters deletes dead-lettered messages, or all of them if
+	// ids is empty
+	PurgeDeadLetters(ctx context.Context, tablet *topodatapb.Tablet, table string, ids []string) (int64, error)
+
+	//
+	// Sequence related methods
+	//
+
+	// GetSequences returns the state of the sequence tables, or of all the
+	// sequences of the tablet if tables is empty
+	GetSequences(ctx context.Context, tablet *topodatapb.Tablet, tables []string) ([]*tabletmanagerdatapb.SequenceState, error)
+
+	// ResetSequence sets the next ID and the cache size of a sequence, 0 to
+	// keep their value, and drops its cached values
+	ResetSequence(ctx context.Context, tablet *topodatapb.Tablet, table string, nextID, cache int64) (*tabletmanagerdatapb.SequenceState, error)
+
 	//
 	// Reparenting related functions
 	//
@@ -926,6 +926,262 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+//
+// 2PC related methods
+//
+
+var (
+	testAbandonAge             = 30 * time.Second
+	testUnresolvedTransactions = []*querypb.TransactionMetadata{{
+		Dtid:        "ks:-80:1234",
+		State:       querypb.TransactionState_COMMIT,
+		TimeCreated: 1234,
+		Participants: []*querypb.Target{{
+			Keyspace:   "ks",
+			Shard:      "80-",
+			TabletType: topodatapb.TabletType_PRIMARY,
+		}},
+	}}
+	testPreparedTransactions = []*tabletmanagerdatapb.PreparedTransaction{{
+		Dtid:        "ks:-80:1234",
+		TimeCreated: 1234,
+		Queries:     []string{"update t set c = 1"},
+	}}
+)
+
+func (fra *fakeRPCTM) GetUnresolvedTransactions(ctx context.Context, abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*tabletmanagerdatapb.PreparedTransaction, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetUnresolvedTransactions abandonAge", abandonAge, testAbandonAge)
+	return testUnresolvedTransactions, testPreparedTransactions, nil
+}
+
+func tmRPCTestGetUnresolvedTransactions(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	transactions, prepared, err := client.GetUnresolvedTransactions(ctx, tablet, testAbandonAge)
+	compareError(t, "GetUnresolvedTransactions", err, transactions, testUnresolvedTransactions)
+	compareError(t, "GetUnresolvedTransactions", err, prepared, testPreparedTransactions)
+}
+
+func tmRPCTestGetUnresolvedTransactionsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.GetUnresolvedTransactions(ctx, tablet, testAbandonAge)
+	expectHandleRPCPanic(t, "GetUnresolvedTransactions", false /*verbose*/, err)
+}
+
+var testResolveTransactionDtid = "ks:-80:1234"
+
+func (fra *fakeRPCTM) ResolveTransaction(ctx context.Context, dtid string, commit, conclude bool) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ResolveTransaction dtid", dtid, testResolveTransactionDtid)
+	compareBool(fra.t, "ResolveTransaction commit", commit)
+	compareBool(fra.t, "ResolveTransaction conclude", conclude)
+	return nil
+}
+
+func tmRPCTestResolveTransaction(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ResolveTransaction(ctx, tablet, testResolveTransactionDtid, true, true)
+	if err != nil {
+		t.Errorf("ResolveTransaction failed: %v", err)
+	}
+}
+
+func tmRPCTestResolveTransactionPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ResolveTransaction(ctx, tablet, testResolveTransactionDtid, true, true)
+	expectHandleRPCPanic(t, "ResolveTransaction", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) SetRollbackTransaction(ctx context.Context, dtid string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetRollbackTransaction dtid", dtid, testResolveTransactionDtid)
+	return nil
+}
+
+func tmRPCTestSetRollbackTransaction(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetRollbackTransaction(ctx, tablet, testResolveTransactionDtid)
+	if err != nil {
+		t.Errorf("SetRollbackTransaction failed: %v", err)
+	}
+}
+
+func tmRPCTestSetRollbackTransactionPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetRollbackTransaction(ctx, tablet, testResolveTransactionDtid)
+	expectHandleRPCPanic(t, "SetRollbackTransaction", true /*verbose*/, err)
+}
+
+//
+// Storage usage related methods
+//
+
+var testGetStorageUsageResponse = &tabletmanagerdatapb.GetStorageUsageResponse{
+	Tables: []*tabletmanagerdatapb.TableStorageUsage{{
+		Name:        "t1",
+		DataLength:  16384,
+		IndexLength: 4096,
+	}},
+	Samples: []*tabletmanagerdatapb.StorageUsageSample{{
+		Time:      1234,
+		UsedBytes: 20480,
+	}},
+	DiskTotalBytes: 1 << 30,
+	DiskFreeBytes:  1 << 29,
+}
+
+func (fra *fakeRPCTM) GetStorageUsage(ctx context.Context) (*tabletmanagerdatapb.GetStorageUsageResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetStorageUsageResponse, nil
+}
+
+func tmRPCTestGetStorageUsage(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.GetStorageUsage(ctx, tablet)
+	compareError(t, "GetStorageUsage", err, resp, testGetStorageUsageResponse)
+}
+
+func tmRPCTestGetStorageUsagePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetStorageUsage(ctx, tablet)
+	expectHandleRPCPanic(t, "GetStorageUsage", false /*verbose*/, err)
+}
+
+//
+// Message related methods
+//
+
+var (
+	testDeadLettersTable  = "msg"
+	testDeadLettersLimit  = 100
+	testDeadLettersIds    = []string{"1", "2"}
+	testDeadLettersCount  = int64(2)
+	testDeadLettersResult = &querypb.QueryResult{
+		Fields: []*querypb.Field{
+			{Name: "epoch", Type: querypb.Type_INT64},
+			{Name: "id", Type: querypb.Type_INT64},
+		},
+		Rows: []*querypb.Row{{
+			Lengths: []int64{1, 1},
+			Values:  []byte("51"),
+		}},
+	}
+)
+
+func (fra *fakeRPCTM) GetDeadLetters(ctx context.Context, table string, limit int) (*querypb.QueryResult, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetDeadLetters table", table, testDeadLettersTable)
+	compare(fra.t, "GetDeadLetters limit", limit, testDeadLettersLimit)
+	return testDeadLettersResult, nil
+}
+
+func tmRPCTestGetDeadLetters(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	qr, err := client.GetDeadLetters(ctx, tablet, testDeadLettersTable, testDeadLettersLimit)
+	compareError(t, "GetDeadLetters", err, qr, testDeadLettersResult)
+}
+
+func tmRPCTestGetDeadLettersPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetDeadLetters(ctx, tablet, testDeadLettersTable, testDeadLettersLimit)
+	expectHandleRPCPanic(t, "GetDeadLetters", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) RequeueDeadLetters(ctx context.Context, table string, ids []string) (int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RequeueDeadLetters table", table, testDeadLettersTable)
+	compare(fra.t, "RequeueDeadLetters ids", ids, testDeadLettersIds)
+	return testDeadLettersCount, nil
+}
+
+func tmRPCTestRequeueDeadLetters(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	count, err := client.RequeueDeadLetters(ctx, tablet, testDeadLettersTable, testDeadLettersIds)
+	compareError(t, "RequeueDeadLetters", err, count, testDeadLettersCount)
+}
+
+func tmRPCTestRequeueDeadLettersPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.RequeueDeadLetters(ctx, tablet, testDeadLettersTable, testDeadLettersIds)
+	expectHandleRPCPanic(t, "RequeueDeadLetters", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) PurgeDeadLetters(ctx context.Context, table string, ids []string) (int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "PurgeDeadLetters table", table, testDeadLettersTable)
+	compare(fra.t, "PurgeDeadLetters ids", ids, testDeadLettersIds)
+	return testDeadLettersCount, nil
+}
+
+func tmRPCTestPurgeDeadLetters(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	count, err := client.PurgeDeadLetters(ctx, tablet, testDeadLettersTable, testDeadLettersIds)
+	compareError(t, "PurgeDeadLetters", err, count, testDeadLettersCount)
+}
+
+func tmRPCTestPurgeDeadLettersPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.PurgeDeadLetters(ctx, tablet, testDeadLettersTable, testDeadLettersIds)
+	expectHandleRPCPanic(t, "PurgeDeadLetters", true /*verbose*/, err)
+}
+
+//
+// Sequence related methods
+//
+
+var (
+	testSequenceTable  = "seq"
+	testSequenceNextID = int64(1000)
+	testSequenceCache  = int64(100)
+	testSequenceState  = &tabletmanagerdatapb.SequenceState{
+		Name:            testSequenceTable,
+		NextId:          testSequenceNextID,
+		Cache:           testSequenceCache,
+		NextVal:         900,
+		LastVal:         1000,
+		MaxValue:        10000,
+		ExhaustionAlert: 0.1,
+	}
+)
+
+func (fra *fakeRPCTM) GetSequences(ctx context.Context, tables []string) ([]*tabletmanagerdatapb.SequenceState, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetSequences tables", tables, []string{testSequenceTable})
+	return []*tabletmanagerdatapb.SequenceState{testSequenceState}, nil
+}
+
+func tmRPCTestGetSequences(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	states, err := client.GetSequences(ctx, tablet, []string{testSequenceTable})
+	compareError(t, "GetSequences", err, states, []*tabletmanagerdatapb.SequenceState{testSequenceState})
+}
+
+func tmRPCTestGetSequencesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetSequences(ctx, tablet, []string{testSequenceTable})
+	expectHandleRPCPanic(t, "GetSequences", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCTM) ResetSequence(ctx context.Context, table string, nextID, cache int64) (*tabletmanagerdatapb.SequenceState, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ResetSequence table", table, testSequenceTable)
+	compare(fra.t, "ResetSequence nextID", nextID, testSequenceNextID)
+	compare(fra.t, "ResetSequence cache", cache, testSequenceCache)
+	return testSequenceState, nil
+}
+
+func tmRPCTestResetSequence(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	state, err := client.ResetSequence(ctx, tablet, testSequenceTable, testSequenceNextID, testSequenceCache)
+	compareError(t, "ResetSequence", err, state, testSequenceState)
+}
+
+func tmRPCTestResetSequencePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ResetSequence(ctx, tablet, testSequenceTable, testSequenceNextID, testSequenceCache)
+	expectHandleRPCPanic(t, "ResetSequence", true /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1340,6 +1596,23 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
 
+	// 2PC related methods
+	tmRPCTestGetUnresolvedTransactions(ctx, t, client, tablet)
+	tmRPCTestResolveTransaction(ctx, t, client, tablet)
+	tmRPCTestSetRollbackTransaction(ctx, t, client, tablet)
+
+	// Storage usage related methods
+	tmRPCTestGetStorageUsage(ctx, t, client, tablet)
+
+	// Message related methods
+	tmRPCTestGetDeadLetters(ctx, t, client, tablet)
+	tmRPCTestRequeueDeadLetters(ctx, t, client, tablet)
+	tmRPCTestPurgeDeadLetters(ctx, t, client, tablet)
+
+	// Sequence related methods
+	tmRPCTestGetSequences(ctx, t, client, tablet)
+	tmRPCTestResetSequence(ctx, t, client, tablet)
+
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
 	tmRPCTestInitMaster(ctx, t, client, tablet)
@@ -1392,6 +1665,23 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
 
+	// 2PC related methods
+	tmRPCTestGetUnresolvedTransactionsPanic(ctx, t, client, tablet)
+	tmRPCTestResolveTransactionPanic(ctx, t, client, tablet)
+	tmRPCTestSetRollbackTransactionPanic(ctx, t, client, tablet)
+
+	// Storage usage related methods
+	tmRPCTestGetStorageUsagePanic(ctx, t, client, tablet)
+
+	// Message related methods
+	tmRPCTestGetDeadLettersPanic(ctx, t, client, tablet)
+	tmRPCTestRequeueDeadLettersPanic(ctx, t, client, tablet)
+	tmRPCTestPurgeDeadLettersPanic(ctx, t, client, tablet)
+
+	// Sequence related methods
+	tmRPCTestGetSequencesPanic(ctx, t, client, tablet)
+	tmRPCTestResetSequencePanic(ctx, t, client, tablet)
+
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
 	tmRPCTestInitMasterPanic(ctx, t, client, tablet)
@@ -124,6 +124,30 @@ func TLSVersionToNumber(tlsVersion string) (uint16, error) {
 	}
 }
 
+// CipherSuitesToNumbers converts a comma separated list of cipher suite
+// names, as returned by tls.CipherSuiteName, to the internal Go number
+// representation. Insecure cipher suites are not allowed. It returns nil
+// for an empty list, so the default cipher suites are used.
+func CipherSuitesToNumbers(cipherSuites string) ([]uint16, error) {
+	if cipherSuites == "" {
+		return nil, nil
+	}
+	byName := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		byName[suite.Name] = suite.ID
+	}
+	var ciphers []uint16
+	for _, name := range strings.Split(cipherSuites, ",") {
+		name = strings.TrimSpace(name)
+		id, ok := byName[name]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "Invalid or insecure TLS cipher suite specified: %s", name)
+		}
+		ciphers = append(ciphers, id)
+	}
+	return ciphers, nil
+}
+
 var onceByKeys = sync.Map{}
 
 // ClientConfig returns the TLS config to use for a client to
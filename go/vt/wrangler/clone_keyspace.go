@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file handles keyspace cloning: the copy of the schema, vschema and
+optionally a sample of the data of a keyspace into a new keyspace, usually to
+spin up an ephemeral testing environment with the shape of production.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/masking"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// CloneKeyspaceOptions are the parameters of a keyspace clone.
+type CloneKeyspaceOptions struct {
+	// Workflow is the name of the VReplication workflow copying the data.
+	Workflow string
+	// ExternalCluster is the mounted cluster of the source keyspace, if the
+	// clone is in another cluster.
+	ExternalCluster string
+	// ExcludeTables are not cloned.
+	ExcludeTables []string
+	// SchemaOnly clones the schema and vschema without any data.
+	SchemaOnly bool
+	// SampleKeyRange restricts the cloned rows of the sharded tables to
+	// the ones whose keyspace id is within the key range, e.g. "-10" for
+	// a sixteenth of the rows. Since the related rows share their keyspace
+	// id, the sample stays consistent across tables. The reference tables
+	// are fully copied.
+	SampleKeyRange string
+	// Mask requires the target keyspace to be one of the non-production
+	// keyspaces of the masking policy, so that the masked columns are never
+	// copied in clear.
+	Mask bool
+	// Cell and TabletTypes select the source tablets.
+	Cell, TabletTypes string
+}
+
+// CloneKeyspace copies the schema and the vschema of the source keyspace into
+// the target keyspace, and starts a workflow copying its data, which stops
+// once the copy is done. The target keyspace must have its tablets, and no
+// vschema tables. The sequences and the lookup vindexes of the vschema which
+// refer to tables of the source keyspace are changed to refer to their clones,
+// so that the writes to the clone never change the source keyspace. The
+// sequences and the owned lookup vindexes of other keyspaces are rejected.
+func (wr *Wrangler) CloneKeyspace(ctx context.Context, sourceKeyspace, targetKeyspace string, opts *CloneKeyspaceOptions) (err error) {
+	if sourceKeyspace == targetKeyspace && opts.ExternalCluster == "" {
+		return fmt.Errorf("cannot clone keyspace %s into itself", sourceKeyspace)
+	}
+	if opts.Workflow == "" {
+		return fmt.Errorf("no workflow specified")
+	}
+	if opts.ExternalCluster != "" {
+		externalTopo, err := wr.ts.OpenExternalVitessClusterServer(ctx, opts.ExternalCluster)
+		if err != nil {
+			return err
+		}
+		wr.sourceTs = externalTopo
+	}
+	if opts.Mask {
+		policy, err := wr.ts.GetMaskingPolicy(ctx)
+		if err != nil {
+			return err
+		}
+		if !masking.IsMasked(policy, targetKeyspace) {
+			return fmt.Errorf("keyspace %s is not a non-production keyspace of the masking policy", targetKeyspace)
+		}
+	}
+
+	vschema, err := wr.sourceTs.GetVSchema(ctx, sourceKeyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return err
+	}
+	if vschema == nil {
+		vschema = &vschemapb.Keyspace{}
+	}
+	if opts.SampleKeyRange != "" {
+		if !vschema.Sharded {
+			return fmt.Errorf("cannot sample keyspace %s which is not sharded", sourceKeyspace)
+		}
+		keyRanges, err := key.ParseShardingSpec(opts.SampleKeyRange)
+		if err != nil {
+			return err
+		}
+		if len(keyRanges) != 1 {
+			return fmt.Errorf("invalid sample key range %s", opts.SampleKeyRange)
+		}
+	}
+	targetVSchema, err := wr.ts.GetVSchema(ctx, targetKeyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return err
+	}
+	if len(targetVSchema.GetTables()) > 0 {
+		return fmt.Errorf("target keyspace %s already has a vschema", targetKeyspace)
+	}
+
+	ksTables, err := wr.getKeyspaceTables(ctx, sourceKeyspace, wr.sourceTs)
+	if err != nil {
+		return err
+	}
+	if err := wr.validateSourceTablesExist(ctx, sourceKeyspace, ksTables, opts.ExcludeTables); err != nil {
+		return err
+	}
+	excluded := make(map[string]bool, len(opts.ExcludeTables))
+	for _, table := range opts.ExcludeTables {
+		excluded[table] = true
+	}
+	var tables []string
+	for _, table := range ksTables {
+		if excluded[table] || schema.IsInternalOperationTableName(table) {
+			continue
+		}
+		if vschema.Sharded && vschema.Tables[table] == nil {
+			return fmt.Errorf("table %s of sharded keyspace %s is not in its vschema", table, sourceKeyspace)
+		}
+		tables = append(tables, table)
+	}
+	if len(tables) == 0 {
+		return fmt.Errorf("no tables to clone")
+	}
+	sort.Strings(tables)
+	log.Infof("Found tables to clone: %v", tables)
+
+	clonedVSchema := proto.Clone(vschema).(*vschemapb.Keyspace)
+	for table := range clonedVSchema.Tables {
+		if excluded[table] {
+			delete(clonedVSchema.Tables, table)
+		}
+	}
+	if err := cloneVSchemaReferences(clonedVSchema, sourceKeyspace, targetKeyspace); err != nil {
+		return err
+	}
+	// The workflow is built from the vschema of the target keyspace, so the
+	// vschema is saved first, and restored if the workflow cannot be created.
+	if err := wr.ts.SaveVSchema(ctx, targetKeyspace, clonedVSchema); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		var restoreErr error
+		if targetVSchema == nil {
+			restoreErr = wr.ts.DeleteVSchema(ctx, targetKeyspace)
+		} else {
+			restoreErr = wr.ts.SaveVSchema(ctx, targetKeyspace, targetVSchema)
+		}
+		if restoreErr == nil {
+			restoreErr = wr.ts.RebuildSrvVSchema(ctx, nil)
+		}
+		if restoreErr != nil {
+			log.Errorf("failed to restore the vschema of keyspace %s after the clone failed: %v", targetKeyspace, restoreErr)
+		}
+	}()
+	if err := wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		return err
+	}
+
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:              opts.Workflow,
+		MaterializationIntent: vtctldatapb.MaterializationIntent_CLONEKEYSPACE,
+		SourceKeyspace:        sourceKeyspace,
+		TargetKeyspace:        targetKeyspace,
+		Cell:                  opts.Cell,
+		TabletTypes:           opts.TabletTypes,
+		StopAfterCopy:         true,
+		ExternalCluster:       opts.ExternalCluster,
+	}
+	for _, table := range tables {
+		sourceExpression := fmt.Sprintf("select * from %s", sqlescape.EscapeID(table))
+		if opts.SampleKeyRange != "" && len(vschema.Tables[table].GetColumnVindexes()) > 0 {
+			sourceExpression += fmt.Sprintf(" where in_keyrange('%s')", opts.SampleKeyRange)
+		}
+		ms.TableSettings = append(ms.TableSettings, &vtctldatapb.TableMaterializeSettings{
+			TargetTable:      table,
+			SourceExpression: sourceExpression,
+			CreateDdl:        createDDLAsCopy,
+		})
+	}
+	if opts.SchemaOnly {
+		mz, err := wr.buildMaterializer(ctx, ms)
+		if err != nil {
+			return err
+		}
+		return mz.deploySchema(ctx)
+	}
+	mz, err := wr.prepareMaterializerStreams(ctx, ms)
+	if err != nil {
+		return err
+	}
+	return mz.startStreams(ctx)
+}
+
+// cloneVSchemaReferences changes the sequences and the lookup vindexes of the
+// cloned vschema which refer to tables of the source keyspace to refer to the
+// clones of these tables. The sequences and the owned lookup vindexes whose
+// tables are not cloned are rejected, since the writes to the clone would
+// change them.
+func cloneVSchemaReferences(vschema *vschemapb.Keyspace, sourceKeyspace, targetKeyspace string) error {
+	// cloned returns the name of the clone of a table, or "" if the table is
+	// not cloned.
+	cloned := func(name string) (string, error) {
+		keyspace, table, err := sqlparser.ParseTable(name)
+		if err != nil {
+			return "", err
+		}
+		if keyspace != "" && keyspace != sourceKeyspace && keyspace != targetKeyspace {
+			return "", nil
+		}
+		if vschema.Tables[table] == nil {
+			// an unqualified table of another keyspace, or an excluded table
+			return "", nil
+		}
+		return fmt.Sprintf("%s.%s", targetKeyspace, table), nil
+	}
+
+	for name, table := range vschema.Tables {
+		if table.AutoIncrement == nil {
+			continue
+		}
+		sequence, err := cloned(table.AutoIncrement.Sequence)
+		if err != nil {
+			return err
+		}
+		if sequence == "" {
+			return fmt.Errorf("sequence %s of table %s is not cloned into keyspace %s", table.AutoIncrement.Sequence, name, targetKeyspace)
+		}
+		table.AutoIncrement.Sequence = sequence
+	}
+	for name, vindex := range vschema.Vindexes {
+		lookupTable := vindex.Params["table"]
+		if !strings.Contains(vindex.Type, "lookup") || lookupTable == "" {
+			continue
+		}
+		table, err := cloned(lookupTable)
+		if err != nil {
+			return err
+		}
+		if table == "" {
+			if vindex.Owner != "" {
+				return fmt.Errorf("table %s of owned lookup vindex %s is not cloned into keyspace %s", lookupTable, name, targetKeyspace)
+			}
+			continue
+		}
+		vindex.Params["table"] = table
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func newCloneKeyspaceEnv(t *testing.T, sources, targets []string) (*testMaterializerEnv, *vschemapb.Keyspace) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}, {
+			TargetTable:      "ref",
+			SourceExpression: "select * from ref",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, sources, targets)
+	delete(env.tmc.schema, "targetks.t1")
+	delete(env.tmc.schema, "targetks.ref")
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "c1",
+					Name:   "hash",
+				}},
+			},
+			"ref": {
+				Type: "reference",
+			},
+		},
+	}
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), "sourceks", vs))
+	return env, vs
+}
+
+func TestCloneKeyspace(t *testing.T) {
+	env, vs := newCloneKeyspaceEnv(t, []string{"-80", "80-"}, []string{"-80", "80-"})
+	defer env.close()
+
+	for _, tabletID := range []int{200, 210} {
+		env.tmc.expectVRQuery(tabletID, mzSelectFrozenQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, "ref_schema", &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, "/t1_schema", &sqltypes.Result{})
+	}
+	// Each target shard only streams from the source shard with the same key range, and the
+	// sampled rows of the sharded tables.
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\('workflow', 'keyspace:\\"sourceks\\" shard:\\"-80\\" filter:{rules:{match:\\"ref\\" filter:\\"select \* from `+"`ref`"+`\\"} `+
+			`rules:{match:\\"t1\\" filter:\\"select \* from t1 where in_keyrange\(c1, .*targetks.hash.*, .*-80.*\) and in_keyrange\(.*-40.*\)\\"}} stop_after_copy:true', [^)]*\)`+eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(
+		210,
+		insertPrefix+
+			`\('workflow', 'keyspace:\\"sourceks\\" shard:\\"80-\\" filter:{rules:{match:\\"ref\\" filter:\\"select \* from `+"`ref`"+`\\"} `+
+			`rules:{match:\\"t1\\" filter:\\"select \* from t1 where in_keyrange\(c1, .*targetks.hash.*, .*80-.*\) and in_keyrange\(.*-40.*\)\\"}} stop_after_copy:true', [^)]*\)`+eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, mzUpdateQuery, &sqltypes.Result{})
+
+	ctx := context.Background()
+	err := env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{
+		Workflow:       "workflow",
+		SampleKeyRange: "-40",
+	})
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	got, err := env.topoServ.GetVSchema(ctx, "targetks")
+	require.NoError(t, err)
+	utils.MustMatch(t, vs, got)
+}
+
+func TestCloneKeyspaceSchemaOnly(t *testing.T) {
+	env, _ := newCloneKeyspaceEnv(t, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	// No workflow is created, only the schema is copied.
+	env.tmc.vrQueries = make(map[int][]*queryResult)
+	env.tmc.expectVRQuery(200, "ref_schema", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "/t1_schema", &sqltypes.Result{})
+
+	err := env.wr.CloneKeyspace(context.Background(), "sourceks", "targetks", &CloneKeyspaceOptions{
+		Workflow:   "workflow",
+		SchemaOnly: true,
+	})
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestCloneKeyspaceFailures(t *testing.T) {
+	env, _ := newCloneKeyspaceEnv(t, []string{"0"}, []string{"0"})
+	defer env.close()
+	ctx := context.Background()
+
+	err := env.wr.CloneKeyspace(ctx, "sourceks", "sourceks", &CloneKeyspaceOptions{Workflow: "workflow"})
+	require.EqualError(t, err, "cannot clone keyspace sourceks into itself")
+
+	err = env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{Workflow: "workflow", SampleKeyRange: "-40-80"})
+	require.Error(t, err)
+
+	err = env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{Workflow: "workflow", ExcludeTables: []string{"t2"}})
+	require.EqualError(t, err, "table(s) not found in source keyspace sourceks: t2")
+
+	err = env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{Workflow: "workflow", Mask: true})
+	require.EqualError(t, err, "keyspace targetks is not a non-production keyspace of the masking policy")
+	require.NoError(t, env.topoServ.SaveMaskingPolicy(ctx, &binlogdatapb.MaskingPolicy{Keyspaces: []string{"targetks"}}))
+
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "sourceks", &vschemapb.Keyspace{}))
+	err = env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{Workflow: "workflow", Mask: true, SampleKeyRange: "-40"})
+	require.EqualError(t, err, "cannot sample keyspace sourceks which is not sharded")
+
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "targetks", &vschemapb.Keyspace{Tables: map[string]*vschemapb.Table{"t1": {}}}))
+	err = env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{Workflow: "workflow", Mask: true})
+	require.EqualError(t, err, "target keyspace targetks already has a vschema")
+}
+
+func TestCloneKeyspaceRestoresVSchema(t *testing.T) {
+	env, _ := newCloneKeyspaceEnv(t, []string{"0"}, []string{"0"})
+	defer env.close()
+	ctx := context.Background()
+
+	want, err := env.topoServ.GetVSchema(ctx, "targetks")
+	require.NoError(t, err)
+	// The streams cannot be created, since the tablets expect no queries.
+	env.tmc.vrQueries = make(map[int][]*queryResult)
+	err = env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{Workflow: "workflow"})
+	require.Error(t, err)
+	got, err := env.topoServ.GetVSchema(ctx, "targetks")
+	require.NoError(t, err)
+	utils.MustMatch(t, want, got)
+
+	require.NoError(t, env.topoServ.DeleteVSchema(ctx, "targetks"))
+	err = env.wr.CloneKeyspace(ctx, "sourceks", "targetks", &CloneKeyspaceOptions{Workflow: "workflow"})
+	require.Error(t, err)
+	_, err = env.topoServ.GetVSchema(ctx, "targetks")
+	require.True(t, topo.IsErrType(err, topo.NoNode), "expected NoNode, got %v", err)
+}
+
+func TestCloneVSchemaReferences(t *testing.T) {
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"t1_c2_lookup": {
+				Type:   "consistent_lookup_unique",
+				Params: map[string]string{"table": "sourceks.t1_c2_lookup", "from": "c2", "to": "keyspace_id"},
+				Owner:  "t1",
+			},
+			"other_lookup": {
+				Type:   "lookup_hash",
+				Params: map[string]string{"table": "otherks.lookup", "from": "c3", "to": "c1"},
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "hash"}},
+				AutoIncrement:  &vschemapb.AutoIncrement{Column: "c1", Sequence: "t1_seq"},
+			},
+			"t1_c2_lookup": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c2", Name: "hash"}},
+			},
+			"t1_seq": {
+				Type: "sequence",
+			},
+		},
+	}
+	require.NoError(t, cloneVSchemaReferences(vs, "sourceks", "targetks"))
+	require.Equal(t, "targetks.t1_seq", vs.Tables["t1"].AutoIncrement.Sequence)
+	require.Equal(t, "targetks.t1_c2_lookup", vs.Vindexes["t1_c2_lookup"].Params["table"])
+	// unowned lookup vindexes are only read
+	require.Equal(t, "otherks.lookup", vs.Vindexes["other_lookup"].Params["table"])
+
+	vs.Vindexes["other_lookup"].Owner = "t1"
+	err := cloneVSchemaReferences(vs, "sourceks", "targetks")
+	require.EqualError(t, err, "table otherks.lookup of owned lookup vindex other_lookup is not cloned into keyspace targetks")
+
+	delete(vs.Vindexes, "other_lookup")
+	vs.Tables["t1"].AutoIncrement.Sequence = "otherks.t1_seq"
+	err = cloneVSchemaReferences(vs, "sourceks", "targetks")
+	require.EqualError(t, err, "sequence otherks.t1_seq of table t1 is not cloned into keyspace targetks")
+}
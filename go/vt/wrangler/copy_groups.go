@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// copyPiece is a table, or a range of the primary key of a table, copied by
+// a stream.
+type copyPiece struct {
+	settings *vtctldatapb.TableMaterializeSettings
+	// pkRange is the condition on the primary key of the range, or nil if
+	// the whole table is copied.
+	pkRange sqlparser.Expr
+	// order is the order of the piece in the rules of the streams.
+	order int
+	size  int64
+}
+
+// copyGroups returns the pieces of the tables copied by each stream from a
+// source shard. Each target shard copies all the tables with a single stream,
+// unless the copy parallelism of the workflow is more than one. The tables are
+// then spread across that many streams, which copy in parallel. The tables
+// larger than an even share of the data are split into ranges of their primary
+// key first, if it's a single integral column, and the pieces are assigned from
+// the largest to the stream with the least data, so that the streams copy
+// about the same amount of data.
+func (mz *materializer) copyGroups(ctx context.Context, sourceShard *topo.ShardInfo) ([][]*copyPiece, error) {
+	if groups, ok := mz.sourceCopyGroups[sourceShard.ShardName()]; ok {
+		return groups, nil
+	}
+	pieces := make([]*copyPiece, 0, len(mz.ms.TableSettings))
+	for _, ts := range mz.ms.TableSettings {
+		pieces = append(pieces, &copyPiece{settings: ts, order: len(pieces)})
+	}
+	parallelism := int(mz.ms.CopyParallelism)
+	if parallelism <= 1 || len(pieces) == 0 {
+		return [][]*copyPiece{pieces}, nil
+	}
+
+	if sourceShard.PrimaryAlias == nil {
+		return nil, fmt.Errorf("source shard must have a primary for a parallel copy: %v", sourceShard.ShardName())
+	}
+	ti, err := mz.wr.sourceTs.GetTablet(ctx, sourceShard.PrimaryAlias)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for _, piece := range pieces {
+		if table := sourceTableName(piece.settings); table != "" {
+			tables = append(tables, table)
+		}
+	}
+	schema, err := mz.wr.tmc.GetSchema(ctx, ti.Tablet, tables, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	tableDefinitions := make(map[string]*tabletmanagerdatapb.TableDefinition)
+	for _, td := range schema.TableDefinitions {
+		tableDefinitions[td.Name] = td
+	}
+	var total int64
+	for _, piece := range pieces {
+		if td := tableDefinitions[sourceTableName(piece.settings)]; td != nil {
+			piece.size = int64(td.DataLength)
+		}
+		total += piece.size
+	}
+
+	share := total / int64(parallelism)
+	var split []*copyPiece
+	for _, piece := range pieces {
+		if share == 0 || piece.size <= share {
+			split = append(split, piece)
+			continue
+		}
+		count := (piece.size + share - 1) / share
+		if count > int64(parallelism) {
+			count = int64(parallelism)
+		}
+		ranges, err := mz.splitTable(ctx, ti.Tablet, piece, tableDefinitions[sourceTableName(piece.settings)], int(count))
+		if err != nil {
+			return nil, err
+		}
+		split = append(split, ranges...)
+	}
+	for i, piece := range split {
+		piece.order = i
+	}
+
+	sorted := append([]*copyPiece(nil), split...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].size > sorted[j].size
+	})
+	groups := make([][]*copyPiece, parallelism)
+	sizes := make([]int64, parallelism)
+	for _, piece := range sorted {
+		smallest := 0
+		for i, size := range sizes {
+			if size < sizes[smallest] {
+				smallest = i
+			}
+		}
+		groups[smallest] = append(groups[smallest], piece)
+		sizes[smallest] += piece.size
+	}
+	nonEmpty := groups[:0]
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].order < group[j].order
+		})
+		nonEmpty = append(nonEmpty, group)
+	}
+	if mz.sourceCopyGroups == nil {
+		mz.sourceCopyGroups = make(map[string][][]*copyPiece)
+	}
+	mz.sourceCopyGroups[sourceShard.ShardName()] = nonEmpty
+	return nonEmpty, nil
+}
+
+// splitTable splits a table into ranges of its primary key, of about the same
+// number of values between the current minimum and maximum of the key. The
+// first and the last ranges are open, to include the rows inserted later. The
+// table isn't split if its primary key isn't a single integral column.
+func (mz *materializer) splitTable(ctx context.Context, tablet *topodatapb.Tablet, piece *copyPiece,
+	td *tabletmanagerdatapb.TableDefinition, count int) ([]*copyPiece, error) {
+	whole := []*copyPiece{piece}
+	if td == nil || len(td.PrimaryKeyColumns) != 1 {
+		return whole, nil
+	}
+	pk := td.PrimaryKeyColumns[0]
+	integral := false
+	for _, field := range td.Fields {
+		if field.Name == pk {
+			integral = sqltypes.IsIntegral(field.Type) && field.Type != querypb.Type_UINT64
+		}
+	}
+	if !integral {
+		return whole, nil
+	}
+
+	col := &sqlparser.ColName{Name: sqlparser.NewColIdent(pk)}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select min(%v), max(%v) from %v", col, col, sqlparser.NewTableIdent(td.Name))
+	p3qr, err := mz.wr.tmc.ExecuteFetchAsDba(ctx, tablet, true, []byte(buf.String()), 1, false, false)
+	if err != nil {
+		return nil, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) != 1 || qr.Rows[0][0].IsNull() || qr.Rows[0][1].IsNull() {
+		return whole, nil
+	}
+	min, err := evalengine.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return nil, err
+	}
+	max, err := evalengine.ToInt64(qr.Rows[0][1])
+	if err != nil {
+		return nil, err
+	}
+	span := uint64(max) - uint64(min)
+	if span < uint64(count-1) {
+		count = int(span) + 1
+	}
+	if count <= 1 {
+		return whole, nil
+	}
+
+	step := span/uint64(count) + 1
+	bound := func(i int) sqlparser.Expr {
+		return sqlparser.NewIntLiteral(strconv.FormatInt(min+int64(step*uint64(i)), 10))
+	}
+	ranges := make([]*copyPiece, 0, count)
+	for i := 0; i < count; i++ {
+		var pkRange sqlparser.Expr
+		from := &sqlparser.ComparisonExpr{Operator: sqlparser.GreaterEqualOp, Left: col, Right: bound(i)}
+		to := &sqlparser.ComparisonExpr{Operator: sqlparser.LessThanOp, Left: col, Right: bound(i + 1)}
+		switch i {
+		case 0:
+			pkRange = to
+		case count - 1:
+			pkRange = from
+		default:
+			pkRange = &sqlparser.AndExpr{Left: from, Right: to}
+		}
+		ranges = append(ranges, &copyPiece{
+			settings: piece.settings,
+			pkRange:  pkRange,
+			size:     piece.size / int64(count),
+		})
+	}
+	return ranges, nil
+}
+
+// sourceTableName returns the name of the source table of a table, or an
+// empty name if its source expression doesn't select from a single table.
+func sourceTableName(ts *vtctldatapb.TableMaterializeSettings) string {
+	if ts.SourceExpression == "" {
+		return ts.TargetTable
+	}
+	table, err := sqlparser.TableFromStatement(ts.SourceExpression)
+	if err != nil {
+		return ""
+	}
+	return table.Name.String()
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file handles failover drills: planned reparents which are measured with
+synthetic traffic sent through vtgate.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// maxFailoverDrillErrors is the number of distinct errors kept in the report.
+const maxFailoverDrillErrors = 10
+
+// DrillExecutor runs the synthetic traffic of a failover drill. It is
+// usually a vtgate session targeting the primary of the shard.
+type DrillExecutor interface {
+	Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
+}
+
+// FailoverDrillOptions are the parameters of a failover drill.
+type FailoverDrillOptions struct {
+	// Table receives the verification writes. It must have an "id" bigint
+	// primary key and a "ts" bigint column.
+	Table string
+	// Before and After are how long the traffic runs before and after the
+	// reparent.
+	Before, After time.Duration
+	// Interval is the delay between two requests of the traffic.
+	Interval time.Duration
+	// BufferedLatency is the latency above which a successful request is
+	// considered as buffered by vtgate.
+	BufferedLatency time.Duration
+}
+
+// FailoverDrillReport describes the impact of a failover drill, as seen by
+// the clients of vtgate.
+type FailoverDrillReport struct {
+	Keyspace   string
+	Shard      string
+	OldPrimary string
+	NewPrimary string
+
+	Start            time.Time
+	ReparentDuration time.Duration
+	ReparentError    string `json:",omitempty"`
+
+	Writes       int
+	FailedWrites int
+	Reads        int
+	FailedReads  int
+	// MissingReads counts the reads which did not find the last
+	// acknowledged write.
+	MissingReads int
+
+	// ErrorDuration is the time between the first and the last failed
+	// request, i.e. how long the clients saw errors.
+	ErrorDuration time.Duration
+	// BufferedRequests counts the successful requests slower than
+	// BufferedLatency, i.e. the requests vtgate held during the reparent
+	// instead of failing them.
+	BufferedRequests int
+	MaxLatency       time.Duration
+	Errors           []string `json:",omitempty"`
+}
+
+// FailoverDrill runs a PlannedReparentShard while sending reads and writes
+// through the executor, and reports the errors and latencies seen by the
+// traffic. An error is only returned if the drill could not run; a failed
+// reparent is recorded in the report.
+func (wr *Wrangler) FailoverDrill(ctx context.Context, keyspace, shard string, primaryElectTabletAlias, avoidTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, executor DrillExecutor, opts FailoverDrillOptions) (*FailoverDrillReport, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	report := &FailoverDrillReport{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		OldPrimary: topoproto.TabletAliasString(si.PrimaryAlias),
+	}
+
+	err = runFailoverDrill(ctx, executor, opts, report, func(ctx context.Context) error {
+		wr.logger.Infof("Failover drill: reparenting %v/%v away from %v", keyspace, shard, report.OldPrimary)
+		return wr.PlannedReparentShard(ctx, keyspace, shard, primaryElectTabletAlias, avoidTabletAlias, waitReplicasTimeout)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	si, err = wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	report.NewPrimary = topoproto.TabletAliasString(si.PrimaryAlias)
+	return report, nil
+}
+
+// runFailoverDrill sends the traffic for the whole drill, and calls reparent
+// once the Before duration elapsed.
+func runFailoverDrill(ctx context.Context, executor DrillExecutor, opts FailoverDrillOptions, report *FailoverDrillReport, reparent func(context.Context) error) error {
+	table := sqlescape.EscapeID(opts.Table)
+	insertQuery := fmt.Sprintf("insert into %s(id, ts) values (:id, :ts)", table)
+	selectQuery := fmt.Sprintf("select id from %s where id = :id", table)
+
+	// Ids are based on the start time of the drill, so that the rows of
+	// consecutive drills do not collide.
+	report.Start = time.Now()
+	firstID := report.Start.UnixNano()
+	// lastID is the id of the last row the drill tried to insert, which
+	// bounds the rows to clean up.
+	lastID := firstID - 1
+
+	traffic := &drillTraffic{report: report, bufferedLatency: opts.BufferedLatency}
+	trafficCtx, stopTraffic := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+
+		nextID, lastWritten := firstID, int64(0)
+		for {
+			start := time.Now()
+			_, err := executor.Execute(trafficCtx, insertQuery, map[string]*querypb.BindVariable{
+				"id": sqltypes.Int64BindVariable(nextID),
+				"ts": sqltypes.Int64BindVariable(start.UnixNano()),
+			})
+			lastID = nextID
+			if trafficCtx.Err() != nil {
+				return
+			}
+			traffic.recordWrite(start, err)
+			if err == nil {
+				lastWritten = nextID
+			}
+			nextID++
+
+			if lastWritten != 0 {
+				start = time.Now()
+				qr, err := executor.Execute(trafficCtx, selectQuery, map[string]*querypb.BindVariable{
+					"id": sqltypes.Int64BindVariable(lastWritten),
+				})
+				if trafficCtx.Err() != nil {
+					return
+				}
+				traffic.recordRead(start, qr, err)
+			}
+
+			select {
+			case <-trafficCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	wait := func(d time.Duration) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d):
+			return nil
+		}
+	}
+	err := wait(opts.Before)
+	if err == nil {
+		reparentStart := time.Now()
+		if reparentErr := reparent(ctx); reparentErr != nil {
+			report.ReparentError = reparentErr.Error()
+		}
+		report.ReparentDuration = time.Since(reparentStart)
+		err = wait(opts.After)
+	}
+	stopTraffic()
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+
+	// The verification rows are not needed anymore. Only the ids the drill
+	// inserted are deleted, since the table may have other rows. A failed
+	// cleanup does not invalidate the drill.
+	if lastID < firstID {
+		return nil
+	}
+	if _, err := executor.Execute(ctx, fmt.Sprintf("delete from %s where id between :first and :last", table), map[string]*querypb.BindVariable{
+		"first": sqltypes.Int64BindVariable(firstID),
+		"last":  sqltypes.Int64BindVariable(lastID),
+	}); err != nil {
+		traffic.recordError(err)
+	}
+	return nil
+}
+
+// drillTraffic records the outcome of the requests of a failover drill.
+type drillTraffic struct {
+	bufferedLatency time.Duration
+
+	mu                        sync.Mutex
+	report                    *FailoverDrillReport
+	firstFailure, lastFailure time.Time
+}
+
+func (dt *drillTraffic) recordWrite(start time.Time, err error) {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+
+	dt.report.Writes++
+	if err != nil {
+		dt.report.FailedWrites++
+	}
+	dt.recordLocked(start, err)
+}
+
+func (dt *drillTraffic) recordRead(start time.Time, qr *sqltypes.Result, err error) {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+
+	dt.report.Reads++
+	switch {
+	case err != nil:
+		dt.report.FailedReads++
+	case len(qr.Rows) == 0:
+		dt.report.MissingReads++
+	}
+	dt.recordLocked(start, err)
+}
+
+func (dt *drillTraffic) recordLocked(start time.Time, err error) {
+	end := time.Now()
+	if err != nil {
+		if dt.firstFailure.IsZero() {
+			dt.firstFailure = start
+		}
+		dt.lastFailure = end
+		dt.report.ErrorDuration = dt.lastFailure.Sub(dt.firstFailure)
+		dt.recordErrorLocked(err)
+		return
+	}
+	latency := end.Sub(start)
+	if latency > dt.report.MaxLatency {
+		dt.report.MaxLatency = latency
+	}
+	if dt.bufferedLatency > 0 && latency >= dt.bufferedLatency {
+		dt.report.BufferedRequests++
+	}
+}
+
+func (dt *drillTraffic) recordError(err error) {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+
+	dt.recordErrorLocked(err)
+}
+
+func (dt *drillTraffic) recordErrorLocked(err error) {
+	if len(dt.report.Errors) >= maxFailoverDrillErrors {
+		return
+	}
+	msg := err.Error()
+	for _, e := range dt.report.Errors {
+		if e == msg {
+			return
+		}
+	}
+	dt.report.Errors = append(dt.report.Errors, msg)
+}
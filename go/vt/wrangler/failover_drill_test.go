@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// fakeDrillExecutor fails all the requests while the shard is reparenting,
+// and delays the requests sent right after the reparent.
+type fakeDrillExecutor struct {
+	mu          sync.Mutex
+	reparenting bool
+	buffering   bool
+	rows        map[int64]bool
+	deletes     int
+}
+
+func (fe *fakeDrillExecutor) Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+	if fe.reparenting {
+		return nil, errors.New("primary is not serving")
+	}
+	if fe.buffering {
+		fe.buffering = false
+		time.Sleep(20 * time.Millisecond)
+	}
+	if strings.HasPrefix(query, "delete from `drill` where id between :first and :last") {
+		fe.deletes++
+		first, _ := sqltypes.BindVariableToValue(bindVars["first"])
+		last, _ := sqltypes.BindVariableToValue(bindVars["last"])
+		firstID, _ := first.ToInt64()
+		lastID, _ := last.ToInt64()
+		for key := range fe.rows {
+			if key >= firstID && key <= lastID {
+				delete(fe.rows, key)
+			}
+		}
+		return &sqltypes.Result{}, nil
+	}
+	id, err := sqltypes.BindVariableToValue(bindVars["id"])
+	if err != nil {
+		return nil, err
+	}
+	key, _ := id.ToInt64()
+	switch {
+	case strings.HasPrefix(query, "insert into `drill`(id, ts)"):
+		fe.rows[key] = true
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	case strings.HasPrefix(query, "select id from `drill`"):
+		if !fe.rows[key] {
+			return &sqltypes.Result{}, nil
+		}
+		return &sqltypes.Result{Rows: [][]sqltypes.Value{{id}}}, nil
+	}
+	return nil, errors.New("unexpected query: " + query)
+}
+
+func (fe *fakeDrillExecutor) set(reparenting, buffering bool) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+	fe.reparenting, fe.buffering = reparenting, buffering
+}
+
+func TestRunFailoverDrill(t *testing.T) {
+	// a row written by the application after the drill started
+	applicationID := time.Now().Add(time.Hour).UnixNano()
+	executor := &fakeDrillExecutor{rows: map[int64]bool{applicationID: true}}
+	opts := FailoverDrillOptions{
+		Table:           "drill",
+		Before:          20 * time.Millisecond,
+		After:           50 * time.Millisecond,
+		Interval:        time.Millisecond,
+		BufferedLatency: 10 * time.Millisecond,
+	}
+	report := &FailoverDrillReport{}
+	err := runFailoverDrill(context.Background(), executor, opts, report, func(ctx context.Context) error {
+		executor.set(true, false)
+		time.Sleep(20 * time.Millisecond)
+		executor.set(false, true)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, report.ReparentError)
+	assert.GreaterOrEqual(t, report.ReparentDuration, 20*time.Millisecond)
+	assert.Greater(t, report.Writes, report.FailedWrites)
+	assert.Greater(t, report.FailedWrites, 0)
+	assert.Greater(t, report.Reads, report.FailedReads)
+	assert.Equal(t, 0, report.MissingReads)
+	assert.Greater(t, report.ErrorDuration, time.Duration(0))
+	assert.GreaterOrEqual(t, report.BufferedRequests, 1)
+	assert.GreaterOrEqual(t, report.MaxLatency, 20*time.Millisecond)
+	assert.Equal(t, []string{"primary is not serving"}, report.Errors)
+	assert.Equal(t, 1, executor.deletes)
+	assert.Equal(t, map[int64]bool{applicationID: true}, executor.rows)
+}
+
+func TestRunFailoverDrillReparentError(t *testing.T) {
+	executor := &fakeDrillExecutor{rows: make(map[int64]bool)}
+	opts := FailoverDrillOptions{
+		Table:    "drill",
+		Interval: time.Millisecond,
+	}
+	report := &FailoverDrillReport{}
+	err := runFailoverDrill(context.Background(), executor, opts, report, func(ctx context.Context) error {
+		return errors.New("primary-elect tablet is not a replica")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "primary-elect tablet is not a replica", report.ReparentError)
+	assert.Equal(t, 0, report.FailedWrites+report.FailedReads)
+}
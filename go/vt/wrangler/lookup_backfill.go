@@ -0,0 +1,321 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+const (
+	// lookupVDiffTabletTypes are the types of the tablets VDiff compares.
+	lookupVDiffTabletTypes = "primary,replica,rdonly"
+	// lookupVDiffWaitTime is how long VDiff waits for the streams and the
+	// tablets to catch up.
+	lookupVDiffWaitTime = 30 * time.Second
+)
+
+// lookupBackfill describes the backfill of a lookup vindex created by
+// CreateLookupVindex.
+type lookupBackfill struct {
+	sourceKeyspace string
+	sourceTable    string
+	vindexName     string
+	owned          bool
+	targetKeyspace string
+	targetTable    string
+	workflow       string
+}
+
+func newLookupBackfill(keyspace string, specs *vschemapb.Keyspace) (*lookupBackfill, error) {
+	if len(specs.Vindexes) != 1 || len(specs.Tables) != 1 {
+		return nil, fmt.Errorf("exactly one vindex and one table must be specified in the specs: %v", specs)
+	}
+	lb := &lookupBackfill{sourceKeyspace: keyspace}
+	for name, vindex := range specs.Vindexes {
+		lb.vindexName = name
+		lb.owned = vindex.Owner != ""
+		strs := strings.Split(vindex.Params["table"], ".")
+		if len(strs) != 2 {
+			return nil, fmt.Errorf("vindex 'table' must be <keyspace>.<table>: %v", vindex)
+		}
+		lb.targetKeyspace, lb.targetTable = strs[0], strs[1]
+	}
+	for name, table := range specs.Tables {
+		if len(table.ColumnVindexes) != 1 {
+			return nil, fmt.Errorf("exactly one ColumnVindex must be specified for the table: %v", specs.Tables)
+		}
+		lb.sourceTable = name
+	}
+	lb.workflow = lb.targetTable + "_vdx"
+	return lb, nil
+}
+
+func (lb *lookupBackfill) String() string {
+	return lb.sourceKeyspace + "." + lb.vindexName
+}
+
+// BackfillLookupVindex creates a lookup vindex and backfills it like
+// CreateLookupVindex, waits for the end of the backfill, logging its
+// progress every pollInterval, diffs the lookup table against the source
+// table with VDiff and then externalizes the vindex. If the vindex has an
+// owner, the streams stop after the copy and are deleted, otherwise they
+// keep the lookup table up to date after it. If the workflow of the
+// backfill already exists, the vindex is not created again: the backfill
+// which did not complete is resumed.
+func (wr *Wrangler) BackfillLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string, pollInterval time.Duration) error {
+	lb, err := newLookupBackfill(keyspace, specs)
+	if err != nil {
+		return err
+	}
+	started, err := wr.lookupBackfillStarted(ctx, lb)
+	if err != nil {
+		return err
+	}
+	if started {
+		wr.Logger().Printf("Resuming the backfill of lookup vindex %v with workflow %v\n", lb, lb.workflow)
+	} else {
+		if err := wr.CreateLookupVindex(ctx, keyspace, specs, cell, tabletTypes, false); err != nil {
+			return err
+		}
+		wr.Logger().Printf("Created lookup vindex %v, backfilling %v.%v with workflow %v\n", lb, lb.targetKeyspace, lb.targetTable, lb.workflow)
+	}
+
+	if err := wr.waitForLookupBackfill(ctx, lb, pollInterval); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Backfill of lookup vindex %v is complete, verifying %v.%v\n", lb, lb.targetKeyspace, lb.targetTable)
+	if err := wr.verifyLookupBackfill(ctx, lb); err != nil {
+		return err
+	}
+	if err := wr.ExternalizeVindex(ctx, lb.String()); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Externalized lookup vindex %v\n", lb)
+	return nil
+}
+
+// lookupBackfillStarted returns true if the workflow of the backfill has
+// streams on a shard of the target keyspace.
+func (wr *Wrangler) lookupBackfillStarted(ctx context.Context, lb *lookupBackfill) (bool, error) {
+	started := false
+	err := wr.forAllLookupPrimaries(ctx, lb.targetKeyspace, func(_ *topo.ShardInfo, primary *topo.TabletInfo) error {
+		p3qr, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, fmt.Sprintf("select 1 from _vt.vreplication where db_name=%s and workflow=%s", encodeString(primary.DbName()), encodeString(lb.workflow)))
+		if err != nil {
+			return err
+		}
+		if len(p3qr.Rows) != 0 {
+			started = true
+		}
+		return nil
+	})
+	return started, err
+}
+
+// waitForLookupBackfill waits until the streams of all the target shards
+// have copied the source table.
+func (wr *Wrangler) waitForLookupBackfill(ctx context.Context, lb *lookupBackfill, pollInterval time.Duration) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		done, total, err := wr.lookupBackfillStreamsDone(ctx, lb)
+		if err != nil {
+			return err
+		}
+		if done == total {
+			return nil
+		}
+		if err := wr.logLookupBackfillProgress(ctx, lb, done, total); err != nil {
+			wr.Logger().Warningf("Could not get the progress of the backfill of lookup vindex %v: %v", lb, err)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("backfill of lookup vindex %v did not complete: %v, run BackfillLookupVindex again to resume it", lb, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// lookupBackfillStreamsDone returns the number of streams which have
+// completed the copy, and the number of streams. It fails if a stream
+// errored or was stopped before the end of the copy.
+func (wr *Wrangler) lookupBackfillStreamsDone(ctx context.Context, lb *lookupBackfill) (done, total int, err error) {
+	err = wr.forAllLookupPrimaries(ctx, lb.targetKeyspace, func(shard *topo.ShardInfo, primary *topo.TabletInfo) error {
+		p3qr, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, fmt.Sprintf("select id, state, message from _vt.vreplication where workflow=%s and db_name=%s", encodeString(lb.workflow), encodeString(primary.DbName())))
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		if len(qr.Rows) == 0 {
+			return fmt.Errorf("no streams found for workflow %v on %v.%v", lb.workflow, shard.Keyspace(), shard.ShardName())
+		}
+		var running []string
+		for _, row := range qr.Rows {
+			id, err := evalengine.ToInt64(row[0])
+			if err != nil {
+				return err
+			}
+			state := row[1].ToString()
+			message := row[2].ToString()
+			switch {
+			case state == binlogplayer.BlpError:
+				return fmt.Errorf("stream %d for %v.%v failed: %v", id, shard.Keyspace(), shard.ShardName(), message)
+			case lb.owned && state == binlogplayer.BlpStopped && strings.Contains(message, "Stopped after copy"):
+				done++
+			case state == binlogplayer.BlpStopped:
+				return fmt.Errorf("stream %d for %v.%v was stopped before the end of the copy: %v", id, shard.Keyspace(), shard.ShardName(), message)
+			case !lb.owned && state == binlogplayer.BlpRunning:
+				running = append(running, fmt.Sprintf("%d", id))
+			}
+			total++
+		}
+		if len(running) == 0 {
+			return nil
+		}
+		// The streams which keep running after the copy are done once they
+		// have no table left to copy.
+		p3qr, err = wr.tmc.VReplicationExec(ctx, primary.Tablet, fmt.Sprintf("select distinct vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(running, ", ")))
+		if err != nil {
+			return err
+		}
+		done += len(running) - len(p3qr.Rows)
+		return nil
+	})
+	return done, total, err
+}
+
+// logLookupBackfillProgress logs the estimated number of rows of the lookup
+// table and of the source table.
+func (wr *Wrangler) logLookupBackfillProgress(ctx context.Context, lb *lookupBackfill, done, total int) error {
+	sourceRows, err := wr.sumTableRows(ctx, lb.sourceKeyspace, lb.sourceTable)
+	if err != nil {
+		return err
+	}
+	targetRows, err := wr.sumTableRows(ctx, lb.targetKeyspace, lb.targetTable)
+	if err != nil {
+		return err
+	}
+	progress := ""
+	if sourceRows > 0 {
+		progress = fmt.Sprintf(" (%.1f%%)", float64(targetRows)*100/float64(sourceRows))
+	}
+	wr.Logger().Printf("Backfill of lookup vindex %v: about %d of %d rows copied%s, %d of %d streams done\n", lb, targetRows, sourceRows, progress, done, total)
+	return nil
+}
+
+// sumTableRows returns the estimated number of rows of a table over all the
+// shards of its keyspace.
+func (wr *Wrangler) sumTableRows(ctx context.Context, keyspace, table string) (int64, error) {
+	var rows int64
+	err := wr.forAllLookupPrimaries(ctx, keyspace, func(_ *topo.ShardInfo, primary *topo.TabletInfo) error {
+		query := fmt.Sprintf("select table_rows from information_schema.tables where table_schema = %s and table_name = %s", encodeString(primary.DbName()), encodeString(table))
+		n, err := wr.selectLookupCount(ctx, primary, query)
+		rows += n
+		return err
+	})
+	return rows, err
+}
+
+// vdiffLookupBackfill diffs the lookup table of a backfill against its source
+// table with VDiff. It's a variable so that it can be replaced by the tests.
+var vdiffLookupBackfill = func(ctx context.Context, wr *Wrangler, lb *lookupBackfill) (*DiffReport, error) {
+	reports, err := wr.VDiff(ctx, lb.targetKeyspace, lb.workflow, "", "", lookupVDiffTabletTypes, lookupVDiffWaitTime, "", math.MaxInt64, lb.targetTable, false, true, false, "", 1)
+	if err != nil {
+		return nil, err
+	}
+	dr, ok := reports[lb.targetTable]
+	if !ok {
+		return nil, fmt.Errorf("no diff of table %v.%v", lb.targetKeyspace, lb.targetTable)
+	}
+	return dr, nil
+}
+
+// verifyLookupBackfill diffs the lookup table against the source table with
+// VDiff. VDiff restarts the streams of the workflow, so the streams of an
+// owned vindex are stopped again.
+func (wr *Wrangler) verifyLookupBackfill(ctx context.Context, lb *lookupBackfill) error {
+	dr, err := vdiffLookupBackfill(ctx, wr, lb)
+	if lb.owned {
+		if stopErr := wr.stopLookupBackfillStreams(ctx, lb); stopErr != nil && err == nil {
+			err = stopErr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("verification of lookup vindex %v did not complete: %v", lb, err)
+	}
+	if dr.MismatchedRows != 0 || dr.ExtraRowsSource != 0 || dr.ExtraRowsTarget != 0 {
+		return fmt.Errorf("lookup vindex %v is inconsistent: %d mismatched rows, %d rows missing from %v.%v, %d extra rows in %v.%v", lb, dr.MismatchedRows, dr.ExtraRowsSource, lb.targetKeyspace, lb.targetTable, dr.ExtraRowsTarget, lb.targetKeyspace, lb.targetTable)
+	}
+	wr.Logger().Printf("Lookup vindex %v is consistent: %d rows in %v.%v\n", lb, dr.MatchingRows, lb.targetKeyspace, lb.targetTable)
+	return nil
+}
+
+// stopLookupBackfillStreams stops the streams of the workflow of an owned
+// vindex like at the end of their copy, as ExternalizeVindex expects.
+func (wr *Wrangler) stopLookupBackfillStreams(ctx context.Context, lb *lookupBackfill) error {
+	return wr.forAllLookupPrimaries(ctx, lb.targetKeyspace, func(_ *topo.ShardInfo, primary *topo.TabletInfo) error {
+		query := fmt.Sprintf("update _vt.vreplication set state='Stopped', message='Stopped after copy' where workflow=%s and db_name=%s", encodeString(lb.workflow), encodeString(primary.DbName()))
+		_, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+		return err
+	})
+}
+
+// selectLookupCount returns the count returned by a query selecting one
+// number, or zero if it returns no row or a NULL.
+func (wr *Wrangler) selectLookupCount(ctx context.Context, primary *topo.TabletInfo, query string) (int64, error) {
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, primary.Tablet, true, []byte(query), 1, false, false)
+	if err != nil {
+		return 0, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 || qr.Rows[0][0].IsNull() {
+		return 0, nil
+	}
+	return evalengine.ToInt64(qr.Rows[0][0])
+}
+
+// forAllLookupPrimaries calls f for the primary of every serving shard of a
+// keyspace, one shard after the other.
+func (wr *Wrangler) forAllLookupPrimaries(ctx context.Context, keyspace string, f func(*topo.ShardInfo, *topo.TabletInfo) error) error {
+	shards, err := wr.ts.GetServingShards(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if shard.PrimaryAlias == nil {
+			return fmt.Errorf("shard %v.%v has no primary", shard.Keyspace(), shard.ShardName())
+		}
+		primary, err := wr.ts.GetTablet(ctx, shard.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		if err := f(shard, primary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func newLookupBackfillEnv(t *testing.T, owner string) (*testMaterializerEnv, *vschemapb.Keyspace) {
+	t.Helper()
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "lkp_vdx",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+
+	specs := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"v": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table": "targetks.lkp",
+					"from":  "c1",
+					"to":    "c2",
+				},
+				Owner: owner,
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "v",
+					Column: "col2",
+				}},
+			},
+		},
+	}
+	sourceSchema := "CREATE TABLE `t1` (\n" +
+		"  `col1` int(11) NOT NULL AUTO_INCREMENT,\n" +
+		"  `col2` int(11) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=latin1"
+	env.tmc.schema[ms.SourceKeyspace+".t1"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Fields: []*querypb.Field{{
+				Name: "col1",
+				Type: querypb.Type_INT64,
+			}, {
+				Name: "col2",
+				Type: querypb.Type_INT64,
+			}},
+			Schema: sourceSchema,
+		}},
+	}
+	sourceVSchema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "hash",
+					Column: "col1",
+				}},
+			},
+		},
+	}
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), ms.TargetKeyspace, &vschemapb.Keyspace{}))
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), ms.SourceKeyspace, sourceVSchema))
+
+	return env, specs
+}
+
+// expectLookupVindexCreated expects the queries of CreateLookupVindex, once
+// the workflow is found not to exist yet by the query expected by the env.
+func expectLookupVindexCreated(env *testMaterializerEnv) {
+	env.tmc.expectVRQuery(200, lookupStartedQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "/CREATE TABLE `lkp`", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "update _vt.vreplication set state='Running' where db_name='vt_targetks' and workflow='lkp_vdx'", &sqltypes.Result{})
+}
+
+// setLookupVDiff replaces the VDiff of the lookup table by one returning dr
+// and err, and returns the function restoring it.
+func setLookupVDiff(dr *DiffReport, err error) func() {
+	vdiff := vdiffLookupBackfill
+	vdiffLookupBackfill = func(context.Context, *Wrangler, *lookupBackfill) (*DiffReport, error) {
+		return dr, err
+	}
+	return func() {
+		vdiffLookupBackfill = vdiff
+	}
+}
+
+const (
+	lookupStartedQuery = "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='lkp_vdx'"
+	lookupStreamsQuery = "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	lookupStopQuery    = "update _vt.vreplication set state='Stopped', message='Stopped after copy' where workflow='lkp_vdx' and db_name='vt_targetks'"
+)
+
+func lookupStreams(state, message string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|state|message", "int64|varbinary|varbinary"), "1|"+state+"|"+message)
+}
+
+func lookupCount(n string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("count", "int64"), n)
+}
+
+func TestBackfillLookupVindexOwned(t *testing.T) {
+	env, specs := newLookupBackfillEnv(t, "t1")
+	defer env.close()
+	defer setLookupVDiff(&DiffReport{ProcessedRows: 90, MatchingRows: 90}, nil)()
+	logger := logutil.NewMemoryLogger()
+	env.wr = New(logger, env.topoServ, env.tmc)
+	expectLookupVindexCreated(env)
+
+	// The first poll finds the streams still copying and reports the progress.
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Running", ""))
+	env.tmc.expectVRQuery(100, "select table_rows from information_schema.tables where table_schema = 'vt_sourceks' and table_name = 't1'", lookupCount("100"))
+	env.tmc.expectVRQuery(200, "select table_rows from information_schema.tables where table_schema = 'vt_targetks' and table_name = 'lkp'", lookupCount("40"))
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Stopped", "Stopped after copy"))
+	// The streams restarted by VDiff are stopped again.
+	env.tmc.expectVRQuery(200, lookupStopQuery, &sqltypes.Result{})
+	// ExternalizeVindex deletes the streams stopped after the copy.
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Stopped", "Stopped after copy"))
+	env.tmc.expectVRQuery(200, "delete from _vt.vreplication where db_name='vt_targetks' and workflow='lkp_vdx'", &sqltypes.Result{})
+
+	ctx := context.Background()
+	err := env.wr.BackfillLookupVindex(ctx, "sourceks", specs, "cell", "PRIMARY", time.Millisecond)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	vschema, err := env.topoServ.GetVSchema(ctx, "sourceks")
+	require.NoError(t, err)
+	_, writeOnly := vschema.Vindexes["v"].Params["write_only"]
+	assert.False(t, writeOnly)
+	logs := logger.String()
+	assert.Contains(t, logs, "Backfill of lookup vindex sourceks.v: about 40 of 100 rows copied (40.0%), 0 of 1 streams done")
+	assert.Contains(t, logs, "Lookup vindex sourceks.v is consistent: 90 rows in targetks.lkp")
+}
+
+func TestBackfillLookupVindexUnowned(t *testing.T) {
+	env, specs := newLookupBackfillEnv(t, "")
+	defer env.close()
+	defer setLookupVDiff(&DiffReport{ProcessedRows: 90, MatchingRows: 90}, nil)()
+	expectLookupVindexCreated(env)
+
+	// A running stream is done once it has no table left to copy.
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Running", ""))
+	env.tmc.expectVRQuery(200, "select distinct vrepl_id from _vt.copy_state where vrepl_id in (1)", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Running", ""))
+
+	ctx := context.Background()
+	err := env.wr.BackfillLookupVindex(ctx, "sourceks", specs, "cell", "PRIMARY", time.Millisecond)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestBackfillLookupVindexResume(t *testing.T) {
+	env, specs := newLookupBackfillEnv(t, "t1")
+	defer env.close()
+	defer setLookupVDiff(&DiffReport{ProcessedRows: 90, MatchingRows: 90}, nil)()
+	ctx := context.Background()
+	expectLookupVindexCreated(env)
+
+	// The backfill times out while the streams are copying.
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Running", ""))
+	env.tmc.expectVRQuery(100, "select table_rows from information_schema.tables where table_schema = 'vt_sourceks' and table_name = 't1'", lookupCount("100"))
+	env.tmc.expectVRQuery(200, "select table_rows from information_schema.tables where table_schema = 'vt_targetks' and table_name = 'lkp'", lookupCount("40"))
+	timeoutCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err := env.wr.BackfillLookupVindex(timeoutCtx, "sourceks", specs, "cell", "PRIMARY", time.Hour)
+	assert.EqualError(t, err, "backfill of lookup vindex sourceks.v did not complete: context canceled, run BackfillLookupVindex again to resume it")
+	env.tmc.verifyQueries(t)
+
+	// The vindex is not created again.
+	env.tmc.expectVRQuery(200, lookupStartedQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"))
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Stopped", "Stopped after copy"))
+	env.tmc.expectVRQuery(200, lookupStopQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Stopped", "Stopped after copy"))
+	env.tmc.expectVRQuery(200, "delete from _vt.vreplication where db_name='vt_targetks' and workflow='lkp_vdx'", &sqltypes.Result{})
+	err = env.wr.BackfillLookupVindex(ctx, "sourceks", specs, "cell", "PRIMARY", time.Millisecond)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestBackfillLookupVindexErrors(t *testing.T) {
+	t.Run("stream error", func(t *testing.T) {
+		env, specs := newLookupBackfillEnv(t, "t1")
+		defer env.close()
+		expectLookupVindexCreated(env)
+		env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Error", "duplicate entry"))
+
+		err := env.wr.BackfillLookupVindex(context.Background(), "sourceks", specs, "cell", "PRIMARY", time.Millisecond)
+		assert.EqualError(t, err, "stream 1 for targetks.0 failed: duplicate entry")
+		env.tmc.verifyQueries(t)
+	})
+	t.Run("inconsistent", func(t *testing.T) {
+		env, specs := newLookupBackfillEnv(t, "t1")
+		defer env.close()
+		defer setLookupVDiff(&DiffReport{ProcessedRows: 90, MatchingRows: 88, MismatchedRows: 1, ExtraRowsSource: 1}, nil)()
+		expectLookupVindexCreated(env)
+		env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Stopped", "Stopped after copy"))
+		env.tmc.expectVRQuery(200, lookupStopQuery, &sqltypes.Result{})
+
+		ctx := context.Background()
+		err := env.wr.BackfillLookupVindex(ctx, "sourceks", specs, "cell", "PRIMARY", time.Millisecond)
+		assert.EqualError(t, err, "lookup vindex sourceks.v is inconsistent: 1 mismatched rows, 1 rows missing from targetks.lkp, 0 extra rows in targetks.lkp")
+		env.tmc.verifyQueries(t)
+
+		// The vindex is not externalized.
+		vschema, err := env.topoServ.GetVSchema(ctx, "sourceks")
+		require.NoError(t, err)
+		assert.Equal(t, "true", vschema.Vindexes["v"].Params["write_only"])
+	})
+	t.Run("vdiff error", func(t *testing.T) {
+		env, specs := newLookupBackfillEnv(t, "t1")
+		defer env.close()
+		defer setLookupVDiff(nil, errors.New("no tablets"))()
+		expectLookupVindexCreated(env)
+		env.tmc.expectVRQuery(200, lookupStreamsQuery, lookupStreams("Stopped", "Stopped after copy"))
+		env.tmc.expectVRQuery(200, lookupStopQuery, &sqltypes.Result{})
+
+		err := env.wr.BackfillLookupVindex(context.Background(), "sourceks", specs, "cell", "PRIMARY", time.Millisecond)
+		assert.EqualError(t, err, "verification of lookup vindex sourceks.v did not complete: no tablets")
+		env.tmc.verifyQueries(t)
+	})
+}
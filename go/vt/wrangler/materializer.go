@@ -45,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -58,6 +59,9 @@ type materializer struct {
 	targetVSchema *vindexes.KeyspaceSchema
 	sourceShards  []*topo.ShardInfo
 	targetShards  []*topo.ShardInfo
+
+	// sourceCopyGroups caches the copy groups of the source shards.
+	sourceCopyGroups map[string][][]*copyPiece
 }
 
 const (
@@ -68,7 +72,7 @@ const (
 // MoveTables initiates moving table(s) over to another keyspace
 func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs,
 	cell, tabletTypes string, allTables bool, excludeTables string, autoStart, stopAfterCopy bool,
-	externalCluster string) error {
+	externalCluster string, copyParallelism int) error {
 	//FIXME validate tableSpecs, allTables, excludeTables
 	var tables []string
 	var externalTopo *topo.Server
@@ -199,6 +203,7 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		TabletTypes:           tabletTypes,
 		StopAfterCopy:         stopAfterCopy,
 		ExternalCluster:       externalCluster,
+		CopyParallelism:       int32(copyParallelism),
 	}
 	for _, table := range tables {
 		buf := sqlparser.NewTrackedBuffer(nil)
@@ -1037,88 +1042,118 @@ func (mz *materializer) generateInserts(ctx context.Context, targetShard *topo.S
 
 	for _, sourceShard := range mz.sourceShards {
 		// Don't create streams from sources which won't contain data for the target shard.
-		// We only do it for MoveTables for now since this doesn't hold for materialize flows
+		// We only do it for MoveTables and CloneKeyspace for now since this doesn't hold for materialize flows
 		// where the target's sharding key might differ from that of the source
-		if mz.ms.MaterializationIntent == vtctldatapb.MaterializationIntent_MOVETABLES &&
+		if (mz.ms.MaterializationIntent == vtctldatapb.MaterializationIntent_MOVETABLES ||
+			mz.ms.MaterializationIntent == vtctldatapb.MaterializationIntent_CLONEKEYSPACE) &&
 			!key.KeyRangesIntersect(sourceShard.KeyRange, targetShard.KeyRange) {
 			continue
 		}
-		bls := &binlogdatapb.BinlogSource{
-			Keyspace:        mz.ms.SourceKeyspace,
-			Shard:           sourceShard.ShardName(),
-			Filter:          &binlogdatapb.Filter{},
-			StopAfterCopy:   mz.ms.StopAfterCopy,
-			ExternalCluster: mz.ms.ExternalCluster,
-		}
-		for _, ts := range mz.ms.TableSettings {
-			rule := &binlogdatapb.Rule{
-				Match: ts.TargetTable,
+		groups, err := mz.copyGroups(ctx, sourceShard)
+		if err != nil {
+			return "", err
+		}
+		for _, group := range groups {
+			bls := &binlogdatapb.BinlogSource{
+				Keyspace:        mz.ms.SourceKeyspace,
+				Shard:           sourceShard.ShardName(),
+				Filter:          &binlogdatapb.Filter{},
+				StopAfterCopy:   mz.ms.StopAfterCopy,
+				ExternalCluster: mz.ms.ExternalCluster,
 			}
-
-			if ts.SourceExpression == "" {
+			for _, piece := range group {
+				rule, err := mz.generateRule(piece.settings, piece.pkRange)
+				if err != nil {
+					return "", err
+				}
 				bls.Filter.Rules = append(bls.Filter.Rules, rule)
-				continue
 			}
+			ig.AddRow(mz.ms.Workflow, bls, "", mz.ms.Cell, mz.ms.TabletTypes)
+		}
+	}
+	return ig.String(), nil
+}
+
+// generateRule returns the rule of the stream copying a table, or the range
+// of its primary key matched by pkRange if it isn't nil.
+func (mz *materializer) generateRule(ts *vtctldatapb.TableMaterializeSettings, pkRange sqlparser.Expr) (*binlogdatapb.Rule, error) {
+	rule := &binlogdatapb.Rule{
+		Match:      ts.TargetTable,
+		AppendOnly: ts.AppendOnly,
+	}
+
+	sourceExpression := ts.SourceExpression
+	if sourceExpression == "" {
+		if pkRange == nil {
+			return rule, nil
+		}
+		sourceExpression = sqlparser.String(&sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+			From:        sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent(ts.TargetTable)}}},
+		})
+	}
 
-			// Validate non-empty query.
-			stmt, err := sqlparser.Parse(ts.SourceExpression)
+	// Validate non-empty query.
+	stmt, err := sqlparser.Parse(sourceExpression)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unrecognized statement: %s", sourceExpression)
+	}
+	if err := vstreamer.ValidateFilterWhere(sel.Where); err != nil {
+		return nil, fmt.Errorf("invalid filter for table %s: %v", ts.TargetTable, err)
+	}
+	filter := sourceExpression
+	if pkRange != nil {
+		sel.AddWhere(pkRange)
+		filter = sqlparser.String(sel)
+	}
+	if mz.targetVSchema.Keyspace.Sharded && mz.targetVSchema.Tables[ts.TargetTable].Type != vindexes.TypeReference {
+		cv, err := vindexes.FindBestColVindex(mz.targetVSchema.Tables[ts.TargetTable])
+		if err != nil {
+			return nil, err
+		}
+		mappedCols := make([]*sqlparser.ColName, 0, len(cv.Columns))
+		for _, col := range cv.Columns {
+			colName, err := matchColInSelect(col, sel)
 			if err != nil {
-				return "", err
+				return nil, err
 			}
-			sel, ok := stmt.(*sqlparser.Select)
-			if !ok {
-				return "", fmt.Errorf("unrecognized statement: %s", ts.SourceExpression)
+			mappedCols = append(mappedCols, colName)
+		}
+		subExprs := make(sqlparser.SelectExprs, 0, len(mappedCols)+2)
+		for _, mappedCol := range mappedCols {
+			subExprs = append(subExprs, &sqlparser.AliasedExpr{Expr: mappedCol})
+		}
+		vindexName := fmt.Sprintf("%s.%s", mz.ms.TargetKeyspace, cv.Name)
+		subExprs = append(subExprs, &sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(vindexName)})
+		subExprs = append(subExprs, &sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral("{{.keyrange}}")})
+		inKeyRange := &sqlparser.FuncExpr{
+			Name:  sqlparser.NewColIdent("in_keyrange"),
+			Exprs: subExprs,
+		}
+		if sel.Where != nil {
+			sel.Where = &sqlparser.Where{
+				Type: sqlparser.WhereClause,
+				Expr: &sqlparser.AndExpr{
+					Left:  inKeyRange,
+					Right: sel.Where.Expr,
+				},
 			}
-			filter := ts.SourceExpression
-			if mz.targetVSchema.Keyspace.Sharded && mz.targetVSchema.Tables[ts.TargetTable].Type != vindexes.TypeReference {
-				cv, err := vindexes.FindBestColVindex(mz.targetVSchema.Tables[ts.TargetTable])
-				if err != nil {
-					return "", err
-				}
-				mappedCols := make([]*sqlparser.ColName, 0, len(cv.Columns))
-				for _, col := range cv.Columns {
-					colName, err := matchColInSelect(col, sel)
-					if err != nil {
-						return "", err
-					}
-					mappedCols = append(mappedCols, colName)
-				}
-				subExprs := make(sqlparser.SelectExprs, 0, len(mappedCols)+2)
-				for _, mappedCol := range mappedCols {
-					subExprs = append(subExprs, &sqlparser.AliasedExpr{Expr: mappedCol})
-				}
-				vindexName := fmt.Sprintf("%s.%s", mz.ms.TargetKeyspace, cv.Name)
-				subExprs = append(subExprs, &sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(vindexName)})
-				subExprs = append(subExprs, &sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral("{{.keyrange}}")})
-				inKeyRange := &sqlparser.FuncExpr{
-					Name:  sqlparser.NewColIdent("in_keyrange"),
-					Exprs: subExprs,
-				}
-				if sel.Where != nil {
-					sel.Where = &sqlparser.Where{
-						Type: sqlparser.WhereClause,
-						Expr: &sqlparser.AndExpr{
-							Left:  inKeyRange,
-							Right: sel.Where.Expr,
-						},
-					}
-				} else {
-					sel.Where = &sqlparser.Where{
-						Type: sqlparser.WhereClause,
-						Expr: inKeyRange,
-					}
-				}
-
-				filter = sqlparser.String(sel)
+		} else {
+			sel.Where = &sqlparser.Where{
+				Type: sqlparser.WhereClause,
+				Expr: inKeyRange,
 			}
-
-			rule.Filter = filter
-
-			bls.Filter.Rules = append(bls.Filter.Rules, rule)
 		}
-		ig.AddRow(mz.ms.Workflow, bls, "", mz.ms.Cell, mz.ms.TabletTypes)
+
+		filter = sqlparser.String(sel)
 	}
-	return ig.String(), nil
+
+	rule.Filter = filter
+	return rule, nil
 }
 
 func matchColInSelect(col sqlparser.ColIdent, sel *sqlparser.Select) (*sqlparser.ColName, error) {
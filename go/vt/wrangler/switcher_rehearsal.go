@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// switchRehearsal records the checks of a rehearsal of SwitchWrites.
+type switchRehearsal struct {
+	ts       *trafficSwitcher
+	drLog    *LogRecorder
+	failures []string
+}
+
+// RehearseSwitchWrites rehearses SwitchWrites for a workflow. On top of the
+// plan of a dry run, it exercises the preconditions of the cutover and rolls
+// back what it changed:
+//   - the streams of the workflow are running, and lag less than the timeout,
+//   - the time is within the cutover windows of the workflow, if it has any,
+//   - the source and target keyspaces can be locked,
+//   - the shard records holding the denied tables can be written,
+//   - the reverse workflow can be created, as stopped streams which are
+//     deleted right after.
+//
+// The source writes are never stopped, and no traffic is switched. It returns
+// the plan followed by the results of the checks, or an error listing the
+// failed checks.
+func (wr *Wrangler) RehearseSwitchWrites(ctx context.Context, targetKeyspace, workflowName string, timeout time.Duration,
+	reverseReplication bool) (*[]string, error) {
+	_, plan, err := wr.SwitchWrites(ctx, targetKeyspace, workflowName, timeout, false, false, reverseReplication, true)
+	if err != nil {
+		return nil, err
+	}
+	ts, _, err := wr.getWorkflowState(ctx, targetKeyspace, workflowName)
+	if err != nil {
+		return nil, err
+	}
+	if ts == nil || ts.frozen {
+		return plan, nil
+	}
+
+	r := &switchRehearsal{ts: ts, drLog: NewLogRecorder()}
+	for _, log := range *plan {
+		r.drLog.Log(log)
+	}
+	r.drLog.Log("Rehearsal of the cutover:")
+	r.rehearse(ctx, timeout, reverseReplication)
+	logs := r.drLog.GetLogs()
+	if len(r.failures) > 0 {
+		return &logs, fmt.Errorf("rehearsal of SwitchWrites for workflow %s.%s failed: %s",
+			targetKeyspace, workflowName, strings.Join(r.failures, "; "))
+	}
+	return &logs, nil
+}
+
+// check records the result of a check, and returns true if it passed.
+func (r *switchRehearsal) check(name string, err error) bool {
+	if err != nil {
+		r.drLog.Log(fmt.Sprintf("\tFAILED: %s: %v", name, err))
+		r.failures = append(r.failures, fmt.Sprintf("%s: %v", name, err))
+		return false
+	}
+	r.drLog.Log(fmt.Sprintf("\tOK: %s", name))
+	return true
+}
+
+func (r *switchRehearsal) rehearse(ctx context.Context, timeout time.Duration, reverseReplication bool) {
+	ts := r.ts
+	r.check(fmt.Sprintf("streams of workflow %s are running and lag less than %v", ts.WorkflowName(), timeout),
+		r.checkStreams(ctx, timeout))
+	if windows, err := ts.cutoverWindows(); err != nil || len(windows) > 0 {
+		r.check(fmt.Sprintf("writes of workflow %s can be switched within its cutover windows", ts.WorkflowName()),
+			ts.checkCutoverWindows(time.Now()))
+	}
+
+	keyspaces := []string{ts.SourceKeyspaceName()}
+	if ts.TargetKeyspaceName() != ts.SourceKeyspaceName() {
+		keyspaces = append(keyspaces, ts.TargetKeyspaceName())
+	}
+	lockCtx := ctx
+	var unlocks []func(*error)
+	defer func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			var err error
+			unlocks[i](&err)
+			r.check(fmt.Sprintf("keyspace %s can be unlocked", keyspaces[i]), err)
+		}
+	}()
+	for _, keyspace := range keyspaces {
+		tctx, unlock, err := ts.TopoServer().LockKeyspace(lockCtx, keyspace, "RehearseSwitchWrites")
+		if !r.check(fmt.Sprintf("keyspace %s can be locked", keyspace), err) {
+			return
+		}
+		lockCtx = tctx
+		unlocks = append(unlocks, unlock)
+	}
+	r.rehearseLocked(lockCtx, reverseReplication)
+}
+
+// rehearseLocked runs the checks which need the keyspaces to be locked.
+func (r *switchRehearsal) rehearseLocked(ctx context.Context, reverseReplication bool) {
+	ts := r.ts
+	journalsExist, _, err := ts.checkJournals(ctx)
+	if !r.check("journals of the source shards can be read", err) {
+		return
+	}
+	if journalsExist {
+		r.drLog.Log("\tJournals exist: SwitchWrites would resume a previous cutover")
+		return
+	}
+	_, err = workflow.BuildStreamMigrator(ctx, ts, false)
+	r.check("streams of the source shards can be migrated", err)
+
+	for _, si := range sortShards(ts.SourceShards()) {
+		r.check(fmt.Sprintf("shard %s/%s can be written", si.Keyspace(), si.ShardName()),
+			r.rewriteShard(ctx, si, false /* remove */))
+	}
+	for _, si := range sortShards(ts.TargetShards()) {
+		r.check(fmt.Sprintf("shard %s/%s can be written", si.Keyspace(), si.ShardName()),
+			r.rewriteShard(ctx, si, true /* remove */))
+	}
+
+	if reverseReplication {
+		r.rehearseReverseReplication(ctx)
+	}
+}
+
+// checkStreams checks that the streams of the workflow are running, and were
+// updated within the timeout.
+func (r *switchRehearsal) checkStreams(ctx context.Context, timeout time.Duration) error {
+	ts := r.ts
+	now := time.Now().Unix()
+	for _, target := range sortTargets(ts.Targets()) {
+		query := fmt.Sprintf("select id, state, time_updated, message from _vt.vreplication where db_name=%s and workflow=%s",
+			encodeString(target.GetPrimary().DbName()), encodeString(ts.WorkflowName()))
+		p3qr, err := ts.TabletManagerClient().VReplicationExec(ctx, target.GetPrimary().Tablet, query)
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		for _, row := range qr.Rows {
+			id, err := evalengine.ToInt64(row[0])
+			if err != nil {
+				return err
+			}
+			timeUpdated, err := evalengine.ToInt64(row[2])
+			if err != nil {
+				return err
+			}
+			if state := row[1].ToString(); state != binlogplayer.BlpRunning {
+				return fmt.Errorf("stream %d on %s/%s is %s: %s", id, ts.TargetKeyspaceName(), target.GetShard().ShardName(), state, row[3].ToString())
+			}
+			if lag := time.Duration(now-timeUpdated) * time.Second; lag > timeout {
+				return fmt.Errorf("stream %d on %s/%s lags %v", id, ts.TargetKeyspaceName(), target.GetShard().ShardName(), lag)
+			}
+		}
+	}
+	return nil
+}
+
+// rewriteShard rewrites the record of a shard unchanged, after checking on a
+// copy that the denied tables SwitchWrites sets on it can be updated.
+func (r *switchRehearsal) rewriteShard(ctx context.Context, si *topo.ShardInfo, remove bool) error {
+	ts := r.ts
+	_, err := ts.TopoServer().UpdateShardFields(ctx, si.Keyspace(), si.ShardName(), func(si *topo.ShardInfo) error {
+		if ts.MigrationType() != binlogdatapb.MigrationType_TABLES {
+			return nil
+		}
+		shard := topo.NewShardInfo(si.Keyspace(), si.ShardName(), proto.Clone(si.Shard).(*topodatapb.Shard), si.Version())
+		return shard.UpdateSourceDeniedTables(ctx, topodatapb.TabletType_PRIMARY, nil, remove, ts.Tables())
+	})
+	return err
+}
+
+// rehearseReverseReplication creates the reverse workflow as stopped streams,
+// from the current positions of the targets, and deletes it.
+func (r *switchRehearsal) rehearseReverseReplication(ctx context.Context) {
+	ts := r.ts
+	name := fmt.Sprintf("reverse workflow %s can be created", ts.ReverseWorkflowName())
+	exists, err := r.reverseWorkflowExists(ctx)
+	if !r.check(fmt.Sprintf("reverse workflow %s can be read", ts.ReverseWorkflowName()), err) {
+		return
+	}
+	if exists {
+		r.drLog.Log(fmt.Sprintf("\tReverse workflow %s exists: SwitchWrites would recreate it", ts.ReverseWorkflowName()))
+		return
+	}
+	err = ts.ForAllTargets(func(target *workflow.MigrationTarget) error {
+		var err error
+		target.Position, err = ts.TabletManagerClient().PrimaryPosition(ctx, target.GetPrimary().Tablet)
+		return err
+	})
+	if !r.check("positions of the target shards can be read", err) {
+		return
+	}
+	createErr := ts.createReverseVReplication(ctx)
+	deleteErr := ts.deleteReverseVReplication(ctx)
+	if r.check(name, createErr) {
+		r.check(fmt.Sprintf("reverse workflow %s can be deleted", ts.ReverseWorkflowName()), deleteErr)
+	}
+}
+
+func (r *switchRehearsal) reverseWorkflowExists(ctx context.Context) (bool, error) {
+	ts := r.ts
+	for _, source := range sortSources(ts.Sources()) {
+		query := fmt.Sprintf("select id from _vt.vreplication where db_name=%s and workflow=%s",
+			encodeString(source.GetPrimary().DbName()), encodeString(ts.ReverseWorkflowName()))
+		p3qr, err := ts.TabletManagerClient().VReplicationExec(ctx, source.GetPrimary().Tablet, query)
+		if err != nil {
+			return false, err
+		}
+		if len(p3qr.Rows) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func sortShards(shards []*topo.ShardInfo) []*topo.ShardInfo {
+	sort.Slice(shards, func(i, j int) bool { return shards[i].ShardName() < shards[j].ShardName() })
+	return shards
+}
+
+func sortSources(sources map[string]*workflow.MigrationSource) []*workflow.MigrationSource {
+	sorted := make([]*workflow.MigrationSource, 0, len(sources))
+	for _, source := range sources {
+		sorted = append(sorted, source)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].GetShard().ShardName() < sorted[j].GetShard().ShardName() })
+	return sorted
+}
+
+func sortTargets(targets map[string]*workflow.MigrationTarget) []*workflow.MigrationTarget {
+	sorted := make([]*workflow.MigrationTarget, 0, len(targets))
+	for _, target := range targets {
+		sorted = append(sorted, target)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].GetShard().ShardName() < sorted[j].GetShard().ShardName() })
+	return sorted
+}
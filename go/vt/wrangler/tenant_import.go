@@ -0,0 +1,414 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// TenantImport describes a workflow importing the rows of one tenant from an
+// external MySQL database into an existing keyspace. The database is one of the
+// external connections configured on the tablets of the keyspace, and its
+// tables have the same names as the target tables.
+type TenantImport struct {
+	Workflow       string
+	TargetKeyspace string
+	// ExternalMysql is the name of the external connection of the database.
+	ExternalMysql string
+	Tables        []string
+	// TenantColumn is the column holding the tenant id in all the tables. If
+	// the keyspace is sharded, it must be the primary vindex column of the
+	// tables, which maps the tenant id to the keyspace id of its rows.
+	TenantColumn string
+	TenantID     string
+	// OnConflict is the action taken when an imported row conflicts with a
+	// row already in the keyspace, like one imported from another database.
+	OnConflict binlogdatapb.Rule_OnConflict
+	AutoStart  bool
+}
+
+// ImportTenant creates the streams of a tenant import. Each target shard
+// owning the keyspace id of the tenant in one of the tables gets a stream
+// copying the rows of the tenant from the external database, then
+// replicating their changes until the tenant is cut over.
+func (wr *Wrangler) ImportTenant(ctx context.Context, ti *TenantImport) error {
+	switch {
+	case ti.ExternalMysql == "":
+		return fmt.Errorf("no external mysql specified to import tenant %s from", ti.TenantID)
+	case ti.TenantColumn == "" || ti.TenantID == "":
+		return fmt.Errorf("the tenant column and the tenant id must be specified")
+	case len(ti.Tables) == 0:
+		return fmt.Errorf("no tables specified to import tenant %s", ti.TenantID)
+	}
+	if err := wr.validateNewWorkflow(ctx, ti.TargetKeyspace, ti.Workflow); err != nil {
+		return err
+	}
+	shardTables, err := wr.tenantShardTables(ctx, ti)
+	if err != nil {
+		return err
+	}
+
+	state := binlogplayer.BlpStopped
+	if ti.AutoStart {
+		state = binlogplayer.BlpRunning
+	}
+	shards := make([]string, 0, len(shardTables))
+	for shard := range shardTables {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, ti.TargetKeyspace, shard)
+		if err != nil {
+			return err
+		}
+		if si.PrimaryAlias == nil {
+			return fmt.Errorf("shard %v.%v has no primary", ti.TargetKeyspace, shard)
+		}
+		primary, err := wr.ts.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		bls := &binlogdatapb.BinlogSource{
+			ExternalMysql: ti.ExternalMysql,
+			Filter:        &binlogdatapb.Filter{},
+		}
+		for _, table := range shardTables[shard] {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v where %v = %v", sqlparser.NewTableIdent(table), sqlparser.NewColIdent(ti.TenantColumn), tenantIDExpr(ti.TenantID))
+			bls.Filter.Rules = append(bls.Filter.Rules, &binlogdatapb.Rule{
+				Match:        table,
+				Filter:       buf.String(),
+				OnConflict:   ti.OnConflict,
+				TenantColumn: ti.TenantColumn,
+			})
+		}
+		ig := vreplication.NewInsertGenerator(state, primary.DbName())
+		ig.AddRow(ti.Workflow, bls, "", "", "")
+		if _, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, ig.String()); err != nil {
+			return err
+		}
+		wr.Logger().Printf("Importing tenant %s of tables %s from %s into %v.%v\n", ti.TenantID, strings.Join(shardTables[shard], ","), ti.ExternalMysql, ti.TargetKeyspace, shard)
+	}
+	return nil
+}
+
+// tenantShardTables returns the tables of a tenant import by the target shard
+// owning the keyspace id of the tenant in them.
+func (wr *Wrangler) tenantShardTables(ctx context.Context, ti *TenantImport) (map[string][]string, error) {
+	shards, err := wr.ts.GetServingShards(ctx, ti.TargetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	vschema, err := wr.ts.GetVSchema(ctx, ti.TargetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	kschema, err := vindexes.BuildKeyspaceSchema(vschema, ti.TargetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	shardTables := make(map[string][]string)
+	if !kschema.Keyspace.Sharded {
+		if len(shards) != 1 {
+			return nil, fmt.Errorf("unsharded keyspace %s has %d serving shards", ti.TargetKeyspace, len(shards))
+		}
+		shardTables[shards[0].ShardName()] = ti.Tables
+		return shardTables, nil
+	}
+	for _, table := range ti.Tables {
+		ksid, err := tenantKeyspaceID(kschema, table, ti.TenantColumn, ti.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		shard := findOwningShard(shards, ksid)
+		if shard == nil {
+			return nil, fmt.Errorf("no serving shard of keyspace %s owns the rows of tenant %s in table %s", ti.TargetKeyspace, ti.TenantID, table)
+		}
+		shardTables[shard.ShardName()] = append(shardTables[shard.ShardName()], table)
+	}
+	return shardTables, nil
+}
+
+// tenantKeyspaceID maps a tenant id to the keyspace id of its rows in a table
+// with the primary vindex of the table.
+func tenantKeyspaceID(kschema *vindexes.KeyspaceSchema, table, tenantColumn, tenantID string) ([]byte, error) {
+	t := kschema.Tables[table]
+	if t == nil {
+		return nil, fmt.Errorf("table %s not found in vschema for keyspace %s", table, kschema.Keyspace.Name)
+	}
+	if t.Type == vindexes.TypeReference {
+		return nil, fmt.Errorf("reference table %s can't be imported for a tenant", table)
+	}
+	// The streams write the rows into the tables of the shards directly, so
+	// the rows of owned lookup vindexes would be missing.
+	if len(t.Owned) != 0 {
+		return nil, fmt.Errorf("table %s owns lookup vindex %s, which can't be populated by a tenant import", table, t.Owned[0].Name)
+	}
+	cv, err := vindexes.FindBestColVindex(t)
+	if err != nil {
+		return nil, err
+	}
+	if len(cv.Columns) != 1 || !cv.Columns[0].EqualString(tenantColumn) {
+		return nil, fmt.Errorf("table %s is not sharded by the tenant column %s", table, tenantColumn)
+	}
+	if cv.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("vindex %s of table %s can't map the tenant id without a lookup", cv.Name, table)
+	}
+	dests, err := vindexes.Map(cv.Vindex, nil, [][]sqltypes.Value{{tenantIDValue(tenantID)}})
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := dests[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("vindex %s of table %s does not map tenant %s to a single keyspace id: %v", cv.Name, table, tenantID, dests[0])
+	}
+	return ksid, nil
+}
+
+func findOwningShard(shards []*topo.ShardInfo, ksid []byte) *topo.ShardInfo {
+	for _, shard := range shards {
+		if key.KeyRangeContains(shard.KeyRange, ksid) {
+			return shard
+		}
+	}
+	return nil
+}
+
+// tenantIDValue returns the value of a tenant id, an integer if it is one.
+func tenantIDValue(tenantID string) sqltypes.Value {
+	if n, err := strconv.ParseInt(tenantID, 10, 64); err == nil {
+		return sqltypes.NewInt64(n)
+	}
+	return sqltypes.NewVarChar(tenantID)
+}
+
+func tenantIDExpr(tenantID string) sqlparser.Expr {
+	if _, err := strconv.ParseInt(tenantID, 10, 64); err == nil {
+		return sqlparser.NewIntLiteral(tenantID)
+	}
+	return sqlparser.NewStrLiteral(tenantID)
+}
+
+// tenantStream is a stream of a tenant import.
+type tenantStream struct {
+	primary     *topo.TabletInfo
+	id          int64
+	bls         *binlogdatapb.BinlogSource
+	state       string
+	message     string
+	timeUpdated int64
+	copying     bool
+}
+
+// tenantStreams returns the streams of a tenant import over all the shards.
+func (wr *Wrangler) tenantStreams(ctx context.Context, keyspace, workflow string) ([]*tenantStream, error) {
+	var streams []*tenantStream
+	err := wr.forAllLookupPrimaries(ctx, keyspace, func(shard *topo.ShardInfo, primary *topo.TabletInfo) error {
+		query := fmt.Sprintf("select id, source, state, message, time_updated from _vt.vreplication where workflow=%s and db_name=%s", encodeString(workflow), encodeString(primary.DbName()))
+		p3qr, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		var ids []string
+		for _, row := range qr.Rows {
+			stream := &tenantStream{primary: primary, bls: &binlogdatapb.BinlogSource{}, state: row[2].ToString(), message: row[3].ToString()}
+			if stream.id, err = evalengine.ToInt64(row[0]); err != nil {
+				return err
+			}
+			if err := prototext.Unmarshal(row[1].ToBytes(), stream.bls); err != nil {
+				return err
+			}
+			if stream.bls.ExternalMysql == "" {
+				return fmt.Errorf("workflow %s.%s is not a tenant import", keyspace, workflow)
+			}
+			if stream.timeUpdated, err = evalengine.ToInt64(row[4]); err != nil {
+				return err
+			}
+			streams = append(streams, stream)
+			ids = append(ids, strconv.FormatInt(stream.id, 10))
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		p3qr, err = wr.tmc.VReplicationExec(ctx, primary.Tablet, fmt.Sprintf("select distinct vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(ids, ", ")))
+		if err != nil {
+			return err
+		}
+		for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+			id, err := evalengine.ToInt64(row[0])
+			if err != nil {
+				return err
+			}
+			for _, stream := range streams {
+				if stream.primary == primary && stream.id == id {
+					stream.copying = true
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(streams) == 0 {
+		return nil, fmt.Errorf("no streams found for workflow %s in keyspace %s", workflow, keyspace)
+	}
+	return streams, nil
+}
+
+// CutoverTenant completes the import of a tenant once its writes to the
+// external database have been stopped: it checks that the streams copied the
+// tables and replicated the changes of the tenant up to maxLag ago, then
+// deletes them, leaving the keyspace as the database of the tenant.
+func (wr *Wrangler) CutoverTenant(ctx context.Context, keyspace, workflow string, maxLag time.Duration) error {
+	streams, err := wr.tenantStreams(ctx, keyspace, workflow)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, stream := range streams {
+		switch {
+		case stream.state != binlogplayer.BlpRunning:
+			return fmt.Errorf("cannot cut over tenant: stream %d on %v is %s: %s", stream.id, stream.primary.AliasString(), stream.state, stream.message)
+		case stream.copying:
+			return fmt.Errorf("cannot cut over tenant: stream %d on %v is still copying", stream.id, stream.primary.AliasString())
+		}
+		if lag := now.Sub(time.Unix(stream.timeUpdated, 0)); lag > maxLag {
+			return fmt.Errorf("cannot cut over tenant: stream %d on %v is lagging by %v, more than %v", stream.id, stream.primary.AliasString(), lag.Truncate(time.Second), maxLag)
+		}
+	}
+	if err := wr.deleteTenantStreams(ctx, streams, workflow); err != nil {
+		return err
+	}
+	if err := wr.deleteTenantImportRows(ctx, streams); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Tenant import %s.%s is cut over\n", keyspace, workflow)
+	return nil
+}
+
+// CancelTenantImport deletes the streams of a tenant import and, unless
+// keepData is set, the rows of the tenant they inserted in the keyspace. The
+// rows of the tenant which existed before the import are kept, even if the
+// import replaced their columns.
+func (wr *Wrangler) CancelTenantImport(ctx context.Context, keyspace, workflow string, keepData bool) error {
+	streams, err := wr.tenantStreams(ctx, keyspace, workflow)
+	if err != nil {
+		return err
+	}
+	if err := wr.deleteTenantStreams(ctx, streams, workflow); err != nil {
+		return err
+	}
+	if keepData {
+		return wr.deleteTenantImportRows(ctx, streams)
+	}
+	for _, stream := range streams {
+		tables := make([]string, 0, len(stream.bls.Filter.Rules))
+		for _, rule := range stream.bls.Filter.Rules {
+			tables = append(tables, rule.Match)
+		}
+		schema, err := wr.tmc.GetSchema(ctx, stream.primary.Tablet, tables, nil, false)
+		if err != nil {
+			return err
+		}
+		for _, rule := range stream.bls.Filter.Rules {
+			stmt, err := sqlparser.Parse(rule.Filter)
+			if err != nil {
+				return err
+			}
+			sel, ok := stmt.(*sqlparser.Select)
+			if !ok || sel.Where == nil || rule.TenantColumn == "" {
+				return fmt.Errorf("unexpected filter of tenant import %s.%s: %s", keyspace, workflow, rule.Filter)
+			}
+			var td *tabletmanagerdatapb.TableDefinition
+			for _, table := range schema.TableDefinitions {
+				if table.Name == rule.Match {
+					td = table
+				}
+			}
+			if td == nil {
+				return fmt.Errorf("table %s of tenant import %s.%s not found on %v", rule.Match, keyspace, workflow, stream.primary.AliasString())
+			}
+			pkCols := td.PrimaryKeyColumns
+			if len(pkCols) == 0 {
+				pkCols = td.Columns
+			}
+			// Only the rows recorded by the stream as inserted are deleted.
+			table := sqlparser.NewTableIdent(rule.Match)
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("delete %v from %v join _vt.tenant_import_rows as r on r.vrepl_id = %s and r.table_name = %s and r.pk = %s%v",
+				table, table, strconv.FormatInt(stream.id, 10), encodeString(rule.Match), vreplication.TenantImportRowKey(table, pkCols), sel.Where)
+			if _, err := wr.tmc.ExecuteFetchAsDba(ctx, stream.primary.Tablet, true, []byte(buf.String()), 0, false, false); err != nil {
+				return err
+			}
+		}
+	}
+	if err := wr.deleteTenantImportRows(ctx, streams); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Tenant import %s.%s is cancelled\n", keyspace, workflow)
+	return nil
+}
+
+func (wr *Wrangler) deleteTenantStreams(ctx context.Context, streams []*tenantStream, workflow string) error {
+	deleted := make(map[*topo.TabletInfo]bool)
+	for _, stream := range streams {
+		if deleted[stream.primary] {
+			continue
+		}
+		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(stream.primary.DbName()), encodeString(workflow))
+		if _, err := wr.tmc.VReplicationExec(ctx, stream.primary.Tablet, query); err != nil {
+			return err
+		}
+		deleted[stream.primary] = true
+	}
+	return nil
+}
+
+// deleteTenantImportRows deletes the primary keys of the rows inserted by the
+// streams of a tenant import.
+func (wr *Wrangler) deleteTenantImportRows(ctx context.Context, streams []*tenantStream) error {
+	for _, stream := range streams {
+		query := fmt.Sprintf("delete from _vt.tenant_import_rows where vrepl_id = %d", stream.id)
+		if _, err := wr.tmc.ExecuteFetchAsDba(ctx, stream.primary.Tablet, true, []byte(query), 0, false, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
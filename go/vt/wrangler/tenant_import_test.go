@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	tenantStreamsQuery    = "select id, source, state, message, time_updated from _vt.vreplication where workflow='tenant1' and db_name='vt_targetks'"
+	tenantDeleteQuery     = "delete from _vt.vreplication where db_name='vt_targetks' and workflow='tenant1'"
+	tenantCopyingQuery    = "select distinct vrepl_id from _vt.copy_state where vrepl_id in (1)"
+	tenantDeleteRowsQuery = "delete from _vt.tenant_import_rows where vrepl_id = 1"
+	tenantStreamsFields   = "id|source|state|message|time_updated"
+)
+
+func newTestTenantImportEnv(t *testing.T) *testMaterializerEnv {
+	env := newTestMaterializerEnv(t, &vtctldatapb.MaterializeSettings{SourceKeyspace: "sourceks", TargetKeyspace: "targetks"}, nil, []string{"-80", "80-"})
+	env.ms.Workflow = "tenant1"
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"name_lookup": {
+				Type:   "consistent_lookup_unique",
+				Params: map[string]string{"table": "targetks.name_lookup", "from": "name", "to": "keyspace_id"},
+				Owner:  "t5",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "tenant_id", Name: "hash"}}},
+			"t2": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "tenant_id", Name: "hash"}}},
+			"t3": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+			"t5": {ColumnVindexes: []*vschemapb.ColumnVindex{
+				{Column: "tenant_id", Name: "hash"},
+				{Column: "name", Name: "name_lookup"},
+			}},
+		},
+	}
+	require.NoError(t, env.topoServ.SaveVSchema(context.Background(), "targetks", vs))
+	return env
+}
+
+// expectTenantValidation expects the queries of wr.validateNewWorkflow.
+func expectTenantValidation(env *testMaterializerEnv) {
+	env.expectValidation()
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, mzSelectFrozenQuery, &sqltypes.Result{})
+}
+
+func TestImportTenant(t *testing.T) {
+	env := newTestTenantImportEnv(t)
+	defer env.close()
+	expectTenantValidation(env)
+
+	// The hash of tenant 1 is 166b40b44aba4bd6, owned by -80.
+	env.tmc.expectVRQuery(200, insertPrefix+
+		`\('tenant1', 'filter:{rules:{match:\\"t1\\" filter:\\"select \* from t1 where tenant_id = 1\\" on_conflict:IGNORE tenant_column:\\"tenant_id\\"} `+
+		`rules:{match:\\"t2\\" filter:\\"select \* from t2 where tenant_id = 1\\" on_conflict:IGNORE tenant_column:\\"tenant_id\\"}} external_mysql:\\"tenants\\"'.*'Running', 'vt_targetks'\)`,
+		&sqltypes.Result{})
+	err := env.wr.ImportTenant(context.Background(), &TenantImport{
+		Workflow:       "tenant1",
+		TargetKeyspace: "targetks",
+		ExternalMysql:  "tenants",
+		Tables:         []string{"t1", "t2"},
+		TenantColumn:   "tenant_id",
+		TenantID:       "1",
+		OnConflict:     binlogdatapb.Rule_IGNORE,
+		AutoStart:      true,
+	})
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestImportTenantErrors(t *testing.T) {
+	testcases := []struct {
+		tables []string
+		err    string
+	}{{
+		tables: []string{"t1", "t3"},
+		err:    "table t3 is not sharded by the tenant column tenant_id",
+	}, {
+		tables: []string{"t4"},
+		err:    "table t4 not found in vschema for keyspace targetks",
+	}, {
+		tables: []string{"t5"},
+		err:    "table t5 owns lookup vindex name_lookup, which can't be populated by a tenant import",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.err, func(t *testing.T) {
+			env := newTestTenantImportEnv(t)
+			defer env.close()
+			expectTenantValidation(env)
+			err := env.wr.ImportTenant(context.Background(), &TenantImport{
+				Workflow:       "tenant1",
+				TargetKeyspace: "targetks",
+				ExternalMysql:  "tenants",
+				Tables:         tc.tables,
+				TenantColumn:   "tenant_id",
+				TenantID:       "1",
+			})
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func tenantStreams(state string, timeUpdated time.Time) *sqltypes.Result {
+	bls := &binlogdatapb.BinlogSource{
+		ExternalMysql: "tenants",
+		Filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{
+			{Match: "t1", Filter: "select * from t1 where tenant_id = 1", TenantColumn: "tenant_id"},
+			{Match: "t2", Filter: "select * from t2 where tenant_id = 1", TenantColumn: "tenant_id"},
+		}},
+	}
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields(tenantStreamsFields, "int64|varbinary|varchar|varchar|int64"),
+		fmt.Sprintf("1|%v|%s||%d", bls, state, timeUpdated.Unix()))
+}
+
+func TestCutoverTenant(t *testing.T) {
+	env := newTestTenantImportEnv(t)
+	defer env.close()
+	ctx := context.Background()
+	now := time.Now()
+
+	env.tmc.expectVRQuery(200, tenantStreamsQuery, tenantStreams("Running", now.Add(-time.Minute)))
+	env.tmc.expectVRQuery(200, tenantCopyingQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, tenantStreamsQuery, &sqltypes.Result{})
+	err := env.wr.CutoverTenant(ctx, "targetks", "tenant1", 30*time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot cut over tenant: stream 1 on cell-0000000200 is lagging by 1m")
+
+	env.tmc.expectVRQuery(200, tenantStreamsQuery, tenantStreams("Running", now))
+	env.tmc.expectVRQuery(200, tenantCopyingQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("vrepl_id", "int64"), "1"))
+	env.tmc.expectVRQuery(210, tenantStreamsQuery, &sqltypes.Result{})
+	err = env.wr.CutoverTenant(ctx, "targetks", "tenant1", 30*time.Second)
+	assert.EqualError(t, err, "cannot cut over tenant: stream 1 on cell-0000000200 is still copying")
+
+	env.tmc.expectVRQuery(200, tenantStreamsQuery, tenantStreams("Running", now))
+	env.tmc.expectVRQuery(200, tenantCopyingQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, tenantStreamsQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, tenantDeleteQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, tenantDeleteRowsQuery, &sqltypes.Result{})
+	require.NoError(t, env.wr.CutoverTenant(ctx, "targetks", "tenant1", 30*time.Second))
+	env.tmc.verifyQueries(t)
+}
+
+func TestCancelTenantImport(t *testing.T) {
+	env := newTestTenantImportEnv(t)
+	defer env.close()
+	env.tmc.schema["targetks.t1"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t1", Columns: []string{"id", "tenant_id"}, PrimaryKeyColumns: []string{"id"}}},
+	}
+	env.tmc.schema["targetks.t2"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t2", Columns: []string{"tenant_id", "id"}, PrimaryKeyColumns: []string{"tenant_id", "id"}}},
+	}
+
+	env.tmc.expectVRQuery(200, tenantStreamsQuery, tenantStreams("Error", time.Now()))
+	env.tmc.expectVRQuery(200, tenantCopyingQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, tenantStreamsQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, tenantDeleteQuery, &sqltypes.Result{})
+	// Only the rows inserted by the stream are deleted.
+	env.tmc.expectVRQuery(200, "delete t1 from t1 join _vt.tenant_import_rows as r on r.vrepl_id = 1 and r.table_name = 't1' and "+
+		"r.pk = convert(json_array(t1.id) using utf8mb4) where tenant_id = 1", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "delete t2 from t2 join _vt.tenant_import_rows as r on r.vrepl_id = 1 and r.table_name = 't2' and "+
+		"r.pk = convert(json_array(t2.id, t2.tenant_id) using utf8mb4) where tenant_id = 1", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, tenantDeleteRowsQuery, &sqltypes.Result{})
+	require.NoError(t, env.wr.CancelTenantImport(context.Background(), "targetks", "tenant1", false))
+	env.tmc.verifyQueries(t)
+}
@@ -28,6 +28,7 @@ import (
 
 	"vitess.io/vitess/go/vt/discovery"
 
+	"vitess.io/vitess/go/cron"
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -438,6 +439,13 @@ func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflowNa
 		return 0, nil, err
 	}
 
+	// Only the forward cutover is restricted to the cutover windows, so that it can always be reversed.
+	if !cancel && !reverse && !dryRun {
+		if err := ts.checkCutoverWindows(time.Now()); err != nil {
+			return 0, nil, err
+		}
+	}
+
 	if reverseReplication {
 		err := wr.areTabletsAvailableToStreamFrom(ctx, ts, ts.TargetKeyspaceName(), ts.TargetShards())
 		if err != nil {
@@ -766,6 +774,24 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 
 	// Build the sources
 	for _, target := range targets {
+		// The tables of a target can be split between its streams by
+		// -copy_parallelism, but all the targets have the same tables.
+		tableSet := make(map[string]bool)
+		for _, bls := range target.Sources {
+			for _, rule := range bls.Filter.Rules {
+				tableSet[rule.Match] = true
+			}
+		}
+		tables := make([]string, 0, len(tableSet))
+		for table := range tableSet {
+			tables = append(tables, table)
+		}
+		sort.Strings(tables)
+		if ts.tables == nil {
+			ts.tables = tables
+		} else if !reflect.DeepEqual(ts.tables, tables) {
+			return nil, fmt.Errorf("table lists are mismatched across streams: %v vs %v", ts.tables, tables)
+		}
 		for _, bls := range target.Sources {
 			if ts.sourceKeyspace == "" {
 				ts.sourceKeyspace = bls.Keyspace
@@ -782,22 +808,6 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 				return nil, fmt.Errorf("source keyspaces are mismatched across streams: %v vs %v", ts.sourceKeyspace, bls.Keyspace)
 			}
 
-			if ts.tables == nil {
-				for _, rule := range bls.Filter.Rules {
-					ts.tables = append(ts.tables, rule.Match)
-				}
-				sort.Strings(ts.tables)
-			} else {
-				var tables []string
-				for _, rule := range bls.Filter.Rules {
-					tables = append(tables, rule.Match)
-				}
-				sort.Strings(tables)
-				if !reflect.DeepEqual(ts.tables, tables) {
-					return nil, fmt.Errorf("table lists are mismatched across streams: %v vs %v", ts.tables, tables)
-				}
-			}
-
 			if _, ok := ts.sources[bls.Shard]; ok {
 				continue
 			}
@@ -860,6 +870,40 @@ func (ts *trafficSwitcher) validate(ctx context.Context) error {
 	return nil
 }
 
+// cutoverWindows returns the cutover windows of the streams of the workflow.
+func (ts *trafficSwitcher) cutoverWindows() (cron.Windows, error) {
+	windows := ""
+	found := false
+	for _, target := range ts.Targets() {
+		for _, bls := range target.Sources {
+			if found && bls.CutoverWindows != windows {
+				return nil, fmt.Errorf("streams of workflow %s have different cutover windows: '%s' and '%s'",
+					ts.WorkflowName(), windows, bls.CutoverWindows)
+			}
+			windows, found = bls.CutoverWindows, true
+		}
+	}
+	return cron.ParseWindows(windows)
+}
+
+// checkCutoverWindows returns an error if the writes of the workflow can't be switched at the given time, because
+// it is outside of the cutover windows of the workflow.
+func (ts *trafficSwitcher) checkCutoverWindows(now time.Time) error {
+	windows, err := ts.cutoverWindows()
+	if err != nil {
+		return err
+	}
+	if len(windows) == 0 || windows.Contains(now) {
+		return nil
+	}
+	next := windows.NextOpen(now)
+	if next.IsZero() {
+		return fmt.Errorf("writes of workflow %s can only be switched within its cutover windows '%s', which never open", ts.WorkflowName(), windows)
+	}
+	return fmt.Errorf("writes of workflow %s can only be switched within its cutover windows '%s', next opening at %s",
+		ts.WorkflowName(), windows, next.Format(time.RFC3339))
+}
+
 func (ts *trafficSwitcher) switchTableReads(ctx context.Context, cells []string, servedTypes []topodatapb.TabletType, direction workflow.TrafficSwitchDirection) error {
 	log.Infof("switchTableReads: servedTypes: %+v, direction %t", servedTypes, direction)
 	rules, err := topotools.GetRoutingRules(ctx, ts.TopoServer())
@@ -1076,17 +1120,53 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 	if err := ts.deleteReverseVReplication(ctx); err != nil {
 		return err
 	}
-	err := ts.ForAllUIDs(func(target *workflow.MigrationTarget, uid uint32) error {
-		bls := target.Sources[uid]
-		source := ts.Sources()[bls.Shard]
-		reverseBls := &binlogdatapb.BinlogSource{
-			Keyspace:   ts.TargetKeyspaceName(),
-			Shard:      target.GetShard().ShardName(),
-			TabletType: bls.TabletType,
-			Filter:     &binlogdatapb.Filter{},
-			OnDdl:      bls.OnDdl,
-		}
-		for _, rule := range bls.Filter.Rules {
+	err := ts.ForAllTargets(func(target *workflow.MigrationTarget) error {
+		// A target has several streams from a source shard if the workflow
+		// copied its tables in parallel, which are reversed by a single stream.
+		uids := make([]int, 0, len(target.Sources))
+		for uid := range target.Sources {
+			uids = append(uids, int(uid))
+		}
+		sort.Ints(uids)
+		var shards []string
+		sourceStreams := make(map[string][]*binlogdatapb.BinlogSource)
+		for _, uid := range uids {
+			bls := target.Sources[uint32(uid)]
+			if _, ok := sourceStreams[bls.Shard]; !ok {
+				shards = append(shards, bls.Shard)
+			}
+			sourceStreams[bls.Shard] = append(sourceStreams[bls.Shard], bls)
+		}
+		for _, shard := range shards {
+			if err := ts.createReverseStream(ctx, target, sourceStreams[shard]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// createReverseStream creates the stream replicating the tables of the streams
+// of a target from a source shard back to the source shard.
+func (ts *trafficSwitcher) createReverseStream(ctx context.Context, target *workflow.MigrationTarget, streams []*binlogdatapb.BinlogSource) error {
+	bls := streams[0]
+	source := ts.Sources()[bls.Shard]
+	reverseBls := &binlogdatapb.BinlogSource{
+		Keyspace:   ts.TargetKeyspaceName(),
+		Shard:      target.GetShard().ShardName(),
+		TabletType: bls.TabletType,
+		Filter:     &binlogdatapb.Filter{},
+		OnDdl:      bls.OnDdl,
+	}
+	reversed := make(map[string]bool)
+	for _, stream := range streams {
+		for _, rule := range stream.Filter.Rules {
+			// The ranges of a table copied in parallel have a rule each.
+			if reversed[rule.Match] {
+				continue
+			}
+			reversed[rule.Match] = true
 			if rule.Filter == "exclude" {
 				reverseBls.Filter.Rules = append(reverseBls.Filter.Rules, rule)
 				continue
@@ -1114,23 +1194,22 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 				Filter: filter,
 			})
 		}
-		log.Infof("Creating reverse workflow vreplication stream on tablet %s: workflow %s, startPos %s",
-			source.GetPrimary().Alias, ts.ReverseWorkflowName(), target.Position)
-		_, err := ts.VReplicationExec(ctx, source.GetPrimary().Alias, binlogplayer.CreateVReplicationState(ts.ReverseWorkflowName(), reverseBls, target.Position, binlogplayer.BlpStopped, source.GetPrimary().DbName()))
-		if err != nil {
-			return err
-		}
+	}
+	log.Infof("Creating reverse workflow vreplication stream on tablet %s: workflow %s, startPos %s",
+		source.GetPrimary().Alias, ts.ReverseWorkflowName(), target.Position)
+	_, err := ts.VReplicationExec(ctx, source.GetPrimary().Alias, binlogplayer.CreateVReplicationState(ts.ReverseWorkflowName(), reverseBls, target.Position, binlogplayer.BlpStopped, source.GetPrimary().DbName()))
+	if err != nil {
+		return err
+	}
 
-		// if user has defined the cell/tablet_types parameters in the forward workflow, update the reverse workflow as well
-		updateQuery := ts.getReverseVReplicationUpdateQuery(target.GetPrimary().Alias.Cell, source.GetPrimary().Alias.Cell, source.GetPrimary().DbName())
-		if updateQuery != "" {
-			log.Infof("Updating vreplication stream entry on %s with: %s", source.GetPrimary().Alias, updateQuery)
-			_, err = ts.VReplicationExec(ctx, source.GetPrimary().Alias, updateQuery)
-			return err
-		}
-		return nil
-	})
-	return err
+	// if user has defined the cell/tablet_types parameters in the forward workflow, update the reverse workflow as well
+	updateQuery := ts.getReverseVReplicationUpdateQuery(target.GetPrimary().Alias.Cell, source.GetPrimary().Alias.Cell, source.GetPrimary().DbName())
+	if updateQuery != "" {
+		log.Infof("Updating vreplication stream entry on %s with: %s", source.GetPrimary().Alias, updateQuery)
+		_, err = ts.VReplicationExec(ctx, source.GetPrimary().Alias, updateQuery)
+		return err
+	}
+	return nil
 }
 
 func (ts *trafficSwitcher) getReverseVReplicationUpdateQuery(targetCell string, sourceCell string, dbname string) string {
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -1054,6 +1055,88 @@ func TestTableMigrateOneToManyDryRun(t *testing.T) {
 	require.Empty(t, cmp.Diff(wantdryRunWrites, *results))
 }
 
+func TestTableMigrateRehearseSwitchWrites(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigraterCustom(ctx, t, []string{"0"}, []string{"-80", "80-"}, "select * %s")
+	defer tme.stopTablets(t)
+
+	tme.expectNoPreviousJournals()
+	_, err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", []topodatapb.TabletType{topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA}, nil, workflow.DirectionForward, false)
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+
+	streams := func(dbclients []*fakeDBClient, state string, timeUpdated int64) {
+		result := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"id|state|time_updated|message",
+			"int64|varchar|int64|varchar"),
+			fmt.Sprintf("1|%s|%d|", state, timeUpdated),
+		)
+		for _, dbclient := range dbclients {
+			dbclient.addQuery("select id, state, time_updated, message from _vt.vreplication where db_name='vt_ks2' and workflow='test'", result, nil)
+		}
+	}
+
+	// The reverse workflow is created, and deleted right after.
+	tme.expectNoPreviousJournals()
+	streams(tme.dbTargetClients, "Running", time.Now().Unix())
+	tme.expectNoPreviousJournals()
+	tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name='vt_ks1' and workflow='test_reverse'", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[0].addQueryRE(`insert into _vt.vreplication.*test_reverse.*ks2.*-80.*t1.*from t1\\".*t2.*from t2\\".*Stopped`, &sqltypes.Result{InsertID: 1}, nil)
+	tme.dbSourceClients[0].addQueryRE(`insert into _vt.vreplication.*test_reverse.*ks2.*80-.*t1.*from t1\\".*t2.*from t2\\".*Stopped`, &sqltypes.Result{InsertID: 2}, nil)
+	tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 2", stoppedResult(2), nil)
+	tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", resultid12, nil)
+	tme.dbSourceClients[0].addQuery("delete from _vt.vreplication where id in (1, 2)", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[0].addQuery("delete from _vt.copy_state where vrepl_id in (1, 2)", &sqltypes.Result{}, nil)
+
+	results, err := tme.wr.RehearseSwitchWrites(ctx, tme.targetKeyspace, "test", 30*time.Second, true)
+	require.NoError(t, err)
+	want := []string{
+		"Rehearsal of the cutover:",
+		"\tOK: streams of workflow test are running and lag less than 30s",
+		"\tOK: keyspace ks1 can be locked",
+		"\tOK: keyspace ks2 can be locked",
+		"\tOK: journals of the source shards can be read",
+		"\tOK: streams of the source shards can be migrated",
+		"\tOK: shard ks1/0 can be written",
+		"\tOK: shard ks2/-80 can be written",
+		"\tOK: shard ks2/80- can be written",
+		"\tOK: reverse workflow test_reverse can be read",
+		"\tOK: positions of the target shards can be read",
+		"\tOK: reverse workflow test_reverse can be created",
+		"\tOK: reverse workflow test_reverse can be deleted",
+		"\tOK: keyspace ks2 can be unlocked",
+		"\tOK: keyspace ks1 can be unlocked",
+	}
+	require.Greater(t, len(*results), len(want))
+	assert.Equal(t, want, (*results)[len(*results)-len(want):])
+	verifyQueries(t, tme.allDBClients)
+
+	// Nothing was switched: the source shards are still writable.
+	si, err := tme.ts.GetShard(ctx, "ks1", "0")
+	require.NoError(t, err)
+	assert.Nil(t, si.GetTabletControl(topodatapb.TabletType_PRIMARY))
+
+	// A lagging stream fails the rehearsal, which still runs the other checks.
+	tme.expectNoPreviousJournals()
+	streams(tme.dbTargetClients[:1], "Running", time.Now().Add(-time.Minute).Unix())
+	tme.expectNoPreviousJournals()
+	_, err = tme.wr.RehearseSwitchWrites(ctx, tme.targetKeyspace, "test", 30*time.Second, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "streams of workflow test are running and lag less than 30s: stream 1 on ks2/-80 lags")
+	verifyQueries(t, tme.allDBClients)
+
+	// So does a stopped stream.
+	tme.expectNoPreviousJournals()
+	streams(tme.dbTargetClients[:1], "Stopped", time.Now().Unix())
+	tme.expectNoPreviousJournals()
+	_, err = tme.wr.RehearseSwitchWrites(ctx, tme.targetKeyspace, "test", 30*time.Second, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stream 1 on ks2/-80 is Stopped")
+	verifyQueries(t, tme.allDBClients)
+}
+
 // TestMigrateFailJournal tests that cancel doesn't get called after point of no return.
 // No need to test this for shard migrate because code paths are the same.
 func TestMigrateFailJournal(t *testing.T) {
@@ -2152,3 +2235,63 @@ func stoppedResult(id int) *sqltypes.Result {
 func runningResult(id int) *sqltypes.Result {
 	return getResult(id, "Running", tpChoice.keyspace, tpChoice.shard)
 }
+
+func TestTableMigrateCutoverWindows(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	setCutoverWindows := func(windows string) {
+		for i, targetShard := range tme.targetShards {
+			var rows []string
+			for j, sourceShard := range tme.sourceShards {
+				bls := &binlogdatapb.BinlogSource{
+					Keyspace: "ks1",
+					Shard:    sourceShard,
+					Filter: &binlogdatapb.Filter{
+						Rules: []*binlogdatapb.Rule{{
+							Match:  "t1",
+							Filter: fmt.Sprintf("select * from t1 where in_keyrange('%s')", targetShard),
+						}, {
+							Match:  "t2",
+							Filter: fmt.Sprintf("select * from t2 where in_keyrange('%s')", targetShard),
+						}},
+					},
+					CutoverWindows: windows,
+				}
+				rows = append(rows, fmt.Sprintf("%d|%v|||", j+1, bls))
+			}
+			tme.dbTargetClients[i].addInvariant(vreplQueryks2, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+				"id|source|message|cell|tablet_types",
+				"int64|varchar|varchar|varchar|varchar"),
+				rows...),
+			)
+		}
+	}
+
+	// Outside of its cutover windows, the workflow waits and its writes are not switched.
+	setCutoverWindows("* * 31 2 *")
+	_, _, err := tme.wr.SwitchWrites(ctx, tme.targetKeyspace, "test", 1*time.Second, false, false, true, false)
+	require.EqualError(t, err, "writes of workflow test can only be switched within its cutover windows '* * 31 2 *', which never open")
+	verifyQueries(t, tme.allDBClients)
+
+	vrw, err := tme.wr.NewVReplicationWorkflow(ctx, MoveTablesWorkflow, &VReplicationWorkflowParams{
+		TargetKeyspace: tme.targetKeyspace,
+		Workflow:       "test",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Reads Not Switched. Writes Not Switched. Waiting For Cutover Window: writes of workflow test can only be switched within its cutover windows '* * 31 2 *', which never open",
+		vrw.CachedState())
+
+	now := time.Date(2021, 10, 14, 13, 37, 0, 0, time.UTC)
+	setCutoverWindows("* 2-4 * * 1-5")
+	ts, _, err := tme.wr.getWorkflowState(ctx, tme.targetKeyspace, "test")
+	require.NoError(t, err)
+	assert.EqualError(t, ts.checkCutoverWindows(now), "writes of workflow test can only be switched within its cutover windows '* 2-4 * * 1-5', next opening at 2021-10-15T02:00:00Z")
+	assert.NoError(t, ts.checkCutoverWindows(now.Add(-10*time.Hour)))
+
+	setCutoverWindows("")
+	ts, _, err = tme.wr.getWorkflowState(ctx, tme.targetKeyspace, "test")
+	require.NoError(t, err)
+	assert.NoError(t, ts.checkCutoverWindows(now))
+}
@@ -24,9 +24,11 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/collations"
@@ -54,6 +56,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
 // DiffReport is the summary of differences for one table.
@@ -99,6 +102,9 @@ type vdiff struct {
 	workflow       string
 	targetKeyspace string
 	tables         []string
+
+	// checkpoint is the progress of the diffs of the tables.
+	checkpoint *vtctldatapb.VDiffCheckpoint
 }
 
 // compareColInfo contains the metadata for a column of the table being diffed
@@ -131,6 +137,9 @@ type tableDiffer struct {
 	// source Primitive and targetPrimitive are used for streaming
 	sourcePrimitive engine.Primitive
 	targetPrimitive engine.Primitive
+
+	// keyspaceID is set if the filter has a keyspace_id() column.
+	keyspaceID *keyspaceIDColumn
 }
 
 // shardStreamer streams rows from one shard. This works for
@@ -147,11 +156,24 @@ type shardStreamer struct {
 	snapshotPosition string
 	result           chan *sqltypes.Result
 	err              error
+	// keyspaceID is set to map the keyspace_id() column of the rows of
+	// a source.
+	keyspaceID *keyspaceIDColumn
 }
 
 // VDiff reports differences between the sources and targets of a vreplication workflow.
+// The progress of the diff of each table is saved in the topo. If resume is set, the
+// diffs of the tables which did not complete are resumed after the last primary key
+// they compared, and the tables which completed are not diffed again. If
+// incrementalColumn is set, only the rows whose incrementalColumn is at or past the
+// start of the last complete diff of their table are compared. If workers is more
+// than one, the rows of each table are split in up to workers chunks of its primary
+// key range, which are compared concurrently. Only one VDiff of a workflow can run
+// at a time, since they share its checkpoint, and the incremental diff does not
+// detect deleted rows: see tableCheckpointer for its limits.
 func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr string,
-	filteredReplicationWaitTime time.Duration, format string, maxRows int64, tables string, debug, onlyPks bool) (map[string]*DiffReport, error) {
+	filteredReplicationWaitTime time.Duration, format string, maxRows int64, tables string, debug, onlyPks bool,
+	resume bool, incrementalColumn string, workers int) (map[string]*DiffReport, error) {
 	log.Infof("Starting VDiff for %s.%s, sourceCell %s, targetCell %s, tabletTypes %s, timeout %s",
 		targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr, filteredReplicationWaitTime.String())
 	// Assign defaults to sourceCell and targetCell if not specified.
@@ -213,10 +235,9 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 		}
 		oneTarget = target
 	}
-	var oneFilter *binlogdatapb.Filter
-	for _, bls := range oneTarget.Sources {
-		oneFilter = bls.Filter
-		break
+	oneFilter, err := mergeStreamFilters(oneTarget.Sources)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "mergeStreamFilters")
 	}
 	schm, err := schematools.GetSchema(ctx, wr.ts, wr.tmc, oneTarget.GetPrimary().Alias, nil, nil, false)
 	if err != nil {
@@ -225,6 +246,12 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	if err = df.buildVDiffPlan(ctx, oneFilter, schm, df.tables); err != nil {
 		return nil, vterrors.Wrap(err, "buildVDiffPlan")
 	}
+	if df.checkpoint, err = wr.ts.GetVDiffCheckpoint(ctx, targetKeyspace, workflowName); err != nil {
+		return nil, vterrors.Wrap(err, "GetVDiffCheckpoint")
+	}
+	if df.checkpoint.Tables == nil {
+		df.checkpoint.Tables = make(map[string]*vtctldatapb.VDiffTableCheckpoint)
+	}
 
 	if err := df.selectTablets(ctx, ts); err != nil {
 		return nil, vterrors.Wrap(err, "selectTablets")
@@ -241,7 +268,6 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// TODO(sougou): parallelize
 	rowsToCompare := maxRows
 	diffReports := make(map[string]*DiffReport)
 	jsonOutput := ""
@@ -250,14 +276,44 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 		if schema.IsInternalOperationTableName(table) {
 			continue
 		}
-		if err := df.diffTable(ctx, wr, table, td, filteredReplicationWaitTime); err != nil {
+		tc, err := df.startTable(table, td, resume, incrementalColumn)
+		if err != nil {
+			return nil, err
+		}
+		if tc.cp.Completed {
+			wr.Logger().Infof("Diff of table %s already completed, skipping it", table)
+			diffReports[table] = tc.report()
+			continue
+		}
+		sourceQuery, targetQuery, err := td.diffQueries(tc.lastPK, tc.cp.IncrementalColumn, tc.cp.Since)
+		if err != nil {
+			return nil, err
+		}
+		if len(tc.lastPK) != 0 {
+			wr.Logger().Infof("Resuming diff of table %s after primary key %v", table, tc.lastPK)
+		}
+		if tc.cp.Since != 0 {
+			wr.Logger().Infof("Diffing the rows of table %s whose %s is at or past %v", table, tc.cp.IncrementalColumn, time.Unix(tc.cp.Since, 0).UTC())
+		}
+		chunks, err := df.splitTable(ctx, td, sourceQuery, targetQuery, workers)
+		if err != nil {
+			return nil, err
+		}
+		if len(chunks) > 1 {
+			wr.Logger().Infof("Diffing table %s in %d chunks", table, len(chunks))
+		}
+		tc.setChunks(len(chunks))
+		if err := df.diffTable(ctx, wr, table, td, chunks, filteredReplicationWaitTime); err != nil {
 			return nil, err
 		}
 		// Perform the diff of source and target streams.
-		dr, err := td.diff(ctx, &rowsToCompare, debug, onlyPks)
+		dr, err := td.diffChunks(ctx, chunks, &rowsToCompare, debug, onlyPks, tc)
 		if err != nil {
+			tc.save(ctx)
 			return nil, vterrors.Wrap(err, "diff")
 		}
+		// The diff stopped early if the limit of rows to compare was reached.
+		tc.done(ctx, dr, rowsToCompare >= 0)
 		dr.TableName = table
 		diffReports[table] = dr
 	}
@@ -296,7 +352,7 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	return diffReports, nil
 }
 
-func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *tableDiffer, filteredReplicationWaitTime time.Duration) error {
+func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *tableDiffer, chunks []*diffChunk, filteredReplicationWaitTime time.Duration) error {
 	log.Infof("Starting vdiff for table %s", table)
 
 	log.Infof("Locking target keyspace %s", df.targetKeyspace)
@@ -325,22 +381,111 @@ func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *
 	if err := df.stopTargets(ctx); err != nil {
 		return vterrors.Wrap(err, "stopTargets")
 	}
-	// Make sure all sources are past the target's positions and start a query stream that records the current source positions.
-	if err := df.startQueryStreams(ctx, df.ts.SourceKeyspaceName(), df.sources, td.sourceExpression, filteredReplicationWaitTime); err != nil {
-		return vterrors.Wrap(err, "startQueryStreams(sources)")
-	}
-	// Fast forward the targets to the newly recorded source positions.
-	if err := df.syncTargets(ctx, filteredReplicationWaitTime); err != nil {
-		return vterrors.Wrap(err, "syncTargets")
-	}
-	// Sources and targets are in sync. Start query streams on the targets.
-	if err := df.startQueryStreams(ctx, df.ts.TargetKeyspaceName(), df.targets, td.targetExpression, filteredReplicationWaitTime); err != nil {
-		return vterrors.Wrap(err, "startQueryStreams(targets)")
+	// Start the query streams of all the chunks of the table.
+	if err := df.startChunks(ctx, td, chunks, filteredReplicationWaitTime); err != nil {
+		return err
 	}
 	// Now that queries are running, target vreplication streams can be restarted.
 	return nil
 }
 
+// mergeStreamFilters returns the filter of the tables replicated by the
+// streams of a target shard. With -copy_parallelism, a table can be split
+// between several streams, each replicating a range of its primary key: the
+// conditions which differ between the rules of these streams are dropped, to
+// diff the whole table.
+func mergeStreamFilters(sources map[uint32]*binlogdatapb.BinlogSource) (*binlogdatapb.Filter, error) {
+	uids := make([]uint32, 0, len(sources))
+	for uid := range sources {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	var filter *binlogdatapb.Filter
+	tableRules := make(map[string][]*binlogdatapb.Rule)
+	for _, uid := range uids {
+		bls := sources[uid]
+		if filter == nil {
+			filter = &binlogdatapb.Filter{FieldEventMode: bls.Filter.GetFieldEventMode()}
+		}
+		for _, rule := range bls.Filter.GetRules() {
+			if _, ok := tableRules[rule.Match]; !ok {
+				filter.Rules = append(filter.Rules, rule)
+			}
+			tableRules[rule.Match] = append(tableRules[rule.Match], rule)
+		}
+	}
+	if filter == nil {
+		return nil, fmt.Errorf("no streams found")
+	}
+	for i, rule := range filter.Rules {
+		merged, err := mergeTableRules(tableRules[rule.Match])
+		if err != nil {
+			return nil, err
+		}
+		filter.Rules[i] = merged
+	}
+	return filter, nil
+}
+
+// mergeTableRules merges the rules of the streams replicating a table,
+// keeping the conditions common to all of them.
+func mergeTableRules(rules []*binlogdatapb.Rule) (*binlogdatapb.Rule, error) {
+	first := rules[0]
+	same := true
+	for _, rule := range rules[1:] {
+		if rule.Filter != first.Filter {
+			same = false
+		}
+	}
+	if same {
+		return first, nil
+	}
+	var sels []*sqlparser.Select
+	for _, rule := range rules {
+		stmt, err := sqlparser.Parse(rule.Filter)
+		if err != nil {
+			return nil, err
+		}
+		sel, ok := stmt.(*sqlparser.Select)
+		if !ok {
+			return nil, fmt.Errorf("unexpected filter of table %s: %s", rule.Match, rule.Filter)
+		}
+		sels = append(sels, sel)
+	}
+	var common []sqlparser.Expr
+	for _, cond := range splitWhere(sels[0]) {
+		inAll := true
+		for _, sel := range sels[1:] {
+			found := false
+			for _, other := range splitWhere(sel) {
+				if sqlparser.EqualsExpr(cond, other) {
+					found = true
+					break
+				}
+			}
+			inAll = inAll && found
+		}
+		if inAll {
+			common = append(common, cond)
+		}
+	}
+	merged := proto.Clone(first).(*binlogdatapb.Rule)
+	sels[0].Where = nil
+	if len(common) != 0 {
+		sels[0].AddWhere(sqlparser.AndExpressions(common...))
+	}
+	merged.Filter = sqlparser.String(sels[0])
+	return merged, nil
+}
+
+func splitWhere(sel *sqlparser.Select) []sqlparser.Expr {
+	if sel.Where == nil {
+		return nil
+	}
+	return sqlparser.SplitAndExpression(nil, sel.Where.Expr)
+}
+
 // buildVDiffPlan builds all the differs.
 func (df *vdiff) buildVDiffPlan(ctx context.Context, filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, tablesToInclude []string) error {
 	df.differs = make(map[string]*tableDiffer)
@@ -437,6 +582,8 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 	targetSelect := &sqlparser.Select{}
 	// aggregates contains the list if Aggregate functions, if any.
 	var aggregates []*engine.AggregateParams
+	// vindexCols are the columns selected by the source for keyspace_id().
+	var vindexCols []*sqlparser.ColName
 	for _, selExpr := range sel.SelectExprs {
 		switch selExpr := selExpr.(type) {
 		case *sqlparser.StarExpr:
@@ -457,6 +604,19 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
 				}
 			}
+			if isKeyspaceIDFunc(selExpr.Expr) {
+				if td.keyspaceID != nil {
+					return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+				}
+				var err error
+				td.keyspaceID, vindexCols, err = df.keyspaceIDColumn(sel, len(sourceSelect.SelectExprs))
+				if err != nil {
+					return nil, err
+				}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: vindexCols[0]})
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+				continue
+			}
 			// If the input was "select a as b", then source will use "a" and target will use "b".
 			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
 			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
@@ -464,7 +624,7 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 			// Check if it's an aggregate expression
 			if expr, ok := selExpr.Expr.(*sqlparser.FuncExpr); ok {
 				switch fname := expr.Name.Lowered(); fname {
-				case "count", "sum":
+				case "count", "sum", "min", "max":
 					aggregates = append(aggregates, &engine.AggregateParams{
 						Opcode: engine.SupportedAggregates[fname],
 						Col:    len(sourceSelect.SelectExprs) - 1,
@@ -497,6 +657,11 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 			td.compareCols[i].weightStringIndex = len(sourceSelect.SelectExprs) - 1
 		}
 	}
+	// The other columns of the vindex are selected after all the others.
+	for i := 1; i < len(vindexCols); i++ {
+		sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: vindexCols[i]})
+		td.keyspaceID.cols = append(td.keyspaceID.cols, len(sourceSelect.SelectExprs)-1)
+	}
 
 	sourceSelect.From = sel.From
 	// The target table name should the one that matched the rule.
@@ -517,6 +682,9 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 	sourceSelect.Where = removeKeyrange(sel.Where)
 	// The source should also perform the group by.
 	sourceSelect.GroupBy = sel.GroupBy
+	if td.keyspaceID != nil {
+		sourceSelect.GroupBy = groupByVindexCols(sel, vindexCols)
+	}
 	sourceSelect.OrderBy = orderby
 
 	// The target should perform the order by, but not the group by.
@@ -743,6 +911,11 @@ func (df *vdiff) streamOne(ctx context.Context, keyspace, shard string, particip
 			if vrs.Fields == nil {
 				result.Fields = nil
 			}
+			if participant.keyspaceID != nil {
+				if err := participant.keyspaceID.mapRows(result); err != nil {
+					return err
+				}
+			}
 			select {
 			case participant.result <- result:
 			case <-ctx.Done():
@@ -755,12 +928,12 @@ func (df *vdiff) streamOne(ctx context.Context, keyspace, shard string, particip
 
 // syncTargets fast-forwards the vreplication to the source snapshot positons
 // and waits for the selected tablets to catch up to that point.
-func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime time.Duration) error {
+func (df *vdiff) syncTargets(ctx context.Context, sources, targets map[string]*shardStreamer, filteredReplicationWaitTime time.Duration) error {
 	waitCtx, cancel := context.WithTimeout(ctx, filteredReplicationWaitTime)
 	defer cancel()
 	err := df.ts.ForAllUIDs(func(target *workflow.MigrationTarget, uid uint32) error {
 		bls := target.Sources[uid]
-		pos := df.sources[bls.Shard].snapshotPosition
+		pos := sources[bls.Shard].snapshotPosition
 		query := fmt.Sprintf("update _vt.vreplication set state='Running', stop_pos='%s', message='synchronizing for vdiff' where id=%d", pos, uid)
 		if _, err := df.ts.TabletManagerClient().VReplicationExec(ctx, target.GetPrimary().Tablet, query); err != nil {
 			return err
@@ -774,7 +947,7 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 		return err
 	}
 
-	err = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	err = df.forAll(targets, func(shard string, target *shardStreamer) error {
 		pos, err := df.ts.TabletManagerClient().PrimaryPosition(ctx, target.primary.Tablet)
 		if err != nil {
 			return err
@@ -914,9 +1087,9 @@ func humanInt(n int64) string {
 //-----------------------------------------------------------------
 // tableDiffer
 
-func (td *tableDiffer) diff(ctx context.Context, rowsToCompare *int64, debug, onlyPks bool) (*DiffReport, error) {
-	sourceExecutor := newPrimitiveExecutor(ctx, td.sourcePrimitive)
-	targetExecutor := newPrimitiveExecutor(ctx, td.targetPrimitive)
+func (td *tableDiffer) diff(ctx context.Context, chunk *diffChunk, rowsToCompare *int64, debug, onlyPks bool, tc *tableCheckpointer) (*DiffReport, error) {
+	sourceExecutor := newPrimitiveExecutor(ctx, chunk.sourcePrimitive)
+	targetExecutor := newPrimitiveExecutor(ctx, chunk.targetPrimitive)
 	dr := &DiffReport{}
 	var sourceRow, targetRow []sqltypes.Value
 	var err error
@@ -926,8 +1099,7 @@ func (td *tableDiffer) diff(ctx context.Context, rowsToCompare *int64, debug, on
 		if dr.ProcessedRows%1e7 == 0 { // log progress every 10 million rows
 			log.Infof("VDiff progress:: table %s: %s rows", td.targetTable, humanInt(int64(dr.ProcessedRows)))
 		}
-		*rowsToCompare--
-		if *rowsToCompare < 0 {
+		if atomic.AddInt64(rowsToCompare, -1) < 0 {
 			log.Infof("Stopping vdiff, specified limit reached")
 			return dr, nil
 		}
@@ -1001,6 +1173,7 @@ func (td *tableDiffer) diff(ctx context.Context, rowsToCompare *int64, debug, on
 				dr.ExtraRowsSourceSample = append(dr.ExtraRowsTargetSample, diffRow)
 			}
 			dr.ExtraRowsSource++
+			tc.compared(ctx, chunk.index, sourceRow, dr)
 			advanceTarget = false
 			continue
 		case c > 0:
@@ -1012,6 +1185,7 @@ func (td *tableDiffer) diff(ctx context.Context, rowsToCompare *int64, debug, on
 				dr.ExtraRowsTargetSample = append(dr.ExtraRowsTargetSample, diffRow)
 			}
 			dr.ExtraRowsTarget++
+			tc.compared(ctx, chunk.index, targetRow, dr)
 			advanceSource = false
 			continue
 		}
@@ -1038,6 +1212,7 @@ func (td *tableDiffer) diff(ctx context.Context, rowsToCompare *int64, debug, on
 		default:
 			dr.MatchingRows++
 		}
+		tc.compared(ctx, chunk.index, sourceRow, dr)
 	}
 }
 
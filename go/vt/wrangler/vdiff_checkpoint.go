@@ -0,0 +1,369 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// vdiffCheckpointInterval is the minimum time between two saves of the
+// checkpoint of a table while it is diffed.
+var vdiffCheckpointInterval = 10 * time.Second
+
+// tableCheckpointer records the progress of the diff of a table in the
+// checkpoint of the VDiff, which is saved in the topo. A resumed diff starts
+// after the last primary key compared, and an incremental diff only compares
+// the rows whose incremental column is at or past the start of the last
+// complete diff of the table.
+//
+// When the table is diffed in chunks, the checkpoint records the rows compared
+// up to the first chunk which did not complete, so that a resumed diff never
+// skips rows.
+//
+// The incremental diff has limits, which make it a cheap check between
+// complete diffs rather than a replacement for them:
+//   - The rows deleted from the source or the target are not streamed, so
+//     the extra rows they leave on the other side are not detected.
+//   - The start of the diff is taken from the clock of vtctld, and compared
+//     with the incremental column set by MySQL, so that the rows modified
+//     just before it are missed if the clocks are skewed.
+//   - The start is compared as a UTC datetime, which only matches the
+//     incremental column if it is a TIMESTAMP and the time_zone of the
+//     sessions of the diff is UTC, or if it is a DATETIME set in UTC.
+//
+// There is a single checkpoint per workflow, and it is saved without checking
+// its version: two VDiffs of the same workflow running at the same time
+// overwrite the progress of each other, and must not be resumed.
+type tableCheckpointer struct {
+	df    *vdiff
+	table string
+	td    *tableDiffer
+	cp    *vtctldatapb.VDiffTableCheckpoint
+
+	// base has the counts of the rows compared before the diff was resumed.
+	base vtctldatapb.VDiffTableCheckpoint
+	// startPK is the primary key after which the diff was resumed.
+	startPK []sqltypes.Value
+
+	mu sync.Mutex
+	// lastPK is the primary key after which the diff would be resumed.
+	lastPK   []sqltypes.Value
+	chunks   []*chunkProgress
+	lastSave time.Time
+}
+
+// chunkProgress is the progress of the diff of a chunk of a table.
+type chunkProgress struct {
+	lastPK []sqltypes.Value
+	counts DiffReport
+	done   bool
+}
+
+// startTable returns the checkpointer of the diff of a table, which resumes
+// the previous diff of the table if resume is set, and compares only the rows
+// modified since its last complete diff if incrementalColumn is set.
+func (df *vdiff) startTable(table string, td *tableDiffer, resume bool, incrementalColumn string) (*tableCheckpointer, error) {
+	tc := &tableCheckpointer{
+		df:       df,
+		table:    table,
+		td:       td,
+		lastSave: time.Now(),
+	}
+	prev := df.checkpoint.Tables[table]
+	if resume && prev != nil {
+		tc.cp = prev
+		tc.base = vtctldatapb.VDiffTableCheckpoint{
+			ProcessedRows:   prev.ProcessedRows,
+			MatchingRows:    prev.MatchingRows,
+			MismatchedRows:  prev.MismatchedRows,
+			ExtraRowsSource: prev.ExtraRowsSource,
+			ExtraRowsTarget: prev.ExtraRowsTarget,
+		}
+		if prev.LastPk != nil {
+			qr := sqltypes.Proto3ToResult(prev.LastPk)
+			if len(qr.Rows) != 1 || len(qr.Rows[0]) != len(td.selectPks) {
+				return nil, fmt.Errorf("checkpoint of table %s has an invalid last primary key: %v", table, prev.LastPk)
+			}
+			tc.startPK = qr.Rows[0]
+			tc.lastPK = tc.startPK
+		}
+		return tc, nil
+	}
+
+	tc.cp = &vtctldatapb.VDiffTableCheckpoint{StartedAt: time.Now().Unix()}
+	if prev != nil {
+		tc.cp.VerifiedAt = prev.VerifiedAt
+	}
+	if incrementalColumn != "" && tc.cp.VerifiedAt != 0 {
+		if td.columnIndex(incrementalColumn) < 0 {
+			log.Warningf("Table %s has no column %s, diffing all its rows", table, incrementalColumn)
+		} else {
+			tc.cp.Since = tc.cp.VerifiedAt
+			tc.cp.IncrementalColumn = incrementalColumn
+		}
+	}
+	df.checkpoint.Tables[table] = tc.cp
+	return tc, nil
+}
+
+// setChunks sets the number of chunks the table is diffed in.
+func (tc *tableCheckpointer) setChunks(n int) {
+	tc.chunks = make([]*chunkProgress, n)
+	for i := range tc.chunks {
+		tc.chunks[i] = &chunkProgress{}
+	}
+}
+
+// compared records the primary key of the last row compared in a chunk, and
+// the counts of the rows compared so far in it. It saves the checkpoint at
+// most every vdiffCheckpointInterval.
+func (tc *tableCheckpointer) compared(ctx context.Context, chunk int, row []sqltypes.Value, dr *DiffReport) {
+	if tc == nil {
+		return
+	}
+	lastPK := make([]sqltypes.Value, 0, len(tc.td.selectPks))
+	for _, i := range tc.td.selectPks {
+		lastPK = append(lastPK, row[i])
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.chunks[chunk].lastPK = lastPK
+	tc.chunks[chunk].counts = *dr
+	tc.update()
+	if time.Since(tc.lastSave) >= vdiffCheckpointInterval {
+		tc.save(ctx)
+	}
+}
+
+// chunkDone records that all the rows of a chunk were compared.
+func (tc *tableCheckpointer) chunkDone(chunk int, dr *DiffReport) {
+	if tc == nil {
+		return
+	}
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.chunks[chunk].counts = *dr
+	tc.chunks[chunk].done = true
+	tc.update()
+}
+
+// update sets the checkpoint to the rows compared in the chunks up to the
+// first one which did not complete, and the primary key of the last of them.
+func (tc *tableCheckpointer) update() {
+	counts := &DiffReport{}
+	tc.lastPK = tc.startPK
+	for _, chunk := range tc.chunks {
+		counts.add(&chunk.counts)
+		if chunk.lastPK != nil {
+			tc.lastPK = chunk.lastPK
+		}
+		if !chunk.done {
+			break
+		}
+	}
+	tc.setCounts(counts)
+}
+
+func (tc *tableCheckpointer) setCounts(dr *DiffReport) {
+	tc.cp.ProcessedRows = tc.base.ProcessedRows + int64(dr.ProcessedRows)
+	tc.cp.MatchingRows = tc.base.MatchingRows + int64(dr.MatchingRows)
+	tc.cp.MismatchedRows = tc.base.MismatchedRows + int64(dr.MismatchedRows)
+	tc.cp.ExtraRowsSource = tc.base.ExtraRowsSource + int64(dr.ExtraRowsSource)
+	tc.cp.ExtraRowsTarget = tc.base.ExtraRowsTarget + int64(dr.ExtraRowsTarget)
+}
+
+// done records the end of the diff of the table, and adds the rows compared
+// before the diff was resumed to its report.
+func (tc *tableCheckpointer) done(ctx context.Context, dr *DiffReport, completed bool) {
+	if completed {
+		tc.setCounts(dr)
+		tc.cp.Completed = true
+		tc.cp.VerifiedAt = tc.cp.StartedAt
+		tc.lastPK = nil
+	}
+	tc.save(ctx)
+	dr.ProcessedRows += int(tc.base.ProcessedRows)
+	dr.MatchingRows += int(tc.base.MatchingRows)
+	dr.MismatchedRows += int(tc.base.MismatchedRows)
+	dr.ExtraRowsSource += int(tc.base.ExtraRowsSource)
+	dr.ExtraRowsTarget += int(tc.base.ExtraRowsTarget)
+}
+
+// report returns the report of a diff which already completed, without
+// samples.
+func (tc *tableCheckpointer) report() *DiffReport {
+	return &DiffReport{
+		TableName:       tc.table,
+		ProcessedRows:   int(tc.cp.ProcessedRows),
+		MatchingRows:    int(tc.cp.MatchingRows),
+		MismatchedRows:  int(tc.cp.MismatchedRows),
+		ExtraRowsSource: int(tc.cp.ExtraRowsSource),
+		ExtraRowsTarget: int(tc.cp.ExtraRowsTarget),
+	}
+}
+
+// save saves the checkpoint of the VDiff. A failed save only loses progress,
+// so it is logged rather than failing the diff. The caller must hold tc.mu
+// while chunks are being diffed.
+func (tc *tableCheckpointer) save(ctx context.Context) {
+	tc.cp.LastPk = nil
+	if len(tc.lastPK) != 0 {
+		fields := make([]*querypb.Field, 0, len(tc.lastPK))
+		for i, pk := range tc.td.selectPks {
+			fields = append(fields, &querypb.Field{Name: tc.td.columnName(pk), Type: tc.lastPK[i].Type()})
+		}
+		tc.cp.LastPk = sqltypes.ResultToProto3(&sqltypes.Result{Fields: fields, Rows: [][]sqltypes.Value{tc.lastPK}})
+	}
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+		defer cancel()
+	}
+	if err := tc.df.ts.TopoServer().SaveVDiffCheckpoint(ctx, tc.df.targetKeyspace, tc.df.workflow, tc.df.checkpoint); err != nil {
+		log.Warningf("Could not save the checkpoint of the vdiff of table %s: %v", tc.table, err)
+	}
+	tc.lastSave = time.Now()
+}
+
+// columnIndex returns the index of a column in the select list of the
+// target, or -1 if the table has no such column.
+func (td *tableDiffer) columnIndex(column string) int {
+	sel, err := parseSelect(td.targetExpression)
+	if err != nil {
+		return -1
+	}
+	for i, expr := range sel.SelectExprs {
+		if col, ok := expr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName); ok && col.Name.EqualString(column) {
+			return i
+		}
+	}
+	return -1
+}
+
+// columnName returns the name of a column of the select list of the target.
+func (td *tableDiffer) columnName(i int) string {
+	sel, err := parseSelect(td.targetExpression)
+	if err != nil {
+		return ""
+	}
+	return sqlparser.String(sel.SelectExprs[i])
+}
+
+// diffQueries returns the queries which stream the rows of the table from the
+// sources and the targets. If lastPK is set, they only stream the rows past
+// it. If since is set, they only stream the rows whose incremental column is
+// at or past it.
+func (td *tableDiffer) diffQueries(lastPK []sqltypes.Value, incrementalColumn string, since int64) (string, string, error) {
+	if len(lastPK) == 0 && since == 0 {
+		return td.sourceExpression, td.targetExpression, nil
+	}
+	sourceSelect, err := parseSelect(td.sourceExpression)
+	if err != nil {
+		return "", "", err
+	}
+	targetSelect, err := parseSelect(td.targetExpression)
+	if err != nil {
+		return "", "", err
+	}
+	bindVars := make(map[string]*querypb.BindVariable)
+	if len(lastPK) != 0 {
+		sourceSelect.AddWhere(pkAfter(sourceSelect, td.selectPks))
+		targetSelect.AddWhere(pkAfter(targetSelect, td.selectPks))
+		for i, pk := range lastPK {
+			bindVars[fmt.Sprintf("lastpk_%d", i)] = sqltypes.ValueBindVariable(pk)
+		}
+	}
+	if since != 0 {
+		i := td.columnIndex(incrementalColumn)
+		if i < 0 {
+			return "", "", fmt.Errorf("table %s has no column %s", td.targetTable, incrementalColumn)
+		}
+		sourceCol, ok := sourceSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName)
+		if !ok {
+			return "", "", fmt.Errorf("column %s of table %s is not a column of the source", incrementalColumn, td.targetTable)
+		}
+		targetCol := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr
+		sourceSelect.AddWhere(&sqlparser.ComparisonExpr{Operator: sqlparser.GreaterEqualOp, Left: sourceCol, Right: sqlparser.NewArgument("since")})
+		targetSelect.AddWhere(&sqlparser.ComparisonExpr{Operator: sqlparser.GreaterEqualOp, Left: targetCol, Right: sqlparser.NewArgument("since")})
+		bindVars["since"] = sqltypes.StringBindVariable(time.Unix(since, 0).UTC().Format("2006-01-02 15:04:05"))
+	}
+	sourceQuery, err := sqlparser.NewParsedQuery(sourceSelect).GenerateQuery(bindVars, nil)
+	if err != nil {
+		return "", "", err
+	}
+	targetQuery, err := sqlparser.NewParsedQuery(targetSelect).GenerateQuery(bindVars, nil)
+	if err != nil {
+		return "", "", err
+	}
+	return sourceQuery, targetQuery, nil
+}
+
+// pkAfter returns the condition selecting the rows whose primary key is past
+// the one in the lastpk_<n> bind variables. If the primary key is (c1, c2),
+// the condition is: (c1 > :lastpk_0) or (c1 = :lastpk_0 and c2 > :lastpk_1).
+func pkAfter(sel *sqlparser.Select, selectPks []int) sqlparser.Expr {
+	var cond sqlparser.Expr
+	for i := range selectPks {
+		var term sqlparser.Expr
+		for j := 0; j <= i; j++ {
+			op := sqlparser.EqualOp
+			if j == i {
+				op = sqlparser.GreaterThanOp
+			}
+			comparison := &sqlparser.ComparisonExpr{
+				Operator: op,
+				Left:     sel.SelectExprs[selectPks[j]].(*sqlparser.AliasedExpr).Expr,
+				Right:    sqlparser.NewArgument(fmt.Sprintf("lastpk_%d", j)),
+			}
+			if term == nil {
+				term = comparison
+			} else {
+				term = &sqlparser.AndExpr{Left: term, Right: comparison}
+			}
+		}
+		if cond == nil {
+			cond = term
+		} else {
+			cond = &sqlparser.OrExpr{Left: cond, Right: term}
+		}
+	}
+	return cond
+}
+
+func parseSelect(query string) (*sqlparser.Select, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	return sel, nil
+}
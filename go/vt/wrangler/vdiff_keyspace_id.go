@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// keyspaceIDColumn is the keyspace_id() column of the filter of a table, like
+// the one of the workflows of CreateLookupVindex. vstreamer computes it from
+// the primary vindex of the source table, but mysql can't: the source query
+// selects the columns of the vindex instead, and the rows streamed from the
+// sources are mapped to their keyspace ids.
+type keyspaceIDColumn struct {
+	// cols are the indexes of the vindex columns in the rows of the source
+	// query. The first one is the index of the keyspace_id() column, and
+	// the others are at the end of the rows.
+	cols   []int
+	vindex vindexes.Vindex
+}
+
+// isKeyspaceIDFunc returns true if the expression is keyspace_id().
+func isKeyspaceIDFunc(expr sqlparser.Expr) bool {
+	fexpr, ok := expr.(*sqlparser.FuncExpr)
+	return ok && fexpr.Name.EqualString("keyspace_id") && len(fexpr.Exprs) == 0
+}
+
+// keyspaceIDColumn returns the keyspace_id() column of the filter of a table
+// at index col, and the vindex columns the source query selects for it.
+func (df *vdiff) keyspaceIDColumn(sel *sqlparser.Select, col int) (*keyspaceIDColumn, []*sqlparser.ColName, error) {
+	tableName, err := selectedTable(sel)
+	if err != nil {
+		return nil, nil, err
+	}
+	if df.ts == nil || df.ts.SourceKeyspaceSchema() == nil {
+		return nil, nil, fmt.Errorf("keyspace_id() requires the vschema of the source keyspace: %v", sqlparser.String(sel))
+	}
+	table := df.ts.SourceKeyspaceSchema().Tables[tableName]
+	if table == nil || len(table.ColumnVindexes) == 0 {
+		return nil, nil, fmt.Errorf("keyspace_id() requires a primary vindex for table %s", tableName)
+	}
+	cv := table.ColumnVindexes[0]
+	if cv.Vindex.NeedsVCursor() {
+		return nil, nil, fmt.Errorf("keyspace_id() is not supported for table %s: its primary vindex %s is not functional", tableName, cv.Name)
+	}
+	kc := &keyspaceIDColumn{
+		cols:   []int{col},
+		vindex: cv.Vindex,
+	}
+	cols := make([]*sqlparser.ColName, 0, len(cv.Columns))
+	for _, c := range cv.Columns {
+		cols = append(cols, &sqlparser.ColName{Name: c})
+	}
+	return kc, cols, nil
+}
+
+func selectedTable(sel *sqlparser.Select) (string, error) {
+	if len(sel.From) == 1 {
+		if aliased, ok := sel.From[0].(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := aliased.Expr.(sqlparser.TableName); ok {
+				return tableName.Name.String(), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unexpected: %v", sqlparser.String(sel))
+}
+
+// groupByVindexCols returns the group by of the source query of a filter
+// with a keyspace_id() column, which groups by the vindex columns instead.
+func groupByVindexCols(sel *sqlparser.Select, vindexCols []*sqlparser.ColName) sqlparser.GroupBy {
+	aliases := make(map[string]bool)
+	for _, selExpr := range sel.SelectExprs {
+		if aliased, ok := selExpr.(*sqlparser.AliasedExpr); ok && !aliased.As.IsEmpty() && isKeyspaceIDFunc(aliased.Expr) {
+			aliases[aliased.As.Lowered()] = true
+		}
+	}
+	var groupBy sqlparser.GroupBy
+	for _, expr := range sel.GroupBy {
+		col, ok := expr.(*sqlparser.ColName)
+		if isKeyspaceIDFunc(expr) || ok && col.Qualifier.IsEmpty() && aliases[col.Name.Lowered()] {
+			for _, vindexCol := range vindexCols {
+				groupBy = append(groupBy, vindexCol)
+			}
+			continue
+		}
+		groupBy = append(groupBy, expr)
+	}
+	return groupBy
+}
+
+// mapRows replaces the vindex columns of the keyspace_id() column of the
+// rows by their keyspace ids.
+func (kc *keyspaceIDColumn) mapRows(result *sqltypes.Result) error {
+	col := kc.cols[0]
+	if result.Fields != nil {
+		field := proto.Clone(result.Fields[col]).(*querypb.Field)
+		field.Type = sqltypes.VarBinary
+		result.Fields[col] = field
+	}
+	if len(result.Rows) == 0 {
+		return nil
+	}
+	rowsColValues := make([][]sqltypes.Value, len(result.Rows))
+	for i, row := range result.Rows {
+		for _, c := range kc.cols {
+			rowsColValues[i] = append(rowsColValues[i], row[c])
+		}
+	}
+	destinations, err := vindexes.Map(kc.vindex, nil, rowsColValues)
+	if err != nil {
+		return err
+	}
+	for i, dest := range destinations {
+		ksid, ok := dest.(key.DestinationKeyspaceID)
+		if !ok {
+			return fmt.Errorf("cannot compute the keyspace id of %v: %v", rowsColValues[i], dest)
+		}
+		result.Rows[i][col] = sqltypes.MakeTrusted(sqltypes.VarBinary, ksid)
+	}
+	return nil
+}
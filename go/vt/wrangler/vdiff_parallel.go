@@ -0,0 +1,311 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// diffChunk is a range of the values of the first primary key column of a
+// table. Each chunk is compared with its own query streams, which read a
+// consistent snapshot of the chunk on the sources and the targets, so that
+// the chunks of a table can be compared concurrently.
+type diffChunk struct {
+	index       int
+	sourceQuery string
+	targetQuery string
+
+	sources         map[string]*shardStreamer
+	targets         map[string]*shardStreamer
+	sourcePrimitive engine.Primitive
+	targetPrimitive engine.Primitive
+}
+
+// splitTable splits the rows of a table streamed by the queries in up to
+// workers chunks. Only the tables whose first primary key column is an
+// integral are split. It returns a single chunk with the queries otherwise.
+func (df *vdiff) splitTable(ctx context.Context, td *tableDiffer, sourceQuery, targetQuery string, workers int) ([]*diffChunk, error) {
+	chunks := []*diffChunk{{sourceQuery: sourceQuery, targetQuery: targetQuery}}
+	if workers <= 1 || len(td.selectPks) == 0 {
+		return chunks, nil
+	}
+	sourceSelect, err := parseSelect(sourceQuery)
+	if err != nil {
+		return nil, err
+	}
+	pk := sourceSelect.SelectExprs[td.selectPks[0]].(*sqlparser.AliasedExpr).Expr
+	bounds := &sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{
+			&sqlparser.AliasedExpr{Expr: &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("min"), Exprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: pk}}}},
+			&sqlparser.AliasedExpr{Expr: &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("max"), Exprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: pk}}}},
+		},
+		From:  sourceSelect.From,
+		Where: sourceSelect.Where,
+	}
+	lo, hi, ok, err := df.pkBounds(ctx, sqlparser.String(bounds))
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "could not read the primary key range of table %s", td.targetTable)
+	}
+	if !ok {
+		return chunks, nil
+	}
+	boundaries := splitRange(lo, hi, workers)
+	if len(boundaries) == 0 {
+		return chunks, nil
+	}
+
+	chunks = make([]*diffChunk, 0, len(boundaries)+1)
+	for i := 0; i <= len(boundaries); i++ {
+		chunk := &diffChunk{index: i}
+		if chunk.sourceQuery, err = chunkQuery(sourceQuery, td.selectPks[0], boundaries, i); err != nil {
+			return nil, err
+		}
+		if chunk.targetQuery, err = chunkQuery(targetQuery, td.selectPks[0], boundaries, i); err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks, nil
+}
+
+// pkBounds runs the query, which selects the minimum and the maximum value of
+// the first primary key column of a table, on the sources. It returns false
+// if the table is empty, or if the values are not integrals.
+func (df *vdiff) pkBounds(ctx context.Context, query string) (lo, hi int64, ok bool, err error) {
+	var mu sync.Mutex
+	integral := true
+	err = df.forAll(df.sources, func(shard string, source *shardStreamer) error {
+		participant := &shardStreamer{
+			primary: source.primary,
+			tablet:  source.tablet,
+			result:  make(chan *sqltypes.Result, 1),
+		}
+		go df.streamOne(ctx, df.ts.SourceKeyspaceName(), shard, participant, query, make(chan string, 1))
+		for result := range participant.result {
+			for _, row := range result.Rows {
+				if row[0].IsNull() || row[1].IsNull() {
+					continue
+				}
+				min, minErr := evalengine.ToInt64(row[0])
+				max, maxErr := evalengine.ToInt64(row[1])
+				mu.Lock()
+				switch {
+				case !row[0].IsIntegral() || !row[1].IsIntegral() || minErr != nil || maxErr != nil:
+					integral = false
+				case !ok:
+					lo, hi, ok = min, max, true
+				default:
+					if min < lo {
+						lo = min
+					}
+					if max > hi {
+						hi = max
+					}
+				}
+				mu.Unlock()
+			}
+		}
+		return participant.err
+	})
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return lo, hi, ok && integral, nil
+}
+
+// splitRange returns the boundaries which split [lo, hi] in up to n ranges of
+// the same size.
+func splitRange(lo, hi int64, n int) []int64 {
+	// The span is computed as an unsigned to not overflow.
+	span := uint64(hi) - uint64(lo)
+	if span < uint64(n) {
+		n = int(span) + 1
+	}
+	width := span/uint64(n) + 1
+	boundaries := make([]int64, 0, n-1)
+	for i := 1; i < n; i++ {
+		boundaries = append(boundaries, lo+int64(uint64(i)*width))
+	}
+	return boundaries
+}
+
+// chunkQuery returns the query restricted to the rows of chunk i, whose
+// column pk is between its boundaries. The first and the last chunks are
+// open ended.
+func chunkQuery(query string, pk int, boundaries []int64, i int) (string, error) {
+	sel, err := parseSelect(query)
+	if err != nil {
+		return "", err
+	}
+	col := sel.SelectExprs[pk].(*sqlparser.AliasedExpr).Expr
+	if i > 0 {
+		sel.AddWhere(&sqlparser.ComparisonExpr{
+			Operator: sqlparser.GreaterEqualOp,
+			Left:     col,
+			Right:    sqlparser.NewIntLiteral(strconv.FormatInt(boundaries[i-1], 10)),
+		})
+	}
+	if i < len(boundaries) {
+		sel.AddWhere(&sqlparser.ComparisonExpr{
+			Operator: sqlparser.LessThanOp,
+			Left:     col,
+			Right:    sqlparser.NewIntLiteral(strconv.FormatInt(boundaries[i], 10)),
+		})
+	}
+	return sqlparser.String(sel), nil
+}
+
+// startChunks starts the query streams of the chunks of a table, one chunk
+// after the other, while the targets are stopped. The targets are fast
+// forwarded to the snapshot of the sources of each chunk before the streams of
+// the targets of the chunk are started, so that every chunk compares a
+// consistent snapshot. The first chunk uses the streamers of the vdiff, and
+// the others use copies of them.
+func (df *vdiff) startChunks(ctx context.Context, td *tableDiffer, chunks []*diffChunk, filteredReplicationWaitTime time.Duration) error {
+	for _, chunk := range chunks {
+		if chunk.index == 0 {
+			chunk.sources, chunk.targets = df.sources, df.targets
+			chunk.sourcePrimitive, chunk.targetPrimitive = td.sourcePrimitive, td.targetPrimitive
+		} else {
+			chunk.sources, chunk.targets = cloneStreamers(df.sources), cloneStreamers(df.targets)
+			chunk.sourcePrimitive = chunkPrimitive(td.sourcePrimitive, chunk.sources)
+			chunk.targetPrimitive = chunkPrimitive(td.targetPrimitive, chunk.targets)
+		}
+		for _, source := range chunk.sources {
+			source.keyspaceID = td.keyspaceID
+		}
+		// Make sure all sources are past the target's positions and start a query stream that records the current source positions.
+		if err := df.startQueryStreams(ctx, df.ts.SourceKeyspaceName(), chunk.sources, chunk.sourceQuery, filteredReplicationWaitTime); err != nil {
+			return vterrors.Wrap(err, "startQueryStreams(sources)")
+		}
+		// Fast forward the targets to the newly recorded source positions.
+		if err := df.syncTargets(ctx, chunk.sources, chunk.targets, filteredReplicationWaitTime); err != nil {
+			return vterrors.Wrap(err, "syncTargets")
+		}
+		// Sources and targets are in sync. Start query streams on the targets.
+		if err := df.startQueryStreams(ctx, df.ts.TargetKeyspaceName(), chunk.targets, chunk.targetQuery, filteredReplicationWaitTime); err != nil {
+			return vterrors.Wrap(err, "startQueryStreams(targets)")
+		}
+	}
+	return nil
+}
+
+// cloneStreamers returns new streamers for the same tablets and positions.
+func cloneStreamers(participants map[string]*shardStreamer) map[string]*shardStreamer {
+	clones := make(map[string]*shardStreamer, len(participants))
+	for shard, participant := range participants {
+		clones[shard] = &shardStreamer{
+			primary:  participant.primary,
+			tablet:   participant.tablet,
+			position: participant.position,
+		}
+	}
+	return clones
+}
+
+// chunkPrimitive returns a copy of the primitive of a table which merges the
+// streams of the participants of a chunk.
+func chunkPrimitive(prim engine.Primitive, participants map[string]*shardStreamer) engine.Primitive {
+	switch prim := prim.(type) {
+	case *engine.MergeSort:
+		ms := newMergeSorter(participants, nil)
+		ms.OrderBy = prim.OrderBy
+		return ms
+	case *engine.OrderedAggregate:
+		oa := *prim
+		oa.Input = chunkPrimitive(prim.Input, participants)
+		return &oa
+	}
+	return prim
+}
+
+// diffChunks compares the chunks of a table concurrently, and merges their
+// reports. The first error cancels the comparison of the other chunks.
+func (td *tableDiffer) diffChunks(ctx context.Context, chunks []*diffChunk, rowsToCompare *int64, debug, onlyPks bool, tc *tableCheckpointer) (*DiffReport, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	rec := &concurrency.FirstErrorRecorder{}
+	drs := make([]*DiffReport, len(chunks))
+	for _, chunk := range chunks {
+		wg.Add(1)
+		go func(chunk *diffChunk) {
+			defer wg.Done()
+			dr, err := td.diff(ctx, chunk, rowsToCompare, debug, onlyPks, tc)
+			if err != nil {
+				rec.RecordError(err)
+				cancel()
+				return
+			}
+			// The chunk is done unless the limit of rows to compare was
+			// reached, possibly by another chunk.
+			if atomic.LoadInt64(rowsToCompare) >= 0 {
+				tc.chunkDone(chunk.index, dr)
+			}
+			drs[chunk.index] = dr
+		}(chunk)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+	if len(drs) == 1 {
+		return drs[0], nil
+	}
+	dr := &DiffReport{}
+	for _, chunkReport := range drs {
+		dr.add(chunkReport)
+		dr.ExtraRowsSourceSample = appendSamples(dr.ExtraRowsSourceSample, chunkReport.ExtraRowsSourceSample)
+		dr.ExtraRowsTargetSample = appendSamples(dr.ExtraRowsTargetSample, chunkReport.ExtraRowsTargetSample)
+		for _, sample := range chunkReport.MismatchedRowsSample {
+			if len(dr.MismatchedRowsSample) < 10 {
+				dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, sample)
+			}
+		}
+	}
+	return dr, nil
+}
+
+// add adds the counts of the rows of another report.
+func (dr *DiffReport) add(other *DiffReport) {
+	dr.ProcessedRows += other.ProcessedRows
+	dr.MatchingRows += other.MatchingRows
+	dr.MismatchedRows += other.MismatchedRows
+	dr.ExtraRowsSource += other.ExtraRowsSource
+	dr.ExtraRowsTarget += other.ExtraRowsTarget
+}
+
+func appendSamples(samples, more []*RowDiff) []*RowDiff {
+	for _, sample := range more {
+		if len(samples) < 10 {
+			samples = append(samples, sample)
+		}
+	}
+	return samples
+}
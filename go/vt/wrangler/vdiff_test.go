@@ -17,6 +17,8 @@ limitations under the License.
 package wrangler
 
 import (
+	"fmt"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -24,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"context"
 
@@ -33,6 +36,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
 
 func TestVDiffPlanSuccess(t *testing.T) {
@@ -399,6 +403,34 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			},
 			targetPrimitive: newMergeSorter(nil, []compareColInfo{{0, 0, true}}),
 		},
+	}, {
+		// min and max
+		input: &binlogdatapb.Rule{
+			Match:  "aggr",
+			Filter: "select c1, c2, min(c4) as c3, max(c4) as c4 from t1 group by c1",
+		},
+		table: "aggr",
+		td: &tableDiffer{
+			targetTable:      "aggr",
+			sourceExpression: "select c1, c2, min(c4) as c3, max(c4) as c4 from t1 group by c1 order by c1 asc",
+			targetExpression: "select c1, c2, c3, c4 from aggr order by c1 asc",
+			compareCols:      []compareColInfo{{0, 0, true}, {1, 0, false}, {2, 0, false}, {3, 0, false}},
+			comparePKs:       []compareColInfo{{0, 0, true}},
+			pkCols:           []int{0},
+			selectPks:        []int{0},
+			sourcePrimitive: &engine.OrderedAggregate{
+				Aggregates: []*engine.AggregateParams{{
+					Opcode: engine.AggregateMin,
+					Col:    2,
+				}, {
+					Opcode: engine.AggregateMax,
+					Col:    3,
+				}},
+				GroupByKeys: []*engine.GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+				Input:       newMergeSorter(nil, []compareColInfo{{0, 0, true}}),
+			},
+			targetPrimitive: newMergeSorter(nil, []compareColInfo{{0, 0, true}}),
+		},
 	}}
 
 	for _, tcase := range testcases {
@@ -465,6 +497,52 @@ func TestVDiffPlanFailure(t *testing.T) {
 	}
 }
 
+func TestVDiffPlanKeyspaceID(t *testing.T) {
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "lkp",
+			Columns:           []string{"c1", "keyspace_id"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary"),
+		}},
+	}
+	sourceKSSchema, err := vindexes.BuildKeyspaceSchema(&vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "col1"}},
+			},
+		},
+	}, "sourceks")
+	require.NoError(t, err)
+
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{
+		Match:  "lkp",
+		Filter: "select col2 as c1, keyspace_id() as keyspace_id from t1 group by c1, keyspace_id",
+	}}}
+	df := &vdiff{ts: &trafficSwitcher{sourceKSSchema: sourceKSSchema}}
+	err = df.buildVDiffPlan(context.Background(), filter, schm, nil)
+	require.NoError(t, err)
+	td := df.differs["lkp"]
+	// mysql selects the vindex column, which is mapped to the keyspace id.
+	assert.Equal(t, "select col2 as c1, col1 from t1 group by c1, col1 order by c1 asc", td.sourceExpression)
+	assert.Equal(t, "select c1, keyspace_id from lkp order by c1 asc", td.targetExpression)
+	assert.Equal(t, []int{1}, td.keyspaceID.cols)
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1|col1", "int64|int64"), "5|1")
+	require.NoError(t, td.keyspaceID.mapRows(result))
+	assert.Equal(t, sqltypes.VarBinary, result.Fields[1].Type)
+	assert.Equal(t, sqltypes.MakeTrusted(sqltypes.VarBinary, []byte("\x16k@\xb4J\xbaK\xd6")), result.Rows[0][1])
+
+	// The primary vindex is required.
+	df = &vdiff{ts: &trafficSwitcher{sourceKSSchema: &vindexes.KeyspaceSchema{Tables: map[string]*vindexes.Table{}}}}
+	err = df.buildVDiffPlan(context.Background(), filter, schm, nil)
+	assert.EqualError(t, err, "keyspace_id() requires a primary vindex for table t1")
+}
+
 func TestVDiffUnsharded(t *testing.T) {
 	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
 	defer env.close()
@@ -733,13 +811,75 @@ func TestVDiffUnsharded(t *testing.T) {
 			env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, tcase.source)
 			env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetPrimaryPosition, tcase.target)
 
-			dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", tcase.debug, tcase.onlyPks)
+			dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", tcase.debug, tcase.onlyPks, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 			require.NoError(t, err)
 			assert.Equal(t, tcase.dr, dr["t1"], tcase.id)
 		})
 	}
 }
 
+// TestVDiffCopyParallelism diffs a table split between two streams of the
+// target shard by -copy_parallelism=2, each replicating a range of its primary
+// key: the whole table is compared.
+func TestVDiffCopyParallelism(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}, {
+			Name:              "t2",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	primary := env.tablets[200].tablet
+	var rows, posRows []string
+	for i, rules := range [][]*binlogdatapb.Rule{{
+		{Match: "t1", Filter: "select * from t1 where c2 > 0 and c1 < 3"},
+		{Match: "t2", Filter: "select * from t2"},
+	}, {
+		{Match: "t1", Filter: "select * from t1 where c2 > 0 and c1 >= 3"},
+	}} {
+		bls := &binlogdatapb.BinlogSource{Keyspace: "source", Shard: "0", Filter: &binlogdatapb.Filter{Rules: rules}}
+		rows = append(rows, fmt.Sprintf("%d|%v|||", i+1, bls))
+		posRows = append(posRows, fmt.Sprintf("%v|%s", bls, vdiffStopPosition))
+		env.tmc.setVRResults(primary, fmt.Sprintf("update _vt.vreplication set state='Running', stop_pos='%s', message='synchronizing for vdiff' where id=%d", vdiffSourceGtid, i+1), &sqltypes.Result{})
+	}
+	env.tmc.setVRResults(primary, "select id, source, message, cell, tablet_types from _vt.vreplication where workflow='vdiffTest' and db_name='vt_target'",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|source|message|cell|tablet_types", "int64|varchar|varchar|varchar|varchar"), rows...))
+	env.tmc.setVRResults(primary, "select source, pos from _vt.vreplication where db_name='vt_target' and workflow='vdiffTest'",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("source|pos", "varchar|varchar"), posRows...))
+
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	for _, tc := range []struct {
+		tablet   int
+		pos      string
+		t1Filter string
+	}{
+		{tablet: 101, pos: vdiffSourceGtid, t1Filter: " where c2 > 0"},
+		{tablet: 201, pos: vdiffTargetPrimaryPosition},
+	} {
+		env.tablets[tc.tablet].setResults("select c1, c2 from t1"+tc.t1Filter+" order by c1 asc", tc.pos, sqltypes.MakeTestStreamingResults(fields,
+			"1|1",
+			"---",
+			"3|3",
+		))
+		env.tablets[tc.tablet].setResults("select c1, c2 from t2 order by c1 asc", tc.pos, sqltypes.MakeTestStreamingResults(fields,
+			"1|1",
+		))
+	}
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, true /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{ProcessedRows: 2, MatchingRows: 2, TableName: "t1"}, dr["t1"])
+	assert.Equal(t, &DiffReport{ProcessedRows: 1, MatchingRows: 1, TableName: "t2"}, dr["t2"])
+}
+
 func TestVDiffSharded(t *testing.T) {
 	// Also test that highest position ""MariaDB/5-456-892" will be used
 	// if lower positions are found.
@@ -803,7 +943,7 @@ func TestVDiffSharded(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 3,
@@ -870,7 +1010,7 @@ func TestVDiffAggregates(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 5,
@@ -935,7 +1075,7 @@ func TestVDiffPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -1000,7 +1140,7 @@ func TestVDiffNoPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -1039,23 +1179,23 @@ func TestVDiffDefaults(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetPrimaryPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 
 	var df map[string]*DiffReport
-	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 	require.Equal(t, df["t1"].ProcessedRows, 3)
-	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 1, "", false /*debug*/, false /*onlyPks*/)
+	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 1, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 	require.Equal(t, df["t1"].ProcessedRows, 1)
-	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 0, "", false /*debug*/, false /*onlyPks*/)
+	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 0, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.NoError(t, err)
 	require.Equal(t, df["t1"].ProcessedRows, 0)
 
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 1*time.Nanosecond, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 1*time.Nanosecond, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.Error(t, err)
 	err = topo.CheckKeyspaceLocked(context.Background(), "target")
 	require.EqualErrorf(t, err, "keyspace target is not locked (no locksInfo)", "")
@@ -1092,7 +1232,7 @@ func TestVDiffReplicationWait(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetPrimaryPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "context deadline exceeded"))
 }
@@ -1370,9 +1510,247 @@ func TestVDiffNullWeightString(t *testing.T) {
 			env.tablets[101].setResults("select c1, c2, weight_string(c2) from t1 order by c1 asc", vdiffSourceGtid, tcase.source)
 			env.tablets[201].setResults("select c1, c2, weight_string(c2) from t1 order by c1 asc", vdiffTargetPrimaryPosition, tcase.target)
 
-			dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+			dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
 			require.NoError(t, err)
 			require.Equal(t, tcase.dr, dr["t1"], tcase.id)
 		})
 	}
 }
+
+func TestVDiffQueries(t *testing.T) {
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "multipk",
+			Columns:           []string{"c1", "c2", "updated_at"},
+			PrimaryKeyColumns: []string{"c1", "c2"},
+			Fields:            sqltypes.MakeTestFields("c1|c2|updated_at", "int64|int64|datetime"),
+		}},
+	}
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{
+		Match:  "multipk",
+		Filter: "select c1 as c1, c2, updated_at from t1 where c2 < 10",
+	}}}
+	df := &vdiff{}
+	require.NoError(t, df.buildVDiffPlan(context.Background(), filter, schm, nil))
+	td := df.differs["multipk"]
+
+	sourceQuery, targetQuery, err := td.diffQueries(nil, "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1 as c1, c2, updated_at from t1 where c2 < 10 order by c1 asc, c2 asc", sourceQuery)
+	assert.Equal(t, "select c1, c2, updated_at from multipk order by c1 asc, c2 asc", targetQuery)
+
+	lastPK := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+	sourceQuery, targetQuery, err = td.diffQueries(lastPK, "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1 as c1, c2, updated_at from t1 where c2 < 10 and (c1 > 1 or c1 = 1 and c2 > 2) order by c1 asc, c2 asc", sourceQuery)
+	assert.Equal(t, "select c1, c2, updated_at from multipk where c1 > 1 or c1 = 1 and c2 > 2 order by c1 asc, c2 asc", targetQuery)
+
+	since := time.Date(2021, time.November, 1, 10, 0, 0, 0, time.UTC).Unix()
+	sourceQuery, targetQuery, err = td.diffQueries(lastPK, "updated_at", since)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1 as c1, c2, updated_at from t1 where c2 < 10 and (c1 > 1 or c1 = 1 and c2 > 2) and updated_at >= '2021-11-01 10:00:00' order by c1 asc, c2 asc", sourceQuery)
+	assert.Equal(t, "select c1, c2, updated_at from multipk where (c1 > 1 or c1 = 1 and c2 > 2) and updated_at >= '2021-11-01 10:00:00' order by c1 asc, c2 asc", targetQuery)
+
+	_, _, err = td.diffQueries(nil, "modified_at", since)
+	require.EqualError(t, err, "table multipk has no column modified_at")
+}
+
+func TestVDiffResume(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	ctx := context.Background()
+
+	// The first vdiff stops after comparing two rows.
+	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields,
+		"1|3",
+		"2|4",
+		"3|1",
+	))
+	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetPrimaryPosition, sqltypes.MakeTestStreamingResults(fields,
+		"1|3",
+		"2|4",
+		"3|2",
+	))
+	dr, err := env.wr.VDiff(ctx, "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 2, "", false /*debug*/, true /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{ProcessedRows: 2, MatchingRows: 2, TableName: "t1"}, dr["t1"])
+
+	checkpoint, err := env.wr.ts.GetVDiffCheckpoint(ctx, "target", env.workflow)
+	require.NoError(t, err)
+	cp := checkpoint.Tables["t1"]
+	require.NotNil(t, cp)
+	assert.False(t, cp.Completed)
+	assert.EqualValues(t, 2, cp.ProcessedRows)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(2)}}, sqltypes.Proto3ToResult(cp.LastPk).Rows)
+
+	// The resumed vdiff compares the rows past the last one compared, and
+	// reports the rows compared by both.
+	env.tablets[101].setResults("select c1, c2 from t1 where c1 > 2 order by c1 asc", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields,
+		"3|1",
+	))
+	env.tablets[201].setResults("select c1, c2 from t1 where c1 > 2 order by c1 asc", vdiffTargetPrimaryPosition, sqltypes.MakeTestStreamingResults(fields,
+		"3|2",
+	))
+	want := &DiffReport{
+		ProcessedRows:  3,
+		MatchingRows:   2,
+		MismatchedRows: 1,
+		TableName:      "t1",
+		MismatchedRowsSample: []*DiffMismatch{{
+			Source: &RowDiff{Row: map[string]sqltypes.Value{"c1": sqltypes.NewInt64(3)}},
+			Target: &RowDiff{Row: map[string]sqltypes.Value{"c1": sqltypes.NewInt64(3)}},
+		}},
+	}
+	dr, err = env.wr.VDiff(ctx, "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, true /*onlyPks*/, true /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
+	require.NoError(t, err)
+	assert.Equal(t, want, dr["t1"])
+
+	checkpoint, err = env.wr.ts.GetVDiffCheckpoint(ctx, "target", env.workflow)
+	require.NoError(t, err)
+	cp = checkpoint.Tables["t1"]
+	assert.True(t, cp.Completed)
+	assert.Nil(t, cp.LastPk)
+	assert.Equal(t, cp.StartedAt, cp.VerifiedAt)
+
+	// A completed table is not diffed again.
+	delete(env.tablets[101].queries, "select c1, c2 from t1 where c1 > 2 order by c1 asc")
+	dr, err = env.wr.VDiff(ctx, "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, true /*onlyPks*/, true /*resume*/, "" /*incrementalColumn*/, 1 /*workers*/)
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{ProcessedRows: 3, MatchingRows: 2, MismatchedRows: 1, TableName: "t1"}, dr["t1"])
+}
+
+func TestVDiffIncremental(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2", "updated_at"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2|updated_at", "int64|int64|datetime"),
+		}},
+	}
+	env.tmc.schema = schm
+	fields := sqltypes.MakeTestFields("c1|c2|updated_at", "int64|int64|datetime")
+	ctx := context.Background()
+
+	// Without a complete vdiff, all the rows are diffed.
+	rows := sqltypes.MakeTestStreamingResults(fields,
+		"1|3|2021-11-01 10:00:00",
+		"2|4|2021-11-01 10:00:00",
+	)
+	env.tablets[101].setResults("select c1, c2, updated_at from t1 order by c1 asc", vdiffSourceGtid, rows)
+	env.tablets[201].setResults("select c1, c2, updated_at from t1 order by c1 asc", vdiffTargetPrimaryPosition, rows)
+	dr, err := env.wr.VDiff(ctx, "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "updated_at", 1 /*workers*/)
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{ProcessedRows: 2, MatchingRows: 2, TableName: "t1"}, dr["t1"])
+
+	checkpoint, err := env.wr.ts.GetVDiffCheckpoint(ctx, "target", env.workflow)
+	require.NoError(t, err)
+	verifiedAt := checkpoint.Tables["t1"].VerifiedAt
+	require.NotZero(t, verifiedAt)
+
+	// The next one only diffs the rows modified since the start of the last
+	// complete one.
+	query := fmt.Sprintf("select c1, c2, updated_at from t1 where updated_at >= '%s' order by c1 asc", time.Unix(verifiedAt, 0).UTC().Format("2006-01-02 15:04:05"))
+	rows = sqltypes.MakeTestStreamingResults(fields,
+		"2|5|2031-11-01 10:00:00",
+	)
+	env.tablets[101].setResults(query, vdiffSourceGtid, rows)
+	env.tablets[201].setResults(query, vdiffTargetPrimaryPosition, rows)
+	dr, err = env.wr.VDiff(ctx, "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/, false /*resume*/, "updated_at", 1 /*workers*/)
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{ProcessedRows: 1, MatchingRows: 1, TableName: "t1"}, dr["t1"])
+
+	checkpoint, err = env.wr.ts.GetVDiffCheckpoint(ctx, "target", env.workflow)
+	require.NoError(t, err)
+	assert.Equal(t, verifiedAt, checkpoint.Tables["t1"].Since)
+	assert.True(t, checkpoint.Tables["t1"].Completed)
+}
+
+func TestVDiffParallel(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	ctx := context.Background()
+
+	// The primary key range [1, 4] is split in two chunks, each compared
+	// with its own streams.
+	env.tablets[101].setResults("select min(c1), max(c1) from t1", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(sqltypes.MakeTestFields("min(c1)|max(c1)", "int64|int64"),
+		"1|4",
+	))
+	env.tablets[101].setResults("select c1, c2 from t1 where c1 < 3 order by c1 asc", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields,
+		"1|1",
+		"2|2",
+	))
+	env.tablets[201].setResults("select c1, c2 from t1 where c1 < 3 order by c1 asc", vdiffTargetPrimaryPosition, sqltypes.MakeTestStreamingResults(fields,
+		"1|1",
+		"2|2",
+	))
+	env.tablets[101].setResults("select c1, c2 from t1 where c1 >= 3 order by c1 asc", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields,
+		"3|3",
+		"4|4",
+	))
+	env.tablets[201].setResults("select c1, c2 from t1 where c1 >= 3 order by c1 asc", vdiffTargetPrimaryPosition, sqltypes.MakeTestStreamingResults(fields,
+		"3|3",
+		"4|5",
+	))
+	want := &DiffReport{
+		ProcessedRows:  4,
+		MatchingRows:   3,
+		MismatchedRows: 1,
+		TableName:      "t1",
+		MismatchedRowsSample: []*DiffMismatch{{
+			Source: &RowDiff{Row: map[string]sqltypes.Value{"c1": sqltypes.NewInt64(4)}},
+			Target: &RowDiff{Row: map[string]sqltypes.Value{"c1": sqltypes.NewInt64(4)}},
+		}},
+	}
+	dr, err := env.wr.VDiff(ctx, "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, true /*onlyPks*/, false /*resume*/, "" /*incrementalColumn*/, 2 /*workers*/)
+	require.NoError(t, err)
+	assert.Equal(t, want, dr["t1"])
+
+	checkpoint, err := env.wr.ts.GetVDiffCheckpoint(ctx, "target", env.workflow)
+	require.NoError(t, err)
+	cp := checkpoint.Tables["t1"]
+	assert.True(t, cp.Completed)
+	assert.EqualValues(t, 4, cp.ProcessedRows)
+}
+
+func TestVDiffSplitRange(t *testing.T) {
+	testcases := []struct {
+		lo, hi int64
+		n      int
+		want   []int64
+	}{
+		{lo: 1, hi: 4, n: 2, want: []int64{3}},
+		{lo: 0, hi: 100, n: 4, want: []int64{26, 52, 78}},
+		{lo: 1, hi: 2, n: 4, want: []int64{2}},
+		{lo: 5, hi: 5, n: 4, want: []int64{}},
+		{lo: math.MinInt64, hi: math.MaxInt64, n: 2, want: []int64{0}},
+	}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, splitRange(tcase.lo, tcase.hi, tcase.n), "splitRange(%d, %d, %d)", tcase.lo, tcase.hi, tcase.n)
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"vitess.io/vitess/go/cron"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
@@ -336,6 +337,63 @@ func (wr *Wrangler) WorkflowTagAction(ctx context.Context, keyspace string, work
 	return wr.convertQueryResultToSQLTypesResult(results), err
 }
 
+// WorkflowThrottleAction sets the throttler app and the throttle ratio of the streams of a workflow. An empty
+// throttlerApp keeps the current throttler app of the streams. The streams restart with the new settings.
+func (wr *Wrangler) WorkflowThrottleAction(ctx context.Context, keyspace string, workflow string, throttlerApp string, throttleRatio float64) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	if throttleRatio < 0 || throttleRatio > 1 {
+		return nil, fmt.Errorf("invalid throttle ratio %v, expecting a ratio between 0 and 1", throttleRatio)
+	}
+	return wr.updateWorkflowSources(ctx, keyspace, workflow, func(bls *binlogdatapb.BinlogSource) {
+		if throttlerApp != "" {
+			bls.ThrottlerApp = throttlerApp
+		}
+		bls.ThrottleRatio = throttleRatio
+	})
+}
+
+// WorkflowCutoverWindowsAction sets the cutover windows of the streams of a workflow, the semicolon separated cron
+// expressions of the times at which its writes may be switched. Empty windows allow switching them at any time.
+func (wr *Wrangler) WorkflowCutoverWindowsAction(ctx context.Context, keyspace string, workflow string, cutoverWindows string) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	windows, err := cron.ParseWindows(cutoverWindows)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cutover windows: %v", err)
+	}
+	return wr.updateWorkflowSources(ctx, keyspace, workflow, func(bls *binlogdatapb.BinlogSource) {
+		bls.CutoverWindows = windows.String()
+	})
+}
+
+// updateWorkflowSources updates the binlog sources of the streams of a workflow.
+func (wr *Wrangler) updateWorkflowSources(ctx context.Context, keyspace string, workflow string, update func(bls *binlogdatapb.BinlogSource)) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	results, err := wr.runVexec(ctx, workflow, keyspace, "select id, source from _vt.vreplication", false)
+	if err != nil {
+		return nil, err
+	}
+	updated := make(map[*topo.TabletInfo]*sqltypes.Result, len(results))
+	for primary, result := range results {
+		qr := &sqltypes.Result{}
+		for _, row := range sqltypes.Proto3ToResult(result).Rows {
+			id, err := evalengine.ToInt64(row[0])
+			if err != nil {
+				return nil, err
+			}
+			var bls binlogdatapb.BinlogSource
+			if err := prototext.Unmarshal(row[1].ToBytes(), &bls); err != nil {
+				return nil, err
+			}
+			update(&bls)
+			query := fmt.Sprintf("update _vt.vreplication set source = %s where id = %d", encodeString(bls.String()), id)
+			res, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+			if err != nil {
+				return nil, err
+			}
+			qr.RowsAffected += res.RowsAffected
+		}
+		updated[primary] = qr
+	}
+	return updated, nil
+}
+
 // ReplicationStatusResult represents the result of trying to get the replication status for a given workflow.
 type ReplicationStatusResult struct {
 	// Workflow represents the name of the workflow relevant to the related replication statuses.
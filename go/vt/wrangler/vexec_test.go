@@ -29,6 +29,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
 
 func TestVExec2(t *testing.T) {
@@ -175,6 +177,81 @@ func TestWorkflowStatusUpdate(t *testing.T) {
 	require.Equal(t, "Error", updateState("error: primary tablet not contactable", "Running", nil, 0))
 }
 
+func TestWorkflowThrottleAction(t *testing.T) {
+	ctx := context.Background()
+	workflow := "wrWorkflow"
+	keyspace := "target"
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil, 0)
+	defer env.close()
+	wr := New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace:     "source",
+		Shard:        "0",
+		ThrottlerApp: "backfill",
+	}
+	throttled := &binlogdatapb.BinlogSource{
+		Keyspace:      "source",
+		Shard:         "0",
+		ThrottlerApp:  "backfill",
+		ThrottleRatio: 0.8,
+	}
+	for _, uid := range []int{200, 210} {
+		tablet := env.tablets[uid].tablet
+		env.tmc.setVRResults(tablet, "select id, source from _vt.vreplication where db_name = 'vt_target' and workflow = 'wrWorkflow'",
+			sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|source", "int64|varchar"), fmt.Sprintf("1|%v", bls)))
+		env.tmc.setVRResults(tablet, fmt.Sprintf("update _vt.vreplication set source = %s where id = 1", encodeString(throttled.String())),
+			&sqltypes.Result{RowsAffected: 1})
+	}
+
+	results, err := wr.WorkflowThrottleAction(ctx, keyspace, workflow, "", 0.8)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.EqualValues(t, 1, result.RowsAffected)
+	}
+
+	_, err = wr.WorkflowThrottleAction(ctx, keyspace, workflow, "", 1.5)
+	require.EqualError(t, err, "invalid throttle ratio 1.5, expecting a ratio between 0 and 1")
+}
+
+func TestWorkflowCutoverWindowsAction(t *testing.T) {
+	ctx := context.Background()
+	workflow := "wrWorkflow"
+	keyspace := "target"
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil, 0)
+	defer env.close()
+	wr := New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: "source",
+		Shard:    "0",
+	}
+	windowed := &binlogdatapb.BinlogSource{
+		Keyspace:       "source",
+		Shard:          "0",
+		CutoverWindows: "* 2-4 * * 1-5; * * * * 0,6",
+	}
+	for _, uid := range []int{200, 210} {
+		tablet := env.tablets[uid].tablet
+		env.tmc.setVRResults(tablet, "select id, source from _vt.vreplication where db_name = 'vt_target' and workflow = 'wrWorkflow'",
+			sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|source", "int64|varchar"), fmt.Sprintf("1|%v", bls)))
+		env.tmc.setVRResults(tablet, fmt.Sprintf("update _vt.vreplication set source = %s where id = 1", encodeString(windowed.String())),
+			&sqltypes.Result{RowsAffected: 1})
+	}
+
+	// The windows are normalized.
+	results, err := wr.WorkflowCutoverWindowsAction(ctx, keyspace, workflow, "* 2-4 * * 1-5;* * * * 0,6;")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.EqualValues(t, 1, result.RowsAffected)
+	}
+
+	_, err = wr.WorkflowCutoverWindowsAction(ctx, keyspace, workflow, "* 2-4 * *")
+	require.EqualError(t, err, `invalid cutover windows: invalid cron expression "* 2-4 * *": expecting 5 fields, found 4`)
+}
+
 func TestWorkflowListStreams(t *testing.T) {
 	ctx := context.Background()
 	workflow := "wrWorkflow"
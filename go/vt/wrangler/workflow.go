@@ -7,8 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"vitess.io/vitess/go/cron"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -67,6 +71,8 @@ type VReplicationWorkflowParams struct {
 	// MoveTables specific
 	SourceKeyspace, Tables  string
 	AllTables, RenameTables bool
+	// CopyParallelism is the number of streams per source shard copying the tables in parallel
+	CopyParallelism int
 
 	// Reshard specific
 	SourceShards, TargetShards []string
@@ -75,6 +81,18 @@ type VReplicationWorkflowParams struct {
 
 	// Migrate specific
 	ExternalCluster string
+
+	// ThrottlerApp and ThrottleRatio are the throttling settings of the streams of the workflow
+	ThrottlerApp  string
+	ThrottleRatio float64
+
+	// CutoverWindows are the semicolon separated cron expressions of the times at which the writes of the workflow
+	// may be switched
+	CutoverWindows string
+
+	// MaxReplicationLagAllowed is the maximum lag of the reverse streams for which a Reshard workflow whose writes
+	// have been switched may be rolled back
+	MaxReplicationLagAllowed time.Duration
 }
 
 // NewVReplicationWorkflow sets up a MoveTables or Reshard workflow based on options provided, deduces the state of the
@@ -157,6 +175,11 @@ func (vrw *VReplicationWorkflow) stateAsString(ws *workflow.State) string {
 			stateInfo = append(stateInfo, "Writes Switched")
 		} else {
 			stateInfo = append(stateInfo, "Writes Not Switched")
+			if vrw.ts != nil {
+				if err := vrw.ts.checkCutoverWindows(time.Now()); err != nil {
+					stateInfo = append(stateInfo, "Waiting For Cutover Window: "+err.Error())
+				}
+			}
 		}
 	}
 	return strings.Join(stateInfo, ". ")
@@ -171,6 +194,21 @@ func (vrw *VReplicationWorkflow) Create(ctx context.Context) error {
 	if vrw.CachedState() != WorkflowStateNotCreated {
 		return fmt.Errorf("workflow has already been created, state is %s", vrw.CachedState())
 	}
+	// The streams are throttled and given their cutover windows from their start, so they are created stopped
+	// and started once updated.
+	throttled := vrw.params.ThrottlerApp != "" || vrw.params.ThrottleRatio != 0
+	autoStart := vrw.params.AutoStart
+	if throttled && (vrw.params.ThrottleRatio < 0 || vrw.params.ThrottleRatio > 1) {
+		return fmt.Errorf("invalid throttle ratio %v, expecting a ratio between 0 and 1", vrw.params.ThrottleRatio)
+	}
+	if _, err := cron.ParseWindows(vrw.params.CutoverWindows); err != nil {
+		return fmt.Errorf("invalid cutover windows: %v", err)
+	}
+	updated := throttled || vrw.params.CutoverWindows != ""
+	if updated {
+		vrw.params.AutoStart = false
+		defer func() { vrw.params.AutoStart = autoStart }()
+	}
 	switch vrw.workflowType {
 	case MoveTablesWorkflow, MigrateWorkflow:
 		err = vrw.initMoveTables()
@@ -190,6 +228,25 @@ func (vrw *VReplicationWorkflow) Create(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if throttled {
+		if _, err := vrw.wr.WorkflowThrottleAction(ctx, vrw.params.TargetKeyspace, vrw.params.Workflow,
+			vrw.params.ThrottlerApp, vrw.params.ThrottleRatio); err != nil {
+			return err
+		}
+	}
+	if vrw.params.CutoverWindows != "" {
+		if _, err := vrw.wr.WorkflowCutoverWindowsAction(ctx, vrw.params.TargetKeyspace, vrw.params.Workflow,
+			vrw.params.CutoverWindows); err != nil {
+			return err
+		}
+	}
+	if updated {
+		if autoStart {
+			if _, err := vrw.wr.WorkflowAction(ctx, vrw.params.Workflow, vrw.params.TargetKeyspace, "start", false); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -297,6 +354,56 @@ func (vrw *VReplicationWorkflow) ReverseTraffic() (*[]string, error) {
 	return vrw.SwitchTraffic(workflow.DirectionBackward)
 }
 
+// Rollback returns all the read and write traffic of a Reshard workflow to the source shards. If the writes have
+// been switched, the reverse workflow must be running on all the source shards and be caught up to within
+// MaxReplicationLagAllowed, so that no write made on the target shards is lost. The forward workflow is restarted,
+// so the traffic can be switched again later.
+func (vrw *VReplicationWorkflow) Rollback() (*[]string, error) {
+	if !vrw.Exists() {
+		return nil, fmt.Errorf("workflow has not yet been started")
+	}
+	if vrw.workflowType != ReshardWorkflow {
+		return nil, fmt.Errorf("invalid action for workflow: Rollback is only supported for Reshard workflows")
+	}
+	ws := vrw.ws
+	var tabletTypes []string
+	if len(ws.ReplicaCellsSwitched) > 0 {
+		tabletTypes = append(tabletTypes, "replica")
+	}
+	if len(ws.RdonlyCellsSwitched) > 0 {
+		tabletTypes = append(tabletTypes, "rdonly")
+	}
+	if ws.WritesSwitched {
+		if err := vrw.checkReverseWorkflow(); err != nil {
+			return nil, err
+		}
+		tabletTypes = append(tabletTypes, "primary")
+	}
+	if len(tabletTypes) == 0 {
+		return nil, fmt.Errorf("cannot roll back workflow because no read or write traffic has been switched")
+	}
+
+	// switchWrites swaps the keyspaces and the workflow name in the params when reversing, restore them for the
+	// state check.
+	savedParams := *vrw.params
+	defer func() { *vrw.params = savedParams }()
+	vrw.params.Cells = ""
+	vrw.params.TabletTypes = strings.Join(tabletTypes, ",")
+	vrw.params.EnableReverseReplication = true
+	dryRunResults, err := vrw.SwitchTraffic(workflow.DirectionBackward)
+	if err != nil {
+		return nil, err
+	}
+	if vrw.params.DryRun {
+		return dryRunResults, nil
+	}
+	*vrw.params = savedParams
+	if state := vrw.CurrentState(); state != WorkflowStateNotSwitched {
+		return nil, fmt.Errorf("workflow is in state %q after the rollback, expected %q", state, WorkflowStateNotSwitched)
+	}
+	return dryRunResults, nil
+}
+
 // Workflow errors
 const (
 	ErrWorkflowNotFullySwitched  = "cannot complete workflow because you have not yet switched all read and write traffic"
@@ -387,6 +494,62 @@ func (vrw *VReplicationWorkflow) parseTabletTypes() (hasReplica, hasRdonly, hasP
 	return hasReplica, hasRdonly, hasPrimary, nil
 }
 
+// checkReverseWorkflow checks that the reverse workflow of a workflow whose writes have been switched is healthy
+func (vrw *VReplicationWorkflow) checkReverseWorkflow() error {
+	ts := vrw.ts
+	reverseWorkflow := ts.ReverseWorkflowName()
+	status, err := vrw.wr.ShowWorkflow(vrw.ctx, reverseWorkflow, ts.SourceKeyspaceName())
+	if err != nil {
+		return fmt.Errorf("cannot roll back: reverse workflow %s.%s not found, the writes were probably switched "+
+			"without -reverse_replication: %v", ts.SourceKeyspaceName(), reverseWorkflow, err)
+	}
+	if err := checkReverseStreams(status, ts.SourceShards(), ts.TargetShards(), vrw.params.MaxReplicationLagAllowed,
+		time.Now()); err != nil {
+		return fmt.Errorf("cannot roll back: reverse workflow %s.%s is not healthy: %v", ts.SourceKeyspaceName(),
+			reverseWorkflow, err)
+	}
+	return nil
+}
+
+// checkReverseStreams checks that every source shard has a running stream from each of the target shards it
+// overlaps, lagging by at most maxLag
+func checkReverseStreams(status *ReplicationStatusResult, sourceShards, targetShards []*topo.ShardInfo,
+	maxLag time.Duration, now time.Time) error {
+	streams := make(map[string][]*ReplicationStatus)
+	for _, shardStatus := range status.ShardStatuses {
+		for _, st := range shardStatus.PrimaryReplicationStatuses {
+			streams[st.Shard] = append(streams[st.Shard], st)
+		}
+	}
+	for _, source := range sourceShards {
+		for _, target := range targetShards {
+			if !key.KeyRangesIntersect(source.KeyRange, target.KeyRange) {
+				continue
+			}
+			var stream *ReplicationStatus
+			for _, st := range streams[source.ShardName()] {
+				if st.Bls.Shard == target.ShardName() {
+					stream = st
+					break
+				}
+			}
+			if stream == nil {
+				return fmt.Errorf("no stream from shard %s to shard %s", target.ShardName(), source.ShardName())
+			}
+			if stream.State != binlogplayer.BlpRunning {
+				return fmt.Errorf("stream %d on %s is in state %s: %s", stream.ID, stream.Tablet, stream.State, stream.Message)
+			}
+			if maxLag > 0 {
+				if lag := now.Sub(time.Unix(stream.TimeUpdated, 0)); lag > maxLag {
+					return fmt.Errorf("stream %d on %s is lagging by %v, more than %v", stream.ID, stream.Tablet,
+						lag.Truncate(time.Second), maxLag)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // endregion
 
 // region Core Actions
@@ -395,7 +558,7 @@ func (vrw *VReplicationWorkflow) initMoveTables() error {
 	log.Infof("In VReplicationWorkflow.initMoveTables() for %+v", vrw)
 	return vrw.wr.MoveTables(vrw.ctx, vrw.params.Workflow, vrw.params.SourceKeyspace, vrw.params.TargetKeyspace,
 		vrw.params.Tables, vrw.params.Cells, vrw.params.TabletTypes, vrw.params.AllTables, vrw.params.ExcludeTables,
-		vrw.params.AutoStart, vrw.params.StopAfterCopy, vrw.params.ExternalCluster)
+		vrw.params.AutoStart, vrw.params.StopAfterCopy, vrw.params.ExternalCluster, vrw.params.CopyParallelism)
 }
 
 func (vrw *VReplicationWorkflow) initReshard() error {
@@ -434,6 +597,10 @@ func (vrw *VReplicationWorkflow) switchWrites() (*[]string, error) {
 		vrw.params.Workflow = workflow.ReverseWorkflowName(vrw.params.Workflow)
 		log.Infof("In VReplicationWorkflow.switchWrites(reverse) for %+v", vrw)
 	}
+	if vrw.params.DryRun {
+		return vrw.wr.RehearseSwitchWrites(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, vrw.params.Timeout,
+			vrw.params.EnableReverseReplication)
+	}
 	journalID, dryRunResults, err = vrw.wr.SwitchWrites(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, vrw.params.Timeout,
 		false, vrw.params.Direction == workflow.DirectionBackward, vrw.params.EnableReverseReplication, vrw.params.DryRun)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -29,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
@@ -385,6 +387,118 @@ func TestReshardV2(t *testing.T) {
 	require.NotNil(t, si)
 }
 
+func TestReshardV2Rollback(t *testing.T) {
+	ctx := context.Background()
+	sourceShards := []string{"-40", "40-"}
+	targetShards := []string{"-80", "80-"}
+	p := &VReplicationWorkflowParams{
+		Workflow:       "test",
+		SourceKeyspace: "ks",
+		TargetKeyspace: "ks",
+		SourceShards:   sourceShards,
+		TargetShards:   targetShards,
+		Cells:          "cell1,cell2",
+		TabletTypes:    "replica,rdonly",
+		Timeout:        DefaultActionTimeout,
+	}
+	tme := newTestShardMigrater(ctx, t, sourceShards, targetShards)
+	defer tme.stopTablets(t)
+	wf, err := tme.wr.NewVReplicationWorkflow(ctx, ReshardWorkflow, p)
+	require.NoError(t, err)
+	require.NotNil(t, wf)
+	require.Equal(t, WorkflowStateNotSwitched, wf.CurrentState())
+	_, err = wf.Rollback()
+	require.EqualError(t, err, "cannot roll back workflow because no read or write traffic has been switched")
+
+	tme.expectNoPreviousJournals()
+	expectReshardQueries(t, tme)
+	require.NoError(t, testSwitchForward(t, wf))
+	require.Equal(t, WorkflowStateReadsSwitched, wf.CurrentState())
+	tme.expectNoPreviousJournals()
+	_, err = wf.Rollback()
+	require.NoError(t, err)
+	require.Equal(t, WorkflowStateNotSwitched, wf.CurrentState())
+	require.Equal(t, "replica,rdonly", wf.params.TabletTypes)
+
+	mtwf := getMoveTablesWorkflow(t, "cell1,cell2", "replica,rdonly")
+	mtwf.ws = &workflow.State{WritesSwitched: true}
+	_, err = mtwf.Rollback()
+	require.EqualError(t, err, "invalid action for workflow: Rollback is only supported for Reshard workflows")
+}
+
+func TestCheckReverseStreams(t *testing.T) {
+	shards := func(names ...string) []*topo.ShardInfo {
+		var sis []*topo.ShardInfo
+		for _, name := range names {
+			_, kr, err := topo.ValidateShardName(name)
+			require.NoError(t, err)
+			sis = append(sis, topo.NewShardInfo("ks", name, &topodata.Shard{KeyRange: kr}, nil))
+		}
+		return sis
+	}
+	now := time.Now()
+	stream := func(shard, sourceShard, state string, timeUpdated time.Time) *ReplicationStatus {
+		return &ReplicationStatus{
+			Shard:       shard,
+			Tablet:      "cell1-0000000100",
+			ID:          1,
+			Bls:         &binlogdatapb.BinlogSource{Keyspace: "ks", Shard: sourceShard},
+			State:       state,
+			TimeUpdated: timeUpdated.Unix(),
+		}
+	}
+	status := func(streams ...*ReplicationStatus) *ReplicationStatusResult {
+		rsr := &ReplicationStatusResult{ShardStatuses: make(map[string]*ShardReplicationStatus)}
+		for _, st := range streams {
+			key := st.Shard + "/" + st.Tablet
+			if rsr.ShardStatuses[key] == nil {
+				rsr.ShardStatuses[key] = &ShardReplicationStatus{}
+			}
+			rsr.ShardStatuses[key].PrimaryReplicationStatuses = append(rsr.ShardStatuses[key].PrimaryReplicationStatuses, st)
+		}
+		return rsr
+	}
+	// The reverse streams of a 2 to 3 shard split.
+	sourceShards, targetShards := shards("-80", "80-"), shards("-40", "40-a0", "a0-")
+	healthy := []*ReplicationStatus{
+		stream("-80", "-40", "Running", now),
+		stream("-80", "40-a0", "Running", now),
+		stream("80-", "40-a0", "Running", now.Add(-5*time.Second)),
+		stream("80-", "a0-", "Running", now),
+	}
+
+	testcases := []struct {
+		name    string
+		streams []*ReplicationStatus
+		err     string
+	}{{
+		name:    "healthy",
+		streams: healthy,
+	}, {
+		name:    "missing stream",
+		streams: healthy[:3],
+		err:     "no stream from shard a0- to shard 80-",
+	}, {
+		name:    "stopped stream",
+		streams: append([]*ReplicationStatus{stream("-80", "-40", "Stopped", now)}, healthy[1:]...),
+		err:     "stream 1 on cell1-0000000100 is in state Stopped: ",
+	}, {
+		name:    "lagging stream",
+		streams: append(healthy[:3:3], stream("80-", "a0-", "Running", now.Add(-time.Minute))),
+		err:     "stream 1 on cell1-0000000100 is lagging by 1m0s, more than 30s",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkReverseStreams(status(tc.streams...), sourceShards, targetShards, 30*time.Second, now)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
 func TestVRWSchemaValidation(t *testing.T) {
 	ctx := context.Background()
 	sourceShards := []string{"-80", "80-"}